@@ -29,17 +29,19 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<]o#9r\xef\xfe\x15\x05\xe7a\xee\x00K\xbe\xc1\xbd\x04~\x9b\xf5x\x11a7sƎ\xcfy\b\xf2@u\x97$\x9e\xd9d\x87d\xcbV\x82\xfc\xf7\xa0\x8ad\u007f\xa8?5\xeb\xbb\xc5\xe2\x86Ov\x8b,\x16\xab\x8a\xf5\xc5\"\xafV\xabՕ(\xe53Z'\x8d\xbe\x03QJ|\xf3\xa8\xe9?\xb7~\xf9W\xb7\x96\xe6\xf6\xf8q\x8b^|\xbcz\x91:\xbf\x83\xfb\xcayS\xfc\x82\xceT6\xc3ϸ\x93Zzi\xf4U\x81^\xe4\u008b\xbb+\x00\xa1\xb5\xf1\x82>;\xfa\x17 3\xda[\xa3\x14\xda\xd5\x1e\xf5\xfa\xa5\xdaⶒ*G\xcb3\xa4\xf9\x8f\u007fZ\xffy\xfd\xa7+\x80\xcc\"\x0f\u007f\x92\x05:/\x8a\xf2\x0et\xa5\xd4\x15\x80\x16\x05\xde\xc1Vd/U\xe9\xd6GTh\xcdZ\x9a+WbFs\xed\xad\xa9\xca;h~\bC\"\x1ea\r?\xf0h\xfe\xa0\xa4\xf3?\xb5>\xfe,\x9d\xe7\x1fJUY\xa1\xea\x99\xf8\x9b\x93z_)a\xd3\xd7+\x80ҢC{Ŀ\xea\x17m^\xf5\x8f\x12U\xee\xee`'\x94\xc3+\x00\x97\x99\x12\xef\xe0\vaP\x8a\f\xf3+\x80\xa3P2\xe7\xd5\x05\x9cL\x89\xfa\xd3\xe3\xe6\xf9\xcf_\xb3\x03\x16\"|\x04\xc8\xd1eV\x96\xdc/\"\aҁ\x80g^\x1a\xd8\xc8\x02\xf0\a\xe1\xe9?FE{\a\xfe\x80\x90\x89\xd2W\x16\xc1\xec\xe0\xa7j\x8bV\xa3G\x17!\x03d\xaar\x1e-8/<\x82\xf0 \xa04R{\x90\x1a\xbc,\x10\xfe\xf0\xe9q\x03f\xfb7̼\x03\xa1s\x10ΙL\n\x8f9\x1c\x8d\xaa\n\fc\xff\xb8\x8e0KkJ\xb4^&BSkIV\xfd\xedl]\x1fh\xe1\xa1\x0f\xe4$K\x18Џ\x12\x8198&\n\xad\xc3\x1f\xa4\x03\x8bq\x99L\xc0\x16X\xa0.BG\xa4\xd7\xf0\x95\xb8b\x1d\xb8\x83\xa9TN\x02xDKt\xca\xcc^\xcb\xff\xa9!;\xf0\x86\xa7T\xc2c\xe4}jR{\xb4Z(bY\x857L\x88B\x9c\xc0\"\xcd\x01\x95nA\xe3.n\r\xffn,\x82\xd4;s\a\a\xefKww{\xbb\x97>\xed\xa5\xcc\x14E\xa5\xa5?\xdd\xf2\x8e\x90\xdb\xca\x1b\xebns<\xa2\xbaur\xbf\x126;H\x8f\x191\xefV\x94rňk\xdeJ\xeb\"\xff\x97\xc4u\xf7\xa1\x85\xa9?\x91\x909o\xa5\xdeןY\xd4G\xe9N2\x1f\xc4)\f\v\xf87\xe4\xa5OD\x95_\x1e\xbe>\xb5EM\xba.͙\xda\xcd0\xd7\x10\x9e\b%\xf5\x0em`\xdcΚ\x82!\xa2\u0383\xac\xb1\x98*\x89\xbaKtWm\v\xe9\x89\xd3\xff]\xa1#q6k\xb8g\x8d\x02[\x84\xaa\xccI\nװ\xd1p/\nT\xf7\xc2\xe1ߝ\xecDa\xb7\"\x92\xce\x13\xbe\xad\b\xbb\x1d\x03\xb5\xea\xcfIe\rr(\xec\xf8\xaf%f\x9d\x8dAc\xe4Nf,\xfe\xb03\xb6Q\bA'\xad[\x00\x876e\x98h'*\xe5\x9fy#\xbb'\xf3\v:/\xb3n\x9f3t>\x0f\x0eI蠃\xd7\x03\xfa\x03Z\x92\x15\xfe\x81\xb7\xdd\x19D`\x06:\xccyω\x17\x04\x11\xb1\xe6ͫ\x14\x94&\xe9\x17\a\xdbSBt}\x06'Psk\x8cB\xd1\xd5\x01\xf8\x96\xa9*ǼV\xb8nrU\x0f\xbd\xeel\xa9\x84Դ3\xc86\x10b\xba\xf9\x95u\xad\xb0\xd8[\x18I\xa7\xd4\x01\x1ak\xd1\x03\x0e0\x84\x9a\xf4X\xf4\xb0\x1a\x11\xa5\b\xbbRJl\x15ށ\xb7\xd5\xf9\xd4a\x9c\xb0V\x9c\x06)\x91,\xf52BԽ\xa3nP2c\x1bRk\x00\xa6\xc5\xef\x88\f\ac^\xa6\x97\xfeoԣ\xd1`\x90\xb1\x83\x03[<\x88\xa346.6\x9a\x91-\x02\xbeaVy\xec˶\xf0\x90\xcb\xdd\x0e-A)\x0f¡\vfk\x8c\x04cۓ\x9a\x1dc[\x0f\xff\x86e\xc2bX\xef\x18ʴI5#ӧnh\xe4c\xe8\\\x1ee^\t\x05R;/t\x16\xd6!j\x9c\xce\xd7\x01\xe3\xec\xeca\x1b\xd4Z\u0099h\xdfQqF#\x18\v\x05i\xf3~W7\b\x1fF\x97\xbb\x15\xa4kL\x10C[)tq\xa2\x9c5g\xb3\xafoF\x00\xd7\\\b\xb6_\x89-*p\xa80\xf3\xc6\x0e\x91a\x9a\xa9\xa1\xcd\xeb\xa8\x11\xda\rh\xabF\xff\xd2\x12ۊʌ\xc2\x04x=\xc8\xec\x10\xcc2\xc9\vC\x81ܠ\xe3\xfd+\xcaR\x9d\x86\x17\aӜ\x0emb\v7mr3\x9f\xc3\xeao\xeb\xa6\xcd깦\xcdh\xbc.-k\xd6\xff\xf3\x902)\xee\x8b\x05s\xd3\x1b\xf8\x9e\x82ID\x94\xe4Zov\x80E\xe9O7 }\xfaJ\x9e\x84\xe0\xc0p\x94<\xf5ܿ;F\\*ӛ\xf3q\xef(ӿ\x92\v\xf5Կ\x1b&\xb0\xb2\xff\x1au\xfdB\x06\xfc\xdc\x1es\x03rW3 \xbf\x81\x9dT\x1e\xed\x19'\xa6\x96k\xa69\xf1kI0o\xa9\xa8\x15\xc2g\x87\x877\xf2\x8e\\\x93\xcfYD\x8d\xf3\xa1\xc1\xa7L^uטNB\x05\x0e\x06\xa5\xc5\"\x84\x98OL\xc1\xe6\v{>\x9f\xbe|\xc6|\x9c(\xb0D\xc2zK\xf8t\x86f{\xda\xe8\"/[@tR\xea\xe8\"\xa4\vn@\xc0\v\x9e\x82w!4\x10C\x04MC\x9dg!Z\xe4\x9c\x05\v\xd4\v\x9e\x18HLČ]\xc6\xfa\xd0^\xf04\xdf\xe9\x8cl\x84\x8dt1\xadB\xf4\xa3\x0fL\x00\x8ea\x97\x92\f8\x89\x944\xccܢ`\xa9\x8aH-Q\xfb\xe2\xe5\xd5lj\xf2\x1e\x81\x91\x1f\\`\nI\xfbA\x96\x8b\x16H\xaa\x13\x1c\xf2\x9eHI\xa4g\xa1d^O\x13\xe4{\xa3o\xe0\x8b\xf1\x1b=\xe6\xacv\xdbÛt1w\xf7٠\xfbb<\u007fyw\"\x06\x94/&a\x18\xc6[H\a5L\xebo\xe7\xa2f\x858\xb4M\x88\xb0j\x96H\a\x1bM1D\xa0U\xc8&\x86ɦ\xb4}\xb7\x15\x95\xe3d\x936z\xc5\xc6n=4O$\xf1BAns\xa1\x8fV=e\x98n\x11\xc4'\xb2\vatȌ*\x91a\x0ey\xc5D\xe4̞\xf0\xb8\x97\x19\x14h\xf7ㆠ\xddJ\xd2\xd9K\xa6_\xa4KC\xbbH\x9e\x96\x98\xe6Ԣ2\xce\xe7\xd0X\xd1ޜ\xed\x93X;\xd3q0\x957\xdeqn\x1dl$\xd9o\x98\xa1\xa6\xc8s>h\x11\xeaq\xb1\xf6^L\xf9\xbe\xdd\x0e(\x05\x1bW\bN\xd0\xfd/\x99*\x16\xda\xff\x83RH;\xbbC?\xf1\x89\x89\xc2\xceȘ\x15jOB\xf0\xa5\x03\xe2\xe6Q\xa8\xf3\x84\xf0\xc0\xb2\fi\rT\xc1\f\x9b]\xcfӸ\x81׃q\xc1*\xee$\xaa\x1c䔧E\xed\xfa\x05O\xd77\xbd=~\xbd\xd1\xd7\xc1<\xf7vl\xb2\xe53\x80\x8dV'\xb8\xe6\x91\xd7\xdf\xee\xba,\x92\xba\x05\x9d\xf8\xf8l\x993K\xd1\\\xb2\xe24\xac>\x83!Wt\x1c\xdb\x052W\x1a\xe7\x17\"\xf1h\x9c\x0f\x19\xba\x8e\xf38\x90\x1b\x9a\x8eibN\b\xc4.\x9c{\x19\x9bN8H\x91\x9d\xa5*\x89K\x0e\a\x13\x9c=\x88y\x04)\x94\x82\xebf\x8f\x06\xfdx\x1d\x8e=x\n\x91\xb1[0\x01\x91D\xa1\xb4&C\xe7\xa6\xc4aV\xf3\xce$\xdc\xead\x9b\bAE8D\x98J\ue976\xd4m$\xd2\\\xe4f?\xbc\xb5r\x80\xb4\xb5\xe9\xffi1\xbb\f#\xe03\xe8\xa2\x10z\xd6X\xf4\x90\xbb\x0f\xe3\xd2V\x88`\x82\xcbn\xf7\x15o㥞^\x14\x9a\xdf\xd6\xc0\x16Ro\x188||Ws\fI%\xe2\xe5.\xf5}\x1aِ\xb9\xfe\x10\xf6fi\xfa)\xf7\xa1\xf6z@\x8b\x1dN\xf53\xc3\xec\xcei\xe3[\xe1\xf92B\a<>8\xd8I\xeb|\x1bI\xc7\a[\xef\x1f\xa3\xe8\ak\xbf!D\xf9K\x18\xd7J\x00\x1d\xcck:)\x1c9\x9c\x1bj|\f\x82 w =\xa0\xceL\xa59\x89A\x9b\x94'\b$\r\xcat\xd6Ȇ\xb6dcSC]\x15K\x16\xbeb\xe9\x91z\"\xd7\xd1\xee\xfc\xa3\x90S\x99\xaa\xd4.b\x93\x97\x05\x9aj¨5\xadæ\xa70\xaes\xc4[\x887YT\x05\x88\x82\x88\xbd\x88\xa2d\x99e\x81]\xfe«\x90\x9e\xb5;AeU\xef\rm\x8aR\xa1_\x16\rlqg,\xefE's\xacMf\xe4\xb9\xd1 `'\xa4\xaa\xec\"\x8dv\x01E\x97{\xf6q\x93\xbf\x8fӾd\xda\x15/\u007f6M\xb9\xc8U\x9bҪ\xa5]\xea\xa8=Z|O\x17\xa9\xb4\x92dƼ\xaf\x97\x14EI\xe8\xd3w7\xa9E\x9b\xefnR\xaf}w\x93:\xed\xbb\x9b\xf4\xddM\x9al\xdfݤ\xefn\xd2?\xab\x9b4\x8dɊ\xf3V\x83?\xcd\xcc>{\x84:\x8e\xd8(\xe4x\xaa\u007f\x1fj\xaf\x97\xd5\xe5m\x86\xc7\f\xd4]ƒ\xee\x15W\x9c\xf7\xf9\xdc\x1c\xfd7j\xbe.\xd4#\xe1O\xc2\x1b\nK'K\xf7\x16\x14\xe2\r\xd5fΗ\x97\xcc\x15\x95tk\x12\xeb\u008eT\x94h\xd2\x14\xbdէJvr3\xdb\x15\fB\xa9vm\x8a\xb0\rQ~\xa3z\xc5\xd9ҏ\x99\x82\x8f\xe9\xb2\xcdq\n\x9d\xb9\xf6]\x12\xd9N\x89\xe1oL\xa1ɺ\x8c\xf1j\x8cx\x92\x81^\x1c?\xae\xbb\xbfx\x13k3\xe0U\xfaCo\x01\\4I!\x8b\u07b7\x8b#\x93L\xc5\xeb\x03\xe7\x94\x03cAKu3X\x17S߬h\x93\x13\xfeR\x86\xa0\xe8\xa2\xfd6\xe5\xda/\xa9\xdd\xf8抍nMƠ\x92\xbd\xec\xb0ci\t\xe9\xf2\x9a\x8cn\xcdň\x91YP\x89qq\xa5\xc5|\xbc5YU\xf1\r\xb5\x14\xa9Nb\xca\xe0NTP,\xf09\xe6\xab%\xbe\xa9F\x82\x0f\xf3&\xb0\xbe\xa82\xa2U\xf50\x01rY=\xc4\x02\x92\xcc\xd5>\\\\\xf1p^e0\xb1\x88\xb9:\x87\xf1\x1a\x86\t\xa0\x83\xd5\rK*\x17&`\xd65\r\xefX\xaf0S\xa5\xf0>\x95\x84\xbf\xd6\xf7\x1c\xab9\x98\xa94\x98\xf1L\xa7\xb0\x9a\xa9%X^A0C\x9fo\xac\x16\xa8\xeb\x01\x06缴F\xa0[\x050\brae\xc0\xc8\xd9\xff \xc8\x05\xf5\x003'\xfe\x83`'\r\xe3\x84D\x8c\xfedl\x8ev\u008d\\&\v\x13r\xd0M\xa3\x9c\xcdvVw\x9c\xeexQ\xaf\xb6[ڧ\x85\xa9+f3\xf8I\xea<\x90\x8fx\xdf2\x83|w\x91+\x12j;\xdc8*C \xcf\xdc`\x87\xa5\xb0\xc8I\xe9S\b\x8c\xdd\x1a\x1eDv\xe8v\x84\x83p\x14\x1a\x15\x03\xa5\x98\xd7u\xd4p\x9b\xc6З\xeb5\xc0\x8f\xa6\x0e\xc6\xda\xf7G\x9c,Ju\x82\xca!\\w\x87\\\xee\x14\x0f\xf0\xdbiQ\xba\x83I\x17\xf4&\xfd\xe2\xafݾ\x03\xc1d\xba\x9e\x97)S\xe55\xecAv\t}\x82\xc7g6\xea|\xf5)k.~Eӝ\x9c\xdd\xf3{a?\xbcgp鼱b\x8f?\x9b\xacu\xb3zl\xfdݾ\x9dk\xb0q\x13\xa7\x14N\xaa{\x11\xe9>fw\xe8P\xac\x10\xb3\xaaQ\xe6\x9bh\x9b0\xec\xef\xef\xd1\x1d潚\\\xc4\xd3\xd3\xcf\x01q/\v\\\u007f\xaeB\xe0\xbe*\x85uH\xf4K\v\n\x83\xb6\xf4\xe7\xc1\xbc\xf6\x10V&\xae\xf4\x87s|-rΖ\xb3\x03\x8b\xb1\x0ew7\x93\x80%2M\x8b\xe3\xf3\xf0\x98V\xec\xd1bJ\xd8\xc1f76\xaa\xb7\xc0\xd6\xc5u\x8a\xeeB\x05\xd3{]I\x1c6\xc6×}\xbd\U000156fb\xee˝\xd2\xe5\xfd\x98\xe1\xaf,\xdf(\f\x00\x820^|\xe37\xa63;/*L\xf1\xe4\xbeߟ\xaf\xce\xdb< \xc5i\xd4\xfa\xf2\xee\xabpu\xc2t\xc0\x825\xc0\xc28v\xfe\b\x16\xe6\x80G\xd4`4\xe7G\xf9\xc6^x\xd7\xe1|L?_т\x11ӯU\xa9\x8c\xc8\xd3\xceM6'>\a\xf0\xc4\xfa\xc8\x1e\xd1~p\xa3\x10\xf9j\xf2\xceء\xe5\x9fKV0\fw\x90\v\x8f\xab\x01\x80\v\xf4\u0600H\xf1a\xc1\xccU]\xee\x12v\a\x9f3\xa4\xbb\xd3ᠡ@\xe7\xc4>\xdd\xd1}%u\xb4GMN\xcd@V0\xba\xdeM\xa2\xba{_5D\xf0\"\xf3\x95\x88\xe0Sʢ\xd5\xebC\u007f\xcf)\xb3\x87\x9dT\xdc1\xbe\x10\x10\xf5\xf3\xb0\"\x91\xda\xe3\x1e\xbb\xee0\xbe\x95\xd2\xce\xeb\xf2\x87\xba\x1bQ\x84S5\xbcÛ\a3Pɽ$\x85H\x8c\xdd\v\xbb\x15{\\eFQ\xdc,\x8d>\xc7\xe8\xef\xc3\xd7\x00u\xe05\x8cނ~l\xf7L\x1eO\x14\xe6\x00%=\x8eq\x13-*q\xb0\x10\u007f3\xb6\u007f8WHmlpW9dJC\x17\xebs\xbe\xc6<\x89\xef#\xf5\xa8O#[\xba\n\x930\r\xdb\xf9\xa1S\xab\x15|\xc1s\x13\x15\x0e\xa20\u007f\xae_M\xe9u\xd8\xe8Gk\xf6\xe4\xe1\xf7~\xbaOZ\xa9\xf7ˣ\xb0^\n\xa5N\x01\xfcȬ\xbdϟ\x91\xf4\u0088!\x18\"`\xc4l\x9a\x86\xb1S\x13BH\x1dx͇G[S\xf9Άk6l\x8f\xe3i\xbe5|1\x1eS\x9eHv!\x92\x05D\xe7W\xb8\xdb\x19\xebC\xbc\xb2Z\x81\xdcE\xc3҃Jڙ3\x9d\xe1\xf5\r\x90\xbe\x89\xda\x1b\xd9d_Тp,\x9b\x9e_\x00\xe1c\x06\x91e\xe4\x9f\xe0\xad\xf3B\xf5t\xc07\xa77\xd9^\x93ta\xfeמ9\xeb\x11y\xd3\xee]\xd75W\xc56\xc4$\f,Ћ\x8fr\x83\xd6S\xc3!\xfc\x16Që\x95ޓ\xbei'\x80\xc1\x93\x86Q\n\x9c\x81\x9d\x18\xbc#>\xae\xf3\xf8W\xe3\x85ڌ%0\xba.`\xdd5-\x87\a\xf7\x17e\x88\r[^\xfa\xe0rB1\x8fti$1.;\b\xbd'\x01\xb2\xa6\xda\x1f\x92\x04\x8eX\x8a\xe1\xa4mE\bA\xa9\xaa=\x89tL\xa4\xfa\xca\xeaV\xf4\x19S\xaby\vU\x91\xbd@U\x0eW\x1a\x84\xb7\x81\xe2\xd3N\xb7\xf1\xee\xf7jgM\xb1\x8a\xf4\xe7\x1c\xe9M\x8c\f\xad4\xe42qL\x13\xaf_\x8e\x80e\xb6\x97%j\x10.\xe22[g4\xc5\xc8\xf1@\xcd\v\xeb\x979a_;]g\xfc/\x86\x8b\xf9\x1a\xbeRt+\x06N\xae\xb9\xc6\xea\xfe\xfca-\x8aLuzE*\xc4ҁ\xf5\x8e\xdc2\x8b\x1c\xb6\x84;\x97=\x88\x1d\x87\xaa\xe3@uQ\xff\xc7\xf8NͻZ\x0f\xf3^\xd4\xf3Y糃3\xda\xc1\r\xbc\xe4\xfb\xfcA\xee\xfa\xf1EY*\x99\x11\xb6\u007f\xfc\x8d\x0eĎ\v\xbc\x8a\x0f\x93\x0e\x05{\x0f\xb5o\x00\x9f\xb1\xb4\x98Ѯ\xec#\xff\xa8\x90\xec\xbdC\xecz*\x1f\x16;v\xdd\x10\xd1}\xf2\x1e\x8br`\xae\x89\x18\xb1\x194\xa6\xf8D\xea\xd0[@z\xbd,\x81\x8a\x95\x1f\xa3A\xe1\xe2\x85Ԯ\xc6%\v\xa9\a\x8d-\xc4U\x19)\xa0]5d\x8a\xea\x98\xeb\x1dW\xf5*,\x05\xdaӻ\xe7?b\xa7\x81($\x8e\u007f\xdf8\xa4\x15\x86$\xfc\xfeA\x81Ȁ\x1e?\xfb\xd4<^\xf8\xb1\xf9\x8fɷ\x8a\x8f\x15\x1eC\xfd k˼\xb5\xb5#*\xf1K\x93 \x10Y\x86$\xbb_\xce\xdf-\xbc\xbe\xe6\u007f\xd2ӄ\xfcoft\xb0\xa5\xee\x0e\xfe\xf3\xbf\xae 晞\x13\x1e\xf4\xf1\xff\x03\x00\x00\xff\xff\fi\xcb\xdd\xe8Q\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcXMsۼ\x11\xbe\xebW\xec\xb8\a_*\xca\xe9{\xe9\xf0\xa6\xe8\xed;\x93։5\x96\xe3\x1e\xda\xce\x04\x02\x97\x12j\x10`\xf1!G\xed\xf4\xbfw\x16\x00?DR\x92\x93i\x82\x93\x04,\x17\xcf>\xfb\x81\x05f\xf3\xf9|\xc6j\xf1\x8c\xc6\n\xadr`\xb5\xc0\xaf\x0e\x15\xfd\xb3\xd9\xcb\x1fm&\xf4\xe2\xf0n\x8b\x8e\xbd\x9b\xbd\bU\xe4\xb0\xf2\xd6\xe9\xea\x11\xad\xf6\x86\xe3\xafX\n%\x9c\xd0jV\xa1c\x05s,\x9f\x010\xa5\xb4c4m\xe9/\x00\xd7\xca\x19-%\x9a\xf9\x0eU\xf6ⷸ\xf5B\x16h\xc2\x0e\xcd\xfe\x87\xbb\xec\x97\xecn\x06\xc0\r\x86ϟD\x85ֱ\xaa\xceAy)g\x00\x8aU\x98Ö\xf1\x17_[\xa7\rۡ\xd4<\xee\x95\x1dP\xa2љ\xd03[#\x0fH\x8a\"\xc0crm\x84rhVZ\xfa*\u009aß7\x0f\x9f\xd6\xcc\xedsȬc\xce۬\xde3\x8b\x01r\x81\x96\x1bQ\xbb\x00\xec}\xd8\x0f6qC\xb8O;B\xfc\n\xac\xe7{`\x16\x96\a&$\xdbJ\\|V\xac\xf9\x1d\xb4E\xd8\xebV\xbb;֘\x83uF\xa8\xdd\x19(\x92Y\xf7̤(Z&Ƹ\xeeG2 ,\xb8=\x02}\r\x8e&\xe8_\xe4\v\x880\x84\x86/xe6\xa8\x048D\x1dX\xf4\xc0\x92nx>Y\x88\xa8\xe9\xff\x10s\xe3\xfdl乞\xc6\xe5\x0e\xc7jvF\xfb:\x87\xceuQ:\x05N\f\xbaH\u007fb\xbf!?\xacKa\xdd_\xce\xcb\xdc\v\xeb\x82\\-\xbda\xf2\\\xe0\x04\x11\xbb\xd7\xc6}궞\xc3\xd6ʸ\"\xd4\xceKf\xce|>\x03\xa8\rZ4\a\xfc\xac^\x94~U\xbf\t\x94\x85͡d2\xf8\xdbrM\x16\a\xe55\xe3\x81M\xeb\xb7&eQ\xda0\xfa=\x87\xff\xfcw\xd6z\x84\xbc\x1c\x16u\x8dj\xb9\xfe\xf0\xfcˆ\xef\xb1byr\xdcD\x94\x0e(\xa0\x80`=\x9f\xef\xd1 <\a\xb6c<\xd8dU\xd2\b\xa0\xb7\xffD\xee\x9aШ\x8d\xae\xd18Ѡ\xa4ѫ\x19\xed\xdc\x00\xcb-\x81\x8d2PP\x95\xc0\x18\x97)ױ\x00\x1b\f\x01]\x82\xdb\v\v\x06\x03\x89\xcau\xcem\x01\x95\xc0T\x82\x95\xc1\x86\x886\x96\xfc\xe5eA\xa5\xe5\x80ƁA\xaewJ\xfc\xbb\xd5l\xc1\xe9\x94\n\x0eS\x184#\x94\x02\xc5$\xd1\xec\xf1\xf7\xc0T\x01\x15;\x82A\xda\x03\xbc\xeai\v\"6\x83\x8f\x94;B\x95:\x87\xbds\xb5\xcd\x17\x8b\x9dpM\x95亪\xbc\x12\xee\xb8\b\xb5Nl\xbd\xd3\xc6.\n<\xa0\\X\xb1\x9b3\xc3\xf7\xc2!w\xde\xe0\x82\xd5b\x1e\x80\xabX\xb8\xaa\xe2wm0\xdc\xf6\x90\x0e\xcaD\x1c!'\xce\xf2N\xd9\x10}\x1e?\x8b\xf8;zi\x8aXy\xfc\xd3\xe6\t\x9aM\x83\vN9\x0flw\x9fَx\"J\xa8\x12Mt\\it\x154\xa2*j-\x94\v\u007f\xb8\x14\xa8NI\xb7~[\tG\x9e\xfe\x97G\xeb\xc8?\x19\xac\xc2Y\x01[\x04_\x87B\x93\xc1\a\x05+V\xa1\\1\x8b?\x9cvb\xd8Ή\xd2\xeb\xc4\xf7\x8f\xb8S\xc1\xc8V;ݜ>\x93\x1e\x9a\xcc\xd2M\x8d\xfc$O\n\xb4\xc2P,;\xe60d@J\xda\x13J\xcf\x17\xc6\xf3\xc9\x1b\x12\x98s\xb4\xf6\xa3.\xf0t~\x00uي\x9d`\xab\xd1T\u0086&\x01Jm\x86'\fKe\xbe?\x9a\xfa\x93\rVP\xf9j\ba\x0e\x8fȊ\a%\x8f\x93\v\u007f5\xc2\r7\x98t\x17\x8d\bksT|\x8dF\xe8⢹\xef\a\u00ad\xd1{\xfd\ne\b[\xe5\xe4\x91\xea\x8a=*>\xac\x9b\xcdX\xae?454&Gʥ\xc4M\x06˔\x93\xba\x84;(\x84\xa5.\xc1\x06\x95Cz\xa8\xe9\xa1\xd5\x1c\x9c\xf1o6\x9akU\x8a\xdd\xd0\xd4~+4\x1d\x15\x17\x95\x0e\xb8Z\x85=\xa8\xd0P\x04\xd4F\x1fD\x81fN\x91/J\xc1\x13\x06o\xe2\xa9S\x86\x03qh\xddd\xee@[|RX_t\xd9C_\xb2k\xcb\"\x8a\x14\xae\x16\x1dU=\v\n)\x9c\x99\x19\xc6\x15\x90G\xb9V\x8a\xbc\xe44\xb0֞[;t\xde\xe0\xd3s\tFc\xeb\xf9\v\xba\xf1\xfc0\xea\x82Xӷŏ\b\x85\xb7\x18\xb8\xbd\f\xe0\x8a\xcf\x008[\xa1\xb9\x8eb\xb5$\xb16\xe2\x19\xac\x96\xb0\xf5\xaa\x90\xd8`yݣ\xa2\xe3[\x94G:C\x9e\xee7\x13:\xa1\xe11\x14\x87t\x007lNa/\xb5\xa9\x98\xcba{\x1c%\xf5U\xd3j\x83\xa5\xf8zմu\x10k\b\xae\x99ۃPV\x14\bl\x82\xee\x89*ی6\x81\x1f\xea\x98H\xdf\xe8\f\xaa T\xd4\xc7\x05/\xc2xkz4|^̌u\x12j\xedn\xfe\x87\x86kX\xb0\xa7Ss\u008a\xae/\xfd-\x16D~\xbc\b\xe3y,\u007f\xa1\xac6\xf7\x90q\x82RK\xa1\x8dA[kUP\xfc\xbd\xad\xa8vp\xff\x1f\xa5uʁ\xf3\xd3ju\xb2\xd2p~\xb5_\x88\x9d\xff\xb7u\f\xf1\xea\xd9?\x97\xf56\\BzM\xc3\x0f\xee\x0fnz\r\x02\xb5\x9c\n\xbc\xf2\x16\x8bX\xef3\xf8\xbb\x82_\xa9\x81\xe4\xd4\xd8儑z9;\xf2\xaeү\xf4qO[P\x00Z\x05\xbbBsD-z\xec7\xc3ҫ\x90\x92\xbaF\x83\x95>\xa4\xebi\u007fP\x8bgP\x1e\xe9Z\xaeK8\xfc!\xbb\xcbn~r\xf3Awp\xea&\xb0xă\x18^\x97\xc6lޏ\xe4\x9b\xe4mC\x9b\xfe|i\xfaЅIb_F\xe6\x97BR\xd7<\x91\xe9\xddUp\xfcL\xf0~s\u007fk\xc3c\ru\xfc#\xa5\xaf\xe4>\x1b\x00\xd2\rJ\xa7F\xdf[\x87f\xc2٭\xaf\x84\x05\xa5Aj\xb5;I\x858R\xdb\x0f\xda@\f\x1dm\xa0@\xea\xd8)\xcb\xf9\x9e\xa9\x1dvW\xb9\x84\xbd\x87\x92\x02c\x8c\xf44:\xbah\x10j:\x14\xde\xe0\xc3'Q]Ά\xfb\x13\xd1釘\x16u\xf2\xa5\x1c\xe7\xe4\x1b\xb8\x1eH7g(\x119w\xcdCQ7\xbe\xaf\x8b\x1c\xbf?]\xb5\xfe\xbb\x9f\xa2\xc6\xe63\xdb=J\xfd|\xdb\xc33\xe0\xe5\xe3\x95$\x1a\v\xb97\x06\x95\xeb\xeanH\xa6\xa9\xda\xfb\xb6\xfb\xcf\xf2\xe4\xed\xb0\xbf2|W\xbcj\xcb\xc4y3\x98\xea^_\xdfu\xff\xd2\x03i|\xb7\v\v\x00\xf1p\xe9\x11\x99*J\x9a\xe9\x0e1:=j\x87ŧ\xe1;\xde\xcd\xcd\xc9c\\\xf8\xcb\xe9<\x8fO\xc5\xf0\xb7\u007f̢V,\x9e\x1b\x1c4\xf9\xbf\x00\x00\x00\xff\xffڀI\b\xa9\x16\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=Moܸ\x92w\xfd\x8a\x82\xf7``\xd1-\xbf\xe0]\x16}\xcb$\x9e]c\xf3f\x8c\x897\x97\x87w`K\xd5-\xae%RCR\xed\xf4,\xf6\xbf/\x8a\x1fjI\xd6\a\xe58\xd8̃\xad\x1cb\x89,\x92\xf5]\xc5\"\x9dl\xb7ۄ\xd5\xfc\v*ͥ\xd8\x01\xab9~5(\xe87\x9d>\xfe\x9bN\xb9\xbc9\xbdۣa\xef\x92G.\xf2\x1d|h\xb4\x91\xd5o\xa8e\xa32\xfc\x88\a.\xb8\xe1R$\x15\x1a\x963\xc3v\t\x00\x13B\x1aF\xaf5\xfd\n\x90Ia\x94,KT\xdb#\x8a\xf4\xb1\xd9\xe3\xbe\xe1e\x8eʎ\x10\xc6?\xfd%\xfdk\xfa\x97\x04 Sh\xbb?\xf0\n\xb5aU\xbd\x03єe\x02 X\x85;س챩kY\xf2\x8c\xa3NOX\xa2\x92)\x97\x89\xae1\xa3!\x8fJ6\xf5\x0e.\x1f\\O?\x1d\xb7\x94\x9f,\x90{\x02r\xb6\xafK\xae\xcd\x7f>\xfb\xf4\x89kc?\xd7e\xa3X9\x1c\xdc~\xd2\\\x1c\x9b\x92\xa9\xde\xc7s\x02P+ԨN\xf8_\xe2Q\xc8'\xf13\xc72\xd7;8\xb0Rc\x02\xa03Y\xe3\x0e>\x94\x8d6\xa8\x12\x80\x13+yn\x97\xeef*k\x14\xef\xef\xef\xbe\xfc\xf5sV`e\x91K\xafsԙ\xe2\xb5mכ,p\r\f2\aok\xc1\xe7\xf0\xc5b\x01\x94'\x1a\x98\x82\x19(d\x99k\xc8dUIᡂ\a\x05\x1a\x8d\xe1\xe2\xa87\xa0\x9b\xac\x00\xa6\xc1\x14\b\x0f\x0f\x9f6\xa0\x8dT\xec\x88P\xca\xccNSo\xa0\x90\xf2Q\x03\x139\xe0W\x1aپmA\xda\xc1h\xf6yS\xa2\x86\x8c\tPx@\x85\"C\xe0B\x1bd9\xc8\x03(\xac\x89\xe6\xe2HcU\xa9\xef_+Y\xa32<P\x8e\x9e\x0eǶ\xef\x06(\xb9&\x9c\xb96\x90\x13\x8f\xa2[\xc2ɽ\xc3\x1c\xb4\xc5'\rl\n\xaeit\xa2\x94p\\\xdb\x01\vԄ\t\x90\xfb\xff\xc6̤\U00019a294\xe8B6eN\x8c}Be@a&\x8f\x82\xff\xd1B\xd6`\xa4\x1d\xb2d\x06\xb5\xe9A\xe4\u00a0\x12\xac$j7\xb8\xb1\xa8\xab\xd8\x19\x14\xd2\x18Ј\x0e4\xdbD\xa7\xf07\xa9\b]\a\xb9\x83\u0098Z\xefnn\x8e\xdc\x04\x19%26\x82\x9b\xf3\x8d\x954\xbeo\x8cT\xfa&\xc7\x13\x967\x9a\x1f\xb7Le\x057\x98\x99F\xe1\r\xab\xf9\xd6N\\\xd0buZ\xe5\xff\x12xC_wfj\xceĜ\xda(.\x8e\xedk+;\x93x'\xf1q<躹%^\xd0\xeb\xe9\v\xbf\xdd~~\xe82$\xd7\x1d\x90\xe0\xb1}\xe9\xa6/\x88'Dqq@e{\xc1A\xc9\xca\xe2\x19E^K.\x8c\xfd%+9\x8a>\xd2u\xb3\xaf\xb8!J\xffޠ6D\x9f\x14>XM\x05{\x84\xa6Ι\xc1<\x85;\x01\x1fX\x85\xe5\a\xa6\U0007b8dd0\xac\xb7\x84\xd2e\xc4w\x15l\xf8\xa1\xfe;\x8f\xad\xf6uЁ\xa3\x14\xea*\x8b\xcf5f=\xf1\xa0\x9e\xfc\xc0\x9dd\xc3A*`Ay8\xbdց\n\xe0\x94\\\x90\xd4)i\xa5\xc7`U\x93\x1c\xf4\xdf\x0ef\xf6\xe0\x1b\x11\xfb\x10\r\xf3ֶ\x90\bқ\x81v\xb2zl\x00\x11:\xaa&\xa8\x99\xc0s\xb5א\xa2@ŭ({8\\\x00k\xfb]\xf79\x91\x1e\xf9$\xda%\x80<\xa1R<\xc7\x0e\xc8k\xddE\xc2\x1c\"\xe8\xc9\xf1\xc0\x9a\xd2|\x91eS\xa1~\x90?k\xb7\xae\xe7-\a\b\xfa8\xd11\x90\r5<\x15h\nTP\xcb\x1cNv\x80\x11\xa0\x00\a^\"\xe8\xb36Xy\xc2n\xe0\x89\x9b®ɽ\xb8\xd6\xd0ԥd9\xaaMPv$&\x1a\xf3Q\x90\xa4\xed\xd8#\x02\xf3\x00\x89f\xac,;3Ѱ?\x87ŧp/s\r\xb26\xe1\xe3(P٘\xe1\xbc.\xb6\xfeƽ\xd8z\x00[k|r\xd4\x1d\xefcH\x16zȏ`\xfb\x12w`T\x83#\r\x9cL\xed\xa5,\x91\x89E\xea\xfd\x86\xda\xf0\x9e\xb8E\xd1\xceu\x1b\xa1\x9c\xf2\x1f,\xc6G\xa0B\xa0\xc2j\x8c\x7f$e\x9a\x91\x92ی\xc2m4N\xb3\x987\xd4\x1bx*xVXRkrn2̭!\x7f*\xf09\xae\xe8\xd9KS\x00SHR\x9b\xae\xc66\xd6\x05V\xa8X\xe9f\xe4\xd4\xd6\"\xb2o\xc7zAVH\xa9;\x98&\x9e\"}J\x9cZ˾\x02\x1e\x99A\xc0i@A.ŵ\x01٘\x92\x9f\xac2\xe0V\xec\xf4\x86\xf4\x17\xb1&\xe6\xd0ԣ0-K;B\xa7p;\x1c\x00\x9e\n\xa9\x11\xc8(\x007\x90K\xd44\x92\x9b>)\xe5Q\x98\x84\xe2vT(\xf9#\x82\x1c\xa8\x02\xfd\"q\x98Se\xf4d\x9a\x8f\x7f\x18\x10\xe5\xc3绠\xd9Y\xd6\xda\x17.J.\xd0~\xec\xe3w\x02$X^\xaa\x95<q\xef\xc89+Ejf\x7f\xf6d\xc8\x15?\xa1\xb2nU\x8e%\x1a̭\x16\x99\x04\xc9G9\x93\xfe\xa1h\xaa\xa9\xd5m\xe1NX\x8d3\xf9\xfd\xf6\xeb\xfcw\xdf\xff\xee\xf0\x9e\xbc\x10\x92̉\xa6\xa3\xfe@\xf7\xc1\xaa6\xe7\x8f\\EQ\xe2\xd67\x1e!G\x803\xc71\x7f&\xbc\xd4J\x92K\x8d=\x8fu\x121\xf7\xa1\xf5\bfZH-\x9fN@\x84^\xcc\xe4\x1b\x93\x8e\xa18\x90g\x044\x93\r\xf9\xa9\xf2\x11\x85N\xe1\xc1\xf2,9\x90(̸\x1d\xa4'\x93\x15:oW\x06Ca\x9d\xe7V!\xf55\x00\x05Q\x1a\xcbӟ\x9d\x86\xa3\x1enx\xbc\xc9\xcf\xdf\xdf\xdf\xfd;\x05\xfc\xa3*\xaa\xcf\xfb\xc3\x1e>X)\x892\xf2\x00\xef\xef\xef\\\xee\xc0\xa5\v\xc6]̠\x86(t\xe0N\x86s\xe0\xa2\xeb\xa5\xc0-\xc5\x03\xe8\xc2\x15\xa2-\xe3\x02\x8e\xa5\xdc\xc3\x13/\xf3\x8c\xa9|\x94.\xdc`5\xa1g\x17\xf0\x14\xe9\xd60\xa5\xd8y\x12\x8f\xbfКk\x96a<\"/]\xc22\t\x9f\x94I!\x9e\x17\x97\xaf/\xc5䏇\xa5\x90\xfc\x8aGR\xdbc\xc0mm\x00\xfcm\xcc\xf6\xe3\xa0Ȧ\x82\x16\xd1\xf2\x1f\xd4\xea\x12\xdcCfs\x8a\xb0ǂ\x9d\xb8T\x0e\x11\x9d\xa0\x03\xbfb\xd6Li\x10f \xe7\a\x1b\xe9\x19\xa8\vF.\x9e<,\xa0gɩi\x95\xeb\xf8\xe7\xc1z.\xe4%\xad`q0\xb5\x84iG\x99\x1eR\xdf\\\x1cI\x7fs\x91\xf3\x13\xcf\x1bV\xda$\x19\x13\x04\x9e<\xfcvnc\xebZ \xfd\xb3\x99\xbb\xc83̟\xe8\xd2\xcb\tH\x81 \x15T\x94{z\xdet\xdaV\xc1\xe4\xf2\xf7Lc\x0e\xd2\xe9Je3\x826\xce\xf7\x8e\xdcE_\x8c\xc7(\x03\xea\xb8\xd4Y\xc9\xf6X\x82\xc6\x123#\xd5\x14Z\x96\x89\xbeF\x17N\xe0sD+^B<Z\xf2e\x81\xb3@\x81\xa2;\x1fjQ\x96\x8bx\xca\x06\x8b6\x1c\xb0\xba\x80\xd5u\xd9K\xbe\xac\xe6\x84(u\xb0B1ĩ\x88\xe7\x98\x0e<\xf5\x12D\xb7};\xa14\xe1\xb9e\x9174s1\xe4\xc9\x15x\xbe{\xd6\xf9\xb5\x19\x9a\x10L{8pwp\xe1ǆ\xa2^\xffv\x19&\xe5\x98.s\xf8\xa7 \xd4K\xe4\xe1n\xd8\xf7\x95\xe5\xe1\x15\xa8\xd4N\xe1OM$kl>{[\xb3\x82@\x9f\xba\xfd6\xc0\x0f-\x81\xf2\r\xe5c\r\xedm\x98b~\x8a\x1dӷH\xa9\xd7BK\x9cդ\xa7b&+n\xbfҖ\xa7\xbel\xfdFch\xd8\x1dx7\x92\xe8\x1b\xf9EȄ\xa9\xdf\x1b\xae\xb0\xa2\xa0\xda\x06ٽ7֥~\xff\xcbG\xcc\xe7\xb91\x9a#\x9f-\xe7\xfd`\xca\xdd\xe1}\x18\x10\xbf\x18\xefP\xb5\x11\x96\xddU\xd3\x1b`\xf0\x88g\xe7\x05\xd1\x1ee\x8d\x8a\xd1P\x93\x81\xc4\xf0QH\xdb2\x96\xf1\b\x92\x05\xe4w\x1c#\xfaǳ\x86\xdf:\xc4\xd1\xdc\xed\"*if>#\xe3pJ/h\x8d\xf6\xd5\n\x9e\xf0\x11\x83\x93\x10\xda\x00\x8c\xec\x13\xadn\xc2\x13(\xf1\xa2\xe5\xb6d\xbcl\x7f:B_ӞVi7\xe7t\xc1\xebd\x11\xac\x7fH\x01S\xfe\x9d\xe4(\xec'\x7f\xa1B\x83v\x9e.r\xb9\x13\x9bh\x98\xbfHs'6p\xfb\x95\xd3^*\xf1\xcdG\x89\xfa\x17i\xec\x9b\xef\x86X7\xfd\x17\xa1\xd5u\xb5\xa2'\x9c\x9a'|t\xb7\xa9\xa3\x98\xde\xfd\xbb;X\xdekI\xc55m\x1cK\x15\xf0B\x1f݀\xd1 ݔ\xaaF\x1b\n\x18\x85\x14[khӑ\xb1\xa2az\xf2HգNwz\x1e\x134l4T\n\xe8\xdc\xd4\x1eȗs\x10\\\x11E\xc92\xcc!o,RY4Dm\x143x\xe4\x19T\xa8\x8e\b5قXjD\xeb\xe7\x17\xf2\\\xack\x10~\xbc\xa2\x9f\xcc9w\x9f-\xc9uT\xbb@\xfe\x88Ƴ9ӗ\xaf\xcd\x1ah\xeb\xc7D`\x9b幭\vc\xe5\xfd*+\xb1\x8a:=\xf9\xeeL\xcf\n9T\xcc\xee\x89\xfe\x0f\x99H\xcb\xec\xff\v5\xe3*J\xca\xdfے\xae\x12{\xbd}֭;\x10\x8d\xc15\x10\xc5O\xac\x1c֜\x8c\xff\x90:\x16\x80\xa5\xf5Mh\x86C\xcfg\x13\xb6\x00\xf1\f\a\xaa\x18\x8b\x00\xca5\\=\xe2\xf9j\xf3L/]݉+\xe7\"\f\xa5>\x02l\xebqHQ\x9e\xe1\xca\xf6\xbe\xfa6w*\x9a;#\x1bR\xf4\xb7K\xa2ل\"\xd9\xe0MP\u05f6\x04\x8cB\xd24y\x05ެ\xa56+&t/\xb5\xb1鴾û.\xdf\xe6\xf9\xca\xe7ـ\x1d\f*[\xab\x17\x8a_HI\x0e\xd2\xc6DE\xbd\x14p0\xd5\xc9\xde9\xb0\x14r_]\xe4\xdb\xe5?\xae\xdc\xde\x14\xfd\x7f\t\xa2\xdd\xfd\xd5a#7C\xad\x97\xd8&J\xc3\xf7\x90\xfa\x1c{mR\x93\xb9`\xc9\xee\x1b/\x80\xbc\xc4[i\xf2z\xae0\xa1s\xb9\xd5`A\xb7_;yY&lF5\x82e\xd7\xcf\xce\xef5V\xac_\xe6\x17=\xd1\x0f\xaeo\x101\x0f\xca\xea\x1f\xa6\x8eM5\xbb\xc99\xcd\xd2?\x8e3Pqqg\xf9\x11\xde}\x17\xf7\x01\xc2F\x1a\xbe,|\xf8\x10z_Hо\x10\x11)\x86\xcb\x0f\x15U<\x15\xa8\xb0G\xc9\xe7Y\xfdX\xdaX\xb7\x99\x92\xaa\x9d\xd4\aA\xaee~\xad\xe1\xc0\x95nC\\\x8c\x0f縶Uii\xf2\x9d(.ŭR/\f\xe5~u}\xdb\x05S\xe2\xf3)\x94T\xce\xd4x\x8d=v{\f)s\xc4\r\xa0\xb0E\x04\x944\"e`\aq\xe4\x88gd\x88\xb5{q5\x03ß\xad\xe5D.\x16\xf2K\x97g\v?3^~/2\x1a^\xa1l\xcc.\xaa\xf1\x80\x8ct\f\x81j\x11\x83\xfe%\xa6\xad\xd8W^5\x15\xb0\x8a\b\x11\t\x15Ȳ\xd3L\xfa<\x00O\x8c\x1b\xbb\x01F\x90I\xab\x83\x91\xd1 3Y\xd5T\xe5\x04{<\xd0N]&\x85\xe69\xb6\xa6\xdf\xf3Š\xac}\xeeap`\xbcl\x14\xa6߇\x1a\xeb\"$\xafx\"\xdaF\xbb\x96\xf1S\xd8Z\x03\x94\xbcҸq\x96\xa0Vk\x1c\xda{\x85\xaf\xed>֊\x13/\xca%\x0fr\x01\xa2\xf5/\xfb\x1e\xa4gQ&\xceS.\xe4\x02L\xb2\xefo.\xe4\x9b\v\xf9\xe6B\xbe\xb9\x90o.\xe4\x9b\v\xf9\xe6B\xbe\xb9\x90o.\xe43\x17r\xea\xc4\xdd\x1c\x87\x86\x13n(\xa8b\x82r\x91tF\xdbl\xb9\xb0ys\xe2\xbb\xda\xfanKx\xa4\x04h\xb7\f\xf2\xf7\x86\xa3\xa6\xcaw\xb0\x87\xa0]\x89\x82?d\xf8T\xd0Y\xb4e\x93B\xfe[\xefd\x8dKB\x87u^\xdb\xd3HvPj\x15\xcc\xca\x02P\x97\xcf\f\x0e\xb4K\x92\xd3\x19\xd1v\x01A\x1e\xda\x1c\xed\xffCYEk\xcev\xc9*\x8d\xb3h\xc4\xc9h.\x82\x84\x8e\xf9&\xec\xea\xeb Lž\xc3\xdd!\x02d\xac\x01\x8f7\xcc+\xb4\xc7\xf2~A\xe4\x9eAP\xb3\x9e\x05\xd3\xe4uL\xdf\x16\x0e\xfa\xa0\x10\xffX\x12\tjZ\x9d\xf5\xef\xcb\xf6nk9\xfa\xa80\xa6\xf1\nTF\xfb5k=\x1a\xef\xa9,\u0085\x18_\xe6»\xafG\xa2h\xbf$\xd2#Y\x81\xf4\x9a\x99b%\xc6\xef\x99)\x02\xffV\x84(\xda`/\x02\x17wN\x03'\x91\x85H\xb6\x9b\xe7\xd2V\x00\xc0\xfd\xae\xd3\xd7\\m\xb4\xcf\xd5[\xf0\xb2\xb7\x052FQ\xcd\xf9YȲ\x16\x85\xde\xd8\xc9\xe4\x15]\xad5.T4B\xe3|\x96\xad\xd5r\xc97\xfb+ˣ-\x8c\x141J\x94͝w\x9afG\xf1U\xb9\xfe\x8a\x96\x90\x0e\x1a\xb5\xdac\x15\xb9\xc3~#G\xbe\xfb\xb7\xb5$s9\xa4\xae\xcd\r\xe5\xc26o\x1c\xb8\xc89U\x8bY\xbao<\x04\xcf\xc5\xe0\x14],6\xe2\xcfݍ\x8b\x92\x1fx\xfda;{(s\x14$\xd3p\xf5\xaf)׆ӥ\x02\x9dJ\x89\x8c\xa4\xf32/\xee/\x94P\xee\xe8=u\xa3\x16W\xe3\xc2y)\x93\xa6\xdd\xf2\x16\x8a\xdb\xf5\x0e\xe8K\x93Uɧ\x05!\x8f\xa4\xe9\xb8\x10\xf0gu\xfe\xbbd\xfdр>M۲\xfc8\x9a:\xf9Ӷ\x8e\xa0[gޯ\xf0\xff\xd1\x11\xb8ZAtJ\xf6\xfb\xe8\v2\xdfb/\x8c1\x02\x18\x86\x12\xd1G\xdfE}\xfc\xa0\xd8[\xac\xaa\x9f\xae\xa5w\x8a\x84.\xc79\xbdK\xfb_\x8c\xf4\x95\xf5\xd3\xc7\xff\xe98\x1e\xd0F\x848v\x8f\xdc\x05^4r\x14\xabt(N\xf0r\xbcZ\x96\x95\x97\xfe=tïv\xfe\xacL_\x82\xbe\xa5\x80qXD6\xdej\x80\xc9a\xa7\xb9\x9a\xfb`\xcdm\x05G\x9a\xccl\xfa\xac,\r\x9b\xe1\xb9o\xa8\xaa_*\x82_SK߭\x93\x9f\x01\x19[A\x1f\x17\xfb/V˿\xa0F>Ծ\xcf\u0085\xc5\xca\xf8\x05U\x10\x9e\x80\xc3\x15\xcbx\xa5\xda\xf7\x15\x15\xef\xfdJ\xf6\x05\xb8\xeb\xea\xdc#\xd1\x14S\xd3\xdeCRL%\xbb\xaf\x1aO\xe2\xce)\xccԯO֥'\xab+䗫\xd1\x17`\xf6\xa7\xf2*5\xe8/\xa8<_\xd0W\xabh?o\x16\xc3OL\x1c5WG\x1eQ=\x1e\x11i-ʹS\x17=5\xd1uU\xe1\x118\xec\xc9E|\x05x[\xdf=9\xf6ں\xef~U\xf7$ؘj\xef\x89Z\xeeI\x98\xb35ޱ\x15ܓ\xd0\x17\xcd\xf7\x02\xe7\xcc~\x96*G\xb5\xe04\xc7\xf3\xcc\x02\xbf\xf4x\xe5\xd7\xc1ȝ\xb8\xfc\xe2\xf1\xb9\xf9u\x9d\xf1q<\xc9\xf64g\x06t\x03\xa6C/\x9d\r\xe8\x98e\xfa`}\xf9\x8b\x8f@\x94\x1eWP\xc1\x05\x1b\x04\x01\x1ak\xa6\xec\x85V{\x8at\xab\x8a\xe9\x14n)\x11\xd5k8\n\xb2`\x9aR\x05\x153p\xd5\xc6S7\xa1\x1f\xbd\xb9J\x01~\x96mB\xa2\x85I\xf7\xc0\xf2\xaa.\xc7Ş\ue37b\xea\x83y\x89\x7f;\xcb'\n\xdb\x1d\xa3O\xe1\xe2\xd9\xdd\x12\x89\x7f\x1b\xe9\xd4qp\xbd`Pލf\xad\xa72\x82\xae\x0e賻\xf7\xb6\x05\xb4\xf1\xd70\x99\x82u#\xafk\xfd\xec\x86\xdcM2\x9bF\xf5\x9c\xc6\xe9.ޚ\xbb䂤+\xf5\xb8\xb9\xd6m\xb6pT\xfaf\fт(DRc\\\xd7\aZG\xde\xc6\x17D\xac{A\xf1\xe5\x06`R\x9b\xb4\xcb\x7f\xe0ǿ\xb1z\xae\xbcħa[\xd6\r\x9a\x8d\b\xe8\xaeقp'\xb3\xf5\xfdm\xa6@\x17\x8c\x126\xfbq\xd6u\xb8wwp\x9d\xaf\x95\xbfX\xd1\xed\n\xf6hJ\xbb\x96\x9d\xcb\x04'\xceW\x7fs\f\xc7jn\xb3c\xe3_\ax\r\xa9\xb4\xa0_l\x82\xa9\xad\x00\bD\x82=\x12\x82Z\x84O\xaaq\x9b\xb3\xea\xc2\x1c٤k\x7f\xb5Z\xaeu\xc4\xf8tY\xc0\xf3DZjU\f\x15\x00\x06\x01\xe2*\xdf\xd6L\x99\xb3\xe5:\xbdig1\t\xd5\xfay\xd6vM.gA\x00\x9e\xdfc<\x89\xe7p\xa51-\x85\xa0\xf6\xd4\xf2\x10\xbb/\x9d\xcdܦ\xe4\xe2V\xe4+\xcf&\xa0vl>[\x8b\xb7dE\"\x7fV\xafk\xc1j]\xc8p\xe1\xe8.YX\xfd\xe7~\xfb\x91dz\xb8\x155+e\x93\xb7\xf0'\xcd6\xf1\xe1\xfd\x97k\x9f۵H\v\xee\x9e\x0f\x1fC*'\xa4q\xc2矾Wr]\xf7-\xcd2N\xfa\xed}\x16ĲZWEv\x18f\x04\"\x15\xec\x8c\x1a\xba\xce\xf6\xbf\xb7T\x97\x1d\b\x9a\xe9\xb8e\x9a\xe50c\xca\xc5E=<|r\v1\xbc\xc2\xf4c\xa3\xecdHMh$܆\x05\xbaN\xfb\xb1a\xe8\xa1\xd3\x16\xa5\xf4\xab\xffi8\x7f\x85\x84\x1c\xb7\x83\xb2z\x15\xce\xe4\x04\x86\f\xe8Zf\xe1/\xe3\xfdf\x1c\x93\x11\x88\x96w\xa7 1\xade\xc6Y\xb8\xf6ԝ\xf2\xf0)\xccW\xf5\"\xa6\x9d\x84\t\xa1\x1f\xd3,\xdbv\xff8\x99\xed?x\xe5o\xf2\xdf\xc1\xe9\xdd\xe57\x8b\xfd\xad\xff\x1b\x11\xf6\x03\xd8k71\xefȢ\x97/\xffF\x1bf\x1aۏe\x19\xd6\xc6\xefgt\xffN\xc4\xd5U\xef\xcf?\xd8_3)\\T\xa2w\xf0\xf7\x7f\xd0_r\xb0\xb2\xe0\xff\xe6\x80\xde\xc1\xdf\xff\x91\xfc\xdf\x00-ax\\_c\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec\x1b]s۸\xf1\x9d\xbfb\xe7z3\xb6\x1b\x93v\x9a\xb6\xd3\xea%s\xe7$\xbdL\xec$c;\xe9\x83ϝ\x81ȕ\x84\x9a\x04X\x00\xb4\xa2L~|gA\x82\x9f E\xa7\xf1\xdd\xcd\\-=X\xc4b\xb1\xdf_\x90\x820\f\x03\x96\xf3\x8f\xa84\x97b\x01,\xe7\xf8ɠ\xa0O:\xba\xfb\x9b\x8e\xb8<\xb9\x7f\xbaDÞ\x06w\\$\v8+\xb4\x91\xd9%jY\xa8\x18_\xe0\x8a\vn\xb8\x14A\x86\x86%̰E\x00\xc0\x84\x90\x86\xd1cM\x1f\x01b)\x8c\x92i\x8a*\\\xa3\x88\xee\x8a%.\v\x9e&\xa8\xec\t\xee\xfc\xfb\xd3\xe8Yt\x1a\x00\xc4\n\xed\xf6k\x9e\xa16,\xcb\x17 \x8a4\r\x00\x04\xcbp\x01K\x16\xdf\x15\xb9\xc2\\jn\xa4⨣{LQɈ\xcb@\xe7\x18ӱk%\x8b|\x01\xcdB\xb9\xbb\"\xa9d\xe7G\x8b\xe8\xd2!\xda٥\x94k\xf3ƻ|ε\xb1 yZ(\x96\xfa\b\xb1˚\x8bu\x9125\x00\xd8\x05\x00\xb9B\x8d\xea\x1e?\x88;!\xb7\xe2\x15\xc74\xd1\vX\xb1Tc\x00\xa0c\x99\xe3\x02\u07b2\fu\xcebL\x02\x80{\x96\xf2\xc4J\xa4$^\xe6(~x\xff\xfa㳫x\x83\x99\x959=NPǊ\xe7\x16n@;p\r\f\x1aJ\xc0l\x98\x01\xb3A\xc8e\x02\xf72-2\xac\xc8\xd5 W\x15J\x00VJ\x8dH\x01\xa6\x10\xb4\x91\n\x13\xe0\xe2\x18\xb8\x00Vm\xb1\x8f\xd9\x1a!\x95\xb1%\xf4\x18\x96;`\x02\x8a<\x95\x8c\x14]!̕\xccQ\x19\xee\xf4@\xaf\x96\r\xd6\xcfz\xdc\x1c\x10\xbb%\f$du\xa8-\xed\xf7\xe53L@[Q\x80\\\x81\xd9pM\x8c\x92\x9cEi\x87-\xb4@ L\x80\\\xfe\x1bc\x13\xc1\x15\xe9Bi\xd0\x1bY\xa4\t\x99\xea=*\x03\nc\xb9\x16\xfcs\x8dY\x83\x91\xf6Ȕ\x19Ԧ\x83\x91\v\x83J\xb0\x94\x14U\xe010\x91@\xc6v\xa0\x90\u0380B\xb4\xb0Y\x10\x1d\xc1\x85T\b\\\xac\xe4\x026\xc6\xe4zqr\xb2\xe6\xc6y],\xb3\xac\x10\xdc\xecN\xac\xef\xf0ea\xa4\xd2'\t\xdecz\xa2\xf9:d*\xdep\x83\xb1)\x14\x9e\xb0\x9c\x87\x96pA\xcc\xea(K\xfe\xa0*\x17\xd5\a-J͎LK\x1b\xc5ź~l=aT\xee\xe4\b\xa5\xe9\x94\xdbJ\x16\x1b\xf1r\xb1\xb6R\xb9|yu\r\xeeP\xab\x82\x16J\xa8\xa4\xddlӍ\xe0IP\\\xacP\xd9]\xb0R2\xb3\x18Q$\xb9䢴\xd18\xe5(\xbaB\xd7\xc52\xe3\x864\xfd\x9f\x02\xb5!\xfdDpfc\x0f,\x11\x8a<a\x06\x93\b^\v8c\x19\xa6gL㣋\x9d$\xacC\x12\xe9~\xc1\xb7C\xa6\xfb\xa3\xfd\x8bJZ\xf5c\x17Ѽ\x1a\xea\xfb\xf9U\x8e1)\x8c\xa4F\x1b\xf9\x8a\x97\x1e\t+\xa9\x80\r\xe2\x82s\xcc1\xe7\xa4W\xe9\xe3W\xa5\x8b\x9fW\x1e\xde\x05\xf1R\xd5\xdb\xe1Ȣ\x80B^H\xff{\x01{\x98\xa1\x8cU\x8d\x87\x1a\xc6E\xed\xe6\x1e>FEN\xef\x98\xc5\x1b\xbc\xe2\x9f\xf1\x9cg\xdc\xf4\xb9`b\xf7n\xd5\x7f\x18V\xd8\xc8\xcfרFV=g\xf5\xa4r\xd69ډ#c\x9fxVd\xa0\xf9\xe7Z,\r_\a\xba\x87\x11l\x8cMK>@\n@\x16o@\xc8\x04#x\xbd\x022\x7f\x8d\xe6\xb8B\xa3\r\x8f\xc1\xe6\x1bu\xa0\xab=t\xd0\x10\xa9#\xa9И\xf4\x85Iٗ-S\\\x80QE\x7fo\xce\f\x85\xbf\x05\xfc\xeb\xf0\xe7'_£燇7\xa7\xe1\xdfo\x9f\x1c\xfe\x1c\xd9\x7f\xfex\xf4\xfc\xe8\x8b\xfb\xf0\xe4\xe8\xe8\xf0\xf0\xe6\xcd\xc5?\xae߿\xbc\xe5G_nD\x91ݕ\x9f\xbe\x1c\xde\xe0\xcbۙH\x8e\x8e\x9e\x7f\xdf#\xe4SH\x95\x85\x12hP\x87\\\x98P\xaa\xb0T\x8a\x87\xeex\x83\xf1\xdd+\x1b<D\xbc[L\xaa\xad\x03J2\xda\xc8-ȕA1P\x16\x90GW\xa6\xda\xc3\t\x14\x96챘XgD\xa5\xa4\xd2Vk\x9fQ\xc9c\xe0\xe6@\x83\x14\xe9\xae\x06\xdbnP\xb8\b\x87\xc9l\x1bO\xe4VP\u07bdd\xe6W0\xf3\x17\xfd\xd3\xfb\x96\xae\x98A[>,w\x065\xe4\xa8@c,Er\xec\xf7|\xbf\x90\xb9\xae\xf9\xc4\x04\x98\xa1\x8a\xc3\xfa\u00a0\xa0\x19\xa2UH\x05\v\x82T\x901rk\xc1D\x8c@\xe1\xcfF \xab\x94\x81\a\x91w2\xebj\xb0aC\xbfd\x90\xca-\xaaҕ\xc8\x01\x99\xf9ݹUK\x9a\xf3\x9c\xeb³\xa1\xebbm\x05U9`\xd9\x17\x16\x80*\xc4l\xf7ha\xbc\xa4#\xb5\x99Kb\x05\xde\x14\x1dm\xe2\x8c$\x0fW\x85\x00!\xb7>\x9b[3\x95\xa4\xa8\xb5\x8b\xf2\xed\xcd[.\x12\xb9\xb5\xa5\xa3\\\x95~\xdfYf\x1aR\xa6\xcd\x1c\xbe\xa7\xcdj$\xc7ӛ\x12\xf3\xf0iO\x1aԙ\x00O\xa8\xe8Y\xf1\xaa\f\xaf\xc4\x11\xc1\x0fN2\xa4B\x92\x84\x141\x0eEA/-\xa9\xb7\xc0m\xbdC &\xba\xea7@\x9a\x8d\xad\b\x99\xa8\x8anm@\n<\xd0Ǡ\x8bx\xe3\xc5\xc8Jb\xe2B)\xa4\xba\x91g\xd8\x17ͤYT\xad\x95j\xb7\xae\x13\x82xW\x83\xdaƨ\xaf\xd0\x06\x13u\x0ed\x9e\xf0z\x15\xf4\x10\xda7f\xb9\xd9\x1d\xf7\xa2\x1c\t0W\x85\xa0\xd0&\x12\x97\x10|\xfcp\x83\x99\x97\xda=\x95bˬkV\xe8T&\x1aڽX\xcbz\xec\xa0T\xb0\x91e\x04\xa5\x92l\xba\xbal\xfeP\x14\x99\x9f\xe0\x10\xde\x13\xcf#kg$\x84\x91\xb5K\x1a9\xe0\x1b\xdcy\xd7'u\xbe\xc7c\x9a\xfdL)\xd6\xc7O\xd6\xcb\x15vZ(z\x87\xb6o\xee=\xf4\x96\xf7\xbd\x88\xf4O\x1b\b\x16\xc1\x84&[\x9a+\xa1]\x825\x9c\\g\x05\t\xab\xfa{ꊓ\"Ť}B\x0f5\xd0nm\x982\xb6\xaf\xefV\x91^\x04\xed\r\x14\xa9\xf0~P-\x90YR\xa2.\xf0\x9bE\xa7\xa4P\xde\xc6c \x9e\x17\x15\xa0K#\xa9\xac\x9a\xd4*\xc6rM\x06.\xa8\x06\x8b\x82\aڊe\x9b\xe6R{\xa9\xb8r\x90\xa3\xcai\x91d\xd1\x0e+\nz1cK\xa5\x0f\xd7g6\x10p\x01?\xfd\xb4\xb8\xb8 \xea3f|\f\xb4*\x87\x9bӧ\xb76\xcf\x7f\xf9\xd3\xcdi\xf8\xec\xf6hqs\x1a\xfe\xa5|\xf4\xfd\xc3x\x1f7t\xa7\x98\xc1B-\xac\xd9n\xc0\xd7%\xaaYi\xb9\a\xec\x12\x89KI.\x06Uy9\x969\xc7\x04\x8c\xec\xe1\xa4b\xb8\xcc6\xa3\xa3\xac\xed\x86ǛҠ\xeb\xf1W=\xa8\xa0\x1c\xf7\xcdl|V\xa7\xfd\xd8\xdd\xf6H\xddM\b3+s+\xc6\xe8a\xe6\xf3;\xaf.\xa8\xba\x18\xf7 \xaf\xda\xff\xa7\x84B\xe6\xffډQ-\x82\t\x91_v@\x9d٬\x8a4\xad\xd8\xf0\x19\x83g,\xe1F\xbd]\xf5u\x864\xb0\xe5f\x13\x053%V\xe4\x9d.\xf2\x17\xeda?t\xcf~\xb4\x0e\xb6\xc8\xff߿\xfe\x96\xfaWg\xc4\xd7d'\xfb\r\xa4\x04t\xd6\xe16\xc3v#u[\xe1尒\xeb\xa1\"\xcb\xd4B\xfe\xaf\xa1\xc8[\xaa\xa7y6pcs\xbe*\x84\x06\xde\xed6m\x99Vv\x0e\x03\xa46S1\xa7\xfb\x86\x8c(\xd8_\x87\x87ն\xc1\xe3;\x99s6\xd7uK\xf3\xad/\xb1&%\xf9\xb1\v\xeb\x84\xd9\\;\xf5\x83H5\x01\x1e\x1a\xf0\xc0q\xb4\xaf\xc6\x1b!\xda\x17\x9d\xf7G\xe6\x102\xcf\x00\xa3\x03\xd0\rŝ\xa5\x9e\x98\x82=\x81]\x1bf\x8aN\xd50\xd9\xe0]Yp\xe0\xdd\xc4U\"\xa1\x8a\xe0\xeb.\x03\xa8\xeeD\xe5\xad$\xf4\xa4\x9e_Ml\xac;\xe8\x91\x1aL\xcf\t\xab\xb0e\xad\n\x85\xea\xb3\b\xaei\xde-X\xae7\xd2ع\x8b3\f>\xac{\xcc\x06u\xebHK\xd3\xfe\x8b\x86\x91\xf6{\xd45\xf6\x84ͱN\x93j\x14\xdb\xff\xfa\x9a\x8f\x8e\x9c\xcfېN\xfb\xb4\xddNDFR\xd1֓\x0fFf\x0ed\x00\xcc,(\x85ah\x86\xd5\xfd\f\xf6<b!\x02[}\xed\x9c\x06\xe0ܻ\xc5W\xf7\x12\xf2\xb6\xa3\xf6\xb0B]%Z\xab\"3z\xc88\xb1G\xfa,\x05\xf5\xe0\x87jjQ;F\xd0#*\xe2!}عgø\x12\x1c\xe0\xb7W\x01]\xac͒\xbd\x03\x9c\xe1\x1btU\xe6\xf5\x8d\f\x99.\x14&\xc7U\x06\xa7\xb9\xd9ʠ*\xbbD\xfb\xad\x18\x96\xda\v\x7fJ\xe0\xe5RK\xa5\xbf\x8c*[\a^\xa2.R3\x1d\xa0/\x06\xe0uXV\xd5\xe7\xb6*i\xfa'W\xb6d\xeda\x85\x91\xa2t^\xe4\x9c\xcci\x03\x1a\x9d\x12K\n\xc9\xd6T!D_\x12\xae\xc0\xf5\xd2\x05r\xde\xe8r\xaaq\xa7/9ey\x8aݯ-y\xc0z\xfc\x9d\rw\x11G4U\xb3\x92n\x88\xac\xf0\x0f\xe3\xf1<\v\x9aaG{\xac\xa9\xd2,j\xcd\xd68\x83\xb5\x8b\x12\xd2)\xc8\xdev6i\xbbal\xc58\xcd\x17\xa95\x1c\x96\xb2\x95\xa5\xd0Wvv\xd1\xd7\xd0[\x9f3\x83\xe2\xce\x18|t\x9e?\x19\xa1~\xa3\x03\xeez\x127\xd7.\xeb\xe9\xe5\x94InY=\a\xfeu\x8dR\x17q\x8c\x98`2\x873\a[1U\x8d\x82\xda|\xd5\xe8\xfc\\\x95\xb2^J\x99\"\xf3\xe71ߔ\x87tX\x1f\xe1Y\xab\x0f\x1d\xac\x8d\x0ey\xbe\xb2\x94\x1cq\xe11\xe7e\xce\xe7\x81-eaF\x9a\x89f\xbc8\x1eBG\xb5XW\x05e\x9a\x9a\xa6\xac\v;\x8c\xff\xc3Z\xc3\xd5\x16\xd1C\xa47\x15\xedg\xc6\xfa\aE\xfa\x86\xda\xc9H?ǥ&\xf9\x9aTĞ\b?f\"\x9e\xf8ް\xb37\xbe\x8fG\xf7I:K\x87\xd0gv\xae\xbf\x97\xdawmhG\xb3(\xb2%*g4\x9dʯ\xc2\xeeA[\xf5\x9e[T\xadK\x05\x8b\xc00\xb5F3\xd6Î3\xe8\x1fV\xd2\x159}\x9b\xd2\xdb1\xef\xe5\xf7j|\xaf\xe3~\x84\xceq\x96\xf7\xb6\xdb\x0fU\xe1\xfe\xb447)5\xe6\xb6')=\xbe\xffԁ|??\x0e\xb2\x9f\x8a\x1anjdQ\xf0\xd0DTZ\xe3\xd7Y\xcf\xf5\xf8\xdeG\xb1\x9e\a_'\x8de\xd9p\xcai\x86\xb0N\xba\x83\x95\t\xe1\x053\xb3s\xbeaz:ɾ'\b'\xcfvJŹ\x19\xd5?\xc1}\x8b\xdb\xc1\xb3KdI\xbft\f\xe1\xad4\xfe\x85*\xcb\x0e\xe4>\xaa\x12\xea\x94\x7f\xa4ːI\x8e\xaf\x1c\x94\xe3z\xecK\xaf\xd5\x05\x89\xb0c\xef\xd2\xd4zxa`z\xc7tG'W\x9d\x1e?\n\xfc\xeeυ\xf9럃y\xc1أ\xe1ޣ\xeag\b\v\xb8\x7f\xda|\xb2\xcdvX\xfdd\xc5.@y\x0f\x93\xb4\xe2J\xc5C\xf5\xa4\x19\xf0\xb28\xc6\xdc`\xf2\xb6\xff\x93\x95\xef\xbe\xeb\xfc\x02\xc5~\x8c\xa5H\xec\xcfp\xf4\x02nn\xe9G$t9\x94T?\x98\xd0\v\xb8\xb9\r\xfe;\x00\xbcf\xb2\xa4\xee3\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}[s#\xb7\x95\xf0;\x7f\xc5)}_\x95f\x12\x92\x13ovS\xbb|Iɒ\x9cUy\xecQY\xf2\xe4\xc1\xf1V\x81݇$\xa2n\xa0\r\xa0\xa5a\xd6\xfb߷\x0e.}E_\xa8\x91\xedI\xed\f\xfd`u\x03\a\xc0\xb9\xe1\xe0\\Ћ\xd5j\xb5`\x05\x7f\x8fJs)6\xc0\n\x8e\x1f\f\n\xfaK\xaf\x1f\xfe]\xaf\xb9|\xf3\xf8\xc5\x16\r\xfbb\xf1\xc0E\xba\x81\xcbR\x1b\x99\x7f\x87Z\x96*\xc1+\xdcq\xc1\r\x97b\x91\xa3a)3l\xb3\x00`BH\xc3豦?\x01\x12)\x8c\x92Y\x86j\xb5G\xb1~(\xb7\xb8-y\x96\xa2\xb2#\x84\xf1\x1f\xff\xb0\xfe\xe3\xfa\x0f\v\x80D\xa1\xed~\xcfsԆ\xe5\xc5\x06D\x99e\v\x00\xc1r\xdc\xc0\x96%\x0fe\xa1\u05cf\x98\xa1\x92k.\x17\xba\xc0\x84\xc6\xda+Y\x16\x1b\xa8_\xb8.~\x1en\r_\xda\xde\xf6AƵ\xf9\xba\xf1\xf0-\xd7ƾ(\xb2R\xb1\xac\x1a\xc9>\xd3\\\xecˌ\xa9\xf0t\x01P(Ԩ\x1e\xf1{\xf1 \xe4\x93\xf8\x8ac\x96\xea\r\xecX\xa6q\x01\xa0\x13Y\xe0\x06\xbee9\xea\x82%\x98.\x00\x1eY\xc6S\xbb:7'Y\xa0\xb8\xb8\xbdy\xffǻ䀹\xc5\x1f=NQ'\x8a\x17\xb6\x9d\x9f\x1cp\r\f\xdeۥ\x81\xf2$\x00s`\x86\xfe\xb2S\x11F\x839 $\xac0\xa5B\x90;\xf8\xbaܢ\x12hP{\xc8\x00IVj\x83\n\xb4a\x06\x81\x19`PH.\fp\x01\x86\xe7\b\xaf.no@n\xff\x8e\x89\xd1\xc0D\nLk\x99pf0\x85G\x99\x959\xba\xbe\xaf\xd7\x1ef\xa1d\x81\xca\xf0\x80h\xfa58\xabz\xd6Y\xd79-ܵ\x81\x94x\t\xdd\xf4\x1f\xdd3LA[\xa4\xd0:́kP\xe8\x97i\x11\xd8\x00\vԄ\t?\xe95\xdc\x11U\x94\x06}\x90e\x96\x12\x03>\xa2\"<%r/\xf8?*\xc8\x1a\x8c\xb4Cf̠6-\x88\\\x18T\x82eD\xb2\x12\x97\x16\x119;\x82BB\f\x94\xa2\x01\xcd6\xd1k\xf8F*\x04.vr\x03\ac\n\xbdy\xf3f\xcfM\x90\xa5D\xe6y)\xb89\xbe\xb1\x12\xc1\xb7\xa5\x91J\xbfI\xf1\x11\xb37\x9a\xefWL%\an0!\xe2\xbda\x05_ى\vZ\xac^\xe7\xe9\xff\vT\xd7獙\x9a#1\x996\x8a\x8b}\xf5ز\xfa މ\xe7\x1d;\xb9nn\x895z\xb9\xd8[\xac|w}w\xdfd5^3\x11\xfd\x1c\xb6\xebn\xbaF<!\x8a\x8b\x1d*\xdb\vvJ\xe6\x16\"\x8a\xd4\xf1\x1a\xfd\x91d\x1cE\x1b\xe9\xba\xdc\xe6\xdc\x10\xa5\x7f*Q\x13;\xcb5\\Z\x8d\x02[\x84\xb2H\x89\v\xd7p#\xe0\x92\xe5\x98]2\x8d\xbf8\xda\t\xc3zE(\x9dF|S\x11\x86\x7f\xd4\x7f\xe3\xb1U=\x0e*+J!'\xf1w\x05&-\xc1\xa0>|\xc7\x13\xcb\xfe\xb0\x93\xaaV\bN'\x05\x81\x1c\x12J\xfa\xa5\xb8cef\xde[A\xd6\xf7\xf2+\xedFk\xb7\xeaL\xe8j\xa0S\x98\x12jx:\xa09\xa0\x82BVZb\xc73\xec@\x05\xd0Gm0\xf7\x13^\xc2\x137\a\xbb8\xf7\xe0\\CYd\x92\xa5\xa8\x96A|\x89\xf0\x1aS+\xab\xec\xa1\x0f\x91y`V\tdYc\x06\x1a\xb6ǰ\xe05\xdc\xcaT\x83,L\xf5R\x96Ǝ߃Xϧ\xded\u07b8\a+\xdfy\x85\x1f\x92\xacLQ7\xf6\xbb&\xfa\xe9G\xbb\x16\xdbf\xb8\x01\xa3\xca\xee\xbc\x1dWl\xa5̐\x89Q\n}\x87\xda\xf0\xe4$\xfa\xb8.\x11\xea(\xff\xc2b\xb6\x03\x11\xba\x98\x9e\x8d\xd9+R\x01\t\x89\xe6\xb2\a\xb3\xd48\xcc>\\h\x83,]\xc2Ӂ'\a;\xa8\xa6m5\xc1\x14E\x824\xed6n跕\xe6\x00L!h4\xeb\xd9X\xc5\xe2\x809*\x96\xb9Y\xdcʌ'\xc7Q\xa4^\xc7z@r\x90R70J\xbcB\x12O\xdcWȶ\x8a\xe8\x8c\x1cp\x17\x96\x9bJqn@\x96&㏤_\x91[\xf1\xd1KR\xce\xc4n\x98BY\xc4Y\xd4\x11r\r\xd7]\xe0\xf0t\x90\x1a\x81\xd4\x15p\x03\xa9DM\xa3\xb8i;\xa5\xa1\xd0C\xefA-\v\xc8\xf8\x03\x82숲>\x89\xb5\x87T\x0f\xfd\x12\xcd\xfb\x0f;\x88\xbf\xbc\xbb!\f\x10fYR\xe9:.2.о\xec\xe0\x91\xa9\xee\f\xaay<roJ\u0605\xdb\x15m\x8f\x1eթ⏨\xecƞb\x86d\xde\x10\xaa\x81\xf7\xb8\x8a\xfeCQ汙\xaf\xe0FXM\x10}w\xfda\xf8\x9d\xefw\xb3\xbb\xa0\xfd\x8d\xa4'\xd2,\xba˄\x1f\xe6\x859^q5\x89\xd1k\xdf0\x82\xd6\x00c\x88ҟ\xea\xda\v%\xc9\xfc\xc0\x96\x9d\x13]\xfcmh\x19Y}\x05\xa5\xe6)]&}q\xa3\x1f\xf3橗3\xb9\x03\xb2\xfeyB\x00\x13Y\n\x03F>\xa0\xd0k\xb8\xb7\xfcE憵\xc9\x13\x99ǰ\x00\xce.\x92A1[3\xab\xc9\xd4\r\x1d`\x0e\x98k\xcc\x1e\xffY\xe8\x13\xb5y\xe8?\xbfm\xa6\x17\xb77\x7f\xa1\xd3ZOE\xb4\xf9\xb6\xdbڛ\xad\x19a]\xee\x80N*\xf6\xd0\xe7\xcey\xee8\x14S\ad@r'o\xa9=\xe8\xd4;<\\\x93U\x88\xceh%\x9a1.`\x9f\xc9-<\xf1,M\x98J{8\xe7\x06\xf3\x88n\x1b\xc1\xc7\fS\x80)ŎQ\\U'\xc8yȪ\x9b\x87\xe5\x10\xce\xe8\xb0K{\x94\xa8\xdf>\a[\xbf-&\x82\xeba\x1e\"\xaa\xd6\x1d\xae\xa9\x8e4\xcfg\x9a\xdf\x06\r\a)\x1fƗ\xfe\x9fԢ>\x92Ab=6\xb0\xc5\x03{\xe4R\xf9\xc5ֆ5~\xc0\xa4\x8cI73\x90\xf2\xdd\x0e\x15\n\x03Ł\x91\xc9#w#(\x18\xdb\xf4+\xe5\xd6\x7fՙ\x7fM22\xf0\xecz\x87\xa6L\x16\x98\xb0\x93\xe9c\xb7\xb2f\xb8H\xf9#OK\x96Y[\x93\t\x02M\xd6l5\xa7\xee:F\xc8ٛ\xad;9\x859\x13\xee[g6)\x10\xa4\x82\x9c\xbc\x02\xfd\xa6z\x11\x01\x0f0\xb8\xdc-\xa3C\x90t\xbaK\x95\x19j?Pjw\xb2Z\xae\xfb6x\x87\nΙ\x91\xb1-f\xa01\xc3\xc4H\x15C\xc38Q\xe7\xea\xa8\x01\xdcE\xb4U}\\\xa1%6\x15\x95\x1c\x84\t~\xbb\xb4~\x06\xe2\x17{\xe8\xb1f\xaf\x95_V\x14\xd91\xbe\xb8\tJO\x8a\xf0La\x9e\x16\xeb>6\x03\x9f\x9c\x8a̪_\xe3\xe8G\xb8\xacH\xff\x7f\a\x95\\t\xf9k&.oz\x1d_\x921\t\x89\x1c\xf5\x1anv\xce\xf4^\xd2)\xcd?\xa5\x837\xb3\x9e\xee\xa1_=\xf6?\x1d!N\xe5\xe9\x9bn\xbf\x17\xe4鏤B5\xf4?\r\x11\xac\xb2\xbf\xf3\xba~&\x01\xde6\xfb,\x81\xef*\x02\xa4K\xd8\xf1̠\xeaPb\x10.\x10g\x8fR\xe2cQ0\xbdS\xd1/g&9\\\x7f\xa00\x82\xae\x03T\xb3\xb0\xd1\xed\n\xbciU\xb77\xd3Q\xa8\xb4\x11\xffTr\x859\x1d\x0e\xeda\xb1\xf5\x84LQ\xb8\xf8\xf6\n\xd3a\xee\x9a\xc5a\xbd%\\t\xa6\xd9\x1c֛\xc8\xf3\x16\xe0\x8d\x94\xeata\xe3\az\t\f\x1e\xf0\xe8\xac\v\x8a\xc6\x14\xa8\x18\rC\x8d'!*\xb4A\x18+\xda\x0fx\xb4@|\\e\xa2\xef<\xd2\xfb\xc0\b\xf6|~\x93h\xa3\xd9xρ\xc3\x1f=\xa05\xd9G3i\xee\xad\xeaJÌ\xd3\xf6\x04\x15\x11~\x01\xdb'/\xaf\"S\x1d\xc8q\x84<\xa78Lf\x83\r\xfa\xc0\x8b\x19p\xad\x98\x13\x17Y\x99\bQ\xb1\xf7\x14\xf3\xac\xe6\xe7,\xfb\x1b\xb1\x84o\xa5\xb9\x11\xcb\xc5\f\xa8p\xfd\x81k\x1f\x8c\xbc\x92\xa8\xbf\x95\xc6>yq$\xba)\x9f\x8cB\xd7͊\x90pj\x98\xd6\xdf\f\xaeM2\xb1\xfb\xeffgy\xaa\"\t\xd7\x14\xea\x92\xca\xe3ʾ\xf4\x83\x8di\xfb\xf6\xbf\xbcԆN\x12B\x8a\x95\xdd\xecֱq<\x8ag2r\x93\n\xfdiUC\xba\xe1fA\xbc';\xc9\xf5v\xa1ތB搖\x16\x896T\xc9\f\xeey\x029\xaa=.&\xc0\xd9\xff\n\xd2\xd9s\x86\x9f\xa5K\x9f\xc1Os\xb6\xe6\xf0\xcf+\xe3\xa8?\xb3\xf9[\x91lN\xb6\t\xa4\x9dh8\xe8\xa7{\xde:\xec&i\xed\x86\tl\xb24\xb5\x99#,\xbb\x9d\xad\xbdgc\xbe%\x9b\x8d)Y\x01\x85\x9c\xd9x\xd6\x7f\xd3Vee\xe9\x7f\xa0`\\MJ\xe8\x85M\x01ɰ\xd5\xd3{\x85\x9a\x83\x10|\xae\x81\xa8\xf9Ȳn\x84\xbb\xff\x8fT\xa6\x00̬=@3\xebZ\x1a\xcb\x10\xd2\xc1#\xec(\xc7\x04:\x81\xf8\xfe\xef\xec\x01\x8fg˞\x8c\x9f݈3\xb7=\xf7$6\xec\xe5\x13\x80\xa5Ȏpf{\x9e=\xdft\x99\xc5u3\x1a\xd1ih\xb3\x98\xc5\x06t\x9a\v\xbb8u\xab\x92J\xe8h\xb6^|\x04\xcf\x15R\x9b\x99\x93\xb8\x95\xdaX\xd7O\xdbx\x8c\xf8\x86\xc6\xcf4\xde'\x04l\xe7\x12y\xa4\n)\x1b\xa4\xc8:\xaeJ\xa2\x92ƨ\x83\xb3\a1\xf5 Y\x96\xc1Y-\xa3\xeel\x7f\xe6\xe2\x15\xf4\xff>\x842\xc6-\xb4\xcb\x17J&\xa8\xf5\x18;Lj\xde\x16\x02\xfb\x98\xaa\x9cm\xcc\x1d*\xc8\x156\xee\xdc;\xd5l$Ԍ\xb7\xe8L\xf2\xfaC\xc3\aȄ\x050\xc1f\xa7͈~\x94\xd5\xc2\xdaI>\xb3&w\xe9\xfa\x05Q\xf0`\xacN`j_\x92\x0e\x9a\xd2\x01^2d`\x9a\xdfv\x83\u0379\xb8\xb1<\x04_\xbc\xe8v\f!x\x82\xa7\x9bԗ\xa1g\x8d\xe6ꁓ\xcdB\xa6\x8b\t\x88\xde{\x81\n[\x94\xea{\x86\xad9G\x0e\xba\xfax>\v\xb6\x9fǹ\x86\x1dW\xba:ιY\x97\xa3R\xfbLjIq\xad\xd43\x8e(\xef\\\xbfj\x81\xe4P{\n\xa9O\x03\xb9,\xb1\x9f\r\x83 y2\xb8\x01\x146hKN\f\x12R;\x80C\xa9S\xa6\x93\x9bl\x1d\x93\x99\x83\xa8\xa1\x18m\xf7\xdf\xcar\x0f\x17#\xbe\x8e\xfa\xb7\x82\xaf\x18\xcf\x16\x93\xedN#\x13e\x81\xca\xd2l&\x1bv\xc8D\t\xbb\x94S\x15t\x1f1X\xce>\xf0\xbć\xe5\x84\xec\x19\x10\x81vD\x9aA\x9b\xbe\xf0ĸ\xb1\x81\x0e\x82JH\xa7\xb3f\"\xf3\x8227f\xc1\xdd\xe2\x8e\"1\x89\x14\x9a\xa7Xm\x99\x9e\xe6R\x00\x83\x1d\xe3Y\xa9p\xfd\xb2\x18\x9do\xd9{!\x9fh7\xcb|\x9a7\xec\xca*\xf1\xc5G\x8e5\xadU\v5\xd7P\xbbU\xf8\x92&R\xa18\xf1\x8c|Y+ɳ\x12\x13\xc7\xcff\xd2g3鳙\xf4\xd9L\xfal&}6\x93>\x9bI\x9fͤ\x8f3\x93\f\xe6\x05\x85\xc16\x8by\x9c\xe4\x9b\x03\n\x8a\x12\xd3\xeeN\x95ufŅ\xf5i\x92\xe5TX;%\xb5n\xaaA\xa8>\xb5̅\xf5~*9j\xcaj\x05[\xd6\xe6B\xb4\xbe\xe8\xe4\xe9\xc03l\xd8Pc\xc2_e\xaf:GaX۹\xcd\xf0\xb7\x03\xd2\xf6\xda\xf1<\x05\xf3oL7\x93~\xa7*!Z\x92\x83\xe3y\xb6\xf2\xaf\xfdJ\xe1\xe4j+\xd8,fK\xff\xacMϝ\xfbG\x81B%\xe1\xfa<\b\x84~\x91m\xef#7\xbc\x99\x12?\ueedd\xe9\xbf\r*γ\xd6z\xf1q[\xcb\nvz\xa7\x10\xff1\x8e\xfa\x15\xe4G\xfd\xd3\xf8~\xb2\xb2\x02\xb7W8\xd5p&\xbaf\xd9\x04\xa7Z\x03~\xa7\x1f\x85\t3\xed\x00ϋ\x1fO\x82Y\xfb\xfa\x8c\x1d}&b\vf\x0e'`\xf5\x96Q\x85\x88ߴ\t!\x16@\xe0F\xaax\xf3\x15n\x8b\x19\t\x14\xb6\x8b縊\x89\xc1\xfd\xad\xd7/\xb1\xbaY6Jk\x81\xd3N\x9c`y\x8c\u0084!\xbb\x04YR\xa1\xcbo:\xb3\r\x94\x972Mf!o\xda.XYM\xb4x\xb6M0>\xc2\b\xf4\tȓ{ܰ!2\b\xd9g\xf1]\xba\xe2\xf1\xe0Z\xe8펱\f\xben\x9fHY\xa2\xafI_ْ\xf9\xbe]\x17\xfc\x14\xcd\xfd-\xa4\x15Zc7p\x843Rڞ\x9d\xc5\t\xc8\x19.\x1f\xe4\xa2S\x892g\xe5\xf3\xebVd\x18\xa0\x03\x15N/V\xb1\x05L\xc04\x9c\xfdn͵\xe1T\xbcz\xd6\xdf\xf3C\x148!\x9fh=\x1f\x9b{\xb1C\xa5\\\x19(\x81\xa1\x16g\xcdTI\x8a\x0e^\xdc\xde\xf4@Z\b\x94\xc4QSg\xbd\x98\xe5\xe0\x18\x11\xc8\x19\xf4\xea32\xef\xe5\xf0n\x16\xa7\xa5\xfc\xb6\xe9U\xa5\xddN\xd3+\\\x9c@N\xc0.\xd2\xea\xec\xddO\tI'\ts#\x1d\xb7\x8d\xa2 \xa3'st\x1bE\xb5\xa8\x7f\x02\x18\x1a͚\x1dΕu\xc2NW\x01<~\xb1n\xbf1\xd2g\xceƫzmI\v9\x94žY\xba\x12x\xca\xc8(\xe6\xa8\xc8D\xf0l\x19\xcdZ\x0e}[\xe8\x84wv\xde,[\x9f\x82\xa6\xb1CQ7i\xa5ߢ\x83\xb1n\x87\xb1|ڰSZ\xb7\xebz\x11O\x1f;%\x15e\x80\x7f>\"c\xb6\x9d\x11\xbb\x18K/\x1c͓=9\x0fv\xfa\xa4:\x9a\xf3\xfa\x8cLא\xc5:\b\x13F\xf3[G\x844\xfc\x02FfN{n\x06+)%6\b\x12N\xcb[m\xe4\xa4.\xe6\xe5I~\x14J\xa62S[\b\x99\x93\x8f\xda\xcd\x01\x1d\x84\f\x93Y\xa8\xc3\x19\xa6#@\xa3\xb9\xa7s\xf2JG`V\x19\xa7/\x98M:\x91C:\xa2If\xd3vx\x03\n\xff\xa6N\nC\x19\xa1\x13y\xa0\x13爱Y52\x1ec\x93\x9a\x9f\xdf9\x81\x9f\x16_\xcf\xcf嬲5\xa3c\x9e\x9a\xc1\xd9\xceь\x82\x9c\x99\xb79\x90\x99\x19\x059#[s\"\x1f3\nvtc\x1c\xe1\x88\xc1WR\xa5\xa8F\xcc\xc8y\xbc0\xc2\a-\x1ex\xd7\x19\xadq\x9a\xacm#7\xa7\xa6Y\xdaǅ\xac\xea\x99\x12\xa0\x1b\xb1\x1c\xfa({\xb7\xb1\r\xd2\vk\xd1\xd6\xfbpm\xa8\xc4@v\xcc`\x8d\x05S\xf6j\x91-].\x90\xe7L\xaf\xe1\x9a\\ \xad\x86p`\x9a\x0e\xb2y\xa4P\xe6\xac:5\xbc\t}\xe8\xc9\xd9\x1a\xe0+Y\x1d\x9d+xz\t\x9a\xe7Ev$W-\x9c\xb5\xbb\x9cb\xed\r\xd2[a\x15\x0fx+\x93\xe6M\x7f\x03$\xfb.ҡa\xeeyf&\xc5L\xb3\xd4u\xbeǝ\x91\x8a\xed\xb1\xea\xd4?\xc5J\x7f\a\x0fk\x9e)ε\xcd\xf6`{\x84\xccw]\xd6f\x8c\xe7\x10N\xf7r\x14<R\xfan$H\xba|\x88\x9bs]y\xa6z\xd22\xa0\xf8G\xd8x\x06\xb6\xfb\xba6\xd0o\xc6}EA$\xfcEE\x16\xc1\nm\t\x7f\x82V\x85Qjَ\xef\xbf!\xfd\xe6\x10\xe6\x9ct\x8b\xc12Ӡi\x888\xee\x86\x14(\b<oܛ\x00\xfa\xc0\xc8]\xb0=z\xfc\xbb\x1bP\x8e\xe7\x91\bF\xa9\xabHO\x8b^\x14gj\\\xb3\x14\xa9\b|\xf6Ʉ\x15\xdc\xfa`\xfao:\xf8\vΚ \xfb֝Q\xc5R\x03!`\x8b\x84\x8c\n\xb1Q5j+y\x9a\xf0\xda\x01\x98\xe6mn\x98Z\xedS\xd9Pn?\x8a\xc2l\xbbj\xd6V\xfc)\x05)\b\x01W\xe9\xaa`\xca\x1c-7\xe9ek\x06\xc1\x84\x88Mw\x84i\xfbw\tFq\x17\xae\x14\xa4\x85\x11\xb4\x96*\xecb\xec\xd4\x19\f\x85\x8a&\x03D/4\x83\x80\xba\xee\x1cV\x167\x8b\x19n\xdbA]\xaa\x1fx\xf1\xbdH\x0eL\xec1\xf5\xf7\xa3m\x16#˼\x8bt\x888TC\x1c\xd1\xdfj\xb4\x18\x8c\xcdZ?\x84\xbfM\xcc\xe9H\xca\xfdp7\x86\xd9I\x91\x1dE\xea\x83\x00*|\xe4\xb2\xd4^l{@\xbdW_\x13\xf6\xe9\xeaδ\xa4h\x88\xcbKPHۑ\x1d\xa0V҂\x15\xfa`/Y\xb1\xd7\xc0\x81\xdc\xf5'j)WO\x97\xed\x19\x17k\xb8\xf0+#\rmqA'\x04\x84\x14\xe9\x1eGL\xeb\x1b'\x89w\"\xc1d\xcd\xffAw=\xe5\xd2\xdd\xe0\x97B.\xd3\xfajE\xc3\xfd6DQf\x8a\xe9\xd0q\xc98ck\xe8\xf0k\xc2%\x8c\xda]`\xeb\x14\"\xab\x10q\x92>\x1b\xf6C\a\x9c\xdd߿\x1d瓺\x1d\xc9%\xb3\xb9\"\xeb\xabR\xd9\x15\x92\x9a\xd0H\xc3{\x8e\xf7\x9d\xb71\xf1\xa3$\xa2L\xfaX˗\x81x~7\b\xf3i\xba\xe6\x15ҖATpu\xe3=\x88\x19j]o\xda\x15\xc8\xfb\xfb\xb7kx\xe7\xb6^\xc0\x8c\x15\x9ahT߹U-\xbe\x8f}G|\x7f\x89[\x95\xa3@l\x1dbM\xe1\xc6A]M\xef\x19\x14\x89\xa8\x870\xa7{\xb6\xff\x85-ߊ\xa4l\xdf>\xfe\x18z@\xfb{\x9a\x06\x17a\x87L\xbd1+LzC\x80n\x1eT\xf2\x91b(\xe4F$jW7sV\xb0\xacO\xc8W\xacӘ=\xa8V\xbc}\u070f\xa5\xa9\x9d\x14O\xe9*\xd5\xdd\xd1\x05\xfe¸\xe7\xda\x13dY\t\xc8\x12\n\xba\xf8W\x9b\xd8\x11\xcb3\x00Ij\x921\x9e۫\"\x9b7EJ\xbaʏnI[\xcfV\xbd~*\xefQU\x92\xbf\x99\x83\xfff\x87\x01\xd5;\x1f\xfdĸ\x8f\x16`\x9dV\\C\x00\u07b4@\xc3=\x9a\xd1\xd4\xe7o\xa5\xe8E=c\xe1\xf6\x95m\xd9{\xf8\x1d\xb2\xb4myR\xd3{Ԇ\xee\x12\x95\xeady\x98\xb5\x99\xb5\xdbƐ\xe9o\"M2Y\xa65\xda:@\x81\xa4\x80,\xa1\xdb\xf7\xe7>~e\xb7\xf9`\xe5z\xc7^p\x85\a7xx\xfd\xe5K\x06\nu\xfb\xcc2\xbe\xfev[\xefQ\xb68m\x1a\xdeM\x93\x86ŏF\x8b\xe1\x8cX\x7fީ\xd53Ͱ\xaf\xfd\x06\tjL6\xba\x88\xd3w\x18JD\xe9@\x84\xee\x0e3\xb0\x9d̞u)l&%\xa6\x9d;qG\x97\xf2\xfd@\xa7\bcz\x8b\xe9\x19\x17\xe9\xd6Z.h5\xab\xd1\xfcyJH{I\xa9\x9dG\xc4n\xd9\x1e\xdd+Bh#\x93\xc2V30\u00992+\xbaM\x96\xf2\xfd\xd2\xea\xec\x92z\xa2\xc4\f,2q|@\xe9e\x04\xc0-\x8a\xf2d\xfc\xadT\xe3:\xe0}\xaf\xb9\xb5\xe4\nf\xe8\xfe\xf5\xea\xd29ʲ\xd1\xd6X\x1d8 5\xac\xdd1\xeb\x96`\xd3a\xacj\xb2\xb4\xa8\xf3\x1bu\x0f\xaa\xdfN\xa5\xa8λ>5\xae\xbe\xf5\xb9\xba\x11\x98\xb0\xcd\xfc\x1c~#oA\x8d\xfb\x1bq\x12\xeeCs\x8b{{\xf9e \xc0҇P\x1e1\xd8\x16J\xca\xfe\x06-wn\x83w3X\x0e\x91m\x84L=\x90]\xb2U͛1㧃̂}\xa8;\xcdz\x10/\"\xc4c\x81\x80\x15\xed\xbc;\xa2\xe2@\x7f:\xf9mI\xea\xbd\"s\xc8集e\x91\xf9\u07bc\xb7ֲ\xb1\xf5\xa4\x13A\xf2h\xc9S\x03\xd5\xe4\x14u\xf5\xa1\xd6\xf1\xe3ぶ?\xb1\x8b\a[\xa5\x866Nf=\xa8V\xcf-\xa1\xba\x89\xd9A\"u\xa6\x81\x9be\x9b\x16~4b\x1d\n\x92\xd6$[.\x06\xae\xf8\xea^\x1e\x1eLB\x8d3I7\x84\xcc\xfa\xd2\xef\x80˞\x0e\xd7C\x97\x1cYD\x01\xefr\xf0\xe2\xb40\xae\xab#\x8b\xbd\xe9\xcc\xfa\"\t\x86\xc48\xd9\x03z\x87\xeb\xddF\xe6:\x9eɹ\xaa̺\x81\xd7dP\xf2x2\xfd\xca\xfa7\xa2\xafF\xe4\x8a\xfes\x97y\xeb\x19(\xbar-\x1b\x0eh\xb9\xb3\xb7\x8a\xfb\xfb\xc0}\xfeJp\x17;D-&\xefs\x1a\xbcJ.\x10\xa0y\x1b\xf9\x96\xee\xb7\x1a\x02\xea\xe6a\xe5\x84v\xaaN\xbf˻\x9b8E\x06\x98z\x16\xf6&tӸ\x86\n\x8c\xfe\xe1\x92\x15,\xe1f ۀ\x89\xe3\xbb]\xfc\xd5\xcaæo\xae\xecQ\x8d\xb6\x19YC\x8b\xcc\xdf\xd4\xf3\tn\xc1\x8c\xa9=y\x04\x92\xf0\\\xee\x9a\"\xb2\x98\xc8\xd4\r\"S\xd3\xfc\xb9\x98\xf4[\xcb\x06\xfe\xeb\xd5\xdf~\xff\xf3\xea\xf5\x9f_\xbd\xfa\xe1\x0f\xab\xff\xf8\xf1\xf7\xaf\xfe\xb6\xb6\xff\xf3\xbb\xd7\x7f~\xfds\xf8\xe3\xf7\xaf_\xbfz\xf5\xc3\xd7\xdf\xfc\xe5\xfe\xf6\xfaG\xfe\xfa\xe7\x1fD\x99?\xb8\xbf~~\xf5\x03^\xff8\x13\xc8\xeb\xd7\x7f\xfe\xff\xd1\xe9|X=T\x9f\tZqaVR\xad\x1c\x9a\aאs\xf1iQ\x9b\x8b.\xb5uβ\xec3\xb9_\x84\xdc\xfeP{\x991\xad\xe3;T\xfcd\xeb;\xb4u\xad\a\x06\t\xbd\xf4!\xbf\x81ݳ.G\xe9\xd2bR\xdd:\x8f\xc0\x00\xcc\xd6\x14>Iu\xea\x98\xf4\xfeX\xccB\xf7\xfb\xbau\x1b\xd7\xfd\xc3\xe6P8|\x8a\xfb\x97\x96R\xa9\xfb>\b\xd1\xc4^E\xe5/Ӯ\x87\x19\x80[Y\xd6t\x92^\x02\xae\xf7k\x10;\xbd\x84Ds\xda\xe8ؓ\xbe\xce\x18\xd9\x05_f2y\xa0\xc0/6h<\x00u\x8c\xf2\x16\xbf\x9f i\x87\x82I\xb4\xc393\xaf\xf7bЅ91\x99\xa1i8D\x05+-\xb8\x90z\x18\x89pX\xafO\x83\xdbba\xfc\x81^\x9d\x81\xa0\xf9\x859\xefv\xe6C^\x89\x01⍐-\x8e\x86(R\xe9\xbbve\vz\v\t\xc1\xf5F\x8d\xc2W\xf6|\x11_\xa9\xecM\xf9\x0e\x00-\xfd\x19\x9f\xe6\xf2\x9e\xde֧\x0f\xc7hr\xd9oo\xbfqGE\x004)\xc3\xf3\x86\xeb鉍d3@\x03\x98u\xe4\x11a\x1d,L\x01\x1fQ\x00}1\x85\xf1\f\xd3:\xca\xdf\xe9Ӄل\xe1}\xdb\xee\xeb^\xc1\xab\x19\xbcb\xfe\xbb}t֤o\xaa\xa0:׃\x10\xe9\x98i=[\x91\xe5w՚K\xa9\xd9\x00}6n\x15\x018C|\",e\x8b\xdf\xf4(il\xb1\x9e?c$\xa1h\x8a\xf2\xdbm_\xc8Qk\xb6\x0fǌ'\xba\xbc`\x8f\x82\xd2\xc1\"\xb1i\x9f\xb4XW9y;\xc63\x96\xcb}f\x89\xa1\x8f\x1cX\xf0!ٻ\xd1*r\x1a\xcf\xe4\x9er\xd1mC\xff)?\xbf-v\x99c\xd8\\\xc3\x0f\x05W\xd3~\xee\xeb\xaa\x19a\xc4&\xb9\xd3\xfdJ\xc1\xd5KU\xc0\x19\xdfs\nG\x12a\xf7Lm\xd9\x1eW\t}44\x89}Y헡\xab\x83\x1a\xf9leoA_5[\x06\x83\xd33\xb3\x83\x12\xbeb\xb9\xf4\xd1\x06\xe2\xf8\x9c\xfd]\xaa\xbe\xfb\"\xe7\x82\xe2\xa4\x14۲ɦ\xa1\xebz\xee\xbcI%\xbe+|\xf5\x93\xbe0TI\x18\xf90Rk\x057\xf1>a-F\x1a\x96\x81(\xf3-*\xd2f4\x84OX\x8c'bY\xb3\xa0\x1b\xa6\xad}w\xfe\xa3~\xd1ܟ\xf0\x9d\xa4>\xd0Z:\xb4a\xcax\xb9\x1f\xd9\x1c\x869\xb5\x8d#\xaf:N\xc2Q\xd5g\bG\x8dyEĭ\x83\xc1P/\x10`\xea2\xa1\xab\x12we\x96\x1d\x9f\xbb**\x89=iI\xae\xc3\v\xae\x87\xee\xcf\xc2t\xfe\xfc\x9d\xdey+\x93\x87\xefl\x90\xe7{a\xf8x\xb4\xe9]\xacG\xb5\x02ڸ(:\x92U\x97\xcd\xd7|ց\nV\xf99UI&'\xa6}E\xe8\x84R7\xabn\xc8Iyn\x13\xb5|\xba\xc1\xaf\xa3\x9a\xec\x17xF\x11sK-\x02\"\x9a\xe6HU*\x1f\x0fs\x0eĈ\xb1\x1b\xa1s\x15ט\xbe\xaf\xbe`\xdckp#n\x95\xa4\x92\xf7.\xaeW\xf0WƩN\xfc+\xa9n\xb3r\xcfE̓\xbd\xa6\x95\x9c\xf5\xde\xdc2e8˲\xa3\x9bI\xef\xfd\xc0\xe3+\"T\x17\xa1c\xb8\xf6\x8b\x18G\xb7o\x14\xe2\xb4\x14Uv\xa4\xa7]\x8em\xa9\x84\xba\xc9}u\x91r\aj=ޚn\xf9\xf69RV\xf6\x9a\x10I\x14Q\x9b\x15\xeevR\xf9T\xa4Պj\xf3\aR8I\x14\xe9\xdc\xe6?\x9aK\xa7\xe4\xaa\xfa\xa1ީ\xecII!\xd3v\xa72\xb6\xac\xd4&!\xb2$\xa1\xa0!\xbeц\x9d\x98\xbc4\xe6\xca&\xada?\xfe\x89\xe9\xf7=㶇\xe4\x9bf\xeb\xc0۵\x82\xb2\xc0\xea\xa45o\x03e\xed\xc3N\xf8\xb7E\x14\xf0\xa4\xb81(څt`\xc8\xde\xc82\xd0\x12v,\xfa%\xa4a\r\xe6C\x8f\xee\xa0\xf3\xe5Ѡ\xbe\x92b:[\xf1\xb6ץ\xbf\xbc-A\v\xc2;t\x13V8\xf4\xd6X\xb0\v\x8dG@\x87\x17\x18\xb4\x16\x17\xe6O\xff\xfa|\x04ܓ\xd9`\x974\x1f\x03u\x9f\xa1\x8d( b1\xec\x1e\xaa\x83\x8au\xb8\xb0\x939\xd8@ĒʣwL\x01\xd3C0\x8f\xe7\x01\x95:a\"\x92-\xf6\xf1X\xb3˼\x19\xf2E\xb4\x90u_5\x1d\u0091\x97\x05\t\xdb\xc1\x8f\xbb\xd2\x7ft^\xb0\xb5Q\xbe'ɻK\xf4\x04sP\xb2\xdc\x1f\x82\xe2\x1a8nD\xa1\xa6%M\b\n\xab\xda=\t\x14\x9aRՑu\x96\xf9\xcaƴ1U\x96<P\x04>\n\x93\xe6P\x7f|\xd9\x7f\x18kEU\xd5+/\xb66\x1dm\xe9\v3\x94MLu\x99\xd0\xfe\xdb4\x03`\xad\x9c\x14\x05\x15\xdaj?\x97\xc9K\x18\xc7\b9\xa7N\xa2G\xe1\xa1\xfa\x88\x8a\xbe\xb5#\xa1\xc6\xfd\xb9/Y\xa0\xbd\x06x$\v\xaa1bU\xf9\xa0g:P\xa2\x17H\xd6\xe0z\xd3\n\xbb\x88\x9b\x14}\xee\xbd\a\x92n\xb2ÐF;kn\xe3\x9b\xc7,\x0fId5\x97\xfd^\xde/\xe1e\x89\xccF\xfa\x1f\xbb\x90\xa7\x01\xa5P\x8d\x1eg\x91i\xcbo\xc6\xd69a\x99\x84\x83{<\xb5,\xb2\xf2\xb7\xb2M\xbe\x90EV\x9b\x83Syd\x1d\xb7p\xb7xf0,2\xb1\x06\xef\x00\x99\xb1\x84o\\K\x1a\x92\xc1\xa1̙X)d)\xa10\xb8Ql\xa9<-\x94\xb2V\x0f\xf1\xed\x1fj\x02\xc7\x0f)\xb3\xa6\x1d5ße\x8c\xd3LN\x8f\xac\x0fZ\xd8S\xc6\xf3\xa8\x89<c\xe9c^\xeb\xc0\x8f\xbdW\x83\x9aqB\n\xe2\x0e[\xf0\xae\xc1;\x9e\xe2\xb5HԱ\x98\xf4;\xddE:\x04\xaa8`+\xbaH\b\xb0~\x1b\rD\xb5T\xb0?\x1bV\xcb\xf6\x8eU\xb1\xe3\xfbR\x05\a\xb6\xf7q\x85n=\x88\xd4'\xf8D֧\xe0fL?\xb2l/\x157\x87(\xfb\xb4\x10s\x11ZN`\xa3\x82\x18ߣ\x99\xf6A!\xf7M\xf5\xce鹑Qn\xe3=g\x17\xd7w\xff\xf2o\x7f:\xa3x\xcf\x19{қ\x87\\\x9fEᒗ\xe7\xe2\xafwp\xf7\xc7\xf5\xe2DF}\xc8\xf5\xd7x\xbcI'Q\xf0\xf57w\xd4\xf0*`\xe0\xe6\xaa\x12M\xfb\xcd\\T\xab\x9c\tF\x150\xb6\x1et\xb8,+,\xf3\\\xdbR_\u05cb\n\x85\xab\xef\x83\x13Sy\xe48cã\xd8sˉ\x8b\x1c\x92\xc5U\xcd\x00\x8b\x99\x82\x18<u\xb5\x83v\xb3\x18\xc1\xd9]\xafy̟{\xae{\x8e\xc0\x0ePW\xaf1\xe1\xf3\xa5ʑ~F\xbc=I\x87\xd1\u05cb\xd3v\xe0\x19Z'\x82q\xeb{\x1c\xb47\xda\bj5\xed\x1b\x19\xd5ћ\xe4\xdf\xfb4\xd7pGE\xc2,r\xfb\x19Y\xbbpI\xd5DM\xdbeY\x95d\x91\x9bٖ$\x87\x1a()\xec\tG*\xba\xbf\xe2>¯\xad\xe8J+\x9aҞ\xba\xfe\x950K\x99\xe8\xd1\xf3b\a\xadU\xbb \xae\xee\xfcCe\\Al+\x05\xed\xfcz\x11{\xb4\xad\x9e\u058b\xf9\x87\xb9a\xfb\xff\xb1r\x87]OG\x85j\xdfY3>T]\xa1D\xf1\xa1\x1a^\x88弊\x14P\xf9+Y\xb7\x19\xbe\x9ei\xde\x0f\xd2\xe0\x99{\xb1\x8fQ\x8c.\xf7|4@b\xa3!U\xac\x03\xae\xe8\ue584E\xe2\x16\x00\xb7\x19\x92sS#\xb6#/\xe7\xf3\xc9\xd4\nDOs\\;p=\xcb;\x11\xf8j1`<7\x9c\xe9\xf5I\xa2\xa7)m\xd5~\xaf&+\x12\x1b\t\xb9\xfduO\xf7\x95\xb0\x0e@\xfb\x91V\x85\x059\r]\x9d\x17Ɍ~!\xe6oaif\xe4\xe9\xfd@\xa7!\xfc\xb2Р\x03\x14\xbaK\xd5Ϗ\x0eu\x16RYѧ,\xa4\xea4\xb4\x90f\x88g1x\xb6\xfc\xe5Vu\x85'\xaf\xc9w\t\a\xacfaVs\xbf\xef@\x84\xfe\x1al\x88\xdbGL`\x8b\t#6w\xfc\x18\x06\xa3:\"W\xf4\x99\xaeO,6\xa9\xe6\xebJ\xf3N[c\xe83D\xb6\xeeZ\x06$\xb1\xa2O#jY\x17\xf6\x1d\xfdb;\xc0\f\xaa\xf9\xe4|b\x8a\xb2k\xc6\x15\xd7_}\xa3H\xea\x81\xef\xff\xb2\xc9\a\x8d܃0\xbf_)\xfb b\xd4v\x1e\x85=\n\x1e\xbf\xa8\xff\xb2\xe8sw\xab\xfa\x17\xde*J\x1b\xfb\x9f\x9f\x8a\x7fRg\x05\xb1$A\xd2U\xf6^\xc9͢\xba\x1e\x01\xce\xdcA\xa6\xc8J\xc52\xffg\"\x85\xf3}\xea\r\xfc\xf0\xe3\"\x98;~\xef\xd2\x1b\xf8\xe1\xc7\xc5\xff\x0e\x00|\xddK\xc0\x86\x95\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec|ms\xe3\xb6v\xff{}\x8a3{\xff3\xb6\xff\xb1\xe8\xcdM{\xa7՛\x8c\u05fbIݵ\xd7\x1e˻y\xb1\xd9΅\xc8#\x11\x15\t\xb0\x00(\xaf\xd2\xf4\xbbw\x0e\b\xf0\x11\xa4d7\xb9Mgn虬D\xe0\xf0\x9c\xdfy\xc4!\xa0\xd9|>\x9f\xb1\x82\x7fB\xa5\xb9\x14\v`\x05ǯ\x06\x05}\xd2\xd1\xf6\x9ft\xc4\xe5\xc5\xee\xdb\x15\x1a\xf6\xedl\xcbE\xb2\x80\xabR\x1b\x99?\xa0\x96\xa5\x8a\xf1-\xae\xb9\xe0\x86K1\xcbѰ\x84\x19\xb6\x98\x010!\xa4a\xf4\xb5\xa6\x8f\x00\xb1\x14F\xc9,C5ߠ\x88\xb6\xe5\nW%\xcf\x12T\xf6\t\xfe\xf9\xbb\xd7\xd1w\xd1\xeb\x19@\xac\xd0N\x7f\xe49j\xc3\xf2b\x01\xa2̲\x19\x80`9.`\xc5\xe2mYh#\x15\xdb`&c;XG;\xccPɈ˙.0\xa6G\xb3$\xb1\xec\xb1\xec^qaP]ɬ\xcc+\xb6\xe6\xf0\xaf˻\x0f\xf7̤\v\x88\xb4a\xa6\xd4Q\x912\x8d\x96\xe5\x04u\xacxA\x93\x17\xf0\xc6>\x0f\x96\xd5\x03\xe1\xc6=\x11\xaaY\xa0\xcb8\x05\xa6\xe1r\xc7x\xc6V\x19^|\x14\xcc\xff\xdbR\xabؾ\xaf\xa9\x9b}\x81\v\xd0Fq\xb1\x19a%c\xda|b\x19Oj$\x86|\xdd\f\xc6\x00\xd7`R\x04\x9a\r\x86\xbe\xa0O\x15^@\x80!x\xbc\xe0\x89iK\x12`W\xd1\xc0\xa4\xc5,цO\x9d\x1b\x15\xd7\xf4\xb9ϳ\xd7~4\xd0\\\x8b\xe2\xe5\x06\x87d6J\x96\xc5\x02\x1a\xd5U:v\x86S\x19]\x05\xbfC߃o\xefg\\\x9b\xf7\xe3cn\xb86v\\\x91\x95\x8aec\x86c\x87\xe8T*\xf3\xa1y\xf4\x1cV\x9a,\x0e@s\xb1)3\xa6F\xa6\xcf\x00\n\x85\x1a\xd5\x0e?\x8a\xad\x90O\xe2\a\x8eY\xa2\x17\xb0f\x99շ\x8e%Il\x89\x17,\xb60\xebr\xa5\x9c\x17\xb9\aVz_\xc0\x7f\xfe\u05ec\xd6\bY\x9f\xbd)\v\x14\x97\xf7ן\xbe[\xc6)\xe6\xd6\xcbF\xac\xb4\a\x01\x19\x04k\xe9<E\x85\xf0ɢ]كvR9\x8a\x00r\xf5\xef\x18\x1bo\x1a\x85\x92\x05*\xc3=,t\xb5bF\xfd]\x8f\x97\x13b\xb6\x1a\x03\tE\t\xac\xecrW}\x87\th+\b\xc85\x98\x94kPhA\x14\xa6Q\xae\xbf\xe4\x1a\x98plE\xb0$\xa0\x95\x06\x9d\xca2K(\xb4\xecP\x19P\x18ˍ\xe0\xbfԔ5\x18\xe9\\\xc1\xa06\x1d\x8a6\x14\b\x96\x11\xcc%\x9e\x03\x13\t\xe4l\x0f\nIt(E\x8b\x9a\x1d\xa2#\xb8%\xdf\xe1b-\x17\x90\x1aS\xe8\xc5\xc5ņ\x1b\x1f%c\x99\xe7\xa5\xe0f\x7fac\x1d_\x95F*}\x91\xe0\x0e\xb3\v\xcd7s\xa6\xe2\x94\x1b\x8cM\xa9\xf0\x82\x15|n\x19\x17$\xac\x8e\xf2\xe4O\xb51\x9c\xb48\xed\x85\t\xfb]\xe5\x13\xa3\xb8\x937T:\xaf\xa6U\"6\xf0r\xb1\xb1\xa8<\xbc[>\x82\x7f\xa8UA\x8b\xa47\x82f\x9an\x80'\xa0\xb8X\xa3\xb2\xb3`\xaddn)\xa2H\nɅ\xb1\x1f⌣肮\xcbU\xce\ri\xfa?JԆ\xf4\x13\xc1\x95\xcd\x15\xb0B(\v\x8a\bI\x04\xd7\x02\xaeX\x8e\xd9\x15\xd3\xf8\xbb\xc3N\b\xeb9Az\x18\xf8v\x8a\xf3\xffU\x03+\xb4\xea\xaf}\xf6\tj(\xe8\xa5\xcb\x02㎟$\xa8\xb9\"[6\xcc 9\tsN\xdb\"\va\x8fo\x8d\b9/],\x8eQ\xeb[\x99`\xf7\xfb\x1e\xab\x97\xf5\xb0\x0eo\x05\xaa\x9ckrc\rk\xa9\xfa\x19\x86\xb90߾|\xfc\x89zwP\x94y\x9f\x859< K\xeeD\xb6\x0f\xde\xf8Iq\xd3\x7f@P]\xf4W\xb1\xb5܋\xf8\x1e\x15\x97ɤ\xb8oz\x83k\xa1S\xf9\x04kk\xb6\xc2d{0\x12\xf4^Ďx\x8f\"\xc0\xe5\xfd\xb53\b\xe7\x1cΗ\x1c6\x11\\:\x9f\x94kx\r\t\xd7T%hK\xb2\x0f\x0f\x15=tw\x01F\x95G\v\x1dK\xb1曾\xa8\xedR(l\x15\x93D{X]\xd9gP\xa0!\v(\x94\xdc\xf1\x04՜,\x9f\xafyLay\xcd7\xa5\xb2\xd6\rk\x9b\x10\xfb\xd2\x05}\x87\xfeb\x85\t\xf9(\xcb\x16\x93<\xd4\xc3\xe8q\x86qQ\xe5\x98f\xba\r\x1c*w\x89P\x18\x14\x89+eڗ\x916\xfehL\xe0\x89\x9b\xb4\nk\xb5\xc5\xc2c\x8a\xa01Vh /\xb5\xa1\xb1\\\xd8\a\xf94j3҉\x9eu\xa8\xba\xb2\xc7&\xfc\b\xae\xd7\xc0͉\x06\nv\x1a\u0379\x9d\xdf2\f\xfb\xfc>\xfbC\x8a\x83\xa7R\x11\a\\hò\xcc\xf1\xff,#\x1a\x8b\x10tmq?\xfc\xb2\xa7\x03\x02g\x8b{\x8aP\xa6\xc1\x89<\x043J\xa5\xe4\x00\x11\xc0\xad\x03\x8e\x91\xe9\xf3\xa1\n\xe8rs\xb7\xb8\xefKp\xc00]}y\x88\xd5\x13\xaa\xbf<\xa3\nרP\x98`\x82\xa1\xf5\x89\x12h\xd0.\x80\x12\x19k\xca\xea1\x16F_\xc8\x1d\xaa\x1dǧ\x8b'\xa9\xb6\\l\xe6d2s\xe7\xef\x17Ĉ\xbe\xf8\x93\xfd_\x80\x1f\x80ǻ\xb7w\v\xb8L\x12\x90&EEZ_\x97\x99w\x90Veun\xf3\xfc9\x94<\xf9\xfed6\xa03\x8d\x87\xb4\xdaa\xd9AL(\xef\xf0\xf5\x1e\x9eR\xb4\xec\x104\xcbJ\x0fR\x01ekR\xae7\xfb*\x1e\x86\xb4Wq\xb3\x922C\xd6-\xde\xc0\xe6{\xcae}f\xe6\xb0\xc5\xfd\xb1!!\xc15+3\xb3\x98M\b\xf3\xb6\x1a\x03\\$<f\x06uד\xfd\xd2ȑ:6e\x9d\xc3S\xca\xe3\xd4\r'\x12\xcc@\"ŉ\x01\xed\xd0c\x9eH\xf3,\xa6\x86\x14i\x10&\xc0E\x04\x94\xdd@\x8a\xd6b,\x1cR\x9a\x10\x02\xf1\x00X \x9d\xb4$\x8af\xc7*\x057\n\xb5\xbeW\xf2\xeb~\x12\xd1w\xcd8\x8f\u07bf<>ޟ.Ϡ\xb0_Z0L\xda\xc8q\xa2\xa1\xc8\xca\r\x1f\xf2\x9a\xb3-\xb6\x8b\xbfT\xc9r\x93\x9e\xdb\xe0\x85,\xf1\x8eY\xd1\xd5h\f\x17\x1b\xed\xbf\r\xd4>\xf4WÄbǕ\x149y\xf4o\x15\xfe\xa8\xca\x0fB4\x80\x890\xe9\x80\xf4\xf1\xe1\xa6+\x0f%I\x1aU\xcb\xdf\xe7\xf2\xa0K\x137\xfaxv\x96\xc7\xf1\xb3|9CB\x1e\xc7\xcd\aY\xb3\u0080\xeau6\xd7X0Ež]\xbf\x13g\xa9\xd4F\x9fC\"s\xca\xe2\x01\x92\xd4TJ\xe0\xfa\x1e\x14\x13\x1bt^\xc8\x14\x05r\x16\xa7.\xf3\xc9\xd2\x00\xab,\xf3\x99\xe2\x8c\xc6\x1dn+\f3\x10\xb3#\xe2\xb5\x1bTW=\xa8;NA\x15#+MJ\xa3(0\xf92c\x18\"\xe2L\x96I\xfdP\xaf\xb3~P(d\xd2v\x1b\xd6*\x19\x86r_\x1b\n\x1d'6\xfdj4\xc02)6\x15\aW\xa3\xd3^\xec4JfGd\xe2\a\x99\xa1\xb7͞\xc8Èr\xd2D\x8d\x00a\xb0V\x90\xb3\x04\x81i\x1f\xab\x89n!\x93\x93\x13\xdd\x10\xf6I\x8ce\x99|\xc2\xc4\xeaD\xebҵ\xd5\xfa\x17e\xbf\xbc@\xa5\xa5`\x86bG\x8ap\xf9\xf0\xc1\xf5\".\x7fZ\xc2\xf5孕\xb6*\xe50g<\xb3w\xe1ǫ\xfb I\nV<F`q,Ka\xce\xc1-\x9d\xaa\xa52\\\xbf\xf5\xc4\x7f)\xadD\x82m\xb0\x01f\xa8X\xba\xaa\xb2\xb2_W:\xd1\xe5\x93h\xc4\xe7\x9aj\x8d$:\xf9\x8d\x1c\xa3\xf2\x15\xb7\xf4\\\xcc&\xb4}\xd7\x1e\xe9\x17\xa9.wr\xe7)u\xbc\x17HKN\xa6\xfa\x85\x81\xad\xd2c)\x04\x15\x95\xa4\xbaz\xcdq\xa2\xdbu4-\xb0\x9ea\xae+&\x92'\x9e\x98\xf4\x86\xe7\xdc\x1c4\xdc7\x9d\xe1ނs\xf6\x95\xe7e\x0e\x14Ҫ\xc0\xe4\x1c\xd6(&\xf4\x1aU\xd8n\xfd\x1a\x91\xa4\x11I\xd3G\xe9J\x03\xcc\xd8\xd5C\xa3\xe0I\xa2L!U&\x19\x89\x83I\xc8h&]\xfb\x10^t%\xf2Id\x92\r\xea9\x7f1\xb1\xbf[\x8fݜ;\x8b\xa2\x0e\xdc\x06ՁQA\x93\fj\xe6\xadc\xaa\xaf\x13Q\xe6+T\xe4Y\xab=U\x84\x05*Z\xccI\x11^\x83\xd0e5\x88,N\xbd&\xb8\xaeeƄ\xf412\xf5 \xb2\xf4W0C\xbd\xc7\x05\xfc\xdb\xe9\xcf\xdf\xfc:?\xfb\xfe\xf4\xf4\xf3\xeb\xf9?\x7f\xf9\xe6\xf4\xe7\xc8\xfe\xe3\xff\x9f}\x7f\xf6\xab\xff\xf0\xcd\xd9\xd9\xe9\xe9\xe7\xf7\xb7?>\u07bf\xfb\xc2\xcf~\xfd,\xca|[}\xfa\xf5\xf43\xbe\xfbr$\x91\xb3\xb3\xef\xff\xdf\bC_\xe7\xcdrg΅\x99K5\xafp\x9f\x90\xa3,\xfep\x16\xf0\xb1\xf8\x1d\xf5_\x16\x7f\u05fe\xd7\xfehJ\xa0\xbfU\x19o\xf1\x88@j\x87yeU\x93(\u0097\x1amK\xb1\x1b\x03C\x90O\x9aG̮P\x1d\xe6\xe2ꒆ\xd5m>\x06W\x97\xb0*E\x92\xa1\xe7\xe5)E\x01;T|\xbd\xa7\xc6\xf9\xe3\xcd2@\x13|b\xb2\x1dQ\xf7\xd6\xc1\xa7\xa7\x10\xefUOjaM\xf2e\xa2=\xe0\xfaH\xe9\x1ep\xedz1T\x7f\xbbV\r\xf3\xcd\x16\xa9\\\xcd\n9+\xce\x03\x14\xc1\xf7\xba\xear\xac\xb5&\xa5j\x83\x99\xa6\xf96\x040Hq\b\xea$\x80\x9d\n\x96j\x98 Q#7U\v\xa3\xaal\xadf\xa3\xd9\v\xdc\xf4P\xfa\xab\xf0\xbae\xc5{\u070f\xa8a\xa8\x8a\ue710B\x1a5\xfc\xcf\x02\xcc\x01\xee'\xfazA\xce}\x7f\xaf\xee\xe8\x8dqw\xd0p\x0f\xf5ꂏ\xffC\xf4\xec~\xf3\xdeݳ\xf0\x9a\xea\xe5\x051\v\xf5\xf4j\x03\xec\xb7\xf5&\x88\xc2t\xcb\xefp\x97\xe9p\vp\xaa\x15xT\xbai\xfa\xc6\xcf\xf0\xc6ekB\xc8\x15+\x82\x7fH7t\x9e0\xd5f\x9f \t\xad\x16\xbc\x93r\xac\xdd\xfe,\x13\xfd\xbbK\xff/\xb8t\xb8M\xff\x7fޟ'o\xfbeX\xe8\xcd\xf5蒐\x06S\xa5Ioq\xc9\xe6֜^\xb7\xcau\xddѧ\u03a2B\xea\x1e\xa0>\xa6\x04\xb2\x1d'\xea\xe6T]\xa4R\xa3\xd2\xdd5z\xa1p\xae\xf9F`B\xad\xd70Q\"B\xd5L\xc8\xfbB\xaf\xc5\xe9\x9a\xc3\xd2R\xfd\xf8p\x13\xbck;\xad\xb3gZ\xa5\a\xf5\xe3\xc3\xcd\xe3\xe3\xcdѰV\xc3=\xb0\xb6\xa9H \xf5D\xa7\xd6F\x80\xa2\xed2|\xe5\x98\xd4O\xaf[\xfd\xadB\xb3\xd2\x14\x01e\xdf\x1a\xd2\xca\xc0\xe3\x1c\xa4\xe9\x1b`\xfb\x93\xf6\x14\xf8\xf65\xe4\\\x94\x06\x83M\xee\x83\xf1|\x12<.4ƥ\xc2\xe5\x96\x17\x8f7\xcbO\xb6\xaa=\x88\xe1uhV\xb3\x15\xc0.8\xb83\xb6\n\x96\x00Eh\xb7\xc0l\x11Me\xab\x9d\x87\xb6hv;\xa4$\xbdk\xf2/\xb8iqEۡ\xb8\xd8D\xb3\xe7:\x7f\xe5\x9572\xde\x1e\x94\xf0\xae\x1e\xea\x17y\n\r\xb5x\xa5hZ\xbc\xddU\xdetӴ(2\xdb,\x94\xad\x99\xba\xd3m\xab\x1c\xf8ܪ\xbcZQ\x86\x1d\xcf\xceIٮ~~&c\xaa\n\xe1\xf4\xa7\xbb\x87\xdb3@AZH\xba\x1e-d#@\x90*m\xb9\xb2<\xfe>M\xb7|$\xe2\r\x80\xf7Ѯ\v9M\xf7\x0e\xe6\xb0\v\xb19\x15{\xe8\x9aÏw\x9f\xde=|\xb8\xfcp\xf5nt\xc8\xd5\xdd\xed\xfd\xcd\xf5ĐI\x87\xa2\xbf\x9a\xef𦝠\xdc\x0f\xdd9\x9d\xb8䭅\"\t){\"\xff\x91\U00070d69r\xac\x8d#\xbe\xf5\x13\xbdL\x9a\xa9T9\xb7z\t\xde\xe8A\xf0\xdcDY(\\\xf3\xaf\x8b\xd9\x01\xd0\xee\xed0o.\x053)\xbdW\xe2\xf4.%Д\x19y\t\xeb_mS\xeb\x1d\xee\\i\x13͞\x89\x94ͧj\xc9\x13|'b\xb5\xb7d\x0e\xf2\xbf\fL\xf2\x9a\x1f\x06\x18\x1fL\x02T\xc9\xec-\x01} \xbct\xa3BӼ\n\xec\xfeim[\x00\xec\xb0W\xea\xdf)J\xb0l#\x157\xe9\xa8\x03wл\xf4\xa3\xbd\x01\x10>\xb4\x89\x8b\f\xa0\xc5qM5\xdc \xa2\x8bU]\xa1\x04V\xfb\x10\xf0>Q\x9d\x03F\x9b\b^]\xbe[\xfe\xf9\x1f\xff\xf2\n\xe4X\xfb\x17\xe0\x15{ҋm\xae_Yӣ\x17n\xcb\xefB\x98\x1d4,\xfa\xdb\xe6\xfa=\uebcf\x8b$\xefo\x974\xf8\xadG\xa5z1G\xff\x8aKmd\x8ej\xee_\u038dW\xb9u\xd1\xd8\xcaѶF\xb73i\x9fB\x9dڬ\x91\xb5B\xd4(E\xa7\x92\x91m^G\x821\x1d\x8fjU?/\xe0\x8c\x11\x9d;\xef\x98\x1dIɃ\xb5\x98M\xe8\xe7\xde\r\xf2\xfa\U00053f16\xba\xfbz\xa2ّ\xf0\xb88\xaf\x1e\x89\xb9\xa9\xe7\x7fl\r\xf4<\xf8\xc9UAB\x1c\xd0;\x03\xfb\xa2~G'N\x02\v\v#\xbbۓld\xc1\xbc0\xfb\xf3\xe0K\x7f\x1fJ\x9aG\xed\x8ba\x8c\x18\x89.\xa1\xa4>\xa7\xed߆ǃ\xaf\xb7\xb2\xe0\xecXؚ\x83\n?\x90\x15\xa0\x88\xf7\x93\xe8}\x1a\x8ew\x8b\xd2\xd0>[G}('!\x14K\xa5P\x17R$\\lz!gl\x97m\xc3n4{F\xf0\x1d\x11?d\xf7s\x90\xed\x17ޝ;\xdeTg\a|\xc1\x1d\x05\x99\x8d`\x18\xdeBn\xe7\xd4X\x12@r\xe5V\xa9\xf5.\xf2\xe0\xcc\xd9\xe1\x04s\xe4\x86\xf1W\xad\x1d\xe3T\x10\v(\x05%\xbb\xaa\xa1\x12\xc1\xcf\x02\xde҉\x02Z\xa2$vS\x055}\x86\xbe!\xe4\x13MnQ\xb3\x04\xc0.\x1eжCha\xe9\x0e \xd8[O<˨\xc1\xa10\x97\xbb@\x81Gš\xc2lO\xe7\xb4\xe4\x1av\x7f\x8e^G\xaf\x8e\xf2\x92\xdfn7zL\xa6\xda:\x167\x82\xe2U=\xccv\x1a\x9a3,N\xa1Vi:\x1c\xeeF\xb71\x9e\xe8\xea,A\xdf\xec\xb9\xc1|\xc0\xce1\xf6Vs\xe9Ʈ\xfcV\x0egk\x03\x92\x00,L\t\x98ݶeώP\xd6\xe4\xf9\x80\xcbC\xa5\x0f\x1dw{\xa4\x8d\x11\x96#:|\x16\x1a\xd5\x13\xebf0)|z\xaeV\xdbH\x91\xe7\xfd\x15\xe2\x946\xa7\x05K\xbb\xe6\xa5\x1f\x85\xb3\xb9\xf1\xc7\xf9\x00\x9e\x11\x85\x0e\x98\x97[)\xa2\xd6ls\x8c\xfc\xb7\xd5H\x12\x9aAZ\xe6L\xcc\x15\xb2\x84\x1e\xef\xa9\x00[Ѧ\xba\xe3P\xa8P\xab\x01\x8d^½B\xa6\xa58\x82\xf9\a;\xb0\xe2=gq\xca\x056\xdcWT\xea\xc3)\x7f\x1bևA{\x84u\x17\xa9\x9d\xad9ۑ\xeb.\xab\xe7v{\xb0\\ã*q\xac\xf0\xfe\x81\x0e\x18R\v\xd8\x1d<|\x11\xdf&P\xf0\x04\xb8n\x97;4e\xc0\xf1\v\x1e>V7R\x16\xadp\t\xdc\b\xd6=\xa3%\xe5Q\x89\x9d)ź\xf1\x9d\f\x82N\x02a\xf2\x80;\xde?\xea8\x00\xe7\xd5\xcd`\xbcǪ\xaeB\xe8\xc3_\xfd\x19\xb2\v\xe5\x86\xfd\xb5G\x16l\xd7ӯ\x1e\xba\xc1\xbd\x0e\xe6\x81 \xf5fys\xa2\xc9|\xa8o0\x84퉎}\xd2\x11#\xdaR(\xdc+\xf68+\xb5A\x15\xc8\xcbuZ崵\xd0vQ\x02[uܑ=2\xc0\xba\xb9\x98 \x9d\xb6\xa3\x82\xac\x8a\x86uˮ\x95\x88<\x97\xc1\xe6p/\x917\x89\x9b\x8bp\xd6\x1e\xb5\xb0F\x87\xa1\x84\xd0\xd1_\xa3\xbe\xc94`\xb1\xf5\xba\xf4\x02=\x0f\xeb\xd9\xf3\xb2\xc2\x11\xc6;\"ySh\x1f%}wx\x18\x81\x965N\x89\xcf\xea2\x1b\x93\xbf\xbd\xec.s-f\xc7f\xbea\xaa\x1b\xf1\xba@\xf6\xa8\x82\xd4y\xfd\v\x00&\xad\x93\x8f]\t\xda3_e\xf3c\x00ѱR\xd8\x1f\"\x98\x94\xc1\xfe\x98\x80\xd7S\\*z\x8d\xda\x1c\x17\xa5/\x83\xc5VtT\xcd[\xff\x92\xc1\xe0N\xff\x97\r\x8e\x90\x85JSL\xde\xd0\xfe\xbbI\x89\x96\xcd8/\x97\x91\x86e\xa0\xf9/\xb5P\xfe\xa5\x9d\v\x90^7\xc3\f\xc9\xea\x9c\xda\x1817\xad\xe0\xf3\x127\xe5\xc2\xfc\xe5\x1fz\xf7ƶ3\x06RR\xef+w\x18~\x01\xbbo\x9bO\xee\xb7)\xa8\x9d\xe6n\xb8\xe6h\xd2\xf2\x03g\x9a\ue6e6\xf2\xa0uZa0i\xfd\x8e\x01\x1d#[\xc0\xabW\x9d\xdfA\xb0\x1f\xeb̭\x17\xf0\xf9\xcb\xcc+ʽ\xf1\xd6\v\xf8\xfce\xf6\xdf\x03\x00\xb73\xb5\x93$D\x00\x00"),
 	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4\x96\xcdn\xe46\f\x80\xef~\nb{\xd8Kǳ\xc1^\n\xdf\xda\xec\x16\b\xda\x06A\xb2ͥ\xe8A#q\xc6ldI%\xa9Iӧ/$ۙ\x9f8\xc8\xf6\xb0\xbe\x89\xa2\xf8\xf3\x91\x94լV\xab\xc6$\xbaG\x16\x8a\xa1\x03\x93\b\xffQ\fe%\xed\xc3\x0f\xd2R\\\xef/6\xa8\xe6\xa2y\xa0\xe0:\xb8̢q\xb8E\x89\x99-~\xc2-\x05R\x8a\xa1\x19P\x8d3j\xba\x06\xc0\x84\x10\xd5\x14\xb1\x94%\x80\x8dA9z\x8f\xbc\xdaah\x1f\xf2\x067\x99\xbcC\xae\x1ef\xff\xfb\x0f\xed\xc7\xf6C\x03`\x19\xeb\xf1/4\xa0\xa8\x19R\a!{\xdf\x00\x043`\a\x0e=*n\x8c}ȉ\xf1\uf322\xd2\xee\xd1#ǖb#\tmq\xbc\xe3\x98S\a\x87\x8d\xf1\xfc\x14ԘЧj\xea\xa7j\xeav4Uw=\x89\xfe\xf2\x9aƯ4i%\x9f\xd9\xf8倪\x82P\xd8eoxQ\xa5\x01H\x8c\x82\xbc\xc7\xdf\xc3C\x88\x8f\xe1gB賈\xad\xf1\x82\r\x80ؘ\xb0\x83\xeb\x12u2\x16]\x03\xb07\x9e\\\xc53\xe6\x11\x13\x86\x1fo\xae\xee?\xde\xd9\x1e\a3\n\x01\x1c\x8aeJUo)\a \x01\x03S$\xa0q\n\x10b@\x88\fCd\x841Zi'\x93\x89cBV\x9a\t\x96\xef\xa8\u007f\x9eeg\xceߗ\xe8F\x1dp\xa5cP@{\x84\xa9\xee\xe8@j\xe4\x10\xb7\xa0=\t0V,a\xec\xa1#\xb3PTL\x80\xb8\xf9\v\xad\xb6pWб\x80\xf41{W\xdal\x8f\xac\xc0h\xe3.пϖ\xa5\xe4W\\z\xa3s\x81珂\"\a\xe3\v\u05cc߃\t\x0e\x06\xf3\x04\x8c\xc5\a\xe4pd\xad\xaaH\v\xbf\x158\x14\xb6\xb1\x83^5I\xb7^\xefH牱q\x18r }Z\u05fe\xa7M\xd6Ȳv\xb8G\xbf\x16ڭ\f۞\x14\xadfƵI\xb4\xaa\x81\x87:0\xed\xe0\xbe\xe3i\xbc\xe4\xfdQ\xa4\xfaT:A\x94)\xec\x9eŵ\x87_\xe5^\xfaw,\xf3xl\x8c\xff\x80\xb7\x88\n\x95\xdb\xcfw_`vZKpʼ\xd2>\x1c\x93\x03\xf8\x02\x8a\xc2\x16y,ܖ\xe3P-bp)Rк\xb0\x9e0\x9cB\x97\xbc\x19Hen\xbfR\x9f\x16.\xeb\xbd\x01\x1b\x84\x9c\x9cQt-\\\x05\xb84\x03\xfaK#\xf8ͱ\x17²*H\xdf\x06\u007f|ݝ*\x8e\xb4\x9e\xc5\xf3]\xb4X\xa1\x85\xb1\xbcKhK\xcd\n\xb8r\x96\xb6d\xeb\x18\xc062<\xf6d\xfby,O\x88>\x0fp{$^\x1a\xd8\xf2\x8d\x06ʭr*\u007f%Y\xa8u\"Ɠ^[\x1d\x99y\x93\x82\x1a\xcd\xf2\xbf8\xd4\x133\t\x9b\x991\xe8d\xa7\xde\x02K\x87\xbe&wd\x8e,\xe7y\x9f\x84\xf3\xb9\xaaԿ\x96\xa1 `\xc2\xd3t\f\xb47\n\x8fȥ\xc5m\xcc\xe5\xee@\a.\x9f\xf1\x9aP\xf48\x16\xa5\x94/q\xb4(Ҟi\x91\xe2\xf0\"\x9aW\xebP\xbe\xf2'4\x1b\x8f\x1d(g\\\xac\x9fa6O';\xa97\xf2\xa2\xd8'I\xdf\x14\x8d%\xde8\xde\xcb\xf8\x16\xf0\n7\xe4\xe1\xdc\xcb\n\xae\xf1\xf1\x85\xec*\xdcp\xdc1\x8a\xbcغ\x19I՟\xddW0Yh\xb83\xd1\xe1\x81qqXU\xe8\xab\xe9AQ7\x00\xea\xaf\xd8\x1d\x81\x15\x8dlv3\xeaC\x17\x1bk1)\xba\xeb\xf3\xe7Ļw'\uf0ba\xb418\x1a_C\xf0ǟ\xcdh\x15\xdd\xfd\x1cG\x11\xfe\x17\x00\x00\xff\xff\"\xf7\xf4 \x8c\t\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WOoܶ\x13\xbd\xebS\f\xf2;\xe4W \xd2&ȥЭuR h\x1a\x04v\xe2K\xd1\x03\x97\x9c\x95XS$\xcb\x19\xae\xe3~\xfab(i\xffhe;=to\x1a\x0e\x87\x8f\x8f\xef\r\xb9U]ו\x8a\xf6\x16\x13\xd9\xe0[P\xd1\xe27F/_\xd4\xdc\xfdH\x8d\r\x9b\xfd\x9b-\xb2zS\xddYoZ\xb8\xca\xc4a\xb8F\n9i|\x87;\xeb-\xdb\xe0\xab\x01Y\x19Ū\xad\x00\x94\xf7\x81\x95\x84I>\x01t\xf0\x9c\x82s\x98\xea\x0e}s\x97\xb7\xb8\xcd\xd6\x19Le\x85y\xfd\xfd\xeb\xe6m\xf3\xba\x02\xd0\t\xcb\xf4/v@b5\xc4\x16|v\xae\x02\xf0j\xc0\x16L\xb8\xf7.(\x93\xf0\xaf\x8c\xc4\xd4\xec\xd1a\n\x8d\r\x15EԲh\x97B\x8e-\x1c\aƹ\x13\xa0q3\xef\xa62\xd7c\x992\xe2,\xf1\xafk\xa3\x1f\xed\x94\x11]N\xca]\x82(\x83d}\x97\x9dJ\x17\xc3\x15@LH\x98\xf6\xf8\xd5\xdf\xf9p\xef\u007f\xb1\xe8\f\xb5\xb0S\x8e\xb0\x02 \x1d\"\xb6\xf0IPF\xa5\xd1T\x00{\xe5\xac)T\x8c\xb8CD\xff\xd3\xe7\x0f\xb7oot\x8f\x83\x1a\x83\x00\x06I'\x1bK\xde\x127X\x02\x05\x13\n\xe0p\x00\x06ʃJlwJ3\xecR\x18`\xab\xf4]\x8eSM\x80\xb0\xfd\x135\x03qH\xaa\xc3W@Y\xf7\xa0\xa4ژ\b.t\xb0\xb3\x0e\x9biJL!bb;\xb3,\xbf\x13}\x1db\v\xc0/eGc\x0e\x18Q\x14\x12p\x8f0\xe9\x02\rP\xd9-\x84\x1dpo\t\x12\x16*\xfd\xa8\xb1\x93\xb2 )\xcaO\xc8\x1b\xb8\x11\xba\x13\x01\xf5!;#2\xdccbH\xa8C\xe7\xed߇\xca$\xbcȒN\xf1,\x84\xf9g=c\xf2\xca\xc9Yd|\x05\xca\x1b\x18\xd4\x03$,\xecd\u007fR\xad\xa4P\x03\xbf\x85\x84`\xfd.\xb4\xd03Gj7\x9b\xce\xf2\xec(\x1d\x86!{\xcb\x0f\x9b\xe2\v\xbb\xcd\x1c\x12m\f\xee\xd1m\xc8v\xb5J\xba\xb7\x8c\x9as\u008d\x8a\xb6.\xc0}1T3\x98\xff\xa5\xc9~\xf4\xf2\x04)?\x88z\x88\x93\xf5\xdd!\\t\xfe(\xef\xa2\xf3Q\x1e\xe3\xb4\x11\xff\x91^\t\t+\xd7\xefo\xbe\xc0\xbch9\x82s\xceG\x9d\x1c\xa6ёx!\xca\xfa\x1d\xa6\xf1\xe0\x8aʤ\"z\x13\x83\xf5\\>\xb4\xb3\xe8\xcfI\xa7\xbc\x1d,\xd3,[9\x9f\x06\xaeJ_\x81-B\x8eF1\x9a\x06>x\xb8R\x03\xba+E\xf8\x9f\xd3.\fS-\x94>O\xfci;<O\x1c\xd9:\x84\xe7~\xb5zB\v+\xdfD\xd4r^B\x9a̳;\xab\x8b\x05`\x17\x12\xa8\xa3\xb3'ښ\x93\xbak\xde,\xa0T\xea\x90\xcfc\v\x14_J\x8a,|߫\xf3\x16\xf2\u007fl\xbaF\xfa\x00M\x10\xc6\xce\xf0C\xb3\xa8\xf7\xd8\xeak\x1a]\xc50KU\xb6.<\x8aѥ\xf5\x9c\xa2Y.*?\xf4yX+^\xc3\xcf\x05\xe9\xc7\xd0=1z\x15<\x8b\xa0\x9fH\xb9\r.\x0fx\xe3U\xa4><\x999_\x9a\x87\x8bd\x99v\x8d\xd2j\xf11H\xd3\xf05Rv\xab\v\xad\nq\xfe\x95\x8b\xf39\x96\xe5\xee\x99Y\x96\tc\xc7E\x90\v;yd\xa4c\x1b\xb8\xb7\xdc\xc3}ou\xbfR\x15ʴr@\xd2_\x88\x82\xb6ű\xff\x0e\xb6\xe8\xd8&\xbc\x90G]Ds\x11\x14\xc8\xd5Z\xf1\x85\xe7\xd6\vד\x17\x9eu,+\xce\xf4ݞ-\xd93\xa9:\xa7\x84\x9e\xa7\x1a\xe5\xb6ZN\xf8\x1e\xd3Ί\xffz\xfd\xf1I\xe7\xbe;\xe6\x957\x98\xb2~\xc4\x11\x13\xd6d;\xb9[eL\xbc[\x9c\xb5$`\xfc\x9d\xde\xf1Ϟ\x1a~\x8b6\x9d<Y\x1e\x81\xf6\xfe\x9066\x16\xf4\xe3\x15\xb1|\xbd\x94rH\xe5\xda\xd5\xca_`\xdb\"\x18t\xc8h`\xfb0v\xc6\ab\x1c\x96xw!\r\x8a[\x90\x8b\xa3f{!\x14y_\xaa\xad\xc3\x168\xe5u\x15\xadl6\xf6\x8a.lu\xb6\xcfϒ\xb1v\xfc\as=q\xfe\xf0H\a\xab\xe1\x13\xde_\xc4>\xa7\xa0\x91\b\x97\xc6x\x04\xfd\x8a\xb8\x17\xa1\xe3\xc3\xfc\xcd\xf1\xabH\xb1\x9e\x1e\xe2e\x00\xa0<k\xcd\tuӛq\x8a\x1c\x1d\xa3\xb4\xc6\xc8h>-\x9f\xe2/^\x9c\xbd\xad˧\x0e\xde\xd8\xf1_\x04\xfc\xfeG5VEs;\xe3\x90\xe0?\x01\x00\x00\xff\xff\x045\xfb\x0f\xc4\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y\xdfs۸\xf1\u007f\xd7_\xb1\x93{\xf0\xf7fB\xea\x92\xfbN\xa7\xa3\xb7\x8b\xddt\xdc\xde9\x9eȗ\x97L\x1eV\xc4JD\r\x02(\x16\x94\xa2v\xfa\xbfw\x16 %Q\xa2e\xf9\xdaK\xf9b\x13\\,\xf6\xf7~\x16\x9a\x14E1A\xaf?Q`\xed\xec\f\xd0k\xfa\x1a\xc9\xca\x1b\x97\x8f\u007f\xe4R\xbb\xe9\xfa͂\"\xbe\x99<j\xabfp\xddrt\xcdGb׆\x8anh\xa9\xad\x8e\xda\xd9IC\x11\x15F\x9cM\x00\xd0Z\x17Q\x96Y^\x01*gcp\xc6P(Vd\xcb\xc7vA\x8bV\x1bE!\x9dП\xbf\xfe\xa1\xfc\xb1\xfca\x02P\x05J\xdb\x1ftC\x1c\xb1\xf13\xb0\xad1\x13\x00\x8b\r\xcd\xc0;\xb5v\xa6mh\x81\xd5c\xeb\xb9\\\x93\xa1\xe0J\xed&쩒CW\xc1\xb5~\x06\xfb\x0fyo'PV\xe6ީO\x89ͻ\xc4&}1\x9a\xe3_Ǿ\xfe\xac9&\noڀ\xe6T\x88\xf4\x91\xb5]\xb5\x06\xc3\xc9\xe7\t\x80\x0f\xc4\x14\xd6\xf4\xab}\xb4nc\xdfk2\x8ag\xb0D\xc34\x01\xe0\xcay\x9a\xc1\x9dH\xe9\xb1\"5\x01X\xa3\xd1*\x99\"\xcb\xed<ٟ\xeeo?\xfd8\xafjj0/\ng\xe7)Dݫ'ρcwk\x00\x8a\xb8\n\xda'\x8ep%\xac2\r(q%1Ě\xa0s\b)\xe0t\f\xb8%\xc4Z3\x04J:\xd8\xec\xdc\x03\xb6 $h\xc1-\xfeFU,a.z\x06\x06\xae]k\x94\xf8\u007fM!B\xa0ʭ\xac\xfeǎ3Ct\xe9H\x83\x91:\xfb\xf6\x8f\xb6\x91\x82E#Fh\xe95\xa0U\xd0\xe0\x16\x02\xc9\x19\xd0\xda\x03n\x89\x84K\xf8\xc5\x05\x02m\x97n\x06u\x8c\x9eg\xd3\xe9J\xc7>\x94+\xd74\xad\xd5q;M\x01\xa9\x17mt\x81\xa7\x8a\xd6d\xa6\xacW\x05\x86\xaa֑\xaa\xd8\x06\x9a\xa2\xd7E\x12ܦH.\x1b\xf5]\xe8➯\x0e$\x8d[q\x1bǠ\xedj\xb7\x9c\x02\xecI\xbbK\x80\x81f\xc0n[\x96\u007fo^Y\x12\xab|\xfc\xd3\xfc\x01\xfaC\x93\v\x866O\xd6\xdeo\xe3\xbd\xe1\xc5P\xda.)d\xc7-\x83k\x12G\xb2\xca;mcz\xa9\x8c&;4:\xb7\x8bFG\xf1\xf4\xdf[\xe2(\xfe)\xe1:%4,\bZ\xaf0\x92*\xe1\xd6\xc256d\xae\x91\xe9w7\xbbX\x98\v1\xe9\xf3\x86?\xacCC\xc2l\xad\xddr_(F=t\x94\xfbsO\x95\xf8K\x8c&\xfb\xf4RW)\x05`\xe9\x02\xe01yy\xc0v,5\xe5\xc9Ua\x1e]\xc0\x15\xfd쪃$\u007fB\xa6wc;z\xa9\xa4\xb6\xe54\xa5\x8e5p\xa6<b\t`\xfa\xad\x9b\x9a\x02\xa5\x1d\x818\xeaJ\x02ɱ\x8e.l\x85\xad\xec'U\x1e\xed\x1f5\xba<\xd6):+\xff\x9dS4&\xael\x84Xc\x8e\xc9{\x972#\xb4\xd6J\x168{\xb1\x00ީ\xb3\xe7w\x9c\x11\x02-)\x90\x95\x8c\xca\xc5ǻT\xa2\"j\xdbg^.\xdd\x10݉\xf9\x16\xd9\xc0\xa4`\xe8\xe8sΆ'\xeb\xf1\xa8\xa4?\xdd\xdf\xf65\xb87R's<>\xf1\xacE\xe4YJ\x97\xb9\xc7X?{\xea\xd5\xed2\x1f\x93*Rt\x80\xe05U4(\xed\xa0-GB\x95\x17GX\x02H\xe2\x06\xea\xe8_\xe7\xfaӕ\xb9};\x10[\x03\xe6\xfe\x06\u007f\x99\u007f\xb8\x9b\xfe\xd9eYGybU\x11\v\x1b\x8cԐ\x8d\xaf\x81۪\x06dQA\aRs\xf9R6h\xf5\x928\x96\xdd\t\x14\xf8\xf3\xdb/c6\x03x\xef\x02\xd0Wl\xbc\xa1נ\xb3\x95w\x05\xb5\x0f\x10\xcd\xd9\x10;~\xb0ѱ\xd6㊣\x04R\xa7\xf0&)\x1a\xf1\x91\xc0u\x8a\xb6\x04F?\xd2\f^I\t9\x10\xf1\x9f\x92\r\xffz5\xca\xf3\xffr\x92\xbe\x12\x92WY\xb0]\xcf<L\xa2\xbd\x809\x93\x82^\xad(и5S#\x90\x02\xfb=\xb8 \xba[w\xc0 \xb1\x15\x9f\xe5BG\xeaD\xe0\xcfo\xbf<!\xed\xd0N\xa0\xad\xa2\xaf\xf0\x16\xb4\xcdV\xf1N}_\xc2C\x8a\x88\xad\x8d\xf8UΩj\xc7d\xc1Y\xb3\x1d\x97\xd6A\x8dk\x02v\r\xc1\x86\x8c)2VQ\xb0\xc1\xad\xe8\u07fbK\"\f\xc1c\x88C42\xca\xf5\xe1\xc3͇Y\x96JBh\x95*\xa9t\xb9\xa5\x16\xcc!`#wN\x89\xc9d\x8e6\aGtP\xd5hG\n+$В\xac\xbbl\xa5\x97\x95W/\xcd\xd6c\xd8\xd0?#\xf0\xe1\xb80\xfc\x8f\x9a\xf0Ej%\xd4\xfe\xacZw\a\xf1|V-\x99\x1f\x82\xa5HI3\xe5*\x16\xa5*\xf2\x91\xa7nMa\xadi3ݸ\xf0\xa8\xed\xaa\x90@,r$\xf04\x8d\x00\xd3\xefҟߤEB早\x92H\xbf\x85>r\x0eO_\xacN\x8f+/\xedJW\xf3\x0e\xf9\x1c\uf514\xd8Ժ\xaa\xfb!a_=Gs\xa4A\x95K.\xda\xed\xef\x1e\xb6b\xc86\x88<ۢ\x1bC\v\xb4J\xfeg\xcdQ\xd6_l\xb9V_\x90\xa4\xbf\xde\xde|\x9b`n\xf5\x8b3r\x14\x10\xe7\x98\xf0\xeeV\x89\xf9\x96\x9a\xc2Y8\xf5q@\xda\x03\xbb\x11$\xb9\xa3\xb9\x18\xc9E\\\x9d\x00(T*]4\xa0\xb9?\x03\xb2\xce\xe8<\x10\xfe\x01W\f\x18\b\x10\x1a\xf4\xe2\xa7G\xda\x16\xb9I{\xd4\xd2c\xa5\x8dvxeA\x80\xde\x1b=\xd2N\xbbV\xdc\xc1\xc5\x0ey\xcbX\x8b+\x1e\xd7w\xc4\xeay\xf7Yk\xe7\xf1b\f>wGg\\\xb2\x83\xd0\xd1\xed\x81\xeai\xfc\x9e\x00\xd7'\xec&S\xa0\xa0\xabCъ\xf1\xd1e@!\x90~\xb0\xe0\x9d\x1a\xbc\x0f\xe3l\xf0)\xeb\xf3\xec\xf4\x161\xb6|\xf1\xfc\x96\xa8{\xeb\xe5z\x10;\x1e\t+\xfc\x96\t\xaer\x82\x1d\x87\xd7T\xe7\\x}J\x9f.D\x82\xcabE\xddH<v1\xb4A\xeeO8\x1d\xc2\xe0\x80Yޗ\xea\xae\xf0\"\x95\xa0\x9d\xa0\xce%jC\n\xfa;\xb2\xe3='<\x0fy,h)\xa5\xaa\xf5ơꇢN\xb4\xfe\x92\xe7A\xa6\xe1t\xdfp\xc5Orl\x99T\x9a\x92G\xd4?n\x0fK\x17\x1a\x8c3P\x18\xa9\x18ah[cpah\x061\xb4\xc7\x1f\x9fL\xfd\x86\x98qu>\xbd~\xc94y>\xec6\x00.\\\x1bw\x03\xe2 ů\xb8\x8b\x9e˧ӑ\x11l\x18\xb2(\x80\x99;\xf8hL\xdaq\x98\xd6\xfbK\xd4$ς\xc4-\xffi\x86\x03\xf8\x1a\xf9\xbcq\xee\x85b,yv5\xe8L\xf6@\x9a\b\xdb\xe6\xf8\x84\x02\xeehs\xb2vk\xef\x83[\x05\xe2\xe3\xd0(\xfa\xf89Q\xb6\x80\xf7)\xce/ַ;\xe0\xbc\xca\x1d\x11\xd4\xce\xf4\xe9\xe9\"\x1a\xb0m\xb3\xa0 z/\xb6\x91xX\x84Og\xfe4E\xec\x8dv\xb0\xbb\xbfB\xc8|\xba\xa1\xa8B\x9bn\xd9$g\xa2\x03\xa5\xd9\x1b<\x9d\x8az\x15\x12\x92\x90\x94\x91\x94\xdeGk\x9f\xa6\x9eB\xfa\xf4\x92[\x8a$͍\xb3\xa3\x18\xb7\xcfOm\xe3\x1f\xfe\xffIġm\xa4ՠ\xa8w_ŀ\xef\x84\xff\u007f\x9b\xf7\x93\x8d\x95-z\xae]\xbc\xbd9\xeb\xed\xf9\x8e\xac\x8f\xf2=hI\xb5+\xdd\xfbuD\xbdˇ--?9\f.N=\x8e\x18\xe2e\xcdc> }\xa6o$\xbe\xa4J\x98\x93ǀ\xf140\xd3}\xf0\xf5\xf1\xaf,\xaf\x81u\xba\x16\x13\xec\x93\xc1P\x1euYډ@;\x17r\xac\x9er\x1c4\x82A\xe1\x1f\x8a\xfe-j\xfeH<\x1c-\xed\u007frz\xb3\u007fKqYt?1\xa5\x0f\x9dZ\xea\xe0\xf0\xeeV\xb5[\xd9\xc3\x10\xac\x04\xb2\x93\xba;\xfe\x91\xe9U\xbe*\xe9\u007f5J\xaf\x95\xb3\x19\xcd\xf2\f>\u007f\x99@w\xd7\xfa\xa9\x97C\x16\xff\x1d\x00\x00\xff\xff\x81\x16-\x05\x9e\x1b\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_s۸\x11\u007fק\xd8\xf1=\xb87\x13R\x97\\\xa7\xd3\xd1\u06dd\xddt\xdc\xde9\x9eȗ\x97L\x1e b)\xa2&\x01\x16\xbb\x90\xacv\xfa\xdd;\v\x90\x92(Q\xb2\x9c\xe9\xa5zI\b,\x16\xbf\xfd\xed\x1f,\xe0I\x96e\x13՚O\xe8\xc98;\x03\xd5\x1a|f\xb4\xf2E\xf9ӟ)7n\xbaz\xbb@Vo'O\xc6\xea\x19\xdc\x04b\xd7|Dr\xc1\x17x\x8b\xa5\xb1\x86\x8d\xb3\x93\x06Yi\xc5j6\x01P\xd6:V2L\xf2\tP8\xcb\xde\xd55\xfal\x896\u007f\n\v\\\x04Sk\xf4q\x87~\xff\xd5\x0f\xf9\x8f\xf9\x0f\x13\x80\xc2c\\\xfeh\x1a$VM;\x03\x1b\xeaz\x02`U\x833h\x9d^\xb9:4\xe8\x91\xd8y\xa4|\x855z\x97\x1b7\xa1\x16\v\xd9u\xe9]hg\xb0\x9bH\x8b;Dɚ\a\xa7?E=\x1f\x93\x9e8U\x1b⿏N\xffb\x88\xa3H[\a\xaf\xea\x11\x1cq\x96\x8c]\x86Z\xf9\xe3\xf9\t@\xeb\x91Я\xf07\xfbd\xddھ7Xk\x9aA\xa9j\x92i*\\\x8b3\xb8\x17\xa4\xad*PO\x00V\xaa6:\U00091c3b\x16\xedO\x0fw\x9f~\x9c\x17\x156*\r\x8afעgӛ(\xbf=\xefn\xc7\x004R\xe1M\x1b5µ\xa8J2\xa0şH\xc0\x15B\xe7\x15\xd4@q\x1bp%pe\b<F\x1bl\xf2\xf0\x9eZ\x10\x11e\xc1-\xfe\x81\x05\xe70\x17;=\x01U.\xd4Z\x82`\x85\x9e\xc1c\xe1\x96\xd6\xfck\xab\x99\x80]ܲV\x8c\x1d\xc3\xfd\xcfXFoU-$\x04|\x03\xcajh\xd4\x06<\xca\x1e\x10잶(B9\xfc\xea<\x82\xb1\xa5\x9bA\xc5\xdc\xd2l:]\x1a\xee\xe3\xb9pM\x13\xac\xe1\xcd4F\xa5Y\x04v\x9e\xa6\x1aWXO\xc9,3\xe5\x8b\xca0\x16\x1c<NUk\xb2\b\xdc\xc6p\xce\x1b\xfd\x9d\uf09f\xae\xf7\x90\xf2F\xdcF\xec\x8d]n\x87c\x90\x9d\xe4]b\f\f\x81\xea\x96%\xfc;zeHX\xf9\xf8\x97\xf9#\xf4\x9bF\x17\f9\x8fl\xef\x96юx!\xca\xd8\x12}r\\\xe9]\x135\xa2խ3\x96\xe3GQ\x1b\xb4C\xd2),\x1a\xc3\xe2\xe9\u007f\x06$\x16\xff\xe4p\x13\xb3\x1a\x16\b\xa1ՊQ\xe7pg\xe1F5X\xdf(\xc2ߝva\x982\xa1\xf4e\xe2\xf7\x8b\xd1P0\xb1\xb5\x1d\xee\x8bŨ\x87\x0e\xd3\u007f\xdeb!\x0e\x13\xd6d\xa1)M\x11s\x00J\xe7A\x1d\xc9\xe7{\x8aǒS~\vU<\x85v\xceΫ%\xfe⊽4?\x81\xea\xe7\xb1\x15=,\xa9p)Q\xb1S\r\x94$\x0fT\x02\xd4\xfd\xd2u\x85\x1e\xe3\n\xa9R\xa6\x90Prd\xd8\xf9\x8d\xa8\x8d\xa6\xe8\xfc`\xfd(\xed\xd1P\xa7\xcf\xc2\u007fp]\xd0{,ѣ\x95\x90N\xd9ߺX#X\x19ۇ~*\x9e\xc0\xee\b\xfd\"\xa1\x1d\x83v\x8aj8Y\x0fG\x81\xfe\xf4p\xd7\xd7\xc0\x9e\xd1\x0e2\x1f\xeex\x96\x10\xf9\x95R\xe5\x1f\x14W/\xeez}W\xa6mbE`\a\nZ\x83\x05\x0eJ+\x18K\x8cJ\xa7\xc1\x11\x95\x00\x928\x1e;\xf97)\xff\xbb2\xb3+\xc7B5\xa8t\xbe\xc0\xdf\xe6\x1f\xee\xa7\u007fu\t\xeb\xa8NU\x14H\xa2F16h\xf9\rP(*P$&\x18\x8fz.3y\xa3\xac)\x918\xefv@O\x9f\xdf}\x19\xe3\f\xe0\xbd\xf3\x80Ϫik|\x03&\xb1\xbc-h}|\x18JDl\xf5\xc1\xdape\xc6\rW\x12G\x9d\xc1\xebh(\xab'\x04\xd7\x19\x1a\x10j\xf3\x843\xb8\x92\fރ\xf8oI\x9d\xff\\\x8d\xea\xfcCJ\x91+\x11\xb9J\xc0\xb6g\xd6~\xc6\xed\x00r\xa5\x18؛\xe5\x12=\x8e\xb3\x19\v\xb1\x14\xb8\xef\xc1y\xb1ݺ=\x05Q\xad\xf8,\xd5\x19\xd4G\x80?\xbf\xfbr\x02\xed\x90'0V\xe33\xbc\x03c\x13+\xad\xd3\xdf\xe7\xf0\x18#bcY=\xcb>E\xe5\b-8[o\xc6\xd1:\xa8\xd4\n\x81\\\x83\xb0ƺ\xceR\xaf\xa0a\xad6b\u007f\xef.\x890\x05\xad\xf2<\xec\x06F\xb5>~\xb8\xfd0K\xa8$\x84\x96\xb1\x8e\xc9)S\x1a9\xf3\xe5\xb0O'\x97\xc4d\xa4#\xa4\xe0`\aE\xa5\xecHY\x83\xd84Dv\xcb gI~\xfd\xdal=<\xb6\xfb\xdf\xc8\xf1}X\x18\xfeO\x87\xe0Ef\xc5\xd6\xf9E\xb3\xee\xf7\xe2\xf9\xacY\xd2\xc4{\x8b\x8c\xd12\xed\n\x12\xa3\nl\x99\xa6n\x85~ep=];\xffd\xec2\x93@\xccR$\xd04\xb6\xe1\xd3\xef\xe2?_eE\xec\x8c/3%\x8a~\v{d\x1f\x9a\xbeڜ\xbe\xaf\xbb\xf4T\xba\x9ew\x8d\xc7\xe1JI\x89ue\x8a\xaao\xd2w\xd5s4G\x1a\xa5S\xc9Uv\U000fb1ed\x10\x19\xbc\xe0\xd9d\xdd]0SV\xcb\xff\xc9\x10\xcb\xf8\xab\x99\v\xe6\x82$\xfd\xed\xee\xf6\xdb\x04s0\xaf\xce\xc8ц4\xc5D\xeb\xee\xb4\xd0W\x1a\xf4g\xbb\xa9\x8f\x03Ѿ\v\x1c\xe9\xe3\xb62\x177rdUK\x95\xe3\xbb۳\b\xe6[\xb1~\xf7\x1d\xe5]\xfb\xd6k\x92\x10=ӷ\x9dD\x92ԜE\x91\xfa\xee\xb1.\xb8Ð:\x868\"\x1d\xe8W!\x91됴9\xfbH\xb2\xf1\x0e~ \xd1:=\xf8\x1e\xfaw0\xb5#}0\x9c\x8cx\xf12Ê\x03]~\x9d\x89\xe2=g)?\xb9S\x12\xcf\uebfa\xd0\x14N\x9a\xb9\xe1\xe3\xcd9\xcf\xdd\x1c\xcb\xc7\x17\x02\xaf\x13.6\r\xc6\xdbBD\x00kE\xfd\x16\xc7~\x83=mia\xac\x84\xa2\ful\xb6\xa4\x0f,\x95\xa9Q\xc3\xf6\xe9\b\x1e\xe5>\x17\xaf\xcc\xd7ǵ\xb2W\x13\bu\xbc\xe7\x8d\x00>\\U:\xdf(\x9e\x81\\\x933Qp0oC]\xabE\x8d3`\x1f\x0e'O\xa6A\x83Djy>\x0f~M2\xe9\x86\xd5-\x00\xb5p\x81\xb7W\xac.!:\xf3\xaf\xa9\xf3\xf8\xe5\x17\xbcJ\xd1y\x10\x0f\"1\x16Wۤ<\x17X\x10o/\xa19\xdc\"\x83{\\\x1f\x8d\xdd\xd9\a\xef\x96\x1e\xe9\xd0\aY﨣\xf6;\x83\xf71\x02.6\xb8\xdb\xe0\xbc͝\x10T\xae\xee#ױ\xaa\xc1\x86f\x81^\f_l\x18\xa9g\xa0O\xf4\xe3\x1bj\xecyw\xbc\xed\xd6\xf7\xd5*)\xea:\xf8B\xd9\xf8$#\xd1\xc9\x0e\xb4\xa1\xb6V\xc7-|oC<\xf6$8%Cvq\xd1g\x97\xa4t\x9c{͝:¹uv\xb4#\xebS\xc1X\xfe\xd3\x1fO\x9e\x8f\xc62.\a\xa5\xb0\x9b\x15\n\u007f\x16\xfd\xffk\xdd'\x0f_b\xe5\xf9\xb2\xd25\x1f\x88\xbeT\xb5\xa2ⱚ\xb5_~\x8e\xcb\xcdp\x93oQiF\xa89\x18\xda=ؿ\xdd}E\x17e\xdd\x03}\x9c\x80d\x96\xdeۼ{\x8c\xeaFv\a\x96*\xa4\xd7B}\u007f\xf8B\u007fu5xp\x8f\x9f\x85\xb3ڤ\xbf.\xc0\xe7/\x13螨>\xf58d\xf0\xbf\x01\x00\x00\xff\xff\x98\xaaEc\xdc\x18\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4W\xcdn\xe36\x10\xbe\xfb)\x06\xdb\xc3^*y\x83\xbd\x14\xba\xb5i\x17\b\x9a\x04\vg\x9bK\xd1\x03E\x8d\xeci(\x92\xe5\f\x9d\xbaO_\x90\x92lٖ\xbd\xc1\x02\xab\x1b\x87Ùo\xbe\xf9!\xb5(\x8ab\xa1<=c`r\xb6\x02\xe5\t\xff\x15\xb4i\xc5\xe5\xcbO\\\x92[noj\x14u\xb3x!\xdbTp\x1bY\\\xb7Bv1h\xfc\x15[\xb2$\xe4\xec\xa2CQ\x8d\x12U-\x00\x94\xb5NT\x12sZ\x02hg%8c0\x14k\xb4\xe5K\xac\xb1\x8ed\x1a\f\xd9\xc3\xe8\u007f\xfb\xa1\xfcX~X\x00\xe8\x80\xf9\xf8\x17\xea\x90Eu\xbe\x02\x1b\x8dY\x00X\xd5a\x05\x01YH\a\xf4\x8eI\\ \xe4r\x8b\x06\x83+\xc9-أNn\xd7\xc1E_\xc1a\xa3?=@\xea\xc3YeC\xab\xd1\xd0.o\x19b\xf9}v\xfb\x9eX\xb2\x8a71(3\a$o3\xd9u4*\x9c)$\a> c\xd8\xe2\x1f\xf6źW\xfb\x89\xd04\\A\xab\f\xe3\x02\x80\xb5\xf3X\xc1c\x82\xea\x95\xc6f\x01\xb0U\x86\x9a\xccH\x0f\xdey\xb4?\u007f\xbe{\xfe\xf8\xa47ة^\x98,;\x8fAh\x8c1}\x93\xfc\xeee\x00\r\xb2\x0e\xe4\xb3Ex\x9fL\xf5:Ф\x8c\"\x83l\x10\x86\xbc`\x03\x9c݀kA6\xc4\x100\xc7`\xfb\x1cO\xccBRQ\x16\\\xfd7j)\xe1)\xc5\x19\x18x\xe3\xa2iR\x19l1\b\x04\xd4nm\u9ffde\x06q٥Q\x82\x03\xc5\xe3GV0Xe\x12\t\x11\u007f\x04e\x1b\xe8\xd4\x0e\x02&\x1f\x10\xed\xc4ZV\xe1\x12\x1e\\@ ۺ\n6\"\x9e\xab\xe5rM2V\xb4v]\x17-\xc9n\x99\xeb\x92\xea(.\xf0\xb2\xc1-\x9a%ӺPAoHPK\f\xb8T\x9e\x8a\f\xdc\xe6\x82.\xbb\xe6\x870\x94?\xbf\x9f \x95]J\x1bK \xbbދs\x95]\xe4=\x15\x19\x10\x83\x1a\x8e\xf5\xf8\x0f\xf4&Qbe\xf5\xdb\xd3\x17\x18\x9d\xe6\x14\x1cs\x9e\xd9>\x1c\xe3\x03\xf1\x89(\xb2-\x86>qmp]\xb6\x88\xb6\xf1\x8e\xac\xe4\x856\x84\xf6\x98t\x8euG\x922\xfdOD\x96\x94\x9f\x12ns_C\x8d\x10}\xa3\x04\x9b\x12\xee,ܪ\x0eͭb\xfc\xee\xb4'\x86\xb9H\x94~\x9d\xf8\xe98:V\xec\xd9ڋ\xc7i1\x9b\xa1\xd3\xfe\u007f\xf2\xa8S\xc2\x12k\xe9 \xb5\xa4s\x0f@\xeb\x02\xa83\xfdrbx\xae9\xd3W+\xfd\x12\xfd\x93\xb8\xa0\xd6x\xef\xf4\xa4\xcd/\xa0\xfae\xee\xc4\b+\x8d\xb8\xbeQq^\xf1\xc42\x80l\x94L:T\x14\xd9}\x9b\xcf\xc4q\x91\xf2L\xbbJ\xedj\x95\xd5\xf8)\u05ceջ\xab\xb1<\xcc\x1cH\xa1l\xdc+\xb8V\xd0NM\x8e(k<\v\"D\xfbf\x90\xfdL\xbekRi\xb5\x84\xe1*\xc0Չ\xf2\xc8s\x1b\x8d\x19,\x15\xdau^\t\xd5\x06\xc7Fn]8\x83H\xbd\x8d]\xdf\xd5\xdf\xc6\xef֙\xd8\xe1\xfen\xb8\x8a\xfc\xf9XwZ \xbd`\x00\x91B\x80p|\x05N\xbf\xa1&\x18\xbck\x06\x00C\xd1r\x8a\xf3\x8d\xd8Sr)\xe0\xd14,\xe6\x8b\xffHc\xae\xa2\x8e\x14N\xb3y\xb4y\xc2\xd7W\x87\x81(\x89\xfc\xf6q\x90\xd5Gbu\f\x01\xad\fF\xf2M\xf8M\x03\xc1(\x96I[\xa47\xd0\xd5<ߟ돐\x92)\x90$\x98vѫ\xe2\xb9~i]\xe8\x94T\x90F{\x91\x0e\x9d\xec\xa7\x17\x98\xaa\rV !\x9en^\x9e\bȬ\xd6\xd7#x\xe8u\xfa\xabp8\x00\xaavQ.\x10\x9b/\xc5+\xd4^E\xe47\x8a\xaf\xe3\xf9\x9c4\xe6Ҋou\x8e6v\xa7.\nx\xc4\xd73\xd9\nUs\xdas\x05<:\x99۸\x10\xd3L-\x9f\x88\x0eO\xec\x9b\xc3*\xd7]1<\xa9\xf3\x06@~\x996\x93\x14sߛ\x83\xe4\xd0 Jk\xf4\x82\xcd\xe3\xe9\x93\xfaݻ\xa3\x17r^jg\x1b\xea\xff\a\xe0Ͽ\x16\xbdUl\x9eG\x1cI\xf8\u007f\x00\x00\x00\xff\xfflC\xbf\xee\x8e\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}mo\xe46\x92\xf0\xf7\xfc\n\xc2\x1b\xc0\xf6\xad\xbb=\xb3{\xb7\xb8\x1b\x1c\x10xg<Yc3\x1ec\xec\x9d<\x8bl.`K\xd5\xdd<K\xa4\x96\xa4\xda\xee\xbb\xdc\u007f\u007f\xc0\"\xa9\x97nu[\xa4l\x8f\x93\x88\a\xdc\xc6=R\x89,\x16뽊\xb4`\x9fA*&\xf8\x1bB\v\x06\xf7\x1a\xb8\xf9KMo\xff]M\x998]\xbd\x9e\x81\xa6\xaf\xbf\xbae<}CޖJ\x8b\xfc\x13(Q\xca\x04\xde\xc1\x9cq\xa6\x99\xe0_\xe5\xa0iJ5}\xf3\x15!\x94s\xa1\xa9\xf9Y\x99?\tI\x04\xd7Rd\x19\xc8\xc9\x02\xf8\xf4\xb6\x9c\xc1\xacdY\n\x12\xbf\u0fffz5\xfd\xe3\xf4\xd5W\x84$\x12\xf0\xf5\x1b\x96\x83\xd24/\xde\x10^f\xd9W\x84p\x9a\xc3\x1b\"Ai!AMW\x90\x81\x14S&\xbeR\x05$\xe6c\v)\xca\xe2\r\xa9\xff\xc1\xbe\xe3&b\x17\xf1ɾ\x8e\xbfdL\xe9\xbf6\u007f\xfd\x8e)\x8d\xffRd\xa5\xa4Y\xfd1\xfcQ1\xbe(3*\xab\x9f\xbf\"\xa4\x90\xa0@\xae\xe0o\xfc\x96\x8b;\xfe\x9eA\x96\xaa7dN3e\xfeY%\xa2\x807\xe4\xd2̢\xa0\t\xa4_\x11\xb2\xa2\x19Kq\x89v^\xa2\x00~vu\xf1\xf9\x8f\xd7\xc9\x12rj\u007f$$\x05\x95HV\xe0s~~\x84)B\xc9g\\\x9f\x99\x04n\x04\xd1K\xaa\x89\x04\x9c\n\u05ca\xe8%\x10Z\x14\x19K\xf0+D\xcc\x1dHR\xbd\xa3\xc8\\\x8a\xbc\x865\xa3\xc9mY\x10-\b%\x9a\xca\x05h\xf2\xd7r\x06\x92\x83\x06E\x92\xacT\x1a\xe4ԁ)\xa4(@j\xe6\x11kF\x83\x94\xaa\xdf6\xd6ph\x16i\x9f!\xa9!\x1e\xb0Su$\x00)Q\x88\x00\"\xe6D/\x99\xaa\x97\x84\xcbh\x80%\xe6\x11ʉ\x98\xfd7$zJ\xae\xcd\x0eHE\xd4R\x94Yj(n\x05Ҡ$\x11\v\xce\xfe\xa7\x82\xac\xcc\x02\xcd'3\xaa\xc1\xed\xb4\x1f\x8ck\x90\x9cff{J8!\x94\xa7$\xa7k\"\xc1|\x83\x94\xbc\x01\r\x1fQS\xf2\x01\xb7\x84\xcf\xc5\x1b\xb2ԺPoNO\x17L\xfbÓ\x88</9\xd3\xebS<\x02lVj!\xd5i\n+\xc8N\x15[L\xa8L\x96LC\xa2K\t\xa7\xb4`\x13\x9c8ǳ3\xcd\xd3\xdfU\x9buؘ\xa9^\x1b\x82RZ2\xbe\xa8~F\xd2މwC\xe2\x96r\xeckv\xfe5z\xcdO\x06+\x9fίo\x9aT\xc5T\x1b\xe7\x88\xed\x06\xa1Ո7\x88b|\x0e\xd2n\x1cҖ\x81\b<-\x04\xe3\x1a\xffH2\x06\xbc\x8dtU\xcer\xa6\xcdN\xff\xb3\x04eHWL\xc9[d!d\x06\xa4,R\xaa!\x9d\x92\vN\xde\xd2\x1c\xb2\xb7T\xc1\x93\xa3\xdd`XM\fJ\x1fF|\x93\xf3\xb5\x1f\xb4ت~\xf6,\xaas\x87\xdc\xe9\xbe. i\x9d\f\xf3\x12\x9b\xfbc<\x17\xb2u\xf8\xcd+\xd3\x06Ȯci\x86=ۆ\x05\xb5\u007fߘğ\xab\xc7\f\xad\x98ϗ\x9c\xfd\xb3\x04d\xa1\xf6L\xc26\xbb\x90\rv\xda\x1c\x86\x04\xa6\x1b\xbfvb\xd0\f\xb8O\xb22\x85\xb4b\x93j\xefLϷ\x1eG!C\x1974n\x98\xba\x99.\xaf\xff\x15\x19$혥\xa13\xc6-4\xc28.\xb1\x03\xb3f0\r\xf9ִ\xf6\xac\x89\xa0Ԣ\xb3\f\xde\x10-\xcb\xcdo\xdb\xf7\xa8\x94t݉\n/e\xfba\xa2z\xda\x1d\xf3\x8c%\xb8e\xd5aFd\xfc\x92\xf0\xb0\x14\xe2v\xff\xda\xffb\x9e\xa8\xb9\x11IP;!3X\xd2\x15\x13ҭ։\x84\x19\x10\xb8\x87\xa4\xd4(\x817\xa0\x96\xc8\x14\x85$\x85Pz\u05faw\x9d.Ҕ\xaa\xdb\xff\xb4\x13a[\xebqL\xc0o\xa5Y^\x8b!\b\x0ef\x8e\xb9a~\xf5\xb3R\x94\xf6Y\xd5\xf9\x05\xb2\v\vdF\x15\xa4D\xb8\xbd.3P\xeeK)2\x9a\xfa\xf4\x9c\xec\x00\\-\xda\xcaʌ\xce #\n2H\xb4\x90\x9b\xd8{\x18\x87v<\xcc\tv`\xaf\x83'8\xee\xe9xi\x93\x1d\x88\x9d0\t\xb9[\xb2diŘ\xa1A\x84BR\x01\n\x0f\x89Q\xab\xd6\u074b#\xfb\xf7ڎ=Ǥ\x1e{\x0f\xcc&\xac\xed\xa3S\x8f\a\x99I=\x1e`+m\\\xd6Z\xe4o\x06\x95\x9e;\x06\x13\xe6\xc5\u058b\x8fI\x98\xa8\xe6\x1bU\xf4bN /\xf4\xfa\x840\xed\u007fEu\x1e-\xa7\x9d詾\xfd\x8bۈP\x9a\xbe\xd8|\xef\x11iz\xe0.T\x9f\xfe\xc5l\x022\xfbk\xc7\xeb{n\xc0w\xcdwN\b\x9bW\x1b\x90\x9e\x909\xcb4ȍ\x9dط\\\xb1\u007f'\x86\xa2\xe0aIeFNu\xb2<\xbf7\x1a\x88\xaa\x1d\x1e\xbd\xb0\xb1\xf9\xaaUܼ\xee\xda\x16\xa6{\xa1\x124\x9e\x98\x84ܚd7\x88\xc1\xfa\x17\xa3\uf473\xcbw\x90\xeeF\n\xe9Ca[K8ۘf\xf3\xb3N\x0f\xed\xb7\x00\xa7\xa4T:\xbc5\xafO\b%\xb7\xb0\xb6څ1\xf6\v\x90\xd4|\xc6<\xfc D\th\xe3#A\xdd\xc2\x1a\x818\xb3\xfd\x81w\xfbm\xbd\x1d\xb7\xb0~\xf8\xa1\r\xb4\x99\xd98\x03\xcb\xe2\xcf\xfc\x80\b@\x93\xaf/\xca\b:]<\x87yhQ\xa4/\x8b\xf0\xc3c;xy\xd565\x1cR\xb8\x91\x87\xcan\x8a\xa1\xf6%+z-\x10\xfdQ\n\xf0Lx\xa7\xcbg\x9a\xb1\xb4\xfa\x8c\xa5\xef\v~B.\x85\xbe໔\xd5\xf68\xbfg\xcaL\x8b\xa7\xe4\x9d\x00u)4\xfe\xf2\xe8H\xb4S\x0eF\xa1}\r\x8f\x10\xb7lج\xbf\xe9\xbby\x90\x88\xed\xb8\xb0F{\xb5%L\x91\vn\x8c\b\x8b+\xeb}\xb3\x1f\xdb\xc7\xed\xdb#/\x15:g\xb8\xe0\x13\x14vӮ\xef8\x14\xf7$\xe4\xe6.lO\xab\xfa\xa4\xfd\\/\x887F.ط\xad'1\xa3\t\xa4\xde\xd6CO\x18հ`\t\xc9A.v\v\x82\xe6(\f\xcf\xee\xf3\xf9^\xbcԎ z\xea#\x9a\xfdp\xcc8}h\x1a\x13s6\x1f|\xc6o\xed\x03\x0fv\xba\xbev?\xf8\xd0:PH\xa2\xde\xf0\x006i\x9ab$\x82fW\xbd\xb9wo\xcco\xcbm;%+\xe3rZ\x98\xd3\xf9\xbfFT!\xd1\xfe\x1f)(\x93\x0f\x9e\xd03\f'd\xd0zӹ^\x9a\x1f1\xf0\x99\"f7W4\xdbt\xa0v,K\x18\xae\x01\x99\x15\xc3b\xbe\xa5i\x9c\x90\xbb\xa5PV*\xce\x19d)a\xfb4-3\x0ena}p\xb2u\xc6\x0f.\xf8\x81\x15\xcf['\xd6\xcb\xf2\a\x00\v\x9e\xad\xc9\x01\xbey\x10\xaf\xba\xf4\xa2\xba\x1e\x0f\xf1\x0e\x17i=Zd\xd0t\x93\xd6\xfeQ\xa7\x8a\xee\x9em\x0f\x9a+\x84\xd2\u007f\xe9r~\xed\x98ɕ\u007f\xbe\xadAvx\x93\x1e\xb0l\x9cg\xa8b\x91F\xeb\x9ak\x90\xce!f٦\xd7\xcd\aX*\x0f9\xbd*\x87\x17\xf5\xae8D\xea^\n\xb0\xae\xf1\x87'\xd7_\xbb3\xd8\b҆\xcf\xef\x1b\xbe:s\x02\xcd\xdf\xcd\x05<\xa6ޙ\x88<\xa7\xfcA\u07be5ɷ\xf6=O\xb9\x0e\x8c\xddk\xb9(\xf1\xd4\xf5U\xcc<\xbd`\xb0\xe7\x8e\xe9%\xe3\x84\xfa\x83\x0f\xd2\x11\x0f%\x85\xd8v\xb9v\x8d%Ud\x06\xc0=\xd2\x1e8\xf4v<\x9d\xa4\xcd\x19\xbf@\xe0\xe4\xf5\xa3\xcaeR\xa3(b\xfb<r\xab\r\xac~\xb0\x92\xa3/\xb2\xef\x96 \xa1E\x03\xdb.b\xd4\xeb\xb8\xd0\r;\xbd\x1f\xa2\xed<\x0e\x15\x993\xa9ts\x92\x8a\x94\xaa\xdf\xc6\x06햙\xf1\r\xcbA\x94:\x18\xa7\xe7\xf5\xbb\xad\xd8[N\xefY^\xe6\x84\xe6\xa2|P\xe8\xdaad\x00˫ \x99\xc3\xe8\x1de\x1a\x19\x94\x81\x8a\x9e\n-\f\u058b\ft?\xbds\x06s\xc3D\x12\xc1\x15KA\xfap\xad\xdd'&̱\x9bS\x96\x95\xdbA\x8b\xae\x11f\x06\xf2s)#\xac\xc0\x8f\xf6\xbd\x86\x8fm)\xeeڈ\xe9\xb9\xf4%]\x01as\xc24\x01\x9e\x98\xbd\x00i\x19,~\xc0!\x01Q\xf2\xa0\x1ecG\x1ffl\x06\xf02\xef\xb3\xf0\t\x9eK\xc6\xf7\xb8\x93\x9a\x0f\xbf\xa7l\x9f3Џ\xa0m24\x16{\x00\xbe\xaf\xdf}\x86\x03P3\x83\xbd\xcaH=f@>\x01M\xd7\xfe\x14P\xad\x8d\x19\x88;.\x88,y\x93\x8b=2\xfd\xf7\xb7\xa1\xdc\xf7\x1f\xcb<b\x9c=\xb8\x91\x1b\xdem\xa6\x9bڇ\x01\xf0dڇ\x01^\x89\xa2p\xf7\xc6E\xebu#\x14\xbcҊ\xb3\xae(\xa4\xb7&2\x03c\x00Bj\xddE\x85\xa8\xcc|\x9bZ\xd2\x19\xce\xed\\W\u007feb\xc3\x11\xeaL\xb9f\xd2U\x83\xd0\xfb\xf8+\xedX\x8b\x92\xdcQ\xae=iWjU!z\xd1v\xd8>\xdaA\xe5\xa2\xf7\xb3[\x19]^i\xf4\x89U\xc0\xb5\\c\xcaO\xbf\xe9\xdaa\f\xbfT$\xb7FE\xc8\xe9\x02\x0e\x0f\x15y\xfb\xe1\x9d\xd7\x17\f\xfb\xef\xcd\xdd\xed`6\xc6XH\xb1b\xa9Qe>S\xc9\xe8,3\x06\xe6\x1c$\xf0\x04\x14\xf9\xfa\xe8\xf3٧\x9f.\xcf>\x9c\x1f\a\x806F)\xdc\x17\x94\x1b\x8a+\x95\x97\xc6\xd5~\x9b\xc9\x03_1)\xb8AM\b\x1e.愒\x95\x9fiR\xe5A\x19\xc3&[Az\xe2\xe2#n\x05!\xf8\xb0l\x92\xf1\xa2\xd4ޓxǲ\f\xb3\xacx\xb2\xa4|a\xb0t\xb3\f\x01\xda\xc0\x1fQk\xae齙3\xaa\x90*\xa1\x05\xa4H\xbf\x84\x06\x80LEi\x96\xfe\xf5\xd7'\x84\xc1\x1b\xf2u\xe3\x13Sr\xee\xa0\xd6[\x18\x00\x19W\xcba\x05\xd2\xea\xb8v\x03O\x88\x84\x05\x95i\x06J\x19\x0et\xb7\x04\xbd\x84~NK;\xac\xeb\xc3m\x19x\xaf\xa7\xa1\xbe\xaeL\xb6\x00\xc0\x1dYn\xb7UJ攉\xd3T$\xeaTSu\xabN\x197\"e\x92RM'\r&tj%\xc2\xc4I\xa7\x89\xb7\xf1&\x15\xb1\x9e\xfeN\x96\x9c3\xbe\x98\xd0\xea)\xc6't\xa2\x96\x90e\x87\xbd\xa7\x1b\xc0:\x1d\xda¬\xb1\xe6K\xfd]\xd5A\x86\xb2\x1dm\xfev^\xb13\xfb\xd5)\xb9\x14zw&\xd1\xeeQ1r\xc4봓\xe3\x9d_\xde|\xfa\xfb\xd5ǋ˛0F\xd7d\x91\xbb\x19_\x00\xccn\x16\xd9\xc1\xf8\x02\x8f\xc9N\x16\xd9f|\x01P\x1fd\x91\x8e\xf1\x05q\xca\aYd\xa4\xe0\xd8\xc7\"\x1b\x8c/d\xae=X$\xae!\x00\xe6\xc8\"\u007fc,\x12\xf8*\x92=~\xe7\xd4\xf6\xc6Q\xae\xf69D4k\x811^\xc6\xdb\\b\x10q\x04c\xbb\xed\x14\xe2\xabϴ\x1d\xc2\xe6\xcde\x06\xc0%5\xe9\xfbLU\x14\x04\x95\x05\x14B\xf0\xe1ڽ\x1d\xfb#\x1b\xddc;\xde\xe1r\xc0c\xf1@\x1a\xb8\x98\x92\x0f.\xa6K\xc9۟.ޝ_\xde\\\xbc\xbf8\xff\x14\x82\f\x12{F\x88\x0f\xcd\x0fB\xc9\xe1\xe3\x99\x14v\xec0,\n\t+&\xca*=7\x18n\xe7\xf1\xdc:m\xe1\xd3\xc5\xc0\xc1\x9a(\x90+\x96@\xf7gB\xf7\xb3\x87\r\x14\f\xb1K!h\x89\xf9`\x88\x8f\xaa\x16\xd8\xd1C9\b\x86\xf9\x04V\x94\x1d\x0f\xdbR\xc1 k\xc5b\x87\xba\x10\f\x11Ջw0\xa7ef\xfd\x13\a\a\xd3\xfe\xd2ڎa\xec\xe5\xbd\x14\xbd\x1c\xc8\xcd\xd1b1\u05f6x\xc3\xfbN\x1f\x83\xf1\x1e\xba\xf4\xba\x96p\xb5\x06D\x04̬\x04oq\x04\xe4\xe6\xd4#V\x9e\x11\x1bF\x9b\xb3\xc5\aZ\xfc\x15֟`\x1e\x0e`\x13٘y\xe7\x92հ\xc00\x02\"1r\xddN+\x9c\xf5\r\xc3\a韏\xd85Z\xb8\xb8qY\x93\xa8\x99\x19\xb4\xc4,\x86\f9@~\xc4h.~\xb4\xc5uS\x85q\xbc/zY}M\x8fD\xf0\x04\n\xadN\xc5\xcaHI\xb8;\xbd\x13\xf2\xd6\xd8\x12\x86\xb3Ol$@\x9db\x1a\xfe\xe9\xef\xf0\u007f\xa2gt\xf3\xf1\xdd\xc77\xe4,M\x89@6Z*\x98\x97\x99M\xf1\x89\x90\xc3~ԅ\xbd'XfzBJ\x96~\x13\xcaH\xfd\x18L\x0f\xa2\xb0y^\x8fB\x13\xd7\x18\x9d\\G\x98\xb4\xedaH\xaa:\xf7ƴeZ\xe1\xf9\xc9K\x15Ϊ\xfd\x98A\xb4\xca禅Ȟ\t\x91\x01\xe5\x110\xfa\x86\xbf\xbaF\x9f\xb4®\xd1;D\xd65\x90\xd6\x1fC\x16\x1c\xd6\xc2\xc0\xa6ȉp\xe9H\xeaT\x887D\x95E!\xa4VU\xc1\xf0\xd4\x1c\xf6p]\x964j\x8e\xa7U\xf5\xceI\xfd\x1b\xa6\x94\xef\xac\xd9\xeb\t\xb8\xd1\xc3\xe1\x04C\xf8S.R\xb8\x8c\x9e1\x82pv\xc2Y\x82A|\x04F\x94\xa6\xbaTӥP\xfa\xe2*\x12\xb6\x05Q\x88\xf4\xe2\xea\xa4\xf5\x97\nV\xf7\xc8#\x88\xe0\xeeF\b!\xa3E\x89\xbea\x82\x15\\Ѽ\xc4uV0\xf4\x88-*\xae\xa8^\x1a\xcd\xedN2\xad!\x869\xd8a\xac)\x90\xb9\"b~b\xb8U\xadl\xaf^\x1f|1\xa5a\xee\x97\xf8([\x80\xb8r\x8a\x03B\x8e\x97\x13^\x9d\xf2Vh\x95Y\x15\r\xf2\xec\xea\xc27\xd0\xf8B\xe8\x1e&%\xaa\xadznY\xe1\x93E\xdf?\x81\xcc\xf0\xb0\xe34\x9cy\xdb1\xf3\xc6fI\xf7\xa9\x8a\xdb=2\x86}6(O\xeb^\x1bG\xf6\xc7iR\x94q\xac\u05fd\x9fC.\xe4\xfa\xc4\xff\t\xc5\x12r\x904\x9b(-$]D\xca\f?M\x9c^\xfd\x97\xfdX\x1cgn,~{\x96\xe1.\x1b\xe2|vI)\x8d-\x91\xad\xbd\x94\x87\xf4\x8bH\x9e\x8ab\xbaZ}\xf4\x1dm\x92\xae\x13N\x87\xd8a5\x8f@W\xc6Jde\x0e\xea\xa4\xd2\xe5\xa3\xc1\x1ah\xc0WdE\xa5\xfab\x16I\xcaVL\xf5K\x91\xec\x1a\x94\xaf?F1\x1f\x82\xfc\xd3N\x9fq\r\x8bh\x03f2\x1c\t\x9d\x86\x95/\xad\x16\xa5.\xcax;h.dNu\x15}\xb8/\x84B\xf7\xa5o?\x11\r\xb8\xa5\xaf\xbc>\x88\x84SP\xadA\xf27俎\xfe\xf1\xfb\x9f'\xc7\xdf\x1c\x1d\xfd\xf0j\xf2\x1f?\xfe\xfe\xe8\x1fS\xfc\x8f\u007f9\xfe\xe6\xf8g\xff\xc7\uf3cf\x8f\x8e~\xf8\xeb\x87oo\xae\xce\u007fd\xc7?\xff\xc0\xcb\xfc\xd6\xfe\xf5\xf3\xd1\x0fp\xfecO \xc7\xc7\xdf|\x1d9\xe1\xfbI\xed\xa9\x980\xae'BN\xec\xd6?P\x14\xbdo\xf8\xedx\x1c\xbe\xf3\xc9\xeb\x14\xc3D)i\xea\\_\x88A\fS\x8f\x06,\u007f\x90v\xa4 \x91\xa0_\x96g\xd5ΩQ\xe9p\xa8\xea\x06\x16\xbf\x02g\xebP\x13Ϣ\xa7\xb61\xb0\x05\x17\xc1@\xeb\x10\x1f\x14\xb5\r\v=\xfc[\b\xf6\xf2\xfb1:\x83Ggps\xfcz\x9d\xc1\xd7\xf6\xac\x8c\x9e\xe0/\xe3\t\x8e|5f\x95\x13dJ!\xc9N1s\x8b\xca\xea\n\v?wfv\xd5-\x91H!\x8a2\xa3:6\n\xbd;\xf1d\xea\x05`L\x86K\x9dWkC\xe5\xf9ଢ\xb3,#\x8c[\x91\x87\x93\xf2\xc9\x1e\x12\xacmO\xa8\"A\x87\bV\xc0\xb5a+|\xb3fS\x11\xa5\xa9Ԍ/\xa6\xe4\xfbe\x90\x1b\xd6\xeaR.;\x82q\x92\x97\x99fE\x06\xa4j\xcaW\xd5\xe4\x87@UJ$\x8cj\x9fzb\x9b\xd4(\xedы\xb8\xd0\xf46\x04f!!\x81\x14x\x02ػ\xa5l4\x1a\x9c\xad\t\xe5䜯\xf0kA\xabOK\x9b\xc2iU\xa7j^\xad\xaf\xd9\f\x87\x00\xb0_$\xd1\xd0\x1cS\x97\xe8\xd1\xee\xe1\x1c\xc4\xf4\xdc\x06\x19\xe5\xda7̩\"\x92!jD\xacR\\ecD\x18\f[\xdap\x1dK\xad\xb4\xd9\xf0X\xa0\x14\xf93f\xa3Ī\xa6O\xa5\x96\xbe,\x95\xf4\t\xd4\xd1\xc7SE\a\xa9\xa1CT\xd0}\xeag\xb4)X\x9f\x1d/\v\xe3U\xc7!jc\xb4\xfaVH\x98\xb3\xfbA<\xe4\x8cW\xfbBX\n\\\xb39\x8b\xd0\xe8\x8d\xd6#\xa1\x00\x8e\x95\xa5@\x93\xa5m\xde\xc6\xdb\t\x1f\xe1\xf4\xfb\x85s\x9f\xad%\xff\x18\x8c\xfa\xba\xcb\xe70rݑ\xeb><~]\\\xd7\x1d\x84_$\xcb}&\x8b\x14\xeb\x1cc\v1\xdf5j%\xf1\xd47o\x81\bXk\x9fSY7 8\xc5\xef\x85\x1c>l;軪\xd5Bȶ\x00\x16wd\xc9\x16\x86\xcc2XAH\xd8\xd3j\xd7$\xa7\x9c.lc7-|\xf8\x8a\bI\f#\x91,\r*\x9d\xac\xcdP\\\xa4\x11k\x86\re\x82\xa6\x8d;{B\x16\x9f\xb1[ \xef\xa0\xc8\xc4\xda\xf5o\xe3)\xb9\xd6T\x1b\xb6s\r:$!+\x82=\xe0:\xae\xca,\xbb\x12\x19K\x02|\xf3mR\xbb@\x1a+\xca,#\x05\x02\x9a\x92\x8f\x1c\xe5\xc3YvG\xd7A\xf1\xc6KX\x81<!\x17\xf3K\xa1\xaf\xaci\u05eeI\xb0 \x03 \xb29yco\xaf!\x9a.ЅPwQ\x16\xb2\xf5\xa9\x00\xb0( \ue602\xce\xebW\x9e\xef\xa8\xfd\x0e\xbfiD\xa1\xfd\xfbI\t&csH\xd6I\x16˕\xce\x12L\x91\xac\x9b\xf76ΧZ+\r!\xaa\x90k\x96\x83N\f\x86M\xd0\n\xc1\x15\xd8fQ\xfe\xa8V3\x0eu?\xa9AŔq*Z!\x94\xbe\xd6T\xf6jIT\x8f\xf6i\xbc\xf2@\f\xa9'4\xcb %,\xcf!eTC\x16\xeaW\xf6=\xe9Z>8\xbcp̵;\v\x97\xffK\xca\xd3\f$v\xe0r^\xb7\x16t\r2g\x9c\x86\xb5\v U\xba\x12:\b!%4I\x84L]\xd7#\xdf׆\xcaP\xbfH\xc5\xd1P\xdbi\xd0\xebf\xd6Y \xdcY&\x92[EJ\xaeYV7:\xf3]\xce\xdcUY\x810\xfb\xeb\xd1\r6R\xfd\xe7\xa4:+\x13\xbcK\xe6\xf4w\xf5?\xe1\x0faJk\xbc\x95ҧ\x93\xe4\xf6\xd8\xe8\xa6\x06H\x0e\x98\b(8ć\x8a\xe7¨!\x86\x8c\xea~\u007f\x95\x00\x99b3\xbc\b\xa8\xed\x9b\x14(\xb2E\xec\bDo{5^j\x8faq\xf9\xe0\x8e\x1f\xcdѣYfd\x04.c\x1c\x9a]3\x19\xf6\xf2k\x9f\xb9\xd8L&\x03\xc4Y\x90$e\x12\xfbǯ}\xd5`$L\xdf\x16\x12\xbbg\v\xa1\xc9\xd1\xe1\xe9\xe1qx;\x8d6L\xdf\xff\xc3\xe8\xc8\x19X\x19\x19\xdau\xa8k\x96F\rby\x91\xad\x11\xbf\x87\xe9\ta\xb1\xd1VW\xce(K\xee\xf7\xc85m9!\xaa_Ǻ\xed\xa1%\xf5\xfd\xa9-,\x03Z\xcb\xd2\xea\x0f\x91@\x8f\x0e\u007f><!\xa0\x93cr'\xf8\xa1F\x12\x98\x92\x1ba\xec\xfcH\x98\xd5Rע$\x1clK5\xb8/2\x960\x1d,m\xfd0b\x9b\x88R\xdb&ax\x1d\x156\xc19\xbf\x8f\xde%[\xe7a\xf8\xe0+<\x9fV\x84\x13\xaaH\xc6Vp\xba\x04\x9a\xe9e\xec|\rEq\xc1'\xff\x03R`\x83\x1d\xee\xe0\xc5\xf9L\x82#D\xcd18G\"\xdcP\xdf|7*\x04o\xc4\xf6\xb7\x10\xa8\xfa\x91\xad;\xdenn\xae\xbe\x05\xdd\x160\x11h0\xb3\xf1\xb9\xdf\xe8\xd6\x059\x17r\xeb\x82\u0087\xc70ٴ\x14*\x02#d\xfb\xe6;\xa5m\xd7qk\x1c\xf0\x98\xf8\x98\x1dZ\xb4\xcbv\\f\x1d\xb9\xb8\x8aM\x12\xfa\xbb(\r\x96ft\x96\xad\xab^\x86\n490ӎM\xb2e\x1c\xf7\xf0/@S\xec\x19ɕ\x06\x1a\xd4+\xa8\x1e\x03\x8fTc\x1e\x8f\xa1d\xd8[\v\x97na=\x9b\xa2n\x8fF\x03\x1dG\xe7S<=\xd6\xef\x14+c$\x14\x96\xb1\xba\xf9}\x01\x06\xb8\xc5\x0f,\xee\xdd\xef\xb3\x019r\xd4_\x19i\x17\xe7:\x89\x96j@5\x16\xe3\x16\xe9\xe6\x00D\xcflh^*\x19\x98)I\xba\"=\x16G\x03 \xba\xaa\xbc\xd0t\xa9\xcd\xf1\b\x95\n\x91\xcd\u007f\x9a\xe3\xe9\xd0\x13\x9a\xb1\xb39\x1e\x01?C\x92\xfdHLJ\\\xfb\xe5!\x18\x18\x94\xf3N\x06jKX\n\x12Yr\xba]p\xaa\x05\xa1I\x82=\xf7b\xcbs\x8d0@v\x847\xd4\a5\x1ak\x00\x19FP\x85\b\xf5\xff\xf91\xa00\xea1ʢ\x1e\xa1(\xaa\xa3\x83\x9a$\xbc\xccg c\x1b\n\xf8\x96\x02R\xb7\bd#\xa32\x12\xf4\xa5\x9d\x9a\x0fbzu\x82\xf2\x9e\xf7cm\x8f\xd7f\x96\u007f\xfa\xb7\u007f\xfb\xe3\xbfM-\x02\xaa\xfc\xccX\x9a\xbe8\xbb<\xfb\xe9\xfa\xf3[\xecf\x15\xb7\xd0'\xa8\u007f\xc2\xf2\xfaH\x89ҎG# \x83\xb5Ra\xe3\xa7xW\x8b\xb1\n\x9c\xbf\xd8:dU#\xf6\x14m. C\xf9\x02\x9c$^(M\xf0\xb8<\xa7\xed\xab\x93\xe2Z$\xb7\x83\xad\xdfÛ\xb7W\x16Pm\x00G`\x9er\xef\x92e|%\xb2\x95\xbd\xc9\xe9\xe6\xed\x15\"&f/ͻ\xe8CGW\xd9\xda\xcc\xcfW>ۤ\x93\b\x98,/ܝe\x94H\xa0\x19S\x9a%\xf8\xa5\x98\xa0\x97\x1ff\x96\xe1\xd9)/\xc2\xca?\xfc\xe8\x93\\j\x83?\xfe\xd8:\x86\xd0e\xf0ǚ)\xd6M\x10W\xfc3j\x15\x8f\xa4U8mB\xfa[\xe8F\xad\"f\xbcD\xad\xe2\x97#\xf1\"_,$\\kQ\f\xca\x0e\xb0 \x1e%7\xc0\xdf/\xb4+|O\xd2\xe0M\xb4wq\x9e]]T\xbeg\xd1\n\xbacjF LU&K\x1f\xe7\xe0\xa0\xd4)\xa6\x01\x94\x85\xf59\xf9\x8b\xc0BC\x89\x85\x04\xbcUI\xf0\x93\xaa\xe6\x1c\x11\x01\xdc\xfe\b:\t=\x17\xe8\x17q\xd9\x11.\xaa\xe67iX\xb2A\"\xa9Z\x02\xf6\x90\x87{V_zN\x95\xe06\xec\xe96\x8d\x05\x9b\xceL\x91\x82*e\x03_\xba^\x80\xfdĕH\x0f\x0fCU\xb0\xc6d\xc8B\xd2\x04H\x01\x92\x89\x94`\x1f\xb4T\xdcq2\x83\xc5\xc3w\xa5n\x0eG\xaff\x92\xfe\x18\x18m\a0\x1aZ\xdd\xe1\x17\b\xf4S\xabտkޑ\x88:?\xda\xe1#\x94\xbe\xdai1X\xae\x85\xc4_\xd2,[ׇ,\x10\xaa\xab\xfe\xd3\xd5\xd6l#;\xf4\x1c\xe0\xd6<{~\x8c!e\xfc\xb7\b\xb4\xee\xa4/\xbc\xf7\x9a&\xcbp*\bLc\x1f\xd3o\xfa\x8e1\xfdf\xef\x18\xd3o\xfc\x18\xd3o\xc6\xf4\x9b1\xfdfL\xbf\x19\xd3oZ\xe3E8\xe6\xc6\xf4\x9b1\xfdfs\x8c\xe97\xc1cL\xbf\xd9=\xc6\xf4\x9b\xbdcL\xbf\xd93\xc6\xf4\x9b\xf01\xa6\xdfl\x8d_[\xa0lL\xbf\xf9\xb5\x06\xca\xc6\xf4\x9b~/\x8f\xe97\x0f\x8e1\xfdfL\xbf\x19\xd3oz|{\xd4*\xc6\xf4\x9b_\xb7V\xf1ˑx\x03\xfa7\x05\xbd\xe43N\xae\xa4\x98E7r\xba\xc2\xd84K\\\xba\x8a\x98G\x85\xd4\xfdT\xa6\xf55\xea\x8d>\xbd\xbegFЕ\xb6\xf6\xaam\x9fB\xd3\xd9/%\xb4\x89E\xff\b\xbao\xbc\xa4N\va\xff_\x1d?o\x04έ_\xab?ˏ\x13\xa4\xe1\x11\xf3>\xd1\xf2:\xf6\x1d\x9a\xf0\xb4+R\x1e\xad\x95\r\x8d\x92\xc7\xeb'\xd1\xd1\U0006724c?UT|oD\xbc\x19ێ\x80\xbd\x15\r\xdf\x15\u05ceQ\xac\x1b\xb3{\xa4\x98\xf6\xdexv32\x1dc\xf6nŲ\xb7\xa2\xd2\x11P\x9bq\xecΈt\x04\xcc:\x86\xbd+\x1a\x1d\x01\xf4\xfc\x9e駋D?b\x14::\x003HY\x8d\xf5\xa5F\xea!.\xf1\xf4f)A-E\x16\xc8\xe3Z\xfc\xed\x03\xe3,/ss\xb0\x95aLlU嵆r\f\xcfs\xacd\xb7!&\x03\x96\xa5\x80\xd7\xd1Q\x96\x857\xe6\xc2&bK\x8a\x96\xbc*\x93\x04 52\xa9\xd1\xd7/\x10\xe2\x1f\xa7՚\xab;\xf5_\x87љ\xbd$\r\xad\xa3?\xfe!b\xbfí\xaa\xa8\x14\x83\x87\xd3\v\x10n \xfe\x86\xa6\x16\xc4\v\xf48g\xc3S\xa4\x13\xecI% \u007f\x17e\x8c\x95\xbf;\x8d`#! F.Ʀ\x10\f\xe0\x89\x83R\a\xf6\xa7\r\x18\xdcDaag\xca@\x15\xfc\x8fq\x81Ŧ\vDK\xaa\xa7I\x13\u061d\"@X\x9c\xafaXz\xc0\xd0ԀG\xbb\xbf\xac\x8ey\x0f\xbc\x91z\x88Ws\xa8'mP\x1a\xc0Ӡcx\xf0\xfb\v\xdd\x13\x19\xb9\x8f\xf1\xe1\xfeA\xa1\xfe\xf80\u007f\\\x88\u007f\u007fx?\xd2\t?(\xb4?\x80X\xe2\x9c\uf44e\xf7\xa1N\xf7\x81\x0e\xf7\xfd!\xfcȍ{\x02G\xfb\x1e';\xba\xcb#@v;؇\xba\xca\x1f\xd9M\x1e\x1bx\xdf\x1fto\x84ϣ\x14ᎀ{|\xe8<\x9a~\xe3\x18zD\xf0 \x92\x153\xce4\xa3\xd9;\xc8\xe8\xfa\x1a\x12\xc1\xd3@\xadf\xe3\x12\x95\xeaT*\v\xcc\xda\xc9\x11\xaeٺNpI\xdd\ry\x90\xfarG\xef\xf9\x0fe\x9a\xa8\xf2\xe1u\xfdv\xdd\x1b}\xed\xbf\xa4\x97\x9e|\x11\xf3\xdd\x16\t\x0e\xdf\xf8\xbf\x88;\"\xe6\x1a89b\xdc\xef\xfdq8\xcfs\x86{\xed\xad\xa9\x0e\xaf9\xbb\xaf_y\xd0\xc1\xb5\x8c\xbf8\xc7\n\xba\x94\x94z*O\x9a\x03\xffخ4\av^\x86z\xb2[\xee4\xeb\x90k\xf3\xed\xc0\r\xab\xaf\xd7z\x8ds\xf6\x1c\x03=\xba\xaeX\xfe\xd7OD\x91IP\x0f&@\xd5\xe9L\x81(\xecL~j\xa72\x05B\xecH|\xeaNc\n\x84\xdbJz\x8aHa\xfa\xa2\xde\xc4GJ[ڟ\xb2D\n\x11ccG\xa5+\x8d\x96R\xaf\xb1?-i\xb4\x94\xbe\xac\xa5\xf4\xd2m\x01\xcdr\x10\xa5~1f\xc0ݒ%˦\xb6\xc1rPD\x94\xf1)\xd4F\x8fpS\xea\f\xb6=\xed\x055\xbf\"\xcb!\x82\xc2\xc2\xdc\xde\x1d>\x9f\x8d\xde+u\"P\xc0z\xa9\"\x94\xbc\xbb\xbc\xfe黳?\x9f\u007f7%\xe74Y6[=qB\x03\xc5\x1a\xf2\x9a%]\x01\xa1\xa4\xe4쟥\xbd\x99\x90\x1cU_9~\xa6;\xc8#$\x87\xe1,\x01\a\xbd\xb5)\xdf1\x85\rq\x10\x86kQ \x14\x84^\xfeږ%\xe4\xdc\x00\xb1\xfa!ʝ%H \v\xb6\n2T\fL\x9b\xffChZ5}0\a՜\x12&8\xa13Q\x06\xb1\xc6%\x10\x0eڜ\xe0\xca/%\xb8j\xf5\t+\x15\x04]\v8+\xf1:\xb3B\xb2\x9cJ\x96\xad\x9b\x13\xa4ٔ\\\n\xafq\xaf\xc3t\x81&\xea\xde}<\xbf&\x97\x1foH!\xb1ՒͶ\xc1\u007f\x0fܨ\x19\x98m\xb1\x9b\x9cN\xc9\x19_[0\x96K3E\x8c\x9a\r<l\xaaN\x99\xf0\x97X\x1e\xbc\x9a\xe2\xff\x1d\x98}\x93F۰\xe9RA\x8bO\xb6\x92A\xad\xe6\xc2f\x99\xa5\xce@=\xc8\xed\xfb\xa0\xbb\xf3\x82C\xaa\x1b\xa9~nEW\x06\xe1\x12\n{\xb3\xa3\"4\x88\xd5{\x02\xc6mCVgNZ\x16\xa9\xcb\xc5\x1a8Is1\x83\ue7ae\xb5\f\xaf\xa2Z\xea\f\xd6\xf2\x1c\x15\x16\"=T\xe4\xe2\xca\x13\xdf\xd4^\xe4j8|0H\xbc\xd7{E3\x96\xda\xc9\xd9p\xc5\tyE\xfe\x93ܓ\xffDu\xf5O\xa1\xfah\xbc\x94\x8fw!X{\xf4\xe2j\xd0N}o\x98\x8e\x81c\xb0\xab\x05\x991\x9eFY#p\xafA\x1af\xeev\xfc\xd9nK7\x93\u007fq\x04k\xa3\x1b\x17\xf3\xe6\xed\xaf\xfae\x91,1\xd3\xfb\x8bP\xfa\xd21\x9f\xf6]\xb5f\xb6\xc1\x10Q\xe5ʩN\x96m\xceh\xd4w\xa5k\x06\x13\x0e9\x15\x98\xa7kS\\\x97,\xd8\xcd\xfce\x0ehLBI\x8b.\x1f\x93\x826Ln\xf4\xb7:\xbd\xd86j\f\xf7\xfdX\xd6\xec\x94u\xb3ش!\xc2b\x9cP;tv\xe7=\x88)\xf8\xadK\xb7\f\xa7K(\xb75(s\x90\xd2\xf6\uf685g\x1f+\x90+\x96@0\x11F\xf3\xb8B\n-\x12\x11|\x9f~;\xb1\xc2\x01A\xaf\xbbu\xef~\x88\xa4\xa5\xbf\xbd\xbb:!7o\xaf\xf0J\xeb\xeb\xb77WC\xb2k\t9\xb8y{u\xf0LȌq\xf5LڪQЛ~\xebBL\x9a\xe7\xb9\xf0\u007fÇf\x8c\x84IN\x8b\xc9-\xac\x03\x14\xc7X\xdcD`f{\xbav\xd19훐,\x81\xa6\xec\x85\xd4\xc89&Rϩ\xbbX.\x17\xab ?\n\x9aQ\x1e6\xf0\xb4\x10\xcc\xd8#\xae\xa5s\xb3\x82.\x00\xe8\xde;\xe7\xc7\n\xba\xb1\x82\xae\x1ac\x05\xddXA7VЍ\x15t=\xc7XA7V\xd0\xf5_\xe8XA7VЍ\x15t{\xc6XA\xf7\xe0|\xc6\n\xba}c\xac\xa0k\x8c\xb1\x82\xae=\xc6\n\xba\xc0\x97\xc7\n\xba1/\xf4\x811Vн\xe4\xbcб\x82n\xdfx\xe9Y\xb3c\x05\xdd\v\xf1ғ\xb1\x82n\xac\xa0k\x8c\xb1\x82n\xac\xa0\xab\xc6XA\xb7s\x8c\x15tv\x8c\x15t;\xc6o\xd7R\x1a+\xe8^\x96\xa5\xf4\xd2m\x81\xb1\x82n\xac\xa0\vz+\x88\xc2\xfc\x95\xfc\xb1\x15[\x87oE^\x94\x1a\xc8'\x0f\xa8:Pa\xf9\xa9\x98!\xdc(\xdaz\xce&\xe9\x89\xe0s\xb6(%\x96I\x9dڻ\xd9'\x89]ؤ\xc2Ф\x9a\xdd\xe9S\xa7ye,g!Etf\xd4UiW\xd1JN\x94|\x1d&]\a\xc9ւj\r\x92\xbf!\xffu\xf4\x8f\xdf\xff<9\xfe\xe6\xe8\xe8\x87W\x93\xff\xf8\xf1\xf7G\xff\x98\xe2\u007f\xfc\xcb\xf17\xc7?\xfb?~\u007f||t\xf4\xc3_?|{su\xfe#;\xfe\xf9\a^\xe6\xb7\xf6\xaf\x9f\x8f~\x80\xf3\x1f{\x029>\xfe\xe6\xeb\xc0\x89>\xaa\xc4j\x1f\xc0\xef\x90V\xeah\x1e\xb2\xe6\x9c\xde\x1b.\x1a\xba\xfd\xb9(\xb9\xb6i\xa1\xf6TW\xc4o#\x9f\xcfq\xe1\xffS\x9dD\x12/\x82]\fx<\x90\x0f\x8e\xf1@\x92\xc3O\x8eZ6\x8f\xa4Ul\x1e\xf1HzA\x1bz&/椚#SD\xe4L\x1b+}.d\xb3\xd254\xb9\x94\xe9\x96)\xea\xd8\x12foS,J\x8e\xben\xbeQG$\xf4\x12\xe4\x1dS\xe8䢼\xf6) Ø\xa40g<8-\x03U\xcd`\x8f\xf3KdU\x11/)HJ\xc9\xf4\xfa\xad\xe0\x1a\xee\x03l\xf26\xd1_;0D\x146\xdb\xd5\xe78\xd9\x14\xf1\x10f[r\xac\xea\nސBd,Y\x9f\xfa\x05!\xe6\xe1^\x9f\x06|\xbb\xdf\x175U\xb7\xf5\xfe\xc3Ę\f\xf56o}\xff\xa9\x95E\x94\xccW\x92\xadX\x06\v8W\t͐&\x87\x98\x8ag;`\x06\x9e,\x83\x02)2E\xee\x96`N.\xa1f\x8d\xe8\xb0H('\v\x1a\x9c*\x94\x9b\x1d*\xfc\xc4\f\x99\x19.\xa0\x15)\xa8\x04\xae=\xf8P\x96\x88E\xd93!2\x97\x13\x9f\xad빻\x02\x14.~\xe2p\xf7\x93\xf9v\xb0{>\xa3\x8b\xaa0F\x81\xde\xf2\xd6\xc4N{\xd76\xd9t\xeb\x12\b\xcd\xee\xe8:t\xbawK\u061c\x1fSo\xc8\xebc<\x9bT\x91ꋡ\x9c\xf6\x0f\xc7\x187|{v\xf5\xd3\xf5߯\u007f:{\xf7\xe1\xe22\x86-\x9a\x9d\x82\xa0K\xe1\x12Z\xd0\x19\xcbX\xb8\x12\xb6\x95\xcd\xd4\x04\x85b(MOS)B\x13c\x11˲\xe4\x9c\xf1E\xa3\xbexH\xaer\xb3\xed\x05\x92ټ=م\xa4<<kq\xb6\xde \x06Yr\xcd\xf2g+̡\xe9Т\x9c\xb34\x85\xb4\x85\x8a`x\x8f\x93}\xf9\xd6Oa]w܈\x80I\xc8\xd5\xc7\xeb\x8b\xff\xb7A\x89\xeb\">Y\xec\x99\xeb\x18\b1\af\xe0\xae~\xb2\x15\x86\xe3\xbev\x8e_R}J%χ\xc4\xd3?\x95\xbc\xddu\xab\x88\x95R\xb9HaJ\xae\xacH\x06Ն\x15\xdf\n\x82J \x06 \u05ccfٚ\x18\xebmE3\xb0\t\xfcX;\x17\xac`ugS\xcdi\xa6\x02\xd9s\xac\\5\x8a\xcb\ac\xa2\x0eع\n\x06I\x81\v\xed\xec\xe5\b\xba\x17s\x84E\xac\xcd\xdcHZkɯ\b\xe5\xb0\x16\xabLyL_U\xb3ƈH \xccR\x81\xea\x16\xab\x95\x15\x1d\x91\x03\"\x81\xa6X\xdb[P\xbd\xb4Y\x159U\xb7\x90\xda\x1f\xa2\xb4b\xe7e\xb0\xb3\xad\x16}\xb3.\x80́\xea284\x83ڰ\xcdQ\x01NgY\xa8\x03#\xba}\x02M?\xf2l\xfdI\b\xfd\xbe*E\x1d@\xb6\xdf;\x9b\xa6\x1d\xb90\nn(c\xc0\xb9Mp\xe3\x90\r4*e=\xb5\x85:c\xd4s2\x01Y\xf23\xf5\xad\x14e\xa0H\xdfR\xad\xbf\xbdx\x87\xbc\xb0\xb4\xf6\ap-\xd7\xd8\x06 \x9c\x11t\xdbW\xe4o\xe6ܹ\x93\x16\xaa\xb2x\x160'%W\xa0\xa7\xe4\x03]\x13\x9a)\xe1ͺ`k\xf6\n\xb3\xfc\x9a\xfe\x97)\xba\xe7,02\x13:\x94\xafl\x80C\x16\xb0\xfd\x95PߞA\xa6\r\xc8V\xbe83\xbf\r\xa8\xa1@\xe9-(RHH \x05\x9e\x04\xd2j#\xb6\xfa\xa7\u007f}\x96\xb4-\xa4\xf2K\xc1\r\x03\x19@\xe7\x17<e\t\xb5R\x8e\xea6\x9d\x86**\xa5\xd2\xde&\xa7X\x11\x8d\xec\xa3T \xb1\x85\x97\x96%\xc4l\xf5_\xcb\x19d\xa0\xad\xcb\x02\xbbwQm[\x0f\xb0\x9c\x06\xdf\xeeNu%ڴ \xc0U)\xc19\x855I\x05\xc4䗹E\xff\xed\xe2\x1dyE\x8e̪\x8f\x91\xd4\xe7\x94eX\xf2\xa7i\xf0E\xe9\x1b\x1e\x8f\xb9\x9f\x1e\xa2\x12O<\t\xee\xe2\x84L\xf8\x84pAT\x99,=.\x99\xe0\x95;\xc8\xe5\xd6FDֶ\x98\xcf.v\x12\xean\xaf\x99\xcfo\x87\x9d\f\x12}\u007fS \aJ\xbe\xbf=\xb9\xe4\x8bw+\x19~\xd2\xde)d\x03$\aMS\xaai\xd8u\xf8\b\x917\xfaŌ\x84\xbc\x01\xf4\x17&\x17\x15|\xc7xyo\x93[\x87:W\xaf\xcf\x11\x18q\xc1\x13k'\x84\n\x9c\xa2Șm\x91\xb7\xd1\t\xda2\xf2*\x9c8H@x\x99\x86\x8c\x9cf\x990B=\\\xf3\xa7<\x15\xf9ֲ\x8d1\a\xad>\xe2S\xe4\xf8\xa1\xf0\xc7cU\x03\x1dt\xac\xe2\xdd\xd7\x19\xac \xb8\xfd\xe1f_t\x03\xc3\x18u\x9eN\x10h\x84W0\xa33Ȭ\xf2eO\x89\xda>%\x91\xde\xc2(W\xa3\x14\xd9\xd0\x12\xc5O\"\xc3<QZ!\xc7\x00\xfd\x15\xe0\x06_\x1d\x86\x1b\xf4Ҵp\x13\xe9M~i\xb8)\x835.\xb2\x89\x1b\xa3\xb4\xb5qc\x80\xfe\xe2q\x13邿c<\x15w\xeaq\x84\xf8\xf7\x16\x98\xe7މ\x11\x19\x9a\xf1E\xb0c\xac\x16\xe44\xcbZA\xd2\xe1\x92\xdc'\xaa\xf8\xee\xfd\x1dr+4\xa2\xebL\xba\x12/3h\xbbq\x06\n\xaf\x1dr\xb5KR\x86z\n\xb7\xe4\xea\x17\x93\x94\x8b\\ѷ\xd2|S3\x9a]\x17\xa1\xad.\xc9&-~\xfb\xe1\xfa\xac\r0\xae\xaf\xe1\x1d^{apm \x12\x9a\xe6L)4\xe2a\xb6\x14\xe26\x02\xe4\x91\xcf/Z0\xbd,g\xd3D\xe4\x8dT\xa3\x89b\vu\xea\xce\xe4\xc4\xe0\xe58\xe2\x1b\x8cg\x8c7\xc2\fx\xbd\x833\x10\xcdB\"@&\x156\x91\xe0\\\xe7l\x97!\xb0\x8d\xee˸\n7l\x14\xf3\xac\xf2d\x9b\xf4.\xa3\xfa\x01=@~\x91\xf8p\xcdD\x1b\x05c\x96\x10\xeb݈\x00\x8a\xfbgcdϫ\xf2y\x8f\xc9#`\x18='\x0e\x94\xe1dN\xf0Ą˻|/[ޔ\b\xc0]\xfe\x17\x04\xda\xf6\xaaD\x1d\xefm?L˳\x12\x01\xb3\x9f/&\x02\xf0~iH\xe2z\xe4>\x8dD$O!\x15ɳ\xebt1\xb9\xc0\xb6\x02\u007fP\x8b\xf1\xeb\x06\f\xc2Z\xb1\x8e\x805;}\xccv\x19\xa9\xba\x17\xe0}V\xd8\x19\x85\xfd\x8fU\xb1B\xdcTu\x169\x176\x91\xbc\xd9z\xc4\xf5Y\x0e!\x96\x92k\x96\xf9\xf0o^dFr\xb7fk\x830aב4\xfa\x9c\x9fTh\xa8\x9b\xaa\xbb\x96+!\n\xef\u007f\x97J\x13Z\xe5\xb1\xfa\x9e\vWՇ\f*o\xc2f\xe9n\xa3\xc0v\u007fZ\x98I\xafX\n$e\xf39\xf8<\xdc\x19\x90\x82J\x9a\x83\x0e˕qA\xb1\x19,\x98M\x8e\x14sB\r\x1a\x0e\x0fU]\xfc\x1f\x82\x01L\xb5d\x9a\xe4l\xb1\xb4\a\x99P\x92\t\xbe >*\x95\t\x9a\x12\xc3C\x03\xa0\nI\xee\xa8\xcc\t%\tM\x96pbs\x91\xd3Rb\xefY\r4]O\x94\x0es\n\x1a\xd5\x19\xe3C\ue7a8d\xbb\n2p\xa7\xd0\u009d\x81\xa6>[\xc3']x\xad\xady`\x03\xe0zh\xf3\x8c.^J\xb7\x9e\xb1\xa7~\xe7\x18{\xea\xbb1\xf6\xd4o\x8f\xb1\xa7\xfe\xd8Sߏ\xb1\xa7\xfe\xd8S\xbf{\x8c=\xf5q\x8c=\xf5Ǟ\xfacO\xfd\xb1\xa7>\x8e\xb1\xa7~\x9f1\xf6\xd4o\x8e\xb1\xa7~s\x8c=\xf5\xfb\x8c\xb1\xa7\xfeo\xb8S\xe4\xd8S\xffeu\x8a\x1c{\xea\xef\x1b/\xbd\x8f\xe6\xd8S\xff\x85x\xe9\xc9\xd8S\u007f\xec\xa9\xdf\x18cO\xfd\xb1\xa7~5ƞ\xfa;\xc7\xd8Sߎ\xb1\xa7\xfe\x8e\xf1۵\x94ƞ\xfa/\xcbRz\xe9\xb6\xc0\xd8S\u007f\xec\xa9\x1f\xf4V`\x1ae\xca\x02\xbao\xf6i*\x13\xdcE\xd5\x17\xa4\x12Jf\xe5|\x0e\x12uC\x9c\xd9V\x1eI\x00X\xdf\xfa\xcf'6\xfa|\x0f\x05\xfa\x04\xbb\xd8\xd8z\x9a\x10\xed\xbfsJ\xbe\xaa\xf6\x8e\xae\x15\x91\xa0\xc2:\xe00N\xce?\xbe\xaf\r\xaa\xf0n81\xed\x00p%\x1fy\x12\x9b:[o}G\x99q\bFm\x02Y\x92\tes\x9b,\x8a\x93%\xe5\x1c2g\u007f\x04%\xf7,\xa9\"3\x00ND\x01\xdcf\x0eR\xa2\x18_d@\xa8\xd64YN\xcd\xecCTd\xb7\xed\xaeMi=K\xa5%\xd0\xdcn\xbf\x84<\xacA\xac\x99\x1e\xa1\x89\x14J\x91\xbc\xcc4+\xaa\t\x12\x05X\xb2\xa3B\xb3\x86\xfd\xa6b\x82\x14\xd84\x1eY\xc2I\xbd\x02\x8b\x94\x90i6\x1bա\x85v\x82\xfd\xb1\xf3B\xaf\xab\xa4b s&U\xc8.%\x19CC\x00\xd7k\x8b\x10q\x8e'h\tjl7\x8a\x18\r\x91%\x16\xa5<E\x9d\xa8\xd0\n\x93d\x1b\x93t\x1fM\x99r\xfa\xb3\nI\xa0\xa3ڋ>\x96C\x8dQ$\xdd\x14?\x1b>c\xf7rc\x8a\x8d.\xb6u\x06u\x88\x86\xe4\x99\x1dv.\xf3\xcc\xe4\xa4\xd9,ݗy\x04y\x190\x1d\xacf\x9an\xfdH\xfa\x1cV\xe6\xecC\x02l\x15r\xf6\xe9\x0e\xce\xf7\xa4\x8cO\x83\xcc\x19Ǵ\xe5\x0f\xa0\x14]\xc0UP\xd8j\x97A\x87\x91\xab\x9aD\x82T\xfa9\xcb\xd0iSkVu\xda\xe4\xa1jN9\x00hnWW\xa5\xe3\xdfI\xa65 \xc9b\xcbA\x8c\xd3\a\xe9\xf4[\x13k\xb6~\xfb\xe0?g?\x13\"\x00\x15\xea9<\xb5\xe9\xf93 3\xc9`N\xe6\x8c\xd3\xcc\xe5\x10\x9e`K\xa2\x10ڲ\xce\x10\xa5\x8c\xb1/\xb8OQ\xf3X\x99\x92\xef-ZB\x96/K\x9e`\x02\xa3KF\xe7\"\x05\xc2\xe6d\x81y\x8dҦ\xd4\xff\xeb\xab\xff\xf8S\x00\xd0\xd9\xda\xe8\xa4\x18$\xd7BӬڶ\f\xf8\xc2P\x94\x15\x104\v\xf1\xdcյ\xc7\xd5\xee\xe3%=\x16\xc1\xaf\xffp;\x8bRյ \xa7)\xacN\x1b\xf48\xc9Ģ\xeb\xfa\xa3\xfejr\x84a\xddq\x84\xb1\x9b~\xe4!\xf6=\xce\xc8R\xdc\xd9f\x9e\x83\xce[\x9d\x12_\x88\xa2\xccl0\xe3\xbd9\xe1\xb8\x17e\x00\u007f#\xdbհ\x9d\xdc+\xcc4\xf7\xd3ڐ7.Y\xd7/#h\xedX&\xe7\x9c\xccUk\xb3R\u0094\xbc\xa7Y6\xa3\xc9\xed\x8d\xf8N,\xd4G~.eP_2\x8f3[\rD\x95&ɲ\xe4\xb7\xf6\x8e\x11?\xf5L\x84\xf8dD\xa9\x8bR\xfb\n\xa3\x06F\xab\xb5#?\x0eJ\x80\xb7\xea\x90S]\x1a3\x83{<uw\xcc\x1ceN\xc0\xac>D\x98\x1b\xbe\x90\x89E5g\xd5<\xc8\u007fx\xf5\xaf\xffn\x19H\xc8\xea%\xf9\xf7WX\\\xa0N\xac\xc0A\xe9m\x14Ɯf\x19\xc8X\xd6`H\xbc\x8b\x15<)'б\x87\xfe\tLכ\x9b\xbf\xa3\xddʴ\x82l~bKS}C\xda\x00\x90\x87\xa8Z\x1d:Yh\xf4\xf7\xe76\x0eW\"+sx\a+\x16\u007f\xd7^\v\x86\xaf\x86ɘ\xd2D\x84\x984\xb3L$\xb7$u`\x1a9\x86\x9b\x8d\xfe\xfbc$8\x8fr\xe7\xba\x1a\x97&Q\x92Ӣ\bu\x0ec\xb1\xa0\xa4w\xade\"\xb7`\xbc\xa9\xb1\x87\xb0\x8c\xd8\b\x87\xfdx\x982\xec\xdfl\xe0\xa7\x06\xe37\xbd\xa0\xc1\x8da\x89\xaf\xc7\xd9\xea\x10X\xb5!\xb5\xdf\t\x86\xeb\xf5!\xb3[\xc8EC\x9d\xcfс\x80\x98\xfc\xd2\x16fy\xe5CϩvvBT\x04\t\xa9\xae\x00\xa9\x982\x8a\xc5g\xa4\xe8\xb7\x19e\xb9sm\x05C\f\x0f9E\xf7\xc5\x0e\xf7\xd5O\x1a4\x19\xf4Z r\a\x14\xbe\x87d[Z\x06\x84}\xcdcy\xf3\x95H\x1d\x18d\xa9\xb6\x03\xbd1\x06\x037\u007fGq\xdf\x10%`\x18s\xfe\\\xe3\xa6͛\xcd/Q\xcc\xd9B\xfcB,\x19\xa7=\x98##/v\v\x18\xd6 \xa4\xe9\xdep\x04\xd40w\x9cWajs<\x82\x81\x1b\x8aqS#\x87o\x0e\x9f\x8d/[$KQ\xd0E\xc4Md\x1b\xb8\xde\x04FR\xb0\x06FDI\x831G\x11\x9eM\x8d+\x1cTH\xab.`\x11 m!V-O\xbd\xc9b[L\xdc\x05\xe7|\x13B\xa5(yj}\xeaux\xe5\xc3\x06\".\x05\x0f\x9f.S\xae=\x19\xb6\x17\xc0\xea\x01\xf3\x1b6\b`\x9c\xbc\x9e\xbe~\xf5\xcb\x11߸\x86\r\xf1\x1d\xd5b\xa9\xc1\x97\x9em\xf5\xfe>\x8aA\x18\xf8\xe0\u070e\xf5\x05\x12,\xae\xed\xbb\x9d\xcf\xe4N2\r\x8d[6\x8f\xd042\x16n\xa3\xb1\xd0qxv\xc1\xc0\xdbi\xe2\xfbs\x13\xa2\xca٣\xf3{˨\x83\xb1\x80L\xa6\xcb#\xadb!v\x88\x8a&\xaa\x0f\x0e\x82!\x1eٙ\x1c*\xec<\x10\xbc\xd5\xd1\xc7\xc1m\xd3\xf9}\x11\xdcس\xb5U\xe7\xf7\x05E\xbfw\xd1\u07b3`D8a\xbc{\xcfb!v\xecٟaIW\x11\xf2L\xb1\x9ceTfk\xb3\xd9\xd7\x16\x83dVj\x02|Ť\xe0y\xcc=d+*\x19\x9de@$`3\x9f\x04\x14\xf9\xfa\xe8\xf3\xd9'\xcc,:6\x923\x18&\xf8])\x15\xe3\x8b-\xeaoLw\x18o98\xd8\"`\x8f\x17CYᒘ\xa7\x15^\x8dƐ\x97\xba\xb4\x97w\xdd'Y\xa9\xd8\xea\xb9\xe4E\x9c\x95Vi\xbb\xbf\x02#\xcd5Xy\xc7\x02\xf8\xc3F\x1b\x99\x9aය\xb5\x04\x86\x83Q)\xab\x1b\x8au\xa6l\x04q\b\u007f\xb9P\xb3\x87\xacs&\xbb\xb6U6\xfd\xdc^9\x1c\xe2\x1a\xd8J\xad\xc1\xa6\x81\xcf\xebV\x0e\xa3\xde\x00\n\f\xa4\xbd\x10\xaas9\x82}\xa6\xdcVJ\xed{\xc4\xdeE\xee\xee~\xa7\xf7\x98\x80gos\xef\xb521\xb7I\x11\x9f!\x03)\xbcи\xa3LW\x95\t\x8c3\xfd6\xec6B4Tl\xab\xba>\xdb\x1d\xb0\xd1=w\xa2\xd7c\x0fm\xd3~r\xdaC>\x0f|}\xf7ww\xbe\xc8x\x92\x95)\xbc\xcdJ\xa5A~\xf2\u05feo\xcel#:\xda\xf9N\xa3\xe8\xc0_\x97\x9d\xd8G&*\x11Eǡ\x97\xf5\xab\x95N\xe1&\x94\xfa\xc2B\xacWq\x97B\xfb\xee\vJ\v\t\x9d\x89P\xbc̲\x8d\xf4wYn\x91\x8ay\xcah\b\x9d\x99\xc1\xbb5u?5c\xa2\xa9\x82\xf6DS\xe3q\xdb\xccNe,A76\xf7\xff`\xff\xcb\xcc\xd6}bk]v\xe7l\x9e\r&/bt\xf1\x04ۊ\xf3\x1a\xbe\xad\x97\xb3\x9f\xdd\\\xf4\x0e7ڞ#\xd2\x03M۴\xe6?\x1fDJ\xf5\xd3\x1b(\xf2\x14\xf20\x86\xb6\x89\xa3\x89\xa3\x9a\xd2\xdcs3\x9aܖ\xc5K@\x18\xb6߿\x86\f\xe5\xf8^d}\xd7|\xd2\"*\aMW\xaf\xa7\xed\u007f16*\xcb4f\xa1v\xa8N\xf6\xe2nēQ!\x18Oي\xa5%\xcdZT\xd6\xc0R\x8dL,Q`ٶq\x8eM\xc2\xdc\xdb-\x9c\x12\x9f\x0e\x15t\x06\xf7yG\xd1Ub\x94a\x97\x10\xd9\xc5D\xdb\x0e\xb8\x8d\x17,\xe6\\\xdc\xd1\xdd~\xa0<\xee\x1ck6\x9a\xfc\x8e\xd2\xc5\x1b\xd7\x00\xc6?\x85\xeb=\xbb|\u05ed\x80\xecq^\xb7\xaf\xf8\xde3\x11w&\xaa\xed]\xd2\xca-\xbaKjb\xa6\xbc:!\x94\xdc\xc2\xda&PR\xee\xbasz\x10\x122\xea/\xab\xbd\x05\x9b\xaa`\xdf\xeb^\xf8\xc3.\xeb[\xd8\xe3\rj-\xd7|\xcf\a\x80q\xdd\xe6\x87*\x90W-\xd5\xddG\xb1O\x1e\xef\x89\xd6\xf5\x90\xfe\x1e#=\xa7]!\xb0\xba%[Y\x14\x1bk͠\xd3\xd0ג\x15X\x84\xb3g\xd6\xeer{\x87m\xf2\x99f,\xad\x80[\x8a\xba\xe0'\xe4Rh\xf3?\xe7\xf7Li\xf5@\x8f\xe9w\x02ԥ\xd0\xf8\xec \x94\xd8I\xf5D\x88}\x18\t\x94[ކ\xa5$\b\xbfZ\xde\xc5\xdc]Xa\u05f7g\x11L\x91\vn\x98\x8c[y\xd5\f[9\xe0\xbe^\x88\v>A\x8e\xe4\xa1\xef\x01Zm\x1aS\x1e\x95B\xb6\xf0\xb5\xe3C{`\u0380\xb8ϣ\x0f\u05fe\x83\xe9\xb9EF\x13H}\x1b]jpA5,XBr\x90{\xef\x9e,\f\x9fڽu\x0f\x86\xc1z)\xbbCU\xd3[\xe8~o\xb2\u007f{\xa3\x15W\xc7\xefQ\xc0u\xae\x9e\xa6\xbe#\xe7\xd5\x03\xfc\xe9\x01\xfcl\xcb\f\xfbQ'hia(\xfb\u007f\r;EB\xf9?RP&Ք\x9c\xb9J\x82\xceo6\x9fw\x9aG\x13\xb4\x81\xca\xd4\xc6M\xea\x94\x13\xb0E\xb1\x9d \xc5|K\xa2\x19C[(\xcbū\x90\xc8\xc1-\xac\x0fNZ'oW\x02\xdb\xc1\x05?\xa8\xb2\xec\xdb\xe7\xc0\xcb\x19\xdb\x1e\xf8\x00\xff\xed`\xba%\x04;\xc1\xee\x15\x8c{(b\xe7?U\x9a\xee\a\x9bX\xb3\xb9\xcf\xfdha\x0f\x1dl\xf5\xafi~\xadE\bM\xb5\xb4\xa5\xc2o\u007f\x8e\xca\x05\xe8.e\xdf\xe9\xaa\x18f\x9f\x923\xbeނ\xda]f]\x99H\x15E\x15\xad\x0e\xebB\xbaD\xee& \x976\xa3hn\xe1o\xee\xc9N\xa4;\x88W\x9f\xf7k\xf2\x9f\xaa\xc7:\xec\xc0\xc6b)\xb6\xc0u\v\xb8\xfa\xbcM9\xb6\x94\x80\xd3B-\x85&G+F]\xa1\x86(Sק]n\xb9\xf5#-:\x95,!-3\xe8\xba\xcac\xab\xe7\x8d\u007f\xd0+.%g\xff,۷\x9axg\x87{z\x9b\x16j<T\x96\\\xc3\rgN\xe0\x9fQ\xe5\xf6\xdfq&\x8c\x83k6\xb9ˈ\xae\x00Zr\x10Jc\xe9\x05\u05cd.\x0f\xde\xe2I\\\xc7]\xf78S\xd5l\xbb)b\xeb\x9ctI\x88\x89\x83\xbe\x11\xbc\xec\xa4)\x9bT\xdc|\xbb\x8b\x8e\xaem\xeaqB\v]\xfa\xcb\xfb\x93Rbo\xfe\xba\x870\xf5\x98qHh\x00ݥ\xad:\xf7\x11\x13\xfc\x86\xe5\xa04ͷ.}\xdfl\u07bd\xf9\xbcA\xae\x90\xa9\x9d\x94\xed\xc0_[\x9eu\v\xfcmË\xd6\x17-\xa4\xd3\x06d\v\x04\xd5\a\x03\x18R\x02+\xe0\xc4\xd5(`x\xd4Z\xb5[ oP[\x96+t\n{(\x98\f9\x17ҶƯ\xa6\xbdy\xd4|%jJ5L:j\xf4z\x9c\xa9\x0e&\x8a\xf9\xcc\xfbY\x05&|;\xb9\x9a`b\x8e\xd9\xca,\xb3\xef\xfa\x94kw\xbf\xf8\x1dH \v\xe0\x06\xa9\x1d.$\xa7gَ\xe8\x06\x95\xee$V~\x80\x1b\xdb<ޘ\xb7vj(\x96*&\xb9K'1\x0f\xd0Ŏ3\xd1U\x83\xeb\xd2\xdb?\x01U\xdb\t#\xad\xe5\xbfo>\xe9Tg\xbbrk\xd9Q{+\x85\xbdɇI\xe8 n7\x19\x81_\xedyn\t)\x96T\xedgsW扪O}\xe3\xb8U\x1c\xeeS\xe7\\\x80\x97\xf9&\xe0\t\xb9\x84\xbb\xad\xdf\xde#A\u007f\xae\xee\x11\xdfz\xe0\x82_I\xb1\x90\xdb-\xa3&\xfe\xc0lQ\xc1\x84\\Q\xa9\x19Ͳ\xf5\xfb\xae\x06\xd1\xfe\xab}\xf1\xa4Z\xc7f\xbf\\h=ړ1\x18F\xd0Ap\xb6\xac\xef\x05\x1e\xe9\xfa\xd6\xf7\xf3\x87\x0f\xf7獇7\x1cz\xb4\xbe\x91\xdf`\xc2\x1dɣ\x8e\x1b\xb8\xd1\xf6O\xccl7\xef\x8e{&\xc7\xdc\x1d\x95\x9c\xf1\xc5\xfe\xe5~\xef\x1e\xea\xe0f\xee\xfd\xa7\xe3g~\x82m\x8e\xb6\xc3w\x1c\xca\xd1:d\xf7\xc6O+\x90\xca:\x01^\xd7\u007f!\xb6l\x04\xc3\xfd\x03\xb1Ԝ6p\xef\xa6\xe2~\xa9\x15\x02[\xa3\xeb<\xe6\x16\xed\xb7\x8c\xa7o|\x1eH\x91\x95\x92f\xee\xcfDp\xab\xed\xab7\xe4\x87\x1f\xbf\"\x0e\x03\x9f\xfd<̏\xff?\x00\x00\xff\xff\xc1T\x93\xf8ǭ\x01\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec\x1c\xcbn#\xb9\xf1\xee\xaf((\x87I\x00K\xde\xc1^\x02\xddf=^\xc4\xd8\xc9\xec`\xc7\xf1%ȁ\xea.Y\x8c\xd9d\x87dˣ\x04\xf9\xf7\xa0\x8a\xfdV?؎\x1dl\x16\xe6ɦ\xc8b\xb1\xde,\x16\xfbb\xbd^_\x88\\ޣu\xd2\xe8-\x88\\\xe27\x8f\x9a\xfes\x9b\xc7?\xba\x8d4W\xc7\xf7;\xf4\xe2\xfdţ\xd4\xe9\x16\xae\v\xe7M\xf6\v:S\xd8\x04?\xe2^j\xe9\xa5\xd1\x17\x19z\x91\n/\xb6\x17\x00Bk\xe3\x05u;\xfa\x17 1\xda[\xa3\x14\xda\xf5\x03\xea\xcdc\xb1\xc3]!U\x8a\x96W\xa8\xd6?~\xb7\xf9~\xf3\xdd\x05@b\x91\xa7\xdf\xc9\f\x9d\x17Y\xbe\x05](u\x01\xa0E\x86[p\xc9\x01\xd3B\xa1\xdb\x1cQ\xa15\x1bi.\\\x8e\t\xad\xf6`M\x91o\xa1\xf9!L*1\t\xbb\xf8Z\xce\xe7.%\x9d\xff\xa9\xd3\xfdI:\xcf?媰B\xb5\xd6\xe3^'\xf5C\xa1\x84m\xfa/\x00r\x8b\x0e\xed\x11\xff\xa2\x1f\xb5y\xd2?JT\xa9\xdb\xc2^(G?\xbb\xc4丅τI.\x12L/\x00\x8eBɔ\xf7\x19p39\xea\x0f_n\xef\xbf'<2\x11:\x01Rt\x89\x959\x8f\xabQ\x04\xe9@\xc0=o\x12l\xc9\x0e\xf0\a\xe1\xc1\"\xe3\xa2=\x8d\xc8-\xae+,S0\xb6\x84\t\x90\xa3\x95&\x95\t\xfc \x92\xc7\"\x0fS\xdd\xc1\x14*\x85\x1d\x82-\xf4\xa6\x1c\x9b[\x93\xa3\xf5\xb2\"!\xb5\x96\xd4\xd4}=L\xdf\xd1V\xc2\x18HINЁ? \x94\xdcƔ\xa9\x97\t0{\xf0\a\xe9\x1a\xbc\x99$-\xb0@C\x84\x06\xb3\xfb;&~\x03_\x89\xce\xd6U\xd8&F\x1f\xd1Ҿ\x13\xf3\xa0\xe5?k\xc8\x0e\xbc\xe1%\x95\xf0Xr\xb4jR{\xb4Z(bB\x81\x97 t\n\x998\x81EZ\x03\n݂\xc6C\xdc\x06\xfel,\x82\xd4{\xb3\x85\x83\xf7\xb9\xdb^]=H_\xe9Ib\xb2\xac\xd0ҟ\xaeX\xda\xe5\xae\xf0ƺ\xab\x14\x8f\xa8\xae\x9c|X\v\x9b\x1c\xa4\xc7\xc4\x17\x16\xafD.\u05cc\xb8f5\xd9d\xe9\xef*.\xbaw-L\xfd\x89\xc4\xc6y+\xf5C\xdd\xcdB<Jw\x92\xe5 \x1eaZ\xc0\xbf!/u\x11U~\xb9\xf9z\xd7\x16\x1d\xe9\xba4gj\xb7\xa4\xa9!<\x11J\xea=\xda\xc0\xb8\xbd5\x19CD\x9d\xe6Fj\xcf\xff$J\xa2\xee\x12\xdd\x15\xbbLz\xe2\xf4?\nt\x9e\xf8\xb3\x81k\xb6\x16$sE\x9e\n\x8f\xe9\x06n5\\\x8b\fյp\xf8\xead'\n\xbb5\x91t\x9e\xf0m#\xd7\x1d\x18\xa8UwW\xc6h\x90C\x95\x0e\u007f\xcd1\xe9\xa8\x06͒{\x99\xb0\x02\xc0\xde\xd8F\xc5]\xdbb\x8d\xeb%\xaf]\x0e\xed\xf6Nڑkk4\xe07\xe2t\xa3\xaf$'O\aԤE\xb6Єa\x0f\"\x94\xc6c\xd3\xeb\x1f\xa4\x1d\xff\x80YN\xca8\x89\xda]9\x88P#\xaa\xa4\xb5\x93\t\xa6\x02k\x93eJK\x05f\x18\xbbܚ\xa3LK{У\xde\x14\x05\x03J{Q(\u007foT\x91\xa1\xbb3\xbf\xa0\xf329\x1f\xd7C\xfe\xe3ഊ\xb3舢\xfe\x80\x96\x14\x8f\u007f`\x1b6\x00\x15X#\x1c\xa6l\xc4\xc4#\x82\x80]\xd87YC\xa5 7)\x1c\xc3:\xb0;U\b\xf7y\xd1\xf0cg\x8cB\xa1\xcf~\xc7o\x89*RLk\xdf4@\x8d\xde.oΦ\xb0\x8b\x17R\x934\x91C%$u\xf3+y\x97\xc1M\n\x8b@\xea/u\x80\b\x92YYnuh3\xd2c6\x88\xe1\x84܅F!\x84\xd8)܂\xb7Ź\xb4T\xf3\x85\xb5\xe24J\xa5*\xf4\x89'R=\xa34\xcaJ&H\xe4\xa9M/\xd3\xe97@\xa2\x831\x8f\xf3d\xf9\x13\x8dj\xdc\n$\x1cQ\xc2\x0e\x0f\xe2(\x8du\xfdH\x04\xbfaRx\x1c\xd6\x11\xe1!\x95\xfb=Z\x82\x94\x1f\x84CW\x19\x89q\xf2L\xa9=5;\xc5\xe2\xb3\xfd4\xec%F1\rƶ\xc0\xe6t\x04&0\xc2ds\x8b\x1c\xa4N\xe5Q\xa6\x85P \xb5\xf3B'a_\xa2\xc6mh_0\xcd\xfa3̃\x19\xad\xf0'\xbet<\x92\xd1\b\xc6BF\xee\xf7|\xa8\x1b]\x03F\xb7\xbf\x13dς\xb1\x06K\xf1t\xb9X\xcaή\xb1\x17\x97\x13\xc0k\ue120M\x89\x1d*p\xa80\xf1Ǝ\x91e\x9e\xe9\xa1\xc5\xd9\xc2\x11z\x0eX\xc5\xc6\xeeӖ\x9b\rN\x02\x052\xf9O\a\x99\x1cB|E2Ő 5\xe8\xd8\x16\x88<W\xa7\xf1\xcd¼$\x94\vM\x9b\x83\xa6\xcd\x1a\x86>\xcca\x13Ѵ({ڴ\x19\xcbڥs-\"od\xae\x9cƳ\x04\xfa\xf6l\xf2K\v4\x11X\xd2\xd9\xeav\x0f\x98\xe5\xfet\t\xd2W\xbd\xf30)\fjp\xf8M0\xea9\xfap۟\xfb\xc2\xfa\xf0\x02\\\xaaQ\xf8\xbff\x12;\x9b\xaf\xa5\xafY\xc0\xa0O\xedy\x97 \xf75\x83\xd2K\xd8K\xe5\x91\xe3\x9ei\x14[\xaeo\x96S/E\x968\xafI-\x13>9\xdc\xd4G\xc8\xd9\xf1=\n\xf5\xa7\x87X\xb9:It\x9d\xfc,d\xe0\f\x83\xb4\x98\x85\xbc\xc5\x1d\xeb@\xd3Ñڇ\xcf\x1f1\x9d&\x14\xc4J\xe4\xd9v>\xf4Pn/_\x1e\x03\xe27S\x06T\xf5\t+\xe4\xa3.A\xc0#\x9eB\x14$4\x10\xa3\x04-5z\x908'\x12'\xc6\xd8D<\xe2\x89\x01\x95\xb9\xae\x88\xf9\xf1\xa2\x11\xda#\x9e\xe2\x06\xf6HI\x98\x95\x99\x80@S\xea`\x82p\xcad\t\x19\x813\x97\xac!\xe0M\xcc&a\x89\xb9\xa9Zŉgm\xb7fc'\x8d\xfb\x88\xa7w.0\x8c\xb4\xe3 \xf3\xe8\r\x93\x01\x06\x87\xacGU&\xf3^(\x99\xd6K\x05}\xb8\xd5SQw\xb7}6\xfeV_\xc2\xcd7\xe9\b=\x9d\xc2G\x83\xee\xb3\xf1\xdc\xf3j\x84\r\xe8?\x8b\xaca*\xab\x9e\x0ef\x9e\xe8\xd1N\x90F\t}h\xb7\xe1\x84Y\xb3J:\xb8\xd5tV*\xe9\xc2in\x86\x19/\x96\x8cRV8΄j\xa3\xd7\xech7\x03kE\xc3,\xd9cl\x87;m\xf4Z\xcbFC\xa5\x03]@\xed\x8e|O\x80\x10\xd2\xf7J$\x98BZ0QE4D\xe7\xad\xf0\xf8 \x13\xc8\xd0> \xe4\xe4\vb\xb9\x11m\x9fC[,s\xb1\xa1A\xd5JC\x9fƠ\xb4&\xbd\x8e\x1aW\xb1?b\xf0`>zzp\xcc\xde\xd8As\x1c\x13Am\x91\xa6\x9c\xb0\x15\xea\xcb\"/\xb1\x88;\xe7\xf1C@/\xf8\xd7Lp\xa2\xf4_\xe4\"Y\xd8\xff\r\xb9\x906J\xcb?\xf0\x15\x9f\xc2\xce\xec2\xeb\xd6^\x88\u0590\x0e\x88\xe3G\xa1\xfa\xb7\x1d#[4d\x81P\x85P\xc0\xec\xcf\"\x9fKx:\x18\x17<\xf2^\xe2Hr\xb8ۤ\x83\xd5#\x9eV\x97gviu\xabW!D\xe8k}\x04\xd8:\xe20Z\x9d`ųW\xff]8\x15-\x9d\x91\x03\xf9>8>\x10\xa7\x93l\x15M\xd0\xd4\xfa\xf2\x91B\xe8i\xec#e37\xce/@\xe8\x8bq>dD;\x01\xef\xb2|\x1b\x04\xb9*\xf3l \xf6\x1e-8olu\xd5GF\xb2\x976&.\xba\xb9\x03\a1\xb6\xce\xde\x05\xb0t\x98[5\xfa\x1d\xec\xef*\xdc\x01\xd2\xdfs\x10\x13\x0e`\x18rnM\x82\xce͉M\x94\x85\x9fIl\xd6IM\x11\x0eK|\xb76+\xacs\xc9֪-\t\x85\x89\x9c\x8b\x8f\x137\xdfZyY2\x1f\xf4\xff\xbc\xc8.\xc7\x0eX\xeb\xb3L\xe8(\av\x86\xe8u\x98[\xa9X\t*\x1cQ\xecC\xc1\xe6bI\xe4Z\n߯'\x18Ȥ\xbe\xe5E\xe0\xfd\xab\x84\x0f\xb5\xd1\xc5\xe7\x1d\x1f\xae\xab\xd9\r\v\xea\x8e\xe1Kұ\x96\x1b\xbe\xaf\xb0\xd8\xe1\xe4yV\u007fQج\x8do\xa7>\brn\xd2w\x0e\xf6\xd2:\xdf \x1b\rS:\xbe$}\xbd\xb3\x9c\xbe\xb1\xf6\x99G\xb9\x9f\xc3\xdcV2\xee`\x9e\xea\v\xfd\xf1\x8bߡ\xc6\xd7c\br\x0f\xd2\x03\xea\xc4\x14\x9a\x93Fd\fx\x91\xc0\x8exA\x86X\xbf\xd74\xd4E\x16K\x885K\xa2\xd43\xf9\xa5\xf6\x84\x1f\x85T\xaf\xc5F/34ŌcnZ\xb7\x1e!\xcc\xedTjd\xe2\x9b̊\fDF\x8c\x88&9E\x1b2î\f\xc0\x93\x90\x9e=\x12Af\xf7\xe4M4\xc8\xc4d\xb9B\x8f\xb0ý\xb1\xac\xefN\xa6X\xbb\xfeR.\xcc\xf8\xbdc\xbf\t\xd8\v\xa9\n\x1bmu\x17rc\xd9\t\xa94</{\xf0\x89Ea\xcd\xe4\x8bJGG\x87\xb4s\x9e \xb7K\x02\xda/\x16_:|̭$Y4s\x11\xe4\fD\x8e/\xbb\x11d)\xa2B\x9f\xc6B\xc8\x19\x98\x8c\xc5[\b\xf9\x16B\xc6\xc1}\v!\xdfB\xc8\xf9\xf6\x16B\xbe\x85\x901\x13\xdeBȷ\x10r9\n\xff\xfb\x10r\x1e\xb35\xe7>G\u007f\x8e\xc0&\xaa\x84`\x1a\xd9\xc9U\xcaj\x98kU8\x8f6\xbe\xe6\xf6vx\xde@\xfdu\x12\x86\xac\xf9aΰl4\xe5\x16\x8d˪\x8bpI\xd9*E\t\xf5\xfa\xb3\xd1qdq\xedX\x9dv\\)\xd7\\\x01W\xb7\x06\xb9.\x9e\xaa\x8a\x90\x87\xadF\xb9tɭ\xf0\xe2\xa3]\rԭ\xc3\xe2ȼ\xc2\xf6WT\x9f\x1cUb5SX5]\xc2m\xaa5F\xa8\xd8I\xc0w\xc9g;eĿB\xea\xcd\xd6>\x8dW<\x957r\xe8\xc5\xf1\xfd\xa6\xfb\x8b7e\xfd\x13<I\u007f\x18\xdc\x14\xbfA\xa1\xe3\xa2~h\x17FW\xb2X\xbe\xf1\xeaS\x15\x8c\x05-\xd5pM\x03\x11\xbc\x9a\xdf!7\xfc\x9c\x87C\xe9\xb3\xf4w\xee\x98\x14[#\xf5\xecʨn\xddӨ\x81_~\x81\xb7\xa4\xac<\xbe\xf6i\xaeTiI\xc5S\xbb\x9ai\x02dl\x9dS܉w\xb6\xa6\xe9\x19\x95L\xd1u\x95/r]\x19W\xab\xf4\x1a\x15J\v꒺\xf5F3p\x97U#E\x92)\xa6\xf2hq\xbdQY\xdb3\xb3\x9f\x88*\xa3\xd1\xea\xa1\x19\xd0\x03uL\xf35Cs\xe4\xef\xa0\xf2\"\x95BϨ\x0fz\xd9:◊\xba\xa7\xaa}\"j|\"\xe2\xf29L#\xaax\x96\xd5\xeeD\xd0\xf0\x99u:u\x15\xce\xe8\xdaK\xabs\xba\xb57\xa3`cjrF*nFaNV\xe2\xc4\xd6ٌB\x9fu\xdf3\x923\xf9\xb3\xb1)ڙ\xa09^ff䥛\xfc\xea\xad\xdc{\xd5P\xbd\x86\xa5Q\xed`|\x98N\xa6\xae\xb9O\xe0'\xa9\xd3@^\x92\x91\x96[\xe6\xa7\xf3\\\x17T\xc7\b,\xa5\x83\x10\xab\x10\xacw\bp\x98\v\x8b|\xddq\n\xa9\a\xb7\x81\x1b\x91\x1c\xba\x03\aA\x1e\x84\xa3\x83e&<\xac\xea\xf3\xd4U5\x8fzV\x1b\x80\x1fM}|m\xbf\x86s2\xcbհ\xda\x17\x0ea\xd5\x05\xf3\xfc\xe3\xc1\x88\x9c8-rw0\xd5\xd3\xe7\xd9\x13\xc2\xd7\xee\xf8\x81#z\xf5\xf09Q\xa6Hk\xf8\xa3\xec\x15\xfa\x04_\xee9\b\xe1\a\xa1I\xf3T\xb6\f3\xaa\x90\xbf\xff\x92v\xf8\x15\xfb\x02\x92\x8c\x1dٝ7V<\xe0'\x93\xb4>\xf31E\x93\xee\xf8\xceW\x1cJ#Q%\xe5\xca\xea\xb5\xe1\xe3M\xb9\xa3>\xb8&\x17_\xeaN\x93\xd7 L\x87\xedǤ\xc6z\xaff7uw\xf7)l\xc4\xcb\f7\x1f\x8b\x90.Y\xe7\xc2:$\xdaV\x1b\f\x93vc\x8e\xe4`\x9e@\x99r\xf7?\xf4\xf1\xb7\xc8Y\u007f\xce\xcb,\xdeExE_\tdE\xaey\x11\xbe\x1f\x9e\xd7:\xa1\xb5\x98\xc6\xfa<&\xbbc\x90\x84s&\x91\x82\xdf\x0eK_>\xf7y\x8d\x87\xdf\xe3\x81È\xd2\x0f\xc5;\xeb\xa1\x0f-\xac\xeb\xaf>\\\xcc\x00u^\xf8\xc2\xcd\u007f6\x83\x87A\"r_\xd8\xd2\x17$\x85\xe5'\xe0\x04\x02\xc3K\xe9\xe5\x1f\xceP\xc2\xf9 X\x93ߧ\xf8T\x0fkNs·\xac|\xa5y\xf0$\x1c\u007f\x99\"\xe4\xe4\xa5\xeb~r\xa8\xddF\xbeM\x11\xdc\xc0\x16R\xe1qM\xb0{\xbfOZ\xa6Qf\xf3\x13\xf9\xc9\xdd}\xa1\x11\xf5\xcd_IV\x9eV=\xac\x1f\xd9\xc9\xd0\xd5\xce\x1a>\xe3\xd3Yߍ&\xc4\xfb9\xd7p{\x83\xe9}\xfdU\xa4\xd8M5\xdfQ\xe2˲3\x05\xe8\xealop/\xa3'\x94j\xc1\v\x17c\x0e~/\xcf\r-\x1f\xd3\x13\xda\xc9\x1fz\xbf\x8dh\xe1\x84\x06\x0ek߀\x92\xf4\xba\x9a/g\xbdo\xfe\xe3\xa5\xd7嗲\x8e᎙?M\x95\xb6d\xa5\xf4LeO\xa3y\"I0\xf7eƸ\xfdɬՊ\xff\xa9\xbe\x88\xc5\xff&F\x87\xb8\xcfm\xe1\xaf\u007f\xbb\x80ҋ\xdcWxP\xe7\u007f\x02\x00\x00\xff\xff\xdb*.\x0eeL\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VM\x8f\xe44\x13\xbe\xe7W\x94\xf6=\xec\xe5MzG{A\xb9\xa1\x06\xa4\x110\x1aM/sA\x1c\x1c\xa7\xd2mƱCU\xb9\x87\x06\xf1ߑ\xed\xa4?\xd2\xe9\x9d\xdd\x03\xbe\xa5\\\x1f\x8f\x9f\xfaH\x15eY\x16j0\xcfHl\xbc\xabA\r\x06\xff\x14t\U0004bad7o\xb82~\xb5\xbfkP\xd4]\xf1b\\[\xc3:\xb0\xf8\xfe\t\xd9\a\xd2\xf8\x1dv\xc6\x191\xde\x15=\x8aj\x95\xa8\xba\x00P\xceyQQ\xcc\xf1\x13@{'\xe4\xadE*\xb7誗\xd0`\x13\x8cm\x91R\x84)\xfe\xfeC\xf5\xb1\xfaP\x00h\xc2d\xfe\xc9\xf4Ȣ\xfa\xa1\x06\x17\xac-\x00\x9c\xea\xb1\x06F\x8aF\xa2$0\xe1\x1f\x01Y\xb8ڣE\xf2\x95\xf1\x05\x0f\xa8c\xe0-\xf90\xd4p\xba\xc8\xf6#\xa8\xfc\xa0Mr\xb5I\xae\x9e\xb2\xabtk\rˏ\xb74~2\xa3\xd6`\x03)\xbb\f()\xf0Γ<\x9c\x82\x96\xc0L\xf9Ƹm\xb0\x8a\x16\x8d\v\x80\x810]\xfc\xe2^\x9c\u007fu?\x18\xb4-\xd7\xd0)\xcbX\x00\xb0\xf6\x03\u0590\\\x0fJc\x1be\xa1\xa113c\xb8촆\xbf\xff)\x00\xf6ʚ6\xf1\x9a/\xfd\x80\xee\xdb\xc7\xfb\xe7\x8f\x1b\xbd\xc3^e!@\x8b\xac\xc9\fIo\xe9\xf1`\x18\x14\x8c@A<(\xad\x91\x19t B'cL0\xae\xf3ԧp\xa3c\x00\xd5\xf8  ;\x84甓\xf1\xe9ը0\x90\x1f\x90\xc4L\xe8\x93ɩ>\x8f\xb2\x19\xc6\xf7\xf1\x11Y\a\xdaX\x91\xc8)\xc6XW\xd8\x02\xa7\a\x82\xef@v\x86\x810\x91\xeb\xe4\x12]\xe2\xa4\x03\xe5\xc07\xbf\xa3\x96j|=\xc7,\x06\xdb\xc62\xde#\t\x10j\xbfu毣g\x8e4ĐV\xc9T@\xd31N\x90\x9c\xb2\x91\xfe\x80\xff\a\xe5Z\xe8\xd5\x01\bc\f\b\xee\xcc[R\xe1\n~\xf6\x84\x89\xc0\x1av\"\x03\u05eb\xd5\xd6\xc8ԑ\xda\xf7}pF\x0e\xab\xd4W\xa6\t\xe2\x89W-\xeeѮ\xd8lKEzg\x04\xb5\x04\u0095\x1aL\x99\x80\xbbԐU\xdf\xfe\xefX$\xefϐ\xca!\xd6\x13\v\x19\xb7=\x8aS\x8f\xdc\xe4=\xf6G\xae\x86l\x96\xf1\x9f荢\xc8\xca\xd3\xf7\x9bO0\x05M)\xb8\xe4<\xb1}2\xe3\x13\xf1\x91(\xe3:\xa4\x9c\xb8\x8e|\x9f<\xa2k\ao\\\xae%m\r\xbaK\xd294\xbd\x11\x9e\xaa4槂u\x9aK\xd0 \x84\xa1U\x82m\x05\xf7\x0e֪G\xbbV\x8c\xff9\xed\x91a.#\xa5o\x13\u007f>N/\x153[G\xf14\xeb\x163\xb4н\x9b\x01u\xccY$.ښ\xce\xe8\xd4\x06\xd0y\x02\xb5dR\xbd\x89!O\x99\xafA1Έ\x8cc69b\x0f\xbe\x85ciT$\xf9N1^\x8afh\x1e\xa3\xc6<\xb25\x1dꃶ\x98\x1d\xe4I\x81o\x81\x88\a]\xe8\xe7\xf1Jx\xc0\xd7+\xd9#\xf98'Ӥ>?\x8b\xf9\x87\xfcs\xd9\x1aǟ\u007fM\xd6I\xbf\xab\xf3\x91{6jG7@\xc1\xb9ؑ\xdeE\xf1\xcc)\\N\xe4٭\x11\xec\xafp,\"\xb9w\x9dO\xbf{\x15C*\xc9}\x82cR\xc7\x18\x19ѕ\xbb[9\xcdg>\x8a\xbe\x80\xc0|\xd2\xca\xf0\xf5\x86qt\x18\u0085\x98e² \x8e\x91\xaeċ\x1d3\"\v֪\xc6b\rBan\x99\xed\x14\x91:\\V\xc5TF\xa7\xe5\xe8\xb3\x05r\xa5\x1ek\xffu\x87\xeeV\x85ë\xe2\xa5\xdcd7\xd0\x1cn\x19\xae\x8f[\u07bcIrY\xd6\x10\xa7n)报/ b!K\xb9T\x17\xb6\x83+\x126\xe7\x9aS\xef_\x14\xfc\xb4,̑\xdf\b\xbe\x90ԙ\xe8\xb4\xd4ޝ\xbeRa\x97\xe3\x12\x9b.\xc6W\xb4g/g\xf1\xa4\xb6\x13\x17\xa7\xd9\x1a\u05ecA\xb0}\x98\xaf\xb0\xef\xde]\xec\xa2\xe9S{ך\xbc\x81ï\xbf\x15\xd9+\xb6\xcf\x13\x8e(\xfc7\x00\x00\xff\xff\xad\x01\x9a\xeb\x00\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V\xc1\x8e\xdc6\f\xbd\xfb+\x88\xf4\x90K\xed\xc9\"\x97·`\xdb\x02A\xd3`\x91M\xe6R\xf4\xa0\x91\xe8\x19veI\x15)\xa7ۯ/$\xcb;\xe3ٙ\xa4E\x11\xdfDS\xe4\xe3\xe3#\xa1\xa6m\xdbF\x05\xdabd\xf2\xae\a\x15\b\xff\x12t\xf9\xc4\xdd\xc3\x0fܑ\xdfL7;\x14u\xd3<\x903=\xdc&\x16?~@\xf6)j\xfc\x11\ar$\xe4]3\xa2(\xa3D\xf5\r\x80r\u038b\xcaf\xceG\x00\xed\x9dDo-\xc6v\x8f\xae{H;\xdc%\xb2\x06cɰ\xe4\x9f^u\xaf\xbbW\r\x80\x8eX\xae\u007f\xa4\x11Y\xd4\x18zp\xc9\xda\x06\xc0\xa9\x11{\x98\xbcM#\xb2S\x81\x0f^\xac\xd7s\xb2nB\x8b\xd1w\xe4\x1b\x0e\xa8s\xee}\xf4)\xf4p\xfc1\x87\xa8\xb8暶%\xda}\x8d\xf6\xaeF+\x0e\x96X~\xf9\x82\xd3;b)\x8e\xc1\xa6\xa8\xecUdŇ\xc9\xed\x93U\xf1\x9aW\x03\x10\"2\xc6\t?\xb9\a\xe7?\xbb\x9f\t\xad\xe1\x1e\x06e\x19\x1b\x00\xd6>`\x0f\xefs\x05Ai4\r\xc0\xa4,\x99r\u007f\xae\xc9\ato\xee\xden_\xdf\xeb\x03\x8ej6\x02\x18d\x1d)\x14\xbf+\xc5\x001(X\xd0\xc0\xe7\x03F\x84ma\x0eX|D\xae\xc0kH\x80\xa5\x02\xee\xaa)D\x1f0\n-\x04\xe7\xefDaO\xb63</3\xe0\xd9\aL\xd6\x142\xc8\x01\xa1*\x03\rp)\x06\xfc\x00r \x86\x88\x85)'\xc7V-\x9f\x1f@9\xf0\xbb?PK\a\xf7\x99\xcd\xc8\xc0\a\x9f\xac\xc9B\x9c0\nD\xd4~\xef\xe8\xef\xa7\xc8\f\xe2KJ\xab\x04kO\x97\x8f\x9c`t\xcaf\xaa\x13~\x0f\xca\x19\x18\xd5#D\xcc9 \xb9\x93hŅ;\xf8\xd5G\x04r\x83\xef\xe1 \x12\xb8\xdfl\xf6$\xcbLi?\x8eɑ<n\xcad\xd0.\x89\x8f\xbc18\xa1\xdd0\xed[\x15\xf5\x81\x04\xb5\xa4\x88\x1b\x15\xa8-\xc0ݬ\xf2\xd1|\x17\xeb\x00\xf2\xcb\x13\xa4\xf2\x98\xc5\xc1\x12\xc9\xed\x9f\xccE\xe2Wy\xcfڞ\xdb>_\x9b\xf1\x1f\xe9ͦ\xccʇ\x9f\xee?\u0092\xb4\xb4`\xcdya\xfbx\x8d\x8f\xc4g\xa2\xc8\r\x18\xe7\xc6\rя%\":\x13<9)\am\tݚtN\xbb\x91$w\xfaτ,\xb9?\x1dܖ\xcd\x02;\x84\x14\x8c\x124\x1d\xbcup\xabF\xb4\xb7\x8a\xf1\x9bӞ\x19\xe66S\xfau\xe2O\x17\xe2\xdaqf\xeb8DuU]\xec\xd0\xe5I\xbd\x0f\xa8W\x83\x92c\xd0@ur\a\x1fA\xadجS|9Zw\xe2zi\x80a\xde\xe0\x03\xed\xd76\x00eL\xd9\xfe\xca\xde]\xb9w\x95\x9e\v\xb5ޖ\x1cY\x8e\xb9\x80\x10\xfdD\x06c\xbb\xd4V1\xa4X\x8b,\xbb\xb1k.\xe5:c\xb8\x16V\u009d\xc3[!\xb8\xabN\x19C\xa6u\xb94\xef\x1d\xac\xeb\xaf,C\xb5\xc7˹\x9fՙ\x15L\x11WS\xd8>\x85\xfe\xaa:DI\xe2\xff\xaa\x8fr\xa9z\xee\xaaFt\x8a\x11\x9dԈ\xe0\x87\x15|\xf5\xff5\x12\x0e\x8a\xf1\x8b\xfc^\x8e}\x97\xef-\x94[\x1aP?Z\x9c\xa3\x95m\xfeLP\xff\x1ai\xfeХ\xf1\x1cT\vo&EV\xed,>\xfb\xf3ɩ+\xff\xae\xf4\xf7B\xdb\xceL\xc7\a\xce\xcd\xf1T\xc8k\x97\a\xcd\xcd\xfcB\xc8K\xd3\xf4 1\xcdɫҪ\xe5\xa8\x05\xa55\x06A\xf3\xfe\xfc-\xf3\xe2\xc5\xea9R\x8eڻyL\xb9\x87\xdf~o\xe6\xa8h\xb6\v\x8el\xfc'\x00\x00\xff\xff\xbcn\x89\xa9\f\n\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WAoܸ\x0e\xbe\xfbW\x10}\x87\xbc\a\xd4N\xfbzy\xf0\xedm\xda\x05\x8a\xcd\x16Ťͥ\xe8A#qlmd\xc9+R3\x9d\xfd\xf5\v\xca\xf6d\xc6\xe3L\xd2\xc3F\x03\xb4\xa6$\x92\xfa\xf8\x91\x94\x8a\xb2,\v\xd5\xdb{\x8cd\x83\xafA\xf5\x16\x7f0z\xf9\xa2\xea\xe1\x7fT\xd9p\xbd}\xbbFVo\x8b\a\xebM\r7\x898t+\xa4\x90\xa2\xc6\xf7\xb8\xb1\u07b2\r\xbe萕Q\xac\xea\x02@y\x1fX\x89\x98\xe4\x13@\a\xcf18\x87\xb1l\xd0W\x0fi\x8d\xebd\x9d\xc1\x98-L\xf6\xb7o\xaaw՛\x02@G\xccۿ\xd8\x0e\x89U\xd7\xd7\xe0\x93s\x05\x80W\x1d\xd6`\xc2λ\xa0L\xc4?\x13\x12S\xb5E\x871T6\x14ԣ\x16\xa3M\f\xa9\xaf\xe1qb\xd8;:4\x1c\xe6\xfd\xa8f5\xa8\xc93\xce\x12\xff\xb64{k\xc7\x15\xbdKQ\xb9s'\xf2$Y\xdf$\xa7\xe2\xd9t\x01\xd0G$\x8c[\xfc\xea\x1f|\xd8\xf9_-:C5l\x94#,\x00H\x87\x1ek\xf8\xa4:\xa4^i4\x05\xc0V9k2\x14\x83ߡG\xff\xff\xcf\x1f\xef\xdf\xdd\xe9\x16\xbb\f\xb6\x88\r\x92\x8e\xb6\xcf\xeb\xe6~\x83%P0z\x01\x1c\x0e\x8e\x81\xf2\xa0\"ۍ\xd2\f\x9b\x18:X+\xfd\x90\xfaQ'@X\xff\x81\x9a\x818D\xd5\xe0k\xa0\xa4[P\xa2mX\b.4\xb0\xb1\x0e\xabqK\x1fC\x8f\x91턲\x8c#~\x1dd3\x87\xaf\xe4D\xc3\x1a0\xc2($\xe0\x16a;\xc8\xd0\x00\xe5\xd3B\xd8\x00\xb7\x96 b\x86\xd2\x0f\x1c;R\v\xb2D\xf9\xd1\xf3\n\xee\x04\xeeH@mH\xce\b\r\xb7\x18\x19\"\xea\xd0x\xfb\xd7A3\t.b\xd2)\x9e\x880\xfdY\xcf\x18\xbdr\x12\x8b\x84\xafAy\x03\x9d\xdaCČN\xf2G\xda\xf2\x12\xaa\xe0\xf7\x10\x11\xac߄\x1aZ\xe6\x9e\xea\xeb\xeb\xc6\xf2\x94Q:t]\xf2\x96\xf7\xd79/\xec:q\x88tmp\x8b\xee\x9alS\xaa\xa8[˨9E\xbcV\xbd-\xb3\xe3^\x0eKUg\xfe\x15\xc7\xf4\xa3\xab#Oy/\xec!\x8e\xd67\aq\xe6\xf9\x93\xb8\v\xcf\az\fۆ#>\xc2k}\x93\x03\xb1\xfap\xf7\x05&\xa39\x04G*\x0f<9l\xa3G\xe0\x05(\xeb7\x18\xf3\xae\x81e\xa2\x11\xbd\xe9\x83\xf5\x9c\xd5kgџ\x82Ni\xddY\xa6\x89\xb6\x12\x9f\nnr]\x815B\xea\x8db4\x15|\xf4p\xa3:t7\x8a\xf0\x1f\x87]\x10\xa6R }\x1e\xf8\xe3r8\xfd\xc9\xfezD\xeb \x9e\xea\xd5b\x84f\xa9|ף\x96x\th\xb2\xcfn\xac\xce)\x00\x9b\x10A=f\xf6\b۔\x97O\xe5\xa6\fV\xb1A>\x95ͼ\xf8\x92\x97\x88\xe1]\xabNKȿ\xb1j*\xa9\x034\xba0T\x86\xff\x1c[\xbed}\x89\xa3\x8b>LT\x95\xa3\v\x8e\x92\xe8Rz\x8e\xbd\x99\x1b\x95\x81>uK\xcaK\xf8%{z\x1b\x9ab6u4{\x13<\v\xa1/,\xb9\x0f.ux\xe7UOm\xb8\xb8rj\x9a\x87Fr:JX\xa1\x94Z|ʥqz\x85\x94\x1cӥ%\xef\xe3~\x95\xfc\n\xfb\x10\x97,-\x12v\x1a\xd2$\x9f\x8d\x86\xf4\xa8)\x1a\xb2A\xa2!\xff\x97\xc6\x1e=2\xd2c\xb9\xd8Yna\xd7Z\xdd.h\x85\\\x00r \xa5\x0e\x11\x05msf\xff\x9c\xdb\xc2w\x1b\xf1\x8cFe&יP\\\x9e\t\x17ssYq9\xe6L\xf1\xccnb\xc5\xe9\x84\xef\x17s;\xaf\x9e@\xd5)F\xf4<\xea\x10x\xd5|CU<\x9f^Sf|]\xdd\xd6ŅxN\xaa\xbf\xaen\xa5I\xb2\xb2~\xf0\xa3\x8fX\x92m<\x1a\x909\xc9q\x11\x9f\x010\xfc\x8e\xef\x02\xcfF\r\x7f\xf46\x1e]m\x9ep\xed\xc3a\x99`\xb3k\xd1\x0f\xadd\x86Ơ\x0e)\xb7g\xadN/\x052\xd6\b\x06\x1d2\x1aX\xef\xf3\xd9hO\x8c\xdd\xdc\xdfM\x88\x9d\xe2\x1a\xa4\xc1\x94lψ\"\xf7P\xb5vX\x03Ǆ/=l\xdf*\u008b\xe7\xfc,+\x96\xc2\x7fH\xaeى\xab\xe2\xf9JW\xc2'ܝ\xc9>Ǡ\x91\b͋\xbd\x8f\xe1\xc7\xfe\xb2\xf7\xb2b\fP\xc4\xec\xee}\xbeyC\xbe\xf1\xc6៱|g\xf2\xe4\x8b\xe3\xeb\x99N\x98\x02\x8c\xd3\x15s\xbc|\x82\vC\xa7\xbb\xa2\x03\xa9\xa1\v\x06\xc5f6^\xfdL\x9c.\xb5\xa3^q{.\x9d\x9fWq;\x05K6L1\x9a|\xab\xe0#\x83\xf5\xda%#\x9c\x04\x0e\x0fx\xceI\x19\xdc*\xbe\"\b\xde\xed!?\v\xa4\xa3%\x92\xcbӮ\r\xa0\x95\x87\x88ʼ\x84\x01\x17c(\xbf>\x98\xe7O\x16\xccRe?\x89\xe6\x15A\x1f~\xb6F\x8b\xf9\xc8/\xb0\x1f\xf9\x80l\x88rCT|\xee\xc2D\xa8\t\xf0\xa5~\b\x10\xfc\x92\x93S\x8a[\xcf\xef\xfe\xbb0?`(\xf7\xfe\x06\xe3\x8b;\x8d\xd0\xe0\\\x18λ\xcfBW~\xa2\xfb,\x88g\xa2\xf1\x89T\xc3\xf6\xed\xe3W\xee9\xe5\xf8T\xce\x130\xc0e\x8e\x92aL\xacQ\xf2ث\x94\xd6\xd83\x9aO\xf3\xc7\xf2\xabW'\xaf\xdf\xfc\xa9\x837\xf9\xf9O5|\xfb.oX\xb9~\x98\xf11G5|\xfb^\xfc=\x00\x1bNV\xebf\x10\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Zݏ\xdb\xc6\x11\x7f\xd7_1\xb8<\\\x03\x9c\xa4\xd8)\x8aBo\xf19.\xd4&\xe7\x83\xef\xec\x17\xc3\x0f#\xeeP܊\xdcew\x96\x92\x95\xa2\xff{1\xbb$ů\xd3\xc9\x0e\xe2\xe4\x04\xc4\xe2\xee\xce\xfe\xe6\xfb\x83\x9a\xcd\xe7\xf3\x19\x96\xfa\x039\xd6֬\x00KM\x9f=\x19\xf9Ƌ\xdd\xdfy\xa1\xedr\xffbC\x1e_\xccvڨ\x15\xdcV\xecm\xf1\x8e\xd8V.\xa1הj\xa3\xbd\xb6fV\x90G\x85\x1eW3\x004\xc6z\x94\xc7,_\x01\x12k\xbc\xb3yNn\xbe%\xb3\xd8U\x1b\xdaT:W\xe4\xc2\r\xcd\xfd\xfb\x1f\x16?.~\x98\x01$\x8e\xc2\xf1G]\x10{,\xca\x15\x98*\xcfg\x00\x06\vZAi\xd5\xde\xe6UA\x1bLvUɋ=\xe5\xe4\xecB\xdb\x19\x97\x94ȥ[g\xabr\x05\xa7\x85x\xb6\x06\x14\x99\xb9\xb7\xeaC \xf3*\x90\t+\xb9f\xff\xaf\xa9\xd5_4\xfb\xb0\xa3\xcc+\x87\xf9\x18DXdm\xb6U\x8en\xb4<\x03(\x1d1\xb9=\xbd7;c\x0f捦\\\xf1\nR̙f\x00\x9cؒVp\x87\x05q\x89\t\xa9\x19\xc0\x1es\xad\x82(\"n[\x92\xf9\xe9~\xfd\xe1Ǉ$\xa3\"\b[\x1e\x97Ζ\xe4\xbcnؓ\xbf\x8eb\xdbg\x00\x8a8q\xba\f\x14\xe1ZH\xc5=\xa0D\x95\xc4\xe03\x82}|F\n8\\\x036\x05\x9fi\x06G\x81\a\x13\x95\xdb!\v\xb2\x05\r\xd8Ϳ)\xf1\vx\x10>\x1d\x03g\xb6ʕ\xe8\x7fO\u0383\xa3\xc4n\x8d\xfe\xad\xa5\xcc\xe0m\xb82GO\xec{\x14\xb5\xf1\xe4\f\xe6\"\x84\x8an\x00\x8d\x82\x02\x8f\xe0H\xee\x80\xcat\xa8\x85-\xbc\x80_\xad#\xd0&\xb5+ȼ/y\xb5\\n\xb5oL9\xb1EQ\x19\xed\x8f\xcb`\x90zSy\xebx\xa9hO\xf9\x92\xf5v\x8e.ɴ\xa7\xc4W\x8e\x96X\xeay\x00n\x84Y^\x14\xea;W\xdb=_w\x90\xfa\xa3\xa8\x8d\xbd\xd3f\xdb>\x0e\x06\xf6\xa4\xdc\xc5\xc0@3`},\xb2x\x12\xaf<\x12\xa9\xbc\xfb\xf9\xe1\x11\x9aK\x83\n:$\xa1\x96\xf6\xe9\x18\x9f\x04/\x82\xd2&%\x17NA\xeal\x11\xe4LF\x95V\x1b\x1f\xbe$\xb9&\xd3\x17:W\x9bB{\xd1\xf4\x7f*b/\xfaY\xc0mph\xd8\x10T\xa5BOj\x01k\x03\xb7XP~\x8bL\x7f\xb8\xd8E\xc2<\x17\x91>/\xf8n\x1cj\xfe\x93\xf3\xabZZ\xed\xe3&PLjh\xe0\xfb\x0f%%\xa2/\x11\x9a\x9cөN\x82\v@j\x1d\xe00T,:d\xa7\\S\xfeb\xe4z\xf0\xd6\xe1\x96~\xb1I\xc7ɟ\xc0\xf4j\xeaD\x83Jb\x9b\xf8\xa0\xfc;\x92\x06\x8e\xb4\a$\x01\xf2\xe6\xe8!#G\xc1\x10\x1c\xb1\u05c9\x18\x92e\xed\xad;\nY9O\xaa\xcb˓B\x97\x8f\xb1\x8a\xce⿳\x8a\xa6\xe0\xcaA\xf0\x19F\x9b\xbc\xb7J6\xb9\xca\x18\xf1\x02k.\x06P\xa2\xcf~\xfe\x9c\xe4\x95\">\v侳\x11\xd0\x11\x94\xe8%\xd4p\x83H(1\x1c\xb4ϴ\t\xa0b\xb2\x19Є\bZ\b\x04\xef\xc0dG\n\xfaڗ?\xed\xa9\x18\x01:\xc3\a\x84\\\x87\x9b\x9cV\xe0]5\xbc6\x9eC\xe7\xf0\xd8[\x11\xd0ks!\xfb\xcd\xc6\xc0\xfe6\xb7\x9bV\x067\xe0(G\xaf\xf7Ԅfg\xad\a\x9b\x0eHBG07\xcf\b\xee$\xa8\x93\x90`=\xa6HE\xe9\x8f7\xe1\xe0!\xb3y{\\\xf3\x9f/]\xab\xce\v\xd5\xd6\x01\xddQJ\x8eLҊ\xaf\xb4!\xffyԦ\t\xeb5[\xde\x0e(\x02l\xba\"\x1a\xac>\x15I\x9eN\xf6\x93H\x7f\xba_7\t\xbeQ[\x8d\xd9\x0fo|F\x90\x00\xa9\x940\xe2N\xcf\xdez\xbdN\xe35BGD\x83PjJ\xa8W7\x806\xec\tU|8A\x12@\xb2\x82\xa3z\xbf\x98J\bT\x81\xe8\xa9\xd6\x10Y\x03JR\xd5\n\xfe\xf9\xf0\xf6n\xf9\x0f\x1b\xb1N\xd2\xc4$!\x162\xe8\xa9 \xe3o\x80\xab$\x03dQ\xb1v\xa4\x1e<zZ\x14htJ\xec\x17\xf5\r\xe4\xf8\xe3\xcbOS2\x03xc\x1d\xd0g,ʜn@G)\xb7ٺ1\x10\x89\x85\"\x88\x96^\xed9\xd3 \xc5\x04k\x86\x0f\x81Q\x8f;\x02[3Z\x11\xe4zG+\xb8\x92\xfcԁ\xf8_\t\xb5\xff\xbb\x9a\xa4\xf9\x97\x98\x01\xaed\xcbU\x04\xd6\x16d\xdd\b}\x02\x18\x1c\xd9;\xbdݒ\xa3ii\xca\x01ړ\xf1߃u»\xb1\x1d\x02\x81\xac\xe8,fQR#\xc0\x1f_~z\x02퉊\xc8\t\xb4Q\xf4\x19^B\b5\x9aE>\xdf/\xe0Q\xca\x1d>\x1a\x8f\x9f\xc5!\x93\xcc2\x19\xb0&?\xce&h\n\xb7\x19\xee\t\xd8\x16\x04\a\xca\xf3y,\x84\x15\x1c\xf0(\xfc7\xea\x12\vC(\xd1\xf9~\xa9;I\xf5\xf1\xed뷫\x88JLhk\x04\x8a$\x89TKA+\x95lX\f6)k\\E\xe3\xf0\x16\x92\f\xcdD֖O\x1dT\xd3J\xea\xd3\xc5\xf5l\xb4ἷ\x0ek\xd2iG\r\xb5\xe900\xfcI\x15\xdeEl\x89I=\xcf\xd6]Ǟϲ%ͩ3\xe4)p\xa6l\xc2\xc2TB\xa5\xe7\xa5ݓ\xdbk:,\x0f\xd6\xed\xb4\xd9\xce\xc5\x10\xe7\xd1\x12x)@x\xf9]\xf8\xdfWq\x11ھ\xcbX\t[\xbf\x05?r\x0f/\xbf\x98\x9d\xa6i\xb94+]?\xd4e\xf5\xf0\xa4x\xe8!\xd3I\xd6t\xa0\xa7\xe89A\x13\xa0@\x15C.\x9a\xe3\x1fn\xb6\"\xc8\xca\t\x9e㼞q\xcc\xd1(\xf97k\xf6\xf2\xfc\x8b%W\xe9\v\x9c\xf4\xfd\xfa\xf5\xb71\xe6J\x7f\xb1GNv[\xf2\x91\xf6b\xadD|\xa9&\xb7\x9a\x9da\xf0]ok\xd35L\xb4)\xed\x9e\xc5\xecB\x80\xbc\xd3\xe5:}ob\x9cUga<\xf4\xf7\xb6Y\x8bᐑ\xcfBw\xddV\xa9u\xfd\xe1\xa8\xe2q\xf8\x16\xf8l\xb0\xe4,\x14\xd3\xe1\\\xe9h\xafm\x152WC\xa4\xee\xdd\xea\x1d\x8cœ͇$\x11\x19Ϩ*\xd4\x18\xa7\xcaiC\x92\xab\xda2\x12p\x8b\xda\x04\x97\xd0\x1e2ds\xed\xeb$3N\xe0\xacMBӢ\xdcX\x9bӠ*\xf3\xb8\x1d\x15\xa3\xa8T\x98\bb~\x7f\xa6`=c?=\r<\xe266)\b\x05\x96\xc2ގ\x8e\xf3X\U00014a1d\x18\x06\xfafδ!\xc0\xb2\xcc\xf5Di\xe2mWW\xb5\x98\x91\x03\v\x8bK-\xb8*s\x8b\x8aܣ\xa0?\a\xfb}gcc\xbd\xcd\xe1\x88X\x10\xb0\xa8\xe7\x84j\b\x03`\x9d6=Qm\xfa\x9a\xa1\xe2qSN\xa6*\x86x\xe6\xf5\x99\xd1\xe3\x9d-5\xce.TGDv\x96\xd7\x0fm\x936\xac\x1bkaw\xfaA\xe9\xee\xbd=\xb59\x03\xba0\xd1\xf6<\x01M\x06TR\x9bw\xa1\xcda35#\xe9\xed\x90iC\xefAi\xbb(\xe6\x83(\xd5[\x1ay⤡H\x1fQ\xf5L\xfe\xech)\xecn\xa4\x17\xb3\x89\x0f\xbdH\x15\xe6\x11_5\\\x8a\xee\xbd6J\xc6T\xf6|\xa8\xbd\xed\xef\x15$\bJo\x89\xdb@%:\xe5\x1b`\xfd\x1b\xf1\r\x14V\xd18\xf7J8*\xac:MƼ\xcc\xee\xfb\x96p͐\xea<FO\x03\xda\xc3\x01;\x9d\xfd͈f\x9d\xf7)\xcf'\x03n\x86\xdc\x04\xb2s\x81kª\x13+\x9dY\xff\r\xc3Y\x19\x8d\xf7\x87Y\xb6SQm\xc2+`\x13U\x84\xab\xfa\x86\xb1\xabB\x87X<'Ӯ@\x8bTh\x9c\xa4\xa7KQ\xe7\xa4j\x82\xbc\x18\x9e\x19\xd1\xec\xd2\xd8P*\xc5z\f7\xcdȡ\x86\xd6\xcc\xe7\x1f%\xb9\x84Q\xf15?IQ\"M\x18pN\xb0?4\x80Ժ\x02\xfd\nd<<\x9f x\xc1\xd0eBO\x051\xe3\xf6|\xf8\xf95\xee\x89v[\x1f\x00\xdc\xd8ʷ\xe3\x97\xda^\xa2\f\xae\xb9\xf6\xae\x8b\xad\xa5\x9c\x18p\xf4 \xc8\x04\xa4\xf1\xe0\xb4\xcas\x19\xa6e\xdd1\xd8\xe9\xfdW\xc8\xf5\xdd\f\xfd\xf5\x11\x10\xa0̐\xcf\v\xe7^vL\x05\x976F\x9f\x89.Og\x96;:\x8c\x9e\xadͽ\xb3[G<4\x8dyc\xbd#f\xe7\xf0&\xd8\xf9\xc5\xfc\xd6\x17\x9cg\xb9\xde\x04\x99\xcd\x1b\xf7\xb4\x1es0U\xb1!'|o\x8e~\x18\x9a\x06\x14\xa1\xee\xd1OB\xeb\x9cn+\xab@\xa7\x1e9$h$\xad\x05\x9f\xf1\x16\x94\xe62\x1f\xcc\x10\xbb,\x84:]\\F\\\xfad\xad\x8d\x9b\x96\xe4\xc2җ\xcc\x00\x03\x9a\xd7\u058c,\xa2\xeb\x9f\xda\xf8\xbf\xfdub=\x1a\xbf\xbcr\xdb\xf6\x92^\xbd*\x02|u\xf4S\xd7\xfe>ړ\x19T>M\xb1\xbc~}V\xdb\x0f\xed\xb6\xc6\xcau\x9b\xbb\x05\xd8t\xe1\xddK\xf9\xddBgq\xa9)\xb2G\xe7\xdbhx\x1ebo\xeb3y#Е\x17l\x0fT\xa2C?6\xcc\xf0*\xefv\xf8\x82\\\xb2\xb3tš\xb6\x8c\xe5qL\x8d,\xe9D*A뢭\x8e)\xf6\x12A/\xf0\xf7\xa1\x7f\x9b\x98_]ԙ\x9dz2Q\xbb\xab\xa8\x99\xb2\xb6\xb5\x81\xea\xf49\xadp\x86(\xe0˚\xb0A\xd3u\x90\xe1\xe2\x88bkmR\a\x84nP\xf5\xda3Ldt$\xe5OӜ]\xdapM8\xcb\xe0Q=\xd8_\xc1\xfe\xc5\xe9[(\x0e\xe7\xf5O'\xc2B\xads\xd5\xd1L\xfd\xb6\xb0~r\xaaae8^zRw\xc3\x1fO\\]\xf5~\r\x11\xbe&\xd6\xc4\xe6\x8fW\xf0\xf1\x93\xfc\xa6!\xbcC\xacG9\xbc\x82\x8f\x9ff\xff\x1f\x00P:7\xd0v\"\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YQ\x8f۸\x11~\xf7\xaf\x18\xec=l\x0fXɗ\\Q\x14z\xbb\xdbm\n\xb7w\x9bE\xbc\xc9K\x90\aZ\x1cY\xac%\x92\xe5\x8c\xec\xb8E\xff{1\x94d˶\xecuR$]\x1bX\x8b\x1c\x0e\xbf\xf983\x1cR\x93$I&ʛ\x0f\x18\xc88\x9b\x81\xf2\x06?3Zy\xa2t\xf5gJ\x8d\x9b\xae_-\x90ի\xc9\xcaX\x9d\xc1}C\xec\xeawH\xae\t9>`a\xaca\xe3\xec\xa4FVZ\xb1\xca&\x00\xcaZ\xc7J\x9aI\x1e\x01rg9\xb8\xaa\u0090,Ѧ\xabf\x81\x8b\xc6T\x1aC\x9c\xa1\x9f\x7f\xfdS\xfas\xfa\xd3\x04 \x0f\x18\x87?\x9b\x1a\x89U\xed3\xb0MUM\x00\xac\xaa1\x03\xef\xf4\xdaUM\x8d\x01\x89]@J\xd7Xap\xa9q\x13\xf2\x98ˬ\xcb\xe0\x1a\x9f\xc1\xbe\xa3\x1d\xdc!j\xadyr\xfaC\xd4\xf3\xae\xd5\x13\xbb*C\xfc\xf7\xd1\xee\xdf\fq\x14\xf1U\x13T5\x82#\xf6\x92\xb1˦R\xe1\xb4\x7f\x02\xe0\x03\x12\x865\xbe\xb7+\xeb6\xf6\x8d\xc1JS\x06\x85\xaa\b'\x00\x94;\x8f\x19<\xaa\x1aɫ\x1c\xf5\x04`\xad*\xa3#\x1f-v\xe7\xd1\xfe\xf24\xfb\xf0\xf3</\xb1\x8e\x8cK\xb3\x0f\xcec`ӛ(\x9f\xc1\xea\xee\xda\x004R\x1e\x8c\x8f\x1a\xe1VT\xb52\xa0e=\x91\x80K\x84uۆ\x1a(N\x03\xae\x00.\rA\xc0h\x83mWx\xa0\x16DDYp\x8b\x7f`\xce)\xcc\xc5\xce@@\xa5k*-N\xb0\xc6\xc0\x100wKk\xfe\xb5\xd3L\xc0.NY)F\xe2\x03\x8d\xc62\x06\xab*!\xa1\xc1;PVC\xad\xb6\x10P\xe6\x80\xc6\x0e\xb4E\x11J\xe1w\x17\x10\x8c-\\\x06%\xb3\xa7l:]\x1a\xee\xfd9wu\xddX\xc3\xdbi\xf4J\xb3h\xd8\x05\x9aj\\c5%\xb3LT\xc8KØs\x13p\xaa\xbcI\"p+\xc6RZ\xeb\x1fB\xe7\xfct;@\xca[Y6\xe2`\xecr\xd7\x1c\x9d\xec,\xef\xe2c`\bT7\xac5qO\xaf4\t+\xef\xfe2\x7f\x86~Ҹ\x04\x03\x95б\xbd\x1fF{\xe2\x85(c\v\fq\x14\x14\xc1Ցg\xb4\xda;c9>\xe4\x95A{H:5\x8bڰ\xac\xf4?\x1b$\x96\xf5I\xe1>F5,\x10\x1a\xaf\x15\xa3Naf\xe1^\xd5X\xdd+\xc2oN\xbb0L\x89P\xfa2\xf1\xc3d\xd4\xff\xc9\xf8\xacck\xd7\xdc'\x8b\xd1\x15:\x0e\xff\xb9\xc7\\\x16LX\x93\x81\xa60y\x8c\x01(\\\x00u\x92.ҁ\xe2\xb1\xe0\x94\xcfB\xe5\xab\xc6\xcf\xd9\x05\xb5\xc4\xdf\\>\b\xf33\xa8~\x1d\x1b\xd1Ò\f'Q(\xbf[\xd5 P\xd4\x12\x8fT\x02T\xfd\xd0M\x89\x01\xa3+H65\xb9\xb8\x92#\xc3.lE\xad\x8cG=\xb4\xe5,\xed\xf2\xf5N_\x84\xff\xe4:\xa7\x0fX`@+.\xddF\xbfw1G\xb02\xb6w\xfd6\xc9\x03\xbb#\x8d n\x18p\x1c\xda9\xaa\xcf\xe7\xc3Q\xa0\xbf<\xcd\xfa\x1c\xd83\xdaA\xe6\xe3\x19/\x12\"\xdfB\xb2\xfc\x93\xe2\xf2\xc5YogE;\x8d\xe8\x11f\x14x\x839\x1e\xa4V0\x96\x18\x95n\x1bGT\x02H\xe0\x04\xec\xe4\xef\xda\xf8\xef\xd2\xcc>\x1d\vՠ$\xef\x18\r\x7f\x9b\xbf}\x9c\xfeյXGu\xaa<G\x125\x8a\xb1F\xcbw@M^\x82\"Ya\x13P\xcfY1\xa6\xb5\xb2\xa6@ⴛ\x01\x03}|\xfdi\x8c3\x807.\x00~V\xb5\xaf\xf0\x0eL\xcb\xf2.\xa1\xf5\xfe!\xbe-D\xec\xf4\xc1\xc6pi\xc6\rW\xb2\xe9v\x06o\xa2\xa1\xacV\b\xae3\xb4A\xa8\xcc\n3\xb8\x91\b\x1e@\xfc\xb7\x84\xce\x7fnFu\xfe\xa1\r\x91\x1b\x11\xb9i\x81\xed\xf6\xaca\xc4\xed\x01r\xa9\x188\x98\xe5\x12C\xdc\xc3O?2\x00\xd7h\xf9GpAl\xb7n\xa0 \xaa\x95\xe8k\xf3\f\xea\x13\xc0\x1f_\x7f:\x83v\xafEx\x02c5~\x86\xd7`lˊw\xfa\xc7\x14\x9e\xe5'm-\xab\xcf\x12\x8fy\xe9\b-8[m\xc7\xd1:(\xd5\x1a\x81\\\x8d\xb0\xc1\xaaJ\xdaZA\xc3Fm\xc5\xfe~\xb9\xc4m\x15x\x15\xf8\xb0\x1a\x18\xd5\xfa\xfc\xf6\xe1m֢\x12\x17ZZ\x81\"\xbbLadϗ\xcd>vF\x9f\x94>j\xa26\x81\x93\x97ʎ\xa45\xf9FK\x11\x8aF\xb6\xf0\xf4vr\"p9Z\x8f\xb7\xed\xf1@\x8d\xdb\xf7qb\xf8?m\x82W\x99%.\xf5\xb2Y\x8f\x03\x7f\xbeh\x96\x14\xf1\xc1\"c\xb4L\xbb\x9cĨ\x1c=\xd3ԭ1\xac\rn\xa6\x1b\x17V\xc6.\x13qĤ\rl\x9a\n\x10\x9a\xfe\x10\xff}\x95\x15\xb12\xbeΔ(\xfa=\xec\x91yh\xfa\xc5\xe6\xf4uݵ\xbb\xd2\xed\xbc+<\x8eGJHlJ\x93\x97}\x91\xbeϞ#:\x01j\xa5۔\xab\xec\xf6\x9b\xbb\xad\x10\xd9\x04\xc1\xb3M\xba\xb3`\xa2\xac\x96\xdfd\x88\xa5\xfd\x8b\x99k\xcc\x15A\xfa~\xf6\xf0}\x9c\xb91_\x1c\x91\xa3\x05\xa9|\xa5\xfe\x9ai\xa1\xaf0\x18\xb2\xc9\x05\x03\xdf\x1d\x88\xf6U\xe0H\x1d\xb7\x93I'W\x02$\xab<\x95\x8eg\x0f\x17\x11\xccwb\xfd\xec{ʻ\xf2\xad\xd7$.z\xa1n;\x8b\xa4\xf1\x95S\x1aó\b\\\xc2\xf2~ أ\xe9\a\xb7[\xb2\xd4Ĩ\xa1\xf1\x03|wG*\xe5\xfeB\xf7(\t\f\xa70+\x00k\xcfۻ\x9eZC\xd0Щ\th\x9b\xfa\x18aҍ9i^9oԵ\x1c\xb4T^\xb4\xbe={\x8c\x9d\x04\xbau\x10\xbf\xed\xb6F\xa9¿j5\xe4H(\xa5\xde\x10I2~\x8a9\x90\xf0nX\x05%G>~еw\xbc\x83\xe6ֈ\xc9\v\xf1#\xc5isP\xf8_>\xd2E\xf1\x9e\xb36Gq\xa7D\xd8\xfb\xbaC]\ue920=\xbc\xc0\xba\xb4r\xf7\xa7\xf2\xf1\x96$\xe8\x16\x17\x9b\x1a\xe3\x89)b\x86\x8d\xa2~\x8a\xd3u\x83\x81\xb6v`\xbc\xb2\xc9]Шc\xc1)\xb5p\xa1L\x85{'\x97r\x10!\xdeK\x85\xdb\xd3\xfd\xa2W#.\x1fϺ#\x80\x8fG\x15.Ԋ3\x90\xab\x82D\x14\x1c\xf5\xcb}\x9eZT\x98\x01\x87\x06\xafs>9\xd8\x13\xa9\xe5\xe58\xf8\xbd\x95\x11\xc0\xaa\x1f\x00j\xe1\x1a\xde\x1d3\xbb\x80\xe8̿\xa5n\xc5\xd3ka\xf8R\xd1e\x10O\"1\xe6W\xbb\xa0\xbc\xe4X\xe7s\xc9#nN\xdaf\xf6)\xb8e@:^\x83\xa4\xf7\x85\x93#H\x02o\xa2\a\\mp7\xc1e\x9b;!(]\xd5{\xaecU\x81m\xea\x05\x061|\xb1e\xa4\x9e\x81>ЏtBW\xf7\xefyۏ\xefVL\xb7\x8a\xbaSL\xae\xacd\xb2\xe8\x9d\xec@\x1b\xf2\x95:=\xc6\xf8\x1e\x9e\x94\xe7\xe2\x9c\x12!{\xbf\xe8T\x83\x84t\xec\xfb\x92{\x85\b\xe7\xc1\xd9\x13\xa7\x18\x86\x82\xb1\xfc\xa7?\x8e\xf4\xb7n&7\x9d˃T\xd8\xf5\n\x85\xbfnyl\xda\xffM\xf7\xd9\x02\x84X\x05\xdeE\xf6\xc55\x9f\x1f\x88\xbe\x94\xb5\xa2ⱜ5L?\xa7\xe9\xe6p\x92\xef\x91iF\xa89jꮆ2X\xbf\xda?ō'\xe9^R\xc4\x0eh\xb3\xaa\x1eL\xde]\xc8u-\xfb\rK\xaeW<\xa3~<~Kqss\xf0\xd2!>\xe6\xce\xea\xf8\xe2\x852\xf8\xf8I^\x1cH\x0e\xd1\xdda\x802\xf8\xf8i\xf2\xdf\x01\x00&@\x9d\xe1\xe0\x19\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec\x1b]o\xdb\xc8\xf1]\xbfbp=\xc0vϤ\x9d\x1e\n\xb4z9\xdc\xf9.=#v\x12\xd8N\xfa\xe0s\x81\x159\x12\xb7&w\xd9ݥ\x14\x05\xf9\xf1\xc5,\xb9\xfc\\Rt\x1a#\a\\-?\x88\xbb\xb3\xb3\xf3\xfd%i\x11\x04\xc1\x82\xe5\xfc=*ͥX\x02\xcb9~0(\xe8I\x87\x8f\x7f\xd3!\x97g\xdb\x17+4\xec\xc5\u244bx\t\x17\x8562\xbbA-\v\x15\xe1ϸ\xe6\x82\x1b.\xc5\"C\xc3bf\xd8r\x01\xc0\x84\x90\x86Ѳ\xa6G\x80H\n\xa3d\x9a\xa2\n6(\xc2\xc7b\x85\xab\x82\xa71*{\x83\xbb\x7f{\x1e~\x1f\x9e/\x00\"\x85\xf6\xf8\x1d\xcfP\x1b\x96\xe5K\x10E\x9a.\x00\x04\xcbp\t\n\xb5\xe1\x91\xc2\\jn\xa4\xe2\xa8\xc3-\xa6\xa8d\xc8\xe5B\xe7\x18ѵ\x1b%\x8b|\t\xcdFy\xba\"\xa9d\xe7\xc6\"\xbaq\x88\xf6v+\xe5ڼ\xf2n_qm,H\x9e\x16\x8a\xa5>B\xec\xb6\xe6bS\xa4L\r\x00\xe8\x82\\\xa1F\xb5\xc5w\xe2Qȝx\xc91\x8d\xf5\x12\xd6,ո\x00Б\xccq\t\xafY\x86:g\x11\xc6\v\x80-Kyl%R\x12/s\x14?\xbe\xbd|\xff\xfdm\x94`feN\xcb1\xeaH\xf1\xdc\xc2\rh\a\xae\x81AC\t\xc8uE\x1d\xe42\x86\xadL\x8b\faŢ\xc7\"\xd7a\x85\x11\xe0\xd2\x1ci\x881W\x181\x831p\x01k\xb6\x95\x8a\x8e\xffd\x81\x9b+Na\x97\xf0(\x01\x93 \xbc\xb7b\a˩\"\x03آ2\xbaF\x8b\x1f\xb86\\l\xfadr\xd4`\xa4\xbb>W2Ge\xb8S\x1a\xbdZ\x06[\xaf\xf5X?\"ٔ0\x10\x93\x89\x12\xd2\x04a[\xaea\f\xdaʍx0\t\xd7$\x15R\x8a(\x8d\xb6\x85\x16\b\x84\t\x90\xab\x7fcdB\xb8\xb5\xechЉ,\xd2ر\x05\n#\xb9\x11\xfcc\x8d\x99\x98\xb0W\xa6̠6\x1d\x8c\\\x18T\x82\xa5\xa4\xd5\x02O\x81\x89\x182\xb6\a\x85t\a\x14\xa2\x85͂\xe8\x10\xae\xa5B\xe0b-\x97\x90\x18\x93\xeb\xe5\xd9ن\x1b碑̲Bp\xb3?\xb3\x8e\xc6W\x85\x91J\x9fŸ\xc5\xf4L\xf3M\xc0T\x94p\x83\x91)\x14\x9e\xb1\x9c\a\x96pA\xcc\xea0\x8b\xff\xa4*\x7f\xd6G-J͞\xecP\x1b\xc5Ŧ^\xb6n3*w\xf2\x9a\xd2\xce\xcac%\x8b\x8dxI\xe1$\x95\x9b_n\xef\xc0]jU\xd0B\t\x95\xb4\x9bc\xba\x11<\t\x8a\x8b5*{\n\xd6JfV\xce(\xe2\\ra\xecC\x94r\x14]\xa1\xebb\x95qC\x9a\xfeO\x81ڐ~B\xb8\xb0\x81\nV\bE\x1e\x93u\x87p)\xe0\x82e\x98^0\x8d\xcf.v\x92\xb0\x0eH\xa4\x87\x05ߎ\xaf\xee\x8f\xce/+i\xd5\xcb.\xfcy5\xd4\x0f\n\xb79F\xa40\x92\x1a\x1d\xe4k\x1eY\x1f\x80\xb5T\xc0\x06A\xa4\x89\v~\xe7\xa4W\x19Bn\x8dTl\x83W2jŭ\x11\xaa~\xf2\x9dpdQ\xcc&/\xa4\xf7^\xc0\x1ef\x00\x930\xd3\xf2Pø\xa8\xdd\xdc\xc3Ǩ\xc8\xe9?bQ\x82\xb7\xfc#^\xf1\x8c\x9b>\x17L\xec߬\xfb\x8bA\x85\x8d\xfc|\x83jd\xd7sWO*\x17\x9d\xab\x9d82\xf6\x81gE\x06\x9a\x7f\xac\xc5\xd2\xf0u\xd4u$z\xa52bi\xc9\aH\x01Ȣ\x04\x84\x8c1\x84\xcb5\x90\xf9k4\xa7\x15\x1a2\x8e*d\x1f\xe9\xea\f]4D\xeaH*4\xc6}aR\xaaf\xab\x14\x97`T\xd1?\x9b3C\xe1o\t\xff:\xfe\xed\xbbO\xc1\xc9\x0f\xc7\xc7\xf7\xe7\xc1\xdf\x1f\xbe;\xfe-\xb4o\xfe|\xf2\xc3\xc9'\xf7\xf0\xdd\xc9\xc9\xf1\xf1\xfd\xab\xeb\x7fܽ\xfd偟|\xba\x17E\xf6X>}:\xbe\xc7_\x1ef\"99\xf9\xe1\xdb\x1e!\x1f\x02*C\x94@\x83:\xe0\xc2\x04R\x05\xa5R<tG\tF\x8f/m\xf0\x10\xd1~9\xa9\xb6\x0e(\xc9(\x91;\x90k\x83b\xa0, \x8f\xaeL\xb5\x87\x13(,\xd9k1\xb6ΈJI\xa5\xad\xd6>\xa2\x92\xa7\xc0)3K\x91\xeek\xb0]\x82\xc2E8\x8cg\xdbx,w\"\x95,\xbea\xe6+\x98\xf9\xcf\xfd\xdb\xfb\x96\xae\x98\xc1S\xaa;V{\x83\x1arT\xa01\x92\">\xf5{\xbe_\xc8\\\xd7|b\f\xcc\xc0j_\xfa\u00a0\xf8\x19\xa2\xa52\x89\x12\xb0T\x901rk\xc1D\x84@\xe1\xcfF \xab\x94\x81\a\x91w2\xebj\x90\xb0\xa1_2H\xe5\x0eU\xe9J\xe4\x80\xcc\xfc\xe1ܪ%\xcdy\xceu\xed9\xd0u\xb1\xb6\x82\xaa\x1c\xb0\xea\v\v@\x15b\xb6{\xb40\xdeЕ\xda\xcc%\xb1\x02o\x8a\x8e6qF\x92\x87\xabB\x80\x90;\x9f\xcdm\x98\x8aS\xd4\xdaE\xf9\xf6\xe1\x1d\x17\xb1\xdc\xd9\xd2Q\xaeK\xbf\xefl3\r)\xd3f\x0e\xdf\xd3f5\x92\xe3\xe9\x9f\x12\xf3p\xb5'\rjc\x80\xc7T\xf4\xacyU\x86W\xe2\b\xe1G\xf7\x96TH\x92\x90\"¡(\xe8\xa5%0\x10\xb8\xabO\bĘ\nM\xa2\x02\xa4IlE\xc8DUtk\x03R\xe0\x91>\x05]D\x89\x17#+\x89\x89\n\xa5\x90\xeaF\x9ea_4\x93fQ\xf5a\xaa\xdd\xe7N\b\xe2M\r\nL\xe1@\xa1\r&\xea\x1c\xc8<\xe1r\xed\xc1\t\x80Yn\xf6\xa7\xbd(G\x02\xccU!(\xb4\x89\xd8%\x04\x1f?\xdc`\xe6\xa5\xf6@\xa5\xd82\xeb\x9a\x15\xba\x95\x89\x86v/ֲ\x1e;*\x15ld\xc95\x95d\xd3\xd5e\xf3\x87\xa2\xc8\xfc\x04\a\xf0\x96x\x1eٻ !\x8c\xec\xdd\xd0|\x02_\xe1\u07bb?\xa9\xf3\x03\x1eӜgJ\xb1>~\xb2^\xae\xb0\xd3B\xd1\x7f`G\x13\xbdEoyߋH\xff\xb4\x81`\xb9\x98\xd0dKs%\xb4K\xb0\x86\x93\xeb\xac!f\xfb\xaaf\x8e\x12\x8c\x8b\x14\xe3\xf6\r=\xd4@\xa7\xb5a\xaa\x1c\x06t\xabH/\x82\xf6\x01\x8aT\xb8\x1dT\vd\x96\x94\xa8\v\xfcb\xd1).\x94\xb7\xf1\x18\x88\xe7\xe7\nХ\x91TVMj\x15c\xb9&\x03\x17T\x83\x85\x8b'ڊe\x9b\x86X\a\xa9\xb8u\x90\xa3\xcai\x91d\xd1\x0e+\nz1cK\xa5ww\x176\x10p\x01\xbf\xfe\xba\xbc\xbe&\xea3f|\f\xb4*\x87\xfb\xf3\x17\x0f6\xcf\x7f\xfa\xcb\xfdy\xf0\xfd\xc3\xc9\xf2\xfe<\xf8k\xb9\xf4\xed\xd3x\x1f7t\xa7\x98\xc1F-\xac\xd9n\xc07%\xaaYi\xb9\a\xec\x12\x89KI.\x06Uy9\x929\xc7\x18\x8c\xec\xe1\xa4b\xb8\xcc6e\x9b\vT\x19\xb2\rBZu\xa3n\x06f\r\x9a6\xab\x99Y5\xa8\xa0\x1c\xf7\xc5l|V\xa7\xfd\xdc\xdd\xf6H\xddM\b3+s+\xc6\xf0i\xe6\xf3\a\xaf.\xa8\xba\x18\xf7 \xaf\xda\xff\xa7\x84R\xf6-\x97N\x90j\xb9\x98\x10\xfaM\x0fؙκHӪ\x03\n\"\x99\xe5\xcc\xf0U\x8a\x15s\x14\x80zH\xc1inO\xfb\x9f;\xa0)\xf2\xaf\u05fa\xbe\xeb\xde\xfdl\x8dk\x91\xff\xbfm\xfd=\xb5\xad\x95>\xd4\x1d\x19\xe5a\x03)\x01\x9du\xb8ðK\xa4\xeeDL\xeb\x02\xbc\xf5ً{]\xae]\xd5o\xb3\ns\nkΆ\x8b\xc35sP\x1d\x1b,?ʜ\xb3\xb9\xfeV\xda\\\xfd\xe9\xd4$\xfbﻰN\x02\xa2^\xa8\x9c\xbe\xc7L\x0f%\xb8!\xae\x1e\x1a\xbd\xf6\x95e#\xb4\xfb\x02\xea\xe1`\x1a@\xe6\x999t\x00\xfaѳ\xb3ٓ\xd7\xe2@4ֆ\x99\xa2\x93\xea'\xbb\xb2[\v\x0e\xbc\x9bmJ$\x94\xc6?o\x82O\xc5\"*o\xfaד\n\x7f9q\xb0n{G\n'='(\u008e\xb5\xca\n\xfa\xf4'\x84;\x1aR\v\x96\xebD\x1a;,q\xa6\xc1\x87ŊIP\xb7\xae\xb44\x1d\xfet`\xa4g\x1e\xf5\x91\x03Ao\xac=\xa4\xc2\xc26\xad\xbe\x8e\xa1#\xe7\xab6\xa4\xd3>\x1d\xb7c\x8c\x91D\xb2\xf3D\xf3\x91A\x01\x19\x003KJ@\x18\x98aI>\x83=\x8fX\x88\xc0V3:\xa7j\xbf\xf2\x1e\xf1\x15\xab\x84\xbc\xed\xaa=\xacP\x97v֪Ȍ\x9e2\x03\xec\x91>KA=\xf8\xa1\x9aZԎ\x11\xf4\x8c\x8axJ\xf3t\xe590\xae\x04\a\xf8eUВ\xd6\r\xea\"5ӡ\xe8z\x00^\a U=\xb7\x89\xa6\xe1\x94\\\xdbҪ\x87\x15F\x8a\xa7y1b2z\x0fht2-)$\xa9\xaaB\x88\xbe$\\!\xe6\xa5\v\xe4\xbc\xc9\xdaT_I\x197\xcbS\xec~\x05\xc7\x03\xd6\xe3\xefbx\x8a8\xa2\xa1\x8f\x95tCd\x85\x7f\x18y\xe6\x99\xfd\f\xe3?`M\x95fQk\xb6\xc1\x19\xac]\x97\x90NA\xf6ø&A5\x8c\xad\x19\xa7\xf1\u05ce\x9bdX\x90W\x96B\xdf(ه\x9fCo}\xcf\f\x8a;S\xda\xd1q\xf3\xa4/\xfeN\xe7\xaf\xf5\xa0h\xae]\xd6õ)\x93ܱzL\xf9u\x8dR\x17Q\x84\x18c<\x873\a[1UM*\xda|\xd5\xe8\xfc\\\x95\xb2^I\x99\"\xf3Gl\xdf\x10\x82tX_\xe1٫/\x1d\xec\x8d\xce >\xb3h\x1aq\xe11\xe7e\xce灭daF\xcafZ=\x14BG\xb5X\xe7\xbf2MMSօ\x1d\xc6\xffaVuY4|\x8a\xf4\xa6\xa2\xfd\xccX\xff\xa4H\xdfP;\x19\xe9\xe7\xb8\xd4$_\x93\x8a8\x10\xe1\xc7L\xc4\x13\xdf\x1bv\x0e\xc6\xf7\xf1\xe8>Ig\xe9\x10\xfa\u008e\x9d\x0fR\xfb\xa6\r\xedh\x16E\xb6B匦S\xffW\xd8=h\xab.k\x87\xaa5\xf3\xb6\b\fS\x1b4c\xdd\xda8\x83\xfe\xa1\x1a}\x82K\xdf\x18\xf6\xf6\x86\a\xf9\xbd\x1d?\xeb\xb8\x1f\xa1s\x9c働\xe5SUx8-\xcdMJ\x8d\xb9\x1dHJ\xcf\xef?u ?̏\x83짢\x86\x9b\x1aY\xb8xj\"*\xad\xf1\xf3\xac\xe7n\xfc\xec\xb3Xϓ?\xed\x18˲\xc1\x94\xd3\fa\x9dt\a;\x13\xc2[\xcc\xcc\xcey\xc2\xf4t\x92}K\x10N\x9e픊s3\xaa\x7fh\xf9\x1aw\x83\xb5\x1bdq\xbft\f\xe0\xb54\xbe\x8d\x11\xc1{X\xed-U_\x17_\xc2\xf6E\xf3d\xbbΠ\xfa\x1d\x82݀rp\x1e\xb7\x1c\xac\xb2\xa3j\xa5\x99\xe9\xb1(\xc2\xdc`\xfc\xba\xff;\x84o\xbe\xe9\xfc\xac\xc0>FR\xc4\xf6\xb7\x15z\t\xf7\x0f\xf4\xcb\x00\x9a\xe6\xc7\xd5\x17\xdb\xf5\x12\xee\x1f\x16\xff\x1d\x00\x96\x12\xba>\xc31\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=\xfdo#\xb7\x95\xbf\xeb\xaf \x9c\x00\xdam%9{A\x8b;\xa3@\xe0\xee:\x8d\x91]\xaf\xbav\xb7(\xd2^@\xcd<I<\x8f\xc8\tɑ\xad^\xee\x7f?<~̇>\x87\x1cy\xbd\xdbJc$ky\xe6\r\xf9\xbe\xf8\xbe\xf8Hs\xf6\x11\xa4b\x82_\x10\x9a3x\xd4\xc0\xf175\xba\xffO5b\xe2|\xf9j\x02\x9a\xbe\xea\xdd3\x9e^\x90ׅ\xd2b\xf1\x01\x94(d\x02o`\xca8\xd3L\xf0\xde\x024M\xa9\xa6\x17=B(\xe7BS\xfcZᯄ$\x82k)\xb2\f\xe4p\x06|t_L`R\xb0,\x05i\xde\xe0߿\xfcf\xf4\xed\xe8\x9b\x1e!\x89\x04\xf3\xf8\x1d[\x80\xd2t\x91_\x10^dY\x8f\x10N\x17pA$(-$\xa8\xd1\x122\x90b\xc4DO\xe5\x90\xe0\xcbfR\x14\xf9\x05\xa9\xfe`\x9fq\x03\xb1\x93\xf8`\x1f7\xdfdL\xe9\x1f\xeb߾eJ\x9b\xbf\xe4Y!iV\xbd\xcc|\xa9\x18\x9f\x15\x19\x95\xe5\xd7=Br\t\n\xe4\x12\xfe\xc2\xef\xb9x\xe0\xdf3\xc8RuA\xa64S\xd0#D%\"\x87\vrC\x17\xa0r\x9a@\xda#dI3\x96\x9a)\xdaq\x89\x1c\xf8\xe5\xf8\xfa㷷\xc9\x1c\x16\x06\x89\xf8u\n*\x91,7\xf7\xf9\xf1\x11\xa6\b%\x1f\xcd\xfcp\x10\x86\x10Dϩ&\x12\xccP\xb8VDρ\xd0<\xcfXb\xdeB\xc4ԁ$\xe53\x8aL\xa5XT\xb0&4\xb9/r\xa2\x05\xa1DS9\x03M~,& 9hP$\xc9\n\xa5A\x8e\x1c\x98\\\x8a\x1c\xa4f\x1e\xb1x\xd5X\xa9\xfcnm\x0e}\x9c\xa4\xbd\x87\xa4\xc8<`\x87\xba\xb4\xdfAJ\x94A\x00\x11S\xa2\xe7LUS2Ө\x81%x\v\xe5DL\xfe\a\x12=\"\xb7H\x01\xa9\x88\x9a\x8b\"K\x91\xe3\x96 \x11%\x89\x98q\xf6\xcf\x12\xb2\xc2\t\xe2+3\xaaA\xe9\x06D\xc65HN3$O\x01\x03ByJ\x16tE$\xe0;H\xc1k\xd0\xcc-jD\xde\x19\x92\xf0\xa9\xb8 s\xadsuq~>c\xda\vO\"\x16\x8b\x823\xbd:7\"\xc0&\x85\x16R\x9d\xa7\xb0\x84\xec\\\xb1ِ\xcad\xce4$\xba\x90pNs64\x03\xe78Y5Z\xa4_\x95\xc4\xea\xd7F\xaaW\xc8PJK\xc6g\xe5׆\xb5w\xe2\x1dY\xdcr\x8e}\xccN\xb1B/\xe33C\x88\x0fW\xb7wu\xaeb\xaa\x06\x928lW\x8f\xa9\n\xf1\x88(Ƨ -\xe1\fo!D\xe0i.\x18\xd7\x06|\x921\xe0M\xa4\xabb\xb2`\x1a)\xfdK\x01\nYW\x8c\xc8k\xa3B\xc8\x04H\x91\xa7TC:\"ל\xbc\xa6\v\xc8^S\x05O\x8evİ\x1a\"J\x0f#\xbe\xae\xf9\xfc\xc7\xdeh\xb1U~\xedU\xd4V\n9\xe9\xbe\xcd!iH\x06>Ħ^\x8c\xa7B6\x84\x1f\x15\x82\x17\xc9]b\xe9D\xf3\x03\xd5\xf0\x96-\x98n\xfeem\x18\x97\xe3\xeb\xf2F\x92\xe1\xedV@%Հ\x12g\xfem\x87گh\xb6\x06\x91x\x19\xab\xe9\x8f\xcb\xf1\xf5\x800\x9eB\x0e<\x05\xae\xb3\x95\agt\xa7죊A\xe6 \n\xb4f|\xa6\xea\xf3\xb2\xd7\xf5\x94 W8\x94@:\xd81\x1cB%\x10\xc1\xb3\x95\x9d\x01\xa4d\xb2j\xbcj\x03\xf2\x81W\xe3\xe2C'\x19\\\x10-\vX\xfb\xe3.\x9c\xe35)\xa4\xda@\xf8\x06\xd2\xff\x88w\xa1t\xe2\x18\x17\xf4\x91-\x8a\x05\xe1\xc5b\x02\x12\x91TN\x8bqBQ\xc1ɦ\x04\xf9O\x0e\x92\x89\x14\x9f\xd0l\x01\xa3-\xe8b\xba︊.\x80PE\xfe<\xbe\xddD\xb3g_T\x873\x90\x1b\x7f\xff%W\a\xa7\xf4\xe7\xf1m\x8b\t\xe5 \x89\x82D\xf0\x94\b\x9e\xac#֍e\x0e\x16\x8d\x96\x98dN\x15\x99\x00p\"\x81&sHCǏ/g\x12\x1a\xba\x12\x7f\x86\xe4\x97|\x9d\x91\xb7\x8a1\xfeص\x12\x97\xf4\x8b\xde\x1e,\xfc\xb1\xbc\xcd#\xa3\xe0\xec\x97\x02\x8cI\xe2\xf9\x7fc\xf9u\f\xbd\x06\x98\x98\xe5z}\xb6[5\x923\xb9\xa6\x19K\xf4Xd,\xd9\u009b\x8dq\xbe^\xbb\xb9\xe4\x19'\xfc\xce\xf2\x98\xc0\x9c.\x99\x90\x03\x94'\xbfH\f\xd6\x00\x13\xa3\xa4\x98\x86\x05>L5\xa1\x99\x04\x9a\xae\b<2\xe4r\xee\x16\x02cN\x98%\x16\xa55e\xd3)H\x14\x7f=\xa7|\x03$>\x828\x87tX\xe4\xdeZ\x18\x91k\xf3\x161\xad\xd94\x0fL\xcfE\xa1\t-1@r\x9c\xd5j\x03&\xbevNy\x9aAJh\x92\b\x99\x9aE\xd0j.3X\xfc\xddCvP\xecz\xc7\x120\x8f\x14|\x8b\xeeC\xb84{\xa0+\xb5\x1b\xbcj\x02\xf1\xc0\xd7`\x19$\xae\x13n\x1f\xe9V\a\bW\x91f\x03(\xf1\xec8cK\xe05\x84\x1a\x1a\x96\xb8D\xf4:\xe26)\xb9>\xf6\xfdz\x91\xb8\x19o\xfb\xcb\xda\x04\xddĜ\xfc\x943\x11\xd3\xc6\fqb~\x90\xeb\xa2P}\xcc\xc07G\x8a\x17\xf0b\xb1}4C\xa2\xeeY\xbe\xe3Ob\t\xf2A2\xbd]w\rɔ\xb2l8\xa5Jo\xfd\xfbN\xe9]\xb3\xd4[\xa0ɻd\xcaYy\x19\xb2WM2\x8cʱ8\xdc!\x0f\x8e{\xd1i@\xb2\x8b\x01r˂j\x8d\f\xacJ@#\xe3a\r\b\x8cf#\x92B\x9e\x89\xd5\xc2X\x824Ϸ\xac\xd9\xf6\xe7\xb2\x1a\aډ\x130~\x17\xa4\xa8\x0e\xa8&\v\xa14\x11\xbc\x12\xb2\xbb9\x90\xb3ߜ\x95O\x1d\x1al9+/`\xcck\x06\x9ae\xeb\xccL%\xf0\xfev\x82\x90\xfa\xb8\xb8\xd0s\x90~L[\xef\xdf!\xa1\xad\xa8\xebo\xa0R\xd2Mj\xecZ\xa6\x90\xa9v\x10pXMt\xe3o;W\xb2\x03\xc6ͮ!\xa6r\xf5\xa1h\xf8y\x1b\x1c\xf9\xc6\xdcR\xd3H\x0fs0\b\xad˭s\u05cc\xb1&!\xdff\xda< ͘&\x0f\xe6\xceT\fJ\x1d\xbf\x10)\x9b\xae\xbc\xeb\xe2\x15\x91a\x1e\v\ve\xc1\xbc'݀ʼ\xebhn\xa03\xd4ق\xcf\x14K\xa1\xaeX\xfa\x8adbf\x96)\t\xaa\xc8\xf4\x06\x8b[\fM\x84\xc8`m\xe1\x82\xc7$+RHK\xcf_\xed\xc5\xd7\xd5\xc6\xed\xb8\x84i\xca8\n4\xf2%\xaah^\xfd\xd53\xf3\x1aPb\x8cd\xc6-4\xbf\xe0\xba\xf9\x8cz\xad\x18x\x0f\xebF\xb1\x8bGE\xa9\xa5Za\xa2\xb5N\xfb\x82\xf0`\xcd\n?\xb3\xf1\xd6Up\r\x19\xdb\x1ei\xb1Я\x01us<l\x93\x19\xc7\x01\x16\xb9^\r\xd0\x13\xa5\xc8\xf4\xa8Vϸ\xe0p6\xea\x1d^7\x87\x04o\xdd\xf8Һ\xf2\x1b_\xe7T'\xf3^K\xbcυ\xb8W{\xf1\xf5\x03\xdeQE(Hb\"\x96%f\x1c\xbb8\xbd3AK\x0f\x92Bo\xd1\x10i\x81\xdcO\x04\xae\x00J\xefb\x9c}VN\xa9\x8e7\xff\xb4\x93\xe3v\x05\x06<\xf9qz\x8d \x01.\x9bB\x92\x05j\xd3\xea^)\n{\xef6K\x0f\xaf\x1dX \x13\xaa\x00\xbd1\xc3\x11\xb2\xc8@\xb97\xa5h\x0e\xd4\xd4Ϧݿ6i\x1b?\xcb\xe8\x042\xa2 \x83D\v\xb9\x8e\xbd\xc38l\xabJw`o\x8bRmJN]\x9f\x8a\x9d0q\x15b\xc9܆\xb6\x90\a\x8d\xfc\x91T\x802Z\x06C\xad;L\x84\x03\xb4>\xc0\xef\xad5\xceaݳ\x89M\xcfS\xa1\xc8,\x9f\xdb\xd4B\xee\xfb\x7f\x1bT2\xbe\xce_-qy\xbd\xf1\xe01\x19\xd3\x19\xc6uU\xcete.\vBM6e\xd7U\xbd\xfb\x8b#D(O_\xaf?wD\x9e\xeeH\x85\xf2\xd5_\f\x11\x8c\xb2\xbfu\xba\xbe%\x01\xde֟\x19\x106-\t\x90\x0eȔe\x1a\xe4\x1a%v\xc25q罔节\xc3+\x15^\v\xb4h\xae\x1e1\x19\xa7\xaa$h+l\xac?JX\xdd\xf8o.\xa6{\xa1\x96\x1e\xa4uΝST}\x83\x063\xb9\xbcy\xb3=\x80\x1a\xc0a\x1bS\xb8\\\x1bf\xfd\xb5ΐo7\x01g\xa4\x94N\x90IY\xa9\x01\xa1\xe4\x1eVֺ\xc0\x04`\x0e\x92\xe2k\xf0\xe6\x83\x10%\x98\xbc\x9f\x11\xed{X\x19 .\x95w\xe0\xd9v\xa4w\xb98ذ\xe9\x0f\xa2\xed\x1e\xca \x97\xc5\x1f~\x81sr\x01Ö(k\xc6p\xf6\xd36@E\xf8\xcbc;xz%\x99\xaaܡ%\xa4\xc9\xdbd&\xbd\xa5\xe6;\xe2l\xeb\x17\xaaNL֠L\xf8D\xecGL\xa9\x97\xe3\xb3\xfc}\xcd\a\xe4F\xe8k>赀j]-ex\xe2\x8d\x00u#\xb4\xf9\xe6\xe8H\xb4C\x0eF\xa1}̈\x10\xb7j\x18\xe7_\xcf\xe7\x1ed\xe22\x8f\x86<U\x92\x84)̮\n\xe9pe\x18νl\x9f\xb6o~\x16\x852\t[.\xf8\xd0,v\xa3m\xefq(n\xc9\xc8u*l\x0e\xab|\xa5}]+\x88wh'\x99I!\x1e%\xe4\x19Vdx_\xcfdǩ\x86\x19K\xc8\x02\xe4\fz\a\xc0\x99\x1f\xe3\xc0\xb6y}+]\x1a\xc1Om\x96f\xff\xd9\x1dWl~\x86(\x9b\a\xef\xf1\xa4=p\xe3\x9e\xe8c\xcc<\xcc\"i\xec\x86\x03ؤij\xaa\x93h6n\xad\xbd[c\xbe!\x9b\xb5!!cQ\xb2\xa09J\xe7\xff\xe2Re\x98\xf6\xffHN\x99<(\xa1\x97\xa6\xc4(\x83Ɠ.@S\x7f\t\xc2g\x8a 5\x974[/\xaa\xd8\xfc\xa0\xca\xe4\x042\xb3\xfa\xe3\xc8\xd6-\x8d\x01y\x98\v\x05Hv2\xc5\x12&\xb2V\xfb\xb1y\x9d\xdd\xc3\xeal\xb0!\xe3g\xd7\xfc\xcc.\xcf\x1b\x12\xeb\xd7\xf2\x03\x80M8\xf8\xcc<y\x16o\xba\xb4\xe2\xba\x167\xf1-i\xde\x1dlPO\xf5V9^g\x8a\x8ez\x1dx\x0ecP?l\v~\xed\x18\xc9\xd8\xdfߴ \xb7D\x93\x0ex6.2T\xaaHL\xd8N1uk\x03b\xe6\xbb\xd26\x1f\xf5\xa2u_c\xf4[\x86Y\x06\xbc\xa8\x0f\xc5\x19\xa4\xee\x81H\\\xb9\xcc\xe1\xc1\xb5\xb7\xee\x10\x1b\xfb\xefX\x9b\xc9\xd5c-VG\xb9\t\xb45&pL\xbb\x13\xeb\x9eh\xb3\f\xac\xd5 _\xdb\xe7<\xe7:0.9?+Pe\x1c\x12Y\xc7\xc8\xc2G\x12M\xb5\x82\xc9\xd4`*\xcdg2@:\xe6\xa1$\x17io/,w\xd5\xea=\fO\xa4ϻ\xd2.\x187U\a\x17\xe4\xd5Q\xd7eR\xa1(\x82|\x1e\xb9%\x01\xcb/\xb8K\x8e\xb6C\xf6\xc3\x1c$4x`3D\xbc\xa5\xb0\xa8\x15l7\x8e\xbe\"S\x86\xe5<\xb5A*R\xa8C\xda<\x82Z8b,!\x16\xc5\xd6\x1a\xac\xbd8\xbd\xaa\x9e-ŷ^\xcdD\x17\xa28\xb8\xe8\xba\xd5\xcc\x16d\xf9B\x1f\x87\xd1\aʴQP\b\x155\x19\x06/\x12\xb1\xc83\xd8QM\xb0~M`\x8aA\xffDp\xccZJ\x9f\a\xc5Y\x17h\xf5\x10j\xca\x0f\x8aͤEg\xcc\n~%e\x84\x17\xf8\xde>W\xb2\x0e.\x8c\x0fMĴ\x00\x89S\x9f\xd3%`\xb0\x88i\x02\xdc\x14\xd1`\x9c\b\x15\xacy\x81C\x02\x9fmְ\xee\xfa\xb4Q\xc6\xfb+E\x9a\x9f\xa1\x91K\xc6\xf7\x84\x93\xaakH\xbe\xa7,\xeb\x1d\xbc/\x8cL\xc8c\x8e\x89\x83I\xf5\xd7\xea\xd9O \x00\x952\xd8k\x8cT\xd7\x04\xb3]\x98\xc2tR\x80u*\x8b\\\xbb\x1a+Y\xb8\x94\xa6]Ɏ\xcc\xff\xed}(\xa7E\x0f\xdc\xd7\xcaP\xc5\x1f\xdclq\xd1\v \xe25g\x15\xf5(7\x00\x9e\xcc\xfa@\xe0\xe5R\xa4\x82\x19\xee\xba\xf18\xda\"\xdehE\xc0\xd5r\xd1\xda\x12\x99\x00\xa1)\xa6\xfe\xd1\xf7A{\xc3۰X\xd4\xec\x90pdc\xa21\xa1ҕ\xaboĨ1z\x9bx\xa5\xbdV\xa2 \x0f\x14\x8b#-k\x97fU.Z\xf1v\x18\x1d\x9d\xef,g\xad\xef]\x9bx\xff\xd2\x1b\x8d\xbe\x1c\v\xb8\x96+\xb3\r\xa0\xddp}\xb0\x06H*\x92{\x90\x84-\xe8\f\xfa}E^\xbf{\xe3\xed\x05T\xff\xad\xb5\xbb#\xa5M\xd7\xe6R,Y\x8a\xa6\xccG*\x19\xa6>\x88\x04S\x7f\x8a\t\xa0\xaf_|\xbc\xfc\xf0\xf3\xcd廫\x97\x01\xa01\xde\b\x8f9\xe5\xc8q\x85*\xab\x92<\xbdq\xf0\xc0\x97L\n\xbe\x800<\\O\t%K?Ҥ\xdc\x1b\x81\x8eM\xb6\xac\xea\xe0\xdd\f\x02 \xbb\xc0\x02\xe3y\xa1\x9d\xee#\x0f,\xcb\xd0\xde+x2\xa7|\x86X\xba\x9b\xb7\xb3H\xecU\xc3\x1fQ+\xae\xe9#I(G\x90\xa0\x12\x9aCj\xdc\x02B\x03@\xa6\xa2\xc0\xa9\x7f\xfd\xf5\x800\xb8 _\xd7^1\"W\x0ej\x89\x80\x10\x8e0\xb3\xe5\xb0\x04I&\x15\x01\aD\u008c\xca4\x03e\xaa\b]\xe9Z\x00\\\xa4HI2\xf0QO\xe4\xbem\xbb[\x02\x00o\xd9\xf9r_n\xb3\xc0\xcd/\xa9HԹ\xa6\xea^\x9d3\x8eK\xca\x10w\xa7\fkJ\xe8ܮ\bC\xb7:\r\xbd\x8f7,\x99\xf5\xfc+Yp\xce\xf8lH˻\x18\x1fҡ\x9aC\x96\xf5{;\xc6\xd6Eu\x06\xaf\xc2q^V\xb0\xa3\xbcM\xbf]\x95\xea\xcc\xfav#\x8c\x9c\x97\x0eRk\xa0\xa4R\xe4\x06\xaf\xa3\xad\x1a\xef\xea\xe6\xee\xc3\xdf\xc6\xef\xafo\xee\x02\x00\xaf\xa9\xc8݊/\x00\xe6v\x15\xb9E\xf1\x05\xc0ܫ\"\x9b\x8a/\x00\xeaA\x15\xe9\xfc\xe2\x00\x90-Td\x1d+\x01\x90\xf7\xa9Ț\xe2\v\x19k\v\x15i\xe6\x10\x00\xf3\xa4\"\xff\xcdT$\xf0e\xa4z|\xeb\xcc\xf6\x9a(\x97t\x0eY\x9a\xb509^ƛZ\xa2\x13s\x04c\xbb1\xb3+\xbe\xfcH\x9b)l^\x9ff\x00\\R\xb1\xbe\x03\x86:\x89V\xb1\xbc\x10\x86\x0f\xb7\xee\xdbd6Z 䦶\x8f-\x16\x0fu\\\x8c\xc8;\x97ӥ\xe4\xf5\xcf\xd7o\xaen\uebbf\xbf\xbe\xfa\x10\x82\x8ch\x19)S\xf3\x9dP\xd2?\x9eK\xb1ױ\xc8%,\x99(\xca\xf2\xdc`\xb85z\x95\xf8W\x1b\xd2\x16>\\L\x1a\xf0U\xb9\xc5m\xebkB\xe9\xd9\xc2\a\n\x86\xb8\xcd h,\xf3\xc1\x10\x8fj\x16\xb46\x0e\x82a>\x81\x17\xd5֗\n\x06Y\x19\x16;̅`\x88ƼxS\xdf\xd7p6\xea\xf7\x02Y\xa7\x93z\xf9^\x8aV\x01\xe4\x9d*\xe6\xd6$E\xcb\xd8iM¢\x15oߕ\xd75\x16W\xeb@D\xc0\xcc\n\xf0\x1eG@mN\xf7\xf5\xcco|f\xb3w4\xff\x11V\x1f`\x1a\x0e`\x1d٦X\xd1\x15\xab\xe1ZG{\xc1\x00\t\xc1u\xdd\x0e+\\\xf5u\xc3G@=\xe2A\\ܹ\xaaIc\x99!Zb&\xd3I\x80\xbaX.[\xa7ԯ\x9b0N\xf7EO\xab\xad\xeb\x91`\xb7\x83\\\xabs\xdc>\xbcd\xf0p\xfe \xe4=\x86[P\xb3\x0fm&@\x9d\xe3$\xd5\xf9W\xe6\x7f\xd1#\xba{\xff\xe6\xfd\x05\xb9LSb\xb7\xb3\x16\n\xa6EfK|Z\xd6\vn\xbb\xaaf?\x03\x82}R\x06\xa4`\xe9w\xfd^\x14\xb0\xee\xfc \f9iv\x14\x9e\xc0\xfdUl\xba\x8api\x9b\x17\xb2T)\xf7\xe8\xdab\xe2\x01\xe5\a\v\x17\xa3\xa1N \xda\xe4;\xb4c\xb5ݧm\xfa+\xb6\xac\xb0S\x8al\xdbex\xfd\x18kA\xbfZ\f\f\xccz[\xad\x90\x8f+\x85\xb8 \xaa\xc8q\x9f\xb2*\x9b\b\x8dP\xd8\a\xbd`\x88\xb5>D\xa3r\xf7Π\xfaΔ\x94\xab\x8e\x80k}\xdd\x06&\x85?\xe2\"\x85\x9b\xe8\x11\x1b\x10\xceO\xb8\xb4\xed4\f0\xa24Յ\x1aͅ\xd2\xd7\xe3H\xd8\x16D.\xd2\xeb\xf1\xa0\xf1\x9b\x1a\xf5\x9fa\t\xde\xde\x1c-\x9a\x13\x1d,\xb7pEB$\xbe\xdb\x1a\xf2\xa3i[7\xa6\xd8\xdeC\x11li\xa1!F9\xb80\v'\x1a\xa4\xe9Ͳ\xb6\x89x\xf9\xeal\xf4\\\x8b\xc4\xd4O\xf1($0\xb8r\x86\x83\x81\x1c\t\xd4\x05\xbaP\xb1x/\xb4\xac\xac\x8a\x06y9\xbe.\xdb\xe4<\x0f\xba\xbb\xad\x12%\xa9>\xf5Z\xe1\x8bE\xbf\x7f\x825\xc3Î\x00Y\xb6\xe7)\x033\x17\xbei\xc6\xe1]q\xbb?\xae\xc3\x1b\x16\xfa\x95M\xb9^\xd8/GI^ĩ^\xf7\xfc\x02\x16B\xae\x06\xfeW\xc8\xe7\xb0\x00I\xb3\xa1k\xb8\x11\a\xdc\x0f\xd3\f\xaf\xfa;,\nb}\xf2\x9b\xa3\f\x0f\xd9\xf8\x98]RH\xf4%\xb2\x95_\xe5!}\x96\x95\xa7\xe4\x98m\xed\xca\xe2X\xba\fRw\xf2\xc3*\x1daB\x19K\x91\x15\vP\x83Җ\x8f\x06\x8bЀ/1\xb8\xd1h\xdf\xf8\t\xb5\x1f!)[2ծDrۇ\xf2\xd5\xfb(\xe5\x83?ý\x1d\xf0B\xa1t@\xc2\x1a\xe3ܺu\xcdV)\x8bB\xe7E\xb8\x86\xf6\x1f\u06dd\xca\xebEx\xcc\x05ƫJ}\x18\xa7^\xf0j\xd8+\xaf\xce\"\xe1\xe4X\x91(\xf9\x05\xf9\xef\x17\x7f\xff\xed\xaf×߽x\xf1\xd37\xc3\xff\xfa\xc7o_\xfc}d\xfe\xf1\x9b\x97߽\xfc\xd5\xff\xf2ۗ/_\xbc\xf8\xe9\xc7w\x7f\xba\x1b_\xfd\x83\xbd\xfc\xf5'^,\xee\xedo\xbf\xbe\xf8\t\xae\xfe\xd1\x12\xc8˗\xdf}\x1d9\xe0\xc7a\x15\xa9\x182\xae\x87B\x0e-\xe9\x0fl\x8a\xdewyr\\\x1c\x83}\xfa\x1f\xbcMQ\xc2\xedns\xf5\xbfD\xf3\xa8\xc3\xf4;YG\n\x12\t\xfa\xf3\x8a\xac\xda1y\xd3\xd9\xee0(]\xe0gXo\x8f\x1dl\xed\xea\xe2Y\xf4T>\x06n\xcc\x19\x11\x93h\x8d\x06j\x12\xb4\xa6\x89\xb9\x87\x7f\x0f\xc1Q\xfe#I\xd2)\x18|\n\x06\x7f!\xc1\xe0[++\xa7H\xf0\xf3D\x82#\x1f\x8d\x99\xe5\xd0(\xa5\xde\x13\x8f-\xaa\xaa+,\xfd\xbc\xb5\xb2˙\xd8hD\xe5\"/\xb0\xa5Jd\xf9\xcf\xee\u0093\x91_\x00c*\\\xaa\xbaZ\xd7t\xb6sU\xd1e\x96\x11\xc6\xed\x92g\x06\xe5\x8b=l\xa7Q\xdb#7H\x88`\x89%1\x0fsX\x9b8\xc6_\x95\xa6R3>\x1b\x91\xbf\u0383°6K\xed\xaa#\x18'\x8b\"\xd3,\xcf\xc0!Bպh\x84@UJ$\f\xcb0MŲkR\xa3\xb4G\xaf\xc1\x85\xa6\xf7!VJ.!\x81\x14ˣ\xb0\x18\xd9\xf4\bpt\xc6\xee┓+\xbe4o\v\x19'I\v[\xc2i8\xa7\x1aW\xe3m\xb6\xc2!\x00\xec\xb3\x14\x1a\xa2\x98\xbaB\x8fZ\xbda\xa8%\xe8\b$\xa6UÜ2#\xa9zOo\x14\x97\xd5\x18\x11\x0eC\x03#w\x8d\\ji\xcd\x06\x82\xb4M\xf4{\x9f\xce!\x885M\x9f\xca,\xfd\xbcL\xd2'0G\x8fg\x8av2C\xbb\x98\xa0\xfb\xcc\xcfhW\xb0\x92\x1d\xdf5)|U=\x86\xd9\x18i\x83\xa1\x06\x82){\xbc\xe8u\xc0\xe5%/]\x03\xc2R\xe0\x1ac\x91\xe1\x16=Z=Ҝc\x83\x06\x10\x9eBb\x16\x1bg\xc0\x94\x88\x0e\xe7\xdfg\xae}\xb6\x9e\xfc1\x14\xf5\xed\xb6\x98\xc3I랴\uefdb\xd6u\x82\xf0E\xaa\xdcO䑚\x9d\xdd\x17\xbd(2\xf5\xdf\xd4\xf6J\x1a\xa9\xaf\x9f\f\xd7\x1a&i%\x95\xa5\x83\xa6\xce\xcd\xfbB\x84ϴ\x1d\xf4]ժE\b\x1b\x13d\x99x s6C6\xcb\xf0\x80\xba\x00\xb0ֺ&\v\xca\xe9\xcc\xf4FC\x95\xeb\xd2WXo\x88\x8aD\xb24\x84wkn\xa8\x99$\xc6\xd5\xd1\xf8\xcb\x04Mk\xe7x\x86L>c\xf7@\xdeT'Ř-\"\xb7\x9aj4\xf6nA\x87\x14dE\xa8\aC\xacq\x91e\xdb\x0f[h\xcbj\xd7\b\x86\xe4E\x96\xf9\x13a\xc8{l\xbd?%\x97氧\x90|\xe3\r\xee\x91\x18\x90\xeb\xe9\x8d\xd0c\xbb\xfb\xab\xb9'\xc1\x82\f\x80Ȧ\xe4\x02\xc30J\x13Mg&\x84\xe0k\x88\x06\xc8\t\xf5W\x05\x805f\xf9\x03S\xb0m\xd3\xdd'\x14\xb5\xaf\xcc;\xd1\x011\xd4TO\xca0\x19\x9bB\xb2J2\x88d\x95\xcb\x04\xff\xef\x0e\x9a@\x97\xad&\x9fj\xa54\x848\xa0\xaeY\x8e\tb0s.W.\xb8\x02d\x92JT\xcb\x11\a\x006\xe1'\xb5\x8d\xae\xbd\xa75Ѱ\x93\xe1-ƷB\x1eZ\x97Ʊ\a\x82\xac\x9e\xd0\f\x0fqc\x8b\x05\xa4\x18\xa5\xcaڮ=\xfe\xe3{\xd2U\x18E\xa8x\b\xb1kw\x16\xbe\xfeۣߤ\xe9\xc0\xe5\xa2n\r\xe8X\x1e\xc98\rk\x17P\x95+\x99\x00\xe1\xfa\xc9r\xbe\xaf\r\xddq@\xe4\xee\xab\xd4h(\xefu~\x15\xd3\xe6\xd0\x03\xe1N2\x91\xdc+RpͲ\xaaљ\xefr\xe6\x8e\xcf\r\x84\xd9ގ.G]\xfb簔\x95\xe1\x1c\x9b_\x9e\x7fU\xfd\xc9|\xd1^\xb5ċ@\xdbN\x92\a\xa4\x00\xd7\x1fd\aS\bh\u0381\x89M\x15O\x05\x9a!\xd8\xfd\xc5\xe9\x9bI\xad\bud\x9a\xe1E@\xf5\x10\xdcq\xd4F-\"\x9f\xa22\v\xf73\xe2Q\x1d\xd5\xf1c'ַ7ˌ\x82\x8bk\r\x87z\xd7L\xc6\xcb\x13\xc8J\xbe\x8c\xaddB \u0383$)\x93\xa6\x7f\xfc\xca\xef\x1a\x8c\x84\xe9fk:)I!4y\xd1?\xef\xbftɛh\x98n\xa2\xa65d\x06v\x8d\f\xed:\xb4m\x94h\x06\xb1E\x9eaF\x04\x92\xbe9\x017\x12\xa4\xdbΈݷ\x1c\x8d\\Ӗ\x01Q\xa2\x17\f\xce\xfchI}\x7fj\v\x8b0\xae\xb4,\x8c\xa0\xa8^0<\xf3\xf3\xa2\xffk\x7f@@'/Ƀ\xe0}<7Oޏȝ@??\x12f9UlD\xc6\xc1\xb6T\x83GL\xb50\x9d\xad\"\xa1\xe2\xb2M\xb0\xbf&\xaa\x04<\xe8\xc05\xc1\xb9z\x8c\xa6\x92\xdd\xe7\x81F\xf97ȡ\xda.ᘚ\xcb\xd8\x12\xce\xe7@3=\x8f\x1d/r\x14v\xb7\xff'6\xab\xc4\x06;\xdc\xc1\v\xd7eQ\x19\xa2\x8efmWG\xbdcd\xa0\xb2\xfe\xff\x04\xba\xe3\xc2\xf7\xc3\xdd\xdd\xf8OPu\xa0\rϋU\xa3\xf1\xb5\xdf\xc8\xd29H\xac*\xfd\xd4k\x13\xees:\xc2\xc2\xf4\x03\x9e\xa2\x8aA\x10\xe7\x1c\xf0p\xf2\xf8\x8f\x16\xcdm;\xae\xb2\x8e\\\x8f\xe3x\x9d\x90\xbf\x89\x02\xfd\x85\t\x9dd\xab\xb2\x97!\xb6w9\xc3a\xc7\x16\xd92nB7?\x00M\xb1\xfd+\xaaO\xa0\x01\x1e\xcc\x11E\xaa6\x8e#\xd0\xf2\xb5=\xb5p\xee&ֲ)\xea\xe6Uk\xa0\xe3\xf8|d\xa4\xc7Ɲb\xd7\x18\xcc~\x18\xc5\xea\xc6\xf7\f\n\xb0\xc9\xf9wwc\x8b{\x87\xc5Idh\x1c\x7f\xa8?2\xd2N\xceu\x12ņ\x93\xd1 \x197C4\x02\x10=\xb2n:\xa6[bd+\xd61\xd3cq\xd4\x01\xa2ە\x17Z.ud\xe1\xad5\xae\xf8<\xd1\x13Z\xb1\xf3\x04\xf8\xe9R\xec\x17U\x12W\xbf\x86\x9d0\xd0\xc1`\xe9n-\x11\x92Go9m0\x94\xd9p\x8a)\x83$1=\xf7B\xf3@\xfe\x83\x8b\xb9QG\xb8\xf5:\xac\xd1\xd8\xd1\x18\nk\xe6\xe2P\xd2ac\xd41\xb6E\x1daST\x83\xa8\xb6\xb4G\x12^,& c\x1b\n\xf8\x96\x02R7\x18\xa4\x19G\x88#4!7vh>\x89\xe9\xcd\t\xecp\x15\t\xf1\x15\x8e\xf2\xf7\xbf\xfbݷ\xbf\x1bY\x04xؔGB\xbc\xbe\xbc\xb9\xfc\xf9\xf6\xe3k\xd3\xcdj\xd4\xfbL\xf6?\x99\xed\xf5pѝKn\r \xc4Z\xa1`\xeb\xe1\xde\xed.\xe7\x15\xb8x1r\a\xfa\x1eU\xee)\x12\xac\x16ƾy\x06M\x12\xbf(\r\x8d\xb8\xf4>\xe1R\xa2\x93\xfc\x16\xf3\xd5\x11\x8a\xaf\xc1\f\xfd\xbb\xd7c\v\xa8r\x80\x83!\xa2\"%\xd4D\x9a\xb0\xaeYdKd\nJ\xee^\x8f\rbbh\x89Ϛ\x18\xba\t\x95\xad@W;\x9fm\xd1I\x04L\f\xdf\xd9T\x04\ue7e7x$\x00K\xcc(c\x92^\xfe\x83\xa3\xec\xf7>\xad\x05~$/\xbf\xff\xde\x17\xb9T\x0e\x7f\x14TR\v\x13ls\xf8#\x81\xba0A\xff\xd3낓UQY\x15Κ\x90\xfe\x14\xba\x93U\xf1\xafbU|9+^䃹\x84[-\xf2\x8b^4\xf7\xf7\xc7\x16\xc4Qj\x03\xfc\xf9B\xbb\xd2\xf7$\r&\"\n\x137-z|\xecY4\x92\xee\xa64#\x10\xa6*\x92\xb9\xcfspP\xeaܔ\x01\x14\xb9\x8d9\xf9\x83\xc0BS\x89\xb9\x04l\xe0i\xea:\xfd\x9es\x83\b,\x9e\xc6/A'\xa1ra\xc2F\xae:\xc2e\xd5<\x91\xba\x15\x1b$\x92\xaa9(\xf4\xa6\xe0\x91U\x87\x9eS%8\xda\xcc%ј\bU\bL\x91\x9c*e\x13_\xba\x9a\x80IR\x92\xb1H\xfb\xfdP\x13\xac6\x182\x934\x01\x92\x83d\x02\x8b\xec\n\xaeS\xf1\x80'\xa6\xcc\x0e\x9f\x95\xba\x83_q\x90^\f\xd0\xdaA\xf4\xaa\xf2\x88\x8aP\x9a}(;\xf8\xfa\x8a\x10Q\xe8DT\xf5\xd1\x0e\x1f\xa1\xfc\xd5 \xb7ݮe\x98\xbf\xa0Y\xb6*Q\x14*_n\xf7\x9f.I\xb3\x89\xec@\x88\x964\x9f\xbc>\x06Y\xd9\xd4\xce\x04\x82\xc5!\xed\xe4/\xcc\xdc㦅p.\xa8\xea\xfdN\xe57\xa7\xf2\x9bS\xf9ͩ\xfc\xe6T~s*\xbf9\x95ߜ\xcaoN\xe57\xa7\xf2\x9bS\xf9ͩ\xfc\xe6T~s*\xbf9\x95ߜ\xcaoN\xe57\xa7\xf2\x9bS\xf9ͩ\xfc\xe6T~s*\xbf9\x95ߜ\xcaoN\xe57\xa7\xf2\x9bS\xf9ͩ\xfc\xe6T~\xf3\x99\x97\xdfD<\xe4+N\xc6Xhrы\x12\x98\xfe\xd8$\xd8Y\xe2\xcaUĴ\xe2\xf0\xd6\x10\xab\xa1\x8c\xaac\xd4k}z}ό\xa0#mQ*\xaa\x12\x9a\xad\xfdRB\x9bX\xb4Ϡ\xfb\xc6K\xea<\x17\xf6?U\xfe\xbc\x9687\xe3\vȜ\xc7-\xa4\xe1\x19\xf36\xd9\xf2*\xf7\x1d\x04\x9a\xecΔG[e]\xb3\xe4\xf1\xf6\x89K\x98\x86>\xf6T\x99\xf1\xa7ʊ\xef͈\xfb\xf1b\xb1U\x04\xec\x8dlx5\xd4f[\x89\b\xd8ws8vN{o>\xbb\x9e\x99\x8e\x80\xbd\x99\xcb\xde\xc8JG@\xad籷f\xa4#`V9\xec]\xd9\xe8\b\xa0\x98\xbf~\xbaL\xf4\x11\xb3\xd0\xd1\t\x98N\xc6jl,5ʜ \xbe\xf0\xf4n.A\xcdE\x96vXA\xde1\xce\x16\xc5\x02\x05[\xa1bb˲\xae5Tcx\x9dcVN\x97bB\xb0,\x05s\x1c\x1deYp\xbe\xc96\x11\x9bS\xe3ɫ\"I\x00RH\xab\xe0N\xb8\x88|;*\xe7\\\x9e\xa9\xff*\x8cϰ\x9d\x05\xd5f\xcb\xe3\xb7\xff\x11\xf4d\xacW\x15Ubp\xb8\xbc\xc0T\x1c\xf6\xa2Ί\x8c.-\x88_\xd0\xe3\x82\rOQN\xb0\xa7\x94\x00\x8b\x02\" \xee)#X+\b\x88\x00\x1e]B\xd0A'v*\x1d\xd8_6\x80\xb8\t\x06I\xf6\x95\f\x94\xc9\xff\b\xb0\xd1\xe5\x02\xd1+\xd5Ӕ\t\xec.\x11 ,.\xd6Э< ^Ot/\vؑ\xf3\xeex\"u\x97\xa8f\x17\xe3\xa4s\x19\xc0Ӡ\xa3{\xf2;\x1a\x1f\xf1\xf1\xa6\x0e)\xff\xf8t\x7f\xa4\x95\xd8\xcd4\x8dM\xf1\xefO\xefG\x06\xe1;\xa5\xf6;0K\\\xf0=2\xf0\xde5\xe8\xde1\xe0\xbe?\x85\x1fI\xb8'\b\xb4\xef\t\xb2\x93Wq.\xf3\xf6\x00{\xd7P\xf9\x91\xc3䱉\xf7\xfdIwo\x05\xc7p\fٞp\x8fO\x9dG\xf3o\x9cB\x8fH\x1eD\xaabƙf4{\x03\x19]\xddB\"x\x1ah\xd54\x88\xd8w\"\x80\x87\x06Z`\xd6O\xee\xb4OpN\xdd\ty\x90\xfa\xed\x8e>\xf2\x1f\b\x17}\x19P\xe6\xb8~;ﵾ\xf6\xcf\x19\xa5\x7f\x1e\xf7\xddn\x12\xecN\xf8\x1f\xc4\x03\x11S\r\x9c\xbc`\xdc\xd3\xfee\xb8\xces\x8e{\x15\xad)\x85\x17e\xf7\xd57\x1et\xa8\x04\x7fy\x81\x15\x13RR\xea\xa9\"i\x0e\xfc\xb1Ci\x0e\xec\xb4Ⱥ\x84\xd30̷\x16K\v%Xu\xbc\xd6+3f\xaf1LR\xcam\x96\xff\xd7g\xa2\xc8\"\xa8\x83\x05PU9S\x10\\\xb2\xbd\xf8\xa9Y\xca\x14\bqK\xe1\xd3\xf62\xa6@\xb8\x8d\xa2\xa7\x88\x12\xa6g\x8d&\x1e\xa9li\x7f\xc9\x12\xeeQ\x8a\x00\x1aU\xaet\xf2\x94\"<\xa5\xf5\xb2\xa4\x93\xa7\xf4\xbc\x9e\xd2\xe7\xee\vh\xb6\x00Q\xe8\xcf\xc6\rx\x98\xb3d^\xb76\xd8\x02\xfb\xbd\x14\xf1%\xd4hC\xba!mM\xb6=\xed\x015\xffB\x9eC\x04\x87\x85\x85\xbd\x9b\x9a\xacv4g\x89\xa7\xd2\x1a\tY\x84\xa8\"\x94\xbc\xb9\xb9\xfd\xf9\xed\xe5\x1f\xafގ\xc8\x15\x1e\xe7Z\x814\x87ȇ-k&*3\xa7K,\xe9(8\xfb\xa5\x00\xabn_\x94oy\xe9\xab\xc8\x02\xa0Ɯ\xcf\x15\xb1r\xa0fQ\x91Dy˔90\xca\xc0@\v\x1d\x1es\x81\xa1\x9b\xb0\xc3_\x9bk\t\xb9B \x98R\xa7vݙ\x83\x042c\xcb G\x05aھ\x16\x84\xa6e\xd3\a\x14T4\xc0\xb1/\n\x9d\x88\"\x84\x1e\b\x91\x83F\t.\xe3Rx\xe8[\xbdOX\xa1@\x85\xd4IM\n\x8d%%\xb9d\v*Y\xb6\xaa\x0f\x90f#r#\xbcŽjOQ\xbc\xea\xa8{\xf3\xfe\xea\x96ܼ\xbf\xc33\x8c\xb1Ւ=z\xc5\xfc=\x90P\x13@\xb2X\"\xa7#r\xc9W\xf65VK3\xecE\xa64\xf0\xb0\xa1:c\xc2Y\x96\xe4웑\xb9ΐn\x12\xad\r[\x8c\x16\x00\xb1N\x11_\fjc\xbcl\x92Y\xee\f\xb4\x83\x1cݷՂ\xf6\x9e,\xa5\xda\x10\xb5\xb2\xbcu\x8c\b\x97\x90ۓ\x1d\x15\xa1\x01\x10ˉX\xb2\x19U\xa7\x18\x9feu\xf9\xeb=\xbd\x83S\xbel\x1ca\x987\xd0RY\x19\xdeD\xb5\xdc\x19\b\xb3\xe4\xc2\\\xa4}E\xaeǞ\xf9\xb0)\x0eSƚ\f\x06\x89\xd6'\xa6\xd5Xj\xd1m\x1b~\x0f\xc87\xe4\x0f\xe4\x91\xfc\xc1\x98\xab\xbf\x0fAw\xb7U>v\x9d\xf7\xfe\xe8\xf5\xb8\x13\xa5\xfe\x8aJ\a\xe1 v1\x7f\xcfx\x1a\xe5\x8d\xc0\xa3\x06\x89g\xe9:\x8a\x87b0ڻ\xc2\xc1\x7fv\f\x8b\x832\aV\x96\xa6\x10\x1e=\xf9Y\xb1,\xc1\xe1a\xb5ЍS>ͳjq\xb4\xc1\x10Q ɂ\xead^\x15\xfe#m\xf0|I\xa5+m\x16\x0e9\x15\x18\x81r%\xaes\xa6\xbe\f\x01\x8d)(i\xf0\xe519h\xcd\xe56\xf1Vg\x17\xdbF\x8d\xc1P\x9djv\xc6:N\xd61h\x84\xb5\xbe\xd7fwу\x98\r\xbf\xd5\xd6-\xd4t\t\xc5n\x9eD\xc2\x14$F\xc5Q\xe3\x85\xd68`7\x19\xb9d\t\xa8O\xa6\xe3r)\xb4HD։\x97\xc6\x0e\bʂ\vﾋ䥿\xbc\x19\x0f06l\x8e\xb4\xbe}}7nd\x04\x82!\x9eݽ\x1e\x9f}\"dƄz\x86\x95\xe6\x1a\x87E|\x86%\xe9zO\x1c$\x8a\xa9\xd9i\xc4\xd0\xd0I\x18.h>\xbc\x87U\x80\xe1\x18\x8b\x9b\b\xccl\x0e\xd7NzA\xf3\x960$Д}&{\xe4\x9c\x12\xa9ƴ}\xb3\xdcB,\x83jL\x8d\x1b\xe5a\x03Os\xc1\xd0\x1faӍ\x1dt\x01@w\xec\xb5{\xfe\b\xdbi\a\xddi\a\xddi\a\xddi\a\xddi\a\xddi\a\xddi\a\xddi\a\xddi\a\xddi\a\xdd\x17\xb9\x83\xee\xff\xd9\xfb\xfa\xe78n#\xd1\xdf\xf7\xaf@\xb1RE1ᮤ<\xbfT¤\x92b\xf4\xe1\xf0ŒX\xa2,\xbf\x9c\xe3sag\xb0K\x84\xb3\xc0d0Cj\x1d\xe7\x7f\xbf\xeaF\x03\xf3=\xbb\x98%i\xc57\xa7\xab\x8aIb\x1a@\x7f\xa3\xd1ݸ\x1f\x9d8U\xd0M\x15tS\x05\xddTA7U\xd0M\x15tS\x05\xddTA7U\xd0M\x15tS\x05\xddTA7U\xd0M\x15tS\x05\xddTA7U\xd0M\x15tS\x05\xddTA7U\xd0M\x15tS\x05\xddTA7U\xd0\xddO\x05\x9d{\x92?\x80\xb1\xeaL\xf5BoR\xc8Oy\xef\x00y\x81\n\xcbO\xc5\f\xe1R}\xf5%n\xcd\x1e\x82\x05\"\xadVr]dX\xc7\xf5Ծ\xcd>\x8f\xec\xc6\xe6\x1eCs\xbf\xba\xa7ǳ\x87u8\x12\xb9\x91!Et\xf0\xaf\xacJ\xbb\x1c\xed䌲\xaf\x87Y׃lk\xcas\xa8\xdd8c\xff\xfd\xe4\xef\xbf\xfaq~\xf2\xa7'O\xbe}6\xff\xddw\xbfz\xf2\xf7\x05\xfe\xc7/O\xfet\xf2\xa3\xfb\xe1W''O\x9e|\xfb\xd77_~\xb8|\xf5\x9d<\xf9\xf1[Uln\xecO?>\xf9V\xbc\xfanO ''\x7f\xfa\xc5\xec'\xb4Xu\x01\xfc\ny\x85~\xb9\xa4\x8b\xfa\r\xff\x04Z4p\x95|\xa3\v\x85\x05\x98\xc4\xfc\xa5z\xb07\x9f\"\x0e>\x9d\x85\x85q\x1eP\x12G*H\xe7\"\b3\t\xe4$\x90\xfb\b\xe4{▦HZ\xc7\xe6\x1eE\xd2\x19\xdaP\x99\xbcX1\xbfFi\x98\xde\xc8\x1c\xf2\xf2  \xc3\xc7'\x97ʼv\x14%\xb5\x84\xd9\xdb\x1c\x8b\x92G?7_\xa9#\xd2\xf9\xb5\xc8\xee\xa4\xc1 \x17WeL\x01\x15\xc6<\x16+\xa9\x82\xd320r\xb4\xf89\xa8\xaa\x11\x1fA\x16_&\xf3-d\xf0\x8bO\x01g\xf2:\xd3_\x11\x18\xa6\xf17ƅ\"(E|o\xa8\f\x1f\xb4\x80\xaa\xae`\x82\xa4:\x91\xd1\xf6\xa9\xdb\x10\x1a\t\xf1)\x7f\x1a0\xf7~3\xe6\xdcܔ\xf4\x17s(\t(\xc9ܚ\xff\xa1\x9dE\xb4̗\x99\xbc\x95\x89X\x8bW&\xe2\tJ\xc3\xd9\x01:\xec\xbc\af\x10Hx\x95F\xe5\x99N\f\xbb\xbb\x16 \xb9P[\x97i\x88Ec=ۚ\a\xa7\nm\x80B\xa9[\x18\xb0\x19h\x81ܰ\x94gЊ\x80\xc0\x87\xaaD,\xca^j\x9dЫ2ɶ\\;\x15\xa0(\xfd\xbd\x12w\xdf\xc3\xdc\xc1\xe1\xf9\x84\xaf}a\f<\xe8ތ\u058c]v\x1f\x99@\xddB\xd3UƓ;\xbe\r]\xeeݵh\xaeO\x9a3\xf6\xfc\x04e\x93\x1b\xe6g\fմ\xbf>\xc1{\xc3\x17\xe7\x97\xdf_\xfd\xed\xea\xfb\xf3\x97o.ގQ\x8b@)\x11\xf4(\\\xc4S\xbe\x94\x89\fw\xc2j\x82\x01\xc9]UPh\x86\xe2\xf8i\x9c\xe9\xd0\xc4X\xc4rV(\xe8nQb\xda\xd4\xeeW\x02AV\xdb^ \x9b\xad\xea\x8b]g\\\x85g-.\xb7\rf\xc8\n\x05A\x9f0f\x1d\xa7\xdbȏ\x0e\xfd\xa4A\xb5\xf38\x16q\r\x15?Q\xf6\xe5\v\xb7\x84m\xd9qc\x04L\xc6.\xdf]]\xfc\xff:qA2F\xc0:\xc0\xd9?$Y\f\x04\xe6@\xaa\xbe\xb7\x15\x86\x13]?\x1f\xba\x8erZYi\xcf\x0f\xb9O\x7f_\xa8\x8a\x8e\x92\xaa\x025\b(c\x1b\x1d\x8b\x05\xbb\xb4&Y\x98:\xacr\x8ePf\x83\x04\x17\xb8\xdcW\xd0\x1c;\xd928\xbd\xdd\xf2\x04\xbc\x96\\\xdbڹ`\a\xab;\x9bj\xc5\x13#\x16\x8fbW\xc1qy\x03Q\xa3\x03(\xe7a\xb0X(\x9d\xd3yy\x04\xdfC\x13\x94LG̞\x99+Ik5\xfb\x15\xece}\xa8\x98Ui\x1c\xa6/\xfd\xaa\xf1F$\x10&4\xf6\xea6\xabn\xaaP\xf6\x82\xe3;Tdcm/\xbcfa\xb3*6\xdc܈\x18\x93sGl\\\xfa(\x83%\x8a\xdf\xf4\x87m*\xd8J\xf0\xbc\b\xbe\x9aAo\xd8\xe6\xa8\bŗIh\x00c\xa4f\x03ܼS\xc9\xf6\xbd\xd6\xf9k\xff\x98\xe3\x01l\xfb\r\x9di\xea7\x17\xe0\xe0\x06\xc1\x84\xdej\xb0\xb69\x12\x0e\xd5@\xa5R\xd6q[ Hi\x1eS\td\x85:7_f\xbaH\x0f@'Hٗ\x17/A\x7f\xc11\x03\xb8M\xa8<\xdbb\x1b\x80 \xb0\x8c\xe9UC\xb6\xdc\xf9\x8a}\rrG\x92\x16\bԫ\x80\x15+\x94\x11Є\x84o\x19O\x8cvǺ\xe0\xd3\xec%\xf6ɯ\xc6_\x16\x18\x9e\x03\xe7]*\xb6\xd4\xf9u \xc4\x068T\x01\xedYBc{\x80L\x8c\x92\xf9d#\xa8\xf2a\r\xa8\xa1@\xf9\x8d\x80V\x85\"\x12\xb1P\x91X\x8c\xbd[\xfd\xcd\x17A_\x8e\r\x8e#\x97\xbf\xd5\n\x14\xc8\x01|~\xa1b\x19qk\xe5x^\xe7\xd3و\x9eCt&\xe7X\x11\x8d\xea\xa30\"\xc3\x16^\x10\x02\x18C\xea\xbf\x16K\x91\x88܆,\xb0\xe1\x1c\xcf\x05\xaeTnx\xf0\xeb\xee<\xf7\xa6\r\xba\x93)Sd\x82\x82\xc29\x8b\xb5\x18\x93_F\x9b\xfe\xfa\xe2%{ƞ\xc0\xaeO\x90ա\xd2\x194\bv\xe3\x0f\x84Y\xd7\x18r喇\xa8D\x89g\xc1]\x9cP\t\x9f2\xa5!\a\xf3\xda\xe1\x12\xba[\xb8p\x10\xe5ֆG\xf1\xdbʧO\x9d\x04\x02\xae(\x9f\xff=\xea\xe4 \xd3\xf7\xb5\x11ف\x96\xef\xeb\a\xb7|\xe3\xc3J\xa0O\xea\x94B5\xc06\"\xe71\xcfy\xd8s\xf8\xf0\xafP\x1e\xdcbb\xe4{e\xe4Ƿ\x8bF|%U\xf1\xc9>\x0fa\x0e\x94\x83\xabW\b\x8c\xd1\xe5\t\xe8\xf2e\xb0\xc1I\xd3D\xda\x16y5Yp\x8aܑj\f\xb5K\xc1r6\r\x159\xdc\xc1\x80Q\x0f])˸\x8a\xf5\xa6\xb5m8̉Z\x1f\xf1\x05j\xfcP\xf8\x93XݓX\x8d\x0f_'\xe2V\x04\xb7?lH\xc6W\x00\x03.u\x1c\x9f \xd0`\x98\x8c%|)\x12\xeb|Y)\xf1i\xe3%\xa3\xcd\x1e1Ԙ\xe9\xe4\xd0\x12\xc5\xf7:\xc1\xb2\x0f\xee\x91\x03@\x7f\x06\xb8\xc1O\x0f\xc3͇m\xda\xc0\xcd\xc8h\xf2熛\"\xd8\xe3j\xe1\x06\x9c\xb6:n\x00\xe8\x7f<nF\x86\xe0金\xf5\x9d\xb9\x1f#\xfe\x8d\x05\xe6\xb4w\x04\xf6'\x97jm\xc6\x1br\x9e$%:\xcd}Xr\x97\xa8\xe2\xba\xf7wح@\xa8\xeeH\aψ/\x1aa\x9c\x03\x8dW\x8f]\xed\xb2\x94\x81\x90\xdbv\xf5'\xb3\x94\xeb\x8d\xe1/2pzsɓ\xabTD\a\x8a\xf8\x97o\xae\xce\xeb\x00\xc7\xf55\xbc\xc3\x17C\x00\xd7\x00\x91\xf1x#\x8d\xc1C\xbcX\xc2+n#@>qٰk\x99_\x17\xcbE\xa47\x95T\xa3\xb9\x91k\xf3\x94dr\x0ex9\x191\x87T\xd0D\xb2\xbcf\x10\xd0N\x95\x0e\x88\xb0\x91\x11 #\x8fMd8\xaca\x8a]\x86@\x1b\xddo\xc7U\xb8a\xa3\x98Gԙ]\xac\xf7vT?\xa0\x1d\xec7\x12\x1f\x90\xcdsMo\x00U\xe8W\xa1\xc6\b\xa0H?{G\xf6\xa8\xa8\xf6\x11\x93{\xc00\x18\x1b\a\n4-\x19\x9e`\xa0\xac;\xf6\xe2\x90\xed\r\xcf\b\xc0]\xf1\x17\x9c\xa6\x1eU\x19\x01\xb9+\x0eS5\x8a\xe1T\xdd7\xa88\x02\xf0\xb05d\xe3z\xe4>\x8cE|\x10\xab\xf8\xf8>݈\x8f\xa8\x02\xff\xa0\x16\xe3W\x15\x18L\xd6\xee:\xf6\x86Ȝ?\x06\x97\xa9\x95\xee\x05\xf8\x9e\x15t\bI\xe4\x0f\xd6\xc5\n\x00\xe9\xd9\x01\xc3\xf1\x98H^m=B}\x96C\x98\x05\x02@\x89+\\\x83D\xf4\\\xd4W\v+\f}\x8e\xa4\xd2\xe7\xfcԣ\xc1y\x96\x99\xa0\x96+!\x0e\xef?\xe0\x96\x88\xfb<V\xd7s\xe1\xd2O\x04\xa8\xfc\x10\xb6Jz\x8d\x02<]P\x9di\xa6oe,X,W+\xe1\xf2p\x97\x02\x92r\xf9F\xe4a\xb92t)\xb6\x14ki\x93#\xf5\x8aqPC\xc7Ǧ,\xfe\x0f\xc1\x00\xa6Zʜm\xe4\xfa\xda\n2\xe3,\xd1j\xcdܭ\x14\x14\x802\x88e\a@\xd5\x19\xbb\xe3\xd9\x06:!\xf2\xe8Z\x00\xb5\xb8bq\x01\xe2Ͱ\x83\xe6vn\xf2\xb0\xa0 \x04\x99\xf0~\x88މ\x8a\xdaU\x90\x81\x94\xc2\x13\xeeR\xe4\xdcek\xb8\xa4\v\xe7\xb5U\x056\x00\xae\x83\x06\xd9\x1c\x9fK\xb7\x9e\xa9\xa7\xfe\xd4S\x7f\xea\xa9?\xf5ԟz\xeaO=\xf5\xa7\x9e\xfaSO\xfd\xa9\xa7\xfe\xd4S\x7f\xea\xa9?\xf5ԟz\xeaO=\xf5\xa7\x9e\xfaSO\xfd\xa9\xa7\xfe\xd4S\x7f\xea\xa9?\xf5ԟz\xeaO=\xf5\xa7\x9e\xfaSO\xfd\xa9\xa7\xfe\xd4S\x7f\xea\xa9?\xf5ԟz\xeaO=\xf5\xa7\x9e\xfaSO\xfd\xa9\xa7\xfe\xd4S\x7f\xea\xa9?\xf5\xd4?\xb0\xa7\xbe\xc9c\xa9\xcef\xa3\x18\xaa\xa7\xa9Lp\x17UW\x90\n\xc9_\x05$\xe5\x81OfW攐\x87\x1e\x00\x96\x8a^}b\xa3\xcb\xf70\"?\x85G}b[O\x13\x00\xb1{I\xae\xaa\x16\xbaWB\xc7\xe3\xb0\x0e8R\xb1W\xef^{\xd9\x19\xd1\rgL;\x00\xdc\xc9;\x15\x89\x83I\xdfQf<\vN \x8b\x12\rm\x92\xaf\x05Q=\xba\xe6J\x89\x84\xce\x1fA\xc9=\x10\x97X\n\xa1\x98N\x85\xb2\x99\x83\x9c\x19\xa9։`<\xcfyt\xbd`\xdf\\\v\x15NvjSZ\xae\xd2@F\xcbƒ?\x13\x9b\xb0\x06\xb1\xb0<ƣL\x1b\xc36E\x92\xcb\xd4/\x90\x19\x81%;&4k\xd8\x11\x15\x98\b2\xe2\xc1#\x84\xb6*\xe5\x0e`֠kK]mT\x87'\xb4S\x80#6i\xbe\xf5Ił\xaddfB\xa8\x14%\x12\x0f\x02\xb8_H.\x806(\xb1T\xa7\x98\x9e\x98C\x0e\xac\xc5h\x88-\x81\xcd\xe1\xf7\xe0\x13\xa5\xb9\xc1$\xd9\xca\"i\xd2X\x1a\xf2\x9fMH\x02\x1d\xa7\xe6ih\xf0J\x8c\"\xeb\xc68m\xf8\x8a\xe9\xe3\xca\x12=\xae\xa5)3\xa8C<$\xa7\xec \xd7\xd5+\x93S\xc6\xdbm6\x82\xa2\f\x98\x0eV*M\xda?\xb2\xbe\x12\xb7\xd0\x11NDBކ\x98iޣ\xf9\x1eT\xf1\xe5\"\xdbH\x85i\xcbo\x841|-.\x83\xae\xad\xfa\x0et\x00\xa5\xc2\"A.=$F\x82\x04\xf8oKZA\x1aye\xc9\x01@7vw>\x1d\xff.\x83\xce\xf9\xa8ư\xe5 \xde\xd3\a\xf9\xf4\xad\x85U[\xbf\x112\xdd4\x01`%4\xad̅\x82\xb6\xb76\x89`\x99I\xb1b+\xa9xB9\x84\xa7\x10\x19\vi/\x06M\xa6\xa0뒁þV.E\xcdae\xc1\xbe\xb1h\t\x00\x99g\x85\x02/\xc5'\xa3+\x1d\v(TXg\x90\v\x02\xb6\x90+\xf6ų\xdf\xfd&\x00\xe8r\v>)\xe6\f\xe4:\xe7\x89[ K\x84Z\x03GY\x03\xc1\x93\x90ȝ'\x92\xf1\xd4\xc7Gz,\x82\x9f\xff\xfaf\xe9\x85.H\x05h\xf64\x16\xb7O+\xfc8O\xf4\xba\xeb\xf9\xa3\xe3\xd9\x03\x86\x10:D\x18\xbb\xe9\x9f\xcd\x0e\xeaqƮ\xf5\x1dҵ\x02\x7f\x84\xbc\x91G\x03\x05%:-\x12`\x98\x05\x83\x1e\x8e\x96\x16\x85\x11#D\xceWö\xb7\x0ez'H\x8cݲ\xea\x8a\xc6%\xeb\xbam\x04\xed\x1d\xcb\xe4(Ȍ\x96\x90\xc4m\xc1^\xf3$Y\xf2\xe8\xe6\x83\xfeJ\xaf\xcd;\xf5*˂\xfa\x929\x9c\xe1b\x13nr\x16]\x17\xea\x06pQ.=\xd1!1\x19]\xe4i\x91\xbb\n\xa3\n\xb1\xfd\xdeA\xaf\x85%\xc0[w\x88\\\x97\xca\xca\xc4'\t\n\x03\x9e\x88\x00}$`\xf7!\xc6\x1c\xf4B\xa2\xd7~ͦ*ȿ~\xf6\xc5o\xad\x02\t\x80\xa83\xf6\xdbgX\\`N\xad?\x83\xd6\x1b\x1c\xc6\rO\x12\x91\x8dU\r\xc0\xe2]\xaa\xe0A5A\xbe=\xf8\xfcroG\xd7\x0f\x1f\xfe\x86\xe7V\x99\x1b\x91\xacNm?#\n.\x85\xe0\xf2\x18]\xabc\xb2\x85p\xe4h\xbbH\x8b\a\xf5\x91nuRl\xc4Kq+ǿ\xb5W\x83\xe1\xaaa\xe0\x19]\xa6C\x8e4\xcbDG7,&0\x95\x1cC\xb2\xc1\x9et\x8bك\xe5Q\xf6\xee\x8bv\x8cU\x99l\xc3\xd3t\x7f\xce%a\x84b\xc1\x8c\xdfն\x89\xdaB*\xc6\xc7ln\xfc\r\x87\xc5q\x983܁\x9f\x12\x8c#:\xa4\x85\x05Bd\xae\x1eG\xaf\xeaT.ې\xday\x82\xe1:\x7f\b\xa8\x85\xeeP\bjGj\xa9\xf1\xf9\xa55\xcc*\x1fC\xdf\xf0\x9c\xce\t\xa3n\x90\xb0D5\x15\x99\x91&\x17*\xff\x88\x1c\xfd\"\xe1rC\xa1\xad`\x88\xe1WN#\xd18&V?\xaf\xb0v\xd0g\x81\xc8\x1d\x15\xde\x0f϶\xb4\x8a\x15\xfb\x9a\aHx\x8d\x93\xa0Jۂ\xc1\xc0\v\x1e\a\xe1\f\xa6\x03\x89\xefŲq\x16<\xc0\t8L9\x7f,qS\xd7Ͱ\xc3P\x81E1\xb1\x10\x7f\"\x95\x8c\x849X#\x03\x00\xb7\x81\x9a2\r\x04Z\x8d\x80A''\x8b\x99\xf2\xb8CQ\x05\xe8\xfdX\x04\xc5\x02I?\xea\xdc-\x8d\x1d\x9f\x1d\x87\xe0\xf7\x00\x85␜锯G\xbcD\xd6\xc0u\x13\x18\x8b\xa1\xa1\xc0\x06\xbc\xed@\xb0\x90ppg\x17g{>\xa4\x04Uľ\v\xd8\b\x90&\xa7\xf4\x01\xb2\xa7\xee\xc8b[L\xdc\x05\xe7|\xc3K!\xba\x80{;\x88\xa9\x97\xd7+o\x1a\x88x\xab\x95\bw\x02\f\xb5'\x836\x02\xb6z\x00\x9c\nl\x10 \x15{\xbex\xfe\xec?\xc7|\xe3\x1e\x1a\xe6{T\x8b\xa5\x8a^z\xb4ݻ\xf7(\x0e\xc2\xc0\x1b\n;\x96\x0fH\xc8qmߡ \x83\xc7s\b5\x12\xe7\xe2+\x9bO0z\f\x99\x15\x95\xc6B'\xa18b\x87\xbeN3\xee\xccE78\xc5\xf2\xde\xf5\xbd\xb5\xf4\x81\x10\x99U2]\x11i3\x16b\x87\xa9\xa8\xa2\xfa\xe8(\x18\xe2\x13\xbb\x92c\x83/\x12\x9d<\x9a8\x10\x99^}J\xb3\x83H\xf5\xeaS\xca1\xee\x9d\xd6i\x16\b\xd39\x85\x034\x1b\v\xb1\x83f\x7f\x16\xd7\xfcv\x84=3r#\x13\x9e%[ \xf6\x95\xc5 [\x169\x13\xeaVfZmƼCv\xcb3\t\xcf\xf2\xb0L`3\x1f\b6\xfc\xe2\xc9\xc7\xf3\xf7\x98Yt\x02\x963\x18\xa6pT)\xe0ڸ\xc5\xfd\x95\xe5\x1e\xa6[\x8e\x8eZ\f\xec\xf0\x02\x9c\x15\f\x1bl\xb9\xc3+x\f\x9b\"/\xec\xe3]\x9f\xa2\xa40\xf2V<\x92\x80\x8c;\xa5yo\xf7gpH\xa3\x06+/e\x80~\xa8i\x86\x17\x15\x86kuk\t!\xe3\xc5\xca:e\xce\x1e\x9ev\xa7l\x04i\b\xca8\xf5\x97K\xe0\xa4Q0\x99\xdaV-q\n|r:(۠yD\xb1M\x03\x1f7\xac\x1cƽ\x01\x1c\x18\xc8{!\\G9\x82g\xb3@6\xfb`\xbf\x83\x1cb\xdf}u\xc3?a>=G\x81\xdc\x03\"\x83\xdb\x18X\x01\xfb(\x12\x91ig4\xee\xb8\xcc}e\x82T2\xf7L\xbd\x1f\xb3\xe1AŶ\xaa[\xcc\xee\x95\xd0{Rb\xafa\xbb\xc84\xccN\x03\xec\xb3c\xf6\xfey{?\x94*J\x8aX\xbcH\n\x93\x8b\xec\xbd{\xf6\xfdl6\xc0!\x17\xdd\xdfx\x85R>\x97\r6&\x17\xd9\xdcD:\xed\x10z\xff\xca|ŧ\xa0\x05Ů\xb0\x10b\xbe\x19=\nM\xc9\xc7\xc2\xe4:\x13\x9d\x89P\xaaH\x92F\xfa;\\\x964\xc6\xc1(\xf0\x10:3\x83\xfb=u\xb748\xa2\x99\x94\uf266\xcap8\xa9rf\x12\x88\xe8\xeb\x15\x92\x19\xe1\xd8\xff\x82\xd5\xd2\x14\r\xb0\x8c(g\xf3l`\xe3\xf6v\x11.\x94\x92\x12\x8c\xab\x97C\x10-u\xd8\x13F\x1b\x10\x91=\xd0\xd4\xe657\xbdc\v\xdc\xfd^x\xaa}\xd1@\x15.\xbe\x82\xa0\xae~\x12\x15\xde8\xa54[j-\xf5\a\xc7h\x7f|\xfa\a\xc0\xd6\x1fO\x99X\xac\x17,\x16i\xa2\xb7\xe0d\x9a\x05OS\xf3\xf4N,\x17\xb3Ns\xa9\xe6\x84q|\xe5\xd0]\\A\xc2\f\xae\x8cg~\xee\x18\xa8\x02\xbd\x19\xe9\x86w\xcbx\xdc\xdb4\x8c\xf6\x057\x18\xf49\x02\xa4\x92\x1dH\xf7ʋL9\x8d\xb9\xf9Lh\x1aF\xcf&-\x1d1vs}[\xe0\xab|\xef\xe0\x187\x0e\x92\n\x8a\xf4\xb3\x10\x82\\l\xce\xc1=\xe1\x9d\xcf\x11\x94\fq9\x10\x06\x1eXT\x1d\xdf\xf5\xc9,\xb67<\x05\x13\xcc+\xbf\x87\x1e\n1\xdco1\xb8\xde\xdfvic@\xb3eiJ\xba\xd4\xfeJ\x894L\x94\x89j\xbe\x93#\xcd\xde\xe6&\x17\x9b\xafཉG\xc0\x89\x9d\xa7\x86\x0e|\x06\xa4\x85\t\xbf\xf3\xd6t\x0f\x88\t\\ʕH\xd0}?\x1b\xda\xcbWՑ\xb4\x1d\x91\xf3\xdb\xe7\x8b\xfa_ 4%\x13\xc8:\x03\xcd3\xebl\"kw\n'\ahm|+\xe3\x82'\xb4\xba\xcaK\x12V\x90Jy\x83\xf8\x99\x92I;&Ǔ\xf2\xeb\x9a\xd81\x97\x05\xb9\b\x11\xa7\xa1K\x11\xbc\xe0\x8430\xe5A\xb7G4\xd0\xd6\xfc\xc0b\x8e\xd2\r\xe8\xd1\x13\xe3pG\x1e\x995\x05\x1d\x90m\xdaMu\x14\xaa\x99\xf3\xb7/\xbb\xcf\x1d=z\xa6\xb5\xc8\U000c1150\xdat\x7f\xc1kn:\x05\xf59\xcbX c \xb3\xf7Fl-\xe3rEMy\x1d\x88L$\xd4\xd1Z\xb0\x1ba3\x94\xecw\x8bٸ\x9b\xaa\x1b1\x10\x04\xaem\x17\xe6sy\x1f\xb8o\xf8\x85\xbf\xbf\xf7H\xb0\xef\xa6\f\x9d\b\x86.\xe9\at\x84\xfb\xe70\xb2\xe7\xb2=\x02\xfd\xe3\xf8@\x99\x1b\xb1\x85(#\xa0\x13\xf8\xebZ\xa6\xa0Q\x86:0C\xfe\xbd^9l\xb3\x8f\xf0\x9a\xa6_\x8b\x95\xa0\vu\xca\xde\xea\x1c\xfe\xe7\xd5'ir\xb3\xa3\xb5\xfcK-\xcc[\x9d\xe3\u0603Pb\x17\xb5'B\xec`dPe\x83  S\x16\xbe\xdf\x1ef\x9d\v\xbf\xbf^\xc8x\xa9s\xa1@\xc9\xd0\xce}\x0f|C\xc0]\x99\xa0\xf7\xc3\x1c\xf4\x01\xa0n^\x80N\xa8\xd4Y\r_=\x13\r\xc0\\\nF\xd3\xe3Ս]\x1cf\xe5\xa7\t\x8fD\xec\xbags0Q<\x17k\x19\xb1\x8d\xc8\x06\x9f\x9cMAO\xf5\x93n@\x93\xecM\xdb~G\xc5\xfd߮\x13\xe9\x8d\xe8\xfen>L\xde^\xeb\xb7{U\xa8\xbe\xbb]\x85\xfd݅=\xf0S\xe3\xebʤ5\xbf\xe1_\xa0N\x91Q\xfe\xcdR.3\xb3`\xe7T@\xd49gu<9\xa7U\xd0\x00\x15\nf\xfeY\xc8[\x9e\x80\xaa\aš\x98HDo\xc4[\xafZ&\x10\xe2kP#\x05J\xd4߄\x1e݈\xed\xd1iM\xf2\xfa\xf2V\x8f.\xd4\x11y7M9pv\xc6v\x05?\u00ad\x1f-ZF\xb0\x13\xec\xa0a\x1c\xe0\x88\xde?y\xa7\xeb\x8dͧ;\x9b\x8d\xe1\x85\x01>\xa8\xf1\xc0\xdb\xc6l5F\xa8\x9e\\j'\xf7\xf6t<[\x8b\xbcc\xa4\xf3\x141\xbbf\xc1\xceն\x05\xb5\xbb\xbb\x82s\xaeJ\x8eJ}\xb8\x95`\xda\xfa\x8d* ʖ3\x90(\x06\xbfn\xd3\xe4\xdcM\xbf)\xe9^\x96\xc7\x1d\xff\xf2\x18&\x89#\x9eŧ0\xb3\r\xe9F\x1c\x9bM\xc3_{N\xe2\xb4\xff\xaar$O\x19\xaa\xd4!\xb5\x9a\x96\xe6\x17\x8b˶L\xde\xe1\x8a\xd3\xc7n-\x8b}\x99\a\xa4Ed\xb7⭎ť\xcers6D\xfc\xcb\xe6莠\x16\xe0\xbe\xfc\xbb^\xf5\x1f\x1f\x00\x94tq\x99\x1b\x91\xe6\xe5\xab\xe6\x18\xb9)\xa1\xb8\x01\xbf\x87\x1ctW\x9e\xd5Q\xe0Q\xff\"J\x04\x87\x03\x9b\xa1\xd6\xdcJ\xdc1\xadh>n\x8c\\+z\xc9\r\xdc\xeeS\x14\xe6\x01\x90\xe8\x88݉LT\xfb\x7f\xbb\xfdCX#\x8at\x16\x83}\xa3\xd3\x10\xed\xaf\xe3\xa2\x00\xd2\xf2\xe7\xf4\xfc\xdd܅\xfd\xd1O\xaa\x1cIOK\xbc\x84\x9c\x12\xfa\x03t\xe9\xed{\x01L\xd4]\xfbQ'\xf4\xc7\xea\xd0:\x95U%\x13\x92.=M?\x91\xf1\xd4d\x14O͵&\xba\xac\xa1\x85\rR\x03\xa60\xb5\xc0E\x1bv\v\xa2$\xb5\x9b\xe1\nczʝ'\x90\xe1\x00\xed\xedї!%@\x11VF-\xf0#H\xd9\xecX$V\xbc^~|\x01\x12\xccK\xfd\x80ls\f\xe93@U\xa8U\x84\x14\xd8&5\x84*6Md\xce\xd99\x167\xb7~\xfdN\xbd\xd0j\x95Ȇ\x18\xc2\x17o\xe1\xb4=\xdbS+\xa7\xb7\xd1{a\xe4\x0fb\a\x19_\xd8Q\x15\n\xba\x9a\x1d\xea\xd2\v\xf2\x91\xeb\f\vX\x06d\xb5E\x16\x8bK\f^I\x15e\x82\xbbW\x11;\xee\xce\xfcT-\xb0nji\xd4q\x8e5\xcck\x11\a\xb1\xfb\xd0\xf9kţ\x9eSL\rK\xafy\x19:\x88E$7<\xa1\xae\x8c\xa7\x90\xc0\x97\b(\xa2y~Z\x9e\xc4\xfa\xf7Sߓ\xabR\x86G.\x97[\x8a\xaa\x1e=_\xfcߣ\xe6\x16\ai\r\xff\xbf\xb1-\x9b\xae\xe4\x0f\xe2\x11\x1d>\xea,\x81\xb3\xd6\r=\xed1J\xb81\xa5\xed\xee;r\xd0\xea\xddg\x1e\x13Ͼ\x94G\x84Wb'|j\bܷ\xd2 \xd2G\x9d\x80\xed\xfcD\x8f~\xa4v\x18\xbe\x81?\x81\"\x81\xbb=\xf3%\xcf\xdbX\xac!\xe8}mhE\xca\xca\xe8\xabuB\x9d`a\xf4\xb0m\x10\xe8\x04\x17\xe9\r\f\x05=F\r\x02+\xb13H\x8a\x85\xe6\xfc\xbemQ9\x87\x83ނk\xbb\x01\x04\xc4\xc6\xfbwW\xd9\x1c\xf7\xc1\xe5\xfdv\x17\xb8\xbf\xc5,,\xc8\x12ie\x99\xffC\xef\x93ʵm\x1d\xbf\xa8~\xe0\".\xc0\x0e\xde\x1f\xb4\x95}\x1e\xf0l\xa0\u009b\xb6Ǝ>d\x858»\b\xae\x90\xccT\x8f\x84\xfb]\xb0\x8b\x1c]H4]\xbdU\xb4z\x03\xb5\xc0\xf6v\xaf$/\x04,\x19gw\"I\xe67J\xdfA\xa0\x92(S\xae\xb1{㌽29_&\xd2\\\x13Xۃ\xdc\x01\xc7klܣ9e\xe7\xb7\\\xa2c\x81\x03+\xd7?=\xa0\xc1\xaa\xf2T:?\xce\x1e\x96@$\xec\xeb8\xa9\x8e\xcd)\xc4-\xe4\x8a\xfd\xbf\xabwo]\x89\x8bc\xa4\xbe\xa2\xd7\x1d\x1a\xea\x1fF\xab\xfe\x94\xbf\x1a\xa5\xab\xb3\xf2r\r\xc2\x1f\x12OY\"o\x04\xfb\xd7\xc2\xd6r.\xd2knĿ\xfb\xb2+\xd1\x00\x94\xf9\xc9\xde\xe5u\xf4Fo\x1evO\xcf\x01\x00;\xf1\x163\xb5ٛN\x1aM(\xf8\xae\x19\xa7\xfe(\x8e5˵\xc3\xc0\x8fp\xf2\x04\x1c\xf7\xc0\x94+\b\xa3\xd9&\x7f\xe4b!\xd4\x06$\xe8\x00\xe2&Z\x8c\xa1\x89c\xa7=h\xe2\uef7a\x9e=m\xa8\x95>m\xe2\x8eѐ.a\xb9\xbaq\xa3\xe9\xe0,֙.Ҟ\xeb\xccQ\x1b\x1dL\x1b\xa9\xed\xd3%\x8aȮ\x1c\x11\x9f\xff\x91k\x9f\xf4\xd1\t\x12\x98\x8e\x10a\xd5I\xa9B\xbb\xbc\xad\xea\xd5\xfe\xf3g=\x107R\x15\xb9\x18\xb7\xff\x81.\xf7\xb5\xdd{\xae\xf31DzB\xbd\xc2\xce^\x1e\a\u008a\x908٫S\xc37\xd0\x1fț{\xe6\x9b\x05\xf8\x10{\x9c\xc4\xda\xf1;7\x11EPZ6\xadS\\\xdc`\xa8\x9a\xac\xba\x17\xf5\xcb\xdd\\\x97\x8f4\xce\xfa\x84\xb4y:\"\xf9\xa8\xc6^@\xf0\xa8֒>\xb2\"قy~y\xc1P\xc8\xf0-\xcf\x1e\a~?_\xa3\xb6O\xb7\x14S\xe1\xff\xfazz\xf3~\xdd=\xb7\xa9~V>]\xe9\x00\x84z\x19iV(\xf1\x1a∝\x7fnl\xe7\xb2\x1cݸ\xdf\a\xaeg\xf8\xfe\xb0\xc8\f\xa1\xfe\xa9IE\xf44\xcf\xe4z\r\xbf\xec\x04\x0f\xb7:\xb6\xa2\x83B\x11\xa0\x013\xb1ѷ\x95\xfa\x16\xda\xf2RDܵ\x00\xb0\x91\xa6\x1e\x90\x1e\x9b\xb1\x16x\x04Cy\xeb\xf2\x17\a)\xb9\x87\xe4픖a\x99\xa1\xa3վF\xe6j\xb7\x89\xa9\tN\x1f\xcaGX\x15h\xa9d\xae\xe5*_H=BC\xb9\xd0\xe8\x1e\x9b\xfc\xe0c\x88\xbd\x9b,Y\xa2?\xad\x9b$\r\xb6\xf8hf\xf4\x16\xc2\tZ\xed\xb1ɏv\xa4\xdb%\xe8\x1b\xfa\xd8m\x96B\xa9n\xb1;\xcdh5\x19\x89q\xd3\x17\xb4H1?\x1e\x0e54_\x0f`Wr\x15\x8e\x86!cԳ\x979\xed\xf6\xf1l\x94\x8e\x01'\xad J\xb7\xee\xa6\xc1@,^\xd6\x17\x80\xe2\xe2\f\xe2^r\xfd\x86\xa7N\xf2l\xeak\x03n\xe5:\xc3E\xdb\xd1\x1a\x14\x890\xc0\x9c\xf6>\x10~\xe54\x9d;Fnk\x84]\x84 aH\xf1\xf3T~\t\xf6\xedl\xb6\x83Q\xcf//p\xa0\xe3T\x94\x19\x9f\xcc\xeb\xf0\xe9c\x89\x84\x9b\x1e\xbe\xb9X\xd5\xe0u\xb0\xa7\xff\x91\xfdU\xaa؟B\a\xaaa\"@\x94\xb7\xd7\v\xf6\x1a\xbd\xaa-\x152\xe6\xd72\x8b\xe7)\xcf\xf2-2\x859\xad\xad\xc0\xf1\xeab\x16\xc8\xe47R\xc5;q\x87[h\x9c\xc3{1\x16\xba\x82\xbe:\xc4\xda\n\xe0Z\xab\xa9I\xefi\x05}b>G\xdc\xcc\xf6\xc8n\xee\x15n\xb7\xc2\xcbL\xeaLv1p\xa7\x9c\x96Ù\xbe\x15Y&c\xf2\xb3\\6:>\x03t\xec\xe3J\r\x98\xe5\xbc,-!YN\x97\xa6\x96\x8f\xd8Ÿ\x04\xbc\x05\xb4\x02\v$\xb90\xf7(\xc5\xd7r}ݏ\xa4\x16\xa2\xfeR\x1b^O\x8dr{\xaf]Vb3\xc7n'BB\xeaF\xdc]\xfe>\xe0N\r\xb2\xf4\x0eL\f)v\xf8\x97\xe8\xbb\x00d|\xa5\xef\x82p\x91\xf0\xff\x18T\f\t\x16\xec\xe5\xf2ckI5Լ\xf7ú.BK\x94\xc0m\xa6\xbf\x9f\xbe\xfch\x86o\xc9ؓ[\xc9逦\x8b\x98^\xdf\xcfZŚ#\xaf\x01iQW\x18\xf1\xdag{vde\x87U\x83\xe6\x02\xdc\x14\xe5*\xe5?n\xf3@%\xf1;\xbf\x162C\x90\xbdz\xc2?\x85\x8c\xaca\xaf\x88Z \xddd\xf7\xa6)ħ\x1d\xc9\xdc-,\xbdj~\xd18\xf09L\xd15I\xf79\x1a\xfe\x95(\x04\xb5ٷ\xb3\x9fPoH\xd5\xd8\xe9N\xdc\\\xa8{Ǎ\xc7K\xe5ڸ\xce/JW\xb8\xb3\xfa\xc5\xe7\x82\xc9^\xb5c \xb7\xa3H\xc4\xdb\x0e\x97\xa5\x86\u05eb\xca@\xe7\xb6\x14J\xfe\xb3\xa8\x9f\x03\x9d=\xa7\xd1\r\x88\xac\xaa\xa2|\xe9LE\f!\x9c\xffg<\x1f\xbby\b\xdf\x04\x17\xd2kZ0\xab\x00Q\x89m\xe0E\xe0LD\x10|)\x9f\xd5q\x11+\x97'Nå\xf1\xab]\xcc\xf6\xa4\a\xdd?\x9cG\x11\xd6\xc3\xee\xcen\xb8\xea\xf8\xa0\xad\xde\x10-K(ݖ:\xeb\f\xd0\xd2Ę\xf9\x01ͅ(.S\xcdDh\x84ڪL\xebb\xb5-\xb0\xb9fG\x98\x16y\xb4_\xaaAW\n\xe5\xdc\xe5\x15\xb5~ond\xba7f\xc9\"ٞ>\uf72fx6\x1bs\xf9\\'A'\xe4\n\x11 MagrI+\xbdĆ\xf9J\xdes\x7f\x01\x0e#hM\x9c\x0e\x9b\x03ƤN;\x7f\xdf\xd8\xd0Ż\xcb+'\x89\xd5Kl\xfc}%\v\xaa\x7f\x19\x8d\xe7\x0f~\xf3E爝jg\xf7K\b]i#]$\x92?x\xdd\xe2/\xf0\xe1w\x1d\xbb\xb1q\xccN\x98\f\xee\xf9\xe1\xa2\x7fA-X0\x16E-\xb0\xcduV\xa8\x9b\xf2/\x11\xe4\xe3\xdb\x1bR&T\x02\xb1\x8e.\xaaSΎ\xeb\xb8\xe0\x89\x9c\xb14)\xd6R\x91$\xd2sJL濧S.\xfd\xb9\a\xa4\xd5E\xb0\xe1\x8d\xf7R\xfc\x96\xf7f\xa7A\x89\"\nؔ\x86\x17\x90\xbd\xb0\x0f%*\xc3\x1dE(+\x02\xd2pL-ͮ\x92A5\x1bjTa|jkon\xcf\x12\xfa\x14Q\xb6\xc1f\xd4F-\x92\xf6\xbc\x97\xff\xe8\a\xbbM:\xd7\xf7\xd8T\xc3\x02u\xce\xeb\x84ː\x1f\xd9:\xfd?#\x96\xddk\x9e\x9bd\xe9\xd4a\xf5D\x996\xa9\xc0>\xb7u\xbe^\xa1A\x14\xf1\xbcH\xdb\x04\xf1)\x1f\xb0\xb4S\xd4)\xa7\x961\x81\x86\x04\xbf\x05\xd3·\x92\xe0\xc0x\xec9\r)3\xcfԔ4I\xf6\x18\xf8\xfft\xd6\xf3νݙ6!\x82\xd1\xef\xf4\xe4\x99L_C\xebr\xf9C\xc7[\xf6u\x94\xd7Ƕ\x8d6y}\xe8d\xb3\x95\x1f\u0600\xc9ڗ'\x1e3\xe8[\xf7\x1dJ\x06 \xc2\xedT\x92\xd4b\xcc\b~1\vP\xe0\x9f\xe9\xc9\x04q\xc2n\x84H\x11\xcf\x1b\x91sx(b1\xeb\x19ڵ\xb2\x1dB\xb7\x87e\xfbL\x8f&\xb8c\x7fq\xe6\x91\xe3\xe9\xdf[\x97;T\x89\xfb\x93\xa1rXL\xdf\xdd)h\x83@\x81\xd0\xd6\xe2j\b\xbe\xea\xf8`\x87\xc0껮&\x8b>\xf0jF\x8am\v\"\xceS\x06t\xcd$\xbc\x93\xf0\xfe\xac\x85\xf7\a\xad\\fŸ\xc3\xdb\xc0\x9ak\x84\xf9\xafr\xa2z°\xe5Un3\fe\"\xf3-.\x8a^\x01Zwݯ\x96iŕb\xa1j̢\xc3O\x82\x02\x1f[\x88\x05\xd0;쾟\xceg\xc1P\xd5;,\x04\xdf'\xe1+L\x89\xdc\xee\xebT\xbb\xa9\x81#2A\xaf\xb9\xd8\\H\xf7'\x0f\xa6q\\\xad8\\-\xb0RUo\xb7q7\vD\xaf\xa9m\x02\xb4\x9d\xe3C\xb7#\xc09\xcfDW\xbc\xb4'C\xa7\x87q\xba\xae\xae\xe6\x14\xb9it\xe2\xec\x84`Z1\xe6\x81\xf8r\xc4Ӽp\x19?Q\x91A\x0eS%\xaa\xc7]4\x8b\x909ۭx\xa9\x17\x92\xd4\n\x92\xf1L\xce7\xe9\xd9\x10\xf3\xbeh\x8f\x87G\x9at\x16\xd3\xd5$tl\"\xbb\x05\v\xa7\x12\xc2.\u07bd\xe3Ʒb\x8a\x17\x15\xc8\xf6-,\x8cJB\xb9\x90\x88\xa1\xdd\x04\x1cz\xe9\xb1h\a\xbb\xadS>T.\xcf<\x14\xb8%\x83\xd8\x14\xbb\x82w\xaf\xfc\xb2ͬ;\xae\x00\x9d\xc0\xe6\x1d\x0f\xce\r\xea\x9c^ُ\xa8\x94\xc5\xec\xc0*\x8d\xb2\n!\xf2\xf9\x8f>#\xa3\x1d6\xeb\x97\a\n\xa4\xa1\f`1V\x99م\xaf\t\xb9\x9c\x1e{\x92\xa2\xd4\r\xd4\b-\x88n\xf9\x10*\xd3Y\xee\xfa\xb1\x19x\x94\x10\xc2O\x82G\xd7\xe5 \xa0\xe85Wq\x02g\x01\bSv\x87\xa4\xe0\x8e\v\xf5\xaf?\xf6\xf9H\x02Q\xf6\xd8=y\xd8sD\xea\n\xdc\xe03(\xc3h\xc6wb\x9a8\x06\x9b\x85ߺ\x97Z\bو\xb9\xb5PP\x01۱\t\xaa\xd3\x16\x9fDTT\x8b\x11\x1do\x02:\xa1\t\x0f\xb4\xc7@\xf0V\xfb\x91\x92\xf3(h\xc1%\x94\xec\xbfoz\x15\xe7\xbd\xe0F\xab\xc1\xed\xbf\xae\x8e\xa4\xd2{\\\x1a\x95\x97@>\x9c\rw\b\x95\xcb2S\xa4\x01\x13c\xe20\xebb_!\x80\x17\xb5\xf7\xb8K\xfb\x8b\x1f\xe6\xf2Z\xc0\x00Y\xb9\x04\f\xf3%$\v\xd7/2\xba\\WZ\xf6\xb1a\xa96\xf9\x9c~DR\xe1R\xcc\"D\xb4\x87\\V\x84v\x9e\xe7pvig/tn\xb0\x1c\xee\"8\xf6\x89.\xe5\x9f\xd1E\xa0%\x0fv\x00\x85\xa6\xe9\x04\xa4\xb9\x95a^\xf1k\x06V\xd8w\xc1vl\xdfj\xfdJ,jg\xbdE\x01\xa4\xbb\xa1\xbc\x02\xbb\xbfR\xebE\xa0J1b#=\xe6\x981\xacO8\x9b\r\xec\xea\x12F0ٶ\xa2>VCVw\xaf\xab\x85\xb7\xe2\xae\xf5;\x8b2\xec\xce\xd1e\xfb\xe6\xecB]fz\r\x15\x16\xad?\x91\x1dl\xa9\x9c9\xbb\xe4Y.\xa1\xf7\xaa\x05\xdf\xfa{\xe7\xaf{\x85\x12d\x83\xf6y\x8e\xbd\xc2\xf6\x90\xd0\xcb\xeeov\x88k\x03\"\xab\x8bo]Hm۲z\x8c\x1a\xa4\x80e\x05x\x00\x94\xafI\xa3;2d\xf0LA_\x90Gyo\xd2N-\xd5\xf6\x97\xf7\xf3\xc6\a}2T\xc5@\aL?s\x05\x1d\a(\x00\x02\xb6\xa7\n\xa0=\xec\xab\x04¶bu\xc2\xfd\x89>\xc9\xd4\xd9l`CN\xf0v\xd9\x18\xdaı)m|\x03l9\xe1\x02:\xee\bW\xfd*\xeb \xf1q\x01\x93\xcf\xc5j\x057-X\xdd6\x9fCMvOz'`\x05\x0fu\xb6--\x93y\xd9\x15\x86VE\xf5\x1e[\xa8\xf20Z\x9d\u0098\r\xc7;!\xa9x\x14A\xa9\xbcxjr\x9e\x88{c\xffT\xc7\xf6\xfa\xe1\xcf\xf0:\xdcK\xad\xc4N\xe6\xb9l}\xe28\xa8\xe4\x1d|k\xae\xd4\x05M\xfdU?@\"\x861\x8e\x88\xaf1\x136\xe8-<\xf8IF\xcch\xb6❹d\xbb\xae\x0e\x87\xe5\xc6\xef\xff\x03\x18l\xdc\xd1\xfe\b(\xbf\xe9\x93!\x87\x87\x0e\x90\xccᦎ\a\x9e\x95y\x97m<\xdc7\x02z\xa5\x8e\x9a\f\\\xfa\xe3?\xddT>l\x14\xe5}Ϭ\xb5\x90\n\xa0Mgr\rW\x12\xfd\xb7J\x1d1\x92R\xd16:18I\xach\x88\x16H\b\xc7\xe0\xb5Qٿ!D\x06{\x11mj\xe7׳!\xecԏ\xba{\x9e\xd0\xd9\x1do\xe3\xc7=\x16\xfd\x19\x9e\xad\vE\xa1\x9aAT|\xedF=\xcc\xd9\xda\xf7\xc6\xe1\xa6\xfb`}\xca\xe4Z\xe9\x0evf\xadR%\xff\xb6\xab\xab\xeb\x87Tt\x1b\xcfX\xcc\xf6\x95\xd4[\xefu\xbe\xda}\".]\xd4\xea\xd9\xd8ǈ\xe1l\\\xc2s\xe7\xd8'\xb2\xad\xa4\xb0SK\x04v\xe5d\xb6W\x94w@\xce\xf7\xe0\x86vd\xf7\x8egjg\xa5\xe074\xa8#\x04@\xdf?\\\x10\xc0-\xb0\x1e\x06h\x81\xacGF\xf6%{\x87\xceh\xfc\x8a\xb8\xf1\x8c\xdd>/\x7fB+o\xbb\x85\xd3\x1fl\xcb!\x11WpOK\xa1ߔ\xf1J\xfb\x1e>5\xb3>\x9b\xf9J\x06\xf7\xe6J\x9a\x14\x19<b\x8e?\xfa\xd2nsƾ\xfdn\xc6\b\x03T\xbad\xceط\xdf\xcd\xfeg\x00\xd8\x12|\x8bG\xfd\x01\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=ko$\xb7\x91\xdf\xe7W\x14t\ah7\x99\x99]'\xc0\xe1N\b\x12\xc8Z9\xa7\x8b\xbd\x16v\xe5\r\x0e\x8e\xef\xc2鮙a\xd4M\xb6I\xb6\xb4\xb2\x9d\xff~(\xbe\xfa1\xec\xc7h\xe5d}\xd8\x19}\xd0t\x93\xc5bU\xb1\xaaX,\x92\x8b\xd5j\xb5`\x15\x7f\x87Js)\u0380U\x1c\xdf\x1b\x14\xf4K\xafo\xff]\xaf\xb9|q\xf7\xd9\x06\r\xfblq\xcbE~\x06\x17\xb56\xb2|\x83Z\xd6*\xc3W\xb8\xe5\x82\x1b.ŢD\xc3rf\xd8\xd9\x02\x80\t!\r\xa3ǚ~\x02dR\x18%\x8b\x02\xd5j\x87b}[opS\xf3\"Ge[\b\xed߽\\\xffv\xfdr\x01\x90)\xb4\xd5ox\x89ڰ\xb2:\x03Q\x17\xc5\x02@\xb0\x12\xcf@g{\xcc\xeb\x02\xf5\xfa\x0e\vTr\xcd\xe5BW\x98Qk;%\xeb\xea\f\x9a\x17\xae\x92\xc7\xc4\xf5⭯o\x1f\x15\\\x9b?u\x1e\x7fɵ\xb1\xaf\xaa\xa2V\xach\xb5g\x9fj.vu\xc1T\xf3|\x01P)Ԩ\xee\xf0\x1bq+\xe4\xbd\xf8\x82c\x91\xeb3زB\xe3\x02@g\xb2\xc23x\xcdJ\xd4\x15\xcb0_\x00ܱ\x82綟\x0e7Y\xa18\xbf\xbez\xf7[B\xaf\xb4\x94\xa4\xc79\xeaL\xf1ʖ\x8b(\x02\xd7\xc0\xe0\x9d\xed$(\xcf\x0e0{f@\xa1\xc5E\x18*Q)\\\x05,s\x90\xca\xc3\x04\xa8Pq\x99\xf3\f>g\xd9m]\xb9\xaaz/\xeb\"\x87\r\x82\xaa\xc5ڗ\xad\x94\xacP\x19\x1eHHߖ\xd4\xc4g=LO\xa9+\xae\f\xe4$'\xa8\xc1\xec\x11\xee\xdc3\xcc-\xf5J\x06r\vf\xcfu\x83\xb7%I\v,P\x11&@n\xfe\x86\x99Y\xc3[\xa2\xb3\xd2\x01\xdbL\x8a;T\xd4\xefL\xee\x04\xff!B\xd6`\xa4m\xb2`\x06\xb5\xe9@\xe4\u00a0\x12\xac &Ը\x04&r(\xd9\x03(\xa46\xa0\x16-h\xb6\x88^\xc3WR!p\xb1\x95g\xb07\xa6\xd2g/^\xec\xb8\t\xe3$\x93eY\vn\x1e^Xi\xe7\x9b\xdaH\xa5_\xe4x\x87\xc5\v\xcdw+\xa6\xb2=7\x98\x99Z\xe1\vV\xf1\x95E\\Pg\xf5\xba\xcc\xff%pQ\x9f\xb605\x0f$6\xda(.v\xf1\xb1\x15\xe2A\xba\x93,;\xf1p\xd5\\\x17\x1b\xf2r\xb1\xb3Tys\xf9\xf6\xa6-:\\\xb7@\x82\xa7vSM7\x84'Bq\xb1E\xe5\x18\xb7U\xb2\xb4\x10Q\xe4\x95\xe4\xc2\xd8\x1fY\xc1Qt\x89\xae\xebM\xc9\rq\xfa\xfb\x1a\xb5!\xfe\xac\xe1\xc2j\v\x92\xb9\xbaʙ\xc1|\rW\x02.X\x89\xc5\x05\xd3\xf8\xb3\x93\x9d(\xacWD\xd2i·\x95\\\xf8P\xfd3O\xad\xf88(\xa3$\x87\xc2\x18~[a\xd6\x19\x1aT\x8boyf\a\x00l\xa5j\x86xK\xd3\x00\f\x8fK\xfan\xec\x80&Ms\x83eE\xb2\xdf}\xdf\xc3\xe6\xf3\x83\xe2Nx\xfe(\xc1\x84\aV9\x10S\xad&\xa5\xe1\xe8ju%\x86\xbeVsc\x0e\x9b\aۣ\xa8\xae\x98Bء@E\x1c\xb6\x12\xb3\x04]g{`\x1a\xfe\xfa\xe3\x8f\xebP\x90\xf0\xf8\xfb\xdfW?\xfe\xb8\x8e\xba\xff\xa0\x8d\x93\u07fc|\xf9o/?{\xf9\x9b\x13W\U000a2a35A\xe5\xaa\xfeu\rW[\xc0\xb22\x0fˀ\xa5m\x9dP\xcf\xe1w\tB\xba?z\xff\xfb\xd5\xefLh\xf6\xf7\xebE\xb7@R\"\xe8oS\xb0\xecV\xd6\xe6\xcf\\\xe4\xf2^\x8fS\xbb[\xd6bF\x84r\xeaؒ\x960\x80\xbc\xa6f\xe0~ϳ=Q\xb2\a\x13\x1aC\x90K\xd4\xe2ԀQ|\xb7C\x15\xfa\xbc\x8e\x9d\xb7̣v\xf2:\xc2e\x11\xe9\x03\xc0\xf7\x163\x8b\x98\xbe\xe5U\x85y\x9f\x10\xdc`y\xd0\xcb\xd1~:\x89r}Lw\x91\xc5\x0e\x1d\xc0\x85\xe1.^\x19@n\xf6\xa8H\xf9\xd7J/A\x1b\xa6\f\x81\xf5\x02K-\x1dJ)\xc0\x8eߡ )ep\xa1\xa4\x00|OF\x93\f\x935\x05\x05\xd3\x16\x8a\x1b\x83y\xad\xec\x90\\\x82T^\xb3r\xb1K\xa2\xea\xfb\xb8As\x8f(\xac\x0ef\xcaX\x98L\x00\x8a\xdcbԧ\xe8\xf0`\xf6\x04\xf0\b\xa4\xde\xf5\b\xff\xca\x17ux\xda\xc6£UŔF\xb6)\xd0K\xb1\xaf\xb9\xe9\vt\xf3\xd9\xcb{(\xa47\x18^2\x886\x1a\xee\xf7(\x80\x9bS\xedz\xe8\x86<)\xf7\xc0\xc7\xc3>\x8e\x0e\"k؈@3\xfax\xe9\f\\\xe0\xaf\xf3]\x02S\x02\x9a(r\x9d\xc6a+U\xc9\xcc\x19\x90\xb5Y\x11\x80d)r8\x89Vg`T\x8d\x8f\xe9LP53z\x14\x88F\xdd:\x94Hk#\x88_\x96\xe8\r+\x92p\xc11Ď\x8eS\rH\xd6\xdf*].:*\xf9T[Q\x84\x1f\xa4x\x1c\xafl3s\xfaF\xe5\xa6\xf9\xe5\xb1\xfe'r,i\xc9g\x80v\xf5\x98R\xec\xa1\xf3&\x93\"\xab\x95B\x91=\\˂g\x0fg\x8b\x112]\xf4K\aw\x00\xb5\x1d\x86\x1dsj\xc8̒\xa88%߃\v\x96§\xdaj\xfc\xfb=/0\x96\x04nh\xaar\xc7e\xad\x8b\x87\xa0Q1\x87=\xb3*\x96\x04M\xef\x0fu>\xc0+ܲ\xba\xb0N\x1b\x9c\x17\x85\xbc\xef\x17AQ\x97\xfd\x1e\xae\\у\xa7_H\xb5\xe1\xf9\xc1\xe37X\x15,\xc3\xc5L\xa6\xfd\x8d\x1b\x83j\x94\xaa\xffe\x8b\x1c\xa9\f\x93\x06\u05cbit\x85Z\xe3(XZk3+\x85,\ay\x87j\r\x97,\xdb\xd3T\x8a\xdaϱ`\x0f\xd8\xef3\x90ڤ\xb9\xcdv\xab\xd1\xc0=7{?N[\xed\x11'Q\xf1;\xef9\xf5\x9a?\x80H\x9e\xcc\x12\xb4\x8ce\xb4\x85k\xabiV\"d}\xfd\"\x89\xf5\xac(\x82<\x1c\x80\x8c=4 E\x86\xa4[Z\x93E\xbd\x97\x8a\xa8l\xf6\xcc\xe1ngWw\xac\x88vp̃9\xd5D\"\xbd\x9e\xcb\xf5[\xc4\xeaK\xa6\xcd(\xdf\xff\xe4\v\x05\xbd#\xear\x83\xca\xfa\x1e]ޕR۩#\n3\xe8\xd4Z\x9eg\xb2\xac\n$E\xaa\xeb,C\xad\xb7uA#HZ\x84\xd6\xf0\x85\x1f9\x01\x8a\xd7r\nAR\xa0#\x05\xd4\xd2E\xa3\xf5\xb5r\xb4\xc0\x97\xa0p\xc7T^\xa0\xd6\x1e[\xae\xe0\xe6\xe6K\xeb\xd6\xfe\x80J.\a\xd1$0R\x14\x0f\x01V4\x17\x0fdL\xb8:P\xf3%\x17\xbc\xac\xcb3x\xd9{\xe1F\x1cq\xb1/\f\x15\xab5棤\xbf\xb6EZ\xda\xeb~\x8f\xd6Gk\x8b-\xf1\xc5\xc1Z\xfb\n\x83\xf2\xa1\xbd|z\xd9\xf4\xf3\x9b\x01y\xd9HY \x13\x9dwմ\xf2\xf5\x1a7\b\v\r\x12\x8a9xR\xfb\xb7\xf7{\xa9\xb1=)\x1a\x95\xe9 \x06\\\xecQq\x03\x1a\r\xb9\x94n\xbaLsi\xff\xf3\xc0,\x1f\x00\x95\xf7\xa2i\x95\x14\x8b\u2e5f5X\xc4N珝!\x97\xa4C\x8c\xa3\x9c\x11I\x837I\vG\x80٨\x85\x1e\x8e\xa2֞\xa2\x12\x01\xf2\x18\x80\fC;\x84\xb3\xa4\x8fb\x81LcW)y\xc7s\x1f+J\xcc;\xc6\x1c\xf2ܙ\xc2w\xb2\xa8K\xd47\xf2\v\xedZ=,\xd9C\xff\xd5@\xc5\xc4`\xa9d\x0ew\xb6\\\x02(\xc0\x96\x8c\xba~\xd0\x06K? \x96\x8d\x92w\x0fN5\xd4U!Y\x8ej\xd9R\xd6ɱF\x7f\x14,c\xb7\xe4*\xb8\xfaDQ\xb2\t\r&\x9a\x8c\x95\xef\xfc\x1a\xae\xed|\xb52\xe1e\x12\xa8\xacM\x1f\xaf&f\xfb\xc25\xb4\xf2\x00V\xf8>+\xea\x1cu+\x80\xbc^<\xc2\xcf\x1bV\x05)\xee\xbdAmx'Z3\x8bw\xaeZ\x82sʿ\xb0\x14O@\x85\xc0\x85\xa3)\xfe\x8abq\x199\xf3\xcb$\xdcZ㰈q\xa1\r\xb2|\x19b\n\xec\x165\xb9\x82\x19\xe6(2\xf2\x13\xf1\x90V\xf4\xddH\xb3\xb7&J\xa3Y\x1fMm\xac\xf6X\xa2b\x85\xc3(\xed\b\x1f\x10\xfb2U\v\xb2\xbd\x94\xbaEi\x92u\nǑ\xa4V2ק\t\xb0-\f\x02M\x03\t\x9cY\x91\xb5)\xf8\x1dzKK`\x96\xa4]H41\x87\x84oM\x7fV\xa4\x1d\xa3\xd7p\xd9o\xc0[\n\x8a)\x92\xbf\x1d\xc2\x17\x0e}\x9a\xcc$a\x12\x89c\xabP\xf0[\x04\xd9S\x05\xfaQ\xc3a<\xb6\x00\x90i\x9e~\xd1c\xca\xc5۫\xa0wY\x16C\x94\\\x14\\\xa0}٥\xef\x00H\xe7\xa7X\xf5\xeb\xd7\x01\\\x90\x85Ԍ\x8b\x1ar\x059y\xbbʆM\xa23\xc3\xcd0H\x9e\x94̡YI\xf8\xac\xe0JX\x8d3\xf8\xfe\xf2\xfd\xf8{_\xffj{\xee4րj\x1d1{\xe1k#\x96\xaf\xf8\xc1l&ɉK_8\xc1\x8e\x00gLb~It\xa9\x94\xa4\xf9\xf8\xa1ϙ$\xccu(\x9d\xa0L\x84\x14\xe5t\x00\"\xc4\xc84A\xf0\x85I\xc7\xd0\x02\x1f\xcf\bh&kZ搷(\xf4\x1an\xac\xcc\xd2\xfa\x03\n\x93\xb6\x83\xf4\xcdd\x89\xd6\xfb\xf3\xe3:.\xf8\xf8\x01\xd3\xd3\x00f\x8f\xa5\xc6\xe2\xee\x97\xceÑ\xb0\n\x807\xf9\xf9\xf9\xf5\xd5\x1fi\xe16\xa9\xa2\xba\xb2߯\xe1#\xb2\x05qFn\xe1\xfc\xfaʭ\x01\xfb\xc5\n\x9a\x86%`:5D+O܍\xe1\x18 \xf3^\n\\\xd2r\x12\xba\xd5.\xe2-\xe3\x02v\x85\xdc\xc0=/\xf2\x8c\xa9txq 8>\x83N3ݚ\xc38S\x9b\x8eq\x81y>!\x9b*\xa1\x9bDOZ\x15'\x99\x17\xcd\xdb\xc7R\xf2\xe3\xa3R\xc8_\x98O\xa4X\xa3'mq\xfd\xf4Ä\xed\xe3!\xd1^\xca\xdbi\xb2\xfc'\x95jֆ!\xb3i!\xb0\xc1=\xbb\xe3R\xf9\xe0G3\xe9\xc0\xf7\x98\xd5C\x1a\x84\x19\xc8\xf9v\x8b\x8ab0՞\x91\x8b'\xb7\x13\xe4\x99rj\xa2rM\xbf\xee\xf5\xa7a/i\x05K\x83\xa1.\f;\xcaa\xf1\x95\x8b\x1dyp\\\xe4\xfc\x8e\xe75+\xac\xef\xcd\x04\x81'\x0f?\xe2\x96\xea\xd7\x04\xeb\x0f0w\x13Ȁ?\U00065cec,\x05ҢUI\xa9\v\x87E\x87m\x15\fv\x7f\xc3(\xba\xe3fՠ\\|\xc66\x96[+\xdb\xe8\x8b\xf4\x1c\xa5ǝ\xa5_n\xdb`\x01\x1a\v̌TCd\x99f\xfa1\xbap\x80\x9e\t\xad\xd8L\xf1\xe2\x128\xa5\xf0\x8c\x11\xcfϧ\xfdT\x8b\x92$H\xa6\xecdѮfZ]\xc0\xaa\xaax\x18\xee\xec\fI\x98\xa5\x0e\x8eP\f\xf3T\xc4!\xa5\x83L=\x86бnk*Mt\x8e\"\xf2\x89\xcc\\\xf4e\xf2\b:_\x1dT~j\x81&\x02s\xd4\xed\xc4\vn\xc2\xd3i\x98\x14cjp\xf8\x7f\xc1\xa8ǌ\x87\xab~\xdd'\x1e\x0fO\xc0\xa5\x88\xc2/\x9aI\xd6ؼ\xf5\xb6\xe6\b\x06}ٮ\xb7\x04\xbe\x8d\fʗ\x14\x8f5\x94\x1a\x97\n5w?\x91\x88\x93\x9cz*\xb2̳\x9a\xf4-\x99\xc9\xf6\x971\xd6?Y\xbeG\xa1~u\xe0\xed\x99D\xd7\xc8OB&J}_s\x85%M\xaa\xed$\xbb\xf3ĺ\xd4\xe7\xaf_\xa5֪\x1f%\x91\a\xdd9\xef\xa1\xdcn\xdeO\x03\xe6w&\xae\"\xfa\x19\x16\xa5eP(\x92\xc1->,C\x82\x101\x8aQS\x83\x13\x89\xfeW!\xad\x87X\xc1#H\x16\x90OX\x9dQ\x7f\xbeh\x84\xb5\xd7d\xecv\x92\x94\x84\x99\x8f\xc88\x9a҃\xb8\x94~\x84L\xf8\x19\x83\x1b!\x94?:\xb3\xcelu\x13\xbe\x81\x13\x8f\xeandc\x9c!\x91\xb4\xdc\xe2\x03\xadu\x13\xc3ht\xecy\xb5\x98\x04뿤\x80i\x05\x91\xc6QHG~G\xe9\xe3\x11O7s\xb9\x12\xcb\xd90_Ks%\x96p\xf9\x9eS>\x17\xc9\xcd+\x89\xfa\xb54\xf6\xc9\xcfFX\x87\xfe\xa3\xc8\xea\xaaڡ'\x9c\x9a'z\xf8\xf4\x8d\xf9B\xef\xbeW[+{\x91U\\SޱT\x81.\xf4\xd2\xc1\x9c\rҡT\xd6\xdaЄQH\xb1\xb2\x86v\x9dhk6L\xcf\x1e\xa9:\xdci\xa3\xe7)A\xcdΆJ\x13:\x87\xda\r\xf9r\x0e\x82\xcb\xc1\xa7\x04\x9c<\xe6\x89Ά\xa8\r\xa5\xf6\xeex\x06%\xaa\x1dBE\xb6`.7f\xeb\xe7G\xca\xdc\\\xd7 |\xbc\xa2\x1f\x8c9\xb7\xbf+R\xbb\xb3\xca\x05\xf6\xcf(<\x1a3}|߬\x81\xb6~\xcc\fj\xb3<\xb7[{Xq}\x94\x958\x8a;\x9d\xf1\xddB\xcf\x0er(\x99]\x13\xfd\x91L\xa4\x15\xf6\xbfCŸ\x9a5\xca\xcfC~a\xbb\xb6\x8f\xba\xb5\x1b\xa26\xb8\x06\xe2\xf8\x1d+\xfa[\x16\xd2\x1fR\xc7\x02\xb0\xb0\xbe\ta\xd8\xf7|\x96a\t\x10\x1f`K[\x81f\x00\xe5\x1aNn\xf1\xe1dy\xa0\x97N\xaeĉs\x11\xfa\xa3~\x06\xd8\xe8q\xd8Ԡ\x13[\xfb\xe4\xc3ܩ\xd9\xd29\xb3 \xcd\xfe\xce\x16\xb3ńf\xb2\xfdT\x9d\xe8B\xaf\x17O \x9b\x95<L/\x1bA\xe8Zjc\xc3i]\x87\xf7\xb8x\x9b\x97+\x1fg\x03\xb6\xa5\x8c:m\xa4\n\xfbuHI\xf6\xc2\xc6\xc4E=5\xe1`\xaa\x15\xbds`i\xca}Ҍo\x17\xff8qkS\xf4\xff\x14D\xbb\xfa\xab\xc3B.%\xc3M\x89\xcd,\r\xdf!\xea!\xf5bP\x93YN\xdbp#\x9b\x00\xd9̷\u058b\xa7s\x85\x89\x9cӥz\x1d\xba|ߊ\xcb\xd2f\x00\xfa=-\xb2\xc7c\xe7\xd7\x1aK6\x94L?\x81腫\x1b\x86\x98\ae\xf5\x0fS\xbb\xba\x1c]\xe4\x1c\x16\xe9\x8f\xc7\x19(\xb9\xb8\xb2\xf2\b\x9f\xfd,\xee\x03\x84i\xdear\xf2L\x06\xf8\xda\r\v\xe2\x83t6\xdbЇ\x92*\xee\xf7\xa8\xb0\xc3\xc9è\xfe\\\xdeX\xb7\x99\x82\xaa\xad\xd0\a!X\xc9\xfcTÖ+\x1d\xa7\xb8\x89\x94ס/\xd7POj\x90\x0f\xe0\xb8\x14\x97J=r*\xf7\xb5\xab\x1b;L\x81\xcf\xfb\xb8+o8\xc7+\xf5\xb1\xcbcH\x91#n\x00\x85M\"\xa0\xa0\x11)\x03ۈc\xc7|A\x86\xb9vo^\xce@\xff\xb3\xb2\x92\xc8\xc5D|\xa9\xf9\xae\xe0\vƋ\x9f\x8b\x8d\x94\xbf/ks6\xabp\x8f\x8d\xb4\x9b\x90r\x11\x83\xfe%\xa1-\xd9{J\x7f\x06V\x12#fB\x85\xb8\x7f\xad#\x03pϸ\xb1\x16\x89 \x93V\a#g\x83\f\xb9\xe5\xb0\xc1-\xad\xd4eRh\x9ec4\xfd^.z\xbb\xa2Ǿ\f\xb6\x8c\x17\xf5a\xce\xf7\x13q\xe3\xb8\x19\x92W<3\xca\xcev-磰\xb2\x06h\xf1D\xedγ\x04\x95:ơ\xbdV\xf8\xd4\xeec\xa58ɢ\x9c\xf2 ' \xde\xc4\xfd\t\xc1R\x04\x11e\xe2aȅ\x9c\x80I\xf6\xfd\x93\v\xf9Ʌ\xfc\xe4B~r!?\xb9\x90\x9f\\\xc8O.\xe4'\x17\xf2\x93\vy\xe0B\x0e\xed\x87\x1b\x93а;\x0e\x05eLP,\x92\x8e\xd92+.lܜ\xe4\xaeR\b\xd3t\xa4\x00h;\r\xf2\xfb\x9a\xa3\xa6\xccw\xb0\xa7[\xb9\x14\x05\x7fN\x8d\xdb`>mR\xc8\x7f\xeb\xec\xacqA\xe8\xd0\xcfS\xbb\x1b\xc96J\xa5\x82Y\x99\x00\xea\xe2\x99\xc1\x81vAr:\x85\"v \x8c\x87\x18\xa3\xfd'\xa4UDsv\xb68J\xe3L\x1aq2\x9a\x93 \xa1e\xbe\x89\xba\xfa4\f&\x9d2\xe3p\xb5\x9d\x01r\xae\x01\x9fo\x98\x8f\xd0\x1e\xd3\xeb\x053\xd7\f\x82\x9a\xf5\"\xb8^<\x8d\xe9[\xc1Vo\x15\xe2\x0fSC\x82\x8a\x96\x0f\xfa\xfbi{\xb7\xb2\x12\xbdS8\xa7\xf0\x11\xa4\x9c\xed\xd7\x1c\xeb\xd1xOe\x12.\xcc\xf1e\x1a\xd9}:\x16\xcd\xf6Kfz$G\x10\xbdbf\x7f$ů\x99\xd9\a\xf9-\x89P\xb4\xc0\xbe\x0fR\xdc\xda\r\xbc\x98\x99\x88d\xaby)\x8d\x03\x00\xdco\xbd~\xca\xde\xce\xf6\xb9:\x1d\x9e\xf6\xb6@\xceQTc~\x16\xb2,\x92\xd0\x1b;\xb9xBW\xeb\x18\x17j6A\xe7\xf9,+\xab\xe5\x16\x1f\xec\xafL\xb76\xd1ҌVf\xd9\xdcq\xa7i\xb4\x15\x9f\x95돉\vᠤ\xd5Ne\xe4\xf6\xeb%\xb6|g\xae\xc8ʞ\xf2\x99/\xc6bHm\x9b\x1b҅m\xdc8H\x91?\xbdk*J\xf7\x81\x9b\xe0\xb9\xe8\xed\xa2\x9bK\x8d\xf9\xfb\xee\xd2C\xc97|\xfcf;\xbb)3\t\x92i8\xf9՚k\xc3\xe9P\x81V\xa6DF\xa3\xb3\xc1\xcb\xe67mQ)\xb7\xf5\x9e\xaaQ\x89\x93\xf4\xe0lҤi\xb5<Bq\xabށ|\xeb\xc5Q\xc1\xa7\x89A>\x93\xa7\xe9A\xc0\x0f\xf2\xfc\xcf\x16\xc7o\r\xe8\xf24\xa6\xe5\xcf\xe3\xa9\x1b\x7fᄓ.\x01\x9b\f\xff\x8f\x9d\x80G+\x88V\xca~\x97|a\xccG\xea\x856\x12\x80\xa1?\"\xba\xe4k\xd4\xc7GJ\xbdɬ\xfa\xe1\\z\xa7H\xe8lջ\xcf\xd6\xdd7F\xfa\xcc\xfa\xe1\xed\xff\xb4\x1d\x0fh!B\xec\xda[\xee\x82,\x1a\x99\xa4*m\x8a\x13\xbcHg˲\xa2\xa9\xdf!7|m\xf1g\xc5\xfa1䛚0\xf6\x93\xc8ҥz\x94\xecW\x1a˹\x0f\xd6\xdcfp\xac\x17#\x8b>G\xa6\x86\x8d\xc8\xdc\ad\xd5O%\xc1\x1f\x93K\xdfΓ\x1f\x0197\x83~\xde\xdc\x7f2[\xfe\x119\xf2!\xf7}\x14.Lf\xc6O\xa8\x82\xf0\r4<\xa2\x1bO\x94\xfb~D\xc6{7\x93}\x02\xeeqy\xee3\xc94'\xa7\xbdC\xa49\x99\xec>k|1o\x9f\xc2H\xfe\xfa`^\xfa\xe2\xe8\f\xf9\xe9l\xf4\t\x98]T\x9e$\a\xfd\x11\x99\xe7\x13\xfa\xea(ޏ\x9b\xc5\xf0\x993\x8f\x1a\xcb#\x9f\x91=>c\xa65\x85i+/z\b\xd1\xe3\xb2\xc2gа3.\xe6g\x80\xc7\xfc\xee\xc1\xb6\x8f\xcd\xfb\xeefu\x0f\x82\x9d\x93\xed=\x90\xcb=\bs4\xc7{n\x06\xf7 \xf4I\xf3=!9\xa3\xaf\xa5\xcaQ\xb5\\\xe0\xb3Ň\xc8̄\xbctd\xe5\xeb^˭yy\xe3\xf19\xfc\xda\xcex\x9aN2\xee\xe6̀.Pp䥽\x01-\xb3L/\xac/\xdf\xf8\b\xc4鴂\n.Xo\x12\xa0\xb1b\n\xfdy\xd96\x0e\xaf\xc39\xb1\xed\x82I\x90{\xa6\xfdQ\xc8p\x12\xe7S/B=zr\xb2\x06\xf8BƀD\x84I'\xa3\xf3\xb2*\xd2Þ\u038d;\xe9\x82y\x8c\x7f;*'\n\xe3\x8aї2k_\x0e3\xc2\xe27\x89J-\a\xd7\x0f\f\x8a\xbb\x85\x8b\t\x12\x10\xc3I\x94o\x8dTl\x87\x11\xd0\xd2\x1f\xc3\x14\x0eb\xf5\x12cO4\xb7%\xa1\xf0E\x97\x8b\xd10\xaa\x974\xae!\x93\x15w\xc1\x05:%\xd7\x1d\x8f\x1e\xa2\x85\xc9\xd17b\x88&\x86\xc2Ln\xa4u}\xe0\xf5\xcc\xd3\xf8\xc2\x10\xf3\xc7\xf0Y\x06(\xb4\a\xb6dH\xbde\xb4ʿ廯X5\x96^\xe2ðQt\x83f#\x06\xbac\xb6\xdcY\xadܟ\xa4c#\x05z\xcf(`\xb3I\x8bn8\f\x96\x86\xebé\xf2\a+\xbaU\xc1\x0eOiղu\x98\xe0\xc0\xfe\xea\x0f\x9eñ\x8a\xdb\xe8X\xfam\x8f\xae!\x94\x16\xf4\x8b\r0\xc5\f\x80\xc0$\xd8 \x11(\x12|P\x8dۘU\x1bfb\x91.\xfe\xb4Z.:b|8-\xe00\x90\xb6\xb6*\x86\x12\x00\xc3\x00\xe2*\xa7\xcb\x05̃\x95:\xbd\x8cX\fB\xb5~\x9e\xb5]\x83ݙ\x18\x00\x87\xd7\xe0\f\xd29܈C]!\xa8\x1d\xb5ܧ\xeec\xb1\x19[\x94\x9c\\\x8a|bl\x02iS\xf8\xac,\xdd\x16G\x04\xf2G\xf5\xba\xbe\xe5\xd57\"\xdb3\xb1\xc3ܟ:z\xb6\x98 \xc1\xdbD\xa5DXݯ*\x87#\xf8\x12P\xa1u^^\xebLN\x1b9\x00J\xb6w\xe7nZ\xe4\xc8_$u\xb5\xc7x\x02\xfe Dr\x1c\xb6́\xe9\xe1\x8c\xe0\x10\xbbWH&\xd36\xd2\x18\r\xc1*\xbd\x97\x87\x14\xa2\xaf?|\x95\x80:yk\xd0f;\xc6\xc5\x1a\xce}/O\xb5Ǘ\xbc?:\xe0\x98\xae.\x1a\x90\x83x\x18<ɞ5\xf1?Б\x02\xa5tg\xe8\xe6Pʼ\xb9O\x88V\xc2\xc8D\xda\xfc\bZ1\x1c\x88o\\\xd1\x19\xef\xc5Ck\x93\xbd'\x89n\xdf\xee\xc3\xe2\xc1ɏң\xe3\xab\x13\x81\x9677_N\xcbRS\xf6)\xee<\xe9\xdcx\xf2y`\xae\xb7N\x01\xaf\xf6\"\x8eB2an\x11'\xed(\xf0-\xd8\xe3ܣ\xa3\x11\xc1\xdasݿv\xae\x02`\xc1*M\xfckN\x9d\x8c\x84H\xcb~\xeb\xdcx\x7f̓\xb7\x1b&Ho8\xfbWG4?\x80[\x03\xea&\xe0x\xc3v\xff@\xef?\xb2\x9d\xed\xbaSEC\x0f\xc8'\xc9\xf3\x10\xfc\r\xf4N6z\xc0Z7W\xe4*\x1c\x11\xae(\x02Ow\xe0\xc4s\xac#\xffl\x9cn`\x18\xd1\xfc\xc1\xe1Bj»>,\xcf-r<\xa7\x1bȶ\x0fn\t:\xb4\x1dO\x8dO\xcbQ\x18pK\xba\xbcOsm(b\xeaѧў\x15\x8c\x97\xf6`\xe7\xf6\xb9\xcet`<a]\xae\x8fV\xed\x1e\xadw\xa8\xa2\x169\x9b˗v\xa5\x01\xd5~\x1c[H\xd8\xef,\xd0f\x13B\x03\x05\xf8\x84\xa7ݽ\xc0\xe4\xf5\xc0\xa57C\xc9#+[#\xf9\xe2\r\xb2<囮\xe0\x06\xb5\xa1S¥z\xf4\x98\x9amP\xbb\xe5S\x04\xf7g\x8dg\x85\xac\xf3\x86\xac\t\xc0@ʃ\xbc\xbb\xebw\xa7~\xc5Ժ\"!\x88\u20f2a\x81$,\x8e\x84\xd7\xe9c\xff\x9f\xc2(t\xe7o\xd34\xe9\x96\xf7k\vV\xb9\xb4'\x1e-7,\x01\x11\x80\xa5\xa7\x8f\xad\xa4:\xef04&\xc1\xb2<_\x1f\xcbtc\x8a\xc9N\xfd|Vn\xc0\xa4\x1d\u074bZ\xd8\xfc$\xcc{'\xe5Ov훁\x8aO~\xc4\xfe\xa1\xf6\xb4\x9a\xd3kj!\a\xb3*-~zI\xee\x8f\xfd\x97\b\xdd\xca!\xb2{\xab\x18\xd1Q\x99\x15\x9d)\x9f\x13\xa40\x9fKB\f7\xcb\x05\xb7\xcc/S>\xfd\xe0qf\x82\xb2\xc8\xfc\xb9\x8b\xd3:\xe5\xddA\x15\xeb\x91V\x8cnT\x12\xf1\x88V\xcaCs\x17\f\rL\"{^\xfc\x98\xc7\x1eNɍE\x06\x1c*\x11\x9d\x8a`⥈1\x03\x9f\x88\xda\xdc!\x11\xef\r\x18\xde\xed\xe5p\xfb\x88\"4\r\xbf\xae\xc4\xd1\xfc\nU,\xbfl\x16M`\xda\xd2/\xc1ݡ'\\\x02(\x80\x92Ҫx+\xdb\x0e\x93e\x8a\xdd\x03\xacM\xc2\x1cbwdu;\xe3\xe1~/\x8b\xe0\x03[˟\x04٪z\x9e`:\v\x8c\a\xe6A\xf90P \xc6\xd8L\xed\xe3\x13\x05\x1f\xb5\x9a+\x06\xbex A\xb8A.\xd0\xd4\x0e\x15\xbb\xdaBLLo\xd9챇\x1cZ\x97\xddm\x83t~m\xda\xc2 Q\xf3\xa0\xc7\x13\xbe۳\\ҹ\xf6FMw\xf7\x83\x83FjU\x037\xcb.\xff\\\x8bI\x90$\x8a\xb4\xfd\xa9a}8N\xff\xe0\x8a\x93\xe0\n\xebcG\xfa\x10\x81\x9b+I\x02}\x0fl\xcbș\xe0~\xbd\rx\x7f$,\x1e\x97r\xe0\xf6\xd4\x0e\xbd\xed\xf5\xe2<\vNѸh\x8c\x91>%&\x8b\xc7\xe5e\xaf\xa2\v;R\x84\x9ci>\xbc\rge\xe3J\x83\xaf'\xc6(\xfd\xb9kG\xf4L\x12\xber\xa5\xbb\x197t\x0f\x8a\xbf\xbd\xc4g}\xf9e\x82A\x98ႲV\xc0%}H+\xa9\xec\xc0\xa4\xd6\xfd)#\x80\xed݂\x0f\x1e\x1f;\xd6Ⱥ\xb6\xea\x92+s\xf1\xf6j\x98k#\x83b6Ug\xe8\xbfi-\x18\x06\xcc\xfb\vV\xb1\x8c\x9b\x91\xcc\x1a&\x1e\xbe\xde\x0e\xbf^\x8d\\o\x97*7ѷ\x8eH|\xd5\xe0\x17B\xbc\x05S;\xa4Ū\xf0\\n\xdb\xc3m1#O\xffP>>\x94\xd2\xde\x04\x9e\xc1\xff<\xfb˯\x7fZ=\xffóg߾\\\xfd\xc7w\xbf~\xf6\x97\xb5\xfd\xe7W\xcf\xff\xf0\xfc\xa7\xf0\xe3\xd7ϟ?{\xf6\ud7fe\xfa\xe3\xcd\xf5\xe5w\xfc\xf9Oߊ\xba\xbcu\xbf~z\xf6-^~7\x13\xc8\xf3\xe7\x7f\xf8\xd7A\x94ޯn\xeb\r*\x81\x06\xf5\x8a\v\xb3\x92j\xe5H?ڗ\x92\x8b\x8f[\"\xb8\xe8K\x84.YQ|\x12\x89\x9fM$|\xa0\xe0\xa2`Z\x0f[\xcbt\xb4\xc0W\xea\xeat\x0f\x90\\\x16\xad\xed*\xc9#y4\xa5\xd6G\xa0\xfa\x98L\a\x95_\x8c\xdav\x82}\xf3P\xcdfǻ\xa6F\x97\x17\x87\x93\xf7\xb1\xb4\x8e)\x8e,-7sw\xdb\x1a\rA{X\xa3\xbf\xa6\xa3ij\x04v\xf4g)J\xb1\x04\\\xef\xd6 \xb6zI\xb7\xaa\x91\xc1e\xf7\xfa\x92nL\xe7\xd9\xe7\x85\xccn)\x8aD\xd7\xe7\x8em]\x1a5\xfc^\x0e\xc84\xfdB\xd8?\xb6\x18IVֹ\xadɗ\xa3\xe1\xe9\x19\b\x8e\xa1\xe6\x18\x17\xbc\xce\x10\xd6KR-!\x99\a\xf5ZR\xda\n.\x0e\xeb\n\xb9\x1d\x84Ĵ\x96\x19g\xe1\xd2;w\xc6\xd7pdh\x84\xd9\x13l\x1e&\xcf \xe1\r/\x91n\x8c?[\x8c\x90\xe8\xc6\x17\n\x06\xef\xea\xfc\xf5y\\ꎷ\xc0S\x89e\xbcj\xed\xe4\xbcD\xc53\xf6\xe25\xde\xff\xef\x7fKu{\xb2\\\f\x8e\xe3\xf6\r\xb5\xed\v\xeeם \xff77\x17\xeb\xc5L\x82\xd4\x1a\xbf\xbe\x17\xa8ބp\xb7\xbe\x12\xe9K];=\xfdf\xb0Z\"j\xf9yw\x15\xb5\a\x17\xfc\xed\x87\xf1&`\xbb~M3[I\x885\x81x\x9a\x06\xd0\x04YS\xe4\x8b\xeeN\xa2\v\x12}$\xfb\x00f\x04\xe6\xd6\tij\x1d\xaf&^\xfaؽ\xafK\xd7.\xd2e\x9a\\x\xc6\a\xbbw\xc8\b\xa6\xe1\x1e\x8b\x83]\x10\xa3\xe3q(>\x99R\x0f\xab\xb8\xd6\xd5y\x18\xb6\xa9.&\x04U\x1bf\xeaΐ\xe80-\xd0\xe4\xad-F\x8e\xb9\xa9\x95\xcf\x1at\x17\xf6\x1b\v\xc2\xdf\x01\xed\x97\xee\x12\x18\rM\xc9i+\x9fݹ|\x87\xb4u\xb8V\xfd\x02=\x84.\x0e\xcbϺ\xb7\xbc\a\x13\xc2=\xe6\xe1\x12\xff\xc0h''t\x9a\x87[\xa6a\xa0\xe4\xfd\x1a\xfel\x97\x8cm\x92\x1a\x9dUn/\x17?\x00\xd9kV\xd5B\xb7g\xfcr\xbb\xa5ˡ\xa5\xa0\xf5LV\x1c^\xb43\xecY\x93U\x9c1ľ\x8c\xc5\x02M\xa8\xa2]\xff\x88k3p\xcf\xec%\xf2>\xd6\xceuDy1\xb4\x88\xda{\xe1\xd2*\xcfh\x04\xe0\x8a`\x1f/\xda\t\xadB\x98RL\xa2\xc2|\xb2\x8f\xbe\xdcD'\xf3\x1ac'\x87\a\xfb\xa66T\x9a\x92_\x88*\x1b\xcc\xe8\x8a\xf5\xaev!\x92\xb9\x1b\xd8)\xc6@\xab\xa7\xc2\t\x7f\"\xd8\xe3\xfd\xa6\xadT\x1b\x96S\xb2\x82\x8d%pۈ\x13\xa8M\xc1\xb2[گ}\xcfE.\xef\xff!ԵWō\xd2\xf5\x9aJ\x00\xef\x0em[\xad?\xa2\x16\xd3\xc1\xaa\x15\xbc\xc6~\xc7Vpi\x8fZ\xe9\xaf\x17\xb93\x030\xb7;VX¿\x19\xec\xd4]\xaca\x0ff\x18W\x1c\rxW\xb8\xb7\xff\x90\xf6\xb15\xf0ܡ\n\x1a\x9e\xf1C\xe7ӟ\xe7\xb2)\xf0\xf9b\x96w1\x88\xff\x90W\x91PԽG\x14K\xb3\\\xbb\xfb\xac\xf9e\xfbﶘ\xfb\x17`\xafaż%+\xdeN\xf9'\x8d\xf6gY\x86\x95\xf1\xfb[\xcf\x161g\x10NN쏪\xa8\x15+\xfc\xcfL\n\x97\xa5\xae\xcf\xe0\xdb\xef\x16\xe0Wq\xdf\x05<\xe0\xdb\xef\x16\xff7\x00k<̦2\x93\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4X͎\xdb6\x10\xbe\xeb)\x06\xe9a/\xb16A.\x85n\xc56\x01\x16M\x83\xc5:\xcd%ȁ\x16\xc7\x16\xbb\x14\xa9r\x86\xdel\x8b\xbe{1\xa4d˶\xb4\xf6\xb6\xa8\xe4\x8b\xc8\xe1\xfc|\xf3͐t\xb1X,\nՙ/\x18\xc8xW\x81\xea\f~gt\xf2E\xe5ÏT\x1a\x7f\xbd}\xbbBVo\x8b\a\xe3t\x057\x91ط\xf7H>\x86\x1a\x7fƵq\x86\x8dwE\x8b\xac\xb4bU\x15\x00\xca9\xcfJ\x86I>\x01j\xef8xk1,6\xe8ʇ\xb8\xc2U4VcH\x16\x06\xfb\xdb7\xe5\xbb\xf2M\x01P\aL\xcb?\x9b\x16\x89U\xdbUࢵ\x05\x80S-V@\x18\xb6\x18\x88\x15G\n\xf8GDb*\xb7h1\xf8\xd2\xf8\x82:\xac\xc5\xf0&\xf8\xd8U\xb0\x9f\xc8\xeb{\xa7r@ˤj\x99T\xddgUi\xd6\x1a\xe2_\xe6$>\x9a^\xaa\xb31(;\xedP\x12\xa0\xc6\a\xfe\xb47\xba\x00\xa2\x90g\x8c\xdbD\xab\xc2\xe4\xe2\x02\xa0\v\x98&~s\x0f\xce?\xba\x0f\x06\xad\xa6\n\xd6\xca\x12\x16\x00T\xfb\x0e+H\xaa;U\xa3\x96\xb1\xb8\n}fzsYi\x05\x7f\xfd]\x00l\x955:\xe1\x9a'}\x87\ue9fb\xdb/\xef\x96u\x83mʜ\fk\xa4:\x98.\xc9M\x05\x0f\x86@A\xef(\xb0\aU\xd7H\x04u\f\x01\x1d\xf76\xc1\xb8\xb5\x0fm2\xd7+\x06P+\x1f\x19\xb8A\xf8\x92r҇^\xf6\x02]\xf0\x1d\x066\x03X\xf2\x8e\xf8\xb9\x1b;\xf2\xf1J\x82\xc82\xa0\x85\x91HɆP\xc4x\x87\x1a(\x05\b~\r\xdc\x18\x82\x80\t\\Ǉ\xde\xc9ϯA9\xf0\xab߱沏\x9e\x80\x1a\x1f\xad\x16\x1ao10\x04\xac\xfdƙ?w\x9aI`\x10\x93V\xf1@\xa0\xe11\x8e18e\x05\xfe\x88\xafA9\r\xadz\x82\x80b\x03\xa2\x1biK\"T¯>`\x02\xb0\x82\x86\xb9\xa3\xea\xfazcx\xa8\xc8ڷmt\x86\x9f\xaeS]\x99Ud\x1f\xe8Z\xe3\x16\xed5\x99\xcdB\x85\xba1\x8c5ǀת3\x8b专`\xa9l\xf5\x0f;\x92\\\x8d<\xe5'\xe1\x13q0n\xb3\x1bN52\x8b\xbb\xd4GfC^\x96C\xdc\xc3k\xdc&%\xe2\xfe\xfd\xf23\fFS\nF*\xa1G{\xbf\x8c\xf6\xc0\vPƭ1\xa4U\xb0\x0e\xbeM\x1a\xd1\xe9\xce\x1b\x97\xb9T[\x83\xee\x10t\x8a\xab\xd60\r,\x95\xfc\x94p\x93\xfa\x12\xac\x10b\xa7\x15\xa3.\xe1\xd6\xc1\x8dj\xd1\xde(\xc2\xff\x1dvA\x98\x16\x02\xe9y\xe0\xc7\xedtx\xb2`Fk7<\xf4\xba\xc9\fMT\xef\xb2\xc3Zr&\xc0\xc9Z\xb36u*\x03X\xfb\x00jjIyև$\xfd\"/\xfa\x1e\x91\xfd8\xea\x1c~}ޏ\xa9V!o\xd7(\xc2á#o\xeeD\xe2ز5k\xac\x9fj\x8bYA\xee\x14x\xce\ty\xd1\xc5\xf6\xd8\xde\x02>\xe1\xe3\xc9\xd8]\xf0\xd2'S\xa7\x068\x93\xff~s٘a\v\x9d\x8b&ˤ\xedj\xdcrG\xad\xb6W\x03!:'\x15\xe9\x9d\f\x1f)\x85Î|4k\x18\xdb\x13?&=\xb9uk/}\x92\x95\x98T\x9c\xeb\x04\xfb\xa4\xf66\xb2G'\xea\xe6r:݊.\x000\xffd\xcb\xffW\v\xbb\x9c\xb0鵇\xb1gɁU{\x1e\xa7\xafD\xa2+\x1a\xf4!I\xa9M*\x85Q®\b\xf0;֑\xd5\xcab\t\xb7|E\xe0[Ì\x1a\xccX34\x8a\xdcU\xaa\x9e\xc0\xa8g\x14{\x87\xc7\xd4\xed\xe1\x89֊\x89\n8\xc4SZ\x9cO\x8c\xbcV\x11\xbf\x0f\xc1\x879\x81#\xc0>\x0e\xf2\x03d\xad\xa7\xb4\xafJ1b\x9a\xe0F1\xa8\x01\xb4Y\xb5rXTԠ~\rke\xac\x80\xc3\x04\r*\xcb\r\xd4\r\xd6\x0f\xaf\xc1\x87a\x8e\xbd\xecC\xac\x02ã\xe1f\x1a\x91\v\xa81\xc4|\x9f\x95\xc99\xf5\x05\x91\x8fVI\xfc\x8f\r\xba}\xa4\xf0\xa8(\xe9\x1e<E=\xeff>bU \xdbقM;\x9d\xbf\v\xd3|aܓ-v&\xda\xc9f+\xe5\x81Cu\xf4Q#\xcd\a9\xd5b\xf7\xcf\x02\xeesc{N\"#\xf9\xbcЇD\x91g\x04\x96\xec\xbb\x0e\xf5\x7f\xc1\xaeO)]\b_\xef\xf7\xae\xb1\xb8خ0$\xe8\xe4ft\b\xe0\xacJ\x80Fm\x11V\x88n\xcf)\xb9\x7f\xd48n#gɖ\xb9!\xe7\xd9\xcd\xc9\x0e\xf1\xcc\xe9`x\xe5,f\x02N4\xf1Ej\xee\x13\xc3Һ\x8b\x17\x18y\x96\xe4\xd99\x15\x82z:\x98\x19\x00\xd4\xfb\xdbf\xf1LN\xeeN\xc4w5<sd\x90\x8a.f6\x17\u0530z\x9a;k\xdc\xec\xae\xcde\U00072ebf\x00\x88\t\x9e\xe6\xfdd\xe2\xbau\x02\xc2r,9\xb0\xf3\xe0\x041ܾ\xcaˌO$\xf5h\xa8\xd7W\xc1\xf6\xed\xfe+\x15Ң\xffW M\xf4Q\xe8Q\xe4\xc4>\xa8̀\xc5\xfe\xb0*\xf7֎Q\x8f\xae\xe7\xc2\xc3\n^\xbd:\xb8ܧ\xcf\xda;\x9d\xfe\xe9\xa0\n\xbe~\x93\xcb6\xfb\x80\xba\x87\x80*\xf8\xfa\xad\xf8g\x00\x80\xb3$\xceQ\x11\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4X_\x8f\xdb8\x0e\x7fϧ v\x1fr\a$N\x8b}9\xf8\xe50\x98\xee\xde\r\xae\x7f\x06ʹ\xf7\xb0\xd8\a\xc6bl]d\xc9'JI\xd3O\x7f\xa0l叓L\xa7{\xbb\xe3\x00\xade\x89\"\x7f$\x7f\xa44\x99\xcf\xe7\x13\xec\xf4g\xf2\xac\x9d-\x01;M_\x02Yy\xe3b\xf37.\xb4[l_\xaf(\xe0\xeb\xc9F[U\xc2}\xe4\xe0ڏ\xc4.\xfa\x8a\xde\xd0Z[\x1d\xb4\xb3\x93\x96\x02*\fXN\x00\xd0Z\x17P\x86Y^\x01*g\x83wƐ\x9f\xd7d\x8bM\\\xd1*j\xa3ȧ\x1d\xf2\xfe\xdbW\xc5Oū\t@\xe5)-\x7f\xd2-q\xc0\xb6+\xc1Fc&\x00\x16[*a\xebLl\x89-vܸ`\\\x95fs\xb1%C\xde\x15\xdaM\xb8\xa3J\xf6\xae\xbd\x8b]\t\xc7\x0f\xbd\x88A\xafަ\xcfI\xdar\x90\xf6v\x90\x96&\x18\xcd\xe1_\xcfLz\xab9\xa4\x89\x9d\x89\x1e\xcdM\xcd\xd2\x1cֶ\x8e\x06\xfd\xadY\x13\x80\xce\x13\x93\xdf\xd2'\xbb\xb1ng\x7f\xd1d\x14\x97\xb0F\xc34\x01\xe0\xcauT\xc2{l\x89;\xacHM\x00\xb6h\xb4J\xca\xf46\xb9\x8e\xec\xdd\xe3\xc3矖UCm\xf2\x87\f+\xe2\xca\xeb.ͻa\fh\x06\x84\xac\r\xec\x1a\xf2\x04\x9f\x13r\xc0\xc1y\xe2A\xf1A$@\xb6\x80\x8ba\xa8\xf3\xae#\x1ft\x06X\x9e\x93\b;\x8c\x8d\xf4\x99\x8a\xc2\xfd\x1cP\x12S\xc4\x10\x1a\x82m?F\n8\x19\x03n\r\xa1\xd1\f\x9e\x12R6\x1c]\x95\x1f\xb7\x06\xb4\xe0V\xff\xa1*\x14\xb0\x144=\x037.\x1a%\x81\xb8%\x1f\xc0S\xe5j\xab\xbf\x1e$3\x04\x97\xb64\x18\x88ÙDm\x03y\x8bF\xa0\x8e4\x03\xb4\nZ܃'\xd9\x03\xa2=\x91\x96\xa6p\x01\xef\x9c'\xd0v\xedJhB\xe8\xb8\\,j\x1drNU\xaem\xa3\xd5a\xbfH\x99\xa1W18\xcf\vE[2\v\xd6\xf5\x1c}\xd5\xe8@U\x88\x9e\x16\xd8\xe9yR܊\xb1\\\xb4\xeaG?$ OO4\r{\t\x0e\x0e^\xdb\xfa0\x9cB\xfc&\xee\x12۽\xdb\xfbe\xbd\x89Gx\xb5\xad\x13*\x1f\x7f^>A\xde4\xb9\xe0D$\fh\x1f\x97\xf1\x11x\x01J\xdb5\xf9\xb4\n\xd6\u07b5I\"Y\xd59mCz\xa9\x8c&{\x0e:\xc7U\xab\x83x\xfa\xbf\x918\x88\x7f\n\xb8O\xcc\x02+\x82\xd8)\f\xa4\nx\xb0p\x8f-\x99{d\xfa\xd3a\x17\x84y.\x90~\x1b\xf8SB\xcc\x7f\xb2\xbe\x1c\xd0:\fg\xaa\xba\xea\xa1뙺\xec\xa8:K\x14\x91\xa1\xd7z\xc8ܵ\xf3\x80'\x12!g\xf1ui9yo%\xf0\xc0\xe0k]\x9f\x8f\x01\xa0R\x89\xfd\xd1<\xdeXw\x13\x9e+\xb6\xde;\xbbֵ\x84\xa3\x18\xd0y\xb7Պ\xfc<\xdb6\xe8\x10\xfd`d\xe2\xc6brm\xaf\x11\xc2\xf2\xab<)\xf1$\x9a\xf2Y\x1d\x0e\xd3d\xbb\x80\xda\xf6Lt\\\x9e\xc2˷\x03c\xda@V%\x1e>\x7f\x82KQʤ`\xa7C\xd3\a\x7f\xa6\xd6\x02\x9e\xc4gTy\n\xd0F\x0e2W۴Q\xe6\xdb\xc4[S\xbe\x10l3\xf7\x17\xf0\xb0\x06\x1d\xa6\f\x92\x12La\x96\xd6\xf7\f}`\xe6@\x1e\"\xd3؈K\xb9\x17{\xc3\x0e\x19\xb4\xe5\x80\xc6\fV\x8c\xc1\x96\x9a\x8c+C%\x04\x1fi\xf4\xf1V$ɳ\xa1\xfd\xe5\xe0\xc8\x13\x02ц\xf6=\xe5\x1f\xd0\n\x0e\x98\x8c\x90\x8d0I\x01\xf0n\x80\x0f\x85\xba\xf4\xa5#\xe4\x19\xd6nh?\xb6\xe0\x1b\xe19\xf4\x1b\xdfRu*\x059+\xeaiM\x9el\xb8JF\xd2\xf9xK\x81Rk\xa5\\\xc5R\x01*\xea\x02/ܖ\xfcV\xd3n\xb1s~\xa3m=\x97\xc0\x99\xf7\xa1\xcc\vQ\x84\x17?\xa6\x7f\xae\xe8\x03\xf0\xf4\xe1͇\x12\xee\x94\x02\x17\x9a\xde\xeb\xebhr\x9a\x9cT\xe1\x19\b\x81\xcd j\xf5\xf7\xe9\xe4B\xce\xf3x\xb8\xe4\x1d4\xdf\xc4D(J\xaf\xf7\xd2E$u\x04\x9ae\xef\a\xe7A\x98]\x9c\x9b\x83\xbf\xe7\xb2k\xde\xeb\xb5Y9g\b\xcf\v=\xa4ڠ=\x9d\xd57\xf9\xcdaC\xfb\x97\x12\x03՞\x98\x1f\xbd\xfbr\x11\x93g\x06\xfd|\x9c'\x14%\xf6\xfc\xf3\xe9\xe9\xf1/˿B\x97\x06C\x83}5\xcbi>e\xe8L\xac\xf5Xm\x80\x167tZ\xda\x1a\xefb\xdd\xccR\xba\x11\xaa\x1cJ\xbd\\\xa6\x10\xb4\xad9\x8f\xf6Yz!33\x06\x90\xddj\xefl+1\xf8G%\xac\xf40W!\xba\x80I09\x03\xe9\xd3Ƿ\xe7\xf6\b\xb9ˬ\x83\xfdߝ\x94\xa2\r\xbf\\\x9d\xe5\xcb\xf4Y\xfe~\x85\xac{\x996\xef\xddA\x15\x04\xe9Fp\xceԡ\x97V&\x9d5D\xb3\xc6q\xe0\x19(\xd7J\xf5\xb9\"R\x0eX\n\x1e\x1e\xc1\xa3\xad\x13\xb5c\x00\xf4B=X5\x03W\xbb\x18\x00\xfb\xc8\xfcNsnf\x8aN\xc5#\\\x98yf\xe2\xc30\xe9P\xad\x89ϒB\xfal\x8c\xa1\x91Y\x15\x06\xca\xe5q\x1c\x8d\x00\x95qQ\x1d6\xcd>\x1b\xd5G\xe8\x9c:M\x1b<)r\x97v?\x04\xa8\xd0NS\xc1`\n\x80\xc6ٺ\xd7\xe0\xfe\xe6\xb2ߝ4ޙ\x17Ԏ\x8f\xceP\x8e͑ɗ\x8c2=\xb2\xc6\x15\xc1\x90\xa2\xa0EE\x80<\x83]\xa3\xab\x04\xad\x804\x9d\xf2Qp\xa6]4\xc6\xedH%\x9f0\x9f\x9e\xecN\xff\x84\xafێ<;\x8bA\xb8\xa3!\xb8\xfb\xf8~8i\xdd\xfd{\t\x0fw\uf4b5}\vB-j\x93\xbe\xc2?\xee\x1f\xaf\x8a\x14\xb2\xd2\x15\x01V\x95\x8b6\xcc\xc0\xf9\x93\x83\x00<\xbc\xc9¿\xc6d\x91Ś\x8e\xc0\\:V\x9e\xbe\x1d\x1a\xf7C\x83\xe9ng\x8f\xe6k\x96ꨊ\xe9\x1f\x94\x18-~\xb9w\xb6\x8a^\xea\xfe\x87\x8e\xfc\xe9\xdd\xc7\r\xbf\xbf\xbb\xbe&GB\x8b_t\x1b[\xb0\xb1]\x91\xcf\xc1\x7f\x88\x83K\xef\x8f\xfa>p\a\x9130zCÝ\x8a\xad\x05jE\x86\xd2\xff\xf3t\x16\xb00\xbc\xa0+\xf4\xd12\fa\xc9\xd2\xf6\x04\xddR\x01\xbfD\x9f\xca\xfcq[ء\x0e\x10m\xd0\xe6Bl\xeaP\x18\xe4\xf2\x88\x9bc#\xfb\x95\xbc\x13Eh/\x81,yjt\xab\xe5\x987\x92\xd0j+\xe8\x94\xf0j\xf4\xa1\xf7\x9e\x1c\xd8k\xf2g\xdf\xf2q\xe2Y\xa7<\x0e\x93\xb2\x17\xf2\xa2\x8c\x7f\x0698\x8f5\x15\x93\x17\x85ε.e~\x10}2x5\xbe8`\x88g\xa1\xf4\x92\xf3aZ4ض\xca\xdd\x7f\x1fk\x83Dp\xeb\x13\x99\x00\xf8\xff\x9f\x11\xbb\x06\x99\x9e\xc5\xf7\xba\xecGY\x97!7zM\xd5\xdeP/M\x80??\xc9~\xd7iV~dc;Vj\x0ew[ԩ\x15\xba\xf8\xf2\xc9\xe2\x8do7\xfc{\xc5m\xa3\xa1\xe1\x1a\xab\x84\xed\xeb\xe3[\xf2\xe9<_h\xbe\x9e\x1cz8uRh\x86H\x1bF\x8e\xb1\x80\x95\x9c\x19H\xbd\x1f\xdfe\xfe\xf0\xc3\xd9udz\xad\x9c\xed\x8f\xe9\\¯\xbf\xc95\xa2\\\xe6\xa9\xe18\xc0%\xfc\xfa\xdb\xe4\x7f\x03\x00\xc9\x13ܫ\f\x16\x00\x00"),
 }
 
 var CRDs = crds()
@@ -0,0 +1,65 @@
+#!/bin/bash
+
+# Copyright 2020 the Velero contributors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+if [[ -z "${BIN}" ]]; then
+    echo "BIN must be set"
+    exit 1
+fi
+
+if [[ "${BIN}" != "velero" ]]; then
+    echo "${BIN} does not need the kopia binary"
+    exit 0
+fi
+
+if [[ -z "${GOOS}" ]]; then
+    echo "GOOS must be set"
+    exit 1
+fi
+if [[ -z "${GOARCH}" ]]; then
+    echo "GOARCH must be set"
+    exit 1
+fi
+if [[ -z "${KOPIA_VERSION}" ]]; then
+    echo "KOPIA_VERSION must be set"
+    exit 1
+fi
+
+# kopia names its release archives after its own architecture names, and doesn't
+# publish ppc64le binaries.
+case "${GOARCH}" in
+    amd64)
+        KOPIA_ARCH=x64
+        ;;
+    arm64|arm)
+        KOPIA_ARCH=${GOARCH}
+        ;;
+    *)
+        echo "kopia is not available for ${GOARCH}, skipping"
+        exit 0
+        ;;
+esac
+
+KOPIA_RELEASE=kopia-${KOPIA_VERSION}-${GOOS}-${KOPIA_ARCH}
+
+wget --quiet https://github.com/kopia/kopia/releases/download/v${KOPIA_VERSION}/${KOPIA_RELEASE}.tar.gz
+tar -xzf ${KOPIA_RELEASE}.tar.gz
+mv ${KOPIA_RELEASE}/kopia /output/usr/bin/kopia
+
+chmod +x /output/usr/bin/kopia
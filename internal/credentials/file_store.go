@@ -0,0 +1,127 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// FileStore defines operations for interacting with credentials
+// that are stored on a file system.
+type FileStore interface {
+	// Path returns a path on disk where the secret key defined by
+	// the given selector is serialized.
+	Path(selector *corev1api.SecretKeySelector) (string, error)
+
+	// CACert returns the CA bundle in the secret key or config map
+	// key that the given reference selects.
+	CACert(ref *velerov1api.CACertReference) ([]byte, error)
+}
+
+type namespacedFileStore struct {
+	client    corev1client.CoreV1Interface
+	namespace string
+	fsRoot    string
+	fs        filesystem.Interface
+}
+
+// NewNamespacedFileStore returns a FileStore which can interact with credentials
+// for the given namespace and will store them under the given fsRoot.
+func NewNamespacedFileStore(client corev1client.CoreV1Interface, namespace string, fsRoot string, fs filesystem.Interface) (FileStore, error) {
+	fsNamespaceRoot := filepath.Join(fsRoot, namespace)
+
+	if err := fs.MkdirAll(fsNamespaceRoot, 0700); err != nil {
+		return nil, errors.Wrapf(err, "error creating credentials directory %s", fsNamespaceRoot)
+	}
+
+	return &namespacedFileStore{
+		client:    client,
+		namespace: namespace,
+		fsRoot:    fsNamespaceRoot,
+		fs:        fs,
+	}, nil
+}
+
+// Path returns a path on disk where the secret key defined by
+// the given selector is serialized. The file is rewritten each
+// time, so it has the secret's current contents.
+func (n *namespacedFileStore) Path(selector *corev1api.SecretKeySelector) (string, error) {
+	secret, err := n.client.Secrets(n.namespace).Get(context.TODO(), selector.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting secret %s/%s", n.namespace, selector.Name)
+	}
+
+	creds, found := secret.Data[selector.Key]
+	if !found {
+		return "", errors.Errorf("secret %s/%s doesn't have key %q", n.namespace, selector.Name, selector.Key)
+	}
+
+	keyFilePath := filepath.Join(n.fsRoot, fmt.Sprintf("%s-%s", selector.Name, selector.Key))
+
+	file, err := n.fs.Create(keyFilePath)
+	if err != nil {
+		return "", errors.Wrapf(err, "error creating credentials file %s", keyFilePath)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(creds); err != nil {
+		return "", errors.Wrapf(err, "error writing credentials file %s", keyFilePath)
+	}
+
+	return keyFilePath, nil
+}
+
+func (n *namespacedFileStore) CACert(ref *velerov1api.CACertReference) ([]byte, error) {
+	switch {
+	case ref.SecretKeyRef != nil && ref.ConfigMapKeyRef != nil:
+		return nil, errors.New("only one of secretKeyRef and configMapKeyRef can be set")
+	case ref.SecretKeyRef != nil:
+		secret, err := n.client.Secrets(n.namespace).Get(context.TODO(), ref.SecretKeyRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting secret %s/%s", n.namespace, ref.SecretKeyRef.Name)
+		}
+
+		caCert, found := secret.Data[ref.SecretKeyRef.Key]
+		if !found {
+			return nil, errors.Errorf("secret %s/%s doesn't have key %q", n.namespace, ref.SecretKeyRef.Name, ref.SecretKeyRef.Key)
+		}
+		return caCert, nil
+	case ref.ConfigMapKeyRef != nil:
+		configMap, err := n.client.ConfigMaps(n.namespace).Get(context.TODO(), ref.ConfigMapKeyRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting config map %s/%s", n.namespace, ref.ConfigMapKeyRef.Name)
+		}
+
+		caCert, found := configMap.Data[ref.ConfigMapKeyRef.Key]
+		if !found {
+			return nil, errors.Errorf("config map %s/%s doesn't have key %q", n.namespace, ref.ConfigMapKeyRef.Name, ref.ConfigMapKeyRef.Key)
+		}
+		return []byte(caCert), nil
+	default:
+		return nil, errors.New("one of secretKeyRef and configMapKeyRef must be set")
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestNamespacedFileStore(t *testing.T) {
+	tests := []struct {
+		name             string
+		namespace        string
+		fsRoot           string
+		secrets          []runtime.Object
+		secretSelector   *corev1api.SecretKeySelector
+		wantErr          string
+		expectedPath     string
+		expectedContents string
+	}{
+		{
+			name:           "returns an error if the secret can't be found",
+			namespace:      "ns1",
+			secretSelector: &corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "secret"}, Key: "credentials"},
+			wantErr:        "error getting secret ns1/secret",
+		},
+		{
+			name:           "returns an error if the secret doesn't have the key",
+			namespace:      "ns1",
+			secrets:        []runtime.Object{builder.ForSecret("ns1", "secret").Data(map[string][]byte{"other": []byte("creds")}).Result()},
+			secretSelector: &corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "secret"}, Key: "credentials"},
+			wantErr:        `secret ns1/secret doesn't have key "credentials"`,
+		},
+		{
+			name:           "returns an error if the secret is in another namespace",
+			namespace:      "ns1",
+			secrets:        []runtime.Object{builder.ForSecret("ns2", "secret").Data(map[string][]byte{"credentials": []byte("creds")}).Result()},
+			secretSelector: &corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "secret"}, Key: "credentials"},
+			wantErr:        "error getting secret ns1/secret",
+		},
+		{
+			name:             "writes the secret key to a file named after the secret and key under the namespace's directory",
+			namespace:        "ns1",
+			fsRoot:           "/tmp/credentials",
+			secrets:          []runtime.Object{builder.ForSecret("ns1", "secret").Data(map[string][]byte{"credentials": []byte("creds")}).Result()},
+			secretSelector:   &corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "secret"}, Key: "credentials"},
+			expectedPath:     "/tmp/credentials/ns1/secret-credentials",
+			expectedContents: "creds",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := velerotest.NewFakeFileSystem()
+
+			fileStore, err := NewNamespacedFileStore(fake.NewSimpleClientset(tc.secrets...).CoreV1(), tc.namespace, tc.fsRoot, fs)
+			require.NoError(t, err)
+
+			path, err := fileStore.Path(tc.secretSelector)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedPath, path)
+
+			contents, err := fs.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedContents, string(contents))
+		})
+	}
+}
+
+func TestNamespacedFileStoreCACert(t *testing.T) {
+	tests := []struct {
+		name             string
+		objects          []runtime.Object
+		ref              *velerov1api.CACertReference
+		wantErr          string
+		expectedContents string
+	}{
+		{
+			name:    "returns an error if neither reference is set",
+			ref:     &velerov1api.CACertReference{},
+			wantErr: "one of secretKeyRef and configMapKeyRef must be set",
+		},
+		{
+			name: "returns an error if both references are set",
+			ref: &velerov1api.CACertReference{
+				SecretKeyRef:    &corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "secret"}, Key: "ca.crt"},
+				ConfigMapKeyRef: &corev1api.ConfigMapKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "config-map"}, Key: "ca.crt"},
+			},
+			wantErr: "only one of secretKeyRef and configMapKeyRef can be set",
+		},
+		{
+			name:    "returns an error if the secret doesn't have the key",
+			objects: []runtime.Object{builder.ForSecret("ns1", "secret").Data(map[string][]byte{"other": []byte("ca")}).Result()},
+			ref: &velerov1api.CACertReference{
+				SecretKeyRef: &corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "secret"}, Key: "ca.crt"},
+			},
+			wantErr: `secret ns1/secret doesn't have key "ca.crt"`,
+		},
+		{
+			name:    "returns the secret key's CA bundle",
+			objects: []runtime.Object{builder.ForSecret("ns1", "secret").Data(map[string][]byte{"ca.crt": []byte("ca")}).Result()},
+			ref: &velerov1api.CACertReference{
+				SecretKeyRef: &corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "secret"}, Key: "ca.crt"},
+			},
+			expectedContents: "ca",
+		},
+		{
+			name: "returns an error if the config map can't be found",
+			ref: &velerov1api.CACertReference{
+				ConfigMapKeyRef: &corev1api.ConfigMapKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "config-map"}, Key: "ca.crt"},
+			},
+			wantErr: "error getting config map ns1/config-map",
+		},
+		{
+			name:    "returns the config map key's CA bundle",
+			objects: []runtime.Object{builder.ForConfigMap("ns1", "config-map").Data("ca.crt", "ca").Result()},
+			ref: &velerov1api.CACertReference{
+				ConfigMapKeyRef: &corev1api.ConfigMapKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "config-map"}, Key: "ca.crt"},
+			},
+			expectedContents: "ca",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fileStore, err := NewNamespacedFileStore(fake.NewSimpleClientset(tc.objects...).CoreV1(), "ns1", "", velerotest.NewFakeFileSystem())
+			require.NoError(t, err)
+
+			caCert, err := fileStore.CACert(tc.ref)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedContents, string(caCert))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+const (
+	// AmbientIdentityConfigKey is the config key that tells a location's plugin to
+	// authenticate with the cloud identity of the Velero server's pod.
+	AmbientIdentityConfigKey = "useAmbientIdentity"
+
+	// IdentityRoleConfigKey is the config key of the cloud identity that a location's
+	// plugin makes requests as, using the pod's identity.
+	IdentityRoleConfigKey = "identityRole"
+)
+
+// WithAmbientIdentity returns the config that a location's plugin is initialized with,
+// adding the keys for the location's ambient identity if it has one. The location's
+// own config isn't modified, since it may belong to an object in an informer's cache.
+func WithAmbientIdentity(config map[string]string, identity *velerov1api.AmbientIdentity) map[string]string {
+	if identity == nil {
+		return config
+	}
+
+	res := make(map[string]string, len(config)+2)
+	for k, v := range config {
+		res[k] = v
+	}
+
+	res[AmbientIdentityConfigKey] = "true"
+	if identity.Role != "" {
+		res[IdentityRoleConfigKey] = identity.Role
+	}
+
+	return res
+}
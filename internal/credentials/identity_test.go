@@ -0,0 +1,66 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestWithAmbientIdentity(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   map[string]string
+		identity *velerov1api.AmbientIdentity
+		want     map[string]string
+	}{
+		{
+			name:   "config is unchanged without an identity",
+			config: map[string]string{"region": "us-east-1"},
+			want:   map[string]string{"region": "us-east-1"},
+		},
+		{
+			name:     "identity without a role uses the pod's identity",
+			config:   map[string]string{"region": "us-east-1"},
+			identity: &velerov1api.AmbientIdentity{},
+			want:     map[string]string{"region": "us-east-1", "useAmbientIdentity": "true"},
+		},
+		{
+			name:     "identity's role is added",
+			identity: &velerov1api.AmbientIdentity{Role: "arn:aws:iam::123456789012:role/velero"},
+			want:     map[string]string{"useAmbientIdentity": "true", "identityRole": "arn:aws:iam::123456789012:role/velero"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var original map[string]string
+			if tc.config != nil {
+				original = make(map[string]string)
+				for k, v := range tc.config {
+					original[k] = v
+				}
+			}
+
+			assert.Equal(t, tc.want, WithAmbientIdentity(tc.config, tc.identity))
+			assert.Equal(t, original, tc.config)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// CredentialsFileConfigKey is the config key of the path of the credentials file that a
+// location's plugin authenticates with, instead of the credentials Velero was installed with.
+const CredentialsFileConfigKey = "credentialsFile"
+
+// VolumeSnapshotterConfig returns the config that a volume snapshot location's volume
+// snapshotter is initialized with. If the location has its own credential, it's written
+// to a file from store, and the file's path is added to the config. The location's own
+// config isn't modified, since it may belong to an object in an informer's cache.
+func VolumeSnapshotterConfig(location *velerov1api.VolumeSnapshotLocation, store FileStore) (map[string]string, error) {
+	if location.Spec.Credential == nil {
+		return WithAmbientIdentity(location.Spec.Config, location.Spec.Identity), nil
+	}
+
+	if location.Spec.Identity != nil {
+		return nil, errors.New("volume snapshot location's credential and identity can't both be set")
+	}
+
+	if store == nil {
+		return nil, errors.New("volume snapshot location's credential can't be used because no credential file store was provided")
+	}
+
+	credentialsFile, err := store.Path(location.Spec.Credential)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting volume snapshot location's credential")
+	}
+
+	res := make(map[string]string, len(location.Spec.Config)+1)
+	for k, v := range location.Spec.Config {
+		res[k] = v
+	}
+	res[CredentialsFileConfigKey] = credentialsFile
+
+	return res, nil
+}
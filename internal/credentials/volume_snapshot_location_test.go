@@ -0,0 +1,112 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestVolumeSnapshotterConfig(t *testing.T) {
+	credential := &corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "secret"}, Key: "cloud"}
+
+	tests := []struct {
+		name     string
+		location *velerov1api.VolumeSnapshotLocation
+		noStore  bool
+		want     map[string]string
+		wantErr  string
+	}{
+		{
+			name:     "config is unchanged without a credential or identity",
+			location: builder.ForVolumeSnapshotLocation("velero", "default").Provider("aws").Result(),
+			want:     nil,
+		},
+		{
+			name: "identity is added to the config",
+			location: builder.ForVolumeSnapshotLocation("velero", "default").
+				Provider("aws").
+				Identity(&velerov1api.AmbientIdentity{}).
+				Result(),
+			want: map[string]string{"useAmbientIdentity": "true"},
+		},
+		{
+			name: "path of the credential's file is added to the config",
+			location: builder.ForVolumeSnapshotLocation("velero", "default").
+				Provider("aws").
+				Credential(credential).
+				Result(),
+			want: map[string]string{"credentialsFile": "/credentials/velero/secret-cloud"},
+		},
+		{
+			name: "error is returned when the credential's secret doesn't exist",
+			location: builder.ForVolumeSnapshotLocation("velero", "default").
+				Provider("aws").
+				Credential(&corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "missing"}, Key: "cloud"}).
+				Result(),
+			wantErr: "error getting volume snapshot location's credential: error getting secret velero/missing",
+		},
+		{
+			name: "error is returned when both a credential and an identity are set",
+			location: builder.ForVolumeSnapshotLocation("velero", "default").
+				Provider("aws").
+				Credential(credential).
+				Identity(&velerov1api.AmbientIdentity{}).
+				Result(),
+			wantErr: "volume snapshot location's credential and identity can't both be set",
+		},
+		{
+			name: "error is returned when there's no credential file store",
+			location: builder.ForVolumeSnapshotLocation("velero", "default").
+				Provider("aws").
+				Credential(credential).
+				Result(),
+			noStore: true,
+			wantErr: "volume snapshot location's credential can't be used because no credential file store was provided",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var store FileStore
+			if !tc.noStore {
+				var err error
+				client := fake.NewSimpleClientset(builder.ForSecret("velero", "secret").Data(map[string][]byte{"cloud": []byte("creds")}).Result())
+				store, err = NewNamespacedFileStore(client.CoreV1(), "velero", "/credentials", velerotest.NewFakeFileSystem())
+				require.NoError(t, err)
+			}
+
+			config, err := VolumeSnapshotterConfig(tc.location, store)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, config)
+			assert.Nil(t, tc.location.Spec.Config)
+		})
+	}
+}
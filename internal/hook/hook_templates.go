@@ -0,0 +1,137 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+const (
+	// Backup hook template annotations
+	podBackupHookTemplateAnnotationKey     = "hook.backup.velero.io/template"
+	podBackupHookTemplatePathAnnotationKey = "hook.backup.velero.io/template-path"
+)
+
+// mysqlLockFile is the file in a MySQL container that the mysql template's pre hook
+// writes the ID of the connection that holds the read lock to, so that the post hook
+// can kill the connection to release the lock.
+const mysqlLockFile = "/tmp/velero-mysql-lock"
+
+// templateCommands are the commands of the pre and post hooks of each hook template.
+var templateCommands = map[velerov1api.HookTemplateName]func(path string) (pre, post []string){
+	velerov1api.HookTemplateFSFreeze: func(path string) ([]string, []string) {
+		return []string{"fsfreeze", "--freeze", path}, []string{"fsfreeze", "--unfreeze", path}
+	},
+
+	// FLUSH TABLES WITH READ LOCK only holds the lock for as long as its connection is
+	// open, so the pre hook leaves a client holding the lock running in the background
+	// (for at most 10 minutes), and waits for it to be acquired.
+	velerov1api.HookTemplateMySQL: func(string) ([]string, []string) {
+		pre := `rm -f ` + mysqlLockFile + ` && ` +
+			`(mysql -uroot --password="$MYSQL_ROOT_PASSWORD" -N -n -e "FLUSH TABLES WITH READ LOCK; SELECT CONNECTION_ID(); DO SLEEP(600);" > ` + mysqlLockFile + ` 2>&1 &) && ` +
+			`for i in $(seq 30); do grep -qx '[0-9][0-9]*' ` + mysqlLockFile + ` && exit 0; sleep 1; done; cat ` + mysqlLockFile + `; exit 1`
+		post := `mysql -uroot --password="$MYSQL_ROOT_PASSWORD" -e "KILL $(cat ` + mysqlLockFile + `)" && rm -f ` + mysqlLockFile
+		return []string{"/bin/sh", "-c", pre}, []string{"/bin/sh", "-c", post}
+	},
+
+	velerov1api.HookTemplatePostgreSQL: func(string) ([]string, []string) {
+		psql := `psql -U "${POSTGRES_USER:-postgres}" -c `
+		return []string{"/bin/sh", "-c", psql + `"SELECT pg_start_backup('velero', true);"`},
+			[]string{"/bin/sh", "-c", psql + `"SELECT pg_stop_backup();"`}
+	},
+}
+
+// ValidateHookTemplate returns an error if a hook template doesn't name a built-in
+// hook template, or is missing a path that the template needs.
+func ValidateHookTemplate(template *velerov1api.HookTemplate) error {
+	if _, ok := templateCommands[template.Name]; !ok {
+		return errors.Errorf("unknown hook template %q", template.Name)
+	}
+	if template.Name == velerov1api.HookTemplateFSFreeze && template.Path == "" {
+		return errors.Errorf("hook template %q requires a path", template.Name)
+	}
+	return nil
+}
+
+// TemplateHooks returns the pre and post exec hooks of a hook template.
+func TemplateHooks(template *velerov1api.HookTemplate) (pre, post *velerov1api.ExecHook, err error) {
+	if err := ValidateHookTemplate(template); err != nil {
+		return nil, nil, err
+	}
+
+	preCommand, postCommand := templateCommands[template.Name](template.Path)
+	pre = &velerov1api.ExecHook{
+		Container: template.Container,
+		Command:   preCommand,
+		OnError:   template.OnError,
+		Timeout:   template.Timeout,
+	}
+	post = &velerov1api.ExecHook{
+		Container: template.Container,
+		Command:   postCommand,
+		OnError:   template.OnError,
+		Timeout:   template.Timeout,
+	}
+	return pre, post, nil
+}
+
+// getPodExecHookFromTemplateAnnotations returns the ExecHook of the given phase of the hook
+// template that a pod's annotations enable, as long as the 'template' annotation is present.
+// If it is absent, this returns nil. The hook's container, error mode and timeout are taken
+// from the annotations of legacy hooks without a phase. If the template is invalid, it is
+// logged and nil is returned.
+func getPodExecHookFromTemplateAnnotations(annotations map[string]string, phase hookPhase, log logrus.FieldLogger) *velerov1api.ExecHook {
+	name := annotations[podBackupHookTemplateAnnotationKey]
+	if name == "" {
+		return nil
+	}
+
+	template := &velerov1api.HookTemplate{
+		Name:      velerov1api.HookTemplateName(name),
+		Container: annotations[podBackupHookContainerAnnotationKey],
+		Path:      annotations[podBackupHookTemplatePathAnnotationKey],
+		OnError:   velerov1api.HookErrorMode(annotations[podBackupHookOnErrorAnnotationKey]),
+	}
+	if template.OnError != velerov1api.HookErrorModeContinue && template.OnError != velerov1api.HookErrorModeFail {
+		template.OnError = ""
+	}
+
+	if timeoutString := annotations[podBackupHookTimeoutAnnotationKey]; timeoutString != "" {
+		if timeout, err := time.ParseDuration(timeoutString); err == nil {
+			template.Timeout = metav1.Duration{Duration: timeout}
+		} else {
+			log.Warn(errors.Wrapf(err, "Unable to parse provided timeout %s, using default", timeoutString))
+		}
+	}
+
+	pre, post, err := TemplateHooks(template)
+	if err != nil {
+		log.WithError(err).Warn("Ignoring invalid hook template annotation")
+		return nil
+	}
+
+	if phase == PhasePost {
+		return post
+	}
+	return pre
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestTemplateHooks(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    *velerov1api.HookTemplate
+		wantPre     []string
+		wantPost    []string
+		expectedErr string
+	}{
+		{
+			name:     "fsfreeze freezes and unfreezes the path",
+			template: &velerov1api.HookTemplate{Name: velerov1api.HookTemplateFSFreeze, Path: "/data"},
+			wantPre:  []string{"fsfreeze", "--freeze", "/data"},
+			wantPost: []string{"fsfreeze", "--unfreeze", "/data"},
+		},
+		{
+			name:        "fsfreeze requires a path",
+			template:    &velerov1api.HookTemplate{Name: velerov1api.HookTemplateFSFreeze},
+			expectedErr: `hook template "fsfreeze" requires a path`,
+		},
+		{
+			name:     "postgresql starts and stops a backup",
+			template: &velerov1api.HookTemplate{Name: velerov1api.HookTemplatePostgreSQL},
+			wantPre:  []string{"/bin/sh", "-c", `psql -U "${POSTGRES_USER:-postgres}" -c "SELECT pg_start_backup('velero', true);"`},
+			wantPost: []string{"/bin/sh", "-c", `psql -U "${POSTGRES_USER:-postgres}" -c "SELECT pg_stop_backup();"`},
+		},
+		{
+			name:        "unknown template is an error",
+			template:    &velerov1api.HookTemplate{Name: "oracle"},
+			expectedErr: `unknown hook template "oracle"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pre, post, err := TemplateHooks(tc.template)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantPre, pre.Command)
+			assert.Equal(t, tc.wantPost, post.Command)
+		})
+	}
+}
+
+func TestTemplateHooksMySQL(t *testing.T) {
+	template := &velerov1api.HookTemplate{
+		Name:      velerov1api.HookTemplateMySQL,
+		Container: "mysql",
+		OnError:   velerov1api.HookErrorModeFail,
+		Timeout:   metav1.Duration{Duration: time.Minute},
+	}
+
+	pre, post, err := TemplateHooks(template)
+	require.NoError(t, err)
+
+	for _, hook := range []*velerov1api.ExecHook{pre, post} {
+		assert.Equal(t, "mysql", hook.Container)
+		assert.Equal(t, velerov1api.HookErrorModeFail, hook.OnError)
+		assert.Equal(t, time.Minute, hook.Timeout.Duration)
+		require.Len(t, hook.Command, 3)
+	}
+	assert.Contains(t, pre.Command[2], "FLUSH TABLES WITH READ LOCK")
+	assert.Contains(t, post.Command[2], "KILL $(cat "+mysqlLockFile+")")
+}
+
+func TestGetPodExecHookFromTemplateAnnotations(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		phase       hookPhase
+		want        *velerov1api.ExecHook
+	}{
+		{
+			name: "missing template annotation",
+			annotations: map[string]string{
+				podBackupHookCommandAnnotationKey: "/usr/bin/foo",
+			},
+			phase: PhasePre,
+		},
+		{
+			name: "pre hook of the template",
+			annotations: map[string]string{
+				podBackupHookTemplateAnnotationKey:     "fsfreeze",
+				podBackupHookTemplatePathAnnotationKey: "/data",
+				podBackupHookContainerAnnotationKey:    "fsfreeze",
+				podBackupHookOnErrorAnnotationKey:      "Fail",
+				podBackupHookTimeoutAnnotationKey:      "1m",
+			},
+			phase: PhasePre,
+			want: &velerov1api.ExecHook{
+				Container: "fsfreeze",
+				Command:   []string{"fsfreeze", "--freeze", "/data"},
+				OnError:   velerov1api.HookErrorModeFail,
+				Timeout:   metav1.Duration{Duration: time.Minute},
+			},
+		},
+		{
+			name: "post hook of the template",
+			annotations: map[string]string{
+				podBackupHookTemplateAnnotationKey:     "fsfreeze",
+				podBackupHookTemplatePathAnnotationKey: "/data",
+				podBackupHookOnErrorAnnotationKey:      "invalid",
+			},
+			phase: PhasePost,
+			want: &velerov1api.ExecHook{
+				Command: []string{"fsfreeze", "--unfreeze", "/data"},
+			},
+		},
+		{
+			name: "invalid template is ignored",
+			annotations: map[string]string{
+				podBackupHookTemplateAnnotationKey: "fsfreeze",
+			},
+			phase: PhasePre,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, getPodExecHookFromTemplateAnnotations(tc.annotations, tc.phase, velerotest.NewLogger()))
+		})
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import "sync"
+
+// HookTracker counts the hooks that have been attempted and the hooks that
+// have failed. It's safe for concurrent use.
+type HookTracker struct {
+	lock      sync.Mutex
+	attempted int
+	failed    int
+}
+
+// NewHookTracker creates a HookTracker.
+func NewHookTracker() *HookTracker {
+	return &HookTracker{}
+}
+
+// Record records the outcome of an attempted hook. Recording on a nil
+// HookTracker is a no-op.
+func (ht *HookTracker) Record(failed bool) {
+	if ht == nil {
+		return
+	}
+
+	ht.lock.Lock()
+	defer ht.lock.Unlock()
+
+	ht.attempted++
+	if failed {
+		ht.failed++
+	}
+}
+
+// Stat returns the number of hooks that have been attempted and the number
+// of those that failed.
+func (ht *HookTracker) Stat() (attempted, failed int) {
+	if ht == nil {
+		return 0, 0
+	}
+
+	ht.lock.Lock()
+	defer ht.lock.Unlock()
+
+	return ht.attempted, ht.failed
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHookTracker(t *testing.T) {
+	tracker := NewHookTracker()
+
+	attempted, failed := tracker.Stat()
+	assert.Equal(t, 0, attempted)
+	assert.Equal(t, 0, failed)
+
+	tracker.Record(false)
+	tracker.Record(true)
+	tracker.Record(false)
+
+	attempted, failed = tracker.Stat()
+	assert.Equal(t, 3, attempted)
+	assert.Equal(t, 1, failed)
+}
+
+func TestNilHookTracker(t *testing.T) {
+	var tracker *HookTracker
+
+	tracker.Record(true)
+
+	attempted, failed := tracker.Stat()
+	assert.Equal(t, 0, attempted)
+	assert.Equal(t, 0, failed)
+}
@@ -194,10 +194,16 @@ func (h *DefaultItemHookHandler) HandleHooks(
 		// See if the pod has the legacy hook annotation keys (i.e. without a phase specified)
 		hookFromAnnotations = getPodExecHookFromAnnotations(metadata.GetAnnotations(), "", log)
 	}
+	hookSource := "annotation"
+	if hookFromAnnotations == nil {
+		// See if the pod enables a built-in hook template instead
+		hookFromAnnotations = getPodExecHookFromTemplateAnnotations(metadata.GetAnnotations(), phase, log)
+		hookSource = "annotationTemplate"
+	}
 	if hookFromAnnotations != nil {
 		hookLog := log.WithFields(
 			logrus.Fields{
-				"hookSource": "annotation",
+				"hookSource": hookSource,
 				"hookType":   "exec",
 				"hookPhase":  phase,
 			},
@@ -58,6 +58,8 @@ var _ ListWatchFactory = &DefaultListWatchFactory{}
 type DefaultWaitExecHookHandler struct {
 	ListWatchFactory   ListWatchFactory
 	PodCommandExecutor podexec.PodCommandExecutor
+	// HookTracker, if set, records the outcome of every hook handled.
+	HookTracker *HookTracker
 }
 
 var _ WaitExecHookHandler = &DefaultWaitExecHookHandler{}
@@ -154,10 +156,12 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 					},
 				)
 				// Check the individual hook's wait timeout is not expired
-				if hook.Hook.WaitTimeout.Duration != 0 && time.Since(waitStart) > hook.Hook.WaitTimeout.Duration {
+				expired := hook.Hook.WaitTimeout.Duration != 0 && time.Since(waitStart) > hook.Hook.WaitTimeout.Duration
+				if expired {
 					err := fmt.Errorf("Hook %s in container %s expired before executing", hook.HookName, hook.Hook.Container)
 					hookLog.Error(err)
 					if hook.Hook.OnError == velerov1api.HookErrorModeFail {
+						e.HookTracker.Record(true)
 						errors = append(errors, err)
 						cancel()
 						return
@@ -169,7 +173,9 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 					OnError:   hook.Hook.OnError,
 					Timeout:   hook.Hook.ExecTimeout,
 				}
-				if err := e.PodCommandExecutor.ExecutePodCommand(hookLog, podMap, pod.Namespace, pod.Name, hook.HookName, eh); err != nil {
+				err := e.PodCommandExecutor.ExecutePodCommand(hookLog, podMap, pod.Namespace, pod.Name, hook.HookName, eh)
+				e.HookTracker.Record(expired || err != nil)
+				if err != nil {
 					hookLog.WithError(err).Error("Error executing hook")
 					if hook.Hook.OnError == velerov1api.HookErrorModeFail {
 						errors = append(errors, err)
@@ -221,6 +227,7 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 				},
 			)
 			hookLog.Error(err)
+			e.HookTracker.Record(true)
 			if hook.Hook.OnError == velerov1api.HookErrorModeFail {
 				errors = append(errors, err)
 			}
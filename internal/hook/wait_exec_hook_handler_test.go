@@ -728,6 +728,20 @@ func TestWaitExecHandleHooks(t *testing.T) {
 			h := &DefaultWaitExecHookHandler{
 				PodCommandExecutor: podCommandExecutor,
 				ListWatchFactory:   &fakeListWatchFactory{source},
+				HookTracker:        NewHookTracker(),
+			}
+
+			// every hook should be recorded exactly once, and every hook that wasn't
+			// executed successfully should be recorded as failed.
+			expectedAttempted := 0
+			for _, hooks := range test.byContainer {
+				expectedAttempted += len(hooks)
+			}
+			expectedFailed := expectedAttempted
+			for _, e := range test.expectedExecutions {
+				if e.error == nil {
+					expectedFailed--
+				}
 			}
 
 			for _, e := range test.expectedExecutions {
@@ -748,6 +762,10 @@ func TestWaitExecHandleHooks(t *testing.T) {
 			for i, ee := range test.expectedErrors {
 				assert.EqualError(t, errs[i], ee.Error())
 			}
+
+			attempted, failed := h.HookTracker.Stat()
+			assert.Equal(t, expectedAttempted, attempted)
+			assert.Equal(t, expectedFailed, failed)
 		})
 	}
 }
@@ -0,0 +1,301 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemodifiers
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// Version is the only supported version of the resource modifiers format.
+const Version = "v1"
+
+var validOperations = sets.NewString("add", "remove", "replace", "copy", "move", "test")
+
+// JSONPatch is a single RFC 6902 JSON patch operation.
+type JSONPatch struct {
+	Operation string      `json:"operation"`
+	From      string      `json:"from,omitempty"`
+	Path      string      `json:"path"`
+	Value     interface{} `json:"value,omitempty"`
+}
+
+// MergePatch is an RFC 7386 JSON merge patch.
+type MergePatch struct {
+	PatchData map[string]interface{} `json:"patchData"`
+}
+
+// StrategicPatch is a Kubernetes strategic merge patch. It can only be applied to
+// built-in Kubernetes types; custom resources should use a MergePatch instead.
+type StrategicPatch struct {
+	PatchData map[string]interface{} `json:"patchData"`
+}
+
+// Conditions select the items a ResourceModifierRule applies to.
+type Conditions struct {
+	// GroupResource is the group-resource of the items to modify, e.g. "deployments.apps"
+	// or "persistentvolumeclaims".
+	GroupResource string `json:"groupResource"`
+
+	// Namespaces, if specified, limits the rule to items being restored into one of
+	// these namespaces. Entries may contain glob wildcards.
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// ResourceNameRegex, if specified, limits the rule to items whose name matches it.
+	ResourceNameRegex string `json:"resourceNameRegex,omitempty"`
+
+	// LabelSelector, if specified, limits the rule to items whose labels match it.
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+// ResourceModifierRule is a set of patches to apply to every restored item
+// that matches the rule's conditions.
+type ResourceModifierRule struct {
+	Conditions       Conditions       `json:"conditions"`
+	Patches          []JSONPatch      `json:"patches,omitempty"`
+	MergePatches     []MergePatch     `json:"mergePatches,omitempty"`
+	StrategicPatches []StrategicPatch `json:"strategicPatches,omitempty"`
+}
+
+// ResourceModifiers is the content of a resource modifiers ConfigMap.
+type ResourceModifiers struct {
+	Version               string                 `json:"version"`
+	ResourceModifierRules []ResourceModifierRule `json:"resourceModifierRules"`
+
+	resolved []resolvedRule
+}
+
+type resolvedRule struct {
+	rule       *ResourceModifierRule
+	namespaces *collections.IncludesExcludes
+	nameRegex  *regexp.Regexp
+	selector   labels.Selector
+}
+
+// GetResourceModifiersFromConfig parses and validates the resource modifier rules
+// stored in the provided ConfigMap. The ConfigMap must contain exactly one data
+// entry, formatted as YAML or JSON.
+func GetResourceModifiersFromConfig(cm *corev1api.ConfigMap) (*ResourceModifiers, error) {
+	if cm == nil {
+		return nil, errors.New("could not parse config from nil configmap")
+	}
+	if len(cm.Data) != 1 {
+		return nil, errors.Errorf("illegal resource modifiers %s/%s configmap: it must contain exactly one data entry", cm.Namespace, cm.Name)
+	}
+
+	var data string
+	for _, v := range cm.Data {
+		data = v
+	}
+
+	modifiers := new(ResourceModifiers)
+	if err := yaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(data), len(data)).Decode(modifiers); err != nil {
+		return nil, errors.Wrapf(err, "error decoding resource modifiers from configmap %s/%s", cm.Namespace, cm.Name)
+	}
+
+	if err := modifiers.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid resource modifiers in configmap %s/%s", cm.Namespace, cm.Name)
+	}
+
+	return modifiers, nil
+}
+
+// Validate checks that the resource modifiers are well-formed and resolves
+// their conditions so that they can be applied.
+func (m *ResourceModifiers) Validate() error {
+	if m.Version != Version {
+		return errors.Errorf("unsupported resource modifiers version %q, expected %q", m.Version, Version)
+	}
+
+	m.resolved = nil
+	for i := range m.ResourceModifierRules {
+		rule := &m.ResourceModifierRules[i]
+
+		if rule.Conditions.GroupResource == "" {
+			return errors.Errorf("rule %d: conditions.groupResource is required", i)
+		}
+
+		resolved := resolvedRule{
+			rule:       rule,
+			namespaces: collections.NewIncludesExcludes().Includes(rule.Conditions.Namespaces...),
+			selector:   labels.Everything(),
+		}
+
+		if rule.Conditions.ResourceNameRegex != "" {
+			re, err := regexp.Compile(rule.Conditions.ResourceNameRegex)
+			if err != nil {
+				return errors.Wrapf(err, "rule %d: invalid resourceNameRegex", i)
+			}
+			resolved.nameRegex = re
+		}
+
+		if rule.Conditions.LabelSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(rule.Conditions.LabelSelector)
+			if err != nil {
+				return errors.Wrapf(err, "rule %d: invalid labelSelector", i)
+			}
+			resolved.selector = selector
+		}
+
+		if len(rule.Patches)+len(rule.MergePatches)+len(rule.StrategicPatches) == 0 {
+			return errors.Errorf("rule %d: at least one patch must be specified", i)
+		}
+
+		for _, p := range rule.Patches {
+			if !validOperations.Has(p.Operation) {
+				return errors.Errorf("rule %d: invalid patch operation %q", i, p.Operation)
+			}
+			if p.Path == "" {
+				return errors.Errorf("rule %d: patch path is required", i)
+			}
+			if (p.Operation == "copy" || p.Operation == "move") && p.From == "" {
+				return errors.Errorf("rule %d: patch operation %q requires a from path", i, p.Operation)
+			}
+		}
+
+		m.resolved = append(m.resolved, resolved)
+	}
+
+	return nil
+}
+
+// ApplyResourceModifierRules applies all rules whose conditions match the provided
+// item, in the order they're defined. groupResource is the item's fully-qualified
+// group-resource, and the item's namespace must already be set to the namespace it
+// is being restored into.
+func (m *ResourceModifiers) ApplyResourceModifierRules(obj *unstructured.Unstructured, groupResource string, log logrus.FieldLogger) []error {
+	var errs []error
+	for _, r := range m.resolved {
+		if !r.matches(obj, groupResource) {
+			continue
+		}
+
+		log.Infof("Applying resource modifier rule for %s", groupResource)
+		if err := r.apply(obj); err != nil {
+			errs = append(errs, errors.Wrapf(err, "error applying resource modifier rule to %s %s", groupResource, obj.GetName()))
+		}
+	}
+
+	return errs
+}
+
+func (r *resolvedRule) matches(obj *unstructured.Unstructured, groupResource string) bool {
+	if r.rule.Conditions.GroupResource != groupResource {
+		return false
+	}
+
+	if obj.GetNamespace() != "" && !r.namespaces.ShouldInclude(obj.GetNamespace()) {
+		return false
+	}
+
+	if r.nameRegex != nil && !r.nameRegex.MatchString(obj.GetName()) {
+		return false
+	}
+
+	return r.selector.Matches(labels.Set(obj.GetLabels()))
+}
+
+func (r *resolvedRule) apply(obj *unstructured.Unstructured) error {
+	objBytes, err := obj.MarshalJSON()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if len(r.rule.Patches) > 0 {
+		patchBytes, err := json.Marshal(toJSONPatchOperations(r.rule.Patches))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		patch, err := jsonpatch.DecodePatch(patchBytes)
+		if err != nil {
+			return errors.Wrap(err, "error decoding JSON patch")
+		}
+		if objBytes, err = patch.Apply(objBytes); err != nil {
+			return errors.Wrap(err, "error applying JSON patch")
+		}
+	}
+
+	for _, p := range r.rule.MergePatches {
+		patchBytes, err := json.Marshal(p.PatchData)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if objBytes, err = jsonpatch.MergePatch(objBytes, patchBytes); err != nil {
+			return errors.Wrap(err, "error applying merge patch")
+		}
+	}
+
+	if len(r.rule.StrategicPatches) > 0 {
+		schemaObj, err := scheme.Scheme.New(obj.GroupVersionKind())
+		if err != nil {
+			return errors.Wrapf(err, "strategic merge patches are not supported for %s", obj.GroupVersionKind())
+		}
+
+		for _, p := range r.rule.StrategicPatches {
+			patchBytes, err := json.Marshal(p.PatchData)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if objBytes, err = strategicpatch.StrategicMergePatch(objBytes, patchBytes, schemaObj); err != nil {
+				return errors.Wrap(err, "error applying strategic merge patch")
+			}
+		}
+	}
+
+	patched := new(unstructured.Unstructured)
+	if err := patched.UnmarshalJSON(objBytes); err != nil {
+		return errors.Wrap(err, "error decoding patched item")
+	}
+	obj.Object = patched.Object
+
+	return nil
+}
+
+// toJSONPatchOperations converts the patches into the RFC 6902 wire format.
+func toJSONPatchOperations(patches []JSONPatch) []map[string]interface{} {
+	ops := make([]map[string]interface{}, 0, len(patches))
+	for _, p := range patches {
+		op := map[string]interface{}{
+			"op":   p.Operation,
+			"path": p.Path,
+		}
+		if p.From != "" {
+			op["from"] = p.From
+		}
+		switch p.Operation {
+		case "add", "replace", "test":
+			op["value"] = p.Value
+		}
+		ops = append(ops, op)
+	}
+	return ops
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemodifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetResourceModifiersFromConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "valid YAML rules are parsed",
+			data: map[string]string{"rules.yaml": `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: persistentvolumeclaims
+    resourceNameRegex: "^mysql.*$"
+    namespaces:
+    - app-*
+  patches:
+  - operation: replace
+    path: /spec/storageClassName
+    value: premium
+`},
+		},
+		{
+			name:    "more than one data entry is an error",
+			data:    map[string]string{"a": "", "b": ""},
+			wantErr: true,
+		},
+		{
+			name: "unsupported version is an error",
+			data: map[string]string{"rules.yaml": `
+version: v2
+resourceModifierRules: []
+`},
+			wantErr: true,
+		},
+		{
+			name: "missing group resource is an error",
+			data: map[string]string{"rules.yaml": `
+version: v1
+resourceModifierRules:
+- conditions: {}
+  patches:
+  - operation: remove
+    path: /spec/replicas
+`},
+			wantErr: true,
+		},
+		{
+			name: "invalid patch operation is an error",
+			data: map[string]string{"rules.yaml": `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: deployments.apps
+  patches:
+  - operation: frobnicate
+    path: /spec/replicas
+`},
+			wantErr: true,
+		},
+		{
+			name: "invalid name regex is an error",
+			data: map[string]string{"rules.yaml": `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: deployments.apps
+    resourceNameRegex: "["
+  patches:
+  - operation: remove
+    path: /spec/replicas
+`},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cm := builder.ForConfigMap("velero", "modifiers").Result()
+			cm.Data = tc.data
+
+			_, err := GetResourceModifiersFromConfig(cm)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestApplyResourceModifierRules(t *testing.T) {
+	tests := []struct {
+		name          string
+		rules         string
+		groupResource string
+		obj           runtime.Object
+		want          runtime.Object
+	}{
+		{
+			name: "JSON patch is applied to a matching item",
+			rules: `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: persistentvolumeclaims
+  patches:
+  - operation: replace
+    path: /spec/storageClassName
+    value: premium
+`,
+			groupResource: "persistentvolumeclaims",
+			obj:           builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("standard").Result(),
+			want:          builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("premium").Result(),
+		},
+		{
+			name: "item in a non-matching namespace is not modified",
+			rules: `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: persistentvolumeclaims
+    namespaces:
+    - ns-2
+  patches:
+  - operation: replace
+    path: /spec/storageClassName
+    value: premium
+`,
+			groupResource: "persistentvolumeclaims",
+			obj:           builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("standard").Result(),
+			want:          builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("standard").Result(),
+		},
+		{
+			name: "item with a non-matching name is not modified",
+			rules: `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: persistentvolumeclaims
+    resourceNameRegex: "^mysql"
+  patches:
+  - operation: replace
+    path: /spec/storageClassName
+    value: premium
+`,
+			groupResource: "persistentvolumeclaims",
+			obj:           builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("standard").Result(),
+			want:          builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("standard").Result(),
+		},
+		{
+			name: "merge patch is applied to a matching item",
+			rules: `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: pods
+    labelSelector:
+      matchLabels:
+        app: web
+  mergePatches:
+  - patchData:
+      metadata:
+        annotations:
+          foo: bar
+`,
+			groupResource: "pods",
+			obj:           builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "web")).Result(),
+			want:          builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "web"), builder.WithAnnotations("foo", "bar")).Result(),
+		},
+		{
+			name: "strategic merge patch merges list entries by key",
+			rules: `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: pods
+  strategicPatches:
+  - patchData:
+      spec:
+        containers:
+        - name: app
+          image: registry.example.com/app:v2
+`,
+			groupResource: "pods",
+			obj: builder.ForPod("ns-1", "pod-1").Containers(
+				builder.ForContainer("app", "app:v1").Result(),
+				builder.ForContainer("sidecar", "sidecar:v1").Result(),
+			).Result(),
+			want: builder.ForPod("ns-1", "pod-1").Containers(
+				builder.ForContainer("app", "registry.example.com/app:v2").Result(),
+				builder.ForContainer("sidecar", "sidecar:v1").Result(),
+			).Result(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cm := builder.ForConfigMap("velero", "modifiers").Data("rules.yaml", tc.rules).Result()
+			modifiers, err := GetResourceModifiersFromConfig(cm)
+			require.NoError(t, err)
+
+			obj := velerotest.UnstructuredOrDie(toJSON(t, tc.obj))
+			errs := modifiers.ApplyResourceModifierRules(obj, tc.groupResource, velerotest.NewLogger())
+			assert.Empty(t, errs)
+
+			want := velerotest.UnstructuredOrDie(toJSON(t, tc.want))
+			assert.Equal(t, want.Object, obj.Object)
+		})
+	}
+}
+
+func toJSON(t *testing.T, obj runtime.Object) string {
+	t.Helper()
+
+	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+
+	bytes, err := (&unstructured.Unstructured{Object: res}).MarshalJSON()
+	require.NoError(t, err)
+
+	return string(bytes)
+}
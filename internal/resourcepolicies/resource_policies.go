@@ -0,0 +1,116 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcepolicies
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// Version is the only supported version of the resource policies format.
+const Version = "v1"
+
+// ResourcePolicies is the content of a resource policies ConfigMap.
+type ResourcePolicies struct {
+	Version string `json:"version"`
+
+	// VolumePolicies choose how the volumes that match them are backed up, like a
+	// backup's VolumePolicies.
+	VolumePolicies []velerov1api.VolumePolicy `json:"volumePolicies"`
+}
+
+// GetResourcePolicies fetches the resource policies ConfigMap that ref refers to from
+// namespace, and parses the policies it contains.
+func GetResourcePolicies(client corev1client.ConfigMapsGetter, namespace string, ref *corev1api.TypedLocalObjectReference) (*ResourcePolicies, error) {
+	if ref.Kind != "ConfigMap" {
+		return nil, errors.Errorf("unsupported resource policy kind %q, only ConfigMap is supported", ref.Kind)
+	}
+
+	cm, err := client.ConfigMaps(namespace).Get(context.TODO(), ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting resource policies configmap %s/%s", namespace, ref.Name)
+	}
+
+	return GetResourcePoliciesFromConfig(cm)
+}
+
+// GetResourcePoliciesFromConfig parses and validates the resource policies stored in
+// the provided ConfigMap. The ConfigMap must contain exactly one data entry, formatted
+// as YAML or JSON.
+func GetResourcePoliciesFromConfig(cm *corev1api.ConfigMap) (*ResourcePolicies, error) {
+	if cm == nil {
+		return nil, errors.New("could not parse config from nil configmap")
+	}
+	if len(cm.Data) != 1 {
+		return nil, errors.Errorf("illegal resource policies %s/%s configmap: it must contain exactly one data entry", cm.Namespace, cm.Name)
+	}
+
+	var data string
+	for _, v := range cm.Data {
+		data = v
+	}
+
+	policies := new(ResourcePolicies)
+	if err := yaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(data), len(data)).Decode(policies); err != nil {
+		return nil, errors.Wrapf(err, "error decoding resource policies from configmap %s/%s", cm.Namespace, cm.Name)
+	}
+
+	if err := policies.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid resource policies in configmap %s/%s", cm.Namespace, cm.Name)
+	}
+
+	return policies, nil
+}
+
+// Validate checks that the resource policies are well-formed.
+func (p *ResourcePolicies) Validate() error {
+	if p.Version != Version {
+		return errors.Errorf("unsupported resource policies version %q, expected %q", p.Version, Version)
+	}
+
+	for i, policy := range p.VolumePolicies {
+		if err := ValidateVolumePolicy(policy); err != nil {
+			return errors.Wrapf(err, "volume policy %d", i)
+		}
+	}
+
+	return nil
+}
+
+// ValidateVolumePolicy returns an error if a volume policy's action isn't a
+// VolumePolicyAction, or its capacity range is empty.
+func ValidateVolumePolicy(policy velerov1api.VolumePolicy) error {
+	switch policy.Action {
+	case velerov1api.VolumePolicyActionSnapshot, velerov1api.VolumePolicyActionRestic, velerov1api.VolumePolicyActionSkip:
+	default:
+		return errors.Errorf("invalid action %q, must be one of Snapshot, Restic or Skip", policy.Action)
+	}
+
+	if policy.MinCapacity != nil && policy.MaxCapacity != nil && policy.MinCapacity.Cmp(*policy.MaxCapacity) > 0 {
+		return errors.Errorf("minCapacity %s is larger than maxCapacity %s", policy.MinCapacity, policy.MaxCapacity)
+	}
+
+	return nil
+}
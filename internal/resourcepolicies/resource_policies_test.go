@@ -0,0 +1,124 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcepolicies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestGetResourcePoliciesFromConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    []velerov1api.VolumePolicy
+		wantErr string
+	}{
+		{
+			name: "valid YAML policies are parsed",
+			data: map[string]string{"policies.yaml": `
+version: v1
+volumePolicies:
+- volumeTypes:
+  - hostPath
+  - nfs
+  action: Skip
+- drivers:
+  - ebs.csi.aws.com
+  maxCapacity: 10Gi
+  action: Restic
+`},
+			want: []velerov1api.VolumePolicy{
+				{VolumeTypes: []string{"hostPath", "nfs"}, Action: velerov1api.VolumePolicyActionSkip},
+				{Drivers: []string{"ebs.csi.aws.com"}, MaxCapacity: quantity("10Gi"), Action: velerov1api.VolumePolicyActionRestic},
+			},
+		},
+		{
+			name:    "more than one data entry is an error",
+			data:    map[string]string{"a": "", "b": ""},
+			wantErr: "illegal resource policies velero/policies configmap: it must contain exactly one data entry",
+		},
+		{
+			name:    "unsupported version is an error",
+			data:    map[string]string{"policies.yaml": "version: v2"},
+			wantErr: `invalid resource policies in configmap velero/policies: unsupported resource policies version "v2", expected "v1"`,
+		},
+		{
+			name: "invalid action is an error",
+			data: map[string]string{"policies.yaml": `
+version: v1
+volumePolicies:
+- action: Copy
+`},
+			wantErr: `invalid resource policies in configmap velero/policies: volume policy 0: invalid action "Copy", must be one of Snapshot, Restic or Skip`,
+		},
+		{
+			name: "empty capacity range is an error",
+			data: map[string]string{"policies.yaml": `
+version: v1
+volumePolicies:
+- minCapacity: 10Gi
+  maxCapacity: 1Gi
+  action: Skip
+`},
+			wantErr: "invalid resource policies in configmap velero/policies: volume policy 0: minCapacity 10Gi is larger than maxCapacity 1Gi",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cm := builder.ForConfigMap("velero", "policies").Result()
+			cm.Data = tc.data
+
+			policies, err := GetResourcePoliciesFromConfig(cm)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, policies.VolumePolicies)
+		})
+	}
+}
+
+func TestGetResourcePolicies(t *testing.T) {
+	cm := builder.ForConfigMap("velero", "policies").Data("policies.yaml", "version: v1\nvolumePolicies:\n- action: Skip\n").Result()
+	client := fake.NewSimpleClientset(cm).CoreV1()
+
+	policies, err := GetResourcePolicies(client, "velero", &corev1api.TypedLocalObjectReference{Kind: "ConfigMap", Name: "policies"})
+	require.NoError(t, err)
+	assert.Equal(t, []velerov1api.VolumePolicy{{Action: velerov1api.VolumePolicyActionSkip}}, policies.VolumePolicies)
+
+	_, err = GetResourcePolicies(client, "velero", &corev1api.TypedLocalObjectReference{Kind: "Secret", Name: "policies"})
+	assert.EqualError(t, err, `unsupported resource policy kind "Secret", only ConfigMap is supported`)
+
+	_, err = GetResourcePolicies(client, "velero", &corev1api.TypedLocalObjectReference{Kind: "ConfigMap", Name: "missing"})
+	assert.Error(t, err)
+}
+
+func quantity(s string) *resource.Quantity {
+	q := resource.MustParse(s)
+	return &q
+}
@@ -18,6 +18,8 @@ package storage
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -72,6 +74,24 @@ func IsReadyToValidate(bslValidationFrequency *metav1.Duration, lastValidationTi
 	return true
 }
 
+// IsValidationRequested returns true if validation of the given backup storage location
+// has been requested, using the ValidationRequestedAnnotation, since it was last validated.
+func IsValidationRequested(location *velerov1api.BackupStorageLocation, log logrus.FieldLogger) bool {
+	value, ok := location.Annotations[velerov1api.ValidationRequestedAnnotation]
+	if !ok {
+		return false
+	}
+
+	requested, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		log.WithError(err).Warnf("Invalid value %q for annotation %s, ignoring", value, velerov1api.ValidationRequestedAnnotation)
+		return false
+	}
+
+	lastValidation := location.Status.LastValidationTime
+	return lastValidation == nil || requested.After(lastValidation.Time)
+}
+
 // ListBackupStorageLocations verifies if there are any backup storage locations.
 // For all purposes, if either there is an error while attempting to fetch items or
 // if there are no items an error would be returned since the functioning of the system
@@ -90,3 +110,39 @@ func ListBackupStorageLocations(ctx context.Context, kbClient client.Client, nam
 
 	return locations, nil
 }
+
+// DefaultBackupStorageLocationName returns the name of the backup storage location that's
+// marked as the default with spec.default. If no location is marked as the default, the
+// server's default backup storage location name is returned instead. It's an error for
+// more than one location to be marked as the default.
+func DefaultBackupStorageLocationName(locations []velerov1api.BackupStorageLocation, serverDefault string) (string, error) {
+	var defaults []string
+	for _, location := range locations {
+		if location.Spec.Default {
+			defaults = append(defaults, location.Name)
+		}
+	}
+
+	switch len(defaults) {
+	case 0:
+		return serverDefault, nil
+	case 1:
+		return defaults[0], nil
+	default:
+		sort.Strings(defaults)
+		return "", errors.Errorf("more than one backup storage location is marked as the default: %s", strings.Join(defaults, ", "))
+	}
+}
+
+// GetDefaultBackupStorageLocationName lists the backup storage locations in the given
+// namespace and returns the name of the default one, as DefaultBackupStorageLocationName does.
+func GetDefaultBackupStorageLocationName(ctx context.Context, kbClient client.Client, namespace, serverDefault string) (string, error) {
+	var locations velerov1api.BackupStorageLocationList
+	if err := kbClient.List(ctx, &locations, &client.ListOptions{
+		Namespace: namespace,
+	}); err != nil {
+		return "", errors.Wrap(err, "error listing backup storage locations")
+	}
+
+	return DefaultBackupStorageLocationName(locations.Items, serverDefault)
+}
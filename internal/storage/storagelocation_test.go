@@ -118,6 +118,56 @@ func TestIsReadyToValidate(t *testing.T) {
 	}
 }
 
+func TestIsValidationRequested(t *testing.T) {
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name      string
+		location  *velerov1api.BackupStorageLocation
+		requested bool
+	}{
+		{
+			name:      "not requested when the location doesn't have the annotation",
+			location:  builder.ForBackupStorageLocation("ns-1", "location-1").Result(),
+			requested: false,
+		},
+		{
+			name: "not requested when the annotation isn't a valid time",
+			location: builder.ForBackupStorageLocation("ns-1", "location-1").
+				ObjectMeta(builder.WithAnnotations(velerov1api.ValidationRequestedAnnotation, "now")).Result(),
+			requested: false,
+		},
+		{
+			name: "requested when the location has never been validated",
+			location: builder.ForBackupStorageLocation("ns-1", "location-1").
+				ObjectMeta(builder.WithAnnotations(velerov1api.ValidationRequestedAnnotation, now.Format(time.RFC3339Nano))).Result(),
+			requested: true,
+		},
+		{
+			name: "requested when the request is after the last validation",
+			location: builder.ForBackupStorageLocation("ns-1", "location-1").
+				ObjectMeta(builder.WithAnnotations(velerov1api.ValidationRequestedAnnotation, now.Format(time.RFC3339Nano))).
+				LastValidationTime(now.Add(-time.Minute)).Result(),
+			requested: true,
+		},
+		{
+			name: "not requested when the location has been validated since the request",
+			location: builder.ForBackupStorageLocation("ns-1", "location-1").
+				ObjectMeta(builder.WithAnnotations(velerov1api.ValidationRequestedAnnotation, now.Add(-time.Minute).Format(time.RFC3339Nano))).
+				LastValidationTime(now).Result(),
+			requested: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(IsValidationRequested(tt.location, velerotest.NewLogger())).To(BeIdenticalTo(tt.requested))
+		})
+	}
+}
+
 func TestListBackupStorageLocations(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -166,3 +216,76 @@ func TestListBackupStorageLocations(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultBackupStorageLocationName(t *testing.T) {
+	tests := []struct {
+		name          string
+		locations     []velerov1api.BackupStorageLocation
+		serverDefault string
+		want          string
+		wantErr       string
+	}{
+		{
+			name: "the location marked as the default is returned",
+			locations: []velerov1api.BackupStorageLocation{
+				*builder.ForBackupStorageLocation("ns-1", "default").Result(),
+				*builder.ForBackupStorageLocation("ns-1", "location-1").Default(true).Result(),
+			},
+			serverDefault: "default",
+			want:          "location-1",
+		},
+		{
+			name: "the server default is returned when no location is marked as the default",
+			locations: []velerov1api.BackupStorageLocation{
+				*builder.ForBackupStorageLocation("ns-1", "location-1").Result(),
+				*builder.ForBackupStorageLocation("ns-1", "location-2").Result(),
+			},
+			serverDefault: "location-2",
+			want:          "location-2",
+		},
+		{
+			name:          "the server default is returned when there are no locations",
+			serverDefault: "default",
+			want:          "default",
+		},
+		{
+			name: "more than one location marked as the default returns an error",
+			locations: []velerov1api.BackupStorageLocation{
+				*builder.ForBackupStorageLocation("ns-1", "location-2").Default(true).Result(),
+				*builder.ForBackupStorageLocation("ns-1", "location-1").Default(true).Result(),
+				*builder.ForBackupStorageLocation("ns-1", "location-3").Result(),
+			},
+			serverDefault: "default",
+			wantErr:       "more than one backup storage location is marked as the default: location-1, location-2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			name, err := DefaultBackupStorageLocationName(tt.locations, tt.serverDefault)
+			if tt.wantErr != "" {
+				g.Expect(err).NotTo(BeNil())
+				g.Expect(err.Error()).To(Equal(tt.wantErr))
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(name).To(Equal(tt.want))
+			}
+		})
+	}
+}
+
+func TestGetDefaultBackupStorageLocationName(t *testing.T) {
+	g := NewWithT(t)
+
+	client := fake.NewFakeClientWithScheme(scheme.Scheme,
+		builder.ForBackupStorageLocation("ns-1", "location-1").Result(),
+		builder.ForBackupStorageLocation("ns-1", "location-2").Default(true).Result(),
+		builder.ForBackupStorageLocation("ns-2", "location-3").Default(true).Result(),
+	)
+
+	name, err := GetDefaultBackupStorageLocationName(context.Background(), client, "ns-1", "default")
+	g.Expect(err).To(BeNil())
+	g.Expect(name).To(Equal("location-2"))
+}
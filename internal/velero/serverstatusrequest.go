@@ -33,7 +33,9 @@ import (
 // plugins and for updating the ServerStatusRequest timestamp.
 type ServerStatus struct {
 	PluginRegistry PluginLister
-	Clock          clock.Clock
+	// PluginProcesses, if set, reports the status of the plugins' processes.
+	PluginProcesses PluginProcessStatuses
+	Clock           clock.Clock
 }
 
 // PatchStatusProcessed patches status fields, including loading the plugin info, and updates
@@ -43,7 +45,7 @@ func (s *ServerStatus) PatchStatusProcessed(kbClient client.Client, req *velerov
 	req.Status.ServerVersion = buildinfo.Version
 	req.Status.Phase = velerov1api.ServerStatusRequestPhaseProcessed
 	req.Status.ProcessedTimestamp = &metav1.Time{Time: s.Clock.Now()}
-	req.Status.Plugins = getInstalledPluginInfo(s.PluginRegistry)
+	req.Status.Plugins = getInstalledPluginInfo(s.PluginRegistry, s.PluginProcesses)
 
 	if err := kbClient.Status().Patch(ctx, req, statusPatch); err != nil {
 		return errors.WithStack(err)
@@ -57,7 +59,13 @@ type PluginLister interface {
 	List(kind framework.PluginKind) []framework.PluginIdentifier
 }
 
-func getInstalledPluginInfo(pluginLister PluginLister) []velerov1api.PluginInfo {
+type PluginProcessStatuses interface {
+	// Get returns the status of the processes for the plugin executable command,
+	// or nil if none have been started.
+	Get(command string) *velerov1api.PluginProcessStatus
+}
+
+func getInstalledPluginInfo(pluginLister PluginLister, processes PluginProcessStatuses) []velerov1api.PluginInfo {
 	var plugins []velerov1api.PluginInfo
 	for _, v := range framework.AllPluginKinds() {
 		list := pluginLister.List(v)
@@ -66,6 +74,9 @@ func getInstalledPluginInfo(pluginLister PluginLister) []velerov1api.PluginInfo
 				Name: plugin.Name,
 				Kind: plugin.Kind.String(),
 			}
+			if processes != nil {
+				pluginInfo.Process = processes.Get(plugin.Command)
+			}
 			plugins = append(plugins, pluginInfo)
 		}
 	}
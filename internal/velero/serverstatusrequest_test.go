@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -54,6 +55,7 @@ func TestPatchStatusProcessed(t *testing.T) {
 		name            string
 		req             *velerov1api.ServerStatusRequest
 		reqPluginLister *fakePluginLister
+		reqProcesses    fakePluginProcessStatuses
 		expected        *velerov1api.ServerStatusRequest
 	}{
 		{
@@ -145,6 +147,53 @@ func TestPatchStatusProcessed(t *testing.T) {
 				}).
 				Result(),
 		},
+		{
+			name: "server status request includes the status of started plugin processes",
+			req:  statusRequestBuilder("0").Result(),
+			reqPluginLister: &fakePluginLister{
+				plugins: []framework.PluginIdentifier{
+					{
+						Command: "/plugins/velero-plugin-for-aws",
+						Name:    "velero.io/aws",
+						Kind:    "ObjectStore",
+					},
+					{
+						Command: "/plugins/myown",
+						Name:    "custom.io/myown",
+						Kind:    "VolumeSnapshotter",
+					},
+				},
+			},
+			reqProcesses: fakePluginProcessStatuses{
+				"/plugins/velero-plugin-for-aws": {
+					Phase:           velerov1api.PluginProcessPhaseRunning,
+					Restarts:        2,
+					LastRestartTime: &metav1.Time{Time: now},
+					LastError:       "plugin process exited",
+				},
+			},
+			expected: statusRequestBuilder("1").
+				ServerVersion(buildinfo.Version).
+				Phase(velerov1api.ServerStatusRequestPhaseProcessed).
+				ProcessedTimestamp(now).
+				Plugins([]velerov1api.PluginInfo{
+					{
+						Name: "velero.io/aws",
+						Kind: "ObjectStore",
+						Process: &velerov1api.PluginProcessStatus{
+							Phase:           velerov1api.PluginProcessPhaseRunning,
+							Restarts:        2,
+							LastRestartTime: &metav1.Time{Time: now},
+							LastError:       "plugin process exited",
+						},
+					},
+					{
+						Name: "custom.io/myown",
+						Kind: "VolumeSnapshotter",
+					},
+				}).
+				Result(),
+		},
 	}
 
 	for _, tc := range tests {
@@ -152,8 +201,9 @@ func TestPatchStatusProcessed(t *testing.T) {
 			g := NewWithT(t)
 
 			serverStatusInfo := ServerStatus{
-				PluginRegistry: tc.reqPluginLister,
-				Clock:          clock.NewFakeClock(now),
+				PluginRegistry:  tc.reqPluginLister,
+				PluginProcesses: tc.reqProcesses,
+				Clock:           clock.NewFakeClock(now),
 			}
 
 			kbClient := fake.NewFakeClientWithScheme(scheme.Scheme, tc.req)
@@ -200,3 +250,9 @@ func (l *fakePluginLister) List(kind framework.PluginKind) []framework.PluginIde
 
 	return plugins
 }
+
+type fakePluginProcessStatuses map[string]*velerov1api.PluginProcessStatus
+
+func (s fakePluginProcessStatuses) Get(command string) *velerov1api.PluginProcessStatus {
+	return s[command]
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package v1
 
 import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -46,6 +48,20 @@ type BackupSpec struct {
 	// +nullable
 	ExcludedResources []string `json:"excludedResources,omitempty"`
 
+	// IncludedAPIGroups is a slice of API group names to include
+	// in the backup. Entries may contain glob wildcards such as
+	// "*.istio.io", and the core API group is referred to as "core".
+	// If empty, all API groups are included.
+	// +optional
+	// +nullable
+	IncludedAPIGroups []string `json:"includedAPIGroups,omitempty"`
+
+	// ExcludedAPIGroups is a slice of API group names that are not
+	// included in the backup. Entries may contain glob wildcards.
+	// +optional
+	// +nullable
+	ExcludedAPIGroups []string `json:"excludedAPIGroups,omitempty"`
+
 	// LabelSelector is a metav1.LabelSelector to filter with
 	// when adding individual objects to the backup. If empty
 	// or nil, all objects are included. Optional.
@@ -65,6 +81,26 @@ type BackupSpec struct {
 	// +optional
 	TTL metav1.Duration `json:"ttl,omitempty"`
 
+	// SnapshotTTL is a time.Duration-parseable string describing how long
+	// the Backup's volume snapshots should be retained for, if less than
+	// the Backup's TTL. Once it elapses, the volume snapshots are deleted
+	// while the rest of the Backup is retained.
+	// +optional
+	// +nullable
+	SnapshotTTL *metav1.Duration `json:"snapshotTTL,omitempty"`
+
+	// SnapshotTags is a map of tags to add to the Backup's volume snapshots
+	// in their provider, along with the Backup's labels. The tags that
+	// Velero adds to identify each snapshot's Backup, schedule, persistent
+	// volume and claim take precedence over them.
+	// +optional
+	SnapshotTags map[string]string `json:"snapshotTags,omitempty"`
+
+	// SnapshotVerification specifies whether the Backup's volume snapshots
+	// are verified before the Backup is completed. Defaults to None.
+	// +optional
+	SnapshotVerification SnapshotVerificationMode `json:"snapshotVerification,omitempty"`
+
 	// IncludeClusterResources specifies whether cluster-scoped resources
 	// should be included for consideration in the backup.
 	// +optional
@@ -83,12 +119,79 @@ type BackupSpec struct {
 	// +optional
 	VolumeSnapshotLocations []string `json:"volumeSnapshotLocations,omitempty"`
 
+	// ReplicationLocations is a list containing names of BackupStorageLocations, other than
+	// the backup's storage location, that the backup is copied to once it's completed.
+	// +optional
+	// +nullable
+	ReplicationLocations []string `json:"replicationLocations,omitempty"`
+
 	// DefaultVolumesToRestic specifies whether restic should be used to take a
-	// backup of all pod volumes by default.
+	// backup of all pod volumes by default. Deprecated, use DefaultVolumesToFsBackup
+	// instead, which takes precedence when both are set.
 	// +optional
 	// + nullable
 	DefaultVolumesToRestic *bool `json:"defaultVolumesToRestic,omitempty"`
 
+	// DefaultVolumesToFsBackup specifies whether pod volume file system backup,
+	// with the backup's uploader, should be used to take a backup of all pod
+	// volumes by default. Pods opt volumes out with the
+	// backup.velero.io/backup-volumes-excludes annotation.
+	// +optional
+	// +nullable
+	DefaultVolumesToFsBackup *bool `json:"defaultVolumesToFsBackup,omitempty"`
+
+	// UnmountedVolumesToRestic specifies whether restic should be used to take a
+	// backup of persistent volume claims that no pod mounts, by mounting each of them
+	// in a short-lived pod for the duration of its backup.
+	// +optional
+	// +nullable
+	UnmountedVolumesToRestic *bool `json:"unmountedVolumesToRestic,omitempty"`
+
+	// SkipUnchangedVolumes specifies whether the pod volumes backed up with restic
+	// that haven't changed since the previous backup of the same schedule should
+	// reuse that backup's snapshot instead of being backed up again. A volume's
+	// changes are detected from the names, sizes, modes and modification times of
+	// its files. It only applies to backups created by a schedule.
+	// +optional
+	// +nullable
+	SkipUnchangedVolumes *bool `json:"skipUnchangedVolumes,omitempty"`
+
+	// VolumePathIncludes are glob patterns, relative to the root of each
+	// volume, of the paths within the pod volumes backed up with restic
+	// that are backed up. If empty, whole volumes are backed up. A pod's
+	// annotation can choose a volume's own patterns instead.
+	// +optional
+	// +nullable
+	VolumePathIncludes []string `json:"volumePathIncludes,omitempty"`
+
+	// VolumePathExcludes are patterns of the paths within the pod volumes
+	// backed up with restic that are not backed up, in addition to the
+	// ones that a pod's annotation chooses for a volume.
+	// +optional
+	// +nullable
+	VolumePathExcludes []string `json:"volumePathExcludes,omitempty"`
+
+	// VolumePolicies choose how the volumes that match them are backed up. The first
+	// policy that matches a volume is used. A volume's claim, or a pod that mounts it,
+	// can choose a policy with an annotation, which takes precedence over these.
+	// +optional
+	// +nullable
+	VolumePolicies []VolumePolicy `json:"volumePolicies,omitempty"`
+
+	// ResourcePolicy is a reference to a ConfigMap in the Velero namespace containing
+	// volume policies that are shared by backups. They're used after the backup's own
+	// VolumePolicies.
+	// +optional
+	// +nullable
+	ResourcePolicy *v1.TypedLocalObjectReference `json:"resourcePolicy,omitempty"`
+
+	// EphemeralVolumePolicy chooses whether the data of pods' ephemeral volumes, which
+	// don't outlive their pods, is backed up with restic. Ephemeral volumes whose kind
+	// it doesn't choose for are backed up like other pod volumes.
+	// +optional
+	// +nullable
+	EphemeralVolumePolicy *EphemeralVolumePolicy `json:"ephemeralVolumePolicy,omitempty"`
+
 	// OrderedResources specifies the backup order of resources of specific Kind.
 	// The map key is the Kind name and value is a list of resource names separeted by commas.
 	// Each resource name has format "namespace/resourcename".  For cluster resources, simply use "resourcename".
@@ -97,6 +200,115 @@ type BackupSpec struct {
 	OrderedResources map[string]string `json:"orderedResources,omitempty"`
 }
 
+// SnapshotVerificationMode is how a Backup's volume snapshots are verified.
+// +kubebuilder:validation:Enum=None;Ready;TestRestore
+type SnapshotVerificationMode string
+
+const (
+	// SnapshotVerificationNone means that volume snapshots aren't verified.
+	SnapshotVerificationNone SnapshotVerificationMode = "None"
+
+	// SnapshotVerificationReady means that each volume snapshot is checked to
+	// exist and be ready to restore from in its provider.
+	SnapshotVerificationReady SnapshotVerificationMode = "Ready"
+
+	// SnapshotVerificationTestRestore means that, in addition to being checked
+	// for readiness, a test volume is created from each volume snapshot and
+	// then deleted.
+	SnapshotVerificationTestRestore SnapshotVerificationMode = "TestRestore"
+)
+
+// VolumePolicyAction is how a volume is backed up.
+// +kubebuilder:validation:Enum=Snapshot;Restic;Skip
+type VolumePolicyAction string
+
+const (
+	// VolumePolicyActionSnapshot means the volume is snapshotted, with a volume
+	// snapshotter or CSI, and not backed up with restic.
+	VolumePolicyActionSnapshot VolumePolicyAction = "Snapshot"
+
+	// VolumePolicyActionRestic means the volume's data is backed up with restic from
+	// a pod that mounts it, and the volume isn't snapshotted.
+	VolumePolicyActionRestic VolumePolicyAction = "Restic"
+
+	// VolumePolicyActionSkip means the volume's data isn't backed up at all.
+	VolumePolicyActionSkip VolumePolicyAction = "Skip"
+)
+
+// EphemeralVolumeAction is whether the data of a kind of ephemeral pod volume is backed up.
+// +kubebuilder:validation:Enum=Include;Exclude;IncludeIfAnnotated
+type EphemeralVolumeAction string
+
+const (
+	// EphemeralVolumeActionInclude means the volumes are backed up with restic, even if
+	// the backup only backs up the volumes that pods' annotations choose. A pod can still
+	// exclude a volume with its annotations.
+	EphemeralVolumeActionInclude EphemeralVolumeAction = "Include"
+
+	// EphemeralVolumeActionExclude means the volumes aren't backed up, even if pods'
+	// annotations choose them.
+	EphemeralVolumeActionExclude EphemeralVolumeAction = "Exclude"
+
+	// EphemeralVolumeActionIncludeIfAnnotated means the volumes are only backed up if
+	// pods' annotations choose them, even if the backup backs up all pod volumes by
+	// default.
+	EphemeralVolumeActionIncludeIfAnnotated EphemeralVolumeAction = "IncludeIfAnnotated"
+)
+
+// EphemeralVolumePolicy chooses whether the data of each kind of ephemeral pod volume is
+// backed up.
+type EphemeralVolumePolicy struct {
+	// EmptyDir is the action for emptyDir volumes.
+	// +optional
+	EmptyDir EphemeralVolumeAction `json:"emptyDir,omitempty"`
+
+	// CSI is the action for inline CSI volumes, which are provisioned for a pod by
+	// their driver and deleted with it.
+	// +optional
+	CSI EphemeralVolumeAction `json:"csi,omitempty"`
+
+	// Projected is the action for projected volumes, such as the volumes of service
+	// account tokens. Their contents come from other resources, which are backed up
+	// themselves.
+	// +optional
+	Projected EphemeralVolumeAction `json:"projected,omitempty"`
+}
+
+// VolumePolicy chooses how the persistent volumes that match it are backed up.
+type VolumePolicy struct {
+	// StorageClasses is a list of storage class names that the policy applies to. If
+	// empty, it applies to volumes of any storage class.
+	// +optional
+	// +nullable
+	StorageClasses []string `json:"storageClasses,omitempty"`
+
+	// VolumeTypes is a list of persistent volume sources that the policy applies to,
+	// named like the fields of a persistent volume's spec, e.g. nfs, csi or
+	// awsElasticBlockStore. If empty, it applies to volumes of any type.
+	// +optional
+	// +nullable
+	VolumeTypes []string `json:"volumeTypes,omitempty"`
+
+	// Drivers is a list of CSI driver names that the policy applies to. If empty, it
+	// applies to volumes provisioned by any driver, or not provisioned by CSI.
+	// +optional
+	// +nullable
+	Drivers []string `json:"drivers,omitempty"`
+
+	// MinCapacity is the smallest capacity of the volumes that the policy applies to.
+	// +optional
+	// +nullable
+	MinCapacity *resource.Quantity `json:"minCapacity,omitempty"`
+
+	// MaxCapacity is the largest capacity of the volumes that the policy applies to.
+	// +optional
+	// +nullable
+	MaxCapacity *resource.Quantity `json:"maxCapacity,omitempty"`
+
+	// Action is how the volumes that match the policy are backed up.
+	Action VolumePolicyAction `json:"action"`
+}
+
 // BackupHooks contains custom behaviors that should be executed at different phases of the backup.
 type BackupHooks struct {
 	// Resources are hooks that should be executed when backing up individual instances of a resource.
@@ -147,6 +359,54 @@ type BackupResourceHookSpec struct {
 	// These are executed after all "additional items" from item actions are processed.
 	// +optional
 	PostHooks []BackupResourceHook `json:"post,omitempty"`
+
+	// Template enables a built-in pair of pre and post hooks that quiesce a known application
+	// while the item is backed up. The template's pre hook is executed after PreHooks, and its
+	// post hook before PostHooks.
+	// +optional
+	// +nullable
+	Template *HookTemplate `json:"template,omitempty"`
+}
+
+// HookTemplateName is the name of a built-in hook template.
+// +kubebuilder:validation:Enum=fsfreeze;mysql;postgresql
+type HookTemplateName string
+
+const (
+	// HookTemplateFSFreeze freezes a file system with fsfreeze, and unfreezes it afterwards.
+	HookTemplateFSFreeze HookTemplateName = "fsfreeze"
+
+	// HookTemplateMySQL holds a global read lock on a MySQL server with
+	// FLUSH TABLES WITH READ LOCK, and releases it afterwards.
+	HookTemplateMySQL HookTemplateName = "mysql"
+
+	// HookTemplatePostgreSQL starts a backup of a PostgreSQL server with pg_start_backup,
+	// and stops it afterwards with pg_stop_backup.
+	HookTemplatePostgreSQL HookTemplateName = "postgresql"
+)
+
+// HookTemplate is a built-in pair of pre and post exec hooks that quiesce a known application.
+type HookTemplate struct {
+	// Name is the name of the hook template.
+	Name HookTemplateName `json:"name"`
+
+	// Container is the container in the pod where the hooks' commands should be executed. If
+	// not specified, the pod's first container is used.
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// Path is the mount path of the file system that the fsfreeze template freezes.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// OnError specifies how Velero should behave if it encounters an error executing the hooks.
+	// +optional
+	OnError HookErrorMode `json:"onError,omitempty"`
+
+	// Timeout defines the maximum amount of time Velero should wait for each of the hooks to
+	// complete before considering the execution a failure.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
 }
 
 // BackupResourceHook defines a hook for a resource.
@@ -192,7 +452,7 @@ const (
 
 // BackupPhase is a string representation of the lifecycle phase
 // of a Velero backup.
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed;Deleting
+// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForPluginOperations;Completed;PartiallyFailed;Failed;Deleting
 type BackupPhase string
 
 const (
@@ -207,6 +467,11 @@ const (
 	// BackupPhaseInProgress means the backup is currently executing.
 	BackupPhaseInProgress BackupPhase = "InProgress"
 
+	// BackupPhaseWaitingForPluginOperations means the backup's contents have been
+	// uploaded, but some of its item operations, like volume snapshots that are still
+	// being uploaded, haven't finished yet.
+	BackupPhaseWaitingForPluginOperations BackupPhase = "WaitingForPluginOperations"
+
 	// BackupPhaseCompleted means the backup has run successfully without
 	// errors.
 	BackupPhaseCompleted BackupPhase = "Completed"
@@ -239,6 +504,12 @@ type BackupStatus struct {
 	// +nullable
 	Expiration *metav1.Time `json:"expiration,omitempty"`
 
+	// SnapshotExpiration is when this Backup's volume snapshots are
+	// eligible for garbage-collection, if before the Backup's expiration.
+	// +optional
+	// +nullable
+	SnapshotExpiration *metav1.Time `json:"snapshotExpiration,omitempty"`
+
 	// Phase is the current state of the Backup.
 	// +optional
 	Phase BackupPhase `json:"phase,omitempty"`
@@ -275,6 +546,22 @@ type BackupStatus struct {
 	// +optional
 	VolumeSnapshotsCompleted int `json:"volumeSnapshotsCompleted,omitempty"`
 
+	// VolumeSnapshotsVerified is the total number of volume snapshots
+	// for this backup that were verified by their volume snapshotter.
+	// +optional
+	VolumeSnapshotsVerified int `json:"volumeSnapshotsVerified,omitempty"`
+
+	// VolumeSnapshotBytes is the total number of bytes of storage that this
+	// backup's completed volume snapshots use in their providers, for the
+	// snapshots whose volume snapshotters report their sizes.
+	// +optional
+	VolumeSnapshotBytes int64 `json:"volumeSnapshotBytes,omitempty"`
+
+	// VolumeSnapshotsDeleted indicates whether this Backup's volume
+	// snapshots were deleted because their SnapshotTTL elapsed.
+	// +optional
+	VolumeSnapshotsDeleted bool `json:"volumeSnapshotsDeleted,omitempty"`
+
 	// Warnings is a count of all warning messages that were generated during
 	// execution of the backup. The actual warnings are in the backup's log
 	// file in object storage.
@@ -287,12 +574,85 @@ type BackupStatus struct {
 	// +optional
 	Errors int `json:"errors,omitempty"`
 
+	// ItemOperationsAttempted is the total number of item operations, like volume
+	// snapshots that are uploaded after the backup's contents, that were started
+	// for this backup.
+	// +optional
+	ItemOperationsAttempted int `json:"itemOperationsAttempted,omitempty"`
+
+	// ItemOperationsCompleted is the total number of this backup's item operations
+	// that completed successfully.
+	// +optional
+	ItemOperationsCompleted int `json:"itemOperationsCompleted,omitempty"`
+
+	// ItemOperationsFailed is the total number of this backup's item operations
+	// that failed.
+	// +optional
+	ItemOperationsFailed int `json:"itemOperationsFailed,omitempty"`
+
 	// Progress contains information about the backup's execution progress. Note
 	// that this information is best-effort only -- if Velero fails to update it
 	// during a backup for any reason, it may be inaccurate/stale.
 	// +optional
 	// +nullable
 	Progress *BackupProgress `json:"progress,omitempty"`
+
+	// ServerSideEncryption is the server-side encryption that the backup's storage
+	// location was configured with when the backup was uploaded.
+	// +optional
+	// +nullable
+	ServerSideEncryption *ServerSideEncryption `json:"serverSideEncryption,omitempty"`
+
+	// ObjectLockRetainUntil is the time until which the backup's files are locked
+	// in object storage, and so the backup can't be deleted.
+	// +optional
+	// +nullable
+	ObjectLockRetainUntil *metav1.Time `json:"objectLockRetainUntil,omitempty"`
+
+	// StoredBytes is the total size of the backup's files in object storage.
+	// +optional
+	StoredBytes int64 `json:"storedBytes,omitempty"`
+
+	// Replications is the status of the backup's copies in its replication locations.
+	// +optional
+	// +nullable
+	Replications []BackupReplication `json:"replications,omitempty"`
+}
+
+// BackupReplicationPhase is a string representation of the lifecycle phase
+// of a backup's copy in one of its replication locations.
+// +kubebuilder:validation:Enum=InProgress;Completed;Failed
+type BackupReplicationPhase string
+
+const (
+	// BackupReplicationPhaseInProgress means the backup is being copied.
+	BackupReplicationPhaseInProgress BackupReplicationPhase = "InProgress"
+
+	// BackupReplicationPhaseCompleted means all of the backup's files have
+	// been copied.
+	BackupReplicationPhaseCompleted BackupReplicationPhase = "Completed"
+
+	// BackupReplicationPhaseFailed means the backup couldn't be copied.
+	BackupReplicationPhaseFailed BackupReplicationPhase = "Failed"
+)
+
+// BackupReplication is the status of a backup's copy in one of its replication locations.
+type BackupReplication struct {
+	// Location is the name of the BackupStorageLocation that the backup is copied to.
+	Location string `json:"location"`
+
+	// Phase is the current state of the copy.
+	// +optional
+	Phase BackupReplicationPhase `json:"phase,omitempty"`
+
+	// CompletionTimestamp records the time the copy was completed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Message is a human-readable message indicating why the copy failed.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // BackupProgress stores information about the progress of a Backup's execution.
@@ -308,6 +668,16 @@ type BackupProgress struct {
 	// backup tarball so far.
 	// +optional
 	ItemsBackedUp int `json:"itemsBackedUp,omitempty"`
+
+	// PodVolumeTotalBytes is the total number of bytes of the pod volumes
+	// that are being backed up with restic, as far as they've been scanned.
+	// +optional
+	PodVolumeTotalBytes int64 `json:"podVolumeTotalBytes,omitempty"`
+
+	// PodVolumeBytesDone is the number of bytes of the pod volumes that have
+	// been backed up with restic so far.
+	// +optional
+	PodVolumeBytesDone int64 `json:"podVolumeBytesDone,omitempty"`
 }
 
 // +genclient
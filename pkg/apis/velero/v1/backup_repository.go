@@ -0,0 +1,309 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupRepositorySpec is the specification for a BackupRepository.
+type BackupRepositorySpec struct {
+	// VolumeNamespace is the namespace this repository contains pod
+	// volume backups for.
+	VolumeNamespace string `json:"volumeNamespace"`
+
+	// BackupStorageLocation is the name of the BackupStorageLocation
+	// that should contain this repository.
+	BackupStorageLocation string `json:"backupStorageLocation"`
+
+	// RepoIdentifier is the full string that the repository's uploader
+	// identifies this repository with.
+	RepoIdentifier string `json:"repoIdentifier"`
+
+	// MaintenanceFrequency is how often maintenance should be run.
+	MaintenanceFrequency metav1.Duration `json:"maintenanceFrequency"`
+
+	// UploaderType is the uploader whose repository this is, which backs
+	// up pod volumes to it and runs its maintenance. If empty, it's a
+	// restic repository.
+	// +optional
+	UploaderType UploaderType `json:"uploaderType,omitempty"`
+
+	// CheckFrequency is how often the repository's data should be checked
+	// for errors. If zero, it's only checked when requested.
+	// +optional
+	CheckFrequency metav1.Duration `json:"checkFrequency,omitempty"`
+
+	// MaintenanceWindow is the time of day that scheduled maintenance is
+	// started in. If not set, scheduled maintenance is started whenever
+	// it's due.
+	// +optional
+	// +nullable
+	MaintenanceWindow *BackupRepositoryMaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// MaintenanceRequest requests maintenance to be run now, regardless of
+	// the maintenance window and of when maintenance was last run.
+	// +optional
+	// +nullable
+	MaintenanceRequest *BackupRepositoryMaintenanceRequest `json:"maintenanceRequest,omitempty"`
+
+	// CacheSizeLimit is the maximum size of the repository's local cache
+	// on each node. If not set, the restic server's cache size limit is
+	// used.
+	// +optional
+	// +nullable
+	CacheSizeLimit *resource.Quantity `json:"cacheSizeLimit,omitempty"`
+
+	// UploadRateLimit is the maximum rate, in bytes per second, that the
+	// repository's data is uploaded at by each pod volume backup, restore
+	// or maintenance operation. If the restic server of a node has a lower
+	// limit, that limit is used.
+	// +optional
+	// +nullable
+	UploadRateLimit *resource.Quantity `json:"uploadRateLimit,omitempty"`
+
+	// DownloadRateLimit is the maximum rate, in bytes per second, that the
+	// repository's data is downloaded at by each pod volume backup, restore
+	// or maintenance operation. If the restic server of a node has a lower
+	// limit, that limit is used.
+	// +optional
+	// +nullable
+	DownloadRateLimit *resource.Quantity `json:"downloadRateLimit,omitempty"`
+
+	// MigrationRequest requests the repository to be copied to another
+	// backup storage location, which it's stored in from then on.
+	// +optional
+	// +nullable
+	MigrationRequest *BackupRepositoryMigrationRequest `json:"migrationRequest,omitempty"`
+}
+
+// BackupRepositoryMaintenanceOperation is an operation that's run to
+// maintain a BackupRepository.
+// +kubebuilder:validation:Enum=Prune;Check;RotateKey
+type BackupRepositoryMaintenanceOperation string
+
+const (
+	// BackupRepositoryMaintenanceOperationPrune deletes data that's no
+	// longer used by any snapshot from the repository.
+	BackupRepositoryMaintenanceOperationPrune BackupRepositoryMaintenanceOperation = "Prune"
+
+	// BackupRepositoryMaintenanceOperationCheck checks the repository's
+	// data for errors.
+	BackupRepositoryMaintenanceOperationCheck BackupRepositoryMaintenanceOperation = "Check"
+
+	// BackupRepositoryMaintenanceOperationRotateKey replaces the repository's
+	// encryption key with a new key of its own, which is wrapped by the
+	// Velero server's key provider.
+	BackupRepositoryMaintenanceOperationRotateKey BackupRepositoryMaintenanceOperation = "RotateKey"
+)
+
+// BackupRepositoryMaintenanceWindow is a daily window of time.
+type BackupRepositoryMaintenanceWindow struct {
+	// StartTime is the time of day that the window starts at, in UTC and
+	// in HH:MM format.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	StartTime string `json:"startTime"`
+
+	// Duration is how long the window is open for.
+	Duration metav1.Duration `json:"duration"`
+}
+
+// BackupRepositoryMaintenanceRequest is a request to run maintenance on a
+// BackupRepository.
+type BackupRepositoryMaintenanceRequest struct {
+	// Name identifies the request. A request is run once, so a new request
+	// needs a name other than the last one's, such as the current time.
+	Name string `json:"name"`
+
+	// Operations are the maintenance operations to run. If empty, the
+	// repository is pruned and checked.
+	// +optional
+	// +nullable
+	Operations []BackupRepositoryMaintenanceOperation `json:"operations,omitempty"`
+}
+
+// BackupRepositoryMigrationRequest is a request to migrate a BackupRepository
+// to another backup storage location.
+type BackupRepositoryMigrationRequest struct {
+	// Name identifies the request. A request is run once, so a new request
+	// needs a name other than the last one's, such as the current time.
+	Name string `json:"name"`
+
+	// BackupStorageLocation is the name of the BackupStorageLocation that
+	// the repository is migrated to.
+	BackupStorageLocation string `json:"backupStorageLocation"`
+}
+
+// BackupRepositoryPhase represents the lifecycle phase of a BackupRepository.
+// +kubebuilder:validation:Enum=New;Ready;NotReady;Migrating
+type BackupRepositoryPhase string
+
+const (
+	BackupRepositoryPhaseNew       BackupRepositoryPhase = "New"
+	BackupRepositoryPhaseReady     BackupRepositoryPhase = "Ready"
+	BackupRepositoryPhaseNotReady  BackupRepositoryPhase = "NotReady"
+	BackupRepositoryPhaseMigrating BackupRepositoryPhase = "Migrating"
+)
+
+// BackupRepositoryStatus is the current status of a BackupRepository.
+type BackupRepositoryStatus struct {
+	// Phase is the current state of the BackupRepository.
+	// +optional
+	Phase BackupRepositoryPhase `json:"phase,omitempty"`
+
+	// Message is a message about the current status of the BackupRepository.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastMaintenanceTime is the last time maintenance was run.
+	// +optional
+	// +nullable
+	LastMaintenanceTime *metav1.Time `json:"lastMaintenanceTime,omitempty"`
+
+	// LastCheckTime is the last time the repository's data was checked.
+	// +optional
+	// +nullable
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+
+	// LastMaintenanceRequest is the name of the last maintenance request
+	// that was run.
+	// +optional
+	LastMaintenanceRequest string `json:"lastMaintenanceRequest,omitempty"`
+
+	// MaintenanceResults are the results of the last run of each
+	// maintenance operation.
+	// +optional
+	// +nullable
+	MaintenanceResults []BackupRepositoryMaintenanceResult `json:"maintenanceResults,omitempty"`
+
+	// SizeBytes is the size of the repository's data in its backup storage
+	// location, as of LastSizeTime.
+	// +optional
+	SizeBytes int64 `json:"sizeBytes,omitempty"`
+
+	// LastSizeTime is the last time the repository's size was measured,
+	// which is after it's initialized and after maintenance.
+	// +optional
+	// +nullable
+	LastSizeTime *metav1.Time `json:"lastSizeTime,omitempty"`
+
+	// LastMigrationRequest is the name of the last migration request that
+	// was run.
+	// +optional
+	LastMigrationRequest string `json:"lastMigrationRequest,omitempty"`
+
+	// MigrationResult is the result of the last migration request.
+	// +optional
+	// +nullable
+	MigrationResult *BackupRepositoryMigrationResult `json:"migrationResult,omitempty"`
+
+	// FormerBackupStorageLocations are the backup storage locations that the
+	// repository was migrated from. The snapshots of backups in these locations
+	// are in this repository.
+	// +optional
+	// +nullable
+	FormerBackupStorageLocations []string `json:"formerBackupStorageLocations,omitempty"`
+}
+
+// BackupRepositoryMaintenanceResult is the result of running a maintenance
+// operation on a BackupRepository.
+type BackupRepositoryMaintenanceResult struct {
+	// Operation is the maintenance operation that was run.
+	Operation BackupRepositoryMaintenanceOperation `json:"operation"`
+
+	// StartTimestamp is when the operation was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp is when the operation completed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Succeeded is whether the operation succeeded.
+	Succeeded bool `json:"succeeded"`
+
+	// Message is the error that the operation failed with, if any.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// BackupRepositoryMigrationResult is the result of migrating a BackupRepository
+// to another backup storage location.
+type BackupRepositoryMigrationResult struct {
+	// SourceBackupStorageLocation is the backup storage location that the
+	// repository was migrated from.
+	SourceBackupStorageLocation string `json:"sourceBackupStorageLocation"`
+
+	// TargetBackupStorageLocation is the backup storage location that the
+	// repository was migrated to.
+	TargetBackupStorageLocation string `json:"targetBackupStorageLocation"`
+
+	// StartTimestamp is when the migration was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp is when the migration completed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Succeeded is whether the migration succeeded.
+	Succeeded bool `json:"succeeded"`
+
+	// ObjectsCopied is the number of the repository's objects that were
+	// copied to the target backup storage location.
+	// +optional
+	ObjectsCopied int `json:"objectsCopied,omitempty"`
+
+	// Message is the error that the migration failed with, if any.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupRepository is a repository that the pod volume backups of a
+// namespace are stored in, in a backup storage location, by an uploader.
+type BackupRepository struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec BackupRepositorySpec `json:"spec,omitempty"`
+
+	// +optional
+	Status BackupRepositoryStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupRepositoryList is a list of BackupRepositories.
+type BackupRepositoryList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []BackupRepository `json:"items"`
+}
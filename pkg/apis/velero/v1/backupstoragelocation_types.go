@@ -17,6 +17,8 @@ limitations under the License.
 package v1
 
 import (
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -30,8 +32,33 @@ type BackupStorageLocationSpec struct {
 	// +optional
 	Config map[string]string `json:"config,omitempty"`
 
+	// Credential contains the credential information intended to be used with this location.
+	// The secret must be in the Velero server's namespace. If it's not set, the object store
+	// uses the credentials the Velero server was installed with.
+	// +optional
+	// +nullable
+	Credential *corev1api.SecretKeySelector `json:"credential,omitempty"`
+
+	// Identity configures the location to authenticate with the cloud identity of the
+	// Velero server's pod instead of a credential. It can't be set along with Credential.
+	// +optional
+	// +nullable
+	Identity *AmbientIdentity `json:"identity,omitempty"`
+
+	// EgressProxy is the HTTP(S) proxy that the location's plugin makes requests through,
+	// instead of the proxy settings of the Velero server's environment.
+	// +optional
+	// +nullable
+	EgressProxy *EgressProxy `json:"egressProxy,omitempty"`
+
 	StorageType `json:",inline"`
 
+	// Default indicates this location is the default backup storage location, which
+	// backups that don't specify a storage location are stored in. Only one location
+	// in the Velero server's namespace can be the default.
+	// +optional
+	Default bool `json:"default,omitempty"`
+
 	// AccessMode defines the permissions for the backup storage location.
 	// +optional
 	AccessMode BackupStorageLocationAccessMode `json:"accessMode,omitempty"`
@@ -45,6 +72,11 @@ type BackupStorageLocationSpec struct {
 	// +optional
 	// +nullable
 	ValidationFrequency *metav1.Duration `json:"validationFrequency,omitempty"`
+
+	// UploaderType is the uploader that backs up pod volumes to this location. If empty,
+	// the Velero server's default uploader type is used.
+	// +optional
+	UploaderType UploaderType `json:"uploaderType,omitempty"`
 }
 
 // BackupStorageLocationStatus defines the observed state of BackupStorageLocation
@@ -65,6 +97,21 @@ type BackupStorageLocationStatus struct {
 	// +nullable
 	LastValidationTime *metav1.Time `json:"lastValidationTime,omitempty"`
 
+	// Message is a message about the backup storage location's status,
+	// such as the reason that it's unavailable.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Conditions are the latest observations of the backup storage location's state.
+	// +optional
+	// +nullable
+	Conditions []BackupStorageLocationCondition `json:"conditions,omitempty"`
+
+	// StoredBytes is the total size of the backups in the location, as of the
+	// last time its contents were synced into the cluster.
+	// +optional
+	StoredBytes int64 `json:"storedBytes,omitempty"`
+
 	// LastSyncedRevision is the value of the `metadata/revision` file in the backup
 	// storage location the last time the BSL's contents were synced into the cluster.
 	//
@@ -135,6 +182,155 @@ type ObjectStorageLocation struct {
 	// CACert defines a CA bundle to use when verifying TLS connections to the provider.
 	// +optional
 	CACert []byte `json:"caCert,omitempty"`
+
+	// CACertRef selects a key of a secret or config map, in the location's namespace,
+	// that contains a CA bundle to use when verifying TLS connections to the provider.
+	// It can't be used together with CACert.
+	// +optional
+	// +nullable
+	CACertRef *CACertReference `json:"caCertRef,omitempty"`
+
+	// InsecureSkipTLSVerify disables verification of the provider's TLS certificate.
+	// It should only be used for testing.
+	// +optional
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+
+	// ServerSideEncryption is how the object storage service encrypts the objects that
+	// Velero stores. If not set, the bucket's default encryption is used.
+	// +optional
+	// +nullable
+	ServerSideEncryption *ServerSideEncryption `json:"serverSideEncryption,omitempty"`
+
+	// ObjectLock is the retention that the object storage service applies to the
+	// objects that Velero stores, for buckets that have object locking (WORM) enabled.
+	// If not set, no retention is applied.
+	// +optional
+	// +nullable
+	ObjectLock *ObjectLock `json:"objectLock,omitempty"`
+
+	// BandwidthLimit is the maximum rate that Velero transfers objects to and from
+	// the object storage at. If not set, transfers aren't limited.
+	// +optional
+	// +nullable
+	BandwidthLimit *BandwidthLimit `json:"bandwidthLimit,omitempty"`
+
+	// DownloadMode is how the files of backups and restores in the location are served
+	// to users. If not set, pre-signed URLs are used.
+	// +optional
+	DownloadMode DownloadMode `json:"downloadMode,omitempty"`
+
+	// DownloadURLTTL is how long the pre-signed URLs and proxied downloads of the
+	// location's files are valid for. If not set, they're valid for 10 minutes.
+	// +optional
+	// +nullable
+	DownloadURLTTL *metav1.Duration `json:"downloadURLTTL,omitempty"`
+}
+
+// DownloadMode is how the files in a backup storage location are downloaded.
+// +kubebuilder:validation:Enum=SignedURL;Proxy
+type DownloadMode string
+
+const (
+	// DownloadModeSignedURL means that files are downloaded directly from the object
+	// storage service using pre-signed URLs.
+	DownloadModeSignedURL DownloadMode = "SignedURL"
+
+	// DownloadModeProxy means that files are streamed through the Velero server, for
+	// object storage providers that can't create pre-signed URLs or that users can't reach.
+	DownloadModeProxy DownloadMode = "Proxy"
+)
+
+// BandwidthLimit specifies the maximum rates of transfers to and from object storage.
+type BandwidthLimit struct {
+	// Upload is the maximum number of bytes per second that each object is uploaded at.
+	// +optional
+	// +nullable
+	Upload *resource.Quantity `json:"upload,omitempty"`
+
+	// Download is the maximum number of bytes per second that each object is downloaded at.
+	// +optional
+	// +nullable
+	Download *resource.Quantity `json:"download,omitempty"`
+}
+
+// CACertReference selects a key of a secret or config map that contains a CA bundle.
+// Exactly one of SecretKeyRef and ConfigMapKeyRef must be set.
+type CACertReference struct {
+	// SecretKeyRef selects a key of a secret.
+	// +optional
+	// +nullable
+	SecretKeyRef *corev1api.SecretKeySelector `json:"secretKeyRef,omitempty"`
+
+	// ConfigMapKeyRef selects a key of a config map.
+	// +optional
+	// +nullable
+	ConfigMapKeyRef *corev1api.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+}
+
+// ServerSideEncryption specifies how an object storage service encrypts objects.
+type ServerSideEncryption struct {
+	// Algorithm is the server-side encryption algorithm, as named by the object
+	// storage provider, e.g. "AES256" or "aws:kms" for AWS S3.
+	Algorithm string `json:"algorithm"`
+
+	// KMSKeyID is the ID of the customer-managed key in the provider's key
+	// management service that objects are encrypted with.
+	// +optional
+	KMSKeyID string `json:"kmsKeyId,omitempty"`
+}
+
+// ObjectLockMode is the retention mode of locked objects.
+// +kubebuilder:validation:Enum=GOVERNANCE;COMPLIANCE
+type ObjectLockMode string
+
+const (
+	// ObjectLockModeGovernance means that locked objects can't be deleted, except
+	// by users with special permissions.
+	ObjectLockModeGovernance ObjectLockMode = "GOVERNANCE"
+
+	// ObjectLockModeCompliance means that locked objects can't be deleted by
+	// any user until their retention period expires.
+	ObjectLockModeCompliance ObjectLockMode = "COMPLIANCE"
+)
+
+// ObjectLock specifies how long an object storage service keeps objects from
+// being deleted or overwritten.
+type ObjectLock struct {
+	// Mode is the retention mode of the objects.
+	Mode ObjectLockMode `json:"mode"`
+
+	// RetentionPeriod is how long objects are locked for after they're uploaded.
+	RetentionPeriod metav1.Duration `json:"retentionPeriod"`
+}
+
+// AmbientIdentity specifies that a location authenticates with the cloud identity that
+// the platform gives the Velero server's pod, such as AWS IAM roles for service accounts,
+// GCP Workload Identity or Azure pod identity, rather than with a static credential.
+type AmbientIdentity struct {
+	// Role is the cloud identity that the location's requests are made as, which the pod's
+	// identity must be allowed to assume or impersonate: the ARN of an AWS IAM role, the
+	// email of a GCP service account, or the client ID of an Azure managed identity. If
+	// it's not set, the pod's own identity is used.
+	// +optional
+	Role string `json:"role,omitempty"`
+}
+
+// EgressProxy specifies the HTTP(S) proxy that a location's plugin makes requests through.
+// When it's set, it replaces the proxy settings of the Velero server's environment for the
+// location's plugin, so a location that sets no proxy URLs bypasses the server's proxy.
+type EgressProxy struct {
+	// HTTPProxy is the URL of the proxy for HTTP requests.
+	// +optional
+	HTTPProxy string `json:"httpProxy,omitempty"`
+
+	// HTTPSProxy is the URL of the proxy for HTTPS requests.
+	// +optional
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+
+	// NoProxy is a comma-separated list of hosts, domains and IP ranges that are
+	// reached without a proxy.
+	// +optional
+	NoProxy string `json:"noProxy,omitempty"`
 }
 
 // BackupStorageLocationPhase is the lifecycle phase of a Velero BackupStorageLocation.
@@ -150,6 +346,52 @@ const (
 	BackupStorageLocationPhaseUnavailable BackupStorageLocationPhase = "Unavailable"
 )
 
+// BackupStorageLocationConditionType is a type of a BackupStorageLocation's condition.
+type BackupStorageLocationConditionType string
+
+const (
+	// BackupStorageLocationConditionAvailable is true if the location's storage was valid
+	// the last time that it was validated.
+	BackupStorageLocationConditionAvailable BackupStorageLocationConditionType = "Available"
+)
+
+const (
+	// BackupStorageLocationReasonValidationSucceeded is the reason that a location is
+	// available when its storage was valid.
+	BackupStorageLocationReasonValidationSucceeded = "ValidationSucceeded"
+
+	// BackupStorageLocationReasonValidationFailed is the reason that a location is
+	// unavailable when its storage wasn't valid.
+	BackupStorageLocationReasonValidationFailed = "ValidationFailed"
+
+	// BackupStorageLocationReasonBackupStoreError is the reason that a location is
+	// unavailable when its backup store couldn't be set up, for example because of
+	// its credential or plugin.
+	BackupStorageLocationReasonBackupStoreError = "BackupStoreError"
+)
+
+// BackupStorageLocationCondition describes the state of a BackupStorageLocation at a point in time.
+type BackupStorageLocationCondition struct {
+	// Type is the type of the condition.
+	Type BackupStorageLocationConditionType `json:"type"`
+
+	// Status is the status of the condition, one of True, False or Unknown.
+	Status corev1api.ConditionStatus `json:"status"`
+
+	// LastTransitionTime is the last time the condition's status changed.
+	// +optional
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// Reason is a machine-readable reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human-readable message about the condition's last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // BackupStorageLocationAccessMode represents the permissions for a BackupStorageLocation.
 // +kubebuilder:validation:Enum=ReadOnly;ReadWrite
 type BackupStorageLocationAccessMode string
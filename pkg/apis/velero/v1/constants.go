@@ -40,6 +40,11 @@ const (
 	// CSIFeatureFlag is the feature flag string that defines whether or not CSI features are being used.
 	CSIFeatureFlag = "EnableCSI"
 
+	// NativeCSIFeatureFlag is the feature flag string that defines whether or not Velero's
+	// built-in actions snapshot CSI volumes, instead of a CSI plugin. It requires the
+	// EnableCSI feature flag.
+	NativeCSIFeatureFlag = "EnableNativeCSI"
+
 	// PreferredVersionDir is the suffix name of the directory containing the preferred version of the API group
 	// resource within a Velero backup.
 	PreferredVersionDir = "-preferredversion"
@@ -25,7 +25,7 @@ type DownloadRequestSpec struct {
 }
 
 // DownloadTargetKind represents what type of file to download.
-// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupResourceList;RestoreLog;RestoreResults
+// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupResourceList;RestoreLog;RestoreResults;RestoreDryRunReport
 type DownloadTargetKind string
 
 const (
@@ -35,6 +35,7 @@ const (
 	DownloadTargetKindBackupResourceList    DownloadTargetKind = "BackupResourceList"
 	DownloadTargetKindRestoreLog            DownloadTargetKind = "RestoreLog"
 	DownloadTargetKindRestoreResults        DownloadTargetKind = "RestoreResults"
+	DownloadTargetKindRestoreDryRunReport   DownloadTargetKind = "RestoreDryRunReport"
 )
 
 // DownloadTarget is the specification for what kind of file to download, and the name of the
@@ -71,12 +72,32 @@ type DownloadRequestStatus struct {
 	// +optional
 	DownloadURL string `json:"downloadURL,omitempty"`
 
+	// Proxy is where the Velero server serves the target file, when the backup storage
+	// location's download mode is Proxy.
+	// +optional
+	// +nullable
+	Proxy *DownloadProxy `json:"proxy,omitempty"`
+
 	// Expiration is when this DownloadRequest expires and can be deleted by the system.
 	// +optional
 	// +nullable
 	Expiration *metav1.Time `json:"expiration,omitempty"`
 }
 
+// DownloadProxy is the pod and path that the Velero server serves a download at. It's
+// reached through the Kubernetes API server's pod proxy.
+type DownloadProxy struct {
+	// Pod is the name of the Velero server's pod.
+	Pod string `json:"pod"`
+
+	// Port is the port that the Velero server serves downloads on.
+	Port int32 `json:"port"`
+
+	// Path is the path of the download. It includes a token that's only known to
+	// users who can read the DownloadRequest.
+	Path string `json:"path"`
+}
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -62,4 +62,48 @@ const (
 	// SourceClusterK8sMajorVersionAnnotation is the label key used to identify the k8s
 	// minor version of the backup , i.e. 16
 	SourceClusterK8sMinorVersionAnnotation = "velero.io/source-cluster-k8s-minor-version"
+
+	// ValidationRequestedAnnotation is the annotation key used to request that
+	// a backup storage location be validated, regardless of its validation
+	// frequency. Its value is the RFC 3339 time that validation was requested.
+	ValidationRequestedAnnotation = "velero.io/validation-requested"
+
+	// ReplicatedFromAnnotation is the annotation key used to identify a backup
+	// that's a copy of a backup in another backup storage location. Its value is
+	// the name of the location that the backup was copied from.
+	ReplicatedFromAnnotation = "velero.io/replicated-from"
+
+	// VolumeSnapshotClassSelectorLabel is the label key used to select the CSI
+	// VolumeSnapshotClass that volumes of the class's driver are snapshotted with.
+	VolumeSnapshotClassSelectorLabel = "velero.io/csi-volumesnapshot-class"
+
+	// VolumeSnapshotAnnotation is the annotation key used to identify the CSI
+	// VolumeSnapshot that a backed up PersistentVolumeClaim is restored from.
+	VolumeSnapshotAnnotation = "velero.io/volume-snapshot-name"
+
+	// CSISnapshotHandleAnnotation is the annotation key used to record the storage
+	// provider's handle of a backed up CSI VolumeSnapshot.
+	CSISnapshotHandleAnnotation = "velero.io/csi-volumesnapshot-handle"
+
+	// CSIDriverNameAnnotation is the annotation key used to record the name of the
+	// CSI driver that took a backed up CSI VolumeSnapshot.
+	CSIDriverNameAnnotation = "velero.io/csi-driver-name"
+
+	// VolumePolicyAnnotation is the annotation key used to choose how the volume of a
+	// PersistentVolumeClaim is backed up. Its value is a VolumePolicyAction.
+	VolumePolicyAnnotation = "backup.velero.io/volume-policy"
+
+	// PodVolumePoliciesAnnotation is the annotation key used to choose how a pod's
+	// volumes are backed up. Its value is a comma-separated list of
+	// <volume name>=<VolumePolicyAction> pairs.
+	PodVolumePoliciesAnnotation = "backup.velero.io/volume-policies"
+
+	// SnapshotGroupLabel is the label key used to group the volumes of
+	// PersistentVolumeClaims in a namespace that are snapshotted together. Its
+	// value is the name of the group.
+	SnapshotGroupLabel = "velero.io/snapshot-group"
+
+	// PodSnapshotGroupAnnotation is the annotation key used to snapshot the
+	// volumes of a pod's PersistentVolumeClaims together, when its value is "true".
+	PodSnapshotGroupAnnotation = "backup.velero.io/snapshot-group"
 )
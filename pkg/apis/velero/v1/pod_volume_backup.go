@@ -44,8 +44,41 @@ type PodVolumeBackupSpec struct {
 	// volume backup as tags.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+
+	// UploaderType is the uploader that backs up the volume. If empty,
+	// restic is used.
+	// +optional
+	UploaderType UploaderType `json:"uploaderType,omitempty"`
+
+	// PathIncludes are glob patterns, relative to the root of the volume,
+	// of the paths within the volume that are backed up. If empty, the
+	// whole volume is backed up.
+	// +optional
+	// +nullable
+	PathIncludes []string `json:"pathIncludes,omitempty"`
+
+	// PathExcludes are patterns of the paths within the volume that are
+	// not backed up.
+	// +optional
+	// +nullable
+	PathExcludes []string `json:"pathExcludes,omitempty"`
+
+	// SkipIfUnchanged specifies whether the volume should reuse the snapshot
+	// of the previous pod volume backup of the same volume and schedule,
+	// instead of being backed up again, if it hasn't changed since.
+	// +optional
+	SkipIfUnchanged bool `json:"skipIfUnchanged,omitempty"`
 }
 
+// UploaderType is the tool that backs up and restores the data of pod volumes.
+// +kubebuilder:validation:Enum=restic;kopia
+type UploaderType string
+
+const (
+	UploaderTypeRestic UploaderType = "restic"
+	UploaderTypeKopia  UploaderType = "kopia"
+)
+
 // PodVolumeBackupPhase represents the lifecycle phase of a PodVolumeBackup.
 // +kubebuilder:validation:Enum=New;InProgress;Completed;Failed
 type PodVolumeBackupPhase string
@@ -75,6 +108,18 @@ type PodVolumeBackupStatus struct {
 	// +optional
 	Message string `json:"message,omitempty"`
 
+	// ChangeIndicator is a digest of the names, sizes, modes and
+	// modification times of the volume's files when it was backed up,
+	// which tells whether the volume has changed since.
+	// +optional
+	ChangeIndicator string `json:"changeIndicator,omitempty"`
+
+	// Unchanged is true if the volume hadn't changed since the previous
+	// pod volume backup of the same volume and schedule, whose snapshot
+	// was reused instead of backing it up again.
+	// +optional
+	Unchanged bool `json:"unchanged,omitempty"`
+
 	// StartTimestamp records the time a backup was started.
 	// Separate from CreationTimestamp, since that value changes
 	// on restores.
@@ -17,6 +17,7 @@ limitations under the License.
 package v1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -36,6 +37,115 @@ type ResticRepositorySpec struct {
 
 	// MaintenanceFrequency is how often maintenance should be run.
 	MaintenanceFrequency metav1.Duration `json:"maintenanceFrequency"`
+
+	// UploaderType is the uploader whose repository this is. If empty,
+	// it's a restic repository.
+	// +optional
+	UploaderType UploaderType `json:"uploaderType,omitempty"`
+
+	// CheckFrequency is how often the repository's data should be checked
+	// for errors. If zero, it's only checked when requested.
+	// +optional
+	CheckFrequency metav1.Duration `json:"checkFrequency,omitempty"`
+
+	// MaintenanceWindow is the time of day that scheduled maintenance is
+	// started in. If not set, scheduled maintenance is started whenever
+	// it's due.
+	// +optional
+	// +nullable
+	MaintenanceWindow *ResticRepositoryMaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// MaintenanceRequest requests maintenance to be run now, regardless of
+	// the maintenance window and of when maintenance was last run.
+	// +optional
+	// +nullable
+	MaintenanceRequest *ResticRepositoryMaintenanceRequest `json:"maintenanceRequest,omitempty"`
+
+	// CacheSizeLimit is the maximum size of the repository's local cache
+	// on each node. If not set, the restic server's cache size limit is
+	// used.
+	// +optional
+	// +nullable
+	CacheSizeLimit *resource.Quantity `json:"cacheSizeLimit,omitempty"`
+
+	// UploadRateLimit is the maximum rate, in bytes per second, that the
+	// repository's data is uploaded at by each pod volume backup, restore
+	// or maintenance operation. If the restic server of a node has a lower
+	// limit, that limit is used.
+	// +optional
+	// +nullable
+	UploadRateLimit *resource.Quantity `json:"uploadRateLimit,omitempty"`
+
+	// DownloadRateLimit is the maximum rate, in bytes per second, that the
+	// repository's data is downloaded at by each pod volume backup, restore
+	// or maintenance operation. If the restic server of a node has a lower
+	// limit, that limit is used.
+	// +optional
+	// +nullable
+	DownloadRateLimit *resource.Quantity `json:"downloadRateLimit,omitempty"`
+
+	// MigrationRequest requests the repository to be copied to another
+	// backup storage location, which it's stored in from then on.
+	// +optional
+	// +nullable
+	MigrationRequest *ResticRepositoryMigrationRequest `json:"migrationRequest,omitempty"`
+}
+
+// ResticRepositoryMaintenanceOperation is an operation that's run to
+// maintain a ResticRepository.
+// +kubebuilder:validation:Enum=Prune;Check;RotateKey
+type ResticRepositoryMaintenanceOperation string
+
+const (
+	// ResticRepositoryMaintenanceOperationPrune deletes data that's no
+	// longer used by any snapshot from the repository.
+	ResticRepositoryMaintenanceOperationPrune ResticRepositoryMaintenanceOperation = "Prune"
+
+	// ResticRepositoryMaintenanceOperationCheck checks the repository's
+	// data for errors.
+	ResticRepositoryMaintenanceOperationCheck ResticRepositoryMaintenanceOperation = "Check"
+
+	// ResticRepositoryMaintenanceOperationRotateKey replaces the repository's
+	// encryption key with a new key of its own, which is wrapped by the
+	// Velero server's key provider.
+	ResticRepositoryMaintenanceOperationRotateKey ResticRepositoryMaintenanceOperation = "RotateKey"
+)
+
+// ResticRepositoryMaintenanceWindow is a daily window of time.
+type ResticRepositoryMaintenanceWindow struct {
+	// StartTime is the time of day that the window starts at, in UTC and
+	// in HH:MM format.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	StartTime string `json:"startTime"`
+
+	// Duration is how long the window is open for.
+	Duration metav1.Duration `json:"duration"`
+}
+
+// ResticRepositoryMaintenanceRequest is a request to run maintenance on a
+// ResticRepository.
+type ResticRepositoryMaintenanceRequest struct {
+	// Name identifies the request. A request is run once, so a new request
+	// needs a name other than the last one's, such as the current time.
+	Name string `json:"name"`
+
+	// Operations are the maintenance operations to run. If empty, the
+	// repository is pruned and checked.
+	// +optional
+	// +nullable
+	Operations []ResticRepositoryMaintenanceOperation `json:"operations,omitempty"`
+}
+
+// ResticRepositoryMigrationRequest is a request to migrate a ResticRepository
+// to another backup storage location.
+type ResticRepositoryMigrationRequest struct {
+	// Name identifies the request. A request is run once, so a new request
+	// needs a name other than the last one's, such as the current time.
+	Name string `json:"name"`
+
+	// BackupStorageLocation is the name of the BackupStorageLocation that
+	// the repository is migrated to.
+	BackupStorageLocation string `json:"backupStorageLocation"`
 }
 
 // ResticRepositoryPhase represents the lifecycle phase of a ResticRepository.
@@ -62,11 +172,105 @@ type ResticRepositoryStatus struct {
 	// +optional
 	// +nullable
 	LastMaintenanceTime *metav1.Time `json:"lastMaintenanceTime,omitempty"`
+
+	// LastCheckTime is the last time the repository's data was checked.
+	// +optional
+	// +nullable
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+
+	// LastMaintenanceRequest is the name of the last maintenance request
+	// that was run.
+	// +optional
+	LastMaintenanceRequest string `json:"lastMaintenanceRequest,omitempty"`
+
+	// MaintenanceResults are the results of the last run of each
+	// maintenance operation.
+	// +optional
+	// +nullable
+	MaintenanceResults []ResticRepositoryMaintenanceResult `json:"maintenanceResults,omitempty"`
+
+	// LastMigrationRequest is the name of the last migration request that
+	// was run.
+	// +optional
+	LastMigrationRequest string `json:"lastMigrationRequest,omitempty"`
+
+	// MigrationResult is the result of the last migration request.
+	// +optional
+	// +nullable
+	MigrationResult *ResticRepositoryMigrationResult `json:"migrationResult,omitempty"`
+
+	// FormerBackupStorageLocations are the backup storage locations that the
+	// repository was migrated from. The snapshots of backups in these locations
+	// are in this repository.
+	// +optional
+	// +nullable
+	FormerBackupStorageLocations []string `json:"formerBackupStorageLocations,omitempty"`
+}
+
+// ResticRepositoryMaintenanceResult is the result of running a maintenance
+// operation on a ResticRepository.
+type ResticRepositoryMaintenanceResult struct {
+	// Operation is the maintenance operation that was run.
+	Operation ResticRepositoryMaintenanceOperation `json:"operation"`
+
+	// StartTimestamp is when the operation was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp is when the operation completed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Succeeded is whether the operation succeeded.
+	Succeeded bool `json:"succeeded"`
+
+	// Message is the error that the operation failed with, if any.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ResticRepositoryMigrationResult is the result of migrating a ResticRepository
+// to another backup storage location.
+type ResticRepositoryMigrationResult struct {
+	// SourceBackupStorageLocation is the backup storage location that the
+	// repository was migrated from.
+	SourceBackupStorageLocation string `json:"sourceBackupStorageLocation"`
+
+	// TargetBackupStorageLocation is the backup storage location that the
+	// repository was migrated to.
+	TargetBackupStorageLocation string `json:"targetBackupStorageLocation"`
+
+	// StartTimestamp is when the migration was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp is when the migration completed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Succeeded is whether the migration succeeded.
+	Succeeded bool `json:"succeeded"`
+
+	// ObjectsCopied is the number of the repository's objects that were
+	// copied to the target backup storage location.
+	// +optional
+	ObjectsCopied int `json:"objectsCopied,omitempty"`
+
+	// Message is the error that the migration failed with, if any.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// ResticRepository is a repository of restic pod volume backups. It's
+// deprecated in favor of BackupRepository, which the Velero server
+// converts existing ResticRepositories to.
 type ResticRepository struct {
 	metav1.TypeMeta `json:",inline"`
 
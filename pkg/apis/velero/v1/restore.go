@@ -57,10 +57,20 @@ type RestoreSpec struct {
 	// +nullable
 	ExcludedResources []string `json:"excludedResources,omitempty"`
 
+	// IncludedResourceNames is a slice of items to include in the
+	// restore, formatted as <resource>/<name>, e.g. deployments.apps/web.
+	// If non-empty, only the listed items are restored, along with any
+	// additional items that restore item actions return for them.
+	// +optional
+	// +nullable
+	IncludedResourceNames []string `json:"includedResourceNames,omitempty"`
+
 	// NamespaceMapping is a map of source namespace names
 	// to target namespace names to restore into. Any source
 	// namespaces not included in the map will be restored into
-	// namespaces of the same name.
+	// namespaces of the same name. A source may contain a single
+	// '*' wildcard, in which case a '*' in the target is replaced
+	// by the portion of the namespace name matched by the wildcard.
 	// +optional
 	NamespaceMapping map[string]string `json:"namespaceMapping,omitempty"`
 
@@ -77,6 +87,22 @@ type RestoreSpec struct {
 	// +nullable
 	RestorePVs *bool `json:"restorePVs,omitempty"`
 
+	// ZoneMapping is a map of source availability zone and region
+	// names to target names to restore persistent volumes into. The
+	// zones and regions in the labels and node affinity of restored
+	// persistent volumes are rewritten, and volumes restored from
+	// snapshots are created in the target zones. Zones and regions
+	// not included in the map aren't changed.
+	// +optional
+	ZoneMapping map[string]string `json:"zoneMapping,omitempty"`
+
+	// SnapshotVolumeOverrides is a map of the storage class names of
+	// backed-up persistent volumes to the type, IOPS, size and storage
+	// class that volumes restored from their snapshots are created with,
+	// instead of those of the snapshotted volumes.
+	// +optional
+	SnapshotVolumeOverrides map[string]SnapshotVolumeOverride `json:"snapshotVolumeOverrides,omitempty"`
+
 	// IncludeClusterResources specifies whether cluster-scoped resources
 	// should be included for consideration in the restore. If null, defaults
 	// to true.
@@ -84,11 +110,366 @@ type RestoreSpec struct {
 	// +nullable
 	IncludeClusterResources *bool `json:"includeClusterResources,omitempty"`
 
+	// PreserveNodePorts specifies whether the NodePorts of restored services
+	// are kept. If true, all NodePorts are kept; if false, all NodePorts are
+	// cleared so that new ones are assigned. If null, only NodePorts that were
+	// explicitly specified, according to the service's last-applied-configuration
+	// annotation, are kept.
+	// +optional
+	// +nullable
+	PreserveNodePorts *bool `json:"preserveNodePorts,omitempty"`
+
 	// Hooks represent custom behaviors that should be executed during or post restore.
 	// +optional
 	Hooks RestoreHooks `json:"hooks,omitempty"`
+
+	// ResourceModifier is a reference to a ConfigMap in the Velero namespace containing
+	// rules for patching items before they are restored.
+	// +optional
+	// +nullable
+	ResourceModifier *v1.TypedLocalObjectReference `json:"resourceModifier,omitempty"`
+
+	// DryRun specifies whether the restore should only report what it would
+	// do, without modifying the cluster. The report is stored in object storage
+	// alongside the restore's log and results.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// ExistingResourcePolicy specifies the restore behavior for items that
+	// already exist in the cluster. If empty, defaults to "none".
+	// +optional
+	ExistingResourcePolicy PolicyType `json:"existingResourcePolicy,omitempty"`
+
+	// ServiceAccountPolicy specifies the restore behavior for service accounts
+	// that already exist in the cluster. If empty, defaults to "merge".
+	// +optional
+	ServiceAccountPolicy ServiceAccountPolicyType `json:"serviceAccountPolicy,omitempty"`
+
+	// ResourcePriorities overrides the Velero server's restore resource priorities
+	// for this restore. The server's high or low priorities are used for whichever
+	// list isn't specified.
+	// +optional
+	// +nullable
+	ResourcePriorities *RestoreResourcePriorities `json:"resourcePriorities,omitempty"`
+
+	// PVRenamePolicy specifies when persistent volumes restored from snapshots are
+	// given new names. If empty, a persistent volume is only renamed if it already
+	// exists in the cluster and is claimed by a PVC in a namespace that's being remapped.
+	// +optional
+	PVRenamePolicy PVRenamePolicy `json:"pvRenamePolicy,omitempty"`
+
+	// ItemLabels is a map of labels to add to every restored item, and to
+	// the namespaces created by the restore.
+	// +optional
+	ItemLabels map[string]string `json:"itemLabels,omitempty"`
+
+	// ItemAnnotations is a map of annotations to add to every restored item,
+	// and to the namespaces created by the restore.
+	// +optional
+	ItemAnnotations map[string]string `json:"itemAnnotations,omitempty"`
+
+	// RestoreStatus specifies the resources whose status is restored, along
+	// with their spec. If not specified, the status of items isn't restored.
+	// +optional
+	// +nullable
+	RestoreStatus *RestoreStatusSpec `json:"restoreStatus,omitempty"`
+
+	// ReadinessGates specifies resources whose restored items must become
+	// ready before the restore proceeds to the resources restored after them.
+	// +optional
+	// +nullable
+	ReadinessGates []ReadinessGate `json:"readinessGates,omitempty"`
+
+	// StripFinalizers specifies the resources whose finalizers are removed
+	// from restored items. If not specified, the finalizers of all items are
+	// removed.
+	// +optional
+	// +nullable
+	StripFinalizers *StripMetadataSpec `json:"stripFinalizers,omitempty"`
+
+	// StripOwnerReferences specifies the resources whose owner references are
+	// removed from restored items. If not specified, the owner references of
+	// all items are removed.
+	// +optional
+	// +nullable
+	StripOwnerReferences *StripMetadataSpec `json:"stripOwnerReferences,omitempty"`
+
+	// ResourceMappings maps resources in the backup to different resources in
+	// the cluster, e.g. to restore items of a resource whose API group has
+	// changed.
+	// +optional
+	// +nullable
+	ResourceMappings []ResourceMapping `json:"resourceMappings,omitempty"`
+
+	// PVCResize specifies how the requested storage of restored persistent
+	// volume claims is increased. If not specified, the requested storage
+	// isn't changed.
+	// +optional
+	// +nullable
+	PVCResize *PVCResizeSpec `json:"pvcResize,omitempty"`
+
+	// ConflictPolicies specifies the restore behavior, by resource, for items
+	// that already exist in the cluster and are different than the backed-up
+	// version. Items of resources without a conflict policy are handled
+	// according to the existing resource policy. Service accounts are always
+	// handled according to the service account policy.
+	// +optional
+	// +nullable
+	ConflictPolicies []ConflictPolicy `json:"conflictPolicies,omitempty"`
+
+	// APIRateLimit limits the rate of the restore's requests to the Kubernetes
+	// API, independently of the server's client settings. If not specified,
+	// the restore's requests are only limited by the server's client settings.
+	// +optional
+	// +nullable
+	APIRateLimit *APIRateLimitSpec `json:"apiRateLimit,omitempty"`
+}
+
+// APIRateLimitSpec specifies the rate at which a restore makes requests to the
+// Kubernetes API when it restores items.
+type APIRateLimitSpec struct {
+	// QPS is the maximum number of requests per second once the burst limit
+	// has been reached.
+	QPS int `json:"qps"`
+
+	// Burst is the maximum number of requests in a short period of time. If
+	// not specified, it's the same as QPS.
+	// +optional
+	Burst int `json:"burst,omitempty"`
+}
+
+// ConflictPolicy specifies the restore behavior for items of the given resources
+// that conflict with items in the cluster.
+type ConflictPolicy struct {
+	// Resources is a slice of resource names the policy applies to, formatted
+	// as resource.group, e.g. deployments.apps. A resource may be listed in at
+	// most one policy. The "*" resource applies the policy to the items of all
+	// resources that aren't listed in another policy.
+	Resources []string `json:"resources"`
+
+	// Policy is the behavior of the restore for conflicting items.
+	Policy ConflictPolicyType `json:"policy"`
+}
+
+// PVCResizeSpec specifies how the requested storage of restored persistent volume
+// claims is increased. It only applies to claims whose volumes are dynamically
+// provisioned by the restore, since a claim can't request more storage than the
+// restored volume it's bound to has. The requested storage is never decreased.
+type PVCResizeSpec struct {
+	// Factor is a decimal number, at least 1, that the requested storage
+	// is multiplied by, e.g. "1.5".
+	// +optional
+	Factor string `json:"factor,omitempty"`
+
+	// MinimumSizes is a map of storage class names to the minimum storage,
+	// e.g. "10Gi", that claims provisioned by the storage class request.
+	// +optional
+	MinimumSizes map[string]string `json:"minimumSizes,omitempty"`
+}
+
+// SnapshotVolumeOverride specifies how volumes restored from snapshots differ
+// from the snapshotted volumes.
+type SnapshotVolumeOverride struct {
+	// StorageClass is the storage class of restored persistent volumes and
+	// the persistent volume claims bound to them.
+	// +optional
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// VolumeType is the provider's type of restored volumes, e.g. gp3.
+	// +optional
+	VolumeType string `json:"volumeType,omitempty"`
+
+	// IOPS is the provisioned IOPS of restored volumes.
+	// +optional
+	// +nullable
+	IOPS *int64 `json:"iops,omitempty"`
+
+	// Size is the minimum size of restored volumes, e.g. "100Gi". Volumes
+	// are never shrunk. Volumes can only be enlarged by volume snapshotter
+	// plugins that support it; other plugins create them with the size of
+	// the snapshotted volumes.
+	// +optional
+	Size string `json:"size,omitempty"`
+}
+
+// ResourceMapping restores the items of a resource in the backup as items of
+// a different resource.
+type ResourceMapping struct {
+	// Source is the name of the resource in the backup, formatted as
+	// resource.group, e.g. deployments.apps.openshift.io.
+	Source string `json:"source"`
+
+	// Target is the name of the resource the items are restored as,
+	// formatted as resource.group, e.g. deployments.apps.
+	Target string `json:"target"`
+
+	// Version is the API version of the target resource the items are
+	// restored as. If not specified, the preferred version is used.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// PruneFields is a slice of JSON pointers, e.g. /spec/triggers, to
+	// fields that are removed from the items because the target resource
+	// doesn't have them.
+	// +optional
+	// +nullable
+	PruneFields []string `json:"pruneFields,omitempty"`
+}
+
+// ReadinessGate specifies a resource whose restored items must become ready
+// before the restore proceeds.
+type ReadinessGate struct {
+	// Resource is the name of the resource whose items are waited for,
+	// formatted as resource.group, e.g. deployments.apps.
+	Resource string `json:"resource"`
+
+	// ConditionType is the type of the status condition that must be "True"
+	// for an item to be ready. It may only be omitted for resources with a
+	// well-known readiness condition: Established for customresourcedefinitions,
+	// Available for deployments and apiservices, and Ready for pods, or if
+	// JSONPath is specified.
+	// +optional
+	ConditionType string `json:"conditionType,omitempty"`
+
+	// JSONPath is a JSONPath expression, like {.status.phase}, that determines
+	// whether an item is ready instead of a status condition. An item is ready
+	// when a result of the expression is Value, or if Value is empty, when the
+	// expression has a result.
+	// +optional
+	JSONPath string `json:"jsonPath,omitempty"`
+
+	// Value is the value that a result of JSONPath must have for an item to
+	// be ready.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Timeout is the maximum amount of time to wait for the items to become
+	// ready. If not specified, defaults to 10 minutes.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+}
+
+// RestoreStatusSpec selects the resources whose status is restored. The status
+// is restored through the status subresource after the item is created.
+type RestoreStatusSpec struct {
+	// IncludedResources is a slice of resource names whose status is
+	// restored. If empty, the status of no resources is restored.
+	// +optional
+	// +nullable
+	IncludedResources []string `json:"includedResources,omitempty"`
+
+	// ExcludedResources is a slice of resource names whose status is
+	// not restored.
+	// +optional
+	// +nullable
+	ExcludedResources []string `json:"excludedResources,omitempty"`
+}
+
+// StripMetadataSpec selects the resources whose items have a piece of
+// metadata removed when they're restored.
+type StripMetadataSpec struct {
+	// IncludedResources is a slice of resource names whose items have the
+	// metadata removed. If empty, all resources are included.
+	// +optional
+	// +nullable
+	IncludedResources []string `json:"includedResources,omitempty"`
+
+	// ExcludedResources is a slice of resource names whose items keep
+	// the metadata.
+	// +optional
+	// +nullable
+	ExcludedResources []string `json:"excludedResources,omitempty"`
 }
 
+// PVRenamePolicy is the policy for renaming persistent volumes restored from snapshots.
+// +kubebuilder:validation:Enum=Always;OnConflict;Never
+type PVRenamePolicy string
+
+const (
+	// PVRenamePolicyAlways means persistent volumes are always given new names.
+	PVRenamePolicyAlways PVRenamePolicy = "Always"
+
+	// PVRenamePolicyOnConflict means persistent volumes are given new names if a
+	// persistent volume with the original name already exists in the cluster.
+	PVRenamePolicyOnConflict PVRenamePolicy = "OnConflict"
+
+	// PVRenamePolicyNever means persistent volumes are never given new names.
+	PVRenamePolicyNever PVRenamePolicy = "Never"
+)
+
+// RestoreResourcePriorities defines the order in which resources are restored. Resources
+// that aren't in either list are restored alphabetically, after the high-priority resources
+// and before the low-priority resources.
+type RestoreResourcePriorities struct {
+	// HighPriorities is a list of resources to restore first, in order.
+	// +optional
+	// +nullable
+	HighPriorities []string `json:"highPriorities,omitempty"`
+
+	// LowPriorities is a list of resources to restore last, in order.
+	// +optional
+	// +nullable
+	LowPriorities []string `json:"lowPriorities,omitempty"`
+}
+
+// PolicyType is the behavior of a restore for items that already exist in the cluster.
+// +kubebuilder:validation:Enum=none;update;patch
+type PolicyType string
+
+const (
+	// PolicyTypeNone means items that already exist in the cluster are left as they
+	// are, and a warning is recorded if they differ from the backed-up version.
+	PolicyTypeNone PolicyType = "none"
+
+	// PolicyTypeUpdate means items that already exist in the cluster are replaced
+	// with the backed-up version.
+	PolicyTypeUpdate PolicyType = "update"
+
+	// PolicyTypePatch means the backed-up version of items that already exist in the
+	// cluster is merged into the in-cluster version. Fields that are only set on the
+	// in-cluster version are kept.
+	PolicyTypePatch PolicyType = "patch"
+)
+
+// ConflictPolicyType is the behavior of a restore for items that already exist in
+// the cluster and are different than the backed-up version.
+// +kubebuilder:validation:Enum=skip;overwrite;fail-fast
+type ConflictPolicyType string
+
+const (
+	// ConflictPolicySkip means conflicting items are left as they are in the cluster.
+	ConflictPolicySkip ConflictPolicyType = "skip"
+
+	// ConflictPolicyOverwrite means conflicting items are replaced with the
+	// backed-up version.
+	ConflictPolicyOverwrite ConflictPolicyType = "overwrite"
+
+	// ConflictPolicyFailFast means the restore is stopped at the first conflicting
+	// item, and no further items are restored.
+	ConflictPolicyFailFast ConflictPolicyType = "fail-fast"
+)
+
+// ServiceAccountPolicyType is the behavior of a restore for service accounts that
+// already exist in the cluster.
+// +kubebuilder:validation:Enum=merge;replace;skip
+type ServiceAccountPolicyType string
+
+const (
+	// ServiceAccountPolicyMerge means the secrets, image pull secrets, labels and
+	// annotations of the backed-up version of a service account that aren't in the
+	// in-cluster version are added to it.
+	ServiceAccountPolicyMerge ServiceAccountPolicyType = "merge"
+
+	// ServiceAccountPolicyReplace means service accounts that already exist in the
+	// cluster are replaced with the backed-up version. The in-cluster version's
+	// token secrets are kept.
+	ServiceAccountPolicyReplace ServiceAccountPolicyType = "replace"
+
+	// ServiceAccountPolicySkip means service accounts that already exist in the
+	// cluster are left as they are.
+	ServiceAccountPolicySkip ServiceAccountPolicyType = "skip"
+)
+
 // RestoreHooks contains custom behaviors that should be executed during or post restore.
 type RestoreHooks struct {
 	Resources []RestoreResourceHookSpec `json:"resources,omitempty"`
@@ -231,6 +612,19 @@ type RestoreStatus struct {
 	// +optional
 	Errors int `json:"errors,omitempty"`
 
+	// Conflicts is a count of the items that already existed in the cluster and
+	// were different than the backed-up version. The conflict report, which says
+	// how each conflict was handled, is stored in object storage with the
+	// restore's results.
+	// +optional
+	Conflicts int `json:"conflicts,omitempty"`
+
+	// Unchanged is a count of the items that already existed in the cluster
+	// and were the same as the backed-up version, ignoring fields that are
+	// populated by the API server.
+	// +optional
+	Unchanged int `json:"unchanged,omitempty"`
+
 	// FailureReason is an error that caused the entire restore to fail.
 	// +optional
 	FailureReason string `json:"failureReason,omitempty"`
@@ -247,6 +641,71 @@ type RestoreStatus struct {
 	// +optional
 	// +nullable
 	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// RenamedPersistentVolumes is a map of the original names of persistent
+	// volumes that were given new names during the restore to their new names.
+	// +optional
+	// +nullable
+	RenamedPersistentVolumes map[string]string `json:"renamedPersistentVolumes,omitempty"`
+
+	// HookStatus contains information about the status of the restore's
+	// post-restore exec hooks.
+	// +optional
+	// +nullable
+	HookStatus *HookStatus `json:"hookStatus,omitempty"`
+
+	// PostRestoreActionStatus contains information about the status of the
+	// post-restore action plugins that were run once the restore's items
+	// were restored.
+	// +optional
+	// +nullable
+	PostRestoreActionStatus *PostRestoreActionStatus `json:"postRestoreActionStatus,omitempty"`
+
+	// Progress contains information about the restore's execution progress.
+	// Note that this information is best-effort only -- if Velero fails to
+	// update it during a restore for any reason, it may be inaccurate/stale.
+	// +optional
+	// +nullable
+	Progress *RestoreProgress `json:"progress,omitempty"`
+}
+
+// RestoreProgress stores information about the progress of a Restore's execution.
+type RestoreProgress struct {
+	// PodVolumeTotalBytes is the total number of bytes of the pod volumes
+	// that are being restored with restic.
+	// +optional
+	PodVolumeTotalBytes int64 `json:"podVolumeTotalBytes,omitempty"`
+
+	// PodVolumeBytesDone is the number of bytes of the pod volumes that have
+	// been restored with restic so far.
+	// +optional
+	PodVolumeBytesDone int64 `json:"podVolumeBytesDone,omitempty"`
+}
+
+// HookStatus stores information about the status of hooks.
+type HookStatus struct {
+	// HooksAttempted is the total number of hooks that were attempted.
+	// +optional
+	HooksAttempted int `json:"hooksAttempted,omitempty"`
+
+	// HooksFailed is the total number of attempted hooks that failed or
+	// could not be executed.
+	// +optional
+	HooksFailed int `json:"hooksFailed,omitempty"`
+}
+
+// PostRestoreActionStatus stores information about the status of post-restore
+// action plugins.
+type PostRestoreActionStatus struct {
+	// ActionsAttempted is the total number of post-restore actions that were
+	// attempted.
+	// +optional
+	ActionsAttempted int `json:"actionsAttempted,omitempty"`
+
+	// ActionsFailed is the total number of attempted post-restore actions
+	// that failed.
+	// +optional
+	ActionsFailed int `json:"actionsFailed,omitempty"`
 }
 
 // +genclient
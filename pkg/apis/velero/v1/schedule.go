@@ -32,8 +32,124 @@ type ScheduleSpec struct {
 	// Schedule is a Cron expression defining when to run
 	// the Backup.
 	Schedule string `json:"schedule"`
+
+	// Timezone is the IANA name of the time zone, such as
+	// "America/New_York", that the Schedule is evaluated in.
+	// Defaults to UTC.
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+
+	// Paused specifies whether the Schedule is paused. Paused
+	// Schedules don't trigger backups.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// ConcurrencyPolicy specifies how the Schedule treats a Backup
+	// that's due while a Backup it previously triggered hasn't
+	// finished. Defaults to Allow.
+	// +optional
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// KeepLast is the number of the Schedule's most recent Backups
+	// that completed successfully to keep. Finished Backups that are
+	// older than these are deleted, regardless of their TTL. If
+	// zero, Backups are only deleted when they expire.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepLast int `json:"keepLast,omitempty"`
+
+	// Jitter is a time.Duration-parseable string describing the
+	// window that the Schedule's Backups are spread over. Each run
+	// is delayed by an offset within the window that's derived from
+	// the Schedule's name, so Schedules with the same cron expression
+	// don't all trigger Backups at once. It should be shorter than the
+	// interval between the Schedule's runs.
+	// +optional
+	Jitter metav1.Duration `json:"jitter,omitempty"`
+
+	// UseOwnerReferencesInBackup specifies whether Backups created by
+	// the Schedule have an owner reference to it, so that deleting the
+	// Schedule deletes its Backups, including their data in object
+	// storage, as well.
+	// +optional
+	// +nullable
+	UseOwnerReferencesInBackup *bool `json:"useOwnerReferencesInBackup,omitempty"`
+
+	// BackupNameTemplate is a Go template that the names of Backups
+	// created by the Schedule are generated from, such as
+	// `{{.ScheduleName}}-{{.Timestamp "20060102"}}-{{.ClusterName}}`.
+	// If empty, Backups are named <schedule name>-<timestamp>.
+	// +optional
+	BackupNameTemplate string `json:"backupNameTemplate,omitempty"`
+
+	// BlackoutWindows are the periods of time during which the
+	// Schedule doesn't trigger Backups. Backups that are due during
+	// a blackout window are skipped.
+	// +optional
+	// +nullable
+	BlackoutWindows []BlackoutWindow `json:"blackoutWindows,omitempty"`
+
+	// Policy is the name of a BackupPolicy whose template the
+	// Schedule's Backups inherit settings from. Settings in the
+	// Schedule's own template override the policy's.
+	// +optional
+	Policy string `json:"policy,omitempty"`
+}
+
+// BlackoutWindow is a period of time during which a Schedule doesn't
+// trigger Backups. It either recurs, starting at the times given by a
+// Cron expression and lasting for a duration, or is a single period
+// between a start and an end time.
+type BlackoutWindow struct {
+	// Schedule is a Cron expression defining when the window starts.
+	// It's evaluated in the Schedule's time zone.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// Duration is a time.Duration-parseable string describing how
+	// long the window lasts when it's started by its Schedule.
+	// +optional
+	Duration metav1.Duration `json:"duration,omitempty"`
+
+	// Start is the time that a single window starts.
+	// +optional
+	// +nullable
+	Start *metav1.Time `json:"start,omitempty"`
+
+	// End is the time that a single window ends.
+	// +optional
+	// +nullable
+	End *metav1.Time `json:"end,omitempty"`
 }
 
+// ConcurrencyPolicy is a string representation of how a Schedule
+// treats Backups that it previously triggered and that haven't
+// finished.
+// +kubebuilder:validation:Enum=Allow;Forbid;Replace
+type ConcurrencyPolicy string
+
+const (
+	// ConcurrencyPolicyAllow means the Schedule triggers Backups
+	// even if Backups it previously triggered haven't finished.
+	ConcurrencyPolicyAllow ConcurrencyPolicy = "Allow"
+
+	// ConcurrencyPolicyForbid means the Schedule skips a Backup
+	// if a Backup it previously triggered hasn't finished.
+	ConcurrencyPolicyForbid ConcurrencyPolicy = "Forbid"
+
+	// ConcurrencyPolicyReplace means Backups the Schedule previously
+	// triggered that haven't started yet are deleted and replaced by
+	// the new Backup. Backups that are in progress can't be stopped,
+	// so they're left to finish.
+	ConcurrencyPolicyReplace ConcurrencyPolicy = "Replace"
+)
+
+// ScheduleBackupsFinalizer is the finalizer of Schedules that use owner
+// references in their Backups. It keeps a deleted Schedule around until
+// the Backups it owns have been deleted through DeleteBackupRequests, so
+// that their data in object storage is deleted along with them.
+const ScheduleBackupsFinalizer = "velero.io/delete-schedule-backups"
+
 // SchedulePhase is a string representation of the lifecycle phase
 // of a Velero schedule
 // +kubebuilder:validation:Enum=New;Enabled;FailedValidation
@@ -65,6 +181,19 @@ type ScheduleStatus struct {
 	// +nullable
 	LastBackup *metav1.Time `json:"lastBackup,omitempty"`
 
+	// LastSkipped is the last time a Backup was due for this
+	// Schedule but wasn't run because the Schedule was paused, its
+	// concurrency policy forbade it, or it was in a blackout window
+	// +optional
+	// +nullable
+	LastSkipped *metav1.Time `json:"lastSkipped,omitempty"`
+
+	// ConsecutiveFailures is the number of the Schedule's most recent
+	// finished Backups that failed in a row. While it's non-zero, the
+	// Schedule's runs are backed off exponentially.
+	// +optional
+	ConsecutiveFailures int `json:"consecutiveFailures,omitempty"`
+
 	// ValidationErrors is a slice of all validation errors (if
 	// applicable)
 	// +optional
@@ -64,6 +64,51 @@ const (
 type PluginInfo struct {
 	Name string `json:"name"`
 	Kind string `json:"kind"`
+
+	// Process is the status of the server's processes for the plugin's
+	// executable. It's omitted if the server hasn't started one.
+	// +optional
+	// +nullable
+	Process *PluginProcessStatus `json:"process,omitempty"`
+}
+
+// PluginProcessPhase represents the state of the processes for a plugin executable.
+// +kubebuilder:validation:Enum=Running;Restarting;Failed;Stopped
+type PluginProcessPhase string
+
+const (
+	// PluginProcessPhaseRunning means a process for the plugin executable is running.
+	PluginProcessPhaseRunning PluginProcessPhase = "Running"
+	// PluginProcessPhaseRestarting means a process crashed, failed its health check, or
+	// timed out, and is being restarted.
+	PluginProcessPhaseRestarting PluginProcessPhase = "Restarting"
+	// PluginProcessPhaseFailed means a process could not be restarted.
+	PluginProcessPhaseFailed PluginProcessPhase = "Failed"
+	// PluginProcessPhaseStopped means there are no processes for the plugin executable
+	// because the operations that used them have completed.
+	PluginProcessPhaseStopped PluginProcessPhase = "Stopped"
+)
+
+// PluginProcessStatus is the status of the server's processes for a plugin executable.
+type PluginProcessStatus struct {
+	// Phase is the current state of the processes.
+	// +optional
+	Phase PluginProcessPhase `json:"phase,omitempty"`
+
+	// Restarts is the number of times the processes have been restarted
+	// since the server started.
+	// +optional
+	Restarts int `json:"restarts,omitempty"`
+
+	// LastRestartTime is when a process was last restarted.
+	// +optional
+	// +nullable
+	LastRestartTime *metav1.Time `json:"lastRestartTime,omitempty"`
+
+	// LastError is the most recent error that a process crashed, failed
+	// its health check, or failed to restart with.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
 }
 
 // ServerStatusRequestStatus is the current status of a ServerStatusRequest.
@@ -16,7 +16,10 @@ limitations under the License.
 
 package v1
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -55,6 +58,33 @@ type VolumeSnapshotLocationSpec struct {
 	// Config is for provider-specific configuration fields.
 	// +optional
 	Config map[string]string `json:"config,omitempty"`
+
+	// Credential contains the credential information intended to be used with this location.
+	// The secret must be in the Velero server's namespace. If it's not set, the volume
+	// snapshotter uses the credentials the Velero server was installed with.
+	// +optional
+	// +nullable
+	Credential *corev1api.SecretKeySelector `json:"credential,omitempty"`
+
+	// Identity configures the location to authenticate with the cloud identity of the
+	// Velero server's pod instead of a credential. It can't be set along with Credential.
+	// +optional
+	// +nullable
+	Identity *AmbientIdentity `json:"identity,omitempty"`
+
+	// EgressProxy is the HTTP(S) proxy that the location's plugin makes requests through,
+	// instead of the proxy settings of the Velero server's environment.
+	// +optional
+	// +nullable
+	EgressProxy *EgressProxy `json:"egressProxy,omitempty"`
+
+	// MaxConcurrentOperations is the maximum number of the location's volume snapshot
+	// operations, like creating or deleting snapshots, that the Velero server runs at
+	// the same time. Further operations wait until others finish. If it's zero, they
+	// aren't limited.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxConcurrentOperations int `json:"maxConcurrentOperations,omitempty"`
 }
 
 // VolumeSnapshotLocationPhase is the lifecyle phase of a Velero VolumeSnapshotLocation.
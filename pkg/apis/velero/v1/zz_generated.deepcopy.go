@@ -26,6 +26,38 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIRateLimitSpec) DeepCopyInto(out *APIRateLimitSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIRateLimitSpec.
+func (in *APIRateLimitSpec) DeepCopy() *APIRateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(APIRateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AmbientIdentity) DeepCopyInto(out *AmbientIdentity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AmbientIdentity.
+func (in *AmbientIdentity) DeepCopy() *AmbientIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(AmbientIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backup) DeepCopyInto(out *Backup) {
 	*out = *in
@@ -110,6 +142,83 @@ func (in *BackupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupPolicy) DeepCopyInto(out *BackupPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupPolicy.
+func (in *BackupPolicy) DeepCopy() *BackupPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupPolicyList) DeepCopyInto(out *BackupPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackupPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupPolicyList.
+func (in *BackupPolicyList) DeepCopy() *BackupPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupPolicySpec) DeepCopyInto(out *BackupPolicySpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupPolicySpec.
+func (in *BackupPolicySpec) DeepCopy() *BackupPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupProgress) DeepCopyInto(out *BackupProgress) {
 	*out = *in
@@ -126,6 +235,282 @@ func (in *BackupProgress) DeepCopy() *BackupProgress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupReplication) DeepCopyInto(out *BackupReplication) {
+	*out = *in
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupReplication.
+func (in *BackupReplication) DeepCopy() *BackupReplication {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupReplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRepository) DeepCopyInto(out *BackupRepository) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepository.
+func (in *BackupRepository) DeepCopy() *BackupRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupRepository) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRepositoryList) DeepCopyInto(out *BackupRepositoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackupRepository, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepositoryList.
+func (in *BackupRepositoryList) DeepCopy() *BackupRepositoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRepositoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupRepositoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRepositoryMaintenanceRequest) DeepCopyInto(out *BackupRepositoryMaintenanceRequest) {
+	*out = *in
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]BackupRepositoryMaintenanceOperation, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepositoryMaintenanceRequest.
+func (in *BackupRepositoryMaintenanceRequest) DeepCopy() *BackupRepositoryMaintenanceRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRepositoryMaintenanceRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRepositoryMaintenanceResult) DeepCopyInto(out *BackupRepositoryMaintenanceResult) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepositoryMaintenanceResult.
+func (in *BackupRepositoryMaintenanceResult) DeepCopy() *BackupRepositoryMaintenanceResult {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRepositoryMaintenanceResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRepositoryMaintenanceWindow) DeepCopyInto(out *BackupRepositoryMaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepositoryMaintenanceWindow.
+func (in *BackupRepositoryMaintenanceWindow) DeepCopy() *BackupRepositoryMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRepositoryMaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRepositoryMigrationRequest) DeepCopyInto(out *BackupRepositoryMigrationRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepositoryMigrationRequest.
+func (in *BackupRepositoryMigrationRequest) DeepCopy() *BackupRepositoryMigrationRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRepositoryMigrationRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRepositoryMigrationResult) DeepCopyInto(out *BackupRepositoryMigrationResult) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepositoryMigrationResult.
+func (in *BackupRepositoryMigrationResult) DeepCopy() *BackupRepositoryMigrationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRepositoryMigrationResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRepositorySpec) DeepCopyInto(out *BackupRepositorySpec) {
+	*out = *in
+	out.MaintenanceFrequency = in.MaintenanceFrequency
+	out.CheckFrequency = in.CheckFrequency
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(BackupRepositoryMaintenanceWindow)
+		**out = **in
+	}
+	if in.MaintenanceRequest != nil {
+		in, out := &in.MaintenanceRequest, &out.MaintenanceRequest
+		*out = new(BackupRepositoryMaintenanceRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CacheSizeLimit != nil {
+		in, out := &in.CacheSizeLimit, &out.CacheSizeLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.UploadRateLimit != nil {
+		in, out := &in.UploadRateLimit, &out.UploadRateLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.DownloadRateLimit != nil {
+		in, out := &in.DownloadRateLimit, &out.DownloadRateLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MigrationRequest != nil {
+		in, out := &in.MigrationRequest, &out.MigrationRequest
+		*out = new(BackupRepositoryMigrationRequest)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepositorySpec.
+func (in *BackupRepositorySpec) DeepCopy() *BackupRepositorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRepositorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRepositoryStatus) DeepCopyInto(out *BackupRepositoryStatus) {
+	*out = *in
+	if in.LastMaintenanceTime != nil {
+		in, out := &in.LastMaintenanceTime, &out.LastMaintenanceTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.MaintenanceResults != nil {
+		in, out := &in.MaintenanceResults, &out.MaintenanceResults
+		*out = make([]BackupRepositoryMaintenanceResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastSizeTime != nil {
+		in, out := &in.LastSizeTime, &out.LastSizeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.MigrationResult != nil {
+		in, out := &in.MigrationResult, &out.MigrationResult
+		*out = new(BackupRepositoryMigrationResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FormerBackupStorageLocations != nil {
+		in, out := &in.FormerBackupStorageLocations, &out.FormerBackupStorageLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepositoryStatus.
+func (in *BackupRepositoryStatus) DeepCopy() *BackupRepositoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRepositoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupResourceHook) DeepCopyInto(out *BackupResourceHook) {
 	*out = *in
@@ -189,6 +574,11 @@ func (in *BackupResourceHookSpec) DeepCopyInto(out *BackupResourceHookSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(HookTemplate)
+		**out = **in
+	}
 	return
 }
 
@@ -225,6 +615,16 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.IncludedAPIGroups != nil {
+		in, out := &in.IncludedAPIGroups, &out.IncludedAPIGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedAPIGroups != nil {
+		in, out := &in.ExcludedAPIGroups, &out.ExcludedAPIGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.LabelSelector != nil {
 		in, out := &in.LabelSelector, &out.LabelSelector
 		*out = new(metav1.LabelSelector)
@@ -236,6 +636,18 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		**out = **in
 	}
 	out.TTL = in.TTL
+	if in.SnapshotTTL != nil {
+		in, out := &in.SnapshotTTL, &out.SnapshotTTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SnapshotTags != nil {
+		in, out := &in.SnapshotTags, &out.SnapshotTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.IncludeClusterResources != nil {
 		in, out := &in.IncludeClusterResources, &out.IncludeClusterResources
 		*out = new(bool)
@@ -247,11 +659,58 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ReplicationLocations != nil {
+		in, out := &in.ReplicationLocations, &out.ReplicationLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.DefaultVolumesToRestic != nil {
 		in, out := &in.DefaultVolumesToRestic, &out.DefaultVolumesToRestic
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DefaultVolumesToFsBackup != nil {
+		in, out := &in.DefaultVolumesToFsBackup, &out.DefaultVolumesToFsBackup
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UnmountedVolumesToRestic != nil {
+		in, out := &in.UnmountedVolumesToRestic, &out.UnmountedVolumesToRestic
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipUnchangedVolumes != nil {
+		in, out := &in.SkipUnchangedVolumes, &out.SkipUnchangedVolumes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VolumePathIncludes != nil {
+		in, out := &in.VolumePathIncludes, &out.VolumePathIncludes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumePathExcludes != nil {
+		in, out := &in.VolumePathExcludes, &out.VolumePathExcludes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumePolicies != nil {
+		in, out := &in.VolumePolicies, &out.VolumePolicies
+		*out = make([]VolumePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourcePolicy != nil {
+		in, out := &in.ResourcePolicy, &out.ResourcePolicy
+		*out = new(corev1.TypedLocalObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EphemeralVolumePolicy != nil {
+		in, out := &in.EphemeralVolumePolicy, &out.EphemeralVolumePolicy
+		*out = new(EphemeralVolumePolicy)
+		**out = **in
+	}
 	if in.OrderedResources != nil {
 		in, out := &in.OrderedResources, &out.OrderedResources
 		*out = make(map[string]string, len(*in))
@@ -279,6 +738,10 @@ func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 		in, out := &in.Expiration, &out.Expiration
 		*out = (*in).DeepCopy()
 	}
+	if in.SnapshotExpiration != nil {
+		in, out := &in.SnapshotExpiration, &out.SnapshotExpiration
+		*out = (*in).DeepCopy()
+	}
 	if in.ValidationErrors != nil {
 		in, out := &in.ValidationErrors, &out.ValidationErrors
 		*out = make([]string, len(*in))
@@ -297,6 +760,22 @@ func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 		*out = new(BackupProgress)
 		**out = **in
 	}
+	if in.ServerSideEncryption != nil {
+		in, out := &in.ServerSideEncryption, &out.ServerSideEncryption
+		*out = new(ServerSideEncryption)
+		**out = **in
+	}
+	if in.ObjectLockRetainUntil != nil {
+		in, out := &in.ObjectLockRetainUntil, &out.ObjectLockRetainUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.Replications != nil {
+		in, out := &in.Replications, &out.Replications
+		*out = make([]BackupReplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -338,6 +817,23 @@ func (in *BackupStorageLocation) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStorageLocationCondition) DeepCopyInto(out *BackupStorageLocationCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorageLocationCondition.
+func (in *BackupStorageLocationCondition) DeepCopy() *BackupStorageLocationCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStorageLocationCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupStorageLocationList) DeepCopyInto(out *BackupStorageLocationList) {
 	*out = *in
@@ -381,6 +877,21 @@ func (in *BackupStorageLocationSpec) DeepCopyInto(out *BackupStorageLocationSpec
 			(*out)[key] = val
 		}
 	}
+	if in.Credential != nil {
+		in, out := &in.Credential, &out.Credential
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Identity != nil {
+		in, out := &in.Identity, &out.Identity
+		*out = new(AmbientIdentity)
+		**out = **in
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxy)
+		**out = **in
+	}
 	in.StorageType.DeepCopyInto(&out.StorageType)
 	if in.BackupSyncPeriod != nil {
 		in, out := &in.BackupSyncPeriod, &out.BackupSyncPeriod
@@ -416,15 +927,120 @@ func (in *BackupStorageLocationStatus) DeepCopyInto(out *BackupStorageLocationSt
 		in, out := &in.LastValidationTime, &out.LastValidationTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]BackupStorageLocationCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorageLocationStatus.
+func (in *BackupStorageLocationStatus) DeepCopy() *BackupStorageLocationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStorageLocationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandwidthLimit) DeepCopyInto(out *BandwidthLimit) {
+	*out = *in
+	if in.Upload != nil {
+		in, out := &in.Upload, &out.Upload
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Download != nil {
+		in, out := &in.Download, &out.Download
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandwidthLimit.
+func (in *BandwidthLimit) DeepCopy() *BandwidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(BandwidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlackoutWindow) DeepCopyInto(out *BlackoutWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = (*in).DeepCopy()
+	}
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlackoutWindow.
+func (in *BlackoutWindow) DeepCopy() *BlackoutWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(BlackoutWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CACertReference) DeepCopyInto(out *CACertReference) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CACertReference.
+func (in *CACertReference) DeepCopy() *CACertReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CACertReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConflictPolicy) DeepCopyInto(out *ConflictPolicy) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorageLocationStatus.
-func (in *BackupStorageLocationStatus) DeepCopy() *BackupStorageLocationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConflictPolicy.
+func (in *ConflictPolicy) DeepCopy() *ConflictPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(BackupStorageLocationStatus)
+	out := new(ConflictPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -527,6 +1143,22 @@ func (in *DeleteBackupRequestStatus) DeepCopy() *DeleteBackupRequestStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DownloadProxy) DeepCopyInto(out *DownloadProxy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadProxy.
+func (in *DownloadProxy) DeepCopy() *DownloadProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(DownloadProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DownloadRequest) DeepCopyInto(out *DownloadRequest) {
 	*out = *in
@@ -608,6 +1240,11 @@ func (in *DownloadRequestSpec) DeepCopy() *DownloadRequestSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DownloadRequestStatus) DeepCopyInto(out *DownloadRequestStatus) {
 	*out = *in
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(DownloadProxy)
+		**out = **in
+	}
 	if in.Expiration != nil {
 		in, out := &in.Expiration, &out.Expiration
 		*out = (*in).DeepCopy()
@@ -641,6 +1278,37 @@ func (in *DownloadTarget) DeepCopy() *DownloadTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressProxy) DeepCopyInto(out *EgressProxy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressProxy.
+func (in *EgressProxy) DeepCopy() *EgressProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EphemeralVolumePolicy) DeepCopyInto(out *EphemeralVolumePolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EphemeralVolumePolicy.
+func (in *EphemeralVolumePolicy) DeepCopy() *EphemeralVolumePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(EphemeralVolumePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecHook) DeepCopyInto(out *ExecHook) {
 	*out = *in
@@ -686,6 +1354,39 @@ func (in *ExecRestoreHook) DeepCopy() *ExecRestoreHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookStatus) DeepCopyInto(out *HookStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookStatus.
+func (in *HookStatus) DeepCopy() *HookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookTemplate) DeepCopyInto(out *HookTemplate) {
+	*out = *in
+	out.Timeout = in.Timeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookTemplate.
+func (in *HookTemplate) DeepCopy() *HookTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(HookTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InitRestoreHook) DeepCopyInto(out *InitRestoreHook) {
 	*out = *in
@@ -710,6 +1411,23 @@ func (in *InitRestoreHook) DeepCopy() *InitRestoreHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLock) DeepCopyInto(out *ObjectLock) {
+	*out = *in
+	out.RetentionPeriod = in.RetentionPeriod
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLock.
+func (in *ObjectLock) DeepCopy() *ObjectLock {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLock)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectStorageLocation) DeepCopyInto(out *ObjectStorageLocation) {
 	*out = *in
@@ -718,6 +1436,31 @@ func (in *ObjectStorageLocation) DeepCopyInto(out *ObjectStorageLocation) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.CACertRef != nil {
+		in, out := &in.CACertRef, &out.CACertRef
+		*out = new(CACertReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServerSideEncryption != nil {
+		in, out := &in.ServerSideEncryption, &out.ServerSideEncryption
+		*out = new(ServerSideEncryption)
+		**out = **in
+	}
+	if in.ObjectLock != nil {
+		in, out := &in.ObjectLock, &out.ObjectLock
+		*out = new(ObjectLock)
+		**out = **in
+	}
+	if in.BandwidthLimit != nil {
+		in, out := &in.BandwidthLimit, &out.BandwidthLimit
+		*out = new(BandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DownloadURLTTL != nil {
+		in, out := &in.DownloadURLTTL, &out.DownloadURLTTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -731,9 +1474,37 @@ func (in *ObjectStorageLocation) DeepCopy() *ObjectStorageLocation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PVCResizeSpec) DeepCopyInto(out *PVCResizeSpec) {
+	*out = *in
+	if in.MinimumSizes != nil {
+		in, out := &in.MinimumSizes, &out.MinimumSizes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PVCResizeSpec.
+func (in *PVCResizeSpec) DeepCopy() *PVCResizeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PVCResizeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PluginInfo) DeepCopyInto(out *PluginInfo) {
 	*out = *in
+	if in.Process != nil {
+		in, out := &in.Process, &out.Process
+		*out = new(PluginProcessStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -747,6 +1518,26 @@ func (in *PluginInfo) DeepCopy() *PluginInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginProcessStatus) DeepCopyInto(out *PluginProcessStatus) {
+	*out = *in
+	if in.LastRestartTime != nil {
+		in, out := &in.LastRestartTime, &out.LastRestartTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginProcessStatus.
+func (in *PluginProcessStatus) DeepCopy() *PluginProcessStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginProcessStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodVolumeBackup) DeepCopyInto(out *PodVolumeBackup) {
 	*out = *in
@@ -819,6 +1610,16 @@ func (in *PodVolumeBackupSpec) DeepCopyInto(out *PodVolumeBackupSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.PathIncludes != nil {
+		in, out := &in.PathIncludes, &out.PathIncludes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PathExcludes != nil {
+		in, out := &in.PathExcludes, &out.PathExcludes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -976,12 +1777,66 @@ func (in *PodVolumeRestoreStatus) DeepCopy() *PodVolumeRestoreStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostRestoreActionStatus) DeepCopyInto(out *PostRestoreActionStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostRestoreActionStatus.
+func (in *PostRestoreActionStatus) DeepCopy() *PostRestoreActionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PostRestoreActionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReadinessGate) DeepCopyInto(out *ReadinessGate) {
+	*out = *in
+	out.Timeout = in.Timeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadinessGate.
+func (in *ReadinessGate) DeepCopy() *ReadinessGate {
+	if in == nil {
+		return nil
+	}
+	out := new(ReadinessGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMapping) DeepCopyInto(out *ResourceMapping) {
+	*out = *in
+	if in.PruneFields != nil {
+		in, out := &in.PruneFields, &out.PruneFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMapping.
+func (in *ResourceMapping) DeepCopy() *ResourceMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResticRepository) DeepCopyInto(out *ResticRepository) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -1001,46 +1856,179 @@ func (in *ResticRepository) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
-	return nil
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResticRepositoryList) DeepCopyInto(out *ResticRepositoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResticRepository, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticRepositoryList.
+func (in *ResticRepositoryList) DeepCopy() *ResticRepositoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResticRepositoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResticRepositoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResticRepositoryMaintenanceRequest) DeepCopyInto(out *ResticRepositoryMaintenanceRequest) {
+	*out = *in
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]ResticRepositoryMaintenanceOperation, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticRepositoryMaintenanceRequest.
+func (in *ResticRepositoryMaintenanceRequest) DeepCopy() *ResticRepositoryMaintenanceRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ResticRepositoryMaintenanceRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResticRepositoryMaintenanceResult) DeepCopyInto(out *ResticRepositoryMaintenanceResult) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticRepositoryMaintenanceResult.
+func (in *ResticRepositoryMaintenanceResult) DeepCopy() *ResticRepositoryMaintenanceResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ResticRepositoryMaintenanceResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResticRepositoryMaintenanceWindow) DeepCopyInto(out *ResticRepositoryMaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticRepositoryMaintenanceWindow.
+func (in *ResticRepositoryMaintenanceWindow) DeepCopy() *ResticRepositoryMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ResticRepositoryMaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResticRepositoryMigrationRequest) DeepCopyInto(out *ResticRepositoryMigrationRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticRepositoryMigrationRequest.
+func (in *ResticRepositoryMigrationRequest) DeepCopy() *ResticRepositoryMigrationRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ResticRepositoryMigrationRequest)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResticRepositoryList) DeepCopyInto(out *ResticRepositoryList) {
+func (in *ResticRepositoryMigrationResult) DeepCopyInto(out *ResticRepositoryMigrationResult) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ResticRepository, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticRepositoryList.
-func (in *ResticRepositoryList) DeepCopy() *ResticRepositoryList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticRepositoryMigrationResult.
+func (in *ResticRepositoryMigrationResult) DeepCopy() *ResticRepositoryMigrationResult {
 	if in == nil {
 		return nil
 	}
-	out := new(ResticRepositoryList)
+	out := new(ResticRepositoryMigrationResult)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ResticRepositoryList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResticRepositorySpec) DeepCopyInto(out *ResticRepositorySpec) {
 	*out = *in
 	out.MaintenanceFrequency = in.MaintenanceFrequency
+	out.CheckFrequency = in.CheckFrequency
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(ResticRepositoryMaintenanceWindow)
+		**out = **in
+	}
+	if in.MaintenanceRequest != nil {
+		in, out := &in.MaintenanceRequest, &out.MaintenanceRequest
+		*out = new(ResticRepositoryMaintenanceRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CacheSizeLimit != nil {
+		in, out := &in.CacheSizeLimit, &out.CacheSizeLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.UploadRateLimit != nil {
+		in, out := &in.UploadRateLimit, &out.UploadRateLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.DownloadRateLimit != nil {
+		in, out := &in.DownloadRateLimit, &out.DownloadRateLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MigrationRequest != nil {
+		in, out := &in.MigrationRequest, &out.MigrationRequest
+		*out = new(ResticRepositoryMigrationRequest)
+		**out = **in
+	}
 	return
 }
 
@@ -1061,6 +2049,27 @@ func (in *ResticRepositoryStatus) DeepCopyInto(out *ResticRepositoryStatus) {
 		in, out := &in.LastMaintenanceTime, &out.LastMaintenanceTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.MaintenanceResults != nil {
+		in, out := &in.MaintenanceResults, &out.MaintenanceResults
+		*out = make([]ResticRepositoryMaintenanceResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MigrationResult != nil {
+		in, out := &in.MigrationResult, &out.MigrationResult
+		*out = new(ResticRepositoryMigrationResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FormerBackupStorageLocations != nil {
+		in, out := &in.FormerBackupStorageLocations, &out.FormerBackupStorageLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1158,6 +2167,22 @@ func (in *RestoreList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreProgress) DeepCopyInto(out *RestoreProgress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreProgress.
+func (in *RestoreProgress) DeepCopy() *RestoreProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestoreResourceHook) DeepCopyInto(out *RestoreResourceHook) {
 	*out = *in
@@ -1232,6 +2257,32 @@ func (in *RestoreResourceHookSpec) DeepCopy() *RestoreResourceHookSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreResourcePriorities) DeepCopyInto(out *RestoreResourcePriorities) {
+	*out = *in
+	if in.HighPriorities != nil {
+		in, out := &in.HighPriorities, &out.HighPriorities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LowPriorities != nil {
+		in, out := &in.LowPriorities, &out.LowPriorities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreResourcePriorities.
+func (in *RestoreResourcePriorities) DeepCopy() *RestoreResourcePriorities {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreResourcePriorities)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 	*out = *in
@@ -1255,6 +2306,11 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.IncludedResourceNames != nil {
+		in, out := &in.IncludedResourceNames, &out.IncludedResourceNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.NamespaceMapping != nil {
 		in, out := &in.NamespaceMapping, &out.NamespaceMapping
 		*out = make(map[string]string, len(*in))
@@ -1272,12 +2328,99 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ZoneMapping != nil {
+		in, out := &in.ZoneMapping, &out.ZoneMapping
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SnapshotVolumeOverrides != nil {
+		in, out := &in.SnapshotVolumeOverrides, &out.SnapshotVolumeOverrides
+		*out = make(map[string]SnapshotVolumeOverride, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.IncludeClusterResources != nil {
 		in, out := &in.IncludeClusterResources, &out.IncludeClusterResources
 		*out = new(bool)
 		**out = **in
 	}
+	if in.PreserveNodePorts != nil {
+		in, out := &in.PreserveNodePorts, &out.PreserveNodePorts
+		*out = new(bool)
+		**out = **in
+	}
 	in.Hooks.DeepCopyInto(&out.Hooks)
+	if in.ResourceModifier != nil {
+		in, out := &in.ResourceModifier, &out.ResourceModifier
+		*out = new(corev1.TypedLocalObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourcePriorities != nil {
+		in, out := &in.ResourcePriorities, &out.ResourcePriorities
+		*out = new(RestoreResourcePriorities)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ItemLabels != nil {
+		in, out := &in.ItemLabels, &out.ItemLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ItemAnnotations != nil {
+		in, out := &in.ItemAnnotations, &out.ItemAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RestoreStatus != nil {
+		in, out := &in.RestoreStatus, &out.RestoreStatus
+		*out = new(RestoreStatusSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessGates != nil {
+		in, out := &in.ReadinessGates, &out.ReadinessGates
+		*out = make([]ReadinessGate, len(*in))
+		copy(*out, *in)
+	}
+	if in.StripFinalizers != nil {
+		in, out := &in.StripFinalizers, &out.StripFinalizers
+		*out = new(StripMetadataSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StripOwnerReferences != nil {
+		in, out := &in.StripOwnerReferences, &out.StripOwnerReferences
+		*out = new(StripMetadataSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceMappings != nil {
+		in, out := &in.ResourceMappings, &out.ResourceMappings
+		*out = make([]ResourceMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PVCResize != nil {
+		in, out := &in.PVCResize, &out.PVCResize
+		*out = new(PVCResizeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConflictPolicies != nil {
+		in, out := &in.ConflictPolicies, &out.ConflictPolicies
+		*out = make([]ConflictPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.APIRateLimit != nil {
+		in, out := &in.APIRateLimit, &out.APIRateLimit
+		*out = new(APIRateLimitSpec)
+		**out = **in
+	}
 	return
 }
 
@@ -1307,6 +2450,28 @@ func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
 		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.RenamedPersistentVolumes != nil {
+		in, out := &in.RenamedPersistentVolumes, &out.RenamedPersistentVolumes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.HookStatus != nil {
+		in, out := &in.HookStatus, &out.HookStatus
+		*out = new(HookStatus)
+		**out = **in
+	}
+	if in.PostRestoreActionStatus != nil {
+		in, out := &in.PostRestoreActionStatus, &out.PostRestoreActionStatus
+		*out = new(PostRestoreActionStatus)
+		**out = **in
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(RestoreProgress)
+		**out = **in
+	}
 	return
 }
 
@@ -1320,6 +2485,32 @@ func (in *RestoreStatus) DeepCopy() *RestoreStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreStatusSpec) DeepCopyInto(out *RestoreStatusSpec) {
+	*out = *in
+	if in.IncludedResources != nil {
+		in, out := &in.IncludedResources, &out.IncludedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedResources != nil {
+		in, out := &in.ExcludedResources, &out.ExcludedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreStatusSpec.
+func (in *RestoreStatusSpec) DeepCopy() *RestoreStatusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreStatusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Schedule) DeepCopyInto(out *Schedule) {
 	*out = *in
@@ -1385,6 +2576,19 @@ func (in *ScheduleList) DeepCopyObject() runtime.Object {
 func (in *ScheduleSpec) DeepCopyInto(out *ScheduleSpec) {
 	*out = *in
 	in.Template.DeepCopyInto(&out.Template)
+	out.Jitter = in.Jitter
+	if in.UseOwnerReferencesInBackup != nil {
+		in, out := &in.UseOwnerReferencesInBackup, &out.UseOwnerReferencesInBackup
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BlackoutWindows != nil {
+		in, out := &in.BlackoutWindows, &out.BlackoutWindows
+		*out = make([]BlackoutWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1405,6 +2609,10 @@ func (in *ScheduleStatus) DeepCopyInto(out *ScheduleStatus) {
 		in, out := &in.LastBackup, &out.LastBackup
 		*out = (*in).DeepCopy()
 	}
+	if in.LastSkipped != nil {
+		in, out := &in.LastSkipped, &out.LastSkipped
+		*out = (*in).DeepCopy()
+	}
 	if in.ValidationErrors != nil {
 		in, out := &in.ValidationErrors, &out.ValidationErrors
 		*out = make([]string, len(*in))
@@ -1423,6 +2631,22 @@ func (in *ScheduleStatus) DeepCopy() *ScheduleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerSideEncryption) DeepCopyInto(out *ServerSideEncryption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerSideEncryption.
+func (in *ServerSideEncryption) DeepCopy() *ServerSideEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerSideEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerStatusRequest) DeepCopyInto(out *ServerStatusRequest) {
 	*out = *in
@@ -1510,7 +2734,9 @@ func (in *ServerStatusRequestStatus) DeepCopyInto(out *ServerStatusRequestStatus
 	if in.Plugins != nil {
 		in, out := &in.Plugins, &out.Plugins
 		*out = make([]PluginInfo, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -1525,6 +2751,26 @@ func (in *ServerStatusRequestStatus) DeepCopy() *ServerStatusRequestStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotVolumeOverride) DeepCopyInto(out *SnapshotVolumeOverride) {
+	*out = *in
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotVolumeOverride.
+func (in *SnapshotVolumeOverride) DeepCopy() *SnapshotVolumeOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotVolumeOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageType) DeepCopyInto(out *StorageType) {
 	*out = *in
@@ -1546,6 +2792,73 @@ func (in *StorageType) DeepCopy() *StorageType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StripMetadataSpec) DeepCopyInto(out *StripMetadataSpec) {
+	*out = *in
+	if in.IncludedResources != nil {
+		in, out := &in.IncludedResources, &out.IncludedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedResources != nil {
+		in, out := &in.ExcludedResources, &out.ExcludedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StripMetadataSpec.
+func (in *StripMetadataSpec) DeepCopy() *StripMetadataSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StripMetadataSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumePolicy) DeepCopyInto(out *VolumePolicy) {
+	*out = *in
+	if in.StorageClasses != nil {
+		in, out := &in.StorageClasses, &out.StorageClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumeTypes != nil {
+		in, out := &in.VolumeTypes, &out.VolumeTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Drivers != nil {
+		in, out := &in.Drivers, &out.Drivers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinCapacity != nil {
+		in, out := &in.MinCapacity, &out.MinCapacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxCapacity != nil {
+		in, out := &in.MaxCapacity, &out.MaxCapacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumePolicy.
+func (in *VolumePolicy) DeepCopy() *VolumePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeSnapshotLocation) DeepCopyInto(out *VolumeSnapshotLocation) {
 	*out = *in
@@ -1617,6 +2930,21 @@ func (in *VolumeSnapshotLocationSpec) DeepCopyInto(out *VolumeSnapshotLocationSp
 			(*out)[key] = val
 		}
 	}
+	if in.Credential != nil {
+		in, out := &in.Credential, &out.Credential
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Identity != nil {
+		in, out := &in.Identity, &out.Identity
+		*out = new(AmbientIdentity)
+		**out = **in
+	}
+	if in.EgressProxy != nil {
+		in, out := &in.EgressProxy, &out.EgressProxy
+		*out = new(EgressProxy)
+		**out = **in
+	}
 	return
 }
 
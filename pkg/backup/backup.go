@@ -37,13 +37,16 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
@@ -66,13 +69,15 @@ type Backupper interface {
 
 // kubernetesBackupper implements Backupper.
 type kubernetesBackupper struct {
-	backupClient           velerov1client.BackupsGetter
-	dynamicFactory         client.DynamicFactory
-	discoveryHelper        discovery.Helper
-	podCommandExecutor     podexec.PodCommandExecutor
-	resticBackupperFactory restic.BackupperFactory
-	resticTimeout          time.Duration
-	defaultVolumesToRestic bool
+	backupClient             velerov1client.BackupsGetter
+	dynamicFactory           client.DynamicFactory
+	discoveryHelper          discovery.Helper
+	podCommandExecutor       podexec.PodCommandExecutor
+	resticBackupperFactory   restic.BackupperFactory
+	resticTimeout            time.Duration
+	defaultVolumesToFsBackup bool
+	credentialFileStore      credentials.FileStore
+	snapshotLimiter          *clientmgmt.SnapshotLimiter
 }
 
 type resolvedAction struct {
@@ -105,16 +110,20 @@ func NewKubernetesBackupper(
 	podCommandExecutor podexec.PodCommandExecutor,
 	resticBackupperFactory restic.BackupperFactory,
 	resticTimeout time.Duration,
-	defaultVolumesToRestic bool,
+	defaultVolumesToFsBackup bool,
+	credentialFileStore credentials.FileStore,
+	snapshotLimiter *clientmgmt.SnapshotLimiter,
 ) (Backupper, error) {
 	return &kubernetesBackupper{
-		backupClient:           backupClient,
-		discoveryHelper:        discoveryHelper,
-		dynamicFactory:         dynamicFactory,
-		podCommandExecutor:     podCommandExecutor,
-		resticBackupperFactory: resticBackupperFactory,
-		resticTimeout:          resticTimeout,
-		defaultVolumesToRestic: defaultVolumesToRestic,
+		backupClient:             backupClient,
+		discoveryHelper:          discoveryHelper,
+		dynamicFactory:           dynamicFactory,
+		podCommandExecutor:       podCommandExecutor,
+		resticBackupperFactory:   resticBackupperFactory,
+		resticTimeout:            resticTimeout,
+		defaultVolumesToFsBackup: defaultVolumesToFsBackup,
+		credentialFileStore:      credentialFileStore,
+		snapshotLimiter:          snapshotLimiter,
 	}, nil
 }
 
@@ -127,14 +136,9 @@ func resolveActions(actions []velero.BackupItemAction, helper discovery.Helper)
 			return nil, err
 		}
 
-		resources := getResourceIncludesExcludes(helper, resourceSelector.IncludedResources, resourceSelector.ExcludedResources)
-		namespaces := collections.NewIncludesExcludes().Includes(resourceSelector.IncludedNamespaces...).Excludes(resourceSelector.ExcludedNamespaces...)
-
-		selector := labels.Everything()
-		if resourceSelector.LabelSelector != "" {
-			if selector, err = labels.Parse(resourceSelector.LabelSelector); err != nil {
-				return nil, err
-			}
+		resources, namespaces, selector, err := resolveResourceSelector(resourceSelector, helper)
+		if err != nil {
+			return nil, err
 		}
 
 		res := resolvedAction{
@@ -150,6 +154,23 @@ func resolveActions(actions []velero.BackupItemAction, helper discovery.Helper)
 	return resolved, nil
 }
 
+// resolveResourceSelector returns the resources, namespaces and labels that an action's
+// resource selector matches.
+func resolveResourceSelector(resourceSelector velero.ResourceSelector, helper discovery.Helper) (*collections.IncludesExcludes, *collections.IncludesExcludes, labels.Selector, error) {
+	resources := getResourceIncludesExcludes(helper, resourceSelector.IncludedResources, resourceSelector.ExcludedResources)
+	namespaces := collections.NewIncludesExcludes().Includes(resourceSelector.IncludedNamespaces...).Excludes(resourceSelector.ExcludedNamespaces...)
+
+	selector := labels.Everything()
+	if resourceSelector.LabelSelector != "" {
+		var err error
+		if selector, err = labels.Parse(resourceSelector.LabelSelector); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	return resources, namespaces, selector, nil
+}
+
 // getResourceIncludesExcludes takes the lists of resources to include and exclude, uses the
 // discovery helper to resolve them to fully-qualified group-resource names, and returns an
 // IncludesExcludes list.
@@ -180,6 +201,12 @@ func getNamespaceIncludesExcludes(backup *velerov1api.Backup) *collections.Inclu
 	return collections.NewIncludesExcludes().Includes(backup.Spec.IncludedNamespaces...).Excludes(backup.Spec.ExcludedNamespaces...)
 }
 
+// getAPIGroupIncludesExcludes returns an IncludesExcludes list containing which API groups to
+// include and exclude from the backup.
+func getAPIGroupIncludesExcludes(backup *velerov1api.Backup) *collections.IncludesExcludes {
+	return collections.NewIncludesExcludes().Includes(backup.Spec.IncludedAPIGroups...).Excludes(backup.Spec.ExcludedAPIGroups...)
+}
+
 func getResourceHooks(hookSpecs []velerov1api.BackupResourceHookSpec, discoveryHelper discovery.Helper) ([]hook.ResourceHook, error) {
 	resourceHooks := make([]hook.ResourceHook, 0, len(hookSpecs))
 
@@ -206,6 +233,17 @@ func getResourceHook(hookSpec velerov1api.BackupResourceHookSpec, discoveryHelpe
 		Post: hookSpec.PostHooks,
 	}
 
+	// the template's hooks quiesce the application right before the item is backed up, and
+	// unquiesce it as soon as it's been backed up.
+	if hookSpec.Template != nil {
+		pre, post, err := hook.TemplateHooks(hookSpec.Template)
+		if err != nil {
+			return hook.ResourceHook{}, errors.WithMessagef(err, "invalid hook template in hook spec %s", hookSpec.Name)
+		}
+		h.Pre = append(append([]velerov1api.BackupResourceHook{}, hookSpec.PreHooks...), velerov1api.BackupResourceHook{Exec: pre})
+		h.Post = append([]velerov1api.BackupResourceHook{{Exec: post}}, hookSpec.PostHooks...)
+	}
+
 	if hookSpec.LabelSelector != nil {
 		labelSelector, err := metav1.LabelSelectorAsSelector(hookSpec.LabelSelector)
 		if err != nil {
@@ -245,7 +283,11 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 	backupRequest.ResourceIncludesExcludes = getResourceIncludesExcludes(kb.discoveryHelper, backupRequest.Spec.IncludedResources, backupRequest.Spec.ExcludedResources)
 	log.Infof("Including resources: %s", backupRequest.ResourceIncludesExcludes.IncludesString())
 	log.Infof("Excluding resources: %s", backupRequest.ResourceIncludesExcludes.ExcludesString())
-	log.Infof("Backing up all pod volumes using restic: %t", *backupRequest.Backup.Spec.DefaultVolumesToRestic)
+
+	backupRequest.APIGroupIncludesExcludes = getAPIGroupIncludesExcludes(backupRequest.Backup)
+	log.Infof("Including API groups: %s", backupRequest.APIGroupIncludesExcludes.IncludesString())
+	log.Infof("Excluding API groups: %s", backupRequest.APIGroupIncludesExcludes.ExcludesString())
+	log.Infof("Backing up all pod volumes using file system backup: %t", *backupRequest.Backup.Spec.DefaultVolumesToFsBackup)
 
 	var err error
 	backupRequest.ResourceHooks, err = getResourceHooks(backupRequest.Spec.Hooks.Resources, kb.discoveryHelper)
@@ -258,6 +300,11 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		return err
 	}
 
+	backupRequest.ResolvedItemBlockActions, err = resolveItemBlockActions(backupRequest.ItemBlockActions, kb.discoveryHelper)
+	if err != nil {
+		return err
+	}
+
 	backupRequest.BackedUpItems = map[itemKey]struct{}{}
 
 	podVolumeTimeout := kb.resticTimeout
@@ -315,6 +362,11 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		resticBackupper:         resticBackupper,
 		resticSnapshotTracker:   newPVCSnapshotTracker(),
 		volumeSnapshotterGetter: volumeSnapshotterGetter,
+		credentialFileStore:     kb.credentialFileStore,
+		snapshotLimiter:         kb.snapshotLimiter,
+		volumePolicies:          make(map[string]velerov1api.VolumePolicyAction),
+		snapshottedPVs:          sets.NewString(),
+		snapshotGroups:          sets.NewString(),
 		itemHookHandler: &hook.DefaultItemHookHandler{
 			PodCommandExecutor: kb.podCommandExecutor,
 		},
@@ -395,8 +447,11 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 				return
 			}
 
-			if backedUp := kb.backupItem(log, item.groupResource, itemBackupper, &unstructured, item.preferredGVR); backedUp {
-				backedUpGroupResources[item.groupResource] = true
+			// back up the items of the item's block, if it's in one, along with it.
+			for _, blockItem := range itemBackupper.getItemBlock(&unstructured, item.groupResource, item.preferredGVR, log) {
+				if backedUp := kb.backupItem(log, blockItem.groupResource, itemBackupper, blockItem.obj, blockItem.preferredGVR); backedUp {
+					backedUpGroupResources[blockItem.groupResource] = true
+				}
 			}
 		}()
 
@@ -442,6 +497,9 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 
 	log.WithField("progress", "").Infof("Backed up a total of %d items", len(backupRequest.BackedUpItems))
 
+	itemBackupper.verifySnapshots(log)
+	itemBackupper.recordSnapshotSizes(log)
+
 	return nil
 }
 
@@ -714,6 +714,68 @@ func TestBackupResourceFiltering(t *testing.T) {
 				"resources/deployments.apps/v1-preferredversion/namespaces/zoo/raz.json",
 			},
 		},
+		{
+			name: "included API groups filter only backs up resources in those groups",
+			backup: defaultBackup().
+				IncludedAPIGroups("apps").
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar").Result(),
+					builder.ForPod("zoo", "raz").Result(),
+				),
+				test.Deployments(
+					builder.ForDeployment("foo", "bar").Result(),
+					builder.ForDeployment("zoo", "raz").Result(),
+				),
+			},
+			want: []string{
+				"resources/deployments.apps/namespaces/foo/bar.json",
+				"resources/deployments.apps/namespaces/zoo/raz.json",
+				"resources/deployments.apps/v1-preferredversion/namespaces/foo/bar.json",
+				"resources/deployments.apps/v1-preferredversion/namespaces/zoo/raz.json",
+			},
+		},
+		{
+			name: "core API group can be included by name",
+			backup: defaultBackup().
+				IncludedAPIGroups("core").
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar").Result(),
+				),
+				test.Deployments(
+					builder.ForDeployment("foo", "bar").Result(),
+				),
+			},
+			want: []string{
+				"resources/pods/namespaces/foo/bar.json",
+				"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+			},
+		},
+		{
+			name: "excluded API groups filter supports wildcards",
+			backup: defaultBackup().
+				ExcludedAPIGroups("ap*").
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar").Result(),
+					builder.ForPod("zoo", "raz").Result(),
+				),
+				test.Deployments(
+					builder.ForDeployment("foo", "bar").Result(),
+					builder.ForDeployment("zoo", "raz").Result(),
+				),
+			},
+			want: []string{
+				"resources/pods/namespaces/foo/bar.json",
+				"resources/pods/namespaces/zoo/raz.json",
+				"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+				"resources/pods/v1-preferredversion/namespaces/zoo/raz.json",
+			},
+		},
 		{
 			name:   "terminating resources are not backed up",
 			backup: defaultBackup().Result(),
@@ -1902,6 +1964,33 @@ func (*fakeVolumeSnapshotter) DeleteSnapshot(snapshotID string) error {
 	panic("DeleteSnapshot should not be used for backups")
 }
 
+// fakeVolumeSnapshotterV2 is a test fake for the velero.VolumeSnapshotterV2 interface, whose
+// snapshots are still in progress once they've been started.
+type fakeVolumeSnapshotterV2 struct {
+	*fakeVolumeSnapshotter
+}
+
+// StartSnapshot creates the snapshot like CreateSnapshot, and returns an operationID
+// of "<volumeID>-operation".
+func (vs *fakeVolumeSnapshotterV2) StartSnapshot(volumeID, volumeAZ string, tags map[string]string) (string, string, error) {
+	snapshotID, err := vs.CreateSnapshot(volumeID, volumeAZ, tags)
+	if err != nil {
+		return "", "", err
+	}
+
+	return snapshotID, volumeID + "-operation", nil
+}
+
+// SnapshotProgress panics because it's not expected to be used for backups.
+func (*fakeVolumeSnapshotterV2) SnapshotProgress(operationID string) (velero.OperationProgress, error) {
+	panic("SnapshotProgress should not be used for backups")
+}
+
+// CancelSnapshot panics because it's not expected to be used for backups.
+func (*fakeVolumeSnapshotterV2) CancelSnapshot(operationID string) error {
+	panic("CancelSnapshot should not be used for backups")
+}
+
 // TestBackupWithSnapshots runs backups with volume snapshot locations and volume snapshotters
 // configured and verifies that snapshots are created as appropriate. Verification is done by
 // looking at the backup request's VolumeSnapshots field. This test uses the fakeVolumeSnapshotter
@@ -2082,6 +2171,40 @@ func TestBackupWithSnapshots(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "version 2 volume snapshotter results in a snapshot that's in progress",
+			req: &Request{
+				Backup: defaultBackup().Result(),
+				SnapshotLocations: []*velerov1.VolumeSnapshotLocation{
+					newSnapshotLocation("velero", "default", "default"),
+				},
+			},
+			apiResources: []*test.APIResource{
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").Result(),
+				),
+			},
+			snapshotterGetter: map[string]velero.VolumeSnapshotter{
+				"default": &fakeVolumeSnapshotterV2{new(fakeVolumeSnapshotter).WithVolume("pv-1", "vol-1", "", "type-1", 100, false)},
+			},
+			want: []*volume.Snapshot{
+				{
+					Spec: volume.SnapshotSpec{
+						BackupName:           "backup-1",
+						Location:             "default",
+						PersistentVolumeName: "pv-1",
+						ProviderVolumeID:     "vol-1",
+						VolumeType:           "type-1",
+						VolumeIOPS:           int64Ptr(100),
+					},
+					Status: volume.SnapshotStatus{
+						Phase:               volume.SnapshotPhaseInProgress,
+						ProviderSnapshotID:  "vol-1-snapshot",
+						ProviderOperationID: "vol-1-operation",
+					},
+				},
+			},
+		},
 		{
 			name: "backup with SnapshotVolumes=false does not create any snapshots",
 			req: &Request{
@@ -2100,6 +2223,70 @@ func TestBackupWithSnapshots(t *testing.T) {
 			},
 			want: nil,
 		},
+		{
+			name: "persistent volume whose claim's volume policy is Skip is not snapshotted",
+			req: &Request{
+				Backup: defaultBackup().Result(),
+				SnapshotLocations: []*velerov1.VolumeSnapshotLocation{
+					newSnapshotLocation("velero", "default", "default"),
+				},
+			},
+			apiResources: []*test.APIResource{
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").
+						ObjectMeta(builder.WithAnnotations("backup.velero.io/volume-policy", "Skip")).
+						VolumeName("pv-1").
+						Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").Result(),
+				),
+			},
+			snapshotterGetter: map[string]velero.VolumeSnapshotter{
+				"default": new(fakeVolumeSnapshotter).WithVolume("pv-1", "vol-1", "", "type-1", 100, false),
+			},
+			want: nil,
+		},
+		{
+			name: "persistent volume matching a backup volume policy for its storage class is not snapshotted",
+			req: &Request{
+				Backup: defaultBackup().
+					VolumePolicies(velerov1.VolumePolicy{StorageClasses: []string{"nfs"}, Action: velerov1.VolumePolicyActionRestic}).
+					Result(),
+				SnapshotLocations: []*velerov1.VolumeSnapshotLocation{
+					newSnapshotLocation("velero", "default", "default"),
+				},
+			},
+			apiResources: []*test.APIResource{
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").StorageClass("nfs").Result(),
+				),
+			},
+			snapshotterGetter: map[string]velero.VolumeSnapshotter{
+				"default": new(fakeVolumeSnapshotter).WithVolume("pv-1", "vol-1", "", "type-1", 100, false),
+			},
+			want: nil,
+		},
+		{
+			name: "persistent volume matching a backup volume policy for its type is not snapshotted",
+			req: &Request{
+				Backup: defaultBackup().
+					VolumePolicies(velerov1.VolumePolicy{VolumeTypes: []string{"awsElasticBlockStore"}, Action: velerov1.VolumePolicyActionSkip}).
+					Result(),
+				SnapshotLocations: []*velerov1.VolumeSnapshotLocation{
+					newSnapshotLocation("velero", "default", "default"),
+				},
+			},
+			apiResources: []*test.APIResource{
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").AWSEBSVolumeID("vol-1").Result(),
+				),
+			},
+			snapshotterGetter: map[string]velero.VolumeSnapshotter{
+				"default": new(fakeVolumeSnapshotter).WithVolume("pv-1", "vol-1", "", "type-1", 100, false),
+			},
+			want: nil,
+		},
 		{
 			name: "backup with no volume snapshot locations does not create any snapshots",
 			req: &Request{
@@ -2560,6 +2747,14 @@ func (b *fakeResticBackupper) BackupPodVolumes(backup *velerov1.Backup, pod *cor
 	return res, nil
 }
 
+// BackupPVC returns one pod volume backup, with namespace "velero" and name
+// "pvb-<pvc-namespace>-<pvc-name>".
+func (b *fakeResticBackupper) BackupPVC(backup *velerov1.Backup, pvc *corev1.PersistentVolumeClaim, _ logrus.FieldLogger) ([]*velerov1.PodVolumeBackup, []error) {
+	return []*velerov1.PodVolumeBackup{
+		builder.ForPodVolumeBackup("velero", fmt.Sprintf("pvb-%s-%s", pvc.Namespace, pvc.Name)).Result(),
+	}, nil
+}
+
 // TestBackupWithRestic runs backups of pods that are annotated for restic backup,
 // and ensures that the restic backupper is called, that the returned PodVolumeBackups
 // are added to the Request object, and that when PVCs are backed up with restic, the
@@ -2585,6 +2780,42 @@ func TestBackupWithRestic(t *testing.T) {
 				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pod-1-foo").Result(),
 			},
 		},
+		{
+			name:   "a pod volume whose policy in the pod's annotation is Restic should result in a pod volume backup being returned",
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").
+						ObjectMeta(builder.WithAnnotations("backup.velero.io/volume-policies", "foo=Restic,bar=Snapshot")).
+						Volumes(
+							builder.ForVolume("foo").PersistentVolumeClaimSource("pvc-1").Result(),
+							builder.ForVolume("bar").PersistentVolumeClaimSource("pvc-2").Result(),
+						).
+						Result(),
+				),
+			},
+			want: []*velerov1.PodVolumeBackup{
+				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pod-1-foo").Result(),
+			},
+		},
+		{
+			name:   "a pod volume annotated for restic backup whose claim's volume policy is Skip should not be backed up",
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").
+						ObjectMeta(builder.WithAnnotations("backup.velero.io/backup-volumes", "foo")).
+						Volumes(builder.ForVolume("foo").PersistentVolumeClaimSource("pvc-1").Result()).
+						Result(),
+				),
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").
+						ObjectMeta(builder.WithAnnotations("backup.velero.io/volume-policy", "Skip")).
+						Result(),
+				),
+			},
+			want: nil,
+		},
 		{
 			name:   "when a PVC is used by two pods and annotated for restic backup on both, only one should be backed up",
 			backup: defaultBackup().Result(),
@@ -2642,6 +2873,40 @@ func TestBackupWithRestic(t *testing.T) {
 				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pod-1-vol-2").Result(),
 			},
 		},
+		{
+			name:   "when unmounted volumes are backed up with restic, PVCs that aren't backed up from a pod are backed up, and their PVs aren't snapshotted",
+			backup: defaultBackup().UnmountedVolumesToRestic(true).Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").
+						Volumes(
+							builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+						).
+						ObjectMeta(
+							builder.WithAnnotations("backup.velero.io/backup-volumes", "vol-1"),
+						).
+						Result(),
+				),
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-2").VolumeName("pv-2").Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").Result(),
+					builder.ForPersistentVolume("pv-2").ClaimRef("ns-1", "pvc-2").Result(),
+				),
+			},
+			vsl: newSnapshotLocation("velero", "default", "default"),
+			snapshotterGetter: map[string]velero.VolumeSnapshotter{
+				"default": new(fakeVolumeSnapshotter).
+					WithVolume("pv-1", "vol-1", "", "type-1", 100, false).
+					WithVolume("pv-2", "vol-2", "", "type-1", 100, false),
+			},
+			want: []*velerov1.PodVolumeBackup{
+				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pod-1-vol-1").Result(),
+				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pvc-2").Result(),
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -2752,7 +3017,7 @@ func newSnapshotLocation(ns, name, provider string) *velerov1.VolumeSnapshotLoca
 }
 
 func defaultBackup() *builder.BackupBuilder {
-	return builder.ForBackup(velerov1.DefaultNamespace, "backup-1").DefaultVolumesToRestic(false)
+	return builder.ForBackup(velerov1.DefaultNamespace, "backup-1").DefaultVolumesToFsBackup(false)
 }
 
 func toUnstructuredOrFail(t *testing.T, obj interface{}) map[string]interface{} {
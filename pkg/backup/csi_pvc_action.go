@@ -0,0 +1,232 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+
+	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/v2/pkg/apis/volumesnapshot/v1beta1"
+	snapshotv1beta1client "github.com/kubernetes-csi/external-snapshotter/v2/pkg/client/clientset/versioned/typed/volumesnapshot/v1beta1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// nativeCSIEnabled returns whether Velero's built-in actions snapshot CSI volumes.
+func nativeCSIEnabled() bool {
+	return features.IsEnabled(velerov1api.CSIFeatureFlag) && features.IsEnabled(velerov1api.NativeCSIFeatureFlag)
+}
+
+// CSIPVCAction snapshots the volumes of PersistentVolumeClaims that are provisioned by
+// CSI drivers, by creating a VolumeSnapshot for each one.
+type CSIPVCAction struct {
+	log             logrus.FieldLogger
+	pvClient        corev1client.PersistentVolumesGetter
+	podClient       corev1client.PodsGetter
+	configMapClient corev1client.ConfigMapsGetter
+	snapshotClient  snapshotv1beta1client.SnapshotV1beta1Interface
+}
+
+// NewCSIPVCAction creates a new ItemAction for CSI PersistentVolumeClaims.
+func NewCSIPVCAction(
+	logger logrus.FieldLogger,
+	pvClient corev1client.PersistentVolumesGetter,
+	podClient corev1client.PodsGetter,
+	configMapClient corev1client.ConfigMapsGetter,
+	snapshotClient snapshotv1beta1client.SnapshotV1beta1Interface,
+) *CSIPVCAction {
+	return &CSIPVCAction{
+		log:             logger,
+		pvClient:        pvClient,
+		podClient:       podClient,
+		configMapClient: configMapClient,
+		snapshotClient:  snapshotClient,
+	}
+}
+
+// AppliesTo returns a ResourceSelector that applies only to persistent volume claims.
+func (a *CSIPVCAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"persistentvolumeclaims"},
+	}, nil
+}
+
+// Execute creates a VolumeSnapshot of the PersistentVolumeClaim if its volume is provisioned
+// by a CSI driver, and returns the VolumeSnapshot as an additional item. The claim is
+// annotated with the VolumeSnapshot's name so that it's restored from the snapshot.
+func (a *CSIPVCAction) Execute(item runtime.Unstructured, backup *velerov1api.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+	if !nativeCSIEnabled() {
+		return item, nil, nil
+	}
+
+	if boolptr.IsSetToFalse(backup.Spec.SnapshotVolumes) {
+		a.log.Info("Skipping CSI snapshot of persistent volume claim because volume snapshots are disabled for the backup")
+		return item, nil, nil
+	}
+
+	var pvc corev1api.PersistentVolumeClaim
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), &pvc); err != nil {
+		return nil, nil, errors.Wrap(err, "unable to convert unstructured item to persistent volume claim")
+	}
+
+	if pvc.Status.Phase != corev1api.ClaimBound || pvc.Spec.VolumeName == "" {
+		return item, nil, nil
+	}
+
+	log := a.log.WithField("persistentVolumeClaim", pvc.Namespace+"/"+pvc.Name)
+
+	pv, err := a.pvClient.PersistentVolumes().Get(context.TODO(), pvc.Spec.VolumeName, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error getting persistent volume %s", pvc.Spec.VolumeName)
+	}
+	if pv.Spec.CSI == nil {
+		log.Debug("Skipping CSI snapshot of persistent volume claim because its volume isn't provisioned by a CSI driver")
+		return item, nil, nil
+	}
+
+	policies := backup.Spec.VolumePolicies
+	if backup.Spec.ResourcePolicy != nil {
+		resourcePolicies, err := resourcepolicies.GetResourcePolicies(a.configMapClient, backup.Namespace, backup.Spec.ResourcePolicy)
+		if err != nil {
+			return nil, nil, err
+		}
+		policies = append(append([]velerov1api.VolumePolicy{}, policies...), resourcePolicies.VolumePolicies...)
+	}
+
+	podAction, err := a.podVolumePolicy(&pvc, log)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch action := getVolumePolicy(policies, podAction, &pvc, pv, log); action {
+	case velerov1api.VolumePolicyActionRestic, velerov1api.VolumePolicyActionSkip:
+		log.Infof("Skipping CSI snapshot of persistent volume claim because its volume policy is %s", action)
+		return item, nil, nil
+	}
+
+	class, err := a.getVolumeSnapshotClass(pv.Spec.CSI.Driver)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshot := &snapshotv1beta1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    pvc.Namespace,
+			GenerateName: "velero-" + pvc.Name + "-",
+			Labels: map[string]string{
+				velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
+			},
+		},
+		Spec: snapshotv1beta1api.VolumeSnapshotSpec{
+			Source: snapshotv1beta1api.VolumeSnapshotSource{
+				PersistentVolumeClaimName: &pvc.Name,
+			},
+			VolumeSnapshotClassName: &class.Name,
+		},
+	}
+
+	snapshot, err = a.snapshotClient.VolumeSnapshots(pvc.Namespace).Create(context.TODO(), snapshot, metav1.CreateOptions{})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error creating volume snapshot")
+	}
+	log.Infof("Created volume snapshot %s with volume snapshot class %s", snapshot.Name, class.Name)
+
+	if pvc.Annotations == nil {
+		pvc.Annotations = make(map[string]string)
+	}
+	pvc.Annotations[velerov1api.VolumeSnapshotAnnotation] = snapshot.Name
+
+	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&pvc)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "unable to convert persistent volume claim to unstructured item")
+	}
+
+	additionalItems := []velero.ResourceIdentifier{
+		{
+			GroupResource: kuberesource.VolumeSnapshots,
+			Namespace:     snapshot.Namespace,
+			Name:          snapshot.Name,
+		},
+	}
+
+	return &unstructured.Unstructured{Object: res}, additionalItems, nil
+}
+
+// podVolumePolicy returns the policy that the annotation of a pod that mounts a persistent
+// volume claim chooses for the claim's volume, or an empty action if none does. If pods
+// choose different policies, the first pod's policy is used.
+func (a *CSIPVCAction) podVolumePolicy(pvc *corev1api.PersistentVolumeClaim, log logrus.FieldLogger) (velerov1api.VolumePolicyAction, error) {
+	pods, err := a.podClient.Pods(pvc.Namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return "", errors.Wrapf(err, "error listing pods in namespace %s", pvc.Namespace)
+	}
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim == nil || volume.PersistentVolumeClaim.ClaimName != pvc.Name {
+				continue
+			}
+			if action := podVolumePolicies(pod, log)[volume.Name]; action != "" {
+				return action, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// getVolumeSnapshotClass returns the VolumeSnapshotClass that's labeled for Velero to
+// snapshot the volumes of driver with.
+func (a *CSIPVCAction) getVolumeSnapshotClass(driver string) (*snapshotv1beta1api.VolumeSnapshotClass, error) {
+	selector := labels.SelectorFromSet(labels.Set{velerov1api.VolumeSnapshotClassSelectorLabel: "true"})
+
+	classes, err := a.snapshotClient.VolumeSnapshotClasses().List(context.TODO(), metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing volume snapshot classes")
+	}
+
+	var res *snapshotv1beta1api.VolumeSnapshotClass
+	for i := range classes.Items {
+		if classes.Items[i].Driver != driver {
+			continue
+		}
+		if res != nil {
+			return nil, errors.Errorf("more than one volume snapshot class with the %s label has driver %s", velerov1api.VolumeSnapshotClassSelectorLabel, driver)
+		}
+		res = &classes.Items[i]
+	}
+
+	if res == nil {
+		return nil, errors.Errorf("no volume snapshot class with the %s label has driver %s", velerov1api.VolumeSnapshotClassSelectorLabel, driver)
+	}
+
+	return res, nil
+}
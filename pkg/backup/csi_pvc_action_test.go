@@ -0,0 +1,270 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"testing"
+
+	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/v2/pkg/apis/volumesnapshot/v1beta1"
+	snapshotfake "github.com/kubernetes-csi/external-snapshotter/v2/pkg/client/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// generateNames makes a fake snapshot clientset set the names of the objects it creates
+// from their generate names, like the API server does.
+func generateNames(client *snapshotfake.Clientset) {
+	client.PrependReactor("create", "*", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		obj := action.(clienttesting.CreateAction).GetObject().(metav1.Object)
+		if obj.GetName() == "" {
+			obj.SetName(obj.GetGenerateName() + "abcde")
+		}
+		return false, nil, nil
+	})
+}
+
+func newVolumeSnapshotClass(name, driver string, selected bool) *snapshotv1beta1api.VolumeSnapshotClass {
+	class := &snapshotv1beta1api.VolumeSnapshotClass{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Driver:     driver,
+	}
+	if selected {
+		class.Labels = map[string]string{velerov1api.VolumeSnapshotClassSelectorLabel: "true"}
+	}
+	return class
+}
+
+func TestCSIPVCActionExecute(t *testing.T) {
+	boundPVC := func() *corev1api.PersistentVolumeClaim {
+		pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result()
+		pvc.Status.Phase = corev1api.ClaimBound
+		return pvc
+	}
+
+	tests := []struct {
+		name                string
+		featureFlags        []string
+		backup              *velerov1api.Backup
+		pvc                 *corev1api.PersistentVolumeClaim
+		pv                  *corev1api.PersistentVolume
+		pods                []*corev1api.Pod
+		classes             []*snapshotv1beta1api.VolumeSnapshotClass
+		wantSnapshotClass   string
+		wantAdditionalItems []velero.ResourceIdentifier
+		wantErr             string
+	}{
+		{
+			name:   "claim is left alone when native CSI snapshots aren't enabled",
+			backup: builder.ForBackup("velero", "backup-1").Result(),
+			pvc:    boundPVC(),
+			pv:     builder.ForPersistentVolume("pv-1").CSI("csi.example.com", "vol-1").Result(),
+		},
+		{
+			name:         "claim is left alone when volume snapshots are disabled for the backup",
+			featureFlags: []string{velerov1api.CSIFeatureFlag, velerov1api.NativeCSIFeatureFlag},
+			backup:       builder.ForBackup("velero", "backup-1").SnapshotVolumes(false).Result(),
+			pvc:          boundPVC(),
+			pv:           builder.ForPersistentVolume("pv-1").CSI("csi.example.com", "vol-1").Result(),
+		},
+		{
+			name:         "claim is left alone when its volume policy is Skip",
+			featureFlags: []string{velerov1api.CSIFeatureFlag, velerov1api.NativeCSIFeatureFlag},
+			backup:       builder.ForBackup("velero", "backup-1").Result(),
+			pvc: func() *corev1api.PersistentVolumeClaim {
+				pvc := boundPVC()
+				pvc.Annotations = map[string]string{velerov1api.VolumePolicyAnnotation: "Skip"}
+				return pvc
+			}(),
+			pv: builder.ForPersistentVolume("pv-1").CSI("csi.example.com", "vol-1").Result(),
+		},
+		{
+			name:         "claim is left alone when the annotation of a pod that mounts it backs up its volume with restic",
+			featureFlags: []string{velerov1api.CSIFeatureFlag, velerov1api.NativeCSIFeatureFlag},
+			backup:       builder.ForBackup("velero", "backup-1").Result(),
+			pvc:          boundPVC(),
+			pv:           builder.ForPersistentVolume("pv-1").CSI("csi.example.com", "vol-1").Result(),
+			pods: []*corev1api.Pod{
+				builder.ForPod("ns-1", "pod-1").
+					ObjectMeta(builder.WithAnnotations(velerov1api.PodVolumePoliciesAnnotation, "data=Restic")).
+					Volumes(builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result()).
+					Result(),
+			},
+		},
+		{
+			name:         "volume snapshot is created when the pod annotation only chooses policies for other claims",
+			featureFlags: []string{velerov1api.CSIFeatureFlag, velerov1api.NativeCSIFeatureFlag},
+			backup:       builder.ForBackup("velero", "backup-1").Result(),
+			pvc:          boundPVC(),
+			pv:           builder.ForPersistentVolume("pv-1").CSI("csi.example.com", "vol-1").Result(),
+			pods: []*corev1api.Pod{
+				builder.ForPod("ns-1", "pod-1").
+					ObjectMeta(builder.WithAnnotations(velerov1api.PodVolumePoliciesAnnotation, "data=Restic")).
+					Volumes(
+						builder.ForVolume("data").PersistentVolumeClaimSource("pvc-2").Result(),
+						builder.ForVolume("other").PersistentVolumeClaimSource("pvc-1").Result(),
+					).
+					Result(),
+				builder.ForPod("ns-2", "pod-2").
+					ObjectMeta(builder.WithAnnotations(velerov1api.PodVolumePoliciesAnnotation, "data=Restic")).
+					Volumes(builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result()).
+					Result(),
+			},
+			classes: []*snapshotv1beta1api.VolumeSnapshotClass{
+				newVolumeSnapshotClass("selected", "csi.example.com", true),
+			},
+			wantSnapshotClass: "selected",
+			wantAdditionalItems: []velero.ResourceIdentifier{
+				{GroupResource: kuberesource.VolumeSnapshots, Namespace: "ns-1", Name: "velero-pvc-1-abcde"},
+			},
+		},
+		{
+			name:         "claim is left alone when a backup volume policy backs up its volume with restic",
+			featureFlags: []string{velerov1api.CSIFeatureFlag, velerov1api.NativeCSIFeatureFlag},
+			backup: builder.ForBackup("velero", "backup-1").
+				VolumePolicies(velerov1api.VolumePolicy{VolumeTypes: []string{"csi"}, Action: velerov1api.VolumePolicyActionRestic}).
+				Result(),
+			pvc: boundPVC(),
+			pv:  builder.ForPersistentVolume("pv-1").CSI("csi.example.com", "vol-1").Result(),
+		},
+		{
+			name:         "claim is left alone when a policy of the backup's resource policies skips its volume's driver",
+			featureFlags: []string{velerov1api.CSIFeatureFlag, velerov1api.NativeCSIFeatureFlag},
+			backup:       builder.ForBackup("velero", "backup-1").ResourcePolicy("policies").Result(),
+			pvc:          boundPVC(),
+			pv:           builder.ForPersistentVolume("pv-1").CSI("csi.example.com", "vol-1").Result(),
+		},
+		{
+			name:         "claim is left alone when it isn't bound",
+			featureFlags: []string{velerov1api.CSIFeatureFlag, velerov1api.NativeCSIFeatureFlag},
+			backup:       builder.ForBackup("velero", "backup-1").Result(),
+			pvc:          builder.ForPersistentVolumeClaim("ns-1", "pvc-1").Result(),
+		},
+		{
+			name:         "claim is left alone when its volume isn't provisioned by a CSI driver",
+			featureFlags: []string{velerov1api.CSIFeatureFlag, velerov1api.NativeCSIFeatureFlag},
+			backup:       builder.ForBackup("velero", "backup-1").Result(),
+			pvc:          boundPVC(),
+			pv:           builder.ForPersistentVolume("pv-1").AWSEBSVolumeID("vol-1").Result(),
+		},
+		{
+			name:         "volume snapshot is created with the labeled class of the volume's driver",
+			featureFlags: []string{velerov1api.CSIFeatureFlag, velerov1api.NativeCSIFeatureFlag},
+			backup:       builder.ForBackup("velero", "backup-1").Result(),
+			pvc:          boundPVC(),
+			pv:           builder.ForPersistentVolume("pv-1").CSI("csi.example.com", "vol-1").Result(),
+			classes: []*snapshotv1beta1api.VolumeSnapshotClass{
+				newVolumeSnapshotClass("unlabeled", "csi.example.com", false),
+				newVolumeSnapshotClass("other-driver", "csi.other.com", true),
+				newVolumeSnapshotClass("selected", "csi.example.com", true),
+			},
+			wantSnapshotClass: "selected",
+			wantAdditionalItems: []velero.ResourceIdentifier{
+				{GroupResource: kuberesource.VolumeSnapshots, Namespace: "ns-1", Name: "velero-pvc-1-abcde"},
+			},
+		},
+		{
+			name:         "error is returned when no class is labeled for the volume's driver",
+			featureFlags: []string{velerov1api.CSIFeatureFlag, velerov1api.NativeCSIFeatureFlag},
+			backup:       builder.ForBackup("velero", "backup-1").Result(),
+			pvc:          boundPVC(),
+			pv:           builder.ForPersistentVolume("pv-1").CSI("csi.example.com", "vol-1").Result(),
+			classes: []*snapshotv1beta1api.VolumeSnapshotClass{
+				newVolumeSnapshotClass("unlabeled", "csi.example.com", false),
+			},
+			wantErr: "no volume snapshot class with the velero.io/csi-volumesnapshot-class label has driver csi.example.com",
+		},
+		{
+			name:         "error is returned when more than one class is labeled for the volume's driver",
+			featureFlags: []string{velerov1api.CSIFeatureFlag, velerov1api.NativeCSIFeatureFlag},
+			backup:       builder.ForBackup("velero", "backup-1").Result(),
+			pvc:          boundPVC(),
+			pv:           builder.ForPersistentVolume("pv-1").CSI("csi.example.com", "vol-1").Result(),
+			classes: []*snapshotv1beta1api.VolumeSnapshotClass{
+				newVolumeSnapshotClass("class-1", "csi.example.com", true),
+				newVolumeSnapshotClass("class-2", "csi.example.com", true),
+			},
+			wantErr: "more than one volume snapshot class with the velero.io/csi-volumesnapshot-class label has driver csi.example.com",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			features.NewFeatureFlagSet(tc.featureFlags...)
+			defer features.NewFeatureFlagSet()
+
+			kubeClient := fake.NewSimpleClientset(
+				builder.ForConfigMap("velero", "policies").Data("policies.yaml", "version: v1\nvolumePolicies:\n- drivers: [csi.example.com]\n  action: Skip\n").Result(),
+			)
+			if tc.pv != nil {
+				require.NoError(t, kubeClient.Tracker().Add(tc.pv))
+			}
+			for _, pod := range tc.pods {
+				require.NoError(t, kubeClient.Tracker().Add(pod))
+			}
+			snapshotClient := snapshotfake.NewSimpleClientset()
+			generateNames(snapshotClient)
+			for _, class := range tc.classes {
+				require.NoError(t, snapshotClient.Tracker().Add(class))
+			}
+
+			pvcMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.pvc)
+			require.NoError(t, err)
+
+			a := NewCSIPVCAction(velerotest.NewLogger(), kubeClient.CoreV1(), kubeClient.CoreV1(), kubeClient.CoreV1(), snapshotClient.SnapshotV1beta1())
+			item, additionalItems, err := a.Execute(&unstructured.Unstructured{Object: pvcMap}, tc.backup)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantAdditionalItems, additionalItems)
+
+			snapshots, err := snapshotClient.SnapshotV1beta1().VolumeSnapshots("ns-1").List(context.TODO(), metav1.ListOptions{})
+			require.NoError(t, err)
+
+			var pvc corev1api.PersistentVolumeClaim
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), &pvc))
+
+			if tc.wantSnapshotClass == "" {
+				assert.Empty(t, snapshots.Items)
+				assert.Empty(t, pvc.Annotations[velerov1api.VolumeSnapshotAnnotation])
+				return
+			}
+
+			require.Len(t, snapshots.Items, 1)
+			snapshot := snapshots.Items[0]
+			assert.Equal(t, "backup-1", snapshot.Labels[velerov1api.BackupNameLabel])
+			assert.Equal(t, "pvc-1", *snapshot.Spec.Source.PersistentVolumeClaimName)
+			assert.Equal(t, tc.wantSnapshotClass, *snapshot.Spec.VolumeSnapshotClassName)
+			assert.Equal(t, snapshot.Name, pvc.Annotations[velerov1api.VolumeSnapshotAnnotation])
+		})
+	}
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"time"
+
+	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/v2/pkg/apis/volumesnapshot/v1beta1"
+	snapshotv1beta1client "github.com/kubernetes-csi/external-snapshotter/v2/pkg/client/clientset/versioned/typed/volumesnapshot/v1beta1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+const (
+	defaultCSISnapshotPollInterval = 5 * time.Second
+	defaultCSISnapshotTimeout      = 10 * time.Minute
+)
+
+// CSIVolumeSnapshotAction waits for the VolumeSnapshots that CSIPVCAction creates to be
+// taken, and records what's needed to restore volumes from them.
+type CSIVolumeSnapshotAction struct {
+	log            logrus.FieldLogger
+	snapshotClient snapshotv1beta1client.SnapshotV1beta1Interface
+	pollInterval   time.Duration
+	timeout        time.Duration
+}
+
+// NewCSIVolumeSnapshotAction creates a new ItemAction for CSI VolumeSnapshots.
+func NewCSIVolumeSnapshotAction(logger logrus.FieldLogger, snapshotClient snapshotv1beta1client.SnapshotV1beta1Interface) *CSIVolumeSnapshotAction {
+	return &CSIVolumeSnapshotAction{
+		log:            logger,
+		snapshotClient: snapshotClient,
+		pollInterval:   defaultCSISnapshotPollInterval,
+		timeout:        defaultCSISnapshotTimeout,
+	}
+}
+
+// AppliesTo returns a ResourceSelector that applies only to volume snapshots.
+func (a *CSIVolumeSnapshotAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"volumesnapshots.snapshot.storage.k8s.io"},
+	}, nil
+}
+
+// Execute waits for a VolumeSnapshot that was created for the backup to be bound to a
+// VolumeSnapshotContent with a snapshot handle. The VolumeSnapshot is annotated with
+// the handle and its driver, and its VolumeSnapshotContent and VolumeSnapshotClass are
+// returned as additional items. The VolumeSnapshotContent is labeled with the backup's
+// name so that it's deleted with the backup.
+func (a *CSIVolumeSnapshotAction) Execute(item runtime.Unstructured, backup *velerov1api.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+	if !nativeCSIEnabled() {
+		return item, nil, nil
+	}
+
+	var snapshot snapshotv1beta1api.VolumeSnapshot
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), &snapshot); err != nil {
+		return nil, nil, errors.Wrap(err, "unable to convert unstructured item to volume snapshot")
+	}
+
+	// volume snapshots that weren't created for this backup are backed up as they are.
+	if snapshot.Labels[velerov1api.BackupNameLabel] != label.GetValidName(backup.Name) {
+		return item, nil, nil
+	}
+
+	log := a.log.WithField("volumeSnapshot", snapshot.Namespace+"/"+snapshot.Name)
+	log.Info("Waiting for volume snapshot to be taken")
+
+	var (
+		latest  *snapshotv1beta1api.VolumeSnapshot
+		content *snapshotv1beta1api.VolumeSnapshotContent
+	)
+	err := wait.PollImmediate(a.pollInterval, a.timeout, func() (bool, error) {
+		var err error
+		latest, err = a.snapshotClient.VolumeSnapshots(snapshot.Namespace).Get(context.TODO(), snapshot.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, errors.Wrap(err, "error getting volume snapshot")
+		}
+		if latest.Status == nil {
+			return false, nil
+		}
+		if latest.Status.Error != nil && latest.Status.Error.Message != nil {
+			return false, errors.Errorf("volume snapshot failed: %s", *latest.Status.Error.Message)
+		}
+		if latest.Status.BoundVolumeSnapshotContentName == nil {
+			return false, nil
+		}
+
+		content, err = a.snapshotClient.VolumeSnapshotContents().Get(context.TODO(), *latest.Status.BoundVolumeSnapshotContentName, metav1.GetOptions{})
+		if err != nil {
+			return false, errors.Wrap(err, "error getting volume snapshot content")
+		}
+
+		return content.Status != nil && content.Status.SnapshotHandle != nil, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return nil, nil, errors.Errorf("timed out after %v waiting for volume snapshot %s/%s to be taken", a.timeout, snapshot.Namespace, snapshot.Name)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	handle := *content.Status.SnapshotHandle
+
+	if content.Labels == nil {
+		content.Labels = make(map[string]string)
+	}
+	content.Labels[velerov1api.BackupNameLabel] = label.GetValidName(backup.Name)
+	if content, err = a.snapshotClient.VolumeSnapshotContents().Update(context.TODO(), content, metav1.UpdateOptions{}); err != nil {
+		return nil, nil, errors.Wrap(err, "error labeling volume snapshot content")
+	}
+
+	// objects from the API don't have their type meta set
+	latest.TypeMeta = snapshot.TypeMeta
+	if latest.Annotations == nil {
+		latest.Annotations = make(map[string]string)
+	}
+	latest.Annotations[velerov1api.CSISnapshotHandleAnnotation] = handle
+	latest.Annotations[velerov1api.CSIDriverNameAnnotation] = content.Spec.Driver
+
+	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(latest)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "unable to convert volume snapshot to unstructured item")
+	}
+
+	additionalItems := []velero.ResourceIdentifier{
+		{
+			GroupResource: kuberesource.VolumeSnapshotContents,
+			Name:          content.Name,
+		},
+	}
+	if latest.Spec.VolumeSnapshotClassName != nil {
+		additionalItems = append(additionalItems, velero.ResourceIdentifier{
+			GroupResource: kuberesource.VolumeSnapshotClasses,
+			Name:          *latest.Spec.VolumeSnapshotClassName,
+		})
+	}
+
+	log.Infof("Volume snapshot was taken with snapshot handle %s", handle)
+
+	return &unstructured.Unstructured{Object: res}, additionalItems, nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/v2/pkg/apis/volumesnapshot/v1beta1"
+	snapshotfake "github.com/kubernetes-csi/external-snapshotter/v2/pkg/client/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestCSIVolumeSnapshotActionExecute(t *testing.T) {
+	newSnapshot := func(backupName string, status *snapshotv1beta1api.VolumeSnapshotStatus) *snapshotv1beta1api.VolumeSnapshot {
+		className := "class-1"
+		return &snapshotv1beta1api.VolumeSnapshot{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: snapshotv1beta1api.SchemeGroupVersion.String(),
+				Kind:       "VolumeSnapshot",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns-1",
+				Name:      "snapshot-1",
+				Labels:    map[string]string{velerov1api.BackupNameLabel: backupName},
+			},
+			Spec: snapshotv1beta1api.VolumeSnapshotSpec{
+				VolumeSnapshotClassName: &className,
+			},
+			Status: status,
+		}
+	}
+
+	contentName := "content-1"
+	snapshotHandle := "snap-1"
+	errorMessage := "out of quota"
+
+	content := &snapshotv1beta1api.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{Name: contentName},
+		Spec:       snapshotv1beta1api.VolumeSnapshotContentSpec{Driver: "csi.example.com"},
+		Status:     &snapshotv1beta1api.VolumeSnapshotContentStatus{SnapshotHandle: &snapshotHandle},
+	}
+
+	tests := []struct {
+		name                string
+		snapshot            *snapshotv1beta1api.VolumeSnapshot
+		wantAdditionalItems []velero.ResourceIdentifier
+		wantAnnotations     map[string]string
+		wantErr             string
+	}{
+		{
+			name:     "volume snapshot that wasn't created for the backup is left alone",
+			snapshot: newSnapshot("backup-2", nil),
+		},
+		{
+			name:     "volume snapshot that's been taken is annotated with its handle and driver",
+			snapshot: newSnapshot("backup-1", &snapshotv1beta1api.VolumeSnapshotStatus{BoundVolumeSnapshotContentName: &contentName}),
+			wantAdditionalItems: []velero.ResourceIdentifier{
+				{GroupResource: kuberesource.VolumeSnapshotContents, Name: "content-1"},
+				{GroupResource: kuberesource.VolumeSnapshotClasses, Name: "class-1"},
+			},
+			wantAnnotations: map[string]string{
+				velerov1api.CSISnapshotHandleAnnotation: "snap-1",
+				velerov1api.CSIDriverNameAnnotation:     "csi.example.com",
+			},
+		},
+		{
+			name:     "error is returned when the volume snapshot fails",
+			snapshot: newSnapshot("backup-1", &snapshotv1beta1api.VolumeSnapshotStatus{Error: &snapshotv1beta1api.VolumeSnapshotError{Message: &errorMessage}}),
+			wantErr:  "volume snapshot failed: out of quota",
+		},
+		{
+			name:     "error is returned when the volume snapshot isn't taken in time",
+			snapshot: newSnapshot("backup-1", nil),
+			wantErr:  "timed out after 10ms waiting for volume snapshot ns-1/snapshot-1 to be taken",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			features.NewFeatureFlagSet(velerov1api.CSIFeatureFlag, velerov1api.NativeCSIFeatureFlag)
+			defer features.NewFeatureFlagSet()
+
+			snapshotClient := snapshotfake.NewSimpleClientset(tc.snapshot, content.DeepCopy())
+
+			snapshotMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.snapshot)
+			require.NoError(t, err)
+
+			a := NewCSIVolumeSnapshotAction(velerotest.NewLogger(), snapshotClient.SnapshotV1beta1())
+			a.pollInterval = time.Millisecond
+			a.timeout = 10 * time.Millisecond
+
+			item, additionalItems, err := a.Execute(&unstructured.Unstructured{Object: snapshotMap}, builder.ForBackup("velero", "backup-1").Result())
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantAdditionalItems, additionalItems)
+
+			var snapshot snapshotv1beta1api.VolumeSnapshot
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), &snapshot))
+			assert.Equal(t, tc.snapshot.TypeMeta, snapshot.TypeMeta)
+			for key, val := range tc.wantAnnotations {
+				assert.Equal(t, val, snapshot.Annotations[key])
+			}
+
+			if tc.wantAnnotations != nil {
+				updated, err := snapshotClient.SnapshotV1beta1().VolumeSnapshotContents().Get(context.TODO(), contentName, metav1.GetOptions{})
+				require.NoError(t, err)
+				assert.Equal(t, "backup-1", updated.Labels[velerov1api.BackupNameLabel])
+			}
+		})
+	}
+}
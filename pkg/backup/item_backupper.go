@@ -33,12 +33,15 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
@@ -54,6 +57,18 @@ type itemBackupper struct {
 	resticBackupper         restic.Backupper
 	resticSnapshotTracker   *pvcSnapshotTracker
 	volumeSnapshotterGetter VolumeSnapshotterGetter
+	credentialFileStore     credentials.FileStore
+	snapshotLimiter         *clientmgmt.SnapshotLimiter
+
+	// volumePolicies are the volume policies of the claims mounted by the pods that have
+	// been backed up, keyed by namespace/name.
+	volumePolicies map[string]velerov1api.VolumePolicyAction
+
+	// snapshottedPVs are the names of the persistent volumes that have been snapshotted,
+	// and snapshotGroups are the snapshot groups of claims that have been snapshotted,
+	// keyed by namespace/name.
+	snapshottedPVs sets.String
+	snapshotGroups sets.String
 
 	itemHookHandler                    hook.ItemHookHandler
 	snapshotLocationVolumeSnapshotters map[string]velero.VolumeSnapshotter
@@ -100,6 +115,11 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 		return false, nil
 	}
 
+	if !ib.backupRequest.APIGroupIncludesExcludes.ShouldInclude(apiGroupName(groupResource.Group)) {
+		log.Info("Excluding item because API group is excluded")
+		return false, nil
+	}
+
 	if metadata.GetDeletionTimestamp() != nil {
 		log.Info("Skipping item because it's being deleted.")
 		return false, nil
@@ -142,7 +162,9 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 			// Get the list of volumes to back up using restic from the pod's annotations. Remove from this list
 			// any volumes that use a PVC that we've already backed up (this would be in a read-write-many scenario,
 			// where it's been backed up from another pod), since we don't need >1 backup per PVC.
-			for _, volume := range restic.GetPodVolumesUsingRestic(pod, boolptr.IsSetToTrue(ib.backupRequest.Spec.DefaultVolumesToRestic)) {
+			podVolumes := restic.GetPodVolumesUsingRestic(pod, boolptr.IsSetToTrue(ib.backupRequest.Spec.DefaultVolumesToFsBackup))
+			podVolumes = applyEphemeralVolumePolicy(ib.backupRequest.Spec.EphemeralVolumePolicy, pod, ib.applyVolumePolicies(pod, podVolumes, log), log)
+			for _, volume := range podVolumes {
 				if found, pvcName := ib.resticSnapshotTracker.HasPVCForPodVolume(pod, volume); found {
 					log.WithFields(map[string]interface{}{
 						"podVolume": volume,
@@ -158,9 +180,26 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 			// via an item action in the next step, we don't snapshot PVs that will have their data backed up
 			// with restic.
 			ib.resticSnapshotTracker.Track(pod, resticVolumesToBackup)
+
+			// snapshot the volumes of the pod's claims together now if it asks for it, so
+			// that they're snapshotted between the pod's pre and post hooks.
+			if err := ib.takePodSnapshotGroup(pod, log); err != nil {
+				backupErrs = append(backupErrs, err)
+			}
 		}
 	}
 
+	// back up the data of a PVC that no pod mounts now, before its PV is backed up by the
+	// PVC's item action, so that the PV isn't also snapshotted.
+	if groupResource == kuberesource.PersistentVolumeClaims && boolptr.IsSetToTrue(ib.backupRequest.Spec.UnmountedVolumesToRestic) {
+		// this function will return partial results, so process podVolumeBackups
+		// even if there are errors.
+		podVolumeBackups, errs := ib.backupUnmountedPVC(log, obj)
+
+		ib.backupRequest.PodVolumeBackups = append(ib.backupRequest.PodVolumeBackups, podVolumeBackups...)
+		backupErrs = append(backupErrs, errs...)
+	}
+
 	// capture the version of the object before invoking plugin actions as the plugin may update
 	// the group version of the object.
 	// group version of this object
@@ -296,6 +335,42 @@ func (ib *itemBackupper) backupPodVolumes(log logrus.FieldLogger, pod *corev1api
 	return ib.resticBackupper.BackupPodVolumes(ib.backupRequest.Backup, pod, volumes, log)
 }
 
+// backupUnmountedPVC triggers a restic backup of a PVC if no pod mounts it, it hasn't already been backed
+// up with restic from a pod, and its volume policy doesn't choose another action. It returns a list of
+// PodVolumeBackups for the PVC's volume, and a slice of any errors that were encountered.
+func (ib *itemBackupper) backupUnmountedPVC(log logrus.FieldLogger, obj runtime.Unstructured) ([]*velerov1api.PodVolumeBackup, []error) {
+	if ib.resticBackupper == nil {
+		log.Warn("No restic backupper, not backing up unmounted persistent volume claim")
+		return nil, nil
+	}
+
+	pvc := new(corev1api.PersistentVolumeClaim)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pvc); err != nil {
+		return nil, []error{errors.WithStack(err)}
+	}
+
+	if ib.resticSnapshotTracker.Has(pvc.Namespace, pvc.Name) {
+		log.Info("Persistent volume claim has already been backed up with restic from a pod, skipping.")
+		return nil, nil
+	}
+
+	var pv *corev1api.PersistentVolume
+	if pvc.Spec.VolumeName != "" {
+		_, pv = ib.getClaimAndVolume(pvc.Namespace, pvc.Name, log)
+	}
+	if action := getVolumePolicy(ib.backupRequest.VolumePolicies(), "", pvc, pv, log); action != "" && action != velerov1api.VolumePolicyActionRestic {
+		log.Infof("Not backing up unmounted persistent volume claim with restic because its volume policy is %s.", action)
+		return nil, nil
+	}
+
+	podVolumeBackups, errs := ib.resticBackupper.BackupPVC(ib.backupRequest.Backup, pvc, log)
+	if len(podVolumeBackups) > 0 {
+		ib.resticSnapshotTracker.TrackPVC(pvc.Namespace, pvc.Name)
+	}
+
+	return podVolumeBackups, errs
+}
+
 func (ib *itemBackupper) executeActions(
 	log logrus.FieldLogger,
 	obj runtime.Unstructured,
@@ -332,6 +407,22 @@ func (ib *itemBackupper) executeActions(
 		}
 		obj = updatedItem
 
+		// version 2 actions can start asynchronous operations for the item, which have to
+		// complete before the item is backed up.
+		if actionV2, ok := action.BackupItemAction.(velero.BackupItemActionV2); ok {
+			item := velero.ResourceIdentifier{GroupResource: groupResource, Namespace: namespace, Name: name}
+			err := clientmgmt.WaitForItemOperation(
+				func() (velero.OperationProgress, error) { return actionV2.Progress(item, ib.backupRequest.Backup) },
+				func() error { return actionV2.Cancel(item, ib.backupRequest.Backup) },
+				clientmgmt.ItemOperationPollInterval,
+				clientmgmt.ItemOperationTimeout,
+				log,
+			)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error executing custom action (groupResource=%s, namespace=%s, name=%s)", groupResource.String(), namespace, name)
+			}
+		}
+
 		for _, additionalItem := range additionalItemIdentifiers {
 			gvr, resource, err := ib.discoveryHelper.ResourceFor(additionalItem.GroupResource.WithVersion(""))
 			if err != nil {
@@ -365,6 +456,106 @@ func (ib *itemBackupper) executeActions(
 	return obj, nil
 }
 
+// applyVolumePolicies returns which of a pod's volumes are backed up with restic, given
+// the volumes that the restic annotations and defaults choose, once the volume policies
+// of the pod's volumes are applied. The policies of the claims that the pod mounts are
+// recorded, so that their volumes aren't snapshotted if they shouldn't be.
+func (ib *itemBackupper) applyVolumePolicies(pod *corev1api.Pod, resticVolumes []string, log logrus.FieldLogger) []string {
+	podPolicies := podVolumePolicies(pod, log)
+
+	policies := make(map[string]velerov1api.VolumePolicyAction)
+	for _, volume := range pod.Spec.Volumes {
+		action := podPolicies[volume.Name]
+		if volume.PersistentVolumeClaim != nil {
+			pvc, pv := ib.getClaimAndVolume(pod.Namespace, volume.PersistentVolumeClaim.ClaimName, log)
+			action = getVolumePolicy(ib.backupRequest.VolumePolicies(), action, pvc, pv, log)
+			if action != "" {
+				ib.volumePolicies[key(pod.Namespace, volume.PersistentVolumeClaim.ClaimName)] = action
+			}
+		}
+		if action != "" {
+			policies[volume.Name] = action
+		}
+	}
+
+	if len(policies) == 0 {
+		return resticVolumes
+	}
+
+	var res []string
+	for _, volume := range resticVolumes {
+		if action, ok := policies[volume]; ok && action != velerov1api.VolumePolicyActionRestic {
+			log.WithField("podVolume", volume).Infof("Not backing up pod volume with restic because its volume policy is %s.", action)
+			continue
+		}
+		res = append(res, volume)
+	}
+	for _, volume := range pod.Spec.Volumes {
+		if policies[volume.Name] == velerov1api.VolumePolicyActionRestic && !sets.NewString(res...).Has(volume.Name) {
+			res = append(res, volume.Name)
+		}
+	}
+
+	return res
+}
+
+// persistentVolumePolicy returns the volume policy of a persistent volume, or an empty
+// action if none applies.
+func (ib *itemBackupper) persistentVolumePolicy(pv *corev1api.PersistentVolume, log logrus.FieldLogger) velerov1api.VolumePolicyAction {
+	if pv.Spec.ClaimRef == nil {
+		return getVolumePolicy(ib.backupRequest.VolumePolicies(), "", nil, pv, log)
+	}
+
+	if action, ok := ib.volumePolicies[key(pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name)]; ok {
+		return action
+	}
+
+	pvc, _ := ib.getClaimAndVolume(pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name, log)
+	return getVolumePolicy(ib.backupRequest.VolumePolicies(), "", pvc, pv, log)
+}
+
+// getClaimAndVolume returns a persistent volume claim and the persistent volume bound to
+// it. Either is nil if it can't be gotten.
+func (ib *itemBackupper) getClaimAndVolume(namespace, name string, log logrus.FieldLogger) (*corev1api.PersistentVolumeClaim, *corev1api.PersistentVolume) {
+	pvc := new(corev1api.PersistentVolumeClaim)
+	if err := ib.getItem(kuberesource.PersistentVolumeClaims, namespace, name, pvc); err != nil {
+		log.WithError(err).WithField("persistentVolumeClaim", key(namespace, name)).Debug("Unable to get persistent volume claim to check its volume policy")
+		return nil, nil
+	}
+
+	if pvc.Spec.VolumeName == "" {
+		return pvc, nil
+	}
+
+	pv := new(corev1api.PersistentVolume)
+	if err := ib.getItem(kuberesource.PersistentVolumes, "", pvc.Spec.VolumeName, pv); err != nil {
+		log.WithError(err).WithField("persistentVolume", pvc.Spec.VolumeName).Debug("Unable to get persistent volume to check its volume policy")
+		return pvc, nil
+	}
+
+	return pvc, pv
+}
+
+// getItem gets an item from the Kubernetes API into the object pointed to by into.
+func (ib *itemBackupper) getItem(groupResource schema.GroupResource, namespace, name string, into interface{}) error {
+	gvr, resource, err := ib.discoveryHelper.ResourceFor(groupResource.WithVersion(""))
+	if err != nil {
+		return err
+	}
+
+	client, err := ib.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, namespace)
+	if err != nil {
+		return err
+	}
+
+	item, err := client.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), into))
+}
+
 // volumeSnapshotter instantiates and initializes a VolumeSnapshotter given a VolumeSnapshotLocation,
 // or returns an existing one if one's already been initialized for the location.
 func (ib *itemBackupper) volumeSnapshotter(snapshotLocation *velerov1api.VolumeSnapshotLocation) (velero.VolumeSnapshotter, error) {
@@ -372,14 +563,20 @@ func (ib *itemBackupper) volumeSnapshotter(snapshotLocation *velerov1api.VolumeS
 		return bs, nil
 	}
 
-	bs, err := ib.volumeSnapshotterGetter.GetVolumeSnapshotter(snapshotLocation.Spec.Provider)
+	bs, err := clientmgmt.GetVolumeSnapshotterWithEgressProxy(ib.volumeSnapshotterGetter, snapshotLocation.Spec.Provider, snapshotLocation.Spec.EgressProxy)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := credentials.VolumeSnapshotterConfig(snapshotLocation, ib.credentialFileStore)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := bs.Init(snapshotLocation.Spec.Config); err != nil {
+	if err := bs.Init(config); err != nil {
 		return nil, err
 	}
+	bs = ib.snapshotLimiter.VolumeSnapshotter(snapshotLocation, bs)
 
 	if ib.snapshotLocationVolumeSnapshotters == nil {
 		ib.snapshotLocationVolumeSnapshotters = make(map[string]velero.VolumeSnapshotter)
@@ -416,15 +613,52 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 
 	log = log.WithField("persistentVolume", pv.Name)
 
+	if ib.snapshottedPVs.Has(pv.Name) {
+		log.Info("Skipping snapshot of persistent volume because it's been snapshotted with its snapshot group.")
+		return nil
+	}
+
+	if grouped, err := ib.takeClaimSnapshotGroup(pv, log); grouped {
+		return err
+	}
+
+	pending, err := ib.preparePVSnapshot(obj, pv, log)
+	if err != nil || pending == nil {
+		return err
+	}
+
+	return ib.createPVSnapshot(pending)
+}
+
+// pendingSnapshot is a snapshot of a persistent volume that's ready to be taken.
+type pendingSnapshot struct {
+	snapshot          *volume.Snapshot
+	volumeSnapshotter velero.VolumeSnapshotter
+	tags              map[string]string
+	log               logrus.FieldLogger
+}
+
+// preparePVSnapshot finds the volume snapshotter and gets the volume information needed to
+// snapshot a persistent volume. It returns nil if the persistent volume isn't snapshotted.
+func (ib *itemBackupper) preparePVSnapshot(obj runtime.Unstructured, pv *corev1api.PersistentVolume, log logrus.FieldLogger) (*pendingSnapshot, error) {
 	// If this PV is claimed, see if we've already taken a (restic) snapshot of the contents
 	// of this PV. If so, don't take a snapshot.
 	if pv.Spec.ClaimRef != nil {
 		if ib.resticSnapshotTracker.Has(pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name) {
 			log.Info("Skipping snapshot of persistent volume because volume is being backed up with restic.")
-			return nil
+			return nil, nil
 		}
 	}
 
+	switch action := ib.persistentVolumePolicy(pv, log); action {
+	case velerov1api.VolumePolicyActionSkip:
+		log.Info("Skipping snapshot of persistent volume because its volume policy is Skip.")
+		return nil, nil
+	case velerov1api.VolumePolicyActionRestic:
+		log.Warn("Skipping snapshot of persistent volume because its volume policy is Restic, but no pod that mounts it was backed up with restic.")
+		return nil, nil
+	}
+
 	// TODO: -- once failure-domain.beta.kubernetes.io/zone is no longer
 	// supported in any velero-supported version of Kubernetes, remove fallback checking of it
 	pvFailureDomainZone, labelFound := pv.Labels[zoneLabel]
@@ -467,43 +701,97 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 
 	if volumeSnapshotter == nil {
 		log.Info("Persistent volume is not a supported volume type for snapshots, skipping.")
-		return nil
+		return nil, nil
 	}
 
 	log = log.WithField("volumeID", volumeID)
 
-	// create tags from the backup's labels
-	tags := map[string]string{}
-	for k, v := range ib.backupRequest.GetLabels() {
-		tags[k] = v
-	}
-	tags["velero.io/backup"] = ib.backupRequest.Name
-	tags["velero.io/pv"] = pv.Name
-
 	log.Info("Getting volume information")
 	volumeType, iops, err := volumeSnapshotter.GetVolumeInfo(volumeID, pvFailureDomainZone)
 	if err != nil {
-		return errors.WithMessage(err, "error getting volume info")
+		return nil, errors.WithMessage(err, "error getting volume info")
 	}
 
-	log.Info("Snapshotting persistent volume")
-	snapshot := volumeSnapshot(ib.backupRequest.Backup, pv.Name, volumeID, volumeType, pvFailureDomainZone, location, iops)
+	return &pendingSnapshot{
+		snapshot:          volumeSnapshot(ib.backupRequest.Backup, pv.Name, volumeID, volumeType, pvFailureDomainZone, location, iops),
+		volumeSnapshotter: volumeSnapshotter,
+		tags:              snapshotTags(ib.backupRequest.Backup, pv),
+		log:               log,
+	}, nil
+}
+
+// createPVSnapshot takes a prepared snapshot of a persistent volume, and records it in the backup.
+func (ib *itemBackupper) createPVSnapshot(pending *pendingSnapshot) error {
+	pending.log.Info("Snapshotting persistent volume")
+	snapshot := pending.snapshot
 
-	var errs []error
-	snapshotID, err := volumeSnapshotter.CreateSnapshot(snapshot.Spec.ProviderVolumeID, snapshot.Spec.VolumeAZ, tags)
-	if err != nil {
+	var (
+		errs                    []error
+		snapshotID, operationID string
+		err                     error
+	)
+	// version 2 volume snapshotters only start taking the snapshot; the backup operations
+	// controller checks on the snapshots that are still in progress once the backup's
+	// contents have been uploaded.
+	if volumeSnapshotterV2, ok := pending.volumeSnapshotter.(velero.VolumeSnapshotterV2); ok {
+		snapshotID, operationID, err = volumeSnapshotterV2.StartSnapshot(snapshot.Spec.ProviderVolumeID, snapshot.Spec.VolumeAZ, pending.tags)
+	} else {
+		snapshotID, err = pending.volumeSnapshotter.CreateSnapshot(snapshot.Spec.ProviderVolumeID, snapshot.Spec.VolumeAZ, pending.tags)
+	}
+	switch {
+	case err != nil:
 		errs = append(errs, errors.Wrap(err, "error taking snapshot of volume"))
 		snapshot.Status.Phase = volume.SnapshotPhaseFailed
-	} else {
+	case operationID != "":
+		pending.log.WithField("operationID", operationID).Info("Snapshot of persistent volume is in progress")
+		snapshot.Status.Phase = volume.SnapshotPhaseInProgress
+		snapshot.Status.ProviderSnapshotID = snapshotID
+		snapshot.Status.ProviderOperationID = operationID
+	default:
 		snapshot.Status.Phase = volume.SnapshotPhaseCompleted
 		snapshot.Status.ProviderSnapshotID = snapshotID
 	}
 	ib.backupRequest.VolumeSnapshots = append(ib.backupRequest.VolumeSnapshots, snapshot)
+	ib.snapshottedPVs.Insert(snapshot.Spec.PersistentVolumeName)
 
 	// nil errors are automatically removed
 	return kubeerrs.NewAggregate(errs)
 }
 
+// the tags that identify a volume snapshot's backup, schedule, persistent volume and claim
+// in its provider, for attributing its cost and finding it if it's orphaned.
+const (
+	snapshotBackupTag       = "velero.io/backup"
+	snapshotScheduleTag     = "velero.io/schedule"
+	snapshotPVTag           = "velero.io/pv"
+	snapshotPVCNamespaceTag = "velero.io/pvc-namespace"
+	snapshotPVCTag          = "velero.io/pvc"
+)
+
+// snapshotTags returns the tags of a persistent volume's snapshot: the backup's labels
+// and snapshot tags, and the tags that identify the snapshot, which take precedence.
+func snapshotTags(backup *velerov1api.Backup, pv *corev1api.PersistentVolume) map[string]string {
+	tags := map[string]string{}
+	for k, v := range backup.GetLabels() {
+		tags[k] = v
+	}
+	for k, v := range backup.Spec.SnapshotTags {
+		tags[k] = v
+	}
+
+	tags[snapshotBackupTag] = backup.Name
+	if schedule := backup.GetLabels()[velerov1api.ScheduleNameLabel]; schedule != "" {
+		tags[snapshotScheduleTag] = schedule
+	}
+	tags[snapshotPVTag] = pv.Name
+	if pv.Spec.ClaimRef != nil {
+		tags[snapshotPVCNamespaceTag] = pv.Spec.ClaimRef.Namespace
+		tags[snapshotPVCTag] = pv.Spec.ClaimRef.Name
+	}
+
+	return tags
+}
+
 func volumeSnapshot(backup *velerov1api.Backup, volumeName, volumeID, volumeType, az, location string, iops *int64) *volume.Snapshot {
 	return &volume.Snapshot{
 		Spec: volume.SnapshotSpec{
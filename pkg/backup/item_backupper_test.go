@@ -20,10 +20,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 )
 
@@ -45,3 +47,57 @@ func Test_resourceKey(t *testing.T) {
 		})
 	}
 }
+
+func TestSnapshotTags(t *testing.T) {
+	tests := []struct {
+		name   string
+		backup *velerov1api.Backup
+		pv     *corev1api.PersistentVolume
+		want   map[string]string
+	}{
+		{
+			name:   "unclaimed volume of a backup without labels",
+			backup: builder.ForBackup("velero", "backup-1").Result(),
+			pv:     builder.ForPersistentVolume("pv-1").Result(),
+			want: map[string]string{
+				"velero.io/backup": "backup-1",
+				"velero.io/pv":     "pv-1",
+			},
+		},
+		{
+			name: "claimed volume of a scheduled backup with labels and snapshot tags",
+			backup: builder.ForBackup("velero", "schedule-1-20200101000000").
+				ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1", "team", "payments")).
+				SnapshotTags(map[string]string{"cost-center": "1234", "team": "billing"}).
+				Result(),
+			pv: builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").Result(),
+			want: map[string]string{
+				"velero.io/schedule-name": "schedule-1",
+				"team":                    "billing",
+				"cost-center":             "1234",
+				"velero.io/backup":        "schedule-1-20200101000000",
+				"velero.io/schedule":      "schedule-1",
+				"velero.io/pv":            "pv-1",
+				"velero.io/pvc-namespace": "ns-1",
+				"velero.io/pvc":           "pvc-1",
+			},
+		},
+		{
+			name: "identifying tags take precedence over snapshot tags",
+			backup: builder.ForBackup("velero", "backup-1").
+				SnapshotTags(map[string]string{"velero.io/backup": "other", "velero.io/pv": "other"}).
+				Result(),
+			pv: builder.ForPersistentVolume("pv-1").Result(),
+			want: map[string]string{
+				"velero.io/backup": "backup-1",
+				"velero.io/pv":     "pv-1",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, snapshotTags(tc.backup, tc.pv))
+		})
+	}
+}
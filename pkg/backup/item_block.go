@@ -0,0 +1,215 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+type resolvedItemBlockAction struct {
+	velero.ItemBlockAction
+
+	resourceIncludesExcludes  *collections.IncludesExcludes
+	namespaceIncludesExcludes *collections.IncludesExcludes
+	selector                  labels.Selector
+}
+
+func resolveItemBlockActions(actions []velero.ItemBlockAction, helper discovery.Helper) ([]resolvedItemBlockAction, error) {
+	var resolved []resolvedItemBlockAction
+
+	for _, action := range actions {
+		resourceSelector, err := action.AppliesTo()
+		if err != nil {
+			return nil, err
+		}
+
+		resources, namespaces, selector, err := resolveResourceSelector(resourceSelector, helper)
+		if err != nil {
+			return nil, err
+		}
+
+		resolved = append(resolved, resolvedItemBlockAction{
+			ItemBlockAction:           action,
+			resourceIncludesExcludes:  resources,
+			namespaceIncludesExcludes: namespaces,
+			selector:                  selector,
+		})
+	}
+
+	return resolved, nil
+}
+
+// appliesTo returns whether the action should be invoked for an item.
+func (a *resolvedItemBlockAction) appliesTo(obj *unstructured.Unstructured, groupResource schema.GroupResource) bool {
+	if !a.resourceIncludesExcludes.ShouldInclude(groupResource.String()) {
+		return false
+	}
+
+	namespace := obj.GetNamespace()
+	if namespace != "" && !a.namespaceIncludesExcludes.ShouldInclude(namespace) {
+		return false
+	}
+	if namespace == "" && !a.namespaceIncludesExcludes.IncludeEverything() {
+		return false
+	}
+
+	return a.selector.Matches(labels.Set(obj.GetLabels()))
+}
+
+// itemBlockEntry is an item of an item block.
+type itemBlockEntry struct {
+	obj           *unstructured.Unstructured
+	groupResource schema.GroupResource
+	preferredGVR  schema.GroupVersionResource
+}
+
+// getItemBlock returns the items that must be backed up together with an item, starting
+// with the item itself. The block is made up of the items that the item block actions return
+// as related to the item, and the items related to those in turn. The volumes of the
+// block's persistent volume claims are snapshotted together before the block is returned.
+// If an action fails, the item is backed up without the items related by it.
+func (ib *itemBackupper) getItemBlock(obj *unstructured.Unstructured, groupResource schema.GroupResource, preferredGVR schema.GroupVersionResource, log logrus.FieldLogger) []itemBlockEntry {
+	block := []itemBlockEntry{{obj: obj, groupResource: groupResource, preferredGVR: preferredGVR}}
+	if len(ib.backupRequest.ResolvedItemBlockActions) == 0 {
+		return block
+	}
+
+	seen := map[itemKey]bool{
+		{resource: groupResource.String(), namespace: obj.GetNamespace(), name: obj.GetName()}: true,
+	}
+
+	// the block grows while it's being walked, as related items are appended to it.
+	for i := 0; i < len(block); i++ {
+		entry := block[i]
+		log := log.WithFields(logrus.Fields{
+			"resource":  entry.groupResource.String(),
+			"namespace": entry.obj.GetNamespace(),
+			"name":      entry.obj.GetName(),
+		})
+
+		for _, action := range ib.backupRequest.ResolvedItemBlockActions {
+			if !action.appliesTo(entry.obj, entry.groupResource) {
+				continue
+			}
+
+			log.Info("Executing item block action")
+
+			relatedItems, err := action.GetRelatedItems(entry.obj, ib.backupRequest.Backup)
+			if err != nil {
+				log.WithError(err).Error("Error executing item block action")
+				continue
+			}
+
+			for _, relatedItem := range relatedItems {
+				id := itemKey{resource: relatedItem.GroupResource.String(), namespace: relatedItem.Namespace, name: relatedItem.Name}
+				if seen[id] {
+					continue
+				}
+				seen[id] = true
+
+				relatedEntry, err := ib.getItemBlockEntry(relatedItem)
+				if err != nil {
+					log.WithError(err).WithField("relatedItem", id.String()).Error("Error getting related item of item block")
+					continue
+				}
+				if relatedEntry == nil {
+					log.WithField("relatedItem", id.String()).Warn("Related item of item block was not found in Kubernetes API, can't back it up")
+					continue
+				}
+
+				block = append(block, *relatedEntry)
+			}
+		}
+	}
+
+	if len(block) > 1 {
+		log.Infof("Backing up item block of %d items", len(block))
+		ib.takeItemBlockSnapshotGroups(block, log)
+	}
+
+	return block
+}
+
+// getItemBlockEntry gets a related item of an item block from the API. It returns nil
+// if the item doesn't exist.
+func (ib *itemBackupper) getItemBlockEntry(item velero.ResourceIdentifier) (*itemBlockEntry, error) {
+	gvr, resource, err := ib.discoveryHelper.ResourceFor(item.GroupResource.WithVersion(""))
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := ib.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, item.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := client.Get(item.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &itemBlockEntry{obj: obj, groupResource: gvr.GroupResource(), preferredGVR: gvr}, nil
+}
+
+// takeItemBlockSnapshotGroups snapshots the volumes of an item block's persistent volume
+// claims together, in a snapshot group per namespace that's named after the block's first
+// item, so that the block gets crash-consistent snapshots.
+func (ib *itemBackupper) takeItemBlockSnapshotGroups(block []itemBlockEntry, log logrus.FieldLogger) {
+	var namespaces []string
+	claims := make(map[string][]string)
+	for _, entry := range block {
+		if entry.groupResource != kuberesource.PersistentVolumeClaims {
+			continue
+		}
+
+		namespace := entry.obj.GetNamespace()
+		if !ib.backupRequest.NamespaceIncludesExcludes.ShouldInclude(namespace) || !ib.backupRequest.ResourceIncludesExcludes.ShouldInclude(kuberesource.PersistentVolumeClaims.String()) {
+			continue
+		}
+
+		if _, ok := claims[namespace]; !ok {
+			namespaces = append(namespaces, namespace)
+		}
+		claims[namespace] = append(claims[namespace], entry.obj.GetName())
+	}
+
+	group := block[0].obj.GetName()
+	for _, namespace := range namespaces {
+		if len(claims[namespace]) < 2 || ib.snapshotGroups.Has(key(namespace, group)) {
+			continue
+		}
+		ib.snapshotGroups.Insert(key(namespace, group))
+
+		if err := ib.takeSnapshotGroup(namespace, group, claims[namespace], log); err != nil {
+			log.WithError(err).WithField("snapshotGroup", key(namespace, group)).Error("Error snapshotting persistent volume claims of item block")
+		}
+	}
+}
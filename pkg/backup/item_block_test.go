@@ -0,0 +1,185 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// relatedItemsAction is an ItemBlockAction that returns the same related items for
+// every item it applies to.
+type relatedItemsAction struct {
+	selector     velero.ResourceSelector
+	relatedItems []velero.ResourceIdentifier
+}
+
+func (a *relatedItemsAction) AppliesTo() (velero.ResourceSelector, error) {
+	return a.selector, nil
+}
+
+func (a *relatedItemsAction) GetRelatedItems(item runtime.Unstructured, backup *velerov1.Backup) ([]velero.ResourceIdentifier, error) {
+	return a.relatedItems, nil
+}
+
+func TestBackupWithItemBlocks(t *testing.T) {
+	tests := []struct {
+		name         string
+		backup       *velerov1.Backup
+		apiResources []*test.APIResource
+		actions      []velero.ItemBlockAction
+		want         []string
+	}{
+		{
+			name:   "related items of an item's block are backed up, including the items related to them",
+			backup: defaultBackup().IncludedNamespaces("ns-1").Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").Result(),
+				),
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("ns-2", "pvc-1").Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").Result(),
+				),
+			},
+			actions: []velero.ItemBlockAction{
+				&relatedItemsAction{
+					selector:     velero.ResourceSelector{IncludedResources: []string{"pods"}},
+					relatedItems: []velero.ResourceIdentifier{{GroupResource: kuberesource.PersistentVolumes, Name: "pv-1"}},
+				},
+				&relatedItemsAction{
+					selector:     velero.ResourceSelector{IncludedResources: []string{"persistentvolumes"}},
+					relatedItems: []velero.ResourceIdentifier{{GroupResource: kuberesource.PersistentVolumes, Name: "pv-2"}},
+				},
+			},
+			want: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json",
+				"resources/persistentvolumes/cluster/pv-1.json",
+				"resources/persistentvolumes/v1-preferredversion/cluster/pv-1.json",
+			},
+		},
+		{
+			name:   "related items in a namespace that isn't included aren't backed up",
+			backup: defaultBackup().IncludedNamespaces("ns-1").Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").Result(),
+				),
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("ns-2", "pvc-1").Result(),
+				),
+			},
+			actions: []velero.ItemBlockAction{
+				&relatedItemsAction{
+					relatedItems: []velero.ResourceIdentifier{{GroupResource: kuberesource.PersistentVolumeClaims, Namespace: "ns-2", Name: "pvc-1"}},
+				},
+			},
+			want: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				req        = &Request{Backup: tc.backup, ItemBlockActions: tc.actions}
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			for _, resource := range tc.apiResources {
+				h.addItems(t, resource)
+			}
+
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+
+			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
+		})
+	}
+}
+
+func TestBackupWithItemBlockSnapshotGroups(t *testing.T) {
+	var (
+		h   = newHarness(t)
+		req = &Request{
+			Backup: defaultBackup().Result(),
+			SnapshotLocations: []*velerov1.VolumeSnapshotLocation{
+				newSnapshotLocation("velero", "default", "default"),
+			},
+			ItemBlockActions: []velero.ItemBlockAction{
+				&relatedItemsAction{
+					selector: velero.ResourceSelector{IncludedResources: []string{"pods"}},
+					relatedItems: []velero.ResourceIdentifier{
+						{GroupResource: kuberesource.PersistentVolumeClaims, Namespace: "ns-1", Name: "pvc-2"},
+						{GroupResource: kuberesource.PersistentVolumeClaims, Namespace: "ns-1", Name: "pvc-3"},
+					},
+				},
+			},
+		}
+		backupFile        = bytes.NewBuffer([]byte{})
+		volumeSnapshotter = &groupingVolumeSnapshotter{
+			fakeVolumeSnapshotter: new(fakeVolumeSnapshotter).
+				WithVolume("pv-1", "vol-1", "", "type-1", 100, false).
+				WithVolume("pv-2", "vol-2", "", "type-1", 100, false).
+				WithVolume("pv-3", "vol-3", "", "type-1", 100, false),
+		}
+	)
+
+	for _, resource := range []*test.APIResource{
+		test.Pods(
+			builder.ForPod("ns-1", "db").Result(),
+		),
+		test.PVCs(
+			builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+			builder.ForPersistentVolumeClaim("ns-1", "pvc-2").VolumeName("pv-2").Result(),
+			builder.ForPersistentVolumeClaim("ns-1", "pvc-3").VolumeName("pv-3").Result(),
+		),
+		test.PVs(
+			builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").Result(),
+			builder.ForPersistentVolume("pv-2").ClaimRef("ns-1", "pvc-2").Result(),
+			builder.ForPersistentVolume("pv-3").ClaimRef("ns-1", "pvc-3").Result(),
+		),
+	} {
+		h.addItems(t, resource)
+	}
+
+	snapshotterGetter := volumeSnapshotterGetter{"default": volumeSnapshotter}
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, snapshotterGetter))
+
+	assert.ElementsMatch(t, []*volume.Snapshot{
+		groupSnapshot("pv-1", "vol-1", "", "vol-1-snapshot", false),
+		groupSnapshot("pv-2", "vol-2", "db", "vol-2-group-snapshot", true),
+		groupSnapshot("pv-3", "vol-3", "db", "vol-3-group-snapshot", true),
+	}, req.VolumeSnapshots)
+	assert.Equal(t, [][]string{{"vol-2", "vol-3"}}, volumeSnapshotter.groups)
+}
@@ -80,6 +80,12 @@ func (r *itemCollector) getGroupItems(log logrus.FieldLogger, group *metav1.APIR
 	if err != nil {
 		return nil, errors.Wrapf(err, "error parsing GroupVersion %q", group.GroupVersion)
 	}
+
+	if !r.backupRequest.APIGroupIncludesExcludes.ShouldInclude(apiGroupName(gv.Group)) {
+		log.Info("Skipping group because it's excluded")
+		return nil, nil
+	}
+
 	if gv.Group == "" {
 		// This is the core group, so make sure we process in the following order: pods, pvcs, pvs,
 		// everything else.
@@ -350,6 +356,19 @@ func (r *itemCollector) writeToFile(item *unstructured.Unstructured) (string, er
 	return f.Name(), nil
 }
 
+// coreGroupName is the name used to refer to the core API group, whose
+// actual name is the empty string, in included/excluded API group lists.
+const coreGroupName = "core"
+
+// apiGroupName returns the name to match against the backup's included/excluded
+// API group lists for the given group.
+func apiGroupName(group string) string {
+	if group == "" {
+		return coreGroupName
+	}
+	return group
+}
+
 // sortCoreGroup sorts the core API group.
 func sortCoreGroup(group *metav1.APIResourceList) {
 	sort.SliceStable(group.APIResources, func(i, j int) bool {
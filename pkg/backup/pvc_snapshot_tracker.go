@@ -51,6 +51,11 @@ func (t *pvcSnapshotTracker) Track(pod *corev1api.Pod, snapshottedVolumes []stri
 	}
 }
 
+// TrackPVC tracks a PVC that was snapshotted without a pod that mounts it.
+func (t *pvcSnapshotTracker) TrackPVC(namespace, name string) {
+	t.pvcs.Insert(key(namespace, name))
+}
+
 // Has returns true if the PVC with the specified namespace and name has been tracked.
 func (t *pvcSnapshotTracker) Has(namespace, name string) bool {
 	return t.pvcs.Has(key(namespace, name))
@@ -21,7 +21,9 @@ import (
 	"sort"
 
 	"github.com/vmware-tanzu/velero/internal/hook"
+	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -41,14 +43,27 @@ type Request struct {
 	SnapshotLocations         []*velerov1api.VolumeSnapshotLocation
 	NamespaceIncludesExcludes *collections.IncludesExcludes
 	ResourceIncludesExcludes  *collections.IncludesExcludes
+	APIGroupIncludesExcludes  *collections.IncludesExcludes
 	ResourceHooks             []hook.ResourceHook
 	ResolvedActions           []resolvedAction
+	ItemBlockActions          []velero.ItemBlockAction
+	ResolvedItemBlockActions  []resolvedItemBlockAction
+	ResourcePolicies          *resourcepolicies.ResourcePolicies
 
 	VolumeSnapshots  []*volume.Snapshot
 	PodVolumeBackups []*velerov1api.PodVolumeBackup
 	BackedUpItems    map[itemKey]struct{}
 }
 
+// VolumePolicies returns the backup's volume policies, followed by those of its
+// resource policies ConfigMap.
+func (r *Request) VolumePolicies() []velerov1api.VolumePolicy {
+	if r.ResourcePolicies == nil {
+		return r.Spec.VolumePolicies
+	}
+	return append(append([]velerov1api.VolumePolicy{}, r.Spec.VolumePolicies...), r.ResourcePolicies.VolumePolicies...)
+}
+
 // BackupResourceList returns the list of backed up resources grouped by the API
 // Version and Kind
 func (r *Request) BackupResourceList() map[string][]string {
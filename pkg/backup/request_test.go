@@ -20,6 +20,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
 )
 
 func TestRequest_BackupResourceList(t *testing.T) {
@@ -80,3 +84,15 @@ func TestRequest_BackupResourceListEntriesSorted(t *testing.T) {
 		"v1/Pod": {"ns1/pod1", "ns2/pod2"},
 	}, req.BackupResourceList())
 }
+
+func TestRequest_VolumePolicies(t *testing.T) {
+	skip := velerov1api.VolumePolicy{VolumeTypes: []string{"hostPath"}, Action: velerov1api.VolumePolicyActionSkip}
+	restic := velerov1api.VolumePolicy{VolumeTypes: []string{"nfs"}, Action: velerov1api.VolumePolicyActionRestic}
+
+	req := &Request{Backup: builder.ForBackup("velero", "backup-1").VolumePolicies(skip).Result()}
+	assert.Equal(t, []velerov1api.VolumePolicy{skip}, req.VolumePolicies())
+
+	req.ResourcePolicies = &resourcepolicies.ResourcePolicies{VolumePolicies: []velerov1api.VolumePolicy{restic}}
+	assert.Equal(t, []velerov1api.VolumePolicy{skip, restic}, req.VolumePolicies())
+	assert.Equal(t, []velerov1api.VolumePolicy{skip}, req.Spec.VolumePolicies)
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// snapshotGroupTag is the tag of a volume snapshot that names the snapshot group
+// that it was taken with.
+const snapshotGroupTag = "velero.io/snapshot-group"
+
+// takePodSnapshotGroup snapshots the volumes of a pod's persistent volume claims together,
+// if the pod's PodSnapshotGroupAnnotation is "true". The snapshot group is named after the pod.
+func (ib *itemBackupper) takePodSnapshotGroup(pod *corev1api.Pod, log logrus.FieldLogger) error {
+	if pod.Annotations[velerov1api.PodSnapshotGroupAnnotation] != "true" {
+		return nil
+	}
+
+	var claims []string
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			claims = append(claims, volume.PersistentVolumeClaim.ClaimName)
+		}
+	}
+
+	return ib.takeSnapshotGroup(pod.Namespace, pod.Name, claims, log)
+}
+
+// takeClaimSnapshotGroup snapshots the volumes of the persistent volume claims in a
+// namespace that have the same SnapshotGroupLabel as the claim of a persistent volume
+// together, the first time that one of their volumes is backed up. grouped is false if
+// the persistent volume's claim isn't in a snapshot group, or if its group has already
+// been snapshotted.
+func (ib *itemBackupper) takeClaimSnapshotGroup(pv *corev1api.PersistentVolume, log logrus.FieldLogger) (grouped bool, err error) {
+	if pv.Spec.ClaimRef == nil {
+		return false, nil
+	}
+	namespace := pv.Spec.ClaimRef.Namespace
+
+	pvc := new(corev1api.PersistentVolumeClaim)
+	if err := ib.getItem(kuberesource.PersistentVolumeClaims, namespace, pv.Spec.ClaimRef.Name, pvc); err != nil {
+		log.WithError(err).Debug("Unable to get persistent volume claim to check its snapshot group")
+		return false, nil
+	}
+
+	group := pvc.Labels[velerov1api.SnapshotGroupLabel]
+	if group == "" || ib.snapshotGroups.Has(key(namespace, group)) {
+		return false, nil
+	}
+	ib.snapshotGroups.Insert(key(namespace, group))
+
+	claims, err := ib.listSnapshotGroupClaims(namespace, group)
+	if err != nil {
+		return true, errors.WithMessagef(err, "error listing persistent volume claims of snapshot group %s", key(namespace, group))
+	}
+
+	if err := ib.takeSnapshotGroup(namespace, group, claims, log); err != nil {
+		return true, err
+	}
+
+	// the claim may have been left out of the group's snapshots, e.g. if it was created
+	// after the claims were listed, in which case its volume is snapshotted on its own.
+	return ib.snapshottedPVs.Has(pv.Name), nil
+}
+
+// listSnapshotGroupClaims returns the names of the persistent volume claims in a namespace
+// whose SnapshotGroupLabel is group.
+func (ib *itemBackupper) listSnapshotGroupClaims(namespace, group string) ([]string, error) {
+	gvr, resource, err := ib.discoveryHelper.ResourceFor(kuberesource.PersistentVolumeClaims.WithVersion(""))
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := ib.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := labels.SelectorFromSet(map[string]string{velerov1api.SnapshotGroupLabel: group})
+	list, err := client.List(metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var claims []string
+	for _, item := range list.Items {
+		claims = append(claims, item.GetName())
+	}
+	return claims, nil
+}
+
+// takeSnapshotGroup snapshots the volumes of persistent volume claims in a namespace
+// together, so that multi-volume applications get crash-consistent snapshots. The volumes
+// of each volume snapshot location are snapshotted in a single consistency group snapshot
+// if its volume snapshotter supports it. Otherwise, they're snapshotted one after another,
+// once all of the volumes have been looked up, to keep the time between the snapshots short.
+func (ib *itemBackupper) takeSnapshotGroup(namespace, group string, claims []string, log logrus.FieldLogger) error {
+	if len(claims) == 0 || boolptr.IsSetToFalse(ib.backupRequest.Spec.SnapshotVolumes) {
+		return nil
+	}
+
+	log = log.WithField("snapshotGroup", key(namespace, group))
+
+	if !ib.backupRequest.ResourceIncludesExcludes.ShouldInclude(kuberesource.PersistentVolumes.String()) {
+		log.Info("Skipping snapshot group because persistent volumes are excluded from the backup")
+		return nil
+	}
+
+	var (
+		errs      []error
+		locations []string
+		pending   = make(map[string][]*pendingSnapshot)
+	)
+
+	for _, claim := range claims {
+		pvc := new(corev1api.PersistentVolumeClaim)
+		if err := ib.getItem(kuberesource.PersistentVolumeClaims, namespace, claim, pvc); err != nil {
+			errs = append(errs, errors.WithMessagef(err, "error getting persistent volume claim %s", key(namespace, claim)))
+			continue
+		}
+		if pvc.Spec.VolumeName == "" || ib.snapshottedPVs.Has(pvc.Spec.VolumeName) {
+			continue
+		}
+
+		pv := new(corev1api.PersistentVolume)
+		if err := ib.getItem(kuberesource.PersistentVolumes, "", pvc.Spec.VolumeName, pv); err != nil {
+			errs = append(errs, errors.WithMessagef(err, "error getting persistent volume %s", pvc.Spec.VolumeName))
+			continue
+		}
+
+		log := log.WithField("persistentVolume", pv.Name)
+
+		if pv.Labels["velero.io/exclude-from-backup"] == "true" {
+			log.Info("Skipping snapshot of persistent volume because it has label velero.io/exclude-from-backup=true")
+			continue
+		}
+
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pv)
+		if err != nil {
+			errs = append(errs, errors.WithStack(err))
+			continue
+		}
+
+		snapshot, err := ib.preparePVSnapshot(&unstructured.Unstructured{Object: obj}, pv, log)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if snapshot == nil {
+			continue
+		}
+
+		snapshot.snapshot.Spec.SnapshotGroup = group
+		snapshot.tags[snapshotGroupTag] = group
+
+		location := snapshot.snapshot.Spec.Location
+		if _, ok := pending[location]; !ok {
+			locations = append(locations, location)
+		}
+		pending[location] = append(pending[location], snapshot)
+	}
+
+	for _, location := range locations {
+		if err := ib.createSnapshotGroup(pending[location], log.WithField("volumeSnapshotLocation", location)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return kubeerrs.NewAggregate(errs)
+}
+
+// createSnapshotGroup takes prepared snapshots of a snapshot group's persistent volumes in
+// a volume snapshot location, and records them in the backup.
+func (ib *itemBackupper) createSnapshotGroup(snapshots []*pendingSnapshot, log logrus.FieldLogger) error {
+	if len(snapshots) > 1 {
+		volumes := make([]velero.SnapshotGroupVolume, 0, len(snapshots))
+		for _, snapshot := range snapshots {
+			volumes = append(volumes, velero.SnapshotGroupVolume{
+				VolumeID: snapshot.snapshot.Spec.ProviderVolumeID,
+				VolumeAZ: snapshot.snapshot.Spec.VolumeAZ,
+				Tags:     snapshot.tags,
+			})
+		}
+
+		log.Infof("Snapshotting %d persistent volumes together", len(volumes))
+		snapshotIDs, grouped, err := velero.CreateSnapshotGroup(snapshots[0].volumeSnapshotter, volumes)
+		if err != nil || grouped {
+			for i, snapshot := range snapshots {
+				if err != nil {
+					snapshot.snapshot.Status.Phase = volume.SnapshotPhaseFailed
+				} else {
+					snapshot.snapshot.Status.Phase = volume.SnapshotPhaseCompleted
+					snapshot.snapshot.Status.ProviderSnapshotID = snapshotIDs[i]
+					snapshot.snapshot.Status.Grouped = true
+				}
+				ib.backupRequest.VolumeSnapshots = append(ib.backupRequest.VolumeSnapshots, snapshot.snapshot)
+				ib.snapshottedPVs.Insert(snapshot.snapshot.Spec.PersistentVolumeName)
+			}
+			return errors.Wrap(err, "error taking snapshot group of volumes")
+		}
+
+		log.Info("Volume snapshotter doesn't support snapshot groups, snapshotting persistent volumes one after another")
+	}
+
+	var errs []error
+	for _, snapshot := range snapshots {
+		if err := ib.createPVSnapshot(snapshot); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return kubeerrs.NewAggregate(errs)
+}
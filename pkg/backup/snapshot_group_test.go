@@ -0,0 +1,184 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// groupingVolumeSnapshotter is a fakeVolumeSnapshotter that supports snapshot groups,
+// and records the IDs of the volumes of each group it snapshots.
+type groupingVolumeSnapshotter struct {
+	*fakeVolumeSnapshotter
+
+	groups [][]string
+}
+
+func (vs *groupingVolumeSnapshotter) CreateSnapshotGroup(volumes []velero.SnapshotGroupVolume) ([]string, error) {
+	var volumeIDs, snapshotIDs []string
+	for _, volume := range volumes {
+		if volume.Tags[snapshotGroupTag] == "" {
+			return nil, assert.AnError
+		}
+		volumeIDs = append(volumeIDs, volume.VolumeID)
+		snapshotIDs = append(snapshotIDs, volume.VolumeID+"-group-snapshot")
+	}
+	vs.groups = append(vs.groups, volumeIDs)
+
+	return snapshotIDs, nil
+}
+
+func groupSnapshot(pvName, volumeID, group, snapshotID string, grouped bool) *volume.Snapshot {
+	return &volume.Snapshot{
+		Spec: volume.SnapshotSpec{
+			BackupName:           "backup-1",
+			Location:             "default",
+			PersistentVolumeName: pvName,
+			ProviderVolumeID:     volumeID,
+			VolumeType:           "type-1",
+			VolumeIOPS:           int64Ptr(100),
+			SnapshotGroup:        group,
+		},
+		Status: volume.SnapshotStatus{
+			Phase:              volume.SnapshotPhaseCompleted,
+			ProviderSnapshotID: snapshotID,
+			Grouped:            grouped,
+		},
+	}
+}
+
+func TestBackupWithSnapshotGroups(t *testing.T) {
+	volumeSnapshotter := func() *fakeVolumeSnapshotter {
+		return new(fakeVolumeSnapshotter).
+			WithVolume("pv-1", "vol-1", "", "type-1", 100, false).
+			WithVolume("pv-2", "vol-2", "", "type-1", 100, false).
+			WithVolume("pv-3", "vol-3", "", "type-1", 100, false)
+	}
+	pvs := test.PVs(
+		builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").Result(),
+		builder.ForPersistentVolume("pv-2").ClaimRef("ns-1", "pvc-2").Result(),
+		builder.ForPersistentVolume("pv-3").ClaimRef("ns-1", "pvc-3").Result(),
+	)
+
+	tests := []struct {
+		name              string
+		apiResources      []*test.APIResource
+		volumeSnapshotter velero.VolumeSnapshotter
+		want              []*volume.Snapshot
+		wantGroups        [][]string
+	}{
+		{
+			name: "volumes of claims with the same snapshot group label are snapshotted together",
+			apiResources: []*test.APIResource{
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").ObjectMeta(builder.WithLabels("velero.io/snapshot-group", "db")).VolumeName("pv-1").Result(),
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-2").ObjectMeta(builder.WithLabels("velero.io/snapshot-group", "db")).VolumeName("pv-2").Result(),
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-3").VolumeName("pv-3").Result(),
+				),
+				pvs,
+			},
+			volumeSnapshotter: &groupingVolumeSnapshotter{fakeVolumeSnapshotter: volumeSnapshotter()},
+			want: []*volume.Snapshot{
+				groupSnapshot("pv-1", "vol-1", "db", "vol-1-group-snapshot", true),
+				groupSnapshot("pv-2", "vol-2", "db", "vol-2-group-snapshot", true),
+				groupSnapshot("pv-3", "vol-3", "", "vol-3-snapshot", false),
+			},
+			wantGroups: [][]string{{"vol-1", "vol-2"}},
+		},
+		{
+			name: "volumes of a snapshot group are snapshotted one after another when the volume snapshotter doesn't support snapshot groups",
+			apiResources: []*test.APIResource{
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-2").ObjectMeta(builder.WithLabels("velero.io/snapshot-group", "db")).VolumeName("pv-2").Result(),
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-3").ObjectMeta(builder.WithLabels("velero.io/snapshot-group", "db")).VolumeName("pv-3").Result(),
+				),
+				pvs,
+			},
+			volumeSnapshotter: volumeSnapshotter(),
+			want: []*volume.Snapshot{
+				groupSnapshot("pv-1", "vol-1", "", "vol-1-snapshot", false),
+				groupSnapshot("pv-2", "vol-2", "db", "vol-2-snapshot", false),
+				groupSnapshot("pv-3", "vol-3", "db", "vol-3-snapshot", false),
+			},
+		},
+		{
+			name: "volumes of the claims of a pod annotated for a snapshot group are snapshotted together",
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").
+						ObjectMeta(builder.WithAnnotations("backup.velero.io/snapshot-group", "true")).
+						Volumes(
+							builder.ForVolume("data").PersistentVolumeClaimSource("pvc-2").Result(),
+							builder.ForVolume("log").PersistentVolumeClaimSource("pvc-3").Result(),
+						).
+						Result(),
+				),
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-2").VolumeName("pv-2").Result(),
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-3").VolumeName("pv-3").Result(),
+				),
+				pvs,
+			},
+			volumeSnapshotter: &groupingVolumeSnapshotter{fakeVolumeSnapshotter: volumeSnapshotter()},
+			want: []*volume.Snapshot{
+				groupSnapshot("pv-2", "vol-2", "pod-1", "vol-2-group-snapshot", true),
+				groupSnapshot("pv-3", "vol-3", "pod-1", "vol-3-group-snapshot", true),
+				groupSnapshot("pv-1", "vol-1", "", "vol-1-snapshot", false),
+			},
+			wantGroups: [][]string{{"vol-2", "vol-3"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h   = newHarness(t)
+				req = &Request{
+					Backup: defaultBackup().Result(),
+					SnapshotLocations: []*velerov1.VolumeSnapshotLocation{
+						newSnapshotLocation("velero", "default", "default"),
+					},
+				}
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			for _, resource := range tc.apiResources {
+				h.addItems(t, resource)
+			}
+
+			snapshotterGetter := volumeSnapshotterGetter{"default": tc.volumeSnapshotter}
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, snapshotterGetter))
+
+			assert.Equal(t, tc.want, req.VolumeSnapshots)
+			if grouping, ok := tc.volumeSnapshotter.(*groupingVolumeSnapshotter); ok {
+				assert.Equal(t, tc.wantGroups, grouping.groups)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// recordSnapshotSizes records the size that each of the backup's completed volume
+// snapshots uses in its provider, as reported by its volume snapshotter. A snapshot's
+// size is left unset if its volume snapshotter doesn't report sizes, or fails to;
+// the latter is logged as a warning, since the snapshot itself is still usable.
+func (ib *itemBackupper) recordSnapshotSizes(log logrus.FieldLogger) {
+	for _, snapshot := range ib.backupRequest.VolumeSnapshots {
+		if snapshot.Status.Phase != volume.SnapshotPhaseCompleted {
+			continue
+		}
+
+		log := log.WithFields(logrus.Fields{
+			"persistentVolume":       snapshot.Spec.PersistentVolumeName,
+			"snapshotID":             snapshot.Status.ProviderSnapshotID,
+			"volumeSnapshotLocation": snapshot.Spec.Location,
+		})
+
+		volumeSnapshotter, err := ib.snapshotVolumeSnapshotter(snapshot)
+		if err != nil {
+			log.WithError(err).Warn("Unable to get size of volume snapshot")
+			continue
+		}
+
+		sizeBytes, supported, err := velero.GetSnapshotSize(volumeSnapshotter, snapshot.Status.ProviderSnapshotID)
+		switch {
+		case err != nil:
+			log.WithError(err).Warn("Unable to get size of volume snapshot")
+		case !supported:
+			log.Debug("Volume snapshotter doesn't report snapshot sizes")
+		default:
+			log.Debugf("Volume snapshot uses %d bytes", sizeBytes)
+			snapshot.Status.SizeBytes = sizeBytes
+		}
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// sizingVolumeSnapshotter is a VolumeSnapshotter that reports the sizes of the
+// snapshots in its map, and fails for any other snapshot.
+type sizingVolumeSnapshotter struct {
+	velero.VolumeSnapshotter
+
+	sizes map[string]int64
+}
+
+func (vs *sizingVolumeSnapshotter) GetSnapshotSize(snapshotID string) (int64, error) {
+	size, ok := vs.sizes[snapshotID]
+	if !ok {
+		return 0, errors.Errorf("snapshot %s not found", snapshotID)
+	}
+	return size, nil
+}
+
+func TestRecordSnapshotSizes(t *testing.T) {
+	newSnapshot := func(location, snapshotID string, phase volume.SnapshotPhase) *volume.Snapshot {
+		return &volume.Snapshot{
+			Spec:   volume.SnapshotSpec{Location: location, PersistentVolumeName: "pv-" + snapshotID},
+			Status: volume.SnapshotStatus{ProviderSnapshotID: snapshotID, Phase: phase},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		snapshots []*volume.Snapshot
+		wantSizes []int64
+	}{
+		{
+			name: "sizes of completed snapshots are recorded",
+			snapshots: []*volume.Snapshot{
+				newSnapshot("vsl-1", "snap-1", volume.SnapshotPhaseCompleted),
+				newSnapshot("vsl-1", "snap-2", volume.SnapshotPhaseFailed),
+			},
+			wantSizes: []int64{1024, 0},
+		},
+		{
+			name:      "size isn't recorded when the volume snapshotter fails to get it",
+			snapshots: []*volume.Snapshot{newSnapshot("vsl-1", "snap-3", volume.SnapshotPhaseCompleted)},
+			wantSizes: []int64{0},
+		},
+		{
+			name:      "size isn't recorded when the volume snapshotter doesn't report sizes",
+			snapshots: []*volume.Snapshot{newSnapshot("vsl-2", "snap-1", volume.SnapshotPhaseCompleted)},
+			wantSizes: []int64{0},
+		},
+		{
+			name:      "size isn't recorded when the snapshot's location isn't found",
+			snapshots: []*volume.Snapshot{newSnapshot("vsl-3", "snap-1", volume.SnapshotPhaseCompleted)},
+			wantSizes: []int64{0},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ib := &itemBackupper{
+				backupRequest: &Request{
+					Backup: builder.ForBackup("velero", "backup-1").Result(),
+					SnapshotLocations: []*velerov1api.VolumeSnapshotLocation{
+						builder.ForVolumeSnapshotLocation("velero", "vsl-1").Result(),
+						builder.ForVolumeSnapshotLocation("velero", "vsl-2").Result(),
+					},
+					VolumeSnapshots: tc.snapshots,
+				},
+				snapshotLocationVolumeSnapshotters: map[string]velero.VolumeSnapshotter{
+					"vsl-1": &sizingVolumeSnapshotter{sizes: map[string]int64{"snap-1": 1024, "snap-2": 2048}},
+					"vsl-2": new(fakeVolumeSnapshotter),
+				},
+			}
+
+			ib.recordSnapshotSizes(test.NewLogger())
+
+			for i, snapshot := range tc.snapshots {
+				assert.Equal(t, tc.wantSizes[i], snapshot.Status.SizeBytes, snapshot.Status.ProviderSnapshotID)
+			}
+		})
+	}
+}
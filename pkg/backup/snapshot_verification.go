@@ -0,0 +1,97 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// verifySnapshots verifies the backup's completed volume snapshots with their volume
+// snapshotters, if the backup requested it, and records the result on each snapshot.
+// A snapshot that fails verification is marked as failed, and its error is logged so
+// that the backup is partially failed; snapshots whose volume snapshotter doesn't
+// support verifying them are left as they are.
+func (ib *itemBackupper) verifySnapshots(log logrus.FieldLogger) {
+	mode := ib.backupRequest.Spec.SnapshotVerification
+	if mode == "" || mode == velerov1api.SnapshotVerificationNone {
+		return
+	}
+
+	testRestore := mode == velerov1api.SnapshotVerificationTestRestore
+
+	for _, snapshot := range ib.backupRequest.VolumeSnapshots {
+		if snapshot.Status.Phase != volume.SnapshotPhaseCompleted {
+			continue
+		}
+
+		log := log.WithFields(logrus.Fields{
+			"persistentVolume":       snapshot.Spec.PersistentVolumeName,
+			"snapshotID":             snapshot.Status.ProviderSnapshotID,
+			"volumeSnapshotLocation": snapshot.Spec.Location,
+		})
+
+		verified, err := ib.verifySnapshot(snapshot, testRestore)
+		switch {
+		case err != nil:
+			log.WithError(err).Error("Error verifying volume snapshot")
+			snapshot.Status.Phase = volume.SnapshotPhaseFailed
+			snapshot.Status.Verification = volume.SnapshotVerificationFailed
+			snapshot.Status.VerificationError = err.Error()
+		case !verified:
+			log.Warn("Volume snapshotter doesn't support verifying snapshots, skipping verification")
+			snapshot.Status.Verification = volume.SnapshotVerificationUnsupported
+		default:
+			log.Info("Verified volume snapshot")
+			snapshot.Status.Verification = volume.SnapshotVerificationVerified
+		}
+	}
+}
+
+// verifySnapshot verifies a volume snapshot with the volume snapshotter of its location.
+func (ib *itemBackupper) verifySnapshot(snapshot *volume.Snapshot, testRestore bool) (bool, error) {
+	volumeSnapshotter, err := ib.snapshotVolumeSnapshotter(snapshot)
+	if err != nil {
+		return false, err
+	}
+
+	return velero.VerifySnapshot(volumeSnapshotter, snapshot.Status.ProviderSnapshotID, snapshot.Spec.VolumeAZ, testRestore)
+}
+
+// snapshotVolumeSnapshotter returns the volume snapshotter of a volume snapshot's location.
+func (ib *itemBackupper) snapshotVolumeSnapshotter(snapshot *volume.Snapshot) (velero.VolumeSnapshotter, error) {
+	var location *velerov1api.VolumeSnapshotLocation
+	for _, l := range ib.backupRequest.SnapshotLocations {
+		if l.Name == snapshot.Spec.Location {
+			location = l
+			break
+		}
+	}
+	if location == nil {
+		return nil, errors.Errorf("volume snapshot location %s not found", snapshot.Spec.Location)
+	}
+
+	volumeSnapshotter, err := ib.volumeSnapshotter(location)
+	if err != nil {
+		return nil, errors.WithMessage(err, "error getting volume snapshotter for volume snapshot location")
+	}
+	return volumeSnapshotter, nil
+}
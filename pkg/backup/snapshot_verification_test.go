@@ -0,0 +1,144 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// verifyingVolumeSnapshotter is a VolumeSnapshotter that verifies the snapshots in
+// its map, failing the ones whose value is false, and records whether it was asked
+// to create test volumes.
+type verifyingVolumeSnapshotter struct {
+	velero.VolumeSnapshotter
+
+	snapshots   map[string]bool
+	testRestore bool
+}
+
+func (vs *verifyingVolumeSnapshotter) VerifySnapshot(snapshotID, volumeAZ string, testRestore bool) error {
+	vs.testRestore = testRestore
+
+	if !vs.snapshots[snapshotID] {
+		return errors.Errorf("snapshot %s isn't ready", snapshotID)
+	}
+	return nil
+}
+
+func TestVerifySnapshots(t *testing.T) {
+	newSnapshot := func(location, snapshotID string, phase volume.SnapshotPhase) *volume.Snapshot {
+		return &volume.Snapshot{
+			Spec:   volume.SnapshotSpec{Location: location, PersistentVolumeName: "pv-" + snapshotID},
+			Status: volume.SnapshotStatus{ProviderSnapshotID: snapshotID, Phase: phase},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		mode             velerov1api.SnapshotVerificationMode
+		snapshots        []*volume.Snapshot
+		wantPhases       []volume.SnapshotPhase
+		wantVerification []volume.SnapshotVerificationPhase
+		wantTestRestore  bool
+	}{
+		{
+			name:             "snapshots aren't verified without a verification mode",
+			snapshots:        []*volume.Snapshot{newSnapshot("vsl-1", "snap-1", volume.SnapshotPhaseCompleted)},
+			wantPhases:       []volume.SnapshotPhase{volume.SnapshotPhaseCompleted},
+			wantVerification: []volume.SnapshotVerificationPhase{""},
+		},
+		{
+			name:             "snapshots aren't verified when the mode is None",
+			mode:             velerov1api.SnapshotVerificationNone,
+			snapshots:        []*volume.Snapshot{newSnapshot("vsl-1", "snap-1", volume.SnapshotPhaseCompleted)},
+			wantPhases:       []volume.SnapshotPhase{volume.SnapshotPhaseCompleted},
+			wantVerification: []volume.SnapshotVerificationPhase{""},
+		},
+		{
+			name: "completed snapshots are verified, and the ones that fail verification are failed",
+			mode: velerov1api.SnapshotVerificationReady,
+			snapshots: []*volume.Snapshot{
+				newSnapshot("vsl-1", "snap-1", volume.SnapshotPhaseCompleted),
+				newSnapshot("vsl-1", "snap-2", volume.SnapshotPhaseCompleted),
+				newSnapshot("vsl-1", "snap-3", volume.SnapshotPhaseFailed),
+			},
+			wantPhases:       []volume.SnapshotPhase{volume.SnapshotPhaseCompleted, volume.SnapshotPhaseFailed, volume.SnapshotPhaseFailed},
+			wantVerification: []volume.SnapshotVerificationPhase{volume.SnapshotVerificationVerified, volume.SnapshotVerificationFailed, ""},
+		},
+		{
+			name:             "test restores are requested in TestRestore mode",
+			mode:             velerov1api.SnapshotVerificationTestRestore,
+			snapshots:        []*volume.Snapshot{newSnapshot("vsl-1", "snap-1", volume.SnapshotPhaseCompleted)},
+			wantPhases:       []volume.SnapshotPhase{volume.SnapshotPhaseCompleted},
+			wantVerification: []volume.SnapshotVerificationPhase{volume.SnapshotVerificationVerified},
+			wantTestRestore:  true,
+		},
+		{
+			name:             "snapshots of volume snapshotters that can't verify them are unsupported",
+			mode:             velerov1api.SnapshotVerificationReady,
+			snapshots:        []*volume.Snapshot{newSnapshot("vsl-2", "snap-1", volume.SnapshotPhaseCompleted)},
+			wantPhases:       []volume.SnapshotPhase{volume.SnapshotPhaseCompleted},
+			wantVerification: []volume.SnapshotVerificationPhase{volume.SnapshotVerificationUnsupported},
+		},
+		{
+			name:             "snapshots whose location isn't found fail verification",
+			mode:             velerov1api.SnapshotVerificationReady,
+			snapshots:        []*volume.Snapshot{newSnapshot("vsl-3", "snap-1", volume.SnapshotPhaseCompleted)},
+			wantPhases:       []volume.SnapshotPhase{volume.SnapshotPhaseFailed},
+			wantVerification: []volume.SnapshotVerificationPhase{volume.SnapshotVerificationFailed},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			verifier := &verifyingVolumeSnapshotter{snapshots: map[string]bool{"snap-1": true}}
+
+			ib := &itemBackupper{
+				backupRequest: &Request{
+					Backup: builder.ForBackup("velero", "backup-1").SnapshotVerification(tc.mode).Result(),
+					SnapshotLocations: []*velerov1api.VolumeSnapshotLocation{
+						builder.ForVolumeSnapshotLocation("velero", "vsl-1").Result(),
+						builder.ForVolumeSnapshotLocation("velero", "vsl-2").Result(),
+					},
+					VolumeSnapshots: tc.snapshots,
+				},
+				snapshotLocationVolumeSnapshotters: map[string]velero.VolumeSnapshotter{
+					"vsl-1": verifier,
+					"vsl-2": new(fakeVolumeSnapshotter),
+				},
+			}
+
+			ib.verifySnapshots(test.NewLogger())
+
+			for i, snapshot := range tc.snapshots {
+				assert.Equal(t, tc.wantPhases[i], snapshot.Status.Phase, snapshot.Status.ProviderSnapshotID)
+				assert.Equal(t, tc.wantVerification[i], snapshot.Status.Verification, snapshot.Status.ProviderSnapshotID)
+				assert.Equal(t, tc.wantVerification[i] == volume.SnapshotVerificationFailed, snapshot.Status.VerificationError != "")
+			}
+			assert.Equal(t, tc.wantTestRestore, verifier.testRestore)
+		})
+	}
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+)
+
+// parseVolumePolicyAction returns the VolumePolicyAction named by s, ignoring case, and
+// whether s names one.
+func parseVolumePolicyAction(s string) (velerov1api.VolumePolicyAction, bool) {
+	for _, action := range []velerov1api.VolumePolicyAction{
+		velerov1api.VolumePolicyActionSnapshot,
+		velerov1api.VolumePolicyActionRestic,
+		velerov1api.VolumePolicyActionSkip,
+	} {
+		if strings.EqualFold(strings.TrimSpace(s), string(action)) {
+			return action, true
+		}
+	}
+	return "", false
+}
+
+// podVolumePolicies returns the policies that a pod's annotation chooses for its
+// volumes, by volume name. Invalid entries are logged and ignored.
+func podVolumePolicies(pod *corev1api.Pod, log logrus.FieldLogger) map[string]velerov1api.VolumePolicyAction {
+	value := pod.Annotations[velerov1api.PodVolumePoliciesAnnotation]
+	if value == "" {
+		return nil
+	}
+
+	policies := make(map[string]velerov1api.VolumePolicyAction)
+	for _, entry := range strings.Split(value, ",") {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 {
+			log.Warnf("Ignoring invalid entry %q in pod's %s annotation, entries must be <volume name>=<policy>", entry, velerov1api.PodVolumePoliciesAnnotation)
+			continue
+		}
+
+		action, ok := parseVolumePolicyAction(parts[1])
+		if !ok {
+			log.Warnf("Ignoring invalid policy %q for volume %s in pod's %s annotation", parts[1], parts[0], velerov1api.PodVolumePoliciesAnnotation)
+			continue
+		}
+		policies[strings.TrimSpace(parts[0])] = action
+	}
+
+	return policies
+}
+
+// persistentVolumeType returns the name of a persistent volume's source, like nfs or
+// awsElasticBlockStore, as it appears in the volume's spec.
+func persistentVolumeType(pv *corev1api.PersistentVolume) string {
+	data, err := json.Marshal(pv.Spec.PersistentVolumeSource)
+	if err != nil {
+		return ""
+	}
+
+	var sources map[string]interface{}
+	if err := json.Unmarshal(data, &sources); err != nil {
+		return ""
+	}
+
+	// a persistent volume has exactly one source.
+	for source := range sources {
+		return source
+	}
+	return ""
+}
+
+// getVolumePolicy returns the policy for a persistent volume claim's volume, or an empty
+// action if none applies. The claim's annotation takes precedence over the policy that
+// the annotation of a pod that mounts it chooses, which takes precedence over the first
+// of the backup's volume policies that matches the volume. The claim and the volume can
+// be nil if they aren't known.
+func getVolumePolicy(
+	policies []velerov1api.VolumePolicy,
+	podAction velerov1api.VolumePolicyAction,
+	pvc *corev1api.PersistentVolumeClaim,
+	pv *corev1api.PersistentVolume,
+	log logrus.FieldLogger,
+) velerov1api.VolumePolicyAction {
+	if pvc != nil {
+		if value, ok := pvc.Annotations[velerov1api.VolumePolicyAnnotation]; ok {
+			action, valid := parseVolumePolicyAction(value)
+			if valid {
+				return action
+			}
+			log.Warnf("Ignoring invalid policy %q in persistent volume claim's %s annotation", value, velerov1api.VolumePolicyAnnotation)
+		}
+	}
+
+	if podAction != "" {
+		return podAction
+	}
+
+	if pvc == nil && pv == nil {
+		return ""
+	}
+
+	var (
+		storageClass, volumeType, driver string
+		capacity                         *resource.Quantity
+	)
+	if pv != nil {
+		storageClass = pv.Spec.StorageClassName
+		volumeType = persistentVolumeType(pv)
+		if pv.Spec.CSI != nil {
+			driver = pv.Spec.CSI.Driver
+		}
+		if size, ok := pv.Spec.Capacity[corev1api.ResourceStorage]; ok {
+			capacity = &size
+		}
+	}
+	if pvc != nil {
+		if storageClass == "" && pvc.Spec.StorageClassName != nil {
+			storageClass = *pvc.Spec.StorageClassName
+		}
+		if size, ok := pvc.Status.Capacity[corev1api.ResourceStorage]; ok && capacity == nil {
+			capacity = &size
+		}
+	}
+
+	for _, policy := range policies {
+		if len(policy.StorageClasses) > 0 && !sets.NewString(policy.StorageClasses...).Has(storageClass) {
+			continue
+		}
+		if len(policy.VolumeTypes) > 0 && !sets.NewString(policy.VolumeTypes...).Has(volumeType) {
+			continue
+		}
+		if len(policy.Drivers) > 0 && !sets.NewString(policy.Drivers...).Has(driver) {
+			continue
+		}
+		// a volume whose capacity isn't known doesn't match a policy for a range of capacities.
+		if policy.MinCapacity != nil && (capacity == nil || capacity.Cmp(*policy.MinCapacity) < 0) {
+			continue
+		}
+		if policy.MaxCapacity != nil && (capacity == nil || capacity.Cmp(*policy.MaxCapacity) > 0) {
+			continue
+		}
+		return policy.Action
+	}
+
+	return ""
+}
+
+// ephemeralVolumeAction returns the action that an ephemeral volume policy chooses for a
+// pod volume, or an empty action if the volume isn't ephemeral or the policy doesn't
+// choose one for its kind.
+func ephemeralVolumeAction(policy *velerov1api.EphemeralVolumePolicy, volume corev1api.Volume) velerov1api.EphemeralVolumeAction {
+	switch {
+	case policy == nil:
+		return ""
+	case volume.EmptyDir != nil:
+		return policy.EmptyDir
+	case volume.CSI != nil:
+		return policy.CSI
+	case volume.Projected != nil:
+		return policy.Projected
+	}
+	return ""
+}
+
+// applyEphemeralVolumePolicy returns which of a pod's volumes are backed up with restic,
+// given the volumes that are chosen otherwise, once a backup's ephemeral volume policy is
+// applied to the pod's ephemeral volumes.
+func applyEphemeralVolumePolicy(policy *velerov1api.EphemeralVolumePolicy, pod *corev1api.Pod, resticVolumes []string, log logrus.FieldLogger) []string {
+	if policy == nil {
+		return resticVolumes
+	}
+
+	// a volume is annotated if the pod's annotations choose to back it up with restic, and
+	// excluded if they choose not to.
+	annotated := sets.NewString(restic.GetVolumesToBackup(pod)...)
+	excluded := sets.NewString(strings.Split(pod.Annotations[restic.VolumesToExcludeAnnotation], ",")...)
+	for volume, action := range podVolumePolicies(pod, log) {
+		if action == velerov1api.VolumePolicyActionRestic {
+			annotated.Insert(volume)
+		} else {
+			excluded.Insert(volume)
+		}
+	}
+
+	actions := make(map[string]velerov1api.EphemeralVolumeAction)
+	for _, volume := range pod.Spec.Volumes {
+		if action := ephemeralVolumeAction(policy, volume); action != "" {
+			actions[volume.Name] = action
+		}
+	}
+
+	var res []string
+	for _, volume := range resticVolumes {
+		switch actions[volume] {
+		case velerov1api.EphemeralVolumeActionExclude:
+			log.WithField("podVolume", volume).Info("Not backing up ephemeral pod volume with restic because the backup's ephemeral volume policy excludes it.")
+			continue
+		case velerov1api.EphemeralVolumeActionIncludeIfAnnotated:
+			if !annotated.Has(volume) {
+				log.WithField("podVolume", volume).Info("Not backing up ephemeral pod volume with restic because it isn't annotated and the backup's ephemeral volume policy only includes annotated ones.")
+				continue
+			}
+		}
+		res = append(res, volume)
+	}
+
+	included := sets.NewString(res...)
+	for _, volume := range pod.Spec.Volumes {
+		if actions[volume.Name] == velerov1api.EphemeralVolumeActionInclude && !included.Has(volume.Name) && !excluded.Has(volume.Name) {
+			res = append(res, volume.Name)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestPodVolumePolicies(t *testing.T) {
+	tests := []struct {
+		name       string
+		annotation string
+		want       map[string]velerov1api.VolumePolicyAction
+	}{
+		{
+			name: "pod without the annotation has no policies",
+			want: nil,
+		},
+		{
+			name:       "policies are parsed ignoring case and whitespace",
+			annotation: "vol-1=restic, vol-2 = SKIP,vol-3=Snapshot",
+			want: map[string]velerov1api.VolumePolicyAction{
+				"vol-1": velerov1api.VolumePolicyActionRestic,
+				"vol-2": velerov1api.VolumePolicyActionSkip,
+				"vol-3": velerov1api.VolumePolicyActionSnapshot,
+			},
+		},
+		{
+			name:       "invalid entries are ignored",
+			annotation: "vol-1,vol-2=Copy,vol-3=Skip",
+			want: map[string]velerov1api.VolumePolicyAction{
+				"vol-3": velerov1api.VolumePolicyActionSkip,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := builder.ForPod("ns-1", "pod-1").Result()
+			if tc.annotation != "" {
+				pod.Annotations = map[string]string{velerov1api.PodVolumePoliciesAnnotation: tc.annotation}
+			}
+
+			assert.Equal(t, tc.want, podVolumePolicies(pod, velerotest.NewLogger()))
+		})
+	}
+}
+
+func TestPersistentVolumeType(t *testing.T) {
+	assert.Equal(t, "awsElasticBlockStore", persistentVolumeType(builder.ForPersistentVolume("pv-1").AWSEBSVolumeID("vol-1").Result()))
+	assert.Equal(t, "csi", persistentVolumeType(builder.ForPersistentVolume("pv-1").CSI("csi.example.com", "vol-1").Result()))
+	assert.Equal(t, "", persistentVolumeType(builder.ForPersistentVolume("pv-1").Result()))
+}
+
+func TestGetVolumePolicy(t *testing.T) {
+	annotatedPVC := func(policy string) *corev1api.PersistentVolumeClaim {
+		return builder.ForPersistentVolumeClaim("ns-1", "pvc-1").
+			ObjectMeta(builder.WithAnnotations(velerov1api.VolumePolicyAnnotation, policy)).
+			Result()
+	}
+
+	quantity := func(s string) *resource.Quantity {
+		q := resource.MustParse(s)
+		return &q
+	}
+
+	policies := []velerov1api.VolumePolicy{
+		{StorageClasses: []string{"nfs"}, Action: velerov1api.VolumePolicyActionRestic},
+		{StorageClasses: []string{"gp2"}, VolumeTypes: []string{"awsElasticBlockStore"}, Action: velerov1api.VolumePolicyActionSnapshot},
+		{VolumeTypes: []string{"hostPath"}, Action: velerov1api.VolumePolicyActionSkip},
+		{Drivers: []string{"csi.example.com"}, MaxCapacity: quantity("1Gi"), Action: velerov1api.VolumePolicyActionRestic},
+		{Drivers: []string{"csi.example.com"}, MinCapacity: quantity("100Gi"), Action: velerov1api.VolumePolicyActionSkip},
+	}
+
+	tests := []struct {
+		name      string
+		podAction velerov1api.VolumePolicyAction
+		pvc       *corev1api.PersistentVolumeClaim
+		pv        *corev1api.PersistentVolume
+		want      velerov1api.VolumePolicyAction
+	}{
+		{
+			name: "no policy applies without a claim or volume",
+			want: "",
+		},
+		{
+			name:      "claim's annotation takes precedence over the pod's policy",
+			podAction: velerov1api.VolumePolicyActionRestic,
+			pvc:       annotatedPVC("skip"),
+			want:      velerov1api.VolumePolicyActionSkip,
+		},
+		{
+			name:      "invalid claim annotation is ignored",
+			podAction: velerov1api.VolumePolicyActionRestic,
+			pvc:       annotatedPVC("copy"),
+			want:      velerov1api.VolumePolicyActionRestic,
+		},
+		{
+			name:      "pod's policy takes precedence over the backup's policies",
+			podAction: velerov1api.VolumePolicyActionSnapshot,
+			pv:        builder.ForPersistentVolume("pv-1").StorageClass("nfs").Result(),
+			want:      velerov1api.VolumePolicyActionSnapshot,
+		},
+		{
+			name: "backup policy matches the volume's storage class",
+			pv:   builder.ForPersistentVolume("pv-1").StorageClass("nfs").Result(),
+			want: velerov1api.VolumePolicyActionRestic,
+		},
+		{
+			name: "backup policy matches the claim's storage class when the volume isn't known",
+			pvc:  builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("nfs").Result(),
+			want: velerov1api.VolumePolicyActionRestic,
+		},
+		{
+			name: "backup policy must match both the storage class and the volume type",
+			pv:   builder.ForPersistentVolume("pv-1").StorageClass("gp2").CSI("csi.example.com", "vol-1").Result(),
+			want: "",
+		},
+		{
+			name: "backup policy matches the storage class and the volume type",
+			pv:   builder.ForPersistentVolume("pv-1").StorageClass("gp2").AWSEBSVolumeID("vol-1").Result(),
+			want: velerov1api.VolumePolicyActionSnapshot,
+		},
+		{
+			name: "backup policy matches the volume's driver and capacity",
+			pv:   builder.ForPersistentVolume("pv-1").CSI("csi.example.com", "vol-1").Capacity(resourceList("500Mi")).Result(),
+			want: velerov1api.VolumePolicyActionRestic,
+		},
+		{
+			name: "backup policy matches the volume's driver and minimum capacity",
+			pv:   builder.ForPersistentVolume("pv-1").CSI("csi.example.com", "vol-1").Capacity(resourceList("100Gi")).Result(),
+			want: velerov1api.VolumePolicyActionSkip,
+		},
+		{
+			name: "backup policy doesn't match a volume whose capacity is outside its range",
+			pv:   builder.ForPersistentVolume("pv-1").CSI("csi.example.com", "vol-1").Capacity(resourceList("10Gi")).Result(),
+			want: "",
+		},
+		{
+			name: "backup policy with a capacity range doesn't match a volume whose capacity isn't known",
+			pv:   builder.ForPersistentVolume("pv-1").CSI("csi.example.com", "vol-1").Result(),
+			want: "",
+		},
+		{
+			name: "backup policy doesn't match a volume of another driver",
+			pv:   builder.ForPersistentVolume("pv-1").CSI("csi.other.com", "vol-1").Capacity(resourceList("500Mi")).Result(),
+			want: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, getVolumePolicy(policies, tc.podAction, tc.pvc, tc.pv, velerotest.NewLogger()))
+		})
+	}
+}
+
+func resourceList(storage string) corev1api.ResourceList {
+	return corev1api.ResourceList{corev1api.ResourceStorage: resource.MustParse(storage)}
+}
+
+func TestApplyEphemeralVolumePolicy(t *testing.T) {
+	newPod := func(annotations ...string) *corev1api.Pod {
+		return builder.ForPod("ns-1", "pod-1").
+			ObjectMeta(builder.WithAnnotations(annotations...)).
+			Volumes(
+				builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result(),
+				builder.ForVolume("scratch").EmptyDirSource().Result(),
+				builder.ForVolume("secrets-store").CSISource("secrets-store.csi.k8s.io").Result(),
+				builder.ForVolume("kube-api-access").ProjectedSource().Result(),
+			).
+			Result()
+	}
+
+	tests := []struct {
+		name          string
+		policy        *velerov1api.EphemeralVolumePolicy
+		pod           *corev1api.Pod
+		resticVolumes []string
+		want          []string
+	}{
+		{
+			name:          "no policy keeps the volumes",
+			pod:           newPod(),
+			resticVolumes: []string{"data", "scratch", "secrets-store", "kube-api-access"},
+			want:          []string{"data", "scratch", "secrets-store", "kube-api-access"},
+		},
+		{
+			name:          "excluded kinds aren't backed up, even if annotated",
+			policy:        &velerov1api.EphemeralVolumePolicy{EmptyDir: velerov1api.EphemeralVolumeActionExclude, Projected: velerov1api.EphemeralVolumeActionExclude},
+			pod:           newPod("backup.velero.io/backup-volumes", "scratch"),
+			resticVolumes: []string{"data", "scratch", "secrets-store", "kube-api-access"},
+			want:          []string{"data", "secrets-store"},
+		},
+		{
+			name:          "kinds included if annotated are only backed up if annotated",
+			policy:        &velerov1api.EphemeralVolumePolicy{EmptyDir: velerov1api.EphemeralVolumeActionIncludeIfAnnotated, CSI: velerov1api.EphemeralVolumeActionIncludeIfAnnotated},
+			pod:           newPod("backup.velero.io/volume-policies", "secrets-store=Restic"),
+			resticVolumes: []string{"data", "scratch", "secrets-store", "kube-api-access"},
+			want:          []string{"data", "secrets-store", "kube-api-access"},
+		},
+		{
+			name:          "included kinds are backed up without annotations",
+			policy:        &velerov1api.EphemeralVolumePolicy{EmptyDir: velerov1api.EphemeralVolumeActionInclude},
+			pod:           newPod(),
+			resticVolumes: nil,
+			want:          []string{"scratch"},
+		},
+		{
+			name:          "included kinds that pods exclude aren't backed up",
+			policy:        &velerov1api.EphemeralVolumePolicy{EmptyDir: velerov1api.EphemeralVolumeActionInclude, CSI: velerov1api.EphemeralVolumeActionInclude},
+			pod:           newPod("backup.velero.io/backup-volumes-excludes", "scratch", "backup.velero.io/volume-policies", "secrets-store=Skip"),
+			resticVolumes: []string{"data"},
+			want:          []string{"data"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, applyEphemeralVolumePolicy(tc.policy, tc.pod, tc.resticVolumes, velerotest.NewLogger()))
+		})
+	}
+}
@@ -19,9 +19,11 @@ package builder
 import (
 	"time"
 
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 )
 
 /*
@@ -73,7 +75,8 @@ func (b *BackupBuilder) ObjectMeta(opts ...ObjectMetaOpt) *BackupBuilder {
 	return b
 }
 
-// FromSchedule sets the Backup's spec and labels from the Schedule template
+// FromSchedule sets the Backup's spec and labels from the Schedule template, and
+// an owner reference to the Schedule if it uses owner references in its backups.
 func (b *BackupBuilder) FromSchedule(schedule *velerov1api.Schedule) *BackupBuilder {
 	labels := schedule.Labels
 	if labels == nil {
@@ -83,6 +86,17 @@ func (b *BackupBuilder) FromSchedule(schedule *velerov1api.Schedule) *BackupBuil
 
 	b.object.Spec = schedule.Spec.Template
 	b.ObjectMeta(WithLabelsMap(labels))
+
+	if boolptr.IsSetToTrue(schedule.Spec.UseOwnerReferencesInBackup) {
+		b.ObjectMeta(WithOwnerReferences(metav1.OwnerReference{
+			APIVersion: velerov1api.SchemeGroupVersion.String(),
+			Kind:       "Schedule",
+			Name:       schedule.Name,
+			UID:        schedule.UID,
+			Controller: boolptr.True(),
+		}))
+	}
+
 	return b
 }
 
@@ -110,6 +124,18 @@ func (b *BackupBuilder) ExcludedResources(resources ...string) *BackupBuilder {
 	return b
 }
 
+// IncludedAPIGroups sets the Backup's included API groups.
+func (b *BackupBuilder) IncludedAPIGroups(groups ...string) *BackupBuilder {
+	b.object.Spec.IncludedAPIGroups = groups
+	return b
+}
+
+// ExcludedAPIGroups sets the Backup's excluded API groups.
+func (b *BackupBuilder) ExcludedAPIGroups(groups ...string) *BackupBuilder {
+	b.object.Spec.ExcludedAPIGroups = groups
+	return b
+}
+
 // IncludeClusterResources sets the Backup's "include cluster resources" flag.
 func (b *BackupBuilder) IncludeClusterResources(val bool) *BackupBuilder {
 	b.object.Spec.IncludeClusterResources = &val
@@ -134,6 +160,45 @@ func (b *BackupBuilder) DefaultVolumesToRestic(val bool) *BackupBuilder {
 	return b
 }
 
+// DefaultVolumesToFsBackup sets the Backup's "DefaultVolumesToFsBackup" flag.
+func (b *BackupBuilder) DefaultVolumesToFsBackup(val bool) *BackupBuilder {
+	b.object.Spec.DefaultVolumesToFsBackup = &val
+	return b
+}
+
+// UnmountedVolumesToRestic sets the Backup's "UnmountedVolumesToRestic" flag.
+func (b *BackupBuilder) UnmountedVolumesToRestic(val bool) *BackupBuilder {
+	b.object.Spec.UnmountedVolumesToRestic = &val
+	return b
+}
+
+// SkipUnchangedVolumes sets the Backup's "SkipUnchangedVolumes" flag.
+func (b *BackupBuilder) SkipUnchangedVolumes(val bool) *BackupBuilder {
+	b.object.Spec.SkipUnchangedVolumes = &val
+	return b
+}
+
+// VolumePolicies sets the Backup's volume policies.
+func (b *BackupBuilder) VolumePolicies(policies ...velerov1api.VolumePolicy) *BackupBuilder {
+	b.object.Spec.VolumePolicies = append(b.object.Spec.VolumePolicies, policies...)
+	return b
+}
+
+// ResourcePolicy sets the name of the Backup's resource policies ConfigMap.
+func (b *BackupBuilder) ResourcePolicy(name string) *BackupBuilder {
+	b.object.Spec.ResourcePolicy = &corev1api.TypedLocalObjectReference{
+		Kind: "ConfigMap",
+		Name: name,
+	}
+	return b
+}
+
+// EphemeralVolumePolicy sets the Backup's ephemeral volume policy.
+func (b *BackupBuilder) EphemeralVolumePolicy(policy *velerov1api.EphemeralVolumePolicy) *BackupBuilder {
+	b.object.Spec.EphemeralVolumePolicy = policy
+	return b
+}
+
 // Phase sets the Backup's phase.
 func (b *BackupBuilder) Phase(phase velerov1api.BackupPhase) *BackupBuilder {
 	b.object.Status.Phase = phase
@@ -152,18 +217,60 @@ func (b *BackupBuilder) VolumeSnapshotLocations(locations ...string) *BackupBuil
 	return b
 }
 
+// ReplicationLocations sets the Backup's replication locations.
+func (b *BackupBuilder) ReplicationLocations(locations ...string) *BackupBuilder {
+	b.object.Spec.ReplicationLocations = locations
+	return b
+}
+
+// VolumePathIncludes sets the Backup's volume path include patterns.
+func (b *BackupBuilder) VolumePathIncludes(patterns ...string) *BackupBuilder {
+	b.object.Spec.VolumePathIncludes = patterns
+	return b
+}
+
+// VolumePathExcludes sets the Backup's volume path exclude patterns.
+func (b *BackupBuilder) VolumePathExcludes(patterns ...string) *BackupBuilder {
+	b.object.Spec.VolumePathExcludes = patterns
+	return b
+}
+
 // TTL sets the Backup's TTL.
 func (b *BackupBuilder) TTL(ttl time.Duration) *BackupBuilder {
 	b.object.Spec.TTL.Duration = ttl
 	return b
 }
 
+// SnapshotTTL sets the Backup's volume snapshots' TTL.
+func (b *BackupBuilder) SnapshotTTL(ttl time.Duration) *BackupBuilder {
+	b.object.Spec.SnapshotTTL = &metav1.Duration{Duration: ttl}
+	return b
+}
+
+// SnapshotTags sets the tags to add to the Backup's volume snapshots.
+func (b *BackupBuilder) SnapshotTags(tags map[string]string) *BackupBuilder {
+	b.object.Spec.SnapshotTags = tags
+	return b
+}
+
+// SnapshotVerification sets how the Backup's volume snapshots are verified.
+func (b *BackupBuilder) SnapshotVerification(mode velerov1api.SnapshotVerificationMode) *BackupBuilder {
+	b.object.Spec.SnapshotVerification = mode
+	return b
+}
+
 // Expiration sets the Backup's expiration.
 func (b *BackupBuilder) Expiration(val time.Time) *BackupBuilder {
 	b.object.Status.Expiration = &metav1.Time{Time: val}
 	return b
 }
 
+// SnapshotExpiration sets the Backup's volume snapshots' expiration.
+func (b *BackupBuilder) SnapshotExpiration(val time.Time) *BackupBuilder {
+	b.object.Status.SnapshotExpiration = &metav1.Time{Time: val}
+	return b
+}
+
 // StartTimestamp sets the Backup's start timestamp.
 func (b *BackupBuilder) StartTimestamp(val time.Time) *BackupBuilder {
 	b.object.Status.StartTimestamp = &metav1.Time{Time: val}
@@ -176,6 +283,12 @@ func (b *BackupBuilder) CompletionTimestamp(val time.Time) *BackupBuilder {
 	return b
 }
 
+// ObjectLockRetainUntil sets the time until which the Backup is locked in object storage.
+func (b *BackupBuilder) ObjectLockRetainUntil(val time.Time) *BackupBuilder {
+	b.object.Status.ObjectLockRetainUntil = &metav1.Time{Time: val}
+	return b
+}
+
 // Hooks sets the Backup's hooks.
 func (b *BackupBuilder) Hooks(hooks velerov1api.BackupHooks) *BackupBuilder {
 	b.object.Spec.Hooks = hooks
@@ -0,0 +1,63 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// BackupPolicyBuilder builds BackupPolicy objects.
+type BackupPolicyBuilder struct {
+	object *velerov1api.BackupPolicy
+}
+
+// ForBackupPolicy is the constructor for a BackupPolicyBuilder.
+func ForBackupPolicy(name string) *BackupPolicyBuilder {
+	return &BackupPolicyBuilder{
+		object: &velerov1api.BackupPolicy{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov1api.SchemeGroupVersion.String(),
+				Kind:       "BackupPolicy",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		},
+	}
+}
+
+// Result returns the built BackupPolicy.
+func (b *BackupPolicyBuilder) Result() *velerov1api.BackupPolicy {
+	return b.object
+}
+
+// ObjectMeta applies functional options to the BackupPolicy's ObjectMeta.
+func (b *BackupPolicyBuilder) ObjectMeta(opts ...ObjectMetaOpt) *BackupPolicyBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+
+	return b
+}
+
+// Template sets the BackupPolicy's template.
+func (b *BackupPolicyBuilder) Template(spec velerov1api.BackupSpec) *BackupPolicyBuilder {
+	b.object.Spec.Template = spec
+	return b
+}
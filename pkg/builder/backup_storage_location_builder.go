@@ -19,6 +19,8 @@ package builder
 import (
 	"time"
 
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -83,6 +85,112 @@ func (b *BackupStorageLocationBuilder) Prefix(val string) *BackupStorageLocation
 	return b
 }
 
+// CACert sets the BackupStorageLocation's object storage CA bundle.
+func (b *BackupStorageLocationBuilder) CACert(val []byte) *BackupStorageLocationBuilder {
+	if b.object.Spec.StorageType.ObjectStorage == nil {
+		b.object.Spec.StorageType.ObjectStorage = new(velerov1api.ObjectStorageLocation)
+	}
+	b.object.Spec.ObjectStorage.CACert = val
+	return b
+}
+
+// CACertRef sets the BackupStorageLocation's object storage CA bundle reference.
+func (b *BackupStorageLocationBuilder) CACertRef(ref *velerov1api.CACertReference) *BackupStorageLocationBuilder {
+	if b.object.Spec.StorageType.ObjectStorage == nil {
+		b.object.Spec.StorageType.ObjectStorage = new(velerov1api.ObjectStorageLocation)
+	}
+	b.object.Spec.ObjectStorage.CACertRef = ref
+	return b
+}
+
+// InsecureSkipTLSVerify sets whether the BackupStorageLocation's object storage TLS certificate is verified.
+func (b *BackupStorageLocationBuilder) InsecureSkipTLSVerify(val bool) *BackupStorageLocationBuilder {
+	if b.object.Spec.StorageType.ObjectStorage == nil {
+		b.object.Spec.StorageType.ObjectStorage = new(velerov1api.ObjectStorageLocation)
+	}
+	b.object.Spec.ObjectStorage.InsecureSkipTLSVerify = val
+	return b
+}
+
+// ServerSideEncryption sets the BackupStorageLocation's object storage server-side encryption.
+func (b *BackupStorageLocationBuilder) ServerSideEncryption(algorithm, kmsKeyID string) *BackupStorageLocationBuilder {
+	if b.object.Spec.StorageType.ObjectStorage == nil {
+		b.object.Spec.StorageType.ObjectStorage = new(velerov1api.ObjectStorageLocation)
+	}
+	b.object.Spec.ObjectStorage.ServerSideEncryption = &velerov1api.ServerSideEncryption{
+		Algorithm: algorithm,
+		KMSKeyID:  kmsKeyID,
+	}
+	return b
+}
+
+// ObjectLock sets the BackupStorageLocation's object storage object lock.
+func (b *BackupStorageLocationBuilder) ObjectLock(mode velerov1api.ObjectLockMode, retentionPeriod time.Duration) *BackupStorageLocationBuilder {
+	if b.object.Spec.StorageType.ObjectStorage == nil {
+		b.object.Spec.StorageType.ObjectStorage = new(velerov1api.ObjectStorageLocation)
+	}
+	b.object.Spec.ObjectStorage.ObjectLock = &velerov1api.ObjectLock{
+		Mode:            mode,
+		RetentionPeriod: metav1.Duration{Duration: retentionPeriod},
+	}
+	return b
+}
+
+// BandwidthLimit sets the BackupStorageLocation's upload and download bandwidth limits,
+// in bytes per second. A nil limit leaves that direction unlimited.
+func (b *BackupStorageLocationBuilder) BandwidthLimit(upload, download *resource.Quantity) *BackupStorageLocationBuilder {
+	if b.object.Spec.StorageType.ObjectStorage == nil {
+		b.object.Spec.StorageType.ObjectStorage = new(velerov1api.ObjectStorageLocation)
+	}
+	b.object.Spec.ObjectStorage.BandwidthLimit = &velerov1api.BandwidthLimit{
+		Upload:   upload,
+		Download: download,
+	}
+	return b
+}
+
+// DownloadMode sets the BackupStorageLocation's object storage download mode.
+func (b *BackupStorageLocationBuilder) DownloadMode(mode velerov1api.DownloadMode) *BackupStorageLocationBuilder {
+	if b.object.Spec.StorageType.ObjectStorage == nil {
+		b.object.Spec.StorageType.ObjectStorage = new(velerov1api.ObjectStorageLocation)
+	}
+	b.object.Spec.ObjectStorage.DownloadMode = mode
+	return b
+}
+
+// DownloadURLTTL sets how long the BackupStorageLocation's download URLs are valid for.
+func (b *BackupStorageLocationBuilder) DownloadURLTTL(ttl time.Duration) *BackupStorageLocationBuilder {
+	if b.object.Spec.StorageType.ObjectStorage == nil {
+		b.object.Spec.StorageType.ObjectStorage = new(velerov1api.ObjectStorageLocation)
+	}
+	b.object.Spec.ObjectStorage.DownloadURLTTL = &metav1.Duration{Duration: ttl}
+	return b
+}
+
+// Credential sets the BackupStorageLocation's credential selector.
+func (b *BackupStorageLocationBuilder) Credential(selector *corev1api.SecretKeySelector) *BackupStorageLocationBuilder {
+	b.object.Spec.Credential = selector
+	return b
+}
+
+// Identity sets the BackupStorageLocation's ambient identity.
+func (b *BackupStorageLocationBuilder) Identity(identity *velerov1api.AmbientIdentity) *BackupStorageLocationBuilder {
+	b.object.Spec.Identity = identity
+	return b
+}
+
+// EgressProxy sets the BackupStorageLocation's egress proxy.
+func (b *BackupStorageLocationBuilder) EgressProxy(proxy *velerov1api.EgressProxy) *BackupStorageLocationBuilder {
+	b.object.Spec.EgressProxy = proxy
+	return b
+}
+
+// Default sets the BackupStorageLocation's default flag.
+func (b *BackupStorageLocationBuilder) Default(isDefault bool) *BackupStorageLocationBuilder {
+	b.object.Spec.Default = isDefault
+	return b
+}
+
 // AccessMode sets the BackupStorageLocation's access mode.
 func (b *BackupStorageLocationBuilder) AccessMode(accessMode velerov1api.BackupStorageLocationAccessMode) *BackupStorageLocationBuilder {
 	b.object.Spec.AccessMode = accessMode
@@ -130,6 +130,13 @@ func WithUID(val string) func(obj metav1.Object) {
 	}
 }
 
+// WithOwnerReferences is a functional option that applies the specified owner references to an object.
+func WithOwnerReferences(vals ...metav1.OwnerReference) func(obj metav1.Object) {
+	return func(obj metav1.Object) {
+		obj.SetOwnerReferences(vals)
+	}
+}
+
 // WithGenerateName is a functional option that applies the specified generate name to an object.
 func WithGenerateName(val string) func(obj metav1.Object) {
 	return func(obj metav1.Object) {
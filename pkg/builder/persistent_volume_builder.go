@@ -94,3 +94,9 @@ func (b *PersistentVolumeBuilder) StorageClass(name string) *PersistentVolumeBui
 	b.object.Spec.StorageClassName = name
 	return b
 }
+
+// Capacity sets the PersistentVolume's capacity.
+func (b *PersistentVolumeBuilder) Capacity(capacity corev1api.ResourceList) *PersistentVolumeBuilder {
+	b.object.Spec.Capacity = capacity
+	return b
+}
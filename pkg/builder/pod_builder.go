@@ -76,6 +76,20 @@ func (b *PodBuilder) NodeName(val string) *PodBuilder {
 	return b
 }
 
+// NodeSelector sets the pod's node selector
+func (b *PodBuilder) NodeSelector(val map[string]string) *PodBuilder {
+	b.object.Spec.NodeSelector = val
+	return b
+}
+
+// ImagePullSecrets appends to the pod's image pull secrets
+func (b *PodBuilder) ImagePullSecrets(names ...string) *PodBuilder {
+	for _, name := range names {
+		b.object.Spec.ImagePullSecrets = append(b.object.Spec.ImagePullSecrets, corev1api.LocalObjectReference{Name: name})
+	}
+	return b
+}
+
 func (b *PodBuilder) InitContainers(containers ...*corev1api.Container) *PodBuilder {
 	for _, c := range containers {
 		b.object.Spec.InitContainers = append(b.object.Spec.InitContainers, *c)
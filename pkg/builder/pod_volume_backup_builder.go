@@ -17,6 +17,8 @@ limitations under the License.
 package builder
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -75,8 +77,32 @@ func (b *PodVolumeBackupBuilder) PodName(name string) *PodVolumeBackupBuilder {
 	return b
 }
 
+// PodNamespace sets the namespace of the pod associated with this PodVolumeBackup.
+func (b *PodVolumeBackupBuilder) PodNamespace(ns string) *PodVolumeBackupBuilder {
+	b.object.Spec.Pod.Namespace = ns
+	return b
+}
+
 // Volume sets the name of the volume associated with this PodVolumeBackup.
 func (b *PodVolumeBackupBuilder) Volume(volume string) *PodVolumeBackupBuilder {
 	b.object.Spec.Volume = volume
 	return b
 }
+
+// UploaderType sets the PodVolumeBackup's uploader type.
+func (b *PodVolumeBackupBuilder) UploaderType(uploaderType velerov1api.UploaderType) *PodVolumeBackupBuilder {
+	b.object.Spec.UploaderType = uploaderType
+	return b
+}
+
+// StartTimestamp sets the PodVolumeBackup's start timestamp.
+func (b *PodVolumeBackupBuilder) StartTimestamp(val time.Time) *PodVolumeBackupBuilder {
+	b.object.Status.StartTimestamp = &metav1.Time{Time: val}
+	return b
+}
+
+// ChangeIndicator sets the PodVolumeBackup's change indicator.
+func (b *PodVolumeBackupBuilder) ChangeIndicator(changeIndicator string) *PodVolumeBackupBuilder {
+	b.object.Status.ChangeIndicator = changeIndicator
+	return b
+}
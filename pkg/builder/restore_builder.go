@@ -19,6 +19,7 @@ package builder
 import (
 	"time"
 
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -95,6 +96,12 @@ func (b *RestoreBuilder) ExcludedResources(resources ...string) *RestoreBuilder
 	return b
 }
 
+// IncludedResourceNames appends to the Restore's included resource names.
+func (b *RestoreBuilder) IncludedResourceNames(resourceNames ...string) *RestoreBuilder {
+	b.object.Spec.IncludedResourceNames = append(b.object.Spec.IncludedResourceNames, resourceNames...)
+	return b
+}
+
 // IncludeClusterResources sets the Restore's "include cluster resources" flag.
 func (b *RestoreBuilder) IncludeClusterResources(val bool) *RestoreBuilder {
 	b.object.Spec.IncludeClusterResources = &val
@@ -124,6 +131,23 @@ func (b *RestoreBuilder) NamespaceMappings(mapping ...string) *RestoreBuilder {
 	return b
 }
 
+// ZoneMappings sets the Restore's zone mappings.
+func (b *RestoreBuilder) ZoneMappings(mapping ...string) *RestoreBuilder {
+	if b.object.Spec.ZoneMapping == nil {
+		b.object.Spec.ZoneMapping = make(map[string]string)
+	}
+
+	if len(mapping)%2 != 0 {
+		panic("mapping must contain an even number of values")
+	}
+
+	for i := 0; i < len(mapping); i += 2 {
+		b.object.Spec.ZoneMapping[mapping[i]] = mapping[i+1]
+	}
+
+	return b
+}
+
 // Phase sets the Restore's phase.
 func (b *RestoreBuilder) Phase(phase velerov1api.RestorePhase) *RestoreBuilder {
 	b.object.Status.Phase = phase
@@ -136,6 +160,164 @@ func (b *RestoreBuilder) RestorePVs(val bool) *RestoreBuilder {
 	return b
 }
 
+// PreserveNodePorts sets the Restore's preserve NodePorts flag.
+func (b *RestoreBuilder) PreserveNodePorts(val bool) *RestoreBuilder {
+	b.object.Spec.PreserveNodePorts = &val
+	return b
+}
+
+// ResourceModifier sets the Restore's resource modifier ConfigMap reference.
+func (b *RestoreBuilder) ResourceModifier(configMapName string) *RestoreBuilder {
+	b.object.Spec.ResourceModifier = &corev1api.TypedLocalObjectReference{
+		Kind: "ConfigMap",
+		Name: configMapName,
+	}
+	return b
+}
+
+// DryRun sets the Restore's dry run flag.
+func (b *RestoreBuilder) DryRun(val bool) *RestoreBuilder {
+	b.object.Spec.DryRun = val
+	return b
+}
+
+// ResourcePriorities sets the Restore's resource priorities.
+func (b *RestoreBuilder) ResourcePriorities(highPriorities, lowPriorities []string) *RestoreBuilder {
+	b.object.Spec.ResourcePriorities = &velerov1api.RestoreResourcePriorities{
+		HighPriorities: highPriorities,
+		LowPriorities:  lowPriorities,
+	}
+	return b
+}
+
+// PVRenamePolicy sets the Restore's PV rename policy.
+func (b *RestoreBuilder) PVRenamePolicy(policy velerov1api.PVRenamePolicy) *RestoreBuilder {
+	b.object.Spec.PVRenamePolicy = policy
+	return b
+}
+
+// ExistingResourcePolicy sets the Restore's existing resource policy.
+func (b *RestoreBuilder) ExistingResourcePolicy(policy velerov1api.PolicyType) *RestoreBuilder {
+	b.object.Spec.ExistingResourcePolicy = policy
+	return b
+}
+
+// ServiceAccountPolicy sets the Restore's service account policy.
+func (b *RestoreBuilder) ServiceAccountPolicy(policy velerov1api.ServiceAccountPolicyType) *RestoreBuilder {
+	b.object.Spec.ServiceAccountPolicy = policy
+	return b
+}
+
+// ItemLabels sets the labels added to every item the Restore restores.
+func (b *RestoreBuilder) ItemLabels(vals ...string) *RestoreBuilder {
+	b.object.Spec.ItemLabels = setMapEntries(b.object.Spec.ItemLabels, vals...)
+	return b
+}
+
+// ItemAnnotations sets the annotations added to every item the Restore restores.
+func (b *RestoreBuilder) ItemAnnotations(vals ...string) *RestoreBuilder {
+	b.object.Spec.ItemAnnotations = setMapEntries(b.object.Spec.ItemAnnotations, vals...)
+	return b
+}
+
+// RestoreStatus sets the resources whose status the Restore restores.
+func (b *RestoreBuilder) RestoreStatus(includedResources, excludedResources []string) *RestoreBuilder {
+	b.object.Spec.RestoreStatus = &velerov1api.RestoreStatusSpec{
+		IncludedResources: includedResources,
+		ExcludedResources: excludedResources,
+	}
+	return b
+}
+
+// ReadinessGate appends a readiness gate to the Restore's readiness gates.
+func (b *RestoreBuilder) ReadinessGate(resource, conditionType string, timeout time.Duration) *RestoreBuilder {
+	b.object.Spec.ReadinessGates = append(b.object.Spec.ReadinessGates, velerov1api.ReadinessGate{
+		Resource:      resource,
+		ConditionType: conditionType,
+		Timeout:       metav1.Duration{Duration: timeout},
+	})
+	return b
+}
+
+// ReadinessGateJSONPath appends a readiness gate whose items are ready when a result of
+// the JSONPath expression is value to the Restore's readiness gates.
+func (b *RestoreBuilder) ReadinessGateJSONPath(resource, jsonPath, value string, timeout time.Duration) *RestoreBuilder {
+	b.object.Spec.ReadinessGates = append(b.object.Spec.ReadinessGates, velerov1api.ReadinessGate{
+		Resource: resource,
+		JSONPath: jsonPath,
+		Value:    value,
+		Timeout:  metav1.Duration{Duration: timeout},
+	})
+	return b
+}
+
+// StripFinalizers sets the resources whose finalizers the Restore removes.
+func (b *RestoreBuilder) StripFinalizers(includedResources, excludedResources []string) *RestoreBuilder {
+	b.object.Spec.StripFinalizers = &velerov1api.StripMetadataSpec{
+		IncludedResources: includedResources,
+		ExcludedResources: excludedResources,
+	}
+	return b
+}
+
+// StripOwnerReferences sets the resources whose owner references the Restore removes.
+func (b *RestoreBuilder) StripOwnerReferences(includedResources, excludedResources []string) *RestoreBuilder {
+	b.object.Spec.StripOwnerReferences = &velerov1api.StripMetadataSpec{
+		IncludedResources: includedResources,
+		ExcludedResources: excludedResources,
+	}
+	return b
+}
+
+// ResourceMapping appends a mapping that restores the items of the source resource
+// in the backup as items of the target resource.
+func (b *RestoreBuilder) ResourceMapping(source, target string, pruneFields ...string) *RestoreBuilder {
+	b.object.Spec.ResourceMappings = append(b.object.Spec.ResourceMappings, velerov1api.ResourceMapping{
+		Source:      source,
+		Target:      target,
+		PruneFields: pruneFields,
+	})
+	return b
+}
+
+// SnapshotVolumeOverride sets the Restore's snapshot volume override for the given
+// storage class.
+func (b *RestoreBuilder) SnapshotVolumeOverride(storageClass string, override velerov1api.SnapshotVolumeOverride) *RestoreBuilder {
+	if b.object.Spec.SnapshotVolumeOverrides == nil {
+		b.object.Spec.SnapshotVolumeOverrides = make(map[string]velerov1api.SnapshotVolumeOverride)
+	}
+	b.object.Spec.SnapshotVolumeOverrides[storageClass] = override
+	return b
+}
+
+// PVCResize sets the factor that the Restore multiplies the requested storage of
+// persistent volume claims by, and the minimum storage they request by storage class.
+func (b *RestoreBuilder) PVCResize(factor string, minimumSizes map[string]string) *RestoreBuilder {
+	b.object.Spec.PVCResize = &velerov1api.PVCResizeSpec{
+		Factor:       factor,
+		MinimumSizes: minimumSizes,
+	}
+	return b
+}
+
+// ConflictPolicy appends a conflict policy for the given resources to the Restore.
+func (b *RestoreBuilder) ConflictPolicy(policy velerov1api.ConflictPolicyType, resources ...string) *RestoreBuilder {
+	b.object.Spec.ConflictPolicies = append(b.object.Spec.ConflictPolicies, velerov1api.ConflictPolicy{
+		Resources: resources,
+		Policy:    policy,
+	})
+	return b
+}
+
+// APIRateLimit sets the Restore's rate limit for requests to the Kubernetes API.
+func (b *RestoreBuilder) APIRateLimit(qps, burst int) *RestoreBuilder {
+	b.object.Spec.APIRateLimit = &velerov1api.APIRateLimitSpec{
+		QPS:   qps,
+		Burst: burst,
+	}
+	return b
+}
+
 // StartTimestamp sets the Restore's start timestamp.
 func (b *RestoreBuilder) StartTimestamp(val time.Time) *RestoreBuilder {
 	b.object.Status.StartTimestamp = &metav1.Time{Time: val}
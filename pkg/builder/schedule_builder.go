@@ -77,6 +77,66 @@ func (b *ScheduleBuilder) CronSchedule(expression string) *ScheduleBuilder {
 	return b
 }
 
+// Timezone sets the time zone that the Schedule's cron schedule is evaluated in.
+func (b *ScheduleBuilder) Timezone(name string) *ScheduleBuilder {
+	b.object.Spec.Timezone = name
+	return b
+}
+
+// Paused sets the Schedule's paused flag.
+func (b *ScheduleBuilder) Paused(val bool) *ScheduleBuilder {
+	b.object.Spec.Paused = val
+	return b
+}
+
+// ConcurrencyPolicy sets the Schedule's concurrency policy.
+func (b *ScheduleBuilder) ConcurrencyPolicy(policy velerov1api.ConcurrencyPolicy) *ScheduleBuilder {
+	b.object.Spec.ConcurrencyPolicy = policy
+	return b
+}
+
+// KeepLast sets the number of the Schedule's most recent completed Backups to keep.
+func (b *ScheduleBuilder) KeepLast(count int) *ScheduleBuilder {
+	b.object.Spec.KeepLast = count
+	return b
+}
+
+// Jitter sets the window that the Schedule's Backups are spread over.
+func (b *ScheduleBuilder) Jitter(val time.Duration) *ScheduleBuilder {
+	b.object.Spec.Jitter = metav1.Duration{Duration: val}
+	return b
+}
+
+// UseOwnerReferencesInBackup sets whether the Schedule's Backups have an owner reference to it.
+func (b *ScheduleBuilder) UseOwnerReferencesInBackup(val bool) *ScheduleBuilder {
+	b.object.Spec.UseOwnerReferencesInBackup = &val
+	return b
+}
+
+// BackupNameTemplate sets the template that the Schedule's Backup names are generated from.
+func (b *ScheduleBuilder) BackupNameTemplate(val string) *ScheduleBuilder {
+	b.object.Spec.BackupNameTemplate = val
+	return b
+}
+
+// BlackoutWindows sets the periods of time during which the Schedule doesn't trigger Backups.
+func (b *ScheduleBuilder) BlackoutWindows(windows ...velerov1api.BlackoutWindow) *ScheduleBuilder {
+	b.object.Spec.BlackoutWindows = windows
+	return b
+}
+
+// Policy sets the name of the BackupPolicy that the Schedule's Backups inherit settings from.
+func (b *ScheduleBuilder) Policy(name string) *ScheduleBuilder {
+	b.object.Spec.Policy = name
+	return b
+}
+
+// ConsecutiveFailures sets the Schedule's number of consecutive failed Backups.
+func (b *ScheduleBuilder) ConsecutiveFailures(val int) *ScheduleBuilder {
+	b.object.Status.ConsecutiveFailures = val
+	return b
+}
+
 // LastBackupTime sets the Schedule's last backup time.
 func (b *ScheduleBuilder) LastBackupTime(val string) *ScheduleBuilder {
 	t, _ := time.Parse("2006-01-02 15:04:05", val)
@@ -54,3 +54,15 @@ func (b *VolumeBuilder) CSISource(driver string) *VolumeBuilder {
 	}
 	return b
 }
+
+// EmptyDirSource sets the Volume's emptyDir source.
+func (b *VolumeBuilder) EmptyDirSource() *VolumeBuilder {
+	b.object.EmptyDir = new(corev1api.EmptyDirVolumeSource)
+	return b
+}
+
+// ProjectedSource sets the Volume's projected source.
+func (b *VolumeBuilder) ProjectedSource() *VolumeBuilder {
+	b.object.Projected = new(corev1api.ProjectedVolumeSource)
+	return b
+}
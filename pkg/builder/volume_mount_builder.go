@@ -39,3 +39,9 @@ func ForVolumeMount(name, mountPath string) *VolumeMountBuilder {
 func (b *VolumeMountBuilder) Result() *corev1api.VolumeMount {
 	return b.object
 }
+
+// SubPath sets the VolumeMount's SubPath.
+func (b *VolumeMountBuilder) SubPath(subPath string) *VolumeMountBuilder {
+	b.object.SubPath = subPath
+	return b
+}
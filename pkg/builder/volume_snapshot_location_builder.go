@@ -17,6 +17,7 @@ limitations under the License.
 package builder
 
 import (
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -62,3 +63,27 @@ func (b *VolumeSnapshotLocationBuilder) Provider(name string) *VolumeSnapshotLoc
 	b.object.Spec.Provider = name
 	return b
 }
+
+// Credential sets the VolumeSnapshotLocation's credential selector.
+func (b *VolumeSnapshotLocationBuilder) Credential(selector *corev1api.SecretKeySelector) *VolumeSnapshotLocationBuilder {
+	b.object.Spec.Credential = selector
+	return b
+}
+
+// Identity sets the VolumeSnapshotLocation's ambient identity.
+func (b *VolumeSnapshotLocationBuilder) Identity(identity *velerov1api.AmbientIdentity) *VolumeSnapshotLocationBuilder {
+	b.object.Spec.Identity = identity
+	return b
+}
+
+// EgressProxy sets the VolumeSnapshotLocation's egress proxy.
+func (b *VolumeSnapshotLocationBuilder) EgressProxy(proxy *velerov1api.EgressProxy) *VolumeSnapshotLocationBuilder {
+	b.object.Spec.EgressProxy = proxy
+	return b
+}
+
+// MaxConcurrentOperations sets the VolumeSnapshotLocation's maximum number of concurrent operations.
+func (b *VolumeSnapshotLocationBuilder) MaxConcurrentOperations(max int) *VolumeSnapshotLocationBuilder {
+	b.object.Spec.MaxConcurrentOperations = max
+	return b
+}
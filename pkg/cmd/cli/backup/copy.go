@@ -0,0 +1,149 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewCopyCommand(f client.Factory, use string) *cobra.Command {
+	o := NewCopyOptions()
+
+	c := &cobra.Command{
+		Use:   use + " NAME --to LOCATION[,LOCATION...]",
+		Short: "Copy a backup to other backup storage locations",
+		Long: `Copy a completed backup from its backup storage location to one or more other backup storage locations.
+The copies share the backup's volume snapshots and restic repositories, which are only deleted along with the original backup.
+The status of each copy is shown by 'velero backup describe NAME'. A copy that failed is retried when it is requested again.`,
+		Example: `	velero backup copy backup-1 --to secondary
+	velero backup copy backup-1 --to secondary,tertiary`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run(f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type CopyOptions struct {
+	Name      string
+	Locations []string
+}
+
+func NewCopyOptions() *CopyOptions {
+	return &CopyOptions{}
+}
+
+func (o *CopyOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringSliceVar(&o.Locations, "to", o.Locations, "Backup storage locations to copy the backup to.")
+}
+
+func (o *CopyOptions) Complete(args []string) error {
+	o.Name = args[0]
+	return nil
+}
+
+func (o *CopyOptions) Validate() error {
+	if len(o.Locations) == 0 {
+		return errors.New("--to is required")
+	}
+	return nil
+}
+
+func (o *CopyOptions) Run(f client.Factory) error {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	backup := &velerov1api.Backup{}
+	if err := kbClient.Get(context.Background(), kbclient.ObjectKey{
+		Namespace: f.Namespace(),
+		Name:      o.Name,
+	}, backup); err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, name := range o.Locations {
+		if name == backup.Spec.StorageLocation {
+			return errors.Errorf("backup %s is already stored in backup storage location %s", o.Name, name)
+		}
+
+		location := &velerov1api.BackupStorageLocation{}
+		if err := kbClient.Get(context.Background(), kbclient.ObjectKey{
+			Namespace: f.Namespace(),
+			Name:      name,
+		}, location); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	patch := kbclient.MergeFrom(backup.DeepCopyObject())
+	requestCopies(backup, o.Locations)
+
+	if err := kbClient.Patch(context.Background(), backup, patch); err != nil {
+		return errors.WithStack(err)
+	}
+
+	fmt.Printf("Copy of backup %q requested. Run `velero backup describe %s` for more details.\n", o.Name, o.Name)
+	return nil
+}
+
+// requestCopies adds the locations to the backup's replication locations, and
+// clears the status of any failed copies to them so they're retried.
+func requestCopies(backup *velerov1api.Backup, locations []string) {
+	requested := make(map[string]bool)
+	for _, location := range backup.Spec.ReplicationLocations {
+		requested[location] = true
+	}
+	for _, location := range locations {
+		if !requested[location] {
+			backup.Spec.ReplicationLocations = append(backup.Spec.ReplicationLocations, location)
+			requested[location] = true
+		}
+	}
+
+	retry := make(map[string]bool)
+	for _, location := range locations {
+		retry[location] = true
+	}
+
+	var replications []velerov1api.BackupReplication
+	for _, replication := range backup.Status.Replications {
+		if retry[replication.Location] && replication.Phase == velerov1api.BackupReplicationPhaseFailed {
+			continue
+		}
+		replications = append(replications, replication)
+	}
+	backup.Status.Replications = replications
+}
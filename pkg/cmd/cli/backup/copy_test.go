@@ -0,0 +1,79 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestRequestCopies(t *testing.T) {
+	tests := []struct {
+		name                 string
+		backup               *velerov1api.Backup
+		locations            []string
+		wantLocations        []string
+		wantReplicationsLocs []string
+	}{
+		{
+			name:          "new locations are added to the backup's replication locations",
+			backup:        builder.ForBackup("velero", "backup-1").ReplicationLocations("loc-1").Result(),
+			locations:     []string{"loc-2", "loc-3"},
+			wantLocations: []string{"loc-1", "loc-2", "loc-3"},
+		},
+		{
+			name:          "locations that are already requested aren't added again",
+			backup:        builder.ForBackup("velero", "backup-1").ReplicationLocations("loc-1").Result(),
+			locations:     []string{"loc-1", "loc-2", "loc-2"},
+			wantLocations: []string{"loc-1", "loc-2"},
+		},
+		{
+			name: "failed copies to the locations are cleared so they're retried",
+			backup: &velerov1api.Backup{
+				Spec: velerov1api.BackupSpec{ReplicationLocations: []string{"loc-1", "loc-2", "loc-3"}},
+				Status: velerov1api.BackupStatus{
+					Replications: []velerov1api.BackupReplication{
+						{Location: "loc-1", Phase: velerov1api.BackupReplicationPhaseFailed},
+						{Location: "loc-2", Phase: velerov1api.BackupReplicationPhaseCompleted},
+						{Location: "loc-3", Phase: velerov1api.BackupReplicationPhaseFailed},
+					},
+				},
+			},
+			locations:            []string{"loc-1", "loc-2"},
+			wantLocations:        []string{"loc-1", "loc-2", "loc-3"},
+			wantReplicationsLocs: []string{"loc-2", "loc-3"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			requestCopies(tc.backup, tc.locations)
+
+			assert.Equal(t, tc.wantLocations, tc.backup.Spec.ReplicationLocations)
+
+			var replicationLocs []string
+			for _, replication := range tc.backup.Status.Replications {
+				replicationLocs = append(replicationLocs, replication.Location)
+			}
+			assert.Equal(t, tc.wantReplicationsLocs, replicationLocs)
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -37,6 +38,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 	veleroclient "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
 	v1 "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/restic"
 )
 
 const DefaultBackupTTL time.Duration = 30 * 24 * time.Hour
@@ -62,6 +64,9 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 	# Create a backup excluding the velero and default namespaces.
 	velero backup create backup2 --exclude-namespaces velero,default
 
+	# Create a backup excluding all Istio and metrics API groups.
+	velero backup create backup5 --exclude-api-groups '*.istio.io,metrics.k8s.io'
+
 	# Create a backup based on a schedule named daily-backup.
 	velero backup create --from-schedule daily-backup
 
@@ -69,7 +74,10 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 	velero backup create backup3 --snapshot-volumes=false -o yaml
 
 	# Wait for a backup to complete before returning from the command.
-	velero backup create backup4 --wait`,
+	velero backup create backup4 --wait
+
+	# Create a backup that chooses how volumes are backed up using the policies in the "volume-policies" ConfigMap.
+	velero backup create backup6 --resource-policies-configmap volume-policies`,
 	}
 
 	o.BindFlags(c.Flags())
@@ -82,22 +90,34 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 }
 
 type CreateOptions struct {
-	Name                    string
-	TTL                     time.Duration
-	SnapshotVolumes         flag.OptionalBool
-	DefaultVolumesToRestic  flag.OptionalBool
-	IncludeNamespaces       flag.StringArray
-	ExcludeNamespaces       flag.StringArray
-	IncludeResources        flag.StringArray
-	ExcludeResources        flag.StringArray
-	Labels                  flag.Map
-	Selector                flag.LabelSelector
-	IncludeClusterResources flag.OptionalBool
-	Wait                    bool
-	StorageLocation         string
-	SnapshotLocations       []string
-	FromSchedule            string
-	OrderedResources        string
+	Name                     string
+	TTL                      time.Duration
+	SnapshotTTL              time.Duration
+	SnapshotVolumes          flag.OptionalBool
+	DefaultVolumesToFsBackup flag.OptionalBool
+	UnmountedVolumesToRestic flag.OptionalBool
+	SkipUnchangedVolumes     flag.OptionalBool
+	IncludeNamespaces        flag.StringArray
+	ExcludeNamespaces        flag.StringArray
+	IncludeResources         flag.StringArray
+	ExcludeResources         flag.StringArray
+	IncludeAPIGroups         flag.StringArray
+	ExcludeAPIGroups         flag.StringArray
+	Labels                   flag.Map
+	SnapshotTags             flag.Map
+	SnapshotVerification     *flag.Enum
+	Selector                 flag.LabelSelector
+	IncludeClusterResources  flag.OptionalBool
+	Wait                     bool
+	StorageLocation          string
+	SnapshotLocations        []string
+	ReplicationLocations     []string
+	VolumePathIncludes       []string
+	VolumePathExcludes       []string
+	FromSchedule             string
+	OrderedResources         string
+	ResourcePolicyConfigMap  string
+	EphemeralVolumePolicy    flag.Map
 
 	client veleroclient.Interface
 }
@@ -107,21 +127,43 @@ func NewCreateOptions() *CreateOptions {
 		TTL:                     DefaultBackupTTL,
 		IncludeNamespaces:       flag.NewStringArray("*"),
 		Labels:                  flag.NewMap(),
+		SnapshotTags:            flag.NewMap(),
+		EphemeralVolumePolicy:   flag.NewMap(),
 		SnapshotVolumes:         flag.NewOptionalBool(nil),
 		IncludeClusterResources: flag.NewOptionalBool(nil),
+		SnapshotVerification: flag.NewEnum(
+			"",
+			string(velerov1api.SnapshotVerificationNone),
+			string(velerov1api.SnapshotVerificationReady),
+			string(velerov1api.SnapshotVerificationTestRestore),
+		),
 	}
 }
 
 func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.DurationVar(&o.TTL, "ttl", o.TTL, "How long before the backup can be garbage collected.")
+	flags.DurationVar(&o.SnapshotTTL, "snapshot-ttl", o.SnapshotTTL, "How long before the backup's volume snapshots are deleted, if less than the backup's TTL. The rest of the backup is kept until its TTL elapses. If zero, the snapshots are kept as long as the backup.")
 	flags.Var(&o.IncludeNamespaces, "include-namespaces", "Namespaces to include in the backup (use '*' for all namespaces).")
 	flags.Var(&o.ExcludeNamespaces, "exclude-namespaces", "Namespaces to exclude from the backup.")
 	flags.Var(&o.IncludeResources, "include-resources", "Resources to include in the backup, formatted as resource.group, such as storageclasses.storage.k8s.io (use '*' for all resources).")
 	flags.Var(&o.ExcludeResources, "exclude-resources", "Resources to exclude from the backup, formatted as resource.group, such as storageclasses.storage.k8s.io.")
+	flags.Var(&o.IncludeAPIGroups, "include-api-groups", "API groups to include in the backup, such as apps or *.istio.io (use 'core' for the core API group).")
+	flags.Var(&o.ExcludeAPIGroups, "exclude-api-groups", "API groups to exclude from the backup, such as metrics.k8s.io or *.istio.io (use 'core' for the core API group).")
 	flags.Var(&o.Labels, "labels", "Labels to apply to the backup.")
+	flags.Var(&o.SnapshotTags, "snapshot-tags", "Tags to add to the backup's volume snapshots in their provider, along with the backup's labels. Optional.")
+	flags.Var(
+		o.SnapshotVerification,
+		"snapshot-verification",
+		fmt.Sprintf("How the backup's volume snapshots are verified before the backup is completed. Ready checks that each snapshot is ready to restore from; TestRestore also creates a test volume from it, then deletes the volume. Valid values are %s. Optional. Default: None.", strings.Join(o.SnapshotVerification.AllowedValues(), ",")),
+	)
 	flags.StringVar(&o.StorageLocation, "storage-location", "", "Location in which to store the backup.")
 	flags.StringSliceVar(&o.SnapshotLocations, "volume-snapshot-locations", o.SnapshotLocations, "List of locations (at most one per provider) where volume snapshots should be stored.")
+	flags.StringSliceVar(&o.ReplicationLocations, "replication-locations", o.ReplicationLocations, "List of backup storage locations to copy the backup to once it has completed.")
+	flags.StringSliceVar(&o.VolumePathIncludes, "volume-path-includes", o.VolumePathIncludes, "Glob patterns, relative to the root of each volume, of the paths within the pod volumes backed up with restic to back up. Optional.")
+	flags.StringSliceVar(&o.VolumePathExcludes, "volume-path-excludes", o.VolumePathExcludes, "Patterns of the paths within the pod volumes backed up with restic not to back up, like node_modules or .cache. Optional.")
 	flags.VarP(&o.Selector, "selector", "l", "Only back up resources matching this label selector.")
+	flags.StringVar(&o.ResourcePolicyConfigMap, "resource-policies-configmap", "", "Name of a ConfigMap in the Velero namespace containing volume policies that choose how the backup's volumes are backed up.")
+	flags.Var(&o.EphemeralVolumePolicy, "ephemeral-volume-policy", "Whether the backup backs up the data of pods' ephemeral volumes, by kind, as emptyDir, csi or projected mapped to Include, Exclude or IncludeIfAnnotated, like emptyDir=Exclude,csi=IncludeIfAnnotated. Optional. Default: ephemeral volumes are backed up like other pod volumes.")
 	flags.StringVar(&o.OrderedResources, "ordered-resources", "", "mapping Kinds to an ordered list of specific resources of that Kind.  Resource names are separated by commas and their names are in format 'namespace/resourcename'. For cluster scope resource, simply use resource name. Key-value pairs in the mapping are separated by semi-colon.  Example: 'pods=ns1/pod1,ns1/pod2;persistentvolumeclaims=ns1/pvc4,ns1/pvc8'.  Optional.")
 	f := flags.VarPF(&o.SnapshotVolumes, "snapshot-volumes", "", "Take snapshots of PersistentVolumes as part of the backup.")
 	// this allows the user to just specify "--snapshot-volumes" as shorthand for "--snapshot-volumes=true"
@@ -131,7 +173,17 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	f = flags.VarPF(&o.IncludeClusterResources, "include-cluster-resources", "", "Include cluster-scoped resources in the backup")
 	f.NoOptDefVal = "true"
 
-	f = flags.VarPF(&o.DefaultVolumesToRestic, "default-volumes-to-restic", "", "Use restic by default to backup all pod volumes")
+	f = flags.VarPF(&o.DefaultVolumesToFsBackup, "default-volumes-to-fs-backup", "", "Use file system backup by default to backup all pod volumes, except the ones that pods opt out with the backup.velero.io/backup-volumes-excludes annotation")
+	f.NoOptDefVal = "true"
+
+	f = flags.VarPF(&o.DefaultVolumesToFsBackup, "default-volumes-to-restic", "", "Use restic by default to backup all pod volumes")
+	f.NoOptDefVal = "true"
+	flags.MarkDeprecated("default-volumes-to-restic", "use --default-volumes-to-fs-backup instead")
+
+	f = flags.VarPF(&o.UnmountedVolumesToRestic, "unmounted-volumes-to-restic", "", "Use restic to backup persistent volume claims that no pod mounts, by mounting each of them in a short-lived pod")
+	f.NoOptDefVal = "true"
+
+	f = flags.VarPF(&o.SkipUnchangedVolumes, "skip-unchanged-volumes", "", "Reuse the restic snapshots of pod volumes that haven't changed since the previous backup of the same schedule, instead of backing them up again")
 	f.NoOptDefVal = "true"
 }
 
@@ -162,6 +214,18 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return fmt.Errorf("A backup name is required, unless you are creating based on a schedule.")
 	}
 
+	if o.SnapshotTTL < 0 {
+		return fmt.Errorf("--snapshot-ttl must not be negative")
+	}
+
+	if err := restic.ValidateVolumePathPatterns(append(append([]string{}, o.VolumePathIncludes...), o.VolumePathExcludes...)); err != nil {
+		return err
+	}
+
+	if _, err := ParseEphemeralVolumePolicy(o.EphemeralVolumePolicy.Data()); err != nil {
+		return err
+	}
+
 	if o.StorageLocation != "" {
 		location := &velerov1api.BackupStorageLocation{}
 		if err := client.Get(context.Background(), kbclient.ObjectKey{
@@ -178,6 +242,16 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		}
 	}
 
+	for _, loc := range o.ReplicationLocations {
+		location := &velerov1api.BackupStorageLocation{}
+		if err := client.Get(context.Background(), kbclient.ObjectKey{
+			Namespace: f.Namespace(),
+			Name:      loc,
+		}, location); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -269,7 +343,7 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 					return nil
 				}
 
-				if backup.Status.Phase != velerov1api.BackupPhaseNew && backup.Status.Phase != velerov1api.BackupPhaseInProgress {
+				if !backupUnfinished(backup.Status.Phase) {
 					fmt.Printf("\nBackup completed with status: %s. You may check for more information using the commands `velero backup describe %s` and `velero backup logs %s`.\n", backup.Status.Phase, backup.Name, backup.Name)
 					return nil
 				}
@@ -306,6 +380,44 @@ func parseOrderedResources(orderMapStr string) (map[string]string, error) {
 	return orderedResources, nil
 }
 
+// ParseEphemeralVolumePolicy returns the ephemeral volume policy of the --ephemeral-volume-policy
+// flag's values, which map kinds of ephemeral volumes to actions. It's nil if there are no values.
+func ParseEphemeralVolumePolicy(values map[string]string) (*velerov1api.EphemeralVolumePolicy, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	policy := new(velerov1api.EphemeralVolumePolicy)
+	for kind, value := range values {
+		var action velerov1api.EphemeralVolumeAction
+		for _, a := range []velerov1api.EphemeralVolumeAction{
+			velerov1api.EphemeralVolumeActionInclude,
+			velerov1api.EphemeralVolumeActionExclude,
+			velerov1api.EphemeralVolumeActionIncludeIfAnnotated,
+		} {
+			if strings.EqualFold(value, string(a)) {
+				action = a
+			}
+		}
+		if action == "" {
+			return nil, errors.Errorf("invalid ephemeral volume action %q for %s, must be one of Include, Exclude or IncludeIfAnnotated", value, kind)
+		}
+
+		switch strings.ToLower(kind) {
+		case "emptydir":
+			policy.EmptyDir = action
+		case "csi":
+			policy.CSI = action
+		case "projected":
+			policy.Projected = action
+		default:
+			return nil, errors.Errorf("invalid ephemeral volume kind %q, must be one of emptyDir, csi or projected", kind)
+		}
+	}
+
+	return policy, nil
+}
+
 func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, error) {
 	var backupBuilder *builder.BackupBuilder
 
@@ -325,10 +437,15 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 			ExcludedNamespaces(o.ExcludeNamespaces...).
 			IncludedResources(o.IncludeResources...).
 			ExcludedResources(o.ExcludeResources...).
+			IncludedAPIGroups(o.IncludeAPIGroups...).
+			ExcludedAPIGroups(o.ExcludeAPIGroups...).
 			LabelSelector(o.Selector.LabelSelector).
 			TTL(o.TTL).
 			StorageLocation(o.StorageLocation).
-			VolumeSnapshotLocations(o.SnapshotLocations...)
+			VolumeSnapshotLocations(o.SnapshotLocations...).
+			ReplicationLocations(o.ReplicationLocations...).
+			VolumePathIncludes(o.VolumePathIncludes...).
+			VolumePathExcludes(o.VolumePathExcludes...)
 		if len(o.OrderedResources) > 0 {
 			orders, err := parseOrderedResources(o.OrderedResources)
 			if err != nil {
@@ -337,17 +454,52 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 			backupBuilder.OrderedResources(orders)
 		}
 
+		if o.SnapshotTTL > 0 {
+			backupBuilder.SnapshotTTL(o.SnapshotTTL)
+		}
+		if len(o.SnapshotTags.Data()) > 0 {
+			backupBuilder.SnapshotTags(o.SnapshotTags.Data())
+		}
+		if o.SnapshotVerification.String() != "" {
+			backupBuilder.SnapshotVerification(velerov1api.SnapshotVerificationMode(o.SnapshotVerification.String()))
+		}
 		if o.SnapshotVolumes.Value != nil {
 			backupBuilder.SnapshotVolumes(*o.SnapshotVolumes.Value)
 		}
 		if o.IncludeClusterResources.Value != nil {
 			backupBuilder.IncludeClusterResources(*o.IncludeClusterResources.Value)
 		}
-		if o.DefaultVolumesToRestic.Value != nil {
-			backupBuilder.DefaultVolumesToRestic(*o.DefaultVolumesToRestic.Value)
+		if o.DefaultVolumesToFsBackup.Value != nil {
+			backupBuilder.DefaultVolumesToFsBackup(*o.DefaultVolumesToFsBackup.Value)
+		}
+		if o.UnmountedVolumesToRestic.Value != nil {
+			backupBuilder.UnmountedVolumesToRestic(*o.UnmountedVolumesToRestic.Value)
+		}
+		if o.SkipUnchangedVolumes.Value != nil {
+			backupBuilder.SkipUnchangedVolumes(*o.SkipUnchangedVolumes.Value)
+		}
+		if o.ResourcePolicyConfigMap != "" {
+			backupBuilder.ResourcePolicy(o.ResourcePolicyConfigMap)
+		}
+		ephemeralVolumePolicy, err := ParseEphemeralVolumePolicy(o.EphemeralVolumePolicy.Data())
+		if err != nil {
+			return nil, err
+		}
+		if ephemeralVolumePolicy != nil {
+			backupBuilder.EphemeralVolumePolicy(ephemeralVolumePolicy)
 		}
 	}
 
 	backup := backupBuilder.ObjectMeta(builder.WithLabelsMap(o.Labels.Data())).Result()
 	return backup, nil
 }
+
+// backupUnfinished returns whether a backup in the given phase is still running.
+func backupUnfinished(phase velerov1api.BackupPhase) bool {
+	switch phase {
+	case velerov1api.BackupPhaseNew, velerov1api.BackupPhaseInProgress, velerov1api.BackupPhaseWaitingForPluginOperations:
+		return true
+	default:
+		return false
+	}
+}
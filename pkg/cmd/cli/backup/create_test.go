@@ -119,3 +119,22 @@ func TestCreateOptions_OrderedResources(t *testing.T) {
 	assert.Equal(t, orderedResources, expectedMixedResources)
 
 }
+
+func TestParseEphemeralVolumePolicy(t *testing.T) {
+	policy, err := ParseEphemeralVolumePolicy(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, policy)
+
+	policy, err = ParseEphemeralVolumePolicy(map[string]string{"emptyDir": "Exclude", "CSI": "includeifannotated"})
+	assert.NoError(t, err)
+	assert.Equal(t, &velerov1api.EphemeralVolumePolicy{
+		EmptyDir: velerov1api.EphemeralVolumeActionExclude,
+		CSI:      velerov1api.EphemeralVolumeActionIncludeIfAnnotated,
+	}, policy)
+
+	_, err = ParseEphemeralVolumePolicy(map[string]string{"emptyDir": "Skip"})
+	assert.EqualError(t, err, `invalid ephemeral volume action "Skip" for emptyDir, must be one of Include, Exclude or IncludeIfAnnotated`)
+
+	_, err = ParseEphemeralVolumePolicy(map[string]string{"hostPath": "Exclude"})
+	assert.EqualError(t, err, `invalid ephemeral volume kind "hostPath", must be one of emptyDir, csi or projected`)
+}
@@ -27,6 +27,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -67,18 +69,56 @@ type CreateOptions struct {
 	BackupSyncPeriod, ValidationFrequency time.Duration
 	Config                                flag.Map
 	Labels                                flag.Map
+	Default                               bool
 	CACertFile                            string
+	CACertSecret                          flag.Map
+	CACertConfigMap                       flag.Map
+	InsecureSkipTLSVerify                 bool
 	AccessMode                            *flag.Enum
+	Credential                            flag.Map
+	AmbientIdentity                       bool
+	IdentityRole                          string
+	HTTPProxy                             string
+	HTTPSProxy                            string
+	NoProxy                               string
+	BypassProxy                           bool
+	ServerSideEncryption                  string
+	KMSKeyID                              string
+	ObjectLockMode                        *flag.Enum
+	ObjectLockRetentionPeriod             time.Duration
+	UploadBandwidthLimit                  string
+	DownloadBandwidthLimit                string
+	DownloadMode                          *flag.Enum
+	DownloadURLTTL                        time.Duration
+	UploaderType                          *flag.Enum
 }
 
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
-		Config: flag.NewMap(),
+		Config:          flag.NewMap(),
+		Credential:      flag.NewMap(),
+		CACertSecret:    flag.NewMap(),
+		CACertConfigMap: flag.NewMap(),
 		AccessMode: flag.NewEnum(
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
 			string(velerov1api.BackupStorageLocationAccessModeReadOnly),
 		),
+		ObjectLockMode: flag.NewEnum(
+			"",
+			string(velerov1api.ObjectLockModeGovernance),
+			string(velerov1api.ObjectLockModeCompliance),
+		),
+		DownloadMode: flag.NewEnum(
+			"",
+			string(velerov1api.DownloadModeSignedURL),
+			string(velerov1api.DownloadModeProxy),
+		),
+		UploaderType: flag.NewEnum(
+			"",
+			string(velerov1api.UploaderTypeRestic),
+			string(velerov1api.UploaderTypeKopia),
+		),
 	}
 }
 
@@ -90,7 +130,39 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.DurationVar(&o.ValidationFrequency, "validation-frequency", o.ValidationFrequency, "How often to verify if the backup storage location is valid. Optional. Set this to `0s` to disable sync. Default 1 minute.")
 	flags.Var(&o.Config, "config", "Configuration key-value pairs.")
 	flags.Var(&o.Labels, "labels", "Labels to apply to the backup storage location.")
+	flags.BoolVar(&o.Default, "default", o.Default, "Sets this new location to be the default backup storage location, which backups that don't specify a storage location are stored in. Only one location can be the default. Optional.")
+	flags.Var(&o.Credential, "credential", "The credential to be used by this location as a key-value pair, where the key is the name of a secret in the Velero server's namespace and the value is the key within the secret's data. Optional, one value only.")
+	flags.BoolVar(&o.AmbientIdentity, "ambient-identity", o.AmbientIdentity, "Authenticate with the cloud identity of the Velero server's pod, such as AWS IAM roles for service accounts, GCP Workload Identity or Azure pod identity, instead of a credential. Optional.")
+	flags.StringVar(&o.IdentityRole, "identity-role", o.IdentityRole, "Cloud identity that the Velero server's pod makes this location's requests as, such as the ARN of an AWS IAM role, the email of a GCP service account or the client ID of an Azure managed identity. Implies --ambient-identity. Optional.")
+	flags.StringVar(&o.HTTPProxy, "http-proxy", o.HTTPProxy, "URL of the proxy that the location's plugin makes HTTP requests through, instead of the Velero server's. Optional.")
+	flags.StringVar(&o.HTTPSProxy, "https-proxy", o.HTTPSProxy, "URL of the proxy that the location's plugin makes HTTPS requests through, instead of the Velero server's. Optional.")
+	flags.StringVar(&o.NoProxy, "no-proxy", o.NoProxy, "Comma-separated hosts, domains and CIDRs that the location's plugin reaches without a proxy, instead of the Velero server's. Optional.")
+	flags.BoolVar(&o.BypassProxy, "bypass-proxy", o.BypassProxy, "Make the location's requests without the Velero server's proxy. Optional.")
 	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "File containing a certificate bundle to use when verifying TLS connections to the object store. Optional.")
+	flags.Var(&o.CACertSecret, "cacert-secret", "The certificate bundle to use when verifying TLS connections to the object store, as a key-value pair, where the key is the name of a secret in the Velero server's namespace and the value is the key within the secret's data. Optional, one value only.")
+	flags.Var(&o.CACertConfigMap, "cacert-config-map", "The certificate bundle to use when verifying TLS connections to the object store, as a key-value pair, where the key is the name of a config map in the Velero server's namespace and the value is the key within the config map's data. Optional, one value only.")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "Don't verify the object store's TLS certificate. This should only be used for testing. Optional.")
+	flags.StringVar(&o.ServerSideEncryption, "server-side-encryption", o.ServerSideEncryption, "Server-side encryption algorithm that the object store should encrypt backups with, as named by the provider (e.g. AES256 or aws:kms for AWS). Optional.")
+	flags.StringVar(&o.KMSKeyID, "kms-key-id", o.KMSKeyID, "ID of the customer-managed key in the provider's key management service that backups should be encrypted with. Requires --server-side-encryption. Optional.")
+	flags.Var(
+		o.ObjectLockMode,
+		"object-lock-mode",
+		fmt.Sprintf("Retention mode that the object store should lock backups with, for buckets that have object locking enabled. Valid values are %s. Requires --object-lock-retention-period. Optional.", strings.Join(o.ObjectLockMode.AllowedValues(), ",")),
+	)
+	flags.DurationVar(&o.ObjectLockRetentionPeriod, "object-lock-retention-period", o.ObjectLockRetentionPeriod, "How long the object store should lock backups for after they're uploaded. Requires --object-lock-mode. Optional.")
+	flags.StringVar(&o.UploadBandwidthLimit, "upload-bandwidth-limit", o.UploadBandwidthLimit, "Maximum number of bytes per second that each object is uploaded to the object store at, as a quantity (e.g. 10Mi). Optional.")
+	flags.StringVar(&o.DownloadBandwidthLimit, "download-bandwidth-limit", o.DownloadBandwidthLimit, "Maximum number of bytes per second that each object is downloaded from the object store at, as a quantity (e.g. 10Mi). Optional.")
+	flags.Var(
+		o.DownloadMode,
+		"download-mode",
+		fmt.Sprintf("How backup and restore files, such as logs, are downloaded from the location. SignedURL downloads them directly from the object store; Proxy streams them through the Velero server, for object stores that can't create pre-signed URLs. Valid values are %s. Optional. Default: SignedURL.", strings.Join(o.DownloadMode.AllowedValues(), ",")),
+	)
+	flags.DurationVar(&o.DownloadURLTTL, "download-url-ttl", o.DownloadURLTTL, "How long download URLs and proxied downloads of the location's files are valid for. Optional. Default: 10 minutes.")
+	flags.Var(
+		o.UploaderType,
+		"uploader-type",
+		fmt.Sprintf("Uploader that backs up pod volumes to the location. Valid values are %s. Optional. Default: the Velero server's uploader type.", strings.Join(o.UploaderType.AllowedValues(), ",")),
+	)
 	flags.Var(
 		o.AccessMode,
 		"access-mode",
@@ -115,9 +187,81 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--backup-sync-period must be non-negative")
 	}
 
+	if len(o.Credential.Data()) > 1 {
+		return errors.New("--credential can only contain 1 key/value pair")
+	}
+
+	if (o.AmbientIdentity || o.IdentityRole != "") && len(o.Credential.Data()) > 0 {
+		return errors.New("--credential can't be used with --ambient-identity or --identity-role")
+	}
+
+	if o.BypassProxy && (o.HTTPProxy != "" || o.HTTPSProxy != "" || o.NoProxy != "") {
+		return errors.New("--bypass-proxy can't be used with --http-proxy, --https-proxy or --no-proxy")
+	}
+
+	if len(o.CACertSecret.Data()) > 1 {
+		return errors.New("--cacert-secret can only contain 1 key/value pair")
+	}
+
+	if len(o.CACertConfigMap.Data()) > 1 {
+		return errors.New("--cacert-config-map can only contain 1 key/value pair")
+	}
+
+	caCertSources := 0
+	for _, set := range []bool{o.CACertFile != "", len(o.CACertSecret.Data()) > 0, len(o.CACertConfigMap.Data()) > 0} {
+		if set {
+			caCertSources++
+		}
+	}
+	if caCertSources > 1 {
+		return errors.New("only one of --cacert, --cacert-secret and --cacert-config-map can be used")
+	}
+
+	if o.KMSKeyID != "" && o.ServerSideEncryption == "" {
+		return errors.New("--kms-key-id requires --server-side-encryption")
+	}
+
+	if (o.ObjectLockMode.String() == "") != (o.ObjectLockRetentionPeriod == 0) {
+		return errors.New("--object-lock-mode and --object-lock-retention-period must be used together")
+	}
+
+	if o.ObjectLockRetentionPeriod < 0 {
+		return errors.New("--object-lock-retention-period must be non-negative")
+	}
+
+	if _, err := parseBandwidthLimit("upload-bandwidth-limit", o.UploadBandwidthLimit); err != nil {
+		return err
+	}
+
+	if _, err := parseBandwidthLimit("download-bandwidth-limit", o.DownloadBandwidthLimit); err != nil {
+		return err
+	}
+
+	if o.DownloadURLTTL < 0 {
+		return errors.New("--download-url-ttl must be non-negative")
+	}
+
 	return nil
 }
 
+// parseBandwidthLimit parses a bandwidth limit flag's value, returning nil if
+// the flag wasn't set.
+func parseBandwidthLimit(flagName, value string) (*resource.Quantity, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	limit, err := resource.ParseQuantity(value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing --%s", flagName)
+	}
+	if limit.Sign() <= 0 {
+		return nil, errors.Errorf("--%s must be greater than zero", flagName)
+	}
+
+	return &limit, nil
+}
+
 func (o *CreateOptions) Complete(args []string, f client.Factory) error {
 	o.Name = args[0]
 	return nil
@@ -146,6 +290,79 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		validationFrequency = &metav1.Duration{Duration: o.ValidationFrequency}
 	}
 
+	var downloadURLTTL *metav1.Duration
+	if o.DownloadURLTTL > 0 {
+		downloadURLTTL = &metav1.Duration{Duration: o.DownloadURLTTL}
+	}
+
+	var credential *corev1api.SecretKeySelector
+	for name, key := range o.Credential.Data() {
+		credential = &corev1api.SecretKeySelector{
+			LocalObjectReference: corev1api.LocalObjectReference{Name: name},
+			Key:                  key,
+		}
+	}
+
+	var identity *velerov1api.AmbientIdentity
+	if o.AmbientIdentity || o.IdentityRole != "" {
+		identity = &velerov1api.AmbientIdentity{Role: o.IdentityRole}
+	}
+
+	var egressProxy *velerov1api.EgressProxy
+	if o.BypassProxy || o.HTTPProxy != "" || o.HTTPSProxy != "" || o.NoProxy != "" {
+		egressProxy = &velerov1api.EgressProxy{HTTPProxy: o.HTTPProxy, HTTPSProxy: o.HTTPSProxy, NoProxy: o.NoProxy}
+	}
+
+	var caCertRef *velerov1api.CACertReference
+	for name, key := range o.CACertSecret.Data() {
+		caCertRef = &velerov1api.CACertReference{
+			SecretKeyRef: &corev1api.SecretKeySelector{
+				LocalObjectReference: corev1api.LocalObjectReference{Name: name},
+				Key:                  key,
+			},
+		}
+	}
+	for name, key := range o.CACertConfigMap.Data() {
+		caCertRef = &velerov1api.CACertReference{
+			ConfigMapKeyRef: &corev1api.ConfigMapKeySelector{
+				LocalObjectReference: corev1api.LocalObjectReference{Name: name},
+				Key:                  key,
+			},
+		}
+	}
+
+	var serverSideEncryption *velerov1api.ServerSideEncryption
+	if o.ServerSideEncryption != "" {
+		serverSideEncryption = &velerov1api.ServerSideEncryption{
+			Algorithm: o.ServerSideEncryption,
+			KMSKeyID:  o.KMSKeyID,
+		}
+	}
+
+	var objectLock *velerov1api.ObjectLock
+	if o.ObjectLockMode.String() != "" {
+		objectLock = &velerov1api.ObjectLock{
+			Mode:            velerov1api.ObjectLockMode(o.ObjectLockMode.String()),
+			RetentionPeriod: metav1.Duration{Duration: o.ObjectLockRetentionPeriod},
+		}
+	}
+
+	var bandwidthLimit *velerov1api.BandwidthLimit
+	if o.UploadBandwidthLimit != "" || o.DownloadBandwidthLimit != "" {
+		upload, err := parseBandwidthLimit("upload-bandwidth-limit", o.UploadBandwidthLimit)
+		if err != nil {
+			return err
+		}
+		download, err := parseBandwidthLimit("download-bandwidth-limit", o.DownloadBandwidthLimit)
+		if err != nil {
+			return err
+		}
+		bandwidthLimit = &velerov1api.BandwidthLimit{
+			Upload:   upload,
+			Download: download,
+		}
+	}
+
 	backupStorageLocation := &velerov1api.BackupStorageLocation{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: f.Namespace(),
@@ -154,17 +371,29 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		},
 		Spec: velerov1api.BackupStorageLocationSpec{
 			Provider: o.Provider,
+			Default:  o.Default,
 			StorageType: velerov1api.StorageType{
 				ObjectStorage: &velerov1api.ObjectStorageLocation{
-					Bucket: o.Bucket,
-					Prefix: o.Prefix,
-					CACert: caCertData,
+					Bucket:                o.Bucket,
+					Prefix:                o.Prefix,
+					CACert:                caCertData,
+					CACertRef:             caCertRef,
+					InsecureSkipTLSVerify: o.InsecureSkipTLSVerify,
+					ServerSideEncryption:  serverSideEncryption,
+					ObjectLock:            objectLock,
+					BandwidthLimit:        bandwidthLimit,
+					DownloadMode:          velerov1api.DownloadMode(o.DownloadMode.String()),
+					DownloadURLTTL:        downloadURLTTL,
 				},
 			},
 			Config:              o.Config.Data(),
+			Credential:          credential,
+			Identity:            identity,
+			EgressProxy:         egressProxy,
 			AccessMode:          velerov1api.BackupStorageLocationAccessMode(o.AccessMode.String()),
 			BackupSyncPeriod:    backupSyncPeriod,
 			ValidationFrequency: validationFrequency,
+			UploaderType:        velerov1api.UploaderType(o.UploaderType.String()),
 		},
 	}
 
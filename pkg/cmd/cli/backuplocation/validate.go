@@ -0,0 +1,70 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backuplocation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewValidateCommand(f client.Factory, use string) *cobra.Command {
+	c := &cobra.Command{
+		Use:   use + " NAME [NAME...]",
+		Short: "Validate backup storage locations",
+		Long: `Request that the Velero server validates one or more backup storage locations now, regardless of their validation frequency.
+The result is shown in the locations' phase by 'velero backup-location get', and the reason that a location is unavailable
+is shown in its status message by 'velero backup-location get NAME -o yaml'.`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			kbClient, err := f.KubebuilderClient()
+			cmd.CheckError(err)
+
+			requested := time.Now().UTC().Format(time.RFC3339Nano)
+			for _, name := range args {
+				location := &velerov1api.BackupStorageLocation{}
+				err := kbClient.Get(context.Background(), kbclient.ObjectKey{
+					Namespace: f.Namespace(),
+					Name:      name,
+				}, location)
+				cmd.CheckError(errors.WithStack(err))
+
+				patch := kbclient.MergeFrom(location.DeepCopyObject())
+				if location.Annotations == nil {
+					location.Annotations = make(map[string]string)
+				}
+				location.Annotations[velerov1api.ValidationRequestedAnnotation] = requested
+
+				err = kbClient.Patch(context.Background(), location, patch)
+				cmd.CheckError(errors.WithStack(err))
+
+				fmt.Printf("Validation of backup storage location %q requested. Run `velero backup-location get %s` to see the result.\n", name, name)
+			}
+		},
+	}
+
+	return c
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -35,6 +36,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 	"github.com/vmware-tanzu/velero/pkg/install"
+	"github.com/vmware-tanzu/velero/pkg/restic"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -46,6 +48,7 @@ type InstallOptions struct {
 	Prefix                            string
 	ProviderName                      string
 	PodAnnotations                    flag.Map
+	PodLabels                         flag.Map
 	ServiceAccountAnnotations         flag.Map
 	VeleroPodCPURequest               string
 	VeleroPodMemRequest               string
@@ -58,10 +61,14 @@ type InstallOptions struct {
 	RestoreOnly                       bool
 	SecretFile                        string
 	NoSecret                          bool
+	AmbientIdentity                   bool
+	IdentityRole                      string
 	DryRun                            bool
 	BackupStorageConfig               flag.Map
 	VolumeSnapshotConfig              flag.Map
 	UseRestic                         bool
+	UseResticWindows                  bool
+	ResticWindowsImage                string
 	Wait                              bool
 	UseVolumeSnapshots                bool
 	DefaultResticMaintenanceFrequency time.Duration
@@ -70,7 +77,16 @@ type InstallOptions struct {
 	CRDsOnly                          bool
 	CACertFile                        string
 	Features                          string
-	DefaultVolumesToRestic            bool
+	DefaultVolumesToFsBackup          bool
+	PrivilegedRestic                  bool
+	UploaderType                      string
+	ResticCacheVolumeType             string
+	ResticCacheVolumeSource           string
+	ResticCacheSizeLimit              string
+	ResticUploadRateLimit             string
+	ResticDownloadRateLimit           string
+	RepositoryKeyProvider             string
+	RepositoryKeyProviderConfig       flag.Map
 }
 
 // BindFlags adds command line values to the options struct.
@@ -79,10 +95,13 @@ func (o *InstallOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.BucketName, "bucket", o.BucketName, "name of the object storage bucket where backups should be stored")
 	flags.StringVar(&o.SecretFile, "secret-file", o.SecretFile, "file containing credentials for backup and volume provider. If not specified, --no-secret must be used for confirmation. Optional.")
 	flags.BoolVar(&o.NoSecret, "no-secret", o.NoSecret, "flag indicating if a secret should be created. Must be used as confirmation if --secret-file is not provided. Optional.")
+	flags.BoolVar(&o.AmbientIdentity, "ambient-identity", o.AmbientIdentity, "configure the default backup storage location and volume snapshot location to authenticate with the cloud identity of the Velero pod, such as AWS IAM roles for service accounts, GCP Workload Identity or Azure pod identity. No secret is created. Optional.")
+	flags.StringVar(&o.IdentityRole, "identity-role", o.IdentityRole, "cloud identity that the Velero pod makes the default locations' requests as, such as the ARN of an AWS IAM role, the email of a GCP service account or the client ID of an Azure managed identity. Implies --ambient-identity. Optional.")
 	flags.BoolVar(&o.NoDefaultBackupLocation, "no-default-backup-location", o.NoDefaultBackupLocation, "flag indicating if a default backup location should be created. Must be used as confirmation if --bucket or --provider are not provided. Optional.")
 	flags.StringVar(&o.Image, "image", o.Image, "image to use for the Velero and restic server pods. Optional.")
 	flags.StringVar(&o.Prefix, "prefix", o.Prefix, "prefix under which all Velero data should be stored within the bucket. Optional.")
 	flags.Var(&o.PodAnnotations, "pod-annotations", "annotations to add to the Velero and restic pods. Optional. Format is key1=value1,key2=value2")
+	flags.Var(&o.PodLabels, "pod-labels", "labels to add to the Velero and restic pods. Add aadpodidbinding=[BINDING_SELECTOR] for Azure pod identity. Optional. Format is key1=value1,key2=value2")
 	flags.Var(&o.ServiceAccountAnnotations, "sa-annotations", "annotations to add to the Velero ServiceAccount. Add iam.gke.io/gcp-service-account=[GSA_NAME]@[PROJECT_NAME].iam.gserviceaccount.com for workload identity. Optional. Format is key1=value1,key2=value2")
 	flags.StringVar(&o.VeleroPodCPURequest, "velero-pod-cpu-request", o.VeleroPodCPURequest, `CPU request for Velero pod. A value of "0" is treated as unbounded. Optional.`)
 	flags.StringVar(&o.VeleroPodMemRequest, "velero-pod-mem-request", o.VeleroPodMemRequest, `memory request for Velero pod. A value of "0" is treated as unbounded. Optional.`)
@@ -98,37 +117,52 @@ func (o *InstallOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.BoolVar(&o.RestoreOnly, "restore-only", o.RestoreOnly, "run the server in restore-only mode. Optional.")
 	flags.BoolVar(&o.DryRun, "dry-run", o.DryRun, "generate resources, but don't send them to the cluster. Use with -o. Optional.")
 	flags.BoolVar(&o.UseRestic, "use-restic", o.UseRestic, "create restic daemonset. Optional.")
+	flags.BoolVar(&o.UseResticWindows, "use-restic-windows", o.UseResticWindows, "also create a restic daemonset for Windows nodes, so that the volumes of Windows pods can be backed up. Optional.")
+	flags.StringVar(&o.ResticWindowsImage, "restic-windows-image", o.ResticWindowsImage, "image to use for the restic pods on Windows nodes, if it's not the --image. Optional.")
 	flags.BoolVar(&o.Wait, "wait", o.Wait, "wait for Velero deployment to be ready. Optional.")
 	flags.DurationVar(&o.DefaultResticMaintenanceFrequency, "default-restic-prune-frequency", o.DefaultResticMaintenanceFrequency, "how often 'restic prune' is run for restic repositories by default. Optional.")
 	flags.Var(&o.Plugins, "plugins", "Plugin container images to install into the Velero Deployment")
 	flags.BoolVar(&o.CRDsOnly, "crds-only", o.CRDsOnly, "only generate CustomResourceDefinition resources. Useful for updating CRDs for an existing Velero install.")
 	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "file containing a certificate bundle to use when verifying TLS connections to the object store. Optional.")
 	flags.StringVar(&o.Features, "features", o.Features, "comma separated list of Velero feature flags to be set on the Velero deployment and the restic daemonset, if restic is enabled")
-	flags.BoolVar(&o.DefaultVolumesToRestic, "default-volumes-to-restic", o.DefaultVolumesToRestic, "bool flag to configure Velero server to use restic by default to backup all pod volumes on all backups. Optional.")
+	flags.BoolVar(&o.DefaultVolumesToFsBackup, "default-volumes-to-fs-backup", o.DefaultVolumesToFsBackup, "bool flag to configure Velero server to use file system backup by default to backup all pod volumes on all backups, unless a pod opts them out. Optional.")
+	flags.BoolVar(&o.DefaultVolumesToFsBackup, "default-volumes-to-restic", o.DefaultVolumesToFsBackup, "bool flag to configure Velero server to use restic by default to backup all pod volumes on all backups. Optional.")
+	flags.MarkDeprecated("default-volumes-to-restic", "use --default-volumes-to-fs-backup instead")
+	flags.BoolVar(&o.PrivilegedRestic, "privileged-restic", o.PrivilegedRestic, "run the restic daemonset in privileged mode, which is required to back up and restore raw block volumes (volumeMode: Block) with restic. Optional.")
+	flags.StringVar(&o.UploaderType, "uploader-type", o.UploaderType, "the uploader that the Velero server backs up pod volumes with by default, restic or kopia. Optional.")
+	flags.StringVar(&o.ResticCacheVolumeType, "restic-cache-volume-type", o.ResticCacheVolumeType, "the type of volume that the restic daemonset keeps repository caches in, emptyDir, hostPath or pvc. Optional.")
+	flags.StringVar(&o.ResticCacheVolumeSource, "restic-cache-volume-source", o.ResticCacheVolumeSource, "the host path of a hostPath restic cache volume, or the name of the claim of a pvc restic cache volume. Optional.")
+	flags.StringVar(&o.ResticCacheSizeLimit, "restic-cache-size-limit", o.ResticCacheSizeLimit, "the maximum size of the cache of each restic repository, such as 5Gi. Optional.")
+	flags.StringVar(&o.ResticUploadRateLimit, "restic-upload-rate-limit", o.ResticUploadRateLimit, "the maximum rate, in bytes per second, that each node of the restic daemonset uploads pod volume data at, such as 50Mi. Optional.")
+	flags.StringVar(&o.ResticDownloadRateLimit, "restic-download-rate-limit", o.ResticDownloadRateLimit, "the maximum rate, in bytes per second, that each node of the restic daemonset downloads pod volume data at, such as 50Mi. Optional.")
+	flags.StringVar(&o.RepositoryKeyProvider, "repository-key-provider", o.RepositoryKeyProvider, "the key provider that wraps the encryption keys of new restic repositories, one of secret, aws-kms or exec. Optional. Default: new repositories share one key.")
+	flags.Var(&o.RepositoryKeyProviderConfig, "repository-key-provider-config", "configuration for the repository key provider, such as keyId=<AWS KMS key ID> for aws-kms, or command=<path> for exec. Optional.")
 }
 
 // NewInstallOptions instantiates a new, default InstallOptions struct.
 func NewInstallOptions() *InstallOptions {
 	return &InstallOptions{
-		Namespace:                 velerov1api.DefaultNamespace,
-		Image:                     install.DefaultImage,
-		BackupStorageConfig:       flag.NewMap(),
-		VolumeSnapshotConfig:      flag.NewMap(),
-		PodAnnotations:            flag.NewMap(),
-		ServiceAccountAnnotations: flag.NewMap(),
-		VeleroPodCPURequest:       install.DefaultVeleroPodCPURequest,
-		VeleroPodMemRequest:       install.DefaultVeleroPodMemRequest,
-		VeleroPodCPULimit:         install.DefaultVeleroPodCPULimit,
-		VeleroPodMemLimit:         install.DefaultVeleroPodMemLimit,
-		ResticPodCPURequest:       install.DefaultResticPodCPURequest,
-		ResticPodMemRequest:       install.DefaultResticPodMemRequest,
-		ResticPodCPULimit:         install.DefaultResticPodCPULimit,
-		ResticPodMemLimit:         install.DefaultResticPodMemLimit,
+		Namespace:                   velerov1api.DefaultNamespace,
+		Image:                       install.DefaultImage,
+		BackupStorageConfig:         flag.NewMap(),
+		VolumeSnapshotConfig:        flag.NewMap(),
+		PodAnnotations:              flag.NewMap(),
+		PodLabels:                   flag.NewMap(),
+		ServiceAccountAnnotations:   flag.NewMap(),
+		RepositoryKeyProviderConfig: flag.NewMap(),
+		VeleroPodCPURequest:         install.DefaultVeleroPodCPURequest,
+		VeleroPodMemRequest:         install.DefaultVeleroPodMemRequest,
+		VeleroPodCPULimit:           install.DefaultVeleroPodCPULimit,
+		VeleroPodMemLimit:           install.DefaultVeleroPodMemLimit,
+		ResticPodCPURequest:         install.DefaultResticPodCPURequest,
+		ResticPodMemRequest:         install.DefaultResticPodMemRequest,
+		ResticPodCPULimit:           install.DefaultResticPodCPULimit,
+		ResticPodMemLimit:           install.DefaultResticPodMemLimit,
 		// Default to creating a VSL unless we're told otherwise
-		UseVolumeSnapshots:      true,
-		NoDefaultBackupLocation: false,
-		CRDsOnly:                false,
-		DefaultVolumesToRestic:  false,
+		UseVolumeSnapshots:       true,
+		NoDefaultBackupLocation:  false,
+		CRDsOnly:                 false,
+		DefaultVolumesToFsBackup: false,
 	}
 }
 
@@ -165,6 +199,11 @@ func (o *InstallOptions) AsVeleroOptions() (*install.VeleroOptions, error) {
 		return nil, err
 	}
 
+	var ambientIdentity *velerov1api.AmbientIdentity
+	if o.usesAmbientIdentity() {
+		ambientIdentity = &velerov1api.AmbientIdentity{Role: o.IdentityRole}
+	}
+
 	return &install.VeleroOptions{
 		Namespace:                         o.Namespace,
 		Image:                             o.Image,
@@ -172,12 +211,15 @@ func (o *InstallOptions) AsVeleroOptions() (*install.VeleroOptions, error) {
 		Bucket:                            o.BucketName,
 		Prefix:                            o.Prefix,
 		PodAnnotations:                    o.PodAnnotations.Data(),
+		PodLabels:                         o.PodLabels.Data(),
 		ServiceAccountAnnotations:         o.ServiceAccountAnnotations.Data(),
 		VeleroPodResources:                veleroPodResources,
 		ResticPodResources:                resticPodResources,
 		SecretData:                        secretData,
 		RestoreOnly:                       o.RestoreOnly,
 		UseRestic:                         o.UseRestic,
+		UseResticWindows:                  o.UseResticWindows,
+		ResticWindowsImage:                o.ResticWindowsImage,
 		UseVolumeSnapshots:                o.UseVolumeSnapshots,
 		BSLConfig:                         o.BackupStorageConfig.Data(),
 		VSLConfig:                         o.VolumeSnapshotConfig.Data(),
@@ -185,11 +227,27 @@ func (o *InstallOptions) AsVeleroOptions() (*install.VeleroOptions, error) {
 		Plugins:                           o.Plugins,
 		NoDefaultBackupLocation:           o.NoDefaultBackupLocation,
 		CACertData:                        caCertData,
+		AmbientIdentity:                   ambientIdentity,
 		Features:                          strings.Split(o.Features, ","),
-		DefaultVolumesToRestic:            o.DefaultVolumesToRestic,
+		DefaultVolumesToFsBackup:          o.DefaultVolumesToFsBackup,
+		PrivilegedRestic:                  o.PrivilegedRestic,
+		UploaderType:                      o.UploaderType,
+		ResticCacheVolumeType:             o.ResticCacheVolumeType,
+		ResticCacheVolumeSource:           o.ResticCacheVolumeSource,
+		ResticCacheSizeLimit:              o.ResticCacheSizeLimit,
+		ResticUploadRateLimit:             o.ResticUploadRateLimit,
+		ResticDownloadRateLimit:           o.ResticDownloadRateLimit,
+		RepositoryKeyProvider:             o.RepositoryKeyProvider,
+		RepositoryKeyProviderConfig:       o.RepositoryKeyProviderConfig.Data(),
 	}, nil
 }
 
+// usesAmbientIdentity returns whether the default locations should authenticate
+// with the Velero pod's cloud identity rather than with a secret.
+func (o *InstallOptions) usesAmbientIdentity() bool {
+	return o.AmbientIdentity || o.IdentityRole != ""
+}
+
 // NewCommand creates a cobra command.
 func NewCommand(f client.Factory) *cobra.Command {
 	o := NewInstallOptions()
@@ -378,13 +436,53 @@ func (o *InstallOptions) Validate(c *cobra.Command, args []string, f client.Fact
 		}
 	}
 
-	if o.DefaultVolumesToRestic && !o.UseRestic {
-		return errors.New("--use-restic is required when using --default-volumes-to-restic")
+	if o.DefaultVolumesToFsBackup && !o.UseRestic {
+		return errors.New("--use-restic is required when using --default-volumes-to-fs-backup")
+	}
+
+	if o.PrivilegedRestic && !o.UseRestic {
+		return errors.New("--use-restic is required when using --privileged-restic")
+	}
+
+	if o.UseResticWindows && !o.UseRestic {
+		return errors.New("--use-restic is required when using --use-restic-windows")
+	}
+
+	if o.ResticWindowsImage != "" && !o.UseResticWindows {
+		return errors.New("--use-restic-windows is required when using --restic-windows-image")
+	}
+
+	if o.UploaderType != "" {
+		if !o.UseRestic {
+			return errors.New("--use-restic is required when using --uploader-type")
+		}
+		if err := restic.ValidateUploaderType(o.UploaderType); err != nil {
+			return err
+		}
+	}
+
+	if err := o.validateResticCache(); err != nil {
+		return err
+	}
+
+	if err := o.validateResticRateLimits(); err != nil {
+		return err
+	}
+
+	if o.RepositoryKeyProvider != "" {
+		if !o.UseRestic {
+			return errors.New("--use-restic is required when using --repository-key-provider")
+		}
+		if _, err := restic.NewKeyProvider(o.RepositoryKeyProvider, o.RepositoryKeyProviderConfig.Data()); err != nil {
+			return err
+		}
 	}
 
 	switch {
-	case o.SecretFile == "" && !o.NoSecret:
-		return errors.New("One of --secret-file or --no-secret is required")
+	case o.SecretFile != "" && o.usesAmbientIdentity():
+		return errors.New("Cannot use --secret-file with --ambient-identity or --identity-role")
+	case o.SecretFile == "" && !o.NoSecret && !o.usesAmbientIdentity():
+		return errors.New("One of --secret-file, --no-secret or --ambient-identity is required")
 	case o.SecretFile != "" && o.NoSecret:
 		return errors.New("Cannot use both --secret-file and --no-secret")
 	}
@@ -395,3 +493,71 @@ func (o *InstallOptions) Validate(c *cobra.Command, args []string, f client.Fact
 
 	return nil
 }
+
+// validateResticCache validates the options of the volume and size limit of the restic
+// daemonset's repository caches.
+func (o *InstallOptions) validateResticCache() error {
+	if o.ResticCacheVolumeType == "" && o.ResticCacheVolumeSource == "" && o.ResticCacheSizeLimit == "" {
+		return nil
+	}
+
+	if !o.UseRestic {
+		return errors.New("--use-restic is required when using --restic-cache-volume-type, --restic-cache-volume-source or --restic-cache-size-limit")
+	}
+
+	switch o.ResticCacheVolumeType {
+	case "", install.ResticCacheVolumeTypeEmptyDir:
+		if o.ResticCacheVolumeSource != "" {
+			return errors.New("--restic-cache-volume-source can only be used with a --restic-cache-volume-type of hostPath or pvc")
+		}
+	case install.ResticCacheVolumeTypeHostPath, install.ResticCacheVolumeTypePVC:
+		if o.ResticCacheVolumeSource == "" {
+			return errors.Errorf("--restic-cache-volume-source is required when using a --restic-cache-volume-type of %s", o.ResticCacheVolumeType)
+		}
+	default:
+		return errors.Errorf("invalid --restic-cache-volume-type %q, must be one of %s, %s or %s", o.ResticCacheVolumeType,
+			install.ResticCacheVolumeTypeEmptyDir, install.ResticCacheVolumeTypeHostPath, install.ResticCacheVolumeTypePVC)
+	}
+
+	if o.ResticCacheSizeLimit != "" {
+		limit, err := resource.ParseQuantity(o.ResticCacheSizeLimit)
+		if err != nil {
+			return errors.Wrapf(err, "invalid --restic-cache-size-limit %q", o.ResticCacheSizeLimit)
+		}
+		if limit.Sign() < 0 {
+			return errors.Errorf("invalid --restic-cache-size-limit %q, must not be negative", o.ResticCacheSizeLimit)
+		}
+	}
+
+	return nil
+}
+
+// validateResticRateLimits validates the options of the restic daemonset's rate limits.
+func (o *InstallOptions) validateResticRateLimits() error {
+	if o.ResticUploadRateLimit == "" && o.ResticDownloadRateLimit == "" {
+		return nil
+	}
+
+	if !o.UseRestic {
+		return errors.New("--use-restic is required when using --restic-upload-rate-limit or --restic-download-rate-limit")
+	}
+
+	for flag, value := range map[string]string{
+		"--restic-upload-rate-limit":   o.ResticUploadRateLimit,
+		"--restic-download-rate-limit": o.ResticDownloadRateLimit,
+	} {
+		if value == "" {
+			continue
+		}
+
+		limit, err := resource.ParseQuantity(value)
+		if err != nil {
+			return errors.Wrapf(err, "invalid %s %q", flag, value)
+		}
+		if limit.Sign() < 0 {
+			return errors.Errorf("invalid %s %q, must not be negative", flag, value)
+		}
+	}
+
+	return nil
+}
@@ -45,6 +45,7 @@ func NewAddCommand(f client.Factory) *cobra.Command {
 	var (
 		imagePullPolicies   = []string{string(corev1api.PullAlways), string(corev1api.PullIfNotPresent), string(corev1api.PullNever)}
 		imagePullPolicyFlag = flag.NewEnum(string(corev1api.PullIfNotPresent), imagePullPolicies...)
+		artifactsConfigMap  string
 	)
 
 	c := &cobra.Command{
@@ -57,6 +58,11 @@ func NewAddCommand(f client.Factory) *cobra.Command {
 				cmd.CheckError(err)
 			}
 
+			if artifactsConfigMap != "" {
+				cmd.CheckError(addPluginArtifact(kubeClient.CoreV1().ConfigMaps(f.Namespace()), f.Namespace(), artifactsConfigMap, args[0]))
+				return
+			}
+
 			veleroDeploy, err := kubeClient.AppsV1().Deployments(f.Namespace()).Get(context.TODO(), veleroDeployment, metav1.GetOptions{})
 			if err != nil {
 				cmd.CheckError(err)
@@ -123,6 +129,7 @@ func NewAddCommand(f client.Factory) *cobra.Command {
 	}
 
 	c.Flags().Var(imagePullPolicyFlag, "image-pull-policy", fmt.Sprintf("The imagePullPolicy for the plugin container. Valid values are %s.", strings.Join(imagePullPolicies, ", ")))
+	c.Flags().StringVar(&artifactsConfigMap, "artifacts-config-map", artifactsConfigMap, "Add the plugin to this config map of the Velero server's --plugin-artifacts-config-map flag, which the server pulls plugins from without restarting, instead of adding an init container to the Velero deployment. The image must be pinned by digest unless the server verifies signatures.")
 
 	return c
 }
@@ -0,0 +1,80 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+// invalidConfigMapKeyChars are the characters that config map keys can't have.
+var invalidConfigMapKeyChars = regexp.MustCompile(`[^-._a-zA-Z0-9]+`)
+
+// addPluginArtifact adds image to the plugin artifacts config map named by the Velero server's
+// --plugin-artifacts-config-map flag, creating it if it doesn't exist. The server pulls the
+// image's plugins the next time it scans its plugin directory, without restarting.
+func addPluginArtifact(configMaps corev1client.ConfigMapInterface, namespace, name, image string) error {
+	key := invalidConfigMapKeyChars.ReplaceAllString(image, "-")
+
+	configMap, err := configMaps.Get(context.TODO(), name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = configMaps.Create(context.TODO(), builder.ForConfigMap(namespace, name).Data(key, image).Result(), metav1.CreateOptions{})
+		return errors.WithStack(err)
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if configMap.Data == nil {
+		configMap.Data = make(map[string]string)
+	}
+	configMap.Data[key] = image
+
+	_, err = configMaps.Update(context.TODO(), configMap, metav1.UpdateOptions{})
+	return errors.WithStack(err)
+}
+
+// removePluginArtifact removes the entries of the plugin artifacts config map whose key or
+// image is nameOrImage. The server removes their plugins the next time it scans its plugin
+// directory.
+func removePluginArtifact(configMaps corev1client.ConfigMapInterface, name, nameOrImage string) error {
+	configMap, err := configMaps.Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	var found bool
+	for key, image := range configMap.Data {
+		if key == nameOrImage || image == nameOrImage {
+			delete(configMap.Data, key)
+			found = true
+		}
+	}
+	if !found {
+		return errors.Errorf("plugin %s not found in config map %s", nameOrImage, name)
+	}
+
+	_, err = configMaps.Update(context.TODO(), configMap, metav1.UpdateOptions{})
+	return errors.WithStack(err)
+}
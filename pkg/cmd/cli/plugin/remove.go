@@ -31,6 +31,8 @@ import (
 )
 
 func NewRemoveCommand(f client.Factory) *cobra.Command {
+	var artifactsConfigMap string
+
 	c := &cobra.Command{
 		Use:   "remove [NAME | IMAGE]",
 		Short: "Remove a plugin",
@@ -41,6 +43,11 @@ func NewRemoveCommand(f client.Factory) *cobra.Command {
 				cmd.CheckError(err)
 			}
 
+			if artifactsConfigMap != "" {
+				cmd.CheckError(removePluginArtifact(kubeClient.CoreV1().ConfigMaps(f.Namespace()), artifactsConfigMap, args[0]))
+				return
+			}
+
 			veleroDeploy, err := kubeClient.AppsV1().Deployments(f.Namespace()).Get(context.TODO(), veleroDeployment, metav1.GetOptions{})
 			if err != nil {
 				cmd.CheckError(err)
@@ -78,5 +85,7 @@ func NewRemoveCommand(f client.Factory) *cobra.Command {
 		},
 	}
 
+	c.Flags().StringVar(&artifactsConfigMap, "artifacts-config-map", artifactsConfigMap, "Remove the plugin from this config map of the Velero server's --plugin-artifacts-config-map flag, instead of removing its init container from the Velero deployment.")
+
 	return c
 }
@@ -0,0 +1,147 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vmware-tanzu/velero/pkg/restic"
+)
+
+// nodeAgentConfigKey is the key of the node agent config map whose value is the
+// configuration, as JSON.
+const nodeAgentConfigKey = "config"
+
+// dataPathSettings are the settings of how a node runs its data paths. Settings
+// that aren't set are inherited.
+type dataPathSettings struct {
+	// Concurrency is how many pod volume backups and restores run at a time.
+	Concurrency int `json:"concurrency,omitempty"`
+
+	// CPUBudget is the quantity of CPU shared by the data paths, like "2" or "1500m".
+	CPUBudget string `json:"cpuBudget,omitempty"`
+
+	// MemoryBudget is the quantity of memory shared by the data paths, like "4Gi".
+	MemoryBudget string `json:"memoryBudget,omitempty"`
+
+	// MaxLoadAverage is the 1-minute load average above which no data paths are started.
+	MaxLoadAverage float64 `json:"maxLoadAverage,omitempty"`
+}
+
+// nodeDataPathSettings are the data path settings of the nodes whose labels match a selector.
+type nodeDataPathSettings struct {
+	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
+
+	dataPathSettings
+}
+
+// nodeAgentConfig is the configuration in the node agent config map. Its settings apply to
+// every node, unless they're overridden by the first of its nodes entries that selects a node.
+type nodeAgentConfig struct {
+	dataPathSettings
+
+	Nodes []nodeDataPathSettings `json:"nodes,omitempty"`
+}
+
+// getDataPathConfig returns the data path configuration of the node with the given labels,
+// from the node agent config map. If the config map is nil or doesn't set the node's
+// concurrency, defaultConcurrency is used.
+func getDataPathConfig(configMap *v1.ConfigMap, nodeLabels map[string]string, defaultConcurrency int) (restic.DataPathConfig, error) {
+	settings := dataPathSettings{Concurrency: defaultConcurrency}
+
+	if configMap != nil && configMap.Data[nodeAgentConfigKey] != "" {
+		var config nodeAgentConfig
+		if err := json.Unmarshal([]byte(configMap.Data[nodeAgentConfigKey]), &config); err != nil {
+			return restic.DataPathConfig{}, errors.Wrapf(err, "error parsing the %s of config map %s/%s", nodeAgentConfigKey, configMap.Namespace, configMap.Name)
+		}
+
+		settings = mergeDataPathSettings(settings, config.dataPathSettings)
+		for i := range config.Nodes {
+			selector, err := metav1.LabelSelectorAsSelector(&config.Nodes[i].NodeSelector)
+			if err != nil {
+				return restic.DataPathConfig{}, errors.Wrapf(err, "invalid node selector of nodes entry %d", i)
+			}
+			if selector.Matches(labels.Set(nodeLabels)) {
+				settings = mergeDataPathSettings(settings, config.Nodes[i].dataPathSettings)
+				break
+			}
+		}
+	}
+
+	return parseDataPathSettings(settings)
+}
+
+// mergeDataPathSettings returns base with the settings that are set in override replaced.
+func mergeDataPathSettings(base, override dataPathSettings) dataPathSettings {
+	if override.Concurrency != 0 {
+		base.Concurrency = override.Concurrency
+	}
+	if override.CPUBudget != "" {
+		base.CPUBudget = override.CPUBudget
+	}
+	if override.MemoryBudget != "" {
+		base.MemoryBudget = override.MemoryBudget
+	}
+	if override.MaxLoadAverage != 0 {
+		base.MaxLoadAverage = override.MaxLoadAverage
+	}
+	return base
+}
+
+func parseDataPathSettings(settings dataPathSettings) (restic.DataPathConfig, error) {
+	config := restic.DataPathConfig{
+		Concurrency:    settings.Concurrency,
+		MaxLoadAverage: settings.MaxLoadAverage,
+	}
+
+	if config.Concurrency < 1 || config.Concurrency > restic.MaxDataPathConcurrency {
+		return config, errors.Errorf("invalid data path concurrency %d, must be between 1 and %d", config.Concurrency, restic.MaxDataPathConcurrency)
+	}
+	if config.MaxLoadAverage < 0 {
+		return config, errors.Errorf("invalid maximum load average %v, must not be negative", config.MaxLoadAverage)
+	}
+
+	if settings.CPUBudget != "" {
+		budget, err := resource.ParseQuantity(settings.CPUBudget)
+		if err != nil {
+			return config, errors.Wrapf(err, "invalid CPU budget %q", settings.CPUBudget)
+		}
+		if budget.Sign() < 0 {
+			return config, errors.Errorf("invalid CPU budget %q, must not be negative", settings.CPUBudget)
+		}
+		config.CPUBudget = budget.MilliValue()
+	}
+
+	if settings.MemoryBudget != "" {
+		budget, err := resource.ParseQuantity(settings.MemoryBudget)
+		if err != nil {
+			return config, errors.Wrapf(err, "invalid memory budget %q", settings.MemoryBudget)
+		}
+		if budget.Sign() < 0 {
+			return config, errors.Errorf("invalid memory budget %q, must not be negative", settings.MemoryBudget)
+		}
+		config.MemoryBudget = budget.Value()
+	}
+
+	return config, nil
+}
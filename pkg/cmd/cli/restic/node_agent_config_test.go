@@ -0,0 +1,137 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/restic"
+)
+
+func TestGetDataPathConfig(t *testing.T) {
+	configMap := func(config string) *v1.ConfigMap {
+		return &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "node-agent-config"},
+			Data:       map[string]string{nodeAgentConfigKey: config},
+		}
+	}
+
+	const config = `{
+		"concurrency": 2,
+		"cpuBudget": "2",
+		"memoryBudget": "4Gi",
+		"nodes": [
+			{"nodeSelector": {"matchLabels": {"size": "large"}}, "concurrency": 8, "cpuBudget": "8", "memoryBudget": "32Gi"},
+			{"nodeSelector": {"matchExpressions": [{"key": "size", "operator": "In", "values": ["small", "large"]}]}, "concurrency": 1, "maxLoadAverage": 2}
+		]
+	}`
+
+	tests := []struct {
+		name       string
+		configMap  *v1.ConfigMap
+		nodeLabels map[string]string
+		want       restic.DataPathConfig
+		wantErr    string
+	}{
+		{
+			name: "no config map uses the default concurrency",
+			want: restic.DataPathConfig{Concurrency: 3},
+		},
+		{
+			name:      "config map without a config uses the default concurrency",
+			configMap: &v1.ConfigMap{},
+			want:      restic.DataPathConfig{Concurrency: 3},
+		},
+		{
+			name:       "nodes that no entry selects use the config's settings",
+			configMap:  configMap(config),
+			nodeLabels: map[string]string{"size": "medium"},
+			want:       restic.DataPathConfig{Concurrency: 2, CPUBudget: 2000, MemoryBudget: 4 << 30},
+		},
+		{
+			name:       "the first entry that selects a node overrides the settings it sets",
+			configMap:  configMap(config),
+			nodeLabels: map[string]string{"size": "large"},
+			want:       restic.DataPathConfig{Concurrency: 8, CPUBudget: 8000, MemoryBudget: 32 << 30},
+		},
+		{
+			name:       "entries can select nodes by expressions",
+			configMap:  configMap(config),
+			nodeLabels: map[string]string{"size": "small"},
+			want:       restic.DataPathConfig{Concurrency: 1, CPUBudget: 2000, MemoryBudget: 4 << 30, MaxLoadAverage: 2},
+		},
+		{
+			name:      "settings that aren't set use the default concurrency",
+			configMap: configMap(`{"cpuBudget": "500m"}`),
+			want:      restic.DataPathConfig{Concurrency: 3, CPUBudget: 500},
+		},
+		{
+			name:      "invalid JSON is an error",
+			configMap: configMap(`concurrency: 2`),
+			wantErr:   "error parsing the config of config map velero/node-agent-config",
+		},
+		{
+			name:      "concurrency above the maximum is an error",
+			configMap: configMap(`{"concurrency": 100}`),
+			wantErr:   "invalid data path concurrency 100, must be between 1 and 32",
+		},
+		{
+			name:      "invalid CPU budget is an error",
+			configMap: configMap(`{"cpuBudget": "lots"}`),
+			wantErr:   `invalid CPU budget "lots"`,
+		},
+		{
+			name:      "negative CPU budget is an error",
+			configMap: configMap(`{"cpuBudget": "-1"}`),
+			wantErr:   `invalid CPU budget "-1", must not be negative`,
+		},
+		{
+			name:      "invalid memory budget is an error",
+			configMap: configMap(`{"memoryBudget": "lots"}`),
+			wantErr:   `invalid memory budget "lots"`,
+		},
+		{
+			name:      "negative memory budget is an error",
+			configMap: configMap(`{"memoryBudget": "-1Gi"}`),
+			wantErr:   `invalid memory budget "-1Gi", must not be negative`,
+		},
+		{
+			name:      "invalid node selector is an error",
+			configMap: configMap(`{"nodes": [{"nodeSelector": {"matchExpressions": [{"key": "size", "operator": "Near"}]}}]}`),
+			wantErr:   "invalid node selector of nodes entry 0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config, err := getDataPathConfig(test.configMap, test.nodeLabels, 3)
+			if test.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.want, config)
+		})
+	}
+}
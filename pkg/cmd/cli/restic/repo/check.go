@@ -0,0 +1,132 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewCheckCommand(f client.Factory, use string) *cobra.Command {
+	o := NewCheckOptions()
+
+	c := &cobra.Command{
+		Use:   use + " [NAME...] | --all",
+		Short: "Check backup repositories for errors",
+		Long: `Request the data of backup repositories to be checked for errors now, instead of at their next scheduled check.
+The result of the check is shown by 'velero repo get NAME -o yaml'. A check that finds errors is also reported by a warning event on the repository and by the velero_backup_repository_check_failed metric.`,
+		Example: `	# check a single repository
+	velero repo check default-primary-x7k2p
+
+	# check all repositories
+	velero repo check --all`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run(f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type CheckOptions struct {
+	Names []string
+	All   bool
+}
+
+func NewCheckOptions() *CheckOptions {
+	return &CheckOptions{}
+}
+
+func (o *CheckOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.All, "all", o.All, "Check all backup repositories.")
+}
+
+func (o *CheckOptions) Complete(args []string) error {
+	o.Names = args
+	return nil
+}
+
+func (o *CheckOptions) Validate() error {
+	if o.All && len(o.Names) > 0 {
+		return errors.New("repository names can't be given with --all")
+	}
+	if !o.All && len(o.Names) == 0 {
+		return errors.New("at least one repository name or --all is required")
+	}
+	return nil
+}
+
+func (o *CheckOptions) Run(f client.Factory) error {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	var repos []velerov1api.BackupRepository
+	if o.All {
+		list := &velerov1api.BackupRepositoryList{}
+		if err := kbClient.List(context.Background(), list, kbclient.InNamespace(f.Namespace())); err != nil {
+			return errors.WithStack(err)
+		}
+		repos = list.Items
+	} else {
+		for _, name := range o.Names {
+			repo := velerov1api.BackupRepository{}
+			if err := kbClient.Get(context.Background(), kbclient.ObjectKey{
+				Namespace: f.Namespace(),
+				Name:      name,
+			}, &repo); err != nil {
+				return errors.WithStack(err)
+			}
+			repos = append(repos, repo)
+		}
+	}
+
+	requestName := fmt.Sprintf("check-%s", time.Now().UTC().Format("20060102150405"))
+	for i := range repos {
+		repo := &repos[i]
+
+		patch := kbclient.MergeFrom(repo.DeepCopyObject())
+		repo.Spec.MaintenanceRequest = &velerov1api.BackupRepositoryMaintenanceRequest{
+			Name:       requestName,
+			Operations: []velerov1api.BackupRepositoryMaintenanceOperation{velerov1api.BackupRepositoryMaintenanceOperationCheck},
+		}
+
+		if err := kbClient.Patch(context.Background(), repo, patch); err != nil {
+			return errors.WithStack(err)
+		}
+
+		fmt.Printf("Check of backup repository %q requested. Run `velero repo get %s -o yaml` for more details.\n", repo.Name, repo.Name)
+	}
+
+	return nil
+}
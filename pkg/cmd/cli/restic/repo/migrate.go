@@ -0,0 +1,121 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewMigrateCommand(f client.Factory, use string) *cobra.Command {
+	o := NewMigrateOptions()
+
+	c := &cobra.Command{
+		Use:   use + " NAME --to LOCATION",
+		Short: "Migrate a backup repository to another backup storage location",
+		Long: `Copy a backup repository to another backup storage location, and store it there from then on.
+The pod volume backups of backups in the repository's former location, including those taken after the migration, are found in the migrated repository.
+The result of the migration is shown by 'velero repo get NAME -o yaml'. A migration that was interrupted, or failed while copying the repository, is resumed when it is requested again.`,
+		Example: `	velero repo migrate default-primary-x7k2p --to secondary`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run(f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type MigrateOptions struct {
+	Name     string
+	Location string
+}
+
+func NewMigrateOptions() *MigrateOptions {
+	return &MigrateOptions{}
+}
+
+func (o *MigrateOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.Location, "to", o.Location, "Backup storage location to migrate the repository to.")
+}
+
+func (o *MigrateOptions) Complete(args []string) error {
+	o.Name = args[0]
+	return nil
+}
+
+func (o *MigrateOptions) Validate() error {
+	if o.Location == "" {
+		return errors.New("--to is required")
+	}
+	return nil
+}
+
+func (o *MigrateOptions) Run(f client.Factory) error {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	repo := &velerov1api.BackupRepository{}
+	if err := kbClient.Get(context.Background(), kbclient.ObjectKey{
+		Namespace: f.Namespace(),
+		Name:      o.Name,
+	}, repo); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if repo.Spec.BackupStorageLocation == o.Location {
+		return errors.Errorf("backup repository %s is already stored in backup storage location %s", o.Name, o.Location)
+	}
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := kbClient.Get(context.Background(), kbclient.ObjectKey{
+		Namespace: f.Namespace(),
+		Name:      o.Location,
+	}, location); err != nil {
+		return errors.WithStack(err)
+	}
+
+	patch := kbclient.MergeFrom(repo.DeepCopyObject())
+	repo.Spec.MigrationRequest = &velerov1api.BackupRepositoryMigrationRequest{
+		Name:                  fmt.Sprintf("migrate-%s", time.Now().UTC().Format("20060102150405")),
+		BackupStorageLocation: o.Location,
+	}
+
+	if err := kbClient.Patch(context.Background(), repo, patch); err != nil {
+		return errors.WithStack(err)
+	}
+
+	fmt.Printf("Migration of backup repository %q requested. Run `velero repo get %s -o yaml` for more details.\n", o.Name, o.Name)
+	return nil
+}
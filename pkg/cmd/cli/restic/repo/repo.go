@@ -25,12 +25,14 @@ import (
 func NewRepositoryCommand(f client.Factory) *cobra.Command {
 	c := &cobra.Command{
 		Use:   "repo",
-		Short: "Work with restic repositories",
-		Long:  "Work with restic repositories",
+		Short: "Work with backup repositories",
+		Long:  "Work with backup repositories",
 	}
 
 	c.AddCommand(
 		NewGetCommand(f, "get"),
+		NewMigrateCommand(f, "migrate"),
+		NewCheckCommand(f, "check"),
 	)
 
 	return c
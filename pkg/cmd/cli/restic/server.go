@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -32,12 +34,15 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	kubeinformers "k8s.io/client-go/informers"
 	corev1informers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
 
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -45,6 +50,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/buildinfo"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/signals"
 	"github.com/vmware-tanzu/velero/pkg/controller"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
@@ -64,11 +70,18 @@ var (
 const (
 	// the port where prometheus metrics are exposed
 	defaultMetricsAddress = ":8085"
+
+	// the number of pod volume backups that are processed at a time on a node
+	// whose concurrency isn't configured
+	defaultPodVolumeBackupConcurrency = 1
 )
 
 func NewServerCommand(f client.Factory) *cobra.Command {
 	logLevelFlag := logging.LogLevelFlag(logrus.InfoLevel)
 	formatFlag := logging.NewFormatFlag()
+	podVolumeBackupConcurrency := defaultPodVolumeBackupConcurrency
+	var concurrencyConfigMap, nodeAgentConfigMap, cacheDir, cacheSizeLimit, repositoryKeyProvider, uploadRateLimit, downloadRateLimit string
+	keyProviderConfig := flag.NewMap()
 
 	command := &cobra.Command{
 		Use:    "server",
@@ -82,16 +95,44 @@ func NewServerCommand(f client.Factory) *cobra.Command {
 			logger := logging.DefaultLogger(logLevel, formatFlag.Parse())
 			logger.Infof("Starting Velero restic server %s (%s)", buildinfo.Version, buildinfo.FormattedGitSHA())
 
+			cacheConfig, err := parseCacheConfig(cacheDir, cacheSizeLimit)
+			cmd.CheckError(err)
+			cacheConfig.Owner = os.Getenv("NODE_NAME")
+			restic.SetCacheConfig(cacheConfig)
+
+			keyProvider, err := restic.NewKeyProvider(repositoryKeyProvider, keyProviderConfig.Data())
+			cmd.CheckError(err)
+			restic.SetKeyProvider(keyProvider)
+
 			f.SetBasename(fmt.Sprintf("%s-%s", c.Parent().Name(), c.Name()))
 			s, err := newResticServer(logger, f, defaultMetricsAddress)
 			cmd.CheckError(err)
 
+			s.podVolumeBackupConcurrency, err = getPodVolumeBackupConcurrency(s.kubeClient.CoreV1(), f.Namespace(), concurrencyConfigMap, os.Getenv("NODE_NAME"), podVolumeBackupConcurrency)
+			cmd.CheckError(err)
+
+			bandwidthConfig, err := parseBandwidthConfig(uploadRateLimit, downloadRateLimit, s.podVolumeBackupConcurrency)
+			cmd.CheckError(err)
+			restic.SetBandwidthConfig(bandwidthConfig)
+
+			s.nodeAgentConfigMap = nodeAgentConfigMap
+			cmd.CheckError(s.initDataPathConfig())
+
 			s.run()
 		},
 	}
 
 	command.Flags().Var(logLevelFlag, "log-level", fmt.Sprintf("The level at which to log. Valid values are %s.", strings.Join(logLevelFlag.AllowedValues(), ", ")))
 	command.Flags().Var(formatFlag, "log-format", fmt.Sprintf("The format for log output. Valid values are %s.", strings.Join(formatFlag.AllowedValues(), ", ")))
+	command.Flags().IntVar(&podVolumeBackupConcurrency, "pod-volume-backup-concurrency", podVolumeBackupConcurrency, "How many pod volume backups are processed at a time on each node whose concurrency isn't set in the --concurrency-config-map.")
+	command.Flags().StringVar(&cacheDir, "cache-dir", cacheDir, "Directory that the local caches of repositories are kept in. Optional. Default: a directory in the restic pod's scratch volume.")
+	command.Flags().StringVar(&cacheSizeLimit, "cache-size-limit", cacheSizeLimit, "Maximum size of the local cache of each repository, as a quantity (e.g. 5Gi), unless a repository sets its own. A cache that grows larger is removed and built again. Optional. Default: unlimited.")
+	command.Flags().StringVar(&repositoryKeyProvider, "repository-key-provider", repositoryKeyProvider, "The key provider that wraps the encryption keys of restic repositories that have their own key. Must be the same as the Velero server's.")
+	command.Flags().Var(&keyProviderConfig, "repository-key-provider-config", "Configuration of the --repository-key-provider, such as keyId=<AWS KMS key ID> for aws-kms, or command=<path> for exec.")
+	command.Flags().StringVar(&uploadRateLimit, "upload-rate-limit", uploadRateLimit, "Maximum rate, in bytes per second as a quantity (e.g. 50Mi), that the node uploads the data of repositories at, shared by the pod volume backups processed at a time. Optional. Default: unlimited.")
+	command.Flags().StringVar(&downloadRateLimit, "download-rate-limit", downloadRateLimit, "Maximum rate, in bytes per second as a quantity (e.g. 50Mi), that the node downloads the data of repositories at, shared by the pod volume backups processed at a time. Optional. Default: unlimited.")
+	command.Flags().StringVar(&concurrencyConfigMap, "concurrency-config-map", concurrencyConfigMap, "Name of a config map in the Velero namespace that sets how many pod volume backups are processed at a time on nodes, keyed by node name. Optional.")
+	command.Flags().StringVar(&nodeAgentConfigMap, "node-agent-config-map", nodeAgentConfigMap, "Name of a config map in the Velero namespace that sets the concurrency, CPU and memory budgets and maximum load average of the pod volume backups and restores of nodes. Changes to it apply without restarting. Optional.")
 
 	return command
 }
@@ -110,6 +151,11 @@ type resticServer struct {
 	mgr                   manager.Manager
 	metrics               *metrics.ServerMetrics
 	metricsAddress        string
+	namespace             string
+	nodeName              string
+
+	podVolumeBackupConcurrency int
+	nodeAgentConfigMap         string
 }
 
 func newResticServer(logger logrus.FieldLogger, factory client.Factory, metricAddress string) (*resticServer, error) {
@@ -181,6 +227,8 @@ func newResticServer(logger logrus.FieldLogger, factory client.Factory, metricAd
 		fileSystem:            filesystem.NewFileSystem(),
 		mgr:                   mgr,
 		metricsAddress:        metricAddress,
+		namespace:             factory.Namespace(),
+		nodeName:              os.Getenv("NODE_NAME"),
 	}
 
 	if err := s.validatePodVolumesHostPath(); err != nil {
@@ -236,6 +284,9 @@ func (s *resticServer) run() {
 	go s.kubeInformerFactory.Start(s.ctx.Done())
 	go s.podInformer.Run(s.ctx.Done())
 	go s.secretInformer.Run(s.ctx.Done())
+	if s.nodeAgentConfigMap != "" {
+		go s.nodeAgentConfigMapInformer().Run(s.ctx.Done())
+	}
 
 	// TODO(2.0): presuming all controllers and resources are converted to runtime-controller
 	// by v2.0, the block from this line and including the `s.mgr.Start() will be
@@ -244,8 +295,10 @@ func (s *resticServer) run() {
 
 	// Adding the controllers to the manager will register them as a (runtime-controller) runnable,
 	// so the manager will ensure the cache is started and ready before all controller are started
-	s.mgr.Add(managercontroller.Runnable(backupController, 1))
-	s.mgr.Add(managercontroller.Runnable(restoreController, 1))
+	// the controllers have enough workers for the most data paths that can run at a time,
+	// which restic.TryAcquireDataPath limits to the node's concurrency.
+	s.mgr.Add(managercontroller.Runnable(backupController, restic.MaxDataPathConcurrency))
+	s.mgr.Add(managercontroller.Runnable(restoreController, restic.MaxDataPathConcurrency))
 
 	s.logger.Info("Controllers starting...")
 
@@ -257,7 +310,7 @@ func (s *resticServer) run() {
 // validatePodVolumesHostPath validates that the pod volumes path contains a
 // directory for each Pod running on this node
 func (s *resticServer) validatePodVolumesHostPath() error {
-	files, err := s.fileSystem.ReadDir("/host_pods/")
+	files, err := s.fileSystem.ReadDir(restic.HostPodsDir())
 	if err != nil {
 		return errors.Wrap(err, "could not read pod volumes host path")
 	}
@@ -289,7 +342,7 @@ func (s *resticServer) validatePodVolumesHostPath() error {
 			valid = false
 			s.logger.WithFields(logrus.Fields{
 				"pod":  fmt.Sprintf("%s/%s", pod.GetNamespace(), pod.GetName()),
-				"path": "/host_pods/" + dirName,
+				"path": filepath.Join(restic.HostPodsDir(), dirName),
 			}).Debug("could not find volumes for pod in host path")
 		}
 	}
@@ -300,3 +353,175 @@ func (s *resticServer) validatePodVolumesHostPath() error {
 
 	return nil
 }
+
+// initDataPathConfig sets the node's data path configuration from the node agent config
+// map, if there is one.
+func (s *resticServer) initDataPathConfig() error {
+	var configMap *v1.ConfigMap
+	if s.nodeAgentConfigMap != "" {
+		var err error
+		configMap, err = s.kubeClient.CoreV1().ConfigMaps(s.namespace).Get(s.ctx, s.nodeAgentConfigMap, metav1.GetOptions{})
+		// a missing config map isn't an error, so that deleting it doesn't stop the
+		// restic server from starting.
+		if apierrors.IsNotFound(err) {
+			configMap = nil
+		} else if err != nil {
+			return errors.Wrapf(err, "error getting node agent config map %s/%s", s.namespace, s.nodeAgentConfigMap)
+		}
+	}
+
+	return s.setDataPathConfig(configMap)
+}
+
+// nodeAgentConfigMapInformer returns an informer of the node agent config map, which updates
+// the node's data path configuration when the config map changes.
+func (s *resticServer) nodeAgentConfigMapInformer() cache.SharedIndexInformer {
+	informer := corev1informers.NewFilteredConfigMapInformer(
+		s.kubeClient,
+		s.namespace,
+		0,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+		func(opts *metav1.ListOptions) {
+			opts.FieldSelector = fmt.Sprintf("metadata.name=%s", s.nodeAgentConfigMap)
+		},
+	)
+
+	update := func(configMap *v1.ConfigMap) {
+		if err := s.setDataPathConfig(configMap); err != nil {
+			s.logger.WithError(err).Error("Error updating data path configuration, keeping the previous configuration")
+		}
+	}
+
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			update(obj.(*v1.ConfigMap))
+		},
+		UpdateFunc: func(_, obj interface{}) {
+			update(obj.(*v1.ConfigMap))
+		},
+		DeleteFunc: func(_ interface{}) {
+			update(nil)
+		},
+	})
+
+	return informer
+}
+
+// setDataPathConfig sets the node's data path configuration from the node agent config map,
+// or from the restic server's flags if configMap is nil.
+func (s *resticServer) setDataPathConfig(configMap *v1.ConfigMap) error {
+	var nodeLabels map[string]string
+	if configMap != nil {
+		node, err := s.kubeClient.CoreV1().Nodes().Get(s.ctx, s.nodeName, metav1.GetOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "error getting node %s", s.nodeName)
+		}
+		nodeLabels = node.Labels
+	}
+
+	config, err := getDataPathConfig(configMap, nodeLabels, s.podVolumeBackupConcurrency)
+	if err != nil {
+		return err
+	}
+
+	restic.SetDataPathConfig(config)
+
+	// the node's bandwidth is shared by its data paths.
+	bandwidthConfig := restic.GetBandwidthConfig()
+	bandwidthConfig.Concurrency = config.Concurrency
+	restic.SetBandwidthConfig(bandwidthConfig)
+
+	s.logger.WithFields(logrus.Fields{
+		"concurrency":    config.Concurrency,
+		"cpuBudget":      config.CPUBudget,
+		"memoryBudget":   config.MemoryBudget,
+		"maxLoadAverage": config.MaxLoadAverage,
+	}).Infof("Processing %d pod volume backups and restores at a time", config.Concurrency)
+
+	return nil
+}
+
+// getPodVolumeBackupConcurrency returns how many pod volume backups are processed at a time on
+// the node, which is the node's entry in the concurrency config map if it has one, or else
+// defaultConcurrency.
+func getPodVolumeBackupConcurrency(client corev1client.ConfigMapsGetter, namespace, configMapName, nodeName string, defaultConcurrency int) (int, error) {
+	if defaultConcurrency < 1 {
+		return 0, errors.Errorf("invalid pod volume backup concurrency %d, must be at least 1", defaultConcurrency)
+	}
+
+	if configMapName == "" {
+		return defaultConcurrency, nil
+	}
+
+	// a missing config map isn't an error, so that deleting it doesn't stop the
+	// restic server from starting.
+	configMap, err := client.ConfigMaps(namespace).Get(context.TODO(), configMapName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return defaultConcurrency, nil
+	}
+	if err != nil {
+		return 0, errors.Wrapf(err, "error getting concurrency config map %s/%s", namespace, configMapName)
+	}
+
+	value, ok := configMap.Data[nodeName]
+	if !ok {
+		return defaultConcurrency, nil
+	}
+
+	concurrency, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || concurrency < 1 {
+		return 0, errors.Errorf("invalid pod volume backup concurrency %q for node %s in config map %s/%s, must be a number of at least 1", value, nodeName, namespace, configMapName)
+	}
+
+	return concurrency, nil
+}
+
+// parseCacheConfig returns the cache configuration of the restic server's flags.
+func parseCacheConfig(dir, sizeLimit string) (restic.CacheConfig, error) {
+	config := restic.CacheConfig{Dir: dir}
+
+	if sizeLimit != "" {
+		limit, err := resource.ParseQuantity(sizeLimit)
+		if err != nil {
+			return config, errors.Wrapf(err, "invalid cache size limit %q", sizeLimit)
+		}
+		if limit.Sign() < 0 {
+			return config, errors.Errorf("invalid cache size limit %q, must not be negative", sizeLimit)
+		}
+		config.SizeLimit = limit.Value()
+	}
+
+	return config, nil
+}
+
+// parseBandwidthConfig returns the bandwidth configuration of the restic server's flags,
+// whose limits are shared by the given number of concurrent pod volume backups.
+func parseBandwidthConfig(uploadLimit, downloadLimit string, concurrency int) (restic.BandwidthConfig, error) {
+	config := restic.BandwidthConfig{Concurrency: concurrency}
+
+	var err error
+	if config.UploadLimit, err = parseRateLimit("upload", uploadLimit); err != nil {
+		return config, err
+	}
+	if config.DownloadLimit, err = parseRateLimit("download", downloadLimit); err != nil {
+		return config, err
+	}
+
+	return config, nil
+}
+
+func parseRateLimit(direction, value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	limit, err := resource.ParseQuantity(value)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid %s rate limit %q", direction, value)
+	}
+	if limit.Sign() < 0 {
+		return 0, errors.Errorf("invalid %s rate limit %q, must not be negative", direction, value)
+	}
+
+	return limit.Value(), nil
+}
@@ -28,6 +28,7 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/restic"
 	testutil "github.com/vmware-tanzu/velero/pkg/test"
 )
 
@@ -109,3 +110,105 @@ func Test_validatePodVolumesHostPath(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPodVolumeBackupConcurrency(t *testing.T) {
+	configMap := builder.ForConfigMap("velero", "restic-concurrency").
+		Data("node-1", "4", "node-2", "one").
+		Result()
+
+	tests := []struct {
+		name               string
+		configMapName      string
+		nodeName           string
+		defaultConcurrency int
+		want               int
+		wantErr            string
+	}{
+		{
+			name:               "no config map uses the default",
+			nodeName:           "node-1",
+			defaultConcurrency: 2,
+			want:               2,
+		},
+		{
+			name:               "node's entry in the config map is used",
+			configMapName:      "restic-concurrency",
+			nodeName:           "node-1",
+			defaultConcurrency: 1,
+			want:               4,
+		},
+		{
+			name:               "node without an entry uses the default",
+			configMapName:      "restic-concurrency",
+			nodeName:           "node-3",
+			defaultConcurrency: 1,
+			want:               1,
+		},
+		{
+			name:               "missing config map uses the default",
+			configMapName:      "missing",
+			nodeName:           "node-1",
+			defaultConcurrency: 1,
+			want:               1,
+		},
+		{
+			name:               "invalid entry is an error",
+			configMapName:      "restic-concurrency",
+			nodeName:           "node-2",
+			defaultConcurrency: 1,
+			wantErr:            `invalid pod volume backup concurrency "one" for node node-2 in config map velero/restic-concurrency, must be a number of at least 1`,
+		},
+		{
+			name:               "invalid default is an error",
+			nodeName:           "node-1",
+			defaultConcurrency: 0,
+			wantErr:            "invalid pod volume backup concurrency 0, must be at least 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(configMap).CoreV1()
+
+			got, err := getPodVolumeBackupConcurrency(client, "velero", tt.configMapName, tt.nodeName, tt.defaultConcurrency)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseCacheConfig(t *testing.T) {
+	config, err := parseCacheConfig("/restic-cache", "5Gi")
+	assert.NoError(t, err)
+	assert.Equal(t, restic.CacheConfig{Dir: "/restic-cache", SizeLimit: 5 << 30}, config)
+
+	config, err = parseCacheConfig("", "")
+	assert.NoError(t, err)
+	assert.Equal(t, restic.CacheConfig{}, config)
+
+	_, err = parseCacheConfig("", "lots")
+	assert.Error(t, err)
+
+	_, err = parseCacheConfig("", "-1Gi")
+	assert.EqualError(t, err, `invalid cache size limit "-1Gi", must not be negative`)
+}
+
+func TestParseBandwidthConfig(t *testing.T) {
+	config, err := parseBandwidthConfig("50Mi", "100M", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, restic.BandwidthConfig{UploadLimit: 50 << 20, DownloadLimit: 100000000, Concurrency: 2}, config)
+
+	config, err = parseBandwidthConfig("", "", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, restic.BandwidthConfig{Concurrency: 1}, config)
+
+	_, err = parseBandwidthConfig("fast", "", 1)
+	assert.Error(t, err)
+
+	_, err = parseBandwidthConfig("", "-1Mi", 1)
+	assert.EqualError(t, err, `invalid download rate limit "-1Mi", must not be negative`)
+}
@@ -20,11 +20,14 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
@@ -58,6 +61,18 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 
   # Create a restore for only persistentvolumeclaims and persistentvolumes within a backup.
   velero restore create --from-backup backup-2 --include-resources persistentvolumeclaims,persistentvolumes
+
+  # Create a restore that patches items using the rules in the "restore-modifiers" ConfigMap.
+  velero restore create --from-backup backup-1 --resource-modifier-configmap restore-modifiers
+
+  # Report what restoring from backup "backup-1" would do, without modifying the cluster.
+  velero restore create --from-backup backup-1 --dry-run
+
+  # Create a restore that updates items which already exist in the cluster to match the backup.
+  velero restore create --from-backup backup-1 --existing-resource-policy update
+
+  # Create a restore that restores widgets.example.com first and deployments.apps last.
+  velero restore create --from-backup backup-1 --resource-priorities widgets.example.com --low-resource-priorities deployments.apps
   `,
 		Args: cobra.MaximumNArgs(1),
 		Run: func(c *cobra.Command, args []string) {
@@ -75,20 +90,48 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 }
 
 type CreateOptions struct {
-	BackupName              string
-	ScheduleName            string
-	RestoreName             string
-	RestoreVolumes          flag.OptionalBool
-	Labels                  flag.Map
-	IncludeNamespaces       flag.StringArray
-	ExcludeNamespaces       flag.StringArray
-	IncludeResources        flag.StringArray
-	ExcludeResources        flag.StringArray
-	NamespaceMappings       flag.Map
-	Selector                flag.LabelSelector
-	IncludeClusterResources flag.OptionalBool
-	Wait                    bool
-	AllowPartiallyFailed    flag.OptionalBool
+	BackupName                string
+	ScheduleName              string
+	RestoreName               string
+	RestoreVolumes            flag.OptionalBool
+	Labels                    flag.Map
+	ItemLabels                flag.Map
+	ItemAnnotations           flag.Map
+	IncludeNamespaces         flag.StringArray
+	ExcludeNamespaces         flag.StringArray
+	IncludeResources          flag.StringArray
+	ExcludeResources          flag.StringArray
+	IncludeResourceNames      flag.StringArray
+	StatusIncludeResources    flag.StringArray
+	StatusExcludeResources    flag.StringArray
+	KeepFinalizers            flag.StringArray
+	KeepOwnerReferences       flag.StringArray
+	NamespaceMappings         flag.Map
+	ZoneMappings              flag.Map
+	Selector                  flag.LabelSelector
+	IncludeClusterResources   flag.OptionalBool
+	PreserveNodePorts         flag.OptionalBool
+	Wait                      bool
+	AllowPartiallyFailed      flag.OptionalBool
+	ResourceModifierConfigMap string
+	DryRun                    bool
+	ExistingResourcePolicy    string
+	ServiceAccountPolicy      string
+	PVRenamePolicy            string
+	ResourcePriorities        flag.StringArray
+	LowResourcePriorities     flag.StringArray
+	WaitForReady              flag.StringArray
+	ReadinessTimeout          time.Duration
+	ResourceMappings          flag.StringArray
+	PVCResizeFactor           string
+	PVCMinimumSizes           flag.Map
+	SnapshotStorageClasses    flag.Map
+	SnapshotVolumeTypes       flag.Map
+	SnapshotVolumeIOPS        flag.Map
+	SnapshotVolumeSizes       flag.Map
+	ConflictPolicies          flag.Map
+	ClientQPS                 int
+	ClientBurst               int
 
 	client veleroclient.Interface
 }
@@ -96,10 +139,20 @@ type CreateOptions struct {
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
 		Labels:                  flag.NewMap(),
+		ItemLabels:              flag.NewMap(),
+		ItemAnnotations:         flag.NewMap(),
 		IncludeNamespaces:       flag.NewStringArray("*"),
 		NamespaceMappings:       flag.NewMap().WithEntryDelimiter(",").WithKeyValueDelimiter(":"),
+		ZoneMappings:            flag.NewMap().WithEntryDelimiter(",").WithKeyValueDelimiter(":"),
 		RestoreVolumes:          flag.NewOptionalBool(nil),
 		IncludeClusterResources: flag.NewOptionalBool(nil),
+		PreserveNodePorts:       flag.NewOptionalBool(nil),
+		PVCMinimumSizes:         flag.NewMap(),
+		SnapshotStorageClasses:  flag.NewMap(),
+		SnapshotVolumeTypes:     flag.NewMap(),
+		SnapshotVolumeIOPS:      flag.NewMap(),
+		SnapshotVolumeSizes:     flag.NewMap(),
+		ConflictPolicies:        flag.NewMap(),
 	}
 }
 
@@ -108,11 +161,38 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.ScheduleName, "from-schedule", "", "Schedule to restore from")
 	flags.Var(&o.IncludeNamespaces, "include-namespaces", "Namespaces to include in the restore (use '*' for all namespaces)")
 	flags.Var(&o.ExcludeNamespaces, "exclude-namespaces", "Namespaces to exclude from the restore.")
-	flags.Var(&o.NamespaceMappings, "namespace-mappings", "Namespace mappings from name in the backup to desired restored name in the form src1:dst1,src2:dst2,...")
+	flags.Var(&o.NamespaceMappings, "namespace-mappings", "Namespace mappings from name in the backup to desired restored name in the form src1:dst1,src2:dst2,... Names may contain a single '*' wildcard, e.g. team-*:staging-team-*")
+	flags.Var(&o.ZoneMappings, "zone-mappings", "Availability zone and region mappings from name in the backup to the name that persistent volumes are restored into, in the form src1:dst1,src2:dst2,... Optional.")
 	flags.Var(&o.Labels, "labels", "Labels to apply to the restore.")
+	flags.Var(&o.ItemLabels, "item-labels", "Labels to apply to every restored item, and to the namespaces created by the restore. Optional.")
+	flags.Var(&o.ItemAnnotations, "item-annotations", "Annotations to apply to every restored item, and to the namespaces created by the restore. Optional.")
 	flags.Var(&o.IncludeResources, "include-resources", "Resources to include in the restore, formatted as resource.group, such as storageclasses.storage.k8s.io (use '*' for all resources).")
 	flags.Var(&o.ExcludeResources, "exclude-resources", "Resources to exclude from the restore, formatted as resource.group, such as storageclasses.storage.k8s.io.")
+	flags.Var(&o.IncludeResourceNames, "include-resource-names", "Items to restore, formatted as resource.group/name, such as deployments.apps/web. If specified, only the named items are restored. Optional.")
+	flags.Var(&o.StatusIncludeResources, "status-include-resources", "Resources whose status is restored along with their spec, formatted as resource.group, such as widgets.example.com (use '*' for all resources). Optional.")
+	flags.Var(&o.StatusExcludeResources, "status-exclude-resources", "Resources whose status isn't restored, formatted as resource.group, such as widgets.example.com. Optional.")
+	flags.Var(&o.KeepFinalizers, "keep-finalizers", "Resources whose items keep their finalizers when restored, formatted as resource.group, such as widgets.example.com (use '*' for all resources). By default, the finalizers of all restored items are removed. Optional.")
+	flags.Var(&o.KeepOwnerReferences, "keep-owner-references", "Resources whose items keep their owner references when restored, formatted as resource.group, such as pods (use '*' for all resources). By default, the owner references of all restored items are removed. Optional.")
 	flags.VarP(&o.Selector, "selector", "l", "Only restore resources matching this label selector.")
+	flags.StringVar(&o.ResourceModifierConfigMap, "resource-modifier-configmap", "", "Name of a ConfigMap in the Velero namespace containing rules for patching items before they're restored.")
+	flags.StringVar(&o.ExistingResourcePolicy, "existing-resource-policy", "", "Restore behavior for items that already exist in the cluster. Valid values are none, update and patch. Optional.")
+	flags.Var(&o.ConflictPolicies, "conflict-policies", "Restore behavior, by resource, for items that already exist in the cluster and are different than the backed-up version, in the form resource1=policy1,resource2=policy2,... such as configmaps=skip,deployments.apps=overwrite,*=fail-fast. Valid policies are skip, overwrite and fail-fast. Resources without a conflict policy are handled according to the existing resource policy. Optional.")
+	flags.StringVar(&o.ServiceAccountPolicy, "service-account-policy", "", "Restore behavior for service accounts that already exist in the cluster. Valid values are merge, replace and skip. If not specified, the secrets, image pull secrets, labels and annotations of the backed-up version are merged into the in-cluster version. Optional.")
+	flags.StringVar(&o.PVRenamePolicy, "pv-rename-policy", "", "When to give persistent volumes restored from snapshots new names. Valid values are Always, OnConflict and Never. If not specified, persistent volumes are only renamed if they already exist in the cluster and are claimed in a namespace that's being remapped. Optional.")
+	flags.Var(&o.ResourcePriorities, "resource-priorities", "Resources to restore first, in order, formatted as resource.group, such as storageclasses.storage.k8s.io. Overrides the server's high-priority restore resources. Optional.")
+	flags.Var(&o.LowResourcePriorities, "low-resource-priorities", "Resources to restore last, in order, formatted as resource.group, such as deployments.apps. Overrides the server's low-priority restore resources. Optional.")
+	flags.Var(&o.WaitForReady, "wait-for-ready", "Resources whose restored items must become ready before later resources are restored, formatted as resource.group[=conditionType] or resource.group=jsonpath={expression}[=value], such as deployments.apps, widgets.example.com=Ready or widgets.example.com=jsonpath={.status.phase}=Running. The condition type may be omitted for customresourcedefinitions, deployments, apiservices and pods. Optional.")
+	flags.DurationVar(&o.ReadinessTimeout, "readiness-timeout", o.ReadinessTimeout, "How long to wait for the items of each resource specified with --wait-for-ready to become ready. Defaults to 10 minutes. Optional.")
+	flags.Var(&o.ResourceMappings, "resource-mappings", "Resources in the backup to restore as different resources, formatted as source=target, such as deploymentconfigs.apps.openshift.io=deployments.apps. Optional.")
+	flags.StringVar(&o.PVCResizeFactor, "pvc-resize-factor", "", "Factor, at least 1, to multiply the requested storage of restored persistent volume claims whose volumes are dynamically provisioned by, such as 1.5. Optional.")
+	flags.Var(&o.PVCMinimumSizes, "pvc-minimum-sizes", "Minimum storage requested by restored persistent volume claims whose volumes are dynamically provisioned, by storage class, in the form class1=size1,class2=size2,... such as standard=10Gi. Optional.")
+	flags.Var(&o.SnapshotStorageClasses, "snapshot-storage-classes", "Storage classes of persistent volumes restored from snapshots, and of the claims bound to them, by the storage class they were backed up with, in the form class1=newclass1,class2=newclass2,... Optional.")
+	flags.Var(&o.SnapshotVolumeTypes, "snapshot-volume-types", "Provider volume types of volumes restored from snapshots, by the storage class of their persistent volumes, in the form class1=type1,class2=type2,... such as gp2=gp3. Optional.")
+	flags.Var(&o.SnapshotVolumeIOPS, "snapshot-volume-iops", "Provisioned IOPS of volumes restored from snapshots, by the storage class of their persistent volumes, in the form class1=iops1,class2=iops2,... Optional.")
+	flags.Var(&o.SnapshotVolumeSizes, "snapshot-volume-sizes", "Minimum sizes of volumes restored from snapshots, by the storage class of their persistent volumes, in the form class1=size1,class2=size2,... such as gp2=100Gi. Volumes are only enlarged by volume snapshotter plugins that support it. Optional.")
+	flags.IntVar(&o.ClientQPS, "client-qps", 0, "Maximum number of requests per second by the restore to the Kubernetes API once the burst limit has been reached, independently of the server's client settings. Optional.")
+	flags.IntVar(&o.ClientBurst, "client-burst", 0, "Maximum number of requests by the restore to the Kubernetes API in a short period of time. Defaults to --client-qps. Optional.")
+	flags.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Report what the restore would do, without modifying the cluster. The report can be viewed with 'velero restore describe --details'.")
 	f := flags.VarPF(&o.RestoreVolumes, "restore-volumes", "", "Whether to restore volumes from snapshots.")
 	// this allows the user to just specify "--restore-volumes" as shorthand for "--restore-volumes=true"
 	// like a normal bool flag
@@ -121,6 +201,9 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	f = flags.VarPF(&o.IncludeClusterResources, "include-cluster-resources", "", "Include cluster-scoped resources in the restore.")
 	f.NoOptDefVal = "true"
 
+	f = flags.VarPF(&o.PreserveNodePorts, "preserve-nodeports", "", "Whether to keep the NodePorts of restored services. If not specified, only NodePorts that were explicitly specified are kept.")
+	f.NoOptDefVal = "true"
+
 	f = flags.VarPF(&o.AllowPartiallyFailed, "allow-partially-failed", "", "If using --from-schedule, whether to consider PartiallyFailed backups when looking for the most recent one. This flag has no effect if not using --from-schedule.")
 	f.NoOptDefVal = "true"
 
@@ -166,6 +249,10 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("Velero client is not set; unable to proceed")
 	}
 
+	if _, err := o.snapshotVolumeOverrides(); err != nil {
+		return err
+	}
+
 	switch {
 	case o.BackupName != "":
 		if _, err := o.client.VeleroV1().Backups(f.Namespace()).Get(context.TODO(), o.BackupName, metav1.GetOptions{}); err != nil {
@@ -184,6 +271,69 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 	return nil
 }
 
+// snapshotVolumeOverrides combines the storage classes, volume types, IOPS and sizes of volumes
+// restored from snapshots, by the storage class they were backed up with, into the restore's
+// snapshot volume overrides.
+func (o *CreateOptions) snapshotVolumeOverrides() (map[string]api.SnapshotVolumeOverride, error) {
+	res := make(map[string]api.SnapshotVolumeOverride)
+
+	for storageClass, newStorageClass := range o.SnapshotStorageClasses.Data() {
+		override := res[storageClass]
+		override.StorageClass = newStorageClass
+		res[storageClass] = override
+	}
+	for storageClass, volumeType := range o.SnapshotVolumeTypes.Data() {
+		override := res[storageClass]
+		override.VolumeType = volumeType
+		res[storageClass] = override
+	}
+	for storageClass, iops := range o.SnapshotVolumeIOPS.Data() {
+		value, err := strconv.ParseInt(iops, 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("invalid --snapshot-volume-iops value %q for storage class %s: must be an integer", iops, storageClass)
+		}
+		override := res[storageClass]
+		override.IOPS = &value
+		res[storageClass] = override
+	}
+	for storageClass, size := range o.SnapshotVolumeSizes.Data() {
+		override := res[storageClass]
+		override.Size = size
+		res[storageClass] = override
+	}
+
+	if len(res) == 0 {
+		return nil, nil
+	}
+	return res, nil
+}
+
+// conflictPolicies converts a map of resources to conflict policies into the
+// restore's conflict policies, with one entry per policy.
+func conflictPolicies(policiesByResource map[string]string) []api.ConflictPolicy {
+	resourcesByPolicy := make(map[string][]string)
+	for resource, policy := range policiesByResource {
+		resourcesByPolicy[policy] = append(resourcesByPolicy[policy], resource)
+	}
+
+	policies := make([]string, 0, len(resourcesByPolicy))
+	for policy := range resourcesByPolicy {
+		policies = append(policies, policy)
+	}
+	sort.Strings(policies)
+
+	var res []api.ConflictPolicy
+	for _, policy := range policies {
+		resources := resourcesByPolicy[policy]
+		sort.Strings(resources)
+		res = append(res, api.ConflictPolicy{
+			Resources: resources,
+			Policy:    api.ConflictPolicyType(policy),
+		})
+	}
+	return res
+}
+
 // mostRecentBackup returns the backup with the most recent start timestamp that has a phase that's
 // in the provided list of allowed phases.
 func mostRecentBackup(backups []api.Backup, allowedPhases ...api.BackupPhase) *api.Backup {
@@ -258,13 +408,107 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			ExcludedNamespaces:      o.ExcludeNamespaces,
 			IncludedResources:       o.IncludeResources,
 			ExcludedResources:       o.ExcludeResources,
+			IncludedResourceNames:   o.IncludeResourceNames,
 			NamespaceMapping:        o.NamespaceMappings.Data(),
 			LabelSelector:           o.Selector.LabelSelector,
 			RestorePVs:              o.RestoreVolumes.Value,
+			ZoneMapping:             o.ZoneMappings.Data(),
 			IncludeClusterResources: o.IncludeClusterResources.Value,
+			PreserveNodePorts:       o.PreserveNodePorts.Value,
+			DryRun:                  o.DryRun,
+			ExistingResourcePolicy:  api.PolicyType(o.ExistingResourcePolicy),
+			ServiceAccountPolicy:    api.ServiceAccountPolicyType(o.ServiceAccountPolicy),
+			PVRenamePolicy:          api.PVRenamePolicy(o.PVRenamePolicy),
+			ItemLabels:              o.ItemLabels.Data(),
+			ItemAnnotations:         o.ItemAnnotations.Data(),
 		},
 	}
 
+	if len(o.StatusIncludeResources) > 0 {
+		restore.Spec.RestoreStatus = &api.RestoreStatusSpec{
+			IncludedResources: o.StatusIncludeResources,
+			ExcludedResources: o.StatusExcludeResources,
+		}
+	}
+
+	if len(o.KeepFinalizers) > 0 {
+		restore.Spec.StripFinalizers = &api.StripMetadataSpec{
+			ExcludedResources: o.KeepFinalizers,
+		}
+	}
+
+	if len(o.KeepOwnerReferences) > 0 {
+		restore.Spec.StripOwnerReferences = &api.StripMetadataSpec{
+			ExcludedResources: o.KeepOwnerReferences,
+		}
+	}
+
+	if o.PVCResizeFactor != "" || len(o.PVCMinimumSizes.Data()) > 0 {
+		restore.Spec.PVCResize = &api.PVCResizeSpec{
+			Factor:       o.PVCResizeFactor,
+			MinimumSizes: o.PVCMinimumSizes.Data(),
+		}
+	}
+
+	snapshotVolumeOverrides, err := o.snapshotVolumeOverrides()
+	if err != nil {
+		return err
+	}
+	restore.Spec.SnapshotVolumeOverrides = snapshotVolumeOverrides
+
+	restore.Spec.ConflictPolicies = conflictPolicies(o.ConflictPolicies.Data())
+
+	if o.ClientQPS != 0 || o.ClientBurst != 0 {
+		restore.Spec.APIRateLimit = &api.APIRateLimitSpec{
+			QPS:   o.ClientQPS,
+			Burst: o.ClientBurst,
+		}
+	}
+
+	if o.ResourceModifierConfigMap != "" {
+		restore.Spec.ResourceModifier = &corev1api.TypedLocalObjectReference{
+			Kind: "ConfigMap",
+			Name: o.ResourceModifierConfigMap,
+		}
+	}
+
+	if len(o.ResourcePriorities) > 0 || len(o.LowResourcePriorities) > 0 {
+		restore.Spec.ResourcePriorities = &api.RestoreResourcePriorities{
+			HighPriorities: o.ResourcePriorities,
+			LowPriorities:  o.LowResourcePriorities,
+		}
+	}
+
+	for _, gate := range o.WaitForReady {
+		parts := strings.SplitN(gate, "=", 2)
+		readinessGate := api.ReadinessGate{
+			Resource: parts[0],
+			Timeout:  metav1.Duration{Duration: o.ReadinessTimeout},
+		}
+		switch {
+		case len(parts) == 2 && strings.HasPrefix(parts[1], "jsonpath="):
+			// the value follows the expression's closing brace, like kubectl wait's --for=jsonpath.
+			readinessGate.JSONPath = strings.TrimPrefix(parts[1], "jsonpath=")
+			if i := strings.LastIndex(readinessGate.JSONPath, "}="); i >= 0 {
+				readinessGate.JSONPath, readinessGate.Value = readinessGate.JSONPath[:i+1], readinessGate.JSONPath[i+2:]
+			}
+		case len(parts) == 2:
+			readinessGate.ConditionType = parts[1]
+		}
+		restore.Spec.ReadinessGates = append(restore.Spec.ReadinessGates, readinessGate)
+	}
+
+	for _, mapping := range o.ResourceMappings {
+		parts := strings.SplitN(mapping, "=", 2)
+		resourceMapping := api.ResourceMapping{
+			Source: parts[0],
+		}
+		if len(parts) == 2 {
+			resourceMapping.Target = parts[1]
+		}
+		restore.Spec.ResourceMappings = append(restore.Spec.ResourceMappings, resourceMapping)
+	}
+
 	if printed, err := output.PrintWithFormat(c, restore); printed || err != nil {
 		return err
 	}
@@ -309,7 +553,7 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		go restoreInformer.Run(stop)
 	}
 
-	restore, err := o.client.VeleroV1().Restores(restore.Namespace).Create(context.TODO(), restore, metav1.CreateOptions{})
+	restore, err = o.client.VeleroV1().Restores(restore.Namespace).Create(context.TODO(), restore, metav1.CreateOptions{})
 	if err != nil {
 		return err
 	}
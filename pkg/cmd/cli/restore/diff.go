@@ -0,0 +1,158 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+func NewDiffCommand(f client.Factory) *cobra.Command {
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+	o := NewDiffOptions()
+	o.caCertFile = config.CACertFile()
+
+	c := &cobra.Command{
+		Use:   "diff",
+		Short: "Compare a backup with the cluster",
+		Long: `Compare the contents of a backup with the current state of the cluster, without creating a restore.
+
+Items in the backup that don't exist in the cluster are reported as missing, and items that are
+different in the cluster than in the backup are reported as changed. Items in the cluster that
+aren't in the backup are reported as extra if they're in a namespace in the backup and of a
+namespaced resource in the backup.`,
+		Example: `  # Compare the contents of backup-1 with the cluster.
+  velero restore diff --from-backup backup-1`,
+		Args: cobra.NoArgs,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Validate(c, args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type DiffOptions struct {
+	BackupName            string
+	Timeout               time.Duration
+	InsecureSkipTLSVerify bool
+	caCertFile            string
+}
+
+func NewDiffOptions() *DiffOptions {
+	return &DiffOptions{
+		Timeout: time.Minute,
+	}
+}
+
+func (o *DiffOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.BackupName, "from-backup", "", "Backup to compare with the cluster.")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait to process download request.")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.StringVar(&o.caCertFile, "cacert", o.caCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+}
+
+func (o *DiffOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
+	if o.BackupName == "" {
+		return errors.New("--from-backup is required")
+	}
+
+	veleroClient, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	if _, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(context.TODO(), o.BackupName, metav1.GetOptions{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *DiffOptions) Run(c *cobra.Command, f client.Factory) error {
+	veleroClient, err := f.Client()
+	if err != nil {
+		return err
+	}
+	kubeClient, err := f.KubeClient()
+	if err != nil {
+		return err
+	}
+	dynamicClient, err := f.DynamicClient()
+	if err != nil {
+		return err
+	}
+
+	log := logrus.New()
+	log.Out = os.Stderr
+	log.Level = logrus.WarnLevel
+
+	discoveryHelper, err := discovery.NewHelper(kubeClient.Discovery(), log)
+	if err != nil {
+		return errors.Wrap(err, "error initializing discovery helper")
+	}
+
+	contents := new(bytes.Buffer)
+	if err := downloadrequest.Stream(veleroClient.VeleroV1(), f.Namespace(), o.BackupName, velerov1api.DownloadTargetKindBackupContents, contents, o.Timeout, o.InsecureSkipTLSVerify, o.caCertFile); err != nil {
+		return err
+	}
+
+	res, err := pkgrestore.NewDiffer(discoveryHelper, client.NewDynamicFactory(dynamicClient), filesystem.NewFileSystem(), log).Diff(contents)
+	if err != nil {
+		return err
+	}
+
+	printDiffItems("Missing (in the backup, not in the cluster)", res.Missing)
+	printDiffItems("Changed (different in the cluster than in the backup)", res.Changed)
+	printDiffItems("Extra (in the cluster, not in the backup)", res.Extra)
+
+	return nil
+}
+
+func printDiffItems(heading string, items []string) {
+	fmt.Printf("%s:\n", heading)
+	if len(items) == 0 {
+		fmt.Println("  <none>")
+	}
+	for _, item := range items {
+		fmt.Printf("  %s\n", item)
+	}
+	fmt.Println()
+}
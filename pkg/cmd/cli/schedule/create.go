@@ -19,16 +19,20 @@ package schedule
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/backup"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 )
 
@@ -38,7 +42,8 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 	c := &cobra.Command{
 		Use:   use + " NAME --schedule",
 		Short: "Create a schedule",
-		Long: `The --schedule flag is required, in cron notation, using UTC time:
+		Long: `The --schedule flag is required, in cron notation, using UTC time unless
+the --timezone flag is set:
 
 | Character Position | Character Period | Acceptable Values |
 | -------------------|:----------------:| -----------------:|
@@ -63,6 +68,9 @@ example: "@every 2h30m".`,
 
 	# Create a weekly backup, each living for 90 days (2160 hours)
 	velero create schedule NAME --schedule="@every 168h" --ttl 2160h0m0s
+
+	# Create a backup every day at 2 AM New York time
+	velero create schedule NAME --schedule="0 2 * * *" --timezone America/New_York
 	`,
 		Args: cobra.ExactArgs(1),
 		Run: func(c *cobra.Command, args []string) {
@@ -80,21 +88,48 @@ example: "@every 2h30m".`,
 }
 
 type CreateOptions struct {
-	BackupOptions *backup.CreateOptions
-	Schedule      string
+	BackupOptions      *backup.CreateOptions
+	Schedule           string
+	Timezone           string
+	Paused             bool
+	ConcurrencyPolicy  *flag.Enum
+	KeepLast           int
+	Jitter             time.Duration
+	BackupNameTemplate string
+	Policy             string
+
+	UseOwnerReferencesInBackup flag.OptionalBool
 
 	labelSelector *metav1.LabelSelector
 }
 
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
-		BackupOptions: backup.NewCreateOptions(),
+		BackupOptions:              backup.NewCreateOptions(),
+		UseOwnerReferencesInBackup: flag.NewOptionalBool(nil),
+		ConcurrencyPolicy: flag.NewEnum(
+			string(api.ConcurrencyPolicyAllow),
+			string(api.ConcurrencyPolicyAllow),
+			string(api.ConcurrencyPolicyForbid),
+			string(api.ConcurrencyPolicyReplace),
+		),
 	}
 }
 
 func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	o.BackupOptions.BindFlags(flags)
 	flags.StringVar(&o.Schedule, "schedule", o.Schedule, "a cron expression specifying a recurring schedule for this backup to run")
+	flags.StringVar(&o.Timezone, "timezone", o.Timezone, "the IANA name of the time zone that the schedule is evaluated in, such as America/New_York. Defaults to UTC.")
+	flags.Var(o.ConcurrencyPolicy, "concurrency-policy", fmt.Sprintf("how to treat a backup that's due while a backup the schedule previously triggered hasn't finished. Valid values are %s. Forbid skips the backup, and Replace deletes previous backups that haven't started yet.", strings.Join(o.ConcurrencyPolicy.AllowedValues(), ", ")))
+	flags.IntVar(&o.KeepLast, "keep-last", o.KeepLast, "the number of the schedule's most recent successfully completed backups to keep. Older backups are deleted regardless of their TTL. If zero, backups are only deleted when they expire.")
+	flags.DurationVar(&o.Jitter, "jitter", o.Jitter, "the window to spread the schedule's backups over, so that schedules with the same cron expression don't all trigger backups at once. Each backup is delayed by an offset within the window that's derived from the schedule's name.")
+	f := flags.VarPF(&o.UseOwnerReferencesInBackup, "use-owner-references-in-backup", "", "give the schedule's backups an owner reference to the schedule, so that deleting the schedule deletes its backups, including their data in object storage, as well.")
+	// this allows the user to just specify "--use-owner-references-in-backup" as shorthand for "--use-owner-references-in-backup=true"
+	// like a normal bool flag
+	f.NoOptDefVal = "true"
+	flags.StringVar(&o.BackupNameTemplate, "backup-name-template", o.BackupNameTemplate, "a Go template that the names of the schedule's backups are generated from, such as '{{.ScheduleName}}-{{.Timestamp \"20060102\"}}-{{.ClusterName}}'. Defaults to the schedule's name followed by a timestamp.")
+	flags.StringVar(&o.Policy, "policy", o.Policy, "the name of a backup policy that the schedule's backups inherit settings from. Settings given with other flags override the policy's.")
+	flags.BoolVar(&o.Paused, "paused", o.Paused, "create the schedule paused, so that it doesn't trigger backups until it's unpaused.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -102,6 +137,18 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--schedule is required")
 	}
 
+	if _, err := time.LoadLocation(o.Timezone); err != nil {
+		return errors.Wrapf(err, "invalid --timezone %q", o.Timezone)
+	}
+
+	if o.KeepLast < 0 {
+		return errors.New("--keep-last must not be negative")
+	}
+
+	if o.Jitter < 0 {
+		return errors.New("--jitter must not be negative")
+	}
+
 	return o.BackupOptions.Validate(c, args, f)
 }
 
@@ -115,6 +162,22 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		return err
 	}
 
+	ttl := o.BackupOptions.TTL
+	// the default TTL would override the policy's, so it's only set if it's given explicitly.
+	if o.Policy != "" && !c.Flags().Changed("ttl") {
+		ttl = 0
+	}
+
+	ephemeralVolumePolicy, err := backup.ParseEphemeralVolumePolicy(o.BackupOptions.EphemeralVolumePolicy.Data())
+	if err != nil {
+		return err
+	}
+
+	var snapshotTTL *metav1.Duration
+	if o.BackupOptions.SnapshotTTL > 0 {
+		snapshotTTL = &metav1.Duration{Duration: o.BackupOptions.SnapshotTTL}
+	}
+
 	schedule := &api.Schedule{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: f.Namespace(),
@@ -123,22 +186,48 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		},
 		Spec: api.ScheduleSpec{
 			Template: api.BackupSpec{
-				IncludedNamespaces:      o.BackupOptions.IncludeNamespaces,
-				ExcludedNamespaces:      o.BackupOptions.ExcludeNamespaces,
-				IncludedResources:       o.BackupOptions.IncludeResources,
-				ExcludedResources:       o.BackupOptions.ExcludeResources,
-				IncludeClusterResources: o.BackupOptions.IncludeClusterResources.Value,
-				LabelSelector:           o.BackupOptions.Selector.LabelSelector,
-				SnapshotVolumes:         o.BackupOptions.SnapshotVolumes.Value,
-				TTL:                     metav1.Duration{Duration: o.BackupOptions.TTL},
-				StorageLocation:         o.BackupOptions.StorageLocation,
-				VolumeSnapshotLocations: o.BackupOptions.SnapshotLocations,
-				DefaultVolumesToRestic:  o.BackupOptions.DefaultVolumesToRestic.Value,
+				IncludedNamespaces:       o.BackupOptions.IncludeNamespaces,
+				ExcludedNamespaces:       o.BackupOptions.ExcludeNamespaces,
+				IncludedResources:        o.BackupOptions.IncludeResources,
+				ExcludedResources:        o.BackupOptions.ExcludeResources,
+				IncludedAPIGroups:        o.BackupOptions.IncludeAPIGroups,
+				ExcludedAPIGroups:        o.BackupOptions.ExcludeAPIGroups,
+				IncludeClusterResources:  o.BackupOptions.IncludeClusterResources.Value,
+				LabelSelector:            o.BackupOptions.Selector.LabelSelector,
+				SnapshotVolumes:          o.BackupOptions.SnapshotVolumes.Value,
+				TTL:                      metav1.Duration{Duration: ttl},
+				SnapshotTTL:              snapshotTTL,
+				SnapshotTags:             o.BackupOptions.SnapshotTags.Data(),
+				SnapshotVerification:     api.SnapshotVerificationMode(o.BackupOptions.SnapshotVerification.String()),
+				StorageLocation:          o.BackupOptions.StorageLocation,
+				VolumeSnapshotLocations:  o.BackupOptions.SnapshotLocations,
+				ReplicationLocations:     o.BackupOptions.ReplicationLocations,
+				VolumePathIncludes:       o.BackupOptions.VolumePathIncludes,
+				VolumePathExcludes:       o.BackupOptions.VolumePathExcludes,
+				DefaultVolumesToFsBackup: o.BackupOptions.DefaultVolumesToFsBackup.Value,
+				UnmountedVolumesToRestic: o.BackupOptions.UnmountedVolumesToRestic.Value,
+				SkipUnchangedVolumes:     o.BackupOptions.SkipUnchangedVolumes.Value,
+				EphemeralVolumePolicy:    ephemeralVolumePolicy,
 			},
-			Schedule: o.Schedule,
+			Schedule:                   o.Schedule,
+			Timezone:                   o.Timezone,
+			Paused:                     o.Paused,
+			ConcurrencyPolicy:          api.ConcurrencyPolicy(o.ConcurrencyPolicy.String()),
+			KeepLast:                   o.KeepLast,
+			Jitter:                     metav1.Duration{Duration: o.Jitter},
+			UseOwnerReferencesInBackup: o.UseOwnerReferencesInBackup.Value,
+			BackupNameTemplate:         o.BackupNameTemplate,
+			Policy:                     o.Policy,
 		},
 	}
 
+	if o.BackupOptions.ResourcePolicyConfigMap != "" {
+		schedule.Spec.Template.ResourcePolicy = &corev1api.TypedLocalObjectReference{
+			Kind: "ConfigMap",
+			Name: o.BackupOptions.ResourcePolicyConfigMap,
+		}
+	}
+
 	if printed, err := output.PrintWithFormat(c, schedule); printed || err != nil {
 		return err
 	}
@@ -23,6 +23,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -54,15 +55,24 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 }
 
 type CreateOptions struct {
-	Name     string
-	Provider string
-	Config   flag.Map
-	Labels   flag.Map
+	Name                    string
+	Provider                string
+	Config                  flag.Map
+	Labels                  flag.Map
+	Credential              flag.Map
+	AmbientIdentity         bool
+	IdentityRole            string
+	HTTPProxy               string
+	HTTPSProxy              string
+	NoProxy                 string
+	BypassProxy             bool
+	MaxConcurrentOperations int
 }
 
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
-		Config: flag.NewMap(),
+		Config:     flag.NewMap(),
+		Credential: flag.NewMap(),
 	}
 }
 
@@ -70,6 +80,14 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.Provider, "provider", o.Provider, "Name of the volume snapshot provider (e.g. aws, azure, gcp).")
 	flags.Var(&o.Config, "config", "Configuration key-value pairs.")
 	flags.Var(&o.Labels, "labels", "Labels to apply to the volume snapshot location.")
+	flags.Var(&o.Credential, "credential", "The credential to be used by this location as a key-value pair, where the key is the name of a secret in the Velero server's namespace and the value is the key within the secret's data. Optional, one value only.")
+	flags.BoolVar(&o.AmbientIdentity, "ambient-identity", o.AmbientIdentity, "Authenticate with the cloud identity of the Velero server's pod, such as AWS IAM roles for service accounts, GCP Workload Identity or Azure pod identity, instead of the credentials Velero was installed with. Optional.")
+	flags.StringVar(&o.IdentityRole, "identity-role", o.IdentityRole, "Cloud identity that the Velero server's pod makes this location's requests as, such as the ARN of an AWS IAM role, the email of a GCP service account or the client ID of an Azure managed identity. Implies --ambient-identity. Optional.")
+	flags.StringVar(&o.HTTPProxy, "http-proxy", o.HTTPProxy, "URL of the proxy that the location's plugin makes HTTP requests through, instead of the Velero server's. Optional.")
+	flags.StringVar(&o.HTTPSProxy, "https-proxy", o.HTTPSProxy, "URL of the proxy that the location's plugin makes HTTPS requests through, instead of the Velero server's. Optional.")
+	flags.StringVar(&o.NoProxy, "no-proxy", o.NoProxy, "Comma-separated hosts, domains and CIDRs that the location's plugin reaches without a proxy, instead of the Velero server's. Optional.")
+	flags.BoolVar(&o.BypassProxy, "bypass-proxy", o.BypassProxy, "Make the location's requests without the Velero server's proxy. Optional.")
+	flags.IntVar(&o.MaxConcurrentOperations, "max-concurrent-operations", o.MaxConcurrentOperations, "Maximum number of this location's volume snapshot operations, like creating or deleting snapshots, that the Velero server runs at the same time. Further operations wait until others finish. If zero, they aren't limited. Optional.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -81,6 +99,22 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--provider is required")
 	}
 
+	if len(o.Credential.Data()) > 1 {
+		return errors.New("--credential can only contain 1 key/value pair")
+	}
+
+	if (o.AmbientIdentity || o.IdentityRole != "") && len(o.Credential.Data()) > 0 {
+		return errors.New("--credential can't be used with --ambient-identity or --identity-role")
+	}
+
+	if o.BypassProxy && (o.HTTPProxy != "" || o.HTTPSProxy != "" || o.NoProxy != "") {
+		return errors.New("--bypass-proxy can't be used with --http-proxy, --https-proxy or --no-proxy")
+	}
+
+	if o.MaxConcurrentOperations < 0 {
+		return errors.New("--max-concurrent-operations must not be negative")
+	}
+
 	return nil
 }
 
@@ -90,6 +124,24 @@ func (o *CreateOptions) Complete(args []string, f client.Factory) error {
 }
 
 func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
+	var credential *corev1api.SecretKeySelector
+	for name, key := range o.Credential.Data() {
+		credential = &corev1api.SecretKeySelector{
+			LocalObjectReference: corev1api.LocalObjectReference{Name: name},
+			Key:                  key,
+		}
+	}
+
+	var identity *api.AmbientIdentity
+	if o.AmbientIdentity || o.IdentityRole != "" {
+		identity = &api.AmbientIdentity{Role: o.IdentityRole}
+	}
+
+	var egressProxy *api.EgressProxy
+	if o.BypassProxy || o.HTTPProxy != "" || o.HTTPSProxy != "" || o.NoProxy != "" {
+		egressProxy = &api.EgressProxy{HTTPProxy: o.HTTPProxy, HTTPSProxy: o.HTTPSProxy, NoProxy: o.NoProxy}
+	}
+
 	volumeSnapshotLocation := &api.VolumeSnapshotLocation{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: f.Namespace(),
@@ -97,8 +149,12 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			Labels:    o.Labels.Data(),
 		},
 		Spec: api.VolumeSnapshotLocationSpec{
-			Provider: o.Provider,
-			Config:   o.Config.Data(),
+			Provider:                o.Provider,
+			Config:                  o.Config.Data(),
+			Credential:              credential,
+			Identity:                identity,
+			EgressProxy:             egressProxy,
+			MaxConcurrentOperations: o.MaxConcurrentOperations,
 		},
 	}
 
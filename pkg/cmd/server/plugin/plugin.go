@@ -17,6 +17,7 @@ limitations under the License.
 package plugin
 
 import (
+	snapshotv1beta1client "github.com/kubernetes-csi/external-snapshotter/v2/pkg/client/clientset/versioned"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
@@ -41,6 +42,8 @@ func NewCommand(f client.Factory) *cobra.Command {
 				RegisterBackupItemAction("velero.io/pod", newPodBackupItemAction).
 				RegisterBackupItemAction("velero.io/service-account", newServiceAccountBackupItemAction(f)).
 				RegisterBackupItemAction("velero.io/crd-remap-version", newRemapCRDVersionAction(f)).
+				RegisterBackupItemAction("velero.io/csi-pvc", newCSIPVCBackupItemAction(f)).
+				RegisterBackupItemAction("velero.io/csi-volumesnapshot", newCSIVolumeSnapshotBackupItemAction(f)).
 				RegisterRestoreItemAction("velero.io/job", newJobRestoreItemAction).
 				RegisterRestoreItemAction("velero.io/pod", newPodRestoreItemAction).
 				RegisterRestoreItemAction("velero.io/restic", newResticRestoreItemAction(f)).
@@ -54,6 +57,10 @@ func NewCommand(f client.Factory) *cobra.Command {
 				RegisterRestoreItemAction("velero.io/cluster-role-bindings", newClusterRoleBindingItemAction).
 				RegisterRestoreItemAction("velero.io/crd-preserve-fields", newCRDV1PreserveUnknownFieldsItemAction).
 				RegisterRestoreItemAction("velero.io/change-pvc-node-selector", newChangePVCNodeSelectorItemAction(f)).
+				RegisterRestoreItemAction("velero.io/change-image-name", newChangeImageNameItemAction(f)).
+				RegisterRestoreItemAction("velero.io/csi-pvc", newCSIPVCRestoreItemAction).
+				RegisterRestoreItemAction("velero.io/csi-volumesnapshot", newCSIVolumeSnapshotRestoreItemAction(f)).
+				RegisterRestoreItemAction("velero.io/csi-volumesnapshotcontent", newCSIVolumeSnapshotContentRestoreItemAction).
 				Serve()
 		},
 	}
@@ -112,6 +119,42 @@ func newRemapCRDVersionAction(f client.Factory) veleroplugin.HandlerInitializer
 	}
 }
 
+func newCSIPVCBackupItemAction(f client.Factory) veleroplugin.HandlerInitializer {
+	return func(logger logrus.FieldLogger) (interface{}, error) {
+		clientset, err := f.KubeClient()
+		if err != nil {
+			return nil, err
+		}
+
+		snapshotClient, err := newSnapshotClient(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return backup.NewCSIPVCAction(logger, clientset.CoreV1(), clientset.CoreV1(), clientset.CoreV1(), snapshotClient.SnapshotV1beta1()), nil
+	}
+}
+
+func newCSIVolumeSnapshotBackupItemAction(f client.Factory) veleroplugin.HandlerInitializer {
+	return func(logger logrus.FieldLogger) (interface{}, error) {
+		snapshotClient, err := newSnapshotClient(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return backup.NewCSIVolumeSnapshotAction(logger, snapshotClient.SnapshotV1beta1()), nil
+	}
+}
+
+func newSnapshotClient(f client.Factory) (snapshotv1beta1client.Interface, error) {
+	config, err := f.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshotv1beta1client.NewForConfig(config)
+}
+
 func newJobRestoreItemAction(logger logrus.FieldLogger) (interface{}, error) {
 	return restore.NewJobAction(logger), nil
 }
@@ -197,3 +240,36 @@ func newChangePVCNodeSelectorItemAction(f client.Factory) veleroplugin.HandlerIn
 		), nil
 	}
 }
+
+func newChangeImageNameItemAction(f client.Factory) veleroplugin.HandlerInitializer {
+	return func(logger logrus.FieldLogger) (interface{}, error) {
+		client, err := f.KubeClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return restore.NewChangeImageNameAction(
+			logger,
+			client.CoreV1().ConfigMaps(f.Namespace()),
+		), nil
+	}
+}
+
+func newCSIPVCRestoreItemAction(logger logrus.FieldLogger) (interface{}, error) {
+	return restore.NewCSIPVCAction(logger), nil
+}
+
+func newCSIVolumeSnapshotRestoreItemAction(f client.Factory) veleroplugin.HandlerInitializer {
+	return func(logger logrus.FieldLogger) (interface{}, error) {
+		snapshotClient, err := newSnapshotClient(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return restore.NewCSIVolumeSnapshotAction(logger, snapshotClient.SnapshotV1beta1()), nil
+	}
+}
+
+func newCSIVolumeSnapshotContentRestoreItemAction(logger logrus.FieldLogger) (interface{}, error) {
+	return restore.NewCSIVolumeSnapshotContentAction(logger), nil
+}
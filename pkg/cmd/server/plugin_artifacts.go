@@ -0,0 +1,147 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/artifact"
+)
+
+// artifactPuller pulls the plugin executables of an artifact into a directory.
+type artifactPuller interface {
+	Pull(ref artifact.Reference, dir string) ([]string, error)
+}
+
+// pluginArtifactSyncer pulls the plugin artifacts listed in the --plugin-artifacts-config-map
+// config map into the plugin directory, and removes the ones that are removed from it, so
+// that the plugin directory's rescans add and remove their plugins without a restart.
+type pluginArtifactSyncer struct {
+	configMaps corev1client.ConfigMapInterface
+	name       string
+	pluginDir  string
+	puller     artifactPuller
+	logger     logrus.FieldLogger
+
+	// static are the directories of the artifacts of the --plugin-artifacts flag, which are
+	// never removed.
+	static map[string]bool
+	// pulled are the directories that the artifacts of the config map were pulled into,
+	// by reference.
+	pulled map[string]string
+}
+
+func newPluginArtifactSyncer(configMaps corev1client.ConfigMapInterface, config serverConfig, puller artifactPuller, logger logrus.FieldLogger) (*pluginArtifactSyncer, error) {
+	s := &pluginArtifactSyncer{
+		configMaps: configMaps,
+		name:       config.pluginArtifactsConfigMap,
+		pluginDir:  config.pluginDir,
+		puller:     puller,
+		logger:     logger,
+		static:     make(map[string]bool),
+		pulled:     make(map[string]string),
+	}
+
+	for _, ref := range config.pluginArtifacts {
+		parsed, err := artifact.ParseReference(ref)
+		if err != nil {
+			return nil, err
+		}
+		s.static[pluginArtifactDir(config.pluginDir, parsed)] = true
+	}
+
+	return s, nil
+}
+
+// sync pulls the artifacts listed in the config map that haven't been pulled yet, and
+// removes the directories of the ones that are no longer listed. An artifact that can't
+// be pulled doesn't stop the others from being pulled, and is tried again the next time.
+func (s *pluginArtifactSyncer) sync() error {
+	configMap, err := s.configMaps.Get(context.TODO(), s.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		configMap = &corev1api.ConfigMap{}
+	} else if err != nil {
+		return errors.Wrapf(err, "error getting plugin artifacts config map %s", s.name)
+	}
+
+	var errs []error
+
+	listed := make(map[string]bool)
+	for _, value := range configMap.Data {
+		ref, err := artifact.ParseReference(strings.TrimSpace(value))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		listed[ref.String()] = true
+
+		if _, pulled := s.pulled[ref.String()]; pulled {
+			continue
+		}
+
+		dir := pluginArtifactDir(s.pluginDir, ref)
+		files, err := s.puller.Pull(ref, dir)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		s.pulled[ref.String()] = dir
+
+		s.logger.WithField("artifact", ref.String()).Infof("Pulled %d plugin executables into %s", len(files), dir)
+	}
+
+	// artifacts of the same repository share a directory, which is kept while any of them
+	// is still listed.
+	inUse := make(map[string]bool)
+	for dir := range s.static {
+		inUse[dir] = true
+	}
+	for ref, dir := range s.pulled {
+		if listed[ref] {
+			inUse[dir] = true
+		}
+	}
+
+	for ref, dir := range s.pulled {
+		if listed[ref] {
+			continue
+		}
+		delete(s.pulled, ref)
+
+		if inUse[dir] {
+			continue
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			errs = append(errs, errors.WithStack(err))
+			continue
+		}
+
+		s.logger.WithField("artifact", ref).Infof("Removed plugin executables from %s", dir)
+	}
+
+	return kubeerrs.NewAggregate(errs)
+}
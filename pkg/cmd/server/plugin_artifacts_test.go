@@ -0,0 +1,129 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/artifact"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+const (
+	digest1 = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	digest2 = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+)
+
+// fakeArtifactPuller writes a file named after the artifact's digest, and fails to pull
+// the artifacts in fail.
+type fakeArtifactPuller struct {
+	pulled []string
+	fail   map[string]bool
+}
+
+func (p *fakeArtifactPuller) Pull(ref artifact.Reference, dir string) ([]string, error) {
+	if p.fail[ref.String()] {
+		return nil, errors.Errorf("error pulling %s", ref)
+	}
+	p.pulled = append(p.pulled, ref.String())
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	file := filepath.Join(dir, ref.Digest[len("sha256:"):len("sha256:")+4])
+	return []string{file}, ioutil.WriteFile(file, nil, 0755)
+}
+
+func TestPluginArtifactSyncer(t *testing.T) {
+	pluginDir, err := ioutil.TempDir("", "plugins")
+	require.NoError(t, err)
+	defer os.RemoveAll(pluginDir)
+
+	kubeClient := kubefake.NewSimpleClientset()
+	configMaps := kubeClient.CoreV1().ConfigMaps("velero")
+	puller := &fakeArtifactPuller{fail: map[string]bool{"ghcr.io/example/broken@" + digest1: true}}
+
+	syncer, err := newPluginArtifactSyncer(configMaps, serverConfig{
+		pluginDir:                pluginDir,
+		pluginArtifacts:          []string{"ghcr.io/example/static@" + digest1},
+		pluginArtifactsConfigMap: "plugin-artifacts",
+	}, puller, velerotest.NewLogger())
+	require.NoError(t, err)
+
+	// without the config map, there's nothing to pull
+	require.NoError(t, syncer.sync())
+	assert.Empty(t, puller.pulled)
+
+	configMap := &corev1api.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "plugin-artifacts"},
+		Data: map[string]string{
+			"plugin":  "ghcr.io/example/plugin@" + digest1,
+			"static":  "ghcr.io/example/static@" + digest2,
+			"broken":  "ghcr.io/example/broken@" + digest1,
+			"invalid": "ghcr.io/Example/plugin",
+		},
+	}
+	configMap, err = configMaps.Create(context.TODO(), configMap, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	// artifacts that can't be pulled don't stop the others from being pulled
+	err = syncer.sync()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error pulling ghcr.io/example/broken@"+digest1)
+	assert.Contains(t, err.Error(), `invalid repository "Example/plugin"`)
+	assert.ElementsMatch(t, []string{"ghcr.io/example/plugin@" + digest1, "ghcr.io/example/static@" + digest2}, puller.pulled)
+	assert.FileExists(t, filepath.Join(pluginDir, "ghcr.io", "example", "plugin", "1111"))
+
+	// artifacts that have been pulled aren't pulled again, and a changed artifact replaces
+	// the previous one in their repository's directory
+	puller.pulled = nil
+	configMap.Data = map[string]string{
+		"plugin": "ghcr.io/example/plugin@" + digest2,
+		"broken": "ghcr.io/example/broken@" + digest1,
+	}
+	configMap, err = configMaps.Update(context.TODO(), configMap, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.Error(t, syncer.sync())
+	assert.Equal(t, []string{"ghcr.io/example/plugin@" + digest2}, puller.pulled)
+	assert.FileExists(t, filepath.Join(pluginDir, "ghcr.io", "example", "plugin", "2222"))
+
+	// the directories of the --plugin-artifacts flag's artifacts aren't removed
+	assert.FileExists(t, filepath.Join(pluginDir, "ghcr.io", "example", "static", "2222"))
+
+	// removed artifacts are removed from the plugin directory
+	puller.fail = nil
+	configMap.Data = nil
+	_, err = configMaps.Update(context.TODO(), configMap, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, syncer.sync())
+	_, err = os.Stat(filepath.Join(pluginDir, "ghcr.io", "example", "plugin"))
+	assert.True(t, os.IsNotExist(err))
+	assert.Empty(t, syncer.pulled)
+}
@@ -18,12 +18,16 @@ package server
 
 import (
 	"context"
+	"crypto"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"net/http/pprof"
 	"os"
+	"path/filepath"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -31,6 +35,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -42,8 +47,10 @@ import (
 	"k8s.io/client-go/dynamic"
 	corev1informers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/v2/pkg/apis/volumesnapshot/v1beta1"
 	snapshotv1beta1client "github.com/kubernetes-csi/external-snapshotter/v2/pkg/client/clientset/versioned"
@@ -61,19 +68,23 @@ import (
 	velerodiscovery "github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+	veleroscheme "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/scheme"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/artifact"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/storage"
 	"github.com/vmware-tanzu/velero/internal/util/managercontroller"
 	"github.com/vmware-tanzu/velero/internal/velero"
@@ -84,10 +95,18 @@ const (
 	// the port where prometheus metrics are exposed
 	defaultMetricsAddress = ":8085"
 
+	// the port where the downloads of backup storage locations whose
+	// download mode is Proxy are served
+	defaultDownloadProxyAddress = ":8086"
+
 	defaultBackupSyncPeriod           = time.Minute
 	defaultStoreValidationFrequency   = time.Minute
 	defaultPodVolumeOperationTimeout  = 240 * time.Minute
 	defaultResourceTerminatingTimeout = 10 * time.Minute
+	defaultOrphanedObjectGCPeriod     = 24 * time.Hour
+	defaultItemOperationSyncFrequency = 10 * time.Second
+	defaultPluginHealthCheckInterval  = time.Minute
+	defaultPluginRescanPeriod         = time.Minute
 
 	// server's client default qps and burst
 	defaultClientQPS   float32 = 20.0
@@ -95,12 +114,23 @@ const (
 
 	defaultProfilerAddress = "localhost:6060"
 
+	// the directory that backup storage locations' credentials are written to
+	defaultCredentialsDirectory = "/tmp/credentials"
+
+	// the ConfigMap in the server's namespace that the cluster name is read from,
+	// under the clusterNameKey key, if the --cluster-name flag isn't set
+	clusterInfoConfigMap = "velero-cluster-info"
+	clusterNameKey       = "clusterName"
+
 	// keys used to map out available controllers with disable-controllers flag
 	BackupControllerKey              = "backup"
 	BackupSyncControllerKey          = "backup-sync"
 	ScheduleControllerKey            = "schedule"
 	GcControllerKey                  = "gc"
 	BackupDeletionControllerKey      = "backup-deletion"
+	BackupReplicationControllerKey   = "backup-replication"
+	OrphanedObjectGCControllerKey    = "orphaned-object-gc"
+	BackupOperationsControllerKey    = "backup-operations"
 	RestoreControllerKey             = "restore"
 	DownloadRequestControllerKey     = "download-request"
 	ResticRepoControllerKey          = "restic-repo"
@@ -118,6 +148,9 @@ var disableControllerList = []string{
 	ScheduleControllerKey,
 	GcControllerKey,
 	BackupDeletionControllerKey,
+	BackupReplicationControllerKey,
+	OrphanedObjectGCControllerKey,
+	BackupOperationsControllerKey,
 	RestoreControllerKey,
 	DownloadRequestControllerKey,
 	ResticRepoControllerKey,
@@ -128,16 +161,28 @@ type serverConfig struct {
 	pluginDir, metricsAddress, defaultBackupLocation                        string
 	backupSyncPeriod, podVolumeOperationTimeout, resourceTerminatingTimeout time.Duration
 	defaultBackupTTL, storeValidationFrequency                              time.Duration
-	restoreResourcePriorities                                               []string
+	restoreResourcePriorities, restoreResourceLowPriorities                 []string
 	defaultVolumeSnapshotLocations                                          map[string]string
 	restoreOnly                                                             bool
 	disabledControllers                                                     []string
 	clientQPS                                                               float32
 	clientBurst                                                             int
 	profilerAddress                                                         string
+	restoreWebhookAddress, restoreWebhookCertFile, restoreWebhookKeyFile    string
 	formatFlag                                                              *logging.FormatFlag
 	defaultResticMaintenanceFrequency                                       time.Duration
-	defaultVolumesToRestic                                                  bool
+	defaultResticCheckFrequency                                             time.Duration
+	defaultVolumesToFsBackup                                                bool
+	clusterName                                                             string
+	orphanedObjectGCPeriod                                                  time.Duration
+	orphanedObjectGCDryRun                                                  bool
+	itemOperationSyncFrequency                                              time.Duration
+	downloadProxyAddress                                                    string
+	uploaderType                                                            string
+	pluginHealthCheckInterval, pluginTimeout, pluginRescanPeriod            time.Duration
+	pluginTimeouts                                                          map[string]time.Duration
+	pluginArtifacts                                                         []string
+	pluginArtifactPublicKey, pluginArtifactsConfigMap                       string
 }
 
 type controllerRunInfo struct {
@@ -148,6 +193,9 @@ type controllerRunInfo struct {
 func NewCommand(f client.Factory) *cobra.Command {
 	var (
 		volumeSnapshotLocations = flag.NewMap().WithKeyValueDelimiter(":")
+		repositoryKeyProvider   string
+		keyProviderConfig       = flag.NewMap()
+		pluginTimeouts          = flag.NewMap()
 		logLevelFlag            = logging.LogLevelFlag(logrus.InfoLevel)
 		config                  = serverConfig{
 			pluginDir:                         "/plugins",
@@ -165,7 +213,15 @@ func NewCommand(f client.Factory) *cobra.Command {
 			resourceTerminatingTimeout:        defaultResourceTerminatingTimeout,
 			formatFlag:                        logging.NewFormatFlag(),
 			defaultResticMaintenanceFrequency: restic.DefaultMaintenanceFrequency,
-			defaultVolumesToRestic:            restic.DefaultVolumesToRestic,
+			defaultResticCheckFrequency:       restic.DefaultCheckFrequency,
+			defaultVolumesToFsBackup:          restic.DefaultVolumesToRestic,
+			orphanedObjectGCPeriod:            defaultOrphanedObjectGCPeriod,
+			orphanedObjectGCDryRun:            true,
+			itemOperationSyncFrequency:        defaultItemOperationSyncFrequency,
+			downloadProxyAddress:              defaultDownloadProxyAddress,
+			uploaderType:                      string(restic.DefaultUploaderType),
+			pluginHealthCheckInterval:         defaultPluginHealthCheckInterval,
+			pluginRescanPeriod:                defaultPluginRescanPeriod,
 		}
 	)
 
@@ -201,6 +257,15 @@ func NewCommand(f client.Factory) *cobra.Command {
 				config.defaultVolumeSnapshotLocations = volumeSnapshotLocations.Data()
 			}
 
+			cmd.CheckError(restic.ValidateUploaderType(config.uploaderType))
+
+			keyProvider, err := restic.NewKeyProvider(repositoryKeyProvider, keyProviderConfig.Data())
+			cmd.CheckError(err)
+			restic.SetKeyProvider(keyProvider)
+
+			config.pluginTimeouts, err = parsePluginTimeouts(pluginTimeouts.Data())
+			cmd.CheckError(err)
+
 			f.SetBasename(fmt.Sprintf("%s-%s", c.Parent().Name(), c.Name()))
 
 			s, err := newServer(f, config, logger)
@@ -213,22 +278,44 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().Var(logLevelFlag, "log-level", fmt.Sprintf("The level at which to log. Valid values are %s.", strings.Join(logLevelFlag.AllowedValues(), ", ")))
 	command.Flags().Var(config.formatFlag, "log-format", fmt.Sprintf("The format for log output. Valid values are %s.", strings.Join(config.formatFlag.AllowedValues(), ", ")))
 	command.Flags().StringVar(&config.pluginDir, "plugin-dir", config.pluginDir, "Directory containing Velero plugins")
+	command.Flags().StringSliceVar(&config.pluginArtifacts, "plugin-artifacts", config.pluginArtifacts, "Plugins to pull into the plugin directory at startup, as references to OCI artifacts in the form [registry/]repository[:tag][@digest]. Artifacts must be pinned by digest unless --plugin-artifact-public-key is set.")
+	command.Flags().StringVar(&config.pluginArtifactPublicKey, "plugin-artifact-public-key", config.pluginArtifactPublicKey, "Path to a PEM-encoded public key that the signatures of plugin artifacts are verified with. Optional. If not set, signatures aren't verified.")
+	command.Flags().StringVar(&config.pluginArtifactsConfigMap, "plugin-artifacts-config-map", config.pluginArtifactsConfigMap, "Name of a config map in the Velero namespace whose values are plugins to pull into the plugin directory, like --plugin-artifacts, each time it's scanned. Plugins removed from it are removed from the plugin directory. Optional.")
 	command.Flags().StringVar(&config.metricsAddress, "metrics-address", config.metricsAddress, "The address to expose prometheus metrics")
 	command.Flags().DurationVar(&config.backupSyncPeriod, "backup-sync-period", config.backupSyncPeriod, "How often to ensure all Velero backups in object storage exist as Backup API objects in the cluster. This is the default sync period if none is explicitly specified for a backup storage location.")
 	command.Flags().DurationVar(&config.podVolumeOperationTimeout, "restic-timeout", config.podVolumeOperationTimeout, "How long backups/restores of pod volumes should be allowed to run before timing out.")
 	command.Flags().BoolVar(&config.restoreOnly, "restore-only", config.restoreOnly, "Run in a mode where only restores are allowed; backups, schedules, and garbage-collection are all disabled. DEPRECATED: this flag will be removed in v2.0. Use read-only backup storage locations instead.")
 	command.Flags().StringSliceVar(&config.disabledControllers, "disable-controllers", config.disabledControllers, fmt.Sprintf("List of controllers to disable on startup. Valid values are %s", strings.Join(disableControllerList, ",")))
 	command.Flags().StringSliceVar(&config.restoreResourcePriorities, "restore-resource-priorities", config.restoreResourcePriorities, "Desired order of resource restores; any resource not in the list will be restored alphabetically after the prioritized resources.")
-	command.Flags().StringVar(&config.defaultBackupLocation, "default-backup-storage-location", config.defaultBackupLocation, "Name of the default backup storage location.")
+	command.Flags().StringSliceVar(&config.restoreResourceLowPriorities, "restore-resource-low-priorities", config.restoreResourceLowPriorities, "Desired order of resource restores for resources that should be restored last, after all other resources. Resources must be specified as they appear in the backup, e.g. pods or deployments.apps.")
+	command.Flags().StringVar(&config.defaultBackupLocation, "default-backup-storage-location", config.defaultBackupLocation, "Name of the backup storage location to use as the default when no backup storage location has spec.default set.")
 	command.Flags().DurationVar(&config.storeValidationFrequency, "store-validation-frequency", config.storeValidationFrequency, "How often to verify if the storage is valid. Optional. Set this to `0s` to disable sync. Default 1 minute.")
 	command.Flags().Var(&volumeSnapshotLocations, "default-volume-snapshot-locations", "List of unique volume providers and default volume snapshot location (provider1:location-01,provider2:location-02,...)")
 	command.Flags().Float32Var(&config.clientQPS, "client-qps", config.clientQPS, "Maximum number of requests per second by the server to the Kubernetes API once the burst limit has been reached.")
 	command.Flags().IntVar(&config.clientBurst, "client-burst", config.clientBurst, "Maximum number of requests by the server to the Kubernetes API in a short period of time.")
 	command.Flags().StringVar(&config.profilerAddress, "profiler-address", config.profilerAddress, "The address to expose the pprof profiler.")
+	command.Flags().StringVar(&config.restoreWebhookAddress, "restore-authorization-webhook-address", config.restoreWebhookAddress, "The address to serve the validating admission webhook that rejects restores whose creator isn't allowed to create the restored items. If not specified, the webhook isn't served.")
+	command.Flags().StringVar(&config.restoreWebhookCertFile, "restore-authorization-webhook-cert-file", config.restoreWebhookCertFile, "Path to the TLS certificate for the restore authorization webhook.")
+	command.Flags().StringVar(&config.restoreWebhookKeyFile, "restore-authorization-webhook-key-file", config.restoreWebhookKeyFile, "Path to the TLS private key for the restore authorization webhook.")
 	command.Flags().DurationVar(&config.resourceTerminatingTimeout, "terminating-resource-timeout", config.resourceTerminatingTimeout, "How long to wait on persistent volumes and namespaces to terminate during a restore before timing out.")
 	command.Flags().DurationVar(&config.defaultBackupTTL, "default-backup-ttl", config.defaultBackupTTL, "How long to wait by default before backups can be garbage collected.")
 	command.Flags().DurationVar(&config.defaultResticMaintenanceFrequency, "default-restic-prune-frequency", config.defaultResticMaintenanceFrequency, "How often 'restic prune' is run for restic repositories by default.")
-	command.Flags().BoolVar(&config.defaultVolumesToRestic, "default-volumes-to-restic", config.defaultVolumesToRestic, "Backup all volumes with restic by default.")
+	command.Flags().DurationVar(&config.defaultResticCheckFrequency, "default-restic-check-frequency", config.defaultResticCheckFrequency, "How often 'restic check' is run for new restic repositories by default. Set this to `0s` to only check repositories on request.")
+	command.Flags().BoolVar(&config.defaultVolumesToFsBackup, "default-volumes-to-fs-backup", config.defaultVolumesToFsBackup, "Backup all pod volumes with file system backup by default, unless a pod opts them out with the backup.velero.io/backup-volumes-excludes annotation.")
+	command.Flags().BoolVar(&config.defaultVolumesToFsBackup, "default-volumes-to-restic", config.defaultVolumesToFsBackup, "Backup all volumes with restic by default.")
+	command.Flags().MarkDeprecated("default-volumes-to-restic", "use --default-volumes-to-fs-backup instead")
+	command.Flags().StringVar(&config.uploaderType, "uploader-type", config.uploaderType, "The uploader that backs up pod volumes to backup storage locations that don't choose one, restic or kopia.")
+	command.Flags().StringVar(&repositoryKeyProvider, "repository-key-provider", repositoryKeyProvider, "The key provider that wraps the encryption keys of new restic repositories, and of repositories whose key is rotated, one of secret, aws-kms or exec. Optional. Default: new repositories share the key of the velero-restic-credentials secret.")
+	command.Flags().Var(&keyProviderConfig, "repository-key-provider-config", "Configuration of the --repository-key-provider, such as keyId=<AWS KMS key ID> for aws-kms, or command=<path> for exec.")
+	command.Flags().DurationVar(&config.orphanedObjectGCPeriod, "orphaned-object-gc-period", config.orphanedObjectGCPeriod, "How often to look for objects in backup storage locations that don't belong to any backup, such as the remains of interrupted uploads and deletions. Set this to `0s` to disable it.")
+	command.Flags().BoolVar(&config.orphanedObjectGCDryRun, "orphaned-object-gc-dry-run", config.orphanedObjectGCDryRun, "Only log the orphaned objects found in backup storage locations, instead of deleting them.")
+	command.Flags().DurationVar(&config.itemOperationSyncFrequency, "item-operation-sync-frequency", config.itemOperationSyncFrequency, "How often to check on the item operations, like volume snapshots that are still being uploaded, of backups that are waiting for them.")
+	command.Flags().StringVar(&config.downloadProxyAddress, "download-proxy-address", config.downloadProxyAddress, "The address to serve the downloads of backup storage locations whose download mode is Proxy at. Set this to an empty string to not serve them.")
+	command.Flags().DurationVar(&config.pluginHealthCheckInterval, "plugin-health-check-interval", config.pluginHealthCheckInterval, "How often plugin processes are health checked, and restarted if they're unresponsive. Set this to `0s` to disable health checks.")
+	command.Flags().DurationVar(&config.pluginTimeout, "plugin-timeout", config.pluginTimeout, "How long a call to a plugin can take before its process is restarted, for plugins that don't have a timeout in --plugin-timeouts. Optional. Default: calls to plugins aren't timed out.")
+	command.Flags().DurationVar(&config.pluginRescanPeriod, "plugin-rescan-period", config.pluginRescanPeriod, "How often the plugin directory is scanned for plugins that have been added, changed or removed since the server started. Set this to `0s` to only scan it when the server starts.")
+	command.Flags().Var(&pluginTimeouts, "plugin-timeouts", "How long calls to the named plugins can take before their processes are restarted, such as velero.io/aws=10m. Overrides --plugin-timeout. Set a plugin's timeout to `0s` to not time out calls to it.")
+	command.Flags().StringVar(&config.clusterName, "cluster-name", config.clusterName, fmt.Sprintf("The name of the cluster that Velero runs in, which schedules' backup name templates and backup storage locations' prefixes can include as {{.ClusterName}}. If not set, it's read from the %q key of the %q ConfigMap in the server's namespace, if it exists.", clusterNameKey, clusterInfoConfigMap))
 
 	return command
 }
@@ -250,6 +337,8 @@ type server struct {
 	logger                              logrus.FieldLogger
 	logLevel                            logrus.Level
 	pluginRegistry                      clientmgmt.Registry
+	pluginProcesses                     *clientmgmt.ProcessStatuses
+	pluginArtifacts                     *pluginArtifactSyncer
 	resticManager                       restic.RepositoryManager
 	metrics                             *metrics.ServerMetrics
 	config                              serverConfig
@@ -282,6 +371,27 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		return nil, err
 	}
 
+	puller, err := newPluginArtifactPuller(config, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := pullPluginArtifacts(config, puller, logger); err != nil {
+		return nil, err
+	}
+
+	var pluginArtifacts *pluginArtifactSyncer
+	if config.pluginArtifactsConfigMap != "" {
+		if pluginArtifacts, err = newPluginArtifactSyncer(kubeClient.CoreV1().ConfigMaps(f.Namespace()), config, puller, logger); err != nil {
+			return nil, err
+		}
+		// the config map's artifacts are added at runtime, so ones that can't be pulled don't
+		// stop the server from starting, and are tried again when the plugins are rescanned.
+		if err := pluginArtifacts.sync(); err != nil {
+			logger.WithError(err).Error("Error pulling plugin artifacts")
+		}
+	}
+
 	pluginRegistry := clientmgmt.NewRegistry(config.pluginDir, logger, logger.Level)
 	if err := pluginRegistry.DiscoverPlugins(); err != nil {
 		return nil, err
@@ -334,6 +444,8 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		logger:                              logger,
 		logLevel:                            logger.Level,
 		pluginRegistry:                      pluginRegistry,
+		pluginProcesses:                     clientmgmt.NewProcessStatuses(clock.RealClock{}),
+		pluginArtifacts:                     pluginArtifacts,
 		config:                              config,
 		mgr:                                 mgr,
 	}
@@ -363,6 +475,18 @@ func (s *server) run() error {
 		return err
 	}
 
+	if err := s.initClusterName(); err != nil {
+		return err
+	}
+
+	if s.config.restoreWebhookAddress != "" {
+		go s.runRestoreAuthorizationWebhook()
+	}
+
+	if s.config.pluginRescanPeriod > 0 {
+		go wait.Until(s.rescanPlugins, s.config.pluginRescanPeriod, s.ctx.Done())
+	}
+
 	if err := s.initRestic(); err != nil {
 		return err
 	}
@@ -387,6 +511,28 @@ func (s *server) namespaceExists(namespace string) error {
 	return nil
 }
 
+// initClusterName reads the cluster name from the cluster info ConfigMap if it wasn't
+// set with the --cluster-name flag, and makes it available to backup storage locations'
+// prefix templates.
+func (s *server) initClusterName() error {
+	if s.config.clusterName == "" {
+		configMap, err := s.kubeClient.CoreV1().ConfigMaps(s.namespace).Get(s.ctx, clusterInfoConfigMap, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			s.logger.Debugf("ConfigMap %s not found, not setting a cluster name", clusterInfoConfigMap)
+		case err != nil:
+			return errors.Wrapf(err, "error getting ConfigMap %s", clusterInfoConfigMap)
+		default:
+			s.config.clusterName = configMap.Data[clusterNameKey]
+		}
+	}
+
+	s.logger.WithField("clusterName", s.config.clusterName).Info("Setting cluster name")
+	persistence.SetClusterName(s.config.clusterName)
+
+	return nil
+}
+
 // initDiscoveryHelper instantiates the server's discovery helper and spawns a
 // goroutine to call Refresh() every 5 minutes.
 func (s *server) initDiscoveryHelper() error {
@@ -502,6 +648,10 @@ func (s *server) initRestic() error {
 		return err
 	}
 
+	if err := restic.ConvertResticRepositories(s.ctx, s.veleroClient.VeleroV1(), s.namespace, s.logger); err != nil {
+		return err
+	}
+
 	// use a stand-alone secrets informer so we can filter to only the restic credentials
 	// secret(s) within the velero namespace
 	//
@@ -524,11 +674,14 @@ func (s *server) initRestic() error {
 		s.namespace,
 		s.veleroClient,
 		secretsInformer,
-		s.sharedInformerFactory.Velero().V1().ResticRepositories(),
+		s.sharedInformerFactory.Velero().V1().BackupRepositories(),
 		s.veleroClient.VeleroV1(),
 		s.mgr.GetClient(),
 		s.kubeClient.CoreV1(),
 		s.kubeClient.CoreV1(),
+		s.kubeClient.CoreV1(),
+		s.kubeClient.CoreV1(),
+		velerov1api.UploaderType(s.config.uploaderType),
 		s.logger,
 	)
 	if err != nil {
@@ -567,6 +720,16 @@ func (s *server) getCSISnapshotListers() (snapshotv1beta1listers.VolumeSnapshotL
 	return vsLister, vscLister
 }
 
+// eventRecorder returns a recorder for events about Velero resources, such as
+// Schedules, that are reported as coming from the given component.
+func (s *server) eventRecorder(component string) record.EventRecorder {
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartLogging(s.logger.Debugf)
+	broadcaster.StartRecordingToSink(&corev1client.EventSinkImpl{Interface: s.kubeClient.CoreV1().Events("")})
+
+	return broadcaster.NewRecorder(veleroscheme.Scheme, corev1api.EventSource{Component: "velero-" + component})
+}
+
 func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string) error {
 	s.logger.Info("Starting controllers")
 
@@ -585,9 +748,36 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	// Initialize manual backup metrics
 	s.metrics.InitSchedule("")
 
+	supervision := clientmgmt.WithProcessSupervision(clientmgmt.ProcessSupervision{
+		HealthCheckInterval: s.config.pluginHealthCheckInterval,
+		DefaultTimeout:      s.config.pluginTimeout,
+		Timeouts:            s.config.pluginTimeouts,
+		Statuses:            s.pluginProcesses,
+	})
 	newPluginManager := func(logger logrus.FieldLogger) clientmgmt.Manager {
-		return clientmgmt.NewManager(logger, s.logLevel, s.pluginRegistry)
+		return clientmgmt.NewManager(
+			logger,
+			s.logLevel,
+			s.pluginRegistry,
+			supervision,
+			clientmgmt.WithMetrics(s.metrics),
+			clientmgmt.WithPluginConfig(s.kubeClient.CoreV1().ConfigMaps(s.namespace)),
+		)
 	}
+
+	credentialFileStore, err := credentials.NewNamespacedFileStore(
+		s.kubeClient.CoreV1(),
+		s.namespace,
+		defaultCredentialsDirectory,
+		filesystem.NewFileSystem(),
+	)
+	if err != nil {
+		return err
+	}
+
+	// the volume snapshot operations of all controllers count towards their location's limit.
+	snapshotLimiter := clientmgmt.NewSnapshotLimiter(s.logger)
+
 	csiVSLister, csiVSCLister := s.getCSISnapshotListers()
 
 	backupSyncControllerRunInfo := func() controllerRunInfo {
@@ -602,6 +792,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.kubeClient,
 			s.config.defaultBackupLocation,
 			newPluginManager,
+			credentialFileStore,
+			s.metrics,
 			s.logger,
 		)
 
@@ -621,7 +813,9 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
 			s.resticManager,
 			s.config.podVolumeOperationTimeout,
-			s.config.defaultVolumesToRestic,
+			s.config.defaultVolumesToFsBackup,
+			credentialFileStore,
+			snapshotLimiter,
 		)
 		cmd.CheckError(err)
 
@@ -633,10 +827,12 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.logger,
 			s.logLevel,
 			newPluginManager,
+			credentialFileStore,
 			backupTracker,
 			s.mgr.GetClient(),
+			s.kubeClient.CoreV1(),
 			s.config.defaultBackupLocation,
-			s.config.defaultVolumesToRestic,
+			s.config.defaultVolumesToFsBackup,
 			s.config.defaultBackupTTL,
 			s.sharedInformerFactory.Velero().V1().VolumeSnapshotLocations().Lister(),
 			defaultVolumeSnapshotLocations,
@@ -655,9 +851,13 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	scheduleControllerRunInfo := func() controllerRunInfo {
 		scheduleController := controller.NewScheduleController(
 			s.namespace,
+			s.config.clusterName,
 			s.veleroClient.VeleroV1(),
 			s.veleroClient.VeleroV1(),
 			s.sharedInformerFactory.Velero().V1().Schedules(),
+			s.sharedInformerFactory.Velero().V1().Backups().Lister(),
+			s.mgr.GetClient(),
+			s.eventRecorder("schedule-controller"),
 			s.logger,
 			s.metrics,
 		)
@@ -672,9 +872,15 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		gcController := controller.NewGCController(
 			s.logger,
 			s.sharedInformerFactory.Velero().V1().Backups(),
+			s.veleroClient.VeleroV1(),
+			s.sharedInformerFactory.Velero().V1().Schedules().Lister(),
 			s.sharedInformerFactory.Velero().V1().DeleteBackupRequests().Lister(),
 			s.veleroClient.VeleroV1(),
+			s.sharedInformerFactory.Velero().V1().VolumeSnapshotLocations().Lister(),
 			s.mgr.GetClient(),
+			newPluginManager,
+			credentialFileStore,
+			snapshotLimiter,
 		)
 
 		return controllerRunInfo{
@@ -700,6 +906,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			csiVSCLister,
 			s.csiSnapshotClient,
 			newPluginManager,
+			credentialFileStore,
+			snapshotLimiter,
 			s.metrics,
 			s.discoveryHelper,
 		)
@@ -710,18 +918,80 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	replicationControllerRunInfo := func() controllerRunInfo {
+		replicationController := controller.NewBackupReplicationController(
+			s.logger,
+			s.sharedInformerFactory.Velero().V1().Backups(),
+			s.veleroClient.VeleroV1(),
+			s.mgr.GetClient(),
+			newPluginManager,
+			credentialFileStore,
+		)
+
+		return controllerRunInfo{
+			controller: replicationController,
+			numWorkers: defaultControllerWorkers,
+		}
+	}
+
+	orphanedObjectGCControllerRunInfo := func() controllerRunInfo {
+		orphanedObjectGCController := controller.NewOrphanedObjectGCController(
+			s.logger,
+			s.namespace,
+			s.mgr.GetClient(),
+			s.sharedInformerFactory.Velero().V1().Backups(),
+			s.config.orphanedObjectGCPeriod,
+			s.config.orphanedObjectGCDryRun,
+			newPluginManager,
+			credentialFileStore,
+		)
+
+		return controllerRunInfo{
+			controller: orphanedObjectGCController,
+			numWorkers: defaultControllerWorkers,
+		}
+	}
+
+	backupOperationsControllerRunInfo := func() controllerRunInfo {
+		backupOperationsController := controller.NewBackupOperationsController(
+			s.logger,
+			s.namespace,
+			s.mgr.GetClient(),
+			s.veleroClient.VeleroV1(),
+			s.sharedInformerFactory.Velero().V1().Backups(),
+			csiVSLister,
+			s.sharedInformerFactory.Velero().V1().VolumeSnapshotLocations().Lister(),
+			snapshotLimiter,
+			s.config.itemOperationSyncFrequency,
+			s.metrics,
+			newPluginManager,
+			credentialFileStore,
+		)
+
+		return controllerRunInfo{
+			controller: backupOperationsController,
+			numWorkers: defaultControllerWorkers,
+		}
+	}
+
 	restoreControllerRunInfo := func() controllerRunInfo {
 		restorer, err := restore.NewKubernetesRestorer(
 			s.discoveryHelper,
 			client.NewDynamicFactory(s.dynamicClient),
-			s.config.restoreResourcePriorities,
+			restore.Priorities{
+				HighPriorities: s.config.restoreResourcePriorities,
+				LowPriorities:  s.config.restoreResourceLowPriorities,
+			},
 			s.kubeClient.CoreV1().Namespaces(),
+			s.kubeClient.StorageV1().StorageClasses(),
 			s.resticManager,
 			s.config.podVolumeOperationTimeout,
 			s.config.resourceTerminatingTimeout,
 			s.logger,
 			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
 			s.kubeClient.CoreV1().RESTClient(),
+			credentialFileStore,
+			snapshotLimiter,
 		)
 		cmd.CheckError(err)
 
@@ -733,10 +1003,12 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			restorer,
 			s.sharedInformerFactory.Velero().V1().Backups().Lister(),
 			s.mgr.GetClient(),
+			s.kubeClient.CoreV1(),
 			s.sharedInformerFactory.Velero().V1().VolumeSnapshotLocations().Lister(),
 			s.logger,
 			s.logLevel,
 			newPluginManager,
+			credentialFileStore,
 			s.config.defaultBackupLocation,
 			s.metrics,
 			s.config.formatFlag.Parse(),
@@ -749,13 +1021,18 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	}
 
 	resticRepoControllerRunInfo := func() controllerRunInfo {
-		resticRepoController := controller.NewResticRepositoryController(
+		resticRepoController := controller.NewBackupRepositoryController(
 			s.logger,
-			s.sharedInformerFactory.Velero().V1().ResticRepositories(),
+			s.sharedInformerFactory.Velero().V1().BackupRepositories(),
 			s.veleroClient.VeleroV1(),
 			s.mgr.GetClient(),
 			s.resticManager,
 			s.config.defaultResticMaintenanceFrequency,
+			s.config.defaultResticCheckFrequency,
+			newPluginManager,
+			credentialFileStore,
+			s.eventRecorder("restic-repository-controller"),
+			s.metrics,
 		)
 
 		return controllerRunInfo{
@@ -765,6 +1042,32 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	}
 
 	downloadrequestControllerRunInfo := func() controllerRunInfo {
+		var (
+			downloadProxyPod  string
+			downloadProxyPort int32
+		)
+		if s.config.downloadProxyAddress != "" {
+			var err error
+			if downloadProxyPort, err = addressPort(s.config.downloadProxyAddress); err != nil {
+				s.logger.WithError(err).Fatalf("Invalid value for --download-proxy-address flag provided: %s", s.config.downloadProxyAddress)
+			}
+			// a pod's hostname is its name, which is how clients reach
+			// the download proxy through the API server.
+			if downloadProxyPod, err = os.Hostname(); err != nil {
+				s.logger.WithError(errors.WithStack(err)).Fatal("Error getting the server's pod name")
+			}
+
+			go s.runDownloadProxy(controller.NewDownloadProxy(
+				s.sharedInformerFactory.Velero().V1().DownloadRequests().Lister(),
+				s.sharedInformerFactory.Velero().V1().Restores().Lister(),
+				s.sharedInformerFactory.Velero().V1().Backups().Lister(),
+				s.mgr.GetClient(),
+				newPluginManager,
+				credentialFileStore,
+				s.logger,
+			))
+		}
+
 		downloadRequestController := controller.NewDownloadRequestController(
 			s.veleroClient.VeleroV1(),
 			s.sharedInformerFactory.Velero().V1().DownloadRequests(),
@@ -772,6 +1075,9 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.mgr.GetClient(),
 			s.sharedInformerFactory.Velero().V1().Backups().Lister(),
 			newPluginManager,
+			credentialFileStore,
+			downloadProxyPod,
+			downloadProxyPort,
 			s.logger,
 		)
 
@@ -782,26 +1088,32 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	}
 
 	enabledControllers := map[string]func() controllerRunInfo{
-		BackupSyncControllerKey:      backupSyncControllerRunInfo,
-		BackupControllerKey:          backupControllerRunInfo,
-		ScheduleControllerKey:        scheduleControllerRunInfo,
-		GcControllerKey:              gcControllerRunInfo,
-		BackupDeletionControllerKey:  deletionControllerRunInfo,
-		RestoreControllerKey:         restoreControllerRunInfo,
-		ResticRepoControllerKey:      resticRepoControllerRunInfo,
-		DownloadRequestControllerKey: downloadrequestControllerRunInfo,
+		BackupSyncControllerKey:        backupSyncControllerRunInfo,
+		BackupControllerKey:            backupControllerRunInfo,
+		ScheduleControllerKey:          scheduleControllerRunInfo,
+		GcControllerKey:                gcControllerRunInfo,
+		BackupDeletionControllerKey:    deletionControllerRunInfo,
+		BackupReplicationControllerKey: replicationControllerRunInfo,
+		OrphanedObjectGCControllerKey:  orphanedObjectGCControllerRunInfo,
+		BackupOperationsControllerKey:  backupOperationsControllerRunInfo,
+		RestoreControllerKey:           restoreControllerRunInfo,
+		ResticRepoControllerKey:        resticRepoControllerRunInfo,
+		DownloadRequestControllerKey:   downloadrequestControllerRunInfo,
 	}
 	// Note: all runtime type controllers that can be disabled are grouped separately, below:
 	enabledRuntimeControllers := make(map[string]struct{})
 	enabledRuntimeControllers[ServerStatusRequestControllerKey] = struct{}{}
 
 	if s.config.restoreOnly {
-		s.logger.Info("Restore only mode - not starting the backup, schedule, delete-backup, or GC controllers")
+		s.logger.Info("Restore only mode - not starting the backup, schedule, delete-backup, backup-replication, orphaned-object-gc, backup-operations, or GC controllers")
 		s.config.disabledControllers = append(s.config.disabledControllers,
 			BackupControllerKey,
 			ScheduleControllerKey,
 			GcControllerKey,
 			BackupDeletionControllerKey,
+			BackupReplicationControllerKey,
+			OrphanedObjectGCControllerKey,
+			BackupOperationsControllerKey,
 		)
 	}
 
@@ -821,6 +1133,11 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	if s.config.orphanedObjectGCPeriod <= 0 {
+		s.logger.Info("Orphaned object garbage collection period is 0, not starting the orphaned-object-gc controller")
+		delete(enabledControllers, OrphanedObjectGCControllerKey)
+	}
+
 	// Instantiate the enabled controllers. This needs to be done *before*
 	// the shared informer factory is started, because the controller
 	// constructors add event handlers to various informers, which should
@@ -859,7 +1176,9 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			StoreValidationFrequency: s.config.storeValidationFrequency,
 		},
 		NewPluginManager: newPluginManager,
-		NewBackupStore:   persistence.NewObjectBackupStore,
+		NewBackupStore:   persistence.NewObjectBackupStoreWithCredentials(credentialFileStore),
+		Metrics:          s.metrics,
+		EventRecorder:    s.eventRecorder("backup-storage-location-controller"),
 		Log:              s.logger,
 	}
 	if err := bslr.SetupWithManager(s.mgr); err != nil {
@@ -872,8 +1191,9 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			Client: s.mgr.GetClient(),
 			Ctx:    s.ctx,
 			ServerStatus: velero.ServerStatus{
-				PluginRegistry: s.pluginRegistry,
-				Clock:          clock.RealClock{},
+				PluginRegistry:  s.pluginRegistry,
+				PluginProcesses: s.pluginProcesses,
+				Clock:           clock.RealClock{},
 			},
 			Log: s.logger,
 		}
@@ -915,6 +1235,128 @@ func (s *server) runProfiler() {
 	}
 }
 
+// rescanPlugins registers the plugins added to the plugin directory since it was last scanned,
+// and unregisters the ones removed from it, after pulling and removing the artifacts of the
+// plugin artifacts config map.
+func (s *server) rescanPlugins() {
+	if s.pluginArtifacts != nil {
+		if err := s.pluginArtifacts.sync(); err != nil {
+			s.logger.WithError(err).Error("Error pulling plugin artifacts")
+		}
+	}
+
+	if err := s.pluginRegistry.DiscoverPlugins(); err != nil {
+		s.logger.WithError(err).Error("Error rescanning plugin directory")
+	}
+}
+
+// runRestoreAuthorizationWebhook serves the validating admission webhook that
+// checks restores against their creator's RBAC permissions.
+func (s *server) runRestoreAuthorizationWebhook() {
+	authorizer := restore.NewAuthorizer(
+		s.kubeClient.AuthorizationV1().SubjectAccessReviews(),
+		s.kubeClient.CoreV1().Namespaces(),
+		s.discoveryHelper,
+	)
+
+	mux := http.NewServeMux()
+	mux.Handle("/validate-restore", restore.NewAdmissionHandler(authorizer, s.logger))
+
+	s.logger.Infof("Starting restore authorization webhook at address [%s]", s.config.restoreWebhookAddress)
+	if err := http.ListenAndServeTLS(s.config.restoreWebhookAddress, s.config.restoreWebhookCertFile, s.config.restoreWebhookKeyFile, mux); err != nil {
+		s.logger.WithError(errors.WithStack(err)).Fatal("error running restore authorization webhook server")
+	}
+}
+
+// runDownloadProxy serves the downloads of backup storage locations whose
+// download mode is Proxy.
+func (s *server) runDownloadProxy(handler http.Handler) {
+	mux := http.NewServeMux()
+	mux.Handle(controller.DownloadProxyPathPrefix, handler)
+
+	s.logger.Infof("Starting download proxy at address [%s]", s.config.downloadProxyAddress)
+	if err := http.ListenAndServe(s.config.downloadProxyAddress, mux); err != nil {
+		s.logger.WithError(errors.WithStack(err)).Fatal("error running download proxy server")
+	}
+}
+
+// addressPort returns the port of a listen address such as ":8086".
+func addressPort(address string) (int32, error) {
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	res, err := strconv.ParseInt(port, 10, 32)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid port %q", port)
+	}
+
+	return int32(res), nil
+}
+
+// parsePluginTimeouts parses the --plugin-timeouts flag's plugin names and durations.
+func parsePluginTimeouts(timeouts map[string]string) (map[string]time.Duration, error) {
+	if len(timeouts) == 0 {
+		return nil, nil
+	}
+
+	res := make(map[string]time.Duration, len(timeouts))
+	for name, timeout := range timeouts {
+		duration, err := time.ParseDuration(timeout)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid timeout for plugin %s", name)
+		}
+		if duration < 0 {
+			return nil, errors.Errorf("invalid timeout for plugin %s: %s is negative", name, timeout)
+		}
+		res[name] = duration
+	}
+
+	return res, nil
+}
+
+// newPluginArtifactPuller returns the puller of plugin artifacts, which verifies their signatures
+// with the --plugin-artifact-public-key flag's key if it's set.
+func newPluginArtifactPuller(config serverConfig, logger logrus.FieldLogger) (*artifact.Puller, error) {
+	var publicKey crypto.PublicKey
+	if config.pluginArtifactPublicKey != "" {
+		var err error
+		if publicKey, err = artifact.LoadPublicKey(config.pluginArtifactPublicKey); err != nil {
+			return nil, err
+		}
+	}
+
+	return artifact.NewPuller(logger, publicKey), nil
+}
+
+// pullPluginArtifacts pulls the plugin artifacts named by the --plugin-artifacts flag into
+// their directories under the plugin directory.
+func pullPluginArtifacts(config serverConfig, puller artifactPuller, logger logrus.FieldLogger) error {
+	for _, s := range config.pluginArtifacts {
+		ref, err := artifact.ParseReference(s)
+		if err != nil {
+			return err
+		}
+
+		dir := pluginArtifactDir(config.pluginDir, ref)
+		files, err := puller.Pull(ref, dir)
+		if err != nil {
+			return err
+		}
+
+		logger.WithField("artifact", ref.String()).Infof("Pulled %d plugin executables into %s", len(files), dir)
+	}
+
+	return nil
+}
+
+// pluginArtifactDir returns the directory under the plugin directory that an artifact's plugin
+// executables are pulled into, which is named after its registry and repository.
+func pluginArtifactDir(pluginDir string, ref artifact.Reference) string {
+	return filepath.Join(pluginDir, ref.Registry, filepath.FromSlash(ref.Repository))
+}
+
 // CSIInformerFactoryWrapper is a proxy around the CSI SharedInformerFactory that checks the CSI feature flag before performing operations.
 type CSIInformerFactoryWrapper struct {
 	factory snapshotv1beta1informers.SharedInformerFactory
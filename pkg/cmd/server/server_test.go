@@ -18,8 +18,10 @@ package server
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -69,3 +71,53 @@ func TestVeleroResourcesExist(t *testing.T) {
 	veleroAPIResourceList.APIResources = veleroAPIResourceList.APIResources[:3]
 	assert.Error(t, server.veleroResourcesExist())
 }
+
+func TestAddressPort(t *testing.T) {
+	port, err := addressPort(":8086")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(8086), port)
+
+	port, err = addressPort("0.0.0.0:9000")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(9000), port)
+
+	_, err = addressPort("8086")
+	assert.Error(t, err)
+
+	_, err = addressPort(":http")
+	assert.Error(t, err)
+}
+
+func TestParsePluginTimeouts(t *testing.T) {
+	timeouts, err := parsePluginTimeouts(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, timeouts)
+
+	timeouts, err = parsePluginTimeouts(map[string]string{"velero.io/aws": "10m", "example.io/slow": "0s"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]time.Duration{"velero.io/aws": 10 * time.Minute, "example.io/slow": 0}, timeouts)
+
+	_, err = parsePluginTimeouts(map[string]string{"velero.io/aws": "soon"})
+	assert.Error(t, err)
+
+	_, err = parsePluginTimeouts(map[string]string{"velero.io/aws": "-1m"})
+	assert.EqualError(t, err, "invalid timeout for plugin velero.io/aws: -1m is negative")
+}
+
+func TestPullPluginArtifacts(t *testing.T) {
+	logger := velerotest.NewLogger()
+
+	puller, err := newPluginArtifactPuller(serverConfig{}, logger)
+	require.NoError(t, err)
+
+	assert.NoError(t, pullPluginArtifacts(serverConfig{pluginDir: "/plugins"}, puller, logger))
+
+	err = pullPluginArtifacts(serverConfig{pluginDir: "/plugins", pluginArtifacts: []string{"ghcr.io/Example/plugin"}}, puller, logger)
+	assert.EqualError(t, err, `invalid repository "Example/plugin" in plugin artifact reference "ghcr.io/Example/plugin"`)
+
+	err = pullPluginArtifacts(serverConfig{pluginDir: "/plugins", pluginArtifacts: []string{"ghcr.io/example/plugin:v1"}}, puller, logger)
+	assert.EqualError(t, err, "plugin artifact ghcr.io/example/plugin:v1 must be pinned by digest when signatures aren't verified")
+
+	_, err = newPluginArtifactPuller(serverConfig{pluginArtifactPublicKey: "/nonexistent/cosign.pub"}, logger)
+	assert.Error(t, err)
+}
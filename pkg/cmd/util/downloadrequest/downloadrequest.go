@@ -29,8 +29,10 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
@@ -40,7 +42,10 @@ import (
 // not found
 var ErrNotFound = errors.New("file not found")
 
-func Stream(client velerov1client.DownloadRequestsGetter, namespace, name string, kind v1.DownloadTargetKind, w io.Writer, timeout time.Duration, insecureSkipTLSVerify bool, caCertFile string) error {
+// Stream creates a DownloadRequest for the target and writes the target's contents to w,
+// fetching them from the request's pre-signed URL or, if the Velero server proxies the
+// download, from the server's pod through the Kubernetes API server.
+func Stream(client velerov1client.VeleroV1Interface, namespace, name string, kind v1.DownloadTargetKind, w io.Writer, timeout time.Duration, insecureSkipTLSVerify bool, caCertFile string) error {
 	req := &v1.DownloadRequest{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: namespace,
@@ -88,7 +93,7 @@ Loop:
 			case watch.Deleted:
 				errors.New("download request was unexpectedly deleted")
 			case watch.Modified:
-				if updated.Status.DownloadURL != "" {
+				if updated.Status.DownloadURL != "" || updated.Status.Proxy != nil {
 					req = updated
 					break Loop
 				}
@@ -96,6 +101,16 @@ Loop:
 		}
 	}
 
+	if req.Status.Proxy != nil {
+		body, err := proxyDownload(client.RESTClient(), namespace, req.Status.Proxy)
+		if err != nil {
+			return err
+		}
+		defer body.Close()
+
+		return copyDownload(kind, body, w)
+	}
+
 	if req.Status.DownloadURL == "" {
 		return ErrNotFound
 	}
@@ -163,10 +178,31 @@ Loop:
 		return errors.Errorf("request failed: %v", string(body))
 	}
 
-	reader := resp.Body
+	return copyDownload(kind, resp.Body, w)
+}
+
+// proxyDownload requests a download that the Velero server proxies, through the
+// Kubernetes API server's proxy to the server's pod.
+func proxyDownload(client rest.Interface, namespace string, proxy *v1.DownloadProxy) (io.ReadCloser, error) {
+	body, err := client.Get().
+		AbsPath("/api/v1/namespaces", namespace, "pods", fmt.Sprintf("%s:%d", proxy.Pod, proxy.Port), "proxy", proxy.Path).
+		Stream(context.TODO())
+	if apierrors.IsNotFound(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "request failed")
+	}
+
+	return body, nil
+}
+
+// copyDownload writes the contents of a downloaded file to w.
+func copyDownload(kind v1.DownloadTargetKind, body io.Reader, w io.Writer) error {
+	reader := body
 	if kind != v1.DownloadTargetKindBackupContents {
 		// need to decompress logs
-		gzipReader, err := gzip.NewReader(resp.Body)
+		gzipReader, err := gzip.NewReader(body)
 		if err != nil {
 			return err
 		}
@@ -174,6 +210,6 @@ Loop:
 		reader = gzipReader
 	}
 
-	_, err = io.Copy(w, reader)
+	_, err := io.Copy(w, reader)
 	return err
 }
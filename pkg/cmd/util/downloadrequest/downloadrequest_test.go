@@ -31,10 +31,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
 	core "k8s.io/client-go/testing"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/scheme"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 )
 
 func TestStream(t *testing.T) {
@@ -48,6 +51,7 @@ func TestStream(t *testing.T) {
 		watchModifies []runtime.Object
 		watchDeletes  []runtime.Object
 		updateWithURL bool
+		updateProxy   bool
 		statusCode    int
 		body          string
 		deleteError   error
@@ -101,6 +105,21 @@ func TestStream(t *testing.T) {
 			body:          "some error",
 			expectedError: "request failed: some error",
 		},
+		{
+			name:        "proxied download",
+			kind:        v1.DownloadTargetKindBackupLog,
+			updateProxy: true,
+			statusCode:  http.StatusOK,
+			body:        "download body",
+		},
+		{
+			name:          "proxied download not found",
+			kind:          v1.DownloadTargetKindBackupLog,
+			updateProxy:   true,
+			statusCode:    http.StatusNotFound,
+			body:          "not found",
+			expectedError: ErrNotFound.Error(),
+		},
 	}
 
 	const testTimeout = 30 * time.Second
@@ -133,8 +152,13 @@ func TestStream(t *testing.T) {
 
 			var server *httptest.Server
 			var url string
-			if test.updateWithURL {
+			if test.updateWithURL || test.updateProxy {
 				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+					if test.updateProxy && req.URL.Path != "/api/v1/namespaces/namespace/pods/velero-pod:8086/proxy/downloads/namespace/download/token" {
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+
 					w.WriteHeader(test.statusCode)
 					if test.statusCode == http.StatusOK {
 						gzipWriter := gzip.NewWriter(w)
@@ -148,10 +172,25 @@ func TestStream(t *testing.T) {
 				url = server.URL
 			}
 
+			var veleroClient velerov1client.VeleroV1Interface = client.VeleroV1()
+			if test.updateProxy {
+				// the fake client doesn't have a REST client, so the proxied
+				// download is requested from the test server.
+				restClient, err := rest.RESTClientFor(&rest.Config{
+					Host: url,
+					ContentConfig: rest.ContentConfig{
+						GroupVersion:         &v1.SchemeGroupVersion,
+						NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+					},
+				})
+				require.NoError(t, err)
+				veleroClient = &restClientOverride{VeleroV1Interface: veleroClient, restClient: restClient}
+			}
+
 			output := new(bytes.Buffer)
 			errCh := make(chan error)
 			go func() {
-				err := Stream(client.VeleroV1(), "namespace", "name", test.kind, output, timeout, false, "")
+				err := Stream(veleroClient, "namespace", "name", test.kind, output, timeout, false, "")
 				errCh <- err
 			}()
 
@@ -166,11 +205,15 @@ func TestStream(t *testing.T) {
 			}
 
 			var createdName string
-			if test.updateWithURL {
+			if test.updateWithURL || test.updateProxy {
 				select {
 				case r := <-created:
 					createdName = r.Name
-					r.Status.DownloadURL = url
+					if test.updateProxy {
+						r.Status.Proxy = &v1.DownloadProxy{Pod: "velero-pod", Port: 8086, Path: "/downloads/namespace/download/token"}
+					} else {
+						r.Status.DownloadURL = url
+					}
 					fakeWatch.Modify(r)
 				case <-time.After(testTimeout):
 					t.Fatalf("created object not received")
@@ -209,6 +252,16 @@ func TestStream(t *testing.T) {
 	}
 }
 
+// restClientOverride is a VeleroV1Interface whose REST client is replaced.
+type restClientOverride struct {
+	velerov1client.VeleroV1Interface
+	restClient rest.Interface
+}
+
+func (c *restClientOverride) RESTClient() rest.Interface {
+	return c.restClient
+}
+
 type downloadRequest struct {
 	*v1.DownloadRequest
 }
@@ -133,6 +133,21 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 
 	d.Printf("\tCluster-scoped:\t%s\n", BoolPointerString(spec.IncludeClusterResources, "excluded", "included", "auto"))
 
+	d.Println()
+	d.Printf("API Groups:\n")
+	if len(spec.IncludedAPIGroups) == 0 {
+		s = "*"
+	} else {
+		s = strings.Join(spec.IncludedAPIGroups, ", ")
+	}
+	d.Printf("\tIncluded:\t%s\n", s)
+	if len(spec.ExcludedAPIGroups) == 0 {
+		s = "<none>"
+	} else {
+		s = strings.Join(spec.ExcludedAPIGroups, ", ")
+	}
+	d.Printf("\tExcluded:\t%s\n", s)
+
 	d.Println()
 	s = "<none>"
 	if spec.LabelSelector != nil {
@@ -142,12 +157,57 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 
 	d.Println()
 	d.Printf("Storage Location:\t%s\n", spec.StorageLocation)
+	if len(spec.ReplicationLocations) > 0 {
+		d.Printf("Replication Locations:\t%s\n", strings.Join(spec.ReplicationLocations, ", "))
+	}
 
 	d.Println()
 	d.Printf("Velero-Native Snapshot PVs:\t%s\n", BoolPointerString(spec.SnapshotVolumes, "false", "true", "auto"))
+	if len(spec.SnapshotTags) > 0 {
+		d.DescribeMap("Snapshot Tags", spec.SnapshotTags)
+	}
+	if spec.SnapshotVerification != "" {
+		d.Printf("Snapshot Verification:\t%s\n", spec.SnapshotVerification)
+	}
+
+	if len(spec.VolumePolicies) > 0 {
+		d.Println()
+		d.Printf("Volume Policies:\n")
+		for _, policy := range spec.VolumePolicies {
+			storageClasses, volumeTypes := "*", "*"
+			if len(policy.StorageClasses) > 0 {
+				storageClasses = strings.Join(policy.StorageClasses, ", ")
+			}
+			if len(policy.VolumeTypes) > 0 {
+				volumeTypes = strings.Join(policy.VolumeTypes, ", ")
+			}
+			conditions := fmt.Sprintf("Storage classes: %s, volume types: %s", storageClasses, volumeTypes)
+			if len(policy.Drivers) > 0 {
+				conditions += ", drivers: " + strings.Join(policy.Drivers, ", ")
+			}
+			if policy.MinCapacity != nil || policy.MaxCapacity != nil {
+				minCapacity, maxCapacity := "0", "*"
+				if policy.MinCapacity != nil {
+					minCapacity = policy.MinCapacity.String()
+				}
+				if policy.MaxCapacity != nil {
+					maxCapacity = policy.MaxCapacity.String()
+				}
+				conditions += fmt.Sprintf(", capacity: %s-%s", minCapacity, maxCapacity)
+			}
+			d.Printf("\t%s:\t%s\n", conditions, policy.Action)
+		}
+	}
+	if spec.ResourcePolicy != nil {
+		d.Println()
+		d.Printf("Resource Policy:\t%s/%s\n", spec.ResourcePolicy.Kind, spec.ResourcePolicy.Name)
+	}
 
 	d.Println()
 	d.Printf("TTL:\t%s\n", spec.TTL.Duration)
+	if spec.SnapshotTTL != nil {
+		d.Printf("Snapshot TTL:\t%s\n", spec.SnapshotTTL.Duration)
+	}
 
 	d.Println()
 	if len(spec.Hooks.Resources) == 0 {
@@ -253,8 +313,45 @@ func DescribeBackupStatus(d *Describer, backup *velerov1api.Backup, details bool
 	// if the controller hasn't processed this Backup yet, in which case this will
 	// just display `<nil>`, though this should be temporary.
 	d.Printf("Expiration:\t%s\n", status.Expiration)
+	if status.SnapshotExpiration != nil {
+		d.Printf("Snapshot Expiration:\t%s\n", status.SnapshotExpiration)
+	}
 	d.Println()
 
+	if sse := status.ServerSideEncryption; sse != nil {
+		if sse.KMSKeyID != "" {
+			d.Printf("Server-Side Encryption:\t%s (KMS key: %s)\n", sse.Algorithm, sse.KMSKeyID)
+		} else {
+			d.Printf("Server-Side Encryption:\t%s\n", sse.Algorithm)
+		}
+		d.Println()
+	}
+
+	if status.StoredBytes > 0 {
+		d.Printf("Stored Bytes:\t%d\n", status.StoredBytes)
+		d.Println()
+	}
+
+	if status.ObjectLockRetainUntil != nil {
+		d.Printf("Locked In Object Storage Until:\t%s\n", status.ObjectLockRetainUntil.Time)
+		d.Println()
+	}
+
+	if len(status.Replications) > 0 {
+		d.Printf("Replications:\n")
+		for _, replication := range status.Replications {
+			switch {
+			case replication.Message != "":
+				d.Printf("\t%s:\t%s (%s)\n", replication.Location, replication.Phase, replication.Message)
+			case replication.CompletionTimestamp != nil:
+				d.Printf("\t%s:\t%s (%s)\n", replication.Location, replication.Phase, replication.CompletionTimestamp.Time)
+			default:
+				d.Printf("\t%s:\t%s\n", replication.Location, replication.Phase)
+			}
+		}
+		d.Println()
+	}
+
 	if backup.Status.Progress != nil {
 		if backup.Status.Phase == velerov1api.BackupPhaseInProgress {
 			d.Printf("Estimated total items to be backed up:\t%d\n", backup.Status.Progress.TotalItems)
@@ -264,6 +361,19 @@ func DescribeBackupStatus(d *Describer, backup *velerov1api.Backup, details bool
 			d.Printf("Items backed up:\t%d\n", backup.Status.Progress.ItemsBackedUp)
 		}
 
+		if backup.Status.Progress.PodVolumeTotalBytes > 0 {
+			d.Printf("Restic bytes backed up:\t%d of %d\n", backup.Status.Progress.PodVolumeBytesDone, backup.Status.Progress.PodVolumeTotalBytes)
+		}
+
+		d.Println()
+	}
+
+	if status.ItemOperationsAttempted > 0 {
+		if status.Phase == velerov1api.BackupPhaseWaitingForPluginOperations {
+			d.Printf("Item operations:\t%d waiting to finish\n", status.ItemOperationsAttempted)
+		} else {
+			d.Printf("Item operations:\t%d of %d completed successfully, %d failed\n", status.ItemOperationsCompleted, status.ItemOperationsAttempted, status.ItemOperationsFailed)
+		}
 		d.Println()
 	}
 
@@ -272,9 +382,21 @@ func DescribeBackupStatus(d *Describer, backup *velerov1api.Backup, details bool
 		d.Println()
 	}
 
+	if status.VolumeSnapshotsDeleted {
+		d.Printf("Velero-Native Snapshots:\t<deleted, their snapshot TTL elapsed>\n")
+		return
+	}
+
 	if status.VolumeSnapshotsAttempted > 0 {
 		if !details {
-			d.Printf("Velero-Native Snapshots:\t%d of %d snapshots completed successfully (specify --details for more information)\n", status.VolumeSnapshotsCompleted, status.VolumeSnapshotsAttempted)
+			d.Printf("Velero-Native Snapshots:\t%d of %d snapshots completed successfully", status.VolumeSnapshotsCompleted, status.VolumeSnapshotsAttempted)
+			if verification := backup.Spec.SnapshotVerification; verification != "" && verification != velerov1api.SnapshotVerificationNone {
+				d.Printf(", %d verified", status.VolumeSnapshotsVerified)
+			}
+			if status.VolumeSnapshotBytes > 0 {
+				d.Printf(", %d bytes", status.VolumeSnapshotBytes)
+			}
+			d.Printf(" (specify --details for more information)\n")
 			return
 		}
 
@@ -290,9 +412,18 @@ func DescribeBackupStatus(d *Describer, backup *velerov1api.Backup, details bool
 			return
 		}
 
-		d.Printf("Velero-Native Snapshots:\n")
+		if status.VolumeSnapshotBytes > 0 {
+			d.Printf("Velero-Native Snapshots (%d bytes):\n", status.VolumeSnapshotBytes)
+		} else {
+			d.Printf("Velero-Native Snapshots:\n")
+		}
 		for _, snap := range snapshots {
 			describeSnapshot(d, snap.Spec.PersistentVolumeName, snap.Status.ProviderSnapshotID, snap.Spec.VolumeType, snap.Spec.VolumeAZ, snap.Spec.VolumeIOPS)
+			describeSnapshotGroup(d, snap.Spec.SnapshotGroup, snap.Status.Grouped)
+			describeSnapshotVerification(d, snap.Status.Verification, snap.Status.VerificationError)
+			if snap.Status.SizeBytes > 0 {
+				d.Printf("\t\tSize (bytes):\t%d\n", snap.Status.SizeBytes)
+			}
 		}
 		return
 	}
@@ -348,6 +479,30 @@ func describeSnapshot(d *Describer, pvName, snapshotID, volumeType, volumeAZ str
 	d.Printf("\t\tIOPS:\t%s\n", iopsString)
 }
 
+func describeSnapshotGroup(d *Describer, group string, grouped bool) {
+	if group == "" {
+		return
+	}
+
+	if grouped {
+		d.Printf("\t\tSnapshot Group:\t%s (consistency group snapshot)\n", group)
+		return
+	}
+	d.Printf("\t\tSnapshot Group:\t%s (sequential snapshots)\n", group)
+}
+
+func describeSnapshotVerification(d *Describer, verification volume.SnapshotVerificationPhase, verificationError string) {
+	if verification == "" {
+		return
+	}
+
+	if verificationError != "" {
+		d.Printf("\t\tVerification:\t%s (%s)\n", verification, verificationError)
+		return
+	}
+	d.Printf("\t\tVerification:\t%s\n", verification)
+}
+
 // DescribeDeleteBackupRequests describes delete backup requests in human-readable format.
 func DescribeDeleteBackupRequests(d *Describer, requests []velerov1api.DeleteBackupRequest) {
 	d.Printf("Deletion Attempts")
@@ -416,7 +571,11 @@ func DescribePodVolumeBackups(d *Describer, backups []velerov1api.PodVolumeBacku
 		backupsByPod := new(volumesByPod)
 
 		for _, backup := range backupsByPhase[phase] {
-			backupsByPod.Add(backup.Spec.Pod.Namespace, backup.Spec.Pod.Name, backup.Spec.Volume, phase, backup.Status.Progress)
+			volume := backup.Spec.Volume
+			if backup.Status.Unchanged {
+				volume += " (unchanged)"
+			}
+			backupsByPod.Add(backup.Spec.Pod.Namespace, backup.Spec.Pod.Name, volume, phase, backup.Status.Progress)
 		}
 
 		d.Printf("\t%s:\n", phase)
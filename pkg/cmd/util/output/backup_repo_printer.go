@@ -17,6 +17,7 @@ limitations under the License.
 package output
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -24,32 +25,34 @@ import (
 )
 
 var (
-	resticRepoColumns = []metav1.TableColumnDefinition{
+	backupRepoColumns = []metav1.TableColumnDefinition{
 		// name needs Type and Format defined for the decorator to identify it:
 		// https://github.com/kubernetes/kubernetes/blob/v1.15.3/pkg/printers/tableprinter.go#L204
 		{Name: "Name", Type: "string", Format: "name"},
 		{Name: "Status"},
 		{Name: "Last Maintenance"},
+		{Name: "Last Check"},
+		{Name: "Size"},
 	}
 )
 
-func printResticRepoList(list *v1.ResticRepositoryList) []metav1.TableRow {
+func printBackupRepoList(list *v1.BackupRepositoryList) []metav1.TableRow {
 	rows := make([]metav1.TableRow, 0, len(list.Items))
 
 	for i := range list.Items {
-		rows = append(rows, printResticRepo(&list.Items[i])...)
+		rows = append(rows, printBackupRepo(&list.Items[i])...)
 	}
 	return rows
 }
 
-func printResticRepo(repo *v1.ResticRepository) []metav1.TableRow {
+func printBackupRepo(repo *v1.BackupRepository) []metav1.TableRow {
 	row := metav1.TableRow{
 		Object: runtime.RawExtension{Object: repo},
 	}
 
 	status := repo.Status.Phase
 	if status == "" {
-		status = v1.ResticRepositoryPhaseNew
+		status = v1.BackupRepositoryPhaseNew
 	}
 
 	var lastMaintenance string
@@ -59,10 +62,27 @@ func printResticRepo(repo *v1.ResticRepository) []metav1.TableRow {
 		lastMaintenance = repo.Status.LastMaintenanceTime.String()
 	}
 
+	lastCheck := "<never>"
+	if repo.Status.LastCheckTime != nil && !repo.Status.LastCheckTime.IsZero() {
+		lastCheck = repo.Status.LastCheckTime.String()
+		for _, result := range repo.Status.MaintenanceResults {
+			if result.Operation == v1.BackupRepositoryMaintenanceOperationCheck && !result.Succeeded {
+				lastCheck += " (failed)"
+			}
+		}
+	}
+
+	size := "<unknown>"
+	if repo.Status.LastSizeTime != nil && !repo.Status.LastSizeTime.IsZero() {
+		size = resource.NewQuantity(repo.Status.SizeBytes, resource.BinarySI).String()
+	}
+
 	row.Cells = append(row.Cells,
 		repo.Name,
 		status,
 		lastMaintenance,
+		lastCheck,
+		size,
 	)
 
 	return []metav1.TableRow{row}
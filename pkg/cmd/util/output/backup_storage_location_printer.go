@@ -33,6 +33,7 @@ var (
 		{Name: "Phase"},
 		{Name: "Last Validated"},
 		{Name: "Access Mode"},
+		{Name: "Default"},
 	}
 )
 
@@ -78,6 +79,7 @@ func printBackupStorageLocation(location *velerov1api.BackupStorageLocation) []m
 		status,
 		LastValidatedStr,
 		accessMode,
+		location.Spec.Default,
 	)
 
 	return []metav1.TableRow{row}
@@ -30,6 +30,8 @@ var (
 		// https://github.com/kubernetes/kubernetes/blob/v1.15.3/pkg/printers/tableprinter.go#L204
 		{Name: "Name", Type: "string", Format: "name"},
 		{Name: "Kind"},
+		{Name: "Status"},
+		{Name: "Restarts"},
 	}
 )
 
@@ -57,7 +59,15 @@ func sortByKindAndName(plugins []velerov1api.PluginInfo) {
 func printPlugin(plugin velerov1api.PluginInfo) []metav1.TableRow {
 	row := metav1.TableRow{}
 
-	row.Cells = append(row.Cells, plugin.Name, plugin.Kind)
+	// plugins whose processes haven't been started by the server have no status
+	var status string
+	var restarts int
+	if plugin.Process != nil {
+		status = string(plugin.Process.Phase)
+		restarts = plugin.Process.Restarts
+	}
+
+	row.Cells = append(row.Cells, plugin.Name, plugin.Kind, status, restarts)
 
 	return []metav1.TableRow{row}
 }
@@ -62,6 +62,15 @@ func DescribeRestore(restore *v1.Restore, podVolumeRestores []v1.PodVolumeRestor
 			d.Printf("Completed:\t%s\n", restore.Status.CompletionTimestamp)
 		}
 
+		if restore.Status.Progress != nil && restore.Status.Progress.PodVolumeTotalBytes > 0 {
+			d.Printf("Restic bytes restored:\t%d of %d\n", restore.Status.Progress.PodVolumeBytesDone, restore.Status.Progress.PodVolumeTotalBytes)
+		}
+
+		if restore.Status.Unchanged > 0 {
+			d.Println()
+			d.Printf("Unchanged items:\t%d (already existed in the cluster and were the same as the backed-up version)\n", restore.Status.Unchanged)
+		}
+
 		if len(restore.Status.ValidationErrors) > 0 {
 			d.Println()
 			d.Printf("Validation errors:")
@@ -107,6 +116,21 @@ func DescribeRestore(restore *v1.Restore, podVolumeRestores []v1.PodVolumeRestor
 			s = strings.Join(restore.Spec.ExcludedResources, ", ")
 		}
 		d.Printf("\tExcluded:\t%s\n", s)
+		if len(restore.Spec.IncludedResourceNames) > 0 {
+			d.Printf("\tIncluded by name:\t%s\n", strings.Join(restore.Spec.IncludedResourceNames, ", "))
+		}
+
+		if restore.Spec.RestoreStatus != nil && len(restore.Spec.RestoreStatus.IncludedResources) > 0 {
+			d.Printf("\tStatus included:\t%s\n", strings.Join(restore.Spec.RestoreStatus.IncludedResources, ", "))
+			s = "<none>"
+			if len(restore.Spec.RestoreStatus.ExcludedResources) > 0 {
+				s = strings.Join(restore.Spec.RestoreStatus.ExcludedResources, ", ")
+			}
+			d.Printf("\tStatus excluded:\t%s\n", s)
+		}
+
+		describeStripMetadata(d, "Finalizers", restore.Spec.StripFinalizers)
+		describeStripMetadata(d, "Owner references", restore.Spec.StripOwnerReferences)
 
 		d.Printf("\tCluster-scoped:\t%s\n", BoolPointerString(restore.Spec.IncludeClusterResources, "excluded", "included", "auto"))
 
@@ -120,8 +144,143 @@ func DescribeRestore(restore *v1.Restore, podVolumeRestores []v1.PodVolumeRestor
 		}
 		d.Printf("Label selector:\t%s\n", s)
 
+		if len(restore.Spec.ItemLabels) > 0 || len(restore.Spec.ItemAnnotations) > 0 {
+			d.Println()
+			d.DescribeMap("Item labels", restore.Spec.ItemLabels)
+			d.DescribeMap("Item annotations", restore.Spec.ItemAnnotations)
+		}
+
 		d.Println()
 		d.Printf("Restore PVs:\t%s\n", BoolPointerString(restore.Spec.RestorePVs, "false", "true", "auto"))
+		if len(restore.Spec.ZoneMapping) > 0 {
+			d.DescribeMap("Zone mappings", restore.Spec.ZoneMapping)
+		}
+		if len(restore.Spec.SnapshotVolumeOverrides) > 0 {
+			describeSnapshotVolumeOverrides(d, restore.Spec.SnapshotVolumeOverrides)
+		}
+		d.Printf("Preserve service NodePorts:\t%s\n", BoolPointerString(restore.Spec.PreserveNodePorts, "false", "true", "auto"))
+
+		s = "<default>"
+		if restore.Spec.PVRenamePolicy != "" {
+			s = string(restore.Spec.PVRenamePolicy)
+		}
+		d.Printf("PV rename policy:\t%s\n", s)
+		if len(restore.Status.RenamedPersistentVolumes) > 0 {
+			d.DescribeMap("Renamed PVs", restore.Status.RenamedPersistentVolumes)
+		}
+
+		s = string(v1.PolicyTypeNone)
+		if restore.Spec.ExistingResourcePolicy != "" {
+			s = string(restore.Spec.ExistingResourcePolicy)
+		}
+		d.Printf("Existing resource policy:\t%s\n", s)
+
+		s = string(v1.ServiceAccountPolicyMerge)
+		if restore.Spec.ServiceAccountPolicy != "" {
+			s = string(restore.Spec.ServiceAccountPolicy)
+		}
+		d.Printf("Service account policy:\t%s\n", s)
+
+		if len(restore.Spec.ConflictPolicies) > 0 {
+			d.Printf("Conflict policies:\n")
+			for _, policy := range restore.Spec.ConflictPolicies {
+				d.Printf("\t%s:\t%s\n", policy.Policy, strings.Join(policy.Resources, ", "))
+			}
+		}
+
+		if restore.Spec.PVCResize != nil {
+			d.Println()
+			d.Printf("PVC resize:\n")
+			s = "<none>"
+			if restore.Spec.PVCResize.Factor != "" {
+				s = restore.Spec.PVCResize.Factor
+			}
+			d.Printf("\tFactor:\t%s\n", s)
+			var sizes []string
+			for storageClass, size := range restore.Spec.PVCResize.MinimumSizes {
+				sizes = append(sizes, fmt.Sprintf("%s=%s", storageClass, size))
+			}
+			sort.Strings(sizes)
+			s = "<none>"
+			if len(sizes) > 0 {
+				s = strings.Join(sizes, ", ")
+			}
+			d.Printf("\tMinimum sizes:\t%s\n", s)
+		}
+
+		if restore.Spec.APIRateLimit != nil {
+			burst := restore.Spec.APIRateLimit.Burst
+			if burst == 0 {
+				burst = restore.Spec.APIRateLimit.QPS
+			}
+			d.Println()
+			d.Printf("API rate limit:\t%d requests per second (burst %d)\n", restore.Spec.APIRateLimit.QPS, burst)
+		}
+
+		if restore.Spec.ResourcePriorities != nil {
+			d.Println()
+			d.Printf("Resource priorities:\n")
+			d.DescribeSlice(1, "High", restore.Spec.ResourcePriorities.HighPriorities)
+			d.DescribeSlice(1, "Low", restore.Spec.ResourcePriorities.LowPriorities)
+		}
+
+		if len(restore.Spec.ReadinessGates) > 0 {
+			d.Println()
+			d.Printf("Readiness gates:\n")
+			for _, gate := range restore.Spec.ReadinessGates {
+				requirement := "condition " + gate.ConditionType
+				switch {
+				case gate.JSONPath != "" && gate.Value != "":
+					requirement = fmt.Sprintf("jsonPath %s=%s", gate.JSONPath, gate.Value)
+				case gate.JSONPath != "":
+					requirement = "jsonPath " + gate.JSONPath
+				case gate.ConditionType == "":
+					requirement = "condition <default>"
+				}
+				timeout := "<default>"
+				if gate.Timeout.Duration > 0 {
+					timeout = gate.Timeout.Duration.String()
+				}
+				d.Printf("\t%s:\t%s, timeout %s\n", gate.Resource, requirement, timeout)
+			}
+		}
+
+		if len(restore.Spec.ResourceMappings) > 0 {
+			d.Println()
+			d.Printf("Resource mappings:\n")
+			for _, mapping := range restore.Spec.ResourceMappings {
+				target := mapping.Target
+				if mapping.Version != "" {
+					target += "/" + mapping.Version
+				}
+				d.Printf("\t%s:\t%s\n", mapping.Source, target)
+				if len(mapping.PruneFields) > 0 {
+					d.Printf("\t\tPruned fields:\t%s\n", strings.Join(mapping.PruneFields, ", "))
+				}
+			}
+		}
+
+		if restore.Spec.ResourceModifier != nil {
+			d.Println()
+			d.Printf("Resource modifier:\t%s/%s\n", restore.Spec.ResourceModifier.Kind, restore.Spec.ResourceModifier.Name)
+		}
+
+		if restore.Status.HookStatus != nil {
+			d.Println()
+			d.Printf("Hooks Attempted:\t%d\n", restore.Status.HookStatus.HooksAttempted)
+			d.Printf("Hooks Failed:\t%d\n", restore.Status.HookStatus.HooksFailed)
+		}
+
+		if restore.Status.PostRestoreActionStatus != nil {
+			d.Println()
+			d.Printf("Post-Restore Actions Attempted:\t%d\n", restore.Status.PostRestoreActionStatus.ActionsAttempted)
+			d.Printf("Post-Restore Actions Failed:\t%d\n", restore.Status.PostRestoreActionStatus.ActionsFailed)
+		}
+
+		if restore.Spec.DryRun {
+			d.Println()
+			describeRestoreDryRunReport(d, restore, details, veleroClient, insecureSkipTLSVerify, caCertFile)
+		}
 
 		if len(podVolumeRestores) > 0 {
 			d.Println()
@@ -131,7 +290,7 @@ func DescribeRestore(restore *v1.Restore, podVolumeRestores []v1.PodVolumeRestor
 }
 
 func describeRestoreResults(d *Describer, restore *v1.Restore, veleroClient clientset.Interface, insecureSkipTLSVerify bool, caCertPath string) {
-	if restore.Status.Warnings == 0 && restore.Status.Errors == 0 {
+	if restore.Status.Warnings == 0 && restore.Status.Errors == 0 && restore.Status.Conflicts == 0 {
 		return
 	}
 
@@ -156,6 +315,69 @@ func describeRestoreResults(d *Describer, restore *v1.Restore, veleroClient clie
 		d.Println()
 		describeRestoreResult(d, "Errors", resultMap["errors"])
 	}
+	if restore.Status.Conflicts > 0 {
+		d.Println()
+		describeRestoreResult(d, "Conflicts", resultMap["conflicts"])
+	}
+}
+
+// describeRestoreDryRunReport describes the plan produced by a dry-run restore in
+// human-readable format.
+func describeRestoreDryRunReport(d *Describer, restore *v1.Restore, details bool, veleroClient clientset.Interface, insecureSkipTLSVerify bool, caCertPath string) {
+	if restore.Status.Phase != v1.RestorePhaseCompleted && restore.Status.Phase != v1.RestorePhasePartiallyFailed {
+		d.Printf("Dry run:\t<report not yet available>\n")
+		return
+	}
+
+	var buf bytes.Buffer
+	var report pkgrestore.DryRunReport
+
+	if err := downloadrequest.Stream(veleroClient.VeleroV1(), restore.Namespace, restore.Name, v1.DownloadTargetKindRestoreDryRunReport, &buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		d.Printf("Dry run:\t<error getting report: %v>\n", err)
+		return
+	}
+
+	if err := json.NewDecoder(&buf).Decode(&report); err != nil {
+		d.Printf("Dry run:\t<error decoding report: %v>\n", err)
+		return
+	}
+
+	if details {
+		d.Printf("Dry run:\n")
+	} else {
+		d.Printf("Dry run (specify --details for more information):\n")
+	}
+
+	counts := report.Counts()
+	for _, action := range []pkgrestore.DryRunAction{
+		pkgrestore.DryRunActionCreate,
+		pkgrestore.DryRunActionConflict,
+		pkgrestore.DryRunActionUnchanged,
+		pkgrestore.DryRunActionSkip,
+	} {
+		d.Printf("\t%s:\t%d\n", action, counts[action])
+
+		if !details {
+			continue
+		}
+
+		for _, item := range report.Items {
+			if item.Action != action {
+				continue
+			}
+
+			name := item.Name
+			if item.Namespace != "" {
+				name = item.Namespace + "/" + name
+			}
+
+			if item.Reason == "" {
+				d.Printf("\t\t%s: %s\n", item.GroupResource, name)
+			} else {
+				d.Printf("\t\t%s: %s (%s)\n", item.GroupResource, name, item.Reason)
+			}
+		}
+	}
 }
 
 func describeRestoreResult(d *Describer, name string, result pkgrestore.Result) {
@@ -172,6 +394,27 @@ func describeRestoreResult(d *Describer, name string, result pkgrestore.Result)
 	}
 }
 
+// describeStripMetadata describes the resources whose items have the named
+// metadata removed, if the restore overrides the default of removing it from
+// all items.
+func describeStripMetadata(d *Describer, name string, spec *v1.StripMetadataSpec) {
+	if spec == nil {
+		return
+	}
+
+	s := "*"
+	if len(spec.IncludedResources) > 0 {
+		s = strings.Join(spec.IncludedResources, ", ")
+	}
+	d.Printf("\t%s stripped:\t%s\n", name, s)
+
+	s = "<none>"
+	if len(spec.ExcludedResources) > 0 {
+		s = strings.Join(spec.ExcludedResources, ", ")
+	}
+	d.Printf("\t%s kept:\t%s\n", name, s)
+}
+
 // describePodVolumeRestores describes pod volume restores in human-readable format.
 func describePodVolumeRestores(d *Describer, restores []v1.PodVolumeRestore, details bool) {
 	if details {
@@ -235,3 +478,33 @@ func groupRestoresByPhase(restores []v1.PodVolumeRestore) map[string][]v1.PodVol
 
 	return restoresByPhase
 }
+
+// describeSnapshotVolumeOverrides describes a restore's snapshot volume overrides, sorted by
+// the storage class they apply to.
+func describeSnapshotVolumeOverrides(d *Describer, overrides map[string]v1.SnapshotVolumeOverride) {
+	storageClasses := make([]string, 0, len(overrides))
+	for storageClass := range overrides {
+		storageClasses = append(storageClasses, storageClass)
+	}
+	sort.Strings(storageClasses)
+
+	d.Printf("Snapshot volume overrides:\n")
+	for _, storageClass := range storageClasses {
+		override := overrides[storageClass]
+
+		var fields []string
+		if override.StorageClass != "" {
+			fields = append(fields, "storage class "+override.StorageClass)
+		}
+		if override.VolumeType != "" {
+			fields = append(fields, "type "+override.VolumeType)
+		}
+		if override.IOPS != nil {
+			fields = append(fields, fmt.Sprintf("IOPS %d", *override.IOPS))
+		}
+		if override.Size != "" {
+			fields = append(fields, "size "+override.Size)
+		}
+		d.Printf("\t%s:\t%s\n", storageClass, strings.Join(fields, ", "))
+	}
+}
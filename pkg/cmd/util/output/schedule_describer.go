@@ -52,6 +52,38 @@ func DescribeSchedule(schedule *v1.Schedule) string {
 
 func DescribeScheduleSpec(d *Describer, spec v1.ScheduleSpec) {
 	d.Printf("Schedule:\t%s\n", spec.Schedule)
+	if spec.Timezone != "" {
+		d.Printf("Timezone:\t%s\n", spec.Timezone)
+	}
+	d.Printf("Paused:\t%t\n", spec.Paused)
+	if spec.ConcurrencyPolicy != "" {
+		d.Printf("Concurrency policy:\t%s\n", spec.ConcurrencyPolicy)
+	}
+	if spec.KeepLast > 0 {
+		d.Printf("Keep last:\t%d\n", spec.KeepLast)
+	}
+	if spec.Jitter.Duration > 0 {
+		d.Printf("Jitter:\t%s\n", spec.Jitter.Duration)
+	}
+	if spec.BackupNameTemplate != "" {
+		d.Printf("Backup name template:\t%s\n", spec.BackupNameTemplate)
+	}
+	if len(spec.BlackoutWindows) > 0 {
+		d.Println("Blackout windows:")
+		for _, window := range spec.BlackoutWindows {
+			if window.Schedule != "" {
+				d.Printf("\t%s for %s\n", window.Schedule, window.Duration.Duration)
+			} else if window.Start != nil && window.End != nil {
+				d.Printf("\t%s to %s\n", window.Start.Time, window.End.Time)
+			}
+		}
+	}
+	if spec.Policy != "" {
+		d.Printf("Policy:\t%s\n", spec.Policy)
+	}
+	if spec.UseOwnerReferencesInBackup != nil {
+		d.Printf("Use owner references in backup:\t%t\n", *spec.UseOwnerReferencesInBackup)
+	}
 
 	d.Println()
 	d.Println("Backup Template:")
@@ -66,4 +98,12 @@ func DescribeScheduleStatus(d *Describer, status v1.ScheduleStatus) {
 		lastBackup = fmt.Sprintf("%v", status.LastBackup.Time)
 	}
 	d.Printf("Last Backup:\t%s\n", lastBackup)
+
+	if status.LastSkipped != nil && !status.LastSkipped.Time.IsZero() {
+		d.Printf("Last Skipped:\t%v\n", status.LastSkipped.Time)
+	}
+
+	if status.ConsecutiveFailures > 0 {
+		d.Printf("Consecutive Failures:\t%d\n", status.ConsecutiveFailures)
+	}
 }
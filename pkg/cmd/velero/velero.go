@@ -37,6 +37,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/install"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/plugin"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/restic"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/restic/repo"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/restore"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/schedule"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/snapshotlocation"
@@ -99,6 +100,7 @@ operations can also be performed as 'velero backup get' and 'velero schedule cre
 		cliclient.NewCommand(),
 		completion.NewCommand(),
 		restic.NewCommand(f),
+		repo.NewRepositoryCommand(f),
 		bug.NewCommand(),
 		backuplocation.NewCommand(f),
 		snapshotlocation.NewCommand(f),
@@ -36,11 +36,16 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
 
 	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/v2/pkg/apis/volumesnapshot/v1beta1"
 	snapshotv1beta1listers "github.com/kubernetes-csi/external-snapshotter/v2/pkg/client/listers/volumesnapshot/v1beta1"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/internal/hook"
+	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
+	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
@@ -48,10 +53,13 @@ import (
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
@@ -69,12 +77,13 @@ type backupController struct {
 	lister                      velerov1listers.BackupLister
 	client                      velerov1client.BackupsGetter
 	kbClient                    kbclient.Client
+	configMapClient             corev1client.ConfigMapsGetter
 	clock                       clock.Clock
 	backupLogLevel              logrus.Level
 	newPluginManager            func(logrus.FieldLogger) clientmgmt.Manager
 	backupTracker               BackupTracker
 	defaultBackupLocation       string
-	defaultVolumesToRestic      bool
+	defaultVolumesToFsBackup    bool
 	defaultBackupTTL            time.Duration
 	snapshotLocationLister      velerov1listers.VolumeSnapshotLocationLister
 	defaultSnapshotLocations    map[string]string
@@ -93,10 +102,12 @@ func NewBackupController(
 	logger logrus.FieldLogger,
 	backupLogLevel logrus.Level,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
 	backupTracker BackupTracker,
 	kbClient kbclient.Client,
+	configMapClient corev1client.ConfigMapsGetter,
 	defaultBackupLocation string,
-	defaultVolumesToRestic bool,
+	defaultVolumesToFsBackup bool,
 	defaultBackupTTL time.Duration,
 	volumeSnapshotLocationLister velerov1listers.VolumeSnapshotLocationLister,
 	defaultSnapshotLocations map[string]string,
@@ -116,8 +127,9 @@ func NewBackupController(
 		newPluginManager:            newPluginManager,
 		backupTracker:               backupTracker,
 		kbClient:                    kbClient,
+		configMapClient:             configMapClient,
 		defaultBackupLocation:       defaultBackupLocation,
-		defaultVolumesToRestic:      defaultVolumesToRestic,
+		defaultVolumesToFsBackup:    defaultVolumesToFsBackup,
 		defaultBackupTTL:            defaultBackupTTL,
 		snapshotLocationLister:      volumeSnapshotLocationLister,
 		defaultSnapshotLocations:    defaultSnapshotLocations,
@@ -125,7 +137,7 @@ func NewBackupController(
 		formatFlag:                  formatFlag,
 		volumeSnapshotLister:        volumeSnapshotLister,
 		volumeSnapshotContentLister: volumeSnapshotContentLister,
-		newBackupStore:              persistence.NewObjectBackupStore,
+		newBackupStore:              persistence.NewObjectBackupStoreWithCredentials(credentialFileStore),
 	}
 
 	c.syncHandler = c.processBackup
@@ -342,14 +354,69 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 	// calculate expiration
 	request.Status.Expiration = &metav1.Time{Time: c.clock.Now().Add(request.Spec.TTL.Duration)}
 
+	// calculate the expiration of the volume snapshots, if they're retained for less time
+	// than the backup.
+	if ttl := request.Spec.SnapshotTTL; ttl != nil {
+		if ttl.Duration < 0 {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, "Invalid snapshot TTL: must not be negative")
+		} else if ttl.Duration > 0 && ttl.Duration < request.Spec.TTL.Duration {
+			request.Status.SnapshotExpiration = &metav1.Time{Time: c.clock.Now().Add(ttl.Duration)}
+		}
+	}
+
+	switch request.Spec.SnapshotVerification {
+	case "", velerov1api.SnapshotVerificationNone, velerov1api.SnapshotVerificationReady, velerov1api.SnapshotVerificationTestRestore:
+	default:
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid snapshot verification mode %q: must be one of None, Ready or TestRestore", request.Spec.SnapshotVerification))
+	}
+
+	for _, hookSpec := range request.Spec.Hooks.Resources {
+		if hookSpec.Template == nil {
+			continue
+		}
+		if err := hook.ValidateHookTemplate(hookSpec.Template); err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid hook template in hook spec %s: %v", hookSpec.Name, err))
+		}
+	}
+
+	for i, policy := range request.Spec.VolumePolicies {
+		if err := resourcepolicies.ValidateVolumePolicy(policy); err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid volume policy %d: %v", i, err))
+		}
+	}
+
+	if err := restic.ValidateVolumePathPatterns(append(append([]string{}, request.Spec.VolumePathIncludes...), request.Spec.VolumePathExcludes...)); err != nil {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid volume path patterns: %v", err))
+	}
+
+	if request.Spec.ResourcePolicy != nil {
+		policies, err := resourcepolicies.GetResourcePolicies(c.configMapClient, request.Namespace, request.Spec.ResourcePolicy)
+		if err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Error getting resource policies: %v", err))
+		} else {
+			request.ResourcePolicies = policies
+		}
+	}
+
 	// default storage location if not specified
 	if request.Spec.StorageLocation == "" {
-		request.Spec.StorageLocation = c.defaultBackupLocation
+		defaultLocation, err := storage.GetDefaultBackupStorageLocationName(context.Background(), c.kbClient, request.Namespace, c.defaultBackupLocation)
+		if err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("error getting the default backup storage location: %v", err))
+		}
+		request.Spec.StorageLocation = defaultLocation
 	}
 
-	if request.Spec.DefaultVolumesToRestic == nil {
-		request.Spec.DefaultVolumesToRestic = &c.defaultVolumesToRestic
+	// the deprecated DefaultVolumesToRestic applies when DefaultVolumesToFsBackup isn't
+	// set, and is kept in sync with the effective value for anything still reading it.
+	if request.Spec.DefaultVolumesToFsBackup == nil {
+		if request.Spec.DefaultVolumesToRestic != nil {
+			request.Spec.DefaultVolumesToFsBackup = request.Spec.DefaultVolumesToRestic
+		} else {
+			request.Spec.DefaultVolumesToFsBackup = &c.defaultVolumesToFsBackup
+		}
 	}
+	request.Spec.DefaultVolumesToRestic = request.Spec.DefaultVolumesToFsBackup
 
 	// add the storage location as a label for easy filtering later.
 	if request.Labels == nil {
@@ -375,23 +442,37 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
 	}
 
+	// validate the included/excluded API groups
+	for _, err := range collections.ValidateIncludesExcludes(request.Spec.IncludedAPIGroups, request.Spec.ExcludedAPIGroups) {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded API group lists: %v", err))
+	}
+
 	// validate the storage location, and store the BackupStorageLocation API obj on the request
 	storageLocation := &velerov1api.BackupStorageLocation{}
-	if err := c.kbClient.Get(context.Background(), kbclient.ObjectKey{
-		Namespace: request.Namespace,
-		Name:      request.Spec.StorageLocation,
-	}, storageLocation); err != nil {
-		if apierrors.IsNotFound(err) {
-			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("a BackupStorageLocation CRD with the name specified in the backup spec needs to be created before this backup can be executed. Error: %v", err))
+	// the default location can be empty if it couldn't be determined, which is already a validation error
+	if request.Spec.StorageLocation != "" {
+		if err := c.kbClient.Get(context.Background(), kbclient.ObjectKey{
+			Namespace: request.Namespace,
+			Name:      request.Spec.StorageLocation,
+		}, storageLocation); err != nil {
+			if apierrors.IsNotFound(err) {
+				request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("a BackupStorageLocation CRD with the name specified in the backup spec needs to be created before this backup can be executed. Error: %v", err))
+			} else {
+				request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("error getting backup storage location: %v", err))
+			}
 		} else {
-			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("error getting backup storage location: %v", err))
-		}
-	} else {
-		request.StorageLocation = storageLocation
+			request.StorageLocation = storageLocation
 
-		if request.StorageLocation.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
-			request.Status.ValidationErrors = append(request.Status.ValidationErrors,
-				fmt.Sprintf("backup can't be created because backup storage location %s is currently in read-only mode", request.StorageLocation.Name))
+			// record the encryption that the backup is uploaded with, in case the
+			// location's encryption is changed later.
+			if storageLocation.Spec.ObjectStorage != nil {
+				request.Status.ServerSideEncryption = storageLocation.Spec.ObjectStorage.ServerSideEncryption.DeepCopy()
+			}
+
+			if request.StorageLocation.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+				request.Status.ValidationErrors = append(request.Status.ValidationErrors,
+					fmt.Sprintf("backup can't be created because backup storage location %s is currently in read-only mode", request.StorageLocation.Name))
+			}
 		}
 	}
 	// validate and get the backup's VolumeSnapshotLocations, and store the
@@ -548,6 +629,12 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		return err
 	}
 
+	backupLog.Info("Getting item block actions")
+	backup.ItemBlockActions, err = pluginManager.GetItemBlockActions()
+	if err != nil {
+		return err
+	}
+
 	backupLog.Info("Setting up backup store to check for backup existence")
 	backupStore, err := c.newBackupStore(backup.StorageLocation, pluginManager, backupLog)
 	if err != nil {
@@ -594,15 +681,32 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		}
 	}
 
+	// Volume snapshots that have been taken but aren't ready to use yet, e.g. because
+	// they're still being uploaded, are tracked as item operations, so that the backup
+	// doesn't block on them.
+	operations := newVolumeSnapshotOperations(backup.Backup, volumeSnapshots, c.clock.Now())
+	operations = append(operations, newPVSnapshotOperations(backup.Backup, backup.VolumeSnapshots, c.clock.Now())...)
+	backup.Status.ItemOperationsAttempted = len(operations)
+
 	// Mark completion timestamp before serializing and uploading.
 	// Otherwise, the JSON file in object storage has a CompletionTimestamp of 'null'.
 	backup.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
 
+	// Record when the backup's files in object storage are unlocked, so that the backup
+	// isn't deleted before then.
+	if lock := backup.StorageLocation.Spec.ObjectStorage.ObjectLock; lock != nil {
+		backup.Status.ObjectLockRetainUntil = &metav1.Time{Time: backup.Status.CompletionTimestamp.Add(lock.RetentionPeriod.Duration)}
+	}
+
 	backup.Status.VolumeSnapshotsAttempted = len(backup.VolumeSnapshots)
 	for _, snap := range backup.VolumeSnapshots {
 		if snap.Status.Phase == volume.SnapshotPhaseCompleted {
 			backup.Status.VolumeSnapshotsCompleted++
 		}
+		if snap.Status.Verification == volume.SnapshotVerificationVerified {
+			backup.Status.VolumeSnapshotsVerified++
+		}
+		backup.Status.VolumeSnapshotBytes += snap.Status.SizeBytes
 	}
 
 	recordBackupMetrics(backupLog, backup.Backup, backupFile, c.metrics)
@@ -621,6 +725,10 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	switch {
 	case len(fatalErrs) > 0:
 		backup.Status.Phase = velerov1api.BackupPhaseFailed
+	case len(operations) > 0:
+		// the backup's final phase is assigned by the backup operations controller
+		// once all of its item operations have finished.
+		backup.Status.Phase = velerov1api.BackupPhaseWaitingForPluginOperations
 	case logCounter.GetCount(logrus.ErrorLevel) > 0:
 		backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
 	default:
@@ -635,7 +743,7 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		return err
 	}
 
-	if errs := persistBackup(backup, backupFile, logFile, backupStore, c.logger, volumeSnapshots, volumeSnapshotContents); len(errs) > 0 {
+	if errs := persistBackup(backup, backupFile, logFile, backupStore, c.logger, volumeSnapshots, volumeSnapshotContents, operations); len(errs) > 0 {
 		fatalErrs = append(fatalErrs, errs...)
 	}
 
@@ -656,6 +764,7 @@ func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, bac
 		backupSizeBytes = backupFileStat.Size()
 	}
 	serverMetrics.SetBackupTarballSizeBytesGauge(backupScheduleName, backupSizeBytes)
+	serverMetrics.SetBackupVolumeSnapshotBytesGauge(backupScheduleName, backup.Status.VolumeSnapshotBytes)
 
 	backupDuration := backup.Status.CompletionTimestamp.Time.Sub(backup.Status.StartTimestamp.Time)
 	backupDurationSeconds := float64(backupDuration / time.Second)
@@ -665,12 +774,73 @@ func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, bac
 	serverMetrics.RegisterVolumeSnapshotFailures(backupScheduleName, backup.Status.VolumeSnapshotsAttempted-backup.Status.VolumeSnapshotsCompleted)
 }
 
+// newVolumeSnapshotOperations returns an in-progress item operation for each of the
+// backup's CSI volume snapshots that isn't ready to use yet.
+func newVolumeSnapshotOperations(backup *velerov1api.Backup, volumeSnapshots []*snapshotv1beta1api.VolumeSnapshot, now time.Time) []*itemoperation.BackupOperation {
+	var operations []*itemoperation.BackupOperation
+	for _, snapshot := range volumeSnapshots {
+		if snapshot.Status != nil && boolptr.IsSetToTrue(snapshot.Status.ReadyToUse) {
+			continue
+		}
+
+		operations = append(operations, &itemoperation.BackupOperation{
+			Spec: itemoperation.BackupOperationSpec{
+				BackupName:  backup.Name,
+				BackupUID:   string(backup.UID),
+				OperationID: kubeutil.NamespaceAndName(snapshot),
+				Group:       kuberesource.VolumeSnapshots.Group,
+				Resource:    kuberesource.VolumeSnapshots.Resource,
+				Namespace:   snapshot.Namespace,
+				Name:        snapshot.Name,
+			},
+			Status: itemoperation.OperationStatus{
+				Phase:   itemoperation.OperationPhaseInProgress,
+				Created: &metav1.Time{Time: now},
+				Updated: &metav1.Time{Time: now},
+			},
+		})
+	}
+
+	return operations
+}
+
+// newPVSnapshotOperations returns an in-progress item operation for each of the backup's
+// persistent volume snapshots that its volume snapshotter is still taking.
+func newPVSnapshotOperations(backup *velerov1api.Backup, snapshots []*volume.Snapshot, now time.Time) []*itemoperation.BackupOperation {
+	var operations []*itemoperation.BackupOperation
+	for _, snapshot := range snapshots {
+		if snapshot.Status.Phase != volume.SnapshotPhaseInProgress {
+			continue
+		}
+
+		operations = append(operations, &itemoperation.BackupOperation{
+			Spec: itemoperation.BackupOperationSpec{
+				BackupName:             backup.Name,
+				BackupUID:              string(backup.UID),
+				OperationID:            snapshot.Spec.Location + "/" + snapshot.Status.ProviderOperationID,
+				Resource:               kuberesource.PersistentVolumes.Resource,
+				Name:                   snapshot.Spec.PersistentVolumeName,
+				VolumeSnapshotLocation: snapshot.Spec.Location,
+				PluginOperationID:      snapshot.Status.ProviderOperationID,
+			},
+			Status: itemoperation.OperationStatus{
+				Phase:   itemoperation.OperationPhaseInProgress,
+				Created: &metav1.Time{Time: now},
+				Updated: &metav1.Time{Time: now},
+			},
+		})
+	}
+
+	return operations
+}
+
 func persistBackup(backup *pkgbackup.Request,
 	backupContents, backupLog *os.File,
 	backupStore persistence.BackupStore,
 	log logrus.FieldLogger,
 	csiVolumeSnapshots []*snapshotv1beta1api.VolumeSnapshot,
 	csiVolumeSnapshotContents []*snapshotv1beta1api.VolumeSnapshotContent,
+	itemOperations []*itemoperation.BackupOperation,
 ) []error {
 	persistErrs := []error{}
 	backupJSON := new(bytes.Buffer)
@@ -705,6 +875,14 @@ func persistBackup(backup *pkgbackup.Request,
 		persistErrs = append(persistErrs, errs...)
 	}
 
+	var itemOperationsJSON *bytes.Buffer
+	if len(itemOperations) > 0 {
+		itemOperationsJSON, errs = encodeToJSONGzip(itemOperations, "backup item operations list")
+		if errs != nil {
+			persistErrs = append(persistErrs, errs...)
+		}
+	}
+
 	if len(persistErrs) > 0 {
 		// Don't upload the JSON files or backup tarball if encoding to json fails.
 		backupJSON = nil
@@ -713,6 +891,7 @@ func persistBackup(backup *pkgbackup.Request,
 		backupResourceList = nil
 		csiSnapshotJSON = nil
 		csiSnapshotContentsJSON = nil
+		itemOperationsJSON = nil
 	}
 
 	backupInfo := persistence.BackupInfo{
@@ -726,6 +905,10 @@ func persistBackup(backup *pkgbackup.Request,
 		CSIVolumeSnapshots:        csiSnapshotJSON,
 		CSIVolumeSnapshotContents: csiSnapshotContentsJSON,
 	}
+	// a nil *bytes.Buffer isn't a nil io.Reader, so only set the item operations if there are any.
+	if itemOperationsJSON != nil {
+		backupInfo.ItemOperations = itemOperationsJSON
+	}
 	if err := backupStore.PutBackup(backupInfo); err != nil {
 		persistErrs = append(persistErrs, err)
 	}
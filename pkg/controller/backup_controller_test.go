@@ -27,14 +27,18 @@ import (
 	"testing"
 	"time"
 
+	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/v2/pkg/apis/volumesnapshot/v1beta1"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/version"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -43,6 +47,8 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
@@ -52,6 +58,7 @@ import (
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
+	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
 type fakeBackupper struct {
@@ -153,6 +160,44 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			backupLocation: defaultBackupLocation,
 			expectedErrs:   []string{"Invalid included/excluded namespace lists: excludes list cannot contain an item in the includes list: foo"},
 		},
+		{
+			name:           "negative snapshot TTL fails validation",
+			backup:         defaultBackup().SnapshotTTL(-time.Hour).Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"Invalid snapshot TTL: must not be negative"},
+		},
+		{
+			name: "invalid hook template fails validation",
+			backup: defaultBackup().Hooks(velerov1api.BackupHooks{
+				Resources: []velerov1api.BackupResourceHookSpec{
+					{Name: "freeze", Template: &velerov1api.HookTemplate{Name: velerov1api.HookTemplateFSFreeze}},
+				},
+			}).Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{`Invalid hook template in hook spec freeze: hook template "fsfreeze" requires a path`},
+		},
+		{
+			name: "invalid volume policy fails validation",
+			backup: defaultBackup().VolumePolicies(velerov1api.VolumePolicy{
+				MinCapacity: resource.NewQuantity(10, resource.BinarySI),
+				MaxCapacity: resource.NewQuantity(1, resource.BinarySI),
+				Action:      velerov1api.VolumePolicyActionSkip,
+			}).Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"Invalid volume policy 0: minCapacity 10 is larger than maxCapacity 1"},
+		},
+		{
+			name:           "invalid volume path pattern fails validation",
+			backup:         defaultBackup().VolumePathExcludes("node_modules", "../etc").Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{`Invalid volume path patterns: invalid volume path pattern "../etc", patterns can't refer to parent directories`},
+		},
+		{
+			name:           "non-existent resource policies configmap fails validation",
+			backup:         defaultBackup().ResourcePolicy("nonexistent").Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{`Error getting resource policies: error getting resource policies configmap velero/nonexistent: configmaps "nonexistent" not found`},
+		},
 		{
 			name:         "non-existent backup location fails validation",
 			backup:       defaultBackup().StorageLocation("nonexistent").Result(),
@@ -192,6 +237,7 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 				client:                 clientset.VeleroV1(),
 				lister:                 sharedInformers.Velero().V1().Backups().Lister(),
 				kbClient:               fakeClient,
+				configMapClient:        kubefake.NewSimpleClientset().CoreV1(),
 				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
 				defaultBackupLocation:  defaultBackupLocation.Name,
 				clock:                  &clock.RealClock{},
@@ -272,6 +318,130 @@ func TestBackupLocationLabel(t *testing.T) {
 	}
 }
 
+func TestBackupDefaultStorageLocation(t *testing.T) {
+	tests := []struct {
+		name                     string
+		backupLocations          []runtime.Object
+		expectedStorageLocation  string
+		expectedValidationErrors []string
+	}{
+		{
+			name: "location marked as the default is used instead of the server's default location",
+			backupLocations: []runtime.Object{
+				builder.ForBackupStorageLocation("velero", "default").Result(),
+				builder.ForBackupStorageLocation("velero", "loc-1").Default(true).Result(),
+			},
+			expectedStorageLocation: "loc-1",
+		},
+		{
+			name: "server's default location is used when no location is marked as the default",
+			backupLocations: []runtime.Object{
+				builder.ForBackupStorageLocation("velero", "default").Result(),
+				builder.ForBackupStorageLocation("velero", "loc-1").Result(),
+			},
+			expectedStorageLocation: "default",
+		},
+		{
+			name: "more than one location marked as the default fails validation",
+			backupLocations: []runtime.Object{
+				builder.ForBackupStorageLocation("velero", "loc-1").Default(true).Result(),
+				builder.ForBackupStorageLocation("velero", "loc-2").Default(true).Result(),
+			},
+			expectedValidationErrors: []string{"error getting the default backup storage location: more than one backup storage location is marked as the default: loc-1, loc-2"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			formatFlag := logging.FormatText
+
+			var (
+				backup          = defaultBackup().Result()
+				clientset       = fake.NewSimpleClientset(backup)
+				sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+				logger          = logging.DefaultLogger(logrus.DebugLevel, formatFlag)
+				fakeClient      = newFakeClient(t, test.backupLocations...)
+			)
+
+			apiServer := velerotest.NewAPIServer(t)
+			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+			require.NoError(t, err)
+
+			c := &backupController{
+				genericController:      newGenericController("backup-test", logger),
+				discoveryHelper:        discoveryHelper,
+				client:                 clientset.VeleroV1(),
+				lister:                 sharedInformers.Velero().V1().Backups().Lister(),
+				kbClient:               fakeClient,
+				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				defaultBackupLocation:  "default",
+				clock:                  &clock.RealClock{},
+				formatFlag:             formatFlag,
+			}
+
+			res := c.prepareBackupRequest(backup)
+			require.NotNil(t, res)
+			assert.Equal(t, test.expectedStorageLocation, res.Spec.StorageLocation)
+			assert.Equal(t, test.expectedValidationErrors, res.Status.ValidationErrors)
+			if test.expectedStorageLocation != "" {
+				require.NotNil(t, res.StorageLocation)
+				assert.Equal(t, test.expectedStorageLocation, res.StorageLocation.Name)
+			}
+		})
+	}
+}
+
+func TestBackupServerSideEncryption(t *testing.T) {
+	tests := []struct {
+		name                         string
+		backupLocation               *velerov1api.BackupStorageLocation
+		expectedServerSideEncryption *velerov1api.ServerSideEncryption
+	}{
+		{
+			name:           "backup to a location without server-side encryption doesn't record any",
+			backupLocation: builder.ForBackupStorageLocation("velero", "loc-1").Bucket("bucket").Result(),
+		},
+		{
+			name:                         "backup to a location with server-side encryption records it",
+			backupLocation:               builder.ForBackupStorageLocation("velero", "loc-1").Bucket("bucket").ServerSideEncryption("aws:kms", "key-1").Result(),
+			expectedServerSideEncryption: &velerov1api.ServerSideEncryption{Algorithm: "aws:kms", KMSKeyID: "key-1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			formatFlag := logging.FormatText
+
+			var (
+				backup          = defaultBackup().StorageLocation("loc-1").Result()
+				clientset       = fake.NewSimpleClientset(backup)
+				sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+				logger          = logging.DefaultLogger(logrus.DebugLevel, formatFlag)
+				fakeClient      = newFakeClient(t, test.backupLocation)
+			)
+
+			apiServer := velerotest.NewAPIServer(t)
+			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+			require.NoError(t, err)
+
+			c := &backupController{
+				genericController:      newGenericController("backup-test", logger),
+				discoveryHelper:        discoveryHelper,
+				client:                 clientset.VeleroV1(),
+				lister:                 sharedInformers.Velero().V1().Backups().Lister(),
+				kbClient:               fakeClient,
+				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				clock:                  &clock.RealClock{},
+				formatFlag:             formatFlag,
+			}
+
+			res := c.prepareBackupRequest(backup)
+			require.NotNil(t, res)
+			assert.Equal(t, test.expectedServerSideEncryption, res.Status.ServerSideEncryption)
+		})
+	}
+}
+
 func TestDefaultBackupTTL(t *testing.T) {
 	var (
 		defaultBackupTTL = metav1.Duration{Duration: 24 * 30 * time.Hour}
@@ -282,11 +452,12 @@ func TestDefaultBackupTTL(t *testing.T) {
 	now = now.Local()
 
 	tests := []struct {
-		name               string
-		backup             *velerov1api.Backup
-		backupLocation     *velerov1api.BackupStorageLocation
-		expectedTTL        metav1.Duration
-		expectedExpiration metav1.Time
+		name                       string
+		backup                     *velerov1api.Backup
+		backupLocation             *velerov1api.BackupStorageLocation
+		expectedTTL                metav1.Duration
+		expectedExpiration         metav1.Time
+		expectedSnapshotExpiration *metav1.Time
 	}{
 		{
 			name:               "backup with no TTL specified",
@@ -300,6 +471,19 @@ func TestDefaultBackupTTL(t *testing.T) {
 			expectedTTL:        metav1.Duration{Duration: 1 * time.Hour},
 			expectedExpiration: metav1.NewTime(now.Add(1 * time.Hour)),
 		},
+		{
+			name:                       "backup with snapshot TTL less than its TTL",
+			backup:                     defaultBackup().TTL(time.Hour).SnapshotTTL(time.Minute).Result(),
+			expectedTTL:                metav1.Duration{Duration: 1 * time.Hour},
+			expectedExpiration:         metav1.NewTime(now.Add(1 * time.Hour)),
+			expectedSnapshotExpiration: &metav1.Time{Time: now.Add(time.Minute)},
+		},
+		{
+			name:               "backup with snapshot TTL greater than its TTL",
+			backup:             defaultBackup().TTL(time.Hour).SnapshotTTL(2 * time.Hour).Result(),
+			expectedTTL:        metav1.Duration{Duration: 1 * time.Hour},
+			expectedExpiration: metav1.NewTime(now.Add(1 * time.Hour)),
+		},
 	}
 
 	for _, test := range tests {
@@ -331,6 +515,7 @@ func TestDefaultBackupTTL(t *testing.T) {
 			assert.NotNil(t, res)
 			assert.Equal(t, test.expectedTTL, res.Spec.TTL)
 			assert.Equal(t, test.expectedExpiration, *res.Status.Expiration)
+			assert.Equal(t, test.expectedSnapshotExpiration, res.Status.SnapshotExpiration)
 		})
 	}
 }
@@ -376,8 +561,9 @@ func TestProcessBackupCompletions(t *testing.T) {
 					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation:        defaultBackupLocation.Name,
-					DefaultVolumesToRestic: boolptr.True(),
+					StorageLocation:          defaultBackupLocation.Name,
+					DefaultVolumesToRestic:   boolptr.True(),
+					DefaultVolumesToFsBackup: boolptr.True(),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
@@ -412,8 +598,9 @@ func TestProcessBackupCompletions(t *testing.T) {
 					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation:        "alt-loc",
-					DefaultVolumesToRestic: boolptr.False(),
+					StorageLocation:          "alt-loc",
+					DefaultVolumesToRestic:   boolptr.False(),
+					DefaultVolumesToFsBackup: boolptr.False(),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
@@ -425,6 +612,44 @@ func TestProcessBackupCompletions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:                   "backup for a location with an object lock records when it's unlocked",
+			backup:                 defaultBackup().StorageLocation("locked").Result(),
+			backupLocation:         builder.ForBackupStorageLocation("velero", "locked").Bucket("store-1").ObjectLock(velerov1api.ObjectLockModeCompliance, time.Hour).Result(),
+			defaultVolumesToRestic: false,
+			expectedResult: &velerov1api.Backup{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Backup",
+					APIVersion: "velero.io/v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: velerov1api.DefaultNamespace,
+					Name:      "backup-1",
+					Annotations: map[string]string{
+						"velero.io/source-cluster-k8s-major-version": "1",
+						"velero.io/source-cluster-k8s-minor-version": "16",
+						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+					},
+					Labels: map[string]string{
+						"velero.io/storage-location": "locked",
+					},
+				},
+				Spec: velerov1api.BackupSpec{
+					StorageLocation:          "locked",
+					DefaultVolumesToRestic:   boolptr.False(),
+					DefaultVolumesToFsBackup: boolptr.False(),
+				},
+				Status: velerov1api.BackupStatus{
+					Phase:                 velerov1api.BackupPhaseCompleted,
+					Version:               1,
+					FormatVersion:         "1.1.0",
+					StartTimestamp:        &timestamp,
+					CompletionTimestamp:   &timestamp,
+					Expiration:            &timestamp,
+					ObjectLockRetainUntil: &metav1.Time{Time: now.Add(time.Hour)},
+				},
+			},
+		},
 		{
 			name:   "backup for a location with ReadWrite access mode gets processed",
 			backup: defaultBackup().StorageLocation("read-write").Result(),
@@ -451,8 +676,9 @@ func TestProcessBackupCompletions(t *testing.T) {
 					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation:        "read-write",
-					DefaultVolumesToRestic: boolptr.True(),
+					StorageLocation:          "read-write",
+					DefaultVolumesToRestic:   boolptr.True(),
+					DefaultVolumesToFsBackup: boolptr.True(),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
@@ -487,9 +713,10 @@ func TestProcessBackupCompletions(t *testing.T) {
 					},
 				},
 				Spec: velerov1api.BackupSpec{
-					TTL:                    metav1.Duration{Duration: 10 * time.Minute},
-					StorageLocation:        defaultBackupLocation.Name,
-					DefaultVolumesToRestic: boolptr.False(),
+					TTL:                      metav1.Duration{Duration: 10 * time.Minute},
+					StorageLocation:          defaultBackupLocation.Name,
+					DefaultVolumesToRestic:   boolptr.False(),
+					DefaultVolumesToFsBackup: boolptr.False(),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
@@ -525,8 +752,9 @@ func TestProcessBackupCompletions(t *testing.T) {
 					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation:        defaultBackupLocation.Name,
-					DefaultVolumesToRestic: boolptr.True(),
+					StorageLocation:          defaultBackupLocation.Name,
+					DefaultVolumesToRestic:   boolptr.True(),
+					DefaultVolumesToFsBackup: boolptr.True(),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
@@ -563,8 +791,48 @@ func TestProcessBackupCompletions(t *testing.T) {
 					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation:        defaultBackupLocation.Name,
-					DefaultVolumesToRestic: boolptr.False(),
+					StorageLocation:          defaultBackupLocation.Name,
+					DefaultVolumesToRestic:   boolptr.False(),
+					DefaultVolumesToFsBackup: boolptr.False(),
+				},
+				Status: velerov1api.BackupStatus{
+					Phase:               velerov1api.BackupPhaseCompleted,
+					Version:             1,
+					FormatVersion:       "1.1.0",
+					StartTimestamp:      &timestamp,
+					CompletionTimestamp: &timestamp,
+					Expiration:          &timestamp,
+				},
+			},
+		},
+		{
+			name:           "backup specifying a value for 'DefaultVolumesToFsBackup' keeps it over 'DefaultVolumesToRestic'",
+			backupExists:   false,
+			backup:         defaultBackup().DefaultVolumesToRestic(true).DefaultVolumesToFsBackup(false).Result(),
+			backupLocation: defaultBackupLocation,
+			// value set in the controller is different from that specified in the backup
+			defaultVolumesToRestic: true,
+			expectedResult: &velerov1api.Backup{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Backup",
+					APIVersion: "velero.io/v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: velerov1api.DefaultNamespace,
+					Name:      "backup-1",
+					Annotations: map[string]string{
+						"velero.io/source-cluster-k8s-major-version": "1",
+						"velero.io/source-cluster-k8s-minor-version": "16",
+						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+					},
+					Labels: map[string]string{
+						"velero.io/storage-location": "loc-1",
+					},
+				},
+				Spec: velerov1api.BackupSpec{
+					StorageLocation:          defaultBackupLocation.Name,
+					DefaultVolumesToRestic:   boolptr.False(),
+					DefaultVolumesToFsBackup: boolptr.False(),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
@@ -601,8 +869,9 @@ func TestProcessBackupCompletions(t *testing.T) {
 					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation:        defaultBackupLocation.Name,
-					DefaultVolumesToRestic: boolptr.True(),
+					StorageLocation:          defaultBackupLocation.Name,
+					DefaultVolumesToRestic:   boolptr.True(),
+					DefaultVolumesToFsBackup: boolptr.True(),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
@@ -639,8 +908,9 @@ func TestProcessBackupCompletions(t *testing.T) {
 					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation:        defaultBackupLocation.Name,
-					DefaultVolumesToRestic: boolptr.True(),
+					StorageLocation:          defaultBackupLocation.Name,
+					DefaultVolumesToRestic:   boolptr.True(),
+					DefaultVolumesToFsBackup: boolptr.True(),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
@@ -677,8 +947,9 @@ func TestProcessBackupCompletions(t *testing.T) {
 					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation:        defaultBackupLocation.Name,
-					DefaultVolumesToRestic: boolptr.False(),
+					StorageLocation:          defaultBackupLocation.Name,
+					DefaultVolumesToRestic:   boolptr.False(),
+					DefaultVolumesToFsBackup: boolptr.False(),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
@@ -716,8 +987,9 @@ func TestProcessBackupCompletions(t *testing.T) {
 					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation:        defaultBackupLocation.Name,
-					DefaultVolumesToRestic: boolptr.True(),
+					StorageLocation:          defaultBackupLocation.Name,
+					DefaultVolumesToRestic:   boolptr.True(),
+					DefaultVolumesToFsBackup: boolptr.True(),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseFailed,
@@ -753,8 +1025,9 @@ func TestProcessBackupCompletions(t *testing.T) {
 					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation:        defaultBackupLocation.Name,
-					DefaultVolumesToRestic: boolptr.True(),
+					StorageLocation:          defaultBackupLocation.Name,
+					DefaultVolumesToRestic:   boolptr.True(),
+					DefaultVolumesToFsBackup: boolptr.True(),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseFailed,
@@ -806,18 +1079,18 @@ func TestProcessBackupCompletions(t *testing.T) {
 			require.NoError(t, err)
 
 			c := &backupController{
-				genericController:      newGenericController("backup-test", logger),
-				discoveryHelper:        discoveryHelper,
-				client:                 clientset.VeleroV1(),
-				lister:                 sharedInformers.Velero().V1().Backups().Lister(),
-				kbClient:               fakeClient,
-				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
-				defaultBackupLocation:  defaultBackupLocation.Name,
-				defaultVolumesToRestic: test.defaultVolumesToRestic,
-				backupTracker:          NewBackupTracker(),
-				metrics:                metrics.NewServerMetrics(),
-				clock:                  clock.NewFakeClock(now),
-				newPluginManager:       func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				genericController:        newGenericController("backup-test", logger),
+				discoveryHelper:          discoveryHelper,
+				client:                   clientset.VeleroV1(),
+				lister:                   sharedInformers.Velero().V1().Backups().Lister(),
+				kbClient:                 fakeClient,
+				snapshotLocationLister:   sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				defaultBackupLocation:    defaultBackupLocation.Name,
+				defaultVolumesToFsBackup: test.defaultVolumesToRestic,
+				backupTracker:            NewBackupTracker(),
+				metrics:                  metrics.NewServerMetrics(),
+				clock:                    clock.NewFakeClock(now),
+				newPluginManager:         func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 				newBackupStore: func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
 					return backupStore, nil
 				},
@@ -826,6 +1099,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 			}
 
 			pluginManager.On("GetBackupItemActions").Return(nil, nil)
+			pluginManager.On("GetItemBlockActions").Return(nil, nil)
 			pluginManager.On("CleanupClients").Return(nil)
 			backupper.On("Backup", mock.Anything, mock.Anything, mock.Anything, []velero.BackupItemAction(nil), pluginManager).Return(nil)
 			backupStore.On("BackupExists", test.backupLocation.Spec.StorageType.ObjectStorage.Bucket, test.backup.Name).Return(test.backupExists, test.existenceCheckError)
@@ -1146,3 +1420,65 @@ func Test_getLastSuccessBySchedule(t *testing.T) {
 		})
 	}
 }
+
+func TestNewVolumeSnapshotOperations(t *testing.T) {
+	now := time.Now()
+	ready, notReady := true, false
+
+	snapshots := []*snapshotv1beta1api.VolumeSnapshot{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "not-taken"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "uploading"},
+			Status:     &snapshotv1beta1api.VolumeSnapshotStatus{ReadyToUse: &notReady},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ready"},
+			Status:     &snapshotv1beta1api.VolumeSnapshotStatus{ReadyToUse: &ready},
+		},
+	}
+
+	operations := newVolumeSnapshotOperations(defaultBackup().Result(), snapshots, now)
+	require.Len(t, operations, 2)
+
+	for i, name := range []string{"not-taken", "uploading"} {
+		assert.Equal(t, "backup-1", operations[i].Spec.BackupName)
+		assert.Equal(t, "ns-1/"+name, operations[i].Spec.OperationID)
+		assert.Equal(t, kuberesource.VolumeSnapshots.Resource, operations[i].Spec.Resource)
+		assert.Equal(t, name, operations[i].Spec.Name)
+		assert.Equal(t, itemoperation.OperationPhaseInProgress, operations[i].Status.Phase)
+		assert.Equal(t, now, operations[i].Status.Created.Time)
+	}
+}
+
+func TestNewPVSnapshotOperations(t *testing.T) {
+	now := time.Now()
+
+	snapshots := []*volume.Snapshot{
+		{
+			Spec:   volume.SnapshotSpec{Location: "vsl-1", PersistentVolumeName: "pv-1"},
+			Status: volume.SnapshotStatus{Phase: volume.SnapshotPhaseCompleted, ProviderSnapshotID: "snap-1"},
+		},
+		{
+			Spec:   volume.SnapshotSpec{Location: "vsl-1", PersistentVolumeName: "pv-2"},
+			Status: volume.SnapshotStatus{Phase: volume.SnapshotPhaseInProgress, ProviderSnapshotID: "snap-2", ProviderOperationID: "op-2"},
+		},
+		{
+			Spec:   volume.SnapshotSpec{Location: "vsl-1", PersistentVolumeName: "pv-3"},
+			Status: volume.SnapshotStatus{Phase: volume.SnapshotPhaseFailed},
+		},
+	}
+
+	operations := newPVSnapshotOperations(defaultBackup().Result(), snapshots, now)
+	require.Len(t, operations, 1)
+
+	assert.Equal(t, "backup-1", operations[0].Spec.BackupName)
+	assert.Equal(t, "vsl-1/op-2", operations[0].Spec.OperationID)
+	assert.Equal(t, kuberesource.PersistentVolumes.Resource, operations[0].Spec.Resource)
+	assert.Equal(t, "pv-2", operations[0].Spec.Name)
+	assert.Equal(t, "vsl-1", operations[0].Spec.VolumeSnapshotLocation)
+	assert.Equal(t, "op-2", operations[0].Spec.PluginOperationID)
+	assert.Equal(t, itemoperation.OperationPhaseInProgress, operations[0].Status.Phase)
+	assert.Equal(t, now, operations[0].Status.Created.Time)
+}
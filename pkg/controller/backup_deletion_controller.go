@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
@@ -36,6 +37,7 @@ import (
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/delete"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
@@ -52,6 +54,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/volume"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -78,6 +81,8 @@ type backupDeletionController struct {
 	clock                     clock.Clock
 	newPluginManager          func(logrus.FieldLogger) clientmgmt.Manager
 	newBackupStore            func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	credentialFileStore       credentials.FileStore
+	snapshotLimiter           *clientmgmt.SnapshotLimiter
 	metrics                   *metrics.ServerMetrics
 	helper                    discovery.Helper
 }
@@ -99,6 +104,8 @@ func NewBackupDeletionController(
 	csiSnapshotContentLister snapshotv1beta1listers.VolumeSnapshotContentLister,
 	csiSnapshotClient *snapshotterClientSet.Clientset,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
+	snapshotLimiter *clientmgmt.SnapshotLimiter,
 	metrics *metrics.ServerMetrics,
 	helper discovery.Helper,
 ) Interface {
@@ -119,10 +126,12 @@ func NewBackupDeletionController(
 		csiSnapshotClient:         csiSnapshotClient,
 		metrics:                   metrics,
 		helper:                    helper,
+		credentialFileStore:       credentialFileStore,
+		snapshotLimiter:           snapshotLimiter,
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
 		newPluginManager: newPluginManager,
-		newBackupStore:   persistence.NewObjectBackupStore,
+		newBackupStore:   persistence.NewObjectBackupStoreWithCredentials(credentialFileStore),
 
 		clock: &clock.RealClock{},
 	}
@@ -245,6 +254,15 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 		return err
 	}
 
+	// Don't allow deleting backups whose files are still locked in object storage
+	if retainUntil := backup.Status.ObjectLockRetainUntil; retainUntil != nil && c.clock.Now().Before(retainUntil.Time) {
+		_, err := c.patchDeleteBackupRequest(req, func(r *velerov1api.DeleteBackupRequest) {
+			r.Status.Phase = velerov1api.DeleteBackupRequestPhaseProcessed
+			r.Status.Errors = append(r.Status.Errors, fmt.Sprintf("cannot delete backup because it's locked in object storage until %s", retainUntil.UTC().Format(time.RFC3339)))
+		})
+		return err
+	}
+
 	// if the request object has no labels defined, initialise an empty map since
 	// we will be updating labels
 	if req.Labels == nil {
@@ -295,37 +313,17 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 		errs = append(errs, err.Error())
 	}
 
-	// Download the tarball
-	backupFile, err := downloadToTempFile(backup.Name, backupStore, log)
-	if err != nil {
-		return errors.Wrap(err, "error downloading backup")
-	}
-	defer closeAndRemoveFile(backupFile, c.logger)
-
-	actions, err := pluginManager.GetDeleteItemActions()
-	log.Debugf("%d actions before invoking actions", len(actions))
-	if err != nil {
-		return errors.Wrap(err, "error getting delete item actions")
-	}
-	// don't defer CleanupClients here, since it was already called above.
-
-	ctx := &delete.Context{
-		Backup:          backup,
-		BackupReader:    backupFile,
-		Actions:         actions,
-		Log:             c.logger,
-		DiscoveryHelper: c.helper,
-		Filesystem:      filesystem.NewFileSystem(),
-	}
-
-	// Optimization: wrap in a gofunc? Would be useful for large backups with lots of objects.
-	// but what do we do with the error returned? We can't just swallow it as that may lead to dangling resources.
-	err = delete.InvokeDeleteActions(ctx)
-	if err != nil {
-		return errors.Wrap(err, "error invoking delete item actions")
+	// a copy of a backup from another storage location shares its snapshots, and the
+	// external resources that plugins created for its items, with the original backup,
+	// so they're only deleted along with the original.
+	_, isReplica := backup.Annotations[velerov1api.ReplicatedFromAnnotation]
+	if isReplica {
+		log.Info("Backup is a copy of a backup in another storage location, not invoking delete item actions or removing its snapshots")
+	} else if err := c.invokeDeleteItemActions(backup, backupStore, pluginManager, log); err != nil {
+		return err
 	}
 
-	if backupStore != nil {
+	if backupStore != nil && !isReplica {
 		log.Info("Removing PV snapshots")
 
 		if snapshots, err := backupStore.GetBackupVolumeSnapshots(backup.Name); err != nil {
@@ -334,11 +332,16 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 			volumeSnapshotters := make(map[string]velero.VolumeSnapshotter)
 
 			for _, snapshot := range snapshots {
+				// snapshots whose snapshot TTL elapsed were already deleted by the GC controller.
+				if snapshot.Status.Phase == volume.SnapshotPhaseDeleted {
+					continue
+				}
+
 				log.WithField("providerSnapshotID", snapshot.Status.ProviderSnapshotID).Info("Removing snapshot associated with backup")
 
 				volumeSnapshotter, ok := volumeSnapshotters[snapshot.Spec.Location]
 				if !ok {
-					if volumeSnapshotter, err = volumeSnapshotterForSnapshotLocation(backup.Namespace, snapshot.Spec.Location, c.snapshotLocationLister, pluginManager); err != nil {
+					if volumeSnapshotter, err = volumeSnapshotterForSnapshotLocation(backup.Namespace, snapshot.Spec.Location, c.snapshotLocationLister, pluginManager, c.credentialFileStore, c.snapshotLimiter); err != nil {
 						errs = append(errs, err.Error())
 						continue
 					}
@@ -352,21 +355,32 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 		}
 	}
 
-	log.Info("Removing restic snapshots")
-	if deleteErrs := c.deleteResticSnapshots(backup); len(deleteErrs) > 0 {
-		for _, err := range deleteErrs {
-			errs = append(errs, err.Error())
+	if !isReplica {
+		log.Info("Removing restic snapshots")
+		if deleteErrs := c.deleteResticSnapshots(backup); len(deleteErrs) > 0 {
+			for _, err := range deleteErrs {
+				errs = append(errs, err.Error())
+			}
 		}
 	}
 
 	if backupStore != nil {
 		log.Info("Removing backup from backup storage")
 		if err := backupStore.DeleteBackup(backup.Name); err != nil {
+			if isAccessDenied(err) {
+				// the backup's files may be locked for longer than the backup records,
+				// e.g. if the bucket's default retention applies to them.
+				err = errors.Wrap(err, "access was denied when deleting backup from backup storage, its files may be locked in object storage")
+			}
 			errs = append(errs, err.Error())
 		}
 	}
 
-	if features.IsEnabled(velerov1api.CSIFeatureFlag) {
+	for _, err := range c.deleteReplicas(backup, pluginManager, log) {
+		errs = append(errs, err.Error())
+	}
+
+	if features.IsEnabled(velerov1api.CSIFeatureFlag) && !isReplica {
 		log.Info("Removing CSI volumesnapshots")
 		if csiErrs := deleteCSIVolumeSnapshots(backup.Name, c.csiSnapshotLister, c.csiSnapshotClient.SnapshotV1beta1(), log); len(csiErrs) > 0 {
 			for _, err := range csiErrs {
@@ -443,26 +457,76 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 	return nil
 }
 
+// invokeDeleteItemActions runs the delete item actions for each of the backup's items, so
+// that plugins can delete the external resources they created for them when the backup was
+// taken.
+func (c *backupDeletionController) invokeDeleteItemActions(backup *velerov1api.Backup, backupStore persistence.BackupStore, pluginManager clientmgmt.Manager, log logrus.FieldLogger) error {
+	// Download the tarball
+	backupFile, err := downloadToTempFile(backup.Name, backupStore, log)
+	if err != nil {
+		return errors.Wrap(err, "error downloading backup")
+	}
+	defer closeAndRemoveFile(backupFile, c.logger)
+
+	actions, err := pluginManager.GetDeleteItemActions()
+	log.Debugf("%d actions before invoking actions", len(actions))
+	if err != nil {
+		return errors.Wrap(err, "error getting delete item actions")
+	}
+	// don't call CleanupClients here, since the caller does.
+
+	ctx := &delete.Context{
+		Backup:          backup,
+		BackupReader:    backupFile,
+		Actions:         actions,
+		Log:             c.logger,
+		DiscoveryHelper: c.helper,
+		Filesystem:      filesystem.NewFileSystem(),
+	}
+
+	// Optimization: wrap in a gofunc? Would be useful for large backups with lots of objects.
+	// but what do we do with the error returned? We can't just swallow it as that may lead to dangling resources.
+	if err := delete.InvokeDeleteActions(ctx); err != nil {
+		return errors.Wrap(err, "error invoking delete item actions")
+	}
+
+	return nil
+}
+
+// isAccessDenied returns whether the error is an object store's access denied error.
+// Object store plugins don't return typed errors, so the error's message is checked.
+func isAccessDenied(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "accessdenied") || strings.Contains(msg, "access denied")
+}
+
 func volumeSnapshotterForSnapshotLocation(
 	namespace, snapshotLocationName string,
 	snapshotLocationLister velerov1listers.VolumeSnapshotLocationLister,
 	pluginManager clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
+	snapshotLimiter *clientmgmt.SnapshotLimiter,
 ) (velero.VolumeSnapshotter, error) {
 	snapshotLocation, err := snapshotLocationLister.VolumeSnapshotLocations(namespace).Get(snapshotLocationName)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error getting volume snapshot location %s", snapshotLocationName)
 	}
 
-	volumeSnapshotter, err := pluginManager.GetVolumeSnapshotter(snapshotLocation.Spec.Provider)
+	volumeSnapshotter, err := clientmgmt.GetVolumeSnapshotterWithEgressProxy(pluginManager, snapshotLocation.Spec.Provider, snapshotLocation.Spec.EgressProxy)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error getting volume snapshotter for provider %s", snapshotLocation.Spec.Provider)
 	}
 
-	if err = volumeSnapshotter.Init(snapshotLocation.Spec.Config); err != nil {
+	config, err := credentials.VolumeSnapshotterConfig(snapshotLocation, credentialFileStore)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting config for volume snapshot location %s", snapshotLocationName)
+	}
+
+	if err = volumeSnapshotter.Init(config); err != nil {
 		return nil, errors.Wrapf(err, "error initializing volume snapshotter for volume snapshot location %s", snapshotLocationName)
 	}
 
-	return volumeSnapshotter, nil
+	return snapshotLimiter.VolumeSnapshotter(snapshotLocation, volumeSnapshotter), nil
 }
 
 func (c *backupDeletionController) deleteExistingDeletionRequests(req *velerov1api.DeleteBackupRequest, log logrus.FieldLogger) []error {
@@ -497,11 +561,21 @@ func (c *backupDeletionController) deleteResticSnapshots(backup *velerov1api.Bac
 		return []error{err}
 	}
 
+	// snapshots that other backups reused, since their volumes hadn't changed, are still needed.
+	otherSnapshots, err := restic.GetSnapshotsInOtherBackups(backup, c.podvolumeBackupLister)
+	if err != nil {
+		return []error{err}
+	}
+
 	ctx, cancelFunc := context.WithTimeout(context.Background(), resticTimeout)
 	defer cancelFunc()
 
 	var errs []error
 	for _, snapshot := range snapshots {
+		if otherSnapshots.Has(snapshot.SnapshotID) {
+			continue
+		}
+
 		if err := c.resticMgr.Forget(ctx, snapshot); err != nil {
 			errs = append(errs, err)
 		}
@@ -648,6 +722,48 @@ func (c *backupDeletionController) patchDeleteBackupRequest(req *velerov1api.Del
 	return req, nil
 }
 
+// deleteReplicas deletes the backup's copies from its replication locations.
+func (c *backupDeletionController) deleteReplicas(backup *velerov1api.Backup, pluginManager clientmgmt.Manager, log logrus.FieldLogger) []error {
+	var errs []error
+
+	// failed and interrupted copies are removed too, since they may have left
+	// some of the backup's files behind.
+	for _, replication := range backup.Status.Replications {
+		log := log.WithField("replicationLocation", replication.Location)
+		log.Info("Removing backup's copy from backup storage")
+
+		location := &velerov1api.BackupStorageLocation{}
+		if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+			Namespace: backup.Namespace,
+			Name:      replication.Location,
+		}, location); err != nil {
+			if apierrors.IsNotFound(err) {
+				log.Warn("Backup's replication location not found, not removing its copy")
+				continue
+			}
+			errs = append(errs, errors.Wrapf(err, "error getting backup storage location %s", replication.Location))
+			continue
+		}
+
+		if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+			log.Info("Backup's replication location is in read-only mode, not removing its copy")
+			continue
+		}
+
+		backupStore, err := c.newBackupStore(location, pluginManager, log)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "error getting backup store for backup storage location %s", replication.Location))
+			continue
+		}
+
+		if err := backupStore.DeleteBackup(backup.Name); err != nil {
+			errs = append(errs, errors.Wrapf(err, "error removing backup's copy from backup storage location %s", replication.Location))
+		}
+	}
+
+	return errs
+}
+
 func (c *backupDeletionController) patchBackup(backup *velerov1api.Backup, mutate func(*velerov1api.Backup)) (*velerov1api.Backup, error) {
 	// Record original json
 	oldData, err := json.Marshal(backup)
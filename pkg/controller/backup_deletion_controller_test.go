@@ -73,6 +73,8 @@ func TestBackupDeletionControllerProcessQueueItem(t *testing.T) {
 		nil, // csiSnapshotContentLister
 		nil, // csiSnapshotClient
 		nil, // new plugin manager func
+		nil, // credential file store
+		nil, // snapshot limiter
 		metrics.NewServerMetrics(),
 		nil, // discovery helper
 	).(*backupDeletionController)
@@ -170,6 +172,8 @@ func setupBackupDeletionControllerTest(t *testing.T, objects ...runtime.Object)
 			nil, // csiSnapshotContentLister
 			nil, // csiSnapshotClient
 			func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			nil, // credential file store
+			nil, // snapshot limiter
 			metrics.NewServerMetrics(),
 			nil, // discovery helper
 		).(*backupDeletionController),
@@ -430,6 +434,34 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		assert.Equal(t, expectedActions, td.client.Actions())
 	})
 
+	t.Run("backup is locked in object storage", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").ObjectLockRetainUntil(time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)).Result()
+		location := builder.ForBackupStorageLocation("velero", "default").Result()
+
+		td := setupBackupDeletionControllerTest(t, location, backup)
+		td.controller.clock = clock.NewFakeClock(time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC))
+
+		err := td.controller.processRequest(td.req)
+		require.NoError(t, err)
+
+		expectedActions := []core.Action{
+			core.NewGetAction(
+				velerov1api.SchemeGroupVersion.WithResource("backups"),
+				td.req.Namespace,
+				td.req.Spec.BackupName,
+			),
+			core.NewPatchAction(
+				velerov1api.SchemeGroupVersion.WithResource("deletebackuprequests"),
+				td.req.Namespace,
+				td.req.Name,
+				types.MergePatchType,
+				[]byte(`{"status":{"errors":["cannot delete backup because it's locked in object storage until 2020-06-01T00:00:00Z"],"phase":"Processed"}}`),
+			),
+		}
+
+		assert.Equal(t, expectedActions, td.client.Actions())
+	})
+
 	t.Run("full delete, no errors", func(t *testing.T) {
 		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").Result()
 		backup.UID = "uid"
@@ -579,6 +611,53 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		assert.Equal(t, 0, td.volumeSnapshotter.SnapshotsTaken.Len())
 	})
 
+	t.Run("copy of a backup from another location keeps its snapshots and doesn't invoke delete item actions, and a backup's copies are deleted", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").
+			StorageLocation("primary").
+			ObjectMeta(builder.WithAnnotations(velerov1api.ReplicatedFromAnnotation, "elsewhere")).
+			Result()
+		backup.UID = "uid"
+		backup.Status.Replications = []velerov1api.BackupReplication{
+			{Location: "secondary", Phase: velerov1api.BackupReplicationPhaseCompleted},
+			{Location: "missing", Phase: velerov1api.BackupReplicationPhaseFailed},
+		}
+
+		td := setupBackupDeletionControllerTest(t, backup)
+
+		require.NoError(t, td.fakeClient.Create(context.Background(), builder.ForBackupStorageLocation(backup.Namespace, "primary").Provider("objStoreProvider").Bucket("bucket").Result()))
+		require.NoError(t, td.fakeClient.Create(context.Background(), builder.ForBackupStorageLocation(backup.Namespace, "secondary").Provider("objStoreProvider").Bucket("dr-bucket").Result()))
+
+		td.client.PrependReactor("get", "backups", func(action core.Action) (bool, runtime.Object, error) {
+			return true, backup, nil
+		})
+		td.client.PrependReactor("patch", "deletebackuprequests", func(action core.Action) (bool, runtime.Object, error) {
+			return true, td.req, nil
+		})
+		td.client.PrependReactor("patch", "backups", func(action core.Action) (bool, runtime.Object, error) {
+			return true, backup, nil
+		})
+		td.volumeSnapshotter.SnapshotsTaken.Insert("snap-1")
+
+		pluginManager := &pluginmocks.Manager{}
+		pluginManager.On("CleanupClients")
+		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
+
+		var deletedFrom []string
+		td.controller.newBackupStore = func(location *velerov1api.BackupStorageLocation, _ persistence.ObjectStoreGetter, _ logrus.FieldLogger) (persistence.BackupStore, error) {
+			deletedFrom = append(deletedFrom, location.Name)
+			return td.backupStore, nil
+		}
+		td.backupStore.On("DeleteBackup", td.req.Spec.BackupName).Return(nil).Times(2)
+
+		err := td.controller.processRequest(td.req)
+		require.NoError(t, err)
+
+		td.backupStore.AssertExpectations(t)
+		pluginManager.AssertNumberOfCalls(t, "GetDeleteItemActions", 0)
+		assert.Equal(t, []string{"primary", "secondary"}, deletedFrom)
+		assert.Equal(t, 1, td.volumeSnapshotter.SnapshotsTaken.Len())
+	})
+
 	t.Run("full delete, no errors, with backup name greater than 63 chars", func(t *testing.T) {
 		backup := defaultBackup().
 			ObjectMeta(
@@ -872,6 +951,8 @@ func TestBackupDeletionControllerDeleteExpiredRequests(t *testing.T) {
 				nil, // csiSnapshotContentLister
 				nil, // csiSnapshotClient
 				nil, // new plugin manager func
+				nil, // credential file store
+				nil, // snapshot limiter
 				metrics.NewServerMetrics(),
 				nil, // discovery helper,
 			).(*backupDeletionController)
@@ -1234,3 +1315,33 @@ func TestDeleteCSIVolumeSnapshotContents(t *testing.T) {
 		})
 	}
 }
+
+func TestIsAccessDenied(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "AWS access denied error",
+			err:  errors.New("rpc error: code = Unknown desc = AccessDenied: Access Denied\n\tstatus code: 403"),
+			want: true,
+		},
+		{
+			name: "lowercase access denied error",
+			err:  errors.New("error deleting object: access denied"),
+			want: true,
+		},
+		{
+			name: "other error",
+			err:  errors.New("error deleting object: connection refused"),
+			want: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, isAccessDenied(test.err))
+		})
+	}
+}
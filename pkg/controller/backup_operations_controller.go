@@ -0,0 +1,426 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	snapshotv1beta1listers "github.com/kubernetes-csi/external-snapshotter/v2/pkg/client/listers/volumesnapshot/v1beta1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// defaultItemOperationTimeout is how long a backup's item operations can run before
+// they're considered failed.
+const defaultItemOperationTimeout = 4 * time.Hour
+
+// backupOperationsController checks on the item operations of backups that are waiting
+// for them, like volume snapshots that are still being uploaded, and assigns the backups
+// their final phase once all of their operations have finished.
+type backupOperationsController struct {
+	*genericController
+
+	namespace              string
+	kbClient               client.Client
+	backupClient           velerov1client.BackupsGetter
+	backupLister           velerov1listers.BackupLister
+	volumeSnapshotLister   snapshotv1beta1listers.VolumeSnapshotLister
+	snapshotLocationLister velerov1listers.VolumeSnapshotLocationLister
+	snapshotLimiter        *clientmgmt.SnapshotLimiter
+	credentialFileStore    credentials.FileStore
+	operationTimeout       time.Duration
+	clock                  clock.Clock
+	metrics                *metrics.ServerMetrics
+	newPluginManager       func(logrus.FieldLogger) clientmgmt.Manager
+	newBackupStore         func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+}
+
+// NewBackupOperationsController constructs a new backupOperationsController.
+func NewBackupOperationsController(
+	logger logrus.FieldLogger,
+	namespace string,
+	kbClient client.Client,
+	backupClient velerov1client.BackupsGetter,
+	backupInformer velerov1informers.BackupInformer,
+	volumeSnapshotLister snapshotv1beta1listers.VolumeSnapshotLister,
+	snapshotLocationLister velerov1listers.VolumeSnapshotLocationLister,
+	snapshotLimiter *clientmgmt.SnapshotLimiter,
+	period time.Duration,
+	metrics *metrics.ServerMetrics,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
+) Interface {
+	c := &backupOperationsController{
+		genericController:      newGenericController("backup-operations", logger),
+		namespace:              namespace,
+		kbClient:               kbClient,
+		backupClient:           backupClient,
+		backupLister:           backupInformer.Lister(),
+		volumeSnapshotLister:   volumeSnapshotLister,
+		snapshotLocationLister: snapshotLocationLister,
+		snapshotLimiter:        snapshotLimiter,
+		credentialFileStore:    credentialFileStore,
+		operationTimeout:       defaultItemOperationTimeout,
+		clock:                  &clock.RealClock{},
+		metrics:                metrics,
+
+		// use variables to refer to these functions so they can be
+		// replaced with fakes for testing.
+		newPluginManager: newPluginManager,
+		newBackupStore:   persistence.NewObjectBackupStoreWithCredentials(credentialFileStore),
+	}
+
+	c.resyncFunc = c.run
+	c.resyncPeriod = period
+	c.cacheSyncWaiters = append(c.cacheSyncWaiters, backupInformer.Informer().HasSynced)
+
+	return c
+}
+
+func (c *backupOperationsController) run() {
+	backups, err := c.backupLister.Backups(c.namespace).List(labels.Everything())
+	if err != nil {
+		c.logger.WithError(err).Error("Error listing backups")
+		return
+	}
+
+	var waiting []*velerov1api.Backup
+	for _, backup := range backups {
+		if backup.Status.Phase == velerov1api.BackupPhaseWaitingForPluginOperations {
+			waiting = append(waiting, backup)
+		}
+	}
+	if len(waiting) == 0 {
+		return
+	}
+
+	pluginManager := c.newPluginManager(c.logger)
+	defer pluginManager.CleanupClients()
+
+	for _, backup := range waiting {
+		log := c.logger.WithField("backup", kubeutil.NamespaceAndName(backup))
+		if err := c.processBackup(backup, pluginManager, log); err != nil {
+			log.WithError(err).Error("Error checking backup's item operations")
+		}
+	}
+}
+
+// processBackup updates the status of a backup's unfinished item operations, and
+// assigns the backup its final phase if all of them have finished.
+func (c *backupOperationsController) processBackup(backup *velerov1api.Backup, pluginManager clientmgmt.Manager, log logrus.FieldLogger) error {
+	location := &velerov1api.BackupStorageLocation{}
+	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+		Namespace: backup.Namespace,
+		Name:      backup.Spec.StorageLocation,
+	}, location); err != nil {
+		return errors.Wrapf(err, "error getting backup storage location %s", backup.Spec.StorageLocation)
+	}
+
+	// the cluster that uploaded a backup to a read-only location checks on its operations.
+	if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		log.Debug("Backup storage location is in read-only mode, skipping backup")
+		return nil
+	}
+
+	backupStore, err := c.newBackupStore(location, pluginManager, log)
+	if err != nil {
+		return err
+	}
+
+	operations, err := backupStore.GetBackupItemOperations(backup.Name)
+	if err != nil {
+		return errors.Wrap(err, "error getting backup's item operations")
+	}
+
+	var updated bool
+	for _, operation := range operations {
+		if operation.Done() {
+			continue
+		}
+
+		if err := c.updateOperation(backup, operation, pluginManager, log); err != nil {
+			log.WithError(err).WithField("operation", operation.Spec.OperationID).Error("Error checking item operation")
+			continue
+		}
+		updated = true
+	}
+
+	if updated {
+		operationsJSON, errs := encodeToJSONGzip(operations, "backup item operations list")
+		if len(errs) > 0 {
+			return errors.Wrap(errs[0], "error encoding backup's item operations")
+		}
+		if err := backupStore.PutBackupItemOperations(backup.Name, operationsJSON); err != nil {
+			return errors.Wrap(err, "error uploading backup's item operations")
+		}
+	}
+
+	var completed, failed int
+	for _, operation := range operations {
+		switch operation.Status.Phase {
+		case itemoperation.OperationPhaseCompleted:
+			completed++
+		case itemoperation.OperationPhaseFailed:
+			failed++
+		default:
+			// the backup keeps waiting until all of its operations have finished.
+			return nil
+		}
+	}
+
+	for _, operation := range operations {
+		if operation.Status.Phase == itemoperation.OperationPhaseFailed {
+			log.WithField("operation", operation.Spec.OperationID).Errorf("Item operation failed: %s", operation.Status.Error)
+		}
+	}
+
+	snapshotsCompleted, err := finishPVSnapshots(backup.Name, backupStore, operations)
+	if err != nil {
+		return err
+	}
+
+	return c.finalizeBackup(backup, backupStore, completed, failed, snapshotsCompleted, log)
+}
+
+// updateOperation checks on an unfinished item operation, and updates its status.
+func (c *backupOperationsController) updateOperation(backup *velerov1api.Backup, operation *itemoperation.BackupOperation, pluginManager clientmgmt.Manager, log logrus.FieldLogger) error {
+	now := c.clock.Now()
+	operation.Status.Updated = &metav1.Time{Time: now}
+
+	if operation.Status.Created != nil && now.Sub(operation.Status.Created.Time) > c.operationTimeout {
+		operation.Status.Phase = itemoperation.OperationPhaseFailed
+		operation.Status.Error = fmt.Sprintf("timed out after %v", c.operationTimeout)
+
+		if isPVSnapshotOperation(operation) {
+			if err := c.cancelPVSnapshot(backup, operation, pluginManager); err != nil {
+				log.WithError(err).WithField("operation", operation.Spec.OperationID).Warn("Error cancelling volume snapshot that timed out")
+			}
+		}
+		return nil
+	}
+
+	switch {
+	case isPVSnapshotOperation(operation):
+		return c.updatePVSnapshotOperation(backup, operation, pluginManager)
+	case operation.Spec.Group == kuberesource.VolumeSnapshots.Group && operation.Spec.Resource == kuberesource.VolumeSnapshots.Resource:
+		return c.updateCSISnapshotOperation(operation)
+	default:
+		operation.Status.Phase = itemoperation.OperationPhaseFailed
+		operation.Status.Error = fmt.Sprintf("item operations for %s.%s aren't supported", operation.Spec.Resource, operation.Spec.Group)
+		return nil
+	}
+}
+
+// updateCSISnapshotOperation updates the status of a CSI volume snapshot's operation from
+// the volume snapshot's status.
+func (c *backupOperationsController) updateCSISnapshotOperation(operation *itemoperation.BackupOperation) error {
+	if c.volumeSnapshotLister == nil {
+		operation.Status.Phase = itemoperation.OperationPhaseFailed
+		operation.Status.Error = fmt.Sprintf("the %s feature flag isn't enabled", velerov1api.CSIFeatureFlag)
+		return nil
+	}
+
+	snapshot, err := c.volumeSnapshotLister.VolumeSnapshots(operation.Spec.Namespace).Get(operation.Spec.Name)
+	if apierrors.IsNotFound(err) {
+		operation.Status.Phase = itemoperation.OperationPhaseFailed
+		operation.Status.Error = "volume snapshot no longer exists"
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting volume snapshot")
+	}
+
+	switch {
+	case snapshot.Status == nil:
+	case snapshot.Status.Error != nil && snapshot.Status.Error.Message != nil:
+		operation.Status.Phase = itemoperation.OperationPhaseFailed
+		operation.Status.Error = fmt.Sprintf("volume snapshot failed: %s", *snapshot.Status.Error.Message)
+	case boolptr.IsSetToTrue(snapshot.Status.ReadyToUse):
+		operation.Status.Phase = itemoperation.OperationPhaseCompleted
+	}
+
+	return nil
+}
+
+// isPVSnapshotOperation returns whether an item operation is for a persistent volume's
+// snapshot that a volume snapshotter is taking.
+func isPVSnapshotOperation(operation *itemoperation.BackupOperation) bool {
+	return operation.Spec.Group == kuberesource.PersistentVolumes.Group && operation.Spec.Resource == kuberesource.PersistentVolumes.Resource
+}
+
+// pvSnapshotVolumeSnapshotter returns the initialized volume snapshotter that runs a
+// persistent volume snapshot's operation, which has to be version 2 of the API.
+func (c *backupOperationsController) pvSnapshotVolumeSnapshotter(backup *velerov1api.Backup, operation *itemoperation.BackupOperation, pluginManager clientmgmt.Manager) (velero.VolumeSnapshotterV2, error) {
+	volumeSnapshotter, err := volumeSnapshotterForSnapshotLocation(backup.Namespace, operation.Spec.VolumeSnapshotLocation, c.snapshotLocationLister, pluginManager, c.credentialFileStore, c.snapshotLimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	volumeSnapshotterV2, ok := volumeSnapshotter.(velero.VolumeSnapshotterV2)
+	if !ok {
+		return nil, errors.Errorf("volume snapshotter for volume snapshot location %s doesn't support version 2 of the VolumeSnapshotter API", operation.Spec.VolumeSnapshotLocation)
+	}
+
+	return volumeSnapshotterV2, nil
+}
+
+// updatePVSnapshotOperation updates the status of a persistent volume snapshot's operation
+// from the progress its volume snapshotter reports.
+func (c *backupOperationsController) updatePVSnapshotOperation(backup *velerov1api.Backup, operation *itemoperation.BackupOperation, pluginManager clientmgmt.Manager) error {
+	volumeSnapshotter, err := c.pvSnapshotVolumeSnapshotter(backup, operation, pluginManager)
+	if err != nil {
+		return err
+	}
+
+	progress, err := volumeSnapshotter.SnapshotProgress(operation.Spec.PluginOperationID)
+	if err != nil {
+		return errors.Wrap(err, "error getting progress of volume snapshot")
+	}
+
+	switch {
+	case progress.Err != "":
+		operation.Status.Phase = itemoperation.OperationPhaseFailed
+		operation.Status.Error = fmt.Sprintf("volume snapshot failed: %s", progress.Err)
+	case progress.Completed:
+		operation.Status.Phase = itemoperation.OperationPhaseCompleted
+	}
+
+	return nil
+}
+
+// cancelPVSnapshot cancels a persistent volume snapshot's operation.
+func (c *backupOperationsController) cancelPVSnapshot(backup *velerov1api.Backup, operation *itemoperation.BackupOperation, pluginManager clientmgmt.Manager) error {
+	volumeSnapshotter, err := c.pvSnapshotVolumeSnapshotter(backup, operation, pluginManager)
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrap(volumeSnapshotter.CancelSnapshot(operation.Spec.PluginOperationID), "error cancelling volume snapshot")
+}
+
+// finishPVSnapshots updates the phase of a backup's persistent volume snapshots that were
+// in progress from their finished operations, and returns how many of them completed.
+func finishPVSnapshots(backupName string, backupStore persistence.BackupStore, operations []*itemoperation.BackupOperation) (int, error) {
+	phases := map[string]volume.SnapshotPhase{}
+	for _, operation := range operations {
+		if !isPVSnapshotOperation(operation) {
+			continue
+		}
+		phase := volume.SnapshotPhaseFailed
+		if operation.Status.Phase == itemoperation.OperationPhaseCompleted {
+			phase = volume.SnapshotPhaseCompleted
+		}
+		phases[operation.Spec.VolumeSnapshotLocation+"/"+operation.Spec.PluginOperationID] = phase
+	}
+	if len(phases) == 0 {
+		return 0, nil
+	}
+
+	snapshots, err := backupStore.GetBackupVolumeSnapshots(backupName)
+	if err != nil {
+		return 0, errors.Wrap(err, "error getting backup's volume snapshots")
+	}
+
+	var completed int
+	for _, snapshot := range snapshots {
+		if snapshot.Status.Phase != volume.SnapshotPhaseInProgress {
+			continue
+		}
+		phase, ok := phases[snapshot.Spec.Location+"/"+snapshot.Status.ProviderOperationID]
+		if !ok {
+			continue
+		}
+		snapshot.Status.Phase = phase
+		if phase == volume.SnapshotPhaseCompleted {
+			completed++
+		}
+	}
+
+	snapshotsJSON, errs := encodeToJSONGzip(snapshots, "native volumesnapshots list")
+	if len(errs) > 0 {
+		return 0, errors.Wrap(errs[0], "error encoding backup's volume snapshots")
+	}
+	if err := backupStore.PutBackupVolumeSnapshots(backupName, snapshotsJSON); err != nil {
+		return 0, errors.Wrap(err, "error uploading backup's volume snapshots")
+	}
+
+	return completed, nil
+}
+
+// finalizeBackup assigns a backup whose item operations have all finished its final
+// phase, both in object storage and in the cluster. snapshotsCompleted is the number of
+// the backup's persistent volume snapshots that completed after its contents were uploaded.
+func (c *backupOperationsController) finalizeBackup(backup *velerov1api.Backup, backupStore persistence.BackupStore, completed, failed, snapshotsCompleted int, log logrus.FieldLogger) error {
+	updated := backup.DeepCopy()
+	updated.Status.ItemOperationsCompleted = completed
+	updated.Status.ItemOperationsFailed = failed
+	updated.Status.VolumeSnapshotsCompleted += snapshotsCompleted
+	updated.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+	if backup.Status.Errors > 0 || failed > 0 {
+		updated.Status.Phase = velerov1api.BackupPhasePartiallyFailed
+	} else {
+		updated.Status.Phase = velerov1api.BackupPhaseCompleted
+	}
+
+	backupJSON := new(bytes.Buffer)
+	if err := encode.EncodeTo(updated, "json", backupJSON); err != nil {
+		return errors.Wrap(err, "error encoding backup")
+	}
+	if err := backupStore.PutBackupMetadata(backup.Name, backupJSON); err != nil {
+		return errors.Wrap(err, "error uploading backup's metadata")
+	}
+
+	if _, err := patchBackup(backup, updated, c.backupClient); err != nil {
+		return errors.Wrapf(err, "error updating backup's phase to %s", updated.Status.Phase)
+	}
+
+	log.Infof("All of the backup's item operations have finished, backup is %s", updated.Status.Phase)
+
+	backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
+	switch updated.Status.Phase {
+	case velerov1api.BackupPhaseCompleted:
+		c.metrics.RegisterBackupSuccess(backupScheduleName)
+	case velerov1api.BackupPhasePartiallyFailed:
+		c.metrics.RegisterBackupPartialFailure(backupScheduleName)
+	}
+
+	return nil
+}
@@ -0,0 +1,357 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/v2/pkg/apis/volumesnapshot/v1beta1"
+	snapshotfake "github.com/kubernetes-csi/external-snapshotter/v2/pkg/client/clientset/versioned/fake"
+	snapshotinformers "github.com/kubernetes-csi/external-snapshotter/v2/pkg/client/informers/externalversions"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+func TestBackupOperationsControllerProcessBackup(t *testing.T) {
+	now, err := time.Parse(time.RFC1123Z, time.RFC1123Z)
+	require.NoError(t, err)
+	now = now.Local()
+
+	newOperation := func(name string, phase itemoperation.OperationPhase) *itemoperation.BackupOperation {
+		return &itemoperation.BackupOperation{
+			Spec: itemoperation.BackupOperationSpec{
+				BackupName:  "backup-1",
+				OperationID: "ns-1/" + name,
+				Group:       kuberesource.VolumeSnapshots.Group,
+				Resource:    kuberesource.VolumeSnapshots.Resource,
+				Namespace:   "ns-1",
+				Name:        name,
+			},
+			Status: itemoperation.OperationStatus{
+				Phase:   phase,
+				Created: &metav1.Time{Time: now.Add(-time.Minute)},
+			},
+		}
+	}
+
+	newSnapshot := func(name string, status *snapshotv1beta1api.VolumeSnapshotStatus) *snapshotv1beta1api.VolumeSnapshot {
+		return &snapshotv1beta1api.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: name},
+			Status:     status,
+		}
+	}
+
+	ready, notReady := true, false
+	errorMessage := "out of quota"
+
+	tests := []struct {
+		name                 string
+		backupErrors         int
+		operations           []*itemoperation.BackupOperation
+		snapshots            []*snapshotv1beta1api.VolumeSnapshot
+		expectPutOperations  bool
+		expectPhase          velerov1api.BackupPhase
+		expectOpsCompleted   int
+		expectOpsFailed      int
+		expectOperationPhase []itemoperation.OperationPhase
+	}{
+		{
+			name:                 "backup keeps waiting while a volume snapshot isn't ready",
+			operations:           []*itemoperation.BackupOperation{newOperation("snapshot-1", itemoperation.OperationPhaseInProgress)},
+			snapshots:            []*snapshotv1beta1api.VolumeSnapshot{newSnapshot("snapshot-1", &snapshotv1beta1api.VolumeSnapshotStatus{ReadyToUse: &notReady})},
+			expectPutOperations:  true,
+			expectPhase:          velerov1api.BackupPhaseWaitingForPluginOperations,
+			expectOperationPhase: []itemoperation.OperationPhase{itemoperation.OperationPhaseInProgress},
+		},
+		{
+			name: "backup is completed once all of its volume snapshots are ready",
+			operations: []*itemoperation.BackupOperation{
+				newOperation("snapshot-1", itemoperation.OperationPhaseCompleted),
+				newOperation("snapshot-2", itemoperation.OperationPhaseInProgress),
+			},
+			snapshots:            []*snapshotv1beta1api.VolumeSnapshot{newSnapshot("snapshot-2", &snapshotv1beta1api.VolumeSnapshotStatus{ReadyToUse: &ready})},
+			expectPutOperations:  true,
+			expectPhase:          velerov1api.BackupPhaseCompleted,
+			expectOpsCompleted:   2,
+			expectOperationPhase: []itemoperation.OperationPhase{itemoperation.OperationPhaseCompleted, itemoperation.OperationPhaseCompleted},
+		},
+		{
+			name:                 "backup is partially failed when a volume snapshot fails",
+			operations:           []*itemoperation.BackupOperation{newOperation("snapshot-1", itemoperation.OperationPhaseInProgress)},
+			snapshots:            []*snapshotv1beta1api.VolumeSnapshot{newSnapshot("snapshot-1", &snapshotv1beta1api.VolumeSnapshotStatus{Error: &snapshotv1beta1api.VolumeSnapshotError{Message: &errorMessage}})},
+			expectPutOperations:  true,
+			expectPhase:          velerov1api.BackupPhasePartiallyFailed,
+			expectOpsFailed:      1,
+			expectOperationPhase: []itemoperation.OperationPhase{itemoperation.OperationPhaseFailed},
+		},
+		{
+			name:                 "backup is partially failed when a volume snapshot no longer exists",
+			operations:           []*itemoperation.BackupOperation{newOperation("snapshot-1", itemoperation.OperationPhaseInProgress)},
+			expectPutOperations:  true,
+			expectPhase:          velerov1api.BackupPhasePartiallyFailed,
+			expectOpsFailed:      1,
+			expectOperationPhase: []itemoperation.OperationPhase{itemoperation.OperationPhaseFailed},
+		},
+		{
+			name:                 "backup with errors is partially failed even if its operations completed",
+			backupErrors:         1,
+			operations:           []*itemoperation.BackupOperation{newOperation("snapshot-1", itemoperation.OperationPhaseCompleted)},
+			expectPhase:          velerov1api.BackupPhasePartiallyFailed,
+			expectOpsCompleted:   1,
+			expectOperationPhase: []itemoperation.OperationPhase{itemoperation.OperationPhaseCompleted},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").
+				StorageLocation("default").
+				Phase(velerov1api.BackupPhaseWaitingForPluginOperations).
+				Result()
+			backup.Status.Errors = test.backupErrors
+			location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result()
+
+			var (
+				client            = fake.NewSimpleClientset(backup)
+				sharedInformers   = informers.NewSharedInformerFactory(client, 0)
+				snapshotInformers = snapshotinformers.NewSharedInformerFactory(snapshotfake.NewSimpleClientset(), 0)
+				backupStore       = &persistencemocks.BackupStore{}
+			)
+
+			for _, snapshot := range test.snapshots {
+				require.NoError(t, snapshotInformers.Snapshot().V1beta1().VolumeSnapshots().Informer().GetStore().Add(snapshot))
+			}
+
+			c := NewBackupOperationsController(
+				velerotest.NewLogger(),
+				velerov1api.DefaultNamespace,
+				newFakeClient(t, location),
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().Backups(),
+				snapshotInformers.Snapshot().V1beta1().VolumeSnapshots().Lister(),
+				sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				nil,
+				time.Minute,
+				metrics.NewServerMetrics(),
+				func(logrus.FieldLogger) clientmgmt.Manager { return nil },
+				nil,
+			).(*backupOperationsController)
+			c.clock = clock.NewFakeClock(now)
+			c.newBackupStore = func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+				return backupStore, nil
+			}
+
+			backupStore.On("GetBackupItemOperations", "backup-1").Return(test.operations, nil)
+			if test.expectPutOperations {
+				backupStore.On("PutBackupItemOperations", "backup-1", mock.Anything).Return(nil)
+			}
+			if test.expectPhase != velerov1api.BackupPhaseWaitingForPluginOperations {
+				backupStore.On("PutBackupMetadata", "backup-1", mock.Anything).Return(nil)
+			}
+
+			require.NoError(t, c.processBackup(backup, nil, velerotest.NewLogger()))
+			backupStore.AssertExpectations(t)
+
+			for i, operation := range test.operations {
+				assert.Equal(t, test.expectOperationPhase[i], operation.Status.Phase)
+			}
+
+			res, err := client.VeleroV1().Backups(velerov1api.DefaultNamespace).Get(context.TODO(), "backup-1", metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, test.expectPhase, res.Status.Phase)
+			assert.Equal(t, test.expectOpsCompleted, res.Status.ItemOperationsCompleted)
+			assert.Equal(t, test.expectOpsFailed, res.Status.ItemOperationsFailed)
+			if test.expectPhase != velerov1api.BackupPhaseWaitingForPluginOperations {
+				require.NotNil(t, res.Status.CompletionTimestamp)
+				assert.Equal(t, now.Unix(), res.Status.CompletionTimestamp.Unix())
+			}
+		})
+	}
+}
+
+func TestBackupOperationsControllerPVSnapshots(t *testing.T) {
+	now, err := time.Parse(time.RFC1123Z, time.RFC1123Z)
+	require.NoError(t, err)
+	now = now.Local()
+
+	tests := []struct {
+		name                     string
+		created                  time.Time
+		progress                 velero.OperationProgress
+		expectCancel             bool
+		expectPhase              velerov1api.BackupPhase
+		expectOperationPhase     itemoperation.OperationPhase
+		expectSnapshotPhase      volume.SnapshotPhase
+		expectSnapshotsCompleted int
+	}{
+		{
+			name:                 "backup keeps waiting while a volume snapshotter is taking a snapshot",
+			created:              now.Add(-time.Minute),
+			progress:             velero.OperationProgress{NCompleted: 1, NTotal: 2},
+			expectPhase:          velerov1api.BackupPhaseWaitingForPluginOperations,
+			expectOperationPhase: itemoperation.OperationPhaseInProgress,
+			expectSnapshotPhase:  volume.SnapshotPhaseInProgress,
+		},
+		{
+			name:                     "backup is completed once its volume snapshotter finishes taking a snapshot",
+			created:                  now.Add(-time.Minute),
+			progress:                 velero.OperationProgress{Completed: true},
+			expectPhase:              velerov1api.BackupPhaseCompleted,
+			expectOperationPhase:     itemoperation.OperationPhaseCompleted,
+			expectSnapshotPhase:      volume.SnapshotPhaseCompleted,
+			expectSnapshotsCompleted: 2,
+		},
+		{
+			name:                     "backup is partially failed when its volume snapshotter fails to take a snapshot",
+			created:                  now.Add(-time.Minute),
+			progress:                 velero.OperationProgress{Completed: true, Err: "out of quota"},
+			expectPhase:              velerov1api.BackupPhasePartiallyFailed,
+			expectOperationPhase:     itemoperation.OperationPhaseFailed,
+			expectSnapshotPhase:      volume.SnapshotPhaseFailed,
+			expectSnapshotsCompleted: 1,
+		},
+		{
+			name:                     "snapshot that times out is cancelled",
+			created:                  now.Add(-defaultItemOperationTimeout - time.Minute),
+			expectCancel:             true,
+			expectPhase:              velerov1api.BackupPhasePartiallyFailed,
+			expectOperationPhase:     itemoperation.OperationPhaseFailed,
+			expectSnapshotPhase:      volume.SnapshotPhaseFailed,
+			expectSnapshotsCompleted: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").
+				StorageLocation("default").
+				Phase(velerov1api.BackupPhaseWaitingForPluginOperations).
+				Result()
+			// the backup's other snapshot completed before its contents were uploaded.
+			backup.Status.VolumeSnapshotsCompleted = 1
+			location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result()
+			snapshotLocation := builder.ForVolumeSnapshotLocation(velerov1api.DefaultNamespace, "vsl-1").Provider("provider-1").Result()
+
+			operation := &itemoperation.BackupOperation{
+				Spec: itemoperation.BackupOperationSpec{
+					BackupName:             "backup-1",
+					OperationID:            "vsl-1/op-1",
+					Resource:               kuberesource.PersistentVolumes.Resource,
+					Name:                   "pv-1",
+					VolumeSnapshotLocation: "vsl-1",
+					PluginOperationID:      "op-1",
+				},
+				Status: itemoperation.OperationStatus{
+					Phase:   itemoperation.OperationPhaseInProgress,
+					Created: &metav1.Time{Time: test.created},
+				},
+			}
+			snapshots := []*volume.Snapshot{
+				{
+					Spec:   volume.SnapshotSpec{Location: "vsl-1", PersistentVolumeName: "pv-1"},
+					Status: volume.SnapshotStatus{Phase: volume.SnapshotPhaseInProgress, ProviderSnapshotID: "snap-1", ProviderOperationID: "op-1"},
+				},
+				{
+					Spec:   volume.SnapshotSpec{Location: "vsl-1", PersistentVolumeName: "pv-2"},
+					Status: volume.SnapshotStatus{Phase: volume.SnapshotPhaseCompleted, ProviderSnapshotID: "snap-2"},
+				},
+			}
+
+			var (
+				client            = fake.NewSimpleClientset(backup)
+				sharedInformers   = informers.NewSharedInformerFactory(client, 0)
+				backupStore       = &persistencemocks.BackupStore{}
+				pluginManager     = &pluginmocks.Manager{}
+				volumeSnapshotter = &providermocks.VolumeSnapshotterV2{}
+			)
+			defer volumeSnapshotter.AssertExpectations(t)
+
+			require.NoError(t, sharedInformers.Velero().V1().VolumeSnapshotLocations().Informer().GetStore().Add(snapshotLocation))
+
+			c := NewBackupOperationsController(
+				velerotest.NewLogger(),
+				velerov1api.DefaultNamespace,
+				newFakeClient(t, location),
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().Backups(),
+				nil,
+				sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				nil,
+				time.Minute,
+				metrics.NewServerMetrics(),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				nil,
+			).(*backupOperationsController)
+			c.clock = clock.NewFakeClock(now)
+			c.newBackupStore = func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+				return backupStore, nil
+			}
+
+			pluginManager.On("GetVolumeSnapshotter", "provider-1").Return(volumeSnapshotter, nil)
+			volumeSnapshotter.On("Init", mock.Anything).Return(nil)
+			if test.expectCancel {
+				volumeSnapshotter.On("CancelSnapshot", "op-1").Return(nil)
+			} else {
+				volumeSnapshotter.On("SnapshotProgress", "op-1").Return(test.progress, nil)
+			}
+
+			backupStore.On("GetBackupItemOperations", "backup-1").Return([]*itemoperation.BackupOperation{operation}, nil)
+			backupStore.On("PutBackupItemOperations", "backup-1", mock.Anything).Return(nil)
+			if test.expectPhase != velerov1api.BackupPhaseWaitingForPluginOperations {
+				backupStore.On("GetBackupVolumeSnapshots", "backup-1").Return(snapshots, nil)
+				backupStore.On("PutBackupVolumeSnapshots", "backup-1", mock.Anything).Return(nil)
+				backupStore.On("PutBackupMetadata", "backup-1", mock.Anything).Return(nil)
+			}
+
+			require.NoError(t, c.processBackup(backup, pluginManager, velerotest.NewLogger()))
+			backupStore.AssertExpectations(t)
+
+			assert.Equal(t, test.expectOperationPhase, operation.Status.Phase)
+			assert.Equal(t, test.expectSnapshotPhase, snapshots[0].Status.Phase)
+			assert.Equal(t, volume.SnapshotPhaseCompleted, snapshots[1].Status.Phase)
+
+			res, err := client.VeleroV1().Backups(velerov1api.DefaultNamespace).Get(context.TODO(), "backup-1", metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, test.expectPhase, res.Status.Phase)
+			if test.expectPhase != velerov1api.BackupPhaseWaitingForPluginOperations {
+				assert.Equal(t, test.expectSnapshotsCompleted, res.Status.VolumeSnapshotsCompleted)
+			}
+		})
+	}
+}
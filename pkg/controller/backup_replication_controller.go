@@ -0,0 +1,280 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+)
+
+// backupReplicationController copies completed backups from their storage location
+// to their replication locations, and records the status of each copy.
+type backupReplicationController struct {
+	*genericController
+
+	backupLister     velerov1listers.BackupLister
+	backupClient     velerov1client.BackupsGetter
+	kbClient         client.Client
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager
+	newBackupStore   func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	clock            clock.Clock
+}
+
+// NewBackupReplicationController constructs a new backupReplicationController.
+func NewBackupReplicationController(
+	logger logrus.FieldLogger,
+	backupInformer velerov1informers.BackupInformer,
+	backupClient velerov1client.BackupsGetter,
+	kbClient client.Client,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
+) Interface {
+	c := &backupReplicationController{
+		genericController: newGenericController("backup-replication", logger),
+		backupLister:      backupInformer.Lister(),
+		backupClient:      backupClient,
+		kbClient:          kbClient,
+		clock:             &clock.RealClock{},
+
+		// use variables to refer to these functions so they can be
+		// replaced with fakes for testing.
+		newPluginManager: newPluginManager,
+		newBackupStore:   persistence.NewObjectBackupStoreWithCredentials(credentialFileStore),
+	}
+
+	c.syncHandler = c.processQueueItem
+
+	backupInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueue,
+			UpdateFunc: func(_, obj interface{}) { c.enqueue(obj) },
+		},
+	)
+
+	return c
+}
+
+func (c *backupReplicationController) processQueueItem(key string) error {
+	log := c.logger.WithField("key", key)
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return errors.Wrap(err, "error splitting queue key")
+	}
+
+	backup, err := c.backupLister.Backups(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.Debug("Unable to find backup")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting backup")
+	}
+
+	// only backups that have been uploaded can be copied
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed:
+	default:
+		return nil
+	}
+
+	locations := pendingReplicationLocations(backup)
+	if len(locations) == 0 {
+		return nil
+	}
+
+	return c.replicate(backup.DeepCopy(), locations, log)
+}
+
+// pendingReplicationLocations returns the backup's replication locations that it
+// hasn't been copied to yet, including those whose copy was interrupted.
+func pendingReplicationLocations(backup *velerov1api.Backup) []string {
+	var pending []string
+	seen := make(map[string]bool)
+
+	for _, location := range backup.Spec.ReplicationLocations {
+		if seen[location] {
+			continue
+		}
+		seen[location] = true
+
+		switch replicationPhase(backup, location) {
+		case velerov1api.BackupReplicationPhaseCompleted, velerov1api.BackupReplicationPhaseFailed:
+			continue
+		}
+		pending = append(pending, location)
+	}
+
+	return pending
+}
+
+// replicationPhase returns the phase of the backup's copy in the given location,
+// or an empty phase if it hasn't been copied to the location.
+func replicationPhase(backup *velerov1api.Backup, location string) velerov1api.BackupReplicationPhase {
+	for _, replication := range backup.Status.Replications {
+		if replication.Location == location {
+			return replication.Phase
+		}
+	}
+
+	return ""
+}
+
+func (c *backupReplicationController) replicate(backup *velerov1api.Backup, locations []string, log logrus.FieldLogger) error {
+	log.Info("Copying backup to its replication locations")
+
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	sourceLocation := &velerov1api.BackupStorageLocation{}
+	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+		Namespace: backup.Namespace,
+		Name:      backup.Spec.StorageLocation,
+	}, sourceLocation); err != nil {
+		return errors.Wrap(err, "error getting backup storage location")
+	}
+
+	sourceStore, err := c.newBackupStore(sourceLocation, pluginManager, log)
+	if err != nil {
+		return errors.Wrap(err, "error getting backup store")
+	}
+
+	// the copies' metadata records the location they were copied from, so that
+	// deleting a copy doesn't delete the snapshots it shares with the original.
+	metadata, err := sourceStore.GetBackupMetadata(backup.Name)
+	if err != nil {
+		return errors.Wrap(err, "error getting backup metadata")
+	}
+	if metadata.Annotations == nil {
+		metadata.Annotations = make(map[string]string)
+	}
+	metadata.Annotations[velerov1api.ReplicatedFromAnnotation] = sourceLocation.Name
+
+	for _, location := range locations {
+		log := log.WithField("replicationLocation", location)
+
+		previousPhase := replicationPhase(backup, location)
+		if backup, err = c.setReplication(backup, velerov1api.BackupReplication{
+			Location: location,
+			Phase:    velerov1api.BackupReplicationPhaseInProgress,
+		}); err != nil {
+			return err
+		}
+
+		replication := velerov1api.BackupReplication{
+			Location: location,
+			Phase:    velerov1api.BackupReplicationPhaseCompleted,
+		}
+		if err := c.copyBackup(backup, location, previousPhase, sourceStore, metadata, pluginManager, log); err != nil {
+			log.WithError(err).Error("Error copying backup")
+			replication.Phase = velerov1api.BackupReplicationPhaseFailed
+			replication.Message = err.Error()
+		} else {
+			log.Info("Backup copied")
+			replication.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+		}
+
+		if backup, err = c.setReplication(backup, replication); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copyBackup copies the backup to the named replication location.
+func (c *backupReplicationController) copyBackup(
+	backup *velerov1api.Backup,
+	locationName string,
+	previousPhase velerov1api.BackupReplicationPhase,
+	sourceStore persistence.BackupStore,
+	metadata *velerov1api.Backup,
+	pluginManager clientmgmt.Manager,
+	log logrus.FieldLogger,
+) error {
+	if locationName == backup.Spec.StorageLocation {
+		return errors.New("backup can't be copied to its own storage location")
+	}
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+		Namespace: backup.Namespace,
+		Name:      locationName,
+	}, location); err != nil {
+		if apierrors.IsNotFound(err) {
+			return errors.Errorf("backup storage location %s not found", locationName)
+		}
+		return errors.Wrapf(err, "error getting backup storage location %s", locationName)
+	}
+
+	if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		return errors.Errorf("backup storage location %s is in read-only mode", locationName)
+	}
+
+	destStore, err := c.newBackupStore(location, pluginManager, log)
+	if err != nil {
+		return errors.Wrapf(err, "error getting backup store for backup storage location %s", locationName)
+	}
+
+	// a copy that was interrupted after its metadata was written is overwritten, but
+	// a different backup with the same name isn't.
+	exists, err := destStore.BackupExists(location.Spec.ObjectStorage.Bucket, backup.Name)
+	if err != nil {
+		return errors.Wrapf(err, "error checking if backup exists in backup storage location %s", locationName)
+	}
+	if exists && previousPhase != velerov1api.BackupReplicationPhaseInProgress {
+		return errors.Errorf("a backup named %s already exists in backup storage location %s", backup.Name, locationName)
+	}
+
+	return sourceStore.CopyBackup(backup.Name, destStore, metadata)
+}
+
+// setReplication records the status of the backup's copy in a replication location.
+func (c *backupReplicationController) setReplication(backup *velerov1api.Backup, replication velerov1api.BackupReplication) (*velerov1api.Backup, error) {
+	updated := backup.DeepCopy()
+	setBackupReplication(updated, replication)
+
+	return patchBackup(backup, updated, c.backupClient)
+}
+
+// setBackupReplication replaces the status of the backup's copy in the replication's
+// location, or adds it if the backup doesn't have one.
+func setBackupReplication(backup *velerov1api.Backup, replication velerov1api.BackupReplication) {
+	for i := range backup.Status.Replications {
+		if backup.Status.Replications[i].Location == replication.Location {
+			backup.Status.Replications[i] = replication
+			return
+		}
+	}
+
+	backup.Status.Replications = append(backup.Status.Replications, replication)
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+func TestBackupReplicationControllerProcessQueueItem(t *testing.T) {
+	now, err := time.Parse(time.RFC1123Z, time.RFC1123Z)
+	require.NoError(t, err)
+	now = now.Local()
+
+	locations := []runtime.Object{
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "primary").Provider("provider-1").Bucket("bucket-1").Result(),
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "secondary").Provider("provider-1").Bucket("bucket-2").Result(),
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "read-only").Provider("provider-1").Bucket("bucket-3").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+	}
+
+	tests := []struct {
+		name                 string
+		backup               *velerov1api.Backup
+		existsInDestination  bool
+		expectCopy           bool
+		expectedReplications []velerov1api.BackupReplication
+	}{
+		{
+			name:   "backup that isn't completed isn't copied",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("primary").ReplicationLocations("secondary").Phase(velerov1api.BackupPhaseInProgress).Result(),
+		},
+		{
+			name:   "completed backup without replication locations isn't copied",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("primary").Phase(velerov1api.BackupPhaseCompleted).Result(),
+		},
+		{
+			name:       "completed backup is copied to its replication location",
+			backup:     builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("primary").ReplicationLocations("secondary").Phase(velerov1api.BackupPhaseCompleted).Result(),
+			expectCopy: true,
+			expectedReplications: []velerov1api.BackupReplication{
+				{Location: "secondary", Phase: velerov1api.BackupReplicationPhaseCompleted, CompletionTimestamp: &metav1.Time{Time: now}},
+			},
+		},
+		{
+			name: "backup whose copy was interrupted is copied again",
+			backup: func() *velerov1api.Backup {
+				backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("primary").ReplicationLocations("secondary").Phase(velerov1api.BackupPhasePartiallyFailed).Result()
+				backup.Status.Replications = []velerov1api.BackupReplication{{Location: "secondary", Phase: velerov1api.BackupReplicationPhaseInProgress}}
+				return backup
+			}(),
+			existsInDestination: true,
+			expectCopy:          true,
+			expectedReplications: []velerov1api.BackupReplication{
+				{Location: "secondary", Phase: velerov1api.BackupReplicationPhaseCompleted, CompletionTimestamp: &metav1.Time{Time: now}},
+			},
+		},
+		{
+			name: "backup that's already been copied isn't copied again",
+			backup: func() *velerov1api.Backup {
+				backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("primary").ReplicationLocations("secondary").Phase(velerov1api.BackupPhaseCompleted).Result()
+				backup.Status.Replications = []velerov1api.BackupReplication{{Location: "secondary", Phase: velerov1api.BackupReplicationPhaseCompleted}}
+				return backup
+			}(),
+			expectedReplications: []velerov1api.BackupReplication{
+				{Location: "secondary", Phase: velerov1api.BackupReplicationPhaseCompleted},
+			},
+		},
+		{
+			name:                "backup isn't copied over a different backup with the same name",
+			backup:              builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("primary").ReplicationLocations("secondary").Phase(velerov1api.BackupPhaseCompleted).Result(),
+			existsInDestination: true,
+			expectedReplications: []velerov1api.BackupReplication{
+				{Location: "secondary", Phase: velerov1api.BackupReplicationPhaseFailed, Message: "a backup named backup-1 already exists in backup storage location secondary"},
+			},
+		},
+		{
+			name:   "copies to missing, read-only and the backup's own locations fail",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("primary").ReplicationLocations("missing", "read-only", "primary").Phase(velerov1api.BackupPhaseCompleted).Result(),
+			expectedReplications: []velerov1api.BackupReplication{
+				{Location: "missing", Phase: velerov1api.BackupReplicationPhaseFailed, Message: "backup storage location missing not found"},
+				{Location: "read-only", Phase: velerov1api.BackupReplicationPhaseFailed, Message: "backup storage location read-only is in read-only mode"},
+				{Location: "primary", Phase: velerov1api.BackupReplicationPhaseFailed, Message: "backup can't be copied to its own storage location"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset(test.backup)
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+				fakeClient      = newFakeClient(t, locations...)
+				pluginManager   = &pluginmocks.Manager{}
+				sourceStore     = &persistencemocks.BackupStore{}
+				destStore       = &persistencemocks.BackupStore{}
+			)
+
+			controller := NewBackupReplicationController(
+				velerotest.NewLogger(),
+				sharedInformers.Velero().V1().Backups(),
+				client.VeleroV1(),
+				fakeClient,
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				nil,
+			).(*backupReplicationController)
+			controller.clock = clock.NewFakeClock(now)
+			controller.newBackupStore = func(location *velerov1api.BackupStorageLocation, _ persistence.ObjectStoreGetter, _ logrus.FieldLogger) (persistence.BackupStore, error) {
+				if location.Name == "primary" {
+					return sourceStore, nil
+				}
+				return destStore, nil
+			}
+
+			pluginManager.On("CleanupClients").Return(nil).Maybe()
+			sourceStore.On("GetBackupMetadata", test.backup.Name).Return(test.backup.DeepCopy(), nil).Maybe()
+			destStore.On("BackupExists", "bucket-2", test.backup.Name).Return(test.existsInDestination, nil).Maybe()
+			if test.expectCopy {
+				sourceStore.On("CopyBackup", test.backup.Name, destStore, mock.MatchedBy(func(metadata *velerov1api.Backup) bool {
+					return metadata.Annotations[velerov1api.ReplicatedFromAnnotation] == "primary"
+				})).Return(nil)
+			}
+
+			require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(test.backup))
+			require.NoError(t, controller.processQueueItem(kube.NamespaceAndName(test.backup)))
+
+			sourceStore.AssertExpectations(t)
+
+			res, err := client.VeleroV1().Backups(test.backup.Namespace).Get(context.TODO(), test.backup.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedReplications, res.Status.Replications)
+		})
+	}
+}
+
+func TestPendingReplicationLocations(t *testing.T) {
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").ReplicationLocations("loc-1", "loc-2", "loc-3", "loc-4", "loc-1").Result()
+	backup.Status.Replications = []velerov1api.BackupReplication{
+		{Location: "loc-2", Phase: velerov1api.BackupReplicationPhaseCompleted},
+		{Location: "loc-3", Phase: velerov1api.BackupReplicationPhaseFailed},
+		{Location: "loc-4", Phase: velerov1api.BackupReplicationPhaseInProgress},
+	}
+
+	assert.Equal(t, []string{"loc-1", "loc-4"}, pendingReplicationLocations(backup))
+}
@@ -0,0 +1,930 @@
+/*
+Copyright 2018, 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type backupRepositoryController struct {
+	*genericController
+
+	backupRepositoryClient      velerov1client.BackupRepositoriesGetter
+	backupRepositoryLister      velerov1listers.BackupRepositoryLister
+	kbClient                    client.Client
+	repositoryManager           restic.RepositoryManager
+	defaultMaintenanceFrequency time.Duration
+	defaultCheckFrequency       time.Duration
+	newPluginManager            func(logrus.FieldLogger) clientmgmt.Manager
+	newBackupStore              func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	eventRecorder               record.EventRecorder
+	metrics                     *metrics.ServerMetrics
+
+	clock clock.Clock
+}
+
+// NewBackupRepositoryController creates a new backup repository controller.
+func NewBackupRepositoryController(
+	logger logrus.FieldLogger,
+	backupRepositoryInformer velerov1informers.BackupRepositoryInformer,
+	backupRepositoryClient velerov1client.BackupRepositoriesGetter,
+	kbClient client.Client,
+	repositoryManager restic.RepositoryManager,
+	defaultMaintenanceFrequency time.Duration,
+	defaultCheckFrequency time.Duration,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
+	eventRecorder record.EventRecorder,
+	metrics *metrics.ServerMetrics,
+) Interface {
+	c := &backupRepositoryController{
+		genericController:           newGenericController("backup-repository", logger),
+		backupRepositoryClient:      backupRepositoryClient,
+		backupRepositoryLister:      backupRepositoryInformer.Lister(),
+		kbClient:                    kbClient,
+		repositoryManager:           repositoryManager,
+		defaultMaintenanceFrequency: defaultMaintenanceFrequency,
+		defaultCheckFrequency:       defaultCheckFrequency,
+		newPluginManager:            newPluginManager,
+		newBackupStore:              persistence.NewObjectBackupStoreWithCredentials(credentialFileStore),
+		eventRecorder:               eventRecorder,
+		metrics:                     metrics,
+
+		clock: &clock.RealClock{},
+	}
+
+	if c.defaultMaintenanceFrequency <= 0 {
+		logger.Infof("Invalid default restic maintenance frequency, setting to %v", restic.DefaultMaintenanceFrequency)
+		c.defaultMaintenanceFrequency = restic.DefaultMaintenanceFrequency
+	}
+	if c.defaultCheckFrequency < 0 {
+		logger.Infof("Invalid default restic check frequency, setting to %v", restic.DefaultCheckFrequency)
+		c.defaultCheckFrequency = restic.DefaultCheckFrequency
+	}
+
+	c.syncHandler = c.processQueueItem
+
+	backupRepositoryInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: c.enqueue,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldRepo := oldObj.(*velerov1api.BackupRepository)
+				newRepo := newObj.(*velerov1api.BackupRepository)
+
+				// maintenance and migration requests are run as soon as they're made,
+				// rather than at the next resync.
+				if !reflect.DeepEqual(oldRepo.Spec.MaintenanceRequest, newRepo.Spec.MaintenanceRequest) ||
+					!reflect.DeepEqual(oldRepo.Spec.MigrationRequest, newRepo.Spec.MigrationRequest) {
+					c.enqueue(newObj)
+				}
+			},
+		},
+	)
+
+	c.resyncPeriod = 5 * time.Minute
+	c.resyncFunc = c.enqueueAllRepositories
+
+	return c
+}
+
+// enqueueAllRepositories lists all backup repositories from cache and enqueues all
+// of them so we can check each one for maintenance.
+func (c *backupRepositoryController) enqueueAllRepositories() {
+	c.logger.Debug("backupRepositoryController.enqueueAllRepositories")
+
+	repos, err := c.backupRepositoryLister.List(labels.Everything())
+	if err != nil {
+		c.logger.WithError(errors.WithStack(err)).Error("error listing backup repositories")
+		return
+	}
+
+	for _, repo := range repos {
+		c.enqueue(repo)
+	}
+}
+
+func (c *backupRepositoryController) processQueueItem(key string) error {
+	log := c.logger.WithField("key", key)
+	log.Debug("Running processQueueItem")
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Error("error splitting queue key")
+		return nil
+	}
+
+	log = c.logger.WithField("namespace", ns).WithField("name", name)
+
+	req, err := c.backupRepositoryLister.BackupRepositories(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.Debug("Unable to find BackupRepository")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting BackupRepository")
+	}
+
+	// Don't mutate the shared cache
+	reqCopy := req.DeepCopy()
+
+	if req.Status.Phase == "" || req.Status.Phase == velerov1api.BackupRepositoryPhaseNew {
+		return c.initializeRepo(reqCopy, log)
+	}
+
+	// If the repository is ready or not-ready, check it for stale locks, but if
+	// this fails for any reason, it's non-critical so we still continue on to the
+	// rest of the "process" logic.
+	log.Debug("Checking repository for stale locks")
+	if err := c.repositoryManager.UnlockRepo(reqCopy); err != nil {
+		log.WithError(err).Error("Error checking repository for stale locks")
+	}
+
+	switch req.Status.Phase {
+	case velerov1api.BackupRepositoryPhaseReady:
+		if migrationRequested(reqCopy) {
+			return c.migrateRepo(reqCopy, log)
+		}
+		return c.runMaintenanceIfDue(reqCopy, log)
+	case velerov1api.BackupRepositoryPhaseNotReady:
+		return c.checkNotReadyRepo(reqCopy, log)
+	case velerov1api.BackupRepositoryPhaseMigrating:
+		// a migration that was interrupted, such as by a restart, is resumed.
+		if migrationRequested(reqCopy) {
+			return c.migrateRepo(reqCopy, log)
+		}
+		return c.patchBackupRepository(reqCopy, repoReady())
+	}
+
+	return nil
+}
+
+func (c *backupRepositoryController) initializeRepo(req *velerov1api.BackupRepository, log logrus.FieldLogger) error {
+	log.Info("Initializing backup repository")
+
+	// confirm the repo's BackupStorageLocation is valid
+	loc := &velerov1api.BackupStorageLocation{}
+	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+		Namespace: req.Namespace,
+		Name:      req.Spec.BackupStorageLocation,
+	}, loc); err != nil {
+		return c.patchBackupRepository(req, repoNotReady(err.Error()))
+	}
+
+	// if the repository was migrated from its location, such as by the cluster that the
+	// location was used by before, it's the repository that it was migrated to.
+	loc, formerLocations, err := c.migrationTarget(req, loc, log)
+	if err != nil {
+		return c.patchBackupRepository(req, repoNotReady(err.Error()))
+	}
+
+	getRepoIdentifier := restic.GetRepoIdentifier
+	if req.Spec.UploaderType == velerov1api.UploaderTypeKopia {
+		getRepoIdentifier = restic.GetKopiaRepoIdentifier
+	}
+
+	repoIdentifier, err := getRepoIdentifier(loc, req.Spec.VolumeNamespace)
+	if err != nil {
+		return c.patchBackupRepository(req, func(r *velerov1api.BackupRepository) {
+			r.Status.Message = err.Error()
+			r.Status.Phase = velerov1api.BackupRepositoryPhaseNotReady
+
+			if r.Spec.MaintenanceFrequency.Duration <= 0 {
+				r.Spec.MaintenanceFrequency = metav1.Duration{Duration: c.defaultMaintenanceFrequency}
+			}
+			if r.Spec.CheckFrequency.Duration <= 0 {
+				r.Spec.CheckFrequency = metav1.Duration{Duration: c.defaultCheckFrequency}
+			}
+		})
+	}
+
+	// defaulting - if the patch fails, return an error so the item is returned to the queue
+	if err := c.patchBackupRepository(req, func(r *velerov1api.BackupRepository) {
+		r.Spec.RepoIdentifier = repoIdentifier
+
+		if len(formerLocations) > 0 {
+			r.Spec.BackupStorageLocation = loc.Name
+			if r.Labels == nil {
+				r.Labels = make(map[string]string)
+			}
+			r.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(loc.Name)
+			r.Status.FormerBackupStorageLocations = formerLocations
+		}
+
+		if r.Spec.MaintenanceFrequency.Duration <= 0 {
+			r.Spec.MaintenanceFrequency = metav1.Duration{Duration: c.defaultMaintenanceFrequency}
+		}
+		if r.Spec.CheckFrequency.Duration <= 0 {
+			r.Spec.CheckFrequency = metav1.Duration{Duration: c.defaultCheckFrequency}
+		}
+	}); err != nil {
+		return err
+	}
+
+	if err := ensureRepo(req, loc, c.repositoryManager); err != nil {
+		return c.patchBackupRepository(req, repoNotReady(err.Error()))
+	}
+
+	setSize := c.measureRepoSize(req, log)
+
+	return c.patchBackupRepository(req, func(req *velerov1api.BackupRepository) {
+		req.Status.Phase = velerov1api.BackupRepositoryPhaseReady
+		req.Status.LastMaintenanceTime = &metav1.Time{Time: time.Now()}
+		setSize(req)
+	})
+}
+
+// migrationTarget returns the backup storage location that the repository is in, given the
+// location that it was created for, by following the migrations that are recorded in the
+// locations, along with the locations that it was migrated from.
+func (c *backupRepositoryController) migrationTarget(req *velerov1api.BackupRepository, loc *velerov1api.BackupStorageLocation, log logrus.FieldLogger) (*velerov1api.BackupStorageLocation, []string, error) {
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	var formerLocations []string
+	for {
+		store, err := c.newBackupStore(loc, pluginManager, log)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "error getting backup store for backup storage location %s", loc.Name)
+		}
+
+		target, err := store.GetRepositoryMigration(req.Spec.UploaderType, req.Spec.VolumeNamespace)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "error getting repository migration from backup storage location %s", loc.Name)
+		}
+		if target == "" {
+			return loc, formerLocations, nil
+		}
+
+		formerLocations = append(formerLocations, loc.Name)
+		for _, former := range formerLocations {
+			if former == target {
+				return nil, nil, errors.Errorf("repository migrations recorded in backup storage locations %s form a cycle", strings.Join(formerLocations, ", "))
+			}
+		}
+
+		log.WithField("targetBackupStorageLocation", target).Infof("Repository was migrated from backup storage location %s", loc.Name)
+
+		loc = &velerov1api.BackupStorageLocation{}
+		if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+			Namespace: req.Namespace,
+			Name:      target,
+		}, loc); err != nil {
+			return nil, nil, errors.Wrapf(err, "error getting backup storage location %s that the repository was migrated to", target)
+		}
+	}
+}
+
+// measureRepoSize measures the size of the repository's data, and returns a function that
+// sets it in a repository's status. Failing to measure it is only logged, since the size is
+// informational, and the returned function then leaves the status' last size as it is.
+func (c *backupRepositoryController) measureRepoSize(req *velerov1api.BackupRepository, log logrus.FieldLogger) func(*velerov1api.BackupRepository) {
+	size, err := c.repositoryManager.RepoSize(req)
+	if err != nil {
+		log.WithError(err).Warn("error measuring the size of the repository")
+		return func(*velerov1api.BackupRepository) {}
+	}
+
+	now := c.clock.Now()
+	return func(r *velerov1api.BackupRepository) {
+		r.Status.SizeBytes = size
+		r.Status.LastSizeTime = &metav1.Time{Time: now}
+	}
+}
+
+// backupStorageLocation returns the backup storage location that the repository is stored in.
+func (c *backupRepositoryController) backupStorageLocation(req *velerov1api.BackupRepository) (*velerov1api.BackupStorageLocation, error) {
+	loc := &velerov1api.BackupStorageLocation{}
+	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+		Namespace: req.Namespace,
+		Name:      req.Spec.BackupStorageLocation,
+	}, loc); err != nil {
+		return nil, errors.Wrap(err, "error getting backup storage location")
+	}
+
+	return loc, nil
+}
+
+// ensureRepo checks to see if a repository exists, and attempts to initialize it if
+// it does not exist. An error is returned if the repository can't be connected to
+// or initialized, or if it doesn't exist and its backup storage location is read-only.
+func ensureRepo(repo *velerov1api.BackupRepository, location *velerov1api.BackupStorageLocation, repoManager restic.RepositoryManager) error {
+	if err := repoManager.ConnectToRepo(repo); err != nil {
+		// If the repository has not yet been initialized, the error message will always say
+		// so. This is the only scenario where we should try to initialize it. Other errors
+		// (e.g. "already locked") should be returned as-is since the repository does already
+		// exist, but it can't be connected to.
+		if restic.IsRepoNotInitialized(err) {
+			if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+				return errors.Errorf("repository doesn't exist and can't be initialized because backup storage location %q is in read-only mode", location.Name)
+			}
+
+			return repoManager.InitRepo(repo)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (c *backupRepositoryController) runMaintenanceIfDue(req *velerov1api.BackupRepository, log logrus.FieldLogger) error {
+	log.Debug("backupRepositoryController.runMaintenanceIfDue")
+
+	now := c.clock.Now()
+
+	operations, requested, err := maintenanceOperationsDue(req, now)
+	if err != nil {
+		log.WithError(err).Warn("error scheduling maintenance")
+		return c.patchBackupRepository(req, func(r *velerov1api.BackupRepository) {
+			r.Status.Message = err.Error()
+		})
+	}
+
+	if len(operations) == 0 {
+		log.Debug("not due for maintenance")
+		return nil
+	}
+
+	// maintenance locks the repository, and pruning deletes data from it, so it's
+	// skipped for repositories whose backup storage location is read-only.
+	loc, err := c.backupStorageLocation(req)
+	if err != nil {
+		return err
+	}
+
+	if loc.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		log.Debugf("Skipping maintenance because backup storage location %s is in read-only mode", loc.Name)
+
+		// a request is only run once, so record it as failed instead of leaving it pending.
+		if !requested {
+			return nil
+		}
+
+		results := make([]velerov1api.BackupRepositoryMaintenanceResult, 0, len(operations))
+		for _, operation := range operations {
+			results = append(results, velerov1api.BackupRepositoryMaintenanceResult{
+				Operation:           operation,
+				StartTimestamp:      &metav1.Time{Time: now},
+				CompletionTimestamp: &metav1.Time{Time: now},
+				Message:             fmt.Sprintf("backup storage location %q is in read-only mode", loc.Name),
+			})
+		}
+
+		return c.patchBackupRepository(req, func(r *velerov1api.BackupRepository) {
+			r.Status.LastMaintenanceRequest = r.Spec.MaintenanceRequest.Name
+			setMaintenanceResults(r, results)
+		})
+	}
+
+	log.WithField("operations", operations).Info("Running maintenance on backup repository")
+
+	// maintenance failures should be displayed in the `.status.message` field but
+	// should not cause the repo to move to `NotReady`.
+	var (
+		results []velerov1api.BackupRepositoryMaintenanceResult
+		message string
+	)
+	for _, operation := range operations {
+		result := velerov1api.BackupRepositoryMaintenanceResult{
+			Operation:      operation,
+			StartTimestamp: &metav1.Time{Time: c.clock.Now()},
+		}
+
+		var err error
+		switch operation {
+		case velerov1api.BackupRepositoryMaintenanceOperationPrune:
+			log.Debug("Pruning repo")
+			err = c.repositoryManager.PruneRepo(req)
+		case velerov1api.BackupRepositoryMaintenanceOperationCheck:
+			log.Debug("Checking repo")
+			err = c.repositoryManager.CheckRepo(req)
+		case velerov1api.BackupRepositoryMaintenanceOperationRotateKey:
+			log.Debug("Rotating repo key")
+			err = c.repositoryManager.RotateRepoKey(req)
+		}
+
+		result.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+		if err != nil {
+			log.WithError(err).Warnf("error running %s on repository", operation)
+			result.Message = err.Error()
+			message = err.Error()
+		} else {
+			result.Succeeded = true
+		}
+
+		if operation == velerov1api.BackupRepositoryMaintenanceOperationCheck {
+			c.recordCheckResult(req, result)
+		}
+
+		results = append(results, result)
+	}
+
+	setSize := c.measureRepoSize(req, log)
+
+	return c.patchBackupRepository(req, func(r *velerov1api.BackupRepository) {
+		setSize(r)
+		if message != "" {
+			r.Status.Message = message
+		}
+		if requested {
+			r.Status.LastMaintenanceRequest = r.Spec.MaintenanceRequest.Name
+		}
+		for _, result := range results {
+			switch result.Operation {
+			case velerov1api.BackupRepositoryMaintenanceOperationPrune:
+				r.Status.LastMaintenanceTime = &metav1.Time{Time: now}
+			case velerov1api.BackupRepositoryMaintenanceOperationCheck:
+				r.Status.LastCheckTime = &metav1.Time{Time: now}
+			}
+		}
+		setMaintenanceResults(r, results)
+	})
+}
+
+// recordCheckResult reports the result of checking the repository's data in metrics and,
+// when the check fails or passes again after failing, in an event on the repository.
+// It's called before the result is set in the repository's status.
+func (c *backupRepositoryController) recordCheckResult(req *velerov1api.BackupRepository, result velerov1api.BackupRepositoryMaintenanceResult) {
+	var failedBefore bool
+	for _, previous := range req.Status.MaintenanceResults {
+		if previous.Operation == velerov1api.BackupRepositoryMaintenanceOperationCheck {
+			failedBefore = !previous.Succeeded
+		}
+	}
+
+	if !result.Succeeded {
+		c.metrics.SetBackupRepositoryCheckFailed(req.Name, true)
+		c.eventRecorder.Eventf(req, corev1api.EventTypeWarning, "CheckFailed", "Repository check failed, its data may be corrupted: %s", result.Message)
+		return
+	}
+
+	c.metrics.SetBackupRepositoryCheckFailed(req.Name, false)
+	c.metrics.SetBackupRepositoryLastSuccessfulCheckTimestamp(req.Name, result.CompletionTimestamp.Time)
+	if failedBefore {
+		c.eventRecorder.Event(req, corev1api.EventTypeNormal, "CheckPassed", "Repository check found no errors after the previous check failed")
+	}
+}
+
+// maintenanceOperationsDue returns the maintenance operations that should be run on the
+// repository now, and whether they were requested through its maintenance request rather
+// than scheduled.
+func maintenanceOperationsDue(req *velerov1api.BackupRepository, now time.Time) ([]velerov1api.BackupRepositoryMaintenanceOperation, bool, error) {
+	if request := req.Spec.MaintenanceRequest; request != nil && request.Name != "" && request.Name != req.Status.LastMaintenanceRequest {
+		if len(request.Operations) == 0 {
+			return []velerov1api.BackupRepositoryMaintenanceOperation{
+				velerov1api.BackupRepositoryMaintenanceOperationPrune,
+				velerov1api.BackupRepositoryMaintenanceOperationCheck,
+			}, true, nil
+		}
+		return request.Operations, true, nil
+	}
+
+	open, err := inMaintenanceWindow(req.Spec.MaintenanceWindow, now)
+	if err != nil || !open {
+		return nil, false, err
+	}
+
+	var operations []velerov1api.BackupRepositoryMaintenanceOperation
+	if dueForMaintenance(req, now) {
+		operations = append(operations, velerov1api.BackupRepositoryMaintenanceOperationPrune)
+	}
+	if dueForCheck(req, now) {
+		operations = append(operations, velerov1api.BackupRepositoryMaintenanceOperationCheck)
+	}
+
+	return operations, false, nil
+}
+
+func dueForMaintenance(req *velerov1api.BackupRepository, now time.Time) bool {
+	return req.Status.LastMaintenanceTime == nil || req.Status.LastMaintenanceTime.Add(req.Spec.MaintenanceFrequency.Duration).Before(now)
+}
+
+// dueForCheck returns whether the repository's data is due to be checked. Repositories
+// without a check frequency are only checked on request.
+func dueForCheck(req *velerov1api.BackupRepository, now time.Time) bool {
+	if req.Spec.CheckFrequency.Duration <= 0 {
+		return false
+	}
+
+	return req.Status.LastCheckTime == nil || req.Status.LastCheckTime.Add(req.Spec.CheckFrequency.Duration).Before(now)
+}
+
+// inMaintenanceWindow returns whether now is in the daily maintenance window. If there's
+// no window, maintenance can be started at any time.
+func inMaintenanceWindow(window *velerov1api.BackupRepositoryMaintenanceWindow, now time.Time) (bool, error) {
+	if window == nil {
+		return true, nil
+	}
+
+	startTime, err := time.Parse("15:04", window.StartTime)
+	if err != nil {
+		return false, errors.Errorf("invalid maintenance window start time %q, must be in HH:MM format", window.StartTime)
+	}
+	if window.Duration.Duration <= 0 {
+		return false, errors.Errorf("invalid maintenance window duration %s, must be positive", window.Duration.Duration)
+	}
+
+	now = now.UTC()
+	start := time.Date(now.Year(), now.Month(), now.Day(), startTime.Hour(), startTime.Minute(), 0, 0, time.UTC)
+	if start.After(now) {
+		// the window that's open now, if any, is yesterday's
+		start = start.AddDate(0, 0, -1)
+	}
+
+	return now.Before(start.Add(window.Duration.Duration)), nil
+}
+
+// setMaintenanceResults replaces the results of the repository's maintenance operations
+// that were run with the new ones.
+func setMaintenanceResults(req *velerov1api.BackupRepository, results []velerov1api.BackupRepositoryMaintenanceResult) {
+	for _, result := range results {
+		replaced := false
+		for i := range req.Status.MaintenanceResults {
+			if req.Status.MaintenanceResults[i].Operation == result.Operation {
+				req.Status.MaintenanceResults[i] = result
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			req.Status.MaintenanceResults = append(req.Status.MaintenanceResults, result)
+		}
+	}
+}
+
+// migrationRequested returns whether the repository has a migration request that hasn't
+// been run yet.
+func migrationRequested(req *velerov1api.BackupRepository) bool {
+	request := req.Spec.MigrationRequest
+	return request != nil && request.Name != "" && request.Name != req.Status.LastMigrationRequest
+}
+
+// resumeMigration returns whether a migration of the repository to the given location
+// resumes the last one, which was interrupted or failed after copying some of the
+// repository's objects, so that the objects that were copied are overwritten.
+func resumeMigration(req *velerov1api.BackupRepository, location string) bool {
+	last := req.Status.MigrationResult
+	if last == nil || last.Succeeded || last.TargetBackupStorageLocation != location {
+		return false
+	}
+	return last.CompletionTimestamp == nil || last.ObjectsCopied > 0
+}
+
+// migrateRepo copies the repository to the backup storage location of its migration
+// request, and then updates it, and the pod volume backups whose snapshots are in it, to
+// refer to the copy. The repository remains the repository of the backups in the location
+// that it was migrated from. While it's copied, its phase is Migrating, so that it isn't
+// used for new pod volume backups, and the migration is recorded in the location that it
+// was migrated from, so that it's used in other clusters too.
+func (c *backupRepositoryController) migrateRepo(req *velerov1api.BackupRepository, log logrus.FieldLogger) error {
+	request := *req.Spec.MigrationRequest
+	log = log.WithField("targetBackupStorageLocation", request.BackupStorageLocation)
+	log.Info("Migrating backup repository")
+
+	result := &velerov1api.BackupRepositoryMigrationResult{
+		SourceBackupStorageLocation: req.Spec.BackupStorageLocation,
+		TargetBackupStorageLocation: request.BackupStorageLocation,
+		StartTimestamp:              &metav1.Time{Time: c.clock.Now()},
+	}
+
+	// record the migration as started, so that it's resumed if it's interrupted.
+	resume := resumeMigration(req, request.BackupStorageLocation)
+	if err := c.patchBackupRepository(req, func(r *velerov1api.BackupRepository) {
+		r.Status.Phase = velerov1api.BackupRepositoryPhaseMigrating
+		r.Status.MigrationResult = result.DeepCopy()
+	}); err != nil {
+		return err
+	}
+
+	oldIdentifier := req.Spec.RepoIdentifier
+	identifier, err := c.copyRepo(req, request.BackupStorageLocation, resume, result, log)
+	if err == nil {
+		err = c.updatePodVolumeBackupRepo(req.Namespace, oldIdentifier, request.BackupStorageLocation, identifier)
+	}
+
+	result.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+	if err != nil {
+		log.WithError(err).Error("Error migrating backup repository")
+		result.Message = err.Error()
+
+		return c.patchBackupRepository(req, func(r *velerov1api.BackupRepository) {
+			r.Status.Phase = velerov1api.BackupRepositoryPhaseReady
+			r.Status.LastMigrationRequest = request.Name
+			r.Status.MigrationResult = result
+		})
+	}
+
+	log.WithField("objectsCopied", result.ObjectsCopied).Info("Backup repository migrated")
+	result.Succeeded = true
+
+	return c.patchBackupRepository(req, func(r *velerov1api.BackupRepository) {
+		r.Spec.BackupStorageLocation = request.BackupStorageLocation
+		r.Spec.RepoIdentifier = identifier
+		if r.Labels == nil {
+			r.Labels = make(map[string]string)
+		}
+		r.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(request.BackupStorageLocation)
+
+		var formerLocations []string
+		for _, location := range append(r.Status.FormerBackupStorageLocations, result.SourceBackupStorageLocation) {
+			if location != request.BackupStorageLocation {
+				formerLocations = append(formerLocations, location)
+			}
+		}
+		r.Status.FormerBackupStorageLocations = formerLocations
+
+		r.Status.Phase = velerov1api.BackupRepositoryPhaseReady
+		r.Status.LastMigrationRequest = request.Name
+		r.Status.MigrationResult = result
+	})
+}
+
+// copyRepo copies the repository's objects to the given backup storage location, records
+// the number of objects that were copied in the result and the migration in the repository's
+// location, and returns the identifier of the copy.
+func (c *backupRepositoryController) copyRepo(
+	req *velerov1api.BackupRepository,
+	locationName string,
+	resume bool,
+	result *velerov1api.BackupRepositoryMigrationResult,
+	log logrus.FieldLogger,
+) (string, error) {
+	if locationName == req.Spec.BackupStorageLocation {
+		return "", errors.Errorf("repository is already in backup storage location %s", locationName)
+	}
+
+	sourceLocation, err := c.backupStorageLocation(req)
+	if err != nil {
+		return "", err
+	}
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+		Namespace: req.Namespace,
+		Name:      locationName,
+	}, location); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", errors.Errorf("backup storage location %s not found", locationName)
+		}
+		return "", errors.Wrapf(err, "error getting backup storage location %s", locationName)
+	}
+
+	if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		return "", errors.Errorf("backup storage location %s is in read-only mode", locationName)
+	}
+
+	// repositories that aren't stored in the location's restic or kopia directory can't be
+	// found from the location's backup store.
+	for _, loc := range []*velerov1api.BackupStorageLocation{sourceLocation, location} {
+		if loc.Spec.Config["resticRepoPrefix"] != "" {
+			return "", errors.Errorf("repository can't be migrated because backup storage location %s has a resticRepoPrefix", loc.Name)
+		}
+	}
+
+	getRepoIdentifier := restic.GetRepoIdentifier
+	uploaderType := velerov1api.UploaderTypeRestic
+	if req.Spec.UploaderType == velerov1api.UploaderTypeKopia {
+		getRepoIdentifier = restic.GetKopiaRepoIdentifier
+		uploaderType = velerov1api.UploaderTypeKopia
+	}
+
+	repos, err := c.backupRepositoryLister.BackupRepositories(req.Namespace).List(labels.SelectorFromSet(map[string]string{
+		velerov1api.ResticVolumeNamespaceLabel: label.GetValidName(req.Spec.VolumeNamespace),
+		velerov1api.StorageLocationLabel:       label.GetValidName(locationName),
+	}))
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	for _, repo := range repos {
+		if repo.Spec.UploaderType == uploaderType || (repo.Spec.UploaderType == "" && uploaderType == velerov1api.UploaderTypeRestic) {
+			return "", errors.Errorf("backup repository %s is already the repository of namespace %s in backup storage location %s", repo.Name, req.Spec.VolumeNamespace, locationName)
+		}
+	}
+
+	if err := c.checkNoSnapshotsTaken(req, result.StartTimestamp.Time); err != nil {
+		return "", err
+	}
+
+	identifier, err := getRepoIdentifier(location, req.Spec.VolumeNamespace)
+	if err != nil {
+		return "", err
+	}
+
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	sourceStore, err := c.newBackupStore(sourceLocation, pluginManager, log)
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting backup store for backup storage location %s", sourceLocation.Name)
+	}
+
+	destStore, err := c.newBackupStore(location, pluginManager, log)
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting backup store for backup storage location %s", locationName)
+	}
+
+	result.ObjectsCopied, err = sourceStore.CopyRepository(uploaderType, req.Spec.VolumeNamespace, destStore, resume)
+	if err != nil {
+		return "", err
+	}
+
+	// make sure that the copy can be used before the repository is updated to refer to it.
+	copied := req.DeepCopy()
+	copied.Spec.BackupStorageLocation = locationName
+	copied.Spec.RepoIdentifier = identifier
+	if err := c.repositoryManager.ConnectToRepoCopy(req, copied); err != nil {
+		return "", errors.Wrap(err, "error connecting to the copy of the repository")
+	}
+
+	// pod volume backups that got the repository before its phase was Migrating could
+	// have taken snapshots while it was copied.
+	if err := c.checkNoSnapshotsTaken(req, result.StartTimestamp.Time); err != nil {
+		return "", err
+	}
+
+	// a migration that the target location records, from when the repository was migrated
+	// from it before, no longer applies.
+	target, err := destStore.GetRepositoryMigration(uploaderType, req.Spec.VolumeNamespace)
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting repository migration from backup storage location %s", locationName)
+	}
+	if target != "" {
+		if err := destStore.PutRepositoryMigration(uploaderType, req.Spec.VolumeNamespace, ""); err != nil {
+			return "", errors.Wrapf(err, "error clearing repository migration in backup storage location %s", locationName)
+		}
+	}
+
+	if err := sourceStore.PutRepositoryMigration(uploaderType, req.Spec.VolumeNamespace, locationName); err != nil {
+		return "", errors.Wrapf(err, "error recording repository migration in backup storage location %s", sourceLocation.Name)
+	}
+
+	return identifier, nil
+}
+
+// checkNoSnapshotsTaken returns an error if a pod volume backup of the repository is in
+// progress or was started after the given time, since its snapshot could be left out of a
+// copy of the repository.
+func (c *backupRepositoryController) checkNoSnapshotsTaken(req *velerov1api.BackupRepository, since time.Time) error {
+	pvbs := &velerov1api.PodVolumeBackupList{}
+	if err := c.kbClient.List(context.Background(), pvbs, client.InNamespace(req.Namespace)); err != nil {
+		return errors.Wrap(err, "error listing pod volume backups")
+	}
+
+	for _, pvb := range pvbs.Items {
+		if pvb.Spec.RepoIdentifier != req.Spec.RepoIdentifier {
+			continue
+		}
+		switch pvb.Status.Phase {
+		case "", velerov1api.PodVolumeBackupPhaseNew, velerov1api.PodVolumeBackupPhaseInProgress:
+			return errors.Errorf("pod volume backup %s is in progress", pvb.Name)
+		}
+		if pvb.Status.StartTimestamp != nil && pvb.Status.StartTimestamp.Time.After(since) {
+			return errors.Errorf("pod volume backup %s was started while the repository was copied", pvb.Name)
+		}
+	}
+
+	return nil
+}
+
+// updatePodVolumeBackupRepo updates the pod volume backups whose snapshots are in the
+// repository with the given identifier to refer to its copy in another location.
+func (c *backupRepositoryController) updatePodVolumeBackupRepo(namespace, oldIdentifier, location, identifier string) error {
+	pvbs := &velerov1api.PodVolumeBackupList{}
+	if err := c.kbClient.List(context.Background(), pvbs, client.InNamespace(namespace)); err != nil {
+		return errors.Wrap(err, "error listing pod volume backups")
+	}
+
+	for i := range pvbs.Items {
+		pvb := &pvbs.Items[i]
+		if pvb.Spec.RepoIdentifier != oldIdentifier {
+			continue
+		}
+
+		patch := client.MergeFrom(pvb.DeepCopyObject())
+		pvb.Spec.BackupStorageLocation = location
+		pvb.Spec.RepoIdentifier = identifier
+		if err := c.kbClient.Patch(context.Background(), pvb, patch); err != nil {
+			return errors.Wrapf(err, "error updating pod volume backup %s", pvb.Name)
+		}
+	}
+
+	return nil
+}
+
+func (c *backupRepositoryController) checkNotReadyRepo(req *velerov1api.BackupRepository, log logrus.FieldLogger) error {
+	// no identifier: can't possibly be ready, so just return
+	if req.Spec.RepoIdentifier == "" {
+		return nil
+	}
+
+	log.Info("Checking backup repository for readiness")
+
+	loc, err := c.backupStorageLocation(req)
+	if err != nil {
+		return c.patchBackupRepository(req, repoNotReady(err.Error()))
+	}
+
+	// we need to ensure it (first check, if check fails, attempt to init)
+	// because we don't know if it's been successfully initialized yet.
+	if err := ensureRepo(req, loc, c.repositoryManager); err != nil {
+		return c.patchBackupRepository(req, repoNotReady(err.Error()))
+	}
+
+	return c.patchBackupRepository(req, repoReady())
+}
+
+func repoNotReady(msg string) func(*velerov1api.BackupRepository) {
+	return func(r *velerov1api.BackupRepository) {
+		r.Status.Phase = velerov1api.BackupRepositoryPhaseNotReady
+		r.Status.Message = msg
+	}
+}
+
+func repoReady() func(*velerov1api.BackupRepository) {
+	return func(r *velerov1api.BackupRepository) {
+		r.Status.Phase = velerov1api.BackupRepositoryPhaseReady
+		r.Status.Message = ""
+	}
+}
+
+// patchBackupRepository mutates req with the provided mutate function, and patches it
+// through the Kube API. After executing this function, req will be updated with both
+// the mutation and the results of the Patch() API call.
+func (c *backupRepositoryController) patchBackupRepository(req *velerov1api.BackupRepository, mutate func(*velerov1api.BackupRepository)) error {
+	// Record original json
+	oldData, err := json.Marshal(req)
+	if err != nil {
+		return errors.Wrap(err, "error marshalling original BackupRepository")
+	}
+
+	mutate(req)
+
+	// Record new json
+	newData, err := json.Marshal(req)
+	if err != nil {
+		return errors.Wrap(err, "error marshalling updated BackupRepository")
+	}
+
+	patchBytes, err := jsonpatch.CreateMergePatch(oldData, newData)
+	if err != nil {
+		return errors.Wrap(err, "error creating json merge patch for BackupRepository")
+	}
+
+	// empty patch: don't apply
+	if string(patchBytes) == "{}" {
+		return nil
+	}
+
+	// patch, and if successful, update req
+	var patched *velerov1api.BackupRepository
+	if patched, err = c.backupRepositoryClient.BackupRepositories(req.Namespace).Patch(context.TODO(), req.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		return errors.Wrap(err, "error patching BackupRepository")
+	}
+	req = patched
+
+	return nil
+}
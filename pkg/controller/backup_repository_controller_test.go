@@ -0,0 +1,745 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type fakeRepositoryManager struct {
+	restic.RepositoryManager
+
+	connectErr error
+	initCalled bool
+	size       int64
+	sizeErr    error
+}
+
+func (m *fakeRepositoryManager) ConnectToRepo(repo *velerov1api.BackupRepository) error {
+	return m.connectErr
+}
+
+func (m *fakeRepositoryManager) ConnectToRepoCopy(repo, copied *velerov1api.BackupRepository) error {
+	return m.connectErr
+}
+
+func (m *fakeRepositoryManager) InitRepo(repo *velerov1api.BackupRepository) error {
+	m.initCalled = true
+	return nil
+}
+
+func (m *fakeRepositoryManager) RepoSize(repo *velerov1api.BackupRepository) (int64, error) {
+	return m.size, m.sizeErr
+}
+
+func TestEnsureRepo(t *testing.T) {
+	notFoundErr := errors.New("Fatal: unable to open config file: Stat: The specified key does not exist.\nIs there a repository at the following location?")
+
+	tests := []struct {
+		name           string
+		accessMode     velerov1api.BackupStorageLocationAccessMode
+		connectErr     error
+		wantInitCalled bool
+		wantErr        bool
+	}{
+		{
+			name:       "existing repository is not initialized",
+			accessMode: velerov1api.BackupStorageLocationAccessModeReadWrite,
+		},
+		{
+			name:           "missing repository in a read-write location is initialized",
+			accessMode:     velerov1api.BackupStorageLocationAccessModeReadWrite,
+			connectErr:     notFoundErr,
+			wantInitCalled: true,
+		},
+		{
+			name:       "missing repository in a read-only location is not initialized",
+			accessMode: velerov1api.BackupStorageLocationAccessModeReadOnly,
+			connectErr: notFoundErr,
+			wantErr:    true,
+		},
+		{
+			name:       "other connection errors are returned as-is",
+			accessMode: velerov1api.BackupStorageLocationAccessModeReadWrite,
+			connectErr: errors.New("repository is already locked"),
+			wantErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := &velerov1api.BackupRepository{}
+			location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").AccessMode(test.accessMode).Result()
+			repoManager := &fakeRepositoryManager{connectErr: test.connectErr}
+
+			err := ensureRepo(repo, location, repoManager)
+			if test.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, test.wantInitCalled, repoManager.initCalled)
+		})
+	}
+}
+
+func TestInMaintenanceWindow(t *testing.T) {
+	now := time.Date(2020, 10, 15, 1, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		window  *velerov1api.BackupRepositoryMaintenanceWindow
+		want    bool
+		wantErr string
+	}{
+		{
+			name: "no window is always open",
+			want: true,
+		},
+		{
+			name:   "window that started earlier today is open",
+			window: &velerov1api.BackupRepositoryMaintenanceWindow{StartTime: "01:00", Duration: metav1.Duration{Duration: time.Hour}},
+			want:   true,
+		},
+		{
+			name:   "window that started earlier today is closed after its duration",
+			window: &velerov1api.BackupRepositoryMaintenanceWindow{StartTime: "00:00", Duration: metav1.Duration{Duration: time.Hour}},
+		},
+		{
+			name:   "window that starts later today is closed",
+			window: &velerov1api.BackupRepositoryMaintenanceWindow{StartTime: "22:00", Duration: metav1.Duration{Duration: 2 * time.Hour}},
+		},
+		{
+			name:   "window that started yesterday is open past midnight",
+			window: &velerov1api.BackupRepositoryMaintenanceWindow{StartTime: "23:00", Duration: metav1.Duration{Duration: 3 * time.Hour}},
+			want:   true,
+		},
+		{
+			name:    "invalid start time is an error",
+			window:  &velerov1api.BackupRepositoryMaintenanceWindow{StartTime: "1am", Duration: metav1.Duration{Duration: time.Hour}},
+			wantErr: `invalid maintenance window start time "1am", must be in HH:MM format`,
+		},
+		{
+			name:    "zero duration is an error",
+			window:  &velerov1api.BackupRepositoryMaintenanceWindow{StartTime: "01:00"},
+			wantErr: "invalid maintenance window duration 0s, must be positive",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			open, err := inMaintenanceWindow(test.window, now)
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, open)
+		})
+	}
+}
+
+func TestMaintenanceOperationsDue(t *testing.T) {
+	now := time.Date(2020, 10, 15, 1, 30, 0, 0, time.UTC)
+	recently := &metav1.Time{Time: now.Add(-time.Hour)}
+	closedWindow := &velerov1api.BackupRepositoryMaintenanceWindow{StartTime: "22:00", Duration: metav1.Duration{Duration: time.Hour}}
+
+	tests := []struct {
+		name          string
+		spec          velerov1api.BackupRepositorySpec
+		status        velerov1api.BackupRepositoryStatus
+		wantOps       []velerov1api.BackupRepositoryMaintenanceOperation
+		wantRequested bool
+	}{
+		{
+			name:    "never maintained repository is pruned",
+			spec:    velerov1api.BackupRepositorySpec{MaintenanceFrequency: metav1.Duration{Duration: 24 * time.Hour}},
+			wantOps: []velerov1api.BackupRepositoryMaintenanceOperation{velerov1api.BackupRepositoryMaintenanceOperationPrune},
+		},
+		{
+			name:   "recently maintained repository isn't due",
+			spec:   velerov1api.BackupRepositorySpec{MaintenanceFrequency: metav1.Duration{Duration: 24 * time.Hour}},
+			status: velerov1api.BackupRepositoryStatus{LastMaintenanceTime: recently},
+		},
+		{
+			name: "repository with a check frequency is checked",
+			spec: velerov1api.BackupRepositorySpec{
+				MaintenanceFrequency: metav1.Duration{Duration: 24 * time.Hour},
+				CheckFrequency:       metav1.Duration{Duration: 30 * time.Minute},
+			},
+			status:  velerov1api.BackupRepositoryStatus{LastMaintenanceTime: recently, LastCheckTime: recently},
+			wantOps: []velerov1api.BackupRepositoryMaintenanceOperation{velerov1api.BackupRepositoryMaintenanceOperationCheck},
+		},
+		{
+			name: "due maintenance waits for the maintenance window",
+			spec: velerov1api.BackupRepositorySpec{
+				MaintenanceFrequency: metav1.Duration{Duration: 24 * time.Hour},
+				MaintenanceWindow:    closedWindow,
+			},
+		},
+		{
+			name: "new request without operations prunes and checks regardless of the window",
+			spec: velerov1api.BackupRepositorySpec{
+				MaintenanceFrequency: metav1.Duration{Duration: 24 * time.Hour},
+				MaintenanceWindow:    closedWindow,
+				MaintenanceRequest:   &velerov1api.BackupRepositoryMaintenanceRequest{Name: "request-2"},
+			},
+			status: velerov1api.BackupRepositoryStatus{LastMaintenanceTime: recently, LastMaintenanceRequest: "request-1"},
+			wantOps: []velerov1api.BackupRepositoryMaintenanceOperation{
+				velerov1api.BackupRepositoryMaintenanceOperationPrune,
+				velerov1api.BackupRepositoryMaintenanceOperationCheck,
+			},
+			wantRequested: true,
+		},
+		{
+			name: "new request runs only its operations",
+			spec: velerov1api.BackupRepositorySpec{
+				MaintenanceRequest: &velerov1api.BackupRepositoryMaintenanceRequest{
+					Name:       "request-1",
+					Operations: []velerov1api.BackupRepositoryMaintenanceOperation{velerov1api.BackupRepositoryMaintenanceOperationCheck},
+				},
+			},
+			status:        velerov1api.BackupRepositoryStatus{LastMaintenanceTime: recently},
+			wantOps:       []velerov1api.BackupRepositoryMaintenanceOperation{velerov1api.BackupRepositoryMaintenanceOperationCheck},
+			wantRequested: true,
+		},
+		{
+			name: "new request to rotate the key only rotates it",
+			spec: velerov1api.BackupRepositorySpec{
+				MaintenanceRequest: &velerov1api.BackupRepositoryMaintenanceRequest{
+					Name:       "rotate-1",
+					Operations: []velerov1api.BackupRepositoryMaintenanceOperation{velerov1api.BackupRepositoryMaintenanceOperationRotateKey},
+				},
+			},
+			status:        velerov1api.BackupRepositoryStatus{LastMaintenanceTime: recently},
+			wantOps:       []velerov1api.BackupRepositoryMaintenanceOperation{velerov1api.BackupRepositoryMaintenanceOperationRotateKey},
+			wantRequested: true,
+		},
+		{
+			name: "request that was already run isn't run again",
+			spec: velerov1api.BackupRepositorySpec{
+				MaintenanceFrequency: metav1.Duration{Duration: 24 * time.Hour},
+				MaintenanceRequest:   &velerov1api.BackupRepositoryMaintenanceRequest{Name: "request-1"},
+			},
+			status: velerov1api.BackupRepositoryStatus{LastMaintenanceTime: recently, LastMaintenanceRequest: "request-1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := &velerov1api.BackupRepository{Spec: test.spec, Status: test.status}
+
+			ops, requested, err := maintenanceOperationsDue(repo, now)
+			require.NoError(t, err)
+			assert.Equal(t, test.wantOps, ops)
+			assert.Equal(t, test.wantRequested, requested)
+		})
+	}
+}
+
+func TestSetMaintenanceResults(t *testing.T) {
+	repo := &velerov1api.BackupRepository{
+		Status: velerov1api.BackupRepositoryStatus{
+			MaintenanceResults: []velerov1api.BackupRepositoryMaintenanceResult{
+				{Operation: velerov1api.BackupRepositoryMaintenanceOperationPrune, Message: "repository is already locked"},
+			},
+		},
+	}
+
+	setMaintenanceResults(repo, []velerov1api.BackupRepositoryMaintenanceResult{
+		{Operation: velerov1api.BackupRepositoryMaintenanceOperationPrune, Succeeded: true},
+		{Operation: velerov1api.BackupRepositoryMaintenanceOperationCheck, Succeeded: true},
+	})
+
+	assert.Equal(t, []velerov1api.BackupRepositoryMaintenanceResult{
+		{Operation: velerov1api.BackupRepositoryMaintenanceOperationPrune, Succeeded: true},
+		{Operation: velerov1api.BackupRepositoryMaintenanceOperationCheck, Succeeded: true},
+	}, repo.Status.MaintenanceResults)
+}
+
+func TestRecordCheckResult(t *testing.T) {
+	now := metav1.Now()
+
+	checkResult := func(succeeded bool) velerov1api.BackupRepositoryMaintenanceResult {
+		result := velerov1api.BackupRepositoryMaintenanceResult{
+			Operation:           velerov1api.BackupRepositoryMaintenanceOperationCheck,
+			StartTimestamp:      &now,
+			CompletionTimestamp: &now,
+			Succeeded:           succeeded,
+		}
+		if !succeeded {
+			result.Message = "Fatal: repository contains errors"
+		}
+		return result
+	}
+
+	tests := []struct {
+		name           string
+		previous       []velerov1api.BackupRepositoryMaintenanceResult
+		result         velerov1api.BackupRepositoryMaintenanceResult
+		expectedEvents []string
+	}{
+		{
+			name:           "failed check records a warning event",
+			result:         checkResult(false),
+			expectedEvents: []string{"Warning CheckFailed Repository check failed, its data may be corrupted: Fatal: repository contains errors"},
+		},
+		{
+			name:           "failed check after a failed check records another warning event",
+			previous:       []velerov1api.BackupRepositoryMaintenanceResult{checkResult(false)},
+			result:         checkResult(false),
+			expectedEvents: []string{"Warning CheckFailed Repository check failed, its data may be corrupted: Fatal: repository contains errors"},
+		},
+		{
+			name:   "passed check without a failed check before records no event",
+			result: checkResult(true),
+		},
+		{
+			name: "passed check after a failed prune records no event",
+			previous: []velerov1api.BackupRepositoryMaintenanceResult{
+				{Operation: velerov1api.BackupRepositoryMaintenanceOperationPrune},
+				checkResult(true),
+			},
+			result: checkResult(true),
+		},
+		{
+			name:           "passed check after a failed check records a normal event",
+			previous:       []velerov1api.BackupRepositoryMaintenanceResult{checkResult(false)},
+			result:         checkResult(true),
+			expectedEvents: []string{"Normal CheckPassed Repository check found no errors after the previous check failed"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			c := &backupRepositoryController{
+				eventRecorder: recorder,
+				metrics:       metrics.NewServerMetrics(),
+			}
+
+			repo := &velerov1api.BackupRepository{
+				ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "repo-1"},
+				Status: velerov1api.BackupRepositoryStatus{
+					MaintenanceResults: test.previous,
+				},
+			}
+
+			c.recordCheckResult(repo, test.result)
+			close(recorder.Events)
+
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			assert.Equal(t, test.expectedEvents, events)
+		})
+	}
+}
+
+func TestMeasureRepoSize(t *testing.T) {
+	now := time.Date(2020, 10, 15, 1, 30, 0, 0, time.UTC)
+	earlier := metav1.NewTime(now.Add(-time.Hour))
+
+	tests := []struct {
+		name             string
+		size             int64
+		sizeErr          error
+		expectedSize     int64
+		expectedSizeTime *metav1.Time
+	}{
+		{
+			name:             "measured size is set with the time it was measured",
+			size:             1 << 30,
+			expectedSize:     1 << 30,
+			expectedSizeTime: &metav1.Time{Time: now},
+		},
+		{
+			name:             "size that failed to be measured is left as it was",
+			sizeErr:          errors.New("error running command"),
+			expectedSize:     1 << 20,
+			expectedSizeTime: &earlier,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &backupRepositoryController{
+				repositoryManager: &fakeRepositoryManager{size: test.size, sizeErr: test.sizeErr},
+				clock:             clock.NewFakeClock(now),
+			}
+
+			repo := &velerov1api.BackupRepository{
+				Status: velerov1api.BackupRepositoryStatus{
+					SizeBytes:    1 << 20,
+					LastSizeTime: &earlier,
+				},
+			}
+
+			c.measureRepoSize(repo, velerotest.NewLogger())(repo)
+
+			assert.Equal(t, test.expectedSize, repo.Status.SizeBytes)
+			assert.Equal(t, test.expectedSizeTime, repo.Status.LastSizeTime)
+		})
+	}
+}
+
+func TestResumeMigration(t *testing.T) {
+	tests := []struct {
+		name   string
+		result *velerov1api.BackupRepositoryMigrationResult
+		want   bool
+	}{
+		{
+			name: "repository that wasn't migrated",
+		},
+		{
+			name:   "interrupted migration to the location is resumed",
+			result: &velerov1api.BackupRepositoryMigrationResult{TargetBackupStorageLocation: "secondary"},
+			want:   true,
+		},
+		{
+			name:   "migration to the location that failed after copying objects is resumed",
+			result: &velerov1api.BackupRepositoryMigrationResult{TargetBackupStorageLocation: "secondary", CompletionTimestamp: &metav1.Time{}, ObjectsCopied: 1},
+			want:   true,
+		},
+		{
+			name:   "migration to the location that failed before copying objects isn't resumed",
+			result: &velerov1api.BackupRepositoryMigrationResult{TargetBackupStorageLocation: "secondary", CompletionTimestamp: &metav1.Time{}},
+		},
+		{
+			name:   "interrupted migration to another location isn't resumed",
+			result: &velerov1api.BackupRepositoryMigrationResult{TargetBackupStorageLocation: "other"},
+		},
+		{
+			name:   "migration that succeeded isn't resumed",
+			result: &velerov1api.BackupRepositoryMigrationResult{TargetBackupStorageLocation: "secondary", CompletionTimestamp: &metav1.Time{}, ObjectsCopied: 1, Succeeded: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := &velerov1api.BackupRepository{Status: velerov1api.BackupRepositoryStatus{MigrationResult: test.result}}
+			assert.Equal(t, test.want, resumeMigration(repo, "secondary"))
+		})
+	}
+}
+
+func TestMigrateRepo(t *testing.T) {
+	now, err := time.Parse(time.RFC1123Z, time.RFC1123Z)
+	require.NoError(t, err)
+	now = now.Local()
+
+	const (
+		oldIdentifier = "gs:bucket-1:/restic/ns-1"
+		newIdentifier = "gs:bucket-2:/restic/ns-1"
+	)
+
+	locations := []runtime.Object{
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "primary").Provider("gcp").Bucket("bucket-1").Result(),
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "secondary").Provider("gcp").Bucket("bucket-2").Result(),
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "read-only").Provider("gcp").Bucket("bucket-3").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+	}
+
+	newPVB := func(name, identifier string, phase velerov1api.PodVolumeBackupPhase) *velerov1api.PodVolumeBackup {
+		pvb := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, name).Phase(phase).Result()
+		pvb.Spec.BackupStorageLocation = "primary"
+		pvb.Spec.RepoIdentifier = identifier
+		return pvb
+	}
+
+	tests := []struct {
+		name              string
+		target            string
+		pvbs              []*velerov1api.PodVolumeBackup
+		status            velerov1api.BackupRepositoryStatus
+		expectCopy        bool
+		expectOverwrite   bool
+		expectedMessage   string
+		expectedFormerBSL []string
+	}{
+		{
+			name:   "repository is copied and its pod volume backups are updated",
+			target: "secondary",
+			pvbs: []*velerov1api.PodVolumeBackup{
+				newPVB("pvb-1", oldIdentifier, velerov1api.PodVolumeBackupPhaseCompleted),
+				newPVB("pvb-2", "gs:bucket-1:/restic/ns-2", velerov1api.PodVolumeBackupPhaseInProgress),
+			},
+			expectCopy:        true,
+			expectedFormerBSL: []string{"primary"},
+		},
+		{
+			name:   "interrupted migration is resumed, and the repository is no longer in the target's former locations",
+			target: "secondary",
+			status: velerov1api.BackupRepositoryStatus{
+				FormerBackupStorageLocations: []string{"secondary", "old"},
+				MigrationResult:              &velerov1api.BackupRepositoryMigrationResult{TargetBackupStorageLocation: "secondary"},
+			},
+			expectCopy:        true,
+			expectOverwrite:   true,
+			expectedFormerBSL: []string{"old", "primary"},
+		},
+		{
+			name:            "repository isn't copied while one of its pod volume backups is in progress",
+			target:          "secondary",
+			pvbs:            []*velerov1api.PodVolumeBackup{newPVB("pvb-1", oldIdentifier, velerov1api.PodVolumeBackupPhaseInProgress)},
+			expectedMessage: "pod volume backup pvb-1 is in progress",
+		},
+		{
+			name:   "repository isn't copied while pod volume backups that started after the migration take snapshots",
+			target: "secondary",
+			pvbs: []*velerov1api.PodVolumeBackup{
+				func() *velerov1api.PodVolumeBackup {
+					pvb := newPVB("pvb-1", oldIdentifier, velerov1api.PodVolumeBackupPhaseCompleted)
+					pvb.Status.StartTimestamp = &metav1.Time{Time: now.Add(time.Second)}
+					return pvb
+				}(),
+			},
+			expectedMessage: "pod volume backup pvb-1 was started while the repository was copied",
+		},
+		{
+			name:            "repository isn't copied to a read-only location",
+			target:          "read-only",
+			expectedMessage: "backup storage location read-only is in read-only mode",
+		},
+		{
+			name:            "repository isn't copied to a missing location",
+			target:          "missing",
+			expectedMessage: "backup storage location missing not found",
+		},
+		{
+			name:            "repository isn't copied to its own location",
+			target:          "primary",
+			expectedMessage: "repository is already in backup storage location primary",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := &velerov1api.BackupRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: velerov1api.DefaultNamespace,
+					Name:      "repo-1",
+					Labels: map[string]string{
+						velerov1api.ResticVolumeNamespaceLabel: "ns-1",
+						velerov1api.StorageLocationLabel:       "primary",
+					},
+				},
+				Spec: velerov1api.BackupRepositorySpec{
+					VolumeNamespace:       "ns-1",
+					BackupStorageLocation: "primary",
+					RepoIdentifier:        oldIdentifier,
+					MigrationRequest:      &velerov1api.BackupRepositoryMigrationRequest{Name: "request-1", BackupStorageLocation: test.target},
+				},
+				Status: test.status,
+			}
+			repo.Status.Phase = velerov1api.BackupRepositoryPhaseReady
+
+			objs := append([]runtime.Object{}, locations...)
+			for _, pvb := range test.pvbs {
+				objs = append(objs, pvb)
+			}
+
+			var (
+				clientset       = fake.NewSimpleClientset(repo)
+				sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+				fakeClient      = newFakeClient(t, objs...)
+				pluginManager   = &pluginmocks.Manager{}
+				sourceStore     = &persistencemocks.BackupStore{}
+				destStore       = &persistencemocks.BackupStore{}
+			)
+
+			c := NewBackupRepositoryController(
+				velerotest.NewLogger(),
+				sharedInformers.Velero().V1().BackupRepositories(),
+				clientset.VeleroV1(),
+				fakeClient,
+				&fakeRepositoryManager{},
+				time.Hour,
+				time.Hour,
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				nil,
+				record.NewFakeRecorder(10),
+				metrics.NewServerMetrics(),
+			).(*backupRepositoryController)
+			c.clock = clock.NewFakeClock(now)
+			c.newBackupStore = func(location *velerov1api.BackupStorageLocation, _ persistence.ObjectStoreGetter, _ logrus.FieldLogger) (persistence.BackupStore, error) {
+				if location.Name == "primary" {
+					return sourceStore, nil
+				}
+				return destStore, nil
+			}
+
+			pluginManager.On("CleanupClients").Return(nil).Maybe()
+			if test.expectCopy {
+				sourceStore.On("CopyRepository", velerov1api.UploaderTypeRestic, "ns-1", destStore, test.expectOverwrite).Return(3, nil)
+				sourceStore.On("PutRepositoryMigration", velerov1api.UploaderTypeRestic, "ns-1", "secondary").Return(nil)
+				destStore.On("GetRepositoryMigration", velerov1api.UploaderTypeRestic, "ns-1").Return("", nil)
+			}
+
+			require.NoError(t, sharedInformers.Velero().V1().BackupRepositories().Informer().GetStore().Add(repo))
+			require.NoError(t, c.migrateRepo(repo.DeepCopy(), velerotest.NewLogger()))
+
+			sourceStore.AssertExpectations(t)
+			destStore.AssertExpectations(t)
+
+			res, err := clientset.VeleroV1().BackupRepositories(repo.Namespace).Get(context.TODO(), repo.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+
+			assert.Equal(t, "request-1", res.Status.LastMigrationRequest)
+			require.NotNil(t, res.Status.MigrationResult)
+			assert.Equal(t, "primary", res.Status.MigrationResult.SourceBackupStorageLocation)
+			assert.Equal(t, test.target, res.Status.MigrationResult.TargetBackupStorageLocation)
+			assert.Equal(t, test.expectedMessage, res.Status.MigrationResult.Message)
+			assert.Equal(t, test.expectedFormerBSL, res.Status.FormerBackupStorageLocations)
+			assert.Equal(t, velerov1api.BackupRepositoryPhaseReady, res.Status.Phase)
+
+			if !test.expectCopy {
+				assert.False(t, res.Status.MigrationResult.Succeeded)
+				assert.Equal(t, "primary", res.Spec.BackupStorageLocation)
+				assert.Equal(t, oldIdentifier, res.Spec.RepoIdentifier)
+				return
+			}
+
+			assert.True(t, res.Status.MigrationResult.Succeeded)
+			assert.Equal(t, 3, res.Status.MigrationResult.ObjectsCopied)
+			assert.Equal(t, "secondary", res.Spec.BackupStorageLocation)
+			assert.Equal(t, newIdentifier, res.Spec.RepoIdentifier)
+			assert.Equal(t, "secondary", res.Labels[velerov1api.StorageLocationLabel])
+
+			for _, pvb := range test.pvbs {
+				updated := &velerov1api.PodVolumeBackup{}
+				require.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKey{Namespace: pvb.Namespace, Name: pvb.Name}, updated))
+				if pvb.Spec.RepoIdentifier == oldIdentifier {
+					assert.Equal(t, "secondary", updated.Spec.BackupStorageLocation)
+					assert.Equal(t, newIdentifier, updated.Spec.RepoIdentifier)
+				} else {
+					assert.Equal(t, pvb.Spec, updated.Spec)
+				}
+			}
+		})
+	}
+}
+
+func TestMigrationTarget(t *testing.T) {
+	locations := []runtime.Object{
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "primary").Provider("gcp").Bucket("bucket-1").Result(),
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "secondary").Provider("gcp").Bucket("bucket-2").Result(),
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "tertiary").Provider("gcp").Bucket("bucket-3").Result(),
+	}
+
+	tests := []struct {
+		name                    string
+		migrations              map[string]string
+		expectedLocation        string
+		expectedFormerLocations []string
+		expectedErr             string
+	}{
+		{
+			name:             "repository that wasn't migrated is in its location",
+			expectedLocation: "primary",
+		},
+		{
+			name:                    "migrations are followed to the location that the repository is in",
+			migrations:              map[string]string{"primary": "secondary", "secondary": "tertiary"},
+			expectedLocation:        "tertiary",
+			expectedFormerLocations: []string{"primary", "secondary"},
+		},
+		{
+			name:        "migration to a missing location is an error",
+			migrations:  map[string]string{"primary": "missing"},
+			expectedErr: `error getting backup storage location missing that the repository was migrated to: backupstoragelocations.velero.io "missing" not found`,
+		},
+		{
+			name:        "migrations that form a cycle are an error",
+			migrations:  map[string]string{"primary": "secondary", "secondary": "primary"},
+			expectedErr: "repository migrations recorded in backup storage locations primary, secondary form a cycle",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				clientset       = fake.NewSimpleClientset()
+				sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+				pluginManager   = &pluginmocks.Manager{}
+			)
+
+			c := NewBackupRepositoryController(
+				velerotest.NewLogger(),
+				sharedInformers.Velero().V1().BackupRepositories(),
+				clientset.VeleroV1(),
+				newFakeClient(t, locations...),
+				&fakeRepositoryManager{},
+				time.Hour,
+				time.Hour,
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				nil,
+				record.NewFakeRecorder(10),
+				metrics.NewServerMetrics(),
+			).(*backupRepositoryController)
+			c.newBackupStore = func(location *velerov1api.BackupStorageLocation, _ persistence.ObjectStoreGetter, _ logrus.FieldLogger) (persistence.BackupStore, error) {
+				store := &persistencemocks.BackupStore{}
+				store.On("GetRepositoryMigration", velerov1api.UploaderType(""), "ns-1").Return(test.migrations[location.Name], nil)
+				return store, nil
+			}
+			pluginManager.On("CleanupClients").Return(nil).Maybe()
+
+			repo := &velerov1api.BackupRepository{
+				ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "repo-1"},
+				Spec: velerov1api.BackupRepositorySpec{
+					VolumeNamespace:       "ns-1",
+					BackupStorageLocation: "primary",
+				},
+			}
+			loc := locations[0].(*velerov1api.BackupStorageLocation).DeepCopy()
+
+			res, formerLocations, err := c.migrationTarget(repo, loc, velerotest.NewLogger())
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedLocation, res.Name)
+			assert.Equal(t, test.expectedFormerLocations, formerLocations)
+		})
+	}
+}
@@ -18,29 +18,63 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	snapshotterClientSet "github.com/kubernetes-csi/external-snapshotter/v2/pkg/client/clientset/versioned"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/equality"
 	kuberrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// fullBackupSyncPeriod is how often a backup storage location is fully synced,
+// even if its backup store's revision hasn't changed. This picks up changes made
+// to the backup store by anything that doesn't update its revision.
+const fullBackupSyncPeriod = time.Hour
+
+// backupStoreRevision is the revision of a backup storage location's backup store
+// as of the location's last complete sync.
+type backupStoreRevision struct {
+	revision   string
+	generation int64
+	syncedAt   time.Time
+}
+
+// backupSizeCache holds the sizes of a backup storage location's backups. A backup's
+// size is recorded once, when the backup is stored, so it only needs to be gotten from
+// the backup store once.
+type backupSizeCache struct {
+	generation int64
+	sizes      map[string]int64
+}
+
+// backupRevisionCache holds the revisions of the metadata of a backup storage location's
+// backups as of when they were last synced, so that only the backups whose metadata has
+// changed since then are downloaded again.
+type backupRevisionCache struct {
+	generation int64
+	revisions  map[string]string
+}
+
 type backupSyncController struct {
 	*genericController
 
@@ -55,6 +89,16 @@ type backupSyncController struct {
 	defaultBackupSyncPeriod time.Duration
 	newPluginManager        func(logrus.FieldLogger) clientmgmt.Manager
 	newBackupStore          func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	metrics                 *metrics.ServerMetrics
+
+	// backupStoreRevisions is keyed by backup storage location name.
+	backupStoreRevisions map[string]backupStoreRevision
+
+	// backupSizes is keyed by backup storage location name.
+	backupSizes map[string]*backupSizeCache
+
+	// backupRevisions is keyed by backup storage location name.
+	backupRevisions map[string]*backupRevisionCache
 }
 
 func NewBackupSyncController(
@@ -68,6 +112,8 @@ func NewBackupSyncController(
 	kubeClient kubernetes.Interface,
 	defaultBackupLocation string,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
+	metrics *metrics.ServerMetrics,
 	logger logrus.FieldLogger,
 ) Interface {
 	if syncPeriod <= 0 {
@@ -86,11 +132,15 @@ func NewBackupSyncController(
 		backupLister:            backupLister,
 		csiSnapshotClient:       csiSnapshotClient,
 		kubeClient:              kubeClient,
+		metrics:                 metrics,
+		backupStoreRevisions:    make(map[string]backupStoreRevision),
+		backupSizes:             make(map[string]*backupSizeCache),
+		backupRevisions:         make(map[string]*backupRevisionCache),
 
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
 		newPluginManager: newPluginManager,
-		newBackupStore:   persistence.NewObjectBackupStore,
+		newBackupStore:   persistence.NewObjectBackupStoreWithCredentials(credentialFileStore),
 	}
 
 	c.resyncFunc = c.run
@@ -130,7 +180,11 @@ func (c *backupSyncController) run() {
 	}
 
 	// sync the default location first, if it exists
-	locations := orderedBackupLocations(&locationList, c.defaultBackupLocation)
+	defaultLocation, err := storage.DefaultBackupStorageLocationName(locationList.Items, c.defaultBackupLocation)
+	if err != nil {
+		c.logger.WithError(err).Warn("Unable to determine the default backup location, syncing locations in no particular order")
+	}
+	locations := orderedBackupLocations(&locationList, defaultLocation)
 
 	pluginManager := c.newPluginManager(c.logger)
 	defer pluginManager.CleanupClients()
@@ -169,13 +223,28 @@ func (c *backupSyncController) run() {
 			continue
 		}
 
-		// get a list of all the backups that are stored in the backup storage location
-		res, err := backupStore.ListBackups()
+		// if no backup has been added to, changed in or deleted from the backup store
+		// since the last complete sync, there's nothing to download.
+		revision, err := backupStore.GetRevision()
+		if err != nil {
+			log.WithError(err).Warn("Error getting backup store revision, proceeding with full sync")
+			revision = ""
+		}
+
+		if c.isSynced(&location, revision) {
+			log.Debug("Backup store revision hasn't changed since the last sync, skipping sync")
+			c.updateLastSyncedTime(&location, log)
+			continue
+		}
+
+		// get a list of all the backups that are stored in the backup storage location,
+		// along with the revisions of their metadata
+		metadataRevisions, err := backupStore.ListBackupRevisions()
 		if err != nil {
 			log.WithError(err).Error("Error listing backups in backup store")
 			continue
 		}
-		backupStoreBackups := sets.NewString(res...)
+		backupStoreBackups := sets.StringKeySet(metadataRevisions)
 		log.WithField("backupCount", len(backupStoreBackups)).Debug("Got backups from backup store")
 
 		// get a list of all the backups that exist as custom resources in the cluster
@@ -199,6 +268,36 @@ func (c *backupSyncController) run() {
 			log.Debug("No backups found in the backup location that need to be synced into the cluster")
 		}
 
+		// get a list of backups that are in both the backup storage location and the
+		// cluster, and whose metadata has changed since they were last synced
+		revisions := c.getBackupRevisions(&location, backupStoreBackups)
+		backupsToUpdate := sets.NewString()
+		for _, b := range clusterBackups {
+			revision := metadataRevisions[b.Name]
+			if b.Spec.StorageLocation != location.Name || revision == "" {
+				continue
+			}
+
+			synced, found := revisions.revisions[b.Name]
+			switch {
+			case !found:
+				// the backup hasn't been synced since the server started, so its
+				// revision is recorded as the one its custom resource is up to date with
+				revisions.revisions[b.Name] = revision
+			case synced != revision:
+				backupsToUpdate.Insert(b.Name)
+			}
+		}
+
+		// the revision is only recorded if every backup is synced, so that
+		// any that fail are retried on the next sync.
+		complete := true
+
+		backupSizes, ok := c.getBackupSizes(&location, backupStore, backupStoreBackups, log)
+		if !ok {
+			complete = false
+		}
+
 		// sync each backup
 		for backupName := range backupsToSync {
 			log = log.WithField("backup", backupName)
@@ -207,12 +306,19 @@ func (c *backupSyncController) run() {
 			backup, err := backupStore.GetBackupMetadata(backupName)
 			if err != nil {
 				log.WithError(errors.WithStack(err)).Error("Error getting backup metadata from backup store")
+				complete = false
 				continue
 			}
 
 			backup.Namespace = c.namespace
 			backup.ResourceVersion = ""
 
+			// remove any owner references, such as to the schedule that created the
+			// backup, since the owners are no longer around or have a different UID
+			// in this cluster, and the backup would otherwise be garbage-collected
+			// right after it's synced.
+			backup.OwnerReferences = nil
+
 			// update the StorageLocation field and label since the name of the location
 			// may be different in this cluster than in the cluster that created the
 			// backup.
@@ -222,6 +328,8 @@ func (c *backupSyncController) run() {
 			}
 			backup.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(backup.Spec.StorageLocation)
 
+			backup.Status.StoredBytes = backupSizes[backupName]
+
 			// attempt to create backup custom resource via API
 			backup, err = c.backupClient.Backups(backup.Namespace).Create(context.TODO(), backup, metav1.CreateOptions{})
 			switch {
@@ -230,15 +338,18 @@ func (c *backupSyncController) run() {
 				continue
 			case err != nil && !kuberrs.IsAlreadyExists(err):
 				log.WithError(errors.WithStack(err)).Error("Error syncing backup into cluster")
+				complete = false
 				continue
 			default:
 				log.Info("Successfully synced backup into cluster")
+				revisions.revisions[backupName] = metadataRevisions[backupName]
 			}
 
 			// process the pod volume backups from object store, if any
 			podVolumeBackups, err := backupStore.GetPodVolumeBackups(backupName)
 			if err != nil {
 				log.WithError(errors.WithStack(err)).Error("Error getting pod volume backups for this backup from backup store")
+				complete = false
 				continue
 			}
 
@@ -267,6 +378,7 @@ func (c *backupSyncController) run() {
 					continue
 				case err != nil && !kuberrs.IsAlreadyExists(err):
 					log.WithError(errors.WithStack(err)).Error("Error syncing pod volume backup into cluster")
+					complete = false
 					continue
 				default:
 					log.Debug("Synced pod volume backup into cluster")
@@ -280,6 +392,7 @@ func (c *backupSyncController) run() {
 				snapConts, err := backupStore.GetCSIVolumeSnapshotContents(backupName)
 				if err != nil {
 					log.WithError(errors.WithStack(err)).Error("Error getting CSI volumesnapshotcontents for this backup from backup store")
+					complete = false
 					continue
 				}
 
@@ -294,6 +407,7 @@ func (c *backupSyncController) run() {
 						continue
 					case err != nil && !kuberrs.IsAlreadyExists(err):
 						log.WithError(errors.WithStack(err)).Errorf("Error syncing volumesnapshotcontent %s into cluster", snapCont.Name)
+						complete = false
 						continue
 					default:
 						log.Infof("Created CSI volumesnapshotcontent %s", created.Name)
@@ -302,15 +416,199 @@ func (c *backupSyncController) run() {
 			}
 		}
 
+		// update each backup whose metadata has changed
+		for backupName := range backupsToUpdate {
+			log := log.WithField("backup", backupName)
+			log.Info("Backup's metadata has changed in the backup location, updating it in the cluster")
+
+			if err := c.updateBackup(backupName, backupStore); err != nil {
+				log.WithError(err).Error("Error updating backup from backup store")
+				complete = false
+				continue
+			}
+			revisions.revisions[backupName] = metadataRevisions[backupName]
+		}
+
+		if ok {
+			c.updateStorageUsage(&location, clusterBackups, backupSizes, log)
+		}
+
 		c.deleteOrphanedBackups(location.Name, backupStoreBackups, log)
 
-		// update the location's last-synced time field
-		statusPatch := client.MergeFrom(location.DeepCopyObject())
-		location.Status.LastSyncedTime = &metav1.Time{Time: time.Now().UTC()}
-		if err := c.kbClient.Status().Patch(context.Background(), &location, statusPatch); err != nil {
-			log.WithError(errors.WithStack(err)).Error("Error patching backup location's last-synced time")
+		if complete && revision != "" {
+			c.backupStoreRevisions[location.Name] = backupStoreRevision{
+				revision:   revision,
+				generation: location.Generation,
+				syncedAt:   time.Now(),
+			}
+		} else {
+			delete(c.backupStoreRevisions, location.Name)
+		}
+
+		c.updateLastSyncedTime(&location, log)
+	}
+}
+
+// isSynced returns true if the location's backup store had the given revision as of
+// the location's last complete sync, its spec hasn't changed since then, and it isn't
+// due for a full sync. The backup store's revision changes whenever a backup is added
+// to it, deleted from it, or has its metadata replaced, so a synced backup store
+// doesn't even need to be listed.
+func (c *backupSyncController) isSynced(location *velerov1api.BackupStorageLocation, revision string) bool {
+	if revision == "" {
+		return false
+	}
+
+	synced, ok := c.backupStoreRevisions[location.Name]
+	if !ok {
+		return false
+	}
+
+	return synced.revision == revision &&
+		synced.generation == location.Generation &&
+		time.Now().Before(synced.syncedAt.Add(fullBackupSyncPeriod))
+}
+
+// getBackupRevisions returns the location's cache of the revisions of its backups'
+// metadata as of when they were last synced, without the backups that are no longer
+// in the backup store. The cache is reset if the location's spec has changed.
+func (c *backupSyncController) getBackupRevisions(location *velerov1api.BackupStorageLocation, backupNames sets.String) *backupRevisionCache {
+	cache, found := c.backupRevisions[location.Name]
+	if !found || cache.generation != location.Generation {
+		cache = &backupRevisionCache{
+			generation: location.Generation,
+			revisions:  make(map[string]string),
+		}
+		c.backupRevisions[location.Name] = cache
+	}
+
+	for backupName := range cache.revisions {
+		if !backupNames.Has(backupName) {
+			delete(cache.revisions, backupName)
+		}
+	}
+
+	return cache
+}
+
+// updateBackup replaces the status of a backup in the cluster with the status in its
+// metadata in the backup store, such as after the backup's asynchronous operations
+// finished in the cluster that created it. The backup's stored bytes are kept, since
+// they're only recorded in the cluster.
+func (c *backupSyncController) updateBackup(backupName string, backupStore persistence.BackupStore) error {
+	stored, err := backupStore.GetBackupMetadata(backupName)
+	if err != nil {
+		return errors.Wrap(err, "error getting backup metadata from backup store")
+	}
+
+	existing, err := c.backupLister.Backups(c.namespace).Get(backupName)
+	if err != nil {
+		return errors.Wrap(err, "error getting backup from cluster")
+	}
+
+	updated := existing.DeepCopy()
+	updated.Status = stored.Status
+	updated.Status.StoredBytes = existing.Status.StoredBytes
+	if equality.Semantic.DeepEqual(existing.Status, updated.Status) {
+		return nil
+	}
+
+	if _, err := c.backupClient.Backups(c.namespace).Update(context.TODO(), updated, metav1.UpdateOptions{}); err != nil {
+		return errors.Wrap(err, "error updating backup")
+	}
+
+	return nil
+}
+
+// getBackupSizes returns the sizes of the backups in the backup store, keyed by
+// backup name, and whether all of them could be gotten. Sizes are cached, so only the
+// sizes of backups that weren't in the backup store as of the last sync are gotten
+// from it. A backup has no recorded size until all of its files are stored, and
+// backups stored by older versions of Velero have none at all, so sizes of zero
+// aren't cached.
+func (c *backupSyncController) getBackupSizes(location *velerov1api.BackupStorageLocation, backupStore persistence.BackupStore, backupNames sets.String, log logrus.FieldLogger) (map[string]int64, bool) {
+	cache, found := c.backupSizes[location.Name]
+	if !found || cache.generation != location.Generation {
+		cache = &backupSizeCache{
+			generation: location.Generation,
+			sizes:      make(map[string]int64),
+		}
+		c.backupSizes[location.Name] = cache
+	}
+
+	// forget the sizes of backups that have been deleted from the backup store
+	for backupName := range cache.sizes {
+		if !backupNames.Has(backupName) {
+			delete(cache.sizes, backupName)
+		}
+	}
+
+	sizes := make(map[string]int64, backupNames.Len())
+	ok := true
+
+	for backupName := range backupNames {
+		if size, found := cache.sizes[backupName]; found {
+			sizes[backupName] = size
 			continue
 		}
+
+		size, err := backupStore.GetBackupSize(backupName)
+		if err != nil {
+			log.WithError(err).WithField("backup", backupName).Error("Error getting backup size from backup store")
+			ok = false
+			continue
+		}
+		sizes[backupName] = size
+
+		if size > 0 {
+			cache.sizes[backupName] = size
+		}
+	}
+
+	return sizes, ok
+}
+
+// updateStorageUsage records the sizes of the location's backups in their statuses, and
+// the location's total size in its status and metrics.
+func (c *backupSyncController) updateStorageUsage(location *velerov1api.BackupStorageLocation, clusterBackups []*velerov1api.Backup, backupSizes map[string]int64, log logrus.FieldLogger) {
+	for _, backup := range clusterBackups {
+		size, ok := backupSizes[backup.Name]
+		if !ok || backup.Spec.StorageLocation != location.Name || backup.Status.StoredBytes == size {
+			continue
+		}
+
+		patch := []byte(fmt.Sprintf(`{"status":{"storedBytes":%d}}`, size))
+		if _, err := c.backupClient.Backups(backup.Namespace).Patch(context.TODO(), backup.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			log.WithError(errors.WithStack(err)).WithField("backup", backup.Name).Error("Error patching backup's stored bytes")
+		}
+	}
+
+	var total int64
+	for _, size := range backupSizes {
+		total += size
+	}
+
+	if c.metrics != nil {
+		c.metrics.SetBackupStorageLocationStoredBytes(location.Name, total)
+	}
+
+	if location.Status.StoredBytes == total {
+		return
+	}
+
+	statusPatch := client.MergeFrom(location.DeepCopyObject())
+	location.Status.StoredBytes = total
+	if err := c.kbClient.Status().Patch(context.Background(), location, statusPatch); err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error patching backup location's stored bytes")
+	}
+}
+
+// updateLastSyncedTime updates the location's last-synced time field.
+func (c *backupSyncController) updateLastSyncedTime(location *velerov1api.BackupStorageLocation, log logrus.FieldLogger) {
+	statusPatch := client.MergeFrom(location.DeepCopyObject())
+	location.Status.LastSyncedTime = &metav1.Time{Time: time.Now().UTC()}
+	if err := c.kbClient.Status().Patch(context.Background(), location, statusPatch); err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error patching backup location's last-synced time")
 	}
 }
 
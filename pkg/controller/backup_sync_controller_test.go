@@ -34,11 +34,14 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func defaultLocationsList(namespace string) []*velerov1api.BackupStorageLocation {
@@ -255,6 +258,25 @@ func TestBackupSyncControllerRun(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:      "owner references get removed from synced backups",
+			namespace: "ns-1",
+			locations: defaultLocationsList("ns-1"),
+			cloudBuckets: map[string][]*cloudBackupData{
+				"bucket-1": {
+					&cloudBackupData{
+						backup: builder.ForBackup("ns-1", "schedule-1-20200101000000").
+							ObjectMeta(builder.WithOwnerReferences(metav1.OwnerReference{
+								APIVersion: velerov1api.SchemeGroupVersion.String(),
+								Kind:       "Schedule",
+								Name:       "schedule-1",
+								UID:        "uid-1",
+							})).
+							Result(),
+					},
+				},
+			},
+		},
 		{
 			name:      "all synced backups and pod volume backups get created in Velero server's namespace",
 			namespace: "ns-1",
@@ -350,6 +372,8 @@ func TestBackupSyncControllerRun(t *testing.T) {
 				nil, // kubeClient
 				"",
 				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				nil, // credential file store
+				nil, // metrics
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
@@ -369,13 +393,15 @@ func TestBackupSyncControllerRun(t *testing.T) {
 				backupStore, ok := backupStores[location.Name]
 				require.True(t, ok, "no mock backup store for location %s", location.Name)
 
-				var backupNames []string
+				backupNames := make(map[string]string)
 				for _, bucket := range test.cloudBuckets[location.Spec.ObjectStorage.Bucket] {
-					backupNames = append(backupNames, bucket.backup.Name)
+					backupNames[bucket.backup.Name] = ""
 					backupStore.On("GetBackupMetadata", bucket.backup.Name).Return(bucket.backup, nil)
 					backupStore.On("GetPodVolumeBackups", bucket.backup.Name).Return(bucket.podVolumeBackups, nil)
+					backupStore.On("GetBackupSize", bucket.backup.Name).Return(int64(0), nil)
 				}
-				backupStore.On("ListBackups").Return(backupNames, nil)
+				backupStore.On("GetRevision").Return("", nil)
+				backupStore.On("ListBackupRevisions").Return(backupNames, nil)
 			}
 
 			for _, existingBackup := range test.existingBackups {
@@ -440,6 +466,8 @@ func TestBackupSyncControllerRun(t *testing.T) {
 						}
 						assert.Equal(t, locationName, obj.Labels[velerov1api.StorageLocationLabel])
 						assert.Equal(t, true, len(obj.Labels[velerov1api.StorageLocationLabel]) <= validation.DNS1035LabelMaxLength)
+
+						assert.Empty(t, obj.OwnerReferences)
 					}
 
 					// process the cloud pod volume backups for this backup, if any
@@ -469,6 +497,243 @@ func TestBackupSyncControllerRun(t *testing.T) {
 	}
 }
 
+func TestBackupSyncControllerRunSkipsUnchangedRevision(t *testing.T) {
+	var (
+		client          = fake.NewSimpleClientset()
+		fakeClient      = newFakeClient(t)
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		pluginManager   = &pluginmocks.Manager{}
+		backupStore     = &persistencemocks.BackupStore{}
+		location        = defaultLocationsList("ns-1")[0]
+	)
+
+	c := NewBackupSyncController(
+		client.VeleroV1(),
+		fakeClient,
+		client.VeleroV1(),
+		sharedInformers.Velero().V1().Backups().Lister(),
+		time.Duration(0),
+		"ns-1",
+		nil, // csiSnapshotClient
+		nil, // kubeClient
+		"",
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		nil, // credential file store
+		nil, // metrics
+		velerotest.NewLogger(),
+	).(*backupSyncController)
+
+	c.newBackupStore = func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+		return backupStore, nil
+	}
+
+	pluginManager.On("CleanupClients").Return(nil)
+
+	// sync the location every time the controller runs
+	location.Spec.BackupSyncPeriod = &metav1.Duration{Duration: time.Nanosecond}
+	require.NoError(t, fakeClient.Create(context.Background(), location))
+
+	backupStore.On("GetRevision").Return("revision-1", nil).Twice()
+	backupStore.On("GetRevision").Return("revision-2", nil).Once()
+	backupStore.On("ListBackupRevisions").Return(map[string]string{}, nil)
+
+	// the first run lists the backup store's backups, the second run
+	// skips it since the revision hasn't changed, and the third run
+	// lists them again since the revision has changed.
+	c.run()
+	backupStore.AssertNumberOfCalls(t, "ListBackupRevisions", 1)
+	assert.Equal(t, "revision-1", c.backupStoreRevisions[location.Name].revision)
+
+	c.run()
+	backupStore.AssertNumberOfCalls(t, "ListBackupRevisions", 1)
+
+	c.run()
+	backupStore.AssertNumberOfCalls(t, "ListBackupRevisions", 2)
+	assert.Equal(t, "revision-2", c.backupStoreRevisions[location.Name].revision)
+}
+
+func TestBackupSyncControllerRunReportsStorageUsage(t *testing.T) {
+	var (
+		client          = fake.NewSimpleClientset()
+		fakeClient      = newFakeClient(t)
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		pluginManager   = &pluginmocks.Manager{}
+		backupStore     = &persistencemocks.BackupStore{}
+		location        = defaultLocationsList("ns-1")[0]
+		existingBackup  = builder.ForBackup("ns-1", "backup-1").StorageLocation(location.Name).Result()
+	)
+
+	c := NewBackupSyncController(
+		client.VeleroV1(),
+		fakeClient,
+		client.VeleroV1(),
+		sharedInformers.Velero().V1().Backups().Lister(),
+		time.Duration(0),
+		"ns-1",
+		nil, // csiSnapshotClient
+		nil, // kubeClient
+		"",
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		nil, // credential file store
+		metrics.NewServerMetrics(),
+		velerotest.NewLogger(),
+	).(*backupSyncController)
+
+	c.newBackupStore = func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+		return backupStore, nil
+	}
+
+	pluginManager.On("CleanupClients").Return(nil)
+
+	require.NoError(t, fakeClient.Create(context.Background(), location))
+
+	require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(existingBackup))
+	_, err := client.VeleroV1().Backups("ns-1").Create(context.TODO(), existingBackup, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	backupStore.On("GetRevision").Return("", nil)
+	backupStore.On("ListBackupRevisions").Return(map[string]string{"backup-1": "", "backup-2": ""}, nil)
+	backupStore.On("GetBackupSize", "backup-1").Return(int64(100), nil).Once()
+	backupStore.On("GetBackupSize", "backup-2").Return(int64(200), nil).Once()
+	backupStore.On("GetBackupMetadata", "backup-2").Return(builder.ForBackup("ns-1", "backup-2").Result(), nil)
+	backupStore.On("GetPodVolumeBackups", "backup-2").Return(nil, nil)
+
+	c.run()
+
+	// the existing backup's size is patched, and the synced backup is created with its size
+	backup, err := client.VeleroV1().Backups("ns-1").Get(context.TODO(), "backup-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), backup.Status.StoredBytes)
+
+	backup, err = client.VeleroV1().Backups("ns-1").Get(context.TODO(), "backup-2", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(200), backup.Status.StoredBytes)
+
+	// the location's total size is recorded
+	updated := &velerov1api.BackupStorageLocation{}
+	require.NoError(t, fakeClient.Get(context.Background(), kbclient.ObjectKey{Namespace: location.Namespace, Name: location.Name}, updated))
+	assert.Equal(t, int64(300), updated.Status.StoredBytes)
+
+	// the backups' sizes are cached, so they're only gotten from the backup store once
+	c.run()
+	backupStore.AssertNumberOfCalls(t, "GetBackupSize", 2)
+}
+
+func TestBackupSyncControllerRunUpdatesChangedBackups(t *testing.T) {
+	var (
+		client          = fake.NewSimpleClientset()
+		fakeClient      = newFakeClient(t)
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		pluginManager   = &pluginmocks.Manager{}
+		backupStore     = &persistencemocks.BackupStore{}
+		location        = defaultLocationsList("ns-1")[0]
+		existingBackup  = builder.ForBackup("ns-1", "backup-1").StorageLocation(location.Name).Phase(velerov1api.BackupPhaseWaitingForPluginOperations).Result()
+	)
+
+	c := NewBackupSyncController(
+		client.VeleroV1(),
+		fakeClient,
+		client.VeleroV1(),
+		sharedInformers.Velero().V1().Backups().Lister(),
+		time.Duration(0),
+		"ns-1",
+		nil, // csiSnapshotClient
+		nil, // kubeClient
+		"",
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		nil, // credential file store
+		nil, // metrics
+		velerotest.NewLogger(),
+	).(*backupSyncController)
+
+	c.newBackupStore = func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+		return backupStore, nil
+	}
+
+	pluginManager.On("CleanupClients").Return(nil)
+
+	// sync the location every time the controller runs
+	location.Spec.BackupSyncPeriod = &metav1.Duration{Duration: time.Nanosecond}
+	require.NoError(t, fakeClient.Create(context.Background(), location))
+
+	require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(existingBackup))
+	_, err := client.VeleroV1().Backups("ns-1").Create(context.TODO(), existingBackup, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	backupStore.On("GetRevision").Return("", nil)
+	backupStore.On("GetBackupSize", "backup-1").Return(int64(0), nil)
+	backupStore.On("ListBackupRevisions").Return(map[string]string{"backup-1": "etag-1"}, nil).Twice()
+	backupStore.On("ListBackupRevisions").Return(map[string]string{"backup-1": "etag-2"}, nil).Once()
+	backupStore.On("GetBackupMetadata", "backup-1").Return(builder.ForBackup("ns-1", "backup-1").StorageLocation(location.Name).Phase(velerov1api.BackupPhaseCompleted).Result(), nil)
+
+	// the backup's metadata isn't downloaded while its revision is unchanged
+	c.run()
+	c.run()
+	backupStore.AssertNumberOfCalls(t, "GetBackupMetadata", 0)
+
+	// once its revision changes, the backup is updated from its metadata
+	c.run()
+	backupStore.AssertNumberOfCalls(t, "GetBackupMetadata", 1)
+	assert.Equal(t, "etag-2", c.backupRevisions[location.Name].revisions["backup-1"])
+
+	backup, err := client.VeleroV1().Backups("ns-1").Get(context.TODO(), "backup-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, velerov1api.BackupPhaseCompleted, backup.Status.Phase)
+}
+
+func TestIsSynced(t *testing.T) {
+	location := builder.ForBackupStorageLocation("ns-1", "location-1").Result()
+	location.Generation = 2
+
+	tests := []struct {
+		name     string
+		revision string
+		synced   *backupStoreRevision
+		expected bool
+	}{
+		{
+			name:     "location that was never synced is not synced",
+			revision: "revision-1",
+		},
+		{
+			name:   "empty revision is never synced",
+			synced: &backupStoreRevision{generation: 2, syncedAt: time.Now()},
+		},
+		{
+			name:     "same revision and generation is synced",
+			revision: "revision-1",
+			synced:   &backupStoreRevision{revision: "revision-1", generation: 2, syncedAt: time.Now()},
+			expected: true,
+		},
+		{
+			name:     "different revision is not synced",
+			revision: "revision-2",
+			synced:   &backupStoreRevision{revision: "revision-1", generation: 2, syncedAt: time.Now()},
+		},
+		{
+			name:     "different generation is not synced",
+			revision: "revision-1",
+			synced:   &backupStoreRevision{revision: "revision-1", generation: 1, syncedAt: time.Now()},
+		},
+		{
+			name:     "location due for a full sync is not synced",
+			revision: "revision-1",
+			synced:   &backupStoreRevision{revision: "revision-1", generation: 2, syncedAt: time.Now().Add(-2 * fullBackupSyncPeriod)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &backupSyncController{backupStoreRevisions: make(map[string]backupStoreRevision)}
+			if test.synced != nil {
+				c.backupStoreRevisions[location.Name] = *test.synced
+			}
+
+			assert.Equal(t, test.expected, c.isSynced(location, test.revision))
+		})
+	}
+}
+
 func TestDeleteOrphanedBackups(t *testing.T) {
 	baseBuilder := func(name string) *builder.BackupBuilder {
 		return builder.ForBackup("ns-1", name).ObjectMeta(builder.WithLabels(velerov1api.StorageLocationLabel, "default"))
@@ -575,6 +840,8 @@ func TestDeleteOrphanedBackups(t *testing.T) {
 				nil, // kubeClient
 				"",
 				nil, // new plugin manager func
+				nil, // credential file store
+				nil, // metrics
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
@@ -668,6 +935,8 @@ func TestStorageLabelsInDeleteOrphanedBackups(t *testing.T) {
 				nil, // kubeClient
 				"",
 				nil, // new plugin manager func
+				nil, // credential file store
+				nil, // metrics
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
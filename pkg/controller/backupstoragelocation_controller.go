@@ -24,14 +24,17 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/cluster-api/util/patch"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 )
@@ -46,12 +49,15 @@ type BackupStorageLocationReconciler struct {
 	// replaced with fakes for testing.
 	NewPluginManager func(logrus.FieldLogger) clientmgmt.Manager
 	NewBackupStore   func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	Metrics          *metrics.ServerMetrics
+	EventRecorder    record.EventRecorder
 
 	Log logrus.FieldLogger
 }
 
 // +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 func (r *BackupStorageLocationReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	log := r.Log.WithField("controller", "backupstoragelocation")
 
@@ -66,6 +72,8 @@ func (r *BackupStorageLocationReconciler) Reconcile(req ctrl.Request) (ctrl.Resu
 	pluginManager := r.NewPluginManager(log)
 	defer pluginManager.CleanupClients()
 
+	defaultLocation, defaultErr := storage.DefaultBackupStorageLocationName(locationList.Items, r.DefaultBackupLocationInfo.StorageLocation)
+
 	var defaultFound bool
 	var unavailableErrors []string
 	var anyVerified bool
@@ -73,17 +81,11 @@ func (r *BackupStorageLocationReconciler) Reconcile(req ctrl.Request) (ctrl.Resu
 		location := &locationList.Items[i]
 		log := r.Log.WithField("controller", "backupstoragelocation").WithField("backupstoragelocation", location.Name)
 
-		if location.Name == r.DefaultBackupLocationInfo.StorageLocation {
+		if location.Name == defaultLocation {
 			defaultFound = true
 		}
 
-		backupStore, err := r.NewBackupStore(location, pluginManager, log)
-		if err != nil {
-			log.WithError(err).Error("Error getting a backup store")
-			continue
-		}
-
-		if !storage.IsReadyToValidate(location.Spec.ValidationFrequency, location.Status.LastValidationTime, r.DefaultBackupLocationInfo, log) {
+		if !storage.IsValidationRequested(location, log) && !storage.IsReadyToValidate(location.Spec.ValidationFrequency, location.Status.LastValidationTime, r.DefaultBackupLocationInfo, log) {
 			log.Debug("Backup location not ready to be validated")
 			continue
 		}
@@ -97,18 +99,33 @@ func (r *BackupStorageLocationReconciler) Reconcile(req ctrl.Request) (ctrl.Resu
 
 		log.Debug("Verifying backup storage location")
 		anyVerified = true
-		if err := backupStore.IsValid(); err != nil {
+
+		reason := velerov1api.BackupStorageLocationReasonValidationFailed
+		backupStore, err := r.NewBackupStore(location, pluginManager, log)
+		if err != nil {
+			log.WithError(err).Error("Error getting a backup store")
+			reason = velerov1api.BackupStorageLocationReasonBackupStoreError
+			err = errors.Wrap(err, "error getting a backup store")
+		} else {
+			err = backupStore.IsValid()
+		}
+
+		if err != nil {
 			log.Debug("Backup location verified, not valid")
 			unavailableErrors = append(unavailableErrors, errors.Wrapf(err, "Backup location %q is unavailable", location.Name).Error())
 
-			if location.Name == r.DefaultBackupLocationInfo.StorageLocation {
-				log.Warnf("The specified default backup location named %q is unavailable; for convenience, be sure to configure it properly or make another backup location that is available the default", r.DefaultBackupLocationInfo.StorageLocation)
+			if location.Name == defaultLocation {
+				log.Warnf("The default backup location named %q is unavailable; for convenience, be sure to configure it properly or make another backup location that is available the default", defaultLocation)
 			}
 
 			location.Status.Phase = velerov1api.BackupStorageLocationPhaseUnavailable
+			location.Status.Message = err.Error()
+			r.setAvailableCondition(location, corev1api.ConditionFalse, reason, err.Error())
 		} else {
 			log.Debug("Backup location verified and it is valid")
 			location.Status.Phase = velerov1api.BackupStorageLocationPhaseAvailable
+			location.Status.Message = ""
+			r.setAvailableCondition(location, corev1api.ConditionTrue, velerov1api.BackupStorageLocationReasonValidationSucceeded, "")
 		}
 		location.Status.LastValidationTime = &metav1.Time{Time: time.Now().UTC()}
 		if err := patchHelper.Patch(r.Ctx, location); err != nil {
@@ -120,12 +137,76 @@ func (r *BackupStorageLocationReconciler) Reconcile(req ctrl.Request) (ctrl.Resu
 		log.Info("No backup locations were ready to be verified")
 	}
 
-	r.logReconciledPhase(defaultFound, locationList, unavailableErrors)
+	r.logReconciledPhase(defaultLocation, defaultFound, defaultErr, locationList, unavailableErrors)
+
+	available := make(map[string]bool, len(locationList.Items))
+	for _, location := range locationList.Items {
+		available[location.Name] = location.Status.Phase == velerov1api.BackupStorageLocationPhaseAvailable
+	}
+	r.Metrics.SetBackupStorageLocationsAvailable(available)
+
+	return ctrl.Result{RequeueAfter: r.requeueAfter(locationList.Items)}, nil
+}
+
+// setAvailableCondition sets the location's Available condition. If the condition's status
+// changes, its last transition time is updated and an event is recorded for the location.
+func (r *BackupStorageLocationReconciler) setAvailableCondition(location *velerov1api.BackupStorageLocation, status corev1api.ConditionStatus, reason, message string) {
+	condition := velerov1api.BackupStorageLocationCondition{
+		Type:               velerov1api.BackupStorageLocationConditionAvailable,
+		Status:             status,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+
+	for i := range location.Status.Conditions {
+		existing := &location.Status.Conditions[i]
+		if existing.Type != condition.Type {
+			continue
+		}
+
+		if existing.Status == condition.Status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		} else {
+			r.recordAvailabilityEvent(location, condition)
+		}
+		*existing = condition
+		return
+	}
+
+	r.recordAvailabilityEvent(location, condition)
+	location.Status.Conditions = append(location.Status.Conditions, condition)
+}
+
+func (r *BackupStorageLocationReconciler) recordAvailabilityEvent(location *velerov1api.BackupStorageLocation, condition velerov1api.BackupStorageLocationCondition) {
+	if condition.Status == corev1api.ConditionTrue {
+		r.EventRecorder.Event(location, corev1api.EventTypeNormal, "Available", "Backup storage location is available")
+		return
+	}
+
+	r.EventRecorder.Eventf(location, corev1api.EventTypeWarning, "Unavailable", "Backup storage location is unavailable: %s", condition.Message)
+}
+
+// requeueAfter returns how long to wait before reconciling the locations again. It's the
+// shortest of their validation frequencies, so that each location is validated when it's
+// due, or zero if none of them are validated periodically.
+func (r *BackupStorageLocationReconciler) requeueAfter(locations []velerov1api.BackupStorageLocation) time.Duration {
+	var requeueAfter time.Duration
+	for _, location := range locations {
+		frequency := r.DefaultBackupLocationInfo.StoreValidationFrequency
+		if location.Spec.ValidationFrequency != nil && location.Spec.ValidationFrequency.Duration >= 0 {
+			frequency = location.Spec.ValidationFrequency.Duration
+		}
+
+		if frequency > 0 && (requeueAfter == 0 || frequency < requeueAfter) {
+			requeueAfter = frequency
+		}
+	}
 
-	return ctrl.Result{Requeue: true}, nil
+	return requeueAfter
 }
 
-func (r *BackupStorageLocationReconciler) logReconciledPhase(defaultFound bool, locationList velerov1api.BackupStorageLocationList, errs []string) {
+func (r *BackupStorageLocationReconciler) logReconciledPhase(defaultLocation string, defaultFound bool, defaultErr error, locationList velerov1api.BackupStorageLocationList, errs []string) {
 	var availableBSLs []*velerov1api.BackupStorageLocation
 	var unAvailableBSLs []*velerov1api.BackupStorageLocation
 	var unknownBSLs []*velerov1api.BackupStorageLocation
@@ -157,8 +238,11 @@ func (r *BackupStorageLocationReconciler) logReconciledPhase(defaultFound bool,
 		log.Warnf("Invalid backup locations detected: available/unavailable/unknown: %v/%v/%v, %s)", numAvailable, numUnavailable, numUnknown, strings.Join(errs, "; "))
 	}
 
-	if !defaultFound {
-		log.Warnf("The specified default backup location named %q was not found; for convenience, be sure to create one or make another backup location that is available the default", r.DefaultBackupLocationInfo.StorageLocation)
+	switch {
+	case defaultErr != nil:
+		log.WithError(defaultErr).Error("Unable to determine the default backup location; backups that don't specify a storage location will fail validation until exactly one location has spec.default set")
+	case !defaultFound:
+		log.Warnf("The default backup location named %q was not found; for convenience, be sure to create one or set spec.default on another backup location that is available", defaultLocation)
 	}
 }
 
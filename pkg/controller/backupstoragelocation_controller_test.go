@@ -27,12 +27,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	corev1api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
@@ -46,19 +49,27 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 
 	It("Should successfully patch a backup storage location object status phase according to whether its storage is valid or not", func() {
 		tests := []struct {
-			backupLocation *velerov1api.BackupStorageLocation
-			isValidError   error
-			expectedPhase  velerov1api.BackupStorageLocationPhase
+			backupLocation          *velerov1api.BackupStorageLocation
+			isValidError            error
+			expectedPhase           velerov1api.BackupStorageLocationPhase
+			expectedMessage         string
+			expectedConditionStatus corev1api.ConditionStatus
+			expectedReason          string
 		}{
 			{
-				backupLocation: builder.ForBackupStorageLocation("ns-1", "location-1").ValidationFrequency(1 * time.Second).Result(),
-				isValidError:   nil,
-				expectedPhase:  velerov1api.BackupStorageLocationPhaseAvailable,
+				backupLocation:          builder.ForBackupStorageLocation("ns-1", "location-1").ValidationFrequency(1 * time.Second).Result(),
+				isValidError:            nil,
+				expectedPhase:           velerov1api.BackupStorageLocationPhaseAvailable,
+				expectedConditionStatus: corev1api.ConditionTrue,
+				expectedReason:          velerov1api.BackupStorageLocationReasonValidationSucceeded,
 			},
 			{
-				backupLocation: builder.ForBackupStorageLocation("ns-1", "location-2").ValidationFrequency(1 * time.Second).Result(),
-				isValidError:   errors.New("an error"),
-				expectedPhase:  velerov1api.BackupStorageLocationPhaseUnavailable,
+				backupLocation:          builder.ForBackupStorageLocation("ns-1", "location-2").ValidationFrequency(1 * time.Second).Result(),
+				isValidError:            errors.New("an error"),
+				expectedPhase:           velerov1api.BackupStorageLocationPhaseUnavailable,
+				expectedMessage:         "an error",
+				expectedConditionStatus: corev1api.ConditionFalse,
+				expectedReason:          velerov1api.BackupStorageLocationReasonValidationFailed,
 			},
 		}
 
@@ -92,14 +103,16 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 				// this gets populated just below, prior to exercising the method under test
 				return backupStores[loc.Name], nil
 			},
-			Log: velerotest.NewLogger(),
+			Metrics:       metrics.NewServerMetrics(),
+			EventRecorder: record.NewFakeRecorder(10),
+			Log:           velerotest.NewLogger(),
 		}
 
 		actualResult, err := r.Reconcile(ctrl.Request{
 			NamespacedName: types.NamespacedName{Namespace: "ns-1"},
 		})
 
-		Expect(actualResult).To(BeEquivalentTo(ctrl.Result{Requeue: true}))
+		Expect(actualResult).To(BeEquivalentTo(ctrl.Result{RequeueAfter: time.Second}))
 		Expect(err).To(BeNil())
 
 		// Assertions
@@ -109,9 +122,73 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 			err := r.Client.Get(ctx, key, instance)
 			Expect(err).To(BeNil())
 			Expect(instance.Status.Phase).To(BeIdenticalTo(tests[i].expectedPhase))
+			Expect(instance.Status.Message).To(BeIdenticalTo(tests[i].expectedMessage))
+			Expect(instance.Status.Conditions).To(HaveLen(1))
+			Expect(instance.Status.Conditions[0].Type).To(Equal(velerov1api.BackupStorageLocationConditionAvailable))
+			Expect(instance.Status.Conditions[0].Status).To(Equal(tests[i].expectedConditionStatus))
+			Expect(instance.Status.Conditions[0].Reason).To(Equal(tests[i].expectedReason))
+			Expect(instance.Status.Conditions[0].Message).To(Equal(tests[i].expectedMessage))
 		}
 	})
 
+	It("Should set a backup storage location's Available condition and record an event only when its availability changes", func() {
+		var (
+			pluginManager = &pluginmocks.Manager{}
+			backupStore   = &persistencemocks.BackupStore{}
+			eventRecorder = record.NewFakeRecorder(10)
+			isValidError  error
+		)
+		pluginManager.On("CleanupClients").Return(nil)
+		backupStore.On("IsValid").Return(func() error { return isValidError })
+
+		// requesting validation in the future makes the location be validated every time it's reconciled
+		location := builder.ForBackupStorageLocation("ns-1", "location-1").
+			ObjectMeta(builder.WithAnnotations(velerov1api.ValidationRequestedAnnotation, time.Now().UTC().Add(time.Hour).Format(time.RFC3339Nano))).
+			ValidationFrequency(0).
+			Result()
+
+		Expect(velerov1api.AddToScheme(scheme.Scheme)).To(Succeed())
+		r := BackupStorageLocationReconciler{
+			Ctx:    ctx,
+			Client: fake.NewFakeClientWithScheme(scheme.Scheme, location),
+			DefaultBackupLocationInfo: storage.DefaultBackupLocationInfo{
+				StorageLocation: "default",
+			},
+			NewPluginManager: func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			NewBackupStore: func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+				return backupStore, nil
+			},
+			Metrics:       metrics.NewServerMetrics(),
+			EventRecorder: eventRecorder,
+			Log:           velerotest.NewLogger(),
+		}
+
+		reconcile := func() *velerov1api.BackupStorageLocation {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns-1"}})
+			Expect(err).To(BeNil())
+
+			instance := &velerov1api.BackupStorageLocation{}
+			Expect(r.Client.Get(ctx, client.ObjectKey{Namespace: "ns-1", Name: "location-1"}, instance)).To(Succeed())
+			Expect(instance.Status.Conditions).To(HaveLen(1))
+			return instance
+		}
+
+		instance := reconcile()
+		Expect(instance.Status.Conditions[0].Status).To(Equal(corev1api.ConditionTrue))
+		Expect(eventRecorder.Events).To(Receive(Equal("Normal Available Backup storage location is available")))
+
+		instance = reconcile()
+		Expect(instance.Status.Conditions[0].Status).To(Equal(corev1api.ConditionTrue))
+		Expect(eventRecorder.Events).NotTo(Receive())
+
+		isValidError = errors.New("an error")
+		instance = reconcile()
+		Expect(instance.Status.Conditions[0].Status).To(Equal(corev1api.ConditionFalse))
+		Expect(instance.Status.Conditions[0].Reason).To(Equal(velerov1api.BackupStorageLocationReasonValidationFailed))
+		Expect(instance.Status.Conditions[0].Message).To(Equal("an error"))
+		Expect(eventRecorder.Events).To(Receive(Equal("Warning Unavailable Backup storage location is unavailable: an error")))
+	})
+
 	It("Should not patch a backup storage location object status phase if the location's validation frequency is specifically set to zero", func() {
 		tests := []struct {
 			backupLocation *velerov1api.BackupStorageLocation
@@ -162,14 +239,92 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 				// this gets populated just below, prior to exercising the method under test
 				return backupStores[loc.Name], nil
 			},
-			Log: velerotest.NewLogger(),
+			Metrics:       metrics.NewServerMetrics(),
+			EventRecorder: record.NewFakeRecorder(10),
+			Log:           velerotest.NewLogger(),
+		}
+
+		actualResult, err := r.Reconcile(ctrl.Request{
+			NamespacedName: types.NamespacedName{Namespace: "ns-1"},
+		})
+
+		Expect(actualResult).To(BeEquivalentTo(ctrl.Result{}))
+		Expect(err).To(BeNil())
+
+		// Assertions
+		for i, location := range locations.Items {
+			key := client.ObjectKey{Name: location.Name, Namespace: location.Namespace}
+			instance := &velerov1api.BackupStorageLocation{}
+			err := r.Client.Get(ctx, key, instance)
+			Expect(err).To(BeNil())
+			Expect(instance.Status.Phase).To(BeIdenticalTo(tests[i].expectedPhase))
+		}
+	})
+
+	It("Should patch a backup storage location object status phase if its validation was requested, even if its validation frequency is set to zero", func() {
+		tests := []struct {
+			backupLocation *velerov1api.BackupStorageLocation
+			isValidError   error
+			expectedPhase  velerov1api.BackupStorageLocationPhase
+		}{
+			{
+				backupLocation: builder.ForBackupStorageLocation("ns-1", "location-1").
+					ObjectMeta(builder.WithAnnotations(velerov1api.ValidationRequestedAnnotation, time.Now().UTC().Format(time.RFC3339Nano))).
+					ValidationFrequency(0).
+					Result(),
+				isValidError:  nil,
+				expectedPhase: velerov1api.BackupStorageLocationPhaseAvailable,
+			},
+			{
+				backupLocation: builder.ForBackupStorageLocation("ns-1", "location-2").
+					ObjectMeta(builder.WithAnnotations(velerov1api.ValidationRequestedAnnotation, time.Now().UTC().Add(-time.Hour).Format(time.RFC3339Nano))).
+					ValidationFrequency(0).
+					LastValidationTime(time.Now().UTC()).
+					Result(),
+				isValidError:  nil,
+				expectedPhase: "",
+			},
+		}
+
+		// Setup
+		var (
+			pluginManager = &pluginmocks.Manager{}
+			backupStores  = make(map[string]*persistencemocks.BackupStore)
+		)
+		pluginManager.On("CleanupClients").Return(nil)
+
+		locations := new(velerov1api.BackupStorageLocationList)
+		for i, test := range tests {
+			location := test.backupLocation
+			locations.Items = append(locations.Items, *location)
+			backupStores[location.Name] = &persistencemocks.BackupStore{}
+			backupStores[location.Name].On("IsValid").Return(tests[i].isValidError)
+		}
+
+		// Setup reconciler
+		Expect(velerov1api.AddToScheme(scheme.Scheme)).To(Succeed())
+		r := BackupStorageLocationReconciler{
+			Ctx:    ctx,
+			Client: fake.NewFakeClientWithScheme(scheme.Scheme, locations),
+			DefaultBackupLocationInfo: storage.DefaultBackupLocationInfo{
+				StorageLocation:          "default",
+				StoreValidationFrequency: time.Minute,
+			},
+			NewPluginManager: func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			NewBackupStore: func(loc *velerov1api.BackupStorageLocation, _ persistence.ObjectStoreGetter, _ logrus.FieldLogger) (persistence.BackupStore, error) {
+				// this gets populated just below, prior to exercising the method under test
+				return backupStores[loc.Name], nil
+			},
+			Metrics:       metrics.NewServerMetrics(),
+			EventRecorder: record.NewFakeRecorder(10),
+			Log:           velerotest.NewLogger(),
 		}
 
 		actualResult, err := r.Reconcile(ctrl.Request{
 			NamespacedName: types.NamespacedName{Namespace: "ns-1"},
 		})
 
-		Expect(actualResult).To(BeEquivalentTo(ctrl.Result{Requeue: true}))
+		Expect(actualResult).To(BeEquivalentTo(ctrl.Result{}))
 		Expect(err).To(BeNil())
 
 		// Assertions
@@ -0,0 +1,169 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+)
+
+// DownloadProxyPathPrefix is the path that proxied downloads are served under.
+const DownloadProxyPathPrefix = "/downloads/"
+
+// downloadProxyPath returns the path that the download of the named DownloadRequest
+// is served at.
+func downloadProxyPath(namespace, name, token string) string {
+	return path.Join(DownloadProxyPathPrefix, namespace, name, token)
+}
+
+// newDownloadToken returns a random token for a download's path, so that the download
+// can only be fetched by users who can read its DownloadRequest.
+func newDownloadToken() (string, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return "", errors.Wrap(err, "error generating download token")
+	}
+
+	return hex.EncodeToString(token), nil
+}
+
+// downloadProxy streams the targets of processed DownloadRequests from their backup
+// storage locations, for locations whose download mode is Proxy.
+type downloadProxy struct {
+	downloadRequestLister velerov1listers.DownloadRequestLister
+	restoreLister         velerov1listers.RestoreLister
+	backupLister          velerov1listers.BackupLister
+	kbClient              client.Client
+	newPluginManager      func(logrus.FieldLogger) clientmgmt.Manager
+	newBackupStore        func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	clock                 clock.Clock
+	logger                logrus.FieldLogger
+}
+
+// NewDownloadProxy returns a handler that serves the downloads of DownloadRequests at
+// the paths in their status.
+func NewDownloadProxy(
+	downloadRequestLister velerov1listers.DownloadRequestLister,
+	restoreLister velerov1listers.RestoreLister,
+	backupLister velerov1listers.BackupLister,
+	kbClient client.Client,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
+	logger logrus.FieldLogger,
+) http.Handler {
+	return &downloadProxy{
+		downloadRequestLister: downloadRequestLister,
+		restoreLister:         restoreLister,
+		backupLister:          backupLister,
+		kbClient:              kbClient,
+		newPluginManager:      newPluginManager,
+		newBackupStore:        persistence.NewObjectBackupStoreWithCredentials(credentialFileStore),
+		clock:                 &clock.RealClock{},
+		logger:                logger,
+	}
+}
+
+func (p *downloadProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// paths are /downloads/<namespace>/<name>/<token>
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, DownloadProxyPathPrefix), "/")
+	if len(parts) != 3 {
+		http.NotFound(w, r)
+		return
+	}
+
+	log := p.logger.WithField("downloadRequest", parts[0]+"/"+parts[1])
+
+	downloadRequest, err := p.downloadRequestLister.DownloadRequests(parts[0]).Get(parts[1])
+	if apierrors.IsNotFound(err) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		log.WithError(err).Error("Error getting download request")
+		http.Error(w, "error getting download request", http.StatusInternalServerError)
+		return
+	}
+
+	proxy := downloadRequest.Status.Proxy
+	if downloadRequest.Status.Phase != velerov1api.DownloadRequestPhaseProcessed || proxy == nil ||
+		subtle.ConstantTimeCompare([]byte(proxy.Path), []byte(r.URL.Path)) != 1 {
+		http.NotFound(w, r)
+		return
+	}
+	if downloadRequest.Status.Expiration == nil || !downloadRequest.Status.Expiration.Time.After(p.clock.Now()) {
+		http.Error(w, "download request has expired", http.StatusForbidden)
+		return
+	}
+
+	backupLocation, err := getDownloadBackupLocation(downloadRequest, p.restoreLister, p.backupLister, p.kbClient)
+	if err != nil {
+		log.WithError(err).Error("Error getting backup storage location")
+		http.Error(w, "error getting backup storage location", http.StatusInternalServerError)
+		return
+	}
+
+	pluginManager := p.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := p.newBackupStore(backupLocation, pluginManager, log)
+	if err != nil {
+		log.WithError(err).Error("Error getting backup store")
+		http.Error(w, "error getting backup store", http.StatusInternalServerError)
+		return
+	}
+
+	rdr, err := backupStore.GetDownload(downloadRequest.Spec.Target)
+	if err != nil {
+		log.WithError(err).Error("Error getting download")
+		http.Error(w, "error getting download", http.StatusInternalServerError)
+		return
+	}
+	// a missing object is reported the same way as a pre-signed URL for a
+	// missing object, so that clients can treat both download modes alike.
+	if rdr == nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer rdr.Close()
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if _, err := io.Copy(w, rdr); err != nil {
+		log.WithError(err).Error("Error streaming download")
+	}
+}
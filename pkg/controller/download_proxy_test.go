@@ -0,0 +1,176 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestDownloadProxyServeHTTP(t *testing.T) {
+	now, err := time.Parse(time.RFC1123, time.RFC1123)
+	require.NoError(t, err)
+
+	const proxyPath = "/downloads/velero/a-download-request/a-token"
+
+	processedRequest := func(expiration time.Time) *velerov1api.DownloadRequest {
+		req := newDownloadRequest(velerov1api.DownloadRequestPhaseProcessed, velerov1api.DownloadTargetKindBackupLog, "a-backup")
+		req.Status.Proxy = &velerov1api.DownloadProxy{Pod: "velero-pod", Port: 8086, Path: proxyPath}
+		req.Status.Expiration = &metav1.Time{Time: expiration}
+		return req
+	}
+
+	tests := []struct {
+		name            string
+		method          string
+		path            string
+		downloadRequest *velerov1api.DownloadRequest
+		objectMissing   bool
+		expectGet       bool
+		wantStatus      int
+		wantBody        string
+	}{
+		{
+			name:       "malformed path is not found",
+			path:       "/downloads/velero/a-download-request",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "nonexistent download request is not found",
+			path:       proxyPath,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:            "path with the wrong token is not found",
+			path:            "/downloads/velero/a-download-request/another-token",
+			downloadRequest: processedRequest(now.Add(time.Minute)),
+			wantStatus:      http.StatusNotFound,
+		},
+		{
+			name:            "download request that isn't proxied is not found",
+			path:            proxyPath,
+			downloadRequest: newDownloadRequest(velerov1api.DownloadRequestPhaseNew, velerov1api.DownloadTargetKindBackupLog, "a-backup"),
+			wantStatus:      http.StatusNotFound,
+		},
+		{
+			name:            "expired download request is forbidden",
+			path:            proxyPath,
+			downloadRequest: processedRequest(now.Add(-time.Minute)),
+			wantStatus:      http.StatusForbidden,
+		},
+		{
+			name:            "methods other than GET aren't allowed",
+			method:          http.MethodPost,
+			path:            proxyPath,
+			downloadRequest: processedRequest(now.Add(time.Minute)),
+			wantStatus:      http.StatusMethodNotAllowed,
+		},
+		{
+			name:            "missing object is not found",
+			path:            proxyPath,
+			downloadRequest: processedRequest(now.Add(time.Minute)),
+			objectMissing:   true,
+			expectGet:       true,
+			wantStatus:      http.StatusNotFound,
+		},
+		{
+			name:            "object is streamed",
+			path:            proxyPath,
+			downloadRequest: processedRequest(now.Add(time.Minute)),
+			expectGet:       true,
+			wantStatus:      http.StatusOK,
+			wantBody:        "some log",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset()
+				informerFactory = informers.NewSharedInformerFactory(client, 0)
+				pluginManager   = new(pluginmocks.Manager)
+				backupStore     = new(persistencemocks.BackupStore)
+			)
+
+			handler := NewDownloadProxy(
+				informerFactory.Velero().V1().DownloadRequests().Lister(),
+				informerFactory.Velero().V1().Restores().Lister(),
+				informerFactory.Velero().V1().Backups().Lister(),
+				newFakeClient(t, newBackupLocation("a-location", "a-provider", "a-bucket")),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				nil,
+				velerotest.NewLogger(),
+			).(*downloadProxy)
+			handler.clock = clock.NewFakeClock(now)
+			handler.newBackupStore = func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+				return backupStore, nil
+			}
+
+			require.NoError(t, informerFactory.Velero().V1().Backups().Informer().GetStore().Add(
+				builder.ForBackup(velerov1api.DefaultNamespace, "a-backup").StorageLocation("a-location").Result(),
+			))
+			if tc.downloadRequest != nil {
+				require.NoError(t, informerFactory.Velero().V1().DownloadRequests().Informer().GetStore().Add(tc.downloadRequest))
+			}
+
+			if tc.expectGet {
+				pluginManager.On("CleanupClients").Return()
+
+				var rdr io.ReadCloser
+				if !tc.objectMissing {
+					rdr = ioutil.NopCloser(strings.NewReader(tc.wantBody))
+				}
+				backupStore.On("GetDownload", tc.downloadRequest.Spec.Target).Return(rdr, nil)
+			}
+
+			method := tc.method
+			if method == "" {
+				method = http.MethodGet
+			}
+
+			res := httptest.NewRecorder()
+			handler.ServeHTTP(res, httptest.NewRequest(method, tc.path, nil))
+
+			assert.Equal(t, tc.wantStatus, res.Code)
+			if tc.wantStatus == http.StatusOK {
+				assert.Equal(t, tc.wantBody, res.Body.String())
+			}
+			backupStore.AssertExpectations(t)
+		})
+	}
+}
@@ -19,7 +19,6 @@ package controller
 import (
 	"context"
 	"encoding/json"
-	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
@@ -32,6 +31,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
@@ -52,9 +52,13 @@ type downloadRequestController struct {
 	backupLister          velerov1listers.BackupLister
 	newPluginManager      func(logrus.FieldLogger) clientmgmt.Manager
 	newBackupStore        func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	downloadProxyPod      string
+	downloadProxyPort     int32
 }
 
-// NewDownloadRequestController creates a new DownloadRequestController.
+// NewDownloadRequestController creates a new DownloadRequestController. The downloads
+// of backup storage locations whose download mode is Proxy are served by the named pod
+// on downloadProxyPort, or fail if downloadProxyPod is empty.
 func NewDownloadRequestController(
 	downloadRequestClient velerov1client.DownloadRequestsGetter,
 	downloadRequestInformer velerov1informers.DownloadRequestInformer,
@@ -62,6 +66,9 @@ func NewDownloadRequestController(
 	kbClient client.Client,
 	backupLister velerov1listers.BackupLister,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
+	downloadProxyPod string,
+	downloadProxyPort int32,
 	logger logrus.FieldLogger,
 ) Interface {
 	c := &downloadRequestController{
@@ -75,7 +82,10 @@ func NewDownloadRequestController(
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
 		newPluginManager: newPluginManager,
-		newBackupStore:   persistence.NewObjectBackupStore,
+		newBackupStore:   persistence.NewObjectBackupStoreWithCredentials(credentialFileStore),
+
+		downloadProxyPod:  downloadProxyPod,
+		downloadProxyPort: downloadProxyPort,
 
 		clock: &clock.RealClock{},
 	}
@@ -101,8 +111,9 @@ func NewDownloadRequestController(
 	return c
 }
 
-// processDownloadRequest is the default per-item sync handler. It generates a pre-signed URL for
-// a new DownloadRequest or deletes the DownloadRequest if it has expired.
+// processDownloadRequest is the default per-item sync handler. It generates a pre-signed URL, or
+// the location of a proxied download, for a new DownloadRequest or deletes the DownloadRequest
+// if it has expired.
 func (c *downloadRequestController) processDownloadRequest(key string) error {
 	log := c.logger.WithField("key", key)
 
@@ -132,23 +143,68 @@ func (c *downloadRequestController) processDownloadRequest(key string) error {
 	return nil
 }
 
-const signedURLTTL = 10 * time.Minute
-
-// generatePreSignedURL generates a pre-signed URL for downloadRequest, changes the phase to
-// Processed, and persists the changes to storage.
+// generatePreSignedURL generates a pre-signed URL for downloadRequest, or the location that
+// the server proxies it at if its backup storage location's download mode is Proxy, changes
+// the phase to Processed, and persists the changes to storage.
 func (c *downloadRequestController) generatePreSignedURL(downloadRequest *velerov1api.DownloadRequest, log logrus.FieldLogger) error {
 	update := downloadRequest.DeepCopy()
 
-	var (
-		backupName string
-		err        error
-	)
+	backupLocation, err := getDownloadBackupLocation(downloadRequest, c.restoreLister, c.backupLister, c.kbClient)
+	if err != nil {
+		return err
+	}
+
+	if backupLocation.Spec.ObjectStorage != nil && backupLocation.Spec.ObjectStorage.DownloadMode == velerov1api.DownloadModeProxy {
+		if c.downloadProxyPod == "" {
+			return errors.Errorf("backup storage location %s's download mode is %s, but the server isn't serving downloads", backupLocation.Name, velerov1api.DownloadModeProxy)
+		}
+
+		token, err := newDownloadToken()
+		if err != nil {
+			return err
+		}
+
+		update.Status.Proxy = &velerov1api.DownloadProxy{
+			Pod:  c.downloadProxyPod,
+			Port: c.downloadProxyPort,
+			Path: downloadProxyPath(downloadRequest.Namespace, downloadRequest.Name, token),
+		}
+	} else {
+		pluginManager := c.newPluginManager(log)
+		defer pluginManager.CleanupClients()
+
+		backupStore, err := c.newBackupStore(backupLocation, pluginManager, log)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		if update.Status.DownloadURL, err = backupStore.GetDownloadURL(downloadRequest.Spec.Target); err != nil {
+			return err
+		}
+	}
+
+	update.Status.Phase = velerov1api.DownloadRequestPhaseProcessed
+	update.Status.Expiration = &metav1.Time{Time: c.clock.Now().Add(persistence.GetDownloadURLTTL(backupLocation))}
+
+	_, err = patchDownloadRequest(downloadRequest, update, c.downloadRequestClient)
+	return errors.WithStack(err)
+}
+
+// getDownloadBackupLocation returns the backup storage location that stores the target
+// of downloadRequest.
+func getDownloadBackupLocation(
+	downloadRequest *velerov1api.DownloadRequest,
+	restoreLister velerov1listers.RestoreLister,
+	backupLister velerov1listers.BackupLister,
+	kbClient client.Client,
+) (*velerov1api.BackupStorageLocation, error) {
+	var backupName string
 
 	switch downloadRequest.Spec.Target.Kind {
 	case velerov1api.DownloadTargetKindRestoreLog, velerov1api.DownloadTargetKindRestoreResults:
-		restore, err := c.restoreLister.Restores(downloadRequest.Namespace).Get(downloadRequest.Spec.Target.Name)
+		restore, err := restoreLister.Restores(downloadRequest.Namespace).Get(downloadRequest.Spec.Target.Name)
 		if err != nil {
-			return errors.Wrap(err, "error getting Restore")
+			return nil, errors.Wrap(err, "error getting Restore")
 		}
 
 		backupName = restore.Spec.BackupName
@@ -156,36 +212,20 @@ func (c *downloadRequestController) generatePreSignedURL(downloadRequest *velero
 		backupName = downloadRequest.Spec.Target.Name
 	}
 
-	backup, err := c.backupLister.Backups(downloadRequest.Namespace).Get(backupName)
+	backup, err := backupLister.Backups(downloadRequest.Namespace).Get(backupName)
 	if err != nil {
-		return errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 
 	backupLocation := &velerov1api.BackupStorageLocation{}
-	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+	if err := kbClient.Get(context.Background(), client.ObjectKey{
 		Namespace: backup.Namespace,
 		Name:      backup.Spec.StorageLocation,
 	}, backupLocation); err != nil {
-		return errors.WithStack(err)
-	}
-
-	pluginManager := c.newPluginManager(log)
-	defer pluginManager.CleanupClients()
-
-	backupStore, err := c.newBackupStore(backupLocation, pluginManager, log)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-
-	if update.Status.DownloadURL, err = backupStore.GetDownloadURL(downloadRequest.Spec.Target); err != nil {
-		return err
+		return nil, errors.WithStack(err)
 	}
 
-	update.Status.Phase = velerov1api.DownloadRequestPhaseProcessed
-	update.Status.Expiration = &metav1.Time{Time: c.clock.Now().Add(persistence.DownloadURLTTL)}
-
-	_, err = patchDownloadRequest(downloadRequest, update, c.downloadRequestClient)
-	return errors.WithStack(err)
+	return backupLocation, nil
 }
 
 // deleteIfExpired deletes downloadRequest if it has expired.
@@ -64,6 +64,9 @@ func newDownloadRequestTestHarness(t *testing.T, fakeClient client.Client) *down
 			fakeClient,
 			informerFactory.Velero().V1().Backups().Lister(),
 			func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			nil, // credential file store
+			"velero-pod",
+			8086,
 			velerotest.NewLogger(),
 		).(*downloadRequestController)
 	)
@@ -136,8 +139,10 @@ func TestProcessDownloadRequest(t *testing.T) {
 		restore         *velerov1api.Restore
 		backupLocation  *velerov1api.BackupStorageLocation
 		expired         bool
+		noDownloadProxy bool
 		expectedErr     string
 		expectGetsURL   bool
+		expectProxy     bool
 	}{
 		{
 			name: "empty key returns without error",
@@ -233,6 +238,28 @@ func TestProcessDownloadRequest(t *testing.T) {
 			backupLocation:  newBackupLocation("a-location", "a-provider", "a-bucket"),
 			expectGetsURL:   true,
 		},
+		{
+			name:            "backup contents request for location with a download URL TTL gets a url that expires after it",
+			downloadRequest: newDownloadRequest("", velerov1api.DownloadTargetKindBackupContents, "a-backup"),
+			backup:          defaultBackup(),
+			backupLocation:  builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "a-location").Provider("a-provider").Bucket("a-bucket").DownloadURLTTL(time.Hour).Result(),
+			expectGetsURL:   true,
+		},
+		{
+			name:            "backup contents request for location with proxy download mode gets the proxy's path",
+			downloadRequest: newDownloadRequest("", velerov1api.DownloadTargetKindBackupContents, "a-backup"),
+			backup:          defaultBackup(),
+			backupLocation:  builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "a-location").Provider("a-provider").Bucket("a-bucket").DownloadMode(velerov1api.DownloadModeProxy).Result(),
+			expectProxy:     true,
+		},
+		{
+			name:            "backup contents request for location with proxy download mode returns an error when downloads aren't served",
+			downloadRequest: newDownloadRequest("", velerov1api.DownloadTargetKindBackupContents, "a-backup"),
+			backup:          defaultBackup(),
+			backupLocation:  builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "a-location").Provider("a-provider").Bucket("a-bucket").DownloadMode(velerov1api.DownloadModeProxy).Result(),
+			noDownloadProxy: true,
+			expectedErr:     "backup storage location a-location's download mode is Proxy, but the server isn't serving downloads",
+		},
 		{
 			name:            "request with phase 'Processed' is not deleted if not expired",
 			downloadRequest: newDownloadRequest(velerov1api.DownloadRequestPhaseProcessed, velerov1api.DownloadTargetKindBackupLog, "a-backup-20170912150214"),
@@ -256,6 +283,9 @@ func TestProcessDownloadRequest(t *testing.T) {
 			}
 
 			harness := newDownloadRequestTestHarness(t, fakeClient)
+			if tc.noDownloadProxy {
+				harness.controller.downloadProxyPod = ""
+			}
 
 			// set up test case data
 
@@ -309,7 +339,20 @@ func TestProcessDownloadRequest(t *testing.T) {
 
 				assert.Equal(t, string(velerov1api.DownloadRequestPhaseProcessed), string(output.Status.Phase))
 				assert.Equal(t, "a-url", output.Status.DownloadURL)
-				assert.True(t, velerotest.TimesAreEqual(harness.controller.clock.Now().Add(signedURLTTL), output.Status.Expiration.Time), "expiration does not match")
+				assert.True(t, velerotest.TimesAreEqual(harness.controller.clock.Now().Add(persistence.GetDownloadURLTTL(tc.backupLocation)), output.Status.Expiration.Time), "expiration does not match")
+			}
+
+			if tc.expectProxy {
+				output, err := harness.client.VeleroV1().DownloadRequests(tc.downloadRequest.Namespace).Get(context.TODO(), tc.downloadRequest.Name, metav1.GetOptions{})
+				require.NoError(t, err)
+
+				assert.Equal(t, string(velerov1api.DownloadRequestPhaseProcessed), string(output.Status.Phase))
+				assert.Empty(t, output.Status.DownloadURL)
+				require.NotNil(t, output.Status.Proxy)
+				assert.Equal(t, "velero-pod", output.Status.Proxy.Pod)
+				assert.Equal(t, int32(8086), output.Status.Proxy.Port)
+				assert.Regexp(t, "^/downloads/velero/a-download-request/[0-9a-f]{32}$", output.Status.Proxy.Path)
+				assert.True(t, velerotest.TimesAreEqual(harness.controller.clock.Now().Add(persistence.DownloadURLTTL), output.Status.Expiration.Time), "expiration does not match")
 			}
 
 			if tc.downloadRequest != nil && tc.downloadRequest.Status.Phase == velerov1api.DownloadRequestPhaseProcessed {
@@ -26,30 +26,45 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/clock"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/tools/cache"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
 const (
 	GCSyncPeriod = 60 * time.Minute
 )
 
-// gcController creates DeleteBackupRequests for expired backups.
+// gcController creates DeleteBackupRequests for expired backups, and for backups that
+// are older than the number of backups their schedule keeps. It deletes the volume
+// snapshots of backups whose snapshot TTL has elapsed.
 type gcController struct {
 	*genericController
 
 	backupLister              velerov1listers.BackupLister
+	backupClient              velerov1client.BackupsGetter
+	scheduleLister            velerov1listers.ScheduleLister
 	deleteBackupRequestLister velerov1listers.DeleteBackupRequestLister
 	deleteBackupRequestClient velerov1client.DeleteBackupRequestsGetter
+	snapshotLocationLister    velerov1listers.VolumeSnapshotLocationLister
 	kbClient                  client.Client
+	newPluginManager          func(logrus.FieldLogger) clientmgmt.Manager
+	newBackupStore            func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	credentialFileStore       credentials.FileStore
+	snapshotLimiter           *clientmgmt.SnapshotLimiter
 
 	clock clock.Clock
 }
@@ -58,17 +73,30 @@ type gcController struct {
 func NewGCController(
 	logger logrus.FieldLogger,
 	backupInformer velerov1informers.BackupInformer,
+	backupClient velerov1client.BackupsGetter,
+	scheduleLister velerov1listers.ScheduleLister,
 	deleteBackupRequestLister velerov1listers.DeleteBackupRequestLister,
 	deleteBackupRequestClient velerov1client.DeleteBackupRequestsGetter,
+	snapshotLocationLister velerov1listers.VolumeSnapshotLocationLister,
 	kbClient client.Client,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
+	snapshotLimiter *clientmgmt.SnapshotLimiter,
 ) Interface {
 	c := &gcController{
 		genericController:         newGenericController("gc-controller", logger),
 		clock:                     clock.RealClock{},
 		backupLister:              backupInformer.Lister(),
+		backupClient:              backupClient,
+		scheduleLister:            scheduleLister,
 		deleteBackupRequestLister: deleteBackupRequestLister,
 		deleteBackupRequestClient: deleteBackupRequestClient,
+		snapshotLocationLister:    snapshotLocationLister,
 		kbClient:                  kbClient,
+		newPluginManager:          newPluginManager,
+		newBackupStore:            persistence.NewObjectBackupStoreWithCredentials(credentialFileStore),
+		credentialFileStore:       credentialFileStore,
+		snapshotLimiter:           snapshotLimiter,
 	}
 
 	c.syncHandler = c.processQueueItem
@@ -127,12 +155,28 @@ func (c *gcController) processQueueItem(key string) error {
 
 	now := c.clock.Now()
 
+	// whether only the backup's volume snapshots are deleted, rather than the whole backup.
+	var snapshotsOnly bool
+
 	if backup.Status.Expiration == nil || backup.Status.Expiration.After(now) {
-		log.Debug("Backup has not expired yet, skipping")
-		return nil
-	}
+		exceeds, err := c.exceedsScheduleKeepLast(backup)
+		if err != nil {
+			return err
+		}
 
-	log.Info("Backup has expired")
+		switch {
+		case exceeds:
+			log.Info("Backup is older than the number of backups its schedule keeps")
+		case snapshotsExpired(backup, now):
+			log.Info("Backup's volume snapshots have expired")
+			snapshotsOnly = true
+		default:
+			log.Debug("Backup has not expired yet, skipping")
+			return nil
+		}
+	} else {
+		log.Info("Backup has expired")
+	}
 
 	loc := &velerov1api.BackupStorageLocation{}
 	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
@@ -150,6 +194,15 @@ func (c *gcController) processQueueItem(key string) error {
 		return nil
 	}
 
+	if backup.Status.ObjectLockRetainUntil != nil && backup.Status.ObjectLockRetainUntil.After(now) {
+		log.Infof("Backup cannot be garbage-collected because it's locked in object storage until %s", backup.Status.ObjectLockRetainUntil.UTC().Format(time.RFC3339))
+		return nil
+	}
+
+	if snapshotsOnly {
+		return c.deleteExpiredSnapshots(backup, loc, log)
+	}
+
 	selector := labels.SelectorFromSet(labels.Set(map[string]string{
 		velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
 		velerov1api.BackupUIDLabel:  string(backup.UID),
@@ -179,3 +232,148 @@ func (c *gcController) processQueueItem(key string) error {
 
 	return nil
 }
+
+// snapshotsExpired returns whether the backup is a finished backup whose volume snapshots
+// are retained for less time than the backup itself, have expired and haven't been deleted
+// yet. A copy of a backup shares its snapshots with the original backup, so they're only
+// deleted along with the original's.
+func snapshotsExpired(backup *velerov1api.Backup, now time.Time) bool {
+	if backup.Status.SnapshotExpiration == nil || backup.Status.SnapshotExpiration.After(now) || backup.Status.VolumeSnapshotsDeleted {
+		return false
+	}
+
+	if _, isReplica := backup.Annotations[velerov1api.ReplicatedFromAnnotation]; isReplica {
+		return false
+	}
+
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// deleteExpiredSnapshots deletes the volume snapshots of a backup whose snapshot TTL has
+// elapsed, and marks them as deleted in the backup's list of volume snapshots so that its
+// persistent volumes are no longer restored from them. The rest of the backup is retained
+// until it expires.
+func (c *gcController) deleteExpiredSnapshots(backup *velerov1api.Backup, loc *velerov1api.BackupStorageLocation, log logrus.FieldLogger) error {
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := c.newBackupStore(loc, pluginManager, log)
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := backupStore.GetBackupVolumeSnapshots(backup.Name)
+	if err != nil {
+		return errors.Wrap(err, "error getting backup's volume snapshots")
+	}
+
+	var (
+		errs               []error
+		updated            bool
+		volumeSnapshotters = make(map[string]velero.VolumeSnapshotter)
+	)
+
+	for _, snapshot := range snapshots {
+		if snapshot.Status.ProviderSnapshotID == "" || snapshot.Status.Phase == volume.SnapshotPhaseDeleted {
+			continue
+		}
+
+		log.WithField("providerSnapshotID", snapshot.Status.ProviderSnapshotID).Info("Removing expired snapshot associated with backup")
+
+		volumeSnapshotter, ok := volumeSnapshotters[snapshot.Spec.Location]
+		if !ok {
+			if volumeSnapshotter, err = volumeSnapshotterForSnapshotLocation(backup.Namespace, snapshot.Spec.Location, c.snapshotLocationLister, pluginManager, c.credentialFileStore, c.snapshotLimiter); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			volumeSnapshotters[snapshot.Spec.Location] = volumeSnapshotter
+		}
+
+		if err := volumeSnapshotter.DeleteSnapshot(snapshot.Status.ProviderSnapshotID); err != nil {
+			errs = append(errs, errors.Wrapf(err, "error deleting snapshot %s", snapshot.Status.ProviderSnapshotID))
+			continue
+		}
+
+		snapshot.Status.Phase = volume.SnapshotPhaseDeleted
+		updated = true
+	}
+
+	// record the snapshots that were deleted even if others couldn't be, so that they're
+	// not restored from.
+	if updated {
+		snapshotsJSON, encodeErrs := encodeToJSONGzip(snapshots, "native volumesnapshots list")
+		if len(encodeErrs) > 0 {
+			errs = append(errs, encodeErrs...)
+		} else if err := backupStore.PutBackupVolumeSnapshots(backup.Name, snapshotsJSON); err != nil {
+			errs = append(errs, errors.Wrap(err, "error uploading backup's volume snapshots"))
+		}
+	}
+
+	if len(errs) > 0 {
+		return kubeerrs.NewAggregate(errs)
+	}
+
+	updatedBackup := backup.DeepCopy()
+	updatedBackup.Status.VolumeSnapshotsDeleted = true
+	if _, err := patchBackup(backup, updatedBackup, c.backupClient); err != nil {
+		return errors.Wrap(err, "error updating backup's status")
+	}
+
+	return nil
+}
+
+// exceedsScheduleKeepLast returns whether the backup is a finished backup that was
+// triggered by a schedule that keeps its last N backups, and at least N backups from
+// the schedule that completed successfully were created after it.
+func (c *gcController) exceedsScheduleKeepLast(backup *velerov1api.Backup) (bool, error) {
+	scheduleName := backup.Labels[velerov1api.ScheduleNameLabel]
+	if scheduleName == "" {
+		return false, nil
+	}
+
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed, velerov1api.BackupPhaseFailedValidation:
+	default:
+		return false, nil
+	}
+
+	schedule, err := c.scheduleLister.Schedules(backup.Namespace).Get(scheduleName)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "error getting backup's schedule")
+	}
+	if schedule.Spec.KeepLast <= 0 {
+		return false, nil
+	}
+
+	backups, err := c.backupLister.Backups(backup.Namespace).List(labels.SelectorFromSet(labels.Set{velerov1api.ScheduleNameLabel: scheduleName}))
+	if err != nil {
+		return false, errors.Wrap(err, "error listing schedule's backups")
+	}
+
+	newer := 0
+	for _, other := range backups {
+		if other.Status.Phase == velerov1api.BackupPhaseCompleted && createdAfter(other, backup) {
+			newer++
+		}
+	}
+
+	return newer >= schedule.Spec.KeepLast, nil
+}
+
+// createdAfter returns whether backup a was created after backup b. Backups that were
+// created at the same time are ordered by name, since scheduled backups are named
+// after the time they were triggered.
+func createdAfter(a, b *velerov1api.Backup) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return b.CreationTimestamp.Before(&a.CreationTimestamp)
+	}
+	return a.Name > b.Name
+}
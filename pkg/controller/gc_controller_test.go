@@ -24,11 +24,14 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/watch"
 	core "k8s.io/client-go/testing"
 
@@ -38,8 +41,13 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
 func TestGCControllerEnqueueAllBackups(t *testing.T) {
@@ -50,8 +58,14 @@ func TestGCControllerEnqueueAllBackups(t *testing.T) {
 		controller = NewGCController(
 			velerotest.NewLogger(),
 			sharedInformers.Velero().V1().Backups(),
+			client.VeleroV1(),
+			sharedInformers.Velero().V1().Schedules().Lister(),
 			sharedInformers.Velero().V1().DeleteBackupRequests().Lister(),
 			client.VeleroV1(),
+			sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+			nil,
+			nil,
+			nil,
 			nil,
 		).(*gcController)
 	)
@@ -111,8 +125,14 @@ func TestGCControllerHasUpdateFunc(t *testing.T) {
 	controller := NewGCController(
 		velerotest.NewLogger(),
 		sharedInformers.Velero().V1().Backups(),
+		client.VeleroV1(),
+		sharedInformers.Velero().V1().Schedules().Lister(),
 		sharedInformers.Velero().V1().DeleteBackupRequests().Lister(),
 		client.VeleroV1(),
+		sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+		nil,
+		nil,
+		nil,
 		nil,
 	).(*gcController)
 
@@ -159,6 +179,8 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 		backup                         *velerov1api.Backup
 		deleteBackupRequests           []*velerov1api.DeleteBackupRequest
 		backupLocation                 *velerov1api.BackupStorageLocation
+		schedule                       *velerov1api.Schedule
+		otherBackups                   []*velerov1api.Backup
 		expectDeletion                 bool
 		createDeleteBackupRequestError bool
 		expectError                    bool
@@ -184,6 +206,18 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 			backupLocation: builder.ForBackupStorageLocation("velero", "read-write").AccessMode(velerov1api.BackupStorageLocationAccessModeReadWrite).Result(),
 			expectDeletion: true,
 		},
+		{
+			name:           "expired backup that's locked in object storage is not deleted",
+			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).ObjectLockRetainUntil(fakeClock.Now().Add(time.Hour)).StorageLocation("default").Result(),
+			backupLocation: defaultBackupLocation,
+			expectDeletion: false,
+		},
+		{
+			name:           "expired backup that's no longer locked in object storage is deleted",
+			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).ObjectLockRetainUntil(fakeClock.Now().Add(-time.Minute)).StorageLocation("default").Result(),
+			backupLocation: defaultBackupLocation,
+			expectDeletion: true,
+		},
 		{
 			name:           "expired backup with no pending deletion requests is deleted",
 			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
@@ -232,6 +266,40 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 			},
 			expectDeletion: true,
 		},
+		{
+			name:           "unexpired scheduled backup older than the schedule's last completed backups is deleted",
+			backup:         scheduledBackup("20200101000000", velerov1api.BackupPhaseCompleted).Expiration(fakeClock.Now().Add(time.Minute)).StorageLocation("default").Result(),
+			backupLocation: defaultBackupLocation,
+			schedule:       builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").KeepLast(2).Result(),
+			otherBackups: []*velerov1api.Backup{
+				scheduledBackup("20200102000000", velerov1api.BackupPhaseCompleted).Result(),
+				scheduledBackup("20200103000000", velerov1api.BackupPhaseCompleted).Result(),
+			},
+			expectDeletion: true,
+		},
+		{
+			name:           "unexpired scheduled backup among the schedule's last completed backups is not deleted",
+			backup:         scheduledBackup("20200101000000", velerov1api.BackupPhaseCompleted).Expiration(fakeClock.Now().Add(time.Minute)).StorageLocation("default").Result(),
+			backupLocation: defaultBackupLocation,
+			schedule:       builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").KeepLast(2).Result(),
+			otherBackups: []*velerov1api.Backup{
+				scheduledBackup("20191231000000", velerov1api.BackupPhaseCompleted).Result(),
+				scheduledBackup("20200102000000", velerov1api.BackupPhaseFailed).Result(),
+				scheduledBackup("20200103000000", velerov1api.BackupPhaseCompleted).Result(),
+				scheduledBackup("20200104000000", velerov1api.BackupPhaseInProgress).Result(),
+			},
+			expectDeletion: false,
+		},
+		{
+			name:           "unexpired scheduled backup is not deleted when its schedule doesn't keep a number of backups",
+			backup:         scheduledBackup("20200101000000", velerov1api.BackupPhaseCompleted).Expiration(fakeClock.Now().Add(time.Minute)).StorageLocation("default").Result(),
+			backupLocation: defaultBackupLocation,
+			schedule:       builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").Result(),
+			otherBackups: []*velerov1api.Backup{
+				scheduledBackup("20200102000000", velerov1api.BackupPhaseCompleted).Result(),
+			},
+			expectDeletion: false,
+		},
 		{
 			name:                           "create DeleteBackupRequest error returns an error",
 			backup:                         defaultBackup().Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
@@ -259,9 +327,15 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 			controller := NewGCController(
 				velerotest.NewLogger(),
 				sharedInformers.Velero().V1().Backups(),
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().Schedules().Lister(),
 				sharedInformers.Velero().V1().DeleteBackupRequests().Lister(),
 				client.VeleroV1(),
+				sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
 				fakeClient,
+				nil,
+				nil,
+				nil,
 			).(*gcController)
 			controller.clock = fakeClock
 
@@ -271,6 +345,14 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 				sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(test.backup)
 			}
 
+			if test.schedule != nil {
+				sharedInformers.Velero().V1().Schedules().Informer().GetStore().Add(test.schedule)
+			}
+
+			for _, backup := range test.otherBackups {
+				sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup)
+			}
+
 			for _, dbr := range test.deleteBackupRequests {
 				sharedInformers.Velero().V1().DeleteBackupRequests().Informer().GetStore().Add(dbr)
 			}
@@ -298,3 +380,159 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 		})
 	}
 }
+
+func TestGCControllerDeleteExpiredSnapshots(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Now())
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result()
+	snapshotLocation := builder.ForVolumeSnapshotLocation(velerov1api.DefaultNamespace, "vsl-1").Provider("provider-1").Result()
+
+	newSnapshot := func(pv, snapshotID string, phase volume.SnapshotPhase) *volume.Snapshot {
+		return &volume.Snapshot{
+			Spec: volume.SnapshotSpec{
+				BackupName:           "backup-1",
+				Location:             "vsl-1",
+				PersistentVolumeName: pv,
+			},
+			Status: volume.SnapshotStatus{
+				ProviderSnapshotID: snapshotID,
+				Phase:              phase,
+			},
+		}
+	}
+
+	backup := func() *builder.BackupBuilder {
+		return builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").
+			StorageLocation("default").
+			Phase(velerov1api.BackupPhaseCompleted).
+			Expiration(fakeClock.Now().Add(time.Hour))
+	}
+
+	tests := []struct {
+		name                string
+		backup              *velerov1api.Backup
+		snapshotsDeleted    bool
+		snapshots           []*volume.Snapshot
+		deleteError         error
+		expectDeleted       []string
+		expectPutSnapshots  bool
+		expectStatusUpdated bool
+		expectError         bool
+	}{
+		{
+			name:   "unexpired snapshots are not deleted",
+			backup: backup().SnapshotExpiration(fakeClock.Now().Add(time.Minute)).Result(),
+		},
+		{
+			name:             "snapshots that were already deleted are not deleted again",
+			backup:           backup().SnapshotExpiration(fakeClock.Now().Add(-time.Minute)).Result(),
+			snapshotsDeleted: true,
+		},
+		{
+			name: "snapshots of a copy of a backup are not deleted",
+			backup: backup().
+				ObjectMeta(builder.WithAnnotations(velerov1api.ReplicatedFromAnnotation, "other-location")).
+				SnapshotExpiration(fakeClock.Now().Add(-time.Minute)).
+				Result(),
+		},
+		{
+			name:   "snapshots of an in-progress backup are not deleted",
+			backup: backup().Phase(velerov1api.BackupPhaseInProgress).SnapshotExpiration(fakeClock.Now().Add(-time.Minute)).Result(),
+		},
+		{
+			name:   "expired snapshots are deleted and marked as deleted",
+			backup: backup().SnapshotExpiration(fakeClock.Now().Add(-time.Minute)).Result(),
+			snapshots: []*volume.Snapshot{
+				newSnapshot("pv-1", "snap-1", volume.SnapshotPhaseCompleted),
+				newSnapshot("pv-2", "snap-2", volume.SnapshotPhaseDeleted),
+				newSnapshot("pv-3", "", volume.SnapshotPhaseFailed),
+			},
+			expectDeleted:       []string{"snap-1"},
+			expectPutSnapshots:  true,
+			expectStatusUpdated: true,
+		},
+		{
+			name:                "backup without snapshots is marked as having its snapshots deleted",
+			backup:              backup().SnapshotExpiration(fakeClock.Now().Add(-time.Minute)).Result(),
+			expectStatusUpdated: true,
+		},
+		{
+			name:        "error deleting a snapshot returns an error",
+			backup:      backup().SnapshotExpiration(fakeClock.Now().Add(-time.Minute)).Result(),
+			snapshots:   []*volume.Snapshot{newSnapshot("pv-1", "snap-1", volume.SnapshotPhaseCompleted)},
+			deleteError: errors.New("snapshot is in use"),
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.backup.Status.VolumeSnapshotsDeleted = test.snapshotsDeleted
+
+			var (
+				client            = fake.NewSimpleClientset(test.backup)
+				sharedInformers   = informers.NewSharedInformerFactory(client, 0)
+				backupStore       = &persistencemocks.BackupStore{}
+				pluginManager     = &pluginmocks.Manager{}
+				volumeSnapshotter = &velerotest.FakeVolumeSnapshotter{SnapshotsTaken: sets.NewString("snap-1", "snap-2"), Error: test.deleteError}
+			)
+
+			controller := NewGCController(
+				velerotest.NewLogger(),
+				sharedInformers.Velero().V1().Backups(),
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().Schedules().Lister(),
+				sharedInformers.Velero().V1().DeleteBackupRequests().Lister(),
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				newFakeClient(t, location),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				nil,
+				nil,
+			).(*gcController)
+			controller.clock = fakeClock
+			controller.newBackupStore = func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+				return backupStore, nil
+			}
+
+			require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(test.backup))
+			require.NoError(t, sharedInformers.Velero().V1().VolumeSnapshotLocations().Informer().GetStore().Add(snapshotLocation))
+
+			pluginManager.On("GetVolumeSnapshotter", "provider-1").Return(volumeSnapshotter, nil)
+			pluginManager.On("CleanupClients")
+			if test.expectStatusUpdated || test.expectError {
+				backupStore.On("GetBackupVolumeSnapshots", "backup-1").Return(test.snapshots, nil)
+			}
+			if test.expectPutSnapshots {
+				backupStore.On("PutBackupVolumeSnapshots", "backup-1", mock.Anything).Return(nil)
+			}
+
+			err := controller.processQueueItem(kube.NamespaceAndName(test.backup))
+			assert.Equal(t, test.expectError, err != nil)
+			backupStore.AssertExpectations(t)
+
+			for _, snapshotID := range test.expectDeleted {
+				assert.False(t, volumeSnapshotter.SnapshotsTaken.Has(snapshotID))
+			}
+			for _, snapshot := range test.snapshots {
+				if snapshot.Status.ProviderSnapshotID != "" && test.deleteError == nil {
+					assert.Equal(t, volume.SnapshotPhaseDeleted, snapshot.Status.Phase)
+				}
+			}
+
+			res, err := client.VeleroV1().Backups(velerov1api.DefaultNamespace).Get(context.TODO(), "backup-1", metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, test.expectStatusUpdated || test.snapshotsDeleted, res.Status.VolumeSnapshotsDeleted)
+
+			for _, action := range client.Actions() {
+				assert.NotEqual(t, "deletebackuprequests", action.GetResource().Resource)
+			}
+		})
+	}
+}
+
+// scheduledBackup returns a builder for a backup of schedule-1 that was triggered at the given time.
+func scheduledBackup(timestamp string, phase velerov1api.BackupPhase) *builder.BackupBuilder {
+	return builder.ForBackup(velerov1api.DefaultNamespace, "schedule-1-"+timestamp).
+		ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1")).
+		Phase(phase)
+}
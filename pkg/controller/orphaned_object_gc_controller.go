@@ -0,0 +1,183 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/internal/storage"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+)
+
+// orphanedObjectGCController finds objects in backup storage locations that don't belong
+// to any backup, such as the remains of backups whose upload or deletion was interrupted,
+// and reports them, or deletes them if it isn't in dry-run mode.
+type orphanedObjectGCController struct {
+	*genericController
+
+	namespace        string
+	kbClient         client.Client
+	backupLister     velerov1listers.BackupLister
+	dryRun           bool
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager
+	newBackupStore   func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+}
+
+// NewOrphanedObjectGCController constructs a new orphanedObjectGCController.
+func NewOrphanedObjectGCController(
+	logger logrus.FieldLogger,
+	namespace string,
+	kbClient client.Client,
+	backupInformer velerov1informers.BackupInformer,
+	period time.Duration,
+	dryRun bool,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
+) Interface {
+	c := &orphanedObjectGCController{
+		genericController: newGenericController("orphaned-object-gc", logger),
+		namespace:         namespace,
+		kbClient:          kbClient,
+		backupLister:      backupInformer.Lister(),
+		dryRun:            dryRun,
+
+		// use variables to refer to these functions so they can be
+		// replaced with fakes for testing.
+		newPluginManager: newPluginManager,
+		newBackupStore:   persistence.NewObjectBackupStoreWithCredentials(credentialFileStore),
+	}
+
+	c.resyncFunc = c.run
+	c.resyncPeriod = period
+	// backups that are being uploaded don't have metadata yet, so the backups
+	// must be known before looking for orphaned objects.
+	c.cacheSyncWaiters = append(c.cacheSyncWaiters, backupInformer.Informer().HasSynced)
+
+	return c
+}
+
+func (c *orphanedObjectGCController) run() {
+	c.logger.WithField("dryRun", c.dryRun).Info("Looking for orphaned objects in backup storage locations")
+
+	locationList, err := storage.ListBackupStorageLocations(context.Background(), c.kbClient, c.namespace)
+	if err != nil {
+		c.logger.WithError(err).Error("Error listing backup storage locations")
+		return
+	}
+
+	pluginManager := c.newPluginManager(c.logger)
+	defer pluginManager.CleanupClients()
+
+	for i := range locationList.Items {
+		location := &locationList.Items[i]
+		log := c.logger.WithField("backupLocation", location.Name)
+
+		backupStore, err := c.newBackupStore(location, pluginManager, log)
+		if err != nil {
+			log.WithError(err).Error("Error getting backup store for this location")
+			continue
+		}
+
+		c.collectLocation(location, backupStore, log)
+	}
+}
+
+// collectLocation reports or deletes the orphaned objects in a backup storage location.
+func (c *orphanedObjectGCController) collectLocation(location *velerov1api.BackupStorageLocation, backupStore persistence.BackupStore, log logrus.FieldLogger) {
+	backupNames, err := backupStore.ListBackups()
+	if err != nil {
+		log.WithError(err).Error("Error listing backups in backup store")
+		return
+	}
+
+	// objects aren't deleted from locations that Velero doesn't write to.
+	readOnly := location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly
+	if readOnly && !c.dryRun {
+		log.Info("Backup storage location is in read-only mode, only reporting its orphaned objects")
+	}
+
+	var orphaned int
+	for _, backupName := range backupNames {
+		log := log.WithField("backup", backupName)
+
+		keepLog, referenced, err := c.backupReference(location, backupName)
+		if err != nil {
+			log.WithError(err).Error("Error getting backup")
+			continue
+		}
+		if referenced {
+			continue
+		}
+
+		keys, err := backupStore.ListOrphanedBackupObjects(backupName, keepLog)
+		if err != nil {
+			log.WithError(err).Error("Error listing orphaned objects")
+			continue
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		orphaned += len(keys)
+
+		if c.dryRun || readOnly {
+			log.WithField("objects", keys).Info("Found orphaned objects")
+			continue
+		}
+
+		log.WithField("objects", keys).Info("Deleting orphaned objects")
+		if err := backupStore.DeleteBackupObjects(backupName, keys); err != nil {
+			log.WithError(err).Error("Error deleting orphaned objects")
+		}
+	}
+
+	log.WithField("objects", orphaned).Info("Finished looking for orphaned objects")
+}
+
+// backupReference returns whether a backup in the cluster still uses the objects in
+// the location's directory for the named backup. A backup that failed before its
+// metadata was uploaded only uses its log.
+func (c *orphanedObjectGCController) backupReference(location *velerov1api.BackupStorageLocation, backupName string) (keepLog bool, referenced bool, err error) {
+	backup, err := c.backupLister.Backups(c.namespace).Get(backupName)
+	if apierrors.IsNotFound(err) {
+		return false, false, nil
+	}
+	if err != nil {
+		return false, false, err
+	}
+
+	// backups that are being uploaded, copied or deleted are left alone, as are
+	// backups in other locations that have the same name.
+	if backup.Spec.StorageLocation != location.Name {
+		return false, true, nil
+	}
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseFailed, velerov1api.BackupPhaseFailedValidation:
+		return true, false, nil
+	default:
+		return false, true, nil
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestOrphanedObjectGCControllerCollectLocation(t *testing.T) {
+	orphanedKeys := []string{"backups/backup-1/backup-1.tar.gz"}
+
+	tests := []struct {
+		name           string
+		location       *velerov1api.BackupStorageLocation
+		backup         *velerov1api.Backup
+		dryRun         bool
+		expectList     bool
+		expectKeepLog  bool
+		orphanedKeys   []string
+		expectDeletion bool
+	}{
+		{
+			name:           "objects of a backup that isn't in the cluster are deleted",
+			location:       builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+			expectList:     true,
+			orphanedKeys:   orphanedKeys,
+			expectDeletion: true,
+		},
+		{
+			name:         "objects of a backup that isn't in the cluster are only reported in dry-run mode",
+			location:     builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+			dryRun:       true,
+			expectList:   true,
+			orphanedKeys: orphanedKeys,
+		},
+		{
+			name:         "objects in a read-only location are only reported",
+			location:     builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+			expectList:   true,
+			orphanedKeys: orphanedKeys,
+		},
+		{
+			name:       "backup with metadata has no orphaned objects",
+			location:   builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+			expectList: true,
+		},
+		{
+			name:     "objects of a backup that's being uploaded are left alone",
+			location: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+			backup:   builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").Phase(velerov1api.BackupPhaseInProgress).Result(),
+		},
+		{
+			name:     "objects of a backup that's being copied from another location are left alone",
+			location: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+			backup:   builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("primary").ReplicationLocations("default").Phase(velerov1api.BackupPhaseCompleted).Result(),
+		},
+		{
+			name:           "objects of a failed backup other than its log are deleted",
+			location:       builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+			backup:         builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").Phase(velerov1api.BackupPhaseFailed).Result(),
+			expectList:     true,
+			expectKeepLog:  true,
+			orphanedKeys:   orphanedKeys,
+			expectDeletion: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset()
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+				backupStore     = &persistencemocks.BackupStore{}
+			)
+
+			controller := NewOrphanedObjectGCController(
+				velerotest.NewLogger(),
+				velerov1api.DefaultNamespace,
+				newFakeClient(t),
+				sharedInformers.Velero().V1().Backups(),
+				time.Hour,
+				test.dryRun,
+				func(logrus.FieldLogger) clientmgmt.Manager { return nil },
+				nil,
+			).(*orphanedObjectGCController)
+
+			if test.backup != nil {
+				require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(test.backup))
+			}
+
+			backupStore.On("ListBackups").Return([]string{"backup-1"}, nil)
+			if test.expectList {
+				backupStore.On("ListOrphanedBackupObjects", "backup-1", test.expectKeepLog).Return(test.orphanedKeys, nil)
+			}
+			if test.expectDeletion {
+				backupStore.On("DeleteBackupObjects", "backup-1", test.orphanedKeys).Return(nil)
+			}
+
+			controller.collectLocation(test.location, backupStore, controller.logger)
+
+			backupStore.AssertExpectations(t)
+		})
+	}
+}
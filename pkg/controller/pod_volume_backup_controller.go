@@ -29,6 +29,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -188,6 +189,15 @@ func getOwningBackup(req *velerov1api.PodVolumeBackup) string {
 func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBackup) error {
 	log := loggerForPodVolumeBackup(c.logger, req)
 
+	// the node's data paths are limited, so the backup stays new until others have ended.
+	release, reason := restic.TryAcquireDataPath(log)
+	if release == nil {
+		log.Debugf("Waiting to start, %s", reason)
+		c.queue.AddAfter(kube.NamespaceAndName(req), restic.DataPathRetryInterval)
+		return nil
+	}
+	defer release()
+
 	log.Info("Backup starting")
 
 	var err error
@@ -208,13 +218,26 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 		return c.fail(req, errors.Wrap(err, "error getting pod").Error(), log)
 	}
 
-	volumeDir, err := kube.GetVolumeDirectory(pod, req.Spec.Volume, c.pvcLister, c.pvLister)
-	if err != nil {
-		log.WithError(err).Error("Error getting volume directory name")
-		return c.fail(req, errors.Wrap(err, "error getting volume directory name").Error(), log)
-	}
+	// raw block volumes don't have a directory under volumes/, since they aren't mounted; their
+	// device is under volumeDevices/ instead, and its data is backed up from restic's stdin.
+	blockVolume := kube.IsBlockVolume(pod, req.Spec.Volume)
 
-	pathGlob := fmt.Sprintf("/host_pods/%s/volumes/*/%s", string(req.Spec.Pod.UID), volumeDir)
+	var pathGlob string
+	if blockVolume {
+		deviceName, err := kube.GetVolumeDeviceName(pod, req.Spec.Volume, c.pvcLister)
+		if err != nil {
+			log.WithError(err).Error("Error getting volume device name")
+			return c.fail(req, errors.Wrap(err, "error getting volume device name").Error(), log)
+		}
+		pathGlob = restic.HostPodPath(string(req.Spec.Pod.UID), "volumeDevices", "*", deviceName)
+	} else {
+		volumeDir, err := kube.GetVolumeDirectory(pod, req.Spec.Volume, c.pvcLister, c.pvLister)
+		if err != nil {
+			log.WithError(err).Error("Error getting volume directory name")
+			return c.fail(req, errors.Wrap(err, "error getting volume directory name").Error(), log)
+		}
+		pathGlob = restic.HostPodPath(string(req.Spec.Pod.UID), "volumes", "*", volumeDir)
+	}
 	log.WithField("pathGlob", pathGlob).Debug("Looking for path matching glob")
 
 	path, err := singlePathMatch(pathGlob)
@@ -224,8 +247,38 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 	}
 	log.WithField("path", path).Debugf("Found path matching glob")
 
+	// if the volume hasn't changed since the previous pod volume backup of the same schedule,
+	// that backup's snapshot is reused instead of backing the volume up again.
+	var changeIndicator string
+	if req.Spec.SkipIfUnchanged && !blockVolume {
+		changeIndicator, err = restic.VolumeChangeIndicator(path)
+		if err != nil {
+			log.WithError(err).Warn("Error detecting changes to the volume, backing it up")
+		} else if previous := getUnchangedPodVolumeBackup(log, req, changeIndicator, c.podVolumeBackupLister.PodVolumeBackups(req.Namespace)); previous != nil {
+			var reused bool
+			req, reused, err = c.completeUnchanged(req, previous, path, changeIndicator, log)
+			if err != nil || reused {
+				return err
+			}
+		}
+	}
+
+	// the repository's cache size limit and rate limits are used, if it has them.
+	resticRepo, err := restic.GetRepository(c.kbClient, req.Namespace, req.Spec.RepoIdentifier)
+	if err != nil {
+		log.WithError(err).Error("Error getting restic repository")
+		return c.fail(req, errors.Wrap(err, "error getting restic repository").Error(), log)
+	}
+
+	var cacheSizeLimit, uploadRateLimit, downloadRateLimit *resource.Quantity
+	if resticRepo != nil {
+		cacheSizeLimit = resticRepo.Spec.CacheSizeLimit
+		uploadRateLimit = resticRepo.Spec.UploadRateLimit
+		downloadRateLimit = resticRepo.Spec.DownloadRateLimit
+	}
+
 	// temp creds
-	credentialsFile, err := restic.TempCredentialsFile(c.secretLister, req.Namespace, req.Spec.Pod.Namespace, c.fileSystem)
+	credentialsFile, err := restic.TempCredentialsFile(c.secretLister, req.Namespace, req.Spec.RepoIdentifier, c.fileSystem)
 	if err != nil {
 		log.WithError(err).Error("Error creating temp restic credentials file")
 		return c.fail(req, errors.Wrap(err, "error creating temp restic credentials file").Error(), log)
@@ -233,80 +286,89 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 	// ignore error since there's nothing we can do and it's a temp file.
 	defer os.Remove(credentialsFile)
 
-	resticCmd := restic.BackupCommand(
-		req.Spec.RepoIdentifier,
-		credentialsFile,
-		path,
-		req.Spec.Tags,
-	)
+	uploaderReq := restic.UploaderBackupRequest{
+		Path: path,
+		Tags: req.Spec.Tags,
+	}
+	if blockVolume {
+		device, err := os.Open(path)
+		if err != nil {
+			log.WithError(err).Error("Error opening volume device")
+			return c.fail(req, errors.Wrap(err, "error opening volume device").Error(), log)
+		}
+		// ignore error since the device is only read from.
+		defer device.Close()
+
+		uploaderReq.Device = device
+		if len(req.Spec.PathIncludes) > 0 || len(req.Spec.PathExcludes) > 0 {
+			log.Info("Volume is a raw block volume, ignoring its path include and exclude patterns")
+		}
+	} else {
+		uploaderReq.Includes = req.Spec.PathIncludes
+		uploaderReq.Excludes = req.Spec.PathExcludes
+	}
+
+	repo := restic.RepoAccess{
+		Identifier:   req.Spec.RepoIdentifier,
+		PasswordFile: credentialsFile,
+	}
+
+	repo.CacheDir, repo.CacheSizeLimit = restic.RepoCache(req.Spec.UploaderType, req.Spec.RepoIdentifier, cacheSizeLimit)
+	repo.UploadLimit, repo.DownloadLimit = restic.RepoBandwidth(uploadRateLimit, downloadRateLimit)
 
-	// if there's a caCert on the ObjectStorage, write it to disk so that it can be passed to restic
+	// if there's a caCert on the ObjectStorage, write it to disk so that it can be passed to the uploader
 	caCert, err := restic.GetCACert(c.kbClient, req.Namespace, req.Spec.BackupStorageLocation)
 	if err != nil {
 		log.WithError(err).Error("Error getting caCert")
 	}
 
-	var caCertFile string
 	if caCert != nil {
-		caCertFile, err = restic.TempCACertFile(caCert, req.Spec.BackupStorageLocation, c.fileSystem)
+		repo.CACertFile, err = restic.TempCACertFile(caCert, req.Spec.BackupStorageLocation, c.fileSystem)
 		if err != nil {
 			log.WithError(err).Error("Error creating temp cacert file")
 		}
 		// ignore error since there's nothing we can do and it's a temp file.
-		defer os.Remove(caCertFile)
+		defer os.Remove(repo.CACertFile)
 	}
-	resticCmd.CACertFile = caCertFile
 
-	// Running restic command might need additional provider specific environment variables. Based on the provider, we
-	// set resticCmd.Env appropriately (currently for Azure and S3 based backuplocations)
-	var env []string
+	// Running the uploader's commands might need additional provider specific environment variables. Based on
+	// the provider, we set repo.Env appropriately (currently for Azure and S3 based backuplocations)
 	if strings.HasPrefix(req.Spec.RepoIdentifier, "azure") {
-		if env, err = restic.AzureCmdEnv(c.kbClient, req.Namespace, req.Spec.BackupStorageLocation); err != nil {
+		if repo.Env, err = restic.AzureCmdEnv(c.kbClient, req.Namespace, req.Spec.BackupStorageLocation); err != nil {
 			return c.fail(req, errors.Wrap(err, "error setting restic cmd env").Error(), log)
 		}
-		resticCmd.Env = env
 	} else if strings.HasPrefix(req.Spec.RepoIdentifier, "s3") {
-		if env, err = restic.S3CmdEnv(c.kbClient, req.Namespace, req.Spec.BackupStorageLocation); err != nil {
+		if repo.Env, err = restic.S3CmdEnv(c.kbClient, req.Namespace, req.Spec.BackupStorageLocation); err != nil {
 			return c.fail(req, errors.Wrap(err, "error setting restic cmd env").Error(), log)
 		}
-		resticCmd.Env = env
 	}
 
 	// If this is a PVC, look for the most recent completed pod volume backup for it and get
-	// its restic snapshot ID to use as the value of the `--parent` flag. Without this,
-	// if the pod using the PVC (and therefore the directory path under /host_pods/) has
-	// changed since the PVC's last backup, restic will not be able to identify a suitable
-	// parent snapshot to use, and will have to do a full rescan of the contents of the PVC.
+	// its snapshot ID to use as the parent of this backup. Without this, if the pod using the
+	// PVC (and therefore the directory path under /host_pods/) has changed since the PVC's
+	// last backup, restic will not be able to identify a suitable parent snapshot to use, and
+	// will have to do a full rescan of the contents of the PVC.
 	if pvcUID, ok := req.Labels[velerov1api.PVCUIDLabel]; ok {
-		parentSnapshotID := getParentSnapshot(log, pvcUID, req.Spec.BackupStorageLocation, c.podVolumeBackupLister.PodVolumeBackups(req.Namespace))
-		if parentSnapshotID == "" {
-			log.Info("No parent snapshot found for PVC, not using --parent flag for this backup")
+		uploaderReq.ParentSnapshotID = getParentSnapshot(log, pvcUID, req.Spec.BackupStorageLocation, req.Spec.UploaderType, c.podVolumeBackupLister.PodVolumeBackups(req.Namespace))
+		if uploaderReq.ParentSnapshotID == "" {
+			log.Info("No parent snapshot found for PVC, not using a parent snapshot for this backup")
 		} else {
-			log.WithField("parentSnapshotID", parentSnapshotID).Info("Setting --parent flag for this backup")
-			resticCmd.ExtraFlags = append(resticCmd.ExtraFlags, fmt.Sprintf("--parent=%s", parentSnapshotID))
+			log.WithField("parentSnapshotID", uploaderReq.ParentSnapshotID).Info("Using parent snapshot for this backup")
 		}
 	}
 
-	var stdout, stderr string
-
-	var emptySnapshot bool
-	if stdout, stderr, err = restic.RunBackup(resticCmd, log, c.updateBackupProgressFunc(req, log)); err != nil {
-		if strings.Contains(stderr, "snapshot is empty") {
-			emptySnapshot = true
-		} else {
-			log.WithError(errors.WithStack(err)).Errorf("Error running command=%s, stdout=%s, stderr=%s", resticCmd.String(), stdout, stderr)
-			return c.fail(req, fmt.Sprintf("error running restic backup, stderr=%s: %s", stderr, err.Error()), log)
-		}
+	uploader, err := restic.NewUploader(req.Spec.UploaderType, log)
+	if err != nil {
+		return c.fail(req, err.Error(), log)
 	}
-	log.Debugf("Ran command=%s, stdout=%s, stderr=%s", resticCmd.String(), stdout, stderr)
 
-	var snapshotID string
-	if !emptySnapshot {
-		snapshotID, err = restic.GetSnapshotID(req.Spec.RepoIdentifier, credentialsFile, req.Spec.Tags, env, caCertFile)
-		if err != nil {
-			log.WithError(err).Error("Error getting SnapshotID")
-			return c.fail(req, errors.Wrap(err, "error getting snapshot id").Error(), log)
-		}
+	repo.Env = restic.DataPathEnv(repo.Env)
+
+	snapshotID, err := uploader.Backup(repo, uploaderReq, c.updateBackupProgressFunc(req, log))
+	release()
+	if err != nil {
+		log.WithError(err).Error("Error backing up volume")
+		return c.fail(req, err.Error(), log)
 	}
 
 	// update status to Completed with path & snapshot id
@@ -314,8 +376,9 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 		r.Status.Path = path
 		r.Status.Phase = velerov1api.PodVolumeBackupPhaseCompleted
 		r.Status.SnapshotID = snapshotID
+		r.Status.ChangeIndicator = changeIndicator
 		r.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
-		if emptySnapshot {
+		if snapshotID == "" {
 			r.Status.Message = "volume was empty so no snapshot was taken"
 		}
 	})
@@ -326,18 +389,18 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 	latencyDuration := req.Status.CompletionTimestamp.Time.Sub(req.Status.StartTimestamp.Time)
 	latencySeconds := float64(latencyDuration / time.Second)
 	backupName := getOwningBackup(req)
-	c.metrics.ObserveRestiOpLatency(c.nodeName, req.Name, resticCmd.Command, backupName, latencySeconds)
-	c.metrics.RegisterResticOpLatencyGauge(c.nodeName, req.Name, resticCmd.Command, backupName, latencySeconds)
+	c.metrics.ObserveRestiOpLatency(c.nodeName, req.Name, "backup", backupName, latencySeconds)
+	c.metrics.RegisterResticOpLatencyGauge(c.nodeName, req.Name, "backup", backupName, latencySeconds)
 	c.metrics.RegisterPodVolumeBackupDequeue(c.nodeName)
 	log.Info("Backup completed")
 
 	return nil
 }
 
-// getParentSnapshot finds the most recent completed pod volume backup for the specified PVC and returns its
-// restic snapshot ID. Any errors encountered are logged but not returned since they do not prevent a backup
-// from proceeding.
-func getParentSnapshot(log logrus.FieldLogger, pvcUID, backupStorageLocation string, podVolumeBackupLister listers.PodVolumeBackupNamespaceLister) string {
+// getParentSnapshot finds the most recent completed pod volume backup for the specified PVC by the same
+// uploader and returns its snapshot ID. Any errors encountered are logged but not returned since they do
+// not prevent a backup from proceeding.
+func getParentSnapshot(log logrus.FieldLogger, pvcUID, backupStorageLocation string, uploaderType velerov1api.UploaderType, podVolumeBackupLister listers.PodVolumeBackupNamespaceLister) string {
 	log = log.WithField("pvcUID", pvcUID)
 	log.Infof("Looking for most recent completed pod volume backup for this PVC")
 
@@ -363,6 +426,11 @@ func getParentSnapshot(log logrus.FieldLogger, pvcUID, backupStorageLocation str
 			continue
 		}
 
+		if !sameUploaderType(uploaderType, backup.Spec.UploaderType) {
+			// The snapshots of one uploader can't be the parents of another's, since they're in different repositories.
+			continue
+		}
+
 		if mostRecentBackup == nil || backup.Status.StartTimestamp.After(mostRecentBackup.Status.StartTimestamp.Time) {
 			mostRecentBackup = backup
 		}
@@ -381,6 +449,181 @@ func getParentSnapshot(log logrus.FieldLogger, pvcUID, backupStorageLocation str
 	return mostRecentBackup.Status.SnapshotID
 }
 
+// completeUnchanged completes a pod volume backup of a volume that hasn't changed since the
+// previous pod volume backup, by reusing its snapshot, and returns whether it did. The snapshot
+// isn't reused if the previous pod volume backup's backup is being deleted, since the snapshot
+// may be forgotten along with it. Deleting a backup keeps the snapshots that other pod volume
+// backups have, so the snapshot ID is recorded first, and the previous backup is checked again
+// afterwards in case its deletion started before then.
+func (c *podVolumeBackupController) completeUnchanged(req, previous *velerov1api.PodVolumeBackup, path, changeIndicator string, log logrus.FieldLogger) (*velerov1api.PodVolumeBackup, bool, error) {
+	log = log.WithFields(logrus.Fields{
+		"previousPodVolumeBackup": previous.Name,
+		"snapshotID":              previous.Status.SnapshotID,
+	})
+
+	if c.previousBackupDeleting(previous, log) {
+		return req, false, nil
+	}
+
+	req, err := c.patchPodVolumeBackup(req, func(r *velerov1api.PodVolumeBackup) {
+		r.Status.SnapshotID = previous.Status.SnapshotID
+	})
+	if err != nil {
+		log.WithError(err).Error("Error setting PodVolumeBackup snapshot ID")
+		return nil, false, err
+	}
+
+	if c.previousBackupDeleting(previous, log) {
+		req, err = c.patchPodVolumeBackup(req, func(r *velerov1api.PodVolumeBackup) {
+			r.Status.SnapshotID = ""
+		})
+		if err != nil {
+			log.WithError(err).Error("Error clearing PodVolumeBackup snapshot ID")
+			return nil, false, err
+		}
+		return req, false, nil
+	}
+
+	req, err = c.patchPodVolumeBackup(req, func(r *velerov1api.PodVolumeBackup) {
+		r.Status.Path = path
+		r.Status.Phase = velerov1api.PodVolumeBackupPhaseCompleted
+		r.Status.ChangeIndicator = changeIndicator
+		r.Status.Unchanged = true
+		r.Status.Progress = velerov1api.PodVolumeOperationProgress{
+			TotalBytes: previous.Status.Progress.TotalBytes,
+			BytesDone:  previous.Status.Progress.TotalBytes,
+		}
+		r.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+		r.Status.Message = fmt.Sprintf("volume was unchanged since pod volume backup %s, so its snapshot was reused", previous.Name)
+	})
+	if err != nil {
+		log.WithError(err).Error("Error setting PodVolumeBackup phase to Completed")
+		return nil, false, err
+	}
+	c.metrics.RegisterPodVolumeBackupDequeue(c.nodeName)
+	log.Info("Volume unchanged since the previous backup, reused its snapshot")
+
+	return req, true, nil
+}
+
+// previousBackupDeleting returns whether the backup of a pod volume backup may be being deleted,
+// that is, whether the pod volume backup no longer exists or its backup has a delete backup
+// request. Errors are logged and treated as the backup being deleted.
+func (c *podVolumeBackupController) previousBackupDeleting(previous *velerov1api.PodVolumeBackup, log logrus.FieldLogger) bool {
+	_, err := c.podVolumeBackupClient.PodVolumeBackups(previous.Namespace).Get(context.TODO(), previous.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		log.Info("Previous pod volume backup was deleted, backing the volume up")
+		return true
+	}
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Warn("Error getting previous pod volume backup, backing the volume up")
+		return true
+	}
+
+	requested, err := backupDeletionRequested(c.kbClient, previous.Namespace, previous.Labels[velerov1api.BackupNameLabel])
+	if err != nil {
+		log.WithError(err).Warn("Error checking whether the previous backup is being deleted, backing the volume up")
+		return true
+	}
+	if requested {
+		log.Info("Previous backup is being deleted, backing the volume up")
+	}
+	return requested
+}
+
+// backupDeletionRequested returns whether there's a delete backup request for the backup with
+// the given backup name label.
+func backupDeletionRequested(kbClient client.Client, namespace, backupNameLabel string) (bool, error) {
+	requests := &velerov1api.DeleteBackupRequestList{}
+	if err := kbClient.List(context.TODO(), requests, client.InNamespace(namespace), client.MatchingLabels{velerov1api.BackupNameLabel: backupNameLabel}); err != nil {
+		return false, errors.Wrap(err, "error listing delete backup requests")
+	}
+
+	return len(requests.Items) > 0, nil
+}
+
+// getUnchangedPodVolumeBackup returns the most recent completed pod volume backup of the same volume
+// and schedule as req, if the volume hasn't changed since, or else nil. Any errors encountered are
+// logged but not returned, since the volume can still be backed up.
+func getUnchangedPodVolumeBackup(log logrus.FieldLogger, req *velerov1api.PodVolumeBackup, changeIndicator string, podVolumeBackupLister listers.PodVolumeBackupNamespaceLister) *velerov1api.PodVolumeBackup {
+	schedule := req.Labels[velerov1api.ScheduleNameLabel]
+	if schedule == "" {
+		return nil
+	}
+
+	// a PVC's pod volume backups are found by its UID, since the pod that mounts it can change,
+	// and other volumes' by their pod and volume name.
+	selector := map[string]string{velerov1api.ScheduleNameLabel: schedule}
+	pvcUID, isPVC := req.Labels[velerov1api.PVCUIDLabel]
+	if isPVC {
+		selector[velerov1api.PVCUIDLabel] = pvcUID
+	}
+
+	pvbs, err := podVolumeBackupLister.List(labels.SelectorFromSet(selector))
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error listing pod volume backups of the schedule")
+		return nil
+	}
+
+	var mostRecent *velerov1api.PodVolumeBackup
+	for _, pvb := range pvbs {
+		if pvb.Name == req.Name || pvb.Status.Phase != velerov1api.PodVolumeBackupPhaseCompleted {
+			continue
+		}
+
+		if !isPVC && (pvb.Spec.Pod.UID != req.Spec.Pod.UID || pvb.Spec.Volume != req.Spec.Volume) {
+			continue
+		}
+
+		// snapshots in other repositories can't be reused.
+		if pvb.Spec.BackupStorageLocation != req.Spec.BackupStorageLocation || !sameUploaderType(pvb.Spec.UploaderType, req.Spec.UploaderType) {
+			continue
+		}
+
+		if mostRecent == nil || pvb.Status.StartTimestamp.After(mostRecent.Status.StartTimestamp.Time) {
+			mostRecent = pvb
+		}
+	}
+
+	switch {
+	case mostRecent == nil:
+		log.Info("No previous pod volume backup of the volume found, backing it up")
+		return nil
+	case mostRecent.Status.ChangeIndicator != changeIndicator:
+		log.WithField("previousPodVolumeBackup", mostRecent.Name).Info("Volume changed since the previous backup, backing it up")
+		return nil
+	case !sameStrings(mostRecent.Spec.PathIncludes, req.Spec.PathIncludes) || !sameStrings(mostRecent.Spec.PathExcludes, req.Spec.PathExcludes):
+		log.WithField("previousPodVolumeBackup", mostRecent.Name).Info("Volume path patterns changed since the previous backup, backing it up")
+		return nil
+	}
+
+	return mostRecent
+}
+
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// sameUploaderType returns true if a and b are the same uploader type, where an empty uploader
+// type is restic.
+func sameUploaderType(a, b velerov1api.UploaderType) bool {
+	if a == "" {
+		a = velerov1api.UploaderTypeRestic
+	}
+	if b == "" {
+		b = velerov1api.UploaderTypeRestic
+	}
+	return a == b
+}
+
 func (c *podVolumeBackupController) patchPodVolumeBackup(req *velerov1api.PodVolumeBackup, mutate func(*velerov1api.PodVolumeBackup)) (*velerov1api.PodVolumeBackup, error) {
 	// Record original json
 	oldData, err := json.Marshal(req)
@@ -18,11 +18,18 @@ package controller
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	kbfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
@@ -171,3 +178,146 @@ func TestPVBHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestGetUnchangedPodVolumeBackup(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	pvcBackup := func(name string, start time.Time, changeIndicator string) *builder.PodVolumeBackupBuilder {
+		return builder.ForPodVolumeBackup("velero", name).
+			ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "daily", velerov1api.PVCUIDLabel, "pvc-uid")).
+			Phase(velerov1api.PodVolumeBackupPhaseCompleted).
+			SnapshotID(name + "-snapshot").
+			StartTimestamp(start).
+			ChangeIndicator(changeIndicator)
+	}
+
+	tests := []struct {
+		name     string
+		req      *velerov1api.PodVolumeBackup
+		existing []*velerov1api.PodVolumeBackup
+		want     string
+	}{
+		{
+			name: "a pod volume backup that isn't of a schedule has no previous backup",
+			req:  builder.ForPodVolumeBackup("velero", "pvb-new").ObjectMeta(builder.WithLabels(velerov1api.PVCUIDLabel, "pvc-uid")).Result(),
+			existing: []*velerov1api.PodVolumeBackup{
+				pvcBackup("pvb-1", now, "sha256:a").Result(),
+			},
+		},
+		{
+			name: "the most recent backup of an unchanged PVC is returned",
+			req:  pvcBackup("pvb-new", now.Add(2*time.Hour), "").Phase(velerov1api.PodVolumeBackupPhaseInProgress).Result(),
+			existing: []*velerov1api.PodVolumeBackup{
+				pvcBackup("pvb-1", now, "sha256:a").Result(),
+				pvcBackup("pvb-2", now.Add(time.Hour), "sha256:a").Result(),
+				pvcBackup("pvb-failed", now.Add(time.Hour+time.Minute), "sha256:a").Phase(velerov1api.PodVolumeBackupPhaseFailed).Result(),
+			},
+			want: "pvb-2",
+		},
+		{
+			name: "a PVC that changed since its most recent backup has no unchanged backup",
+			req:  pvcBackup("pvb-new", now.Add(2*time.Hour), "").Phase(velerov1api.PodVolumeBackupPhaseInProgress).Result(),
+			existing: []*velerov1api.PodVolumeBackup{
+				pvcBackup("pvb-1", now, "sha256:a").Result(),
+				pvcBackup("pvb-2", now.Add(time.Hour), "sha256:b").Result(),
+			},
+		},
+		{
+			name: "backups of another uploader aren't used",
+			req:  pvcBackup("pvb-new", now.Add(2*time.Hour), "").UploaderType(velerov1api.UploaderTypeKopia).Result(),
+			existing: []*velerov1api.PodVolumeBackup{
+				pvcBackup("pvb-1", now, "sha256:a").Result(),
+			},
+		},
+		{
+			name: "a PVC whose path patterns changed has no unchanged backup",
+			req: func() *velerov1api.PodVolumeBackup {
+				pvb := pvcBackup("pvb-new", now.Add(2*time.Hour), "").Result()
+				pvb.Spec.PathExcludes = []string{"tmp"}
+				return pvb
+			}(),
+			existing: []*velerov1api.PodVolumeBackup{
+				pvcBackup("pvb-1", now, "sha256:a").Result(),
+			},
+		},
+		{
+			name: "backups of other volumes of the pod aren't used",
+			req: builder.ForPodVolumeBackup("velero", "pvb-new").
+				ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "daily")).
+				Volume("cache").
+				Result(),
+			existing: []*velerov1api.PodVolumeBackup{
+				builder.ForPodVolumeBackup("velero", "pvb-1").
+					ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "daily")).
+					Volume("data").
+					Phase(velerov1api.PodVolumeBackupPhaseCompleted).
+					StartTimestamp(now).
+					ChangeIndicator("sha256:a").
+					Result(),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sharedInformers := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0)
+			for _, pvb := range test.existing {
+				require.NoError(t, sharedInformers.Velero().V1().PodVolumeBackups().Informer().GetStore().Add(pvb))
+			}
+
+			res := getUnchangedPodVolumeBackup(velerotest.NewLogger(), test.req, "sha256:a", sharedInformers.Velero().V1().PodVolumeBackups().Lister().PodVolumeBackups("velero"))
+			if test.want == "" {
+				assert.Nil(t, res)
+				return
+			}
+			require.NotNil(t, res)
+			assert.Equal(t, test.want, res.Name)
+		})
+	}
+}
+
+func TestBackupDeletionRequested(t *testing.T) {
+	require.NoError(t, velerov1api.AddToScheme(scheme.Scheme))
+
+	request := &velerov1api.DeleteBackupRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "backup-1-abcde",
+			Labels:    map[string]string{velerov1api.BackupNameLabel: "backup-1"},
+		},
+		Spec: velerov1api.DeleteBackupRequestSpec{BackupName: "backup-1"},
+	}
+
+	tests := []struct {
+		name            string
+		namespace       string
+		backupNameLabel string
+		want            bool
+	}{
+		{
+			name:            "backup with a delete backup request is being deleted",
+			namespace:       "velero",
+			backupNameLabel: "backup-1",
+			want:            true,
+		},
+		{
+			name:            "backup without a delete backup request isn't being deleted",
+			namespace:       "velero",
+			backupNameLabel: "backup-2",
+		},
+		{
+			name:            "delete backup requests in other namespaces are ignored",
+			namespace:       "other",
+			backupNameLabel: "backup-1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kbClient := kbfake.NewFakeClientWithScheme(scheme.Scheme, request.DeepCopy())
+
+			requested, err := backupDeletionRequested(kbClient, test.namespace, test.backupNameLabel)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, requested)
+		})
+	}
+}
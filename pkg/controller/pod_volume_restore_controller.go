@@ -262,6 +262,15 @@ func loggerForPodVolumeRestore(baseLogger logrus.FieldLogger, req *velerov1api.P
 func (c *podVolumeRestoreController) processRestore(req *velerov1api.PodVolumeRestore) error {
 	log := loggerForPodVolumeRestore(c.logger, req)
 
+	// the node's data paths are limited, so the restore stays new until others have ended.
+	release, reason := restic.TryAcquireDataPath(log)
+	if release == nil {
+		log.Debugf("Waiting to start, %s", reason)
+		c.queue.AddAfter(kube.NamespaceAndName(req), restic.DataPathRetryInterval)
+		return nil
+	}
+	defer release()
+
 	log.Info("Restore starting")
 
 	var err error
@@ -282,13 +291,34 @@ func (c *podVolumeRestoreController) processRestore(req *velerov1api.PodVolumeRe
 		return c.failRestore(req, errors.Wrap(err, "error getting pod").Error(), log)
 	}
 
-	volumeDir, err := kube.GetVolumeDirectory(pod, req.Spec.Volume, c.pvcLister, c.pvLister)
+	// raw block volumes are restored to their device under volumeDevices/, rather than to
+	// a directory under volumes/.
+	blockVolume := kube.IsBlockVolume(pod, req.Spec.Volume)
+
+	var volumeDir string
+	if blockVolume {
+		volumeDir, err = kube.GetVolumeDeviceName(pod, req.Spec.Volume, c.pvcLister)
+	} else {
+		volumeDir, err = kube.GetVolumeDirectory(pod, req.Spec.Volume, c.pvcLister, c.pvLister)
+	}
 	if err != nil {
 		log.WithError(err).Error("Error getting volume directory name")
 		return c.failRestore(req, errors.Wrap(err, "error getting volume directory name").Error(), log)
 	}
 
-	credsFile, err := restic.TempCredentialsFile(c.secretLister, req.Namespace, req.Spec.Pod.Namespace, c.fileSystem)
+	// the repository's cache size limit and rate limits are used, if it has them.
+	resticRepo, err := restic.GetRepository(c.kbClient, req.Namespace, req.Spec.RepoIdentifier)
+	if err != nil {
+		log.WithError(err).Error("Error getting restic repository")
+		return c.failRestore(req, errors.Wrap(err, "error getting restic repository").Error(), log)
+	}
+
+	var repoSpec velerov1api.BackupRepositorySpec
+	if resticRepo != nil {
+		repoSpec = resticRepo.Spec
+	}
+
+	credsFile, err := restic.TempCredentialsFile(c.secretLister, req.Namespace, req.Spec.RepoIdentifier, c.fileSystem)
 	if err != nil {
 		log.WithError(err).Error("Error creating temp restic credentials file")
 		return c.failRestore(req, errors.Wrap(err, "error creating temp restic credentials file").Error(), log)
@@ -313,7 +343,7 @@ func (c *podVolumeRestoreController) processRestore(req *velerov1api.PodVolumeRe
 	}
 
 	// execute the restore process
-	if err := c.restorePodVolume(req, credsFile, caCertFile, volumeDir, log); err != nil {
+	if err := c.restorePodVolume(req, credsFile, caCertFile, volumeDir, blockVolume, repoSpec, log); err != nil {
 		log.WithError(err).Error("Error restoring volume")
 		return c.failRestore(req, errors.Wrap(err, "error restoring volume").Error(), log)
 	}
@@ -332,51 +362,88 @@ func (c *podVolumeRestoreController) processRestore(req *velerov1api.PodVolumeRe
 	return nil
 }
 
-func (c *podVolumeRestoreController) restorePodVolume(req *velerov1api.PodVolumeRestore, credsFile, caCertFile, volumeDir string, log logrus.FieldLogger) error {
-	// Get the full path of the new volume's directory as mounted in the daemonset pod, which
-	// will look like: /host_pods/<new-pod-uid>/volumes/<volume-plugin-name>/<volume-dir>
-	volumePath, err := singlePathMatch(fmt.Sprintf("/host_pods/%s/volumes/*/%s", string(req.Spec.Pod.UID), volumeDir))
-	if err != nil {
-		return errors.Wrap(err, "error identifying path of volume")
+func (c *podVolumeRestoreController) restorePodVolume(req *velerov1api.PodVolumeRestore, credsFile, caCertFile, volumeDir string, blockVolume bool, repoSpec velerov1api.BackupRepositorySpec, log logrus.FieldLogger) error {
+	var (
+		volumePath, donePath string
+		err                  error
+	)
+
+	if blockVolume {
+		// Get the full path of the new volume's device as mapped in the daemonset pod, which
+		// will look like: /host_pods/<new-pod-uid>/volumeDevices/<volume-plugin-name>/<pv-name>
+		volumePath, err = singlePathMatch(restic.HostPodPath(string(req.Spec.Pod.UID), "volumeDevices", "*", volumeDir))
+		if err != nil {
+			return errors.Wrap(err, "error identifying path of volume device")
+		}
+
+		// The done file can't be written to the block volume, so it's written to the directory
+		// that the init container mounts for the volume from the block restores emptyDir volume.
+		donePath, err = singlePathMatch(restic.HostPodPath(string(req.Spec.Pod.UID), "volumes", "*", restic.BlockRestoresVolume, req.Spec.Volume))
+		if err != nil {
+			return errors.Wrap(err, "error identifying path of volume's done file directory")
+		}
+	} else {
+		// Get the full path of the new volume's directory as mounted in the daemonset pod, which
+		// will look like: /host_pods/<new-pod-uid>/volumes/<volume-plugin-name>/<volume-dir>
+		volumePath, err = singlePathMatch(restic.HostPodPath(string(req.Spec.Pod.UID), "volumes", "*", volumeDir))
+		if err != nil {
+			return errors.Wrap(err, "error identifying path of volume")
+		}
+		donePath = volumePath
 	}
 
-	resticCmd := restic.RestoreCommand(
-		req.Spec.RepoIdentifier,
-		credsFile,
-		req.Spec.SnapshotID,
-		volumePath,
-	)
-	resticCmd.CACertFile = caCertFile
+	repo := restic.RepoAccess{
+		Identifier:   req.Spec.RepoIdentifier,
+		PasswordFile: credsFile,
+		CACertFile:   caCertFile,
+	}
+
+	repo.CacheDir, repo.CacheSizeLimit = restic.RepoCache(req.Spec.UploaderType, req.Spec.RepoIdentifier, repoSpec.CacheSizeLimit)
+	repo.UploadLimit, repo.DownloadLimit = restic.RepoBandwidth(repoSpec.UploadRateLimit, repoSpec.DownloadRateLimit)
 
-	// Running restic command might need additional provider specific environment variables. Based on the provider, we
-	// set resticCmd.Env appropriately (currently for Azure and S3 based backuplocations)
+	// Running the uploader's commands might need additional provider specific environment variables. Based on
+	// the provider, we set repo.Env appropriately (currently for Azure and S3 based backuplocations)
 	if strings.HasPrefix(req.Spec.RepoIdentifier, "azure") {
 		env, err := restic.AzureCmdEnv(c.kbClient, req.Namespace, req.Spec.BackupStorageLocation)
 		if err != nil {
 			return c.failRestore(req, errors.Wrap(err, "error setting restic cmd env").Error(), log)
 		}
-		resticCmd.Env = env
+		repo.Env = env
 	} else if strings.HasPrefix(req.Spec.RepoIdentifier, "s3") {
 		env, err := restic.S3CmdEnv(c.kbClient, req.Namespace, req.Spec.BackupStorageLocation)
 		if err != nil {
 			return c.failRestore(req, errors.Wrap(err, "error setting restic cmd env").Error(), log)
 		}
-		resticCmd.Env = env
+		repo.Env = env
+	}
+
+	uploader, err := restic.NewUploader(req.Spec.UploaderType, log)
+	if err != nil {
+		return err
 	}
 
-	var stdout, stderr string
+	repo.Env = restic.DataPathEnv(repo.Env)
 
-	if stdout, stderr, err = restic.RunRestore(resticCmd, log, c.updateRestoreProgressFunc(req, log)); err != nil {
-		return errors.Wrapf(err, "error running restic restore, cmd=%s, stdout=%s, stderr=%s", resticCmd.String(), stdout, stderr)
+	if blockVolume {
+		if err := c.restoreBlockVolume(req, uploader, repo, volumePath, log); err != nil {
+			return err
+		}
+	} else {
+		uploaderReq := restic.UploaderRestoreRequest{
+			SnapshotID: req.Spec.SnapshotID,
+			Path:       volumePath,
+		}
+		if err := uploader.Restore(repo, uploaderReq, c.updateRestoreProgressFunc(req, log)); err != nil {
+			return err
+		}
 	}
-	log.Debugf("Ran command=%s, stdout=%s, stderr=%s", resticCmd.String(), stdout, stderr)
 
 	// Remove the .velero directory from the restored volume (it may contain done files from previous restores
 	// of this volume, which we don't want to carry over). If this fails for any reason, log and continue, since
 	// this is non-essential cleanup (the done files are named based on restore UID and the init container looks
 	// for the one specific to the restore being executed).
-	if err := os.RemoveAll(filepath.Join(volumePath, ".velero")); err != nil {
-		log.WithError(err).Warnf("error removing .velero directory from directory %s", volumePath)
+	if err := os.RemoveAll(filepath.Join(donePath, ".velero")); err != nil {
+		log.WithError(err).Warnf("error removing .velero directory from directory %s", donePath)
 	}
 
 	var restoreUID types.UID
@@ -389,20 +456,39 @@ func (c *podVolumeRestoreController) restorePodVolume(req *velerov1api.PodVolume
 
 	// Create the .velero directory within the volume dir so we can write a done file
 	// for this restore.
-	if err := os.MkdirAll(filepath.Join(volumePath, ".velero"), 0755); err != nil {
+	if err := os.MkdirAll(filepath.Join(donePath, ".velero"), 0755); err != nil {
 		return errors.Wrap(err, "error creating .velero directory for done file")
 	}
 
 	// Write a done file with name=<restore-uid> into the just-created .velero dir
 	// within the volume. The velero restic init container on the pod is waiting
 	// for this file to exist in each restored volume before completing.
-	if err := ioutil.WriteFile(filepath.Join(volumePath, ".velero", string(restoreUID)), nil, 0644); err != nil {
+	if err := ioutil.WriteFile(filepath.Join(donePath, ".velero", string(restoreUID)), nil, 0644); err != nil {
 		return errors.Wrap(err, "error writing done file")
 	}
 
 	return nil
 }
 
+// restoreBlockVolume writes a raw block volume's data from its snapshot to the volume's device.
+func (c *podVolumeRestoreController) restoreBlockVolume(req *velerov1api.PodVolumeRestore, uploader restic.Uploader, repo restic.RepoAccess, devicePath string, log logrus.FieldLogger) error {
+	device, err := os.OpenFile(devicePath, os.O_WRONLY, 0)
+	if err != nil {
+		return errors.Wrap(err, "error opening volume device")
+	}
+	defer device.Close()
+
+	uploaderReq := restic.UploaderRestoreRequest{
+		SnapshotID: req.Spec.SnapshotID,
+		Device:     device,
+	}
+	if err := uploader.Restore(repo, uploaderReq, c.updateRestoreProgressFunc(req, log)); err != nil {
+		return err
+	}
+
+	return errors.Wrap(device.Sync(), "error syncing volume device")
+}
+
 func (c *podVolumeRestoreController) patchPodVolumeRestore(req *velerov1api.PodVolumeRestore, mutate func(*velerov1api.PodVolumeRestore)) (*velerov1api.PodVolumeRestore, error) {
 	// Record original json
 	oldData, err := json.Marshal(req)
@@ -31,13 +31,18 @@ import (
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/util/sets"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/internal/hook"
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
@@ -47,10 +52,12 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
+	"github.com/vmware-tanzu/velero/pkg/volume"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -74,6 +81,7 @@ var nonRestorableResources = []string{
 	// created as needed if they don't exist.
 	// https://github.com/vmware-tanzu/velero/issues/1113
 	"resticrepositories.velero.io",
+	"backuprepositories.velero.io",
 }
 
 type restoreController struct {
@@ -86,6 +94,7 @@ type restoreController struct {
 	backupLister           velerov1listers.BackupLister
 	restoreLister          velerov1listers.RestoreLister
 	kbClient               client.Client
+	configMapClient        corev1client.ConfigMapsGetter
 	snapshotLocationLister velerov1listers.VolumeSnapshotLocationLister
 	restoreLogLevel        logrus.Level
 	defaultBackupLocation  string
@@ -105,10 +114,12 @@ func NewRestoreController(
 	restorer pkgrestore.Restorer,
 	backupLister velerov1listers.BackupLister,
 	kbClient client.Client,
+	configMapClient corev1client.ConfigMapsGetter,
 	snapshotLocationLister velerov1listers.VolumeSnapshotLocationLister,
 	logger logrus.FieldLogger,
 	restoreLogLevel logrus.Level,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
 	defaultBackupLocation string,
 	metrics *metrics.ServerMetrics,
 	logFormat logging.Format,
@@ -122,6 +133,7 @@ func NewRestoreController(
 		backupLister:           backupLister,
 		restoreLister:          restoreInformer.Lister(),
 		kbClient:               kbClient,
+		configMapClient:        configMapClient,
 		snapshotLocationLister: snapshotLocationLister,
 		restoreLogLevel:        restoreLogLevel,
 		defaultBackupLocation:  defaultBackupLocation,
@@ -132,7 +144,7 @@ func NewRestoreController(
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
 		newPluginManager: newPluginManager,
-		newBackupStore:   persistence.NewObjectBackupStore,
+		newBackupStore:   persistence.NewObjectBackupStoreWithCredentials(credentialFileStore),
 	}
 
 	c.syncHandler = c.processQueueItem
@@ -282,9 +294,10 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 }
 
 type backupInfo struct {
-	backup      *api.Backup
-	location    *velerov1api.BackupStorageLocation
-	backupStore persistence.BackupStore
+	backup            *api.Backup
+	location          *velerov1api.BackupStorageLocation
+	backupStore       persistence.BackupStore
+	resourceModifiers *resourcemodifiers.ResourceModifiers
 }
 
 func (c *restoreController) validateAndComplete(restore *api.Restore, pluginManager clientmgmt.Manager) backupInfo {
@@ -309,11 +322,90 @@ func (c *restoreController) validateAndComplete(restore *api.Restore, pluginMana
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded resource lists: %v", err))
 	}
 
+	// validate included/excluded resources for restoring status
+	if restore.Spec.RestoreStatus != nil {
+		for _, err := range collections.ValidateIncludesExcludes(restore.Spec.RestoreStatus.IncludedResources, restore.Spec.RestoreStatus.ExcludedResources) {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded resource lists for restoring status: %v", err))
+		}
+	}
+
+	// validate included/excluded resources for stripping finalizers and owner references
+	if restore.Spec.StripFinalizers != nil {
+		for _, err := range collections.ValidateIncludesExcludes(restore.Spec.StripFinalizers.IncludedResources, restore.Spec.StripFinalizers.ExcludedResources) {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded resource lists for stripping finalizers: %v", err))
+		}
+	}
+	if restore.Spec.StripOwnerReferences != nil {
+		for _, err := range collections.ValidateIncludesExcludes(restore.Spec.StripOwnerReferences.IncludedResources, restore.Spec.StripOwnerReferences.ExcludedResources) {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded resource lists for stripping owner references: %v", err))
+		}
+	}
+
 	// validate included/excluded namespaces
 	for _, err := range collections.ValidateIncludesExcludes(restore.Spec.IncludedNamespaces, restore.Spec.ExcludedNamespaces) {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
 	}
 
+	// validate included resource names
+	for _, err := range pkgrestore.ValidateIncludedResourceNames(restore.Spec.IncludedResourceNames) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, err.Error())
+	}
+
+	// validate item labels and annotations
+	for _, err := range pkgrestore.ValidateItemMetadata(restore.Spec.ItemLabels, restore.Spec.ItemAnnotations) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, err.Error())
+	}
+
+	// validate readiness gates
+	for _, err := range pkgrestore.ValidateReadinessGates(restore.Spec.ReadinessGates) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, err.Error())
+	}
+
+	// validate namespace mappings
+	for _, err := range pkgrestore.ValidateNamespaceMapping(restore.Spec.NamespaceMapping) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, err.Error())
+	}
+
+	// validate resource mappings
+	for _, err := range pkgrestore.ValidateResourceMappings(restore.Spec.ResourceMappings) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, err.Error())
+	}
+
+	// validate the PVC resize spec
+	for _, err := range pkgrestore.ValidatePVCResize(restore.Spec.PVCResize) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, err.Error())
+	}
+
+	// validate the snapshot volume overrides
+	for _, err := range pkgrestore.ValidateSnapshotVolumeOverrides(restore.Spec.SnapshotVolumeOverrides) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, err.Error())
+	}
+
+	// validate conflict policies
+	for _, err := range pkgrestore.ValidateConflictPolicies(restore.Spec.ConflictPolicies) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, err.Error())
+	}
+
+	// validate the API rate limit
+	for _, err := range pkgrestore.ValidateAPIRateLimit(restore.Spec.APIRateLimit) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, err.Error())
+	}
+
+	// validate the existing resource policy
+	if err := pkgrestore.ValidateExistingResourcePolicy(restore.Spec.ExistingResourcePolicy); err != nil {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid existing resource policy: %v", err))
+	}
+
+	// validate the service account policy
+	if err := pkgrestore.ValidateServiceAccountPolicy(restore.Spec.ServiceAccountPolicy); err != nil {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid service account policy: %v", err))
+	}
+
+	// validate the PV rename policy
+	if err := pkgrestore.ValidatePVRenamePolicy(restore.Spec.PVRenamePolicy); err != nil {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid PV rename policy: %v", err))
+	}
+
 	// validate that exactly one of BackupName and ScheduleName have been specified
 	if !backupXorScheduleProvided(restore) {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "Either a backup or schedule must be specified as a source for the restore, but not both")
@@ -355,9 +447,33 @@ func (c *restoreController) validateAndComplete(restore *api.Restore, pluginMana
 		restore.Spec.ScheduleName = info.backup.GetLabels()[velerov1api.ScheduleNameLabel]
 	}
 
+	if restore.Spec.ResourceModifier != nil {
+		modifiers, err := c.getResourceModifiers(restore.Spec.ResourceModifier)
+		if err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Error retrieving resource modifiers: %v", err))
+			return backupInfo{}
+		}
+		info.resourceModifiers = modifiers
+	}
+
 	return info
 }
 
+// getResourceModifiers fetches the referenced resource modifiers ConfigMap from the
+// Velero namespace and parses the rules it contains.
+func (c *restoreController) getResourceModifiers(ref *corev1api.TypedLocalObjectReference) (*resourcemodifiers.ResourceModifiers, error) {
+	if ref.Kind != "ConfigMap" {
+		return nil, errors.Errorf("unsupported resource modifier kind %q, only ConfigMap is supported", ref.Kind)
+	}
+
+	cm, err := c.configMapClient.ConfigMaps(c.namespace).Get(context.TODO(), ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting resource modifiers configmap %s/%s", c.namespace, ref.Name)
+	}
+
+	return resourcemodifiers.GetResourceModifiersFromConfig(cm)
+}
+
 // backupXorScheduleProvided returns true if exactly one of BackupName and
 // ScheduleName are non-empty for the restore, or false otherwise.
 func backupXorScheduleProvided(restore *api.Restore) bool {
@@ -446,6 +562,11 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		return errors.Wrap(err, "error getting restore item actions")
 	}
 
+	postRestoreActions, err := pluginManager.GetPostRestoreActions()
+	if err != nil {
+		return errors.Wrap(err, "error getting post-restore actions")
+	}
+
 	backupFile, err := downloadToTempFile(restore.Spec.BackupName, info.backupStore, restoreLog)
 	if err != nil {
 		return errors.Wrap(err, "error downloading backup")
@@ -459,11 +580,20 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		return errors.WithStack(err)
 	}
 
-	volumeSnapshots, err := info.backupStore.GetBackupVolumeSnapshots(restore.Spec.BackupName)
+	backupVolumeSnapshots, err := info.backupStore.GetBackupVolumeSnapshots(restore.Spec.BackupName)
 	if err != nil {
 		return errors.Wrap(err, "error fetching volume snapshots metadata")
 	}
 
+	// snapshots that were deleted because the backup's snapshot TTL elapsed can't be
+	// restored from, so their persistent volumes are restored as if they weren't snapshotted.
+	var volumeSnapshots []*volume.Snapshot
+	for _, snapshot := range backupVolumeSnapshots {
+		if snapshot.Status.Phase != volume.SnapshotPhaseDeleted {
+			volumeSnapshots = append(volumeSnapshots, snapshot)
+		}
+	}
+
 	restoreLog.Info("starting restore")
 
 	var podVolumeBackups []*velerov1api.PodVolumeBackup
@@ -471,16 +601,47 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		podVolumeBackups = append(podVolumeBackups, &podVolumeBackupList.Items[i])
 	}
 	restoreReq := pkgrestore.Request{
-		Log:              restoreLog,
-		Restore:          restore,
-		Backup:           info.backup,
-		PodVolumeBackups: podVolumeBackups,
-		VolumeSnapshots:  volumeSnapshots,
-		BackupReader:     backupFile,
+		Log:               restoreLog,
+		Restore:           restore,
+		Backup:            info.backup,
+		PodVolumeBackups:  podVolumeBackups,
+		VolumeSnapshots:   volumeSnapshots,
+		BackupReader:      backupFile,
+		ResourceModifiers: info.resourceModifiers,
+		RenamedPVs:        make(map[string]string),
+		HookTracker:       hook.NewHookTracker(),
+		ConflictReport:    new(pkgrestore.Result),
+		ItemCounts:        new(pkgrestore.ItemCounts),
+	}
+	if restore.Spec.DryRun {
+		restoreReq.DryRunReport = new(pkgrestore.DryRunReport)
 	}
 	restoreWarnings, restoreErrors := c.restorer.Restore(restoreReq, actions, c.snapshotLocationLister, pluginManager)
 	restoreLog.Info("restore completed")
 
+	restore.Status.Conflicts = len(restoreReq.ConflictReport.Cluster)
+	for _, conflicts := range restoreReq.ConflictReport.Namespaces {
+		restore.Status.Conflicts += len(conflicts)
+	}
+
+	restore.Status.Unchanged = restoreReq.ItemCounts.Unchanged
+
+	if !restore.Spec.DryRun && len(restoreReq.RenamedPVs) > 0 {
+		restore.Status.RenamedPersistentVolumes = restoreReq.RenamedPVs
+	}
+
+	if attempted, failed := restoreReq.HookTracker.Stat(); attempted > 0 {
+		restore.Status.HookStatus = &api.HookStatus{
+			HooksAttempted: attempted,
+			HooksFailed:    failed,
+		}
+	}
+
+	if !restore.Spec.DryRun && len(postRestoreActions) > 0 {
+		restoreLog.Info("running post-restore actions")
+		restore.Status.PostRestoreActionStatus = runPostRestoreActions(restore, info.backup, postRestoreActions, &restoreWarnings, &restoreErrors, restoreLog)
+	}
+
 	// re-instantiate the backup store because credentials could have changed since the original
 	// instantiation, if this was a long-running restore
 	info.backupStore, err = c.newBackupStore(info.location, pluginManager, c.logger)
@@ -499,28 +660,70 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 	// At this point, no further logs should be written to restoreLog since it's been uploaded
 	// to object storage.
 
-	restore.Status.Warnings = len(restoreWarnings.Velero) + len(restoreWarnings.Cluster)
-	for _, w := range restoreWarnings.Namespaces {
-		restore.Status.Warnings += len(w)
-	}
-
-	restore.Status.Errors = len(restoreErrors.Velero) + len(restoreErrors.Cluster)
-	for _, e := range restoreErrors.Namespaces {
-		restore.Status.Errors += len(e)
-	}
+	restore.Status.Warnings = resultCount(restoreWarnings)
+	restore.Status.Errors = resultCount(restoreErrors)
 
 	m := map[string]pkgrestore.Result{
 		"warnings": restoreWarnings,
 		"errors":   restoreErrors,
 	}
+	if restore.Status.Conflicts > 0 {
+		m["conflicts"] = *restoreReq.ConflictReport
+	}
 
 	if err := putResults(restore, m, info.backupStore, c.logger); err != nil {
 		c.logger.WithError(err).Error("Error uploading restore results to backup storage")
 	}
 
+	if restoreReq.DryRunReport != nil {
+		if err := putDryRunReport(restore, restoreReq.DryRunReport, info.backupStore); err != nil {
+			c.logger.WithError(err).Error("Error uploading restore dry-run report to backup storage")
+		}
+	}
+
 	return nil
 }
 
+// runPostRestoreActions runs each of the post-restore actions with the restore, whose
+// status summarizes the results of restoring its items. The warnings returned by the
+// actions and the errors of the actions that failed are added to the restore's results.
+func runPostRestoreActions(restore *api.Restore, backup *api.Backup, actions []velero.PostRestoreAction, warnings, errs *pkgrestore.Result, log logrus.FieldLogger) *api.PostRestoreActionStatus {
+	summary := restore.DeepCopy()
+	summary.Status.Warnings = resultCount(*warnings)
+	summary.Status.Errors = resultCount(*errs)
+
+	status := new(api.PostRestoreActionStatus)
+	for _, action := range actions {
+		status.ActionsAttempted++
+
+		output, err := action.Execute(&velero.PostRestoreActionExecuteInput{
+			Restore: summary,
+			Backup:  backup,
+		})
+		if err != nil {
+			log.WithError(err).Error("Error running post-restore action")
+			status.ActionsFailed++
+			errs.Velero = append(errs.Velero, fmt.Sprintf("error running post-restore action: %v", err))
+			continue
+		}
+
+		if output != nil {
+			warnings.Velero = append(warnings.Velero, output.Warnings...)
+		}
+	}
+
+	return status
+}
+
+// resultCount returns the number of messages in a restore's result.
+func resultCount(result pkgrestore.Result) int {
+	count := len(result.Velero) + len(result.Cluster)
+	for _, messages := range result.Namespaces {
+		count += len(messages)
+	}
+	return count
+}
+
 func putResults(restore *api.Restore, results map[string]pkgrestore.Result, backupStore persistence.BackupStore, log logrus.FieldLogger) error {
 	buf := new(bytes.Buffer)
 	gzw := gzip.NewWriter(buf)
@@ -541,6 +744,22 @@ func putResults(restore *api.Restore, results map[string]pkgrestore.Result, back
 	return nil
 }
 
+func putDryRunReport(restore *api.Restore, report *pkgrestore.DryRunReport, backupStore persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(report); err != nil {
+		return errors.Wrap(err, "error encoding restore dry-run report to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	return backupStore.PutRestoreDryRunReport(restore.Spec.BackupName, restore.Name, buf)
+}
+
 func downloadToTempFile(backupName string, backupStore persistence.BackupStore, logger logrus.FieldLogger) (*os.File, error) {
 	readCloser, err := backupStore.GetBackupContents(backupName)
 	if err != nil {
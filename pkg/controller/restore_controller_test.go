@@ -33,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/clock"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	core "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 
@@ -113,10 +114,12 @@ func TestFetchBackupInfo(t *testing.T) {
 				restorer,
 				sharedInformers.Velero().V1().Backups().Lister(),
 				fakeClient,
+				kubefake.NewSimpleClientset().CoreV1(),
 				sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
 				logger,
 				logrus.InfoLevel,
 				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				nil, // credential file store
 				"default",
 				metrics.NewServerMetrics(),
 				formatFlag,
@@ -209,10 +212,12 @@ func TestProcessQueueItemSkips(t *testing.T) {
 				restorer,
 				sharedInformers.Velero().V1().Backups().Lister(),
 				nil,
+				nil,
 				sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
 				logger,
 				logrus.InfoLevel,
 				nil,
+				nil, // credential file store
 				"default",
 				metrics.NewServerMetrics(),
 				formatFlag,
@@ -246,6 +251,7 @@ func TestProcessQueueItem(t *testing.T) {
 		restore                         *velerov1api.Restore
 		backup                          *velerov1api.Backup
 		restorerError                   error
+		restorerRenamedPVs              map[string]string
 		expectedErr                     bool
 		expectedPhase                   string
 		expectedStartTime               *metav1.Time
@@ -276,6 +282,96 @@ func TestProcessQueueItem(t *testing.T) {
 			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
 			expectedValidationErrors: []string{"Invalid included/excluded resource lists: excludes list cannot contain an item in the includes list: a-resource"},
 		},
+		{
+			name:                     "restore with resource in both included and excluded resources for stripping owner references fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).StripOwnerReferences([]string{"pods"}, []string{"pods"}).Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Invalid included/excluded resource lists for stripping owner references: excludes list cannot contain an item in the includes list: pods"},
+		},
+		{
+			name:                     "restore with invalid included resource name fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).IncludedResourceNames("deployments.apps").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{`invalid resource name "deployments.apps": must be formatted as <resource>/<name>`},
+		},
+		{
+			name:                     "restore with reserved item label fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).ItemLabels("velero.io/restore-name", "foo").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"invalid item label velero.io/restore-name: label is reserved for use by Velero"},
+		},
+		{
+			name:                     "restore with invalid wildcard namespace mapping fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).NamespaceMappings("ns-*-*", "new-ns").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"invalid namespace mapping ns-*-*:new-ns: source may contain at most one '*'"},
+		},
+		{
+			name:                     "restore with invalid resource mapping fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).ResourceMapping("deployments.apps.openshift.io", "deployments.apps", "spec/triggers").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{`invalid resource mapping for deployments.apps.openshift.io: prune field "spec/triggers" must be a JSON pointer starting with '/'`},
+		},
+		{
+			name:                     "restore with invalid PVC resize factor fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).PVCResize("0.5", nil).Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{`invalid PVC resize factor "0.5": must be a number that's at least 1`},
+		},
+		{
+			name:                     "restore with invalid conflict policy fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).ConflictPolicy("update", "configmaps").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{`invalid conflict policy "update", must be one of "skip", "overwrite" or "fail-fast"`},
+		},
+		{
+			name:                     "restore with invalid API rate limit fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).APIRateLimit(0, 10).Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"invalid API rate limit QPS 0: must be greater than 0"},
+		},
+		{
+			name:                     "restore with invalid existing resource policy fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).ExistingResourcePolicy("replace").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{`Invalid existing resource policy: invalid existing resource policy "replace", must be one of "none", "update" or "patch"`},
+		},
+		{
+			name:                     "restore with invalid service account policy fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).ServiceAccountPolicy("update").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{`Invalid service account policy: invalid service account policy "update", must be one of "merge", "replace" or "skip"`},
+		},
 		{
 			name:                     "new restore with empty backup and schedule names fails validation",
 			restore:                  NewRestore("foo", "bar", "", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
@@ -309,6 +405,15 @@ func TestProcessQueueItem(t *testing.T) {
 			expectedValidationErrors:        []string{"Error retrieving backup: backup.velero.io \"backup-1\" not found"},
 			backupStoreGetBackupMetadataErr: errors.New("no backup here"),
 		},
+		{
+			name:                     "restore with non-existent resource modifier configmap fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).ResourceModifier("modifiers").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Error retrieving resource modifiers: error getting resource modifiers configmap velero/modifiers: configmaps \"modifiers\" not found"},
+		},
 		{
 			name:                  "restorer throwing an error causes the restore to fail",
 			location:              defaultStorageLocation,
@@ -334,6 +439,38 @@ func TestProcessQueueItem(t *testing.T) {
 			expectedCompletedTime: &timestamp,
 			expectedRestorerCall:  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseInProgress).Result(),
 		},
+		{
+			name:                  "valid dry-run restore gets executed and its report is uploaded",
+			location:              defaultStorageLocation,
+			restore:               NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).DryRun(true).Result(),
+			backup:                defaultBackup().StorageLocation("default").Result(),
+			expectedErr:           false,
+			expectedPhase:         string(velerov1api.RestorePhaseInProgress),
+			expectedStartTime:     &timestamp,
+			expectedCompletedTime: &timestamp,
+			expectedRestorerCall:  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseInProgress).DryRun(true).Result(),
+		},
+		{
+			name:                  "valid restore that renames PVs records the renamed PVs in its status",
+			location:              defaultStorageLocation,
+			restore:               NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).PVRenamePolicy(velerov1api.PVRenamePolicyAlways).Result(),
+			backup:                defaultBackup().StorageLocation("default").Result(),
+			restorerRenamedPVs:    map[string]string{"pv-1": "velero-clone-1"},
+			expectedErr:           false,
+			expectedPhase:         string(velerov1api.RestorePhaseInProgress),
+			expectedStartTime:     &timestamp,
+			expectedCompletedTime: &timestamp,
+			expectedRestorerCall:  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseInProgress).PVRenamePolicy(velerov1api.PVRenamePolicyAlways).Result(),
+		},
+		{
+			name:                     "restore with invalid PV rename policy fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).PVRenamePolicy("Sometimes").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{`Invalid PV rename policy: invalid PV rename policy "Sometimes", must be one of "Always", "OnConflict" or "Never"`},
+		},
 		{
 			name:          "restoration of nodes is not supported",
 			location:      defaultStorageLocation,
@@ -414,7 +551,7 @@ func TestProcessQueueItem(t *testing.T) {
 			var (
 				client          = fake.NewSimpleClientset()
 				fakeClient      = newFakeClient(t)
-				restorer        = &fakeRestorer{}
+				restorer        = &fakeRestorer{renamedPVs: test.restorerRenamedPVs}
 				sharedInformers = informers.NewSharedInformerFactory(client, 0)
 				logger          = velerotest.NewLogger()
 				pluginManager   = &pluginmocks.Manager{}
@@ -436,10 +573,12 @@ func TestProcessQueueItem(t *testing.T) {
 				restorer,
 				sharedInformers.Velero().V1().Backups().Lister(),
 				fakeClient,
+				kubefake.NewSimpleClientset().CoreV1(),
 				sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
 				logger,
 				logrus.InfoLevel,
 				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				nil, // credential file store
 				"default",
 				metrics.NewServerMetrics(),
 				formatFlag,
@@ -519,6 +658,10 @@ func TestProcessQueueItem(t *testing.T) {
 
 				backupStore.On("PutRestoreResults", test.backup.Name, test.restore.Name, mock.Anything).Return(nil)
 
+				if test.restore.Spec.DryRun {
+					backupStore.On("PutRestoreDryRunReport", test.backup.Name, test.restore.Name, mock.Anything).Return(nil)
+				}
+
 				volumeSnapshots := []*volume.Snapshot{
 					{
 						Spec: volume.SnapshotSpec{
@@ -553,6 +696,7 @@ func TestProcessQueueItem(t *testing.T) {
 
 			if test.restore != nil {
 				pluginManager.On("GetRestoreItemActions").Return(nil, nil)
+				pluginManager.On("GetPostRestoreActions").Return(nil, nil)
 				pluginManager.On("CleanupClients")
 			}
 
@@ -572,11 +716,12 @@ func TestProcessQueueItem(t *testing.T) {
 			}
 
 			type StatusPatch struct {
-				Phase               velerov1api.RestorePhase `json:"phase"`
-				ValidationErrors    []string                 `json:"validationErrors"`
-				Errors              int                      `json:"errors"`
-				StartTimestamp      *metav1.Time             `json:"startTimestamp"`
-				CompletionTimestamp *metav1.Time             `json:"completionTimestamp"`
+				Phase                    velerov1api.RestorePhase `json:"phase"`
+				ValidationErrors         []string                 `json:"validationErrors"`
+				Errors                   int                      `json:"errors"`
+				StartTimestamp           *metav1.Time             `json:"startTimestamp"`
+				CompletionTimestamp      *metav1.Time             `json:"completionTimestamp"`
+				RenamedPersistentVolumes map[string]string        `json:"renamedPersistentVolumes"`
 			}
 
 			type Patch struct {
@@ -625,18 +770,20 @@ func TestProcessQueueItem(t *testing.T) {
 
 			expected = Patch{
 				Status: StatusPatch{
-					Phase:               velerov1api.RestorePhaseCompleted,
-					Errors:              test.expectedRestoreErrors,
-					CompletionTimestamp: test.expectedCompletedTime,
+					Phase:                    velerov1api.RestorePhaseCompleted,
+					Errors:                   test.expectedRestoreErrors,
+					CompletionTimestamp:      test.expectedCompletedTime,
+					RenamedPersistentVolumes: test.restorerRenamedPVs,
 				},
 			}
 			// Override our default expectations if the case requires it
 			if test.expectedFinalPhase != "" {
 				expected = Patch{
 					Status: StatusPatch{
-						Phase:               velerov1api.RestorePhase(test.expectedFinalPhase),
-						Errors:              test.expectedRestoreErrors,
-						CompletionTimestamp: test.expectedCompletedTime,
+						Phase:                    velerov1api.RestorePhase(test.expectedFinalPhase),
+						Errors:                   test.expectedRestoreErrors,
+						CompletionTimestamp:      test.expectedCompletedTime,
+						RenamedPersistentVolumes: test.restorerRenamedPVs,
 					},
 				}
 			}
@@ -669,10 +816,12 @@ func TestvalidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 		nil,
 		sharedInformers.Velero().V1().Backups().Lister(),
 		nil,
+		nil,
 		sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
 		logger,
 		logrus.DebugLevel,
 		nil,
+		nil, // credential file store
 		"default",
 		nil,
 		formatFlag,
@@ -849,9 +998,92 @@ func NewRestore(ns, name, backup, includeNS, includeResource string, phase veler
 	return restore
 }
 
+func TestRunPostRestoreActions(t *testing.T) {
+	tests := []struct {
+		name             string
+		actions          []*fakePostRestoreAction
+		expectedStatus   *velerov1api.PostRestoreActionStatus
+		expectedWarnings []string
+		expectedErrors   []string
+	}{
+		{
+			name:           "no actions",
+			expectedStatus: &velerov1api.PostRestoreActionStatus{},
+		},
+		{
+			name: "warnings from actions are added to the restore's warnings",
+			actions: []*fakePostRestoreAction{
+				{warnings: []string{"smoke test took 2m"}},
+				{},
+			},
+			expectedStatus:   &velerov1api.PostRestoreActionStatus{ActionsAttempted: 2},
+			expectedWarnings: []string{"existing warning", "smoke test took 2m"},
+			expectedErrors:   []string{"existing error"},
+		},
+		{
+			name: "failed actions are added to the restore's errors and the remaining actions are still run",
+			actions: []*fakePostRestoreAction{
+				{err: errors.New("smoke test failed")},
+				{warnings: []string{"notification was delayed"}},
+			},
+			expectedStatus:   &velerov1api.PostRestoreActionStatus{ActionsAttempted: 2, ActionsFailed: 1},
+			expectedWarnings: []string{"existing warning", "notification was delayed"},
+			expectedErrors:   []string{"existing error", "error running post-restore action: smoke test failed"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			restore := NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseInProgress).Result()
+			backup := defaultBackup().Result()
+			warnings := pkgrestore.Result{Namespaces: map[string][]string{"ns-1": {"existing warning"}}}
+			errs := pkgrestore.Result{Cluster: []string{"existing error"}}
+
+			var actions []velero.PostRestoreAction
+			for _, action := range tc.actions {
+				actions = append(actions, action)
+			}
+
+			status := runPostRestoreActions(restore, backup, actions, &warnings, &errs, velerotest.NewLogger())
+
+			assert.Equal(t, tc.expectedStatus, status)
+			if len(tc.actions) == 0 {
+				return
+			}
+
+			actualWarnings := append(warnings.Namespaces["ns-1"], warnings.Velero...)
+			actualErrors := append(errs.Cluster, errs.Velero...)
+			assert.Equal(t, tc.expectedWarnings, actualWarnings)
+			assert.Equal(t, tc.expectedErrors, actualErrors)
+
+			for _, action := range tc.actions {
+				require.NotNil(t, action.input)
+				assert.Equal(t, 1, action.input.Restore.Status.Warnings)
+				assert.Equal(t, 1, action.input.Restore.Status.Errors)
+				assert.Equal(t, backup, action.input.Backup)
+			}
+		})
+	}
+}
+
+type fakePostRestoreAction struct {
+	warnings []string
+	err      error
+	input    *velero.PostRestoreActionExecuteInput
+}
+
+func (a *fakePostRestoreAction) Execute(input *velero.PostRestoreActionExecuteInput) (*velero.PostRestoreActionExecuteOutput, error) {
+	a.input = input
+	if a.err != nil {
+		return nil, a.err
+	}
+	return &velero.PostRestoreActionExecuteOutput{Warnings: a.warnings}, nil
+}
+
 type fakeRestorer struct {
 	mock.Mock
 	calledWithArg velerov1api.Restore
+	renamedPVs    map[string]string
 }
 
 func (r *fakeRestorer) Restore(
@@ -864,5 +1096,9 @@ func (r *fakeRestorer) Restore(
 
 	r.calledWithArg = *info.Restore
 
+	for oldName, newName := range r.renamedPVs {
+		info.RenamedPVs[oldName] = newName
+	}
+
 	return res.Get(0).(pkgrestore.Result), res.Get(1).(pkgrestore.Result)
 }
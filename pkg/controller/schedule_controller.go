@@ -17,60 +17,91 @@ limitations under the License.
 package controller
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/robfig/cron"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
 const (
 	scheduleSyncPeriod = time.Minute
+
+	// scheduleBackoffBase is how long a schedule's runs are backed off
+	// after a single failed backup. It's doubled for every further
+	// consecutive failure, up to scheduleMaxBackoff.
+	scheduleBackoffBase = 5 * time.Minute
+	scheduleMaxBackoff  = time.Hour
 )
 
 type scheduleController struct {
 	*genericController
 
 	namespace       string
+	clusterName     string
 	schedulesClient velerov1client.SchedulesGetter
 	backupsClient   velerov1client.BackupsGetter
 	schedulesLister velerov1listers.ScheduleLister
+	backupLister    velerov1listers.BackupLister
+	kbClient        client.Client
+	eventRecorder   record.EventRecorder
 	clock           clock.Clock
 	metrics         *metrics.ServerMetrics
 }
 
 func NewScheduleController(
 	namespace string,
+	clusterName string,
 	schedulesClient velerov1client.SchedulesGetter,
 	backupsClient velerov1client.BackupsGetter,
 	schedulesInformer velerov1informers.ScheduleInformer,
+	backupLister velerov1listers.BackupLister,
+	kbClient client.Client,
+	eventRecorder record.EventRecorder,
 	logger logrus.FieldLogger,
 	metrics *metrics.ServerMetrics,
 ) *scheduleController {
 	c := &scheduleController{
 		genericController: newGenericController("schedule", logger),
 		namespace:         namespace,
+		clusterName:       clusterName,
 		schedulesClient:   schedulesClient,
 		backupsClient:     backupsClient,
 		schedulesLister:   schedulesInformer.Lister(),
+		backupLister:      backupLister,
+		kbClient:          kbClient,
+		eventRecorder:     eventRecorder,
 		clock:             clock.RealClock{},
 		metrics:           metrics,
 	}
@@ -106,6 +137,21 @@ func NewScheduleController(
 				//Init Prometheus metrics to 0 to have them flowing up
 				metrics.InitSchedule(scheduleName)
 			},
+			UpdateFunc: func(_, obj interface{}) {
+				schedule := obj.(*api.Schedule)
+
+				// only deletions are handled here, everything else is picked up at resync
+				if schedule.DeletionTimestamp == nil || !hasScheduleBackupsFinalizer(schedule) {
+					return
+				}
+
+				key, err := cache.MetaNamespaceKeyFunc(schedule)
+				if err != nil {
+					c.logger.WithError(errors.WithStack(err)).WithField("schedule", schedule).Error("Error creating queue key, item not added to queue")
+					return
+				}
+				c.queue.Add(key)
+			},
 		},
 	)
 
@@ -120,7 +166,8 @@ func (c *scheduleController) enqueueAllEnabledSchedules() {
 	}
 
 	for _, schedule := range schedules {
-		if schedule.Status.Phase != api.SchedulePhaseEnabled {
+		// schedules that are being deleted are enqueued so that their backups are deleted
+		if schedule.Status.Phase != api.SchedulePhaseEnabled && schedule.DeletionTimestamp == nil {
 			continue
 		}
 
@@ -153,6 +200,18 @@ func (c *scheduleController) processSchedule(key string) error {
 		return errors.Wrap(err, "error getting Schedule")
 	}
 
+	if schedule.DeletionTimestamp != nil {
+		return c.deleteScheduleBackups(schedule, log)
+	}
+
+	if boolptr.IsSetToTrue(schedule.Spec.UseOwnerReferencesInBackup) && !hasScheduleBackupsFinalizer(schedule) {
+		updated := schedule.DeepCopy()
+		updated.Finalizers = append(updated.Finalizers, api.ScheduleBackupsFinalizer)
+		if schedule, err = patchSchedule(schedule, updated, c.schedulesClient); err != nil {
+			return errors.Wrap(err, "error adding finalizer to Schedule")
+		}
+	}
+
 	switch schedule.Status.Phase {
 	case "", api.SchedulePhaseNew, api.SchedulePhaseEnabled:
 		// valid phase for processing
@@ -171,6 +230,21 @@ func (c *scheduleController) processSchedule(key string) error {
 	currentPhase := schedule.Status.Phase
 
 	cronSchedule, errs := parseCronSchedule(schedule, c.logger)
+	switch schedule.Spec.ConcurrencyPolicy {
+	case "", api.ConcurrencyPolicyAllow, api.ConcurrencyPolicyForbid, api.ConcurrencyPolicyReplace:
+	default:
+		errs = append(errs, fmt.Sprintf("invalid concurrency policy %q, must be one of %q, %q or %q", schedule.Spec.ConcurrencyPolicy, api.ConcurrencyPolicyAllow, api.ConcurrencyPolicyForbid, api.ConcurrencyPolicyReplace))
+	}
+	if schedule.Spec.KeepLast < 0 {
+		errs = append(errs, fmt.Sprintf("invalid keepLast %d, must not be negative", schedule.Spec.KeepLast))
+	}
+	if schedule.Spec.Jitter.Duration < 0 {
+		errs = append(errs, fmt.Sprintf("invalid jitter %s, must not be negative", schedule.Spec.Jitter.Duration))
+	}
+	if _, err := backupName(schedule, c.clock.Now(), c.clusterName); err != nil {
+		errs = append(errs, fmt.Sprintf("invalid backup name template: %v", err))
+	}
+	errs = append(errs, validateBlackoutWindows(schedule)...)
 	if len(errs) > 0 {
 		schedule.Status.Phase = api.SchedulePhaseFailedValidation
 		schedule.Status.ValidationErrors = errs
@@ -191,6 +265,11 @@ func (c *scheduleController) processSchedule(key string) error {
 		return nil
 	}
 
+	schedule, err = c.updateConsecutiveFailures(schedule)
+	if err != nil {
+		return err
+	}
+
 	// check for the schedule being due to run, and submit a Backup if so
 	if err := c.submitBackupIfDue(schedule, cronSchedule); err != nil {
 		return err
@@ -199,6 +278,121 @@ func (c *scheduleController) processSchedule(key string) error {
 	return nil
 }
 
+// deleteScheduleBackups deletes the Backups owned by a Schedule that's being deleted,
+// by creating a DeleteBackupRequest for each of them, and removes the Schedule's
+// finalizer once none of them are left to delete. Backups that are in progress can't
+// be deleted until they finish, and are retried at the next resync. Backups whose
+// deletion failed are left to the garbage collector, which only deletes their custom
+// resources.
+func (c *scheduleController) deleteScheduleBackups(schedule *api.Schedule, log logrus.FieldLogger) error {
+	if !hasScheduleBackupsFinalizer(schedule) {
+		return nil
+	}
+
+	// the schedule's dependents are orphaned, so its backups are kept
+	orphan := false
+	for _, finalizer := range schedule.Finalizers {
+		if finalizer == metav1.FinalizerOrphanDependents {
+			orphan = true
+		}
+	}
+
+	pending := false
+	if !orphan {
+		backups, err := c.backupLister.Backups(schedule.Namespace).List(labels.SelectorFromSet(labels.Set{api.ScheduleNameLabel: schedule.Name}))
+		if err != nil {
+			return errors.Wrap(err, "error listing Backups")
+		}
+
+		for _, backup := range backups {
+			if !isOwnedBySchedule(backup, schedule) {
+				continue
+			}
+
+			backupLog := log.WithField("backup", kubeutil.NamespaceAndName(backup))
+
+			switch backup.Status.Phase {
+			case "", api.BackupPhaseNew, api.BackupPhaseInProgress:
+				backupLog.Info("Backup of deleted schedule is still in progress, waiting for it to finish before deleting it")
+				pending = true
+				continue
+			}
+
+			requests := new(api.DeleteBackupRequestList)
+			if err := c.kbClient.List(context.Background(), requests, client.InNamespace(backup.Namespace), client.MatchingLabels{
+				api.BackupNameLabel: label.GetValidName(backup.Name),
+				api.BackupUIDLabel:  string(backup.UID),
+			}); err != nil {
+				return errors.Wrap(err, "error listing DeleteBackupRequests")
+			}
+
+			requested, processed, failed := false, false, false
+			for _, request := range requests.Items {
+				switch request.Status.Phase {
+				case "", api.DeleteBackupRequestPhaseNew, api.DeleteBackupRequestPhaseInProgress:
+					requested = true
+				case api.DeleteBackupRequestPhaseProcessed:
+					processed = true
+					failed = failed || len(request.Status.Errors) > 0
+				}
+			}
+
+			switch {
+			case requested:
+				pending = true
+				continue
+			case processed:
+				if failed {
+					backupLog.Warn("Backup of deleted schedule couldn't be deleted, see its DeleteBackupRequest for details")
+				}
+				continue
+			}
+
+			backupLog.Info("Creating a DeleteBackupRequest for backup of deleted schedule")
+			if err := c.kbClient.Create(context.Background(), pkgbackup.NewDeleteBackupRequest(backup.Name, string(backup.UID))); err != nil {
+				return errors.Wrap(err, "error creating DeleteBackupRequest")
+			}
+			pending = true
+		}
+	}
+
+	if pending {
+		return nil
+	}
+
+	updated := schedule.DeepCopy()
+	updated.Finalizers = nil
+	for _, finalizer := range schedule.Finalizers {
+		if finalizer != api.ScheduleBackupsFinalizer {
+			updated.Finalizers = append(updated.Finalizers, finalizer)
+		}
+	}
+	if _, err := patchSchedule(schedule, updated, c.schedulesClient); err != nil {
+		return errors.Wrap(err, "error removing finalizer from Schedule")
+	}
+
+	return nil
+}
+
+func hasScheduleBackupsFinalizer(schedule *api.Schedule) bool {
+	for _, finalizer := range schedule.Finalizers {
+		if finalizer == api.ScheduleBackupsFinalizer {
+			return true
+		}
+	}
+	return false
+}
+
+// isOwnedBySchedule returns whether the backup has an owner reference to the schedule.
+func isOwnedBySchedule(backup *api.Backup, schedule *api.Schedule) bool {
+	for _, ref := range backup.OwnerReferences {
+		if ref.Kind == "Schedule" && ref.UID == schedule.UID {
+			return true
+		}
+	}
+	return false
+}
+
 func parseCronSchedule(itm *api.Schedule, logger logrus.FieldLogger) (cron.Schedule, []string) {
 	var validationErrors []string
 	var schedule cron.Schedule
@@ -232,6 +426,10 @@ func parseCronSchedule(itm *api.Schedule, logger logrus.FieldLogger) (cron.Sched
 		}
 	}()
 
+	if _, err := scheduleLocation(itm); err != nil {
+		validationErrors = append(validationErrors, fmt.Sprintf("invalid timezone: %v", err))
+	}
+
 	if len(validationErrors) > 0 {
 		return nil, validationErrors
 	}
@@ -239,6 +437,80 @@ func parseCronSchedule(itm *api.Schedule, logger logrus.FieldLogger) (cron.Sched
 	return schedule, nil
 }
 
+// validateBlackoutWindows returns validation errors for the schedule's blackout windows,
+// each of which needs either a Cron expression and a duration, or a start and an end time.
+func validateBlackoutWindows(schedule *api.Schedule) []string {
+	var errs []string
+
+	for i, window := range schedule.Spec.BlackoutWindows {
+		switch {
+		case window.Schedule != "" && (window.Start != nil || window.End != nil):
+			errs = append(errs, fmt.Sprintf("invalid blackout window %d: must have either a schedule or a start and end, not both", i))
+		case window.Schedule != "":
+			if _, err := cron.ParseStandard(window.Schedule); err != nil {
+				errs = append(errs, fmt.Sprintf("invalid blackout window %d: invalid schedule: %v", i, err))
+			}
+			if window.Duration.Duration <= 0 {
+				errs = append(errs, fmt.Sprintf("invalid blackout window %d: duration must be positive", i))
+			}
+		case window.Start != nil && window.End != nil:
+			if !window.End.After(window.Start.Time) {
+				errs = append(errs, fmt.Sprintf("invalid blackout window %d: end must be after start", i))
+			}
+		default:
+			errs = append(errs, fmt.Sprintf("invalid blackout window %d: must have either a schedule and duration, or a start and end", i))
+		}
+	}
+
+	return errs
+}
+
+// inBlackoutWindow returns whether the given time is in one of the schedule's blackout windows.
+func inBlackoutWindow(schedule *api.Schedule, t time.Time) bool {
+	if location, err := scheduleLocation(schedule); err == nil {
+		t = t.In(location)
+	}
+
+	for _, window := range schedule.Spec.BlackoutWindows {
+		if window.Schedule == "" {
+			if window.Start != nil && window.End != nil && !t.Before(window.Start.Time) && t.Before(window.End.Time) {
+				return true
+			}
+			continue
+		}
+
+		cronSchedule, err := cron.ParseStandard(window.Schedule)
+		if err != nil {
+			continue
+		}
+
+		// the time is in a recurring window if the window started within
+		// its duration before the time.
+		if start := cronSchedule.Next(t.Add(-window.Duration.Duration)); !start.After(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// scheduleLocation returns the time zone that the schedule's cron expression is
+// evaluated in, which is UTC unless the schedule specifies a time zone.
+func scheduleLocation(schedule *api.Schedule) (*time.Location, error) {
+	// the server's local time zone isn't something users can rely on, so
+	// only time zones with IANA names are supported.
+	if schedule.Spec.Timezone == "Local" {
+		return nil, errors.Errorf("unknown time zone %s", schedule.Spec.Timezone)
+	}
+
+	location, err := time.LoadLocation(schedule.Spec.Timezone)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return location, nil
+}
+
 func (c *scheduleController) submitBackupIfDue(item *api.Schedule, cronSchedule cron.Schedule) error {
 	var (
 		now                = c.clock.Now()
@@ -251,15 +523,69 @@ func (c *scheduleController) submitBackupIfDue(item *api.Schedule, cronSchedule
 		return nil
 	}
 
+	if item.Spec.Paused {
+		log.WithField("nextRunTime", nextRunTime).Info("Schedule is paused, skipping Backup")
+		return c.recordSkippedBackup(item, now)
+	}
+
+	if inBlackoutWindow(item, now) {
+		log.WithField("nextRunTime", nextRunTime).Info("Schedule is in a blackout window, skipping Backup")
+		return c.recordSkippedBackup(item, now)
+	}
+
+	if policy := item.Spec.ConcurrencyPolicy; policy == api.ConcurrencyPolicyForbid || policy == api.ConcurrencyPolicyReplace {
+		unfinished, err := c.unfinishedBackups(item)
+		if err != nil {
+			return err
+		}
+
+		if policy == api.ConcurrencyPolicyForbid && len(unfinished) > 0 {
+			log.WithField("nextRunTime", nextRunTime).Infof("Schedule's previous Backup %s hasn't finished, skipping Backup", unfinished[0].Name)
+			return c.recordSkippedBackup(item, now)
+		}
+
+		for _, backup := range unfinished {
+			// backups that are in progress can't be stopped, so only the
+			// ones that haven't started yet are replaced.
+			if backup.Status.Phase != "" && backup.Status.Phase != api.BackupPhaseNew {
+				continue
+			}
+
+			log.Infof("Deleting Backup %s that hasn't started yet so that it's replaced", backup.Name)
+			if err := c.backupsClient.Backups(backup.Namespace).Delete(context.TODO(), backup.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return errors.Wrapf(err, "error deleting Backup %s", backup.Name)
+			}
+		}
+	}
+
 	// Don't attempt to "catch up" if there are any missed or failed runs - simply
-	// trigger a Backup if it's time.
-	//
-	// It might also make sense in the future to explicitly check for currently-running
-	// backups so that we don't overlap runs (for disk snapshots in particular, this can
-	// lead to performance issues).
+	// trigger a Backup if it's time. Overlapping runs can be avoided by setting
+	// the schedule's concurrency policy.
 	log.WithField("nextRunTime", nextRunTime).Info("Schedule is due, submitting Backup")
-	backup := getBackup(item, now)
-	if _, err := c.backupsClient.Backups(backup.Namespace).Create(context.TODO(), backup, metav1.CreateOptions{}); err != nil {
+	backup, err := getBackup(item, now, c.clusterName)
+	if err != nil {
+		return err
+	}
+	if item.Spec.Policy != "" {
+		policy := new(api.BackupPolicy)
+		if err := c.kbClient.Get(context.Background(), client.ObjectKey{Name: item.Spec.Policy}, policy); err != nil {
+			return errors.Wrapf(err, "error getting BackupPolicy %s", item.Spec.Policy)
+		}
+		backup.Spec = mergeBackupSpecs(policy.Spec.Template, item.Spec.Template)
+	}
+	_, err = c.backupsClient.Backups(backup.Namespace).Create(context.TODO(), backup, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		// the schedule's backup name template doesn't generate a unique name for each
+		// backup, e.g. because its timestamp is less precise than the schedule's interval,
+		// so the time the backup was triggered at is appended to the name rather than
+		// failing every time until the template is fixed.
+		name := fmt.Sprintf("%s-%s", backup.Name, now.UTC().Format("20060102150405"))
+		log.Warnf("Backup %s already exists, submitting Backup as %s instead", backup.Name, name)
+		backup = backup.DeepCopy()
+		backup.Name = name
+		_, err = c.backupsClient.Backups(backup.Namespace).Create(context.TODO(), backup, metav1.CreateOptions{})
+	}
+	if err != nil {
 		return errors.Wrap(err, "error creating Backup")
 	}
 
@@ -275,27 +601,258 @@ func (c *scheduleController) submitBackupIfDue(item *api.Schedule, cronSchedule
 	return nil
 }
 
+// recordSkippedBackup updates the schedule's LastSkipped time to now, for a
+// backup that was due but wasn't triggered.
+func (c *scheduleController) recordSkippedBackup(item *api.Schedule, now time.Time) error {
+	original := item
+	schedule := item.DeepCopy()
+
+	schedule.Status.LastSkipped = &metav1.Time{Time: now}
+
+	if _, err := patchSchedule(original, schedule, c.schedulesClient); err != nil {
+		return errors.Wrapf(err, "error updating Schedule's LastSkipped time to %v", schedule.Status.LastSkipped)
+	}
+
+	return nil
+}
+
+// unfinishedBackups returns the backups that the schedule triggered that haven't
+// finished, i.e. that are new, in progress, or waiting for their item operations.
+func (c *scheduleController) unfinishedBackups(item *api.Schedule) ([]api.Backup, error) {
+	selector := labels.SelectorFromSet(labels.Set{api.ScheduleNameLabel: item.Name})
+	backups, err := c.backupsClient.Backups(item.Namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing Schedule's Backups")
+	}
+
+	var unfinished []api.Backup
+	for _, backup := range backups.Items {
+		switch backup.Status.Phase {
+		case "", api.BackupPhaseNew, api.BackupPhaseInProgress, api.BackupPhaseWaitingForPluginOperations:
+			unfinished = append(unfinished, backup)
+		}
+	}
+
+	return unfinished, nil
+}
+
+// updateConsecutiveFailures records the number of the schedule's most recent finished
+// backups that failed in a row in its status and metrics, and records an event for the
+// schedule when a backup fails or the schedule's backups stop failing.
+func (c *scheduleController) updateConsecutiveFailures(schedule *api.Schedule) (*api.Schedule, error) {
+	failures, lastFailed, err := c.consecutiveFailures(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	c.metrics.SetScheduleConsecutiveFailures(schedule.Name, failures)
+
+	if failures == schedule.Status.ConsecutiveFailures {
+		return schedule, nil
+	}
+
+	if failures > schedule.Status.ConsecutiveFailures {
+		c.eventRecorder.Eventf(schedule, corev1api.EventTypeWarning, "BackupFailed", "Backup %s failed; the schedule's last %d backups failed", lastFailed.Name, failures)
+	} else if failures == 0 {
+		c.eventRecorder.Eventf(schedule, corev1api.EventTypeNormal, "BackupRecovered", "Backup completed after %d failed backups", schedule.Status.ConsecutiveFailures)
+	}
+
+	updated := schedule.DeepCopy()
+	updated.Status.ConsecutiveFailures = failures
+
+	patched, err := patchSchedule(schedule, updated, c.schedulesClient)
+	if err != nil {
+		return nil, errors.Wrap(err, "error updating Schedule's consecutive failures")
+	}
+
+	return patched, nil
+}
+
+// consecutiveFailures returns the number of the schedule's most recent finished backups
+// that failed in a row, and the most recent of them. Partially failed backups break the
+// run, since they still back up most of what the schedule covers.
+func (c *scheduleController) consecutiveFailures(schedule *api.Schedule) (int, *api.Backup, error) {
+	selector := labels.SelectorFromSet(labels.Set{api.ScheduleNameLabel: schedule.Name})
+	backups, err := c.backupLister.Backups(schedule.Namespace).List(selector)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "error listing Schedule's Backups")
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		return createdAfter(backups[i], backups[j])
+	})
+
+	var (
+		failures   int
+		lastFailed *api.Backup
+	)
+	for _, backup := range backups {
+		switch backup.Status.Phase {
+		case api.BackupPhaseFailed, api.BackupPhaseFailedValidation:
+			if lastFailed == nil {
+				lastFailed = backup
+			}
+			failures++
+		case api.BackupPhaseCompleted, api.BackupPhasePartiallyFailed:
+			return failures, lastFailed, nil
+		}
+	}
+
+	return failures, lastFailed, nil
+}
+
+// scheduleBackoff returns how long the schedule's runs are backed off after its last
+// backup, given the number of its backups that failed in a row.
+func scheduleBackoff(schedule *api.Schedule) time.Duration {
+	if schedule.Status.ConsecutiveFailures <= 0 {
+		return 0
+	}
+
+	backoff := scheduleBackoffBase
+	for i := 1; i < schedule.Status.ConsecutiveFailures && backoff < scheduleMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > scheduleMaxBackoff {
+		backoff = scheduleMaxBackoff
+	}
+
+	return backoff
+}
+
 func getNextRunTime(schedule *api.Schedule, cronSchedule cron.Schedule, asOf time.Time) (bool, time.Time) {
 	// get the latest run time (if the schedule hasn't run yet, this will be the zero value which will trigger
-	// an immediate backup)
+	// an immediate backup). Runs that were skipped because the schedule was paused count as runs, so that
+	// unpausing a schedule doesn't immediately trigger a backup.
 	var lastBackupTime time.Time
 	if schedule.Status.LastBackup != nil {
 		lastBackupTime = schedule.Status.LastBackup.Time
 	}
+	if schedule.Status.LastSkipped != nil && schedule.Status.LastSkipped.Time.After(lastBackupTime) {
+		lastBackupTime = schedule.Status.LastSkipped.Time
+	}
 
-	nextRunTime := cronSchedule.Next(lastBackupTime)
+	// while the schedule's backups keep failing, its next run is the first one
+	// after the backoff period, so that a misconfigured schedule doesn't keep
+	// triggering backups that fail.
+	if backoff := scheduleBackoff(schedule); backoff > 0 && schedule.Status.LastBackup != nil {
+		if backoffUntil := schedule.Status.LastBackup.Add(backoff); backoffUntil.After(lastBackupTime) {
+			lastBackupTime = backoffUntil
+		}
+	}
+
+	// the cron schedule is evaluated in the location of the time it's given,
+	// so this makes schedules honor their time zone, including daylight saving
+	// time changes.
+	if location, err := scheduleLocation(schedule); err == nil {
+		lastBackupTime = lastBackupTime.In(location)
+	}
+
+	// the last run was delayed by the schedule's jitter offset, so the offset is
+	// removed before evaluating the cron schedule and added back to the result.
+	// This keeps the interval between runs the same as without jitter.
+	jitter := scheduleJitter(schedule)
+	nextRunTime := cronSchedule.Next(lastBackupTime.Add(-jitter)).Add(jitter)
 
 	return asOf.After(nextRunTime), nextRunTime
 }
 
-func getBackup(item *api.Schedule, timestamp time.Time) *api.Backup {
-	name := item.TimestampedName(timestamp)
+// scheduleJitter returns the offset within the schedule's jitter window that its
+// runs are delayed by. It's derived from the schedule's namespace and name, so it's
+// stable across resyncs and controller restarts, but differs between schedules. It's
+// truncated to whole seconds, since that's the precision the last backup time is
+// stored with.
+func scheduleJitter(schedule *api.Schedule) time.Duration {
+	if schedule.Spec.Jitter.Duration <= 0 {
+		return 0
+	}
+
+	hash := fnv.New64a()
+	hash.Write([]byte(kubeutil.NamespaceAndName(schedule)))
+
+	return time.Duration(hash.Sum64() % uint64(schedule.Spec.Jitter.Duration)).Truncate(time.Second)
+}
+
+func getBackup(item *api.Schedule, timestamp time.Time, clusterName string) (*api.Backup, error) {
+	name, err := backupName(item, timestamp, clusterName)
+	if err != nil {
+		return nil, errors.Wrap(err, "error generating Backup name")
+	}
+
 	backup := builder.
 		ForBackup(item.Namespace, name).
 		FromSchedule(item).
 		Result()
 
-	return backup
+	return backup, nil
+}
+
+// mergeBackupSpecs returns the spec of the backups of a schedule that references a
+// backup policy: the policy's template, with each setting that's set in the schedule's
+// own template overriding the policy's.
+func mergeBackupSpecs(policy, schedule api.BackupSpec) api.BackupSpec {
+	merged := policy.DeepCopy()
+
+	mergedValue := reflect.ValueOf(merged).Elem()
+	scheduleValue := reflect.ValueOf(schedule.DeepCopy()).Elem()
+	for i := 0; i < scheduleValue.NumField(); i++ {
+		if field := scheduleValue.Field(i); !field.IsZero() {
+			mergedValue.Field(i).Set(field)
+		}
+	}
+
+	return *merged
+}
+
+// backupNameTemplateData is the data that a schedule's backup name template is executed with.
+type backupNameTemplateData struct {
+	ScheduleName string
+	Namespace    string
+	ClusterName  string
+
+	time time.Time
+}
+
+// Timestamp returns the time that the backup was triggered at, in the schedule's time zone,
+// formatted with the given Go time layout.
+func (d backupNameTemplateData) Timestamp(layout string) string {
+	return d.time.Format(layout)
+}
+
+// backupName returns the name of the schedule's backup that's triggered at the given time.
+// It's generated from the schedule's backup name template if it has one, and is otherwise
+// the schedule's name followed by the timestamp.
+func backupName(item *api.Schedule, timestamp time.Time, clusterName string) (string, error) {
+	if item.Spec.BackupNameTemplate == "" {
+		return item.TimestampedName(timestamp), nil
+	}
+
+	tmpl, err := template.New("backupName").Parse(item.Spec.BackupNameTemplate)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	if location, err := scheduleLocation(item); err == nil {
+		timestamp = timestamp.In(location)
+	}
+
+	data := backupNameTemplateData{
+		ScheduleName: item.Name,
+		Namespace:    item.Namespace,
+		ClusterName:  clusterName,
+		time:         timestamp,
+	}
+
+	buf := new(bytes.Buffer)
+	if err := tmpl.Execute(buf, data); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	name := buf.String()
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return "", errors.Errorf("%q isn't a valid backup name: %s", name, strings.Join(errs, "; "))
+	}
+
+	return name, nil
 }
 
 func patchSchedule(original, updated *api.Schedule, client velerov1client.SchedulesGetter) (*api.Schedule, error) {
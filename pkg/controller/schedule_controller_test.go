@@ -17,7 +17,10 @@ limitations under the License.
 package controller
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -28,15 +31,19 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/validation"
 	core "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 )
 
 func TestProcessSchedule(t *testing.T) {
@@ -53,7 +60,16 @@ func TestProcessSchedule(t *testing.T) {
 		expectedPhase            string
 		expectedValidationErrors []string
 		expectedBackupCreate     *velerov1api.Backup
+		expectedConflictCreate   *velerov1api.Backup
 		expectedLastBackup       string
+		expectedLastSkipped      string
+		existingBackups          []*velerov1api.Backup
+		existingPolicies         []runtime.Object
+		expectedBackupList       bool
+		expectedBackupDelete     string
+		expectedFailuresPatch    bool
+		expectedFailures         int
+		expectedEvent            string
 	}{
 		{
 			name:        "invalid key returns error",
@@ -91,6 +107,13 @@ func TestProcessSchedule(t *testing.T) {
 			expectedPhase:            string(velerov1api.SchedulePhaseFailedValidation),
 			expectedValidationErrors: []string{"Schedule must be a non-empty valid Cron expression"},
 		},
+		{
+			name:                     "schedule with an invalid timezone gets validated and failed",
+			schedule:                 newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").Timezone("Mars/Olympus_Mons").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{"invalid timezone: unknown time zone Mars/Olympus_Mons"},
+		},
 		{
 			name:                 "schedule with phase New gets validated and triggers a backup",
 			schedule:             newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").Result(),
@@ -116,6 +139,157 @@ func TestProcessSchedule(t *testing.T) {
 			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
 			expectedLastBackup:   "2017-01-01 12:00:00",
 		},
+		{
+			name:          "schedule whose backup name template generated an existing name triggers a backup with the time appended",
+			schedule:      newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").BackupNameTemplate(`{{.ScheduleName}}-{{.Timestamp "20060102"}}`).Result(),
+			fakeClockTime: "2017-01-01 12:00:00",
+			existingBackups: []*velerov1api.Backup{
+				builder.ForBackup("ns", "name-20170101").Result(),
+			},
+			expectedConflictCreate: builder.ForBackup("ns", "name-20170101").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
+			expectedBackupCreate:   builder.ForBackup("ns", "name-20170101-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:     "2017-01-01 12:00:00",
+		},
+		{
+			name:                "paused schedule doesn't trigger a backup and gets LastSkipped updated",
+			schedule:            newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").Paused(true).LastBackupTime("2000-01-01 00:00:00").Result(),
+			fakeClockTime:       "2017-01-01 12:00:00",
+			expectedErr:         false,
+			expectedLastSkipped: "2017-01-01 12:00:00",
+		},
+		{
+			name:                     "schedule with an invalid concurrency policy gets validated and failed",
+			schedule:                 newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").ConcurrencyPolicy("Sometimes").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{`invalid concurrency policy "Sometimes", must be one of "Allow", "Forbid" or "Replace"`},
+		},
+		{
+			name:          "schedule whose last backups failed records its consecutive failures and backs off",
+			schedule:      newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2017-01-01 11:00:00").Result(),
+			fakeClockTime: "2017-01-01 11:08:00",
+			existingBackups: []*velerov1api.Backup{
+				builder.ForBackup("ns", "name-20170101110000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Phase(velerov1api.BackupPhaseFailed).Result(),
+				builder.ForBackup("ns", "name-20170101105500").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Phase(velerov1api.BackupPhaseFailed).Result(),
+				builder.ForBackup("ns", "name-20170101105000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Phase(velerov1api.BackupPhaseCompleted).Result(),
+				builder.ForBackup("ns", "name-20170101104500").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Phase(velerov1api.BackupPhaseFailed).Result(),
+			},
+			expectedFailuresPatch: true,
+			expectedFailures:      2,
+			expectedEvent:         "Warning BackupFailed Backup name-20170101110000 failed; the schedule's last 2 backups failed",
+		},
+		{
+			name:          "schedule whose backups stop failing resets its consecutive failures",
+			schedule:      newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").ConsecutiveFailures(2).LastBackupTime("2017-01-01 11:00:00").Result(),
+			fakeClockTime: "2017-01-01 11:03:00",
+			existingBackups: []*velerov1api.Backup{
+				builder.ForBackup("ns", "name-20170101110000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Phase(velerov1api.BackupPhaseCompleted).Result(),
+				builder.ForBackup("ns", "name-20170101105500").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Phase(velerov1api.BackupPhaseFailed).Result(),
+			},
+			expectedFailuresPatch: true,
+			expectedFailures:      0,
+			expectedEvent:         "Normal BackupRecovered Backup completed after 2 failed backups",
+		},
+		{
+			name:                     "schedule with a negative keepLast gets validated and failed",
+			schedule:                 newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").KeepLast(-1).Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{"invalid keepLast -1, must not be negative"},
+		},
+		{
+			name:                     "schedule with a backup name template that generates an invalid name gets validated and failed",
+			schedule:                 newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").BackupNameTemplate("{{.ScheduleName}}_backup").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{fmt.Sprintf(`invalid backup name template: "name_backup" isn't a valid backup name: %s`, strings.Join(validation.IsDNS1123Subdomain("name_backup"), "; "))},
+		},
+		{
+			name: "schedule with invalid blackout windows gets validated and failed",
+			schedule: newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").BlackoutWindows(
+				velerov1api.BlackoutWindow{Schedule: "0 0 * * 6"},
+				velerov1api.BlackoutWindow{Start: &metav1.Time{Time: parseTime("2017-01-01 12:00:00")}},
+				velerov1api.BlackoutWindow{Start: &metav1.Time{Time: parseTime("2017-01-01 12:00:00")}, End: &metav1.Time{Time: parseTime("2017-01-01 11:00:00")}},
+			).Result(),
+			expectedErr:   false,
+			expectedPhase: string(velerov1api.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{
+				"invalid blackout window 0: duration must be positive",
+				"invalid blackout window 1: must have either a schedule and duration, or a start and end",
+				"invalid blackout window 2: end must be after start",
+			},
+		},
+		{
+			name: "schedule in a blackout window skips the backup and records when it was due",
+			schedule: newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").BlackoutWindows(
+				velerov1api.BlackoutWindow{Start: &metav1.Time{Time: parseTime("2017-01-01 11:30:00")}, End: &metav1.Time{Time: parseTime("2017-01-01 12:30:00")}},
+			).Result(),
+			fakeClockTime:       "2017-01-01 12:00:00",
+			expectedLastSkipped: "2017-01-01 12:00:00",
+		},
+		{
+			name:                     "schedule with a negative jitter gets validated and failed",
+			schedule:                 newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").Jitter(-time.Minute).Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{"invalid jitter -1m0s, must not be negative"},
+		},
+		{
+			name:          "schedule with concurrency policy Forbid skips a backup while its previous backup is in progress",
+			schedule:      newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").ConcurrencyPolicy(velerov1api.ConcurrencyPolicyForbid).LastBackupTime("2000-01-01 00:00:00").Result(),
+			fakeClockTime: "2017-01-01 12:00:00",
+			existingBackups: []*velerov1api.Backup{
+				builder.ForBackup("ns", "name-20000101000000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Phase(velerov1api.BackupPhaseInProgress).Result(),
+			},
+			expectedErr:         false,
+			expectedBackupList:  true,
+			expectedLastSkipped: "2017-01-01 12:00:00",
+		},
+		{
+			name:          "schedule with concurrency policy Forbid triggers a backup when its previous backup has finished",
+			schedule:      newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").ConcurrencyPolicy(velerov1api.ConcurrencyPolicyForbid).LastBackupTime("2000-01-01 00:00:00").Result(),
+			fakeClockTime: "2017-01-01 12:00:00",
+			existingBackups: []*velerov1api.Backup{
+				builder.ForBackup("ns", "name-20000101000000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Phase(velerov1api.BackupPhaseCompleted).Result(),
+				builder.ForBackup("ns", "other-20000101000000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "other")).Phase(velerov1api.BackupPhaseInProgress).Result(),
+			},
+			expectedErr:          false,
+			expectedBackupList:   true,
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 12:00:00",
+		},
+		{
+			name:          "schedule with concurrency policy Replace deletes its previous backup that hasn't started",
+			schedule:      newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").ConcurrencyPolicy(velerov1api.ConcurrencyPolicyReplace).LastBackupTime("2000-01-01 00:00:00").Result(),
+			fakeClockTime: "2017-01-01 12:00:00",
+			existingBackups: []*velerov1api.Backup{
+				builder.ForBackup("ns", "name-20000101000000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Phase(velerov1api.BackupPhaseNew).Result(),
+			},
+			expectedErr:          false,
+			expectedBackupList:   true,
+			expectedBackupDelete: "name-20000101000000",
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 12:00:00",
+		},
+		{
+			name: "schedule with a policy triggers a backup with the policy's settings and its own overrides",
+			schedule: newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").Policy("policy-1").
+				Template(builder.ForBackup("", "").ExcludedNamespaces("kube-system").Result().Spec).LastBackupTime("2000-01-01 00:00:00").Result(),
+			existingPolicies: []runtime.Object{
+				builder.ForBackupPolicy("policy-1").Template(builder.ForBackup("", "").StorageLocation("loc-1").TTL(time.Hour).ExcludedNamespaces("velero").Result().Spec).Result(),
+			},
+			fakeClockTime: "2017-01-01 12:00:00",
+			expectedErr:   false,
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).
+				StorageLocation("loc-1").TTL(time.Hour).ExcludedNamespaces("kube-system").Result(),
+			expectedLastBackup: "2017-01-01 12:00:00",
+		},
+		{
+			name:          "schedule with a policy that doesn't exist returns an error and doesn't trigger a backup",
+			schedule:      newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").Policy("policy-1").LastBackupTime("2000-01-01 00:00:00").Result(),
+			fakeClockTime: "2017-01-01 12:00:00",
+			expectedErr:   true,
+		},
 	}
 
 	for _, test := range tests {
@@ -124,13 +298,18 @@ func TestProcessSchedule(t *testing.T) {
 				client          = fake.NewSimpleClientset()
 				sharedInformers = informers.NewSharedInformerFactory(client, 0)
 				logger          = velerotest.NewLogger()
+				recorder        = record.NewFakeRecorder(10)
 			)
 
 			c := NewScheduleController(
 				"namespace",
+				"cluster-1",
 				client.VeleroV1(),
 				client.VeleroV1(),
 				sharedInformers.Velero().V1().Schedules(),
+				sharedInformers.Velero().V1().Backups().Lister(),
+				newFakeClient(t, test.existingPolicies...),
+				recorder,
 				logger,
 				metrics.NewServerMetrics(),
 			)
@@ -145,6 +324,11 @@ func TestProcessSchedule(t *testing.T) {
 			}
 			c.clock = clock.NewFakeClock(testTime)
 
+			for _, backup := range test.existingBackups {
+				require.NoError(t, client.Tracker().Add(backup))
+				require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+			}
+
 			if test.schedule != nil {
 				sharedInformers.Velero().V1().Schedules().Informer().GetStore().Add(test.schedule)
 
@@ -177,6 +361,13 @@ func TestProcessSchedule(t *testing.T) {
 						res.Status.LastBackup = &metav1.Time{Time: parsed}
 					}
 
+					consecutiveFailures, found, err := unstructured.NestedFieldNoCopy(patchMap, "status", "consecutiveFailures")
+					if err == nil && found {
+						// the field is null when it's reset to zero
+						failures, _ := consecutiveFailures.(float64)
+						res.Status.ConsecutiveFailures = int(failures)
+					}
+
 					return true, res, nil
 				})
 			}
@@ -198,6 +389,9 @@ func TestProcessSchedule(t *testing.T) {
 				ValidationErrors []string                  `json:"validationErrors"`
 				Phase            velerov1api.SchedulePhase `json:"phase"`
 				LastBackup       time.Time                 `json:"lastBackup"`
+				LastSkipped      time.Time                 `json:"lastSkipped"`
+
+				ConsecutiveFailures int `json:"consecutiveFailures"`
 			}
 
 			type Patch struct {
@@ -226,6 +420,54 @@ func TestProcessSchedule(t *testing.T) {
 				index++
 			}
 
+			if test.expectedFailuresPatch {
+				require.True(t, len(actions) > index, "len(actions) is too small")
+
+				expected := Patch{
+					Status: PatchStatus{
+						ConsecutiveFailures: test.expectedFailures,
+					},
+				}
+
+				velerotest.ValidatePatch(t, actions[index], expected, decode)
+
+				index++
+			}
+
+			if test.expectedBackupList {
+				require.True(t, len(actions) > index, "len(actions) is too small")
+				assert.Equal(t, "list", actions[index].GetVerb())
+				assert.Equal(t, "backups", actions[index].GetResource().Resource)
+
+				index++
+			}
+
+			if test.expectedBackupDelete != "" {
+				require.True(t, len(actions) > index, "len(actions) is too small")
+
+				action := core.NewDeleteAction(
+					velerov1api.SchemeGroupVersion.WithResource("backups"),
+					test.schedule.Namespace,
+					test.expectedBackupDelete)
+
+				assert.Equal(t, action, actions[index])
+
+				index++
+			}
+
+			if created := test.expectedConflictCreate; created != nil {
+				require.True(t, len(actions) > index, "len(actions) is too small")
+
+				action := core.NewCreateAction(
+					velerov1api.SchemeGroupVersion.WithResource("backups"),
+					created.Namespace,
+					created)
+
+				assert.Equal(t, action, actions[index])
+
+				index++
+			}
+
 			if created := test.expectedBackupCreate; created != nil {
 				require.True(t, len(actions) > index, "len(actions) is too small")
 
@@ -250,6 +492,25 @@ func TestProcessSchedule(t *testing.T) {
 
 				velerotest.ValidatePatch(t, actions[index], expected, decode)
 			}
+
+			if test.expectedLastSkipped != "" {
+				require.Len(t, actions, index+1, "a paused schedule should only be patched")
+
+				expected := Patch{
+					Status: PatchStatus{
+						LastSkipped: parseTime(test.expectedLastSkipped),
+					},
+				}
+
+				velerotest.ValidatePatch(t, actions[index], expected, decode)
+			}
+
+			if test.expectedEvent != "" {
+				require.Len(t, recorder.Events, 1)
+				assert.Equal(t, test.expectedEvent, <-recorder.Events)
+			} else {
+				assert.Empty(t, recorder.Events)
+			}
 		})
 	}
 }
@@ -259,6 +520,138 @@ func parseTime(timeString string) time.Time {
 	return res
 }
 
+func TestDeleteScheduleBackups(t *testing.T) {
+	owner := metav1.OwnerReference{
+		APIVersion: "velero.io/v1",
+		Kind:       "Schedule",
+		Name:       "name",
+		UID:        "uid-1",
+		Controller: boolptr.True(),
+	}
+
+	newBackup := func(name string, phase velerov1api.BackupPhase, owners ...metav1.OwnerReference) *velerov1api.Backup {
+		return builder.ForBackup("ns", name).
+			ObjectMeta(
+				builder.WithUID(name+"-uid"),
+				builder.WithLabels(velerov1api.ScheduleNameLabel, "name"),
+				builder.WithOwnerReferences(owners...),
+			).
+			Phase(phase).
+			Result()
+	}
+
+	newDeleteBackupRequest := func(backup string, phase velerov1api.DeleteBackupRequestPhase, errs ...string) *velerov1api.DeleteBackupRequest {
+		req := pkgbackup.NewDeleteBackupRequest(backup, backup+"-uid")
+		req.Namespace = "ns"
+		req.Name = backup + "-request"
+		req.Status.Phase = phase
+		req.Status.Errors = errs
+		return req
+	}
+
+	tests := []struct {
+		name                       string
+		schedule                   *velerov1api.Schedule
+		backups                    []*velerov1api.Backup
+		deleteBackupRequests       []*velerov1api.DeleteBackupRequest
+		expectedFinalizers         []string
+		expectedRequestedDeletions []string
+	}{
+		{
+			name:                       "finished backups owned by the schedule get a delete backup request",
+			schedule:                   builder.ForSchedule("ns", "name").ObjectMeta(builder.WithUID("uid-1"), builder.WithFinalizers(velerov1api.ScheduleBackupsFinalizer), builder.WithDeletionTimestamp(time.Now())).Result(),
+			backups:                    []*velerov1api.Backup{newBackup("backup-1", velerov1api.BackupPhaseCompleted, owner)},
+			expectedFinalizers:         []string{velerov1api.ScheduleBackupsFinalizer},
+			expectedRequestedDeletions: []string{"backup-1"},
+		},
+		{
+			name:               "backups in progress aren't deleted until they finish",
+			schedule:           builder.ForSchedule("ns", "name").ObjectMeta(builder.WithUID("uid-1"), builder.WithFinalizers(velerov1api.ScheduleBackupsFinalizer), builder.WithDeletionTimestamp(time.Now())).Result(),
+			backups:            []*velerov1api.Backup{newBackup("backup-1", velerov1api.BackupPhaseInProgress, owner)},
+			expectedFinalizers: []string{velerov1api.ScheduleBackupsFinalizer},
+		},
+		{
+			name:                       "backups with a pending delete backup request don't get another one",
+			schedule:                   builder.ForSchedule("ns", "name").ObjectMeta(builder.WithUID("uid-1"), builder.WithFinalizers(velerov1api.ScheduleBackupsFinalizer), builder.WithDeletionTimestamp(time.Now())).Result(),
+			backups:                    []*velerov1api.Backup{newBackup("backup-1", velerov1api.BackupPhaseCompleted, owner)},
+			deleteBackupRequests:       []*velerov1api.DeleteBackupRequest{newDeleteBackupRequest("backup-1", velerov1api.DeleteBackupRequestPhaseInProgress)},
+			expectedFinalizers:         []string{velerov1api.ScheduleBackupsFinalizer},
+			expectedRequestedDeletions: []string{"backup-1"},
+		},
+		{
+			name:                       "finalizer is removed when the deletion of the remaining backups failed",
+			schedule:                   builder.ForSchedule("ns", "name").ObjectMeta(builder.WithUID("uid-1"), builder.WithFinalizers(velerov1api.ScheduleBackupsFinalizer), builder.WithDeletionTimestamp(time.Now())).Result(),
+			backups:                    []*velerov1api.Backup{newBackup("backup-1", velerov1api.BackupPhaseCompleted, owner)},
+			deleteBackupRequests:       []*velerov1api.DeleteBackupRequest{newDeleteBackupRequest("backup-1", velerov1api.DeleteBackupRequestPhaseProcessed, "error deleting backup")},
+			expectedRequestedDeletions: []string{"backup-1"},
+		},
+		{
+			name:     "finalizer is removed and backups that aren't owned by the schedule are kept",
+			schedule: builder.ForSchedule("ns", "name").ObjectMeta(builder.WithUID("uid-1"), builder.WithFinalizers(velerov1api.ScheduleBackupsFinalizer), builder.WithDeletionTimestamp(time.Now())).Result(),
+			backups:  []*velerov1api.Backup{newBackup("backup-1", velerov1api.BackupPhaseCompleted)},
+		},
+		{
+			name:               "backups are kept when the schedule's dependents are orphaned",
+			schedule:           builder.ForSchedule("ns", "name").ObjectMeta(builder.WithUID("uid-1"), builder.WithFinalizers(metav1.FinalizerOrphanDependents, velerov1api.ScheduleBackupsFinalizer), builder.WithDeletionTimestamp(time.Now())).Result(),
+			backups:            []*velerov1api.Backup{newBackup("backup-1", velerov1api.BackupPhaseCompleted, owner)},
+			expectedFinalizers: []string{metav1.FinalizerOrphanDependents},
+		},
+		{
+			name:               "finalizer is added to schedules that use owner references in their backups",
+			schedule:           builder.ForSchedule("ns", "name").Phase(velerov1api.SchedulePhaseNew).ObjectMeta(builder.WithUID("uid-1")).UseOwnerReferencesInBackup(true).Result(),
+			expectedFinalizers: []string{velerov1api.ScheduleBackupsFinalizer},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset(test.schedule)
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+				initObjs        []runtime.Object
+			)
+
+			for _, req := range test.deleteBackupRequests {
+				initObjs = append(initObjs, req)
+			}
+			kbClient := newFakeClient(t, initObjs...)
+
+			c := NewScheduleController(
+				"ns",
+				"cluster-1",
+				client.VeleroV1(),
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().Schedules(),
+				sharedInformers.Velero().V1().Backups().Lister(),
+				kbClient,
+				record.NewFakeRecorder(10),
+				velerotest.NewLogger(),
+				metrics.NewServerMetrics(),
+			)
+
+			require.NoError(t, sharedInformers.Velero().V1().Schedules().Informer().GetStore().Add(test.schedule))
+			for _, backup := range test.backups {
+				require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+			}
+
+			require.NoError(t, c.processSchedule("ns/name"))
+
+			schedule, err := client.VeleroV1().Schedules("ns").Get(context.TODO(), "name", metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedFinalizers, schedule.Finalizers)
+
+			requests := new(velerov1api.DeleteBackupRequestList)
+			require.NoError(t, kbClient.List(context.TODO(), requests))
+
+			var requestedDeletions []string
+			for _, req := range requests.Items {
+				requestedDeletions = append(requestedDeletions, req.Spec.BackupName)
+			}
+			assert.Equal(t, test.expectedRequestedDeletions, requestedDeletions)
+		})
+	}
+}
+
 func TestGetNextRunTime(t *testing.T) {
 	defaultSchedule := func() *velerov1api.Schedule {
 		return builder.ForSchedule("velero", "schedule-1").CronSchedule("@every 5m").Result()
@@ -387,12 +780,193 @@ func TestParseCronSchedule(t *testing.T) {
 	assert.Equal(t, time.Date(2017, 8, 12, 9, 0, 0, 0, time.UTC), next)
 }
 
+func TestGetNextRunTimeAfterSkippedRun(t *testing.T) {
+	s := builder.ForSchedule("velero", "schedule-1").CronSchedule("0 9 * * *").LastBackupTime("2017-08-10 09:00:00").Result()
+	s.Status.LastSkipped = &metav1.Time{Time: time.Date(2017, 8, 11, 9, 0, 0, 0, time.UTC)}
+
+	c, errs := parseCronSchedule(s, velerotest.NewLogger())
+	require.Empty(t, errs)
+
+	// the skipped run counts as a run, so the schedule isn't due until the day after it
+	due, next := getNextRunTime(s, c, time.Date(2017, 8, 11, 12, 0, 0, 0, time.UTC))
+	assert.False(t, due)
+	assert.Equal(t, time.Date(2017, 8, 12, 9, 0, 0, 0, time.UTC), next)
+}
+
+func TestScheduleBackoff(t *testing.T) {
+	tests := []struct {
+		failures int
+		expected time.Duration
+	}{
+		{failures: 0, expected: 0},
+		{failures: 1, expected: 5 * time.Minute},
+		{failures: 2, expected: 10 * time.Minute},
+		{failures: 3, expected: 20 * time.Minute},
+		{failures: 4, expected: 40 * time.Minute},
+		{failures: 5, expected: time.Hour},
+		{failures: 100, expected: time.Hour},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%d failures", test.failures), func(t *testing.T) {
+			s := builder.ForSchedule("velero", "schedule-1").ConsecutiveFailures(test.failures).Result()
+			assert.Equal(t, test.expected, scheduleBackoff(s))
+		})
+	}
+}
+
+func TestGetNextRunTimeWithBackoff(t *testing.T) {
+	// Start with a Schedule with:
+	// - schedule: every 5 minutes
+	// - last backup: 2017-08-10 09:00:00, which is the third failed backup in a row
+	s := builder.ForSchedule("velero", "schedule-1").CronSchedule("*/5 * * * *").ConsecutiveFailures(3).LastBackupTime("2017-08-10 09:00:00").Result()
+
+	c, errs := parseCronSchedule(s, velerotest.NewLogger())
+	require.Empty(t, errs)
+
+	// the next backup is the first one after the 20 minute backoff
+	due, next := getNextRunTime(s, c, time.Date(2017, 8, 10, 9, 10, 0, 0, time.UTC))
+	assert.False(t, due)
+	assert.Equal(t, time.Date(2017, 8, 10, 9, 25, 0, 0, time.UTC), next)
+
+	// schedules whose backups aren't failing aren't backed off
+	s.Status.ConsecutiveFailures = 0
+	due, next = getNextRunTime(s, c, time.Date(2017, 8, 10, 9, 10, 0, 0, time.UTC))
+	assert.True(t, due)
+	assert.Equal(t, time.Date(2017, 8, 10, 9, 5, 0, 0, time.UTC), next)
+}
+
+func TestInBlackoutWindow(t *testing.T) {
+	// 2017-01-07 is a Saturday
+	saturdayMornings := velerov1api.BlackoutWindow{Schedule: "0 0 * * 6", Duration: metav1.Duration{Duration: 8 * time.Hour}}
+
+	tests := []struct {
+		name     string
+		schedule *velerov1api.Schedule
+		time     string
+		expected bool
+	}{
+		{
+			name:     "schedule without blackout windows",
+			schedule: builder.ForSchedule("velero", "schedule-1").Result(),
+			time:     "2017-01-07 01:00:00",
+			expected: false,
+		},
+		{
+			name:     "time at the start of a recurring window",
+			schedule: builder.ForSchedule("velero", "schedule-1").BlackoutWindows(saturdayMornings).Result(),
+			time:     "2017-01-07 00:00:00",
+			expected: true,
+		},
+		{
+			name:     "time within a recurring window",
+			schedule: builder.ForSchedule("velero", "schedule-1").BlackoutWindows(saturdayMornings).Result(),
+			time:     "2017-01-07 07:59:00",
+			expected: true,
+		},
+		{
+			name:     "time at the end of a recurring window",
+			schedule: builder.ForSchedule("velero", "schedule-1").BlackoutWindows(saturdayMornings).Result(),
+			time:     "2017-01-07 08:00:00",
+			expected: false,
+		},
+		{
+			name:     "time before a recurring window",
+			schedule: builder.ForSchedule("velero", "schedule-1").BlackoutWindows(saturdayMornings).Result(),
+			time:     "2017-01-06 23:59:00",
+			expected: false,
+		},
+		{
+			name:     "recurring window is evaluated in the schedule's time zone",
+			schedule: builder.ForSchedule("velero", "schedule-1").Timezone("America/New_York").BlackoutWindows(saturdayMornings).Result(),
+			time:     "2017-01-07 12:59:00",
+			expected: true,
+		},
+		{
+			name:     "time within a single window",
+			schedule: builder.ForSchedule("velero", "schedule-1").BlackoutWindows(velerov1api.BlackoutWindow{Start: &metav1.Time{Time: parseTime("2017-03-31 00:00:00")}, End: &metav1.Time{Time: parseTime("2017-04-02 00:00:00")}}).Result(),
+			time:     "2017-04-01 12:00:00",
+			expected: true,
+		},
+		{
+			name:     "time after a single window",
+			schedule: builder.ForSchedule("velero", "schedule-1").BlackoutWindows(velerov1api.BlackoutWindow{Start: &metav1.Time{Time: parseTime("2017-03-31 00:00:00")}, End: &metav1.Time{Time: parseTime("2017-04-02 00:00:00")}}).Result(),
+			time:     "2017-04-02 00:00:00",
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, inBlackoutWindow(test.schedule, parseTime(test.time)))
+		})
+	}
+}
+
+func TestGetNextRunTimeWithJitter(t *testing.T) {
+	// Start with a Schedule with:
+	// - schedule: once a day at 9am, spread over a 10 minute window
+	// - last backup: 2017-08-10 09:06:30, which is 9am delayed by the schedule's jitter offset
+	s := builder.ForSchedule("velero", "schedule-1").CronSchedule("0 9 * * *").Jitter(10 * time.Minute).LastBackupTime("2017-08-10 09:06:30").Result()
+
+	c, errs := parseCronSchedule(s, velerotest.NewLogger())
+	require.Empty(t, errs)
+
+	jitter := scheduleJitter(s)
+	assert.Equal(t, 6*time.Minute+30*time.Second, jitter)
+
+	// the offset differs between schedules, and is stable for each schedule
+	other := builder.ForSchedule("velero", "schedule-2").Jitter(10 * time.Minute).Result()
+	assert.NotEqual(t, jitter, scheduleJitter(other))
+	assert.Equal(t, jitter, scheduleJitter(s))
+
+	// make sure we're not due at 9am, and the next backup is tomorrow at 9am delayed by the offset
+	due, next := getNextRunTime(s, c, time.Date(2017, 8, 11, 9, 0, 0, 0, time.UTC))
+	assert.False(t, due)
+	assert.Equal(t, time.Date(2017, 8, 11, 9, 6, 30, 0, time.UTC), next)
+
+	due, next = getNextRunTime(s, c, time.Date(2017, 8, 11, 9, 7, 0, 0, time.UTC))
+	assert.True(t, due)
+	assert.Equal(t, time.Date(2017, 8, 11, 9, 6, 30, 0, time.UTC), next)
+
+	// schedules without jitter aren't delayed
+	s.Spec.Jitter.Duration = 0
+	assert.Equal(t, time.Duration(0), scheduleJitter(s))
+}
+
+func TestGetNextRunTimeWithTimezone(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// Start with a Schedule with:
+	// - schedule: once a day at 2am New York time
+	// - last backup: 2020-10-31 02:00:00 EDT, the day before daylight saving time ends
+	s := builder.ForSchedule("velero", "schedule-1").CronSchedule("0 2 * * *").Timezone("America/New_York").Result()
+	s.Status.LastBackup = &metav1.Time{Time: time.Date(2020, 10, 31, 6, 0, 0, 0, time.UTC)}
+
+	c, errs := parseCronSchedule(s, velerotest.NewLogger())
+	require.Empty(t, errs)
+
+	// make sure the next backup is at 2am EST, which is 7am rather than 6am UTC
+	due, next := getNextRunTime(s, c, time.Date(2020, 11, 1, 6, 30, 0, 0, time.UTC))
+	assert.False(t, due)
+	assert.True(t, time.Date(2020, 11, 1, 2, 0, 0, 0, newYork).Equal(next))
+	assert.True(t, time.Date(2020, 11, 1, 7, 0, 0, 0, time.UTC).Equal(next))
+
+	// the schedule is evaluated in UTC when it doesn't have a time zone
+	s.Spec.Timezone = ""
+	due, next = getNextRunTime(s, c, time.Date(2020, 11, 1, 6, 30, 0, 0, time.UTC))
+	assert.True(t, due)
+	assert.True(t, time.Date(2020, 11, 1, 2, 0, 0, 0, time.UTC).Equal(next))
+}
+
 func TestGetBackup(t *testing.T) {
 	tests := []struct {
 		name           string
 		schedule       *velerov1api.Schedule
 		testClockTime  string
 		expectedBackup *velerov1api.Backup
+		expectedErr    bool
 	}{
 		{
 			name:           "ensure name is formatted correctly (AM time)",
@@ -436,6 +1010,53 @@ func TestGetBackup(t *testing.T) {
 			testClockTime:  "2017-07-25 14:15:00",
 			expectedBackup: builder.ForBackup("foo", "bar-20170725141500").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "bar", "bar", "baz", "foo", "bar")).Result(),
 		},
+		{
+			name:          "ensure owner reference to schedule is added when enabled",
+			schedule:      builder.ForSchedule("foo", "bar").ObjectMeta(builder.WithUID("uid-1")).UseOwnerReferencesInBackup(true).Result(),
+			testClockTime: "2017-07-25 14:15:00",
+			expectedBackup: builder.ForBackup("foo", "bar-20170725141500").
+				ObjectMeta(
+					builder.WithLabels(velerov1api.ScheduleNameLabel, "bar"),
+					builder.WithOwnerReferences(metav1.OwnerReference{
+						APIVersion: "velero.io/v1",
+						Kind:       "Schedule",
+						Name:       "bar",
+						UID:        "uid-1",
+						Controller: boolptr.True(),
+					}),
+				).
+				Result(),
+		},
+		{
+			name:           "ensure owner reference to schedule is not added when disabled",
+			schedule:       builder.ForSchedule("foo", "bar").ObjectMeta(builder.WithUID("uid-1")).UseOwnerReferencesInBackup(false).Result(),
+			testClockTime:  "2017-07-25 14:15:00",
+			expectedBackup: builder.ForBackup("foo", "bar-20170725141500").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "bar")).Result(),
+		},
+		{
+			name:           "ensure name is generated from the backup name template",
+			schedule:       builder.ForSchedule("foo", "bar").BackupNameTemplate(`{{.ScheduleName}}-{{.Timestamp "20060102"}}-{{.ClusterName}}`).Result(),
+			testClockTime:  "2017-07-25 14:15:00",
+			expectedBackup: builder.ForBackup("foo", "bar-20170725-cluster-1").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "bar")).Result(),
+		},
+		{
+			name:           "ensure backup name template timestamp is in the schedule's time zone",
+			schedule:       builder.ForSchedule("foo", "bar").Timezone("America/New_York").BackupNameTemplate(`{{.Namespace}}-{{.ScheduleName}}-{{.Timestamp "2006010215"}}`).Result(),
+			testClockTime:  "2017-07-25 02:15:00",
+			expectedBackup: builder.ForBackup("foo", "foo-bar-2017072422").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "bar")).Result(),
+		},
+		{
+			name:          "backup name template that generates an invalid name returns an error",
+			schedule:      builder.ForSchedule("foo", "bar").BackupNameTemplate(`{{.ScheduleName}}_{{.ClusterName}}`).Result(),
+			testClockTime: "2017-07-25 14:15:00",
+			expectedErr:   true,
+		},
+		{
+			name:          "backup name template with an unknown field returns an error",
+			schedule:      builder.ForSchedule("foo", "bar").BackupNameTemplate(`{{.ScheduleName}}-{{.Unknown}}`).Result(),
+			testClockTime: "2017-07-25 14:15:00",
+			expectedErr:   true,
+		},
 	}
 
 	for _, test := range tests {
@@ -443,12 +1064,52 @@ func TestGetBackup(t *testing.T) {
 			testTime, err := time.Parse("2006-01-02 15:04:05", test.testClockTime)
 			require.NoError(t, err, "unable to parse test.testClockTime: %v", err)
 
-			backup := getBackup(test.schedule, clock.NewFakeClock(testTime).Now())
+			backup, err := getBackup(test.schedule, clock.NewFakeClock(testTime).Now(), "cluster-1")
+			if test.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
 
 			assert.Equal(t, test.expectedBackup.Namespace, backup.Namespace)
 			assert.Equal(t, test.expectedBackup.Name, backup.Name)
 			assert.Equal(t, test.expectedBackup.Labels, backup.Labels)
+			assert.Equal(t, test.expectedBackup.OwnerReferences, backup.OwnerReferences)
 			assert.Equal(t, test.expectedBackup.Spec, backup.Spec)
 		})
 	}
 }
+
+func TestMergeBackupSpecs(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   velerov1api.BackupSpec
+		schedule velerov1api.BackupSpec
+		expected velerov1api.BackupSpec
+	}{
+		{
+			name:     "empty schedule template inherits all of the policy's settings",
+			policy:   builder.ForBackup("", "").IncludedNamespaces("ns-1").StorageLocation("loc-1").TTL(time.Hour).SnapshotVolumes(false).Result().Spec,
+			schedule: velerov1api.BackupSpec{},
+			expected: builder.ForBackup("", "").IncludedNamespaces("ns-1").StorageLocation("loc-1").TTL(time.Hour).SnapshotVolumes(false).Result().Spec,
+		},
+		{
+			name:     "settings in the schedule template override the policy's",
+			policy:   builder.ForBackup("", "").IncludedNamespaces("ns-1").ExcludedResources("secrets").StorageLocation("loc-1").TTL(time.Hour).Result().Spec,
+			schedule: builder.ForBackup("", "").IncludedNamespaces("ns-2", "ns-3").TTL(2 * time.Hour).Result().Spec,
+			expected: builder.ForBackup("", "").IncludedNamespaces("ns-2", "ns-3").ExcludedResources("secrets").StorageLocation("loc-1").TTL(2 * time.Hour).Result().Spec,
+		},
+		{
+			name:     "explicitly disabling a setting in the schedule template overrides the policy",
+			policy:   builder.ForBackup("", "").SnapshotVolumes(true).Result().Spec,
+			schedule: builder.ForBackup("", "").SnapshotVolumes(false).Result().Spec,
+			expected: builder.ForBackup("", "").SnapshotVolumes(false).Result().Spec,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, mergeBackupSpecs(test.policy, test.schedule))
+		})
+	}
+}
@@ -26,6 +26,14 @@ type BackupListerExpansion interface{}
 // BackupNamespaceLister.
 type BackupNamespaceListerExpansion interface{}
 
+// BackupRepositoryListerExpansion allows custom methods to be added to
+// BackupRepositoryLister.
+type BackupRepositoryListerExpansion interface{}
+
+// BackupRepositoryNamespaceListerExpansion allows custom methods to be added to
+// BackupRepositoryNamespaceLister.
+type BackupRepositoryNamespaceListerExpansion interface{}
+
 // BackupStorageLocationListerExpansion allows custom methods to be added to
 // BackupStorageLocationLister.
 type BackupStorageLocationListerExpansion interface{}
@@ -25,7 +25,62 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// The types of volume that the restic daemonset can keep its repositories' caches in.
+const (
+	ResticCacheVolumeTypeEmptyDir = "emptyDir"
+	ResticCacheVolumeTypeHostPath = "hostPath"
+	ResticCacheVolumeTypePVC      = "pvc"
+)
+
+// resticNodeOS is the operating system of the nodes that a restic daemonset runs on, and
+// the paths of its pods' volumes on them.
+type resticNodeOS struct {
+	// name is the name of the daemonset.
+	name string
+	// os is the value of the nodes' kubernetes.io/os label.
+	os string
+	// kubeletPodsDir is the kubelet's pods directory on the nodes.
+	kubeletPodsDir string
+	// rootDir and separator make up the paths of the container's volume mounts.
+	rootDir   string
+	separator string
+}
+
+var (
+	resticLinux = resticNodeOS{
+		name:           "restic",
+		os:             "linux",
+		kubeletPodsDir: "/var/lib/kubelet/pods",
+		rootDir:        "/",
+		separator:      "/",
+	}
+
+	resticWindows = resticNodeOS{
+		name:           "restic-windows",
+		os:             "windows",
+		kubeletPodsDir: `C:\var\lib\kubelet\pods`,
+		rootDir:        `C:\`,
+		separator:      `\`,
+	}
+)
+
+// path returns the path in the container of the given elements.
+func (o resticNodeOS) path(elem ...string) string {
+	return o.rootDir + strings.Join(elem, o.separator)
+}
+
+// DaemonSet returns the restic daemonset, which runs on Linux nodes.
 func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
+	return resticDaemonSet(namespace, resticLinux, opts...)
+}
+
+// WindowsDaemonSet returns the restic daemonset that runs on Windows nodes, so that the
+// volumes of Windows pods can be backed up and restored.
+func WindowsDaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
+	return resticDaemonSet(namespace, resticWindows, opts...)
+}
+
+func resticDaemonSet(namespace string, nodeOS resticNodeOS, opts ...podTemplateOption) *appsv1.DaemonSet {
 	c := &podTemplateConfig{
 		image: DefaultImage,
 	}
@@ -34,8 +89,13 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 		opt(c)
 	}
 
+	image := c.image
+	if nodeOS == resticWindows && c.resticWindowsImage != "" {
+		image = c.resticWindowsImage
+	}
+
 	pullPolicy := corev1.PullAlways
-	imageParts := strings.Split(c.image, ":")
+	imageParts := strings.Split(image, ":")
 	if len(imageParts) == 2 && imageParts[1] != "latest" {
 		pullPolicy = corev1.PullIfNotPresent
 
@@ -48,12 +108,24 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 	if len(c.features) > 0 {
 		resticArgs = append(resticArgs, fmt.Sprintf("--features=%s", strings.Join(c.features, ",")))
 	}
+	resticArgs = append(resticArgs, repositoryKeyProviderArgs(c)...)
 
-	userID := int64(0)
-	mountPropagationMode := corev1.MountPropagationHostToContainer
+	// Windows containers don't have user IDs or mount propagation, and run as the container's
+	// administrator to be able to read the volumes of the node's pods.
+	securityContext := &corev1.PodSecurityContext{}
+	var mountPropagation *corev1.MountPropagationMode
+	if nodeOS == resticWindows {
+		userName := "ContainerAdministrator"
+		securityContext.WindowsOptions = &corev1.WindowsSecurityContextOptions{RunAsUserName: &userName}
+	} else {
+		userID := int64(0)
+		securityContext.RunAsUser = &userID
+		mountPropagationMode := corev1.MountPropagationHostToContainer
+		mountPropagation = &mountPropagationMode
+	}
 
 	daemonSet := &appsv1.DaemonSet{
-		ObjectMeta: objectMeta(namespace, "restic"),
+		ObjectMeta: objectMeta(namespace, nodeOS.name),
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "DaemonSet",
 			APIVersion: appsv1.SchemeGroupVersion.String(),
@@ -61,28 +133,29 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 		Spec: appsv1.DaemonSetSpec{
 			Selector: &metav1.LabelSelector{
 				MatchLabels: map[string]string{
-					"name": "restic",
+					"name": nodeOS.name,
 				},
 			},
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: map[string]string{
-						"name":      "restic",
+					Labels: podLabels(c.labels, map[string]string{
+						"name":      nodeOS.name,
 						"component": "velero",
-					},
+					}),
 					Annotations: c.annotations,
 				},
 				Spec: corev1.PodSpec{
 					ServiceAccountName: "velero",
-					SecurityContext: &corev1.PodSecurityContext{
-						RunAsUser: &userID,
+					SecurityContext:    securityContext,
+					NodeSelector: map[string]string{
+						"kubernetes.io/os": nodeOS.os,
 					},
 					Volumes: []corev1.Volume{
 						{
 							Name: "host-pods",
 							VolumeSource: corev1.VolumeSource{
 								HostPath: &corev1.HostPathVolumeSource{
-									Path: "/var/lib/kubelet/pods",
+									Path: nodeOS.kubeletPodsDir,
 								},
 							},
 						},
@@ -96,7 +169,7 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 					Containers: []corev1.Container{
 						{
 							Name:            "restic",
-							Image:           c.image,
+							Image:           image,
 							ImagePullPolicy: pullPolicy,
 							Command: []string{
 								"/velero",
@@ -106,12 +179,12 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 							VolumeMounts: []corev1.VolumeMount{
 								{
 									Name:             "host-pods",
-									MountPath:        "/host_pods",
-									MountPropagation: &mountPropagationMode,
+									MountPath:        nodeOS.path("host_pods"),
+									MountPropagation: mountPropagation,
 								},
 								{
 									Name:      "scratch",
-									MountPath: "/scratch",
+									MountPath: nodeOS.path("scratch"),
 								},
 							},
 							Env: []corev1.EnvVar{
@@ -133,7 +206,7 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 								},
 								{
 									Name:  "VELERO_SCRATCH_DIR",
-									Value: "/scratch",
+									Value: nodeOS.path("scratch"),
 								},
 							},
 							Resources: c.resources,
@@ -144,6 +217,56 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 		},
 	}
 
+	// Windows containers can't be privileged.
+	if c.privilegedRestic && nodeOS != resticWindows {
+		privileged := true
+		daemonSet.Spec.Template.Spec.Containers[0].SecurityContext = &corev1.SecurityContext{
+			Privileged: &privileged,
+		}
+	}
+
+	if c.resticCacheVolumeType != "" {
+		var volumeSource corev1.VolumeSource
+		switch c.resticCacheVolumeType {
+		case ResticCacheVolumeTypeHostPath:
+			volumeSource.HostPath = &corev1.HostPathVolumeSource{Path: c.resticCacheVolumeSource}
+		case ResticCacheVolumeTypePVC:
+			volumeSource.PersistentVolumeClaim = &corev1.PersistentVolumeClaimVolumeSource{ClaimName: c.resticCacheVolumeSource}
+		default:
+			volumeSource.EmptyDir = new(corev1.EmptyDirVolumeSource)
+		}
+
+		daemonSet.Spec.Template.Spec.Volumes = append(
+			daemonSet.Spec.Template.Spec.Volumes,
+			corev1.Volume{
+				Name:         "restic-cache",
+				VolumeSource: volumeSource,
+			},
+		)
+
+		daemonSet.Spec.Template.Spec.Containers[0].VolumeMounts = append(
+			daemonSet.Spec.Template.Spec.Containers[0].VolumeMounts,
+			corev1.VolumeMount{
+				Name:      "restic-cache",
+				MountPath: nodeOS.path("restic-cache"),
+			},
+		)
+
+		daemonSet.Spec.Template.Spec.Containers[0].Args = append(daemonSet.Spec.Template.Spec.Containers[0].Args, "--cache-dir="+nodeOS.path("restic-cache"))
+	}
+
+	if c.resticCacheSizeLimit != "" {
+		daemonSet.Spec.Template.Spec.Containers[0].Args = append(daemonSet.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--cache-size-limit=%s", c.resticCacheSizeLimit))
+	}
+
+	if c.resticUploadRateLimit != "" {
+		daemonSet.Spec.Template.Spec.Containers[0].Args = append(daemonSet.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--upload-rate-limit=%s", c.resticUploadRateLimit))
+	}
+
+	if c.resticDownloadRateLimit != "" {
+		daemonSet.Spec.Template.Spec.Containers[0].Args = append(daemonSet.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--download-rate-limit=%s", c.resticDownloadRateLimit))
+	}
+
 	if c.withSecret {
 		daemonSet.Spec.Template.Spec.Volumes = append(
 			daemonSet.Spec.Template.Spec.Volumes,
@@ -161,26 +284,26 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 			daemonSet.Spec.Template.Spec.Containers[0].VolumeMounts,
 			corev1.VolumeMount{
 				Name:      "cloud-credentials",
-				MountPath: "/credentials",
+				MountPath: nodeOS.path("credentials"),
 			},
 		)
 
 		daemonSet.Spec.Template.Spec.Containers[0].Env = append(daemonSet.Spec.Template.Spec.Containers[0].Env, []corev1.EnvVar{
 			{
 				Name:  "GOOGLE_APPLICATION_CREDENTIALS",
-				Value: "/credentials/cloud",
+				Value: nodeOS.path("credentials", "cloud"),
 			},
 			{
 				Name:  "AWS_SHARED_CREDENTIALS_FILE",
-				Value: "/credentials/cloud",
+				Value: nodeOS.path("credentials", "cloud"),
 			},
 			{
 				Name:  "AZURE_CREDENTIALS_FILE",
-				Value: "/credentials/cloud",
+				Value: nodeOS.path("credentials", "cloud"),
 			},
 			{
 				Name:  "ALIBABA_CLOUD_CREDENTIALS_FILE",
-				Value: "/credentials/cloud",
+				Value: nodeOS.path("credentials", "cloud"),
 			},
 		}...)
 	}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -28,6 +29,7 @@ func TestDaemonSet(t *testing.T) {
 
 	assert.Equal(t, "restic", ds.Spec.Template.Spec.Containers[0].Name)
 	assert.Equal(t, "velero", ds.ObjectMeta.Namespace)
+	assert.Equal(t, map[string]string{"kubernetes.io/os": "linux"}, ds.Spec.Template.Spec.NodeSelector)
 
 	ds = DaemonSet("velero", WithImage("velero/velero:v0.11"))
 	assert.Equal(t, "velero/velero:v0.11", ds.Spec.Template.Spec.Containers[0].Image)
@@ -37,7 +39,52 @@ func TestDaemonSet(t *testing.T) {
 	assert.Equal(t, 7, len(ds.Spec.Template.Spec.Containers[0].Env))
 	assert.Equal(t, 3, len(ds.Spec.Template.Spec.Volumes))
 
+	ds = DaemonSet("velero", WithPrivilegedRestic())
+	require.NotNil(t, ds.Spec.Template.Spec.Containers[0].SecurityContext)
+	assert.True(t, *ds.Spec.Template.Spec.Containers[0].SecurityContext.Privileged)
+
 	ds = DaemonSet("velero", WithFeatures([]string{"foo,bar,baz"}))
 	assert.Len(t, ds.Spec.Template.Spec.Containers[0].Args, 3)
 	assert.Equal(t, "--features=foo,bar,baz", ds.Spec.Template.Spec.Containers[0].Args[2])
+
+	ds = DaemonSet("velero", WithResticCache(ResticCacheVolumeTypePVC, "restic-cache", "5Gi"))
+	require.Len(t, ds.Spec.Template.Spec.Volumes, 3)
+	require.NotNil(t, ds.Spec.Template.Spec.Volumes[2].PersistentVolumeClaim)
+	assert.Equal(t, "restic-cache", ds.Spec.Template.Spec.Volumes[2].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, "/restic-cache", ds.Spec.Template.Spec.Containers[0].VolumeMounts[2].MountPath)
+	assert.Equal(t, []string{"restic", "server", "--cache-dir=/restic-cache", "--cache-size-limit=5Gi"}, ds.Spec.Template.Spec.Containers[0].Args)
+
+	ds = DaemonSet("velero", WithResticCache(ResticCacheVolumeTypeEmptyDir, "", ""))
+	require.Len(t, ds.Spec.Template.Spec.Volumes, 3)
+	assert.NotNil(t, ds.Spec.Template.Spec.Volumes[2].EmptyDir)
+	assert.Equal(t, []string{"restic", "server", "--cache-dir=/restic-cache"}, ds.Spec.Template.Spec.Containers[0].Args)
+
+	ds = DaemonSet("velero", WithResticRateLimits("50Mi", ""))
+	assert.Equal(t, []string{"restic", "server", "--upload-rate-limit=50Mi"}, ds.Spec.Template.Spec.Containers[0].Args)
+}
+
+func TestWindowsDaemonSet(t *testing.T) {
+	ds := WindowsDaemonSet("velero", WithImage("velero/velero:v0.11"))
+
+	assert.Equal(t, "restic-windows", ds.ObjectMeta.Name)
+	assert.Equal(t, map[string]string{"name": "restic-windows"}, ds.Spec.Selector.MatchLabels)
+	assert.Equal(t, map[string]string{"kubernetes.io/os": "windows"}, ds.Spec.Template.Spec.NodeSelector)
+	assert.Equal(t, "velero/velero:v0.11", ds.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, `C:\var\lib\kubelet\pods`, ds.Spec.Template.Spec.Volumes[0].HostPath.Path)
+	assert.Equal(t, `C:\host_pods`, ds.Spec.Template.Spec.Containers[0].VolumeMounts[0].MountPath)
+	assert.Nil(t, ds.Spec.Template.Spec.Containers[0].VolumeMounts[0].MountPropagation)
+	assert.Nil(t, ds.Spec.Template.Spec.SecurityContext.RunAsUser)
+	require.NotNil(t, ds.Spec.Template.Spec.SecurityContext.WindowsOptions)
+	assert.Equal(t, "ContainerAdministrator", *ds.Spec.Template.Spec.SecurityContext.WindowsOptions.RunAsUserName)
+
+	ds = WindowsDaemonSet("velero", WithImage("velero/velero:v0.11"), WithResticWindowsImage("velero/velero:v0.11-windows"))
+	assert.Equal(t, "velero/velero:v0.11-windows", ds.Spec.Template.Spec.Containers[0].Image)
+
+	ds = WindowsDaemonSet("velero", WithSecret(true), WithPrivilegedRestic())
+	assert.Nil(t, ds.Spec.Template.Spec.Containers[0].SecurityContext)
+	assert.Equal(t, `C:\credentials`, ds.Spec.Template.Spec.Containers[0].VolumeMounts[2].MountPath)
+	assert.Equal(t, `C:\credentials\cloud`, ds.Spec.Template.Spec.Containers[0].Env[3].Value)
+
+	ds = WindowsDaemonSet("velero", WithResticCache(ResticCacheVolumeTypeEmptyDir, "", ""))
+	assert.Equal(t, []string{"restic", "server", `--cache-dir=C:\restic-cache`}, ds.Spec.Template.Spec.Containers[0].Args)
 }
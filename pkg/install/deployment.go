@@ -18,6 +18,7 @@ package install
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -35,12 +36,23 @@ type podTemplateConfig struct {
 	envVars                           []corev1.EnvVar
 	restoreOnly                       bool
 	annotations                       map[string]string
+	labels                            map[string]string
 	resources                         corev1.ResourceRequirements
 	withSecret                        bool
 	defaultResticMaintenanceFrequency time.Duration
 	plugins                           []string
 	features                          []string
-	defaultVolumesToRestic            bool
+	defaultVolumesToFsBackup          bool
+	privilegedRestic                  bool
+	uploaderType                      string
+	resticCacheVolumeType             string
+	resticCacheVolumeSource           string
+	resticCacheSizeLimit              string
+	resticUploadRateLimit             string
+	resticDownloadRateLimit           string
+	resticWindowsImage                string
+	repositoryKeyProvider             string
+	repositoryKeyProviderConfig       map[string]string
 }
 
 func WithImage(image string) podTemplateOption {
@@ -55,6 +67,14 @@ func WithAnnotations(annotations map[string]string) podTemplateOption {
 	}
 }
 
+// WithLabels adds labels to the pod template, such as the label that binds
+// the pod to an Azure identity.
+func WithLabels(labels map[string]string) podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.labels = labels
+	}
+}
+
 func WithEnvFromSecretKey(varName, secret, key string) podTemplateOption {
 	return func(c *podTemplateConfig) {
 		c.envVars = append(c.envVars, corev1.EnvVar{
@@ -108,9 +128,83 @@ func WithFeatures(features []string) podTemplateOption {
 	}
 }
 
-func WithDefaultVolumesToRestic() podTemplateOption {
+func WithDefaultVolumesToFsBackup() podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.defaultVolumesToFsBackup = true
+	}
+}
+
+// WithUploaderType sets the uploader that the Velero server backs up pod volumes with by
+// default.
+func WithUploaderType(uploaderType string) podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.uploaderType = uploaderType
+	}
+}
+
+// WithResticCache keeps the caches of the restic daemonset's repositories in a volume of the
+// given type, one of emptyDir, hostPath or pvc. The source is the host path of a hostPath
+// volume, or the claim name of a pvc volume. If sizeLimit isn't empty, it limits the size of
+// each repository's cache.
+func WithResticCache(volumeType, source, sizeLimit string) podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.resticCacheVolumeType = volumeType
+		c.resticCacheVolumeSource = source
+		c.resticCacheSizeLimit = sizeLimit
+	}
+}
+
+// WithRepositoryKeyProvider sets the key provider that wraps the encryption keys of restic
+// repositories that have their own key, and its configuration.
+func WithRepositoryKeyProvider(name string, config map[string]string) podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.repositoryKeyProvider = name
+		c.repositoryKeyProviderConfig = config
+	}
+}
+
+// repositoryKeyProviderArgs returns the arguments that configure the repository key provider
+// of the Velero server and the restic server, which must be the same.
+func repositoryKeyProviderArgs(c *podTemplateConfig) []string {
+	if c.repositoryKeyProvider == "" {
+		return nil
+	}
+
+	args := []string{fmt.Sprintf("--repository-key-provider=%s", c.repositoryKeyProvider)}
+	if len(c.repositoryKeyProviderConfig) > 0 {
+		var config []string
+		for k, v := range c.repositoryKeyProviderConfig {
+			config = append(config, fmt.Sprintf("%s=%s", k, v))
+		}
+		sort.Strings(config)
+		args = append(args, fmt.Sprintf("--repository-key-provider-config=%s", strings.Join(config, ",")))
+	}
+
+	return args
+}
+
+// WithResticRateLimits limits the rates, in bytes per second, that each node of the restic
+// daemonset uploads and downloads the data of repositories at. Empty limits are unlimited.
+func WithResticRateLimits(uploadLimit, downloadLimit string) podTemplateOption {
 	return func(c *podTemplateConfig) {
-		c.defaultVolumesToRestic = true
+		c.resticUploadRateLimit = uploadLimit
+		c.resticDownloadRateLimit = downloadLimit
+	}
+}
+
+// WithResticWindowsImage sets the image of the restic daemonset that runs on Windows nodes,
+// if it's not the same as the image of the other pods.
+func WithResticWindowsImage(image string) podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.resticWindowsImage = image
+	}
+}
+
+// WithPrivilegedRestic runs the restic container in privileged mode, so that it can
+// read and write the devices of raw block volumes.
+func WithPrivilegedRestic() podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.privilegedRestic = true
 	}
 }
 
@@ -136,11 +230,17 @@ func Deployment(namespace string, opts ...podTemplateOption) *appsv1.Deployment
 		args = append(args, fmt.Sprintf("--features=%s", strings.Join(c.features, ",")))
 	}
 
-	if c.defaultVolumesToRestic {
-		args = append(args, "--default-volumes-to-restic=true")
+	if c.defaultVolumesToFsBackup {
+		args = append(args, "--default-volumes-to-fs-backup=true")
+	}
+
+	if c.uploaderType != "" {
+		args = append(args, fmt.Sprintf("--uploader-type=%s", c.uploaderType))
 	}
 
-	containerLabels := labels()
+	args = append(args, repositoryKeyProviderArgs(c)...)
+
+	containerLabels := podLabels(c.labels, labels())
 	containerLabels["deploy"] = "velero"
 
 	deployment := &appsv1.Deployment{
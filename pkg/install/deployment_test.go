@@ -50,7 +50,22 @@ func TestDeployment(t *testing.T) {
 	assert.Len(t, deploy.Spec.Template.Spec.Containers[0].Args, 2)
 	assert.Equal(t, "--default-restic-prune-frequency=24h0m0s", deploy.Spec.Template.Spec.Containers[0].Args[1])
 
+	deploy = Deployment("velero", WithDefaultVolumesToFsBackup())
+	assert.Len(t, deploy.Spec.Template.Spec.Containers[0].Args, 2)
+	assert.Equal(t, "--default-volumes-to-fs-backup=true", deploy.Spec.Template.Spec.Containers[0].Args[1])
+
 	deploy = Deployment("velero", WithFeatures([]string{"EnableCSI", "foo", "bar", "baz"}))
 	assert.Len(t, deploy.Spec.Template.Spec.Containers[0].Args, 2)
 	assert.Equal(t, "--features=EnableCSI,foo,bar,baz", deploy.Spec.Template.Spec.Containers[0].Args[1])
+
+	deploy = Deployment("velero", WithLabels(map[string]string{"aadpodidbinding": "velero", "deploy": "other"}))
+	assert.Equal(t, "velero", deploy.Spec.Template.Labels["aadpodidbinding"])
+	assert.Equal(t, "velero", deploy.Spec.Template.Labels["deploy"])
+
+	deploy = Deployment("velero", WithRepositoryKeyProvider("aws-kms", map[string]string{"region": "us-east-1", "keyId": "alias/velero"}))
+	assert.Equal(t, []string{
+		"server",
+		"--repository-key-provider=aws-kms",
+		"--repository-key-provider-config=keyId=alias/velero,region=us-east-1",
+	}, deploy.Spec.Template.Spec.Containers[0].Args)
 }
@@ -59,6 +59,19 @@ func labels() map[string]string {
 	}
 }
 
+// podLabels returns the user's labels along with the base labels, which
+// take precedence so that the pods still match their selectors.
+func podLabels(userLabels, base map[string]string) map[string]string {
+	res := make(map[string]string, len(userLabels)+len(base))
+	for k, v := range userLabels {
+		res[k] = v
+	}
+	for k, v := range base {
+		res[k] = v
+	}
+	return res
+}
+
 func podAnnotations(userAnnotations map[string]string) map[string]string {
 	// Use the default annotations as a starting point
 	base := map[string]string{
@@ -154,7 +167,8 @@ func BackupStorageLocation(namespace, provider, bucket, prefix string, config ma
 					CACert: caCert,
 				},
 			},
-			Config: config,
+			Config:  config,
+			Default: true,
 		},
 	}
 }
@@ -207,12 +221,15 @@ type VeleroOptions struct {
 	Bucket                            string
 	Prefix                            string
 	PodAnnotations                    map[string]string
+	PodLabels                         map[string]string
 	ServiceAccountAnnotations         map[string]string
 	VeleroPodResources                corev1.ResourceRequirements
 	ResticPodResources                corev1.ResourceRequirements
 	SecretData                        []byte
 	RestoreOnly                       bool
 	UseRestic                         bool
+	UseResticWindows                  bool
+	ResticWindowsImage                string
 	UseVolumeSnapshots                bool
 	BSLConfig                         map[string]string
 	VSLConfig                         map[string]string
@@ -220,8 +237,18 @@ type VeleroOptions struct {
 	Plugins                           []string
 	NoDefaultBackupLocation           bool
 	CACertData                        []byte
+	AmbientIdentity                   *velerov1api.AmbientIdentity
 	Features                          []string
-	DefaultVolumesToRestic            bool
+	DefaultVolumesToFsBackup          bool
+	PrivilegedRestic                  bool
+	UploaderType                      string
+	ResticCacheVolumeType             string
+	ResticCacheVolumeSource           string
+	ResticCacheSizeLimit              string
+	ResticUploadRateLimit             string
+	ResticDownloadRateLimit           string
+	RepositoryKeyProvider             string
+	RepositoryKeyProviderConfig       map[string]string
 }
 
 func AllCRDs() *unstructured.UnstructuredList {
@@ -258,12 +285,14 @@ func AllResources(o *VeleroOptions) (*unstructured.UnstructuredList, error) {
 
 	if !o.NoDefaultBackupLocation {
 		bsl := BackupStorageLocation(o.Namespace, o.ProviderName, o.Bucket, o.Prefix, o.BSLConfig, o.CACertData)
+		bsl.Spec.Identity = o.AmbientIdentity
 		appendUnstructured(resources, bsl)
 	}
 
 	// A snapshot location may not be desirable for users relying on restic
 	if o.UseVolumeSnapshots {
 		vsl := VolumeSnapshotLocation(o.Namespace, o.ProviderName, o.VSLConfig)
+		vsl.Spec.Identity = o.AmbientIdentity
 		appendUnstructured(resources, vsl)
 	}
 
@@ -271,6 +300,7 @@ func AllResources(o *VeleroOptions) (*unstructured.UnstructuredList, error) {
 
 	deployOpts := []podTemplateOption{
 		WithAnnotations(o.PodAnnotations),
+		WithLabels(o.PodLabels),
 		WithImage(o.Image),
 		WithResources(o.VeleroPodResources),
 		WithSecret(secretPresent),
@@ -289,8 +319,16 @@ func AllResources(o *VeleroOptions) (*unstructured.UnstructuredList, error) {
 		deployOpts = append(deployOpts, WithPlugins(o.Plugins))
 	}
 
-	if o.DefaultVolumesToRestic {
-		deployOpts = append(deployOpts, WithDefaultVolumesToRestic())
+	if o.DefaultVolumesToFsBackup {
+		deployOpts = append(deployOpts, WithDefaultVolumesToFsBackup())
+	}
+
+	if o.UploaderType != "" {
+		deployOpts = append(deployOpts, WithUploaderType(o.UploaderType))
+	}
+
+	if o.RepositoryKeyProvider != "" {
+		deployOpts = append(deployOpts, WithRepositoryKeyProvider(o.RepositoryKeyProvider, o.RepositoryKeyProviderConfig))
 	}
 
 	deploy := Deployment(o.Namespace, deployOpts...)
@@ -300,6 +338,7 @@ func AllResources(o *VeleroOptions) (*unstructured.UnstructuredList, error) {
 	if o.UseRestic {
 		dsOpts := []podTemplateOption{
 			WithAnnotations(o.PodAnnotations),
+			WithLabels(o.PodLabels),
 			WithImage(o.Image),
 			WithResources(o.ResticPodResources),
 			WithSecret(secretPresent),
@@ -307,8 +346,28 @@ func AllResources(o *VeleroOptions) (*unstructured.UnstructuredList, error) {
 		if len(o.Features) > 0 {
 			dsOpts = append(dsOpts, WithFeatures(o.Features))
 		}
+		if o.PrivilegedRestic {
+			dsOpts = append(dsOpts, WithPrivilegedRestic())
+		}
+		if o.RepositoryKeyProvider != "" {
+			dsOpts = append(dsOpts, WithRepositoryKeyProvider(o.RepositoryKeyProvider, o.RepositoryKeyProviderConfig))
+		}
+		if o.ResticCacheVolumeType != "" || o.ResticCacheSizeLimit != "" {
+			dsOpts = append(dsOpts, WithResticCache(o.ResticCacheVolumeType, o.ResticCacheVolumeSource, o.ResticCacheSizeLimit))
+		}
+		if o.ResticUploadRateLimit != "" || o.ResticDownloadRateLimit != "" {
+			dsOpts = append(dsOpts, WithResticRateLimits(o.ResticUploadRateLimit, o.ResticDownloadRateLimit))
+		}
 		ds := DaemonSet(o.Namespace, dsOpts...)
 		appendUnstructured(resources, ds)
+
+		if o.UseResticWindows {
+			if o.ResticWindowsImage != "" {
+				dsOpts = append(dsOpts, WithResticWindowsImage(o.ResticWindowsImage))
+			}
+			windowsDS := WindowsDaemonSet(o.Namespace, dsOpts...)
+			appendUnstructured(resources, windowsDS)
+		}
 	}
 
 	return resources, nil
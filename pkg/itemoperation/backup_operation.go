@@ -0,0 +1,98 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package itemoperation
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupOperation stores information about a long-running operation on one of a
+// Velero backup's items, like a volume snapshot that's still being uploaded, that
+// continues after the backup's contents have been uploaded.
+type BackupOperation struct {
+	Spec BackupOperationSpec `json:"spec"`
+
+	Status OperationStatus `json:"status"`
+}
+
+type BackupOperationSpec struct {
+	// BackupName is the name of the Velero backup this operation
+	// is associated with.
+	BackupName string `json:"backupName"`
+
+	// BackupUID is the UID of the Velero backup this operation
+	// is associated with.
+	BackupUID string `json:"backupUID"`
+
+	// OperationID identifies the operation among the backup's operations.
+	OperationID string `json:"operationID"`
+
+	// Group is the API group of the item the operation is for.
+	Group string `json:"group,omitempty"`
+
+	// Resource is the resource of the item the operation is for.
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace of the item the operation is for, if
+	// it's namespaced.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the item the operation is for.
+	Name string `json:"name"`
+
+	// VolumeSnapshotLocation is the name of the volume snapshot location whose
+	// volume snapshotter runs the operation, if it's a persistent volume's
+	// snapshot.
+	VolumeSnapshotLocation string `json:"volumeSnapshotLocation,omitempty"`
+
+	// PluginOperationID is the ID that the volume snapshotter assigned to the
+	// operation, if it's a persistent volume's snapshot.
+	PluginOperationID string `json:"pluginOperationID,omitempty"`
+}
+
+type OperationStatus struct {
+	// Phase is the current state of the operation.
+	Phase OperationPhase `json:"phase,omitempty"`
+
+	// Error is the reason the operation failed, if it did.
+	Error string `json:"error,omitempty"`
+
+	// Created records the time the operation was started.
+	Created *metav1.Time `json:"created,omitempty"`
+
+	// Updated records the last time the operation's status was checked.
+	Updated *metav1.Time `json:"updated,omitempty"`
+}
+
+// OperationPhase is the lifecyle phase of a backup item operation.
+type OperationPhase string
+
+const (
+	// OperationPhaseInProgress means the operation hasn't finished yet.
+	OperationPhaseInProgress OperationPhase = "InProgress"
+
+	// OperationPhaseCompleted means the operation finished successfully.
+	OperationPhaseCompleted OperationPhase = "Completed"
+
+	// OperationPhaseFailed means the operation finished unsuccessfully.
+	OperationPhaseFailed OperationPhase = "Failed"
+)
+
+// Done returns whether the operation has finished, successfully or not.
+func (o *BackupOperation) Done() bool {
+	return o.Status.Phase == OperationPhaseCompleted || o.Status.Phase == OperationPhaseFailed
+}
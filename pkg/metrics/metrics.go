@@ -32,6 +32,7 @@ const (
 	resticMetricsNamespace = "restic"
 	//Velero metrics
 	backupTarballSizeBytesGauge   = "backup_tarball_size_bytes"
+	backupVolumeSnapshotBytes     = "backup_volume_snapshot_bytes"
 	backupTotal                   = "backup_total"
 	backupAttemptTotal            = "backup_attempt_total"
 	backupSuccessTotal            = "backup_success_total"
@@ -52,6 +53,17 @@ const (
 	volumeSnapshotAttemptTotal    = "volume_snapshot_attempt_total"
 	volumeSnapshotSuccessTotal    = "volume_snapshot_success_total"
 	volumeSnapshotFailureTotal    = "volume_snapshot_failure_total"
+	scheduleConsecutiveFailures   = "schedule_consecutive_failures"
+
+	backupStorageLocationAvailable   = "backup_storage_location_available"
+	backupStorageLocationStoredBytes = "backup_storage_location_stored_bytes"
+
+	backupRepositoryCheckFailed                  = "backup_repository_check_failed"
+	backupRepositoryLastSuccessfulCheckTimestamp = "backup_repository_last_successful_check_timestamp"
+
+	pluginCallTotal           = "plugin_call_total"
+	pluginCallFailureTotal    = "plugin_call_failure_total"
+	pluginCallDurationSeconds = "plugin_call_duration_seconds"
 
 	// Restic metrics
 	podVolumeBackupEnqueueTotal        = "pod_volume_backup_enqueue_count"
@@ -65,6 +77,11 @@ const (
 	pvbNameLabel         = "pod_volume_backup"
 	scheduleLabel        = "schedule"
 	backupNameLabel      = "backupName"
+	backupLocationLabel  = "backup_location"
+	backupRepoLabel      = "backup_repository"
+	pluginNameLabel      = "plugin_name"
+	pluginKindLabel      = "plugin_kind"
+	pluginMethodLabel    = "method"
 
 	secondsInMinute = 60.0
 )
@@ -81,6 +98,14 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			backupVolumeSnapshotBytes: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      backupVolumeSnapshotBytes,
+					Help:      "Size, in bytes, of the volume snapshots of a backup, as reported by their providers",
+				},
+				[]string{scheduleLabel},
+			),
 			backupLastSuccessfulTimestamp: prometheus.NewGaugeVec(
 				prometheus.GaugeOpts{
 					Namespace: metricNamespace,
@@ -250,6 +275,82 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			scheduleConsecutiveFailures: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      scheduleConsecutiveFailures,
+					Help:      "Number of a schedule's most recent finished backups that failed in a row",
+				},
+				[]string{scheduleLabel},
+			),
+			backupStorageLocationAvailable: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      backupStorageLocationAvailable,
+					Help:      "Whether a backup storage location is available (1) or not (0)",
+				},
+				[]string{backupLocationLabel},
+			),
+			backupStorageLocationStoredBytes: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      backupStorageLocationStoredBytes,
+					Help:      "Total size of the backups in a backup storage location, in bytes",
+				},
+				[]string{backupLocationLabel},
+			),
+			backupRepositoryCheckFailed: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      backupRepositoryCheckFailed,
+					Help:      "Whether the last check of a backup repository's data found errors or failed to run (1) or not (0)",
+				},
+				[]string{backupRepoLabel},
+			),
+			backupRepositoryLastSuccessfulCheckTimestamp: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      backupRepositoryLastSuccessfulCheckTimestamp,
+					Help:      "Last time a check of a backup repository's data found no errors, Unix timestamp in seconds",
+				},
+				[]string{backupRepoLabel},
+			),
+			pluginCallTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      pluginCallTotal,
+					Help:      "Total number of calls to plugins",
+				},
+				[]string{pluginNameLabel, pluginKindLabel, pluginMethodLabel},
+			),
+			pluginCallFailureTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      pluginCallFailureTotal,
+					Help:      "Total number of calls to plugins that returned an error",
+				},
+				[]string{pluginNameLabel, pluginKindLabel, pluginMethodLabel},
+			),
+			pluginCallDurationSeconds: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Namespace: metricNamespace,
+					Name:      pluginCallDurationSeconds,
+					Help:      "Time taken by calls to plugins, in seconds",
+					Buckets: []float64{
+						0.01,
+						0.05,
+						0.1,
+						0.5,
+						1,
+						5,
+						30,
+						toSeconds(1 * time.Minute),
+						toSeconds(5 * time.Minute),
+						toSeconds(15 * time.Minute),
+					},
+				},
+				[]string{pluginNameLabel, pluginKindLabel, pluginMethodLabel},
+			),
 		},
 	}
 }
@@ -361,6 +462,9 @@ func (m *ServerMetrics) InitSchedule(scheduleName string) {
 	if c, ok := m.metrics[volumeSnapshotFailureTotal].(*prometheus.CounterVec); ok {
 		c.WithLabelValues(scheduleName).Add(0)
 	}
+	if g, ok := m.metrics[scheduleConsecutiveFailures].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(scheduleName).Set(0)
+	}
 }
 
 // InitSchedule initializes counter metrics for a node.
@@ -408,6 +512,13 @@ func (m *ServerMetrics) SetBackupTarballSizeBytesGauge(backupSchedule string, si
 	}
 }
 
+// SetBackupVolumeSnapshotBytesGauge records the size, in bytes, of a backup's volume snapshots.
+func (m *ServerMetrics) SetBackupVolumeSnapshotBytesGauge(backupSchedule string, size int64) {
+	if g, ok := m.metrics[backupVolumeSnapshotBytes].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(backupSchedule).Set(float64(size))
+	}
+}
+
 // SetBackupLastSuccessfulTimestamp records the last time a backup ran successfully, Unix timestamp in seconds
 func (m *ServerMetrics) SetBackupLastSuccessfulTimestamp(backupSchedule string, time time.Time) {
 	if g, ok := m.metrics[backupLastSuccessfulTimestamp].(*prometheus.GaugeVec); ok {
@@ -415,6 +526,54 @@ func (m *ServerMetrics) SetBackupLastSuccessfulTimestamp(backupSchedule string,
 	}
 }
 
+// SetScheduleConsecutiveFailures records the number of a schedule's most recent finished backups that failed in a row.
+func (m *ServerMetrics) SetScheduleConsecutiveFailures(scheduleName string, failures int) {
+	if g, ok := m.metrics[scheduleConsecutiveFailures].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(scheduleName).Set(float64(failures))
+	}
+}
+
+// SetBackupStorageLocationsAvailable records whether each of the backup storage locations,
+// keyed by name, is available. Locations that aren't included are no longer reported.
+func (m *ServerMetrics) SetBackupStorageLocationsAvailable(available map[string]bool) {
+	if g, ok := m.metrics[backupStorageLocationAvailable].(*prometheus.GaugeVec); ok {
+		g.Reset()
+		for location, isAvailable := range available {
+			var value float64
+			if isAvailable {
+				value = 1
+			}
+			g.WithLabelValues(location).Set(value)
+		}
+	}
+}
+
+// SetBackupStorageLocationStoredBytes records the total size of the backups in a backup storage location.
+func (m *ServerMetrics) SetBackupStorageLocationStoredBytes(location string, bytes int64) {
+	if g, ok := m.metrics[backupStorageLocationStoredBytes].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(location).Set(float64(bytes))
+	}
+}
+
+// SetBackupRepositoryCheckFailed records whether the last check of a backup repository's data failed.
+func (m *ServerMetrics) SetBackupRepositoryCheckFailed(repo string, failed bool) {
+	if g, ok := m.metrics[backupRepositoryCheckFailed].(*prometheus.GaugeVec); ok {
+		var value float64
+		if failed {
+			value = 1
+		}
+		g.WithLabelValues(repo).Set(value)
+	}
+}
+
+// SetBackupRepositoryLastSuccessfulCheckTimestamp records the last time a check of a backup repository's
+// data found no errors, Unix timestamp in seconds
+func (m *ServerMetrics) SetBackupRepositoryLastSuccessfulCheckTimestamp(repo string, time time.Time) {
+	if g, ok := m.metrics[backupRepositoryLastSuccessfulCheckTimestamp].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(repo).Set(float64(time.Unix()))
+	}
+}
+
 // SetBackupTotal records the current number of existent backups.
 func (m *ServerMetrics) SetBackupTotal(numberOfBackups int64) {
 	if g, ok := m.metrics[backupTotal].(prometheus.Gauge); ok {
@@ -554,3 +713,19 @@ func (m *ServerMetrics) RegisterVolumeSnapshotFailures(backupSchedule string, vo
 		c.WithLabelValues(backupSchedule).Add(float64(volumeSnapshotsFailed))
 	}
 }
+
+// ObservePluginCall records a call to method of the plugin with the given name and kind, which
+// took the given number of seconds, and whether it failed.
+func (m *ServerMetrics) ObservePluginCall(pluginName, pluginKind, method string, seconds float64, failed bool) {
+	if c, ok := m.metrics[pluginCallTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(pluginName, pluginKind, method).Inc()
+	}
+	if failed {
+		if c, ok := m.metrics[pluginCallFailureTotal].(*prometheus.CounterVec); ok {
+			c.WithLabelValues(pluginName, pluginKind, method).Inc()
+		}
+	}
+	if h, ok := m.metrics[pluginCallDurationSeconds].(*prometheus.HistogramVec); ok {
+		h.WithLabelValues(pluginName, pluginKind, method).Observe(seconds)
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/util/flowcontrol"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// bandwidthLimitChunkSize is the number of bytes that can be transferred for each
+// token taken from a bandwidth limiter.
+const bandwidthLimitChunkSize = 32 * 1024
+
+// validateBandwidthLimit checks that a backup storage location's bandwidth limits,
+// if they're set, are greater than zero.
+func validateBandwidthLimit(limit *velerov1api.BandwidthLimit) error {
+	if limit == nil {
+		return nil
+	}
+
+	if limit.Upload != nil && limit.Upload.Sign() <= 0 {
+		return errors.Errorf("backup storage location's upload bandwidth limit %s must be greater than zero", limit.Upload.String())
+	}
+	if limit.Download != nil && limit.Download.Sign() <= 0 {
+		return errors.Errorf("backup storage location's download bandwidth limit %s must be greater than zero", limit.Download.String())
+	}
+
+	return nil
+}
+
+// newBandwidthLimitedObjectStore returns an ObjectStore whose object uploads and
+// downloads are each limited to the given number of bytes per second. If no limit
+// is set, the given object store is returned.
+func newBandwidthLimitedObjectStore(objectStore velero.ObjectStore, limit *velerov1api.BandwidthLimit) velero.ObjectStore {
+	if limit == nil || (limit.Upload == nil && limit.Download == nil) {
+		return objectStore
+	}
+
+	return &bandwidthLimitedObjectStore{
+		ObjectStore:    objectStore,
+		upload:         limit.Upload,
+		download:       limit.Download,
+		newRateLimiter: newBandwidthRateLimiter,
+	}
+}
+
+// newBandwidthRateLimiter returns a rate limiter that allows one chunk of
+// bytesPerSecond bytes to be transferred per token.
+func newBandwidthRateLimiter(bytesPerSecond *resource.Quantity) flowcontrol.RateLimiter {
+	return flowcontrol.NewTokenBucketRateLimiter(float32(bytesPerSecond.Value())/bandwidthLimitChunkSize, 1)
+}
+
+// bandwidthLimitedObjectStore wraps an ObjectStore so that the bodies of the objects
+// it puts and gets are read no faster than the location's bandwidth limits. Each
+// object gets its own rate limiter, so the limits apply per transfer.
+type bandwidthLimitedObjectStore struct {
+	velero.ObjectStore
+
+	upload         *resource.Quantity
+	download       *resource.Quantity
+	newRateLimiter func(bytesPerSecond *resource.Quantity) flowcontrol.RateLimiter
+}
+
+func (s *bandwidthLimitedObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	if s.upload != nil {
+		body = &rateLimitedReader{reader: body, limiter: s.newRateLimiter(s.upload)}
+	}
+
+	return s.ObjectStore.PutObject(bucket, key, body)
+}
+
+func (s *bandwidthLimitedObjectStore) GetObject(bucket, key string) (io.ReadCloser, error) {
+	rc, err := s.ObjectStore.GetObject(bucket, key)
+	if err != nil || s.download == nil {
+		return rc, err
+	}
+
+	return &rateLimitedReadCloser{
+		rateLimitedReader: &rateLimitedReader{reader: rc, limiter: s.newRateLimiter(s.download)},
+		Closer:            rc,
+	}, nil
+}
+
+// ListObjectsPage delegates to the wrapped ObjectStore, since listing objects isn't
+// limited.
+func (s *bandwidthLimitedObjectStore) ListObjectsPage(bucket, prefix, startAfter string, limit int) ([]velero.ObjectInfo, string, error) {
+	return velero.ListObjectsPage(s.ObjectStore, bucket, prefix, startAfter, limit)
+}
+
+// rateLimitedReader waits for a token from its rate limiter before reading each
+// chunk of at most bandwidthLimitChunkSize bytes from the underlying reader.
+type rateLimitedReader struct {
+	reader  io.Reader
+	limiter flowcontrol.RateLimiter
+}
+
+func (r *rateLimitedReader) Read(p []byte) (int, error) {
+	if len(p) > bandwidthLimitChunkSize {
+		p = p[:bandwidthLimitChunkSize]
+	}
+
+	r.limiter.Accept()
+	return r.reader.Read(p)
+}
+
+type rateLimitedReadCloser struct {
+	*rateLimitedReader
+	io.Closer
+}
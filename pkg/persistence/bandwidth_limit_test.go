@@ -0,0 +1,186 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/util/flowcontrol"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func resourcePtr(s string) *resource.Quantity {
+	q := resource.MustParse(s)
+	return &q
+}
+
+// countingRateLimiter is a flowcontrol.RateLimiter that never waits, and counts
+// the tokens taken from it.
+type countingRateLimiter struct {
+	accepted int
+}
+
+func (l *countingRateLimiter) TryAccept() bool            { l.accepted++; return true }
+func (l *countingRateLimiter) Accept()                    { l.accepted++ }
+func (l *countingRateLimiter) Stop()                      {}
+func (l *countingRateLimiter) QPS() float32               { return 0 }
+func (l *countingRateLimiter) Wait(context.Context) error { l.accepted++; return nil }
+
+func TestValidateBandwidthLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		limit   *velerov1api.BandwidthLimit
+		wantErr string
+	}{
+		{
+			name: "nil limit is valid",
+		},
+		{
+			name:  "positive limits are valid",
+			limit: &velerov1api.BandwidthLimit{Upload: resourcePtr("10Mi"), Download: resourcePtr("500k")},
+		},
+		{
+			name:    "zero upload limit is invalid",
+			limit:   &velerov1api.BandwidthLimit{Upload: resourcePtr("0")},
+			wantErr: "backup storage location's upload bandwidth limit 0 must be greater than zero",
+		},
+		{
+			name:    "negative download limit is invalid",
+			limit:   &velerov1api.BandwidthLimit{Download: resourcePtr("-1Mi")},
+			wantErr: "backup storage location's download bandwidth limit -1Mi must be greater than zero",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateBandwidthLimit(tc.limit)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewBandwidthLimitedObjectStore(t *testing.T) {
+	objectStore := newInMemoryObjectStore("bucket")
+
+	assert.Equal(t, objectStore, newBandwidthLimitedObjectStore(objectStore, nil))
+	assert.Equal(t, objectStore, newBandwidthLimitedObjectStore(objectStore, &velerov1api.BandwidthLimit{}))
+	assert.IsType(t, &bandwidthLimitedObjectStore{}, newBandwidthLimitedObjectStore(objectStore, &velerov1api.BandwidthLimit{Upload: resourcePtr("1Mi")}))
+}
+
+func TestRateLimitedReader(t *testing.T) {
+	// 2.5 chunks of data
+	data := bytes.Repeat([]byte("a"), bandwidthLimitChunkSize*5/2)
+	limiter := &countingRateLimiter{}
+	reader := &rateLimitedReader{reader: bytes.NewReader(data), limiter: limiter}
+
+	var (
+		res   []byte
+		reads []int
+		buf   = make([]byte, len(data))
+	)
+	for {
+		n, err := reader.Read(buf)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		res = append(res, buf[:n]...)
+		reads = append(reads, n)
+	}
+
+	assert.Equal(t, data, res)
+	assert.Equal(t, []int{bandwidthLimitChunkSize, bandwidthLimitChunkSize, bandwidthLimitChunkSize / 2}, reads)
+	// one token per chunk, plus one for the read that returned io.EOF
+	assert.Equal(t, 4, limiter.accepted)
+}
+
+func TestBandwidthLimitedObjectStore(t *testing.T) {
+	data := bytes.Repeat([]byte("a"), bandwidthLimitChunkSize*3)
+
+	tests := []struct {
+		name     string
+		upload   *resource.Quantity
+		download *resource.Quantity
+	}{
+		{
+			name:   "upload limit only applies to puts",
+			upload: resourcePtr("1Mi"),
+		},
+		{
+			name:     "download limit only applies to gets",
+			download: resourcePtr("1Mi"),
+		},
+		{
+			name:     "upload and download limits apply to their own transfers",
+			upload:   resourcePtr("1Mi"),
+			download: resourcePtr("2Mi"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			limiters := map[*resource.Quantity]*countingRateLimiter{}
+			store := &bandwidthLimitedObjectStore{
+				ObjectStore: newInMemoryObjectStore("bucket"),
+				upload:      tc.upload,
+				download:    tc.download,
+				newRateLimiter: func(bytesPerSecond *resource.Quantity) flowcontrol.RateLimiter {
+					limiter := &countingRateLimiter{}
+					limiters[bytesPerSecond] = limiter
+					return limiter
+				},
+			}
+
+			require.NoError(t, store.PutObject("bucket", "key", bytes.NewReader(data)))
+			if tc.upload != nil {
+				require.Contains(t, limiters, tc.upload)
+				assert.True(t, limiters[tc.upload].accepted >= 3)
+			}
+
+			rc, err := store.GetObject("bucket", "key")
+			require.NoError(t, err)
+			res, err := ioutil.ReadAll(rc)
+			require.NoError(t, err)
+			require.NoError(t, rc.Close())
+			assert.Equal(t, data, res)
+			if tc.download != nil {
+				require.Contains(t, limiters, tc.download)
+				assert.True(t, limiters[tc.download].accepted >= 3)
+			}
+
+			wantLimiters := 0
+			for _, limit := range []*resource.Quantity{tc.upload, tc.download} {
+				if limit != nil {
+					wantLimiters++
+				}
+			}
+			assert.Len(t, limiters, wantLimiters)
+		})
+	}
+}
@@ -18,11 +18,16 @@ package persistence
 
 import (
 	"bytes"
+	"crypto/md5"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
+	"sort"
 	"strings"
 	"time"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 type BucketData map[string][]byte
@@ -140,6 +145,28 @@ func (o *inMemoryObjectStore) ListObjects(bucket, prefix string) ([]string, erro
 	return objs, nil
 }
 
+func (o *inMemoryObjectStore) ListObjectsPage(bucket, prefix, startAfter string, limit int) ([]velero.ObjectInfo, string, error) {
+	keys, err := o.ListObjects(bucket, prefix)
+	if err != nil {
+		return nil, "", err
+	}
+	sort.Strings(keys)
+
+	var objs []velero.ObjectInfo
+	for _, key := range keys {
+		if key <= startAfter {
+			continue
+		}
+		if len(objs) == limit {
+			return objs, objs[len(objs)-1].Key, nil
+		}
+
+		objs = append(objs, velero.ObjectInfo{Key: key, ETag: fmt.Sprintf("%x", md5.Sum(o.Data[bucket][key]))})
+	}
+
+	return objs, "", nil
+}
+
 func (o *inMemoryObjectStore) DeleteObject(bucket, key string) error {
 	bucketData, ok := o.Data[bucket]
 	if !ok {
@@ -24,6 +24,7 @@ import (
 	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/v2/pkg/apis/volumesnapshot/v1beta1"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	itemoperation "github.com/vmware-tanzu/velero/pkg/itemoperation"
 	persistence "github.com/vmware-tanzu/velero/pkg/persistence"
 	volume "github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -54,6 +55,76 @@ func (_m *BackupStore) BackupExists(bucket string, backupName string) (bool, err
 	return r0, r1
 }
 
+// CopyBackup provides a mock function with given fields: name, to, metadata
+func (_m *BackupStore) CopyBackup(name string, to persistence.BackupStore, metadata *v1.Backup) error {
+	ret := _m.Called(name, to, metadata)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, persistence.BackupStore, *v1.Backup) error); ok {
+		r0 = rf(name, to, metadata)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CopyRepository provides a mock function with given fields: uploaderType, volumeNamespace, to, overwrite
+func (_m *BackupStore) CopyRepository(uploaderType v1.UploaderType, volumeNamespace string, to persistence.BackupStore, overwrite bool) (int, error) {
+	ret := _m.Called(uploaderType, volumeNamespace, to, overwrite)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(v1.UploaderType, string, persistence.BackupStore, bool) int); ok {
+		r0 = rf(uploaderType, volumeNamespace, to, overwrite)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(v1.UploaderType, string, persistence.BackupStore, bool) error); ok {
+		r1 = rf(uploaderType, volumeNamespace, to, overwrite)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRepositoryMigration provides a mock function with given fields: uploaderType, volumeNamespace
+func (_m *BackupStore) GetRepositoryMigration(uploaderType v1.UploaderType, volumeNamespace string) (string, error) {
+	ret := _m.Called(uploaderType, volumeNamespace)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(v1.UploaderType, string) string); ok {
+		r0 = rf(uploaderType, volumeNamespace)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(v1.UploaderType, string) error); ok {
+		r1 = rf(uploaderType, volumeNamespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutRepositoryMigration provides a mock function with given fields: uploaderType, volumeNamespace, location
+func (_m *BackupStore) PutRepositoryMigration(uploaderType v1.UploaderType, volumeNamespace string, location string) error {
+	ret := _m.Called(uploaderType, volumeNamespace, location)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(v1.UploaderType, string, string) error); ok {
+		r0 = rf(uploaderType, volumeNamespace, location)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteBackup provides a mock function with given fields: name
 func (_m *BackupStore) DeleteBackup(name string) error {
 	ret := _m.Called(name)
@@ -68,6 +139,20 @@ func (_m *BackupStore) DeleteBackup(name string) error {
 	return r0
 }
 
+// DeleteBackupObjects provides a mock function with given fields: name, keys
+func (_m *BackupStore) DeleteBackupObjects(name string, keys []string) error {
+	ret := _m.Called(name, keys)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string) error); ok {
+		r0 = rf(name, keys)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteRestore provides a mock function with given fields: name
 func (_m *BackupStore) DeleteRestore(name string) error {
 	ret := _m.Called(name)
@@ -105,6 +190,29 @@ func (_m *BackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
 	return r0, r1
 }
 
+// GetBackupItemOperations provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupItemOperations(name string) ([]*itemoperation.BackupOperation, error) {
+	ret := _m.Called(name)
+
+	var r0 []*itemoperation.BackupOperation
+	if rf, ok := ret.Get(0).(func(string) []*itemoperation.BackupOperation); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*itemoperation.BackupOperation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupMetadata provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupMetadata(name string) (*v1.Backup, error) {
 	ret := _m.Called(name)
@@ -128,6 +236,27 @@ func (_m *BackupStore) GetBackupMetadata(name string) (*v1.Backup, error) {
 	return r0, r1
 }
 
+// GetBackupSize provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupSize(name string) (int64, error) {
+	ret := _m.Called(name)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupVolumeSnapshots provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error) {
 	ret := _m.Called(name)
@@ -151,6 +280,29 @@ func (_m *BackupStore) GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot
 	return r0, r1
 }
 
+// GetDownload provides a mock function with given fields: target
+func (_m *BackupStore) GetDownload(target v1.DownloadTarget) (io.ReadCloser, error) {
+	ret := _m.Called(target)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(v1.DownloadTarget) io.ReadCloser); ok {
+		r0 = rf(target)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(v1.DownloadTarget) error); ok {
+		r1 = rf(target)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDownloadURL provides a mock function with given fields: target
 func (_m *BackupStore) GetDownloadURL(target v1.DownloadTarget) (string, error) {
 	ret := _m.Called(target)
@@ -195,6 +347,27 @@ func (_m *BackupStore) GetPodVolumeBackups(name string) ([]*v1.PodVolumeBackup,
 	return r0, r1
 }
 
+// GetRevision provides a mock function with given fields:
+func (_m *BackupStore) GetRevision() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsValid provides a mock function with given fields:
 func (_m *BackupStore) IsValid() error {
 	ret := _m.Called()
@@ -209,6 +382,29 @@ func (_m *BackupStore) IsValid() error {
 	return r0
 }
 
+// ListBackupRevisions provides a mock function with given fields:
+func (_m *BackupStore) ListBackupRevisions() (map[string]string, error) {
+	ret := _m.Called()
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListBackups provides a mock function with given fields:
 func (_m *BackupStore) ListBackups() ([]string, error) {
 	ret := _m.Called()
@@ -232,6 +428,29 @@ func (_m *BackupStore) ListBackups() ([]string, error) {
 	return r0, r1
 }
 
+// ListOrphanedBackupObjects provides a mock function with given fields: name, keepLog
+func (_m *BackupStore) ListOrphanedBackupObjects(name string, keepLog bool) ([]string, error) {
+	ret := _m.Called(name, keepLog)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string, bool) []string); ok {
+		r0 = rf(name, keepLog)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = rf(name, keepLog)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PutBackup provides a mock function with given fields: info
 func (_m *BackupStore) PutBackup(info persistence.BackupInfo) error {
 	ret := _m.Called(info)
@@ -246,6 +465,48 @@ func (_m *BackupStore) PutBackup(info persistence.BackupInfo) error {
 	return r0
 }
 
+// PutBackupItemOperations provides a mock function with given fields: backup, operations
+func (_m *BackupStore) PutBackupItemOperations(backup string, operations io.Reader) error {
+	ret := _m.Called(backup, operations)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(backup, operations)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutBackupMetadata provides a mock function with given fields: backup, metadata
+func (_m *BackupStore) PutBackupMetadata(backup string, metadata io.Reader) error {
+	ret := _m.Called(backup, metadata)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(backup, metadata)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutBackupVolumeSnapshots provides a mock function with given fields: name, snapshots
+func (_m *BackupStore) PutBackupVolumeSnapshots(name string, snapshots io.Reader) error {
+	ret := _m.Called(name, snapshots)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(name, snapshots)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutRestoreLog provides a mock function with given fields: backup, restore, log
 func (_m *BackupStore) PutRestoreLog(backup string, restore string, log io.Reader) error {
 	ret := _m.Called(backup, restore, log)
@@ -274,6 +535,20 @@ func (_m *BackupStore) PutRestoreResults(backup string, restore string, results
 	return r0
 }
 
+// PutRestoreDryRunReport provides a mock function with given fields: backup, restore, report
+func (_m *BackupStore) PutRestoreDryRunReport(backup string, restore string, report io.Reader) error {
+	ret := _m.Called(backup, restore, report)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader) error); ok {
+		r0 = rf(backup, restore, report)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 func (_m *BackupStore) GetCSIVolumeSnapshots(backup string) ([]*snapshotv1beta1api.VolumeSnapshot, error) {
 	panic("Not implemented")
 	return nil, nil
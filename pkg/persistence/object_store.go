@@ -17,6 +17,7 @@ limitations under the License.
 package persistence
 
 import (
+	"bytes"
 	"compress/gzip"
 	"encoding/json"
 	"io"
@@ -24,14 +25,19 @@ import (
 	"strings"
 	"time"
 
+	uuid "github.com/gofrs/uuid"
 	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/v2/pkg/apis/volumesnapshot/v1beta1"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/scheme"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -44,7 +50,8 @@ type BackupInfo struct {
 	VolumeSnapshots,
 	BackupResourceList,
 	CSIVolumeSnapshots,
-	CSIVolumeSnapshotContents io.Reader
+	CSIVolumeSnapshotContents,
+	ItemOperations io.Reader
 }
 
 // BackupStore defines operations for creating, retrieving, and deleting
@@ -53,35 +60,97 @@ type BackupStore interface {
 	IsValid() error
 
 	ListBackups() ([]string, error)
+	// ListBackupRevisions returns the names of the backups in the backup store, mapped to
+	// the revisions of their metadata. A backup's revision changes every time its metadata
+	// is replaced, and is empty if the object store doesn't provide one. The backup store
+	// is listed in pages if the object store supports it.
+	ListBackupRevisions() (map[string]string, error)
+
+	// GetRevision returns an identifier that changes every time a backup is added to or
+	// deleted from the backup store, or an empty string if the backup store doesn't have one.
+	GetRevision() (string, error)
 
 	PutBackup(info BackupInfo) error
+	// PutBackupMetadata replaces the metadata of a backup that's already been uploaded.
+	PutBackupMetadata(backup string, metadata io.Reader) error
 	GetBackupMetadata(name string) (*velerov1api.Backup, error)
+	// GetBackupSize returns the total size in bytes of the backup's objects, or zero
+	// if it wasn't recorded when the backup was uploaded.
+	GetBackupSize(name string) (int64, error)
 	GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error)
+	// PutBackupVolumeSnapshots replaces the backup's volume snapshots.
+	PutBackupVolumeSnapshots(name string, snapshots io.Reader) error
 	GetPodVolumeBackups(name string) ([]*velerov1api.PodVolumeBackup, error)
 	GetBackupContents(name string) (io.ReadCloser, error)
 	GetCSIVolumeSnapshots(name string) ([]*snapshotv1beta1api.VolumeSnapshot, error)
 	GetCSIVolumeSnapshotContents(name string) ([]*snapshotv1beta1api.VolumeSnapshotContent, error)
+	// GetBackupItemOperations returns the backup's item operations, or nil if it
+	// doesn't have any.
+	GetBackupItemOperations(name string) ([]*itemoperation.BackupOperation, error)
+	// PutBackupItemOperations replaces the backup's item operations.
+	PutBackupItemOperations(backup string, operations io.Reader) error
 
 	// BackupExists checks if the backup metadata file exists in object storage.
 	BackupExists(bucket, backupName string) (bool, error)
 
 	DeleteBackup(name string) error
 
+	// ListOrphanedBackupObjects returns the keys of the objects in a backup's directory
+	// if the backup has no metadata, leaving out its log if keepLog is true. It returns
+	// no keys if the backup has metadata.
+	ListOrphanedBackupObjects(name string, keepLog bool) ([]string, error)
+	// DeleteBackupObjects deletes the objects with the given keys from a backup's directory.
+	DeleteBackupObjects(name string, keys []string) error
+
+	// CopyBackup copies all of a backup's objects to another backup store, replacing
+	// its metadata with the given backup if it's not nil.
+	CopyBackup(name string, to BackupStore, metadata *velerov1api.Backup) error
+	// CopyRepository copies all of the objects of the given uploader's repository for a
+	// namespace's pod volumes to another backup store, and returns the number of objects
+	// that were copied. Unless overwrite is true, it fails if the other backup store
+	// already has objects of the repository.
+	CopyRepository(uploaderType velerov1api.UploaderType, volumeNamespace string, to BackupStore, overwrite bool) (int, error)
+	// PutRepositoryMigration records that the given uploader's repository for a namespace's
+	// pod volumes was migrated to the named backup storage location.
+	PutRepositoryMigration(uploaderType velerov1api.UploaderType, volumeNamespace, location string) error
+	// GetRepositoryMigration returns the name of the backup storage location that the given
+	// uploader's repository for a namespace's pod volumes was migrated to, or an empty string
+	// if it wasn't migrated.
+	GetRepositoryMigration(uploaderType velerov1api.UploaderType, volumeNamespace string) (string, error)
+
 	PutRestoreLog(backup, restore string, log io.Reader) error
 	PutRestoreResults(backup, restore string, results io.Reader) error
+	PutRestoreDryRunReport(backup, restore string, report io.Reader) error
 	DeleteRestore(name string) error
 
 	GetDownloadURL(target velerov1api.DownloadTarget) (string, error)
+	// GetDownload returns the contents of a download target's object, for serving
+	// it to users without a pre-signed URL, or nil if the object doesn't exist.
+	GetDownload(target velerov1api.DownloadTarget) (io.ReadCloser, error)
 }
 
-// DownloadURLTTL is how long a download URL is valid for.
+// DownloadURLTTL is how long a download URL is valid for by default.
 const DownloadURLTTL = 10 * time.Minute
 
+// backupListPageSize is the number of objects that are listed at a time when listing
+// the backups in a backup store. It's a variable so that tests can list smaller pages.
+var backupListPageSize = 1000
+
+// GetDownloadURLTTL returns how long the download URLs of a backup storage location's
+// objects are valid for.
+func GetDownloadURLTTL(location *velerov1api.BackupStorageLocation) time.Duration {
+	if location.Spec.ObjectStorage == nil || location.Spec.ObjectStorage.DownloadURLTTL == nil {
+		return DownloadURLTTL
+	}
+	return location.Spec.ObjectStorage.DownloadURLTTL.Duration
+}
+
 type objectBackupStore struct {
-	objectStore velero.ObjectStore
-	bucket      string
-	layout      *ObjectStoreLayout
-	logger      logrus.FieldLogger
+	objectStore    velero.ObjectStore
+	bucket         string
+	layout         *ObjectStoreLayout
+	downloadURLTTL time.Duration
+	logger         logrus.FieldLogger
 }
 
 // ObjectStoreGetter is a type that can get a velero.ObjectStore
@@ -91,6 +160,19 @@ type ObjectStoreGetter interface {
 }
 
 func NewObjectBackupStore(location *velerov1api.BackupStorageLocation, objectStoreGetter ObjectStoreGetter, logger logrus.FieldLogger) (BackupStore, error) {
+	return newObjectBackupStore(location, nil, objectStoreGetter, logger)
+}
+
+// NewObjectBackupStoreWithCredentials returns a function that constructs BackupStores like
+// NewObjectBackupStore, and that also passes the path of a file containing the location's
+// credential, if it has one, to the location's object store in the "credentialsFile" config key.
+func NewObjectBackupStoreWithCredentials(credentialFileStore credentials.FileStore) func(*velerov1api.BackupStorageLocation, ObjectStoreGetter, logrus.FieldLogger) (BackupStore, error) {
+	return func(location *velerov1api.BackupStorageLocation, objectStoreGetter ObjectStoreGetter, logger logrus.FieldLogger) (BackupStore, error) {
+		return newObjectBackupStore(location, credentialFileStore, objectStoreGetter, logger)
+	}
+}
+
+func newObjectBackupStore(location *velerov1api.BackupStorageLocation, credentialFileStore credentials.FileStore, objectStoreGetter ObjectStoreGetter, logger logrus.FieldLogger) (BackupStore, error) {
 	if location.Spec.ObjectStorage == nil {
 		return nil, errors.New("backup storage location does not use object storage")
 	}
@@ -99,9 +181,14 @@ func NewObjectBackupStore(location *velerov1api.BackupStorageLocation, objectSto
 		return nil, errors.New("object storage provider name must not be empty")
 	}
 
+	prefix, err := Prefix(location)
+	if err != nil {
+		return nil, err
+	}
+
 	// trim off any leading/trailing slashes
 	bucket := strings.Trim(location.Spec.ObjectStorage.Bucket, "/")
-	prefix := strings.Trim(location.Spec.ObjectStorage.Prefix, "/")
+	prefix = strings.Trim(prefix, "/")
 
 	// if there are any slashes in the middle of 'bucket', the user
 	// probably put <bucket>/<prefix> in the bucket field, which we
@@ -123,9 +210,75 @@ func NewObjectBackupStore(location *velerov1api.BackupStorageLocation, objectSto
 		if location.Spec.ObjectStorage.CACert != nil {
 			location.Spec.Config["caCert"] = string(location.Spec.ObjectStorage.CACert)
 		}
+		if ref := location.Spec.ObjectStorage.CACertRef; ref != nil {
+			if location.Spec.ObjectStorage.CACert != nil {
+				return nil, errors.New("backup storage location's caCert and caCertRef can't both be set")
+			}
+			if credentialFileStore == nil {
+				return nil, errors.New("backup storage location's CA certificate reference can't be used because no credential file store was provided")
+			}
+
+			caCert, err := credentialFileStore.CACert(ref)
+			if err != nil {
+				return nil, errors.Wrap(err, "error getting backup storage location's CA certificate")
+			}
+			location.Spec.Config["caCert"] = string(caCert)
+		}
+		if location.Spec.ObjectStorage.InsecureSkipTLSVerify {
+			location.Spec.Config["insecureSkipTLSVerify"] = "true"
+		}
+		// Likewise, only include the server-side encryption settings if they're specified, so
+		// that they don't override the same config keys when they're set directly.
+		if sse := location.Spec.ObjectStorage.ServerSideEncryption; sse != nil {
+			if sse.Algorithm == "" {
+				return nil, errors.New("backup storage location's server-side encryption algorithm must not be empty")
+			}
+			location.Spec.Config["serverSideEncryption"] = sse.Algorithm
+			if sse.KMSKeyID != "" {
+				location.Spec.Config["kmsKeyId"] = sse.KMSKeyID
+			}
+		}
+		// Object stores that support object locking apply the retention to the objects
+		// they put, so that backups can't be deleted or overwritten before it expires.
+		if lock := location.Spec.ObjectStorage.ObjectLock; lock != nil {
+			if lock.RetentionPeriod.Duration <= 0 {
+				return nil, errors.New("backup storage location's object lock retention period must be greater than zero")
+			}
+			location.Spec.Config["objectLockMode"] = string(lock.Mode)
+			location.Spec.Config["objectLockRetentionPeriod"] = lock.RetentionPeriod.Duration.String()
+		}
+	}
+
+	if err := validateBandwidthLimit(location.Spec.ObjectStorage.BandwidthLimit); err != nil {
+		return nil, err
+	}
+
+	if GetDownloadURLTTL(location) <= 0 {
+		return nil, errors.New("backup storage location's download URL TTL must be greater than zero")
+	}
+
+	// if the location has its own credential, write it to a file and add the file's path
+	// to the config map so that the object store uses it instead of the server's credentials.
+	if location.Spec.Credential != nil {
+		if credentialFileStore == nil {
+			return nil, errors.New("backup storage location's credential can't be used because no credential file store was provided")
+		}
+
+		credentialsFile, err := credentialFileStore.Path(location.Spec.Credential)
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting backup storage location's credential")
+		}
+		location.Spec.Config["credentialsFile"] = credentialsFile
+	}
+
+	if location.Spec.Identity != nil {
+		if location.Spec.Credential != nil {
+			return nil, errors.New("backup storage location's credential and identity can't both be set")
+		}
+		location.Spec.Config = credentials.WithAmbientIdentity(location.Spec.Config, location.Spec.Identity)
 	}
 
-	objectStore, err := objectStoreGetter.GetObjectStore(location.Spec.Provider)
+	objectStore, err := clientmgmt.GetObjectStoreWithEgressProxy(objectStoreGetter, location.Spec.Provider, location.Spec.EgressProxy)
 	if err != nil {
 		return nil, err
 	}
@@ -140,10 +293,11 @@ func NewObjectBackupStore(location *velerov1api.BackupStorageLocation, objectSto
 	}))
 
 	return &objectBackupStore{
-		objectStore: objectStore,
-		bucket:      bucket,
-		layout:      NewObjectStoreLayout(prefix),
-		logger:      log,
+		objectStore:    newBandwidthLimitedObjectStore(objectStore, location.Spec.ObjectStorage.BandwidthLimit),
+		bucket:         bucket,
+		layout:         NewObjectStoreLayout(prefix),
+		downloadURLTTL: GetDownloadURLTTL(location),
+		logger:         log,
 	}, nil
 }
 
@@ -196,8 +350,44 @@ func (s *objectBackupStore) ListBackups() ([]string, error) {
 	return output, nil
 }
 
+func (s *objectBackupStore) ListBackupRevisions() (map[string]string, error) {
+	prefix := s.layout.subdirs["backups"]
+	revisions := make(map[string]string)
+
+	var startAfter string
+	for {
+		objects, next, err := velero.ListObjectsPage(s.objectStore, s.bucket, prefix, startAfter, backupListPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range objects {
+			parts := strings.SplitN(strings.TrimPrefix(object.Key, prefix), "/", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			backupName := parts[0]
+
+			if object.Key == s.layout.getBackupMetadataKey(backupName) {
+				revisions[backupName] = object.ETag
+			} else if _, ok := revisions[backupName]; !ok {
+				revisions[backupName] = ""
+			}
+		}
+
+		if next == "" {
+			return revisions, nil
+		}
+		startAfter = next
+	}
+}
+
 func (s *objectBackupStore) PutBackup(info BackupInfo) error {
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupLogKey(info.Name), info.Log); err != nil {
+	// size is the total size of the backup's objects, which is recorded in the
+	// backup store once they've all been uploaded.
+	var size int64
+
+	if err := s.putBackupObject(s.layout.getBackupLogKey(info.Name), info.Log, &size); err != nil {
 		// Uploading the log file is best-effort; if it fails, we log the error but it doesn't impact the
 		// backup's status.
 		s.logger.WithError(err).WithField("backup", info.Name).Error("Error uploading log file")
@@ -210,12 +400,12 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		return nil
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupMetadataKey(info.Name), info.Metadata); err != nil {
+	if err := s.putBackupObject(s.layout.getBackupMetadataKey(info.Name), info.Metadata, &size); err != nil {
 		// failure to upload metadata file is a hard-stop
 		return err
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupContentsKey(info.Name), info.Contents); err != nil {
+	if err := s.putBackupObject(s.layout.getBackupContentsKey(info.Name), info.Contents, &size); err != nil {
 		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
 		return kerrors.NewAggregate([]error{err, deleteErr})
 	}
@@ -228,10 +418,11 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		s.layout.getBackupResourceListKey(info.Name):        info.BackupResourceList,
 		s.layout.getCSIVolumeSnapshotKey(info.Name):         info.CSIVolumeSnapshots,
 		s.layout.getCSIVolumeSnapshotContentsKey(info.Name): info.CSIVolumeSnapshotContents,
+		s.layout.getBackupItemOperationsKey(info.Name):      info.ItemOperations,
 	}
 
 	for key, reader := range backupObjs {
-		if err := seekAndPutObject(s.objectStore, s.bucket, key, reader); err != nil {
+		if err := s.putBackupObject(key, reader, &size); err != nil {
 			errs := []error{err}
 
 			// attempt to clean up the backup contents and metadata if we fail to upload and of the extra files.
@@ -244,6 +435,126 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		}
 	}
 
+	if err := s.putBackupSize(info.Name, size); err != nil {
+		// The size is only used to report storage usage, so failing to record
+		// it doesn't impact the backup's status.
+		s.logger.WithError(err).WithField("backup", info.Name).Error("Error recording backup size")
+	}
+
+	if err := s.putRevision(); err != nil {
+		// The revision is only used to skip unnecessary backup syncs, so failing to
+		// update it doesn't impact the backup's status.
+		s.logger.WithError(err).WithField("backup", info.Name).Error("Error updating backup store revision")
+	}
+
+	return nil
+}
+
+// putBackupObject uploads one of a backup's objects, and adds its size to size.
+func (s *objectBackupStore) putBackupObject(key string, file io.Reader, size *int64) error {
+	if file == nil {
+		return nil
+	}
+
+	if err := seekToBeginning(file); err != nil {
+		return errors.WithStack(err)
+	}
+
+	counter := &countingReader{reader: file}
+	if err := s.objectStore.PutObject(s.bucket, key, counter); err != nil {
+		return err
+	}
+
+	*size += counter.count
+	return nil
+}
+
+// backupSize is the contents of a backup's size file.
+type backupSize struct {
+	Bytes int64 `json:"bytes"`
+}
+
+// putBackupSize records the total size of the backup's objects in the backup store.
+func (s *objectBackupStore) putBackupSize(name string, size int64) error {
+	data, err := json.Marshal(backupSize{Bytes: size})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return s.objectStore.PutObject(s.bucket, s.layout.getBackupSizeKey(name), bytes.NewReader(data))
+}
+
+func (s *objectBackupStore) GetBackupSize(name string) (int64, error) {
+	key := s.layout.getBackupSizeKey(name)
+
+	exists, err := s.objectStore.ObjectExists(s.bucket, key)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	if !exists {
+		return 0, nil
+	}
+
+	res, err := s.objectStore.GetObject(s.bucket, key)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	defer res.Close()
+
+	var size backupSize
+	if err := json.NewDecoder(res).Decode(&size); err != nil {
+		return 0, errors.Wrapf(err, "error decoding object data for key %q", key)
+	}
+
+	return size.Bytes, nil
+}
+
+func (s *objectBackupStore) GetRevision() (string, error) {
+	key := s.layout.getRevisionKey()
+
+	exists, err := s.objectStore.ObjectExists(s.bucket, key)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	if !exists {
+		return "", nil
+	}
+
+	res, err := s.objectStore.GetObject(s.bucket, key)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	defer res.Close()
+
+	data, err := ioutil.ReadAll(res)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return string(data), nil
+}
+
+// putRevision writes a new, unique revision to the backup store.
+func (s *objectBackupStore) putRevision() error {
+	revision, err := uuid.NewV4()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return s.objectStore.PutObject(s.bucket, s.layout.getRevisionKey(), strings.NewReader(revision.String()))
+}
+
+func (s *objectBackupStore) PutBackupMetadata(backup string, metadata io.Reader) error {
+	if err := s.objectStore.PutObject(s.bucket, s.layout.getBackupMetadataKey(backup), metadata); err != nil {
+		return err
+	}
+
+	if err := s.putRevision(); err != nil {
+		// The revision is only used to skip unnecessary backup syncs, so failing to
+		// update it doesn't impact the backup's metadata.
+		s.logger.WithError(err).WithField("backup", backup).Error("Error updating backup store revision")
+	}
+
 	return nil
 }
 
@@ -296,6 +607,10 @@ func (s *objectBackupStore) GetBackupVolumeSnapshots(name string) ([]*volume.Sna
 	return volumeSnapshots, nil
 }
 
+func (s *objectBackupStore) PutBackupVolumeSnapshots(name string, snapshots io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getBackupVolumeSnapshotsKey(name), snapshots)
+}
+
 // tryGet returns the object with the given key if it exists, nil if it does not exist,
 // or an error if it was unable to check existence or get the object.
 func tryGet(objectStore velero.ObjectStore, bucket, key string) (io.ReadCloser, error) {
@@ -362,6 +677,28 @@ func (s *objectBackupStore) GetCSIVolumeSnapshotContents(name string) ([]*snapsh
 	return snapConts, nil
 }
 
+func (s *objectBackupStore) GetBackupItemOperations(name string) ([]*itemoperation.BackupOperation, error) {
+	// backups without item operations don't have this file.
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getBackupItemOperationsKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	var operations []*itemoperation.BackupOperation
+	if err := decode(res, &operations); err != nil {
+		return nil, err
+	}
+	return operations, nil
+}
+
+func (s *objectBackupStore) PutBackupItemOperations(backup string, operations io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getBackupItemOperationsKey(backup), operations)
+}
+
 func (s *objectBackupStore) GetPodVolumeBackups(name string) ([]*velerov1api.PodVolumeBackup, error) {
 	// if the podvolumebackups file doesn't exist, we don't want to return an error, since
 	// a legacy backup or a backup with no pod volume backups would not have this file, so
@@ -407,9 +744,186 @@ func (s *objectBackupStore) DeleteBackup(name string) error {
 		}
 	}
 
+	if err := s.putRevision(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.WithStack(kerrors.NewAggregate(errs))
+}
+
+func (s *objectBackupStore) ListOrphanedBackupObjects(name string, keepLog bool) ([]string, error) {
+	exists, err := s.objectStore.ObjectExists(s.bucket, s.layout.getBackupMetadataKey(name))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if exists {
+		return nil, nil
+	}
+
+	keys, err := s.objectStore.ListObjects(s.bucket, s.layout.getBackupDir(name))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var orphaned []string
+	for _, key := range keys {
+		if keepLog && key == s.layout.getBackupLogKey(name) {
+			continue
+		}
+		orphaned = append(orphaned, key)
+	}
+
+	return orphaned, nil
+}
+
+func (s *objectBackupStore) DeleteBackupObjects(name string, keys []string) error {
+	dir := s.layout.getBackupDir(name)
+
+	var errs []error
+	for _, key := range keys {
+		if !strings.HasPrefix(key, dir) {
+			errs = append(errs, errors.Errorf("object %q isn't in backup %s's directory", key, name))
+			continue
+		}
+
+		s.logger.WithFields(logrus.Fields{
+			"key": key,
+		}).Debug("Trying to delete object")
+		if err := s.objectStore.DeleteObject(s.bucket, key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	return errors.WithStack(kerrors.NewAggregate(errs))
 }
 
+func (s *objectBackupStore) CopyBackup(name string, to BackupStore, metadata *velerov1api.Backup) error {
+	dest, ok := to.(*objectBackupStore)
+	if !ok {
+		return errors.Errorf("backups can't be copied to a backup store of type %T", to)
+	}
+
+	dir := s.layout.getBackupDir(name)
+	keys, err := s.objectStore.ListObjects(s.bucket, dir)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// copy the metadata last, so that the copy isn't synced from the other backup
+	// store until all of its objects are there.
+	metadataKey := s.layout.getBackupMetadataKey(name)
+	for _, key := range keys {
+		if key == metadataKey {
+			continue
+		}
+		if err := s.copyObject(key, dest, dest.layout.getBackupDir(name)+strings.TrimPrefix(key, dir)); err != nil {
+			return err
+		}
+	}
+
+	if metadata == nil {
+		if err := s.copyObject(metadataKey, dest, dest.layout.getBackupMetadataKey(name)); err != nil {
+			return err
+		}
+	} else {
+		buf := new(bytes.Buffer)
+		if err := encode.EncodeTo(metadata, "json", buf); err != nil {
+			return errors.Wrap(err, "error encoding backup metadata")
+		}
+		if err := dest.objectStore.PutObject(dest.bucket, dest.layout.getBackupMetadataKey(name), buf); err != nil {
+			return errors.Wrapf(err, "error copying object %q", metadataKey)
+		}
+	}
+
+	if err := dest.putRevision(); err != nil {
+		// The revision is only used to skip unnecessary backup syncs, so failing to
+		// update it doesn't impact the copy.
+		dest.logger.WithError(err).WithField("backup", name).Error("Error updating backup store revision")
+	}
+
+	return nil
+}
+
+func (s *objectBackupStore) CopyRepository(uploaderType velerov1api.UploaderType, volumeNamespace string, to BackupStore, overwrite bool) (int, error) {
+	dest, ok := to.(*objectBackupStore)
+	if !ok {
+		return 0, errors.Errorf("repositories can't be copied to a backup store of type %T", to)
+	}
+
+	dir := s.layout.getRepositoryDir(uploaderType, volumeNamespace)
+	destDir := dest.layout.getRepositoryDir(uploaderType, volumeNamespace)
+
+	if !overwrite {
+		existing, err := dest.objectStore.ListObjects(dest.bucket, destDir)
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		if len(existing) > 0 {
+			return 0, errors.Errorf("the destination backup store already has a repository in %q", destDir)
+		}
+	}
+
+	keys, err := s.objectStore.ListObjects(s.bucket, dir)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	if len(keys) == 0 {
+		return 0, errors.Errorf("no repository found in %q", dir)
+	}
+
+	var copied int
+	for _, key := range keys {
+		// a restic repository's locks are specific to the operations that hold them,
+		// so they aren't copied.
+		if uploaderType != velerov1api.UploaderTypeKopia && strings.HasPrefix(key, dir+"locks/") {
+			continue
+		}
+		if err := s.copyObject(key, dest, destDir+strings.TrimPrefix(key, dir)); err != nil {
+			return copied, err
+		}
+		copied++
+	}
+
+	return copied, nil
+}
+
+func (s *objectBackupStore) PutRepositoryMigration(uploaderType velerov1api.UploaderType, volumeNamespace, location string) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getRepositoryMigrationKey(uploaderType, volumeNamespace), strings.NewReader(location))
+}
+
+func (s *objectBackupStore) GetRepositoryMigration(uploaderType velerov1api.UploaderType, volumeNamespace string) (string, error) {
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getRepositoryMigrationKey(uploaderType, volumeNamespace))
+	if err != nil {
+		return "", err
+	}
+	if res == nil {
+		return "", nil
+	}
+	defer res.Close()
+
+	data, err := ioutil.ReadAll(res)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return string(data), nil
+}
+
+// copyObject copies one of the objects in this backup store to the given key in another.
+func (s *objectBackupStore) copyObject(key string, dest *objectBackupStore, destKey string) error {
+	res, err := s.objectStore.GetObject(s.bucket, key)
+	if err != nil {
+		return errors.Wrapf(err, "error getting object %q", key)
+	}
+	defer res.Close()
+
+	if err := dest.objectStore.PutObject(dest.bucket, destKey, res); err != nil {
+		return errors.Wrapf(err, "error copying object %q", key)
+	}
+
+	return nil
+}
+
 func (s *objectBackupStore) DeleteRestore(name string) error {
 	objects, err := s.objectStore.ListObjects(s.bucket, s.layout.getRestoreDir(name))
 	if err != nil {
@@ -437,20 +951,58 @@ func (s *objectBackupStore) PutRestoreResults(backup string, restore string, res
 	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreResultsKey(restore), results)
 }
 
+func (s *objectBackupStore) PutRestoreDryRunReport(backup string, restore string, report io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreDryRunReportKey(restore), report)
+}
+
 func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (string, error) {
+	key, err := s.downloadKey(target)
+	if err != nil {
+		return "", err
+	}
+
+	return s.objectStore.CreateSignedURL(s.bucket, key, s.downloadURLTTL)
+}
+
+func (s *objectBackupStore) GetDownload(target velerov1api.DownloadTarget) (io.ReadCloser, error) {
+	key, err := s.downloadKey(target)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := s.objectStore.ObjectExists(s.bucket, key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	res, err := s.objectStore.GetObject(s.bucket, key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return res, nil
+}
+
+// downloadKey returns the key of the object that a download target refers to.
+func (s *objectBackupStore) downloadKey(target velerov1api.DownloadTarget) (string, error) {
 	switch target.Kind {
 	case velerov1api.DownloadTargetKindBackupContents:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupContentsKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupContentsKey(target.Name), nil
 	case velerov1api.DownloadTargetKindBackupLog:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupLogKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupLogKey(target.Name), nil
 	case velerov1api.DownloadTargetKindBackupVolumeSnapshots:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupVolumeSnapshotsKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupVolumeSnapshotsKey(target.Name), nil
 	case velerov1api.DownloadTargetKindBackupResourceList:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupResourceListKey(target.Name), DownloadURLTTL)
+		return s.layout.getBackupResourceListKey(target.Name), nil
 	case velerov1api.DownloadTargetKindRestoreLog:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreLogKey(target.Name), DownloadURLTTL)
+		return s.layout.getRestoreLogKey(target.Name), nil
 	case velerov1api.DownloadTargetKindRestoreResults:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreResultsKey(target.Name), DownloadURLTTL)
+		return s.layout.getRestoreResultsKey(target.Name), nil
+	case velerov1api.DownloadTargetKindRestoreDryRunReport:
+		return s.layout.getRestoreDryRunReportKey(target.Name), nil
 	default:
 		return "", errors.Errorf("unsupported download target kind %q", target.Kind)
 	}
@@ -466,14 +1018,14 @@ func seekToBeginning(r io.Reader) error {
 	return err
 }
 
-func seekAndPutObject(objectStore velero.ObjectStore, bucket, key string, file io.Reader) error {
-	if file == nil {
-		return nil
-	}
-
-	if err := seekToBeginning(file); err != nil {
-		return errors.WithStack(err)
-	}
+// countingReader counts the bytes that are read from its reader.
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
 
-	return objectStore.PutObject(bucket, key, file)
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += int64(n)
+	return n, err
 }
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"path"
 	"strings"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
 // ObjectStoreLayout defines how Velero's persisted files map to
@@ -38,6 +40,7 @@ func NewObjectStoreLayout(prefix string) *ObjectStoreLayout {
 		"backups":  path.Join(prefix, "backups") + "/",
 		"restores": path.Join(prefix, "restores") + "/",
 		"restic":   path.Join(prefix, "restic") + "/",
+		"kopia":    path.Join(prefix, "kopia") + "/",
 		"metadata": path.Join(prefix, "metadata") + "/",
 		"plugins":  path.Join(prefix, "plugins") + "/",
 	}
@@ -55,15 +58,45 @@ func (l *ObjectStoreLayout) GetResticDir() string {
 	return l.subdirs["restic"]
 }
 
+// GetKopiaDir returns the full prefix representing the kopia
+// directory within an object storage bucket containing a backup
+// store.
+func (l *ObjectStoreLayout) GetKopiaDir() string {
+	return l.subdirs["kopia"]
+}
+
 func (l *ObjectStoreLayout) isValidSubdir(name string) bool {
 	_, ok := l.subdirs[name]
 	return ok
 }
 
+func (l *ObjectStoreLayout) getRevisionKey() string {
+	return path.Join(l.subdirs["metadata"], "revision")
+}
+
 func (l *ObjectStoreLayout) getBackupDir(backup string) string {
 	return path.Join(l.subdirs["backups"], backup) + "/"
 }
 
+// getRepositoryDir returns the prefix of the objects of the given uploader's
+// repository for a namespace's pod volumes.
+func (l *ObjectStoreLayout) getRepositoryDir(uploaderType velerov1api.UploaderType, volumeNamespace string) string {
+	if uploaderType == velerov1api.UploaderTypeKopia {
+		return path.Join(l.subdirs["kopia"], volumeNamespace) + "/"
+	}
+	return path.Join(l.subdirs["restic"], volumeNamespace) + "/"
+}
+
+// getRepositoryMigrationKey returns the key of the object that records the backup
+// storage location that the given uploader's repository for a namespace's pod volumes
+// was migrated to.
+func (l *ObjectStoreLayout) getRepositoryMigrationKey(uploaderType velerov1api.UploaderType, volumeNamespace string) string {
+	if uploaderType == "" {
+		uploaderType = velerov1api.UploaderTypeRestic
+	}
+	return path.Join(l.subdirs["metadata"], "repository-migrations", string(uploaderType), volumeNamespace)
+}
+
 func (l *ObjectStoreLayout) getRestoreDir(restore string) string {
 	return path.Join(l.subdirs["restores"], restore) + "/"
 }
@@ -92,6 +125,10 @@ func (l *ObjectStoreLayout) getBackupResourceListKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-resource-list.json.gz", backup))
 }
 
+func (l *ObjectStoreLayout) getBackupSizeKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-size.json", backup))
+}
+
 func (l *ObjectStoreLayout) getRestoreLogKey(restore string) string {
 	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-logs.gz", restore))
 }
@@ -100,6 +137,10 @@ func (l *ObjectStoreLayout) getRestoreResultsKey(restore string) string {
 	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-results.gz", restore))
 }
 
+func (l *ObjectStoreLayout) getRestoreDryRunReportKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-dryrun-report.json.gz", restore))
+}
+
 func (l *ObjectStoreLayout) getCSIVolumeSnapshotKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-csi-volumesnapshots.json.gz", backup))
 }
@@ -107,3 +148,7 @@ func (l *ObjectStoreLayout) getCSIVolumeSnapshotKey(backup string) string {
 func (l *ObjectStoreLayout) getCSIVolumeSnapshotContentsKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-csi-volumesnapshotcontents.json.gz", backup))
 }
+
+func (l *ObjectStoreLayout) getBackupItemOperationsKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-itemoperations.json.gz", backup))
+}
@@ -26,14 +26,18 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -54,10 +58,11 @@ func newObjectBackupStoreTestHarness(bucket, prefix string) *objectBackupStoreTe
 
 	return &objectBackupStoreTestHarness{
 		objectBackupStore: &objectBackupStore{
-			objectStore: objectStore,
-			bucket:      bucket,
-			layout:      NewObjectStoreLayout(prefix),
-			logger:      velerotest.NewLogger(),
+			objectStore:    objectStore,
+			bucket:         bucket,
+			layout:         NewObjectStoreLayout(prefix),
+			downloadURLTTL: DownloadURLTTL,
+			logger:         velerotest.NewLogger(),
 		},
 		objectStore: objectStore,
 		bucket:      bucket,
@@ -145,6 +150,14 @@ func TestIsValid(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "backup store with restic and kopia directories is valid",
+			storageData: map[string][]byte{
+				"restic/ns-1/config":          {},
+				"kopia/ns-1/kopia.repository": {},
+			},
+			expectErr: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -212,6 +225,49 @@ func TestListBackups(t *testing.T) {
 	}
 }
 
+func TestListBackupRevisions(t *testing.T) {
+	// list in pages that each have some, but not all, of a backup's objects
+	defer func(pageSize int) { backupListPageSize = pageSize }(backupListPageSize)
+	backupListPageSize = 2
+
+	harness := newObjectBackupStoreTestHarness("foo", "velero-backups/")
+	storageData := map[string][]byte{
+		"velero-backups/backups/backup-1/backup-1.tar.gz":     []byte("contents"),
+		"velero-backups/backups/backup-1/velero-backup.json":  encodeToBytes(builder.ForBackup("", "backup-1").Result()),
+		"velero-backups/backups/backup-2/velero-backup.json":  encodeToBytes(builder.ForBackup("", "backup-2").Result()),
+		"velero-backups/backups/backup-3/backup-3-logs.gz":    []byte("logs"),
+		"velero-backups/restores/restore-1/restore-1-logs.gz": []byte("logs"),
+	}
+	for key, obj := range storageData {
+		require.NoError(t, harness.objectStore.PutObject(harness.bucket, key, bytes.NewReader(obj)))
+	}
+
+	res, err := harness.ListBackupRevisions()
+	require.NoError(t, err)
+
+	// backup-3 has no metadata yet, so it has no revision
+	require.Len(t, res, 3)
+	assert.NotEmpty(t, res["backup-1"])
+	assert.NotEmpty(t, res["backup-2"])
+	assert.NotEqual(t, res["backup-1"], res["backup-2"])
+	assert.Equal(t, "", res["backup-3"])
+
+	// replacing a backup's metadata changes its revision, and the backup store's
+	revision, err := harness.GetRevision()
+	require.NoError(t, err)
+
+	require.NoError(t, harness.PutBackupMetadata("backup-1", bytes.NewReader(encodeToBytes(builder.ForBackup("", "backup-1").Phase(velerov1api.BackupPhaseCompleted).Result()))))
+
+	updated, err := harness.ListBackupRevisions()
+	require.NoError(t, err)
+	assert.NotEqual(t, res["backup-1"], updated["backup-1"])
+	assert.Equal(t, res["backup-2"], updated["backup-2"])
+
+	updatedRevision, err := harness.GetRevision()
+	require.NoError(t, err)
+	assert.NotEqual(t, revision, updatedRevision)
+}
+
 func TestPutBackup(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -224,6 +280,7 @@ func TestPutBackup(t *testing.T) {
 		resourceList    io.Reader
 		expectedErr     string
 		expectedKeys    []string
+		expectedSize    int64
 	}{
 		{
 			name:            "normal case",
@@ -241,7 +298,10 @@ func TestPutBackup(t *testing.T) {
 				"backups/backup-1/backup-1-podvolumebackups.json.gz",
 				"backups/backup-1/backup-1-volumesnapshots.json.gz",
 				"backups/backup-1/backup-1-resource-list.json.gz",
+				"backups/backup-1/backup-1-size.json",
+				"metadata/revision",
 			},
+			expectedSize: 55,
 		},
 		{
 			name:            "normal case with backup store prefix",
@@ -260,7 +320,10 @@ func TestPutBackup(t *testing.T) {
 				"prefix-1/backups/backup-1/backup-1-podvolumebackups.json.gz",
 				"prefix-1/backups/backup-1/backup-1-volumesnapshots.json.gz",
 				"prefix-1/backups/backup-1/backup-1-resource-list.json.gz",
+				"prefix-1/backups/backup-1/backup-1-size.json",
+				"prefix-1/metadata/revision",
 			},
+			expectedSize: 55,
 		},
 		{
 			name:            "error on metadata upload does not upload data",
@@ -298,7 +361,10 @@ func TestPutBackup(t *testing.T) {
 				"backups/backup-1/backup-1-podvolumebackups.json.gz",
 				"backups/backup-1/backup-1-volumesnapshots.json.gz",
 				"backups/backup-1/backup-1-resource-list.json.gz",
+				"backups/backup-1/backup-1-size.json",
+				"metadata/revision",
 			},
+			expectedSize: 42,
 		},
 		{
 			name:            "don't upload data when metadata is nil",
@@ -333,10 +399,29 @@ func TestPutBackup(t *testing.T) {
 			for _, key := range tc.expectedKeys {
 				assert.Contains(t, harness.objectStore.Data[harness.bucket], key)
 			}
+
+			size, err := harness.GetBackupSize("backup-1")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSize, size)
 		})
 	}
 }
 
+func TestGetBackupSize(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// a backup that was uploaded without a size
+	size, err := harness.GetBackupSize("backup-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), size)
+
+	harness.objectStore.PutObject(harness.bucket, "backups/backup-1/backup-1-size.json", newStringReadSeeker(`{"bytes":1024}`))
+
+	size, err = harness.GetBackupSize("backup-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1024), size)
+}
+
 func TestGetBackupMetadata(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -417,13 +502,61 @@ func TestGetBackupVolumeSnapshots(t *testing.T) {
 
 	require.NoError(t, json.NewEncoder(gzw).Encode(snapshots))
 	require.NoError(t, gzw.Close())
-	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-volumesnapshots.json.gz", obj))
+	require.NoError(t, harness.PutBackupVolumeSnapshots("test-backup", obj))
+	assert.Contains(t, harness.objectStore.Data[harness.bucket], "backups/test-backup/test-backup-volumesnapshots.json.gz")
 
 	res, err = harness.GetBackupVolumeSnapshots("test-backup")
 	assert.NoError(t, err)
 	assert.EqualValues(t, snapshots, res)
 }
 
+func TestBackupItemOperations(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// itemoperations file not found should not error
+	res, err := harness.GetBackupItemOperations("test-backup")
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+
+	// itemoperations file containing invalid data should error
+	require.NoError(t, harness.PutBackupItemOperations("test-backup", newStringReadSeeker("foo")))
+	_, err = harness.GetBackupItemOperations("test-backup")
+	assert.NotNil(t, err)
+
+	// itemoperations file containing gzipped json data should return correctly
+	operations := []*itemoperation.BackupOperation{
+		{
+			Spec: itemoperation.BackupOperationSpec{
+				BackupName:  "test-backup",
+				OperationID: "op-1",
+				Resource:    "volumesnapshots",
+				Namespace:   "ns-1",
+				Name:        "snapshot-1",
+			},
+			Status: itemoperation.OperationStatus{Phase: itemoperation.OperationPhaseInProgress},
+		},
+	}
+
+	obj := new(bytes.Buffer)
+	gzw := gzip.NewWriter(obj)
+
+	require.NoError(t, json.NewEncoder(gzw).Encode(operations))
+	require.NoError(t, gzw.Close())
+	require.NoError(t, harness.PutBackupItemOperations("test-backup", obj))
+	assert.Contains(t, harness.objectStore.Data[harness.bucket], "backups/test-backup/test-backup-itemoperations.json.gz")
+
+	res, err = harness.GetBackupItemOperations("test-backup")
+	assert.NoError(t, err)
+	assert.EqualValues(t, operations, res)
+}
+
+func TestPutBackupMetadata(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	require.NoError(t, harness.PutBackupMetadata("test-backup", newStringReadSeeker("foo")))
+	assert.Equal(t, []byte("foo"), harness.objectStore.Data[harness.bucket]["backups/test-backup/velero-backup.json"])
+}
+
 func TestGetBackupContents(t *testing.T) {
 	harness := newObjectBackupStoreTestHarness("test-bucket", "")
 
@@ -438,6 +571,33 @@ func TestGetBackupContents(t *testing.T) {
 	assert.Equal(t, "foo", string(data))
 }
 
+func TestGetRevision(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// a backup store without a revision has an empty one
+	revision, err := harness.GetRevision()
+	require.NoError(t, err)
+	assert.Empty(t, revision)
+
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "metadata/revision", newStringReadSeeker("revision-1")))
+
+	revision, err = harness.GetRevision()
+	require.NoError(t, err)
+	assert.Equal(t, "revision-1", revision)
+
+	// adding a backup changes the revision
+	require.NoError(t, harness.PutBackup(BackupInfo{
+		Name:     "backup-1",
+		Metadata: newStringReadSeeker("metadata"),
+		Contents: newStringReadSeeker("contents"),
+	}))
+
+	revision, err = harness.GetRevision()
+	require.NoError(t, err)
+	assert.NotEmpty(t, revision)
+	assert.NotEqual(t, "revision-1", revision)
+}
+
 func TestDeleteBackup(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -482,6 +642,7 @@ func TestDeleteBackup(t *testing.T) {
 
 				objectStore.On("DeleteObject", backupStore.bucket, obj).Return(err)
 			}
+			objectStore.On("PutObject", backupStore.bucket, test.prefix+"metadata/revision", mock.Anything).Return(nil)
 
 			err := backupStore.DeleteBackup("bak")
 
@@ -490,6 +651,237 @@ func TestDeleteBackup(t *testing.T) {
 	}
 }
 
+func TestListOrphanedBackupObjects(t *testing.T) {
+	tests := []struct {
+		name     string
+		backup   string
+		keepLog  bool
+		expected []string
+	}{
+		{
+			name:   "backup with metadata has no orphaned objects",
+			backup: "bak",
+		},
+		{
+			name:     "all of the objects of a backup without metadata are orphaned",
+			backup:   "half-uploaded",
+			expected: []string{"backups/half-uploaded/half-uploaded-logs.gz", "backups/half-uploaded/half-uploaded.tar.gz"},
+		},
+		{
+			name:     "backup's log can be kept",
+			backup:   "half-uploaded",
+			keepLog:  true,
+			expected: []string{"backups/half-uploaded/half-uploaded.tar.gz"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			harness := newObjectBackupStoreTestHarness("test-bucket", "")
+			for _, key := range []string{
+				"backups/bak/velero-backup.json",
+				"backups/bak/bak.tar.gz",
+				"backups/half-uploaded/half-uploaded.tar.gz",
+				"backups/half-uploaded/half-uploaded-logs.gz",
+			} {
+				require.NoError(t, harness.objectStore.PutObject(harness.bucket, key, newStringReadSeeker("foo")))
+			}
+
+			res, err := harness.ListOrphanedBackupObjects(tc.backup, tc.keepLog)
+			require.NoError(t, err)
+
+			sort.Strings(res)
+			assert.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestDeleteBackupObjects(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	for _, key := range []string{
+		"backups/bak/bak.tar.gz",
+		"backups/bak/bak-logs.gz",
+		"backups/bak-2/bak-2.tar.gz",
+	} {
+		require.NoError(t, harness.objectStore.PutObject(harness.bucket, key, newStringReadSeeker("foo")))
+	}
+
+	err := harness.DeleteBackupObjects("bak", []string{"backups/bak/bak.tar.gz", "backups/bak-2/bak-2.tar.gz"})
+	assert.EqualError(t, err, `object "backups/bak-2/bak-2.tar.gz" isn't in backup bak's directory`)
+
+	data := harness.objectStore.Data[harness.bucket]
+	assert.NotContains(t, data, "backups/bak/bak.tar.gz")
+	assert.Contains(t, data, "backups/bak/bak-logs.gz")
+	assert.Contains(t, data, "backups/bak-2/bak-2.tar.gz")
+}
+
+func TestCopyBackup(t *testing.T) {
+	sourceData := BucketData{
+		"backups/bak/velero-backup.json": encodeToBytes(builder.ForBackup("velero", "bak").StorageLocation("primary").Result()),
+		"backups/bak/bak.tar.gz":         []byte("contents"),
+		"backups/bak/bak-logs.gz":        []byte("logs"),
+		"backups/bak-2/bak-2.tar.gz":     []byte("other backup's contents"),
+	}
+
+	tests := []struct {
+		name             string
+		destPrefix       string
+		metadata         *velerov1api.Backup
+		expectedMetadata []byte
+	}{
+		{
+			name:             "backup's objects are copied as they are when no metadata is given",
+			expectedMetadata: sourceData["backups/bak/velero-backup.json"],
+		},
+		{
+			name:             "backup's metadata is replaced when it's given",
+			destPrefix:       "dr-copies",
+			metadata:         builder.ForBackup("velero", "bak").StorageLocation("primary").ObjectMeta(builder.WithAnnotations(velerov1api.ReplicatedFromAnnotation, "primary")).Result(),
+			expectedMetadata: encodeToBytes(builder.ForBackup("velero", "bak").StorageLocation("primary").ObjectMeta(builder.WithAnnotations(velerov1api.ReplicatedFromAnnotation, "primary")).Result()),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			source := newObjectBackupStoreTestHarness("source-bucket", "")
+			for key, obj := range sourceData {
+				require.NoError(t, source.objectStore.PutObject(source.bucket, key, bytes.NewReader(obj)))
+			}
+			dest := newObjectBackupStoreTestHarness("dest-bucket", tc.destPrefix)
+
+			require.NoError(t, source.CopyBackup("bak", dest.objectBackupStore, tc.metadata))
+
+			destPrefix := dest.layout.subdirs["backups"]
+			destData := dest.objectStore.Data[dest.bucket]
+			assert.Equal(t, []byte("contents"), destData[destPrefix+"bak/bak.tar.gz"])
+			assert.Equal(t, []byte("logs"), destData[destPrefix+"bak/bak-logs.gz"])
+			assert.Equal(t, tc.expectedMetadata, destData[destPrefix+"bak/velero-backup.json"])
+			assert.NotContains(t, destData, destPrefix+"bak-2/bak-2.tar.gz")
+			assert.Contains(t, destData, dest.layout.getRevisionKey())
+		})
+	}
+}
+
+// otherBackupStore is a BackupStore that isn't backed by an object store.
+type otherBackupStore struct {
+	BackupStore
+}
+
+func TestCopyBackupToOtherBackupStore(t *testing.T) {
+	source := newObjectBackupStoreTestHarness("source-bucket", "")
+
+	err := source.CopyBackup("bak", &otherBackupStore{}, nil)
+	assert.EqualError(t, err, "backups can't be copied to a backup store of type *persistence.otherBackupStore")
+}
+
+func TestRepositoryMigration(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "prefix")
+
+	// a repository that wasn't migrated has no location
+	location, err := harness.GetRepositoryMigration(velerov1api.UploaderTypeRestic, "ns-1")
+	require.NoError(t, err)
+	assert.Empty(t, location)
+
+	require.NoError(t, harness.PutRepositoryMigration("", "ns-1", "secondary"))
+	assert.Contains(t, harness.objectStore.Data[harness.bucket], "prefix/metadata/repository-migrations/restic/ns-1")
+
+	location, err = harness.GetRepositoryMigration(velerov1api.UploaderTypeRestic, "ns-1")
+	require.NoError(t, err)
+	assert.Equal(t, "secondary", location)
+
+	// migrations are recorded per uploader
+	location, err = harness.GetRepositoryMigration(velerov1api.UploaderTypeKopia, "ns-1")
+	require.NoError(t, err)
+	assert.Empty(t, location)
+}
+
+func TestCopyRepository(t *testing.T) {
+	sourceData := BucketData{
+		"restic/ns-1/config":          []byte("config"),
+		"restic/ns-1/data/00/0001":    []byte("data"),
+		"restic/ns-1/locks/0002":      []byte("lock"),
+		"restic/ns-10/config":         []byte("other namespace's config"),
+		"kopia/ns-1/kopia.repository": []byte("kopia config"),
+	}
+
+	tests := []struct {
+		name           string
+		uploaderType   velerov1api.UploaderType
+		volumeNs       string
+		destPrefix     string
+		destData       BucketData
+		overwrite      bool
+		expectedCopied int
+		expectedKeys   []string
+		expectedErr    string
+	}{
+		{
+			name:           "restic repository's objects are copied, except for its locks",
+			uploaderType:   velerov1api.UploaderTypeRestic,
+			volumeNs:       "ns-1",
+			destPrefix:     "moved",
+			expectedCopied: 2,
+			expectedKeys:   []string{"moved/restic/ns-1/config", "moved/restic/ns-1/data/00/0001"},
+		},
+		{
+			name:           "kopia repository's objects are copied",
+			uploaderType:   velerov1api.UploaderTypeKopia,
+			volumeNs:       "ns-1",
+			expectedCopied: 1,
+			expectedKeys:   []string{"kopia/ns-1/kopia.repository"},
+		},
+		{
+			name:         "missing repository is an error",
+			uploaderType: velerov1api.UploaderTypeRestic,
+			volumeNs:     "ns-2",
+			expectedErr:  `no repository found in "restic/ns-2/"`,
+		},
+		{
+			name:         "existing repository in the destination is an error",
+			uploaderType: velerov1api.UploaderTypeRestic,
+			volumeNs:     "ns-1",
+			destData:     BucketData{"restic/ns-1/config": []byte("existing config")},
+			expectedErr:  `the destination backup store already has a repository in "restic/ns-1/"`,
+		},
+		{
+			name:           "existing repository in the destination is overwritten when requested",
+			uploaderType:   velerov1api.UploaderTypeRestic,
+			volumeNs:       "ns-1",
+			destData:       BucketData{"restic/ns-1/config": []byte("existing config")},
+			overwrite:      true,
+			expectedCopied: 2,
+			expectedKeys:   []string{"restic/ns-1/config", "restic/ns-1/data/00/0001"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			source := newObjectBackupStoreTestHarness("source-bucket", "")
+			for key, obj := range sourceData {
+				require.NoError(t, source.objectStore.PutObject(source.bucket, key, bytes.NewReader(obj)))
+			}
+			dest := newObjectBackupStoreTestHarness("dest-bucket", tc.destPrefix)
+			for key, obj := range tc.destData {
+				require.NoError(t, dest.objectStore.PutObject(dest.bucket, key, bytes.NewReader(obj)))
+			}
+
+			copied, err := source.CopyRepository(tc.uploaderType, tc.volumeNs, dest.objectBackupStore, tc.overwrite)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedCopied, copied)
+			destData := dest.objectStore.Data[dest.bucket]
+			assert.Len(t, destData, len(tc.expectedKeys))
+			for _, key := range tc.expectedKeys {
+				assert.Equal(t, sourceData[strings.TrimPrefix(key, "moved/")], destData[key])
+			}
+		})
+	}
+}
+
 func TestGetDownloadURL(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -553,8 +945,9 @@ func TestGetDownloadURL(t *testing.T) {
 			name:       "restore",
 			targetName: "my-backup",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
-				velerov1api.DownloadTargetKindRestoreLog:     "restores/my-backup/restore-my-backup-logs.gz",
-				velerov1api.DownloadTargetKindRestoreResults: "restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestoreLog:          "restores/my-backup/restore-my-backup-logs.gz",
+				velerov1api.DownloadTargetKindRestoreResults:      "restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestoreDryRunReport: "restores/my-backup/restore-my-backup-dryrun-report.json.gz",
 			},
 		},
 		{
@@ -587,12 +980,45 @@ func TestGetDownloadURL(t *testing.T) {
 					url, err := harness.GetDownloadURL(velerov1api.DownloadTarget{Kind: kind, Name: test.targetName})
 					require.NoError(t, err)
 					assert.Equal(t, "a-url", url)
+
+					rdr, err := harness.GetDownload(velerov1api.DownloadTarget{Kind: kind, Name: test.targetName})
+					require.NoError(t, err)
+					defer rdr.Close()
+
+					contents, err := ioutil.ReadAll(rdr)
+					require.NoError(t, err)
+					assert.Equal(t, "foo", string(contents))
 				})
 			}
 		})
 	}
 }
 
+func TestGetDownloadURLTTL(t *testing.T) {
+	tests := []struct {
+		name     string
+		location *velerov1api.BackupStorageLocation
+		want     time.Duration
+	}{
+		{
+			name:     "location without a download URL TTL uses the default",
+			location: builder.ForBackupStorageLocation("", "").Bucket("bucket").Result(),
+			want:     DownloadURLTTL,
+		},
+		{
+			name:     "location's download URL TTL is used",
+			location: builder.ForBackupStorageLocation("", "").Bucket("bucket").DownloadURLTTL(time.Hour).Result(),
+			want:     time.Hour,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, GetDownloadURLTTL(tc.location))
+		})
+	}
+}
+
 type objectStoreGetter map[string]velero.ObjectStore
 
 func (osg objectStoreGetter) GetObjectStore(provider string) (velero.ObjectStore, error) {
@@ -604,6 +1030,33 @@ func (osg objectStoreGetter) GetObjectStore(provider string) (velero.ObjectStore
 	return res, nil
 }
 
+// fakeCredentialFileStore is a credentials.FileStore that returns the paths
+// of its secret keys, keyed by "<secret name>/<key>", without writing them.
+// Its CA certificates are the same values.
+type fakeCredentialFileStore map[string]string
+
+func (s fakeCredentialFileStore) Path(selector *corev1api.SecretKeySelector) (string, error) {
+	path, ok := s[selector.Name+"/"+selector.Key]
+	if !ok {
+		return "", errors.New("secret not found")
+	}
+
+	return path, nil
+}
+
+func (s fakeCredentialFileStore) CACert(ref *velerov1api.CACertReference) ([]byte, error) {
+	if ref.SecretKeyRef == nil {
+		return nil, errors.New("secret not found")
+	}
+
+	caCert, ok := s[ref.SecretKeyRef.Name+"/"+ref.SecretKeyRef.Key]
+	if !ok {
+		return nil, errors.New("secret not found")
+	}
+
+	return []byte(caCert), nil
+}
+
 // TestNewObjectBackupStore runs the NewObjectBackupStore constructor and ensures
 // that an ObjectBackupStore is constructed correctly or an appropriate error is
 // returned.
@@ -612,8 +1065,11 @@ func TestNewObjectBackupStore(t *testing.T) {
 		name              string
 		location          *velerov1api.BackupStorageLocation
 		objectStoreGetter objectStoreGetter
+		credentialStore   fakeCredentialFileStore
 		wantBucket        string
 		wantPrefix        string
+		wantCredsFile     string
+		wantConfig        map[string]string
 		wantErr           string
 	}{
 		{
@@ -657,11 +1113,175 @@ func TestNewObjectBackupStore(t *testing.T) {
 			wantBucket: "bucket",
 			wantPrefix: "prefix/",
 		},
+		{
+			name: "when the location has a credential, the path of its credentials file is added to the config",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").
+				Credential(&corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "secret"}, Key: "key"}).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			credentialStore: fakeCredentialFileStore{"secret/key": "/tmp/credentials/ns/secret-key"},
+			wantBucket:      "bucket",
+			wantCredsFile:   "/tmp/credentials/ns/secret-key",
+		},
+		{
+			name: "when the location's credential can't be found, an error is returned",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").
+				Credential(&corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "secret"}, Key: "other"}).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			credentialStore: fakeCredentialFileStore{"secret/key": "/tmp/credentials/ns/secret-key"},
+			wantErr:         "error getting backup storage location's credential: secret not found",
+		},
+		{
+			name: "when the location has a credential and there's no credential file store, an error is returned",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").
+				Credential(&corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "secret"}, Key: "key"}).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			wantErr: "backup storage location's credential can't be used because no credential file store was provided",
+		},
+		{
+			name: "when the location has an identity, it's added to the config",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").
+				Identity(&velerov1api.AmbientIdentity{Role: "arn:aws:iam::123456789012:role/velero"}).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			wantBucket: "bucket",
+			wantConfig: map[string]string{"useAmbientIdentity": "true", "identityRole": "arn:aws:iam::123456789012:role/velero"},
+		},
+		{
+			name: "when the location has both a credential and an identity, an error is returned",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").
+				Credential(&corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "secret"}, Key: "key"}).
+				Identity(&velerov1api.AmbientIdentity{}).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			credentialStore: fakeCredentialFileStore{"secret/key": "/tmp/credentials/ns/secret-key"},
+			wantErr:         "backup storage location's credential and identity can't both be set",
+		},
+		{
+			name: "when the location has a CA certificate reference, the CA bundle is added to the config",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").
+				CACertRef(&velerov1api.CACertReference{SecretKeyRef: &corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "secret"}, Key: "ca.crt"}}).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			credentialStore: fakeCredentialFileStore{"secret/ca.crt": "ca-bundle"},
+			wantBucket:      "bucket",
+			wantConfig: map[string]string{
+				"caCert": "ca-bundle",
+			},
+		},
+		{
+			name: "when the location has both a CA certificate and a CA certificate reference, an error is returned",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").CACert([]byte("ca-bundle")).
+				CACertRef(&velerov1api.CACertReference{SecretKeyRef: &corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "secret"}, Key: "ca.crt"}}).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			credentialStore: fakeCredentialFileStore{"secret/ca.crt": "ca-bundle"},
+			wantErr:         "backup storage location's caCert and caCertRef can't both be set",
+		},
+		{
+			name: "when the location's CA certificate reference can't be found, an error is returned",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").
+				CACertRef(&velerov1api.CACertReference{SecretKeyRef: &corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "secret"}, Key: "other"}}).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			credentialStore: fakeCredentialFileStore{"secret/ca.crt": "ca-bundle"},
+			wantErr:         "error getting backup storage location's CA certificate: secret not found",
+		},
+		{
+			name:     "when the location skips TLS verification, it's added to the config",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").InsecureSkipTLSVerify(true).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			wantBucket: "bucket",
+			wantConfig: map[string]string{
+				"insecureSkipTLSVerify": "true",
+			},
+		},
+		{
+			name:     "when the location has server-side encryption, its algorithm and KMS key ID are added to the config",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").ServerSideEncryption("aws:kms", "key-1").Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			wantBucket: "bucket",
+			wantConfig: map[string]string{
+				"serverSideEncryption": "aws:kms",
+				"kmsKeyId":             "key-1",
+			},
+		},
+		{
+			name:     "when the location has server-side encryption without an algorithm, an error is returned",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").ServerSideEncryption("", "key-1").Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			wantErr: "backup storage location's server-side encryption algorithm must not be empty",
+		},
+		{
+			name:     "when the location has an object lock, its mode and retention period are added to the config",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").ObjectLock(velerov1api.ObjectLockModeCompliance, 30*24*time.Hour).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			wantBucket: "bucket",
+			wantConfig: map[string]string{
+				"objectLockMode":            "COMPLIANCE",
+				"objectLockRetentionPeriod": "720h0m0s",
+			},
+		},
+		{
+			name:     "when the location has an object lock without a retention period, an error is returned",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").ObjectLock(velerov1api.ObjectLockModeGovernance, 0).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			wantErr: "backup storage location's object lock retention period must be greater than zero",
+		},
+		{
+			name:     "when the location has a bandwidth limit, the object store is wrapped with it",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").BandwidthLimit(resourcePtr("10Mi"), nil).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			wantBucket: "bucket",
+		},
+		{
+			name:     "when the location has a bandwidth limit that isn't greater than zero, an error is returned",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").BandwidthLimit(nil, resourcePtr("0")).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			wantErr: "backup storage location's download bandwidth limit 0 must be greater than zero",
+		},
+		{
+			name:     "when the location has a download URL TTL that isn't greater than zero, an error is returned",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").DownloadURLTTL(0).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			wantErr: "backup storage location's download URL TTL must be greater than zero",
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			res, err := NewObjectBackupStore(tc.location, tc.objectStoreGetter, velerotest.NewLogger())
+			newBackupStore := NewObjectBackupStore
+			if tc.credentialStore != nil {
+				newBackupStore = NewObjectBackupStoreWithCredentials(tc.credentialStore)
+			}
+
+			res, err := newBackupStore(tc.location, tc.objectStoreGetter, velerotest.NewLogger())
 			if tc.wantErr != "" {
 				require.Equal(t, tc.wantErr, err.Error())
 			} else {
@@ -672,6 +1292,13 @@ func TestNewObjectBackupStore(t *testing.T) {
 
 				assert.Equal(t, tc.wantBucket, store.bucket)
 				assert.Equal(t, tc.wantPrefix, store.layout.rootPrefix)
+				assert.Equal(t, tc.wantCredsFile, tc.location.Spec.Config["credentialsFile"])
+				for key, value := range tc.wantConfig {
+					assert.Equal(t, value, tc.location.Spec.Config[key])
+				}
+
+				_, limited := store.objectStore.(*bandwidthLimitedObjectStore)
+				assert.Equal(t, tc.location.Spec.ObjectStorage.BandwidthLimit != nil, limited)
 			}
 		})
 	}
@@ -0,0 +1,79 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"text/template"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// clusterName is the name of the cluster that Velero runs in. It's set once, at server start.
+var clusterName string
+
+// SetClusterName sets the name of the cluster that backup storage locations' object
+// storage prefixes can include as {{.ClusterName}}.
+func SetClusterName(name string) {
+	clusterName = name
+}
+
+// prefixTemplateData is the data that a backup storage location's object storage
+// prefix is executed with.
+type prefixTemplateData struct {
+	Namespace string
+
+	clusterName string
+}
+
+// ClusterName returns the name of the cluster that Velero runs in, or an error
+// if it isn't set, so that locations shared by several clusters don't silently
+// end up with the same prefix.
+func (d prefixTemplateData) ClusterName() (string, error) {
+	if d.clusterName == "" {
+		return "", errors.New("no cluster name is set, use the velero server's --cluster-name flag to set it")
+	}
+
+	return d.clusterName, nil
+}
+
+// Prefix returns the backup storage location's object storage prefix with its
+// template variables, such as {{.ClusterName}} and {{.Namespace}}, resolved.
+func Prefix(location *velerov1api.BackupStorageLocation) (string, error) {
+	if location.Spec.ObjectStorage == nil {
+		return "", nil
+	}
+
+	tmpl, err := template.New("prefix").Parse(location.Spec.ObjectStorage.Prefix)
+	if err != nil {
+		return "", errors.Wrap(err, "error parsing backup storage location's prefix")
+	}
+
+	data := prefixTemplateData{
+		Namespace:   location.Namespace,
+		clusterName: clusterName,
+	}
+
+	buf := new(bytes.Buffer)
+	if err := tmpl.Execute(buf, data); err != nil {
+		return "", errors.Wrap(err, "error resolving backup storage location's prefix")
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestPrefix(t *testing.T) {
+	tests := []struct {
+		name        string
+		prefix      string
+		clusterName string
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:     "empty prefix",
+			expected: "",
+		},
+		{
+			name:     "prefix without template variables is unchanged",
+			prefix:   "backups/cluster-1",
+			expected: "backups/cluster-1",
+		},
+		{
+			name:        "cluster name and namespace are resolved",
+			prefix:      "{{.ClusterName}}/{{.Namespace}}",
+			clusterName: "cluster-1",
+			expected:    "cluster-1/velero",
+		},
+		{
+			name:        "cluster name that isn't set is an error",
+			prefix:      "{{.ClusterName}}/{{.Namespace}}",
+			expectedErr: `error resolving backup storage location's prefix: template: prefix:1:2: executing "prefix" at <.ClusterName>: error calling ClusterName: no cluster name is set, use the velero server's --cluster-name flag to set it`,
+		},
+		{
+			name:        "invalid template is an error",
+			prefix:      "{{.ClusterName",
+			expectedErr: `error parsing backup storage location's prefix: template: prefix:1: unclosed action`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			SetClusterName(test.clusterName)
+			defer SetClusterName("")
+
+			location := builder.ForBackupStorageLocation("velero", "default").Bucket("bucket").Prefix(test.prefix).Result()
+
+			prefix, err := Prefix(location)
+			velerotest.AssertErrorMatches(t, test.expectedErr, err)
+			assert.Equal(t, test.expected, prefix)
+		})
+	}
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifact
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	mediaTypeOCILayer           = "application/vnd.oci.image.layer.v1.tar"
+	mediaTypeOCILayerGzip       = "application/vnd.oci.image.layer.v1.tar+gzip"
+	mediaTypeDockerLayerGzip    = "application/vnd.docker.image.rootfs.diff.tar.gzip"
+	mediaTypeDockerLayerUnknown = "application/vnd.docker.image.rootfs.diff.tar"
+
+	// titleAnnotation names the file that a layer of an artifact that isn't an image holds.
+	titleAnnotation = "org.opencontainers.image.title"
+
+	// imagePluginDir is the directory of plugin images that holds their plugin executables, which
+	// init containers copy into the server's plugin directory.
+	imagePluginDir = "plugins"
+)
+
+// Puller pulls plugin artifacts from OCI registries.
+type Puller struct {
+	logger    logrus.FieldLogger
+	publicKey crypto.PublicKey
+	client    *http.Client
+	platform  platform
+}
+
+// NewPuller returns a Puller that verifies the signatures of the artifacts it pulls with publicKey.
+// If publicKey is nil, signatures aren't verified, and only artifacts pinned by digest are pulled.
+func NewPuller(logger logrus.FieldLogger, publicKey crypto.PublicKey) *Puller {
+	return &Puller{
+		logger:    logger,
+		publicKey: publicKey,
+		client:    &http.Client{},
+		platform:  platform{OS: runtime.GOOS, Architecture: runtime.GOARCH},
+	}
+}
+
+// Pull pulls the plugin executables in the artifact ref into dir, returning their paths. The
+// artifact is either a plugin image, whose layers' plugins directory holds the executables, or an
+// artifact whose layers are the executables, named by their org.opencontainers.image.title
+// annotations. Indexes are resolved to the manifest for the server's platform.
+func (p *Puller) Pull(ref Reference, dir string) ([]string, error) {
+	if ref.Digest == "" && p.publicKey == nil {
+		return nil, errors.Errorf("plugin artifact %s must be pinned by digest when signatures aren't verified", ref)
+	}
+
+	log := p.logger.WithField("artifact", ref.String())
+	c := &registryClient{client: p.client, ref: ref}
+
+	reference := ref.Digest
+	if reference == "" {
+		reference = ref.Tag
+	}
+	m, digest, err := c.getManifest(reference, ref.Digest)
+	if err != nil {
+		return nil, err
+	}
+	log.WithField("digest", digest).Info("Got plugin artifact manifest")
+
+	if p.publicKey != nil {
+		if err := c.verifySignatures(p.publicKey, digest); err != nil {
+			return nil, err
+		}
+		log.Info("Verified plugin artifact signature")
+	}
+
+	if m.isIndex() {
+		desc, err := p.platformManifest(m)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error resolving plugin artifact %s", ref)
+		}
+		if m, _, err = c.getManifest(desc.Digest, desc.Digest); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	pulled := make(map[string]bool)
+	for _, layer := range m.Layers {
+		var files []string
+		switch {
+		case layer.MediaType == mediaTypeOCILayer || layer.MediaType == mediaTypeDockerLayerUnknown:
+			files, err = p.extractLayer(c, layer, dir, false)
+		case layer.MediaType == mediaTypeOCILayerGzip || layer.MediaType == mediaTypeDockerLayerGzip:
+			files, err = p.extractLayer(c, layer, dir, true)
+		case layer.Annotations[titleAnnotation] != "":
+			files, err = p.pullFile(c, layer, dir)
+		default:
+			log.WithField("layer", layer.Digest).Debugf("Skipping layer with media type %s", layer.MediaType)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "error pulling plugin artifact %s", ref)
+		}
+
+		for _, file := range files {
+			pulled[file] = true
+		}
+	}
+
+	if len(pulled) == 0 {
+		return nil, errors.Errorf("plugin artifact %s doesn't contain any plugin executables", ref)
+	}
+
+	res := make([]string, 0, len(pulled))
+	for file := range pulled {
+		res = append(res, file)
+	}
+	sort.Strings(res)
+
+	return res, nil
+}
+
+// platformManifest returns the descriptor of the manifest in index for the Puller's platform.
+func (p *Puller) platformManifest(index *manifest) (descriptor, error) {
+	for _, desc := range index.Manifests {
+		if desc.Platform != nil && desc.Platform.OS == p.platform.OS && desc.Platform.Architecture == p.platform.Architecture {
+			return desc, nil
+		}
+	}
+	return descriptor{}, errors.Errorf("no manifest for platform %s/%s", p.platform.OS, p.platform.Architecture)
+}
+
+// download gets the blob that layer describes into a temporary file in dir, which the caller must
+// close and remove.
+func (p *Puller) download(c *registryClient, layer descriptor, dir string) (*os.File, error) {
+	file, err := ioutil.TempFile(dir, ".download-")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := c.getBlob(layer, file); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, err
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, errors.WithStack(err)
+	}
+
+	return file, nil
+}
+
+// extractLayer writes the regular files in the plugins directory of the image layer into dir. The
+// layer is downloaded and verified before anything is extracted from it.
+func (p *Puller) extractLayer(c *registryClient, layer descriptor, dir string, compressed bool) ([]string, error) {
+	file, err := p.download(c, layer, dir)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	var r io.Reader = file
+	if compressed {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error decompressing layer %s", layer.Digest)
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	var files []string
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading layer %s", layer.Digest)
+		}
+
+		if header.Typeflag != tar.TypeReg && header.Typeflag != tar.TypeRegA {
+			continue
+		}
+
+		// Cleaning the name resolves any "..", so names still in the plugins directory stay in dir.
+		name := path.Clean("/" + header.Name)[1:]
+		if !strings.HasPrefix(name, imagePluginDir+"/") || strings.HasPrefix(path.Base(name), ".wh.") {
+			continue
+		}
+
+		dest := filepath.Join(dir, filepath.FromSlash(strings.TrimPrefix(name, imagePluginDir+"/")))
+		if err := writeExecutable(dest, tr); err != nil {
+			return nil, err
+		}
+		files = append(files, dest)
+	}
+
+	return files, nil
+}
+
+// pullFile writes the blob that layer describes into dir as an executable named by the layer's
+// title annotation.
+func (p *Puller) pullFile(c *registryClient, layer descriptor, dir string) ([]string, error) {
+	name := layer.Annotations[titleAnnotation]
+	if name != filepath.Base(name) || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return nil, errors.Errorf("invalid file name %q for layer %s", name, layer.Digest)
+	}
+
+	file, err := p.download(c, layer, dir)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	dest := filepath.Join(dir, name)
+	if err := writeExecutable(dest, file); err != nil {
+		return nil, err
+	}
+
+	return []string{dest}, nil
+}
+
+// writeExecutable writes the contents of r to an executable file at path, replacing any file
+// there without leaving a partially written one.
+func writeExecutable(path string, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.WithStack(err)
+	}
+
+	file, err := ioutil.TempFile(filepath.Dir(path), ".extract-")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := io.Copy(file, r); err != nil {
+		file.Close()
+		return errors.Wrapf(err, "error writing %s", path)
+	}
+	if err := file.Chmod(0755); err != nil {
+		file.Close()
+		return errors.WithStack(err)
+	}
+	if err := file.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(os.Rename(file.Name(), path))
+}
@@ -0,0 +1,372 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifact
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// fakeRegistry serves manifests and blobs using the OCI distribution API, requiring a bearer token
+// if token is set.
+type fakeRegistry struct {
+	t         *testing.T
+	server    *httptest.Server
+	token     string
+	manifests map[string][]byte
+	types     map[string]string
+	blobs     map[string][]byte
+}
+
+func newFakeRegistry(t *testing.T) *fakeRegistry {
+	r := &fakeRegistry{
+		t:         t,
+		manifests: make(map[string][]byte),
+		types:     make(map[string]string),
+		blobs:     make(map[string][]byte),
+	}
+	r.server = httptest.NewTLSServer(http.HandlerFunc(r.serveHTTP))
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+func (r *fakeRegistry) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/token" {
+		assert.Equal(r.t, "repository:example/plugin:pull", req.URL.Query().Get("scope"))
+		json.NewEncoder(w).Encode(map[string]string{"token": r.token})
+		return
+	}
+
+	if r.token != "" && req.Header.Get("Authorization") != "Bearer "+r.token {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="fake"`, r.server.URL))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	const prefix = "/v2/example/plugin/"
+	switch {
+	case strings.HasPrefix(req.URL.Path, prefix+"manifests/"):
+		reference := strings.TrimPrefix(req.URL.Path, prefix+"manifests/")
+		data, ok := r.manifests[reference]
+		if !ok {
+			http.NotFound(w, req)
+			return
+		}
+		w.Header().Set("Content-Type", r.types[reference])
+		w.Write(data)
+	case strings.HasPrefix(req.URL.Path, prefix+"blobs/"):
+		data, ok := r.blobs[strings.TrimPrefix(req.URL.Path, prefix+"blobs/")]
+		if !ok {
+			http.NotFound(w, req)
+			return
+		}
+		w.Write(data)
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+func sha256Digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// addBlob adds data as a blob and returns its descriptor.
+func (r *fakeRegistry) addBlob(mediaType string, data []byte) descriptor {
+	desc := descriptor{MediaType: mediaType, Digest: sha256Digest(data), Size: int64(len(data))}
+	r.blobs[desc.Digest] = data
+	return desc
+}
+
+// addManifest adds m, tagged with tags, and returns its digest.
+func (r *fakeRegistry) addManifest(m *manifest, tags ...string) string {
+	data, err := json.Marshal(m)
+	require.NoError(r.t, err)
+
+	digest := sha256Digest(data)
+	for _, reference := range append(tags, digest) {
+		r.manifests[reference] = data
+		r.types[reference] = m.MediaType
+	}
+	return digest
+}
+
+// sign adds a signature of the manifest with the given digest made with key.
+func (r *fakeRegistry) sign(key *ecdsa.PrivateKey, digest string) {
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"example/plugin"},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, digest))
+	hash := sha256.Sum256(payload)
+	sigR, sigS, err := ecdsa.Sign(rand.Reader, key, hash[:])
+	require.NoError(r.t, err)
+	signature, err := asn1.Marshal(struct{ R, S interface{} }{sigR, sigS})
+	require.NoError(r.t, err)
+
+	layer := r.addBlob("application/vnd.dev.cosign.simplesigning.v1+json", payload)
+	layer.Annotations = map[string]string{signatureAnnotation: base64.StdEncoding.EncodeToString(signature)}
+	r.addManifest(&manifest{MediaType: mediaTypeOCIManifest, Layers: []descriptor{layer}}, strings.Replace(digest, ":", "-", 1)+".sig")
+}
+
+func (r *fakeRegistry) reference(tag, digest string) Reference {
+	return Reference{
+		Registry:   strings.TrimPrefix(r.server.URL, "https://"),
+		Repository: "example/plugin",
+		Tag:        tag,
+		Digest:     digest,
+	}
+}
+
+// tarGzip returns a gzipped tar archive of files.
+func tarGzip(t *testing.T, files map[string]string) []byte {
+	buf := new(bytes.Buffer)
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+	for name, contents := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0755, Size: int64(len(contents)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(contents))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+// addPluginImage adds an image whose layer holds a plugin in its plugins directory, along with
+// files that aren't plugins.
+func (r *fakeRegistry) addPluginImage(tags ...string) string {
+	layer := r.addBlob(mediaTypeOCILayerGzip, tarGzip(r.t, map[string]string{
+		"plugins/velero-plugin-example": "plugin",
+		"plugins/../etc/passwd":         "not a plugin",
+		"bin/cp-plugin":                 "not a plugin",
+		"plugins/.wh.old-plugin":        "",
+	}))
+	return r.addManifest(&manifest{MediaType: mediaTypeOCIManifest, Layers: []descriptor{layer}}, tags...)
+}
+
+func newTestPuller(r *fakeRegistry, publicKey crypto.PublicKey) *Puller {
+	p := NewPuller(velerotest.NewLogger(), publicKey)
+	p.client = r.server.Client()
+	return p
+}
+
+func assertPulled(t *testing.T, dir string, files []string) {
+	expected := filepath.Join(dir, "velero-plugin-example")
+	assert.Equal(t, []string{expected}, files)
+
+	contents, err := ioutil.ReadFile(expected)
+	require.NoError(t, err)
+	assert.Equal(t, "plugin", string(contents))
+
+	info, err := os.Stat(expected)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+
+	entries, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "only the plugin should be left in the plugin directory")
+}
+
+func TestPullImageByDigest(t *testing.T) {
+	r := newFakeRegistry(t)
+	digest := r.addPluginImage("v1")
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	files, err := newTestPuller(r, nil).Pull(r.reference("v1", digest), dir)
+	require.NoError(t, err)
+	assertPulled(t, dir, files)
+}
+
+func TestPullWithToken(t *testing.T) {
+	r := newFakeRegistry(t)
+	r.token = "secret-token"
+	digest := r.addPluginImage()
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	files, err := newTestPuller(r, nil).Pull(r.reference("", digest), dir)
+	require.NoError(t, err)
+	assertPulled(t, dir, files)
+}
+
+func TestPullIndex(t *testing.T) {
+	r := newFakeRegistry(t)
+	imageDigest := r.addPluginImage()
+	other := r.addManifest(&manifest{MediaType: mediaTypeOCIManifest})
+	index := r.addManifest(&manifest{
+		MediaType: mediaTypeOCIIndex,
+		Manifests: []descriptor{
+			{MediaType: mediaTypeOCIManifest, Digest: other, Platform: &platform{OS: "plan9", Architecture: "mips"}},
+			{MediaType: mediaTypeOCIManifest, Digest: imageDigest, Platform: &platform{OS: runtime.GOOS, Architecture: runtime.GOARCH}},
+		},
+	})
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	files, err := newTestPuller(r, nil).Pull(r.reference("", index), dir)
+	require.NoError(t, err)
+	assertPulled(t, dir, files)
+}
+
+func TestPullArtifact(t *testing.T) {
+	r := newFakeRegistry(t)
+	layer := r.addBlob("application/vnd.example.velero-plugin", []byte("plugin"))
+	layer.Annotations = map[string]string{titleAnnotation: "velero-plugin-example"}
+	digest := r.addManifest(&manifest{MediaType: mediaTypeOCIManifest, Layers: []descriptor{layer}})
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	files, err := newTestPuller(r, nil).Pull(r.reference("", digest), dir)
+	require.NoError(t, err)
+	assertPulled(t, dir, files)
+}
+
+func TestPullSigned(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		signWith    *ecdsa.PrivateKey
+		expectedErr string
+	}{
+		{
+			name:     "signature made with the public key's private key",
+			signWith: key,
+		},
+		{
+			name:        "signature made with another key",
+			signWith:    otherKey,
+			expectedErr: "invalid signature of",
+		},
+		{
+			name:        "no signature",
+			expectedErr: "error getting signatures of",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newFakeRegistry(t)
+			digest := r.addPluginImage("v1")
+			if tc.signWith != nil {
+				r.sign(tc.signWith, digest)
+			}
+			dir, err := ioutil.TempDir("", "")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			files, err := newTestPuller(r, &key.PublicKey).Pull(r.reference("v1", ""), dir)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assertPulled(t, dir, files)
+		})
+	}
+}
+
+func TestPullErrors(t *testing.T) {
+	r := newFakeRegistry(t)
+	digest := r.addPluginImage("v1")
+
+	corrupted := r.addBlob(mediaTypeOCILayerGzip, tarGzip(t, map[string]string{"plugins/velero-plugin-example": "plugin"}))
+	tampered := append([]byte{}, r.blobs[corrupted.Digest]...)
+	tampered[len(tampered)-1] ^= 0xff
+	r.blobs[corrupted.Digest] = tampered
+	corruptedDigest := r.addManifest(&manifest{MediaType: mediaTypeOCIManifest, Layers: []descriptor{corrupted}})
+
+	empty := r.addManifest(&manifest{MediaType: mediaTypeOCIManifest})
+
+	// The registry serves the image's manifest for a digest that isn't the manifest's.
+	wrongDigest := "sha256:" + strings.Repeat("0", 64)
+	r.manifests[wrongDigest] = r.manifests[digest]
+	r.types[wrongDigest] = r.types[digest]
+
+	tests := []struct {
+		name        string
+		ref         Reference
+		expectedErr string
+	}{
+		{
+			name:        "tag without a digest or a public key",
+			ref:         r.reference("v1", ""),
+			expectedErr: "must be pinned by digest when signatures aren't verified",
+		},
+		{
+			name:        "manifest doesn't match the digest",
+			ref:         r.reference("v1", wrongDigest),
+			expectedErr: "not " + wrongDigest,
+		},
+		{
+			name:        "layer doesn't match its digest",
+			ref:         r.reference("", corruptedDigest),
+			expectedErr: "blob " + corrupted.Digest + " has digest",
+		},
+		{
+			name:        "no plugins",
+			ref:         r.reference("", empty),
+			expectedErr: "doesn't contain any plugin executables",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			_, err = newTestPuller(r, nil).Pull(tc.ref, dir)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedErr)
+
+			entries, err := ioutil.ReadDir(dir)
+			require.NoError(t, err)
+			assert.Empty(t, entries)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifact
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// dockerHubRegistry is the registry of references that don't name one.
+	dockerHubRegistry = "docker.io"
+	// dockerHubHost is the host that serves the registry API for dockerHubRegistry.
+	dockerHubHost = "registry-1.docker.io"
+)
+
+var (
+	repositoryRegexp = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*$`)
+	tagRegexp        = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
+	digestRegexp     = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+)
+
+// Reference identifies a plugin artifact in an OCI registry.
+type Reference struct {
+	// Registry is the host, and optionally the port, of the registry, like "ghcr.io".
+	Registry string
+	// Repository is the artifact's repository in the registry, like "example/velero-plugin".
+	Repository string
+	// Tag is the artifact's tag. It's ignored when the artifact is pinned by Digest.
+	Tag string
+	// Digest pins the artifact to the manifest with this digest, like "sha256:<hex>".
+	Digest string
+}
+
+// ParseReference parses a reference of the form [registry/]repository[:tag][@digest]. The registry
+// defaults to Docker Hub, and the tag to "latest" when there's no digest.
+func ParseReference(ref string) (Reference, error) {
+	var res Reference
+
+	name := ref
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, res.Digest = name[:i], name[i+1:]
+		if !digestRegexp.MatchString(res.Digest) {
+			return Reference{}, errors.Errorf("invalid digest %q in plugin artifact reference %q", res.Digest, ref)
+		}
+	}
+
+	// A tag follows the last colon, unless that colon separates the registry's host and port.
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, res.Tag = name[:i], name[i+1:]
+		if !tagRegexp.MatchString(res.Tag) {
+			return Reference{}, errors.Errorf("invalid tag %q in plugin artifact reference %q", res.Tag, ref)
+		}
+	}
+	if res.Tag == "" && res.Digest == "" {
+		res.Tag = "latest"
+	}
+
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		res.Registry, res.Repository = parts[0], parts[1]
+	} else {
+		res.Registry, res.Repository = dockerHubRegistry, name
+		if len(parts) == 1 {
+			res.Repository = "library/" + name
+		}
+	}
+
+	if !repositoryRegexp.MatchString(res.Repository) {
+		return Reference{}, errors.Errorf("invalid repository %q in plugin artifact reference %q", res.Repository, ref)
+	}
+
+	return res, nil
+}
+
+// String returns the reference in the form ParseReference parses.
+func (r Reference) String() string {
+	s := r.Registry + "/" + r.Repository
+	if r.Tag != "" {
+		s += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		s += "@" + r.Digest
+	}
+	return s
+}
+
+// host returns the host that serves the registry API for the reference's registry.
+func (r Reference) host() string {
+	if r.Registry == dockerHubRegistry {
+		return dockerHubHost
+	}
+	return r.Registry
+}
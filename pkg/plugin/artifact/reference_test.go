@@ -0,0 +1,114 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifact
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReference(t *testing.T) {
+	digest := "sha256:" + "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	tests := []struct {
+		name        string
+		ref         string
+		expected    Reference
+		expectedErr string
+	}{
+		{
+			name:     "registry, repository and tag",
+			ref:      "ghcr.io/example/velero-plugin:v1.0.0",
+			expected: Reference{Registry: "ghcr.io", Repository: "example/velero-plugin", Tag: "v1.0.0"},
+		},
+		{
+			name:     "registry with a port and no tag defaults to latest",
+			ref:      "registry.example.com:5000/velero-plugin",
+			expected: Reference{Registry: "registry.example.com:5000", Repository: "velero-plugin", Tag: "latest"},
+		},
+		{
+			name:     "digest without a tag",
+			ref:      "localhost/velero-plugin@" + digest,
+			expected: Reference{Registry: "localhost", Repository: "velero-plugin", Digest: digest},
+		},
+		{
+			name:     "tag and digest",
+			ref:      "ghcr.io/example/velero-plugin:v1.0.0@" + digest,
+			expected: Reference{Registry: "ghcr.io", Repository: "example/velero-plugin", Tag: "v1.0.0", Digest: digest},
+		},
+		{
+			name:     "Docker Hub repository",
+			ref:      "velero/velero-plugin-for-aws:v1.1.0",
+			expected: Reference{Registry: "docker.io", Repository: "velero/velero-plugin-for-aws", Tag: "v1.1.0"},
+		},
+		{
+			name:     "Docker Hub official repository",
+			ref:      "velero-plugin",
+			expected: Reference{Registry: "docker.io", Repository: "library/velero-plugin", Tag: "latest"},
+		},
+		{
+			name:        "invalid digest",
+			ref:         "ghcr.io/example/velero-plugin@sha256:abc",
+			expectedErr: `invalid digest "sha256:abc" in plugin artifact reference "ghcr.io/example/velero-plugin@sha256:abc"`,
+		},
+		{
+			name:        "empty tag",
+			ref:         "ghcr.io/example/velero-plugin:",
+			expectedErr: `invalid tag "" in plugin artifact reference "ghcr.io/example/velero-plugin:"`,
+		},
+		{
+			name:        "invalid repository",
+			ref:         "ghcr.io/Example/velero-plugin:v1",
+			expectedErr: `invalid repository "Example/velero-plugin" in plugin artifact reference "ghcr.io/Example/velero-plugin:v1"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ref, err := ParseReference(tc.ref)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, ref)
+		})
+	}
+}
+
+func TestReferenceString(t *testing.T) {
+	digest := "sha256:" + "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	assert.Equal(t, "ghcr.io/example/velero-plugin:v1.0.0", Reference{Registry: "ghcr.io", Repository: "example/velero-plugin", Tag: "v1.0.0"}.String())
+	assert.Equal(t, "ghcr.io/example/velero-plugin@"+digest, Reference{Registry: "ghcr.io", Repository: "example/velero-plugin", Digest: digest}.String())
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:example/plugin:pull"`)
+	assert.Equal(t, "Bearer", scheme)
+	assert.Equal(t, map[string]string{
+		"realm":   "https://auth.example.com/token",
+		"service": "registry.example.com",
+		"scope":   "repository:example/plugin:pull",
+	}, params)
+
+	scheme, params = parseChallenge(`Basic realm=registry`)
+	assert.Equal(t, "Basic", scheme)
+	assert.Equal(t, map[string]string{"realm": "registry"}, params)
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	mediaTypeOCIManifest        = "application/vnd.oci.image.manifest.v1+json"
+	mediaTypeOCIIndex           = "application/vnd.oci.image.index.v1+json"
+	mediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+
+	// maxManifestSize bounds the size of the manifests that are read.
+	maxManifestSize = 4 << 20
+)
+
+// descriptor describes content in a registry.
+type descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Platform    *platform         `json:"platform,omitempty"`
+}
+
+// platform is the platform that a manifest in an index is for.
+type platform struct {
+	OS           string `json:"os"`
+	Architecture string `json:"architecture"`
+}
+
+// manifest is an image manifest, or an index of image manifests for different platforms.
+type manifest struct {
+	MediaType string       `json:"mediaType"`
+	Manifests []descriptor `json:"manifests,omitempty"`
+	Layers    []descriptor `json:"layers,omitempty"`
+}
+
+// isIndex returns whether m is an index of manifests rather than an image manifest.
+func (m *manifest) isIndex() bool {
+	return m.MediaType == mediaTypeOCIIndex || m.MediaType == mediaTypeDockerManifestList || len(m.Manifests) > 0
+}
+
+// registryClient gets the manifests and blobs of a repository using the OCI distribution API.
+type registryClient struct {
+	client *http.Client
+	ref    Reference
+	// token is the bearer token that requests are authorized with, once the registry asks for one.
+	token string
+}
+
+func (c *registryClient) url(kind, reference string) string {
+	return fmt.Sprintf("https://%s/v2/%s/%s/%s", c.ref.host(), c.ref.Repository, kind, reference)
+}
+
+// get gets url, getting an anonymous bearer token from the registry first if it asks for one.
+func (c *registryClient) get(url string, accept ...string) (*http.Response, error) {
+	res, err := c.do(url, accept)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == http.StatusUnauthorized && c.token == "" {
+		res.Body.Close()
+		if err := c.authenticate(res.Header.Get("WWW-Authenticate")); err != nil {
+			return nil, err
+		}
+		if res, err = c.do(url, accept); err != nil {
+			return nil, err
+		}
+	}
+
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, errors.Errorf("error getting %s: %s", url, res.Status)
+	}
+
+	return res, nil
+}
+
+func (c *registryClient) do(url string, accept []string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(accept) > 0 {
+		req.Header.Set("Accept", strings.Join(accept, ", "))
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting %s", url)
+	}
+	return res, nil
+}
+
+// authenticate gets an anonymous token to pull the repository with from the token service named
+// by challenge, the WWW-Authenticate header of a response asking for authorization.
+func (c *registryClient) authenticate(challenge string) error {
+	scheme, params := parseChallenge(challenge)
+	if !strings.EqualFold(scheme, "bearer") || params["realm"] == "" {
+		return errors.Errorf("registry %s requires authentication that isn't supported: %q", c.ref.Registry, challenge)
+	}
+
+	query := url.Values{}
+	if params["service"] != "" {
+		query.Set("service", params["service"])
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", c.ref.Repository)
+	}
+	query.Set("scope", scope)
+
+	res, err := c.client.Get(params["realm"] + "?" + query.Encode())
+	if err != nil {
+		return errors.Wrapf(err, "error getting token for registry %s", c.ref.Registry)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("error getting token for registry %s: %s", c.ref.Registry, res.Status)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxManifestSize)).Decode(&token); err != nil {
+		return errors.Wrapf(err, "error decoding token for registry %s", c.ref.Registry)
+	}
+
+	c.token = token.Token
+	if c.token == "" {
+		c.token = token.AccessToken
+	}
+	if c.token == "" {
+		return errors.Errorf("registry %s returned no token", c.ref.Registry)
+	}
+
+	return nil
+}
+
+// parseChallenge parses a WWW-Authenticate header like `Bearer realm="...",service="..."`.
+func parseChallenge(challenge string) (string, map[string]string) {
+	params := make(map[string]string)
+
+	parts := strings.SplitN(strings.TrimSpace(challenge), " ", 2)
+	if len(parts) < 2 {
+		return parts[0], params
+	}
+
+	rest := parts[1]
+	for rest != "" {
+		eq := strings.Index(rest, "=")
+		if eq < 0 {
+			break
+		}
+		key := strings.ToLower(strings.TrimSpace(rest[:eq]))
+		rest = strings.TrimSpace(rest[eq+1:])
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				break
+			}
+			value, rest = rest[1:end+1], rest[end+2:]
+		} else if comma := strings.Index(rest, ","); comma >= 0 {
+			value, rest = rest[:comma], rest[comma:]
+		} else {
+			value, rest = rest, ""
+		}
+		params[key] = value
+
+		rest = strings.TrimPrefix(strings.TrimSpace(rest), ",")
+	}
+
+	return parts[0], params
+}
+
+// getManifest gets the manifest for reference, a tag or a digest, and returns it along with its
+// digest. If expectedDigest isn't empty, the manifest's digest must be expectedDigest.
+func (c *registryClient) getManifest(reference, expectedDigest string) (*manifest, string, error) {
+	res, err := c.get(c.url("manifests", reference), mediaTypeOCIIndex, mediaTypeOCIManifest, mediaTypeDockerManifestList, mediaTypeDockerManifest)
+	if err != nil {
+		return nil, "", err
+	}
+	defer res.Body.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(res.Body, maxManifestSize+1))
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "error reading manifest %s", reference)
+	}
+	if len(data) > maxManifestSize {
+		return nil, "", errors.Errorf("manifest %s is larger than %d bytes", reference, maxManifestSize)
+	}
+
+	sum := sha256.Sum256(data)
+	digest := "sha256:" + hex.EncodeToString(sum[:])
+	if expectedDigest != "" && digest != expectedDigest {
+		return nil, "", errors.Errorf("manifest %s has digest %s, not %s", reference, digest, expectedDigest)
+	}
+
+	m := new(manifest)
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, "", errors.Wrapf(err, "error decoding manifest %s", reference)
+	}
+	if m.MediaType == "" {
+		m.MediaType = res.Header.Get("Content-Type")
+	}
+
+	return m, digest, nil
+}
+
+// getBlob writes the blob that desc describes to w, returning an error if its size or digest
+// doesn't match desc's.
+func (c *registryClient) getBlob(desc descriptor, w io.Writer) error {
+	if !digestRegexp.MatchString(desc.Digest) {
+		return errors.Errorf("unsupported blob digest %q", desc.Digest)
+	}
+
+	res, err := c.get(c.url("blobs", desc.Digest))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	hash := sha256.New()
+	n, err := io.Copy(io.MultiWriter(w, hash), io.LimitReader(res.Body, desc.Size+1))
+	if err != nil {
+		return errors.Wrapf(err, "error reading blob %s", desc.Digest)
+	}
+	if n != desc.Size {
+		return errors.Errorf("blob %s has %d bytes, not %d", desc.Digest, n, desc.Size)
+	}
+	if digest := "sha256:" + hex.EncodeToString(hash.Sum(nil)); digest != desc.Digest {
+		return errors.Errorf("blob %s has digest %s", desc.Digest, digest)
+	}
+
+	return nil
+}
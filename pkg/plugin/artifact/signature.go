@@ -0,0 +1,163 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifact
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// signatureAnnotation is the annotation of the layers of a signature manifest that holds the
+	// base64-encoded signature of the layer, following the conventions of cosign.
+	signatureAnnotation = "dev.cosignproject.cosign/signature"
+
+	// maxSignaturePayloadSize bounds the size of the signature payloads that are read.
+	maxSignaturePayloadSize = 1 << 20
+)
+
+// signaturePayload is the part of a signature payload, in the simple signing format, that names
+// the digest of the signed manifest.
+type signaturePayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// LoadPublicKey loads the PEM-encoded public key in the file at path, to verify the signatures of
+// plugin artifacts with. ECDSA, RSA and Ed25519 keys are supported.
+func LoadPublicKey(path string) (crypto.PublicKey, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.Errorf("%s doesn't contain a PEM-encoded public key", path)
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing public key in %s", path)
+	}
+
+	switch publicKey.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return publicKey, nil
+	default:
+		return nil, errors.Errorf("public key in %s has unsupported type %T", path, publicKey)
+	}
+}
+
+// verifySignatures verifies that the manifest with the given digest has a signature made with
+// publicKey. Signatures are stored the way cosign stores them: as the layers of a manifest tagged
+// with the digest, with each layer's payload naming the digest.
+func (c *registryClient) verifySignatures(publicKey crypto.PublicKey, digest string) error {
+	tag := strings.Replace(digest, ":", "-", 1) + ".sig"
+	signatures, _, err := c.getManifest(tag, "")
+	if err != nil {
+		return errors.Wrapf(err, "error getting signatures of %s", digest)
+	}
+
+	err = errors.Errorf("no signatures of %s found", digest)
+	for _, layer := range signatures.Layers {
+		encoded, ok := layer.Annotations[signatureAnnotation]
+		if !ok {
+			continue
+		}
+		if err = c.verifySignature(publicKey, digest, layer, encoded); err == nil {
+			return nil
+		}
+	}
+
+	return err
+}
+
+// verifySignature verifies that the payload in layer names digest and has the signature encoded.
+func (c *registryClient) verifySignature(publicKey crypto.PublicKey, digest string, layer descriptor, encoded string) error {
+	signature, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return errors.Wrapf(err, "error decoding signature of %s", digest)
+	}
+
+	if layer.Size > maxSignaturePayloadSize {
+		return errors.Errorf("signature payload %s is larger than %d bytes", layer.Digest, maxSignaturePayloadSize)
+	}
+	payload := new(bytes.Buffer)
+	if err := c.getBlob(layer, payload); err != nil {
+		return err
+	}
+
+	if err := verify(publicKey, payload.Bytes(), signature); err != nil {
+		return errors.Wrapf(err, "invalid signature of %s", digest)
+	}
+
+	var signed signaturePayload
+	if err := json.Unmarshal(payload.Bytes(), &signed); err != nil {
+		return errors.Wrapf(err, "error decoding signature payload %s", layer.Digest)
+	}
+	if signed.Critical.Image.DockerManifestDigest != digest {
+		return errors.Errorf("signature payload %s is for %s, not %s", layer.Digest, signed.Critical.Image.DockerManifestDigest, digest)
+	}
+
+	return nil
+}
+
+// verify verifies that signature is publicKey's signature of payload.
+func verify(publicKey crypto.PublicKey, payload, signature []byte) error {
+	hash := sha256.Sum256(payload)
+
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		var sig struct {
+			R, S *big.Int
+		}
+		if _, err := asn1.Unmarshal(signature, &sig); err != nil {
+			return errors.Wrap(err, "error decoding ECDSA signature")
+		}
+		if !ecdsa.Verify(key, hash[:], sig.R, sig.S) {
+			return errors.New("ECDSA verification failed")
+		}
+		return nil
+	case *rsa.PublicKey:
+		return errors.WithStack(rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature))
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, payload, signature) {
+			return errors.New("Ed25519 verification failed")
+		}
+		return nil
+	default:
+		return errors.Errorf("unsupported public key type %T", publicKey)
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifact
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadPublicKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, "cosign.pub")
+	require.NoError(t, ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0644))
+
+	publicKey, err := LoadPublicKey(path)
+	require.NoError(t, err)
+	assert.Equal(t, &key.PublicKey, publicKey)
+
+	notPEM := filepath.Join(dir, "not-pem")
+	require.NoError(t, ioutil.WriteFile(notPEM, []byte("not a key"), 0644))
+	_, err = LoadPublicKey(notPEM)
+	assert.EqualError(t, err, notPEM+" doesn't contain a PEM-encoded public key")
+
+	_, err = LoadPublicKey(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
+
+func TestVerify(t *testing.T) {
+	payload := []byte("payload")
+	hash := sha256.Sum256(payload)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaSignature, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, hash[:])
+	require.NoError(t, err)
+
+	ed25519PublicKey, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ed25519Signature := ed25519.Sign(ed25519Key, payload)
+
+	assert.NoError(t, verify(&rsaKey.PublicKey, payload, rsaSignature))
+	assert.Error(t, verify(&rsaKey.PublicKey, []byte("other payload"), rsaSignature))
+
+	assert.NoError(t, verify(ed25519PublicKey, payload, ed25519Signature))
+	assert.EqualError(t, verify(ed25519PublicKey, []byte("other payload"), ed25519Signature), "Ed25519 verification failed")
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	assert.Error(t, verify(&ecdsaKey.PublicKey, payload, []byte("not ASN.1")))
+
+	assert.EqualError(t, verify("not a key", payload, nil), "unsupported public key type string")
+}
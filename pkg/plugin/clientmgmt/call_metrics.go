@@ -0,0 +1,53 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"time"
+
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// callMetrics records metrics about the calls the restartable plugins make to their delegates.
+// Its zero value records nothing.
+type callMetrics struct {
+	metrics *metrics.ServerMetrics
+}
+
+// observe records a call to method of the plugin identified by key, which started at start and
+// returned err.
+func (c callMetrics) observe(key kindAndName, method string, start time.Time, err error) {
+	if c.metrics == nil {
+		return
+	}
+	c.metrics.ObservePluginCall(key.name, key.kind.String(), method, time.Since(start).Seconds(), callFailed(err))
+}
+
+// callFailed returns whether a call to a plugin that returned err failed. Plugins reporting that
+// they don't support an optional method haven't failed.
+func callFailed(err error) bool {
+	switch err {
+	case nil,
+		velero.ErrSnapshotVerificationNotSupported,
+		velero.ErrSnapshotGroupsNotSupported,
+		velero.ErrSnapshotSizeNotSupported:
+		return false
+	default:
+		return true
+	}
+}
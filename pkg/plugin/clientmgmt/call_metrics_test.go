@@ -0,0 +1,65 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+func TestCallFailed(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "no error",
+			expected: false,
+		},
+		{
+			name:     "plugin error",
+			err:      errors.New("plugin error"),
+			expected: true,
+		},
+		{
+			name:     "snapshot verification not supported",
+			err:      velero.ErrSnapshotVerificationNotSupported,
+			expected: false,
+		},
+		{
+			name:     "snapshot groups not supported",
+			err:      velero.ErrSnapshotGroupsNotSupported,
+			expected: false,
+		},
+		{
+			name:     "snapshot sizes not supported",
+			err:      velero.ErrSnapshotSizeNotSupported,
+			expected: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, callFailed(tc.err))
+		})
+	}
+}
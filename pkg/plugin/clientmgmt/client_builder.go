@@ -20,6 +20,8 @@ package clientmgmt
 import (
 	"os"
 	"os/exec"
+	"sort"
+	"time"
 
 	hclog "github.com/hashicorp/go-hclog"
 	hcplugin "github.com/hashicorp/go-plugin"
@@ -33,8 +35,12 @@ import (
 type clientBuilder struct {
 	commandName  string
 	commandArgs  []string
+	env          map[string]string
 	clientLogger logrus.FieldLogger
 	pluginLogger hclog.Logger
+	// timeout and onTimeout are passed to the plugins' clients. See framework.ClientTimeout.
+	timeout   func(name string) time.Duration
+	onTimeout func(name string)
 }
 
 // newClientBuilder returns a new clientBuilder with commandName to name. If the command matches the currently running
@@ -63,24 +69,44 @@ func newLogrusAdapter(pluginLogger logrus.FieldLogger, logLevel logrus.Level) *l
 }
 
 func (b *clientBuilder) clientConfig() *hcplugin.ClientConfig {
+	cmd := exec.Command(b.commandName, b.commandArgs...)
+
+	// go-plugin launches the process with the server's environment after cmd.Env,
+	// which takes precedence, so the plugin server applies the overrides itself.
+	keys := make([]string, 0, len(b.env))
+	for key := range b.env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		cmd.Env = append(cmd.Env, framework.EnvironmentOverridePrefix+key+"="+b.env[key])
+	}
+
+	options := []framework.PluginOption{
+		framework.ClientLogger(b.clientLogger),
+		framework.ClientTimeout(b.timeout, b.onTimeout),
+	}
+
 	return &hcplugin.ClientConfig{
 		HandshakeConfig:  framework.Handshake(),
 		AllowedProtocols: []hcplugin.Protocol{hcplugin.ProtocolGRPC},
 		Plugins: map[string]hcplugin.Plugin{
-			string(framework.PluginKindBackupItemAction):  framework.NewBackupItemActionPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindVolumeSnapshotter): framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindObjectStore):       framework.NewObjectStorePlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindBackupItemAction):  framework.NewBackupItemActionPlugin(options...),
+			string(framework.PluginKindVolumeSnapshotter): framework.NewVolumeSnapshotterPlugin(options...),
+			string(framework.PluginKindObjectStore):       framework.NewObjectStorePlugin(options...),
 			string(framework.PluginKindPluginLister):      &framework.PluginListerPlugin{},
-			string(framework.PluginKindRestoreItemAction): framework.NewRestoreItemActionPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindDeleteItemAction):  framework.NewDeleteItemActionPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindRestoreItemAction): framework.NewRestoreItemActionPlugin(options...),
+			string(framework.PluginKindDeleteItemAction):  framework.NewDeleteItemActionPlugin(options...),
+			string(framework.PluginKindPostRestoreAction): framework.NewPostRestoreActionPlugin(options...),
+			string(framework.PluginKindItemBlockAction):   framework.NewItemBlockActionPlugin(options...),
 		},
 		Logger: b.pluginLogger,
-		Cmd:    exec.Command(b.commandName, b.commandArgs...),
+		Cmd:    cmd,
 	}
 }
 
 // client creates a new go-plugin Client with support for all of Velero's plugin kinds (BackupItemAction, VolumeSnapshotter,
-// ObjectStore, PluginLister, RestoreItemAction).
+// ObjectStore, PluginLister, RestoreItemAction, DeleteItemAction, PostRestoreAction, ItemBlockAction).
 func (b *clientBuilder) client() *hcplugin.Client {
 	return hcplugin.NewClient(b.clientConfig())
 }
@@ -66,6 +66,8 @@ func TestClientConfig(t *testing.T) {
 			string(framework.PluginKindPluginLister):      &framework.PluginListerPlugin{},
 			string(framework.PluginKindRestoreItemAction): framework.NewRestoreItemActionPlugin(framework.ClientLogger(logger)),
 			string(framework.PluginKindDeleteItemAction):  framework.NewDeleteItemActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindPostRestoreAction): framework.NewPostRestoreActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindItemBlockAction):   framework.NewItemBlockActionPlugin(framework.ClientLogger(logger)),
 		},
 		Logger: cb.pluginLogger,
 		Cmd:    exec.Command(cb.commandName, cb.commandArgs...),
@@ -74,3 +76,14 @@ func TestClientConfig(t *testing.T) {
 	cc := cb.clientConfig()
 	assert.Equal(t, expected, cc)
 }
+
+func TestClientConfigEnvironment(t *testing.T) {
+	cb := newClientBuilder("velero", test.NewLogger(), logrus.InfoLevel)
+	cb.env = map[string]string{"NO_PROXY": "", "HTTPS_PROXY": "https://proxy:3128"}
+
+	cc := cb.clientConfig()
+	assert.Equal(t, []string{
+		framework.EnvironmentOverridePrefix + "HTTPS_PROXY=https://proxy:3128",
+		framework.EnvironmentOverridePrefix + "NO_PROXY=",
+	}, cc.Cmd.Env)
+}
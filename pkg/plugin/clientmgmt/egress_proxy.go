@@ -0,0 +1,83 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// EgressProxyEnvironment returns the environment variables that make a plugin process
+// use an egress proxy. Both the upper and lower case variables are set, since either
+// may be set in the Velero server's environment and HTTP clients read both.
+func EgressProxyEnvironment(proxy *velerov1api.EgressProxy) map[string]string {
+	return map[string]string{
+		"HTTP_PROXY":  proxy.HTTPProxy,
+		"http_proxy":  proxy.HTTPProxy,
+		"HTTPS_PROXY": proxy.HTTPSProxy,
+		"https_proxy": proxy.HTTPSProxy,
+		"NO_PROXY":    proxy.NoProxy,
+		"no_proxy":    proxy.NoProxy,
+	}
+}
+
+// withEgressProxy returns the Manager that launches a location's plugins with its
+// egress proxy. getter must be a Manager.
+func withEgressProxy(getter interface{}, proxy *velerov1api.EgressProxy) (Manager, error) {
+	manager, ok := getter.(Manager)
+	if !ok {
+		return nil, errors.New("location's egress proxy can't be used because its plugin can't be launched with its own environment")
+	}
+
+	return manager.WithEnvironment(EgressProxyEnvironment(proxy)), nil
+}
+
+// GetObjectStoreWithEgressProxy returns the named ObjectStore from getter, launched
+// with the egress proxy if it isn't nil.
+func GetObjectStoreWithEgressProxy(getter interface {
+	GetObjectStore(name string) (velero.ObjectStore, error)
+}, name string, proxy *velerov1api.EgressProxy) (velero.ObjectStore, error) {
+	if proxy == nil {
+		return getter.GetObjectStore(name)
+	}
+
+	manager, err := withEgressProxy(getter, proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager.GetObjectStore(name)
+}
+
+// GetVolumeSnapshotterWithEgressProxy returns the named VolumeSnapshotter from getter,
+// launched with the egress proxy if it isn't nil.
+func GetVolumeSnapshotterWithEgressProxy(getter interface {
+	GetVolumeSnapshotter(name string) (velero.VolumeSnapshotter, error)
+}, name string, proxy *velerov1api.EgressProxy) (velero.VolumeSnapshotter, error) {
+	if proxy == nil {
+		return getter.GetVolumeSnapshotter(name)
+	}
+
+	manager, err := withEgressProxy(getter, proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager.GetVolumeSnapshotter(name)
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestEgressProxyEnvironment(t *testing.T) {
+	env := EgressProxyEnvironment(&velerov1api.EgressProxy{
+		HTTPSProxy: "https://proxy:3128",
+		NoProxy:    "10.0.0.0/8",
+	})
+
+	assert.Equal(t, map[string]string{
+		"HTTP_PROXY":  "",
+		"http_proxy":  "",
+		"HTTPS_PROXY": "https://proxy:3128",
+		"https_proxy": "https://proxy:3128",
+		"NO_PROXY":    "10.0.0.0/8",
+		"no_proxy":    "10.0.0.0/8",
+	}, env)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+const (
+	// ItemOperationTimeout is how long Velero waits for the asynchronous operation that a
+	// version 2 item action started for an item before it cancels the operation.
+	ItemOperationTimeout = 4 * time.Hour
+
+	// ItemOperationPollInterval is how often Velero checks the progress of an item action's
+	// asynchronous operation.
+	ItemOperationPollInterval = 5 * time.Second
+)
+
+// WaitForItemOperation waits for the asynchronous operation whose progress is returned by
+// progress to complete, checking it every interval, and returns an error if the operation
+// failed. If the operation doesn't complete within timeout, it's canceled with cancel.
+func WaitForItemOperation(progress func() (velero.OperationProgress, error), cancel func() error, interval, timeout time.Duration, log logrus.FieldLogger) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		p, err := progress()
+		if err != nil {
+			return errors.Wrap(err, "error getting the progress of the item action's operation")
+		}
+
+		if p.Completed {
+			if p.Err != "" {
+				return errors.Errorf("item action's operation failed: %s", p.Err)
+			}
+			return nil
+		}
+
+		log.WithFields(logrus.Fields{
+			"completed":   p.NCompleted,
+			"total":       p.NTotal,
+			"units":       p.OperationUnits,
+			"description": p.Description,
+		}).Info("Waiting for item action's operation to complete")
+
+		if time.Now().After(deadline) {
+			if err := cancel(); err != nil {
+				log.WithError(err).Warn("Error canceling item action's operation")
+			}
+			return errors.Errorf("timed out after %v waiting for item action's operation to complete", timeout)
+		}
+
+		time.Sleep(interval)
+	}
+}
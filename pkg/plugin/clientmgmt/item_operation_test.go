@@ -0,0 +1,102 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestWaitForItemOperation(t *testing.T) {
+	tests := []struct {
+		name         string
+		progress     []velero.OperationProgress
+		progressErr  error
+		timeout      time.Duration
+		expectCancel bool
+		expectedErr  string
+	}{
+		{
+			name:     "no operation",
+			progress: []velero.OperationProgress{{Completed: true}},
+			timeout:  time.Minute,
+		},
+		{
+			name: "operation completes",
+			progress: []velero.OperationProgress{
+				{NCompleted: 1, NTotal: 2, OperationUnits: "bytes"},
+				{NCompleted: 2, NTotal: 2, OperationUnits: "bytes"},
+				{Completed: true},
+			},
+			timeout: time.Minute,
+		},
+		{
+			name:        "operation fails",
+			progress:    []velero.OperationProgress{{}, {Completed: true, Err: "copy failed"}},
+			timeout:     time.Minute,
+			expectedErr: "item action's operation failed: copy failed",
+		},
+		{
+			name:        "error getting progress",
+			progressErr: errors.New("plugin crashed"),
+			timeout:     time.Minute,
+			expectedErr: "error getting the progress of the item action's operation: plugin crashed",
+		},
+		{
+			name:         "operation times out",
+			progress:     []velero.OperationProgress{{}},
+			expectCancel: true,
+			expectedErr:  "timed out after 0s waiting for item action's operation to complete",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls int
+			progress := func() (velero.OperationProgress, error) {
+				if tc.progressErr != nil {
+					return velero.OperationProgress{}, tc.progressErr
+				}
+				p := tc.progress[calls]
+				if calls < len(tc.progress)-1 {
+					calls++
+				}
+				return p, nil
+			}
+
+			var canceled bool
+			cancel := func() error {
+				canceled = true
+				return nil
+			}
+
+			err := WaitForItemOperation(progress, cancel, time.Millisecond, tc.timeout, test.NewLogger())
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectCancel, canceled)
+		})
+	}
+}
@@ -17,11 +17,14 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"sort"
 	"strings"
 	"sync"
 
 	"github.com/sirupsen/logrus"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
@@ -52,6 +55,24 @@ type Manager interface {
 	// GetDeleteItemAction returns the delete item action plugin for name.
 	GetDeleteItemAction(name string) (velero.DeleteItemAction, error)
 
+	// GetPostRestoreActions returns all post-restore action plugins.
+	GetPostRestoreActions() ([]velero.PostRestoreAction, error)
+
+	// GetPostRestoreAction returns the post-restore action plugin for name.
+	GetPostRestoreAction(name string) (velero.PostRestoreAction, error)
+
+	// GetItemBlockActions returns all item block action plugins.
+	GetItemBlockActions() ([]velero.ItemBlockAction, error)
+
+	// GetItemBlockAction returns the item block action plugin for name.
+	GetItemBlockAction(name string) (velero.ItemBlockAction, error)
+
+	// WithEnvironment returns a Manager whose plugin processes are launched with the given
+	// environment variables overriding the server's. Its processes are separate from the
+	// processes of the Manager it's returned by, but both Managers' CleanupClients
+	// terminate all of them.
+	WithEnvironment(env map[string]string) Manager
+
 	// CleanupClients terminates all of the Manager's running plugin processes.
 	CleanupClients()
 }
@@ -61,25 +82,68 @@ type manager struct {
 	logger   logrus.FieldLogger
 	logLevel logrus.Level
 	registry Registry
+	env      map[string]string
 
 	restartableProcessFactory RestartableProcessFactory
-
-	// lock guards restartableProcesses
-	lock                 sync.Mutex
+	calls                     callMetrics
+	// pluginConfigs gets the data of the plugins' ConfigMaps. It's nil if they aren't delivered
+	// to plugins.
+	pluginConfigs *pluginConfigs
+
+	// lock guards restartableProcesses, which are shared with
+	// the Managers returned by WithEnvironment
+	lock                 *sync.Mutex
 	restartableProcesses map[string]RestartableProcess
 }
 
+// ManagerOption configures a Manager.
+type ManagerOption func(m *manager)
+
+// WithProcessSupervision configures how the Manager supervises the plugin processes it starts.
+func WithProcessSupervision(supervision ProcessSupervision) ManagerOption {
+	return func(m *manager) {
+		m.restartableProcessFactory = newRestartableProcessFactory(supervision)
+	}
+}
+
+// WithMetrics records metrics about the calls the Manager's plugins make to serverMetrics.
+func WithMetrics(serverMetrics *metrics.ServerMetrics) ManagerOption {
+	return func(m *manager) {
+		m.calls = callMetrics{metrics: serverMetrics}
+	}
+}
+
+// WithPluginConfig delivers the data of the plugin ConfigMaps that client gets to the Manager's plugins.
+// Item actions and post-restore actions that implement velero.Configurable are configured with it, and
+// object stores and volume snapshotters are initialized with it, overridden by their location's config.
+func WithPluginConfig(client corev1client.ConfigMapInterface) ManagerOption {
+	return func(m *manager) {
+		m.pluginConfigs = newPluginConfigs(client)
+	}
+}
+
 // NewManager constructs a manager for getting plugins.
-func NewManager(logger logrus.FieldLogger, level logrus.Level, registry Registry) Manager {
-	return &manager{
+func NewManager(logger logrus.FieldLogger, level logrus.Level, registry Registry, options ...ManagerOption) Manager {
+	m := &manager{
 		logger:   logger,
 		logLevel: level,
 		registry: registry,
 
-		restartableProcessFactory: newRestartableProcessFactory(),
+		restartableProcessFactory: newRestartableProcessFactory(ProcessSupervision{}),
 
+		lock:                 new(sync.Mutex),
 		restartableProcesses: make(map[string]RestartableProcess),
 	}
+	for _, option := range options {
+		option(m)
+	}
+	return m
+}
+
+func (m *manager) WithEnvironment(env map[string]string) Manager {
+	res := *m
+	res.env = env
+	return &res
 }
 
 func (m *manager) CleanupClients() {
@@ -111,7 +175,8 @@ func (m *manager) getRestartableProcess(kind framework.PluginKind, name string)
 
 	logger = logger.WithField("command", info.Command)
 
-	restartableProcess, found := m.restartableProcesses[info.Command]
+	key := processKey(info.Command, m.env)
+	restartableProcess, found := m.restartableProcesses[key]
 	if found {
 		logger.Debug("found preexisting restartable plugin process")
 		return restartableProcess, nil
@@ -119,16 +184,51 @@ func (m *manager) getRestartableProcess(kind framework.PluginKind, name string)
 
 	logger.Debug("creating new restartable plugin process")
 
-	restartableProcess, err = m.restartableProcessFactory.newRestartableProcess(info.Command, m.logger, m.logLevel)
+	restartableProcess, err = m.restartableProcessFactory.newRestartableProcess(info.Command, m.env, m.logger, m.logLevel)
 	if err != nil {
 		return nil, err
 	}
 
-	m.restartableProcesses[info.Command] = restartableProcess
+	m.restartableProcesses[key] = restartableProcess
 
 	return restartableProcess, nil
 }
 
+// apiVersion returns the version of kind's API that the plugin with the given name
+// is used with.
+func (m *manager) apiVersion(kind framework.PluginKind, name string) int {
+	info, err := m.registry.Get(kind, name)
+	if err != nil || info.APIVersion == 0 {
+		return 1
+	}
+	return info.APIVersion
+}
+
+// pluginConfig returns the data of the ConfigMap for the plugin of the given kind and name. It's nil
+// if the Manager doesn't deliver plugin ConfigMaps.
+func (m *manager) pluginConfig(kind framework.PluginKind, name string) (map[string]string, error) {
+	if m.pluginConfigs == nil {
+		return nil, nil
+	}
+	return m.pluginConfigs.get(kind, name)
+}
+
+// processKey returns the key of the process that runs command with env, so that a
+// command's processes with different environments are kept apart.
+func processKey(command string, env map[string]string) string {
+	if len(env) == 0 {
+		return command
+	}
+
+	vars := make([]string, 0, len(env))
+	for key, val := range env {
+		vars = append(vars, key+"="+val)
+	}
+	sort.Strings(vars)
+
+	return command + "?" + strings.Join(vars, "&")
+}
+
 // GetObjectStore returns a restartableObjectStore for name.
 func (m *manager) GetObjectStore(name string) (velero.ObjectStore, error) {
 	// Backwards compatibility with non-namespaced, built-in plugins.
@@ -140,7 +240,14 @@ func (m *manager) GetObjectStore(name string) (velero.ObjectStore, error) {
 		return nil, err
 	}
 
+	config, err := m.pluginConfig(framework.PluginKindObjectStore, name)
+	if err != nil {
+		return nil, err
+	}
+
 	r := newRestartableObjectStore(name, restartableProcess)
+	r.calls = m.calls
+	r.pluginConfig = config
 
 	return r, nil
 }
@@ -156,7 +263,17 @@ func (m *manager) GetVolumeSnapshotter(name string) (velero.VolumeSnapshotter, e
 		return nil, err
 	}
 
+	config, err := m.pluginConfig(framework.PluginKindVolumeSnapshotter, name)
+	if err != nil {
+		return nil, err
+	}
+
 	r := newRestartableVolumeSnapshotter(name, restartableProcess)
+	r.calls = m.calls
+	r.pluginConfig = config
+	if m.apiVersion(framework.PluginKindVolumeSnapshotter, name) >= 2 {
+		return &restartableVolumeSnapshotterV2{r}, nil
+	}
 
 	return r, nil
 }
@@ -192,7 +309,17 @@ func (m *manager) GetBackupItemAction(name string) (velero.BackupItemAction, err
 		return nil, err
 	}
 
+	config, err := m.pluginConfig(framework.PluginKindBackupItemAction, name)
+	if err != nil {
+		return nil, err
+	}
+
 	r := newRestartableBackupItemAction(name, restartableProcess)
+	r.calls = m.calls
+	r.config = config
+	if m.apiVersion(framework.PluginKindBackupItemAction, name) >= 2 {
+		return &restartableBackupItemActionV2{r}, nil
+	}
 	return r, nil
 }
 
@@ -227,7 +354,17 @@ func (m *manager) GetRestoreItemAction(name string) (velero.RestoreItemAction, e
 		return nil, err
 	}
 
+	config, err := m.pluginConfig(framework.PluginKindRestoreItemAction, name)
+	if err != nil {
+		return nil, err
+	}
+
 	r := newRestartableRestoreItemAction(name, restartableProcess)
+	r.calls = m.calls
+	r.config = config
+	if m.apiVersion(framework.PluginKindRestoreItemAction, name) >= 2 {
+		return &restartableRestoreItemActionV2{r}, nil
+	}
 	return r, nil
 }
 
@@ -263,6 +400,89 @@ func (m *manager) GetDeleteItemAction(name string) (velero.DeleteItemAction, err
 		return nil, err
 	}
 
+	config, err := m.pluginConfig(framework.PluginKindDeleteItemAction, name)
+	if err != nil {
+		return nil, err
+	}
+
 	r := newRestartableDeleteItemAction(name, restartableProcess)
+	r.calls = m.calls
+	r.config = config
+	return r, nil
+}
+
+// GetPostRestoreActions returns all post-restore actions as restartablePostRestoreActions.
+func (m *manager) GetPostRestoreActions() ([]velero.PostRestoreAction, error) {
+	list := m.registry.List(framework.PluginKindPostRestoreAction)
+
+	actions := make([]velero.PostRestoreAction, 0, len(list))
+
+	for i := range list {
+		id := list[i]
+
+		r, err := m.GetPostRestoreAction(id.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		actions = append(actions, r)
+	}
+
+	return actions, nil
+}
+
+// GetPostRestoreAction returns a restartablePostRestoreAction for name.
+func (m *manager) GetPostRestoreAction(name string) (velero.PostRestoreAction, error) {
+	restartableProcess, err := m.getRestartableProcess(framework.PluginKindPostRestoreAction, name)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := m.pluginConfig(framework.PluginKindPostRestoreAction, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRestartablePostRestoreAction(name, restartableProcess)
+	r.calls = m.calls
+	r.config = config
+	return r, nil
+}
+
+// GetItemBlockActions returns all item block actions as restartableItemBlockActions.
+func (m *manager) GetItemBlockActions() ([]velero.ItemBlockAction, error) {
+	list := m.registry.List(framework.PluginKindItemBlockAction)
+
+	actions := make([]velero.ItemBlockAction, 0, len(list))
+
+	for i := range list {
+		id := list[i]
+
+		r, err := m.GetItemBlockAction(id.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		actions = append(actions, r)
+	}
+
+	return actions, nil
+}
+
+// GetItemBlockAction returns a restartableItemBlockAction for name.
+func (m *manager) GetItemBlockAction(name string) (velero.ItemBlockAction, error) {
+	restartableProcess, err := m.getRestartableProcess(framework.PluginKindItemBlockAction, name)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := m.pluginConfig(framework.PluginKindItemBlockAction, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRestartableItemBlockAction(name, restartableProcess)
+	r.calls = m.calls
+	r.config = config
 	return r, nil
 }
@@ -25,7 +25,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/test"
 )
@@ -66,14 +70,20 @@ func TestNewManager(t *testing.T) {
 	assert.Equal(t, registry, m.registry)
 	assert.NotNil(t, m.restartableProcesses)
 	assert.Empty(t, m.restartableProcesses)
+
+	assert.Nil(t, m.calls.metrics)
+
+	serverMetrics := metrics.NewServerMetrics()
+	m = NewManager(logger, logLevel, registry, WithMetrics(serverMetrics)).(*manager)
+	assert.Equal(t, serverMetrics, m.calls.metrics)
 }
 
 type mockRestartableProcessFactory struct {
 	mock.Mock
 }
 
-func (f *mockRestartableProcessFactory) newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
-	args := f.Called(command, logger, logLevel)
+func (f *mockRestartableProcessFactory) newRestartableProcess(command string, env map[string]string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
+	args := f.Called(command, env, logger, logLevel)
 	var rp RestartableProcess
 	if args.Get(0) != nil {
 		rp = args.Get(0).(RestartableProcess)
@@ -135,7 +145,7 @@ func TestGetRestartableProcess(t *testing.T) {
 		Name:    pluginName,
 	}
 	registry.On("Get", pluginKind, pluginName).Return(podID, nil)
-	factory.On("newRestartableProcess", podID.Command, logger, logLevel).Return(nil, errors.Errorf("factory")).Once()
+	factory.On("newRestartableProcess", podID.Command, map[string]string(nil), logger, logLevel).Return(nil, errors.Errorf("factory")).Once()
 	rp, err = m.getRestartableProcess(pluginKind, pluginName)
 	assert.Nil(t, rp)
 	assert.EqualError(t, err, "factory")
@@ -143,7 +153,7 @@ func TestGetRestartableProcess(t *testing.T) {
 	// Test 3: registry ok, factory ok
 	restartableProcess := &mockRestartableProcess{}
 	defer restartableProcess.AssertExpectations(t)
-	factory.On("newRestartableProcess", podID.Command, logger, logLevel).Return(restartableProcess, nil).Once()
+	factory.On("newRestartableProcess", podID.Command, map[string]string(nil), logger, logLevel).Return(restartableProcess, nil).Once()
 	rp, err = m.getRestartableProcess(pluginKind, pluginName)
 	require.NoError(t, err)
 	assert.Equal(t, restartableProcess, rp)
@@ -152,6 +162,22 @@ func TestGetRestartableProcess(t *testing.T) {
 	rp, err = m.getRestartableProcess(pluginKind, pluginName)
 	require.NoError(t, err)
 	assert.Equal(t, restartableProcess, rp)
+
+	// Test 5: a different environment gets its own process
+	env := map[string]string{"HTTPS_PROXY": "https://proxy:3128"}
+	envProcess := &mockRestartableProcess{}
+	defer envProcess.AssertExpectations(t)
+	factory.On("newRestartableProcess", podID.Command, env, logger, logLevel).Return(envProcess, nil).Once()
+	rp, err = m.WithEnvironment(env).(*manager).getRestartableProcess(pluginKind, pluginName)
+	require.NoError(t, err)
+	assert.Equal(t, envProcess, rp)
+	assert.Equal(t, envProcess, m.restartableProcesses[processKey(podID.Command, env)])
+	assert.Equal(t, restartableProcess, m.restartableProcesses[podID.Command])
+}
+
+func TestProcessKey(t *testing.T) {
+	assert.Equal(t, "/command", processKey("/command", nil))
+	assert.Equal(t, "/command?A=1&B=2", processKey("/command", map[string]string{"B": "2", "A": "1"}))
 }
 
 func TestCleanupClients(t *testing.T) {
@@ -207,6 +233,42 @@ func TestGetVolumeSnapshotter(t *testing.T) {
 	)
 }
 
+func TestGetVolumeSnapshotterV2(t *testing.T) {
+	logger := test.NewLogger()
+	logLevel := logrus.InfoLevel
+
+	registry := &mockRegistry{}
+	defer registry.AssertExpectations(t)
+
+	m := NewManager(logger, logLevel, registry).(*manager)
+	factory := &mockRestartableProcessFactory{}
+	defer factory.AssertExpectations(t)
+	m.restartableProcessFactory = factory
+
+	name := "velero.io/aws"
+	pluginID := framework.PluginIdentifier{
+		Command:     "/command",
+		Kind:        framework.PluginKindVolumeSnapshotter,
+		Name:        name,
+		APIVersions: []int{1, 2},
+		APIVersion:  2,
+	}
+	registry.On("Get", framework.PluginKindVolumeSnapshotter, name).Return(pluginID, nil)
+
+	restartableProcess := &mockRestartableProcess{}
+	defer restartableProcess.AssertExpectations(t)
+	factory.On("newRestartableProcess", pluginID.Command, map[string]string(nil), logger, logLevel).Return(restartableProcess, nil).Once()
+
+	volumeSnapshotter, err := m.GetVolumeSnapshotter(name)
+	require.NoError(t, err)
+	assert.Equal(t, &restartableVolumeSnapshotterV2{
+		restartableVolumeSnapshotter: &restartableVolumeSnapshotter{
+			key:                 kindAndName{kind: framework.PluginKindVolumeSnapshotter, name: name},
+			sharedPluginProcess: restartableProcess,
+		},
+	}, volumeSnapshotter)
+}
+
 func TestGetBackupItemAction(t *testing.T) {
 	getPluginTest(t,
 		framework.PluginKindBackupItemAction,
@@ -224,6 +286,84 @@ func TestGetBackupItemAction(t *testing.T) {
 	)
 }
 
+func TestGetBackupItemActionV2(t *testing.T) {
+	logger := test.NewLogger()
+	logLevel := logrus.InfoLevel
+
+	registry := &mockRegistry{}
+	defer registry.AssertExpectations(t)
+
+	m := NewManager(logger, logLevel, registry).(*manager)
+	factory := &mockRestartableProcessFactory{}
+	defer factory.AssertExpectations(t)
+	m.restartableProcessFactory = factory
+
+	name := "velero.io/pod"
+	pluginID := framework.PluginIdentifier{
+		Command:     "/command",
+		Kind:        framework.PluginKindBackupItemAction,
+		Name:        name,
+		APIVersions: []int{1, 2},
+		APIVersion:  2,
+	}
+	registry.On("Get", framework.PluginKindBackupItemAction, name).Return(pluginID, nil)
+
+	restartableProcess := &mockRestartableProcess{}
+	defer restartableProcess.AssertExpectations(t)
+	factory.On("newRestartableProcess", pluginID.Command, map[string]string(nil), logger, logLevel).Return(restartableProcess, nil).Once()
+
+	action, err := m.GetBackupItemAction(name)
+	require.NoError(t, err)
+	assert.Equal(t, &restartableBackupItemActionV2{
+		restartableBackupItemAction: &restartableBackupItemAction{
+			key:                 kindAndName{kind: framework.PluginKindBackupItemAction, name: name},
+			sharedPluginProcess: restartableProcess,
+		},
+	}, action)
+}
+
+func TestGetBackupItemActionWithPluginConfig(t *testing.T) {
+	logger := test.NewLogger()
+	logLevel := logrus.InfoLevel
+
+	registry := &mockRegistry{}
+	defer registry.AssertExpectations(t)
+
+	client := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "pod-config",
+			Labels:    map[string]string{PluginConfigLabel: "", "velero.io/pod": "BackupItemAction"},
+		},
+		Data: map[string]string{"key": "value"},
+	})
+
+	m := NewManager(logger, logLevel, registry, WithPluginConfig(client.CoreV1().ConfigMaps("velero"))).(*manager)
+	factory := &mockRestartableProcessFactory{}
+	defer factory.AssertExpectations(t)
+	m.restartableProcessFactory = factory
+
+	name := "velero.io/pod"
+	pluginID := framework.PluginIdentifier{
+		Command: "/command",
+		Kind:    framework.PluginKindBackupItemAction,
+		Name:    name,
+	}
+	registry.On("Get", framework.PluginKindBackupItemAction, name).Return(pluginID, nil)
+
+	restartableProcess := &mockRestartableProcess{}
+	defer restartableProcess.AssertExpectations(t)
+	factory.On("newRestartableProcess", pluginID.Command, map[string]string(nil), logger, logLevel).Return(restartableProcess, nil).Once()
+
+	action, err := m.GetBackupItemAction(name)
+	require.NoError(t, err)
+	assert.Equal(t, &restartableBackupItemAction{
+		key:                 kindAndName{kind: framework.PluginKindBackupItemAction, name: name},
+		sharedPluginProcess: restartableProcess,
+		config:              map[string]string{"key": "value"},
+	}, action)
+}
+
 func TestGetRestoreItemAction(t *testing.T) {
 	getPluginTest(t,
 		framework.PluginKindRestoreItemAction,
@@ -273,13 +413,13 @@ func getPluginTest(
 	defer restartableProcess.AssertExpectations(t)
 
 	// Test 1: error getting restartable process
-	factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
+	factory.On("newRestartableProcess", pluginID.Command, map[string]string(nil), logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
 	actual, err := getPluginFunc(m, pluginName)
 	assert.Nil(t, actual)
 	assert.EqualError(t, err, "newRestartableProcess")
 
 	// Test 2: happy path
-	factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(restartableProcess, nil).Once()
+	factory.On("newRestartableProcess", pluginID.Command, map[string]string(nil), logger, logLevel).Return(restartableProcess, nil).Once()
 
 	expected := expectedResultFunc(name, restartableProcess)
 	if reinitializable {
@@ -356,13 +496,13 @@ func TestGetBackupItemActions(t *testing.T) {
 
 				if tc.newRestartableProcessError != nil {
 					// Test 1: error getting restartable process
-					factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
+					factory.On("newRestartableProcess", pluginID.Command, map[string]string(nil), logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
 					break
 				}
 
 				// Test 2: happy path
 				if i == 0 {
-					factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(restartableProcess, nil).Once()
+					factory.On("newRestartableProcess", pluginID.Command, map[string]string(nil), logger, logLevel).Return(restartableProcess, nil).Once()
 				}
 
 				expectedActions = append(expectedActions, expected)
@@ -448,13 +588,13 @@ func TestGetRestoreItemActions(t *testing.T) {
 
 				if tc.newRestartableProcessError != nil {
 					// Test 1: error getting restartable process
-					factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
+					factory.On("newRestartableProcess", pluginID.Command, map[string]string(nil), logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
 					break
 				}
 
 				// Test 2: happy path
 				if i == 0 {
-					factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(restartableProcess, nil).Once()
+					factory.On("newRestartableProcess", pluginID.Command, map[string]string(nil), logger, logLevel).Return(restartableProcess, nil).Once()
 				}
 
 				expectedActions = append(expectedActions, expected)
@@ -547,13 +687,13 @@ func TestGetDeleteItemActions(t *testing.T) {
 
 				if tc.newRestartableProcessError != nil {
 					// Test 1: error getting restartable process
-					factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
+					factory.On("newRestartableProcess", pluginID.Command, map[string]string(nil), logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
 					break
 				}
 
 				// Test 2: happy path
 				if i == 0 {
-					factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(restartableProcess, nil).Once()
+					factory.On("newRestartableProcess", pluginID.Command, map[string]string(nil), logger, logLevel).Return(restartableProcess, nil).Once()
 				}
 
 				expectedActions = append(expectedActions, expected)
@@ -574,3 +714,221 @@ func TestGetDeleteItemActions(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPostRestoreAction(t *testing.T) {
+	getPluginTest(t,
+		framework.PluginKindPostRestoreAction,
+		"velero.io/smoke-test",
+		func(m Manager, name string) (interface{}, error) {
+			return m.GetPostRestoreAction(name)
+		},
+		func(name string, sharedPluginProcess RestartableProcess) interface{} {
+			return &restartablePostRestoreAction{
+				key:                 kindAndName{kind: framework.PluginKindPostRestoreAction, name: name},
+				sharedPluginProcess: sharedPluginProcess,
+			}
+		},
+		false,
+	)
+}
+
+func TestGetPostRestoreActions(t *testing.T) {
+	tests := []struct {
+		name                       string
+		names                      []string
+		newRestartableProcessError error
+		expectedError              string
+	}{
+		{
+			name:  "No items",
+			names: []string{},
+		},
+		{
+			name:                       "Error getting restartable process",
+			names:                      []string{"velero.io/a", "velero.io/b", "velero.io/c"},
+			newRestartableProcessError: errors.Errorf("newRestartableProcess"),
+			expectedError:              "newRestartableProcess",
+		},
+		{
+			name:  "Happy path",
+			names: []string{"velero.io/a", "velero.io/b", "velero.io/c"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := test.NewLogger()
+			logLevel := logrus.InfoLevel
+
+			registry := &mockRegistry{}
+			defer registry.AssertExpectations(t)
+
+			m := NewManager(logger, logLevel, registry).(*manager)
+			factory := &mockRestartableProcessFactory{}
+			defer factory.AssertExpectations(t)
+			m.restartableProcessFactory = factory
+
+			pluginKind := framework.PluginKindPostRestoreAction
+			var pluginIDs []framework.PluginIdentifier
+			for i := range tc.names {
+				pluginID := framework.PluginIdentifier{
+					Command: "/command",
+					Kind:    pluginKind,
+					Name:    tc.names[i],
+				}
+				pluginIDs = append(pluginIDs, pluginID)
+			}
+			registry.On("List", pluginKind).Return(pluginIDs)
+
+			var expectedActions []interface{}
+			for i := range pluginIDs {
+				pluginID := pluginIDs[i]
+				pluginName := pluginID.Name
+
+				registry.On("Get", pluginKind, pluginName).Return(pluginID, nil)
+
+				restartableProcess := &mockRestartableProcess{}
+				defer restartableProcess.AssertExpectations(t)
+
+				expected := &restartablePostRestoreAction{
+					key:                 kindAndName{kind: pluginKind, name: pluginName},
+					sharedPluginProcess: restartableProcess,
+				}
+
+				if tc.newRestartableProcessError != nil {
+					// Test 1: error getting restartable process
+					factory.On("newRestartableProcess", pluginID.Command, map[string]string(nil), logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
+					break
+				}
+
+				// Test 2: happy path
+				if i == 0 {
+					factory.On("newRestartableProcess", pluginID.Command, map[string]string(nil), logger, logLevel).Return(restartableProcess, nil).Once()
+				}
+
+				expectedActions = append(expectedActions, expected)
+			}
+
+			postRestoreActions, err := m.GetPostRestoreActions()
+			if tc.newRestartableProcessError != nil {
+				assert.Nil(t, postRestoreActions)
+				assert.EqualError(t, err, "newRestartableProcess")
+			} else {
+				require.NoError(t, err)
+				var actual []interface{}
+				for i := range postRestoreActions {
+					actual = append(actual, postRestoreActions[i])
+				}
+				assert.Equal(t, expectedActions, actual)
+			}
+		})
+	}
+}
+
+func TestGetItemBlockAction(t *testing.T) {
+	getPluginTest(t,
+		framework.PluginKindItemBlockAction,
+		"velero.io/database",
+		func(m Manager, name string) (interface{}, error) {
+			return m.GetItemBlockAction(name)
+		},
+		func(name string, sharedPluginProcess RestartableProcess) interface{} {
+			return &restartableItemBlockAction{
+				key:                 kindAndName{kind: framework.PluginKindItemBlockAction, name: name},
+				sharedPluginProcess: sharedPluginProcess,
+			}
+		},
+		false,
+	)
+}
+
+func TestGetItemBlockActions(t *testing.T) {
+	tests := []struct {
+		name                       string
+		names                      []string
+		newRestartableProcessError error
+		expectedError              string
+	}{
+		{
+			name:  "No items",
+			names: []string{},
+		},
+		{
+			name:                       "Error getting restartable process",
+			names:                      []string{"velero.io/a", "velero.io/b", "velero.io/c"},
+			newRestartableProcessError: errors.Errorf("newRestartableProcess"),
+			expectedError:              "newRestartableProcess",
+		},
+		{
+			name:  "Happy path",
+			names: []string{"velero.io/a", "velero.io/b", "velero.io/c"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := test.NewLogger()
+			logLevel := logrus.InfoLevel
+
+			registry := &mockRegistry{}
+			defer registry.AssertExpectations(t)
+
+			m := NewManager(logger, logLevel, registry).(*manager)
+			factory := &mockRestartableProcessFactory{}
+			defer factory.AssertExpectations(t)
+			m.restartableProcessFactory = factory
+
+			pluginKind := framework.PluginKindItemBlockAction
+			var pluginIDs []framework.PluginIdentifier
+			for i := range tc.names {
+				pluginID := framework.PluginIdentifier{
+					Command: "/command",
+					Kind:    pluginKind,
+					Name:    tc.names[i],
+				}
+				pluginIDs = append(pluginIDs, pluginID)
+			}
+			registry.On("List", pluginKind).Return(pluginIDs)
+
+			var expectedActions []interface{}
+			for i := range pluginIDs {
+				pluginID := pluginIDs[i]
+				pluginName := pluginID.Name
+
+				registry.On("Get", pluginKind, pluginName).Return(pluginID, nil)
+
+				restartableProcess := &mockRestartableProcess{}
+				defer restartableProcess.AssertExpectations(t)
+
+				expected := &restartableItemBlockAction{
+					key:                 kindAndName{kind: pluginKind, name: pluginName},
+					sharedPluginProcess: restartableProcess,
+				}
+
+				if tc.newRestartableProcessError != nil {
+					// Test 1: error getting restartable process
+					factory.On("newRestartableProcess", pluginID.Command, map[string]string(nil), logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
+					break
+				}
+
+				// Test 2: happy path
+				if i == 0 {
+					factory.On("newRestartableProcess", pluginID.Command, map[string]string(nil), logger, logLevel).Return(restartableProcess, nil).Once()
+				}
+
+				expectedActions = append(expectedActions, expected)
+			}
+
+			itemBlockActions, err := m.GetItemBlockActions()
+			if tc.newRestartableProcessError != nil {
+				assert.Nil(t, itemBlockActions)
+				assert.EqualError(t, err, "newRestartableProcess")
+			} else {
+				require.NoError(t, err)
+				var actual []interface{}
+				for i := range itemBlockActions {
+					actual = append(actual, itemBlockActions[i])
+				}
+				assert.Equal(t, expectedActions, actual)
+			}
+		})
+	}
+}
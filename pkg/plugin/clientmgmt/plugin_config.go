@@ -0,0 +1,111 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// PluginConfigLabel is the label that, along with a "<plugin name>: <plugin kind>" label, identifies
+// the ConfigMap in Velero's namespace that holds a plugin's configuration.
+const PluginConfigLabel = "velero.io/plugin-config"
+
+// pluginConfigs gets the data of plugin ConfigMaps, caching it for the life of the Manager.
+type pluginConfigs struct {
+	client corev1client.ConfigMapInterface
+
+	lock    sync.Mutex
+	configs map[kindAndName]map[string]string
+}
+
+func newPluginConfigs(client corev1client.ConfigMapInterface) *pluginConfigs {
+	return &pluginConfigs{
+		client:  client,
+		configs: make(map[kindAndName]map[string]string),
+	}
+}
+
+// get returns the data of the ConfigMap for the plugin of the given kind and name, or an empty
+// map if it doesn't have one.
+func (p *pluginConfigs) get(kind framework.PluginKind, name string) (map[string]string, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	key := kindAndName{kind: kind, name: name}
+	if config, found := p.configs[key]; found {
+		return config, nil
+	}
+
+	selector := fmt.Sprintf("%s,%s=%s", PluginConfigLabel, name, kind)
+	list, err := p.client.List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing ConfigMaps for plugin %s", name)
+	}
+
+	config := map[string]string{}
+	switch len(list.Items) {
+	case 0:
+	case 1:
+		for k, v := range list.Items[0].Data {
+			config[k] = v
+		}
+	default:
+		var items []string
+		for _, item := range list.Items {
+			items = append(items, item.Name)
+		}
+		return nil, errors.Errorf("found more than one ConfigMap matching label selector %q: %v", selector, items)
+	}
+
+	p.configs[key] = config
+	return config, nil
+}
+
+// configure passes config to delegate if it's a velero.Configurable.
+func configure(delegate interface{}, config map[string]string) error {
+	configurable, ok := delegate.(velero.Configurable)
+	if !ok {
+		return nil
+	}
+	return configurable.Configure(config)
+}
+
+// mergePluginConfig returns the config to initialize an object store or volume snapshotter with:
+// its plugin ConfigMap's data, overridden by the location's config.
+func mergePluginConfig(pluginConfig, config map[string]string) map[string]string {
+	if len(pluginConfig) == 0 {
+		return config
+	}
+
+	merged := make(map[string]string, len(pluginConfig)+len(config))
+	for k, v := range pluginConfig {
+		merged[k] = v
+	}
+	for k, v := range config {
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vmware-tanzu/velero/pkg/backup/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+)
+
+func pluginConfigMap(name string, labels map[string]string, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      name,
+			Labels:    labels,
+		},
+		Data: data,
+	}
+}
+
+func TestPluginConfigsGet(t *testing.T) {
+	tests := []struct {
+		name           string
+		configMaps     []runtime.Object
+		expectedConfig map[string]string
+		expectedErr    string
+	}{
+		{
+			name:           "no ConfigMaps gives an empty config",
+			expectedConfig: map[string]string{},
+		},
+		{
+			name: "ConfigMap for the plugin gives its data",
+			configMaps: []runtime.Object{
+				pluginConfigMap("pod-config", map[string]string{PluginConfigLabel: "", "velero.io/pod": "BackupItemAction"}, map[string]string{"key": "value"}),
+			},
+			expectedConfig: map[string]string{"key": "value"},
+		},
+		{
+			name: "ConfigMaps for other kinds and names and without the plugin config label are ignored",
+			configMaps: []runtime.Object{
+				pluginConfigMap("restore-config", map[string]string{PluginConfigLabel: "", "velero.io/pod": "RestoreItemAction"}, map[string]string{"key": "restore"}),
+				pluginConfigMap("pvc-config", map[string]string{PluginConfigLabel: "", "velero.io/pvc": "BackupItemAction"}, map[string]string{"key": "pvc"}),
+				pluginConfigMap("unlabeled", map[string]string{"velero.io/pod": "BackupItemAction"}, map[string]string{"key": "unlabeled"}),
+			},
+			expectedConfig: map[string]string{},
+		},
+		{
+			name: "more than one ConfigMap for the plugin is an error",
+			configMaps: []runtime.Object{
+				pluginConfigMap("pod-config-1", map[string]string{PluginConfigLabel: "", "velero.io/pod": "BackupItemAction"}, nil),
+				pluginConfigMap("pod-config-2", map[string]string{PluginConfigLabel: "", "velero.io/pod": "BackupItemAction"}, nil),
+			},
+			expectedErr: `found more than one ConfigMap matching label selector "velero.io/plugin-config,velero.io/pod=BackupItemAction": [pod-config-1 pod-config-2]`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(tc.configMaps...)
+			configs := newPluginConfigs(client.CoreV1().ConfigMaps("velero"))
+
+			config, err := configs.get(framework.PluginKindBackupItemAction, "velero.io/pod")
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedConfig, config)
+		})
+	}
+}
+
+func TestPluginConfigsGetCaches(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		pluginConfigMap("pod-config", map[string]string{PluginConfigLabel: "", "velero.io/pod": "BackupItemAction"}, map[string]string{"key": "value"}),
+	)
+	configs := newPluginConfigs(client.CoreV1().ConfigMaps("velero"))
+
+	config, err := configs.get(framework.PluginKindBackupItemAction, "velero.io/pod")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"key": "value"}, config)
+
+	require.NoError(t, client.CoreV1().ConfigMaps("velero").Delete(context.TODO(), "pod-config", metav1.DeleteOptions{}))
+
+	config, err = configs.get(framework.PluginKindBackupItemAction, "velero.io/pod")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"key": "value"}, config)
+}
+
+type configurableItemAction struct {
+	*mocks.ItemAction
+	config       map[string]string
+	configureErr error
+}
+
+func (a *configurableItemAction) Configure(config map[string]string) error {
+	a.config = config
+	return a.configureErr
+}
+
+func TestConfigure(t *testing.T) {
+	config := map[string]string{"key": "value"}
+
+	// Delegates that aren't configurable are left alone.
+	assert.NoError(t, configure(new(mocks.ItemAction), config))
+
+	action := &configurableItemAction{}
+	assert.NoError(t, configure(action, config))
+	assert.Equal(t, config, action.config)
+
+	action = &configurableItemAction{configureErr: errors.New("bad config")}
+	assert.EqualError(t, configure(action, config), "bad config")
+
+}
+
+func TestMergePluginConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		pluginConfig map[string]string
+		config       map[string]string
+		expected     map[string]string
+	}{
+		{
+			name: "no plugin config leaves config as is",
+		},
+		{
+			name:     "no plugin config leaves non-nil config as is",
+			config:   map[string]string{"region": "us-east-1"},
+			expected: map[string]string{"region": "us-east-1"},
+		},
+		{
+			name:         "plugin config is used when there's no config",
+			pluginConfig: map[string]string{"region": "us-east-1"},
+			expected:     map[string]string{"region": "us-east-1"},
+		},
+		{
+			name:         "config overrides plugin config",
+			pluginConfig: map[string]string{"region": "us-east-1", "profile": "default"},
+			config:       map[string]string{"region": "us-west-2"},
+			expected:     map[string]string{"region": "us-west-2", "profile": "default"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mergePluginConfig(tc.pluginConfig, tc.config))
+		})
+	}
+}
@@ -18,6 +18,7 @@ package clientmgmt
 
 import (
 	"strings"
+	"time"
 
 	plugin "github.com/hashicorp/go-plugin"
 	"github.com/pkg/errors"
@@ -27,7 +28,7 @@ import (
 )
 
 type ProcessFactory interface {
-	newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error)
+	newProcess(command string, env map[string]string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error)
 }
 
 type processFactory struct {
@@ -37,26 +38,43 @@ func newProcessFactory() ProcessFactory {
 	return &processFactory{}
 }
 
-func (pf *processFactory) newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error) {
-	return newProcess(command, logger, logLevel)
+func (pf *processFactory) newProcess(command string, env map[string]string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error) {
+	return newProcess(command, env, logger, logLevel, nil)
 }
 
 type Process interface {
 	dispense(key kindAndName) (interface{}, error)
 	exited() bool
+	ping() error
 	kill()
 }
 
+// healthCheckTimeout is how long a plugin process has to respond to a health check.
+const healthCheckTimeout = 10 * time.Second
+
 type process struct {
 	client         *plugin.Client
 	protocolClient plugin.ClientProtocol
 }
 
-func newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error) {
+// newProcess launches a plugin process. If timeout is non-nil, calls to the process's plugins are
+// bounded by the duration it returns for each plugin's name, and the process is killed when one
+// exceeds it, since the plugin is likely wedged.
+func newProcess(command string, env map[string]string, logger logrus.FieldLogger, logLevel logrus.Level, timeout func(name string) time.Duration) (Process, error) {
 	builder := newClientBuilder(command, logger.WithField("cmd", command), logLevel)
+	builder.env = env
+
+	var client *plugin.Client
+	if timeout != nil {
+		builder.timeout = timeout
+		builder.onTimeout = func(name string) {
+			logger.WithField("name", name).Warn("Plugin call timed out - killing the plugin process so it's restarted.")
+			client.Kill()
+		}
+	}
 
 	// This creates a new go-plugin Client that has its own unique exec.Cmd for launching the plugin process.
-	client := builder.client()
+	client = builder.client()
 
 	// This launches the plugin process.
 	protocolClient, err := client.Client()
@@ -148,6 +166,21 @@ func (r *process) exited() bool {
 	return r.client.Exited()
 }
 
+// ping checks that the plugin process is responsive.
+func (r *process) ping() error {
+	res := make(chan error, 1)
+	go func() {
+		res <- r.protocolClient.Ping()
+	}()
+
+	select {
+	case err := <-res:
+		return errors.WithStack(err)
+	case <-time.After(healthCheckTimeout):
+		return errors.Errorf("plugin process did not respond to a health check within %v", healthCheckTimeout)
+	}
+}
+
 func (r *process) kill() {
 	r.client.Kill()
 }
@@ -0,0 +1,141 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ProcessStatuses tracks the status of plugin processes across all of a server's Managers,
+// by the command of the plugin executable they run. A nil *ProcessStatuses tracks nothing.
+type ProcessStatuses struct {
+	clock clock.Clock
+
+	lock     sync.Mutex
+	statuses map[string]*processStatus
+}
+
+type processStatus struct {
+	velerov1api.PluginProcessStatus
+
+	// processes is the number of started processes that haven't been stopped.
+	processes int
+}
+
+// NewProcessStatuses returns an empty ProcessStatuses.
+func NewProcessStatuses(clock clock.Clock) *ProcessStatuses {
+	return &ProcessStatuses{
+		clock:    clock,
+		statuses: make(map[string]*processStatus),
+	}
+}
+
+// Get returns the status of the processes for command, or nil if none have been started.
+func (s *ProcessStatuses) Get(command string) *velerov1api.PluginProcessStatus {
+	if s == nil {
+		return nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	status, found := s.statuses[command]
+	if !found {
+		return nil
+	}
+	return status.DeepCopy()
+}
+
+// statusLH returns the status for command, creating it if needed.
+//
+// Callers of statusLH *must* acquire the lock before calling it.
+func (s *ProcessStatuses) statusLH(command string) *processStatus {
+	status, found := s.statuses[command]
+	if !found {
+		status = new(processStatus)
+		s.statuses[command] = status
+	}
+	return status
+}
+
+// started records that a process for command was started. restarted is whether it replaced one
+// that crashed, failed its health check, or timed out.
+func (s *ProcessStatuses) started(command string, restarted bool) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	status := s.statusLH(command)
+	status.Phase = velerov1api.PluginProcessPhaseRunning
+	if restarted {
+		status.Restarts++
+		status.LastRestartTime = &metav1.Time{Time: s.clock.Now()}
+	} else {
+		status.processes++
+	}
+}
+
+// restarting records that a process for command is being restarted because of err.
+func (s *ProcessStatuses) restarting(command string, err error) {
+	s.record(command, velerov1api.PluginProcessPhaseRestarting, err)
+}
+
+// failed records that a process for command could not be restarted because of err.
+func (s *ProcessStatuses) failed(command string, err error) {
+	s.record(command, velerov1api.PluginProcessPhaseFailed, err)
+}
+
+func (s *ProcessStatuses) record(command string, phase velerov1api.PluginProcessPhase, err error) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	status := s.statusLH(command)
+	status.Phase = phase
+	if err != nil {
+		status.LastError = err.Error()
+	}
+}
+
+// stopped records that a started process for command was stopped.
+func (s *ProcessStatuses) stopped(command string) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	status := s.statusLH(command)
+	if status.processes > 0 {
+		status.processes--
+	}
+	if status.processes == 0 {
+		status.Phase = velerov1api.PluginProcessPhaseStopped
+	}
+}
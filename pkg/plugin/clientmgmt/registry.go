@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -30,7 +32,9 @@ import (
 
 // Registry manages information about available plugins.
 type Registry interface {
-	// DiscoverPlugins discovers all available plugins.
+	// DiscoverPlugins discovers all available plugins, replacing the plugins discovered before.
+	// It can be called again to register plugins added to the plugin directory since, and to
+	// unregister plugins removed from it.
 	DiscoverPlugins() error
 	// List returns all PluginIdentifiers for kind.
 	List(kind framework.PluginKind) []framework.PluginIdentifier
@@ -53,8 +57,22 @@ type registry struct {
 
 	processFactory ProcessFactory
 	fs             filesystem.Interface
-	pluginsByID    map[kindAndName]framework.PluginIdentifier
-	pluginsByKind  map[framework.PluginKind][]framework.PluginIdentifier
+
+	// lock guards the fields below
+	lock          sync.RWMutex
+	pluginsByID   map[kindAndName]framework.PluginIdentifier
+	pluginsByKind map[framework.PluginKind][]framework.PluginIdentifier
+	// listed caches the plugins each command was last found to have, so that
+	// commands that haven't changed aren't run again to rediscover them.
+	listed map[string]listedPlugins
+}
+
+// listedPlugins are the plugins a command was found to have when its executable
+// had the given modification time and size.
+type listedPlugins struct {
+	modTime time.Time
+	size    int64
+	plugins []framework.PluginIdentifier
 }
 
 // NewRegistry returns a new registry.
@@ -68,6 +86,7 @@ func NewRegistry(dir string, logger logrus.FieldLogger, logLevel logrus.Level) R
 		fs:             filesystem.NewFileSystem(),
 		pluginsByID:    make(map[kindAndName]framework.PluginIdentifier),
 		pluginsByKind:  make(map[framework.PluginKind][]framework.PluginIdentifier),
+		listed:         make(map[string]listedPlugins),
 	}
 }
 
@@ -85,38 +104,112 @@ func (r *registry) DiscoverPlugins() error {
 	return r.discoverPlugins(commands)
 }
 
+// discoverPlugins registers the plugins of commands in place of the registered plugins. If any of
+// them can't be registered, the registered plugins are left as they are.
 func (r *registry) discoverPlugins(commands []string) error {
+	discovered := &registry{
+		pluginsByID:   make(map[kindAndName]framework.PluginIdentifier),
+		pluginsByKind: make(map[framework.PluginKind][]framework.PluginIdentifier),
+		listed:        make(map[string]listedPlugins),
+	}
+
 	for _, command := range commands {
-		plugins, err := r.listPlugins(command)
+		plugins, err := r.listPluginsIfChanged(command, discovered.listed)
 		if err != nil {
 			return err
 		}
 
 		for _, plugin := range plugins {
-			r.logger.WithFields(logrus.Fields{
-				"kind":    plugin.Kind,
-				"name":    plugin.Name,
-				"command": command,
-			}).Info("registering plugin")
+			apiVersion, err := framework.NegotiateAPIVersion(plugin.Kind, plugin.APIVersions)
+			if err != nil {
+				return errors.Wrapf(err, "unable to register plugin (kind=%s, name=%s, command=%s)", plugin.Kind, plugin.Name, command)
+			}
+			plugin.APIVersion = apiVersion
 
-			if err := r.register(plugin); err != nil {
+			if err := discovered.register(plugin); err != nil {
 				return err
 			}
 		}
 	}
 
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for key, plugin := range discovered.pluginsByID {
+		if existing, found := r.pluginsByID[key]; found && existing.Command == plugin.Command && existing.APIVersion == plugin.APIVersion {
+			continue
+		}
+		r.logger.WithFields(logrus.Fields{
+			"kind":       plugin.Kind,
+			"name":       plugin.Name,
+			"command":    plugin.Command,
+			"apiVersion": plugin.APIVersion,
+		}).Info("registering plugin")
+	}
+	for key, plugin := range r.pluginsByID {
+		if _, found := discovered.pluginsByID[key]; !found {
+			r.logger.WithFields(logrus.Fields{
+				"kind":    plugin.Kind,
+				"name":    plugin.Name,
+				"command": plugin.Command,
+			}).Info("unregistering plugin")
+		}
+	}
+
+	r.pluginsByID = discovered.pluginsByID
+	r.pluginsByKind = discovered.pluginsByKind
+	r.listed = discovered.listed
+
 	return nil
 }
 
+// listPluginsIfChanged returns the plugins of command, listing them if its executable has changed since
+// they were last listed, and records them in listed.
+func (r *registry) listPluginsIfChanged(command string, listed map[string]listedPlugins) ([]framework.PluginIdentifier, error) {
+	info, statErr := r.fs.Stat(command)
+
+	r.lock.RLock()
+	previous, found := r.listed[command]
+	r.lock.RUnlock()
+
+	if statErr == nil && found && previous.modTime.Equal(info.ModTime()) && previous.size == info.Size() {
+		listed[command] = previous
+		return previous.plugins, nil
+	}
+
+	plugins, err := r.listPlugins(command)
+	if err != nil {
+		return nil, err
+	}
+
+	// commands that can't be stat'd, such as velero itself when it's run from the PATH,
+	// are listed every time.
+	if statErr == nil {
+		listed[command] = listedPlugins{
+			modTime: info.ModTime(),
+			size:    info.Size(),
+			plugins: plugins,
+		}
+	}
+
+	return plugins, nil
+}
+
 // List returns info about all plugin binaries that implement the given
 // PluginKind.
 func (r *registry) List(kind framework.PluginKind) []framework.PluginIdentifier {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
 	return r.pluginsByKind[kind]
 }
 
 // Get returns info about a plugin with the given name and kind, or an
 // error if one cannot be found.
 func (r *registry) Get(kind framework.PluginKind, name string) (framework.PluginIdentifier, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
 	p, found := r.pluginsByID[kindAndName{kind: kind, name: name}]
 	if !found {
 		return framework.PluginIdentifier{}, newPluginNotFoundError(kind, name)
@@ -176,7 +269,7 @@ func executable(info os.FileInfo) bool {
 
 // listPlugins executes command, queries it for registered plugins, and returns the list of PluginIdentifiers.
 func (r *registry) listPlugins(command string) ([]framework.PluginIdentifier, error) {
-	process, err := r.processFactory.newProcess(command, r.logger, r.logLevel)
+	process, err := r.processFactory.newProcess(command, nil, r.logger, r.logLevel)
 	if err != nil {
 		return nil, err
 	}
@@ -196,6 +289,8 @@ func (r *registry) listPlugins(command string) ([]framework.PluginIdentifier, er
 }
 
 // register registers a PluginIdentifier with the registry.
+//
+// Callers of register *must* acquire the lock before calling it, unless the registry isn't shared.
 func (r *registry) register(id framework.PluginIdentifier) error {
 	key := kindAndName{kind: id.Kind, name: id.Name}
 	if existing, found := r.pluginsByID[key]; found {
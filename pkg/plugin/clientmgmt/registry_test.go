@@ -20,10 +20,12 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/test"
 )
 
@@ -128,3 +130,77 @@ func TestReadPluginsDir(t *testing.T) {
 	sort.Strings(expected)
 	assert.Equal(t, expected, plugins)
 }
+
+// fakeProcessFactory launches mockProcesses that list the plugins in pluginsByCommand, and
+// records the commands it launches.
+type fakeProcessFactory struct {
+	pluginsByCommand map[string][]framework.PluginIdentifier
+	launched         []string
+}
+
+func (pf *fakeProcessFactory) newProcess(command string, env map[string]string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error) {
+	pf.launched = append(pf.launched, command)
+
+	plugins, found := pf.pluginsByCommand[command]
+	if !found {
+		return nil, errors.Errorf("unable to launch %s", command)
+	}
+
+	process := new(mockProcess)
+	process.On("dispense", kindAndName{kind: framework.PluginKindPluginLister}).Return(framework.NewPluginLister(plugins...), nil)
+	process.On("kill").Return()
+	return process, nil
+}
+
+func TestDiscoverPluginsAgain(t *testing.T) {
+	objectStore := framework.PluginIdentifier{Command: "/plugins/a", Kind: framework.PluginKindObjectStore, Name: "velero.io/a"}
+	backupItemAction := framework.PluginIdentifier{Command: "/plugins/b", Kind: framework.PluginKindBackupItemAction, Name: "velero.io/b"}
+	restoreItemAction := framework.PluginIdentifier{Command: "/plugins/b", Kind: framework.PluginKindRestoreItemAction, Name: "velero.io/b"}
+
+	fs := test.NewFakeFileSystem().
+		WithFileAndMode("/plugins/a", []byte("a"), 0755).
+		WithFileAndMode("/plugins/b", []byte("b"), 0755)
+	processFactory := &fakeProcessFactory{
+		pluginsByCommand: map[string][]framework.PluginIdentifier{
+			"/plugins/a": {objectStore},
+			"/plugins/b": {backupItemAction},
+		},
+	}
+
+	r := NewRegistry("/plugins", test.NewLogger(), logrus.InfoLevel).(*registry)
+	r.fs = fs
+	r.processFactory = processFactory
+
+	// the first discovery lists every command's plugins
+	require.NoError(t, r.discoverPlugins([]string{"/plugins/a", "/plugins/b"}))
+	assert.Equal(t, []string{"/plugins/a", "/plugins/b"}, processFactory.launched)
+	assert.Len(t, r.List(framework.PluginKindObjectStore), 1)
+	assert.Len(t, r.List(framework.PluginKindBackupItemAction), 1)
+
+	// discovering again doesn't list the plugins of commands that haven't changed
+	processFactory.launched = nil
+	require.NoError(t, r.discoverPlugins([]string{"/plugins/a", "/plugins/b"}))
+	assert.Empty(t, processFactory.launched)
+	assert.Len(t, r.List(framework.PluginKindObjectStore), 1)
+	assert.Len(t, r.List(framework.PluginKindBackupItemAction), 1)
+
+	// a changed command is listed again, and a removed one is unregistered
+	processFactory.launched = nil
+	fs.WithFileAndMode("/plugins/b", []byte("b, with a restore item action"), 0755)
+	processFactory.pluginsByCommand["/plugins/b"] = []framework.PluginIdentifier{backupItemAction, restoreItemAction}
+	require.NoError(t, fs.RemoveAll("/plugins/a"))
+
+	require.NoError(t, r.discoverPlugins([]string{"/plugins/b"}))
+	assert.Equal(t, []string{"/plugins/b"}, processFactory.launched)
+	assert.Empty(t, r.List(framework.PluginKindObjectStore))
+	_, err := r.Get(framework.PluginKindObjectStore, "velero.io/a")
+	assert.Error(t, err)
+	assert.Len(t, r.List(framework.PluginKindBackupItemAction), 1)
+	assert.Len(t, r.List(framework.PluginKindRestoreItemAction), 1)
+
+	// if a command's plugins can't be listed, the registered plugins are kept
+	fs.WithFileAndMode("/plugins/c", []byte("c"), 0755)
+	require.Error(t, r.discoverPlugins([]string{"/plugins/b", "/plugins/c"}))
+	assert.Len(t, r.List(framework.PluginKindBackupItemAction), 1)
+	assert.Len(t, r.List(framework.PluginKindRestoreItemAction), 1)
+}
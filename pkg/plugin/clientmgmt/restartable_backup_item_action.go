@@ -17,6 +17,8 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -32,6 +34,9 @@ import (
 type restartableBackupItemAction struct {
 	key                 kindAndName
 	sharedPluginProcess RestartableProcess
+	calls               callMetrics
+	// config is the data of the plugin's ConfigMap, which the plugin is configured with before each call to AppliesTo.
+	config map[string]string
 }
 
 // newRestartableBackupItemAction returns a new restartableBackupItemAction.
@@ -75,7 +80,14 @@ func (r *restartableBackupItemAction) AppliesTo() (velero.ResourceSelector, erro
 		return velero.ResourceSelector{}, err
 	}
 
-	return delegate.AppliesTo()
+	if err := configure(delegate, r.config); err != nil {
+		return velero.ResourceSelector{}, err
+	}
+
+	start := time.Now()
+	selector, err := delegate.AppliesTo()
+	r.calls.observe(r.key, "AppliesTo", start, err)
+	return selector, err
 }
 
 // Execute restarts the plugin's process if needed, then delegates the call.
@@ -85,5 +97,56 @@ func (r *restartableBackupItemAction) Execute(item runtime.Unstructured, backup
 		return nil, nil, err
 	}
 
-	return delegate.Execute(item, backup)
+	start := time.Now()
+	updatedItem, additionalItems, err := delegate.Execute(item, backup)
+	r.calls.observe(r.key, "Execute", start, err)
+	return updatedItem, additionalItems, err
+}
+
+// restartableBackupItemActionV2 is a restartableBackupItemAction for a plugin that Velero uses with version 2
+// of the BackupItemAction API.
+type restartableBackupItemActionV2 struct {
+	*restartableBackupItemAction
+}
+
+// getDelegateV2 restarts the plugin process (if needed) and returns the version 2 backup item action for this
+// restartableBackupItemActionV2.
+func (r *restartableBackupItemActionV2) getDelegateV2() (velero.BackupItemActionV2, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+
+	backupItemAction, ok := delegate.(velero.BackupItemActionV2)
+	if !ok {
+		return nil, errors.Errorf("%T is not a BackupItemActionV2!", delegate)
+	}
+
+	return backupItemAction, nil
+}
+
+// Progress restarts the plugin's process if needed, then delegates the call.
+func (r *restartableBackupItemActionV2) Progress(item velero.ResourceIdentifier, backup *api.Backup) (velero.OperationProgress, error) {
+	delegate, err := r.getDelegateV2()
+	if err != nil {
+		return velero.OperationProgress{}, err
+	}
+
+	start := time.Now()
+	progress, err := delegate.Progress(item, backup)
+	r.calls.observe(r.key, "Progress", start, err)
+	return progress, err
+}
+
+// Cancel restarts the plugin's process if needed, then delegates the call.
+func (r *restartableBackupItemActionV2) Cancel(item velero.ResourceIdentifier, backup *api.Backup) error {
+	delegate, err := r.getDelegateV2()
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	err = delegate.Cancel(item, backup)
+	r.calls.observe(r.key, "Cancel", start, err)
+	return err
 }
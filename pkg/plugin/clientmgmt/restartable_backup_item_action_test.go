@@ -146,3 +146,89 @@ func TestRestartableBackupItemActionDelegatedFunctions(t *testing.T) {
 		},
 	)
 }
+
+func TestRestartableBackupItemActionAppliesToConfigures(t *testing.T) {
+	p := new(mockRestartableProcess)
+	defer p.AssertExpectations(t)
+
+	name := "pod"
+	key := kindAndName{kind: framework.PluginKindBackupItemAction, name: name}
+	config := map[string]string{"key": "value"}
+
+	itemAction := new(mocks.ItemAction)
+	defer itemAction.AssertExpectations(t)
+	delegate := &configurableItemAction{ItemAction: itemAction}
+
+	p.On("resetIfNeeded").Return(nil)
+	p.On("getByKindAndName", key).Return(delegate, nil)
+
+	r := newRestartableBackupItemAction(name, p)
+	r.config = config
+
+	// Configure error
+	delegate.configureErr = errors.Errorf("configure error")
+	_, err := r.AppliesTo()
+	assert.EqualError(t, err, "configure error")
+	assert.Equal(t, config, delegate.config)
+
+	// Happy path
+	delegate.configureErr = nil
+	delegate.config = nil
+	itemAction.On("AppliesTo").Return(velero.ResourceSelector{IncludedResources: []string{"pods"}}, nil).Once()
+	selector, err := r.AppliesTo()
+	require.NoError(t, err)
+	assert.Equal(t, velero.ResourceSelector{IncludedResources: []string{"pods"}}, selector)
+	assert.Equal(t, config, delegate.config)
+}
+
+func TestRestartableBackupItemActionV2DelegatedFunctions(t *testing.T) {
+	b := new(v1.Backup)
+
+	item := velero.ResourceIdentifier{
+		GroupResource: schema.GroupResource{Resource: "persistentvolumeclaims"},
+		Namespace:     "ns",
+		Name:          "pvc",
+	}
+
+	runRestartableDelegateTests(
+		t,
+		framework.PluginKindBackupItemAction,
+		func(key kindAndName, p RestartableProcess) interface{} {
+			return &restartableBackupItemActionV2{
+				restartableBackupItemAction: &restartableBackupItemAction{
+					key:                 key,
+					sharedPluginProcess: p,
+				},
+			}
+		},
+		func() mockable {
+			return new(mocks.ItemActionV2)
+		},
+		restartableDelegateTest{
+			function:                "Progress",
+			inputs:                  []interface{}{item, b},
+			expectedErrorOutputs:    []interface{}{velero.OperationProgress{}, errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{velero.OperationProgress{NCompleted: 1, NTotal: 2}, errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "Cancel",
+			inputs:                  []interface{}{item, b},
+			expectedErrorOutputs:    []interface{}{errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{errors.Errorf("delegate error")},
+		},
+	)
+}
+
+func TestRestartableBackupItemActionV2GetDelegate(t *testing.T) {
+	p := new(mockRestartableProcess)
+	defer p.AssertExpectations(t)
+
+	name := "pod"
+	key := kindAndName{kind: framework.PluginKindBackupItemAction, name: name}
+	p.On("resetIfNeeded").Return(nil)
+	p.On("getByKindAndName", key).Return(new(mocks.ItemAction), nil)
+
+	r := &restartableBackupItemActionV2{newRestartableBackupItemAction(name, p)}
+	_, err := r.getDelegateV2()
+	assert.EqualError(t, err, "*mocks.ItemAction is not a BackupItemActionV2!")
+}
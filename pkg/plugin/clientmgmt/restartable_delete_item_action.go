@@ -17,6 +17,8 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
@@ -30,7 +32,9 @@ import (
 type restartableDeleteItemAction struct {
 	key                 kindAndName
 	sharedPluginProcess RestartableProcess
-	config              map[string]string
+	calls               callMetrics
+	// config is the data of the plugin's ConfigMap, which the plugin is configured with before each call to AppliesTo.
+	config map[string]string
 }
 
 // newRestartableDeleteItemAction returns a new restartableDeleteItemAction.
@@ -74,7 +78,14 @@ func (r *restartableDeleteItemAction) AppliesTo() (velero.ResourceSelector, erro
 		return velero.ResourceSelector{}, err
 	}
 
-	return delegate.AppliesTo()
+	if err := configure(delegate, r.config); err != nil {
+		return velero.ResourceSelector{}, err
+	}
+
+	start := time.Now()
+	selector, err := delegate.AppliesTo()
+	r.calls.observe(r.key, "AppliesTo", start, err)
+	return selector, err
 }
 
 // Execute restarts the plugin's process if needed, then delegates the call.
@@ -84,5 +95,8 @@ func (r *restartableDeleteItemAction) Execute(input *velero.DeleteItemActionExec
 		return err
 	}
 
-	return delegate.Execute(input)
+	start := time.Now()
+	err = delegate.Execute(input)
+	r.calls.observe(r.key, "Execute", start, err)
+	return err
 }
@@ -26,6 +26,10 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
+// maxObjectResumes is how many times in a row reading an object from an object store plugin is
+// resumed after failing, before the failure is returned.
+const maxObjectResumes = 3
+
 // restartableObjectStore is an object store for a given implementation (such as "aws"). It is associated with
 // a restartableProcess, which may be shared and used to run multiple plugins. At the beginning of each method
 // call, the restartableObjectStore asks its restartableProcess to restart itself if needed (e.g. if the
@@ -33,9 +37,12 @@ import (
 type restartableObjectStore struct {
 	key                 kindAndName
 	sharedPluginProcess RestartableProcess
+	calls               callMetrics
 	// config contains the data used to initialize the plugin. It is used to reinitialize the plugin in the event its
 	// sharedPluginProcess gets restarted.
 	config map[string]string
+	// pluginConfig is the data of the plugin's ConfigMap, which provides defaults for the config passed to Init.
+	pluginConfig map[string]string
 }
 
 // newRestartableObjectStore returns a new restartableObjectStore.
@@ -100,15 +107,18 @@ func (r *restartableObjectStore) Init(config map[string]string) error {
 		return err
 	}
 
-	r.config = config
+	r.config = mergePluginConfig(r.pluginConfig, config)
 
-	return r.init(delegate, config)
+	return r.init(delegate, r.config)
 }
 
 // init calls Init on objectStore with config. This is split out from Init() so that both Init() and reinitialize() may
 // call it using a specific ObjectStore.
 func (r *restartableObjectStore) init(objectStore velero.ObjectStore, config map[string]string) error {
-	return objectStore.Init(config)
+	start := time.Now()
+	err := objectStore.Init(config)
+	r.calls.observe(r.key, "Init", start, err)
+	return err
 }
 
 // PutObject restarts the plugin's process if needed, then delegates the call.
@@ -117,7 +127,10 @@ func (r *restartableObjectStore) PutObject(bucket string, key string, body io.Re
 	if err != nil {
 		return err
 	}
-	return delegate.PutObject(bucket, key, body)
+	start := time.Now()
+	err = delegate.PutObject(bucket, key, body)
+	r.calls.observe(r.key, "PutObject", start, err)
+	return err
 }
 
 // ObjectExists restarts the plugin's process if needed, then delegates the call.
@@ -126,16 +139,44 @@ func (r *restartableObjectStore) ObjectExists(bucket, key string) (bool, error)
 	if err != nil {
 		return false, err
 	}
-	return delegate.ObjectExists(bucket, key)
+	start := time.Now()
+	exists, err := delegate.ObjectExists(bucket, key)
+	r.calls.observe(r.key, "ObjectExists", start, err)
+	return exists, err
 }
 
-// GetObject restarts the plugin's process if needed, then delegates the call.
+// GetObject restarts the plugin's process if needed, then delegates the call. If reading the
+// object fails, such as because the process crashed, it's resumed from where it failed.
 func (r *restartableObjectStore) GetObject(bucket string, key string) (io.ReadCloser, error) {
-	delegate, err := r.getDelegate()
+	return r.getObject(bucket, key, 0, "GetObject")
+}
+
+// GetObjectRange restarts the plugin's process if needed, then delegates the call. If reading
+// the object fails, such as because the process crashed, it's resumed from where it failed.
+func (r *restartableObjectStore) GetObjectRange(bucket string, key string, offset int64) (io.ReadCloser, error) {
+	return r.getObject(bucket, key, offset, "GetObjectRange")
+}
+
+// getObject retrieves an object starting from offset, wrapped to resume retrieving it if
+// reading it fails. method is the name of the method the call is recorded as.
+func (r *restartableObjectStore) getObject(bucket, key string, offset int64, method string) (io.ReadCloser, error) {
+	resume := func(offset int64) (io.ReadCloser, error) {
+		delegate, err := r.getDelegate()
+		if err != nil {
+			return nil, err
+		}
+		start := time.Now()
+		body, err := velero.GetObjectRange(delegate, bucket, key, offset)
+		r.calls.observe(r.key, method, start, err)
+		return body, err
+	}
+
+	body, err := resume(offset)
 	if err != nil {
-		return nil, err
+		return body, err
 	}
-	return delegate.GetObject(bucket, key)
+
+	return &resumableObject{body: body, offset: offset, resume: resume}, nil
 }
 
 // ListCommonPrefixes restarts the plugin's process if needed, then delegates the call.
@@ -144,7 +185,10 @@ func (r *restartableObjectStore) ListCommonPrefixes(bucket string, prefix string
 	if err != nil {
 		return nil, err
 	}
-	return delegate.ListCommonPrefixes(bucket, prefix, delimiter)
+	start := time.Now()
+	prefixes, err := delegate.ListCommonPrefixes(bucket, prefix, delimiter)
+	r.calls.observe(r.key, "ListCommonPrefixes", start, err)
+	return prefixes, err
 }
 
 // ListObjects restarts the plugin's process if needed, then delegates the call.
@@ -153,7 +197,22 @@ func (r *restartableObjectStore) ListObjects(bucket string, prefix string) ([]st
 	if err != nil {
 		return nil, err
 	}
-	return delegate.ListObjects(bucket, prefix)
+	start := time.Now()
+	keys, err := delegate.ListObjects(bucket, prefix)
+	r.calls.observe(r.key, "ListObjects", start, err)
+	return keys, err
+}
+
+// ListObjectsPage restarts the plugin's process if needed, then delegates the call.
+func (r *restartableObjectStore) ListObjectsPage(bucket, prefix, startAfter string, limit int) ([]velero.ObjectInfo, string, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, "", err
+	}
+	start := time.Now()
+	objects, next, err := velero.ListObjectsPage(delegate, bucket, prefix, startAfter, limit)
+	r.calls.observe(r.key, "ListObjectsPage", start, err)
+	return objects, next, err
 }
 
 // DeleteObject restarts the plugin's process if needed, then delegates the call.
@@ -162,7 +221,10 @@ func (r *restartableObjectStore) DeleteObject(bucket string, key string) error {
 	if err != nil {
 		return err
 	}
-	return delegate.DeleteObject(bucket, key)
+	start := time.Now()
+	err = delegate.DeleteObject(bucket, key)
+	r.calls.observe(r.key, "DeleteObject", start, err)
+	return err
 }
 
 // CreateSignedURL restarts the plugin's process if needed, then delegates the call.
@@ -171,5 +233,64 @@ func (r *restartableObjectStore) CreateSignedURL(bucket string, key string, ttl
 	if err != nil {
 		return "", err
 	}
-	return delegate.CreateSignedURL(bucket, key, ttl)
+	start := time.Now()
+	url, err := delegate.CreateSignedURL(bucket, key, ttl)
+	r.calls.observe(r.key, "CreateSignedURL", start, err)
+	return url, err
+}
+
+// resumableObject is an object being read from an object store plugin that's retrieved again
+// from where reading it failed, if it fails.
+type resumableObject struct {
+	body io.ReadCloser
+	// offset is the offset in the object of the next byte to read
+	offset int64
+	// resumes is the number of times reading has been resumed since data was last read
+	resumes int
+	resume  func(offset int64) (io.ReadCloser, error)
+	err     error
+}
+
+func (o *resumableObject) Read(p []byte) (int, error) {
+	for {
+		if o.err != nil {
+			return 0, o.err
+		}
+
+		n, err := o.body.Read(p)
+		o.offset += int64(n)
+		if err == nil || err == io.EOF {
+			if n > 0 {
+				o.resumes = 0
+			}
+			return n, err
+		}
+		if n > 0 {
+			// return the data that was read, and resume on the next read
+			// if it fails again
+			return n, nil
+		}
+
+		o.body.Close()
+		if o.resumes >= maxObjectResumes {
+			o.err = err
+			continue
+		}
+		o.resumes++
+
+		body, resumeErr := o.resume(o.offset)
+		if resumeErr != nil {
+			o.err = errors.Wrapf(resumeErr, "error resuming reading the object at offset %d after error %v", o.offset, err)
+			continue
+		}
+		o.body = body
+	}
+}
+
+func (o *resumableObject) Close() error {
+	if o.err != nil {
+		// the body was already closed when reading it failed
+		return nil
+	}
+	return o.body.Close()
 }
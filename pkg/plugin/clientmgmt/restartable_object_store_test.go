@@ -17,6 +17,7 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"io"
 	"io/ioutil"
 	"strings"
 	"testing"
@@ -183,6 +184,102 @@ func TestRestartableObjectStoreInit(t *testing.T) {
 	assert.EqualError(t, err, "already initialized")
 }
 
+func TestRestartableObjectStoreInitWithPluginConfig(t *testing.T) {
+	p := new(mockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	name := "aws"
+	key := kindAndName{kind: framework.PluginKindObjectStore, name: name}
+	r := &restartableObjectStore{
+		key:                 key,
+		sharedPluginProcess: p,
+		pluginConfig: map[string]string{
+			"region":  "us-east-1",
+			"profile": "default",
+		},
+	}
+
+	objectStore := new(providermocks.ObjectStore)
+	objectStore.Test(t)
+	defer objectStore.AssertExpectations(t)
+	p.On("getByKindAndName", key).Return(objectStore, nil)
+
+	expected := map[string]string{
+		"region":  "us-west-2",
+		"profile": "default",
+	}
+	objectStore.On("Init", expected).Return(nil)
+
+	err := r.Init(map[string]string{"region": "us-west-2"})
+	assert.NoError(t, err)
+
+	// The merged config is the one used to reinitialize the plugin.
+	assert.Equal(t, expected, r.config)
+}
+
+// failingReader returns data, then fails with err.
+type failingReader struct {
+	data string
+	err  error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.data == "" {
+		return 0, r.err
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func TestRestartableObjectStoreGetObjectResumes(t *testing.T) {
+	p := new(mockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	key := kindAndName{kind: framework.PluginKindObjectStore, name: "aws"}
+	r := &restartableObjectStore{
+		key:                 key,
+		sharedPluginProcess: p,
+	}
+
+	objectStore := new(providermocks.ObjectStore)
+	objectStore.Test(t)
+	defer objectStore.AssertExpectations(t)
+	p.On("resetIfNeeded").Return(nil)
+	p.On("getByKindAndName", key).Return(objectStore, nil)
+
+	// reading the object fails partway through, and is resumed from where it failed
+	objectStore.On("GetObject", "bucket", "key").Return(ioutil.NopCloser(&failingReader{data: "hel", err: errors.New("process crashed")}), nil).Once()
+	objectStore.On("GetObject", "bucket", "key").Return(ioutil.NopCloser(strings.NewReader("hello world")), nil).Once()
+
+	body, err := r.GetObject("bucket", "key")
+	require.NoError(t, err)
+	res, err := ioutil.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(res))
+	require.NoError(t, body.Close())
+
+	// reading the object fails after being resumed too many times in a row
+	objectStore.On("GetObject", "bucket", "key").Return(ioutil.NopCloser(&failingReader{err: errors.New("process crashed")}), nil).Times(maxObjectResumes + 1)
+
+	body, err = r.GetObject("bucket", "key")
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(body)
+	assert.EqualError(t, err, "process crashed")
+	require.NoError(t, body.Close())
+
+	// reading the object fails if it can't be resumed
+	objectStore.On("GetObject", "bucket", "key").Return(ioutil.NopCloser(&failingReader{data: "hel", err: errors.New("process crashed")}), nil).Once()
+	objectStore.On("GetObject", "bucket", "key").Return(nil, errors.New("object deleted")).Once()
+
+	body, err = r.GetObject("bucket", "key")
+	require.NoError(t, err)
+	_, err = io.Copy(ioutil.Discard, body)
+	assert.EqualError(t, err, "error resuming reading the object at offset 3 after error process crashed: object deleted")
+}
+
 func TestRestartableObjectStoreDelegatedFunctions(t *testing.T) {
 	runRestartableDelegateTests(
 		t,
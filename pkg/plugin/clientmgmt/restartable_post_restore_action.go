@@ -0,0 +1,90 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// restartablePostRestoreAction is a post-restore action for a given implementation (such as "smoke-test"). It is
+// associated with a restartableProcess, which may be shared and used to run multiple plugins. At the beginning of each
+// method call, the restartablePostRestoreAction asks its restartableProcess to restart itself if needed (e.g. if the
+// process terminated for any reason), then it proceeds with the actual call.
+type restartablePostRestoreAction struct {
+	key                 kindAndName
+	sharedPluginProcess RestartableProcess
+	calls               callMetrics
+	// config is the data of the plugin's ConfigMap, which the plugin is configured with before each call to Execute.
+	config map[string]string
+}
+
+// newRestartablePostRestoreAction returns a new restartablePostRestoreAction.
+func newRestartablePostRestoreAction(name string, sharedPluginProcess RestartableProcess) *restartablePostRestoreAction {
+	r := &restartablePostRestoreAction{
+		key:                 kindAndName{kind: framework.PluginKindPostRestoreAction, name: name},
+		sharedPluginProcess: sharedPluginProcess,
+	}
+	return r
+}
+
+// getPostRestoreAction returns the post-restore action for this restartablePostRestoreAction. It does *not* restart
+// the plugin process.
+func (r *restartablePostRestoreAction) getPostRestoreAction() (velero.PostRestoreAction, error) {
+	plugin, err := r.sharedPluginProcess.getByKindAndName(r.key)
+	if err != nil {
+		return nil, err
+	}
+
+	postRestoreAction, ok := plugin.(velero.PostRestoreAction)
+	if !ok {
+		return nil, errors.Errorf("%T is not a PostRestoreAction!", plugin)
+	}
+
+	return postRestoreAction, nil
+}
+
+// getDelegate restarts the plugin process (if needed) and returns the post-restore action for this
+// restartablePostRestoreAction.
+func (r *restartablePostRestoreAction) getDelegate() (velero.PostRestoreAction, error) {
+	if err := r.sharedPluginProcess.resetIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	return r.getPostRestoreAction()
+}
+
+// Execute restarts the plugin's process if needed, then delegates the call.
+func (r *restartablePostRestoreAction) Execute(input *velero.PostRestoreActionExecuteInput) (*velero.PostRestoreActionExecuteOutput, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := configure(delegate, r.config); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	output, err := delegate.Execute(input)
+	r.calls.observe(r.key, "Execute", start, err)
+	return output, err
+}
@@ -18,24 +18,70 @@ package clientmgmt
 
 import (
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
+const (
+	// maxResetFailures is how many times in a row a plugin process can fail to
+	// restart before restarting it is abandoned.
+	maxResetFailures = 10
+
+	// initialRestartBackoff is how long to wait before restarting a plugin process
+	// that exited again soon after it was restarted. The wait doubles with each such
+	// restart, up to maxRestartBackoff.
+	initialRestartBackoff = time.Second
+	maxRestartBackoff     = 30 * time.Second
+
+	// stableProcessPeriod is how long a plugin process must run for its restart
+	// backoff to be reset.
+	stableProcessPeriod = time.Minute
+)
+
+// ProcessSupervision configures how a Manager supervises the plugin processes it starts.
+type ProcessSupervision struct {
+	// HealthCheckInterval is how often running plugin processes are health checked, and
+	// restarted if they're unresponsive. Processes aren't health checked if it's zero.
+	HealthCheckInterval time.Duration
+
+	// DefaultTimeout bounds each call to a plugin that doesn't have a timeout in Timeouts.
+	// Calls are unbounded if it's zero. The process of a plugin whose call times out is
+	// restarted.
+	DefaultTimeout time.Duration
+
+	// Timeouts bound each call to the plugins they're keyed by name, such as "velero.io/aws".
+	Timeouts map[string]time.Duration
+
+	// Statuses records the status of the plugin processes, if set.
+	Statuses *ProcessStatuses
+}
+
+// timeout returns the timeout for calls to the plugin named name.
+func (s ProcessSupervision) timeout(name string) time.Duration {
+	if timeout, found := s.Timeouts[name]; found {
+		return timeout
+	}
+	return s.DefaultTimeout
+}
+
 type RestartableProcessFactory interface {
-	newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error)
+	newRestartableProcess(command string, env map[string]string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error)
 }
 
 type restartableProcessFactory struct {
+	supervision ProcessSupervision
 }
 
-func newRestartableProcessFactory() RestartableProcessFactory {
-	return &restartableProcessFactory{}
+func newRestartableProcessFactory(supervision ProcessSupervision) RestartableProcessFactory {
+	return &restartableProcessFactory{supervision: supervision}
 }
 
-func (rpf *restartableProcessFactory) newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
-	return newRestartableProcess(command, logger, logLevel)
+func (rpf *restartableProcessFactory) newRestartableProcess(command string, env map[string]string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
+	return newRestartableProcess(command, env, logger, logLevel, rpf.supervision)
 }
 
 type RestartableProcess interface {
@@ -47,12 +93,19 @@ type RestartableProcess interface {
 }
 
 // restartableProcess encapsulates the lifecycle for all plugins contained in a single executable file. It is able
-// to restart a plugin process if it is terminated for any reason. If this happens, all plugins are reinitialized using
-// the original configuration data.
+// to restart a plugin process if it is terminated for any reason, backing off if the process keeps exiting. If this
+// happens, all plugins are reinitialized using the original configuration data.
 type restartableProcess struct {
-	command  string
-	logger   logrus.FieldLogger
-	logLevel logrus.Level
+	command     string
+	env         map[string]string
+	logger      logrus.FieldLogger
+	logLevel    logrus.Level
+	supervision ProcessSupervision
+	clock       clock.Clock
+	// newProcess launches the plugin process.
+	newProcess func() (Process, error)
+	// stopCh is closed when the process is stopped, ending its health checks.
+	stopCh chan struct{}
 
 	// lock guards all of the fields below
 	lock           sync.RWMutex
@@ -60,6 +113,11 @@ type restartableProcess struct {
 	plugins        map[kindAndName]interface{}
 	reinitializers map[kindAndName]reinitializer
 	resetFailures  int
+	// startTime is when the process was last started, successfully or not.
+	startTime time.Time
+	// restarts is the number of times the process has been restarted without running for stableProcessPeriod.
+	restarts int
+	stopped  bool
 }
 
 // reinitializer is capable of reinitializing a restartable plugin instance using the newly dispensed plugin.
@@ -68,20 +126,38 @@ type reinitializer interface {
 	reinitialize(dispensed interface{}) error
 }
 
-// newRestartableProcess creates a new restartableProcess for the given command and options.
-func newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
+// newRestartableProcess creates a new restartableProcess for the given command and options, and starts health
+// checking it if supervision has a HealthCheckInterval.
+func newRestartableProcess(command string, env map[string]string, logger logrus.FieldLogger, logLevel logrus.Level, supervision ProcessSupervision) (RestartableProcess, error) {
 	p := &restartableProcess{
 		command:        command,
+		env:            env,
 		logger:         logger,
 		logLevel:       logLevel,
+		supervision:    supervision,
+		clock:          clock.RealClock{},
+		stopCh:         make(chan struct{}),
 		plugins:        make(map[kindAndName]interface{}),
 		reinitializers: make(map[kindAndName]reinitializer),
 	}
+	p.newProcess = func() (Process, error) {
+		var timeout func(name string) time.Duration
+		if supervision.DefaultTimeout > 0 || len(supervision.Timeouts) > 0 {
+			timeout = supervision.timeout
+		}
+		return newProcess(command, env, logger, logLevel, timeout)
+	}
 
 	// This launches the process
-	err := p.reset()
+	if err := p.reset(); err != nil {
+		return p, err
+	}
 
-	return p, err
+	if supervision.HealthCheckInterval > 0 {
+		go wait.Until(p.checkHealth, supervision.HealthCheckInterval, p.stopCh)
+	}
+
+	return p, nil
 }
 
 // addReinitializer registers the reinitializer r for key.
@@ -105,16 +181,38 @@ func (p *restartableProcess) reset() error {
 //
 // Callers of resetLH *must* acquire the lock before calling it.
 func (p *restartableProcess) resetLH() error {
-	if p.resetFailures > 10 {
-		return errors.Errorf("unable to restart plugin process: execeeded maximum number of reset failures")
+	if p.resetFailures > maxResetFailures {
+		err := errors.Errorf("unable to restart plugin process: execeeded maximum number of reset failures")
+		p.supervision.Statuses.failed(p.command, err)
+		return err
 	}
 
-	process, err := newProcess(p.command, p.logger, p.logLevel)
-	if err != nil {
+	if err := p.startLH(); err != nil {
 		p.resetFailures++
+		if p.process == nil {
+			p.supervision.Statuses.failed(p.command, err)
+		} else {
+			p.supervision.Statuses.restarting(p.command, err)
+		}
 		return err
 	}
+
+	return nil
+}
+
+// startLH launches the plugin process, redispensing and reinitializing plugins as described for resetLH.
+//
+// Callers of startLH *must* acquire the lock before calling it.
+func (p *restartableProcess) startLH() error {
+	p.startTime = p.clock.Now()
+
+	process, err := p.newProcess()
+	if err != nil {
+		return err
+	}
+	restarted := p.process != nil
 	p.process = process
+	p.supervision.Statuses.started(p.command, restarted)
 
 	// Redispense any previously dispensed plugins, reinitializing if necessary.
 	// Start by creating a new map to hold the newly dispensed plugins.
@@ -123,7 +221,6 @@ func (p *restartableProcess) resetLH() error {
 		// Re-dispense
 		dispensed, err := p.process.dispense(key)
 		if err != nil {
-			p.resetFailures++
 			return err
 		}
 		// Store in the new map
@@ -132,7 +229,6 @@ func (p *restartableProcess) resetLH() error {
 		// Reinitialize
 		if r, found := p.reinitializers[key]; found {
 			if err := r.reinitialize(dispensed); err != nil {
-				p.resetFailures++
 				return err
 			}
 		}
@@ -153,12 +249,75 @@ func (p *restartableProcess) resetIfNeeded() error {
 
 	if p.process.exited() {
 		p.logger.Info("Plugin process exited - restarting.")
-		return p.resetLH()
+		return p.restartLH(errors.New("plugin process exited"))
 	}
 
 	return nil
 }
 
+// checkHealth restarts the plugin process if it has exited or doesn't respond to a health check.
+func (p *restartableProcess) checkHealth() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.stopped {
+		return
+	}
+
+	var reason error
+	if p.process.exited() {
+		p.logger.Info("Plugin process exited - restarting.")
+		reason = errors.New("plugin process exited")
+	} else if err := p.process.ping(); err != nil {
+		p.logger.WithError(err).Warn("Plugin process failed its health check - restarting.")
+		p.process.kill()
+		reason = err
+	} else {
+		return
+	}
+
+	if err := p.restartLH(reason); err != nil {
+		p.logger.WithError(err).Error("Error restarting plugin process")
+	}
+}
+
+// restartLH resets the plugin process after it stopped working because of reason. If the process is
+// restarted repeatedly without running for stableProcessPeriod, restartLH waits with exponential backoff
+// before each restart.
+//
+// Callers of restartLH *must* acquire the lock before calling it.
+func (p *restartableProcess) restartLH(reason error) error {
+	p.supervision.Statuses.restarting(p.command, reason)
+
+	if p.clock.Since(p.startTime) >= stableProcessPeriod {
+		p.restarts = 0
+	}
+	if backoff := restartBackoff(p.restarts); backoff > 0 {
+		p.logger.Infof("Waiting %v before restarting plugin process", backoff)
+		p.clock.Sleep(backoff)
+	}
+	p.restarts++
+
+	return p.resetLH()
+}
+
+// restartBackoff returns how long to wait before restarting a plugin process that's been restarted
+// restarts times without running for stableProcessPeriod.
+func restartBackoff(restarts int) time.Duration {
+	if restarts == 0 {
+		return 0
+	}
+
+	backoff := initialRestartBackoff
+	for i := 1; i < restarts && backoff < maxRestartBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxRestartBackoff {
+		backoff = maxRestartBackoff
+	}
+	return backoff
+}
+
 // getByKindAndName acquires the lock and calls getByKindAndNameLH.
 func (p *restartableProcess) getByKindAndName(key kindAndName) (interface{}, error) {
 	p.lock.Lock()
@@ -183,9 +342,17 @@ func (p *restartableProcess) getByKindAndNameLH(key kindAndName) (interface{}, e
 	return p.plugins[key], nil
 }
 
-// stop terminates the plugin process.
+// stop terminates the plugin process and ends its health checks.
 func (p *restartableProcess) stop() {
 	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.stopped {
+		return
+	}
+	p.stopped = true
+	close(p.stopCh)
+
 	p.process.kill()
-	p.lock.Unlock()
+	p.supervision.Statuses.stopped(p.command)
 }
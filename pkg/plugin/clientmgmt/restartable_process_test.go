@@ -0,0 +1,196 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type mockProcess struct {
+	mock.Mock
+}
+
+func (p *mockProcess) dispense(key kindAndName) (interface{}, error) {
+	args := p.Called(key)
+	return args.Get(0), args.Error(1)
+}
+
+func (p *mockProcess) exited() bool {
+	args := p.Called()
+	return args.Bool(0)
+}
+
+func (p *mockProcess) ping() error {
+	args := p.Called()
+	return args.Error(0)
+}
+
+func (p *mockProcess) kill() {
+	p.Called()
+}
+
+// newTestRestartableProcess returns a restartableProcess that launches processes, in order, and
+// the clock it uses.
+func newTestRestartableProcess(t *testing.T, processes ...*mockProcess) (*restartableProcess, *clock.FakeClock) {
+	fakeClock := clock.NewFakeClock(time.Now())
+
+	p := &restartableProcess{
+		command: "/plugin",
+		logger:  test.NewLogger(),
+		supervision: ProcessSupervision{
+			Statuses: NewProcessStatuses(fakeClock),
+		},
+		clock:          fakeClock,
+		stopCh:         make(chan struct{}),
+		plugins:        make(map[kindAndName]interface{}),
+		reinitializers: make(map[kindAndName]reinitializer),
+	}
+	p.newProcess = func() (Process, error) {
+		require.NotEmpty(t, processes, "unexpected process launch")
+		process := processes[0]
+		processes = processes[1:]
+		return process, nil
+	}
+
+	require.NoError(t, p.reset())
+	return p, fakeClock
+}
+
+func TestRestartBackoff(t *testing.T) {
+	tests := []struct {
+		restarts int
+		expected time.Duration
+	}{
+		{restarts: 0, expected: 0},
+		{restarts: 1, expected: time.Second},
+		{restarts: 2, expected: 2 * time.Second},
+		{restarts: 3, expected: 4 * time.Second},
+		{restarts: 6, expected: 30 * time.Second},
+		{restarts: 100, expected: 30 * time.Second},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, restartBackoff(tc.restarts), "restarts=%d", tc.restarts)
+	}
+}
+
+func TestRestartableProcessResetIfNeededBacksOff(t *testing.T) {
+	first, second, third, fourth := new(mockProcess), new(mockProcess), new(mockProcess), new(mockProcess)
+	p, fakeClock := newTestRestartableProcess(t, first, second, third, fourth)
+	start := fakeClock.Now()
+
+	// a running process isn't restarted
+	first.On("exited").Return(false).Once()
+	require.NoError(t, p.resetIfNeeded())
+	assert.Equal(t, first, p.process)
+
+	// the first restart is immediate
+	first.On("exited").Return(true).Once()
+	require.NoError(t, p.resetIfNeeded())
+	assert.Equal(t, second, p.process)
+	assert.Equal(t, start, fakeClock.Now())
+
+	// a process that exits again soon after being restarted is restarted with backoff
+	second.On("exited").Return(true).Once()
+	require.NoError(t, p.resetIfNeeded())
+	assert.Equal(t, third, p.process)
+	assert.Equal(t, start.Add(time.Second), fakeClock.Now())
+
+	// a process that ran for a while is restarted immediately
+	fakeClock.Step(stableProcessPeriod)
+	start = fakeClock.Now()
+	third.On("exited").Return(true).Once()
+	require.NoError(t, p.resetIfNeeded())
+	assert.Equal(t, fourth, p.process)
+	assert.Equal(t, start, fakeClock.Now())
+
+	status := p.supervision.Statuses.Get("/plugin")
+	require.NotNil(t, status)
+	assert.Equal(t, velerov1api.PluginProcessPhaseRunning, status.Phase)
+	assert.Equal(t, 3, status.Restarts)
+	assert.Equal(t, start, status.LastRestartTime.Time)
+	assert.Equal(t, "plugin process exited", status.LastError)
+}
+
+func TestRestartableProcessCheckHealth(t *testing.T) {
+	first, second := new(mockProcess), new(mockProcess)
+	p, _ := newTestRestartableProcess(t, first, second)
+
+	// a healthy process is left running
+	first.On("exited").Return(false)
+	first.On("ping").Return(nil).Once()
+	p.checkHealth()
+	assert.Equal(t, first, p.process)
+
+	// an unresponsive process is killed and restarted
+	first.On("ping").Return(errors.New("unresponsive")).Once()
+	first.On("kill").Once()
+	p.checkHealth()
+	assert.Equal(t, second, p.process)
+	first.AssertExpectations(t)
+
+	status := p.supervision.Statuses.Get("/plugin")
+	require.NotNil(t, status)
+	assert.Equal(t, velerov1api.PluginProcessPhaseRunning, status.Phase)
+	assert.Equal(t, 1, status.Restarts)
+	assert.Equal(t, "unresponsive", status.LastError)
+
+	// a stopped process isn't health checked
+	second.On("kill").Once()
+	p.stop()
+	p.checkHealth()
+	second.AssertExpectations(t)
+
+	status = p.supervision.Statuses.Get("/plugin")
+	require.NotNil(t, status)
+	assert.Equal(t, velerov1api.PluginProcessPhaseStopped, status.Phase)
+
+	// stopping again is a no-op
+	p.stop()
+	second.AssertNumberOfCalls(t, "kill", 1)
+}
+
+func TestRestartableProcessResetFailures(t *testing.T) {
+	first := new(mockProcess)
+	p, _ := newTestRestartableProcess(t, first)
+	p.newProcess = func() (Process, error) {
+		return nil, errors.New("start failed")
+	}
+
+	for i := 0; i <= maxResetFailures; i++ {
+		assert.EqualError(t, p.reset(), "start failed")
+	}
+	status := p.supervision.Statuses.Get("/plugin")
+	require.NotNil(t, status)
+	assert.Equal(t, velerov1api.PluginProcessPhaseRestarting, status.Phase)
+	assert.Equal(t, "start failed", status.LastError)
+
+	assert.EqualError(t, p.reset(), "unable to restart plugin process: execeeded maximum number of reset failures")
+	status = p.supervision.Statuses.Get("/plugin")
+	require.NotNil(t, status)
+	assert.Equal(t, velerov1api.PluginProcessPhaseFailed, status.Phase)
+}
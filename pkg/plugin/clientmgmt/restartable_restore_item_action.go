@@ -17,8 +17,11 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
@@ -30,7 +33,9 @@ import (
 type restartableRestoreItemAction struct {
 	key                 kindAndName
 	sharedPluginProcess RestartableProcess
-	config              map[string]string
+	calls               callMetrics
+	// config is the data of the plugin's ConfigMap, which the plugin is configured with before each call to AppliesTo.
+	config map[string]string
 }
 
 // newRestartableRestoreItemAction returns a new restartableRestoreItemAction.
@@ -74,7 +79,14 @@ func (r *restartableRestoreItemAction) AppliesTo() (velero.ResourceSelector, err
 		return velero.ResourceSelector{}, err
 	}
 
-	return delegate.AppliesTo()
+	if err := configure(delegate, r.config); err != nil {
+		return velero.ResourceSelector{}, err
+	}
+
+	start := time.Now()
+	selector, err := delegate.AppliesTo()
+	r.calls.observe(r.key, "AppliesTo", start, err)
+	return selector, err
 }
 
 // Execute restarts the plugin's process if needed, then delegates the call.
@@ -84,5 +96,56 @@ func (r *restartableRestoreItemAction) Execute(input *velero.RestoreItemActionEx
 		return nil, err
 	}
 
-	return delegate.Execute(input)
+	start := time.Now()
+	output, err := delegate.Execute(input)
+	r.calls.observe(r.key, "Execute", start, err)
+	return output, err
+}
+
+// restartableRestoreItemActionV2 is a restartableRestoreItemAction for a plugin that Velero uses with version 2
+// of the RestoreItemAction API.
+type restartableRestoreItemActionV2 struct {
+	*restartableRestoreItemAction
+}
+
+// getDelegateV2 restarts the plugin process (if needed) and returns the version 2 restore item action for this
+// restartableRestoreItemActionV2.
+func (r *restartableRestoreItemActionV2) getDelegateV2() (velero.RestoreItemActionV2, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+
+	restoreItemAction, ok := delegate.(velero.RestoreItemActionV2)
+	if !ok {
+		return nil, errors.Errorf("%T is not a RestoreItemActionV2!", delegate)
+	}
+
+	return restoreItemAction, nil
+}
+
+// Progress restarts the plugin's process if needed, then delegates the call.
+func (r *restartableRestoreItemActionV2) Progress(item velero.ResourceIdentifier, restore *api.Restore) (velero.OperationProgress, error) {
+	delegate, err := r.getDelegateV2()
+	if err != nil {
+		return velero.OperationProgress{}, err
+	}
+
+	start := time.Now()
+	progress, err := delegate.Progress(item, restore)
+	r.calls.observe(r.key, "Progress", start, err)
+	return progress, err
+}
+
+// Cancel restarts the plugin's process if needed, then delegates the call.
+func (r *restartableRestoreItemActionV2) Cancel(item velero.ResourceIdentifier, restore *api.Restore) error {
+	delegate, err := r.getDelegateV2()
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	err = delegate.Cancel(item, restore)
+	r.calls.observe(r.key, "Cancel", start, err)
+	return err
 }
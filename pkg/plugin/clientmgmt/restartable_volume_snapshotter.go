@@ -17,6 +17,8 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -31,7 +33,10 @@ import (
 type restartableVolumeSnapshotter struct {
 	key                 kindAndName
 	sharedPluginProcess RestartableProcess
+	calls               callMetrics
 	config              map[string]string
+	// pluginConfig is the data of the plugin's ConfigMap, which provides defaults for the config passed to Init.
+	pluginConfig map[string]string
 }
 
 // newRestartableVolumeSnapshotter returns a new restartableVolumeSnapshotter.
@@ -95,15 +100,18 @@ func (r *restartableVolumeSnapshotter) Init(config map[string]string) error {
 		return err
 	}
 
-	r.config = config
+	r.config = mergePluginConfig(r.pluginConfig, config)
 
-	return r.init(delegate, config)
+	return r.init(delegate, r.config)
 }
 
 // init calls Init on volumeSnapshotter with config. This is split out from Init() so that both Init() and reinitialize() may
 // call it using a specific VolumeSnapshotter.
 func (r *restartableVolumeSnapshotter) init(volumeSnapshotter velero.VolumeSnapshotter, config map[string]string) error {
-	return volumeSnapshotter.Init(config)
+	start := time.Now()
+	err := volumeSnapshotter.Init(config)
+	r.calls.observe(r.key, "Init", start, err)
+	return err
 }
 
 // CreateVolumeFromSnapshot restarts the plugin's process if needed, then delegates the call.
@@ -112,7 +120,22 @@ func (r *restartableVolumeSnapshotter) CreateVolumeFromSnapshot(snapshotID strin
 	if err != nil {
 		return "", err
 	}
-	return delegate.CreateVolumeFromSnapshot(snapshotID, volumeType, volumeAZ, iops)
+	start := time.Now()
+	volumeID, err = delegate.CreateVolumeFromSnapshot(snapshotID, volumeType, volumeAZ, iops)
+	r.calls.observe(r.key, "CreateVolumeFromSnapshot", start, err)
+	return volumeID, err
+}
+
+// CreateResizedVolumeFromSnapshot restarts the plugin's process if needed, then delegates the call.
+func (r *restartableVolumeSnapshotter) CreateResizedVolumeFromSnapshot(snapshotID string, volumeType string, volumeAZ string, iops *int64, sizeBytes int64) (volumeID string, resized bool, err error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return "", false, err
+	}
+	start := time.Now()
+	volumeID, resized, err = velero.CreateResizedVolumeFromSnapshot(delegate, snapshotID, volumeType, volumeAZ, iops, sizeBytes)
+	r.calls.observe(r.key, "CreateResizedVolumeFromSnapshot", start, err)
+	return volumeID, resized, err
 }
 
 // GetVolumeID restarts the plugin's process if needed, then delegates the call.
@@ -121,7 +144,10 @@ func (r *restartableVolumeSnapshotter) GetVolumeID(pv runtime.Unstructured) (str
 	if err != nil {
 		return "", err
 	}
-	return delegate.GetVolumeID(pv)
+	start := time.Now()
+	volumeID, err := delegate.GetVolumeID(pv)
+	r.calls.observe(r.key, "GetVolumeID", start, err)
+	return volumeID, err
 }
 
 // SetVolumeID restarts the plugin's process if needed, then delegates the call.
@@ -130,7 +156,10 @@ func (r *restartableVolumeSnapshotter) SetVolumeID(pv runtime.Unstructured, volu
 	if err != nil {
 		return nil, err
 	}
-	return delegate.SetVolumeID(pv, volumeID)
+	start := time.Now()
+	updated, err := delegate.SetVolumeID(pv, volumeID)
+	r.calls.observe(r.key, "SetVolumeID", start, err)
+	return updated, err
 }
 
 // GetVolumeInfo restarts the plugin's process if needed, then delegates the call.
@@ -139,7 +168,10 @@ func (r *restartableVolumeSnapshotter) GetVolumeInfo(volumeID string, volumeAZ s
 	if err != nil {
 		return "", nil, err
 	}
-	return delegate.GetVolumeInfo(volumeID, volumeAZ)
+	start := time.Now()
+	volumeType, iops, err := delegate.GetVolumeInfo(volumeID, volumeAZ)
+	r.calls.observe(r.key, "GetVolumeInfo", start, err)
+	return volumeType, iops, err
 }
 
 // CreateSnapshot restarts the plugin's process if needed, then delegates the call.
@@ -148,7 +180,10 @@ func (r *restartableVolumeSnapshotter) CreateSnapshot(volumeID string, volumeAZ
 	if err != nil {
 		return "", err
 	}
-	return delegate.CreateSnapshot(volumeID, volumeAZ, tags)
+	start := time.Now()
+	snapshotID, err = delegate.CreateSnapshot(volumeID, volumeAZ, tags)
+	r.calls.observe(r.key, "CreateSnapshot", start, err)
+	return snapshotID, err
 }
 
 // DeleteSnapshot restarts the plugin's process if needed, then delegates the call.
@@ -157,5 +192,117 @@ func (r *restartableVolumeSnapshotter) DeleteSnapshot(snapshotID string) error {
 	if err != nil {
 		return err
 	}
-	return delegate.DeleteSnapshot(snapshotID)
+	start := time.Now()
+	err = delegate.DeleteSnapshot(snapshotID)
+	r.calls.observe(r.key, "DeleteSnapshot", start, err)
+	return err
+}
+
+// VerifySnapshot restarts the plugin's process if needed, then delegates the call if the plugin
+// supports verifying snapshots.
+func (r *restartableVolumeSnapshotter) VerifySnapshot(snapshotID string, volumeAZ string, testRestore bool) error {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+	verifier, ok := delegate.(velero.SnapshotVerifier)
+	if !ok {
+		return velero.ErrSnapshotVerificationNotSupported
+	}
+	start := time.Now()
+	err = verifier.VerifySnapshot(snapshotID, volumeAZ, testRestore)
+	r.calls.observe(r.key, "VerifySnapshot", start, err)
+	return err
+}
+
+// CreateSnapshotGroup restarts the plugin's process if needed, then delegates the call if the plugin
+// supports snapshot groups.
+func (r *restartableVolumeSnapshotter) CreateSnapshotGroup(volumes []velero.SnapshotGroupVolume) ([]string, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+	creator, ok := delegate.(velero.SnapshotGroupCreator)
+	if !ok {
+		return nil, velero.ErrSnapshotGroupsNotSupported
+	}
+	start := time.Now()
+	snapshotIDs, err := creator.CreateSnapshotGroup(volumes)
+	r.calls.observe(r.key, "CreateSnapshotGroup", start, err)
+	return snapshotIDs, err
+}
+
+// GetSnapshotSize restarts the plugin's process if needed, then delegates the call if the plugin
+// supports reporting snapshot sizes.
+func (r *restartableVolumeSnapshotter) GetSnapshotSize(snapshotID string) (int64, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return 0, err
+	}
+	sizer, ok := delegate.(velero.SnapshotSizer)
+	if !ok {
+		return 0, velero.ErrSnapshotSizeNotSupported
+	}
+	start := time.Now()
+	size, err := sizer.GetSnapshotSize(snapshotID)
+	r.calls.observe(r.key, "GetSnapshotSize", start, err)
+	return size, err
+}
+
+// restartableVolumeSnapshotterV2 is a restartableVolumeSnapshotter for a plugin that Velero uses with version 2
+// of the VolumeSnapshotter API.
+type restartableVolumeSnapshotterV2 struct {
+	*restartableVolumeSnapshotter
+}
+
+// getDelegateV2 restarts the plugin process (if needed) and returns the version 2 volume snapshotter for this
+// restartableVolumeSnapshotterV2.
+func (r *restartableVolumeSnapshotterV2) getDelegateV2() (velero.VolumeSnapshotterV2, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+
+	volumeSnapshotter, ok := delegate.(velero.VolumeSnapshotterV2)
+	if !ok {
+		return nil, errors.Errorf("%T is not a VolumeSnapshotterV2!", delegate)
+	}
+
+	return volumeSnapshotter, nil
+}
+
+// StartSnapshot restarts the plugin's process if needed, then delegates the call.
+func (r *restartableVolumeSnapshotterV2) StartSnapshot(volumeID string, volumeAZ string, tags map[string]string) (snapshotID string, operationID string, err error) {
+	delegate, err := r.getDelegateV2()
+	if err != nil {
+		return "", "", err
+	}
+	start := time.Now()
+	snapshotID, operationID, err = delegate.StartSnapshot(volumeID, volumeAZ, tags)
+	r.calls.observe(r.key, "StartSnapshot", start, err)
+	return snapshotID, operationID, err
+}
+
+// SnapshotProgress restarts the plugin's process if needed, then delegates the call.
+func (r *restartableVolumeSnapshotterV2) SnapshotProgress(operationID string) (velero.OperationProgress, error) {
+	delegate, err := r.getDelegateV2()
+	if err != nil {
+		return velero.OperationProgress{}, err
+	}
+	start := time.Now()
+	progress, err := delegate.SnapshotProgress(operationID)
+	r.calls.observe(r.key, "SnapshotProgress", start, err)
+	return progress, err
+}
+
+// CancelSnapshot restarts the plugin's process if needed, then delegates the call.
+func (r *restartableVolumeSnapshotterV2) CancelSnapshot(operationID string) error {
+	delegate, err := r.getDelegateV2()
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	err = delegate.CancelSnapshot(operationID)
+	r.calls.observe(r.key, "CancelSnapshot", start, err)
+	return err
 }
@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 )
 
@@ -213,6 +214,12 @@ func TestRestartableVolumeSnapshotterDelegatedFunctions(t *testing.T) {
 			expectedErrorOutputs:    []interface{}{"", errors.Errorf("reset error")},
 			expectedDelegateOutputs: []interface{}{"volumeID", errors.Errorf("delegate error")},
 		},
+		restartableDelegateTest{
+			function:                "CreateResizedVolumeFromSnapshot",
+			inputs:                  []interface{}{"snapshotID", "volumeID", "volumeAZ", to.Int64Ptr(10000), int64(1 << 30)},
+			expectedErrorOutputs:    []interface{}{"", false, errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{"volumeID", true, errors.Errorf("delegate error")},
+		},
 		restartableDelegateTest{
 			function:                "GetVolumeID",
 			inputs:                  []interface{}{pv},
@@ -243,5 +250,73 @@ func TestRestartableVolumeSnapshotterDelegatedFunctions(t *testing.T) {
 			expectedErrorOutputs:    []interface{}{errors.Errorf("reset error")},
 			expectedDelegateOutputs: []interface{}{errors.Errorf("delegate error")},
 		},
+		restartableDelegateTest{
+			function:                "VerifySnapshot",
+			inputs:                  []interface{}{"snapshotID", "volumeAZ", true},
+			expectedErrorOutputs:    []interface{}{errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "CreateSnapshotGroup",
+			inputs:                  []interface{}{[]velero.SnapshotGroupVolume{{VolumeID: "volumeID", VolumeAZ: "volumeAZ"}}},
+			expectedErrorOutputs:    []interface{}{([]string)(nil), errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{[]string{"snapshotID"}, errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "GetSnapshotSize",
+			inputs:                  []interface{}{"snapshotID"},
+			expectedErrorOutputs:    []interface{}{int64(0), errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{int64(1024), errors.Errorf("delegate error")},
+		},
+	)
+}
+
+func TestRestartableVolumeSnapshotterV2DelegatedFunctions(t *testing.T) {
+	runRestartableDelegateTests(
+		t,
+		framework.PluginKindVolumeSnapshotter,
+		func(key kindAndName, p RestartableProcess) interface{} {
+			return &restartableVolumeSnapshotterV2{
+				restartableVolumeSnapshotter: &restartableVolumeSnapshotter{
+					key:                 key,
+					sharedPluginProcess: p,
+				},
+			}
+		},
+		func() mockable {
+			return new(providermocks.VolumeSnapshotterV2)
+		},
+		restartableDelegateTest{
+			function:                "StartSnapshot",
+			inputs:                  []interface{}{"volumeID", "volumeAZ", map[string]string{"a": "b"}},
+			expectedErrorOutputs:    []interface{}{"", "", errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{"snapshotID", "operationID", errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "SnapshotProgress",
+			inputs:                  []interface{}{"operationID"},
+			expectedErrorOutputs:    []interface{}{velero.OperationProgress{}, errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{velero.OperationProgress{NCompleted: 1, NTotal: 2}, errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "CancelSnapshot",
+			inputs:                  []interface{}{"operationID"},
+			expectedErrorOutputs:    []interface{}{errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{errors.Errorf("delegate error")},
+		},
 	)
 }
+
+func TestRestartableVolumeSnapshotterV2GetDelegate(t *testing.T) {
+	p := new(mockRestartableProcess)
+	defer p.AssertExpectations(t)
+
+	name := "aws"
+	key := kindAndName{kind: framework.PluginKindVolumeSnapshotter, name: name}
+	p.On("resetIfNeeded").Return(nil)
+	p.On("getByKindAndName", key).Return(new(providermocks.VolumeSnapshotter), nil)
+
+	r := &restartableVolumeSnapshotterV2{newRestartableVolumeSnapshotter(name, p)}
+	_, err := r.getDelegateV2()
+	assert.EqualError(t, err, "*mocks.VolumeSnapshotter is not a VolumeSnapshotterV2!")
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// SnapshotLimiter limits the number of volume snapshot operations that run at the same
+// time in each volume snapshot location, to keep them within the rate limits of the
+// location's provider. It's shared by everything in the server that takes, restores or
+// deletes volume snapshots, since their operations count towards the same limits.
+type SnapshotLimiter struct {
+	logger logrus.FieldLogger
+
+	lock sync.Mutex
+	// slots holds a buffered channel for each limited location, by namespace and name,
+	// whose capacity is the location's limit. An operation sends on the channel
+	// before it starts and receives from it once it has finished.
+	slots map[string]chan struct{}
+}
+
+// NewSnapshotLimiter constructs a new SnapshotLimiter.
+func NewSnapshotLimiter(logger logrus.FieldLogger) *SnapshotLimiter {
+	return &SnapshotLimiter{
+		logger: logger,
+		slots:  make(map[string]chan struct{}),
+	}
+}
+
+// VolumeSnapshotter returns a VolumeSnapshotter whose operations wait while the location's
+// MaxConcurrentOperations are already running. volumeSnapshotter is returned as is if the
+// location doesn't limit its operations, or if the limiter is nil.
+func (l *SnapshotLimiter) VolumeSnapshotter(location *velerov1api.VolumeSnapshotLocation, volumeSnapshotter velero.VolumeSnapshotter) velero.VolumeSnapshotter {
+	if l == nil || location.Spec.MaxConcurrentOperations <= 0 {
+		return volumeSnapshotter
+	}
+
+	limited := &limitedVolumeSnapshotter{
+		VolumeSnapshotter: volumeSnapshotter,
+		slots:             l.locationSlots(location),
+		log:               l.logger.WithField("volumeSnapshotLocation", location.Name),
+	}
+	if _, ok := volumeSnapshotter.(velero.VolumeSnapshotterV2); ok {
+		return &limitedVolumeSnapshotterV2{limited}
+	}
+
+	return limited
+}
+
+// locationSlots returns the slots of a limited location. They're replaced if the
+// location's limit has changed; operations that are already running release the
+// slots they acquired from the old channel.
+func (l *SnapshotLimiter) locationSlots(location *velerov1api.VolumeSnapshotLocation) chan struct{} {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	key := location.Namespace + "/" + location.Name
+	if slots, ok := l.slots[key]; ok && cap(slots) == location.Spec.MaxConcurrentOperations {
+		return slots
+	}
+
+	slots := make(chan struct{}, location.Spec.MaxConcurrentOperations)
+	l.slots[key] = slots
+	return slots
+}
+
+// limitedVolumeSnapshotter is a VolumeSnapshotter whose operations that call its
+// provider's API to create or delete snapshots and volumes are limited by a
+// SnapshotLimiter.
+type limitedVolumeSnapshotter struct {
+	velero.VolumeSnapshotter
+
+	slots chan struct{}
+	log   logrus.FieldLogger
+}
+
+// acquire waits until fewer than the location's maximum number of operations are
+// running, and counts the caller's operation as running.
+func (s *limitedVolumeSnapshotter) acquire() {
+	select {
+	case s.slots <- struct{}{}:
+	default:
+		s.log.Info("Waiting for other operations of volume snapshot location to finish")
+		s.slots <- struct{}{}
+	}
+}
+
+// release counts the caller's operation as finished.
+func (s *limitedVolumeSnapshotter) release() {
+	<-s.slots
+}
+
+func (s *limitedVolumeSnapshotter) CreateVolumeFromSnapshot(snapshotID, volumeType, volumeAZ string, iops *int64) (string, error) {
+	s.acquire()
+	defer s.release()
+
+	return s.VolumeSnapshotter.CreateVolumeFromSnapshot(snapshotID, volumeType, volumeAZ, iops)
+}
+
+func (s *limitedVolumeSnapshotter) CreateResizedVolumeFromSnapshot(snapshotID, volumeType, volumeAZ string, iops *int64, sizeBytes int64) (string, bool, error) {
+	s.acquire()
+	defer s.release()
+
+	return velero.CreateResizedVolumeFromSnapshot(s.VolumeSnapshotter, snapshotID, volumeType, volumeAZ, iops, sizeBytes)
+}
+
+func (s *limitedVolumeSnapshotter) CreateSnapshot(volumeID, volumeAZ string, tags map[string]string) (string, error) {
+	s.acquire()
+	defer s.release()
+
+	return s.VolumeSnapshotter.CreateSnapshot(volumeID, volumeAZ, tags)
+}
+
+func (s *limitedVolumeSnapshotter) DeleteSnapshot(snapshotID string) error {
+	s.acquire()
+	defer s.release()
+
+	return s.VolumeSnapshotter.DeleteSnapshot(snapshotID)
+}
+
+func (s *limitedVolumeSnapshotter) VerifySnapshot(snapshotID, volumeAZ string, testRestore bool) error {
+	verifier, ok := s.VolumeSnapshotter.(velero.SnapshotVerifier)
+	if !ok {
+		return velero.ErrSnapshotVerificationNotSupported
+	}
+
+	s.acquire()
+	defer s.release()
+
+	return verifier.VerifySnapshot(snapshotID, volumeAZ, testRestore)
+}
+
+func (s *limitedVolumeSnapshotter) CreateSnapshotGroup(volumes []velero.SnapshotGroupVolume) ([]string, error) {
+	creator, ok := s.VolumeSnapshotter.(velero.SnapshotGroupCreator)
+	if !ok {
+		return nil, velero.ErrSnapshotGroupsNotSupported
+	}
+
+	s.acquire()
+	defer s.release()
+
+	return creator.CreateSnapshotGroup(volumes)
+}
+
+// GetSnapshotSize isn't limited, since it doesn't create or delete anything.
+func (s *limitedVolumeSnapshotter) GetSnapshotSize(snapshotID string) (int64, error) {
+	sizer, ok := s.VolumeSnapshotter.(velero.SnapshotSizer)
+	if !ok {
+		return 0, velero.ErrSnapshotSizeNotSupported
+	}
+
+	return sizer.GetSnapshotSize(snapshotID)
+}
+
+// limitedVolumeSnapshotterV2 is a limitedVolumeSnapshotter for a VolumeSnapshotterV2. Only
+// starting a snapshot counts as a running operation; the snapshot's progress afterwards
+// is up to the provider.
+type limitedVolumeSnapshotterV2 struct {
+	*limitedVolumeSnapshotter
+}
+
+func (s *limitedVolumeSnapshotterV2) StartSnapshot(volumeID, volumeAZ string, tags map[string]string) (string, string, error) {
+	s.acquire()
+	defer s.release()
+
+	return s.VolumeSnapshotter.(velero.VolumeSnapshotterV2).StartSnapshot(volumeID, volumeAZ, tags)
+}
+
+// SnapshotProgress isn't limited, since it doesn't create or delete anything.
+func (s *limitedVolumeSnapshotterV2) SnapshotProgress(operationID string) (velero.OperationProgress, error) {
+	return s.VolumeSnapshotter.(velero.VolumeSnapshotterV2).SnapshotProgress(operationID)
+}
+
+func (s *limitedVolumeSnapshotterV2) CancelSnapshot(operationID string) error {
+	s.acquire()
+	defer s.release()
+
+	return s.VolumeSnapshotter.(velero.VolumeSnapshotterV2).CancelSnapshot(operationID)
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// blockingVolumeSnapshotter is a VolumeSnapshotter whose CreateSnapshot calls block until
+// they're unblocked, and which records how many of them ran at the same time.
+type blockingVolumeSnapshotter struct {
+	velero.VolumeSnapshotter
+
+	lock       sync.Mutex
+	running    int
+	maxRunning int
+	unblock    chan struct{}
+}
+
+func (b *blockingVolumeSnapshotter) CreateSnapshot(volumeID, volumeAZ string, tags map[string]string) (string, error) {
+	b.lock.Lock()
+	b.running++
+	if b.running > b.maxRunning {
+		b.maxRunning = b.running
+	}
+	b.lock.Unlock()
+
+	<-b.unblock
+
+	b.lock.Lock()
+	b.running--
+	b.lock.Unlock()
+
+	return "snap-" + volumeID, nil
+}
+
+func (b *blockingVolumeSnapshotter) getRunning() int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.running
+}
+
+func TestSnapshotLimiterVolumeSnapshotter(t *testing.T) {
+	volumeSnapshotter := &blockingVolumeSnapshotter{}
+
+	var limiter *SnapshotLimiter
+	location := builder.ForVolumeSnapshotLocation("velero", "vsl-1").Result()
+	assert.Equal(t, volumeSnapshotter, limiter.VolumeSnapshotter(location, volumeSnapshotter), "nil limiter doesn't limit operations")
+
+	limiter = NewSnapshotLimiter(test.NewLogger())
+	assert.Equal(t, volumeSnapshotter, limiter.VolumeSnapshotter(location, volumeSnapshotter), "location without a limit doesn't limit operations")
+}
+
+func TestSnapshotLimiterLimitsConcurrentOperations(t *testing.T) {
+	var (
+		limiter           = NewSnapshotLimiter(test.NewLogger())
+		location          = builder.ForVolumeSnapshotLocation("velero", "vsl-1").MaxConcurrentOperations(2).Result()
+		volumeSnapshotter = &blockingVolumeSnapshotter{unblock: make(chan struct{})}
+		wg                sync.WaitGroup
+	)
+
+	// every operation gets its own limited volume snapshotter, like the backups,
+	// restores and deletions that share the location.
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := limiter.VolumeSnapshotter(location, volumeSnapshotter).CreateSnapshot("vol-1", "zone-1", nil)
+			assert.NoError(t, err)
+		}()
+	}
+
+	assert.Eventually(t, func() bool { return volumeSnapshotter.getRunning() == 2 }, time.Second, 10*time.Millisecond)
+
+	for i := 0; i < 5; i++ {
+		volumeSnapshotter.unblock <- struct{}{}
+	}
+	wg.Wait()
+
+	assert.Equal(t, 2, volumeSnapshotter.maxRunning)
+}
+
+func TestSnapshotLimiterVolumeSnapshotterV2(t *testing.T) {
+	var (
+		limiter           = NewSnapshotLimiter(test.NewLogger())
+		location          = builder.ForVolumeSnapshotLocation("velero", "vsl-1").MaxConcurrentOperations(1).Result()
+		volumeSnapshotter = new(providermocks.VolumeSnapshotterV2)
+	)
+	defer volumeSnapshotter.AssertExpectations(t)
+
+	limited, ok := limiter.VolumeSnapshotter(location, volumeSnapshotter).(velero.VolumeSnapshotterV2)
+	require.True(t, ok, "limited volume snapshotter should implement VolumeSnapshotterV2")
+
+	// another operation is running, which doesn't stop the progress of snapshots from being checked.
+	slots := limiter.locationSlots(location)
+	slots <- struct{}{}
+
+	volumeSnapshotter.On("SnapshotProgress", "op-1").Return(velero.OperationProgress{Completed: true}, nil)
+	progress, err := limited.SnapshotProgress("op-1")
+	require.NoError(t, err)
+	assert.True(t, progress.Completed)
+
+	<-slots
+
+	volumeSnapshotter.On("StartSnapshot", "vol-1", "zone-1", map[string]string(nil)).Return("snap-1", "op-2", nil)
+	snapshotID, operationID, err := limited.StartSnapshot("vol-1", "zone-1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "snap-1", snapshotID)
+	assert.Equal(t, "op-2", operationID)
+	assert.Len(t, slots, 0)
+}
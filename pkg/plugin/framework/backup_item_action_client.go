@@ -20,7 +20,6 @@ import (
 	"encoding/json"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -42,24 +41,31 @@ func NewBackupItemActionPlugin(options ...PluginOption) *BackupItemActionPlugin
 // gRPC client to make calls to the plugin server.
 type BackupItemActionGRPCClient struct {
 	*clientBase
-	grpcClient proto.BackupItemActionClient
+	pluginConfig
+	grpcClient   proto.BackupItemActionClient
+	grpcClientV2 proto.BackupItemActionV2Client
 }
 
 func newBackupItemActionGRPCClient(base *clientBase, clientConn *grpc.ClientConn) interface{} {
 	return &BackupItemActionGRPCClient{
-		clientBase: base,
-		grpcClient: proto.NewBackupItemActionClient(clientConn),
+		clientBase:   base,
+		grpcClient:   proto.NewBackupItemActionClient(clientConn),
+		grpcClientV2: proto.NewBackupItemActionV2Client(clientConn),
 	}
 }
 
 func (c *BackupItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
 	req := &proto.BackupItemActionAppliesToRequest{
 		Plugin: c.plugin,
+		Config: c.config,
 	}
 
-	res, err := c.grpcClient.AppliesTo(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.AppliesTo(ctx, req)
 	if err != nil {
-		return velero.ResourceSelector{}, fromGRPCError(err)
+		return velero.ResourceSelector{}, c.callError(ctx, err)
 	}
 
 	if res.ResourceSelector == nil {
@@ -92,9 +98,12 @@ func (c *BackupItemActionGRPCClient) Execute(item runtime.Unstructured, backup *
 		Backup: backupJSON,
 	}
 
-	res, err := c.grpcClient.Execute(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.Execute(ctx, req)
 	if err != nil {
-		return nil, nil, fromGRPCError(err)
+		return nil, nil, c.callError(ctx, err)
 	}
 
 	var updatedItem unstructured.Unstructured
@@ -119,3 +128,50 @@ func (c *BackupItemActionGRPCClient) Execute(item runtime.Unstructured, backup *
 
 	return &updatedItem, additionalItems, nil
 }
+
+// Progress uses the version 2 API, so it can only be called if the plugin serves it.
+func (c *BackupItemActionGRPCClient) Progress(item velero.ResourceIdentifier, backup *api.Backup) (velero.OperationProgress, error) {
+	backupJSON, err := json.Marshal(backup)
+	if err != nil {
+		return velero.OperationProgress{}, errors.WithStack(err)
+	}
+
+	req := &proto.BackupItemActionProgressRequest{
+		Plugin: c.plugin,
+		Item:   backupResourceIdentifierToProto(item),
+		Backup: backupJSON,
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClientV2.Progress(ctx, req)
+	if err != nil {
+		return velero.OperationProgress{}, c.callError(ctx, err)
+	}
+
+	return operationProgressFromProto(res.Progress), nil
+}
+
+// Cancel uses the version 2 API, so it can only be called if the plugin serves it.
+func (c *BackupItemActionGRPCClient) Cancel(item velero.ResourceIdentifier, backup *api.Backup) error {
+	backupJSON, err := json.Marshal(backup)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	req := &proto.BackupItemActionCancelRequest{
+		Plugin: c.plugin,
+		Item:   backupResourceIdentifierToProto(item),
+		Backup: backupJSON,
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	if _, err := c.grpcClientV2.Cancel(ctx, req); err != nil {
+		return c.callError(ctx, err)
+	}
+
+	return nil
+}
@@ -48,6 +48,20 @@ func (s *BackupItemActionGRPCServer) getImpl(name string) (velero.BackupItemActi
 	return itemAction, nil
 }
 
+func (s *BackupItemActionGRPCServer) getImplV2(name string) (velero.BackupItemActionV2, error) {
+	impl, err := s.mux.getHandler(name)
+	if err != nil {
+		return nil, err
+	}
+
+	itemAction, ok := impl.(velero.BackupItemActionV2)
+	if !ok {
+		return nil, errors.Errorf("%T is not a version 2 backup item action", impl)
+	}
+
+	return itemAction, nil
+}
+
 func (s *BackupItemActionGRPCServer) AppliesTo(ctx context.Context, req *proto.BackupItemActionAppliesToRequest) (response *proto.BackupItemActionAppliesToResponse, err error) {
 	defer func() {
 		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
@@ -60,6 +74,10 @@ func (s *BackupItemActionGRPCServer) AppliesTo(ctx context.Context, req *proto.B
 		return nil, newGRPCError(err)
 	}
 
+	if err := configure(impl, req.Config); err != nil {
+		return nil, newGRPCError(err)
+	}
+
 	resourceSelector, err := impl.AppliesTo()
 	if err != nil {
 		return nil, newGRPCError(err)
@@ -126,6 +144,55 @@ func (s *BackupItemActionGRPCServer) Execute(ctx context.Context, req *proto.Exe
 	return res, nil
 }
 
+func (s *BackupItemActionGRPCServer) Progress(ctx context.Context, req *proto.BackupItemActionProgressRequest) (response *proto.BackupItemActionProgressResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImplV2(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	var backup api.Backup
+	if err := json.Unmarshal(req.Backup, &backup); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+
+	progress, err := impl.Progress(resourceIdentifierFromProto(req.Item), &backup)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.BackupItemActionProgressResponse{Progress: operationProgressToProto(progress)}, nil
+}
+
+func (s *BackupItemActionGRPCServer) Cancel(ctx context.Context, req *proto.BackupItemActionCancelRequest) (response *proto.Empty, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImplV2(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	var backup api.Backup
+	if err := json.Unmarshal(req.Backup, &backup); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+
+	if err := impl.Cancel(resourceIdentifierFromProto(req.Item), &backup); err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.Empty{}, nil
+}
+
 func backupResourceIdentifierToProto(id velero.ResourceIdentifier) *proto.ResourceIdentifier {
 	return &proto.ResourceIdentifier{
 		Group:     id.Group,
@@ -197,3 +197,84 @@ func TestBackupItemActionGRPCServerExecute(t *testing.T) {
 		})
 	}
 }
+
+type configurableItemAction struct {
+	*mocks.ItemAction
+	config       map[string]string
+	configureErr error
+}
+
+func (a *configurableItemAction) Configure(config map[string]string) error {
+	a.config = config
+	return a.configureErr
+}
+
+func TestBackupItemActionGRPCServerAppliesToConfigure(t *testing.T) {
+	tests := []struct {
+		name           string
+		configurable   bool
+		config         map[string]string
+		configureErr   error
+		expectedConfig map[string]string
+		expectError    bool
+	}{
+		{
+			name:   "impl that isn't configurable ignores config",
+			config: map[string]string{"key": "value"},
+		},
+		{
+			name:           "configurable impl is configured before AppliesTo",
+			configurable:   true,
+			config:         map[string]string{"key": "value"},
+			expectedConfig: map[string]string{"key": "value"},
+		},
+		{
+			name:           "configurable impl gets an empty map when there's no config",
+			configurable:   true,
+			expectedConfig: map[string]string{},
+		},
+		{
+			name:           "error configuring impl is returned",
+			configurable:   true,
+			configureErr:   errors.New("bad config"),
+			expectedConfig: map[string]string{},
+			expectError:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			itemAction := &mocks.ItemAction{}
+			defer itemAction.AssertExpectations(t)
+
+			if !test.expectError {
+				itemAction.On("AppliesTo").Return(velero.ResourceSelector{IncludedResources: []string{"pods"}}, nil)
+			}
+
+			var impl interface{} = itemAction
+			configurable := &configurableItemAction{ItemAction: itemAction, configureErr: test.configureErr}
+			if test.configurable {
+				impl = configurable
+			}
+
+			s := &BackupItemActionGRPCServer{mux: &serverMux{
+				serverLog: velerotest.NewLogger(),
+				handlers: map[string]interface{}{
+					"xyz": impl,
+				},
+			}}
+
+			resp, err := s.AppliesTo(context.Background(), &proto.BackupItemActionAppliesToRequest{
+				Plugin: "xyz",
+				Config: test.config,
+			})
+
+			assert.Equal(t, test.expectedConfig, configurable.config)
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []string{"pods"}, resp.ResourceSelector.IncludedResources)
+		})
+	}
+}
@@ -17,7 +17,11 @@ limitations under the License.
 package framework
 
 import (
+	"time"
+
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 )
 
@@ -25,6 +29,32 @@ import (
 type clientBase struct {
 	plugin string
 	logger logrus.FieldLogger
+
+	// timeout bounds each call to the plugin. Calls are unbounded if it's zero.
+	timeout time.Duration
+	// onTimeout, if set, is called when a call to the plugin exceeds timeout.
+	onTimeout func(name string)
+}
+
+// callContext returns the context for a single call to the plugin.
+func (c *clientBase) callContext() (context.Context, context.CancelFunc) {
+	if c.timeout <= 0 {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithTimeout(context.Background(), c.timeout)
+}
+
+// callError converts err, returned by a call to the plugin made with ctx, to the error returned to
+// the caller. Calls that exceeded the client's timeout are reported to onTimeout.
+func (c *clientBase) callError(ctx context.Context, err error) error {
+	if ctx.Err() != context.DeadlineExceeded {
+		return fromGRPCError(err)
+	}
+
+	if c.onTimeout != nil {
+		c.onTimeout(c.plugin)
+	}
+	return errors.Errorf("plugin %s did not respond within %v", c.plugin, c.timeout)
 }
 
 type ClientDispenser interface {
@@ -42,6 +72,10 @@ type clientDispenser struct {
 	initFunc clientInitFunc
 	// clients keeps track of all the initialized implementations.
 	clients map[string]interface{}
+	// timeout returns the timeout for calls to the implementation named name. It may be nil.
+	timeout func(name string) time.Duration
+	// onTimeout is called when a call to an implementation times out. It may be nil.
+	onTimeout func(name string)
 }
 
 type clientInitFunc func(base *clientBase, clientConn *grpc.ClientConn) interface{}
@@ -64,8 +98,12 @@ func (cd *clientDispenser) ClientFor(name string) interface{} {
 	}
 
 	base := &clientBase{
-		plugin: name,
-		logger: cd.logger,
+		plugin:    name,
+		logger:    cd.logger,
+		onTimeout: cd.onTimeout,
+	}
+	if cd.timeout != nil {
+		base.timeout = cd.timeout(name)
 	}
 	// Initialize the plugin (e.g. newBackupItemActionGRPCClient())
 	client := cd.initFunc(base, cd.clientConn)
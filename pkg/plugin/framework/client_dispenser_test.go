@@ -17,10 +17,14 @@ package framework
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/vmware-tanzu/velero/pkg/test"
 )
@@ -79,3 +83,64 @@ func TestClientFor(t *testing.T) {
 	typed = actual.(*fakeClient)
 	assert.Equal(t, 1, count)
 }
+
+func TestClientForWithTimeout(t *testing.T) {
+	c := new(fakeClient)
+	initFunc := func(base *clientBase, clientConn *grpc.ClientConn) interface{} {
+		c.base = base
+		return c
+	}
+
+	var timedOut []string
+	base := newPluginBase(ClientTimeout(
+		func(name string) time.Duration {
+			if name == "slow" {
+				return time.Hour
+			}
+			return time.Minute
+		},
+		func(name string) { timedOut = append(timedOut, name) },
+	))
+	cd := base.newClientDispenser(new(grpc.ClientConn), initFunc)
+
+	cd.ClientFor("slow")
+	assert.Equal(t, time.Hour, c.base.timeout)
+
+	cd.ClientFor("fast")
+	assert.Equal(t, time.Minute, c.base.timeout)
+
+	require.NotNil(t, c.base.onTimeout)
+	c.base.onTimeout("fast")
+	assert.Equal(t, []string{"fast"}, timedOut)
+}
+
+func TestCallError(t *testing.T) {
+	var timedOut []string
+	base := &clientBase{
+		plugin:    "pod",
+		timeout:   time.Millisecond,
+		onTimeout: func(name string) { timedOut = append(timedOut, name) },
+	}
+
+	// a call that fails before its timeout returns the plugin's error
+	ctx, cancel := base.callContext()
+	pluginErr := status.Error(codes.Internal, "plugin error")
+	err := base.callError(ctx, pluginErr)
+	cancel()
+	assert.Equal(t, pluginErr, err)
+	assert.Empty(t, timedOut)
+
+	// a call that exceeds its timeout is reported
+	ctx, cancel = base.callContext()
+	defer cancel()
+	<-ctx.Done()
+	err = base.callError(ctx, status.Error(codes.DeadlineExceeded, context.DeadlineExceeded.Error()))
+	assert.EqualError(t, err, "plugin pod did not respond within 1ms")
+	assert.Equal(t, []string{"pod"}, timedOut)
+
+	// calls are unbounded without a timeout
+	ctx, cancel = (&clientBase{plugin: "pod"}).callContext()
+	defer cancel()
+	_, hasDeadline := ctx.Deadline()
+	assert.False(t, hasDeadline)
+}
@@ -20,7 +20,6 @@ import (
 	"encoding/json"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
@@ -40,6 +39,7 @@ func NewDeleteItemActionPlugin(options ...PluginOption) *DeleteItemActionPlugin
 // gRPC client to make calls to the plugin server.
 type DeleteItemActionGRPCClient struct {
 	*clientBase
+	pluginConfig
 	grpcClient proto.DeleteItemActionClient
 }
 
@@ -51,9 +51,12 @@ func newDeleteItemActionGRPCClient(base *clientBase, clientConn *grpc.ClientConn
 }
 
 func (c *DeleteItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
-	res, err := c.grpcClient.AppliesTo(context.Background(), &proto.DeleteItemActionAppliesToRequest{Plugin: c.plugin})
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.AppliesTo(ctx, &proto.DeleteItemActionAppliesToRequest{Plugin: c.plugin, Config: c.config})
 	if err != nil {
-		return velero.ResourceSelector{}, fromGRPCError(err)
+		return velero.ResourceSelector{}, c.callError(ctx, err)
 	}
 
 	if res.ResourceSelector == nil {
@@ -86,9 +89,12 @@ func (c *DeleteItemActionGRPCClient) Execute(input *velero.DeleteItemActionExecu
 		Backup: backupJSON,
 	}
 
+	ctx, cancel := c.callContext()
+	defer cancel()
+
 	// First return item is just an empty struct no matter what.
-	if _, err = c.grpcClient.Execute(context.Background(), req); err != nil {
-		return fromGRPCError(err)
+	if _, err = c.grpcClient.Execute(ctx, req); err != nil {
+		return c.callError(ctx, err)
 	}
 
 	return nil
@@ -60,6 +60,10 @@ func (s *DeleteItemActionGRPCServer) AppliesTo(ctx context.Context, req *proto.D
 		return nil, newGRPCError(err)
 	}
 
+	if err := configure(impl, req.Config); err != nil {
+		return nil, newGRPCError(err)
+	}
+
 	resourceSelector, err := impl.AppliesTo()
 	if err != nil {
 		return nil, newGRPCError(err)
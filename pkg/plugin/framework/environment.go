@@ -0,0 +1,49 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"os"
+	"strings"
+)
+
+// EnvironmentOverridePrefix is the prefix of the environment variables that the Velero
+// server launches a plugin process with to override the process's other environment
+// variables. go-plugin gives the server's own environment precedence over the variables
+// that a process is launched with, so the overrides are applied by the plugin server.
+const EnvironmentOverridePrefix = "VELERO_PLUGIN_ENV_"
+
+// applyEnvironmentOverrides sets the environment variables named by the overrides in
+// environ, a list of "key=value" strings like the one returned by os.Environ.
+func applyEnvironmentOverrides(environ []string) error {
+	for _, kv := range environ {
+		if !strings.HasPrefix(kv, EnvironmentOverridePrefix) {
+			continue
+		}
+
+		parts := strings.SplitN(strings.TrimPrefix(kv, EnvironmentOverridePrefix), "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			continue
+		}
+
+		if err := os.Setenv(parts[0], parts[1]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
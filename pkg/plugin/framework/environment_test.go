@@ -0,0 +1,45 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyEnvironmentOverrides(t *testing.T) {
+	require.NoError(t, os.Setenv("VELERO_TEST_PROXY", "http://pod-proxy:3128"))
+	require.NoError(t, os.Setenv("VELERO_TEST_NO_PROXY", "example.com"))
+	defer os.Unsetenv("VELERO_TEST_PROXY")
+	defer os.Unsetenv("VELERO_TEST_NO_PROXY")
+
+	err := applyEnvironmentOverrides([]string{
+		"VELERO_PLUGIN_ENV_VELERO_TEST_PROXY=http://site-proxy:3128",
+		"VELERO_PLUGIN_ENV_VELERO_TEST_NO_PROXY=",
+		"VELERO_PLUGIN_ENV_=ignored",
+		"OTHER=value",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "http://site-proxy:3128", os.Getenv("VELERO_TEST_PROXY"))
+	noProxy, ok := os.LookupEnv("VELERO_TEST_NO_PROXY")
+	assert.True(t, ok)
+	assert.Equal(t, "", noProxy)
+}
@@ -25,4 +25,8 @@ type Interface interface {
 	// names returns a list of all the registered implementations for this plugin (such as "pod" and "pvc" for
 	// BackupItemAction).
 	names() []string
+
+	// apiVersionsFor returns the versions of the plugin's API that the implementation with the
+	// given name serves.
+	apiVersionsFor(name string) []int
 }
@@ -0,0 +1,108 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+var _ velero.ItemBlockAction = &ItemBlockActionGRPCClient{}
+
+// NewItemBlockActionPlugin constructs an ItemBlockActionPlugin.
+func NewItemBlockActionPlugin(options ...PluginOption) *ItemBlockActionPlugin {
+	return &ItemBlockActionPlugin{
+		pluginBase: newPluginBase(options...),
+	}
+}
+
+// ItemBlockActionGRPCClient implements the ItemBlockAction interface and uses a
+// gRPC client to make calls to the plugin server.
+type ItemBlockActionGRPCClient struct {
+	*clientBase
+	pluginConfig
+	grpcClient proto.ItemBlockActionClient
+}
+
+func newItemBlockActionGRPCClient(base *clientBase, clientConn *grpc.ClientConn) interface{} {
+	return &ItemBlockActionGRPCClient{
+		clientBase: base,
+		grpcClient: proto.NewItemBlockActionClient(clientConn),
+	}
+}
+
+func (c *ItemBlockActionGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.AppliesTo(ctx, &proto.ItemBlockActionAppliesToRequest{Plugin: c.plugin, Config: c.config})
+	if err != nil {
+		return velero.ResourceSelector{}, c.callError(ctx, err)
+	}
+
+	if res.ResourceSelector == nil {
+		return velero.ResourceSelector{}, nil
+	}
+
+	return velero.ResourceSelector{
+		IncludedNamespaces: res.ResourceSelector.IncludedNamespaces,
+		ExcludedNamespaces: res.ResourceSelector.ExcludedNamespaces,
+		IncludedResources:  res.ResourceSelector.IncludedResources,
+		ExcludedResources:  res.ResourceSelector.ExcludedResources,
+		LabelSelector:      res.ResourceSelector.Selector,
+	}, nil
+}
+
+func (c *ItemBlockActionGRPCClient) GetRelatedItems(item runtime.Unstructured, backup *api.Backup) ([]velero.ResourceIdentifier, error) {
+	itemJSON, err := json.Marshal(item.UnstructuredContent())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	backupJSON, err := json.Marshal(backup)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	req := &proto.ItemBlockActionGetRelatedItemsRequest{
+		Plugin: c.plugin,
+		Item:   itemJSON,
+		Backup: backupJSON,
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.GetRelatedItems(ctx, req)
+	if err != nil {
+		return nil, c.callError(ctx, err)
+	}
+
+	var relatedItems []velero.ResourceIdentifier
+	for _, itm := range res.RelatedItems {
+		relatedItems = append(relatedItems, resourceIdentifierFromProto(itm))
+	}
+
+	return relatedItems, nil
+}
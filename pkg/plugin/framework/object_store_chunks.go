@@ -0,0 +1,99 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+
+	"github.com/pkg/errors"
+
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+)
+
+// crc32cTable is the table for the CRC-32C checksums of the chunks of object data
+// streamed between Velero and object store plugins.
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// chunkChecksum returns the big-endian CRC-32C checksum of data.
+func chunkChecksum(data []byte) []byte {
+	checksum := make([]byte, crc32.Size)
+	binary.BigEndian.PutUint32(checksum, crc32.Checksum(data, crc32cTable))
+	return checksum
+}
+
+// verifyChunkChecksum returns an error if checksum isn't the checksum of the chunk of data
+// at offset. Chunks sent without a checksum, by older versions of Velero or plugins, aren't
+// verified.
+func verifyChunkChecksum(data, checksum []byte, offset int64) error {
+	if len(checksum) == 0 {
+		return nil
+	}
+	if len(checksum) != crc32.Size || binary.BigEndian.Uint32(checksum) != crc32.Checksum(data, crc32cTable) {
+		return errors.Errorf("checksum mismatch for the %d bytes at offset %d of the object", len(data), offset)
+	}
+	return nil
+}
+
+// receiveObjectChunks returns a ReceiveFunc that returns the data of the chunks returned by
+// recv, starting from offset, after verifying their checksums.
+//
+// Object store plugins built with older versions of Velero send chunks without checksums
+// or offsets, starting from the beginning of the object no matter the requested offset, so
+// the data before offset is discarded.
+func receiveObjectChunks(recv func() (*proto.Bytes, error), offset int64) ReceiveFunc {
+	// next is the offset of the next byte to return, and unversioned is the offset
+	// of the next chunk if it doesn't have one.
+	next := offset
+	var unversioned int64
+
+	return func() ([]byte, error) {
+		for {
+			chunk, err := recv()
+			if err == io.EOF {
+				// we need to return io.EOF errors unwrapped so that
+				// calling code sees them as io.EOF and knows to stop
+				// reading.
+				return nil, err
+			}
+			if err != nil {
+				return nil, fromGRPCError(err)
+			}
+
+			start := unversioned
+			if len(chunk.Checksum) > 0 {
+				start = chunk.Offset
+			}
+			unversioned += int64(len(chunk.Data))
+
+			if err := verifyChunkChecksum(chunk.Data, chunk.Checksum, start); err != nil {
+				return nil, err
+			}
+			if start > next {
+				return nil, errors.Errorf("expected the chunk at offset %d of the object, got the one at offset %d", next, start)
+			}
+
+			// skip the data that's already been returned
+			if skip := next - start; skip < int64(len(chunk.Data)) {
+				data := chunk.Data[skip:]
+				next += int64(len(data))
+				return data, nil
+			}
+		}
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+)
+
+func TestVerifyChunkChecksum(t *testing.T) {
+	data := []byte("some object data")
+
+	assert.NoError(t, verifyChunkChecksum(data, chunkChecksum(data), 0))
+	assert.NoError(t, verifyChunkChecksum(data, nil, 0))
+	assert.Error(t, verifyChunkChecksum([]byte("other object data"), chunkChecksum(data), 0))
+	assert.Error(t, verifyChunkChecksum(data, []byte{1, 2}, 0))
+}
+
+// checksummed returns the chunk of data at offset, as sent by a plugin that checksums chunks.
+func checksummed(data string, offset int64) *proto.Bytes {
+	return &proto.Bytes{Data: []byte(data), Checksum: chunkChecksum([]byte(data)), Offset: offset}
+}
+
+func TestReceiveObjectChunks(t *testing.T) {
+	tests := []struct {
+		name        string
+		offset      int64
+		chunks      []*proto.Bytes
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:     "checksummed chunks from the start of the object",
+			chunks:   []*proto.Bytes{checksummed("hello ", 0), checksummed("world", 6)},
+			expected: "hello world",
+		},
+		{
+			name:     "checksummed chunks from an offset",
+			offset:   6,
+			chunks:   []*proto.Bytes{checksummed("wor", 6), checksummed("ld", 9)},
+			expected: "world",
+		},
+		{
+			name:     "chunks without checksums are returned as they are",
+			chunks:   []*proto.Bytes{{Data: []byte("hello ")}, {Data: []byte("world")}},
+			expected: "hello world",
+		},
+		{
+			name:     "chunks without checksums before the offset are discarded",
+			offset:   4,
+			chunks:   []*proto.Bytes{{Data: []byte("hel")}, {Data: []byte("lo ")}, {Data: []byte("world")}},
+			expected: "o world",
+		},
+		{
+			name:        "a chunk with the wrong checksum is an error",
+			chunks:      []*proto.Bytes{checksummed("hello ", 0), {Data: []byte("world"), Checksum: chunkChecksum([]byte("wrld")), Offset: 6}},
+			expectedErr: "checksum mismatch for the 5 bytes at offset 6 of the object",
+		},
+		{
+			name:        "a missing chunk is an error",
+			chunks:      []*proto.Bytes{checksummed("hello ", 0), checksummed("ld", 9)},
+			expectedErr: "expected the chunk at offset 6 of the object, got the one at offset 9",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			chunks := tc.chunks
+			recv := func() (*proto.Bytes, error) {
+				if len(chunks) == 0 {
+					return nil, io.EOF
+				}
+				chunk := chunks[0]
+				chunks = chunks[1:]
+				return chunk, nil
+			}
+
+			res, err := ioutil.ReadAll(&StreamReadCloser{receive: receiveObjectChunks(recv, tc.offset)})
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedErr, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, string(res))
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"google.golang.org/grpc"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 const byteChunkSize = 16384
@@ -59,8 +60,11 @@ func (c *ObjectStoreGRPCClient) Init(config map[string]string) error {
 		Config: config,
 	}
 
-	if _, err := c.grpcClient.Init(context.Background(), req); err != nil {
-		return fromGRPCError(err)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	if _, err := c.grpcClient.Init(ctx, req); err != nil {
+		return c.callError(ctx, err)
 	}
 
 	return nil
@@ -75,10 +79,21 @@ func (c *ObjectStoreGRPCClient) PutObject(bucket, key string, body io.Reader) er
 	}
 
 	// read from the provider io.Reader into chunks, and send each one over
-	// the gRPC stream
+	// the gRPC stream with its checksum
 	chunk := make([]byte, byteChunkSize)
 	for {
 		n, err := body.Read(chunk)
+		if n > 0 {
+			req := &proto.PutObjectRequest{Plugin: c.plugin, Bucket: bucket, Key: key, Body: chunk[0:n], Checksum: chunkChecksum(chunk[0:n])}
+			if sendErr := stream.Send(req); sendErr != nil {
+				// the plugin stopped receiving, so the reason is only
+				// returned when closing the stream.
+				if sendErr == io.EOF {
+					_, sendErr = stream.CloseAndRecv()
+				}
+				return fromGRPCError(sendErr)
+			}
+		}
 		if err == io.EOF {
 			if _, resErr := stream.CloseAndRecv(); resErr != nil {
 				return fromGRPCError(resErr)
@@ -89,10 +104,6 @@ func (c *ObjectStoreGRPCClient) PutObject(bucket, key string, body io.Reader) er
 			stream.CloseSend()
 			return errors.WithStack(err)
 		}
-
-		if err := stream.Send(&proto.PutObjectRequest{Plugin: c.plugin, Bucket: bucket, Key: key, Body: chunk[0:n]}); err != nil {
-			return fromGRPCError(err)
-		}
 	}
 }
 
@@ -104,9 +115,12 @@ func (c *ObjectStoreGRPCClient) ObjectExists(bucket, key string) (bool, error) {
 		Key:    key,
 	}
 
-	res, err := c.grpcClient.ObjectExists(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.ObjectExists(ctx, req)
 	if err != nil {
-		return false, err
+		return false, c.callError(ctx, err)
 	}
 
 	return res.Exists, nil
@@ -115,40 +129,39 @@ func (c *ObjectStoreGRPCClient) ObjectExists(bucket, key string) (bool, error) {
 // GetObject retrieves the object with the given key from the specified
 // bucket in object storage.
 func (c *ObjectStoreGRPCClient) GetObject(bucket, key string) (io.ReadCloser, error) {
+	return c.GetObjectRange(bucket, key, 0)
+}
+
+// GetObjectRange retrieves the object with the given key from the specified
+// bucket in object storage, starting from offset. The checksums of the chunks
+// of the object are verified as they're read.
+func (c *ObjectStoreGRPCClient) GetObjectRange(bucket, key string, offset int64) (io.ReadCloser, error) {
 	req := &proto.GetObjectRequest{
 		Plugin: c.plugin,
 		Bucket: bucket,
 		Key:    key,
+		Offset: offset,
 	}
 
-	stream, err := c.grpcClient.GetObject(context.Background(), req)
+	// the stream is canceled when the object is closed, so that the plugin
+	// stops sending it if it's closed before it's been read.
+	ctx, cancel := context.WithCancel(context.Background())
+
+	stream, err := c.grpcClient.GetObject(ctx, req)
 	if err != nil {
+		cancel()
 		return nil, fromGRPCError(err)
 	}
 
-	receive := func() ([]byte, error) {
-		data, err := stream.Recv()
-		if err == io.EOF {
-			// we need to return io.EOF errors unwrapped so that
-			// calling code sees them as io.EOF and knows to stop
-			// reading.
-			return nil, err
-		}
-		if err != nil {
-			return nil, fromGRPCError(err)
-		}
-
-		return data.Data, nil
-	}
-
 	close := func() error {
+		defer cancel()
 		if err := stream.CloseSend(); err != nil {
 			return fromGRPCError(err)
 		}
 		return nil
 	}
 
-	return &StreamReadCloser{receive: receive, close: close}, nil
+	return &StreamReadCloser{receive: receiveObjectChunks(stream.Recv, offset), close: close}, nil
 }
 
 // ListCommonPrefixes gets a list of all object key prefixes that come
@@ -162,9 +175,12 @@ func (c *ObjectStoreGRPCClient) ListCommonPrefixes(bucket, prefix, delimiter str
 		Delimiter: delimiter,
 	}
 
-	res, err := c.grpcClient.ListCommonPrefixes(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.ListCommonPrefixes(ctx, req)
 	if err != nil {
-		return nil, fromGRPCError(err)
+		return nil, c.callError(ctx, err)
 	}
 
 	return res.Prefixes, nil
@@ -178,14 +194,50 @@ func (c *ObjectStoreGRPCClient) ListObjects(bucket, prefix string) ([]string, er
 		Prefix: prefix,
 	}
 
-	res, err := c.grpcClient.ListObjects(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.ListObjects(ctx, req)
 	if err != nil {
-		return nil, fromGRPCError(err)
+		return nil, c.callError(ctx, err)
 	}
 
 	return res.Keys, nil
 }
 
+// ListObjectsPage gets a page of up to limit objects in bucket that have the same prefix
+// and whose keys sort after startAfter, along with their ETags and the key to list the
+// next page after. Plugins built with versions of Velero that don't list objects in pages
+// list all of the objects in a single page, without ETags.
+func (c *ObjectStoreGRPCClient) ListObjectsPage(bucket, prefix, startAfter string, limit int) ([]velero.ObjectInfo, string, error) {
+	req := &proto.ListObjectsRequest{
+		Plugin:     c.plugin,
+		Bucket:     bucket,
+		Prefix:     prefix,
+		StartAfter: startAfter,
+		Limit:      int32(limit),
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.ListObjects(ctx, req)
+	if err != nil {
+		return nil, "", c.callError(ctx, err)
+	}
+
+	objects := make([]velero.ObjectInfo, 0, len(res.Keys))
+	for i, key := range res.Keys {
+		object := velero.ObjectInfo{Key: key}
+		if i < len(res.Etags) {
+			object.ETag = res.Etags[i]
+		}
+		objects = append(objects, object)
+	}
+
+	return objects, res.NextStartAfter, nil
+}
+
 // DeleteObject removes object with the specified key from the given
 // bucket.
 func (c *ObjectStoreGRPCClient) DeleteObject(bucket, key string) error {
@@ -195,8 +247,11 @@ func (c *ObjectStoreGRPCClient) DeleteObject(bucket, key string) error {
 		Key:    key,
 	}
 
-	if _, err := c.grpcClient.DeleteObject(context.Background(), req); err != nil {
-		return fromGRPCError(err)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	if _, err := c.grpcClient.DeleteObject(ctx, req); err != nil {
+		return c.callError(ctx, err)
 	}
 
 	return nil
@@ -211,9 +266,12 @@ func (c *ObjectStoreGRPCClient) CreateSignedURL(bucket, key string, ttl time.Dur
 		Ttl:    int64(ttl),
 	}
 
-	res, err := c.grpcClient.CreateSignedURL(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.CreateSignedURL(ctx, req)
 	if err != nil {
-		return "", fromGRPCError(err)
+		return "", c.callError(ctx, err)
 	}
 
 	return res.Url, nil
@@ -93,24 +93,35 @@ func (s *ObjectStoreGRPCServer) PutObject(stream proto.ObjectStore_PutObjectServ
 	bucket := firstChunk.Bucket
 	key := firstChunk.Key
 
+	// offset is the offset in the object of the next chunk received
+	var offset int64
+
 	receive := func() ([]byte, error) {
-		if firstChunk != nil {
-			res := firstChunk.Body
+		chunk := firstChunk
+		if chunk != nil {
 			firstChunk = nil
-			return res, nil
+		} else {
+			var err error
+			chunk, err = stream.Recv()
+			if err == io.EOF {
+				// we need to return io.EOF errors unwrapped so that
+				// calling code sees them as io.EOF and knows to stop
+				// reading.
+				return nil, err
+			}
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
 		}
 
-		data, err := stream.Recv()
-		if err == io.EOF {
-			// we need to return io.EOF errors unwrapped so that
-			// calling code sees them as io.EOF and knows to stop
-			// reading.
+		// returning an error fails the upload, rather than storing a
+		// corrupted object.
+		if err := verifyChunkChecksum(chunk.Body, chunk.Checksum, offset); err != nil {
 			return nil, err
 		}
-		if err != nil {
-			return nil, errors.WithStack(err)
-		}
-		return data.Body, nil
+		offset += int64(len(chunk.Body))
+
+		return chunk.Body, nil
 	}
 
 	close := func() error {
@@ -150,7 +161,8 @@ func (s *ObjectStoreGRPCServer) ObjectExists(ctx context.Context, req *proto.Obj
 }
 
 // GetObject retrieves the object with the given key from the specified
-// bucket in object storage.
+// bucket in object storage, starting from the requested offset, and streams
+// it in chunks with their checksums.
 func (s *ObjectStoreGRPCServer) GetObject(req *proto.GetObjectRequest, stream proto.ObjectStore_GetObjectServer) (err error) {
 	defer func() {
 		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
@@ -163,12 +175,13 @@ func (s *ObjectStoreGRPCServer) GetObject(req *proto.GetObjectRequest, stream pr
 		return newGRPCError(err)
 	}
 
-	rdr, err := impl.GetObject(req.Bucket, req.Key)
+	rdr, err := velero.GetObjectRange(impl, req.Bucket, req.Key, req.Offset)
 	if err != nil {
 		return newGRPCError(err)
 	}
 	defer rdr.Close()
 
+	offset := req.Offset
 	chunk := make([]byte, byteChunkSize)
 	for {
 		n, err := rdr.Read(chunk)
@@ -179,9 +192,10 @@ func (s *ObjectStoreGRPCServer) GetObject(req *proto.GetObjectRequest, stream pr
 			return nil
 		}
 
-		if err := stream.Send(&proto.Bytes{Data: chunk[0:n]}); err != nil {
+		if err := stream.Send(&proto.Bytes{Data: chunk[0:n], Checksum: chunkChecksum(chunk[0:n]), Offset: offset}); err != nil {
 			return newGRPCError(errors.WithStack(err))
 		}
+		offset += int64(n)
 	}
 }
 
@@ -208,7 +222,8 @@ func (s *ObjectStoreGRPCServer) ListCommonPrefixes(ctx context.Context, req *pro
 	return &proto.ListCommonPrefixesResponse{Prefixes: prefixes}, nil
 }
 
-// ListObjects gets a list of all objects in bucket that have the same prefix.
+// ListObjects gets a list of all objects in bucket that have the same prefix, or a page
+// of them along with their ETags if the request has a limit.
 func (s *ObjectStoreGRPCServer) ListObjects(ctx context.Context, req *proto.ListObjectsRequest) (response *proto.ListObjectsResponse, err error) {
 	defer func() {
 		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
@@ -221,12 +236,27 @@ func (s *ObjectStoreGRPCServer) ListObjects(ctx context.Context, req *proto.List
 		return nil, newGRPCError(err)
 	}
 
-	keys, err := impl.ListObjects(req.Bucket, req.Prefix)
+	if req.Limit <= 0 {
+		keys, err := impl.ListObjects(req.Bucket, req.Prefix)
+		if err != nil {
+			return nil, newGRPCError(err)
+		}
+
+		return &proto.ListObjectsResponse{Keys: keys}, nil
+	}
+
+	objects, next, err := velero.ListObjectsPage(impl, req.Bucket, req.Prefix, req.StartAfter, int(req.Limit))
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
 
-	return &proto.ListObjectsResponse{Keys: keys}, nil
+	res := &proto.ListObjectsResponse{NextStartAfter: next}
+	for _, object := range objects {
+		res.Keys = append(res.Keys, object.Key)
+		res.Etags = append(res.Etags, object.ETag)
+	}
+
+	return res, nil
 }
 
 // DeleteObject removes object with the specified key from the given
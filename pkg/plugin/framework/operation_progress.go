@@ -0,0 +1,65 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+func operationProgressToProto(progress velero.OperationProgress) *proto.OperationProgress {
+	return &proto.OperationProgress{
+		Completed:      progress.Completed,
+		Err:            progress.Err,
+		NCompleted:     progress.NCompleted,
+		NTotal:         progress.NTotal,
+		OperationUnits: progress.OperationUnits,
+		Description:    progress.Description,
+	}
+}
+
+func operationProgressFromProto(progress *proto.OperationProgress) velero.OperationProgress {
+	if progress == nil {
+		return velero.OperationProgress{}
+	}
+
+	return velero.OperationProgress{
+		Completed:      progress.Completed,
+		Err:            progress.Err,
+		NCompleted:     progress.NCompleted,
+		NTotal:         progress.NTotal,
+		OperationUnits: progress.OperationUnits,
+		Description:    progress.Description,
+	}
+}
+
+func resourceIdentifierFromProto(id *proto.ResourceIdentifier) velero.ResourceIdentifier {
+	if id == nil {
+		return velero.ResourceIdentifier{}
+	}
+
+	return velero.ResourceIdentifier{
+		GroupResource: schema.GroupResource{
+			Group:    id.Group,
+			Resource: id.Resource,
+		},
+		Namespace: id.Namespace,
+		Name:      id.Name,
+	}
+}
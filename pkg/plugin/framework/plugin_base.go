@@ -17,11 +17,16 @@ limitations under the License.
 package framework
 
 import (
+	"time"
+
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 )
 
 type pluginBase struct {
-	clientLogger logrus.FieldLogger
+	clientLogger    logrus.FieldLogger
+	clientTimeout   func(name string) time.Duration
+	onClientTimeout func(name string)
 	*serverMux
 }
 
@@ -33,6 +38,15 @@ func newPluginBase(options ...PluginOption) *pluginBase {
 	return base
 }
 
+// newClientDispenser creates a clientDispenser for the plugin's implementations, configured with
+// the base's client options.
+func (b *pluginBase) newClientDispenser(clientConn *grpc.ClientConn, initFunc clientInitFunc) *clientDispenser {
+	cd := newClientDispenser(b.clientLogger, clientConn, initFunc)
+	cd.timeout = b.clientTimeout
+	cd.onTimeout = b.onClientTimeout
+	return cd
+}
+
 type PluginOption func(base *pluginBase)
 
 func ClientLogger(logger logrus.FieldLogger) PluginOption {
@@ -41,6 +55,16 @@ func ClientLogger(logger logrus.FieldLogger) PluginOption {
 	}
 }
 
+// ClientTimeout bounds each call a client makes to a plugin implementation by the duration timeout
+// returns for the implementation's name, where zero means unbounded. onTimeout is called with the
+// implementation's name when a call exceeds its timeout, since the plugin process may be wedged.
+func ClientTimeout(timeout func(name string) time.Duration, onTimeout func(name string)) PluginOption {
+	return func(base *pluginBase) {
+		base.clientTimeout = timeout
+		base.onClientTimeout = onTimeout
+	}
+}
+
 func serverLogger(logger logrus.FieldLogger) PluginOption {
 	return func(base *pluginBase) {
 		base.serverMux = newServerMux(logger)
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// pluginConfig implements velero.Configurable for clients, holding the configuration that's sent to
+// the plugin with each call that delivers it.
+type pluginConfig struct {
+	config map[string]string
+}
+
+func (c *pluginConfig) Configure(config map[string]string) error {
+	c.config = config
+	return nil
+}
+
+// configure passes config to impl if it's a velero.Configurable.
+func configure(impl interface{}, config map[string]string) error {
+	configurable, ok := impl.(velero.Configurable)
+	if !ok {
+		return nil
+	}
+
+	if config == nil {
+		config = map[string]string{}
+	}
+
+	return errors.Wrap(configurable.Configure(config), "error configuring plugin")
+}
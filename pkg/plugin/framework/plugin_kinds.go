@@ -16,6 +16,10 @@ limitations under the License.
 
 package framework
 
+import (
+	"github.com/pkg/errors"
+)
+
 // PluginKind is a type alias for a string that describes
 // the kind of a Velero-supported plugin.
 type PluginKind string
@@ -41,6 +45,12 @@ const (
 	// PluginKindDeleteItemAction represents a delete item action plugin.
 	PluginKindDeleteItemAction PluginKind = "DeleteItemAction"
 
+	// PluginKindPostRestoreAction represents a post-restore action plugin.
+	PluginKindPostRestoreAction PluginKind = "PostRestoreAction"
+
+	// PluginKindItemBlockAction represents an item block action plugin.
+	PluginKindItemBlockAction PluginKind = "ItemBlockAction"
+
 	// PluginKindPluginLister represents a plugin lister plugin.
 	PluginKindPluginLister PluginKind = "PluginLister"
 )
@@ -54,5 +64,42 @@ func AllPluginKinds() map[string]PluginKind {
 	allPluginKinds[PluginKindBackupItemAction.String()] = PluginKindBackupItemAction
 	allPluginKinds[PluginKindRestoreItemAction.String()] = PluginKindRestoreItemAction
 	allPluginKinds[PluginKindDeleteItemAction.String()] = PluginKindDeleteItemAction
+	allPluginKinds[PluginKindPostRestoreAction.String()] = PluginKindPostRestoreAction
+	allPluginKinds[PluginKindItemBlockAction.String()] = PluginKindItemBlockAction
 	return allPluginKinds
 }
+
+// SupportedAPIVersions returns the versions of kind's API that Velero supports, from lowest
+// to highest. New methods are added to a kind's API in new versions, so that plugins that
+// implement older versions keep working.
+func SupportedAPIVersions(kind PluginKind) []int {
+	switch kind {
+	case PluginKindBackupItemAction, PluginKindRestoreItemAction, PluginKindVolumeSnapshotter:
+		return []int{1, 2}
+	default:
+		return []int{1}
+	}
+}
+
+// NegotiateAPIVersion returns the highest version of kind's API that both Velero and a plugin
+// that serves pluginVersions support. A plugin that doesn't list the versions it serves was
+// built before kinds' APIs were versioned, and serves version 1.
+func NegotiateAPIVersion(kind PluginKind, pluginVersions []int) (int, error) {
+	if len(pluginVersions) == 0 {
+		pluginVersions = []int{1}
+	}
+
+	var res int
+	for _, supported := range SupportedAPIVersions(kind) {
+		for _, served := range pluginVersions {
+			if served == supported && served > res {
+				res = served
+			}
+		}
+	}
+
+	if res == 0 {
+		return 0, errors.Errorf("the plugin serves versions %v of the %s API, but Velero only supports versions %v", pluginVersions, kind, SupportedAPIVersions(kind))
+	}
+	return res, nil
+}
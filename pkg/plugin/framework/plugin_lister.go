@@ -30,6 +30,13 @@ type PluginIdentifier struct {
 	Command string
 	Kind    PluginKind
 	Name    string
+
+	// APIVersions are the versions of the kind's API that the plugin serves.
+	APIVersions []int
+
+	// APIVersion is the version of the kind's API that Velero uses the plugin with, which is
+	// negotiated when the plugin is registered.
+	APIVersion int
 }
 
 // PluginLister lists plugins.
@@ -96,6 +103,9 @@ func (c *PluginListerGRPCClient) ListPlugins() ([]PluginIdentifier, error) {
 			Kind:    PluginKind(id.Kind),
 			Name:    id.Name,
 		}
+		for _, version := range id.ApiVersions {
+			ret[i].APIVersions = append(ret[i].APIVersions, int(version))
+		}
 	}
 
 	return ret, nil
@@ -135,6 +145,9 @@ func (s *PluginListerGRPCServer) ListPlugins(ctx context.Context, req *proto.Emp
 			Kind:    id.Kind.String(),
 			Name:    id.Name,
 		}
+		for _, version := range id.APIVersions {
+			plugins[i].ApiVersions = append(plugins[i].ApiVersions, int32(version))
+		}
 	}
 	ret := &proto.ListPluginsResponse{
 		Plugins: plugins,
@@ -30,6 +30,9 @@ func TestPluginImplementationsAreGRPCPlugins(t *testing.T) {
 		new(ObjectStorePlugin),
 		new(PluginListerPlugin),
 		new(RestoreItemActionPlugin),
+		new(DeleteItemActionPlugin),
+		new(PostRestoreActionPlugin),
+		new(ItemBlockActionPlugin),
 	}
 
 	for _, impl := range pluginImpls {
@@ -37,3 +40,70 @@ func TestPluginImplementationsAreGRPCPlugins(t *testing.T) {
 		assert.True(t, ok, "plugin implementation %T does not implement the go-plugin.GRPCPlugin interface", impl)
 	}
 }
+
+func TestNegotiateAPIVersion(t *testing.T) {
+	tests := []struct {
+		name           string
+		kind           PluginKind
+		pluginVersions []int
+		expected       int
+		expectedErr    string
+	}{
+		{
+			name:     "plugins that don't list versions serve version 1",
+			kind:     PluginKindBackupItemAction,
+			expected: 1,
+		},
+		{
+			name:           "highest version that both support",
+			kind:           PluginKindBackupItemAction,
+			pluginVersions: []int{1, 2},
+			expected:       2,
+		},
+		{
+			name:           "volume snapshotters that serve version 2",
+			kind:           PluginKindVolumeSnapshotter,
+			pluginVersions: []int{1, 2},
+			expected:       2,
+		},
+		{
+			name:           "versions Velero doesn't support are ignored",
+			kind:           PluginKindObjectStore,
+			pluginVersions: []int{1, 2},
+			expected:       1,
+		},
+		{
+			name:           "no version that both support",
+			kind:           PluginKindRestoreItemAction,
+			pluginVersions: []int{3},
+			expectedErr:    "the plugin serves versions [3] of the RestoreItemAction API, but Velero only supports versions [1 2]",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			version, err := NegotiateAPIVersion(tc.kind, tc.pluginVersions)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, version)
+		})
+	}
+}
+
+func TestServerMuxAPIVersions(t *testing.T) {
+	mux := newServerMux(newLogger())
+	mux.register("velero.io/v1", nil)
+	mux.register("velero.io/v2", nil, 1, 2)
+
+	assert.Equal(t, []int{1}, mux.apiVersionsFor("velero.io/v1"))
+	assert.Equal(t, []int{1, 2}, mux.apiVersionsFor("velero.io/v2"))
+
+	ids := getNames("/plugin", PluginKindBackupItemAction, &BackupItemActionPlugin{pluginBase: &pluginBase{serverMux: mux}})
+	assert.Equal(t, []PluginIdentifier{
+		{Command: "/plugin", Kind: PluginKindBackupItemAction, Name: "velero.io/v1", APIVersions: []int{1}},
+		{Command: "/plugin", Kind: PluginKindBackupItemAction, Name: "velero.io/v2", APIVersions: []int{1, 2}},
+	}, ids)
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+var _ velero.PostRestoreAction = &PostRestoreActionGRPCClient{}
+
+// NewPostRestoreActionPlugin constructs a PostRestoreActionPlugin.
+func NewPostRestoreActionPlugin(options ...PluginOption) *PostRestoreActionPlugin {
+	return &PostRestoreActionPlugin{
+		pluginBase: newPluginBase(options...),
+	}
+}
+
+// PostRestoreActionGRPCClient implements the PostRestoreAction interface and uses a
+// gRPC client to make calls to the plugin server.
+type PostRestoreActionGRPCClient struct {
+	*clientBase
+	pluginConfig
+	grpcClient proto.PostRestoreActionClient
+}
+
+func newPostRestoreActionGRPCClient(base *clientBase, clientConn *grpc.ClientConn) interface{} {
+	return &PostRestoreActionGRPCClient{
+		clientBase: base,
+		grpcClient: proto.NewPostRestoreActionClient(clientConn),
+	}
+}
+
+func (c *PostRestoreActionGRPCClient) Execute(input *velero.PostRestoreActionExecuteInput) (*velero.PostRestoreActionExecuteOutput, error) {
+	restoreJSON, err := json.Marshal(input.Restore)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	backupJSON, err := json.Marshal(input.Backup)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	req := &proto.PostRestoreActionExecuteRequest{
+		Plugin:  c.plugin,
+		Restore: restoreJSON,
+		Backup:  backupJSON,
+		Config:  c.config,
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.Execute(ctx, req)
+	if err != nil {
+		return nil, c.callError(ctx, err)
+	}
+
+	return &velero.PostRestoreActionExecuteOutput{
+		Warnings: res.Warnings,
+	}, nil
+}
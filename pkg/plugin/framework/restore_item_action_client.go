@@ -18,18 +18,19 @@ package framework
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
-var _ velero.RestoreItemAction = &RestoreItemActionGRPCClient{}
+var _ velero.RestoreItemActionV2 = &RestoreItemActionGRPCClient{}
 
 // NewRestoreItemActionPlugin constructs a RestoreItemActionPlugin.
 func NewRestoreItemActionPlugin(options ...PluginOption) *RestoreItemActionPlugin {
@@ -42,20 +43,26 @@ func NewRestoreItemActionPlugin(options ...PluginOption) *RestoreItemActionPlugi
 // gRPC client to make calls to the plugin server.
 type RestoreItemActionGRPCClient struct {
 	*clientBase
-	grpcClient proto.RestoreItemActionClient
+	pluginConfig
+	grpcClient   proto.RestoreItemActionClient
+	grpcClientV2 proto.RestoreItemActionV2Client
 }
 
 func newRestoreItemActionGRPCClient(base *clientBase, clientConn *grpc.ClientConn) interface{} {
 	return &RestoreItemActionGRPCClient{
-		clientBase: base,
-		grpcClient: proto.NewRestoreItemActionClient(clientConn),
+		clientBase:   base,
+		grpcClient:   proto.NewRestoreItemActionClient(clientConn),
+		grpcClientV2: proto.NewRestoreItemActionV2Client(clientConn),
 	}
 }
 
 func (c *RestoreItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
-	res, err := c.grpcClient.AppliesTo(context.Background(), &proto.RestoreItemActionAppliesToRequest{Plugin: c.plugin})
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.AppliesTo(ctx, &proto.RestoreItemActionAppliesToRequest{Plugin: c.plugin, Config: c.config})
 	if err != nil {
-		return velero.ResourceSelector{}, fromGRPCError(err)
+		return velero.ResourceSelector{}, c.callError(ctx, err)
 	}
 
 	if res.ResourceSelector == nil {
@@ -94,9 +101,12 @@ func (c *RestoreItemActionGRPCClient) Execute(input *velero.RestoreItemActionExe
 		Restore:        restoreJSON,
 	}
 
-	res, err := c.grpcClient.Execute(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.Execute(ctx, req)
 	if err != nil {
-		return nil, fromGRPCError(err)
+		return nil, c.callError(ctx, err)
 	}
 
 	var updatedItem unstructured.Unstructured
@@ -119,8 +129,58 @@ func (c *RestoreItemActionGRPCClient) Execute(input *velero.RestoreItemActionExe
 	}
 
 	return &velero.RestoreItemActionExecuteOutput{
-		UpdatedItem:     &updatedItem,
-		AdditionalItems: additionalItems,
-		SkipRestore:     res.SkipRestore,
+		UpdatedItem:                 &updatedItem,
+		AdditionalItems:             additionalItems,
+		SkipRestore:                 res.SkipRestore,
+		SkipRestoreReason:           res.SkipRestoreReason,
+		WaitForAdditionalItems:      res.WaitForAdditionalItems,
+		AdditionalItemsReadyTimeout: time.Duration(res.AdditionalItemsReadyTimeout),
 	}, nil
 }
+
+// Progress uses the version 2 API, so it can only be called if the plugin serves it.
+func (c *RestoreItemActionGRPCClient) Progress(item velero.ResourceIdentifier, restore *api.Restore) (velero.OperationProgress, error) {
+	restoreJSON, err := json.Marshal(restore)
+	if err != nil {
+		return velero.OperationProgress{}, errors.WithStack(err)
+	}
+
+	req := &proto.RestoreItemActionProgressRequest{
+		Plugin:  c.plugin,
+		Item:    restoreResourceIdentifierToProto(item),
+		Restore: restoreJSON,
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClientV2.Progress(ctx, req)
+	if err != nil {
+		return velero.OperationProgress{}, c.callError(ctx, err)
+	}
+
+	return operationProgressFromProto(res.Progress), nil
+}
+
+// Cancel uses the version 2 API, so it can only be called if the plugin serves it.
+func (c *RestoreItemActionGRPCClient) Cancel(item velero.ResourceIdentifier, restore *api.Restore) error {
+	restoreJSON, err := json.Marshal(restore)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	req := &proto.RestoreItemActionCancelRequest{
+		Plugin:  c.plugin,
+		Item:    restoreResourceIdentifierToProto(item),
+		Restore: restoreJSON,
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	if _, err := c.grpcClientV2.Cancel(ctx, req); err != nil {
+		return c.callError(ctx, err)
+	}
+
+	return nil
+}
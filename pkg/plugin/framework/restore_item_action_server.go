@@ -48,6 +48,20 @@ func (s *RestoreItemActionGRPCServer) getImpl(name string) (velero.RestoreItemAc
 	return itemAction, nil
 }
 
+func (s *RestoreItemActionGRPCServer) getImplV2(name string) (velero.RestoreItemActionV2, error) {
+	impl, err := s.mux.getHandler(name)
+	if err != nil {
+		return nil, err
+	}
+
+	itemAction, ok := impl.(velero.RestoreItemActionV2)
+	if !ok {
+		return nil, errors.Errorf("%T is not a version 2 restore item action", impl)
+	}
+
+	return itemAction, nil
+}
+
 func (s *RestoreItemActionGRPCServer) AppliesTo(ctx context.Context, req *proto.RestoreItemActionAppliesToRequest) (response *proto.RestoreItemActionAppliesToResponse, err error) {
 	defer func() {
 		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
@@ -60,6 +74,10 @@ func (s *RestoreItemActionGRPCServer) AppliesTo(ctx context.Context, req *proto.
 		return nil, newGRPCError(err)
 	}
 
+	if err := configure(impl, req.Config); err != nil {
+		return nil, newGRPCError(err)
+	}
+
 	resourceSelector, err := impl.AppliesTo()
 	if err != nil {
 		return nil, newGRPCError(err)
@@ -128,8 +146,11 @@ func (s *RestoreItemActionGRPCServer) Execute(ctx context.Context, req *proto.Re
 	}
 
 	res := &proto.RestoreItemActionExecuteResponse{
-		Item:        updatedItemJSON,
-		SkipRestore: executeOutput.SkipRestore,
+		Item:                        updatedItemJSON,
+		SkipRestore:                 executeOutput.SkipRestore,
+		SkipRestoreReason:           executeOutput.SkipRestoreReason,
+		WaitForAdditionalItems:      executeOutput.WaitForAdditionalItems,
+		AdditionalItemsReadyTimeout: int64(executeOutput.AdditionalItemsReadyTimeout),
 	}
 
 	for _, item := range executeOutput.AdditionalItems {
@@ -139,6 +160,55 @@ func (s *RestoreItemActionGRPCServer) Execute(ctx context.Context, req *proto.Re
 	return res, nil
 }
 
+func (s *RestoreItemActionGRPCServer) Progress(ctx context.Context, req *proto.RestoreItemActionProgressRequest) (response *proto.RestoreItemActionProgressResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImplV2(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	var restore api.Restore
+	if err := json.Unmarshal(req.Restore, &restore); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+
+	progress, err := impl.Progress(resourceIdentifierFromProto(req.Item), &restore)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.RestoreItemActionProgressResponse{Progress: operationProgressToProto(progress)}, nil
+}
+
+func (s *RestoreItemActionGRPCServer) Cancel(ctx context.Context, req *proto.RestoreItemActionCancelRequest) (response *proto.Empty, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImplV2(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	var restore api.Restore
+	if err := json.Unmarshal(req.Restore, &restore); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+
+	if err := impl.Cancel(resourceIdentifierFromProto(req.Item), &restore); err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.Empty{}, nil
+}
+
 func restoreResourceIdentifierToProto(id velero.ResourceIdentifier) *proto.ResourceIdentifier {
 	return &proto.ResourceIdentifier{
 		Group:     id.Group,
@@ -26,6 +26,7 @@ import (
 	"github.com/spf13/pflag"
 
 	veleroflag "github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 )
 
@@ -46,6 +47,13 @@ type Server interface {
 	// RegisterBackupItemActions registers multiple backup item actions.
 	RegisterBackupItemActions(map[string]HandlerInitializer) Server
 
+	// RegisterBackupItemActionV2 registers a backup item action whose initializer
+	// returns a velero.BackupItemActionV2. It's used with version 1 of the API by
+	// Velero servers that don't support version 2, which don't call its Progress
+	// and Cancel methods. Accepted format for the plugin name is
+	// <DNS subdomain>/<non-empty name>.
+	RegisterBackupItemActionV2(pluginName string, initializer HandlerInitializer) Server
+
 	// RegisterVolumeSnapshotter registers a volume snapshotter. Accepted format
 	// for the plugin name is <DNS subdomain>/<non-empty name>.
 	RegisterVolumeSnapshotter(pluginName string, initializer HandlerInitializer) Server
@@ -53,6 +61,13 @@ type Server interface {
 	// RegisterVolumeSnapshotters registers multiple volume snapshotters.
 	RegisterVolumeSnapshotters(map[string]HandlerInitializer) Server
 
+	// RegisterVolumeSnapshotterV2 registers a volume snapshotter whose initializer
+	// returns a velero.VolumeSnapshotterV2. It's used with version 1 of the API by
+	// Velero servers that don't support version 2, which only call its CreateSnapshot
+	// method to take snapshots. Accepted format for the plugin name is
+	// <DNS subdomain>/<non-empty name>.
+	RegisterVolumeSnapshotterV2(pluginName string, initializer HandlerInitializer) Server
+
 	// RegisterObjectStore registers an object store. Accepted format
 	// for the plugin name is <DNS subdomain>/<non-empty name>.
 	RegisterObjectStore(pluginName string, initializer HandlerInitializer) Server
@@ -67,6 +82,13 @@ type Server interface {
 	// RegisterRestoreItemActions registers multiple restore item actions.
 	RegisterRestoreItemActions(map[string]HandlerInitializer) Server
 
+	// RegisterRestoreItemActionV2 registers a restore item action whose initializer
+	// returns a velero.RestoreItemActionV2. It's used with version 1 of the API by
+	// Velero servers that don't support version 2, which don't call its Progress
+	// and Cancel methods. Accepted format for the plugin name is
+	// <DNS subdomain>/<non-empty name>.
+	RegisterRestoreItemActionV2(pluginName string, initializer HandlerInitializer) Server
+
 	// RegisterDeleteItemAction registers a delete item action. Accepted format
 	// for the plugin name is <DNS subdomain>/<non-empty name>.
 	RegisterDeleteItemAction(pluginName string, initializer HandlerInitializer) Server
@@ -74,6 +96,20 @@ type Server interface {
 	// RegisterDeleteItemActions registers multiple Delete item actions.
 	RegisterDeleteItemActions(map[string]HandlerInitializer) Server
 
+	// RegisterPostRestoreAction registers a post-restore action. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterPostRestoreAction(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterPostRestoreActions registers multiple post-restore actions.
+	RegisterPostRestoreActions(map[string]HandlerInitializer) Server
+
+	// RegisterItemBlockAction registers an item block action. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterItemBlockAction(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterItemBlockActions registers multiple item block actions.
+	RegisterItemBlockActions(map[string]HandlerInitializer) Server
+
 	// Server runs the plugin server.
 	Serve()
 }
@@ -89,6 +125,8 @@ type server struct {
 	objectStore       *ObjectStorePlugin
 	restoreItemAction *RestoreItemActionPlugin
 	deleteItemAction  *DeleteItemActionPlugin
+	postRestoreAction *PostRestoreActionPlugin
+	itemBlockAction   *ItemBlockActionPlugin
 }
 
 // NewServer returns a new Server
@@ -105,6 +143,8 @@ func NewServer() Server {
 		objectStore:       NewObjectStorePlugin(serverLogger(log)),
 		restoreItemAction: NewRestoreItemActionPlugin(serverLogger(log)),
 		deleteItemAction:  NewDeleteItemActionPlugin(serverLogger(log)),
+		postRestoreAction: NewPostRestoreActionPlugin(serverLogger(log)),
+		itemBlockAction:   NewItemBlockActionPlugin(serverLogger(log)),
 	}
 }
 
@@ -129,6 +169,11 @@ func (s *server) RegisterBackupItemActions(m map[string]HandlerInitializer) Serv
 	return s
 }
 
+func (s *server) RegisterBackupItemActionV2(name string, initializer HandlerInitializer) Server {
+	s.backupItemAction.register(name, initializer, 1, 2)
+	return s
+}
+
 func (s *server) RegisterVolumeSnapshotter(name string, initializer HandlerInitializer) Server {
 	s.volumeSnapshotter.register(name, initializer)
 	return s
@@ -141,6 +186,11 @@ func (s *server) RegisterVolumeSnapshotters(m map[string]HandlerInitializer) Ser
 	return s
 }
 
+func (s *server) RegisterVolumeSnapshotterV2(name string, initializer HandlerInitializer) Server {
+	s.volumeSnapshotter.register(name, initializer, 1, 2)
+	return s
+}
+
 func (s *server) RegisterObjectStore(name string, initializer HandlerInitializer) Server {
 	s.objectStore.register(name, initializer)
 	return s
@@ -165,6 +215,11 @@ func (s *server) RegisterRestoreItemActions(m map[string]HandlerInitializer) Ser
 	return s
 }
 
+func (s *server) RegisterRestoreItemActionV2(name string, initializer HandlerInitializer) Server {
+	s.restoreItemAction.register(name, initializer, 1, 2)
+	return s
+}
+
 func (s *server) RegisterDeleteItemAction(name string, initializer HandlerInitializer) Server {
 	s.deleteItemAction.register(name, initializer)
 	return s
@@ -177,12 +232,36 @@ func (s *server) RegisterDeleteItemActions(m map[string]HandlerInitializer) Serv
 	return s
 }
 
+func (s *server) RegisterPostRestoreAction(name string, initializer HandlerInitializer) Server {
+	s.postRestoreAction.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterPostRestoreActions(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterPostRestoreAction(name, m[name])
+	}
+	return s
+}
+
+func (s *server) RegisterItemBlockAction(name string, initializer HandlerInitializer) Server {
+	s.itemBlockAction.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterItemBlockActions(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterItemBlockAction(name, m[name])
+	}
+	return s
+}
+
 // getNames returns a list of PluginIdentifiers registered with plugin.
 func getNames(command string, kind PluginKind, plugin Interface) []PluginIdentifier {
 	var pluginIdentifiers []PluginIdentifier
 
 	for _, name := range plugin.names() {
-		id := PluginIdentifier{Command: command, Kind: kind, Name: name}
+		id := PluginIdentifier{Command: command, Kind: kind, Name: name, APIVersions: plugin.apiVersionsFor(name)}
 		pluginIdentifiers = append(pluginIdentifiers, id)
 	}
 
@@ -198,6 +277,16 @@ func (s *server) Serve() {
 	s.log.Level = s.logLevelFlag.Parse()
 	s.log.Debugf("Setting log level to %s", strings.ToUpper(s.log.Level.String()))
 
+	// enable the feature flags that the Velero server launched the plugin with, so that
+	// plugins can check them with the features package.
+	features.Enable(*s.featureSet...)
+
+	// the overrides are applied before any plugin is initialized, since HTTP
+	// clients read their proxy settings from the environment only once.
+	if err := applyEnvironmentOverrides(os.Environ()); err != nil {
+		s.log.WithError(err).Fatal("Error applying environment overrides")
+	}
+
 	command := os.Args[0]
 
 	var pluginIdentifiers []PluginIdentifier
@@ -206,6 +295,8 @@ func (s *server) Serve() {
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindObjectStore, s.objectStore)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindRestoreItemAction, s.restoreItemAction)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindDeleteItemAction, s.deleteItemAction)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindPostRestoreAction, s.postRestoreAction)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindItemBlockAction, s.itemBlockAction)...)
 
 	pluginLister := NewPluginLister(pluginIdentifiers...)
 
@@ -218,6 +309,8 @@ func (s *server) Serve() {
 			string(PluginKindPluginLister):      NewPluginListerPlugin(pluginLister),
 			string(PluginKindRestoreItemAction): s.restoreItemAction,
 			string(PluginKindDeleteItemAction):  s.deleteItemAction,
+			string(PluginKindPostRestoreAction): s.postRestoreAction,
+			string(PluginKindItemBlockAction):   s.itemBlockAction,
 		},
 		GRPCServer: plugin.DefaultGRPCServer,
 	})
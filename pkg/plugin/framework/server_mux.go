@@ -33,6 +33,7 @@ type HandlerInitializer func(logger logrus.FieldLogger) (interface{}, error)
 type serverMux struct {
 	kind         PluginKind
 	initializers map[string]HandlerInitializer
+	apiVersions  map[string][]int
 	handlers     map[string]interface{}
 	serverLog    logrus.FieldLogger
 }
@@ -41,19 +42,26 @@ type serverMux struct {
 func newServerMux(logger logrus.FieldLogger) *serverMux {
 	return &serverMux{
 		initializers: make(map[string]HandlerInitializer),
+		apiVersions:  make(map[string][]int),
 		handlers:     make(map[string]interface{}),
 		serverLog:    logger,
 	}
 }
 
 // register validates the plugin name and registers the
-// initializer for the given name.
-func (m *serverMux) register(name string, f HandlerInitializer) {
+// initializer for the given name. The implementation serves
+// the given versions of the kind's API, or version 1 if none
+// are given.
+func (m *serverMux) register(name string, f HandlerInitializer, apiVersions ...int) {
 	if err := ValidatePluginName(name, m.names()); err != nil {
 		m.serverLog.Errorf("invalid plugin name %q: %s", name, err)
 		return
 	}
+	if len(apiVersions) == 0 {
+		apiVersions = []int{1}
+	}
 	m.initializers[name] = f
+	m.apiVersions[name] = apiVersions
 }
 
 // names returns a list of all registered implementations.
@@ -61,6 +69,12 @@ func (m *serverMux) names() []string {
 	return sets.StringKeySet(m.initializers).List()
 }
 
+// apiVersionsFor returns the versions of the kind's API that the implementation
+// with the given name serves.
+func (m *serverMux) apiVersionsFor(name string) []int {
+	return m.apiVersions[name]
+}
+
 // getHandler returns the instance for a plugin with the given name. If an instance has already been initialized,
 // that is returned. Otherwise, the instance is initialized by calling its initialization function.
 func (m *serverMux) getHandler(name string) (interface{}, error) {
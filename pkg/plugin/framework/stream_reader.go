@@ -39,26 +39,21 @@ type StreamReadCloser struct {
 	close   CloseFunc
 }
 
+// Read reads the data that's already been received into p, receiving more
+// only if none is left, so that no more than one received slice of data is
+// buffered no matter how large p is.
 func (s *StreamReadCloser) Read(p []byte) (n int, err error) {
-	for {
-		// if buf exists and holds at least as much as we're trying to read,
-		// read from the buffer
-		if s.buf != nil && s.buf.Len() >= len(p) {
-			return s.buf.Read(p)
-		}
-
-		// if buf is nil, create it
-		if s.buf == nil {
-			s.buf = new(bytes.Buffer)
-		}
+	// if buf is nil, create it
+	if s.buf == nil {
+		s.buf = new(bytes.Buffer)
+	}
 
-		// buf exists but doesn't hold enough data to fill p, so
-		// receive again. If we get an EOF, return what's in the
-		// buffer; else, write the new data to the buffer and
-		// try another read.
+	// receive until there's data in buf. If we get an EOF, there's
+	// no more data to read.
+	for s.buf.Len() == 0 {
 		data, err := s.receive()
 		if err == io.EOF {
-			return s.buf.Read(p)
+			return 0, io.EOF
 		}
 		if err != nil {
 			return 0, err
@@ -68,6 +63,8 @@ func (s *StreamReadCloser) Read(p []byte) (n int, err error) {
 			return 0, err
 		}
 	}
+
+	return s.buf.Read(p)
 }
 
 func (s *StreamReadCloser) Close() error {
@@ -64,3 +64,33 @@ func TestStreamReader(t *testing.T) {
 	require.Nil(t, err)
 	assert.Equal(t, s, string(res))
 }
+
+func TestStreamReaderReadsOneChunkAtATime(t *testing.T) {
+	rdr := &stringByteReceiver{
+		buf:       bytes.NewBufferString("hello world"),
+		chunkSize: 3,
+	}
+
+	sr := &StreamReadCloser{
+		receive: rdr.Receive,
+		close:   rdr.CloseSend,
+	}
+
+	// a read larger than a chunk only returns the chunk that was received
+	p := make([]byte, 100)
+	n, err := sr.Read(p)
+	require.NoError(t, err)
+	assert.Equal(t, "hel", string(p[:n]))
+	assert.Equal(t, "lo world", rdr.buf.String())
+
+	// a read smaller than a chunk leaves the rest of it buffered
+	n, err = sr.Read(p[:2])
+	require.NoError(t, err)
+	assert.Equal(t, "lo", string(p[:n]))
+	assert.Equal(t, "world", rdr.buf.String())
+
+	n, err = sr.Read(p)
+	require.NoError(t, err)
+	assert.Equal(t, " ", string(p[:n]))
+	assert.Equal(t, "world", rdr.buf.String())
+}
@@ -26,8 +26,9 @@ import (
 // The special keys "bucket" and "prefix" are always considered valid.
 func ValidateObjectStoreConfigKeys(config map[string]string, validKeys ...string) error {
 	// `bucket` and `prefix` are automatically added to all object
-	// store config by velero, so add them as valid keys.
-	return validateConfigKeys(config, append(validKeys, "bucket", "prefix", "caCert")...)
+	// store config by velero, and `caCert` and `insecureSkipTLSVerify`
+	// by a location's TLS settings, so add them as valid keys.
+	return validateConfigKeys(config, append(validKeys, "bucket", "prefix", "caCert", "insecureSkipTLSVerify")...)
 }
 
 // ValidateVolumeSnapshotterConfigKeys ensures that a volume snapshotter's
@@ -33,5 +33,6 @@ func TestValidateConfigKeys(t *testing.T) {
 	assert.Error(t, validateConfigKeys(map[string]string{"foo": "bar", "boo": ""}, "foo"))
 
 	assert.NoError(t, ValidateObjectStoreConfigKeys(map[string]string{"bucket": "foo"}))
+	assert.NoError(t, ValidateObjectStoreConfigKeys(map[string]string{"caCert": "foo", "insecureSkipTLSVerify": "true"}))
 	assert.Error(t, ValidateVolumeSnapshotterConfigKeys(map[string]string{"bucket": "foo"}))
 }
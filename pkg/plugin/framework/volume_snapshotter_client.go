@@ -20,12 +20,14 @@ import (
 	"encoding/json"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 // NewVolumeSnapshotterPlugin constructs a VolumeSnapshotterPlugin.
@@ -39,13 +41,15 @@ func NewVolumeSnapshotterPlugin(options ...PluginOption) *VolumeSnapshotterPlugi
 // gRPC client to make calls to the plugin server.
 type VolumeSnapshotterGRPCClient struct {
 	*clientBase
-	grpcClient proto.VolumeSnapshotterClient
+	grpcClient   proto.VolumeSnapshotterClient
+	grpcClientV2 proto.VolumeSnapshotterV2Client
 }
 
 func newVolumeSnapshotterGRPCClient(base *clientBase, clientConn *grpc.ClientConn) interface{} {
 	return &VolumeSnapshotterGRPCClient{
-		clientBase: base,
-		grpcClient: proto.NewVolumeSnapshotterClient(clientConn),
+		clientBase:   base,
+		grpcClient:   proto.NewVolumeSnapshotterClient(clientConn),
+		grpcClientV2: proto.NewVolumeSnapshotterV2Client(clientConn),
 	}
 }
 
@@ -58,8 +62,11 @@ func (c *VolumeSnapshotterGRPCClient) Init(config map[string]string) error {
 		Config: config,
 	}
 
-	if _, err := c.grpcClient.Init(context.Background(), req); err != nil {
-		return fromGRPCError(err)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	if _, err := c.grpcClient.Init(ctx, req); err != nil {
+		return c.callError(ctx, err)
 	}
 
 	return nil
@@ -81,14 +88,45 @@ func (c *VolumeSnapshotterGRPCClient) CreateVolumeFromSnapshot(snapshotID, volum
 		req.Iops = *iops
 	}
 
-	res, err := c.grpcClient.CreateVolumeFromSnapshot(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.CreateVolumeFromSnapshot(ctx, req)
 	if err != nil {
-		return "", fromGRPCError(err)
+		return "", c.callError(ctx, err)
 	}
 
 	return res.VolumeID, nil
 }
 
+// CreateResizedVolumeFromSnapshot creates a new block volume like CreateVolumeFromSnapshot, that's
+// at least sizeBytes large if the plugin supports resizing volumes.
+func (c *VolumeSnapshotterGRPCClient) CreateResizedVolumeFromSnapshot(snapshotID, volumeType, volumeAZ string, iops *int64, sizeBytes int64) (string, bool, error) {
+	req := &proto.CreateVolumeRequest{
+		Plugin:     c.plugin,
+		SnapshotID: snapshotID,
+		VolumeType: volumeType,
+		VolumeAZ:   volumeAZ,
+		SizeBytes:  sizeBytes,
+	}
+
+	if iops != nil {
+		req.Iops = *iops
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	// plugins that don't support resizing volumes ignore the size, and
+	// don't set Resized in their response.
+	res, err := c.grpcClient.CreateVolumeFromSnapshot(ctx, req)
+	if err != nil {
+		return "", false, c.callError(ctx, err)
+	}
+
+	return res.VolumeID, res.Resized, nil
+}
+
 // GetVolumeInfo returns the type and IOPS (if using provisioned IOPS) for a specified block
 // volume.
 func (c *VolumeSnapshotterGRPCClient) GetVolumeInfo(volumeID, volumeAZ string) (string, *int64, error) {
@@ -98,9 +136,12 @@ func (c *VolumeSnapshotterGRPCClient) GetVolumeInfo(volumeID, volumeAZ string) (
 		VolumeAZ: volumeAZ,
 	}
 
-	res, err := c.grpcClient.GetVolumeInfo(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.GetVolumeInfo(ctx, req)
 	if err != nil {
-		return "", nil, fromGRPCError(err)
+		return "", nil, c.callError(ctx, err)
 	}
 
 	var iops *int64
@@ -121,14 +162,73 @@ func (c *VolumeSnapshotterGRPCClient) CreateSnapshot(volumeID, volumeAZ string,
 		Tags:     tags,
 	}
 
-	res, err := c.grpcClient.CreateSnapshot(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.CreateSnapshot(ctx, req)
 	if err != nil {
-		return "", fromGRPCError(err)
+		return "", c.callError(ctx, err)
 	}
 
 	return res.SnapshotID, nil
 }
 
+// StartSnapshot starts a snapshot of the specified block volume with the provided set of
+// tags. It uses the version 2 API, so it can only be called if the plugin serves it.
+func (c *VolumeSnapshotterGRPCClient) StartSnapshot(volumeID, volumeAZ string, tags map[string]string) (string, string, error) {
+	req := &proto.CreateSnapshotRequest{
+		Plugin:   c.plugin,
+		VolumeID: volumeID,
+		VolumeAZ: volumeAZ,
+		Tags:     tags,
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClientV2.StartSnapshot(ctx, req)
+	if err != nil {
+		return "", "", c.callError(ctx, err)
+	}
+
+	return res.SnapshotID, res.OperationID, nil
+}
+
+// SnapshotProgress uses the version 2 API, so it can only be called if the plugin serves it.
+func (c *VolumeSnapshotterGRPCClient) SnapshotProgress(operationID string) (velero.OperationProgress, error) {
+	req := &proto.SnapshotProgressRequest{
+		Plugin:      c.plugin,
+		OperationID: operationID,
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClientV2.SnapshotProgress(ctx, req)
+	if err != nil {
+		return velero.OperationProgress{}, c.callError(ctx, err)
+	}
+
+	return operationProgressFromProto(res.Progress), nil
+}
+
+// CancelSnapshot uses the version 2 API, so it can only be called if the plugin serves it.
+func (c *VolumeSnapshotterGRPCClient) CancelSnapshot(operationID string) error {
+	req := &proto.CancelSnapshotRequest{
+		Plugin:      c.plugin,
+		OperationID: operationID,
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	if _, err := c.grpcClientV2.CancelSnapshot(ctx, req); err != nil {
+		return c.callError(ctx, err)
+	}
+
+	return nil
+}
+
 // DeleteSnapshot deletes the specified volume snapshot.
 func (c *VolumeSnapshotterGRPCClient) DeleteSnapshot(snapshotID string) error {
 	req := &proto.DeleteSnapshotRequest{
@@ -136,8 +236,11 @@ func (c *VolumeSnapshotterGRPCClient) DeleteSnapshot(snapshotID string) error {
 		SnapshotID: snapshotID,
 	}
 
-	if _, err := c.grpcClient.DeleteSnapshot(context.Background(), req); err != nil {
-		return fromGRPCError(err)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	if _, err := c.grpcClient.DeleteSnapshot(ctx, req); err != nil {
+		return c.callError(ctx, err)
 	}
 
 	return nil
@@ -154,9 +257,12 @@ func (c *VolumeSnapshotterGRPCClient) GetVolumeID(pv runtime.Unstructured) (stri
 		PersistentVolume: encodedPV,
 	}
 
-	resp, err := c.grpcClient.GetVolumeID(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	resp, err := c.grpcClient.GetVolumeID(ctx, req)
 	if err != nil {
-		return "", fromGRPCError(err)
+		return "", c.callError(ctx, err)
 	}
 
 	return resp.VolumeID, nil
@@ -174,9 +280,12 @@ func (c *VolumeSnapshotterGRPCClient) SetVolumeID(pv runtime.Unstructured, volum
 		VolumeID:         volumeID,
 	}
 
-	resp, err := c.grpcClient.SetVolumeID(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	resp, err := c.grpcClient.SetVolumeID(ctx, req)
 	if err != nil {
-		return nil, fromGRPCError(err)
+		return nil, c.callError(ctx, err)
 	}
 
 	var updatedPV unstructured.Unstructured
@@ -186,3 +295,91 @@ func (c *VolumeSnapshotterGRPCClient) SetVolumeID(pv runtime.Unstructured, volum
 
 	return &updatedPV, nil
 }
+
+// VerifySnapshot checks that a snapshot can be restored from, optionally by creating and deleting a
+// volume from it. It returns velero.ErrSnapshotVerificationNotSupported if the plugin doesn't support
+// verifying snapshots.
+func (c *VolumeSnapshotterGRPCClient) VerifySnapshot(snapshotID, volumeAZ string, testRestore bool) error {
+	req := &proto.VerifySnapshotRequest{
+		Plugin:      c.plugin,
+		SnapshotID:  snapshotID,
+		VolumeAZ:    volumeAZ,
+		TestRestore: testRestore,
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.VerifySnapshot(ctx, req)
+	if status.Code(err) == codes.Unimplemented {
+		// the plugin was built before snapshot verification was added.
+		return velero.ErrSnapshotVerificationNotSupported
+	}
+	if err != nil {
+		return c.callError(ctx, err)
+	}
+
+	if !res.Verified {
+		return velero.ErrSnapshotVerificationNotSupported
+	}
+	return nil
+}
+
+// CreateSnapshotGroup creates a consistency group snapshot of several volumes, and returns the IDs
+// of their snapshots in the same order as volumes. It returns velero.ErrSnapshotGroupsNotSupported
+// if the plugin doesn't support snapshot groups.
+func (c *VolumeSnapshotterGRPCClient) CreateSnapshotGroup(volumes []velero.SnapshotGroupVolume) ([]string, error) {
+	req := &proto.CreateSnapshotGroupRequest{
+		Plugin: c.plugin,
+	}
+	for _, volume := range volumes {
+		req.Volumes = append(req.Volumes, &proto.SnapshotGroupVolume{
+			VolumeID: volume.VolumeID,
+			VolumeAZ: volume.VolumeAZ,
+			Tags:     volume.Tags,
+		})
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.CreateSnapshotGroup(ctx, req)
+	if status.Code(err) == codes.Unimplemented {
+		// the plugin was built before snapshot groups were added.
+		return nil, velero.ErrSnapshotGroupsNotSupported
+	}
+	if err != nil {
+		return nil, c.callError(ctx, err)
+	}
+
+	if !res.Grouped {
+		return nil, velero.ErrSnapshotGroupsNotSupported
+	}
+	return res.SnapshotIDs, nil
+}
+
+// GetSnapshotSize returns the number of bytes of storage that a snapshot uses in its provider. It
+// returns velero.ErrSnapshotSizeNotSupported if the plugin doesn't support reporting snapshot sizes.
+func (c *VolumeSnapshotterGRPCClient) GetSnapshotSize(snapshotID string) (int64, error) {
+	req := &proto.GetSnapshotSizeRequest{
+		Plugin:     c.plugin,
+		SnapshotID: snapshotID,
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.GetSnapshotSize(ctx, req)
+	if status.Code(err) == codes.Unimplemented {
+		// the plugin was built before snapshot sizes were added.
+		return 0, velero.ErrSnapshotSizeNotSupported
+	}
+	if err != nil {
+		return 0, c.callError(ctx, err)
+	}
+
+	if !res.Supported {
+		return 0, velero.ErrSnapshotSizeNotSupported
+	}
+	return res.SizeBytes, nil
+}
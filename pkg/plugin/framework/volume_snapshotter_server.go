@@ -47,6 +47,20 @@ func (s *VolumeSnapshotterGRPCServer) getImpl(name string) (velero.VolumeSnapsho
 	return volumeSnapshotter, nil
 }
 
+func (s *VolumeSnapshotterGRPCServer) getImplV2(name string) (velero.VolumeSnapshotterV2, error) {
+	impl, err := s.mux.getHandler(name)
+	if err != nil {
+		return nil, err
+	}
+
+	volumeSnapshotter, ok := impl.(velero.VolumeSnapshotterV2)
+	if !ok {
+		return nil, errors.Errorf("%T is not a version 2 volume snapshotter", impl)
+	}
+
+	return volumeSnapshotter, nil
+}
+
 // Init prepares the VolumeSnapshotter for usage using the provided map of
 // configuration key-value pairs. It returns an error if the VolumeSnapshotter
 // cannot be initialized from the provided config.
@@ -92,12 +106,12 @@ func (s *VolumeSnapshotterGRPCServer) CreateVolumeFromSnapshot(ctx context.Conte
 		iops = &req.Iops
 	}
 
-	volumeID, err := impl.CreateVolumeFromSnapshot(snapshotID, volumeType, volumeAZ, iops)
+	volumeID, resized, err := velero.CreateResizedVolumeFromSnapshot(impl, snapshotID, volumeType, volumeAZ, iops, req.SizeBytes)
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
 
-	return &proto.CreateVolumeResponse{VolumeID: volumeID}, nil
+	return &proto.CreateVolumeResponse{VolumeID: volumeID, Resized: resized}, nil
 }
 
 // GetVolumeInfo returns the type and IOPS (if using provisioned IOPS) for a specified block
@@ -152,6 +166,68 @@ func (s *VolumeSnapshotterGRPCServer) CreateSnapshot(ctx context.Context, req *p
 	return &proto.CreateSnapshotResponse{SnapshotID: snapshotID}, nil
 }
 
+// StartSnapshot starts a snapshot of the specified block volume with the provided set of tags.
+func (s *VolumeSnapshotterGRPCServer) StartSnapshot(ctx context.Context, req *proto.CreateSnapshotRequest) (response *proto.StartSnapshotResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImplV2(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	snapshotID, operationID, err := impl.StartSnapshot(req.VolumeID, req.VolumeAZ, req.Tags)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.StartSnapshotResponse{SnapshotID: snapshotID, OperationID: operationID}, nil
+}
+
+// SnapshotProgress returns the progress of the specified snapshot operation.
+func (s *VolumeSnapshotterGRPCServer) SnapshotProgress(ctx context.Context, req *proto.SnapshotProgressRequest) (response *proto.SnapshotProgressResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImplV2(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	progress, err := impl.SnapshotProgress(req.OperationID)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.SnapshotProgressResponse{Progress: operationProgressToProto(progress)}, nil
+}
+
+// CancelSnapshot cancels the specified snapshot operation.
+func (s *VolumeSnapshotterGRPCServer) CancelSnapshot(ctx context.Context, req *proto.CancelSnapshotRequest) (response *proto.Empty, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImplV2(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	if err := impl.CancelSnapshot(req.OperationID); err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.Empty{}, nil
+}
+
 // DeleteSnapshot deletes the specified volume snapshot.
 func (s *VolumeSnapshotterGRPCServer) DeleteSnapshot(ctx context.Context, req *proto.DeleteSnapshotRequest) (response *proto.Empty, err error) {
 	defer func() {
@@ -227,3 +303,75 @@ func (s *VolumeSnapshotterGRPCServer) SetVolumeID(ctx context.Context, req *prot
 
 	return &proto.SetVolumeIDResponse{PersistentVolume: updatedPVBytes}, nil
 }
+
+// VerifySnapshot checks that a snapshot can be restored from, if the plugin supports verifying snapshots.
+func (s *VolumeSnapshotterGRPCServer) VerifySnapshot(ctx context.Context, req *proto.VerifySnapshotRequest) (response *proto.VerifySnapshotResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	verified, err := velero.VerifySnapshot(impl, req.SnapshotID, req.VolumeAZ, req.TestRestore)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.VerifySnapshotResponse{Verified: verified}, nil
+}
+
+// CreateSnapshotGroup snapshots several volumes together, if the plugin supports snapshot groups.
+func (s *VolumeSnapshotterGRPCServer) CreateSnapshotGroup(ctx context.Context, req *proto.CreateSnapshotGroupRequest) (response *proto.CreateSnapshotGroupResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	volumes := make([]velero.SnapshotGroupVolume, 0, len(req.Volumes))
+	for _, volume := range req.Volumes {
+		volumes = append(volumes, velero.SnapshotGroupVolume{
+			VolumeID: volume.VolumeID,
+			VolumeAZ: volume.VolumeAZ,
+			Tags:     volume.Tags,
+		})
+	}
+
+	snapshotIDs, grouped, err := velero.CreateSnapshotGroup(impl, volumes)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.CreateSnapshotGroupResponse{SnapshotIDs: snapshotIDs, Grouped: grouped}, nil
+}
+
+// GetSnapshotSize returns the size of a snapshot, if the plugin supports reporting snapshot sizes.
+func (s *VolumeSnapshotterGRPCServer) GetSnapshotSize(ctx context.Context, req *proto.GetSnapshotSizeRequest) (response *proto.GetSnapshotSizeResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	sizeBytes, supported, err := velero.GetSnapshotSize(impl, req.SnapshotID)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.GetSnapshotSizeResponse{SizeBytes: sizeBytes, Supported: supported}, nil
+}
@@ -7,8 +7,10 @@ Package generated is a generated protocol buffer package.
 It is generated from these files:
 	BackupItemAction.proto
 	DeleteItemAction.proto
+	ItemBlockAction.proto
 	ObjectStore.proto
 	PluginLister.proto
+	PostRestoreAction.proto
 	RestoreItemAction.proto
 	Shared.proto
 	VolumeSnapshotter.proto
@@ -18,9 +20,16 @@ It has these top-level messages:
 	ExecuteResponse
 	BackupItemActionAppliesToRequest
 	BackupItemActionAppliesToResponse
+	BackupItemActionProgressRequest
+	BackupItemActionProgressResponse
+	BackupItemActionCancelRequest
 	DeleteItemActionExecuteRequest
 	DeleteItemActionAppliesToRequest
 	DeleteItemActionAppliesToResponse
+	ItemBlockActionAppliesToRequest
+	ItemBlockActionAppliesToResponse
+	ItemBlockActionGetRelatedItemsRequest
+	ItemBlockActionGetRelatedItemsResponse
 	PutObjectRequest
 	ObjectExistsRequest
 	ObjectExistsResponse
@@ -36,15 +45,21 @@ It has these top-level messages:
 	ObjectStoreInitRequest
 	PluginIdentifier
 	ListPluginsResponse
+	PostRestoreActionExecuteRequest
+	PostRestoreActionExecuteResponse
 	RestoreItemActionExecuteRequest
 	RestoreItemActionExecuteResponse
 	RestoreItemActionAppliesToRequest
 	RestoreItemActionAppliesToResponse
+	RestoreItemActionProgressRequest
+	RestoreItemActionProgressResponse
+	RestoreItemActionCancelRequest
 	Empty
 	Stack
 	StackFrame
 	ResourceIdentifier
 	ResourceSelector
+	OperationProgress
 	CreateVolumeRequest
 	CreateVolumeResponse
 	GetVolumeInfoRequest
@@ -57,6 +72,17 @@ It has these top-level messages:
 	SetVolumeIDRequest
 	SetVolumeIDResponse
 	VolumeSnapshotterInitRequest
+	VerifySnapshotRequest
+	VerifySnapshotResponse
+	SnapshotGroupVolume
+	CreateSnapshotGroupRequest
+	CreateSnapshotGroupResponse
+	GetSnapshotSizeRequest
+	GetSnapshotSizeResponse
+	StartSnapshotResponse
+	SnapshotProgressRequest
+	SnapshotProgressResponse
+	CancelSnapshotRequest
 */
 package generated
 
@@ -137,7 +163,8 @@ func (m *ExecuteResponse) GetAdditionalItems() []*ResourceIdentifier {
 }
 
 type BackupItemActionAppliesToRequest struct {
-	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Plugin string            `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Config map[string]string `protobuf:"bytes,2,rep,name=config" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *BackupItemActionAppliesToRequest) Reset()         { *m = BackupItemActionAppliesToRequest{} }
@@ -154,6 +181,13 @@ func (m *BackupItemActionAppliesToRequest) GetPlugin() string {
 	return ""
 }
 
+func (m *BackupItemActionAppliesToRequest) GetConfig() map[string]string {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
 type BackupItemActionAppliesToResponse struct {
 	ResourceSelector *ResourceSelector `protobuf:"bytes,1,opt,name=ResourceSelector" json:"ResourceSelector,omitempty"`
 }
@@ -172,11 +206,100 @@ func (m *BackupItemActionAppliesToResponse) GetResourceSelector() *ResourceSelec
 	return nil
 }
 
+type BackupItemActionProgressRequest struct {
+	Plugin string              `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Item   *ResourceIdentifier `protobuf:"bytes,2,opt,name=item" json:"item,omitempty"`
+	Backup []byte              `protobuf:"bytes,3,opt,name=backup,proto3" json:"backup,omitempty"`
+}
+
+func (m *BackupItemActionProgressRequest) Reset()         { *m = BackupItemActionProgressRequest{} }
+func (m *BackupItemActionProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupItemActionProgressRequest) ProtoMessage()    {}
+func (*BackupItemActionProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{4}
+}
+
+func (m *BackupItemActionProgressRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *BackupItemActionProgressRequest) GetItem() *ResourceIdentifier {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *BackupItemActionProgressRequest) GetBackup() []byte {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
+type BackupItemActionProgressResponse struct {
+	Progress *OperationProgress `protobuf:"bytes,1,opt,name=progress" json:"progress,omitempty"`
+}
+
+func (m *BackupItemActionProgressResponse) Reset()         { *m = BackupItemActionProgressResponse{} }
+func (m *BackupItemActionProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupItemActionProgressResponse) ProtoMessage()    {}
+func (*BackupItemActionProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{5}
+}
+
+func (m *BackupItemActionProgressResponse) GetProgress() *OperationProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type BackupItemActionCancelRequest struct {
+	Plugin string              `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Item   *ResourceIdentifier `protobuf:"bytes,2,opt,name=item" json:"item,omitempty"`
+	Backup []byte              `protobuf:"bytes,3,opt,name=backup,proto3" json:"backup,omitempty"`
+}
+
+func (m *BackupItemActionCancelRequest) Reset()         { *m = BackupItemActionCancelRequest{} }
+func (m *BackupItemActionCancelRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupItemActionCancelRequest) ProtoMessage()    {}
+func (*BackupItemActionCancelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{6}
+}
+
+func (m *BackupItemActionCancelRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *BackupItemActionCancelRequest) GetItem() *ResourceIdentifier {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *BackupItemActionCancelRequest) GetBackup() []byte {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ExecuteRequest)(nil), "generated.ExecuteRequest")
 	proto.RegisterType((*ExecuteResponse)(nil), "generated.ExecuteResponse")
 	proto.RegisterType((*BackupItemActionAppliesToRequest)(nil), "generated.BackupItemActionAppliesToRequest")
 	proto.RegisterType((*BackupItemActionAppliesToResponse)(nil), "generated.BackupItemActionAppliesToResponse")
+	proto.RegisterType((*BackupItemActionProgressRequest)(nil), "generated.BackupItemActionProgressRequest")
+	proto.RegisterType((*BackupItemActionProgressResponse)(nil), "generated.BackupItemActionProgressResponse")
+	proto.RegisterType((*BackupItemActionCancelRequest)(nil), "generated.BackupItemActionCancelRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -284,27 +407,134 @@ var _BackupItemAction_serviceDesc = grpc.ServiceDesc{
 	Metadata: "BackupItemAction.proto",
 }
 
+// Client API for BackupItemActionV2 service
+
+type BackupItemActionV2Client interface {
+	Progress(ctx context.Context, in *BackupItemActionProgressRequest, opts ...grpc.CallOption) (*BackupItemActionProgressResponse, error)
+	Cancel(ctx context.Context, in *BackupItemActionCancelRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type backupItemActionV2Client struct {
+	cc *grpc.ClientConn
+}
+
+func NewBackupItemActionV2Client(cc *grpc.ClientConn) BackupItemActionV2Client {
+	return &backupItemActionV2Client{cc}
+}
+
+func (c *backupItemActionV2Client) Progress(ctx context.Context, in *BackupItemActionProgressRequest, opts ...grpc.CallOption) (*BackupItemActionProgressResponse, error) {
+	out := new(BackupItemActionProgressResponse)
+	err := grpc.Invoke(ctx, "/generated.BackupItemActionV2/Progress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backupItemActionV2Client) Cancel(ctx context.Context, in *BackupItemActionCancelRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/generated.BackupItemActionV2/Cancel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for BackupItemActionV2 service
+
+type BackupItemActionV2Server interface {
+	Progress(context.Context, *BackupItemActionProgressRequest) (*BackupItemActionProgressResponse, error)
+	Cancel(context.Context, *BackupItemActionCancelRequest) (*Empty, error)
+}
+
+func RegisterBackupItemActionV2Server(s *grpc.Server, srv BackupItemActionV2Server) {
+	s.RegisterService(&_BackupItemActionV2_serviceDesc, srv)
+}
+
+func _BackupItemActionV2_Progress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupItemActionProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupItemActionV2Server).Progress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.BackupItemActionV2/Progress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupItemActionV2Server).Progress(ctx, req.(*BackupItemActionProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackupItemActionV2_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupItemActionCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupItemActionV2Server).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.BackupItemActionV2/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupItemActionV2Server).Cancel(ctx, req.(*BackupItemActionCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BackupItemActionV2_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "generated.BackupItemActionV2",
+	HandlerType: (*BackupItemActionV2Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Progress",
+			Handler:    _BackupItemActionV2_Progress_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _BackupItemActionV2_Cancel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "BackupItemAction.proto",
+}
+
 func init() { proto.RegisterFile("BackupItemAction.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x86, 0x49, 0x2b, 0x95, 0x4e, 0x8b, 0x2d, 0x7b, 0x28, 0x31, 0x22, 0xc4, 0x9c, 0x02, 0x4a,
-	0x0e, 0xf1, 0xe6, 0xc9, 0x0a, 0x52, 0x7a, 0xdd, 0xf6, 0x05, 0xd2, 0x64, 0x5a, 0x17, 0xd3, 0xdd,
-	0x75, 0x77, 0x03, 0x3e, 0x9c, 0x0f, 0x27, 0xd9, 0x6e, 0x43, 0x8c, 0xc5, 0x7a, 0xcb, 0x64, 0xe6,
-	0xff, 0xe7, 0xfb, 0xd9, 0x81, 0xd9, 0x4b, 0x96, 0xbf, 0x57, 0x72, 0x69, 0x70, 0x3f, 0xcf, 0x0d,
-	0x13, 0x3c, 0x91, 0x4a, 0x18, 0x41, 0x86, 0x3b, 0xe4, 0xa8, 0x32, 0x83, 0x45, 0x30, 0x5e, 0xbd,
-	0x65, 0x0a, 0x8b, 0x43, 0x23, 0x5a, 0xc3, 0xd5, 0xeb, 0x27, 0xe6, 0x95, 0x41, 0x8a, 0x1f, 0x15,
-	0x6a, 0x43, 0x66, 0x30, 0x90, 0x65, 0xb5, 0x63, 0xdc, 0xf7, 0x42, 0x2f, 0x1e, 0x52, 0x57, 0x11,
-	0x02, 0x17, 0xcc, 0xe0, 0xde, 0xef, 0x85, 0x5e, 0x3c, 0xa6, 0xf6, 0xbb, 0x9e, 0xdd, 0xd8, 0x85,
-	0x7e, 0xdf, 0xfe, 0x75, 0x55, 0xc4, 0x61, 0xd2, 0xb8, 0x6a, 0x29, 0xb8, 0xc6, 0x46, 0xee, 0xb5,
-	0xe4, 0x0b, 0x98, 0x64, 0x45, 0xc1, 0x6a, 0xce, 0xac, 0xac, 0x99, 0xb5, 0xdf, 0x0b, 0xfb, 0xf1,
-	0x28, 0xbd, 0x4d, 0x1a, 0xde, 0x84, 0xa2, 0x16, 0x95, 0xca, 0x71, 0x59, 0x20, 0x37, 0x6c, 0xcb,
-	0x50, 0xd1, 0xae, 0x2a, 0x7a, 0x82, 0xb0, 0x1b, 0x7c, 0x2e, 0x65, 0xc9, 0x50, 0xaf, 0xc5, 0x99,
-	0x5c, 0x51, 0x09, 0x77, 0x7f, 0x68, 0x1d, 0xfd, 0x02, 0xa6, 0x47, 0x8e, 0x15, 0x96, 0x98, 0x1b,
-	0xa1, 0xac, 0xcd, 0x28, 0xbd, 0x39, 0x81, 0x7a, 0x1c, 0xa1, 0xbf, 0x44, 0xe9, 0x97, 0x07, 0xd3,
-	0xee, 0x3a, 0xb2, 0x85, 0x61, 0xb3, 0x92, 0xdc, 0xb7, 0x0c, 0xcf, 0x85, 0x0a, 0x1e, 0xfe, 0x37,
-	0xec, 0x52, 0x3c, 0xc3, 0xa5, 0x7b, 0x16, 0x72, 0xdd, 0x12, 0xfe, 0x3c, 0x80, 0x20, 0x38, 0xd5,
-	0x3a, 0x38, 0x6c, 0x06, 0xf6, 0x6a, 0x1e, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x45, 0xdb, 0x5d,
-	0x9f, 0x68, 0x02, 0x00, 0x00,
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbd, 0x54, 0x3b, 0x4f, 0xc3, 0x30,
+	0x10, 0x96, 0x29, 0x94, 0xf6, 0x8a, 0xa0, 0xb2, 0x50, 0x55, 0x02, 0x08, 0xc8, 0x54, 0x01, 0xaa,
+	0x44, 0x18, 0x78, 0x4c, 0xbc, 0x2a, 0xc4, 0x04, 0x0a, 0x88, 0x89, 0x25, 0x24, 0xd7, 0x12, 0x11,
+	0x6c, 0xe3, 0x38, 0x88, 0xae, 0x88, 0x9f, 0x85, 0xc4, 0xc6, 0xef, 0xa2, 0x49, 0xdd, 0x10, 0x42,
+	0xd5, 0xc2, 0xc2, 0x66, 0x9f, 0xef, 0x7b, 0xdc, 0x23, 0x81, 0xda, 0x91, 0xe3, 0xde, 0x47, 0xe2,
+	0x4c, 0xe1, 0xc3, 0xa1, 0xab, 0x7c, 0xce, 0x9a, 0x42, 0x72, 0xc5, 0x69, 0xb9, 0x83, 0x0c, 0xa5,
+	0xa3, 0xd0, 0x33, 0x66, 0x2e, 0xef, 0x1c, 0x89, 0x5e, 0xff, 0xc1, 0xbc, 0x82, 0xd9, 0xd6, 0x33,
+	0xba, 0x91, 0x42, 0x1b, 0x1f, 0x23, 0x0c, 0x15, 0xad, 0x41, 0x51, 0x04, 0x51, 0xc7, 0x67, 0x75,
+	0xb2, 0x4a, 0x1a, 0x65, 0x5b, 0xdf, 0x28, 0x85, 0x49, 0xbf, 0x47, 0x5b, 0x9f, 0xe8, 0x45, 0x67,
+	0xec, 0xe4, 0x1c, 0xe7, 0xde, 0x26, 0x82, 0xf5, 0x42, 0x12, 0xd5, 0x37, 0x93, 0xc1, 0x5c, 0xca,
+	0x1a, 0x0a, 0xce, 0x42, 0x4c, 0xe1, 0x24, 0x03, 0x3f, 0x85, 0x39, 0xc7, 0xf3, 0xfc, 0xd8, 0xa7,
+	0x13, 0xc4, 0x9e, 0xc3, 0x1e, 0x7b, 0xa1, 0x51, 0xb1, 0x96, 0x9b, 0xa9, 0xdf, 0x66, 0x8f, 0x81,
+	0x47, 0xd2, 0xc5, 0x33, 0x0f, 0x99, 0xf2, 0xdb, 0x3e, 0x4a, 0x3b, 0x8f, 0x32, 0x3f, 0x08, 0xac,
+	0xe6, 0x2b, 0x3f, 0x14, 0x22, 0xf0, 0x31, 0xbc, 0xe2, 0xe3, 0x0a, 0x3b, 0x87, 0xa2, 0xcb, 0x59,
+	0xdb, 0xef, 0x68, 0xf1, 0x9d, 0x8c, 0xf8, 0x38, 0xd2, 0xe6, 0x71, 0x82, 0x6c, 0x31, 0x25, 0xbb,
+	0xb6, 0xa6, 0x31, 0xf6, 0xa0, 0x92, 0x09, 0xd3, 0x2a, 0x14, 0xee, 0xb1, 0xab, 0x45, 0xe3, 0x23,
+	0x9d, 0x87, 0xa9, 0x27, 0x27, 0x88, 0x30, 0xe9, 0x65, 0xd9, 0xee, 0x5f, 0xf6, 0x27, 0x76, 0x89,
+	0x19, 0xc0, 0xda, 0x08, 0x49, 0xdd, 0xca, 0x53, 0xa8, 0x0e, 0x9a, 0x72, 0x89, 0x01, 0xba, 0x8a,
+	0xcb, 0x84, 0xbd, 0x62, 0x2d, 0x0e, 0xe9, 0xdb, 0x20, 0xc5, 0xfe, 0x01, 0x32, 0x5f, 0x09, 0xac,
+	0xe4, 0xe5, 0x2e, 0x24, 0xef, 0x48, 0x0c, 0xc3, 0x71, 0x5d, 0xdb, 0xca, 0xac, 0xc3, 0xd8, 0x81,
+	0x8d, 0xde, 0x96, 0x9b, 0x9f, 0xc3, 0xfb, 0x72, 0xa1, 0x6b, 0xde, 0x85, 0x92, 0xd0, 0x31, 0x5d,
+	0xeb, 0x52, 0x46, 0xf2, 0x5c, 0xc4, 0x87, 0x2c, 0x2e, 0xcd, 0x36, 0x5f, 0x08, 0x2c, 0xe7, 0xe9,
+	0x8f, 0x1d, 0xe6, 0x62, 0xf0, 0x7f, 0x25, 0x5a, 0x6f, 0x04, 0xaa, 0x79, 0x13, 0xb4, 0x0d, 0xe5,
+	0x74, 0xb8, 0x74, 0xe3, 0x0f, 0x5b, 0x67, 0x6c, 0xfe, 0x2e, 0x59, 0xf7, 0xee, 0x00, 0xa6, 0xf5,
+	0xd7, 0x48, 0x17, 0x32, 0xc0, 0xef, 0xdf, 0xbd, 0x61, 0x0c, 0x7b, 0xea, 0x33, 0x58, 0xef, 0x04,
+	0x68, 0x5e, 0xe7, 0xda, 0xa2, 0x2e, 0x94, 0x06, 0x0d, 0xa7, 0xeb, 0x23, 0x2c, 0xe5, 0x76, 0xca,
+	0xd8, 0xf8, 0x55, 0xae, 0x76, 0x7f, 0x02, 0xc5, 0xfe, 0xb8, 0x68, 0x63, 0x04, 0xec, 0xdb, 0x44,
+	0x8d, 0x6a, 0xb6, 0x96, 0x07, 0xa1, 0xba, 0xb7, 0xc5, 0xe4, 0x77, 0xb7, 0xfd, 0x09, 0x54, 0x92,
+	0xe5, 0x61, 0x21, 0x05, 0x00, 0x00,
 }
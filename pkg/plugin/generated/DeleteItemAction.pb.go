@@ -50,7 +50,8 @@ func (m *DeleteItemActionExecuteRequest) GetBackup() []byte {
 }
 
 type DeleteItemActionAppliesToRequest struct {
-	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Plugin string            `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Config map[string]string `protobuf:"bytes,2,rep,name=config" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *DeleteItemActionAppliesToRequest) Reset()         { *m = DeleteItemActionAppliesToRequest{} }
@@ -67,6 +68,13 @@ func (m *DeleteItemActionAppliesToRequest) GetPlugin() string {
 	return ""
 }
 
+func (m *DeleteItemActionAppliesToRequest) GetConfig() map[string]string {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
 type DeleteItemActionAppliesToResponse struct {
 	ResourceSelector *ResourceSelector `protobuf:"bytes,1,opt,name=ResourceSelector" json:"ResourceSelector,omitempty"`
 }
@@ -199,21 +207,25 @@ var _DeleteItemAction_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("DeleteItemAction.proto", fileDescriptor1) }
 
 var fileDescriptor1 = []byte{
-	// 253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x41, 0x4b, 0xc3, 0x40,
-	0x14, 0x84, 0x89, 0x4a, 0x25, 0xcf, 0x1e, 0xc2, 0x1e, 0x4a, 0x88, 0x20, 0x31, 0xa7, 0x8a, 0x92,
-	0x43, 0xbd, 0x79, 0x2b, 0x58, 0xc5, 0x6b, 0xea, 0x1f, 0x48, 0x37, 0x63, 0x0d, 0x6e, 0xb2, 0xeb,
-	0xee, 0x5b, 0xd0, 0xbf, 0xe7, 0x2f, 0x13, 0x63, 0x28, 0x35, 0x42, 0xdb, 0xdb, 0xbe, 0xdd, 0x99,
-	0xf7, 0x31, 0x3b, 0x34, 0xb9, 0x87, 0x02, 0xe3, 0x89, 0xd1, 0xcc, 0x25, 0xd7, 0xba, 0xcd, 0x8d,
-	0xd5, 0xac, 0x45, 0xb8, 0x46, 0x0b, 0x5b, 0x32, 0xaa, 0x64, 0xbc, 0x7c, 0x2d, 0x2d, 0xaa, 0xdf,
-	0x87, 0xac, 0xa2, 0x8b, 0xa1, 0x65, 0xf1, 0x01, 0xe9, 0x19, 0x05, 0xde, 0x3d, 0x1c, 0x8b, 0x09,
-	0x8d, 0x8c, 0xf2, 0xeb, 0xba, 0x8d, 0x83, 0x34, 0x98, 0x86, 0x45, 0x3f, 0x09, 0x41, 0x27, 0x35,
-	0xa3, 0x89, 0x8f, 0xd2, 0x60, 0x3a, 0x2e, 0xba, 0xf3, 0x8f, 0x76, 0x55, 0xca, 0x37, 0x6f, 0xe2,
-	0xe3, 0xee, 0xb6, 0x9f, 0xb2, 0x3b, 0x4a, 0x87, 0x94, 0xb9, 0x31, 0xaa, 0x86, 0x7b, 0xd6, 0x7b,
-	0x38, 0x99, 0xa2, 0xcb, 0x1d, 0x5e, 0x67, 0x74, 0xeb, 0x20, 0x1e, 0x29, 0x2a, 0xe0, 0xb4, 0xb7,
-	0x12, 0x4b, 0x28, 0x48, 0xd6, 0xb6, 0x5b, 0x73, 0x36, 0x3b, 0xcf, 0x37, 0xd1, 0xf3, 0xa1, 0xa4,
-	0xf8, 0x67, 0x9a, 0x7d, 0x05, 0x14, 0x0d, 0x71, 0xe2, 0x85, 0xc2, 0x0d, 0x52, 0x5c, 0x6f, 0x2d,
-	0xdc, 0x17, 0x2a, 0xb9, 0x39, 0x4c, 0xdc, 0xa7, 0x78, 0xa0, 0xd3, 0xfe, 0xf3, 0xc5, 0xd5, 0x0e,
-	0xe3, 0xdf, 0x82, 0x92, 0x68, 0x4b, 0xba, 0x68, 0x0c, 0x7f, 0xae, 0x46, 0x5d, 0xb7, 0xb7, 0xdf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x31, 0x0b, 0xd3, 0x0e, 0x02, 0x00, 0x00,
+	// 308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x95, 0x92, 0xcf, 0x4e, 0x83, 0x40,
+	0x10, 0xc6, 0x43, 0xab, 0x18, 0x86, 0x1e, 0xc8, 0xc6, 0x34, 0x04, 0x13, 0x53, 0x39, 0xd5, 0x68,
+	0x38, 0xe0, 0xc1, 0x3f, 0xb7, 0x46, 0xd1, 0x78, 0x32, 0xd9, 0xfa, 0x02, 0x74, 0x99, 0x22, 0x29,
+	0x65, 0xd7, 0x65, 0x69, 0xe4, 0xf5, 0x3c, 0xf8, 0x5c, 0x52, 0x4a, 0x1a, 0xc4, 0xa4, 0xd5, 0xdb,
+	0xcc, 0xec, 0xf7, 0xcd, 0x6f, 0x66, 0x33, 0x30, 0x7c, 0xc0, 0x14, 0x15, 0x3e, 0x2b, 0x5c, 0x4e,
+	0x98, 0x4a, 0x78, 0xe6, 0x09, 0xc9, 0x15, 0x27, 0x46, 0x8c, 0x19, 0xca, 0x50, 0x61, 0xe4, 0x0c,
+	0xa6, 0x6f, 0xa1, 0xc4, 0x68, 0xf3, 0xe0, 0x46, 0x70, 0xda, 0xb5, 0x04, 0x1f, 0xc8, 0x0a, 0x85,
+	0x14, 0xdf, 0x0b, 0xcc, 0x15, 0x19, 0x82, 0x2e, 0xd2, 0x22, 0x4e, 0x32, 0x5b, 0x1b, 0x69, 0x63,
+	0x83, 0x36, 0x19, 0x21, 0x70, 0x90, 0x54, 0x1e, 0xbb, 0x57, 0x55, 0x07, 0xb4, 0x8e, 0xd7, 0xda,
+	0x59, 0xc8, 0x16, 0x85, 0xb0, 0xfb, 0x75, 0xb5, 0xc9, 0xdc, 0x2f, 0x0d, 0x46, 0x5d, 0xcc, 0x44,
+	0x88, 0x34, 0xc1, 0xfc, 0x95, 0xef, 0x03, 0xbd, 0x80, 0xce, 0x78, 0x36, 0x4f, 0xe2, 0x0a, 0xd5,
+	0x1f, 0x9b, 0xfe, 0xb5, 0xb7, 0x5d, 0xc6, 0xdb, 0xd7, 0xd4, 0xbb, 0xaf, 0x9d, 0x41, 0xa6, 0x64,
+	0x49, 0x9b, 0x36, 0xce, 0x2d, 0x98, 0xad, 0x32, 0xb1, 0xa0, 0xbf, 0xc0, 0xb2, 0x81, 0xae, 0x43,
+	0x72, 0x0c, 0x87, 0xab, 0x30, 0x2d, 0xb0, 0xde, 0xcd, 0xa0, 0x9b, 0xe4, 0xae, 0x77, 0xa3, 0xb9,
+	0x29, 0x9c, 0xed, 0x40, 0xe6, 0x82, 0x67, 0x39, 0x92, 0x27, 0xb0, 0xaa, 0x98, 0x17, 0x92, 0xe1,
+	0xb4, 0x12, 0x33, 0xc5, 0x65, 0xdd, 0xdd, 0xf4, 0x4f, 0x5a, 0xa3, 0x77, 0x25, 0xf4, 0x97, 0xc9,
+	0xff, 0xd4, 0xc0, 0xea, 0xe2, 0xc8, 0x1c, 0x8c, 0x2d, 0x92, 0x5c, 0xfc, 0xe3, 0x2f, 0x9c, 0xcb,
+	0xbf, 0x89, 0x9b, 0x2d, 0x1e, 0xe1, 0xa8, 0xb9, 0x04, 0x72, 0xbe, 0xc3, 0xf8, 0xf3, 0x5a, 0x1c,
+	0xab, 0x25, 0x0d, 0x96, 0x42, 0x95, 0x33, 0xbd, 0x3e, 0xb4, 0xab, 0x6f, 0x02, 0x7c, 0xad, 0x6a,
+	0x9b, 0x02, 0x00, 0x00,
 }
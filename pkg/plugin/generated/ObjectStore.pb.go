@@ -18,16 +18,17 @@ var _ = fmt.Errorf
 var _ = math.Inf
 
 type PutObjectRequest struct {
-	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
-	Bucket string `protobuf:"bytes,2,opt,name=bucket" json:"bucket,omitempty"`
-	Key    string `protobuf:"bytes,3,opt,name=key" json:"key,omitempty"`
-	Body   []byte `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Plugin   string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Bucket   string `protobuf:"bytes,2,opt,name=bucket" json:"bucket,omitempty"`
+	Key      string `protobuf:"bytes,3,opt,name=key" json:"key,omitempty"`
+	Body     []byte `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Checksum []byte `protobuf:"bytes,5,opt,name=checksum,proto3" json:"checksum,omitempty"`
 }
 
 func (m *PutObjectRequest) Reset()                    { *m = PutObjectRequest{} }
 func (m *PutObjectRequest) String() string            { return proto.CompactTextString(m) }
 func (*PutObjectRequest) ProtoMessage()               {}
-func (*PutObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{0} }
+func (*PutObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{0} }
 
 func (m *PutObjectRequest) GetPlugin() string {
 	if m != nil {
@@ -57,6 +58,13 @@ func (m *PutObjectRequest) GetBody() []byte {
 	return nil
 }
 
+func (m *PutObjectRequest) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
 type ObjectExistsRequest struct {
 	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
 	Bucket string `protobuf:"bytes,2,opt,name=bucket" json:"bucket,omitempty"`
@@ -66,7 +74,7 @@ type ObjectExistsRequest struct {
 func (m *ObjectExistsRequest) Reset()                    { *m = ObjectExistsRequest{} }
 func (m *ObjectExistsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ObjectExistsRequest) ProtoMessage()               {}
-func (*ObjectExistsRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{1} }
+func (*ObjectExistsRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1} }
 
 func (m *ObjectExistsRequest) GetPlugin() string {
 	if m != nil {
@@ -96,7 +104,7 @@ type ObjectExistsResponse struct {
 func (m *ObjectExistsResponse) Reset()                    { *m = ObjectExistsResponse{} }
 func (m *ObjectExistsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ObjectExistsResponse) ProtoMessage()               {}
-func (*ObjectExistsResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{2} }
+func (*ObjectExistsResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{2} }
 
 func (m *ObjectExistsResponse) GetExists() bool {
 	if m != nil {
@@ -109,12 +117,13 @@ type GetObjectRequest struct {
 	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
 	Bucket string `protobuf:"bytes,2,opt,name=bucket" json:"bucket,omitempty"`
 	Key    string `protobuf:"bytes,3,opt,name=key" json:"key,omitempty"`
+	Offset int64  `protobuf:"varint,4,opt,name=offset" json:"offset,omitempty"`
 }
 
 func (m *GetObjectRequest) Reset()                    { *m = GetObjectRequest{} }
 func (m *GetObjectRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetObjectRequest) ProtoMessage()               {}
-func (*GetObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{3} }
+func (*GetObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{3} }
 
 func (m *GetObjectRequest) GetPlugin() string {
 	if m != nil {
@@ -137,14 +146,23 @@ func (m *GetObjectRequest) GetKey() string {
 	return ""
 }
 
+func (m *GetObjectRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
 type Bytes struct {
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data     []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Checksum []byte `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Offset   int64  `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
 }
 
 func (m *Bytes) Reset()                    { *m = Bytes{} }
 func (m *Bytes) String() string            { return proto.CompactTextString(m) }
 func (*Bytes) ProtoMessage()               {}
-func (*Bytes) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{4} }
+func (*Bytes) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4} }
 
 func (m *Bytes) GetData() []byte {
 	if m != nil {
@@ -153,6 +171,20 @@ func (m *Bytes) GetData() []byte {
 	return nil
 }
 
+func (m *Bytes) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func (m *Bytes) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
 type ListCommonPrefixesRequest struct {
 	Plugin    string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
 	Bucket    string `protobuf:"bytes,2,opt,name=bucket" json:"bucket,omitempty"`
@@ -163,7 +195,7 @@ type ListCommonPrefixesRequest struct {
 func (m *ListCommonPrefixesRequest) Reset()                    { *m = ListCommonPrefixesRequest{} }
 func (m *ListCommonPrefixesRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListCommonPrefixesRequest) ProtoMessage()               {}
-func (*ListCommonPrefixesRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{5} }
+func (*ListCommonPrefixesRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5} }
 
 func (m *ListCommonPrefixesRequest) GetPlugin() string {
 	if m != nil {
@@ -200,7 +232,7 @@ type ListCommonPrefixesResponse struct {
 func (m *ListCommonPrefixesResponse) Reset()                    { *m = ListCommonPrefixesResponse{} }
 func (m *ListCommonPrefixesResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListCommonPrefixesResponse) ProtoMessage()               {}
-func (*ListCommonPrefixesResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{6} }
+func (*ListCommonPrefixesResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{6} }
 
 func (m *ListCommonPrefixesResponse) GetPrefixes() []string {
 	if m != nil {
@@ -210,15 +242,17 @@ func (m *ListCommonPrefixesResponse) GetPrefixes() []string {
 }
 
 type ListObjectsRequest struct {
-	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
-	Bucket string `protobuf:"bytes,2,opt,name=bucket" json:"bucket,omitempty"`
-	Prefix string `protobuf:"bytes,3,opt,name=prefix" json:"prefix,omitempty"`
+	Plugin     string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Bucket     string `protobuf:"bytes,2,opt,name=bucket" json:"bucket,omitempty"`
+	Prefix     string `protobuf:"bytes,3,opt,name=prefix" json:"prefix,omitempty"`
+	StartAfter string `protobuf:"bytes,4,opt,name=startAfter" json:"startAfter,omitempty"`
+	Limit      int32  `protobuf:"varint,5,opt,name=limit" json:"limit,omitempty"`
 }
 
 func (m *ListObjectsRequest) Reset()                    { *m = ListObjectsRequest{} }
 func (m *ListObjectsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListObjectsRequest) ProtoMessage()               {}
-func (*ListObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{7} }
+func (*ListObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{7} }
 
 func (m *ListObjectsRequest) GetPlugin() string {
 	if m != nil {
@@ -241,14 +275,30 @@ func (m *ListObjectsRequest) GetPrefix() string {
 	return ""
 }
 
+func (m *ListObjectsRequest) GetStartAfter() string {
+	if m != nil {
+		return m.StartAfter
+	}
+	return ""
+}
+
+func (m *ListObjectsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 type ListObjectsResponse struct {
-	Keys []string `protobuf:"bytes,1,rep,name=keys" json:"keys,omitempty"`
+	Keys           []string `protobuf:"bytes,1,rep,name=keys" json:"keys,omitempty"`
+	Etags          []string `protobuf:"bytes,2,rep,name=etags" json:"etags,omitempty"`
+	NextStartAfter string   `protobuf:"bytes,3,opt,name=nextStartAfter" json:"nextStartAfter,omitempty"`
 }
 
 func (m *ListObjectsResponse) Reset()                    { *m = ListObjectsResponse{} }
 func (m *ListObjectsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListObjectsResponse) ProtoMessage()               {}
-func (*ListObjectsResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{8} }
+func (*ListObjectsResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{8} }
 
 func (m *ListObjectsResponse) GetKeys() []string {
 	if m != nil {
@@ -257,6 +307,20 @@ func (m *ListObjectsResponse) GetKeys() []string {
 	return nil
 }
 
+func (m *ListObjectsResponse) GetEtags() []string {
+	if m != nil {
+		return m.Etags
+	}
+	return nil
+}
+
+func (m *ListObjectsResponse) GetNextStartAfter() string {
+	if m != nil {
+		return m.NextStartAfter
+	}
+	return ""
+}
+
 type DeleteObjectRequest struct {
 	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
 	Bucket string `protobuf:"bytes,2,opt,name=bucket" json:"bucket,omitempty"`
@@ -266,7 +330,7 @@ type DeleteObjectRequest struct {
 func (m *DeleteObjectRequest) Reset()                    { *m = DeleteObjectRequest{} }
 func (m *DeleteObjectRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteObjectRequest) ProtoMessage()               {}
-func (*DeleteObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{9} }
+func (*DeleteObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{9} }
 
 func (m *DeleteObjectRequest) GetPlugin() string {
 	if m != nil {
@@ -299,7 +363,7 @@ type CreateSignedURLRequest struct {
 func (m *CreateSignedURLRequest) Reset()                    { *m = CreateSignedURLRequest{} }
 func (m *CreateSignedURLRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateSignedURLRequest) ProtoMessage()               {}
-func (*CreateSignedURLRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{10} }
+func (*CreateSignedURLRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{10} }
 
 func (m *CreateSignedURLRequest) GetPlugin() string {
 	if m != nil {
@@ -336,7 +400,7 @@ type CreateSignedURLResponse struct {
 func (m *CreateSignedURLResponse) Reset()                    { *m = CreateSignedURLResponse{} }
 func (m *CreateSignedURLResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateSignedURLResponse) ProtoMessage()               {}
-func (*CreateSignedURLResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{11} }
+func (*CreateSignedURLResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{11} }
 
 func (m *CreateSignedURLResponse) GetUrl() string {
 	if m != nil {
@@ -353,7 +417,7 @@ type ObjectStoreInitRequest struct {
 func (m *ObjectStoreInitRequest) Reset()                    { *m = ObjectStoreInitRequest{} }
 func (m *ObjectStoreInitRequest) String() string            { return proto.CompactTextString(m) }
 func (*ObjectStoreInitRequest) ProtoMessage()               {}
-func (*ObjectStoreInitRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{12} }
+func (*ObjectStoreInitRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{12} }
 
 func (m *ObjectStoreInitRequest) GetPlugin() string {
 	if m != nil {
@@ -750,45 +814,49 @@ var _ObjectStore_serviceDesc = grpc.ServiceDesc{
 	Metadata: "ObjectStore.proto",
 }
 
-func init() { proto.RegisterFile("ObjectStore.proto", fileDescriptor2) }
-
-var fileDescriptor2 = []byte{
-	// 577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xd6, 0xc6, 0x69, 0x54, 0x4f, 0x22, 0x61, 0xb6, 0x55, 0x30, 0x2e, 0x94, 0xb0, 0x02, 0x29,
-	0x08, 0x11, 0xa1, 0x72, 0x29, 0xd0, 0x03, 0x22, 0x44, 0x08, 0x29, 0x52, 0x2b, 0x07, 0x04, 0x07,
-	0x2e, 0x4e, 0x3c, 0x4d, 0x4d, 0x1c, 0x3b, 0xd8, 0x6b, 0x54, 0x1f, 0x79, 0x25, 0x9e, 0x89, 0x07,
-	0x41, 0xbb, 0xde, 0x26, 0xde, 0xfc, 0x10, 0xa9, 0xca, 0x6d, 0x66, 0x76, 0xe7, 0x9b, 0x6f, 0x66,
-	0xfd, 0x8d, 0xe1, 0xee, 0xf9, 0xf0, 0x07, 0x8e, 0xf8, 0x80, 0xc7, 0x09, 0x76, 0x66, 0x49, 0xcc,
-	0x63, 0x6a, 0x8e, 0x31, 0xc2, 0xc4, 0xe3, 0xe8, 0x3b, 0x8d, 0xc1, 0x95, 0x97, 0xa0, 0x5f, 0x1c,
-	0xb0, 0x2b, 0xb0, 0x2e, 0x32, 0x5e, 0x24, 0xb8, 0xf8, 0x33, 0xc3, 0x94, 0xd3, 0x26, 0xd4, 0x66,
-	0x61, 0x36, 0x0e, 0x22, 0x9b, 0xb4, 0x48, 0xdb, 0x74, 0x95, 0x27, 0xe2, 0xc3, 0x6c, 0x34, 0x41,
-	0x6e, 0x57, 0x8a, 0x78, 0xe1, 0x51, 0x0b, 0x8c, 0x09, 0xe6, 0xb6, 0x21, 0x83, 0xc2, 0xa4, 0x14,
-	0xaa, 0xc3, 0xd8, 0xcf, 0xed, 0x6a, 0x8b, 0xb4, 0x1b, 0xae, 0xb4, 0xd9, 0x57, 0x38, 0x28, 0xca,
-	0xf4, 0xae, 0x83, 0x94, 0xa7, 0x3b, 0x2b, 0xc6, 0x3a, 0x70, 0xa8, 0x03, 0xa7, 0xb3, 0x38, 0x4a,
-	0x51, 0x20, 0xa0, 0x8c, 0x48, 0xe4, 0x7d, 0x57, 0x79, 0xec, 0x33, 0x58, 0x1f, 0x71, 0xd7, 0x2d,
-	0xb3, 0x23, 0xd8, 0x7b, 0x9f, 0x73, 0x4c, 0x45, 0xef, 0xbe, 0xc7, 0x3d, 0x09, 0xd4, 0x70, 0xa5,
-	0xcd, 0x7e, 0x13, 0xb8, 0xdf, 0x0f, 0x52, 0xde, 0x8d, 0xa7, 0xd3, 0x38, 0xba, 0x48, 0xf0, 0x32,
-	0xb8, 0xc6, 0x5b, 0x8f, 0xe0, 0x01, 0x98, 0x3e, 0x86, 0xc1, 0x34, 0xe0, 0x98, 0x28, 0x0a, 0x8b,
-	0x80, 0x44, 0x93, 0x05, 0xe4, 0xf4, 0x05, 0x9a, 0xf4, 0xd8, 0x29, 0x38, 0xeb, 0x28, 0xa8, 0x61,
-	0x39, 0xb0, 0x3f, 0x53, 0x31, 0x9b, 0xb4, 0x8c, 0xb6, 0xe9, 0xce, 0x7d, 0xf6, 0x1d, 0xa8, 0xc8,
-	0x2c, 0x26, 0x76, 0x6b, 0xd6, 0x0b, 0x5e, 0x86, 0xc6, 0xeb, 0x19, 0x1c, 0x68, 0xe8, 0x8a, 0x10,
-	0x85, 0xea, 0x04, 0xf3, 0x1b, 0x32, 0xd2, 0x16, 0x9f, 0xd0, 0x07, 0x0c, 0x91, 0xe3, 0xae, 0x1f,
-	0x2f, 0x84, 0x66, 0x37, 0x41, 0x8f, 0xe3, 0x20, 0x18, 0x47, 0xe8, 0x7f, 0x71, 0xfb, 0xbb, 0xd3,
-	0x82, 0x05, 0x06, 0xe7, 0xa1, 0x7c, 0x0c, 0xc3, 0x15, 0x26, 0x7b, 0x0e, 0xf7, 0x56, 0xaa, 0xa9,
-	0xae, 0x2d, 0x30, 0xb2, 0x24, 0x54, 0xb5, 0x84, 0xc9, 0xfe, 0x10, 0x68, 0x96, 0xf4, 0xfc, 0x29,
-	0x0a, 0xb6, 0xf6, 0xdd, 0x83, 0xda, 0x28, 0x8e, 0x2e, 0x83, 0xb1, 0x5d, 0x69, 0x19, 0xed, 0xfa,
-	0xc9, 0x8b, 0xce, 0x5c, 0xfd, 0x9d, 0xf5, 0x50, 0x9d, 0xae, 0xbc, 0xdf, 0x8b, 0x78, 0x92, 0xbb,
-	0x2a, 0xd9, 0x79, 0x0d, 0xf5, 0x52, 0xf8, 0xa6, 0x33, 0xb2, 0xe8, 0xec, 0x10, 0xf6, 0x7e, 0x79,
-	0x61, 0x86, 0x6a, 0x04, 0x85, 0xf3, 0xa6, 0x72, 0x4a, 0x4e, 0xfe, 0x56, 0xa1, 0x5e, 0xaa, 0x44,
-	0xdf, 0x42, 0x55, 0x54, 0xa3, 0x8f, 0xb7, 0x32, 0x71, 0xac, 0xd2, 0x95, 0xde, 0x74, 0xc6, 0x73,
-	0x7a, 0x06, 0xe6, 0x7c, 0x45, 0xd1, 0xa3, 0xd2, 0xf1, 0xf2, 0xe2, 0x5a, 0xcd, 0x6d, 0x13, 0x7a,
-	0x0e, 0x8d, 0xf2, 0x76, 0xa0, 0xc7, 0x2b, 0x14, 0xb4, 0x7d, 0xe4, 0x3c, 0xda, 0x78, 0xae, 0x9e,
-	0xe8, 0x0c, 0xcc, 0xf9, 0xfa, 0xd0, 0xe8, 0x2c, 0x2f, 0x15, 0x8d, 0x8e, 0xdc, 0x0d, 0x2f, 0x09,
-	0xf5, 0x0a, 0x2d, 0xe9, 0x2a, 0xa4, 0x4f, 0x4a, 0x37, 0x37, 0xee, 0x09, 0xe7, 0xe9, 0x96, 0x5b,
-	0x8a, 0x60, 0x1f, 0xea, 0x25, 0x41, 0xd1, 0x87, 0x4b, 0x59, 0xba, 0x8c, 0x9d, 0xe3, 0x4d, 0xc7,
-	0x0a, 0xed, 0x1d, 0x34, 0xca, 0x9a, 0xd3, 0xe6, 0xb7, 0x46, 0x8c, 0x6b, 0xde, 0xef, 0x1b, 0xdc,
-	0x59, 0xfa, 0xdc, 0xb5, 0xef, 0x60, 0xbd, 0xf0, 0x1c, 0xf6, 0xbf, 0x2b, 0x05, 0xb7, 0x61, 0x4d,
-	0xfe, 0xc3, 0x5e, 0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x4e, 0x76, 0xa3, 0xf1, 0x06, 0x00,
-	0x00,
+func init() { proto.RegisterFile("ObjectStore.proto", fileDescriptor3) }
+
+var fileDescriptor3 = []byte{
+	// 652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xad, 0x55, 0xdb, 0x6e, 0xd3, 0x40,
+	0x10, 0x95, 0x73, 0x53, 0x33, 0x89, 0x20, 0x6c, 0xab, 0x10, 0x0c, 0x04, 0xb0, 0x0a, 0xaa, 0x84,
+	0x88, 0x50, 0x79, 0x29, 0x97, 0x07, 0x20, 0x44, 0x08, 0x29, 0x52, 0x2a, 0x47, 0x08, 0x5e, 0x9d,
+	0x78, 0xe2, 0x9a, 0x38, 0x76, 0xb0, 0xd7, 0xa8, 0x79, 0x44, 0xe2, 0x0b, 0xf8, 0x14, 0xbe, 0x89,
+	0x0f, 0x61, 0x6f, 0x75, 0xbc, 0xb9, 0x10, 0xa9, 0xca, 0xdb, 0xce, 0xf1, 0xec, 0xcc, 0x99, 0xb3,
+	0x33, 0x63, 0xb8, 0x35, 0x18, 0x7d, 0xc3, 0x31, 0x1d, 0xd2, 0x28, 0xc6, 0xce, 0x3c, 0x8e, 0x68,
+	0x44, 0xaa, 0x1e, 0x86, 0x18, 0x3b, 0x14, 0x5d, 0xb3, 0x3e, 0xbc, 0x70, 0x62, 0x74, 0xe5, 0x07,
+	0xeb, 0x97, 0x01, 0x8d, 0xf3, 0x94, 0xca, 0x1b, 0x36, 0x7e, 0x4f, 0x31, 0xa1, 0xa4, 0x09, 0x95,
+	0x79, 0x90, 0x7a, 0x7e, 0xd8, 0x32, 0x1e, 0x1a, 0x27, 0x55, 0x5b, 0x59, 0x1c, 0x1f, 0xa5, 0xe3,
+	0x29, 0xd2, 0x56, 0x41, 0xe2, 0xd2, 0x22, 0x0d, 0x28, 0x4e, 0x71, 0xd1, 0x2a, 0x0a, 0x90, 0x1f,
+	0x09, 0x81, 0xd2, 0x28, 0x72, 0x17, 0xad, 0x12, 0x83, 0xea, 0xb6, 0x38, 0x13, 0x13, 0x0e, 0xc6,
+	0x17, 0x38, 0x9e, 0x26, 0xe9, 0xac, 0x55, 0x16, 0x78, 0x66, 0x5b, 0x5f, 0xe0, 0x50, 0x52, 0xe8,
+	0x5d, 0xfa, 0x09, 0x4d, 0xf6, 0x46, 0xc4, 0xea, 0xc0, 0x91, 0x1e, 0x38, 0x99, 0x47, 0x61, 0x82,
+	0x3c, 0x02, 0x0a, 0x44, 0x44, 0x3e, 0xb0, 0x95, 0x65, 0x05, 0xd0, 0xf8, 0x88, 0x7b, 0x97, 0x83,
+	0x79, 0x46, 0x93, 0x49, 0xc2, 0x3c, 0xb9, 0x20, 0x45, 0x5b, 0x59, 0xd6, 0x00, 0xca, 0xef, 0x17,
+	0x14, 0x13, 0xae, 0x97, 0xeb, 0x50, 0x47, 0x24, 0x60, 0x7a, 0xf1, 0xb3, 0xa6, 0x57, 0x41, 0xd7,
+	0x2b, 0x17, 0xb0, 0xa8, 0x05, 0xfc, 0x69, 0xc0, 0x9d, 0x3e, 0x2b, 0xa4, 0x1b, 0xcd, 0x66, 0x51,
+	0x78, 0x1e, 0xe3, 0xc4, 0xbf, 0xc4, 0x6b, 0xcb, 0x79, 0x0f, 0xaa, 0x2e, 0x06, 0xfe, 0xcc, 0xa7,
+	0x18, 0xab, 0x72, 0x96, 0x80, 0x88, 0x26, 0x12, 0x88, 0xa2, 0x78, 0x34, 0x61, 0x59, 0x67, 0x60,
+	0x6e, 0xa2, 0xa0, 0x84, 0x67, 0x55, 0xcd, 0x15, 0xc6, 0x58, 0x14, 0xd9, 0xbd, 0xcc, 0xb6, 0x7e,
+	0x1b, 0x40, 0xf8, 0x55, 0x29, 0xff, 0xb5, 0x69, 0x2f, 0x89, 0x15, 0xf3, 0xc4, 0x48, 0x1b, 0x20,
+	0xa1, 0x4e, 0x4c, 0xdf, 0x4d, 0x78, 0x3d, 0x92, 0x74, 0x0e, 0x21, 0x47, 0x50, 0x16, 0xb5, 0x89,
+	0xee, 0x2c, 0xdb, 0xd2, 0xb0, 0x3c, 0x38, 0xd4, 0x38, 0xa9, 0x3a, 0xd8, 0x8b, 0xb1, 0x97, 0xbd,
+	0xaa, 0x41, 0x9c, 0x79, 0x00, 0xa4, 0x8e, 0x97, 0x30, 0x3e, 0x1c, 0x94, 0x06, 0x79, 0x02, 0x37,
+	0x42, 0xbc, 0x64, 0xe3, 0x98, 0xa5, 0x96, 0xb4, 0x56, 0x50, 0x3e, 0x03, 0x1f, 0x30, 0x40, 0x8a,
+	0x7b, 0xee, 0x3e, 0xd6, 0xd3, 0xcd, 0x6e, 0x8c, 0x6c, 0xf8, 0x87, 0xbe, 0x17, 0xa2, 0xfb, 0xd9,
+	0xee, 0xef, 0xaf, 0xb3, 0x19, 0x42, 0x69, 0xa0, 0xda, 0x9a, 0x1f, 0xad, 0xa7, 0x70, 0x7b, 0x2d,
+	0x9b, 0xd2, 0x8c, 0x39, 0xa7, 0x71, 0xa0, 0x72, 0xf1, 0xa3, 0xf5, 0xc7, 0x80, 0x66, 0x6e, 0x5b,
+	0x7d, 0x0a, 0xfd, 0x9d, 0x75, 0xf7, 0xa0, 0x32, 0x8e, 0xc2, 0x89, 0xef, 0x09, 0x95, 0x6b, 0xa7,
+	0xcf, 0x3a, 0xd9, 0x6e, 0xeb, 0x6c, 0x0e, 0xd5, 0xe9, 0x0a, 0xff, 0x5e, 0x48, 0xe3, 0x85, 0xad,
+	0x2e, 0x9b, 0x2f, 0xa1, 0x96, 0x83, 0xaf, 0x2a, 0x33, 0x96, 0x95, 0xb1, 0xc7, 0xfc, 0xe1, 0x04,
+	0x29, 0x2a, 0x09, 0xa4, 0xf1, 0xaa, 0x70, 0x66, 0x9c, 0xfe, 0x2d, 0x41, 0x2d, 0x97, 0x89, 0xbc,
+	0x86, 0x12, 0xcf, 0x46, 0x1e, 0xed, 0x64, 0x62, 0x36, 0x72, 0x2e, 0xbd, 0xd9, 0x9c, 0x2e, 0xc8,
+	0x1b, 0xa8, 0x66, 0xfb, 0x97, 0xdc, 0xcd, 0x7d, 0x5e, 0xdd, 0xca, 0xeb, 0x77, 0x4f, 0x0c, 0x32,
+	0x80, 0x7a, 0x7e, 0xbd, 0x91, 0xf6, 0x1a, 0x05, 0x6d, 0xa1, 0x9a, 0x0f, 0xb6, 0x7e, 0x57, 0x4f,
+	0xc4, 0xe8, 0x64, 0xfb, 0x4f, 0xa3, 0xb3, 0xba, 0x15, 0x35, 0x3a, 0x62, 0x89, 0x3d, 0x37, 0x88,
+	0x23, 0xe7, 0x57, 0x1f, 0x7d, 0x72, 0x9c, 0xf3, 0xdc, 0xba, 0x9c, 0xcc, 0xc7, 0x3b, 0xbc, 0x14,
+	0xc1, 0x3e, 0xd4, 0x72, 0xe3, 0x48, 0xee, 0xaf, 0xdc, 0xd2, 0x57, 0x87, 0xd9, 0xde, 0xf6, 0x59,
+	0x45, 0x7b, 0x0b, 0xf5, 0xfc, 0xcc, 0x69, 0xfa, 0x6d, 0x18, 0xc6, 0x0d, 0xef, 0xf7, 0x15, 0x6e,
+	0xae, 0xb4, 0xbb, 0xd6, 0x07, 0x9b, 0x07, 0xcf, 0xb4, 0xfe, 0xe7, 0x22, 0xb9, 0x8d, 0x2a, 0xe2,
+	0x0f, 0xfd, 0xe2, 0x1f, 0x43, 0x1e, 0xf1, 0x44, 0xcf, 0x07, 0x00, 0x00,
 }
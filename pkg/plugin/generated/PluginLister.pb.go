@@ -18,15 +18,16 @@ var _ = fmt.Errorf
 var _ = math.Inf
 
 type PluginIdentifier struct {
-	Command string `protobuf:"bytes,1,opt,name=command" json:"command,omitempty"`
-	Kind    string `protobuf:"bytes,2,opt,name=kind" json:"kind,omitempty"`
-	Name    string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	Command     string  `protobuf:"bytes,1,opt,name=command" json:"command,omitempty"`
+	Kind        string  `protobuf:"bytes,2,opt,name=kind" json:"kind,omitempty"`
+	Name        string  `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	ApiVersions []int32 `protobuf:"varint,4,rep,packed,name=apiVersions" json:"apiVersions,omitempty"`
 }
 
 func (m *PluginIdentifier) Reset()                    { *m = PluginIdentifier{} }
 func (m *PluginIdentifier) String() string            { return proto.CompactTextString(m) }
 func (*PluginIdentifier) ProtoMessage()               {}
-func (*PluginIdentifier) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{0} }
+func (*PluginIdentifier) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{0} }
 
 func (m *PluginIdentifier) GetCommand() string {
 	if m != nil {
@@ -49,6 +50,13 @@ func (m *PluginIdentifier) GetName() string {
 	return ""
 }
 
+func (m *PluginIdentifier) GetApiVersions() []int32 {
+	if m != nil {
+		return m.ApiVersions
+	}
+	return nil
+}
+
 type ListPluginsResponse struct {
 	Plugins []*PluginIdentifier `protobuf:"bytes,1,rep,name=plugins" json:"plugins,omitempty"`
 }
@@ -56,7 +64,7 @@ type ListPluginsResponse struct {
 func (m *ListPluginsResponse) Reset()                    { *m = ListPluginsResponse{} }
 func (m *ListPluginsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListPluginsResponse) ProtoMessage()               {}
-func (*ListPluginsResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1} }
+func (*ListPluginsResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1} }
 
 func (m *ListPluginsResponse) GetPlugins() []*PluginIdentifier {
 	if m != nil {
@@ -142,21 +150,22 @@ var _PluginLister_serviceDesc = grpc.ServiceDesc{
 	Metadata: "PluginLister.proto",
 }
 
-func init() { proto.RegisterFile("PluginLister.proto", fileDescriptor3) }
-
-var fileDescriptor3 = []byte{
-	// 201 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x0a, 0xc8, 0x29, 0x4d,
-	0xcf, 0xcc, 0xf3, 0xc9, 0x2c, 0x2e, 0x49, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2,
-	0x4c, 0x4f, 0xcd, 0x4b, 0x2d, 0x4a, 0x2c, 0x49, 0x4d, 0x91, 0xe2, 0x09, 0xce, 0x48, 0x2c, 0x4a,
-	0x4d, 0x81, 0x48, 0x28, 0x85, 0x70, 0x09, 0x40, 0x94, 0x7b, 0xa6, 0xa4, 0xe6, 0x95, 0x64, 0xa6,
-	0x65, 0xa6, 0x16, 0x09, 0x49, 0x70, 0xb1, 0x27, 0xe7, 0xe7, 0xe6, 0x26, 0xe6, 0xa5, 0x48, 0x30,
-	0x2a, 0x30, 0x6a, 0x70, 0x06, 0xc1, 0xb8, 0x42, 0x42, 0x5c, 0x2c, 0xd9, 0x99, 0x79, 0x29, 0x12,
-	0x4c, 0x60, 0x61, 0x30, 0x1b, 0x24, 0x96, 0x97, 0x98, 0x9b, 0x2a, 0xc1, 0x0c, 0x11, 0x03, 0xb1,
-	0x95, 0x7c, 0xb8, 0x84, 0x41, 0xd6, 0x43, 0x4c, 0x2e, 0x0e, 0x4a, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b,
-	0x4e, 0x15, 0x32, 0xe5, 0x62, 0x2f, 0x80, 0x08, 0x49, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x1b, 0x49,
-	0xeb, 0xc1, 0xdd, 0xa5, 0x87, 0xee, 0x8c, 0x20, 0x98, 0x5a, 0x23, 0x7f, 0x2e, 0x1e, 0x64, 0x2f,
-	0x09, 0xd9, 0x73, 0x71, 0x23, 0x99, 0x2e, 0x24, 0x80, 0x64, 0x88, 0x6b, 0x6e, 0x41, 0x49, 0xa5,
-	0x94, 0x1c, 0x92, 0x08, 0x16, 0x77, 0x24, 0xb1, 0x81, 0xfd, 0x6e, 0x0c, 0x08, 0x00, 0x00, 0xff,
-	0xff, 0x0e, 0xb5, 0xe4, 0x0c, 0x2a, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("PluginLister.proto", fileDescriptor4) }
+
+var fileDescriptor4 = []byte{
+	// 213 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6d, 0x90, 0xbd, 0x0b, 0xc2, 0x40,
+	0x0c, 0xc5, 0xd1, 0xfa, 0x81, 0x69, 0x07, 0x89, 0x4b, 0x51, 0x90, 0xe2, 0xd4, 0xa9, 0x83, 0xe2,
+	0xec, 0xe4, 0x20, 0x08, 0x4a, 0x05, 0xf7, 0xd3, 0xc6, 0x7a, 0x68, 0xaf, 0xc7, 0xdd, 0x29, 0xf8,
+	0xdf, 0xdb, 0xf6, 0x50, 0x0e, 0x71, 0x4b, 0x7e, 0x09, 0xef, 0xbd, 0x04, 0x70, 0x7f, 0x7f, 0xe4,
+	0x5c, 0x6c, 0xb9, 0x36, 0xa4, 0x12, 0xa9, 0x4a, 0x53, 0xe2, 0x20, 0x27, 0x41, 0x8a, 0x19, 0xca,
+	0xc6, 0xc1, 0xe1, 0xca, 0x14, 0x65, 0x76, 0x30, 0x7b, 0xc2, 0xd0, 0xae, 0x6f, 0x32, 0x12, 0x86,
+	0x5f, 0x38, 0x29, 0x0c, 0xa1, 0x7f, 0x2e, 0x8b, 0x82, 0x89, 0x2c, 0x6c, 0x45, 0xad, 0x78, 0x90,
+	0x7e, 0x5a, 0x44, 0xe8, 0xdc, 0x78, 0x85, 0xdb, 0x0d, 0x6e, 0xea, 0x9a, 0x09, 0x56, 0x50, 0xe8,
+	0x59, 0x56, 0xd7, 0x18, 0x81, 0xcf, 0x24, 0x3f, 0x92, 0xd2, 0xbc, 0x14, 0x3a, 0xec, 0x44, 0x5e,
+	0xdc, 0x4d, 0x5d, 0x34, 0xdb, 0xc2, 0xa8, 0x0e, 0x68, 0xbd, 0x75, 0x4a, 0x5a, 0x56, 0x94, 0x70,
+	0x09, 0x7d, 0x69, 0x51, 0x65, 0xed, 0xc5, 0xfe, 0x7c, 0x92, 0x7c, 0x93, 0x27, 0xbf, 0x41, 0xd3,
+	0xcf, 0xee, 0x7c, 0x07, 0x81, 0x7b, 0x34, 0xae, 0xc0, 0x77, 0xd4, 0x71, 0xe8, 0x88, 0xac, 0x0b,
+	0x69, 0x5e, 0xe3, 0xa9, 0x43, 0xfe, 0xe4, 0x38, 0xf5, 0x9a, 0xef, 0x2c, 0xde, 0x22, 0x5f, 0x65,
+	0xd3, 0x4c, 0x01, 0x00, 0x00,
 }
@@ -28,7 +28,7 @@ func (m *RestoreItemActionExecuteRequest) Reset()         { *m = RestoreItemActi
 func (m *RestoreItemActionExecuteRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreItemActionExecuteRequest) ProtoMessage()    {}
 func (*RestoreItemActionExecuteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor4, []int{0}
+	return fileDescriptor6, []int{0}
 }
 
 func (m *RestoreItemActionExecuteRequest) GetPlugin() string {
@@ -60,16 +60,19 @@ func (m *RestoreItemActionExecuteRequest) GetItemFromBackup() []byte {
 }
 
 type RestoreItemActionExecuteResponse struct {
-	Item            []byte                `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
-	AdditionalItems []*ResourceIdentifier `protobuf:"bytes,2,rep,name=additionalItems" json:"additionalItems,omitempty"`
-	SkipRestore     bool                  `protobuf:"varint,3,opt,name=skipRestore" json:"skipRestore,omitempty"`
+	Item                        []byte                `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	AdditionalItems             []*ResourceIdentifier `protobuf:"bytes,2,rep,name=additionalItems" json:"additionalItems,omitempty"`
+	SkipRestore                 bool                  `protobuf:"varint,3,opt,name=skipRestore" json:"skipRestore,omitempty"`
+	SkipRestoreReason           string                `protobuf:"bytes,4,opt,name=skipRestoreReason" json:"skipRestoreReason,omitempty"`
+	WaitForAdditionalItems      bool                  `protobuf:"varint,5,opt,name=waitForAdditionalItems" json:"waitForAdditionalItems,omitempty"`
+	AdditionalItemsReadyTimeout int64                 `protobuf:"varint,6,opt,name=additionalItemsReadyTimeout" json:"additionalItemsReadyTimeout,omitempty"`
 }
 
 func (m *RestoreItemActionExecuteResponse) Reset()         { *m = RestoreItemActionExecuteResponse{} }
 func (m *RestoreItemActionExecuteResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreItemActionExecuteResponse) ProtoMessage()    {}
 func (*RestoreItemActionExecuteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor4, []int{1}
+	return fileDescriptor6, []int{1}
 }
 
 func (m *RestoreItemActionExecuteResponse) GetItem() []byte {
@@ -93,15 +96,37 @@ func (m *RestoreItemActionExecuteResponse) GetSkipRestore() bool {
 	return false
 }
 
+func (m *RestoreItemActionExecuteResponse) GetSkipRestoreReason() string {
+	if m != nil {
+		return m.SkipRestoreReason
+	}
+	return ""
+}
+
+func (m *RestoreItemActionExecuteResponse) GetWaitForAdditionalItems() bool {
+	if m != nil {
+		return m.WaitForAdditionalItems
+	}
+	return false
+}
+
+func (m *RestoreItemActionExecuteResponse) GetAdditionalItemsReadyTimeout() int64 {
+	if m != nil {
+		return m.AdditionalItemsReadyTimeout
+	}
+	return 0
+}
+
 type RestoreItemActionAppliesToRequest struct {
-	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Plugin string            `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Config map[string]string `protobuf:"bytes,2,rep,name=config" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *RestoreItemActionAppliesToRequest) Reset()         { *m = RestoreItemActionAppliesToRequest{} }
 func (m *RestoreItemActionAppliesToRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreItemActionAppliesToRequest) ProtoMessage()    {}
 func (*RestoreItemActionAppliesToRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor4, []int{2}
+	return fileDescriptor6, []int{2}
 }
 
 func (m *RestoreItemActionAppliesToRequest) GetPlugin() string {
@@ -111,6 +136,13 @@ func (m *RestoreItemActionAppliesToRequest) GetPlugin() string {
 	return ""
 }
 
+func (m *RestoreItemActionAppliesToRequest) GetConfig() map[string]string {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
 type RestoreItemActionAppliesToResponse struct {
 	ResourceSelector *ResourceSelector `protobuf:"bytes,1,opt,name=ResourceSelector" json:"ResourceSelector,omitempty"`
 }
@@ -119,7 +151,7 @@ func (m *RestoreItemActionAppliesToResponse) Reset()         { *m = RestoreItemA
 func (m *RestoreItemActionAppliesToResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreItemActionAppliesToResponse) ProtoMessage()    {}
 func (*RestoreItemActionAppliesToResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor4, []int{3}
+	return fileDescriptor6, []int{3}
 }
 
 func (m *RestoreItemActionAppliesToResponse) GetResourceSelector() *ResourceSelector {
@@ -129,11 +161,100 @@ func (m *RestoreItemActionAppliesToResponse) GetResourceSelector() *ResourceSele
 	return nil
 }
 
+type RestoreItemActionProgressRequest struct {
+	Plugin  string              `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Item    *ResourceIdentifier `protobuf:"bytes,2,opt,name=item" json:"item,omitempty"`
+	Restore []byte              `protobuf:"bytes,3,opt,name=restore,proto3" json:"restore,omitempty"`
+}
+
+func (m *RestoreItemActionProgressRequest) Reset()         { *m = RestoreItemActionProgressRequest{} }
+func (m *RestoreItemActionProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreItemActionProgressRequest) ProtoMessage()    {}
+func (*RestoreItemActionProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor6, []int{4}
+}
+
+func (m *RestoreItemActionProgressRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *RestoreItemActionProgressRequest) GetItem() *ResourceIdentifier {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *RestoreItemActionProgressRequest) GetRestore() []byte {
+	if m != nil {
+		return m.Restore
+	}
+	return nil
+}
+
+type RestoreItemActionProgressResponse struct {
+	Progress *OperationProgress `protobuf:"bytes,1,opt,name=progress" json:"progress,omitempty"`
+}
+
+func (m *RestoreItemActionProgressResponse) Reset()         { *m = RestoreItemActionProgressResponse{} }
+func (m *RestoreItemActionProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreItemActionProgressResponse) ProtoMessage()    {}
+func (*RestoreItemActionProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor6, []int{5}
+}
+
+func (m *RestoreItemActionProgressResponse) GetProgress() *OperationProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type RestoreItemActionCancelRequest struct {
+	Plugin  string              `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Item    *ResourceIdentifier `protobuf:"bytes,2,opt,name=item" json:"item,omitempty"`
+	Restore []byte              `protobuf:"bytes,3,opt,name=restore,proto3" json:"restore,omitempty"`
+}
+
+func (m *RestoreItemActionCancelRequest) Reset()         { *m = RestoreItemActionCancelRequest{} }
+func (m *RestoreItemActionCancelRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreItemActionCancelRequest) ProtoMessage()    {}
+func (*RestoreItemActionCancelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor6, []int{6}
+}
+
+func (m *RestoreItemActionCancelRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *RestoreItemActionCancelRequest) GetItem() *ResourceIdentifier {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *RestoreItemActionCancelRequest) GetRestore() []byte {
+	if m != nil {
+		return m.Restore
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RestoreItemActionExecuteRequest)(nil), "generated.RestoreItemActionExecuteRequest")
 	proto.RegisterType((*RestoreItemActionExecuteResponse)(nil), "generated.RestoreItemActionExecuteResponse")
 	proto.RegisterType((*RestoreItemActionAppliesToRequest)(nil), "generated.RestoreItemActionAppliesToRequest")
 	proto.RegisterType((*RestoreItemActionAppliesToResponse)(nil), "generated.RestoreItemActionAppliesToResponse")
+	proto.RegisterType((*RestoreItemActionProgressRequest)(nil), "generated.RestoreItemActionProgressRequest")
+	proto.RegisterType((*RestoreItemActionProgressResponse)(nil), "generated.RestoreItemActionProgressResponse")
+	proto.RegisterType((*RestoreItemActionCancelRequest)(nil), "generated.RestoreItemActionCancelRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -241,29 +362,140 @@ var _RestoreItemAction_serviceDesc = grpc.ServiceDesc{
 	Metadata: "RestoreItemAction.proto",
 }
 
-func init() { proto.RegisterFile("RestoreItemAction.proto", fileDescriptor4) }
-
-var fileDescriptor4 = []byte{
-	// 332 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xdd, 0x4e, 0xc2, 0x30,
-	0x14, 0x4e, 0x81, 0x80, 0x1c, 0x88, 0x3f, 0xbd, 0xd0, 0x06, 0x63, 0x9c, 0xbb, 0x30, 0xc4, 0x1f,
-	0x2e, 0xf0, 0xd2, 0x2b, 0x4c, 0x94, 0x70, 0x5b, 0x7c, 0x81, 0xb1, 0x1d, 0xa1, 0x61, 0x5b, 0x6b,
-	0xdb, 0x25, 0xbe, 0x85, 0xcf, 0xe0, 0xa3, 0xf9, 0x26, 0x86, 0x31, 0x96, 0xc1, 0x74, 0x72, 0xd7,
-	0x73, 0xfa, 0x7d, 0xe7, 0xfb, 0xbe, 0xf6, 0xc0, 0x19, 0x47, 0x63, 0xa5, 0xc6, 0x89, 0xc5, 0x68,
-	0xe4, 0x5b, 0x21, 0xe3, 0x81, 0xd2, 0xd2, 0x4a, 0xda, 0x9e, 0x63, 0x8c, 0xda, 0xb3, 0x18, 0xf4,
-	0xba, 0xd3, 0x85, 0xa7, 0x31, 0x58, 0x5f, 0xb8, 0x9f, 0x04, 0x2e, 0x4b, 0xa4, 0xe7, 0x0f, 0xf4,
-	0x13, 0x8b, 0x1c, 0xdf, 0x13, 0x34, 0x96, 0x9e, 0x42, 0x53, 0x85, 0xc9, 0x5c, 0xc4, 0x8c, 0x38,
-	0xa4, 0xdf, 0xe6, 0x59, 0x45, 0x29, 0x34, 0x84, 0xc5, 0x88, 0xd5, 0x1c, 0xd2, 0xef, 0xf2, 0xf4,
-	0x4c, 0x19, 0xb4, 0xf4, 0x7a, 0x1c, 0xab, 0xa7, 0xed, 0x4d, 0x49, 0xaf, 0xe1, 0x70, 0x85, 0x78,
-	0xd1, 0x32, 0x7a, 0xf2, 0xfc, 0x65, 0xa2, 0x58, 0x23, 0x05, 0xec, 0x74, 0xdd, 0x2f, 0x02, 0xce,
-	0xdf, 0x8e, 0x8c, 0x92, 0xb1, 0xc1, 0x5c, 0x9a, 0x14, 0xa4, 0xc7, 0x70, 0xe4, 0x05, 0x81, 0x58,
-	0xc1, 0xbd, 0x70, 0x45, 0x35, 0xac, 0xe6, 0xd4, 0xfb, 0x9d, 0xe1, 0xc5, 0x20, 0x4f, 0x3f, 0xe0,
-	0x68, 0x64, 0xa2, 0x7d, 0x9c, 0x04, 0x18, 0x5b, 0xf1, 0x26, 0x50, 0xf3, 0x5d, 0x16, 0x75, 0xa0,
-	0x63, 0x96, 0x42, 0xf1, 0x42, 0x8e, 0x03, 0x5e, 0x6c, 0xb9, 0x8f, 0x70, 0x55, 0xb2, 0x38, 0x52,
-	0x2a, 0x14, 0x68, 0x5e, 0xe5, 0x3f, 0xcf, 0xe6, 0x46, 0xe0, 0x56, 0x91, 0xb3, 0x84, 0x63, 0x38,
-	0xde, 0x78, 0x9d, 0x62, 0x88, 0xbe, 0x95, 0x3a, 0x9d, 0xd3, 0x19, 0x9e, 0xff, 0x12, 0x67, 0x03,
-	0xe1, 0x25, 0xd2, 0xf0, 0x9b, 0xc0, 0x49, 0x49, 0x8f, 0x2e, 0xa0, 0x9d, 0x6b, 0xd2, 0xbb, 0xed,
-	0x89, 0xd5, 0xb9, 0x7a, 0xf7, 0x7b, 0xa2, 0xb3, 0x20, 0x33, 0x68, 0x65, 0xbf, 0x47, 0x6f, 0xaa,
-	0x98, 0xdb, 0x4b, 0xd7, 0xbb, 0xdd, 0x0b, 0xbb, 0xd6, 0x98, 0x35, 0xd3, 0x65, 0x7e, 0xf8, 0x09,
-	0x00, 0x00, 0xff, 0xff, 0x1b, 0x4c, 0xdc, 0xb7, 0x00, 0x03, 0x00, 0x00,
+// Client API for RestoreItemActionV2 service
+
+type RestoreItemActionV2Client interface {
+	Progress(ctx context.Context, in *RestoreItemActionProgressRequest, opts ...grpc.CallOption) (*RestoreItemActionProgressResponse, error)
+	Cancel(ctx context.Context, in *RestoreItemActionCancelRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type restoreItemActionV2Client struct {
+	cc *grpc.ClientConn
+}
+
+func NewRestoreItemActionV2Client(cc *grpc.ClientConn) RestoreItemActionV2Client {
+	return &restoreItemActionV2Client{cc}
+}
+
+func (c *restoreItemActionV2Client) Progress(ctx context.Context, in *RestoreItemActionProgressRequest, opts ...grpc.CallOption) (*RestoreItemActionProgressResponse, error) {
+	out := new(RestoreItemActionProgressResponse)
+	err := grpc.Invoke(ctx, "/generated.RestoreItemActionV2/Progress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restoreItemActionV2Client) Cancel(ctx context.Context, in *RestoreItemActionCancelRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/generated.RestoreItemActionV2/Cancel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for RestoreItemActionV2 service
+
+type RestoreItemActionV2Server interface {
+	Progress(context.Context, *RestoreItemActionProgressRequest) (*RestoreItemActionProgressResponse, error)
+	Cancel(context.Context, *RestoreItemActionCancelRequest) (*Empty, error)
+}
+
+func RegisterRestoreItemActionV2Server(s *grpc.Server, srv RestoreItemActionV2Server) {
+	s.RegisterService(&_RestoreItemActionV2_serviceDesc, srv)
+}
+
+func _RestoreItemActionV2_Progress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreItemActionProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestoreItemActionV2Server).Progress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.RestoreItemActionV2/Progress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestoreItemActionV2Server).Progress(ctx, req.(*RestoreItemActionProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestoreItemActionV2_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreItemActionCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestoreItemActionV2Server).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.RestoreItemActionV2/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestoreItemActionV2Server).Cancel(ctx, req.(*RestoreItemActionCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RestoreItemActionV2_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "generated.RestoreItemActionV2",
+	HandlerType: (*RestoreItemActionV2Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Progress",
+			Handler:    _RestoreItemActionV2_Progress_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _RestoreItemActionV2_Cancel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "RestoreItemAction.proto",
+}
+
+func init() { proto.RegisterFile("RestoreItemAction.proto", fileDescriptor6) }
+
+var fileDescriptor6 = []byte{
+	// 554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbd, 0x55, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x95, 0x93, 0x36, 0x4d, 0x6e, 0x2a, 0x48, 0xa7, 0xa8, 0x58, 0x2e, 0x8f, 0xe0, 0x05, 0x2a,
+	0x10, 0x22, 0x11, 0x24, 0x14, 0x58, 0x11, 0xaa, 0xb4, 0xea, 0x8a, 0x6a, 0x5a, 0xb1, 0x63, 0xe1,
+	0xda, 0xb7, 0xe9, 0x28, 0xb6, 0xc7, 0x1d, 0x8f, 0x81, 0x7c, 0x00, 0x62, 0xc9, 0x47, 0xb1, 0x81,
+	0xcf, 0xe0, 0x4f, 0xb0, 0x9d, 0x49, 0x70, 0xec, 0xd4, 0x09, 0x1b, 0x76, 0x33, 0xf7, 0x9e, 0x73,
+	0xee, 0x73, 0x6c, 0xb8, 0x4b, 0x31, 0x94, 0x5c, 0xe0, 0x89, 0x44, 0x6f, 0x60, 0x4b, 0xc6, 0xfd,
+	0x6e, 0x20, 0xb8, 0xe4, 0xa4, 0x31, 0x42, 0x1f, 0x85, 0x25, 0xd1, 0x31, 0xb6, 0xcf, 0xae, 0x2c,
+	0x81, 0xce, 0xd4, 0x61, 0x7e, 0xd7, 0xe0, 0x61, 0x81, 0x34, 0xfc, 0x82, 0x76, 0x24, 0x91, 0xe2,
+	0x75, 0x14, 0xbb, 0xc8, 0x1e, 0xd4, 0x02, 0x37, 0x1a, 0x31, 0x5f, 0xd7, 0xda, 0xda, 0x41, 0x83,
+	0xaa, 0x1b, 0x21, 0xb0, 0xc1, 0x62, 0x8e, 0x5e, 0x89, 0xad, 0xdb, 0x34, 0x3d, 0x13, 0x1d, 0xb6,
+	0xc4, 0x54, 0x4e, 0xaf, 0xa6, 0xe6, 0xd9, 0x95, 0x3c, 0x86, 0x5b, 0x09, 0xe2, 0x48, 0x70, 0xef,
+	0x9d, 0x65, 0x8f, 0xa3, 0x40, 0xdf, 0x48, 0x01, 0x39, 0xab, 0xf9, 0xab, 0x02, 0xed, 0x9b, 0x33,
+	0x0a, 0x03, 0xee, 0x87, 0x38, 0x0f, 0xad, 0x65, 0x42, 0x1f, 0xc3, 0x6d, 0xcb, 0x71, 0x58, 0x02,
+	0xb7, 0xdc, 0x84, 0x1a, 0xc6, 0x99, 0x55, 0x0f, 0x9a, 0xbd, 0xfb, 0xdd, 0x79, 0xf5, 0xdd, 0x58,
+	0x81, 0x47, 0xc2, 0xc6, 0x13, 0x07, 0x7d, 0xc9, 0x2e, 0x19, 0x0a, 0x9a, 0x67, 0x91, 0x36, 0x34,
+	0xc3, 0x31, 0x0b, 0x68, 0xa6, 0x8e, 0x3a, 0xcd, 0x9a, 0x48, 0x07, 0x76, 0x32, 0x57, 0x8a, 0x56,
+	0xc8, 0xfd, 0xb4, 0x9c, 0x06, 0x2d, 0x3a, 0xc8, 0x2b, 0xd8, 0xfb, 0x6c, 0x31, 0x79, 0xc4, 0xc5,
+	0x20, 0x97, 0xdf, 0x66, 0x2a, 0x7d, 0x83, 0x97, 0xbc, 0x85, 0xfd, 0x5c, 0x6a, 0xb1, 0xa0, 0x33,
+	0x39, 0x67, 0x1e, 0xf2, 0x48, 0xea, 0xb5, 0x98, 0x5c, 0xa5, 0x65, 0x10, 0xf3, 0xa7, 0x06, 0x8f,
+	0x0a, 0xbd, 0x1c, 0x04, 0x81, 0xcb, 0x30, 0x3c, 0xe7, 0xab, 0xe6, 0x7b, 0x0a, 0x35, 0x9b, 0xfb,
+	0x97, 0x6c, 0xa4, 0xfa, 0xd8, 0x5f, 0xec, 0x63, 0xb9, 0x6a, 0xf7, 0x30, 0xa5, 0x0e, 0x7d, 0x29,
+	0x26, 0x54, 0xe9, 0x18, 0xaf, 0xa1, 0x99, 0x31, 0x93, 0x16, 0x54, 0xc7, 0x38, 0x51, 0x51, 0x93,
+	0x23, 0xb9, 0x03, 0x9b, 0x9f, 0x2c, 0x37, 0xc2, 0x74, 0xa7, 0x1a, 0x74, 0x7a, 0x79, 0x53, 0xe9,
+	0x6b, 0xa6, 0x07, 0x66, 0x59, 0x4c, 0xb5, 0x17, 0xc7, 0xd0, 0x9a, 0x4d, 0xf8, 0x0c, 0x5d, 0xb4,
+	0x63, 0x7c, 0x2a, 0xdf, 0xec, 0xed, 0x2f, 0x59, 0x82, 0x19, 0x84, 0x16, 0x48, 0xe6, 0x37, 0x6d,
+	0xc9, 0x16, 0x9e, 0x0a, 0x3e, 0x8a, 0xd7, 0x39, 0x5c, 0xd5, 0xb8, 0x17, 0x99, 0x87, 0xb1, 0x72,
+	0xfd, 0x56, 0xbc, 0x1b, 0xf3, 0xe3, 0x92, 0x11, 0xfe, 0x4d, 0x44, 0xd5, 0xdd, 0x87, 0x7a, 0xa0,
+	0x6c, 0xaa, 0xde, 0x7b, 0x99, 0xa8, 0xef, 0x83, 0xe4, 0x90, 0xe5, 0xcd, 0xd1, 0xe6, 0x57, 0x0d,
+	0x1e, 0x14, 0xf4, 0x0f, 0x2d, 0xdf, 0x46, 0xf7, 0x7f, 0x96, 0xd9, 0xfb, 0xad, 0xc1, 0x4e, 0x21,
+	0x0f, 0x72, 0x05, 0x8d, 0xf9, 0x90, 0x49, 0xe7, 0x5f, 0xf6, 0xcf, 0x78, 0xbe, 0x26, 0x5a, 0x75,
+	0xf0, 0x02, 0xb6, 0xd4, 0x47, 0x86, 0x3c, 0x2d, 0x63, 0x2e, 0x7e, 0x1b, 0x8d, 0x67, 0x6b, 0x61,
+	0xa7, 0x31, 0x7a, 0x3f, 0x34, 0xd8, 0x2d, 0x80, 0x3e, 0xf4, 0x08, 0x42, 0x7d, 0x36, 0x19, 0x52,
+	0x2a, 0x98, 0x5b, 0x40, 0xa3, 0xb3, 0x1e, 0x58, 0x95, 0x38, 0x84, 0xda, 0x74, 0xb0, 0xe4, 0x49,
+	0x19, 0x6f, 0x61, 0xf8, 0x46, 0x2b, 0x03, 0x1d, 0x7a, 0x81, 0x9c, 0x5c, 0xd4, 0xd2, 0x3f, 0xc7,
+	0xcb, 0x3f, 0x80, 0xd0, 0x26, 0x09, 0x6d, 0x06, 0x00, 0x00,
 }
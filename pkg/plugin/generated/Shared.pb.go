@@ -18,7 +18,7 @@ type Empty struct {
 func (m *Empty) Reset()                    { *m = Empty{} }
 func (m *Empty) String() string            { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()               {}
-func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{0} }
+func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{0} }
 
 type Stack struct {
 	Frames []*StackFrame `protobuf:"bytes,1,rep,name=frames" json:"frames,omitempty"`
@@ -27,7 +27,7 @@ type Stack struct {
 func (m *Stack) Reset()                    { *m = Stack{} }
 func (m *Stack) String() string            { return proto.CompactTextString(m) }
 func (*Stack) ProtoMessage()               {}
-func (*Stack) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{1} }
+func (*Stack) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{1} }
 
 func (m *Stack) GetFrames() []*StackFrame {
 	if m != nil {
@@ -45,7 +45,7 @@ type StackFrame struct {
 func (m *StackFrame) Reset()                    { *m = StackFrame{} }
 func (m *StackFrame) String() string            { return proto.CompactTextString(m) }
 func (*StackFrame) ProtoMessage()               {}
-func (*StackFrame) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{2} }
+func (*StackFrame) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{2} }
 
 func (m *StackFrame) GetFile() string {
 	if m != nil {
@@ -78,7 +78,7 @@ type ResourceIdentifier struct {
 func (m *ResourceIdentifier) Reset()                    { *m = ResourceIdentifier{} }
 func (m *ResourceIdentifier) String() string            { return proto.CompactTextString(m) }
 func (*ResourceIdentifier) ProtoMessage()               {}
-func (*ResourceIdentifier) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{3} }
+func (*ResourceIdentifier) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{3} }
 
 func (m *ResourceIdentifier) GetGroup() string {
 	if m != nil {
@@ -119,7 +119,7 @@ type ResourceSelector struct {
 func (m *ResourceSelector) Reset()                    { *m = ResourceSelector{} }
 func (m *ResourceSelector) String() string            { return proto.CompactTextString(m) }
 func (*ResourceSelector) ProtoMessage()               {}
-func (*ResourceSelector) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{4} }
+func (*ResourceSelector) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{4} }
 
 func (m *ResourceSelector) GetIncludedNamespaces() []string {
 	if m != nil {
@@ -156,35 +156,97 @@ func (m *ResourceSelector) GetSelector() string {
 	return ""
 }
 
+type OperationProgress struct {
+	Completed      bool   `protobuf:"varint,1,opt,name=completed" json:"completed,omitempty"`
+	Err            string `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+	NCompleted     int64  `protobuf:"varint,3,opt,name=nCompleted" json:"nCompleted,omitempty"`
+	NTotal         int64  `protobuf:"varint,4,opt,name=nTotal" json:"nTotal,omitempty"`
+	OperationUnits string `protobuf:"bytes,5,opt,name=operationUnits" json:"operationUnits,omitempty"`
+	Description    string `protobuf:"bytes,6,opt,name=description" json:"description,omitempty"`
+}
+
+func (m *OperationProgress) Reset()                    { *m = OperationProgress{} }
+func (m *OperationProgress) String() string            { return proto.CompactTextString(m) }
+func (*OperationProgress) ProtoMessage()               {}
+func (*OperationProgress) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{5} }
+
+func (m *OperationProgress) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+func (m *OperationProgress) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *OperationProgress) GetNCompleted() int64 {
+	if m != nil {
+		return m.NCompleted
+	}
+	return 0
+}
+
+func (m *OperationProgress) GetNTotal() int64 {
+	if m != nil {
+		return m.NTotal
+	}
+	return 0
+}
+
+func (m *OperationProgress) GetOperationUnits() string {
+	if m != nil {
+		return m.OperationUnits
+	}
+	return ""
+}
+
+func (m *OperationProgress) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Empty)(nil), "generated.Empty")
 	proto.RegisterType((*Stack)(nil), "generated.Stack")
 	proto.RegisterType((*StackFrame)(nil), "generated.StackFrame")
 	proto.RegisterType((*ResourceIdentifier)(nil), "generated.ResourceIdentifier")
 	proto.RegisterType((*ResourceSelector)(nil), "generated.ResourceSelector")
-}
-
-func init() { proto.RegisterFile("Shared.proto", fileDescriptor5) }
-
-var fileDescriptor5 = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x4e, 0xb5, 0x30,
-	0x10, 0x85, 0xc3, 0x05, 0xee, 0xff, 0x33, 0xba, 0xd0, 0x46, 0x93, 0xc6, 0xb8, 0x20, 0xac, 0x58,
-	0x28, 0x0b, 0x4d, 0x7c, 0x03, 0x4d, 0xdc, 0x18, 0x53, 0x9e, 0x00, 0xcb, 0x70, 0x6d, 0xe4, 0xb6,
-	0xa4, 0x94, 0xe4, 0xfa, 0xca, 0x3e, 0x85, 0x69, 0x4b, 0x61, 0x81, 0xbb, 0x39, 0x73, 0x3e, 0xce,
-	0x0c, 0x93, 0xc2, 0x79, 0xfd, 0xd9, 0x68, 0x6c, 0xab, 0x41, 0x2b, 0xa3, 0x48, 0x76, 0x40, 0x89,
-	0xba, 0x31, 0xd8, 0x16, 0xff, 0x20, 0x7d, 0x3e, 0x0e, 0xe6, 0xbb, 0x78, 0x82, 0xb4, 0x36, 0x0d,
-	0xff, 0x22, 0xf7, 0xb0, 0xef, 0x74, 0x73, 0xc4, 0x91, 0x46, 0x79, 0x5c, 0x9e, 0x3d, 0x5c, 0x57,
-	0x0b, 0x5d, 0x39, 0xe2, 0xc5, 0xba, 0x6c, 0x86, 0x8a, 0x77, 0x80, 0xb5, 0x4b, 0x08, 0x24, 0x9d,
-	0xe8, 0x91, 0x46, 0x79, 0x54, 0x66, 0xcc, 0xd5, 0xb6, 0xd7, 0x0b, 0x89, 0x74, 0x97, 0x47, 0x65,
-	0xca, 0x5c, 0x4d, 0x6e, 0xe0, 0x7f, 0x37, 0x49, 0x6e, 0x84, 0x92, 0x34, 0x76, 0xec, 0xa2, 0x8b,
-	0x13, 0x10, 0x86, 0xa3, 0x9a, 0x34, 0xc7, 0xd7, 0x16, 0xa5, 0x11, 0x9d, 0x40, 0x4d, 0xae, 0x20,
-	0x3d, 0x68, 0x35, 0x0d, 0x73, 0xb4, 0x17, 0x36, 0x47, 0xcf, 0xac, 0xcb, 0xcf, 0xd8, 0xa2, 0xc9,
-	0x2d, 0x64, 0xd2, 0xae, 0x38, 0x34, 0x1c, 0xe7, 0x21, 0x6b, 0xc3, 0x6e, 0x65, 0x05, 0x4d, 0xfc,
-	0xa6, 0xb6, 0x2e, 0x7e, 0x22, 0xb8, 0x08, 0xa3, 0x6b, 0xec, 0x91, 0x1b, 0xa5, 0x49, 0x05, 0x44,
-	0x48, 0xde, 0x4f, 0x2d, 0xb6, 0x6f, 0xe1, 0x6b, 0x7f, 0x9b, 0x8c, 0xfd, 0xe1, 0x58, 0x1e, 0x4f,
-	0x1b, 0x7e, 0xe7, 0xf9, 0xad, 0x43, 0xee, 0xe0, 0x32, 0xa4, 0x84, 0xd9, 0x23, 0x8d, 0x1d, 0xbe,
-	0x35, 0x2c, 0x1d, 0x32, 0x56, 0x3a, 0xf1, 0xf4, 0xc6, 0xb0, 0xe7, 0x19, 0xe7, 0xff, 0xa0, 0xa9,
-	0x3f, 0x4f, 0xd0, 0x1f, 0x7b, 0xf7, 0x16, 0x1e, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x83,
-	0xa1, 0x97, 0x1b, 0x02, 0x00, 0x00,
+	proto.RegisterType((*OperationProgress)(nil), "generated.OperationProgress")
+}
+
+func init() { proto.RegisterFile("Shared.proto", fileDescriptor7) }
+
+var fileDescriptor7 = []byte{
+	// 384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6d, 0x92, 0xd1, 0x4a, 0xc3, 0x30,
+	0x14, 0x86, 0xe9, 0xba, 0xd6, 0xf5, 0x4c, 0x64, 0x0b, 0x2a, 0x45, 0x44, 0x46, 0x2f, 0x64, 0x17,
+	0xda, 0x0b, 0x05, 0x5f, 0x40, 0x14, 0xbc, 0xd1, 0xd1, 0xe9, 0x03, 0xd4, 0xf6, 0x74, 0x06, 0xbb,
+	0xa4, 0xa4, 0x19, 0xcc, 0x07, 0xf4, 0x65, 0x7c, 0x0a, 0x93, 0xb4, 0x59, 0x87, 0xf5, 0xee, 0x9c,
+	0xff, 0x7c, 0x39, 0xe7, 0xe4, 0x4f, 0xe0, 0x70, 0xf9, 0x91, 0x0a, 0xcc, 0xe3, 0x4a, 0x70, 0xc9,
+	0x49, 0xb0, 0x42, 0x86, 0x22, 0x95, 0x98, 0x47, 0x07, 0xe0, 0x3d, 0xac, 0x2b, 0xf9, 0x15, 0xdd,
+	0x81, 0xb7, 0x94, 0x69, 0xf6, 0x49, 0xae, 0xc1, 0x2f, 0x44, 0xba, 0xc6, 0x3a, 0x74, 0x66, 0xee,
+	0x7c, 0x7c, 0x73, 0x12, 0xef, 0xe8, 0xd8, 0x10, 0x8f, 0xba, 0x9a, 0xb4, 0x50, 0xb4, 0x00, 0xe8,
+	0x54, 0x42, 0x60, 0x58, 0xd0, 0x12, 0xd5, 0x51, 0x67, 0x1e, 0x24, 0x26, 0xd6, 0x5a, 0x49, 0x19,
+	0x86, 0x03, 0xa5, 0x79, 0x89, 0x89, 0xc9, 0x19, 0x8c, 0x8a, 0x0d, 0xcb, 0x24, 0xe5, 0x2c, 0x74,
+	0x0d, 0xbb, 0xcb, 0xa3, 0x2d, 0x90, 0x04, 0x6b, 0xbe, 0x11, 0x19, 0x3e, 0xe5, 0xc8, 0x24, 0x2d,
+	0x28, 0x0a, 0x72, 0x0c, 0xde, 0x4a, 0xf0, 0x4d, 0xd5, 0xb6, 0x6e, 0x12, 0xdd, 0x47, 0xb4, 0xac,
+	0xe9, 0xaf, 0xfa, 0xd8, 0x9c, 0x9c, 0x43, 0xc0, 0xf4, 0x8a, 0x55, 0xaa, 0x8a, 0xcd, 0x90, 0x4e,
+	0xd0, 0x5b, 0xe9, 0x24, 0x1c, 0x36, 0x9b, 0xea, 0x38, 0xfa, 0x71, 0x60, 0x62, 0x47, 0x2f, 0xb1,
+	0xc4, 0x4c, 0x72, 0x41, 0x62, 0x20, 0x94, 0x65, 0xe5, 0x26, 0xc7, 0xfc, 0xd9, 0x9e, 0x6e, 0xbc,
+	0x09, 0x92, 0x7f, 0x2a, 0x9a, 0xc7, 0x6d, 0x8f, 0x1f, 0x34, 0x7c, 0xbf, 0x42, 0xae, 0x60, 0x6a,
+	0xbb, 0xd8, 0xd9, 0xb5, 0x5a, 0x57, 0xe3, 0xfd, 0x82, 0xa6, 0x6d, 0x8f, 0x8e, 0x1e, 0x36, 0x74,
+	0xaf, 0xa0, 0xed, 0xa9, 0xdb, 0x7b, 0x84, 0x5e, 0x63, 0x8f, 0xcd, 0xa3, 0x6f, 0x07, 0xa6, 0x2f,
+	0x95, 0x7e, 0x58, 0x65, 0xfa, 0x42, 0xf0, 0x95, 0x32, 0xae, 0xd6, 0xa6, 0x65, 0x7c, 0x5d, 0x95,
+	0xa8, 0x9e, 0xdb, 0x58, 0x3d, 0x4a, 0x3a, 0x81, 0x4c, 0xc0, 0x45, 0x21, 0x5a, 0xa7, 0x75, 0x48,
+	0x2e, 0x00, 0xd8, 0xfd, 0xee, 0x80, 0x76, 0xd9, 0x4d, 0xf6, 0x14, 0x72, 0x0a, 0x3e, 0x7b, 0xe5,
+	0x32, 0x2d, 0x8d, 0xd1, 0x6e, 0xd2, 0x66, 0xe4, 0x12, 0x8e, 0xb8, 0x1d, 0xfe, 0xc6, 0xa8, 0xac,
+	0xdb, 0xfd, 0xfe, 0xa8, 0x64, 0x06, 0xe3, 0x1c, 0xeb, 0x4c, 0xd0, 0xca, 0xfc, 0x15, 0xdf, 0x40,
+	0xfb, 0xd2, 0xbb, 0x6f, 0xfe, 0xf4, 0xed, 0x2f, 0x16, 0x28, 0x79, 0x97, 0xe3, 0x02, 0x00, 0x00,
 }
@@ -23,12 +23,13 @@ type CreateVolumeRequest struct {
 	VolumeType string `protobuf:"bytes,3,opt,name=volumeType" json:"volumeType,omitempty"`
 	VolumeAZ   string `protobuf:"bytes,4,opt,name=volumeAZ" json:"volumeAZ,omitempty"`
 	Iops       int64  `protobuf:"varint,5,opt,name=iops" json:"iops,omitempty"`
+	SizeBytes  int64  `protobuf:"varint,6,opt,name=sizeBytes" json:"sizeBytes,omitempty"`
 }
 
 func (m *CreateVolumeRequest) Reset()                    { *m = CreateVolumeRequest{} }
 func (m *CreateVolumeRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateVolumeRequest) ProtoMessage()               {}
-func (*CreateVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{0} }
+func (*CreateVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{0} }
 
 func (m *CreateVolumeRequest) GetPlugin() string {
 	if m != nil {
@@ -65,14 +66,22 @@ func (m *CreateVolumeRequest) GetIops() int64 {
 	return 0
 }
 
+func (m *CreateVolumeRequest) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
 type CreateVolumeResponse struct {
 	VolumeID string `protobuf:"bytes,1,opt,name=volumeID" json:"volumeID,omitempty"`
+	Resized  bool   `protobuf:"varint,2,opt,name=resized" json:"resized,omitempty"`
 }
 
 func (m *CreateVolumeResponse) Reset()                    { *m = CreateVolumeResponse{} }
 func (m *CreateVolumeResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateVolumeResponse) ProtoMessage()               {}
-func (*CreateVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{1} }
+func (*CreateVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{1} }
 
 func (m *CreateVolumeResponse) GetVolumeID() string {
 	if m != nil {
@@ -81,6 +90,13 @@ func (m *CreateVolumeResponse) GetVolumeID() string {
 	return ""
 }
 
+func (m *CreateVolumeResponse) GetResized() bool {
+	if m != nil {
+		return m.Resized
+	}
+	return false
+}
+
 type GetVolumeInfoRequest struct {
 	Plugin   string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
 	VolumeID string `protobuf:"bytes,2,opt,name=volumeID" json:"volumeID,omitempty"`
@@ -90,7 +106,7 @@ type GetVolumeInfoRequest struct {
 func (m *GetVolumeInfoRequest) Reset()                    { *m = GetVolumeInfoRequest{} }
 func (m *GetVolumeInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetVolumeInfoRequest) ProtoMessage()               {}
-func (*GetVolumeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{2} }
+func (*GetVolumeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{2} }
 
 func (m *GetVolumeInfoRequest) GetPlugin() string {
 	if m != nil {
@@ -121,7 +137,7 @@ type GetVolumeInfoResponse struct {
 func (m *GetVolumeInfoResponse) Reset()                    { *m = GetVolumeInfoResponse{} }
 func (m *GetVolumeInfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetVolumeInfoResponse) ProtoMessage()               {}
-func (*GetVolumeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{3} }
+func (*GetVolumeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{3} }
 
 func (m *GetVolumeInfoResponse) GetVolumeType() string {
 	if m != nil {
@@ -147,7 +163,7 @@ type CreateSnapshotRequest struct {
 func (m *CreateSnapshotRequest) Reset()                    { *m = CreateSnapshotRequest{} }
 func (m *CreateSnapshotRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateSnapshotRequest) ProtoMessage()               {}
-func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{4} }
+func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{4} }
 
 func (m *CreateSnapshotRequest) GetPlugin() string {
 	if m != nil {
@@ -184,7 +200,7 @@ type CreateSnapshotResponse struct {
 func (m *CreateSnapshotResponse) Reset()                    { *m = CreateSnapshotResponse{} }
 func (m *CreateSnapshotResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateSnapshotResponse) ProtoMessage()               {}
-func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{5} }
+func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{5} }
 
 func (m *CreateSnapshotResponse) GetSnapshotID() string {
 	if m != nil {
@@ -201,7 +217,7 @@ type DeleteSnapshotRequest struct {
 func (m *DeleteSnapshotRequest) Reset()                    { *m = DeleteSnapshotRequest{} }
 func (m *DeleteSnapshotRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteSnapshotRequest) ProtoMessage()               {}
-func (*DeleteSnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{6} }
+func (*DeleteSnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{6} }
 
 func (m *DeleteSnapshotRequest) GetPlugin() string {
 	if m != nil {
@@ -225,7 +241,7 @@ type GetVolumeIDRequest struct {
 func (m *GetVolumeIDRequest) Reset()                    { *m = GetVolumeIDRequest{} }
 func (m *GetVolumeIDRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetVolumeIDRequest) ProtoMessage()               {}
-func (*GetVolumeIDRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{7} }
+func (*GetVolumeIDRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{7} }
 
 func (m *GetVolumeIDRequest) GetPlugin() string {
 	if m != nil {
@@ -248,7 +264,7 @@ type GetVolumeIDResponse struct {
 func (m *GetVolumeIDResponse) Reset()                    { *m = GetVolumeIDResponse{} }
 func (m *GetVolumeIDResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetVolumeIDResponse) ProtoMessage()               {}
-func (*GetVolumeIDResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{8} }
+func (*GetVolumeIDResponse) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{8} }
 
 func (m *GetVolumeIDResponse) GetVolumeID() string {
 	if m != nil {
@@ -266,7 +282,7 @@ type SetVolumeIDRequest struct {
 func (m *SetVolumeIDRequest) Reset()                    { *m = SetVolumeIDRequest{} }
 func (m *SetVolumeIDRequest) String() string            { return proto.CompactTextString(m) }
 func (*SetVolumeIDRequest) ProtoMessage()               {}
-func (*SetVolumeIDRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{9} }
+func (*SetVolumeIDRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{9} }
 
 func (m *SetVolumeIDRequest) GetPlugin() string {
 	if m != nil {
@@ -296,7 +312,7 @@ type SetVolumeIDResponse struct {
 func (m *SetVolumeIDResponse) Reset()                    { *m = SetVolumeIDResponse{} }
 func (m *SetVolumeIDResponse) String() string            { return proto.CompactTextString(m) }
 func (*SetVolumeIDResponse) ProtoMessage()               {}
-func (*SetVolumeIDResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{10} }
+func (*SetVolumeIDResponse) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{10} }
 
 func (m *SetVolumeIDResponse) GetPersistentVolume() []byte {
 	if m != nil {
@@ -313,7 +329,7 @@ type VolumeSnapshotterInitRequest struct {
 func (m *VolumeSnapshotterInitRequest) Reset()                    { *m = VolumeSnapshotterInitRequest{} }
 func (m *VolumeSnapshotterInitRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeSnapshotterInitRequest) ProtoMessage()               {}
-func (*VolumeSnapshotterInitRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{11} }
+func (*VolumeSnapshotterInitRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{11} }
 
 func (m *VolumeSnapshotterInitRequest) GetPlugin() string {
 	if m != nil {
@@ -329,6 +345,278 @@ func (m *VolumeSnapshotterInitRequest) GetConfig() map[string]string {
 	return nil
 }
 
+type VerifySnapshotRequest struct {
+	Plugin      string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	SnapshotID  string `protobuf:"bytes,2,opt,name=snapshotID" json:"snapshotID,omitempty"`
+	VolumeAZ    string `protobuf:"bytes,3,opt,name=volumeAZ" json:"volumeAZ,omitempty"`
+	TestRestore bool   `protobuf:"varint,4,opt,name=testRestore" json:"testRestore,omitempty"`
+}
+
+func (m *VerifySnapshotRequest) Reset()                    { *m = VerifySnapshotRequest{} }
+func (m *VerifySnapshotRequest) String() string            { return proto.CompactTextString(m) }
+func (*VerifySnapshotRequest) ProtoMessage()               {}
+func (*VerifySnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{12} }
+
+func (m *VerifySnapshotRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *VerifySnapshotRequest) GetSnapshotID() string {
+	if m != nil {
+		return m.SnapshotID
+	}
+	return ""
+}
+
+func (m *VerifySnapshotRequest) GetVolumeAZ() string {
+	if m != nil {
+		return m.VolumeAZ
+	}
+	return ""
+}
+
+func (m *VerifySnapshotRequest) GetTestRestore() bool {
+	if m != nil {
+		return m.TestRestore
+	}
+	return false
+}
+
+type VerifySnapshotResponse struct {
+	Verified bool `protobuf:"varint,1,opt,name=verified" json:"verified,omitempty"`
+}
+
+func (m *VerifySnapshotResponse) Reset()                    { *m = VerifySnapshotResponse{} }
+func (m *VerifySnapshotResponse) String() string            { return proto.CompactTextString(m) }
+func (*VerifySnapshotResponse) ProtoMessage()               {}
+func (*VerifySnapshotResponse) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{13} }
+
+func (m *VerifySnapshotResponse) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+type SnapshotGroupVolume struct {
+	VolumeID string            `protobuf:"bytes,1,opt,name=volumeID" json:"volumeID,omitempty"`
+	VolumeAZ string            `protobuf:"bytes,2,opt,name=volumeAZ" json:"volumeAZ,omitempty"`
+	Tags     map[string]string `protobuf:"bytes,3,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *SnapshotGroupVolume) Reset()                    { *m = SnapshotGroupVolume{} }
+func (m *SnapshotGroupVolume) String() string            { return proto.CompactTextString(m) }
+func (*SnapshotGroupVolume) ProtoMessage()               {}
+func (*SnapshotGroupVolume) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{14} }
+
+func (m *SnapshotGroupVolume) GetVolumeID() string {
+	if m != nil {
+		return m.VolumeID
+	}
+	return ""
+}
+
+func (m *SnapshotGroupVolume) GetVolumeAZ() string {
+	if m != nil {
+		return m.VolumeAZ
+	}
+	return ""
+}
+
+func (m *SnapshotGroupVolume) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type CreateSnapshotGroupRequest struct {
+	Plugin  string                 `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Volumes []*SnapshotGroupVolume `protobuf:"bytes,2,rep,name=volumes" json:"volumes,omitempty"`
+}
+
+func (m *CreateSnapshotGroupRequest) Reset()                    { *m = CreateSnapshotGroupRequest{} }
+func (m *CreateSnapshotGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateSnapshotGroupRequest) ProtoMessage()               {}
+func (*CreateSnapshotGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{15} }
+
+func (m *CreateSnapshotGroupRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *CreateSnapshotGroupRequest) GetVolumes() []*SnapshotGroupVolume {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
+type CreateSnapshotGroupResponse struct {
+	SnapshotIDs []string `protobuf:"bytes,1,rep,name=snapshotIDs" json:"snapshotIDs,omitempty"`
+	Grouped     bool     `protobuf:"varint,2,opt,name=grouped" json:"grouped,omitempty"`
+}
+
+func (m *CreateSnapshotGroupResponse) Reset()                    { *m = CreateSnapshotGroupResponse{} }
+func (m *CreateSnapshotGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateSnapshotGroupResponse) ProtoMessage()               {}
+func (*CreateSnapshotGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{16} }
+
+func (m *CreateSnapshotGroupResponse) GetSnapshotIDs() []string {
+	if m != nil {
+		return m.SnapshotIDs
+	}
+	return nil
+}
+
+func (m *CreateSnapshotGroupResponse) GetGrouped() bool {
+	if m != nil {
+		return m.Grouped
+	}
+	return false
+}
+
+type GetSnapshotSizeRequest struct {
+	Plugin     string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	SnapshotID string `protobuf:"bytes,2,opt,name=snapshotID" json:"snapshotID,omitempty"`
+}
+
+func (m *GetSnapshotSizeRequest) Reset()                    { *m = GetSnapshotSizeRequest{} }
+func (m *GetSnapshotSizeRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetSnapshotSizeRequest) ProtoMessage()               {}
+func (*GetSnapshotSizeRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{17} }
+
+func (m *GetSnapshotSizeRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *GetSnapshotSizeRequest) GetSnapshotID() string {
+	if m != nil {
+		return m.SnapshotID
+	}
+	return ""
+}
+
+type GetSnapshotSizeResponse struct {
+	SizeBytes int64 `protobuf:"varint,1,opt,name=sizeBytes" json:"sizeBytes,omitempty"`
+	Supported bool  `protobuf:"varint,2,opt,name=supported" json:"supported,omitempty"`
+}
+
+func (m *GetSnapshotSizeResponse) Reset()                    { *m = GetSnapshotSizeResponse{} }
+func (m *GetSnapshotSizeResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetSnapshotSizeResponse) ProtoMessage()               {}
+func (*GetSnapshotSizeResponse) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{18} }
+
+func (m *GetSnapshotSizeResponse) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *GetSnapshotSizeResponse) GetSupported() bool {
+	if m != nil {
+		return m.Supported
+	}
+	return false
+}
+
+type StartSnapshotResponse struct {
+	SnapshotID  string `protobuf:"bytes,1,opt,name=snapshotID" json:"snapshotID,omitempty"`
+	OperationID string `protobuf:"bytes,2,opt,name=operationID" json:"operationID,omitempty"`
+}
+
+func (m *StartSnapshotResponse) Reset()                    { *m = StartSnapshotResponse{} }
+func (m *StartSnapshotResponse) String() string            { return proto.CompactTextString(m) }
+func (*StartSnapshotResponse) ProtoMessage()               {}
+func (*StartSnapshotResponse) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{19} }
+
+func (m *StartSnapshotResponse) GetSnapshotID() string {
+	if m != nil {
+		return m.SnapshotID
+	}
+	return ""
+}
+
+func (m *StartSnapshotResponse) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type SnapshotProgressRequest struct {
+	Plugin      string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	OperationID string `protobuf:"bytes,2,opt,name=operationID" json:"operationID,omitempty"`
+}
+
+func (m *SnapshotProgressRequest) Reset()                    { *m = SnapshotProgressRequest{} }
+func (m *SnapshotProgressRequest) String() string            { return proto.CompactTextString(m) }
+func (*SnapshotProgressRequest) ProtoMessage()               {}
+func (*SnapshotProgressRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{20} }
+
+func (m *SnapshotProgressRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *SnapshotProgressRequest) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type SnapshotProgressResponse struct {
+	Progress *OperationProgress `protobuf:"bytes,1,opt,name=progress" json:"progress,omitempty"`
+}
+
+func (m *SnapshotProgressResponse) Reset()                    { *m = SnapshotProgressResponse{} }
+func (m *SnapshotProgressResponse) String() string            { return proto.CompactTextString(m) }
+func (*SnapshotProgressResponse) ProtoMessage()               {}
+func (*SnapshotProgressResponse) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{21} }
+
+func (m *SnapshotProgressResponse) GetProgress() *OperationProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type CancelSnapshotRequest struct {
+	Plugin      string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	OperationID string `protobuf:"bytes,2,opt,name=operationID" json:"operationID,omitempty"`
+}
+
+func (m *CancelSnapshotRequest) Reset()                    { *m = CancelSnapshotRequest{} }
+func (m *CancelSnapshotRequest) String() string            { return proto.CompactTextString(m) }
+func (*CancelSnapshotRequest) ProtoMessage()               {}
+func (*CancelSnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{22} }
+
+func (m *CancelSnapshotRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *CancelSnapshotRequest) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CreateVolumeRequest)(nil), "generated.CreateVolumeRequest")
 	proto.RegisterType((*CreateVolumeResponse)(nil), "generated.CreateVolumeResponse")
@@ -342,6 +630,17 @@ func init() {
 	proto.RegisterType((*SetVolumeIDRequest)(nil), "generated.SetVolumeIDRequest")
 	proto.RegisterType((*SetVolumeIDResponse)(nil), "generated.SetVolumeIDResponse")
 	proto.RegisterType((*VolumeSnapshotterInitRequest)(nil), "generated.VolumeSnapshotterInitRequest")
+	proto.RegisterType((*VerifySnapshotRequest)(nil), "generated.VerifySnapshotRequest")
+	proto.RegisterType((*VerifySnapshotResponse)(nil), "generated.VerifySnapshotResponse")
+	proto.RegisterType((*SnapshotGroupVolume)(nil), "generated.SnapshotGroupVolume")
+	proto.RegisterType((*CreateSnapshotGroupRequest)(nil), "generated.CreateSnapshotGroupRequest")
+	proto.RegisterType((*CreateSnapshotGroupResponse)(nil), "generated.CreateSnapshotGroupResponse")
+	proto.RegisterType((*GetSnapshotSizeRequest)(nil), "generated.GetSnapshotSizeRequest")
+	proto.RegisterType((*GetSnapshotSizeResponse)(nil), "generated.GetSnapshotSizeResponse")
+	proto.RegisterType((*StartSnapshotResponse)(nil), "generated.StartSnapshotResponse")
+	proto.RegisterType((*SnapshotProgressRequest)(nil), "generated.SnapshotProgressRequest")
+	proto.RegisterType((*SnapshotProgressResponse)(nil), "generated.SnapshotProgressResponse")
+	proto.RegisterType((*CancelSnapshotRequest)(nil), "generated.CancelSnapshotRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -362,6 +661,9 @@ type VolumeSnapshotterClient interface {
 	DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*Empty, error)
 	GetVolumeID(ctx context.Context, in *GetVolumeIDRequest, opts ...grpc.CallOption) (*GetVolumeIDResponse, error)
 	SetVolumeID(ctx context.Context, in *SetVolumeIDRequest, opts ...grpc.CallOption) (*SetVolumeIDResponse, error)
+	VerifySnapshot(ctx context.Context, in *VerifySnapshotRequest, opts ...grpc.CallOption) (*VerifySnapshotResponse, error)
+	CreateSnapshotGroup(ctx context.Context, in *CreateSnapshotGroupRequest, opts ...grpc.CallOption) (*CreateSnapshotGroupResponse, error)
+	GetSnapshotSize(ctx context.Context, in *GetSnapshotSizeRequest, opts ...grpc.CallOption) (*GetSnapshotSizeResponse, error)
 }
 
 type volumeSnapshotterClient struct {
@@ -435,6 +737,33 @@ func (c *volumeSnapshotterClient) SetVolumeID(ctx context.Context, in *SetVolume
 	return out, nil
 }
 
+func (c *volumeSnapshotterClient) VerifySnapshot(ctx context.Context, in *VerifySnapshotRequest, opts ...grpc.CallOption) (*VerifySnapshotResponse, error) {
+	out := new(VerifySnapshotResponse)
+	err := grpc.Invoke(ctx, "/generated.VolumeSnapshotter/VerifySnapshot", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeSnapshotterClient) CreateSnapshotGroup(ctx context.Context, in *CreateSnapshotGroupRequest, opts ...grpc.CallOption) (*CreateSnapshotGroupResponse, error) {
+	out := new(CreateSnapshotGroupResponse)
+	err := grpc.Invoke(ctx, "/generated.VolumeSnapshotter/CreateSnapshotGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeSnapshotterClient) GetSnapshotSize(ctx context.Context, in *GetSnapshotSizeRequest, opts ...grpc.CallOption) (*GetSnapshotSizeResponse, error) {
+	out := new(GetSnapshotSizeResponse)
+	err := grpc.Invoke(ctx, "/generated.VolumeSnapshotter/GetSnapshotSize", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for VolumeSnapshotter service
 
 type VolumeSnapshotterServer interface {
@@ -445,6 +774,9 @@ type VolumeSnapshotterServer interface {
 	DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*Empty, error)
 	GetVolumeID(context.Context, *GetVolumeIDRequest) (*GetVolumeIDResponse, error)
 	SetVolumeID(context.Context, *SetVolumeIDRequest) (*SetVolumeIDResponse, error)
+	VerifySnapshot(context.Context, *VerifySnapshotRequest) (*VerifySnapshotResponse, error)
+	CreateSnapshotGroup(context.Context, *CreateSnapshotGroupRequest) (*CreateSnapshotGroupResponse, error)
+	GetSnapshotSize(context.Context, *GetSnapshotSizeRequest) (*GetSnapshotSizeResponse, error)
 }
 
 func RegisterVolumeSnapshotterServer(s *grpc.Server, srv VolumeSnapshotterServer) {
@@ -577,6 +909,60 @@ func _VolumeSnapshotter_SetVolumeID_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VolumeSnapshotter_VerifySnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeSnapshotterServer).VerifySnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.VolumeSnapshotter/VerifySnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeSnapshotterServer).VerifySnapshot(ctx, req.(*VerifySnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeSnapshotter_CreateSnapshotGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeSnapshotterServer).CreateSnapshotGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.VolumeSnapshotter/CreateSnapshotGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeSnapshotterServer).CreateSnapshotGroup(ctx, req.(*CreateSnapshotGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeSnapshotter_GetSnapshotSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSnapshotSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeSnapshotterServer).GetSnapshotSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.VolumeSnapshotter/GetSnapshotSize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeSnapshotterServer).GetSnapshotSize(ctx, req.(*GetSnapshotSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _VolumeSnapshotter_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "generated.VolumeSnapshotter",
 	HandlerType: (*VolumeSnapshotterServer)(nil),
@@ -609,49 +995,214 @@ var _VolumeSnapshotter_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetVolumeID",
 			Handler:    _VolumeSnapshotter_SetVolumeID_Handler,
 		},
+		{
+			MethodName: "VerifySnapshot",
+			Handler:    _VolumeSnapshotter_VerifySnapshot_Handler,
+		},
+		{
+			MethodName: "CreateSnapshotGroup",
+			Handler:    _VolumeSnapshotter_CreateSnapshotGroup_Handler,
+		},
+		{
+			MethodName: "GetSnapshotSize",
+			Handler:    _VolumeSnapshotter_GetSnapshotSize_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "VolumeSnapshotter.proto",
+}
+
+// Client API for VolumeSnapshotterV2 service
+
+type VolumeSnapshotterV2Client interface {
+	StartSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*StartSnapshotResponse, error)
+	SnapshotProgress(ctx context.Context, in *SnapshotProgressRequest, opts ...grpc.CallOption) (*SnapshotProgressResponse, error)
+	CancelSnapshot(ctx context.Context, in *CancelSnapshotRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type volumeSnapshotterV2Client struct {
+	cc *grpc.ClientConn
+}
+
+func NewVolumeSnapshotterV2Client(cc *grpc.ClientConn) VolumeSnapshotterV2Client {
+	return &volumeSnapshotterV2Client{cc}
+}
+
+func (c *volumeSnapshotterV2Client) StartSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*StartSnapshotResponse, error) {
+	out := new(StartSnapshotResponse)
+	err := grpc.Invoke(ctx, "/generated.VolumeSnapshotterV2/StartSnapshot", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeSnapshotterV2Client) SnapshotProgress(ctx context.Context, in *SnapshotProgressRequest, opts ...grpc.CallOption) (*SnapshotProgressResponse, error) {
+	out := new(SnapshotProgressResponse)
+	err := grpc.Invoke(ctx, "/generated.VolumeSnapshotterV2/SnapshotProgress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeSnapshotterV2Client) CancelSnapshot(ctx context.Context, in *CancelSnapshotRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/generated.VolumeSnapshotterV2/CancelSnapshot", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for VolumeSnapshotterV2 service
+
+type VolumeSnapshotterV2Server interface {
+	StartSnapshot(context.Context, *CreateSnapshotRequest) (*StartSnapshotResponse, error)
+	SnapshotProgress(context.Context, *SnapshotProgressRequest) (*SnapshotProgressResponse, error)
+	CancelSnapshot(context.Context, *CancelSnapshotRequest) (*Empty, error)
+}
+
+func RegisterVolumeSnapshotterV2Server(s *grpc.Server, srv VolumeSnapshotterV2Server) {
+	s.RegisterService(&_VolumeSnapshotterV2_serviceDesc, srv)
+}
+
+func _VolumeSnapshotterV2_StartSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeSnapshotterV2Server).StartSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.VolumeSnapshotterV2/StartSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeSnapshotterV2Server).StartSnapshot(ctx, req.(*CreateSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeSnapshotterV2_SnapshotProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeSnapshotterV2Server).SnapshotProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.VolumeSnapshotterV2/SnapshotProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeSnapshotterV2Server).SnapshotProgress(ctx, req.(*SnapshotProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeSnapshotterV2_CancelSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeSnapshotterV2Server).CancelSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.VolumeSnapshotterV2/CancelSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeSnapshotterV2Server).CancelSnapshot(ctx, req.(*CancelSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _VolumeSnapshotterV2_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "generated.VolumeSnapshotterV2",
+	HandlerType: (*VolumeSnapshotterV2Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartSnapshot",
+			Handler:    _VolumeSnapshotterV2_StartSnapshot_Handler,
+		},
+		{
+			MethodName: "SnapshotProgress",
+			Handler:    _VolumeSnapshotterV2_SnapshotProgress_Handler,
+		},
+		{
+			MethodName: "CancelSnapshot",
+			Handler:    _VolumeSnapshotterV2_CancelSnapshot_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "VolumeSnapshotter.proto",
 }
 
-func init() { proto.RegisterFile("VolumeSnapshotter.proto", fileDescriptor6) }
-
-var fileDescriptor6 = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xd5, 0xda, 0x6e, 0x44, 0x26, 0xa5, 0x0a, 0x9b, 0xa4, 0x58, 0x16, 0x04, 0xe3, 0x0b, 0x51,
-	0x0f, 0x96, 0x48, 0x0f, 0x14, 0x0e, 0x48, 0x51, 0x5d, 0x50, 0xd4, 0x4a, 0x48, 0x76, 0x41, 0x08,
-	0x4e, 0x86, 0x6e, 0x5c, 0x8b, 0xc4, 0x36, 0xde, 0x4d, 0xa5, 0x7c, 0x0c, 0xbf, 0x82, 0xf8, 0x14,
-	0x3e, 0x05, 0xc5, 0xde, 0x24, 0xbb, 0xb1, 0x1d, 0x87, 0x43, 0x6f, 0xde, 0x99, 0x9d, 0x37, 0x6f,
-	0x66, 0xdf, 0x8c, 0xe1, 0xf1, 0xa7, 0x78, 0x3a, 0x9f, 0x11, 0x2f, 0xf2, 0x13, 0x7a, 0x1b, 0x33,
-	0x46, 0x52, 0x3b, 0x49, 0x63, 0x16, 0xe3, 0x66, 0x40, 0x22, 0x92, 0xfa, 0x8c, 0xdc, 0x18, 0x87,
-	0xde, 0xad, 0x9f, 0x92, 0x9b, 0xdc, 0x61, 0xfd, 0x42, 0xd0, 0x39, 0x4f, 0x89, 0xcf, 0x48, 0x1e,
-	0xea, 0x92, 0x9f, 0x73, 0x42, 0x19, 0x3e, 0x86, 0x46, 0x32, 0x9d, 0x07, 0x61, 0xa4, 0x23, 0x13,
-	0x0d, 0x9a, 0x2e, 0x3f, 0xe1, 0x3e, 0x00, 0xe5, 0xe8, 0x63, 0x47, 0x57, 0x32, 0x9f, 0x60, 0x59,
-	0xfa, 0xef, 0x32, 0xa0, 0xeb, 0x45, 0x42, 0x74, 0x35, 0xf7, 0x6f, 0x2c, 0xd8, 0x80, 0x07, 0xf9,
-	0x69, 0xf4, 0x45, 0xd7, 0x32, 0xef, 0xfa, 0x8c, 0x31, 0x68, 0x61, 0x9c, 0x50, 0xfd, 0xc0, 0x44,
-	0x03, 0xd5, 0xcd, 0xbe, 0xad, 0x21, 0x74, 0x65, 0x7a, 0x34, 0x89, 0x23, 0x2a, 0xe0, 0x8c, 0x1d,
-	0xce, 0x70, 0x7d, 0xb6, 0x26, 0xd0, 0x7d, 0x4f, 0x58, 0x1e, 0x30, 0x8e, 0x26, 0x71, 0x5d, 0x4d,
-	0x22, 0x96, 0x22, 0x63, 0x49, 0x7c, 0x55, 0x99, 0xaf, 0x75, 0x09, 0xbd, 0xad, 0x3c, 0x9c, 0x9c,
-	0xdc, 0x04, 0x54, 0x68, 0xc2, 0xaa, 0x50, 0x45, 0x28, 0xf4, 0x2f, 0x82, 0x5e, 0x5e, 0xe9, 0xea,
-	0xf5, 0xee, 0x89, 0x36, 0x7e, 0x0b, 0x1a, 0xf3, 0x03, 0xaa, 0x6b, 0xa6, 0x3a, 0x68, 0x0d, 0x4f,
-	0xec, 0xb5, 0x34, 0xec, 0xd2, 0xfc, 0xf6, 0xb5, 0x1f, 0xd0, 0x8b, 0x88, 0xa5, 0x0b, 0x37, 0x8b,
-	0x33, 0x5e, 0x41, 0x73, 0x6d, 0xc2, 0x6d, 0x50, 0x7f, 0x90, 0x05, 0x67, 0xb6, 0xfc, 0xc4, 0x5d,
-	0x38, 0xb8, 0xf3, 0xa7, 0x73, 0xc2, 0x39, 0xe5, 0x87, 0x37, 0xca, 0x19, 0xb2, 0xce, 0xe0, 0x78,
-	0x3b, 0xc3, 0xa6, 0x61, 0x82, 0xaa, 0xd0, 0xb6, 0xaa, 0xac, 0x0f, 0xd0, 0x73, 0xc8, 0x94, 0xec,
-	0xdf, 0x9b, 0x1a, 0x99, 0x5a, 0x9f, 0x01, 0x6f, 0x9e, 0xce, 0xa9, 0x43, 0x3b, 0x81, 0x76, 0x42,
-	0x52, 0x1a, 0x52, 0x46, 0x22, 0x1e, 0x94, 0x61, 0x1e, 0xba, 0x05, 0xbb, 0xf5, 0x12, 0x3a, 0x12,
-	0xf2, 0x1e, 0x7a, 0x65, 0x80, 0xbd, 0x7b, 0x21, 0x23, 0x65, 0x55, 0xb7, 0xb2, 0x8e, 0xa0, 0xe3,
-	0x95, 0x10, 0x2d, 0x83, 0x47, 0x15, 0xb5, 0xfe, 0x46, 0xf0, 0xa4, 0xb0, 0x71, 0xc6, 0x51, 0x58,
-	0xfb, 0x3c, 0x97, 0xd0, 0xf8, 0x1e, 0x47, 0x93, 0x30, 0xd0, 0x95, 0x4c, 0x84, 0xa7, 0x82, 0x08,
-	0x77, 0x01, 0xda, 0xe7, 0x59, 0x54, 0xae, 0x46, 0x0e, 0x61, 0xbc, 0x86, 0x96, 0x60, 0xfe, 0x1f,
-	0x45, 0x0e, 0xff, 0x68, 0xf0, 0xa8, 0x90, 0x0f, 0x8f, 0x40, 0x5b, 0xe6, 0xc4, 0x2f, 0xf6, 0x64,
-	0x65, 0xb4, 0x85, 0x8b, 0x17, 0xb3, 0x84, 0x2d, 0xf0, 0x57, 0xd0, 0xc5, 0xb5, 0xf5, 0x2e, 0x8d,
-	0x67, 0xab, 0x58, 0xdc, 0x2f, 0x4c, 0x9c, 0xb4, 0x7a, 0x8d, 0x67, 0x95, 0x7e, 0xfe, 0x44, 0x2e,
-	0x3c, 0x94, 0xf6, 0x0e, 0x16, 0x23, 0xca, 0x36, 0x9f, 0x61, 0x56, 0x5f, 0xe0, 0x98, 0x1f, 0xe1,
-	0x48, 0x9e, 0x4d, 0x6c, 0xd6, 0x2d, 0x06, 0xe3, 0xf9, 0x8e, 0x1b, 0x1c, 0xd6, 0x81, 0x23, 0x79,
-	0x70, 0x25, 0xd8, 0xd2, 0x99, 0x2e, 0xe9, 0xe6, 0x15, 0xb4, 0x84, 0x99, 0xc2, 0x4f, 0x4b, 0xab,
-	0x59, 0x0d, 0x8e, 0xd1, 0xaf, 0x72, 0x73, 0x4e, 0x57, 0xd0, 0xf2, 0x2a, 0xd0, 0xbc, 0xdd, 0x68,
-	0x25, 0xf3, 0xf2, 0xad, 0x91, 0xfd, 0x47, 0x4f, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xf2,
-	0xb8, 0x2f, 0x7b, 0x07, 0x00, 0x00,
+func init() { proto.RegisterFile("VolumeSnapshotter.proto", fileDescriptor8) }
+
+var fileDescriptor8 = []byte{
+	// 939 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb5, 0x57, 0xc1, 0x4e, 0xdb, 0x40,
+	0x10, 0x95, 0x13, 0x08, 0x61, 0x02, 0x34, 0xdd, 0x10, 0xb0, 0x5c, 0x4a, 0x61, 0xab, 0xb6, 0x88,
+	0x43, 0xa4, 0x86, 0x4a, 0xa5, 0x55, 0x55, 0x89, 0x02, 0x45, 0x08, 0x24, 0xa8, 0x0d, 0x88, 0x96,
+	0x53, 0x4a, 0x36, 0xc1, 0x6a, 0xb0, 0x5d, 0xdb, 0x41, 0x4a, 0xff, 0x81, 0x2f, 0xaa, 0xd4, 0x53,
+	0xff, 0xa1, 0xd7, 0x7e, 0x4a, 0xd7, 0xf6, 0xda, 0xde, 0xb5, 0x9d, 0x18, 0x54, 0xb8, 0x65, 0x67,
+	0x66, 0xdf, 0xbc, 0x9d, 0x1d, 0xbf, 0xd9, 0xc0, 0xfc, 0x89, 0xd9, 0xeb, 0x5f, 0x12, 0xcd, 0x68,
+	0x59, 0xce, 0x85, 0xe9, 0xba, 0xc4, 0x6e, 0x58, 0xb6, 0xe9, 0x9a, 0x68, 0xb2, 0x4b, 0x0c, 0x62,
+	0xb7, 0x5c, 0xd2, 0x56, 0xa6, 0xb4, 0x8b, 0x96, 0x4d, 0xda, 0x81, 0x03, 0xff, 0x94, 0xa0, 0xb6,
+	0x69, 0x13, 0xea, 0x09, 0xb6, 0xaa, 0xe4, 0x7b, 0x9f, 0x38, 0x2e, 0x9a, 0x83, 0x92, 0xd5, 0xeb,
+	0x77, 0x75, 0x43, 0x96, 0x96, 0xa4, 0x95, 0x49, 0x95, 0xad, 0xd0, 0x22, 0x80, 0xc3, 0xd0, 0x77,
+	0xb7, 0xe4, 0x82, 0xef, 0xe3, 0x2c, 0x9e, 0xff, 0xca, 0x07, 0x3a, 0x1a, 0x58, 0x44, 0x2e, 0x06,
+	0xfe, 0xd8, 0x82, 0x14, 0x28, 0x07, 0xab, 0x8d, 0x2f, 0xf2, 0x98, 0xef, 0x8d, 0xd6, 0x08, 0xc1,
+	0x98, 0x6e, 0x5a, 0x8e, 0x3c, 0x4e, 0xed, 0x45, 0xd5, 0xff, 0x8d, 0x16, 0x60, 0xd2, 0xd1, 0x7f,
+	0x90, 0x0f, 0x03, 0x97, 0x38, 0x72, 0xc9, 0x77, 0xc4, 0x06, 0xbc, 0x0f, 0xb3, 0x22, 0x79, 0xc7,
+	0x32, 0x0d, 0x87, 0xcb, 0x42, 0x39, 0x4a, 0x7c, 0x16, 0xca, 0x50, 0x86, 0x09, 0x9b, 0x78, 0x10,
+	0x6d, 0x9f, 0x7e, 0x59, 0x0d, 0x97, 0xb8, 0x03, 0xb3, 0x3b, 0xc4, 0x0d, 0xa0, 0x76, 0x8d, 0x8e,
+	0x99, 0x57, 0x0b, 0x3e, 0x4b, 0x21, 0x91, 0x85, 0x3f, 0x67, 0x51, 0x3c, 0x27, 0xde, 0x83, 0x7a,
+	0x22, 0x0f, 0xa3, 0x2d, 0x16, 0x4f, 0x4a, 0x15, 0x2f, 0x2c, 0x50, 0x21, 0x2e, 0x10, 0xfe, 0x2b,
+	0x41, 0x3d, 0xa8, 0x41, 0x78, 0xeb, 0xf7, 0x44, 0x1b, 0xbd, 0x87, 0x31, 0xb7, 0xd5, 0x75, 0xe8,
+	0xb5, 0x15, 0x57, 0x2a, 0xcd, 0xd5, 0x46, 0xd4, 0x52, 0x8d, 0xcc, 0xfc, 0x8d, 0x23, 0x1a, 0xbc,
+	0x6d, 0xb8, 0xf6, 0x40, 0xf5, 0xf7, 0x29, 0xaf, 0x61, 0x32, 0x32, 0xa1, 0x2a, 0x14, 0xbf, 0x91,
+	0x01, 0x63, 0xe6, 0xfd, 0x44, 0xb3, 0x30, 0x7e, 0xd5, 0xea, 0xf5, 0x09, 0xe3, 0x14, 0x2c, 0xde,
+	0x16, 0xd6, 0x25, 0xbc, 0x0e, 0x73, 0xc9, 0x0c, 0x71, 0xc1, 0xb8, 0x6e, 0x94, 0x92, 0xdd, 0x88,
+	0x0f, 0xa0, 0xbe, 0x45, 0x7a, 0xe4, 0xe6, 0xb5, 0xc9, 0x69, 0x6f, 0x7c, 0x0a, 0x28, 0xbe, 0xba,
+	0xad, 0x3c, 0xb4, 0x55, 0xa8, 0x5a, 0xc4, 0x76, 0x74, 0xc7, 0x25, 0x06, 0xdb, 0xe4, 0x63, 0x4e,
+	0xa9, 0x29, 0x3b, 0x7e, 0x09, 0x35, 0x01, 0x39, 0xbf, 0x93, 0xb1, 0x0b, 0x48, 0xbb, 0x17, 0x32,
+	0x42, 0xd6, 0x62, 0x22, 0xeb, 0x06, 0xd4, 0xb4, 0x0c, 0xa2, 0x59, 0xf0, 0xd2, 0x90, 0xb3, 0xfe,
+	0x92, 0x60, 0x21, 0xa5, 0x54, 0xbb, 0x86, 0x9e, 0x7b, 0x3d, 0x7b, 0x50, 0x3a, 0x37, 0x8d, 0x8e,
+	0xde, 0xa5, 0xcc, 0xbd, 0x26, 0x5c, 0xe3, 0x9a, 0x70, 0x14, 0x60, 0x63, 0xd3, 0xdf, 0x15, 0x74,
+	0x23, 0x83, 0x50, 0xde, 0x40, 0x85, 0x33, 0xdf, 0xaa, 0x23, 0xaf, 0xe9, 0x47, 0x77, 0x42, 0x6c,
+	0xbd, 0x33, 0xb8, 0xa3, 0xc6, 0x1a, 0xf9, 0xe1, 0x2d, 0x41, 0x85, 0x8a, 0x9d, 0xd7, 0xf5, 0xae,
+	0x69, 0x13, 0x5f, 0x36, 0xcb, 0x2a, 0x6f, 0xc2, 0xaf, 0x60, 0x2e, 0x49, 0x87, 0xeb, 0x1f, 0xcf,
+	0xa3, 0x53, 0xb9, 0x93, 0xfc, 0x8d, 0xd1, 0x1a, 0xff, 0xa6, 0xda, 0x1f, 0x6e, 0xd8, 0xb1, 0xcd,
+	0xbe, 0x95, 0x71, 0xfb, 0xd2, 0x08, 0x81, 0x28, 0x24, 0x78, 0xbe, 0x63, 0x02, 0x51, 0xf4, 0xef,
+	0x66, 0x85, 0xbb, 0x9b, 0x8c, 0x2c, 0x77, 0x27, 0x0f, 0x06, 0x28, 0xa2, 0x3c, 0xf8, 0x59, 0xf2,
+	0x2e, 0x64, 0x1d, 0x26, 0x02, 0xe2, 0x0e, 0xeb, 0xa5, 0xc5, 0xd1, 0x7c, 0xd5, 0x30, 0x1c, 0x7f,
+	0x86, 0x47, 0x99, 0xf9, 0x58, 0xc5, 0xe9, 0x6d, 0xc5, 0xf7, 0xea, 0xd0, 0xac, 0x45, 0x9a, 0x95,
+	0x37, 0x79, 0x13, 0xa8, 0xeb, 0x6d, 0x89, 0x27, 0x10, 0x5b, 0xe2, 0x43, 0x98, 0xa3, 0x22, 0x10,
+	0xe2, 0x6a, 0x74, 0x2a, 0xfd, 0xaf, 0x60, 0x1d, 0xc3, 0x7c, 0x0a, 0x91, 0x11, 0x15, 0x46, 0xab,
+	0x94, 0x18, 0xad, 0xbe, 0xb7, 0x6f, 0x59, 0xa6, 0xed, 0x46, 0x34, 0x63, 0x03, 0xad, 0x41, 0x5d,
+	0x73, 0x5b, 0xb6, 0x7b, 0x5b, 0x45, 0xf6, 0xaa, 0x63, 0x5a, 0x5e, 0x95, 0x75, 0xd3, 0x88, 0x08,
+	0xf3, 0x26, 0xac, 0xc1, 0x7c, 0x88, 0x7a, 0x68, 0x9b, 0x5d, 0x3a, 0x9c, 0x9d, 0xbc, 0x22, 0xe4,
+	0x83, 0x1e, 0x81, 0x9c, 0x06, 0x65, 0x94, 0xd7, 0xa1, 0x6c, 0x31, 0x9b, 0x8f, 0x5b, 0x69, 0x2e,
+	0x70, 0xad, 0x70, 0x10, 0xa2, 0x44, 0xfb, 0xa2, 0x68, 0xfc, 0x89, 0x8e, 0xde, 0x96, 0x71, 0x4e,
+	0x7a, 0x37, 0x55, 0x81, 0x5c, 0xa2, 0xcd, 0x3f, 0x25, 0x78, 0x98, 0x52, 0x32, 0xb4, 0x01, 0x63,
+	0x9e, 0x9a, 0xa1, 0x17, 0x37, 0xd4, 0x3b, 0xa5, 0xca, 0x05, 0x6e, 0x5f, 0x5a, 0xee, 0x00, 0x9d,
+	0x81, 0xcc, 0x3f, 0x95, 0x3e, 0xda, 0xe6, 0x65, 0xb8, 0x17, 0x2d, 0xa6, 0x66, 0xb9, 0xf0, 0x18,
+	0x54, 0x9e, 0x0c, 0xf5, 0xb3, 0x12, 0xaa, 0x30, 0x2d, 0xbc, 0x68, 0x10, 0xbf, 0x23, 0xeb, 0x4d,
+	0xa5, 0x2c, 0x0d, 0x0f, 0x60, 0x98, 0xc7, 0x30, 0x23, 0x7e, 0x66, 0x68, 0x29, 0xef, 0xc9, 0xa1,
+	0x2c, 0x8f, 0x88, 0x60, 0xb0, 0x5b, 0x30, 0x23, 0x3e, 0x09, 0x04, 0xd8, 0xcc, 0xd7, 0x42, 0x46,
+	0x35, 0xf7, 0xa1, 0xc2, 0x4d, 0x6b, 0xf4, 0x38, 0xf3, 0x34, 0xe1, 0x48, 0x56, 0x16, 0x87, 0xb9,
+	0x19, 0x27, 0x8a, 0xa6, 0x0d, 0x41, 0xd3, 0x46, 0xa3, 0x65, 0x4d, 0x62, 0x5a, 0x38, 0x71, 0x18,
+	0x08, 0x27, 0xcc, 0x1c, 0x5b, 0x42, 0xe1, 0x86, 0x4c, 0x92, 0x76, 0xf8, 0x47, 0x41, 0x90, 0x3d,
+	0xf4, 0x6c, 0x68, 0xc9, 0x79, 0x19, 0x56, 0x9e, 0xe7, 0x85, 0xb1, 0x2c, 0xa7, 0xf0, 0x20, 0xa1,
+	0x57, 0x68, 0x59, 0xac, 0x5e, 0x86, 0x3a, 0x2a, 0x78, 0x54, 0x48, 0x80, 0xdc, 0xbc, 0x2e, 0x40,
+	0x2d, 0xf5, 0xcd, 0x9c, 0x34, 0x91, 0x06, 0xd3, 0x82, 0x94, 0xdd, 0xa0, 0xcd, 0xf8, 0x88, 0x6c,
+	0x19, 0x3c, 0x83, 0x6a, 0x52, 0x6f, 0x10, 0xce, 0x18, 0x30, 0x09, 0x85, 0x53, 0x9e, 0x8e, 0x8c,
+	0x89, 0x5b, 0x58, 0x94, 0x1d, 0x91, 0x72, 0x96, 0x22, 0xa5, 0x5b, 0xf8, 0x6b, 0xc9, 0xff, 0x03,
+	0xb8, 0xf6, 0x0f, 0xb0, 0x5a, 0xc1, 0x77, 0x34, 0x0e, 0x00, 0x00,
 }
@@ -4,6 +4,8 @@ package mocks
 
 import (
 	mock "github.com/stretchr/testify/mock"
+	clientmgmt "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+
 	velero "github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
@@ -109,6 +111,52 @@ func (_m *Manager) GetDeleteItemActions() ([]velero.DeleteItemAction, error) {
 	return r0, r1
 }
 
+// GetItemBlockAction provides a mock function with given fields: name
+func (_m *Manager) GetItemBlockAction(name string) (velero.ItemBlockAction, error) {
+	ret := _m.Called(name)
+
+	var r0 velero.ItemBlockAction
+	if rf, ok := ret.Get(0).(func(string) velero.ItemBlockAction); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(velero.ItemBlockAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItemBlockActions provides a mock function with given fields:
+func (_m *Manager) GetItemBlockActions() ([]velero.ItemBlockAction, error) {
+	ret := _m.Called()
+
+	var r0 []velero.ItemBlockAction
+	if rf, ok := ret.Get(0).(func() []velero.ItemBlockAction); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]velero.ItemBlockAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetObjectStore provides a mock function with given fields: name
 func (_m *Manager) GetObjectStore(name string) (velero.ObjectStore, error) {
 	ret := _m.Called(name)
@@ -132,6 +180,52 @@ func (_m *Manager) GetObjectStore(name string) (velero.ObjectStore, error) {
 	return r0, r1
 }
 
+// GetPostRestoreAction provides a mock function with given fields: name
+func (_m *Manager) GetPostRestoreAction(name string) (velero.PostRestoreAction, error) {
+	ret := _m.Called(name)
+
+	var r0 velero.PostRestoreAction
+	if rf, ok := ret.Get(0).(func(string) velero.PostRestoreAction); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(velero.PostRestoreAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPostRestoreActions provides a mock function with given fields:
+func (_m *Manager) GetPostRestoreActions() ([]velero.PostRestoreAction, error) {
+	ret := _m.Called()
+
+	var r0 []velero.PostRestoreAction
+	if rf, ok := ret.Get(0).(func() []velero.PostRestoreAction); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]velero.PostRestoreAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRestoreItemAction provides a mock function with given fields: name
 func (_m *Manager) GetRestoreItemAction(name string) (velero.RestoreItemAction, error) {
 	ret := _m.Called(name)
@@ -200,3 +294,19 @@ func (_m *Manager) GetVolumeSnapshotter(name string) (velero.VolumeSnapshotter,
 
 	return r0, r1
 }
+
+// WithEnvironment provides a mock function with given fields: env
+func (_m *Manager) WithEnvironment(env map[string]string) clientmgmt.Manager {
+	ret := _m.Called(env)
+
+	var r0 clientmgmt.Manager
+	if rf, ok := ret.Get(0).(func(map[string]string) clientmgmt.Manager); ok {
+		r0 = rf(env)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(clientmgmt.Manager)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,30 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package testing is a harness for testing Velero plugins without a cluster. It runs
+// conformance tests against ObjectStore, VolumeSnapshotter, BackupItemAction and
+// RestoreItemAction implementations, and provides an in-memory ObjectStore and helpers
+// for comparing the items that item actions return with golden files.
+//
+// The tests are meant to be run from a plugin's own Go tests:
+//
+//	func TestObjectStoreConformance(t *testing.T) {
+//		objectStore := NewObjectStore(logrus.New())
+//		require.NoError(t, objectStore.Init(config))
+//
+//		plugintesting.TestObjectStore(t, objectStore, "my-bucket")
+//	}
+package testing
@@ -0,0 +1,99 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	gotesting "testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// UpdateGoldenFilesEnvVar is the environment variable that makes AssertGoldenItem write
+// the items it's given to their golden files instead of comparing them, if it's "true".
+// It's used to create or update the golden files after an action's output changed on purpose:
+//
+//	VELERO_UPDATE_GOLDEN_FILES=true go test ./...
+const UpdateGoldenFilesEnvVar = "VELERO_UPDATE_GOLDEN_FILES"
+
+// LoadItem reads an item from a YAML or JSON file, like a backed up item in a plugin's
+// testdata directory.
+func LoadItem(t *gotesting.T, path string) *unstructured.Unstructured {
+	t.Helper()
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err, "error reading item file")
+
+	jsonData, err := yaml.ToJSON(data)
+	require.NoError(t, err, "error converting item file %s to JSON", path)
+
+	item := new(unstructured.Unstructured)
+	require.NoError(t, item.UnmarshalJSON(jsonData), "error decoding item file %s", path)
+
+	return item
+}
+
+// AssertGoldenItem asserts that item is the item in the golden YAML or JSON file at path.
+// If UpdateGoldenFilesEnvVar is "true", item is written to the file instead.
+func AssertGoldenItem(t *gotesting.T, path string, item runtime.Unstructured) bool {
+	t.Helper()
+
+	if os.Getenv(UpdateGoldenFilesEnvVar) == "true" {
+		data, err := json.MarshalIndent(item.UnstructuredContent(), "", "  ")
+		require.NoError(t, err, "error encoding item")
+		require.NoError(t, ioutil.WriteFile(path, append(data, '\n'), 0644), "error writing golden file")
+		return true
+	}
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err, "error reading golden file (set %s=true to create it)", UpdateGoldenFilesEnvVar)
+
+	expected, err := yaml.ToJSON(data)
+	require.NoError(t, err, "error converting golden file %s to JSON", path)
+
+	return assertItemJSON(t, expected, item, "item doesn't match golden file %s", path)
+}
+
+// assertItemEqual asserts that two items have the same content, regardless of the Go types
+// that their content's numbers are represented with.
+func assertItemEqual(t *gotesting.T, expected, actual runtime.Unstructured, msgAndArgs ...interface{}) bool {
+	t.Helper()
+
+	expectedJSON, err := json.Marshal(expected.UnstructuredContent())
+	require.NoError(t, err, "error encoding expected item")
+
+	return assertItemJSON(t, expectedJSON, actual, msgAndArgs...)
+}
+
+func assertItemJSON(t *gotesting.T, expected []byte, actual runtime.Unstructured, msgAndArgs ...interface{}) bool {
+	t.Helper()
+
+	if !assert.NotNil(t, actual, msgAndArgs...) {
+		return false
+	}
+
+	actualJSON, err := json.Marshal(actual.UnstructuredContent())
+	require.NoError(t, err, "error encoding item")
+
+	return assert.JSONEq(t, string(expected), string(actualJSON), msgAndArgs...)
+}
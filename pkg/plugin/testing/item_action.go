@@ -0,0 +1,275 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	gotesting "testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// BackupItemActionTest is a test case of TestBackupItemAction.
+type BackupItemActionTest struct {
+	// Name is the name of the test case's subtest.
+	Name string
+
+	// Item is the item being backed up.
+	Item runtime.Unstructured
+
+	// Backup is the backup that the item is backed up in. If it's nil, a backup named
+	// "backup-1" in the "velero" namespace is used.
+	Backup *velerov1api.Backup
+
+	// ExpectedItem is the item that Execute should return. If it and GoldenFile are
+	// both unset, Execute should return Item unchanged.
+	ExpectedItem runtime.Unstructured
+
+	// GoldenFile is the path of a YAML or JSON file with the item that Execute should
+	// return. See AssertGoldenItem.
+	GoldenFile string
+
+	// ExpectedAdditionalItems are the additional items that Execute should return.
+	ExpectedAdditionalItems []velero.ResourceIdentifier
+
+	// ExpectedError is the error that Execute should return, if any.
+	ExpectedError string
+}
+
+// TestBackupItemAction runs test cases against a BackupItemAction, each of which executes
+// the action for an item and checks the item and additional items that it returns.
+func TestBackupItemAction(t *gotesting.T, action velero.BackupItemAction, tests ...BackupItemActionTest) {
+	t.Run("AppliesTo", func(t *gotesting.T) {
+		assertValidResourceSelector(t, action.AppliesTo)
+	})
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *gotesting.T) {
+			backup := test.Backup
+			if backup == nil {
+				backup = defaultBackup()
+			}
+
+			item, additionalItems, err := action.Execute(test.Item.DeepCopyObject().(runtime.Unstructured), backup)
+			if test.ExpectedError != "" {
+				assert.EqualError(t, err, test.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			assertExpectedItem(t, test.Item, test.ExpectedItem, test.GoldenFile, item)
+			assert.Equal(t, test.ExpectedAdditionalItems, additionalItems)
+		})
+	}
+}
+
+// RestoreItemActionTest is a test case of TestRestoreItemAction.
+type RestoreItemActionTest struct {
+	// Name is the name of the test case's subtest.
+	Name string
+
+	// Item is the item being restored.
+	Item runtime.Unstructured
+
+	// ItemFromBackup is the item as it was backed up. If it's nil, Item is used.
+	ItemFromBackup runtime.Unstructured
+
+	// Restore is the restore that the item is restored in. If it's nil, a restore named
+	// "restore-1" of "backup-1" in the "velero" namespace is used.
+	Restore *velerov1api.Restore
+
+	// ExpectedItem is the item that Execute should return. If it and GoldenFile are
+	// both unset, Execute should return Item unchanged.
+	ExpectedItem runtime.Unstructured
+
+	// GoldenFile is the path of a YAML or JSON file with the item that Execute should
+	// return. See AssertGoldenItem.
+	GoldenFile string
+
+	// ExpectedAdditionalItems are the additional items that Execute should return.
+	ExpectedAdditionalItems []velero.ResourceIdentifier
+
+	// ExpectedSkipRestore is whether Execute should skip restoring the item.
+	ExpectedSkipRestore bool
+
+	// ExpectedError is the error that Execute should return, if any.
+	ExpectedError string
+}
+
+// TestRestoreItemAction runs test cases against a RestoreItemAction, each of which executes
+// the action for an item and checks its output.
+func TestRestoreItemAction(t *gotesting.T, action velero.RestoreItemAction, tests ...RestoreItemActionTest) {
+	t.Run("AppliesTo", func(t *gotesting.T) {
+		assertValidResourceSelector(t, action.AppliesTo)
+	})
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *gotesting.T) {
+			itemFromBackup := test.ItemFromBackup
+			if itemFromBackup == nil {
+				itemFromBackup = test.Item
+			}
+			restore := test.Restore
+			if restore == nil {
+				restore = defaultRestore()
+			}
+
+			output, err := action.Execute(&velero.RestoreItemActionExecuteInput{
+				Item:           test.Item.DeepCopyObject().(runtime.Unstructured),
+				ItemFromBackup: itemFromBackup.DeepCopyObject().(runtime.Unstructured),
+				Restore:        restore,
+			})
+			if test.ExpectedError != "" {
+				assert.EqualError(t, err, test.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, output, "Execute should return an output")
+
+			assert.Equal(t, test.ExpectedSkipRestore, output.SkipRestore)
+			if !output.SkipRestore {
+				assertExpectedItem(t, test.Item, test.ExpectedItem, test.GoldenFile, output.UpdatedItem)
+				assert.Equal(t, test.ExpectedAdditionalItems, output.AdditionalItems)
+			}
+		})
+	}
+}
+
+// RoundTripTest is a test case of TestRoundTrip.
+type RoundTripTest struct {
+	// Name is the name of the test case's subtest.
+	Name string
+
+	// Item is the item in the cluster that's backed up.
+	Item runtime.Unstructured
+
+	// Backup is the backup that the item is backed up in. If it's nil, a backup named
+	// "backup-1" in the "velero" namespace is used.
+	Backup *velerov1api.Backup
+
+	// Restore is the restore that the item is restored in. If it's nil, a restore named
+	// "restore-1" of "backup-1" in the "velero" namespace is used.
+	Restore *velerov1api.Restore
+
+	// ExpectedItem is the item that's restored. If it and GoldenFile are both unset, the
+	// item that's restored should be Item.
+	ExpectedItem runtime.Unstructured
+
+	// GoldenFile is the path of a YAML or JSON file with the item that's restored. See
+	// AssertGoldenItem.
+	GoldenFile string
+}
+
+// TestRoundTrip runs test cases that back up an item with a BackupItemAction, restore the
+// item that was backed up with a RestoreItemAction, and check the item that's restored.
+// It's for pairs of actions that change items when they're backed up and change them
+// back when they're restored.
+func TestRoundTrip(t *gotesting.T, backupAction velero.BackupItemAction, restoreAction velero.RestoreItemAction, tests ...RoundTripTest) {
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *gotesting.T) {
+			backup := test.Backup
+			if backup == nil {
+				backup = defaultBackup()
+			}
+			restore := test.Restore
+			if restore == nil {
+				restore = defaultRestore()
+			}
+
+			backedUp, _, err := backupAction.Execute(test.Item.DeepCopyObject().(runtime.Unstructured), backup)
+			require.NoError(t, err, "error backing up item")
+			require.NotNil(t, backedUp, "backup item action should return the item")
+
+			output, err := restoreAction.Execute(&velero.RestoreItemActionExecuteInput{
+				Item:           backedUp.DeepCopyObject().(runtime.Unstructured),
+				ItemFromBackup: backedUp.DeepCopyObject().(runtime.Unstructured),
+				Restore:        restore,
+			})
+			require.NoError(t, err, "error restoring item")
+			require.NotNil(t, output, "restore item action should return an output")
+			require.False(t, output.SkipRestore, "restore item action shouldn't skip restoring the item")
+
+			assertExpectedItem(t, test.Item, test.ExpectedItem, test.GoldenFile, output.UpdatedItem)
+		})
+	}
+}
+
+// assertExpectedItem asserts that an action returned the item that its test case expected:
+// the item in the test case's golden file, its expected item, or otherwise the item that
+// the action was executed for.
+func assertExpectedItem(t *gotesting.T, item, expectedItem runtime.Unstructured, goldenFile string, actual runtime.Unstructured) bool {
+	t.Helper()
+
+	switch {
+	case goldenFile != "":
+		return AssertGoldenItem(t, goldenFile, actual)
+	case expectedItem != nil:
+		return assertItemEqual(t, expectedItem, actual)
+	default:
+		return assertItemEqual(t, item, actual, "item should be unchanged")
+	}
+}
+
+// assertValidResourceSelector asserts that an action's AppliesTo returns a selector whose
+// label selector can be parsed.
+func assertValidResourceSelector(t *gotesting.T, appliesTo func() (velero.ResourceSelector, error)) {
+	t.Helper()
+
+	selector, err := appliesTo()
+	require.NoError(t, err)
+
+	_, err = labels.Parse(selector.LabelSelector)
+	assert.NoError(t, err, "AppliesTo should return a valid label selector")
+}
+
+func defaultBackup() *velerov1api.Backup {
+	return &velerov1api.Backup{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: velerov1api.SchemeGroupVersion.String(),
+			Kind:       "Backup",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      "backup-1",
+		},
+	}
+}
+
+func defaultRestore() *velerov1api.Restore {
+	return &velerov1api.Restore{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: velerov1api.SchemeGroupVersion.String(),
+			Kind:       "Restore",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      "restore-1",
+		},
+		Spec: velerov1api.RestoreSpec{
+			BackupName: "backup-1",
+		},
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	gotesting "testing"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+const backupAnnotation = "example.io/backup"
+
+// annotatingAction is a backup item action that annotates pods with the name of their
+// backup, and a restore item action that removes the annotation.
+type annotatingAction struct{}
+
+func (a *annotatingAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"pods"},
+		LabelSelector:     "app=example",
+	}, nil
+}
+
+func (a *annotatingAction) Execute(item runtime.Unstructured, backup *velerov1api.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+	pod := &unstructured.Unstructured{Object: item.UnstructuredContent()}
+	if pod.GetName() == "" {
+		return nil, nil, errors.New("pod has no name")
+	}
+
+	annotations := pod.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[backupAnnotation] = backup.Name
+	pod.SetAnnotations(annotations)
+
+	return pod, []velero.ResourceIdentifier{
+		{GroupResource: schema.GroupResource{Resource: "namespaces"}, Name: pod.GetNamespace()},
+	}, nil
+}
+
+type removeAnnotationAction struct{}
+
+func (a *removeAnnotationAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{IncludedResources: []string{"pods"}}, nil
+}
+
+func (a *removeAnnotationAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	pod := &unstructured.Unstructured{Object: input.Item.UnstructuredContent()}
+	if pod.GetLabels()["skip"] == "true" {
+		return velero.NewRestoreItemActionExecuteOutput(pod).WithoutRestore(), nil
+	}
+
+	annotations := pod.GetAnnotations()
+	delete(annotations, backupAnnotation)
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	pod.SetAnnotations(annotations)
+
+	return velero.NewRestoreItemActionExecuteOutput(pod), nil
+}
+
+func TestBackupItemActionHarness(t *gotesting.T) {
+	pod := LoadItem(t, "testdata/pod.yaml")
+	expected := LoadItem(t, "testdata/pod-backed-up.yaml")
+
+	TestBackupItemAction(t, new(annotatingAction),
+		BackupItemActionTest{
+			Name:       "pod is annotated with the name of its backup",
+			Item:       pod,
+			GoldenFile: "testdata/pod-backed-up.yaml",
+			ExpectedAdditionalItems: []velero.ResourceIdentifier{
+				{GroupResource: schema.GroupResource{Resource: "namespaces"}, Name: "ns-1"},
+			},
+		},
+		BackupItemActionTest{
+			Name:         "expected item can be given instead of a golden file",
+			Item:         pod,
+			ExpectedItem: expected,
+			ExpectedAdditionalItems: []velero.ResourceIdentifier{
+				{GroupResource: schema.GroupResource{Resource: "namespaces"}, Name: "ns-1"},
+			},
+		},
+		BackupItemActionTest{
+			Name:          "error from Execute is expected",
+			Item:          &unstructured.Unstructured{Object: map[string]interface{}{}},
+			ExpectedError: "pod has no name",
+		},
+	)
+}
+
+func TestRestoreItemActionHarness(t *gotesting.T) {
+	pod := LoadItem(t, "testdata/pod.yaml")
+	skipped := pod.DeepCopy()
+	skipped.SetLabels(map[string]string{"skip": "true"})
+
+	TestRestoreItemAction(t, new(removeAnnotationAction),
+		RestoreItemActionTest{
+			Name:         "annotation is removed from backed up pod",
+			Item:         LoadItem(t, "testdata/pod-backed-up.yaml"),
+			ExpectedItem: pod,
+		},
+		RestoreItemActionTest{
+			Name: "pod without annotation is unchanged",
+			Item: pod,
+		},
+		RestoreItemActionTest{
+			Name:                "pod is skipped",
+			Item:                skipped,
+			ExpectedSkipRestore: true,
+		},
+	)
+}
+
+func TestRoundTripHarness(t *gotesting.T) {
+	TestRoundTrip(t, new(annotatingAction), new(removeAnnotationAction),
+		RoundTripTest{
+			Name: "pod is restored as it was backed up",
+			Item: LoadItem(t, "testdata/pod.yaml"),
+		},
+	)
+}
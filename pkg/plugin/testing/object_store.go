@@ -0,0 +1,360 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"bytes"
+	"crypto/md5"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+	gotesting "testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// ObjectStore is an in-memory velero.ObjectStore, for testing plugins and other code that
+// use object stores without a real one. It's safe for concurrent use.
+type ObjectStore struct {
+	lock    sync.Mutex
+	buckets map[string]map[string][]byte
+}
+
+// NewObjectStore returns a new ObjectStore with the given empty buckets.
+func NewObjectStore(buckets ...string) *ObjectStore {
+	o := &ObjectStore{
+		buckets: make(map[string]map[string][]byte),
+	}
+
+	for _, bucket := range buckets {
+		o.buckets[bucket] = make(map[string][]byte)
+	}
+
+	return o
+}
+
+// Init is a no-op.
+func (o *ObjectStore) Init(config map[string]string) error {
+	return nil
+}
+
+func (o *ObjectStore) bucket(bucket string) (map[string][]byte, error) {
+	objects, ok := o.buckets[bucket]
+	if !ok {
+		return nil, errors.Errorf("bucket %s not found", bucket)
+	}
+	return objects, nil
+}
+
+func (o *ObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	objects, err := o.bucket(bucket)
+	if err != nil {
+		return err
+	}
+	objects[key] = data
+
+	return nil
+}
+
+func (o *ObjectStore) ObjectExists(bucket, key string) (bool, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	objects, err := o.bucket(bucket)
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := objects[key]
+	return ok, nil
+}
+
+func (o *ObjectStore) GetObject(bucket, key string) (io.ReadCloser, error) {
+	return o.GetObjectRange(bucket, key, 0)
+}
+
+func (o *ObjectStore) GetObjectRange(bucket, key string, offset int64) (io.ReadCloser, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	objects, err := o.bucket(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := objects[key]
+	if !ok {
+		return nil, errors.Errorf("key %s not found", key)
+	}
+	if offset > int64(len(data)) {
+		return nil, errors.Errorf("object is shorter than offset %d", offset)
+	}
+
+	return ioutil.NopCloser(bytes.NewReader(data[offset:])), nil
+}
+
+func (o *ObjectStore) ListCommonPrefixes(bucket, prefix, delimiter string) ([]string, error) {
+	keys, err := o.ListObjects(bucket, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var prefixes []string
+	for _, key := range keys {
+		i := strings.Index(key[len(prefix):], delimiter)
+		if i == -1 {
+			continue
+		}
+
+		commonPrefix := key[:len(prefix)+i+len(delimiter)]
+		if len(prefixes) == 0 || prefixes[len(prefixes)-1] != commonPrefix {
+			prefixes = append(prefixes, commonPrefix)
+		}
+	}
+
+	return prefixes, nil
+}
+
+func (o *ObjectStore) ListObjects(bucket, prefix string) ([]string, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	objects, err := o.bucket(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for key := range objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
+// ListObjectsPage lists a page of the objects with the prefix. The objects' ETags are
+// the MD5 sums of their data.
+func (o *ObjectStore) ListObjectsPage(bucket, prefix, startAfter string, limit int) ([]velero.ObjectInfo, string, error) {
+	keys, err := o.ListObjects(bucket, prefix)
+	if err != nil {
+		return nil, "", err
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	objects, err := o.bucket(bucket)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var page []velero.ObjectInfo
+	for _, key := range keys {
+		if key <= startAfter {
+			continue
+		}
+		if len(page) == limit {
+			return page, page[len(page)-1].Key, nil
+		}
+
+		data, ok := objects[key]
+		if !ok {
+			// deleted since it was listed
+			continue
+		}
+		page = append(page, velero.ObjectInfo{Key: key, ETag: fmt.Sprintf("%x", md5.Sum(data))})
+	}
+
+	return page, "", nil
+}
+
+func (o *ObjectStore) DeleteObject(bucket, key string) error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	objects, err := o.bucket(bucket)
+	if err != nil {
+		return err
+	}
+	delete(objects, key)
+
+	return nil
+}
+
+func (o *ObjectStore) CreateSignedURL(bucket, key string, ttl time.Duration) (string, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	objects, err := o.bucket(bucket)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := objects[key]; !ok {
+		return "", errors.Errorf("key %s not found", key)
+	}
+
+	return fmt.Sprintf("memory://%s/%s?ttl=%v", bucket, key, ttl), nil
+}
+
+// objectStoreTestData is the data of the objects that TestObjectStore puts. It's larger
+// than the chunks that object data is streamed to and from plugins in, so that objects
+// span several of them.
+var objectStoreTestData = bytes.Repeat([]byte("velero-object-store-conformance\n"), 128*1024)
+
+// TestObjectStore runs conformance tests against an initialized ObjectStore, which puts,
+// gets, lists and deletes objects in bucket. The objects' keys have a prefix that's
+// unique to the test run, and they're deleted when the tests finish, so an existing
+// bucket of a real object store can be used.
+func TestObjectStore(t *gotesting.T, objectStore velero.ObjectStore, bucket string) {
+	prefix := fmt.Sprintf("velero-plugin-conformance-%d/", time.Now().UnixNano())
+	keys := []string{
+		prefix + "backups/backup-1/backup-1.tar.gz",
+		prefix + "backups/backup-1/velero-backup.json",
+		prefix + "backups/backup-2/velero-backup.json",
+		prefix + "restores/restore-1/restore-1-logs.gz",
+	}
+
+	defer func() {
+		for _, key := range keys {
+			if err := objectStore.DeleteObject(bucket, key); err != nil {
+				t.Logf("Error deleting object %s: %v", key, err)
+			}
+		}
+	}()
+
+	if !t.Run("PutObject", func(t *gotesting.T) {
+		for _, key := range keys {
+			require.NoError(t, objectStore.PutObject(bucket, key, bytes.NewReader(objectStoreTestData)), "error putting object %s", key)
+		}
+	}) {
+		return
+	}
+
+	t.Run("ObjectExists", func(t *gotesting.T) {
+		exists, err := objectStore.ObjectExists(bucket, keys[0])
+		require.NoError(t, err)
+		assert.True(t, exists, "object that was put should exist")
+
+		exists, err = objectStore.ObjectExists(bucket, prefix+"missing")
+		require.NoError(t, err)
+		assert.False(t, exists, "object that wasn't put shouldn't exist")
+	})
+
+	t.Run("GetObject", func(t *gotesting.T) {
+		body, err := objectStore.GetObject(bucket, keys[0])
+		require.NoError(t, err)
+		defer body.Close()
+
+		data, err := ioutil.ReadAll(body)
+		require.NoError(t, err)
+		assert.True(t, bytes.Equal(objectStoreTestData, data), "object's data should be the data that was put")
+
+		_, err = objectStore.GetObject(bucket, prefix+"missing")
+		assert.Error(t, err, "getting an object that wasn't put should fail")
+	})
+
+	t.Run("GetObjectRange", func(t *gotesting.T) {
+		offset := int64(len(objectStoreTestData) / 3)
+		body, err := velero.GetObjectRange(objectStore, bucket, keys[0], offset)
+		require.NoError(t, err)
+		defer body.Close()
+
+		data, err := ioutil.ReadAll(body)
+		require.NoError(t, err)
+		assert.True(t, bytes.Equal(objectStoreTestData[offset:], data), "object's data from offset should be the data that was put from offset")
+	})
+
+	t.Run("ListObjects", func(t *gotesting.T) {
+		res, err := objectStore.ListObjects(bucket, prefix+"backups/")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, keys[:3], res)
+
+		res, err = objectStore.ListObjects(bucket, prefix+"missing/")
+		require.NoError(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("ListObjectsPage", func(t *gotesting.T) {
+		var (
+			listed     []string
+			startAfter string
+		)
+		for {
+			page, next, err := velero.ListObjectsPage(objectStore, bucket, prefix+"backups/", startAfter, 2)
+			require.NoError(t, err)
+			if _, ok := objectStore.(velero.ObjectPageLister); ok {
+				assert.True(t, len(page) <= 2, "page should have at most 2 objects")
+			}
+
+			for _, object := range page {
+				listed = append(listed, object.Key)
+			}
+			if next == "" {
+				break
+			}
+			startAfter = next
+		}
+		assert.Equal(t, keys[:3], listed)
+	})
+
+	t.Run("ListCommonPrefixes", func(t *gotesting.T) {
+		res, err := objectStore.ListCommonPrefixes(bucket, prefix, "/")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{prefix + "backups/", prefix + "restores/"}, res)
+
+		res, err = objectStore.ListCommonPrefixes(bucket, prefix+"backups/", "/")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{prefix + "backups/backup-1/", prefix + "backups/backup-2/"}, res)
+	})
+
+	t.Run("CreateSignedURL", func(t *gotesting.T) {
+		url, err := objectStore.CreateSignedURL(bucket, keys[0], time.Minute)
+		require.NoError(t, err)
+		assert.NotEmpty(t, url)
+	})
+
+	t.Run("DeleteObject", func(t *gotesting.T) {
+		require.NoError(t, objectStore.DeleteObject(bucket, keys[3]))
+
+		exists, err := objectStore.ObjectExists(bucket, keys[3])
+		require.NoError(t, err)
+		assert.False(t, exists, "object that was deleted shouldn't exist")
+
+		res, err := objectStore.ListCommonPrefixes(bucket, prefix, "/")
+		require.NoError(t, err)
+		assert.Equal(t, []string{prefix + "backups/"}, res)
+	})
+}
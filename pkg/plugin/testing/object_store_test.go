@@ -0,0 +1,47 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"bytes"
+	gotesting "testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectStoreHarness(t *gotesting.T) {
+	objectStore := NewObjectStore("bucket-1")
+
+	TestObjectStore(t, objectStore, "bucket-1")
+
+	keys, err := objectStore.ListObjects("bucket-1", "")
+	require.NoError(t, err)
+	assert.Empty(t, keys, "conformance tests should delete the objects they put")
+}
+
+func TestObjectStoreMissingBucket(t *gotesting.T) {
+	objectStore := NewObjectStore("bucket-1")
+
+	assert.EqualError(t, objectStore.PutObject("bucket-2", "key", bytes.NewReader(nil)), "bucket bucket-2 not found")
+
+	_, err := objectStore.ObjectExists("bucket-2", "key")
+	assert.EqualError(t, err, "bucket bucket-2 not found")
+
+	_, err = objectStore.ListObjects("bucket-2", "")
+	assert.EqualError(t, err, "bucket bucket-2 not found")
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	gotesting "testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+const (
+	defaultSnapshotTimeout      = 10 * time.Minute
+	defaultSnapshotPollInterval = 5 * time.Second
+)
+
+// VolumeSnapshotterTest describes the volume that TestVolumeSnapshotter snapshots.
+type VolumeSnapshotterTest struct {
+	// PersistentVolume is the persistent volume of a volume that the volume snapshotter
+	// supports.
+	PersistentVolume runtime.Unstructured
+
+	// VolumeAZ is the availability zone of the volume.
+	VolumeAZ string
+
+	// Tags are the tags of the volume's snapshot.
+	Tags map[string]string
+
+	// SkipCreateVolume skips creating a volume from the snapshot. The VolumeSnapshotter
+	// API can't delete volumes, so the volume that's created from the snapshot of a
+	// real provider's volume has to be deleted after the test.
+	SkipCreateVolume bool
+
+	// SnapshotTimeout is how long to wait for the snapshot of a version 2 volume
+	// snapshotter to complete. The default is 10 minutes.
+	SnapshotTimeout time.Duration
+
+	// SnapshotPollInterval is how often the progress of the snapshot of a version 2
+	// volume snapshotter is checked. The default is 5 seconds.
+	SnapshotPollInterval time.Duration
+}
+
+// TestVolumeSnapshotter runs conformance tests against an initialized VolumeSnapshotter,
+// which snapshot the test's volume, create a volume from the snapshot, and delete the
+// snapshot. A VolumeSnapshotterV2 starts the snapshot and the tests wait for it to complete.
+func TestVolumeSnapshotter(t *gotesting.T, volumeSnapshotter velero.VolumeSnapshotter, test VolumeSnapshotterTest) {
+	var (
+		volumeID, volumeType, snapshotID string
+		iops                             *int64
+	)
+
+	if !t.Run("GetVolumeID", func(t *gotesting.T) {
+		var err error
+		volumeID, err = volumeSnapshotter.GetVolumeID(test.PersistentVolume)
+		require.NoError(t, err)
+		require.NotEmpty(t, volumeID, "volume snapshotter should support the persistent volume")
+	}) {
+		return
+	}
+
+	if !t.Run("GetVolumeInfo", func(t *gotesting.T) {
+		var err error
+		volumeType, iops, err = volumeSnapshotter.GetVolumeInfo(volumeID, test.VolumeAZ)
+		require.NoError(t, err)
+	}) {
+		return
+	}
+
+	if !t.Run("CreateSnapshot", func(t *gotesting.T) {
+		var err error
+		snapshotID, err = createSnapshot(t, volumeSnapshotter, volumeID, test)
+		require.NoError(t, err)
+		require.NotEmpty(t, snapshotID, "snapshot should have an ID")
+	}) {
+		return
+	}
+
+	if sizer, ok := volumeSnapshotter.(velero.SnapshotSizer); ok {
+		t.Run("GetSnapshotSize", func(t *gotesting.T) {
+			size, err := sizer.GetSnapshotSize(snapshotID)
+			if err == velero.ErrSnapshotSizeNotSupported {
+				t.Skip("volume snapshotter doesn't support reporting snapshot sizes")
+			}
+			require.NoError(t, err)
+			assert.True(t, size >= 0, "snapshot size shouldn't be negative")
+		})
+	}
+
+	if !test.SkipCreateVolume {
+		t.Run("CreateVolumeFromSnapshot", func(t *gotesting.T) {
+			newVolumeID, err := volumeSnapshotter.CreateVolumeFromSnapshot(snapshotID, volumeType, test.VolumeAZ, iops)
+			require.NoError(t, err)
+			require.NotEmpty(t, newVolumeID, "volume created from snapshot should have an ID")
+
+			pv, err := volumeSnapshotter.SetVolumeID(test.PersistentVolume.DeepCopyObject().(runtime.Unstructured), newVolumeID)
+			require.NoError(t, err)
+
+			pvVolumeID, err := volumeSnapshotter.GetVolumeID(pv)
+			require.NoError(t, err)
+			assert.Equal(t, newVolumeID, pvVolumeID, "persistent volume should have the volume ID that was set")
+		})
+	}
+
+	t.Run("DeleteSnapshot", func(t *gotesting.T) {
+		require.NoError(t, volumeSnapshotter.DeleteSnapshot(snapshotID))
+	})
+}
+
+// createSnapshot takes a snapshot of a volume. If the volume snapshotter is a
+// VolumeSnapshotterV2, it starts the snapshot and waits for it to complete.
+func createSnapshot(t *gotesting.T, volumeSnapshotter velero.VolumeSnapshotter, volumeID string, test VolumeSnapshotterTest) (string, error) {
+	volumeSnapshotterV2, ok := volumeSnapshotter.(velero.VolumeSnapshotterV2)
+	if !ok {
+		return volumeSnapshotter.CreateSnapshot(volumeID, test.VolumeAZ, test.Tags)
+	}
+
+	snapshotID, operationID, err := volumeSnapshotterV2.StartSnapshot(volumeID, test.VolumeAZ, test.Tags)
+	if err != nil || operationID == "" {
+		return snapshotID, err
+	}
+
+	timeout := test.SnapshotTimeout
+	if timeout == 0 {
+		timeout = defaultSnapshotTimeout
+	}
+	interval := test.SnapshotPollInterval
+	if interval == 0 {
+		interval = defaultSnapshotPollInterval
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		progress, err := volumeSnapshotterV2.SnapshotProgress(operationID)
+		if err != nil {
+			return "", errors.WithMessage(err, "error getting progress of snapshot")
+		}
+		if progress.Completed {
+			if progress.Err != "" {
+				return "", errors.Errorf("snapshot failed: %s", progress.Err)
+			}
+			return snapshotID, nil
+		}
+
+		if time.Now().After(deadline) {
+			if err := volumeSnapshotterV2.CancelSnapshot(operationID); err != nil {
+				t.Logf("Error cancelling snapshot: %v", err)
+			}
+			return "", errors.Errorf("timed out after %v waiting for snapshot to complete", timeout)
+		}
+
+		t.Logf("Waiting for snapshot to complete (%d/%d %s)", progress.NCompleted, progress.NTotal, progress.OperationUnits)
+		time.Sleep(interval)
+	}
+}
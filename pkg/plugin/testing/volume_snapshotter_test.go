@@ -0,0 +1,175 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"fmt"
+	gotesting "testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// memoryVolumeSnapshotter is a volume snapshotter of volumes whose IDs are in their
+// persistent volume's spec.volumeID.
+type memoryVolumeSnapshotter struct {
+	volumes   map[string]bool
+	snapshots map[string]string
+}
+
+func newMemoryVolumeSnapshotter(volumeIDs ...string) *memoryVolumeSnapshotter {
+	vs := &memoryVolumeSnapshotter{
+		volumes:   map[string]bool{},
+		snapshots: map[string]string{},
+	}
+	for _, volumeID := range volumeIDs {
+		vs.volumes[volumeID] = true
+	}
+	return vs
+}
+
+func (vs *memoryVolumeSnapshotter) Init(config map[string]string) error {
+	return nil
+}
+
+func (vs *memoryVolumeSnapshotter) CreateVolumeFromSnapshot(snapshotID, volumeType, volumeAZ string, iops *int64) (string, error) {
+	if _, ok := vs.snapshots[snapshotID]; !ok {
+		return "", errors.Errorf("snapshot %s not found", snapshotID)
+	}
+
+	volumeID := fmt.Sprintf("vol-%d", len(vs.volumes)+1)
+	vs.volumes[volumeID] = true
+	return volumeID, nil
+}
+
+func (vs *memoryVolumeSnapshotter) GetVolumeID(pv runtime.Unstructured) (string, error) {
+	volumeID, _, err := unstructured.NestedString(pv.UnstructuredContent(), "spec", "volumeID")
+	return volumeID, err
+}
+
+func (vs *memoryVolumeSnapshotter) SetVolumeID(pv runtime.Unstructured, volumeID string) (runtime.Unstructured, error) {
+	if err := unstructured.SetNestedField(pv.UnstructuredContent(), volumeID, "spec", "volumeID"); err != nil {
+		return nil, err
+	}
+	return pv, nil
+}
+
+func (vs *memoryVolumeSnapshotter) GetVolumeInfo(volumeID, volumeAZ string) (string, *int64, error) {
+	if !vs.volumes[volumeID] {
+		return "", nil, errors.Errorf("volume %s not found", volumeID)
+	}
+	return "ssd", nil, nil
+}
+
+func (vs *memoryVolumeSnapshotter) CreateSnapshot(volumeID, volumeAZ string, tags map[string]string) (string, error) {
+	if !vs.volumes[volumeID] {
+		return "", errors.Errorf("volume %s not found", volumeID)
+	}
+
+	snapshotID := fmt.Sprintf("snap-%d", len(vs.snapshots)+1)
+	vs.snapshots[snapshotID] = volumeID
+	return snapshotID, nil
+}
+
+func (vs *memoryVolumeSnapshotter) DeleteSnapshot(snapshotID string) error {
+	if _, ok := vs.snapshots[snapshotID]; !ok {
+		return errors.Errorf("snapshot %s not found", snapshotID)
+	}
+	delete(vs.snapshots, snapshotID)
+	return nil
+}
+
+// memoryVolumeSnapshotterV2 is a memoryVolumeSnapshotter whose snapshots complete after
+// their progress has been checked twice.
+type memoryVolumeSnapshotterV2 struct {
+	*memoryVolumeSnapshotter
+
+	checks map[string]int
+}
+
+func (vs *memoryVolumeSnapshotterV2) StartSnapshot(volumeID, volumeAZ string, tags map[string]string) (string, string, error) {
+	snapshotID, err := vs.CreateSnapshot(volumeID, volumeAZ, tags)
+	if err != nil {
+		return "", "", err
+	}
+	return snapshotID, "op-" + snapshotID, nil
+}
+
+func (vs *memoryVolumeSnapshotterV2) SnapshotProgress(operationID string) (velero.OperationProgress, error) {
+	vs.checks[operationID]++
+	return velero.OperationProgress{
+		Completed:  vs.checks[operationID] > 2,
+		NCompleted: int64(vs.checks[operationID]),
+		NTotal:     3,
+	}, nil
+}
+
+func (vs *memoryVolumeSnapshotterV2) CancelSnapshot(operationID string) error {
+	return nil
+}
+
+func newTestPV() *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "PersistentVolume",
+			"metadata": map[string]interface{}{
+				"name": "pv-1",
+			},
+			"spec": map[string]interface{}{
+				"volumeID": "vol-1",
+			},
+		},
+	}
+}
+
+func TestVolumeSnapshotterHarness(t *gotesting.T) {
+	volumeSnapshotter := newMemoryVolumeSnapshotter("vol-1")
+
+	TestVolumeSnapshotter(t, volumeSnapshotter, VolumeSnapshotterTest{
+		PersistentVolume: newTestPV(),
+		Tags:             map[string]string{"velero.io/backup": "backup-1"},
+	})
+
+	if len(volumeSnapshotter.snapshots) != 0 {
+		t.Errorf("conformance tests should delete the snapshot they take, got %v", volumeSnapshotter.snapshots)
+	}
+}
+
+func TestVolumeSnapshotterV2Harness(t *gotesting.T) {
+	volumeSnapshotter := &memoryVolumeSnapshotterV2{
+		memoryVolumeSnapshotter: newMemoryVolumeSnapshotter("vol-1"),
+		checks:                  map[string]int{},
+	}
+
+	TestVolumeSnapshotter(t, volumeSnapshotter, VolumeSnapshotterTest{
+		PersistentVolume:     newTestPV(),
+		SkipCreateVolume:     true,
+		SnapshotPollInterval: time.Millisecond,
+	})
+
+	if volumeSnapshotter.checks["op-snap-1"] != 3 {
+		t.Errorf("conformance tests should wait for the snapshot to complete, got %d progress checks", volumeSnapshotter.checks["op-snap-1"])
+	}
+	if len(volumeSnapshotter.volumes) != 1 {
+		t.Errorf("conformance tests shouldn't create a volume when SkipCreateVolume is set, got %v", volumeSnapshotter.volumes)
+	}
+}
@@ -37,6 +37,21 @@ type BackupItemAction interface {
 	Execute(item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, []ResourceIdentifier, error)
 }
 
+// BackupItemActionV2 is version 2 of the BackupItemAction API. Its Execute can start an
+// asynchronous operation for the item, like a copy of the item's data, which Velero waits
+// for before the backup of the item is done.
+type BackupItemActionV2 interface {
+	BackupItemAction
+
+	// Progress returns the progress of the operation that Execute started for the item in
+	// the backup. If Execute didn't start one, the progress is Completed.
+	Progress(item ResourceIdentifier, backup *api.Backup) (OperationProgress, error)
+
+	// Cancel cancels the operation that Execute started for the item in the backup, if it
+	// hasn't completed.
+	Cancel(item ResourceIdentifier, backup *api.Backup) error
+}
+
 // ResourceIdentifier describes a single item by its group, resource, namespace, and name.
 type ResourceIdentifier struct {
 	schema.GroupResource
@@ -0,0 +1,38 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ItemBlockAction is an actor that determines which other items must be backed up together
+// with an individual item, as a single block. The items of a block are backed up one after
+// another, and the volumes of a block's persistent volume claims are snapshotted together.
+type ItemBlockAction interface {
+	// AppliesTo returns information about which resources this action should be invoked for.
+	// An ItemBlockAction's GetRelatedItems function will only be invoked on items that match the
+	// returned selector. A zero-valued ResourceSelector matches all resources.
+	AppliesTo() (ResourceSelector, error)
+
+	// GetRelatedItems returns the items that must be in the same block as the item being backed
+	// up, such as the stateful set and persistent volume claims of a database custom resource.
+	// The related items' own related items are added to the block too.
+	GetRelatedItems(item runtime.Unstructured, backup *api.Backup) ([]ResourceIdentifier, error)
+}
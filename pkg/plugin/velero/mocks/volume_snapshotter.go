@@ -4,12 +4,41 @@ package mocks
 
 import mock "github.com/stretchr/testify/mock"
 import runtime "k8s.io/apimachinery/pkg/runtime"
+import velero "github.com/vmware-tanzu/velero/pkg/plugin/velero"
 
 // VolumeSnapshotter is an autogenerated mock type for the VolumeSnapshotter type
 type VolumeSnapshotter struct {
 	mock.Mock
 }
 
+// CreateResizedVolumeFromSnapshot provides a mock function with given fields: snapshotID, volumeType, volumeAZ, iops, sizeBytes
+func (_m *VolumeSnapshotter) CreateResizedVolumeFromSnapshot(snapshotID string, volumeType string, volumeAZ string, iops *int64, sizeBytes int64) (string, bool, error) {
+	ret := _m.Called(snapshotID, volumeType, volumeAZ, iops, sizeBytes)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string, string, *int64, int64) string); ok {
+		r0 = rf(snapshotID, volumeType, volumeAZ, iops, sizeBytes)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(string, string, string, *int64, int64) bool); ok {
+		r1 = rf(snapshotID, volumeType, volumeAZ, iops, sizeBytes)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, string, string, *int64, int64) error); ok {
+		r2 = rf(snapshotID, volumeType, volumeAZ, iops, sizeBytes)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // CreateSnapshot provides a mock function with given fields: volumeID, volumeAZ, tags
 func (_m *VolumeSnapshotter) CreateSnapshot(volumeID string, volumeAZ string, tags map[string]string) (string, error) {
 	ret := _m.Called(volumeID, volumeAZ, tags)
@@ -31,6 +60,29 @@ func (_m *VolumeSnapshotter) CreateSnapshot(volumeID string, volumeAZ string, ta
 	return r0, r1
 }
 
+// CreateSnapshotGroup provides a mock function with given fields: volumes
+func (_m *VolumeSnapshotter) CreateSnapshotGroup(volumes []velero.SnapshotGroupVolume) ([]string, error) {
+	ret := _m.Called(volumes)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func([]velero.SnapshotGroupVolume) []string); ok {
+		r0 = rf(volumes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]velero.SnapshotGroupVolume) error); ok {
+		r1 = rf(volumes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateVolumeFromSnapshot provides a mock function with given fields: snapshotID, volumeType, volumeAZ, iops
 func (_m *VolumeSnapshotter) CreateVolumeFromSnapshot(snapshotID string, volumeType string, volumeAZ string, iops *int64) (string, error) {
 	ret := _m.Called(snapshotID, volumeType, volumeAZ, iops)
@@ -66,6 +118,27 @@ func (_m *VolumeSnapshotter) DeleteSnapshot(snapshotID string) error {
 	return r0
 }
 
+// GetSnapshotSize provides a mock function with given fields: snapshotID
+func (_m *VolumeSnapshotter) GetSnapshotSize(snapshotID string) (int64, error) {
+	ret := _m.Called(snapshotID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(snapshotID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(snapshotID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetVolumeID provides a mock function with given fields: pv
 func (_m *VolumeSnapshotter) GetVolumeID(pv runtime.Unstructured) (string, error) {
 	ret := _m.Called(pv)
@@ -153,3 +226,17 @@ func (_m *VolumeSnapshotter) SetVolumeID(pv runtime.Unstructured, volumeID strin
 
 	return r0, r1
 }
+
+// VerifySnapshot provides a mock function with given fields: snapshotID, volumeAZ, testRestore
+func (_m *VolumeSnapshotter) VerifySnapshot(snapshotID string, volumeAZ string, testRestore bool) error {
+	ret := _m.Called(snapshotID, volumeAZ, testRestore)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, bool) error); ok {
+		r0 = rf(snapshotID, volumeAZ, testRestore)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
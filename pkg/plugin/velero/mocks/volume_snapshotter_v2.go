@@ -0,0 +1,89 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mocks
+
+import (
+	velero "github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// VolumeSnapshotterV2 is a mock type for the VolumeSnapshotterV2 type
+type VolumeSnapshotterV2 struct {
+	VolumeSnapshotter
+}
+
+// StartSnapshot provides a mock function with given fields: volumeID, volumeAZ, tags
+func (_m *VolumeSnapshotterV2) StartSnapshot(volumeID string, volumeAZ string, tags map[string]string) (string, string, error) {
+	ret := _m.Called(volumeID, volumeAZ, tags)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string, map[string]string) string); ok {
+		r0 = rf(volumeID, volumeAZ, tags)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(string, string, map[string]string) string); ok {
+		r1 = rf(volumeID, volumeAZ, tags)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, string, map[string]string) error); ok {
+		r2 = rf(volumeID, volumeAZ, tags)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SnapshotProgress provides a mock function with given fields: operationID
+func (_m *VolumeSnapshotterV2) SnapshotProgress(operationID string) (velero.OperationProgress, error) {
+	ret := _m.Called(operationID)
+
+	var r0 velero.OperationProgress
+	if rf, ok := ret.Get(0).(func(string) velero.OperationProgress); ok {
+		r0 = rf(operationID)
+	} else {
+		r0 = ret.Get(0).(velero.OperationProgress)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(operationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelSnapshot provides a mock function with given fields: operationID
+func (_m *VolumeSnapshotterV2) CancelSnapshot(operationID string) error {
+	ret := _m.Called(operationID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(operationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
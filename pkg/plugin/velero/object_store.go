@@ -18,7 +18,11 @@ package velero
 
 import (
 	"io"
+	"io/ioutil"
+	"sort"
 	"time"
+
+	"github.com/pkg/errors"
 )
 
 // ObjectStore exposes basic object-storage operations required
@@ -63,3 +67,79 @@ type ObjectStore interface {
 	// CreateSignedURL creates a pre-signed URL for the given bucket and key that expires after ttl.
 	CreateSignedURL(bucket, key string, ttl time.Duration) (string, error)
 }
+
+// ObjectRangeGetter is an optional interface of ObjectStores that can retrieve an object
+// starting from an offset, so that interrupted retrievals of large objects can be resumed
+// without reading them from the start again.
+type ObjectRangeGetter interface {
+	// GetObjectRange retrieves the object with the given key from the specified bucket
+	// in object storage, starting from offset.
+	GetObjectRange(bucket, key string, offset int64) (io.ReadCloser, error)
+}
+
+// GetObjectRange retrieves an object starting from offset with objectStore if it's an
+// ObjectRangeGetter. Otherwise, the object is retrieved from the start, and the data
+// before offset is discarded.
+func GetObjectRange(objectStore ObjectStore, bucket, key string, offset int64) (io.ReadCloser, error) {
+	if getter, ok := objectStore.(ObjectRangeGetter); ok {
+		return getter.GetObjectRange(bucket, key, offset)
+	}
+
+	body, err := objectStore.GetObject(bucket, key)
+	if err != nil || offset <= 0 {
+		return body, err
+	}
+
+	if _, err := io.CopyN(ioutil.Discard, body, offset); err != nil {
+		body.Close()
+		if err == io.EOF {
+			return nil, errors.Errorf("object is shorter than offset %d", offset)
+		}
+		return nil, errors.WithStack(err)
+	}
+	return body, nil
+}
+
+// ObjectInfo is an object's key, along with its ETag.
+type ObjectInfo struct {
+	Key string
+
+	// ETag identifies the object's data. It changes every time the object is
+	// replaced. It's empty if the object store doesn't have one.
+	ETag string
+}
+
+// ObjectPageLister is an optional interface of ObjectStores that can list objects in
+// pages, along with their ETags, so that buckets with many objects can be listed a
+// page at a time, and objects that changed can be found without retrieving them.
+type ObjectPageLister interface {
+	// ListObjectsPage gets up to limit objects in the specified bucket that have the
+	// given prefix and whose keys sort after startAfter, in the order of their keys.
+	// It also returns the key to list the next page after, which is empty if there
+	// are no more objects.
+	ListObjectsPage(bucket, prefix, startAfter string, limit int) ([]ObjectInfo, string, error)
+}
+
+// ListObjectsPage lists a page of objects with objectStore if it's an ObjectPageLister.
+// Otherwise, all of the objects that have prefix and whose keys sort after startAfter are
+// listed in a single page, without ETags.
+func ListObjectsPage(objectStore ObjectStore, bucket, prefix, startAfter string, limit int) ([]ObjectInfo, string, error) {
+	if lister, ok := objectStore.(ObjectPageLister); ok {
+		return lister.ListObjectsPage(bucket, prefix, startAfter, limit)
+	}
+
+	keys, err := objectStore.ListObjects(bucket, prefix)
+	if err != nil {
+		return nil, "", err
+	}
+	sort.Strings(keys)
+
+	var objects []ObjectInfo
+	for _, key := range keys {
+		if key > startAfter {
+			objects = append(objects, ObjectInfo{Key: key})
+		}
+	}
+
+	return objects, "", nil
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// PostRestoreAction is an actor that runs once all of a restore's items have been restored,
+// e.g. to run smoke tests against the restored applications or to notify external systems.
+type PostRestoreAction interface {
+	// Execute allows the PostRestoreAction to perform arbitrary logic with the finished restore.
+	// An error should be returned if the action failed, e.g. because a smoke test didn't pass;
+	// it's recorded as an error of the restore, so the restore is marked as partially failed.
+	Execute(input *PostRestoreActionExecuteInput) (*PostRestoreActionExecuteOutput, error)
+}
+
+// PostRestoreActionExecuteInput contains the input parameters for the PostRestoreAction's Execute function.
+type PostRestoreActionExecuteInput struct {
+	// Restore is the representation of the restore resource processed by Velero. Its status
+	// summarizes the results of restoring the items, e.g. the number of warnings and errors.
+	Restore *velerov1api.Restore
+	// Backup is the representation of the backup resource the restore is from.
+	Backup *velerov1api.Backup
+}
+
+// PostRestoreActionExecuteOutput contains the output variables for the PostRestoreAction's Execute function.
+type PostRestoreActionExecuteOutput struct {
+	// Warnings are recorded as warnings of the restore.
+	Warnings []string
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package velero
 
 import (
+	"time"
+
 	"k8s.io/apimachinery/pkg/runtime"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -38,6 +40,21 @@ type RestoreItemAction interface {
 	Execute(input *RestoreItemActionExecuteInput) (*RestoreItemActionExecuteOutput, error)
 }
 
+// RestoreItemActionV2 is version 2 of the RestoreItemAction API. Its Execute can start an
+// asynchronous operation for the item, like a copy of the item's data, which Velero waits
+// for before the restore of the item is done.
+type RestoreItemActionV2 interface {
+	RestoreItemAction
+
+	// Progress returns the progress of the operation that Execute started for the item in
+	// the restore. If Execute didn't start one, the progress is Completed.
+	Progress(item ResourceIdentifier, restore *api.Restore) (OperationProgress, error)
+
+	// Cancel cancels the operation that Execute started for the item in the restore, if it
+	// hasn't completed.
+	Cancel(item ResourceIdentifier, restore *api.Restore) error
+}
+
 // RestoreItemActionExecuteInput contains the input parameters for the ItemAction's Execute function.
 type RestoreItemActionExecuteInput struct {
 	// Item is the item being restored. It is likely different from the pristine backed up version
@@ -62,6 +79,22 @@ type RestoreItemActionExecuteOutput struct {
 	// on this item, and skip the restore step. When this field's
 	// value is true, AdditionalItems will be ignored.
 	SkipRestore bool
+
+	// SkipRestoreReason is an optional explanation of why the item
+	// was skipped, which is included in the restore log.
+	SkipRestoreReason string
+
+	// WaitForAdditionalItems tells velero to wait for the additional
+	// items to become ready before restoring this item. An item is
+	// ready when it has the condition of its resource's readiness gate,
+	// or the resource's well-known readiness condition; items of other
+	// resources are ready once they exist.
+	WaitForAdditionalItems bool
+
+	// AdditionalItemsReadyTimeout is how long velero waits for the
+	// additional items to become ready. If zero, the default readiness
+	// timeout is used.
+	AdditionalItemsReadyTimeout time.Duration
 }
 
 // NewRestoreItemActionExecuteOutput creates a new RestoreItemActionExecuteOutput
@@ -76,3 +109,19 @@ func (r *RestoreItemActionExecuteOutput) WithoutRestore() *RestoreItemActionExec
 	r.SkipRestore = true
 	return r
 }
+
+// WithoutRestoreReason returns SkipRestore for RestoreItemActionExecuteOutput, with
+// the reason the item is skipped.
+func (r *RestoreItemActionExecuteOutput) WithoutRestoreReason(reason string) *RestoreItemActionExecuteOutput {
+	r.SkipRestore = true
+	r.SkipRestoreReason = reason
+	return r
+}
+
+// WithWaitForAdditionalItems returns WaitForAdditionalItems for RestoreItemActionExecuteOutput,
+// with the timeout to wait for the additional items to become ready.
+func (r *RestoreItemActionExecuteOutput) WithWaitForAdditionalItems(timeout time.Duration) *RestoreItemActionExecuteOutput {
+	r.WaitForAdditionalItems = true
+	r.AdditionalItemsReadyTimeout = timeout
+	return r
+}
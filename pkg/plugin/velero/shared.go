@@ -48,3 +48,30 @@ type ResourceSelector struct {
 	// for details on syntax.
 	LabelSelector string
 }
+
+// OperationProgress is the progress of an asynchronous operation that a version 2 item
+// action started for an item.
+type OperationProgress struct {
+	// Completed is whether the operation has ended, whether or not it succeeded.
+	Completed bool
+	// Err is the error that the operation failed with, if it's completed and failed.
+	Err string
+	// NCompleted is how much of the operation is done, in OperationUnits.
+	NCompleted int64
+	// NTotal is how much there is to do, in OperationUnits, or zero if it isn't known.
+	NTotal int64
+	// OperationUnits is the unit of NCompleted and NTotal, like "bytes" or "volumes".
+	OperationUnits string
+	// Description is a short description of the operation's current state.
+	Description string
+}
+
+// Configurable is implemented by item actions and post-restore actions that accept configuration
+// from the plugin ConfigMap, the ConfigMap in Velero's namespace labeled with
+// "velero.io/plugin-config" and "<plugin name>: <plugin kind>".
+type Configurable interface {
+	// Configure receives the data of the action's plugin ConfigMap, or an empty map if there isn't
+	// one. Velero calls it before each call to AppliesTo, or for post-restore actions, before each
+	// call to Execute.
+	Configure(config map[string]string) error
+}
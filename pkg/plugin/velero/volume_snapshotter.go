@@ -17,6 +17,7 @@ limitations under the License.
 package velero
 
 import (
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -51,3 +52,144 @@ type VolumeSnapshotter interface {
 	// DeleteSnapshot deletes the specified volume snapshot.
 	DeleteSnapshot(snapshotID string) error
 }
+
+// VolumeSnapshotterV2 is version 2 of the VolumeSnapshotter API. Its StartSnapshot returns
+// once a snapshot has been started, instead of blocking until it's complete, and Velero
+// polls the progress of the snapshot's operation until it completes.
+type VolumeSnapshotterV2 interface {
+	VolumeSnapshotter
+
+	// StartSnapshot starts a snapshot of the specified volume with the provided set of tags,
+	// and returns the snapshot's ID and the ID of its operation. operationID is empty if the
+	// snapshot has already completed.
+	StartSnapshot(volumeID, volumeAZ string, tags map[string]string) (snapshotID, operationID string, err error)
+
+	// SnapshotProgress returns the progress of the snapshot operation with the given ID.
+	SnapshotProgress(operationID string) (OperationProgress, error)
+
+	// CancelSnapshot cancels the snapshot operation with the given ID, if it hasn't completed.
+	CancelSnapshot(operationID string) error
+}
+
+// VolumeResizer is an optional interface of VolumeSnapshotters that can create
+// volumes from snapshots that are larger than the snapshotted volumes.
+type VolumeResizer interface {
+	// CreateResizedVolumeFromSnapshot creates a new volume like CreateVolumeFromSnapshot,
+	// that's at least sizeBytes large. resized is false if the volume was created with
+	// the size of the snapshotted volume instead.
+	CreateResizedVolumeFromSnapshot(snapshotID, volumeType, volumeAZ string, iops *int64, sizeBytes int64) (volumeID string, resized bool, err error)
+}
+
+// CreateResizedVolumeFromSnapshot creates a new volume from a snapshot with volumeSnapshotter
+// that's at least sizeBytes large, if volumeSnapshotter is a VolumeResizer. Otherwise, or if
+// sizeBytes isn't positive, the volume is created with the size of the snapshotted volume.
+func CreateResizedVolumeFromSnapshot(volumeSnapshotter VolumeSnapshotter, snapshotID, volumeType, volumeAZ string, iops *int64, sizeBytes int64) (volumeID string, resized bool, err error) {
+	if resizer, ok := volumeSnapshotter.(VolumeResizer); ok && sizeBytes > 0 {
+		return resizer.CreateResizedVolumeFromSnapshot(snapshotID, volumeType, volumeAZ, iops, sizeBytes)
+	}
+
+	volumeID, err = volumeSnapshotter.CreateVolumeFromSnapshot(snapshotID, volumeType, volumeAZ, iops)
+	return volumeID, false, err
+}
+
+// SnapshotVerifier is an optional interface of VolumeSnapshotters that can check that
+// the snapshots they've taken can be restored from.
+type SnapshotVerifier interface {
+	// VerifySnapshot returns an error if the specified snapshot doesn't exist, or isn't
+	// ready to be restored from, waiting for it to become ready if needed. If testRestore
+	// is true, it also creates a volume from the snapshot in the given availability zone,
+	// and deletes the volume once it's been created.
+	VerifySnapshot(snapshotID, volumeAZ string, testRestore bool) error
+}
+
+// ErrSnapshotVerificationNotSupported is returned by a SnapshotVerifier that's a client of
+// a volume snapshotter plugin that doesn't support verifying snapshots.
+var ErrSnapshotVerificationNotSupported = errors.New("volume snapshotter doesn't support verifying snapshots")
+
+// VerifySnapshot verifies a snapshot with volumeSnapshotter if it supports verifying
+// snapshots. verified is false if it doesn't, in which case the snapshot isn't checked.
+func VerifySnapshot(volumeSnapshotter VolumeSnapshotter, snapshotID, volumeAZ string, testRestore bool) (verified bool, err error) {
+	verifier, ok := volumeSnapshotter.(SnapshotVerifier)
+	if !ok {
+		return false, nil
+	}
+
+	if err := verifier.VerifySnapshot(snapshotID, volumeAZ, testRestore); err != nil {
+		if errors.Cause(err) == ErrSnapshotVerificationNotSupported {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// SnapshotGroupVolume is a volume of a snapshot group, with the tags to apply to
+// its snapshot.
+type SnapshotGroupVolume struct {
+	VolumeID string
+	VolumeAZ string
+	Tags     map[string]string
+}
+
+// SnapshotGroupCreator is an optional interface of VolumeSnapshotters that can snapshot
+// several volumes together, so that the snapshots are crash-consistent with each other.
+type SnapshotGroupCreator interface {
+	// CreateSnapshotGroup creates a consistency group snapshot of the specified volumes,
+	// and returns the IDs of their snapshots in the same order as volumes.
+	CreateSnapshotGroup(volumes []SnapshotGroupVolume) (snapshotIDs []string, err error)
+}
+
+// ErrSnapshotGroupsNotSupported is returned by a SnapshotGroupCreator that's a client of
+// a volume snapshotter plugin that doesn't support snapshot groups.
+var ErrSnapshotGroupsNotSupported = errors.New("volume snapshotter doesn't support snapshot groups")
+
+// CreateSnapshotGroup snapshots volumes together with volumeSnapshotter if it supports
+// snapshot groups. grouped is false if it doesn't, in which case no snapshots are taken.
+func CreateSnapshotGroup(volumeSnapshotter VolumeSnapshotter, volumes []SnapshotGroupVolume) (snapshotIDs []string, grouped bool, err error) {
+	creator, ok := volumeSnapshotter.(SnapshotGroupCreator)
+	if !ok {
+		return nil, false, nil
+	}
+
+	snapshotIDs, err = creator.CreateSnapshotGroup(volumes)
+	if err != nil {
+		if errors.Cause(err) == ErrSnapshotGroupsNotSupported {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	if len(snapshotIDs) != len(volumes) {
+		return nil, false, errors.Errorf("volume snapshotter returned %d snapshot IDs for %d volumes", len(snapshotIDs), len(volumes))
+	}
+	return snapshotIDs, true, nil
+}
+
+// SnapshotSizer is an optional interface of VolumeSnapshotters that can report how much
+// storage their snapshots use in their provider.
+type SnapshotSizer interface {
+	// GetSnapshotSize returns the number of bytes of storage that the specified snapshot
+	// uses, as reported by its provider.
+	GetSnapshotSize(snapshotID string) (int64, error)
+}
+
+// ErrSnapshotSizeNotSupported is returned by a SnapshotSizer that's a client of a volume
+// snapshotter plugin that doesn't support reporting the sizes of snapshots.
+var ErrSnapshotSizeNotSupported = errors.New("volume snapshotter doesn't support reporting snapshot sizes")
+
+// GetSnapshotSize returns the size of a snapshot with volumeSnapshotter if it supports
+// reporting the sizes of snapshots. supported is false if it doesn't.
+func GetSnapshotSize(volumeSnapshotter VolumeSnapshotter, snapshotID string) (sizeBytes int64, supported bool, err error) {
+	sizer, ok := volumeSnapshotter.(SnapshotSizer)
+	if !ok {
+		return 0, false, nil
+	}
+
+	sizeBytes, err = sizer.GetSnapshotSize(snapshotID)
+	if err != nil {
+		if errors.Cause(err) == ErrSnapshotSizeNotSupported {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	return sizeBytes, true, nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/sirupsen/logrus"
 	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
 
@@ -37,6 +38,10 @@ import (
 type Backupper interface {
 	// BackupPodVolumes backs up all specified volumes in a pod.
 	BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.Pod, volumesToBackup []string, log logrus.FieldLogger) ([]*velerov1api.PodVolumeBackup, []error)
+
+	// BackupPVC backs up a persistent volume claim that no pod mounts, by mounting it in a
+	// helper pod for the duration of its backup. It does nothing if the claim is mounted.
+	BackupPVC(backup *velerov1api.Backup, pvc *corev1api.PersistentVolumeClaim, log logrus.FieldLogger) ([]*velerov1api.PodVolumeBackup, []error)
 }
 
 type backupper struct {
@@ -48,10 +53,13 @@ type backupper struct {
 
 	results     map[string]chan *velerov1api.PodVolumeBackup
 	resultsLock sync.Mutex
+
+	progress *podVolumeProgress
 }
 
 func newBackupper(
 	ctx context.Context,
+	backup *velerov1api.Backup,
 	repoManager *repositoryManager,
 	repoEnsurer *repositoryEnsurer,
 	podVolumeBackupInformer cache.SharedIndexInformer,
@@ -67,6 +75,11 @@ func newBackupper(
 		pvClient:    pvClient,
 
 		results: make(map[string]chan *velerov1api.PodVolumeBackup),
+
+		progress: newPodVolumeProgress(func(patch []byte) error {
+			_, err := repoManager.veleroClient.VeleroV1().Backups(backup.Namespace).Patch(ctx, backup.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+			return err
+		}),
 	}
 
 	podVolumeBackupInformer.AddEventHandler(
@@ -74,6 +87,10 @@ func newBackupper(
 			UpdateFunc: func(_, obj interface{}) {
 				pvb := obj.(*velerov1api.PodVolumeBackup)
 
+				if err := b.progress.update(pvb.Name, pvb.Status.Progress); err != nil {
+					log.WithError(errors.WithStack(err)).Warn("Got error trying to update backup's status.progress")
+				}
+
 				if pvb.Status.Phase == velerov1api.PodVolumeBackupPhaseCompleted || pvb.Status.Phase == velerov1api.PodVolumeBackupPhaseFailed {
 					b.resultsLock.Lock()
 					defer b.resultsLock.Unlock()
@@ -101,7 +118,12 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		return nil, nil
 	}
 
-	repo, err := b.repoEnsurer.EnsureRepo(b.ctx, backup.Namespace, pod.Namespace, backup.Spec.StorageLocation)
+	uploaderType, err := b.repoManager.uploaderTypeForLocation(backup.Spec.StorageLocation)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	repo, err := b.repoEnsurer.EnsureRepo(b.ctx, backup.Namespace, pod.Namespace, backup.Spec.StorageLocation, uploaderType)
 	if err != nil {
 		return nil, []error{err}
 	}
@@ -157,7 +179,10 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 			continue
 		}
 
-		volumeBackup := newPodVolumeBackup(backup, pod, volume, repo.Spec.ResticIdentifier, pvc)
+		volumeBackup := newPodVolumeBackup(backup, pod, volume, repo.Spec.RepoIdentifier, uploaderType, pvc)
+		// the repository may have been migrated from the backup's storage location, so
+		// its data is accessed through the location that it's stored in now.
+		volumeBackup.Spec.BackupStorageLocation = repo.Spec.BackupStorageLocation
 		if volumeBackup, err = b.repoManager.veleroClient.VeleroV1().PodVolumeBackups(volumeBackup.Namespace).Create(context.TODO(), volumeBackup, metav1.CreateOptions{}); err != nil {
 			errs = append(errs, err)
 			continue
@@ -189,6 +214,51 @@ ForEachVolume:
 	return podVolumeBackups, errs
 }
 
+func (b *backupper) BackupPVC(backup *velerov1api.Backup, pvc *corev1api.PersistentVolumeClaim, log logrus.FieldLogger) ([]*velerov1api.PodVolumeBackup, []error) {
+	log = log.WithField("persistentVolumeClaim", fmt.Sprintf("%s/%s", pvc.Namespace, pvc.Name))
+
+	if pvc.Status.Phase != corev1api.ClaimBound {
+		log.Info("Persistent volume claim is not bound, not backing it up with restic")
+		return nil, nil
+	}
+	if pvc.Spec.VolumeMode != nil && *pvc.Spec.VolumeMode == corev1api.PersistentVolumeBlock {
+		log.Warn("Persistent volume claim is a raw block volume, which is not supported for restic backup without a pod that mounts it, skipping")
+		return nil, nil
+	}
+
+	mounted, err := isPVCMounted(b.repoManager.podClient, pvc)
+	if err != nil {
+		return nil, []error{err}
+	}
+	if mounted {
+		log.Debug("Persistent volume claim is mounted by a pod, not backing it up with a helper pod")
+		return nil, nil
+	}
+
+	pod := newPVCHelperPod(pvc, pvcHelperPodVolume, "")
+	pod.GenerateName = "velero-pvc-backup-"
+	pod.Labels[velerov1api.BackupNameLabel] = label.GetValidName(backup.Name)
+
+	pod, err = b.repoManager.podClient.Pods(pod.Namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+	if err != nil {
+		return nil, []error{errors.Wrap(err, "error creating helper pod for persistent volume claim")}
+	}
+	defer func() {
+		if err := deletePVCHelperPod(b.repoManager.podClient, pod); err != nil {
+			log.WithError(err).Warn("Unable to delete helper pod")
+		}
+	}()
+
+	log.Infof("Backing up persistent volume claim with restic by mounting it in helper pod %s", pod.Name)
+
+	running, err := waitForPodRunning(b.ctx, b.repoManager.podClient, pod.Namespace, pod.Name)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	return b.BackupPodVolumes(backup, running, []string{pvcHelperPodVolume}, log)
+}
+
 type pvcGetter interface {
 	Get(ctx context.Context, name string, opts metav1.GetOptions) (*corev1api.PersistentVolumeClaim, error)
 }
@@ -216,7 +286,7 @@ func isHostPathVolume(volume *corev1api.Volume, pvc *corev1api.PersistentVolumeC
 	return pv.Spec.HostPath != nil, nil
 }
 
-func newPodVolumeBackup(backup *velerov1api.Backup, pod *corev1api.Pod, volume corev1api.Volume, repoIdentifier string, pvc *corev1api.PersistentVolumeClaim) *velerov1api.PodVolumeBackup {
+func newPodVolumeBackup(backup *velerov1api.Backup, pod *corev1api.Pod, volume corev1api.Volume, repoIdentifier string, uploaderType velerov1api.UploaderType, pvc *corev1api.PersistentVolumeClaim) *velerov1api.PodVolumeBackup {
 	pvb := &velerov1api.PodVolumeBackup{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:    backup.Namespace,
@@ -254,9 +324,20 @@ func newPodVolumeBackup(backup *velerov1api.Backup, pod *corev1api.Pod, volume c
 			},
 			BackupStorageLocation: backup.Spec.StorageLocation,
 			RepoIdentifier:        repoIdentifier,
+			UploaderType:          uploaderType,
 		},
 	}
 
+	pvb.Spec.PathIncludes, pvb.Spec.PathExcludes = getVolumePathFilters(backup, pod, volume.Name)
+
+	// this label is used by the pod volume backup controller to find the
+	// previous pod volume backup of the same schedule, whose snapshot is
+	// reused if the volume hasn't changed since.
+	if schedule := backup.Labels[velerov1api.ScheduleNameLabel]; schedule != "" {
+		pvb.Labels[velerov1api.ScheduleNameLabel] = schedule
+		pvb.Spec.SkipIfUnchanged = boolptr.IsSetToTrue(backup.Spec.SkipUnchangedVolumes)
+	}
+
 	if pvc != nil {
 		// this annotation is used in pkg/restore to identify if a PVC
 		// has a restic backup.
@@ -270,6 +351,12 @@ func newPodVolumeBackup(backup *velerov1api.Backup, pod *corev1api.Pod, volume c
 
 		// this tag is not used by velero, but useful for debugging.
 		pvb.Spec.Tags["pvc-uid"] = string(pvc.UID)
+
+		// this annotation is used in pkg/restore to restore the PVC's data
+		// with a helper pod, since no pod that mounts it is restored.
+		if pod.Labels[PVCHelperPodLabel] != "" {
+			pvb.Annotations[UnmountedPVCAnnotation] = "true"
+		}
 	}
 
 	return pvb
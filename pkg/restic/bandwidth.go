@@ -0,0 +1,100 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// BandwidthConfig is how fast the uploaders of a node can transfer the data of
+// repositories, in total.
+type BandwidthConfig struct {
+	// UploadLimit is the maximum number of bytes per second that the node uploads.
+	// If zero, uploads aren't limited.
+	UploadLimit int64
+
+	// DownloadLimit is the maximum number of bytes per second that the node
+	// downloads. If zero, downloads aren't limited.
+	DownloadLimit int64
+
+	// Concurrency is how many uploader commands the node runs at a time, which
+	// share the node's limits.
+	Concurrency int
+}
+
+// bandwidthConfig is the bandwidth configuration of this node.
+var (
+	bandwidthConfig     BandwidthConfig
+	bandwidthConfigLock sync.RWMutex
+)
+
+// SetBandwidthConfig sets the bandwidth configuration of this node. It's called when a
+// server starts, and again when the node's data path concurrency changes.
+func SetBandwidthConfig(config BandwidthConfig) {
+	bandwidthConfigLock.Lock()
+	defer bandwidthConfigLock.Unlock()
+
+	bandwidthConfig = config
+}
+
+// GetBandwidthConfig returns the bandwidth configuration of this node.
+func GetBandwidthConfig() BandwidthConfig {
+	bandwidthConfigLock.RLock()
+	defer bandwidthConfigLock.RUnlock()
+
+	return bandwidthConfig
+}
+
+// RepoBandwidth returns the maximum number of bytes per second that each uploader
+// command uploads and downloads, which is the lower of the node's share of its limit
+// and the repository's limit, if either is set. Zero means unlimited.
+func RepoBandwidth(uploadLimit, downloadLimit *resource.Quantity) (int64, int64) {
+	config := GetBandwidthConfig()
+	return rateLimit(config.UploadLimit, config.Concurrency, uploadLimit), rateLimit(config.DownloadLimit, config.Concurrency, downloadLimit)
+}
+
+func rateLimit(nodeLimit int64, concurrency int, repoLimit *resource.Quantity) int64 {
+	var limit int64
+	if nodeLimit > 0 {
+		limit = nodeLimit
+		if concurrency > 1 {
+			limit /= int64(concurrency)
+		}
+		// a limit of zero means unlimited, so it can't be rounded down to it.
+		if limit < 1 {
+			limit = 1
+		}
+	}
+
+	if repoLimit != nil && repoLimit.Value() > 0 && (limit == 0 || repoLimit.Value() < limit) {
+		limit = repoLimit.Value()
+	}
+
+	return limit
+}
+
+// resticRateLimit returns the value of restic's --limit-upload and --limit-download
+// flags, in KiB per second, for a limit in bytes per second.
+func resticRateLimit(limit int64) int64 {
+	kib := limit / 1024
+	if kib < 1 {
+		kib = 1
+	}
+	return kib
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestRepoBandwidth(t *testing.T) {
+	quantity := func(s string) *resource.Quantity {
+		q := resource.MustParse(s)
+		return &q
+	}
+
+	tests := []struct {
+		name          string
+		config        BandwidthConfig
+		uploadLimit   *resource.Quantity
+		downloadLimit *resource.Quantity
+		wantUpload    int64
+		wantDownload  int64
+	}{
+		{
+			name: "no limits",
+		},
+		{
+			name:         "the node's limits are shared by its concurrent commands",
+			config:       BandwidthConfig{UploadLimit: 100 << 20, DownloadLimit: 40 << 20, Concurrency: 4},
+			wantUpload:   25 << 20,
+			wantDownload: 10 << 20,
+		},
+		{
+			name:          "the repository's limits",
+			uploadLimit:   quantity("10Mi"),
+			downloadLimit: quantity("20Mi"),
+			wantUpload:    10 << 20,
+			wantDownload:  20 << 20,
+		},
+		{
+			name:          "the lower of the node's and the repository's limits",
+			config:        BandwidthConfig{UploadLimit: 100 << 20, DownloadLimit: 40 << 20, Concurrency: 2},
+			uploadLimit:   quantity("10Mi"),
+			downloadLimit: quantity("1Gi"),
+			wantUpload:    10 << 20,
+			wantDownload:  20 << 20,
+		},
+		{
+			name:         "a node's share isn't rounded down to unlimited",
+			config:       BandwidthConfig{UploadLimit: 1, Concurrency: 2},
+			wantUpload:   1,
+			wantDownload: 0,
+		},
+	}
+
+	defer SetBandwidthConfig(BandwidthConfig{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			SetBandwidthConfig(test.config)
+
+			upload, download := RepoBandwidth(test.uploadLimit, test.downloadLimit)
+			assert.Equal(t, test.wantUpload, upload)
+			assert.Equal(t, test.wantDownload, download)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// CacheConfig is where the uploaders keep their local caches of repositories, and
+// how large the cache of each repository can grow.
+type CacheConfig struct {
+	// Dir is the directory that the caches are kept in. If empty, they're kept in
+	// VELERO_SCRATCH_DIR if it's defined, or else where the uploaders choose.
+	Dir string
+
+	// SizeLimit is the maximum number of bytes of a repository's cache. If zero,
+	// caches grow without limit.
+	SizeLimit int64
+
+	// Owner names the server that uses the caches in Dir, such as its node. If it's
+	// defined, the server's caches are kept in a directory of their own within Dir, so
+	// that servers sharing a volume don't remove caches that another is using.
+	Owner string
+}
+
+// cacheConfig is the cache configuration of the repositories whose BackupRepository
+// doesn't override it.
+var cacheConfig CacheConfig
+
+// SetCacheConfig sets the cache configuration of the repositories whose BackupRepository
+// doesn't override it. It's meant to be called once, when a server starts.
+func SetCacheConfig(config CacheConfig) {
+	cacheConfig = config
+}
+
+// RepoCache returns the cache directory and the cache size limit of the repository with
+// the given uploader type and identifier. If sizeLimit isn't nil, it overrides the default
+// cache size limit. The directory is empty if the uploader should choose it.
+func RepoCache(uploaderType velerov1api.UploaderType, repoIdentifier string, sizeLimit *resource.Quantity) (string, int64) {
+	limit := cacheConfig.SizeLimit
+	if sizeLimit != nil {
+		limit = sizeLimit.Value()
+	}
+
+	if uploaderType == "" {
+		uploaderType = velerov1api.UploaderTypeRestic
+	}
+
+	base := cacheConfig.Dir
+	if base != "" && cacheConfig.Owner != "" {
+		base = filepath.Join(base, cacheConfig.Owner)
+	}
+	if base == "" {
+		if scratch := os.Getenv("VELERO_SCRATCH_DIR"); scratch != "" {
+			base = filepath.Join(scratch, ".cache")
+		}
+	}
+	if base == "" {
+		return "", limit
+	}
+
+	// each repository gets its own cache directory so that its size can be limited.
+	return filepath.Join(base, string(uploaderType), kopiaRepoDirName(repoIdentifier)), limit
+}
+
+// cacheUsers counts the commands that are using a repository's cache directory.
+type cacheUsers struct {
+	sync.Mutex
+	count int
+}
+
+var (
+	cachesLock sync.Mutex
+	caches     = make(map[string]*cacheUsers)
+)
+
+// useCache records that a command uses a repository's cache directory until the returned
+// function is called. If no other command is using the cache, it's first removed if it's
+// over its size limit, so that a cache isn't removed while a command is reading it. Caches
+// are only tracked within this process, so each server needs its own cache directories.
+func useCache(dir string, limit int64, log logrus.FieldLogger) func() {
+	if dir == "" {
+		return func() {}
+	}
+
+	cachesLock.Lock()
+	users, ok := caches[dir]
+	if !ok {
+		users = new(cacheUsers)
+		caches[dir] = users
+	}
+	cachesLock.Unlock()
+
+	users.Lock()
+	if users.count == 0 {
+		// failing to remove the cache doesn't fail the command, which can still use it.
+		if err := enforceCacheSizeLimit(dir, limit, log); err != nil {
+			log.WithError(err).Warn("Error enforcing repository cache size limit")
+		}
+	}
+	users.count++
+	users.Unlock()
+
+	return func() {
+		users.Lock()
+		users.count--
+		users.Unlock()
+	}
+}
+
+// enforceCacheSizeLimit removes the cache directory of a repository if it's grown larger
+// than limit, so that the uploader builds it again from the repository.
+func enforceCacheSizeLimit(dir string, limit int64, log logrus.FieldLogger) error {
+	if dir == "" || limit <= 0 {
+		return nil
+	}
+
+	size, err := dirSize(dir)
+	if err != nil {
+		return err
+	}
+
+	if size <= limit {
+		return nil
+	}
+
+	log.Infof("Removing repository cache %s because its size of %d bytes is over its limit of %d bytes", dir, size, limit)
+	return errors.Wrapf(os.RemoveAll(dir), "error removing repository cache %s", dir)
+}
+
+// dirSize returns the total number of bytes of the files in dir, which is zero if dir
+// doesn't exist.
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			// files can be removed by the uploaders while the directory is walked.
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "error getting size of directory %s", dir)
+	}
+
+	return size, nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRepoCache(t *testing.T) {
+	defer SetCacheConfig(CacheConfig{})
+	require.NoError(t, os.Setenv("VELERO_SCRATCH_DIR", "/scratch"))
+	defer os.Unsetenv("VELERO_SCRATCH_DIR")
+
+	// without a configured directory, caches are kept in the scratch directory
+	dir, limit := RepoCache("", "s3:http://minio:9000/bucket/restic/ns-1", nil)
+	assert.Equal(t, "/scratch/.cache/restic/s3_http_minio_9000_bucket_restic_ns-1", dir)
+	assert.Equal(t, int64(0), limit)
+
+	SetCacheConfig(CacheConfig{Dir: "/restic-cache", SizeLimit: 1 << 30})
+
+	dir, limit = RepoCache(velerov1api.UploaderTypeKopia, "gs:bucket:/kopia/ns-1", nil)
+	assert.Equal(t, "/restic-cache/kopia/gs_bucket_kopia_ns-1", dir)
+	assert.Equal(t, int64(1<<30), limit)
+
+	// a repository's own limit overrides the default one
+	repoLimit := resource.MustParse("100Mi")
+	_, limit = RepoCache(velerov1api.UploaderTypeRestic, "gs:bucket:/restic/ns-1", &repoLimit)
+	assert.Equal(t, int64(100<<20), limit)
+
+	// servers that share a cache directory each keep their caches in their own directory
+	SetCacheConfig(CacheConfig{Dir: "/restic-cache", Owner: "node-1"})
+
+	dir, _ = RepoCache(velerov1api.UploaderTypeRestic, "gs:bucket:/restic/ns-1", nil)
+	assert.Equal(t, "/restic-cache/node-1/restic/gs_bucket_restic_ns-1", dir)
+}
+
+func TestEnforceCacheSizeLimit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "restic-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	repoDir := filepath.Join(dir, "repo")
+	require.NoError(t, os.MkdirAll(filepath.Join(repoDir, "data"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(repoDir, "data", "pack"), make([]byte, 100), 0644))
+
+	// a missing cache or one without a limit isn't removed
+	require.NoError(t, enforceCacheSizeLimit(filepath.Join(dir, "missing"), 10, velerotest.NewLogger()))
+	require.NoError(t, enforceCacheSizeLimit(repoDir, 0, velerotest.NewLogger()))
+	assert.DirExists(t, repoDir)
+
+	// a cache within its limit isn't removed
+	require.NoError(t, enforceCacheSizeLimit(repoDir, 100, velerotest.NewLogger()))
+	assert.DirExists(t, repoDir)
+
+	// a cache over its limit is removed
+	require.NoError(t, enforceCacheSizeLimit(repoDir, 99, velerotest.NewLogger()))
+	_, err = os.Stat(repoDir)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestUseCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "restic-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	repoDir := filepath.Join(dir, "repo")
+	require.NoError(t, os.MkdirAll(filepath.Join(repoDir, "data"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(repoDir, "data", "pack"), make([]byte, 100), 0644))
+
+	// a cache that's in use isn't removed, even if it's over its limit
+	done := useCache(repoDir, 100, velerotest.NewLogger())
+	require.NoError(t, ioutil.WriteFile(filepath.Join(repoDir, "data", "pack-2"), make([]byte, 100), 0644))
+
+	useCache(repoDir, 100, velerotest.NewLogger())()
+	assert.DirExists(t, repoDir)
+
+	// once no command uses it, it's removed
+	done()
+	useCache(repoDir, 100, velerotest.NewLogger())()
+	_, err = os.Stat(repoDir)
+	assert.True(t, os.IsNotExist(err))
+}
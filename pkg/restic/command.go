@@ -18,6 +18,7 @@ package restic
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -30,10 +31,17 @@ type Command struct {
 	RepoIdentifier string
 	PasswordFile   string
 	CACertFile     string
+	CacheDir       string
+	UploadLimit    int64
+	DownloadLimit  int64
 	Dir            string
 	Args           []string
 	ExtraFlags     []string
 	Env            []string
+
+	// Stdin, if set, is what the command reads as its standard input, like the
+	// device of a block volume being backed up.
+	Stdin io.Reader
 }
 
 func (c *Command) RepoName() string {
@@ -56,13 +64,23 @@ func (c *Command) StringSlice() []string {
 		res = append(res, cacertFlag(c.CACertFile))
 	}
 
-	// If VELERO_SCRATCH_DIR is defined, put the restic cache within it. If not,
-	// allow restic to choose the location. This makes running either in-cluster
-	// or local (dev) work properly.
-	if scratch := os.Getenv("VELERO_SCRATCH_DIR"); scratch != "" {
+	// If the command has a cache directory, use it. If not and VELERO_SCRATCH_DIR is
+	// defined, put the restic cache within it. Otherwise, allow restic to choose the
+	// location. This makes running either in-cluster or local (dev) work properly.
+	if c.CacheDir != "" {
+		res = append(res, cacheDirFlag(c.CacheDir))
+	} else if scratch := os.Getenv("VELERO_SCRATCH_DIR"); scratch != "" {
 		res = append(res, cacheDirFlag(filepath.Join(scratch, ".cache", "restic")))
 	}
 
+	// rate limits are in bytes per second, and restic's flags in KiB per second.
+	if c.UploadLimit > 0 {
+		res = append(res, fmt.Sprintf("--limit-upload=%d", resticRateLimit(c.UploadLimit)))
+	}
+	if c.DownloadLimit > 0 {
+		res = append(res, fmt.Sprintf("--limit-download=%d", resticRateLimit(c.DownloadLimit)))
+	}
+
 	res = append(res, c.Args...)
 	res = append(res, c.ExtraFlags...)
 
@@ -79,6 +97,7 @@ func (c *Command) Cmd() *exec.Cmd {
 	parts := c.StringSlice()
 	cmd := exec.Command(parts[0], parts[1:]...)
 	cmd.Dir = c.Dir
+	cmd.Stdin = c.Stdin
 
 	if len(c.Env) > 0 {
 		cmd.Env = c.Env
@@ -38,6 +38,21 @@ func BackupCommand(repoIdentifier, passwordFile, path string, tags map[string]st
 	}
 }
 
+// BlockVolumeFileName is the name of the file that a block volume's data is stored
+// as in a restic snapshot.
+const BlockVolumeFileName = "volume"
+
+// BackupBlockCommand returns a Command for running a restic backup of a block volume,
+// whose data is read from the command's stdin.
+func BackupBlockCommand(repoIdentifier, passwordFile string, tags map[string]string) *Command {
+	return &Command{
+		Command:        "backup",
+		RepoIdentifier: repoIdentifier,
+		PasswordFile:   passwordFile,
+		ExtraFlags:     append(backupTagFlags(tags), "--host=velero", "--json", "--stdin", "--stdin-filename="+BlockVolumeFileName),
+	}
+}
+
 func backupTagFlags(tags map[string]string) []string {
 	var flags []string
 	for k, v := range tags {
@@ -58,6 +73,17 @@ func RestoreCommand(repoIdentifier, passwordFile, snapshotID, target string) *Co
 	}
 }
 
+// DumpCommand returns a Command for running a restic dump of a block volume's data
+// from a snapshot to the command's stdout.
+func DumpCommand(repoIdentifier, passwordFile, snapshotID string) *Command {
+	return &Command{
+		Command:        "dump",
+		RepoIdentifier: repoIdentifier,
+		PasswordFile:   passwordFile,
+		Args:           []string{snapshotID, "/" + BlockVolumeFileName},
+	}
+}
+
 // GetSnapshotCommand returns a Command for running a restic (get) snapshots.
 func GetSnapshotCommand(repoIdentifier, passwordFile string, tags map[string]string) *Command {
 	return &Command{
@@ -98,6 +124,23 @@ func PruneCommand(repoIdentifier string) *Command {
 	}
 }
 
+func CheckCommand(repoIdentifier string) *Command {
+	return &Command{
+		Command:        "check",
+		RepoIdentifier: repoIdentifier,
+	}
+}
+
+// KeyPasswdCommand changes the password of the repository's current key to the
+// contents of newPasswordFile.
+func KeyPasswdCommand(repoIdentifier, newPasswordFile string) *Command {
+	return &Command{
+		Command:        "key passwd",
+		RepoIdentifier: repoIdentifier,
+		ExtraFlags:     []string{fmt.Sprintf("--new-password-file=%s", newPasswordFile)},
+	}
+}
+
 func ForgetCommand(repoIdentifier, snapshotID string) *Command {
 	return &Command{
 		Command:        "forget",
@@ -113,6 +156,16 @@ func UnlockCommand(repoIdentifier string) *Command {
 	}
 }
 
+// RepoStatsCommand returns a command that reports the size of all of a repository's data,
+// as it's stored.
+func RepoStatsCommand(repoIdentifier string) *Command {
+	return &Command{
+		Command:        "stats",
+		RepoIdentifier: repoIdentifier,
+		ExtraFlags:     []string{"--mode=raw-data", "--json"},
+	}
+}
+
 func StatsCommand(repoIdentifier, passwordFile, snapshotID string) *Command {
 	return &Command{
 		Command:        "stats",
@@ -38,6 +38,21 @@ func TestBackupCommand(t *testing.T) {
 	assert.Equal(t, expected, c.ExtraFlags)
 }
 
+func TestBackupBlockCommand(t *testing.T) {
+	c := BackupBlockCommand("repo-id", "password-file", map[string]string{"foo": "bar"})
+
+	assert.Equal(t, "backup", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Equal(t, "password-file", c.PasswordFile)
+	assert.Empty(t, c.Dir)
+	assert.Empty(t, c.Args)
+
+	expected := []string{"--tag=foo=bar", "--host=velero", "--json", "--stdin", "--stdin-filename=volume"}
+	sort.Strings(expected)
+	sort.Strings(c.ExtraFlags)
+	assert.Equal(t, expected, c.ExtraFlags)
+}
+
 func TestRestoreCommand(t *testing.T) {
 	c := RestoreCommand("repo-id", "password-file", "snapshot-id", "target")
 
@@ -49,6 +64,16 @@ func TestRestoreCommand(t *testing.T) {
 	assert.Equal(t, []string{"--target=."}, c.ExtraFlags)
 }
 
+func TestDumpCommand(t *testing.T) {
+	c := DumpCommand("repo-id", "password-file", "snapshot-id")
+
+	assert.Equal(t, "dump", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Equal(t, "password-file", c.PasswordFile)
+	assert.Equal(t, []string{"snapshot-id", "/volume"}, c.Args)
+	assert.Empty(t, c.ExtraFlags)
+}
+
 func TestGetSnapshotCommand(t *testing.T) {
 	expectedTags := map[string]string{"foo": "bar", "c": "d"}
 	c := GetSnapshotCommand("repo-id", "password-file", expectedTags)
@@ -110,6 +135,21 @@ func TestPruneCommand(t *testing.T) {
 	assert.Equal(t, "repo-id", c.RepoIdentifier)
 }
 
+func TestCheckCommand(t *testing.T) {
+	c := CheckCommand("repo-id")
+
+	assert.Equal(t, "check", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+}
+
+func TestKeyPasswdCommand(t *testing.T) {
+	c := KeyPasswdCommand("repo-id", "/tmp/new-password")
+
+	assert.Equal(t, "key passwd", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Equal(t, []string{"--new-password-file=/tmp/new-password"}, c.ExtraFlags)
+}
+
 func TestForgetCommand(t *testing.T) {
 	c := ForgetCommand("repo-id", "snapshot-id")
 
@@ -127,3 +167,12 @@ func TestStatsCommand(t *testing.T) {
 	assert.Equal(t, []string{"snapshot-id"}, c.Args)
 	assert.Equal(t, []string{"--json"}, c.ExtraFlags)
 }
+
+func TestRepoStatsCommand(t *testing.T) {
+	c := RepoStatsCommand("repo-id")
+
+	assert.Equal(t, "stats", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Empty(t, c.Args)
+	assert.Equal(t, []string{"--mode=raw-data", "--json"}, c.ExtraFlags)
+}
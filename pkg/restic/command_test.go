@@ -72,6 +72,20 @@ func TestStringSlice(t *testing.T) {
 	}, c.StringSlice())
 
 	require.NoError(t, os.Unsetenv("VELERO_SCRATCH_DIR"))
+
+	c.UploadLimit = 10 << 20
+	c.DownloadLimit = 100
+	assert.Equal(t, []string{
+		"restic",
+		"cmd",
+		"--repo=repo-id",
+		"--password-file=/path/to/password-file",
+		"--limit-upload=10240",
+		"--limit-download=1",
+		"arg-1",
+		"arg-2",
+		"--foo=bar",
+	}, c.StringSlice())
 }
 
 func TestString(t *testing.T) {
@@ -29,6 +29,7 @@ import (
 	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -45,10 +46,19 @@ const (
 	// to workload pods to help with restores.
 	InitContainer = "restic-wait"
 
+	// BlockRestoresVolume is the name of the emptyDir volume added to workload pods
+	// being restored, which holds the init container's done files for their raw block
+	// volumes, since the done files can't be written to the volumes themselves.
+	BlockRestoresVolume = "velero-block-restores"
+
 	// DefaultMaintenanceFrequency is the default time interval
 	// at which restic prune is run.
 	DefaultMaintenanceFrequency = 7 * 24 * time.Hour
 
+	// DefaultCheckFrequency is the default time interval at which
+	// restic check is run. Zero disables scheduled checks.
+	DefaultCheckFrequency = 7 * 24 * time.Hour
+
 	// DefaultVolumesToRestic specifies whether restic should be used, by default, to
 	// take backup of all pod volumes.
 	DefaultVolumesToRestic = false
@@ -121,6 +131,27 @@ func GetVolumeBackupsForPod(podVolumeBackups []*velerov1api.PodVolumeBackup, pod
 	return getPodSnapshotAnnotations(pod)
 }
 
+// getVolumeUploaderTypes returns a map, of volume name -> uploader type, of the
+// volumes of the provided pod that GetVolumeBackupsForPod returns. Volumes whose
+// PodVolumeBackups don't have an uploader type, or that are only known from the
+// pod's annotations, were backed up by restic.
+func getVolumeUploaderTypes(podVolumeBackups []*velerov1api.PodVolumeBackup, pod metav1.Object) map[string]velerov1api.UploaderType {
+	uploaderTypes := make(map[string]velerov1api.UploaderType)
+	for volume := range GetVolumeBackupsForPod(podVolumeBackups, pod) {
+		uploaderTypes[volume] = velerov1api.UploaderTypeRestic
+	}
+
+	for _, pvb := range podVolumeBackups {
+		if pod.GetName() != pvb.Spec.Pod.Name || pvb.Status.SnapshotID == "" || pvb.Spec.UploaderType == "" {
+			continue
+		}
+
+		uploaderTypes[pvb.Spec.Volume] = pvb.Spec.UploaderType
+	}
+
+	return uploaderTypes
+}
+
 // GetVolumesToBackup returns a list of volume names to backup for
 // the provided pod.
 // Deprecated: Use GetPodVolumesUsingRestic instead.
@@ -186,6 +217,11 @@ func GetPodVolumesUsingRestic(pod *corev1api.Pod, defaultVolumesToRestic bool) [
 		if strings.HasPrefix(pv.Name, "default-token") {
 			continue
 		}
+		// don't include the volume that velero added to hold the done files of restored
+		// block volumes.
+		if pv.Name == BlockRestoresVolume {
+			continue
+		}
 		podVolumes = append(podVolumes, pv.Name)
 	}
 	return podVolumes
@@ -204,6 +240,9 @@ type SnapshotIdentifier struct {
 
 	// SnapshotID is the short ID of the restic snapshot.
 	SnapshotID string
+
+	// UploaderType is the uploader that took the snapshot.
+	UploaderType velerov1api.UploaderType
 }
 
 // GetSnapshotsInBackup returns a list of all restic snapshot ids associated with
@@ -227,34 +266,76 @@ func GetSnapshotsInBackup(backup *velerov1api.Backup, podVolumeBackupLister vele
 			VolumeNamespace:       item.Spec.Pod.Namespace,
 			BackupStorageLocation: backup.Spec.StorageLocation,
 			SnapshotID:            item.Status.SnapshotID,
+			UploaderType:          item.Spec.UploaderType,
 		})
 	}
 
 	return res, nil
 }
 
-// TempCredentialsFile creates a temp file containing a restic
-// encryption key for the given repo and returns its path. The
-// caller should generally call os.Remove() to remove the file
-// when done with it.
-func TempCredentialsFile(secretLister corev1listers.SecretLister, veleroNamespace, repoName string, fs filesystem.Interface) (string, error) {
-	secretGetter := NewListerSecretGetter(secretLister)
-
-	// For now, all restic repos share the same key so we don't need the repoName to fetch it.
-	// When we move to full-backup encryption, we'll likely have a separate key per restic repo
-	// (all within the Velero server's namespace) so GetRepositoryKey will need to take the repo
-	// name as an argument as well.
-	repoKey, err := GetRepositoryKey(secretGetter, veleroNamespace)
+// GetSnapshotsInOtherBackups returns the IDs of the restic snapshots of pod volume backups
+// of backups other than the given one. A snapshot of a volume that hadn't changed is shared
+// by the pod volume backups of several backups, and is only forgotten with the last of them.
+// Pod volume backups that are still in progress are included, since one that reuses a snapshot
+// records its ID before it completes.
+func GetSnapshotsInOtherBackups(backup *velerov1api.Backup, podVolumeBackupLister velerov1listers.PodVolumeBackupLister) (sets.String, error) {
+	podVolumeBackups, err := podVolumeBackupLister.List(labels.Everything())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	res := sets.NewString()
+	for _, item := range podVolumeBackups {
+		if item.Status.SnapshotID == "" || item.Labels[velerov1api.BackupNameLabel] == label.GetValidName(backup.Name) {
+			continue
+		}
+		res.Insert(item.Status.SnapshotID)
+	}
+
+	return res, nil
+}
+
+// GetRepository returns the BackupRepository with the given identifier, or nil
+// if there isn't one.
+func GetRepository(client kbclient.Client, namespace, repoIdentifier string) (*velerov1api.BackupRepository, error) {
+	repos := &velerov1api.BackupRepositoryList{}
+	if err := client.List(context.Background(), repos, kbclient.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrap(err, "error listing restic repositories")
+	}
+
+	for i := range repos.Items {
+		if repos.Items[i].Spec.RepoIdentifier == repoIdentifier {
+			return &repos.Items[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// TempCredentialsFile creates a temp file containing the restic
+// encryption key of the repository with the given identifier and
+// returns its path. If the repository doesn't have its own key, the
+// file contains the common repository key. The caller should
+// generally call os.Remove() to remove the file when done with it.
+func TempCredentialsFile(secretLister corev1listers.SecretLister, veleroNamespace, repoIdentifier string, fs filesystem.Interface) (string, error) {
+	keyName := RepoKeyName(repoIdentifier)
+	repoKey, err := GetRepoKey(NewListerSecretGetter(secretLister), veleroNamespace, keyName)
 	if err != nil {
 		return "", err
 	}
 
+	return tempKeyFile(repoKey, keyName, fs)
+}
+
+// tempKeyFile creates a temp file containing a repository key and
+// returns its path.
+func tempKeyFile(key []byte, repoName string, fs filesystem.Interface) (string, error) {
 	file, err := fs.TempFile("", fmt.Sprintf("%s-%s", CredentialsSecretName, repoName))
 	if err != nil {
 		return "", errors.WithStack(err)
 	}
 
-	if _, err := file.Write(repoKey); err != nil {
+	if _, err := file.Write(key); err != nil {
 		// nothing we can do about an error closing the file here, and we're
 		// already returning an error about the write failing.
 		file.Close()
@@ -134,6 +134,26 @@ func TestGetVolumeBackupsForPod(t *testing.T) {
 	}
 }
 
+func TestGetVolumeUploaderTypes(t *testing.T) {
+	pod := &corev1api.Pod{}
+	pod.Name = "TestPod"
+	pod.Annotations = map[string]string{podAnnotationPrefix + "annotated": "abc"}
+
+	assert.Equal(t, map[string]velerov1api.UploaderType{"annotated": velerov1api.UploaderTypeRestic}, getVolumeUploaderTypes(nil, pod))
+
+	podVolumeBackups := []*velerov1api.PodVolumeBackup{
+		builder.ForPodVolumeBackup("velero", "pvb-1").PodName("TestPod").SnapshotID("bar").Volume("restic-1").Result(),
+		builder.ForPodVolumeBackup("velero", "pvb-2").PodName("TestPod").SnapshotID("123").Volume("restic-2").UploaderType(velerov1api.UploaderTypeRestic).Result(),
+		builder.ForPodVolumeBackup("velero", "pvb-3").PodName("TestPod").SnapshotID("k456").Volume("kopia-1").UploaderType(velerov1api.UploaderTypeKopia).Result(),
+		builder.ForPodVolumeBackup("velero", "pvb-4").PodName("TestPod").Volume("empty").UploaderType(velerov1api.UploaderTypeKopia).Result(),
+	}
+	assert.Equal(t, map[string]velerov1api.UploaderType{
+		"restic-1": velerov1api.UploaderTypeRestic,
+		"restic-2": velerov1api.UploaderTypeRestic,
+		"kopia-1":  velerov1api.UploaderTypeKopia,
+	}, getVolumeUploaderTypes(podVolumeBackups, pod))
+}
+
 func TestGetVolumesToBackup(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -381,6 +401,18 @@ func TestTempCredentialsFile(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.Equal(t, "passw0rd", string(contents))
+
+	// repository with its own key: expect temp file to be created with its key
+	secret.Data[RepoKeyName("s3:s3.amazonaws.com/bucket/restic/ns-1")] = []byte(`{"provider":"secret","key":"cmVwby1wYXNzdzByZA=="}`)
+	require.NoError(t, secretInformer.GetStore().Update(secret))
+
+	fileName, err = TempCredentialsFile(secretLister, "velero", "s3:s3.amazonaws.com/bucket/restic/ns-1", fs)
+	require.NoError(t, err)
+
+	contents, err = fs.ReadFile(fileName)
+	require.NoError(t, err)
+
+	assert.Equal(t, "repo-passw0rd", string(contents))
 }
 
 func TestTempCACertFile(t *testing.T) {
@@ -485,6 +517,21 @@ func TestGetPodVolumesUsingRestic(t *testing.T) {
 			},
 			expected: []string{"resticPV1", "resticPV2", "resticPV3"},
 		},
+		{
+			name:                   "should exclude the block restores volume from restic backup",
+			defaultVolumesToRestic: true,
+			pod: &corev1api.Pod{
+				Spec: corev1api.PodSpec{
+					Volumes: []corev1api.Volume{
+						// Restic Volumes
+						{Name: "resticPV1"}, {Name: "resticPV2"}, {Name: "resticPV3"},
+						/// Excluded from restic because it holds the done files of restored block volumes
+						{Name: "velero-block-restores"},
+					},
+				},
+			},
+			expected: []string{"resticPV1", "resticPV2", "resticPV3"},
+		},
 		{
 			name:                   "should exclude host path volumes from restic backups",
 			defaultVolumesToRestic: true,
@@ -569,3 +616,23 @@ func newFakeClient(t *testing.T, initObjs ...runtime.Object) client.Client {
 	require.NoError(t, err)
 	return k8sfake.NewFakeClientWithScheme(scheme.Scheme, initObjs...)
 }
+
+func TestGetSnapshotsInOtherBackups(t *testing.T) {
+	var (
+		clientset       = fake.NewSimpleClientset()
+		veleroInformers = informers.NewSharedInformerFactory(clientset, 0)
+	)
+
+	for _, pvb := range []*velerov1api.PodVolumeBackup{
+		builder.ForPodVolumeBackup("velero", "pvb-1").ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-1")).SnapshotID("snap-1").Result(),
+		builder.ForPodVolumeBackup("velero", "pvb-2").ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-2")).SnapshotID("snap-1").Result(),
+		builder.ForPodVolumeBackup("velero", "pvb-3").ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-2")).SnapshotID("snap-2").Result(),
+		builder.ForPodVolumeBackup("velero", "pvb-4").ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-3")).Result(),
+	} {
+		require.NoError(t, veleroInformers.Velero().V1().PodVolumeBackups().Informer().GetStore().Add(pvb))
+	}
+
+	res, err := GetSnapshotsInOtherBackups(builder.ForBackup("velero", "backup-1").Result(), veleroInformers.Velero().V1().PodVolumeBackups().Lister())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"snap-1", "snap-2"}, res.List())
+}
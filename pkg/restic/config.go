@@ -44,15 +44,24 @@ const (
 var getAWSBucketRegion = getBucketRegion
 
 // getRepoPrefix returns the prefix of the value of the --repo flag for
-// restic commands, i.e. everything except the "/<repo-name>".
-func getRepoPrefix(location *velerov1api.BackupStorageLocation) (string, error) {
+// restic commands, i.e. everything except the "/<repo-name>". Kopia
+// repositories are stored in the location's kopia directory instead
+// of its restic one.
+func getRepoPrefix(location *velerov1api.BackupStorageLocation, uploaderType velerov1api.UploaderType) (string, error) {
 	var bucket, prefix string
 
 	if location.Spec.ObjectStorage != nil {
-		layout := persistence.NewObjectStoreLayout(location.Spec.ObjectStorage.Prefix)
+		storagePrefix, err := persistence.Prefix(location)
+		if err != nil {
+			return "", err
+		}
+		layout := persistence.NewObjectStoreLayout(storagePrefix)
 
 		bucket = location.Spec.ObjectStorage.Bucket
 		prefix = layout.GetResticDir()
+		if uploaderType == velerov1api.UploaderTypeKopia {
+			prefix = layout.GetKopiaDir()
+		}
 	}
 
 	var provider = location.Spec.Provider
@@ -61,6 +70,9 @@ func getRepoPrefix(location *velerov1api.BackupStorageLocation) (string, error)
 	}
 
 	if repoPrefix := location.Spec.Config["resticRepoPrefix"]; repoPrefix != "" {
+		if uploaderType == velerov1api.UploaderTypeKopia {
+			return strings.TrimSuffix(repoPrefix, "/") + "/kopia", nil
+		}
 		return repoPrefix, nil
 	}
 
@@ -94,7 +106,18 @@ func getRepoPrefix(location *velerov1api.BackupStorageLocation) (string, error)
 // GetRepoIdentifier returns the string to be used as the value of the --repo flag in
 // restic commands for the given repository.
 func GetRepoIdentifier(location *velerov1api.BackupStorageLocation, name string) (string, error) {
-	prefix, err := getRepoPrefix(location)
+	return getUploaderRepoIdentifier(location, name, velerov1api.UploaderTypeRestic)
+}
+
+// GetKopiaRepoIdentifier returns the identifier of the given kopia repository. It has
+// the same format as a restic repository identifier, and is turned into the storage
+// flags of kopia commands by kopiaStorageFlags.
+func GetKopiaRepoIdentifier(location *velerov1api.BackupStorageLocation, name string) (string, error) {
+	return getUploaderRepoIdentifier(location, name, velerov1api.UploaderTypeKopia)
+}
+
+func getUploaderRepoIdentifier(location *velerov1api.BackupStorageLocation, name string, uploaderType velerov1api.UploaderType) (string, error) {
+	prefix, err := getRepoPrefix(location, uploaderType)
 	if err != nil {
 		return "", err
 	}
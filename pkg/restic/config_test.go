@@ -179,3 +179,27 @@ func TestGetRepoIdentifier(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "custom:prefix:/restic/repo-1", id)
 }
+
+func TestGetKopiaRepoIdentifier(t *testing.T) {
+	backupLocation := &velerov1api.BackupStorageLocation{
+		Spec: velerov1api.BackupStorageLocationSpec{
+			Provider: "azure",
+			StorageType: velerov1api.StorageType{
+				ObjectStorage: &velerov1api.ObjectStorageLocation{
+					Bucket: "bucket",
+					Prefix: "prefix",
+				},
+			},
+		},
+	}
+	id, err := GetKopiaRepoIdentifier(backupLocation, "repo-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "azure:bucket:/prefix/kopia/repo-1", id)
+
+	backupLocation.Spec.Config = map[string]string{
+		"resticRepoPrefix": "s3:http://minio:9000/bucket/restic/",
+	}
+	id, err = GetKopiaRepoIdentifier(backupLocation, "repo-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "s3:http://minio:9000/bucket/restic/kopia/repo-1", id)
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// MaxDataPathConcurrency is the most data paths that a node runs at a time.
+const MaxDataPathConcurrency = 32
+
+// DataPathRetryInterval is how often a data path that's waiting to start checks whether it can.
+const DataPathRetryInterval = 5 * time.Second
+
+// DataPathConfig is how a node runs its data paths, the uploader commands that back up and
+// restore pod volumes.
+type DataPathConfig struct {
+	// Concurrency is how many data paths the node runs at a time, counting both backups
+	// and restores.
+	Concurrency int
+
+	// CPUBudget is the number of millicores that the node's data paths use in total. Each
+	// data path that runs at a time gets an equal share. If zero, CPU isn't limited.
+	CPUBudget int64
+
+	// MemoryBudget is the number of bytes of memory that the node's data paths use in total.
+	// Each data path that runs at a time gets an equal share. If zero, memory isn't limited.
+	MemoryBudget int64
+
+	// MaxLoadAverage, if positive, is the node's 1-minute load average above which no data
+	// paths are started.
+	MaxLoadAverage float64
+}
+
+// dataPaths are the data paths of this node.
+var dataPaths = struct {
+	sync.Mutex
+
+	config  DataPathConfig
+	running int
+
+	// loadAverage returns the node's 1-minute load average.
+	loadAverage func() (float64, error)
+}{
+	config:      DataPathConfig{Concurrency: 1},
+	loadAverage: nodeLoadAverage,
+}
+
+// SetDataPathConfig sets how this node runs its data paths. It can be called while data
+// paths are running, whose number is then only reduced to the new concurrency as they end.
+func SetDataPathConfig(config DataPathConfig) {
+	if config.Concurrency < 1 {
+		config.Concurrency = 1
+	}
+	if config.Concurrency > MaxDataPathConcurrency {
+		config.Concurrency = MaxDataPathConcurrency
+	}
+
+	dataPaths.Lock()
+	defer dataPaths.Unlock()
+
+	dataPaths.config = config
+}
+
+// GetDataPathConfig returns how this node runs its data paths.
+func GetDataPathConfig() DataPathConfig {
+	dataPaths.Lock()
+	defer dataPaths.Unlock()
+
+	return dataPaths.config
+}
+
+// TryAcquireDataPath starts a data path if this node can, which is when fewer than its
+// concurrency are running and its load average isn't above its maximum. It returns a
+// function that must be called when the data path ends, or if the node can't start it,
+// nil and why not. It doesn't wait, so that a request that can't start yet stays new and
+// is tried again later, rather than holding up a worker.
+func TryAcquireDataPath(log logrus.FieldLogger) (func(), string) {
+	if ok, reason := tryAcquireDataPath(log); !ok {
+		return nil, reason
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			dataPaths.Lock()
+			defer dataPaths.Unlock()
+
+			dataPaths.running--
+		})
+	}, ""
+}
+
+// tryAcquireDataPath starts a data path if this node can, or else returns why it can't.
+func tryAcquireDataPath(log logrus.FieldLogger) (bool, string) {
+	dataPaths.Lock()
+	defer dataPaths.Unlock()
+
+	if dataPaths.running >= dataPaths.config.Concurrency {
+		return false, fmt.Sprintf("the node is already running %d data paths", dataPaths.running)
+	}
+
+	if dataPaths.config.MaxLoadAverage > 0 {
+		load, err := dataPaths.loadAverage()
+		if err != nil {
+			// the load average is only a hint, so failing to read it doesn't hold data paths up.
+			log.WithError(err).Debug("Error reading node load average")
+		} else if load > dataPaths.config.MaxLoadAverage {
+			return false, fmt.Sprintf("the node's load average %.2f is above %.2f", load, dataPaths.config.MaxLoadAverage)
+		}
+	}
+
+	dataPaths.running++
+	return true, ""
+}
+
+// DataPathEnv returns the environment of a data path's uploader commands, which is env, or
+// this process' environment if env is empty, with the data path's share of the node's CPU
+// and memory budgets. Restic and kopia are limited to them through the Go runtime.
+func DataPathEnv(env []string) []string {
+	config := GetDataPathConfig()
+	if config.CPUBudget <= 0 && config.MemoryBudget <= 0 {
+		return env
+	}
+
+	if len(env) == 0 {
+		env = os.Environ()
+	}
+
+	res := append([]string{}, env...)
+
+	if config.CPUBudget > 0 {
+		// GOMAXPROCS is a number of CPUs, which can't be less than one.
+		procs := config.CPUBudget / int64(config.Concurrency) / 1000
+		if procs < 1 {
+			procs = 1
+		}
+		res = append(res, fmt.Sprintf("GOMAXPROCS=%d", procs))
+	}
+
+	if config.MemoryBudget > 0 {
+		res = append(res, fmt.Sprintf("GOMEMLIMIT=%d", config.MemoryBudget/int64(config.Concurrency)))
+	}
+
+	return res
+}
+
+// nodeLoadAverage returns the node's 1-minute load average, which /proc/loadavg isn't
+// namespaced for.
+func nodeLoadAverage() (float64, error) {
+	content, err := ioutil.ReadFile("/proc/loadavg")
+	if err != nil {
+		return 0, errors.Wrap(err, "error reading /proc/loadavg")
+	}
+
+	return parseLoadAverage(string(content))
+}
+
+// parseLoadAverage returns the 1-minute load average in the content of /proc/loadavg.
+func parseLoadAverage(content string) (float64, error) {
+	fields := strings.Fields(content)
+	if len(fields) == 0 {
+		return 0, errors.New("/proc/loadavg is empty")
+	}
+
+	load, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "error parsing load average %q", fields[0])
+	}
+
+	return load, nil
+}
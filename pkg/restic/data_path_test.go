@@ -0,0 +1,167 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestSetDataPathConfig(t *testing.T) {
+	defer SetDataPathConfig(DataPathConfig{})
+
+	SetDataPathConfig(DataPathConfig{})
+	assert.Equal(t, 1, GetDataPathConfig().Concurrency)
+
+	SetDataPathConfig(DataPathConfig{Concurrency: 1000})
+	assert.Equal(t, MaxDataPathConcurrency, GetDataPathConfig().Concurrency)
+
+	SetDataPathConfig(DataPathConfig{Concurrency: 4, MaxLoadAverage: 8})
+	assert.Equal(t, DataPathConfig{Concurrency: 4, MaxLoadAverage: 8}, GetDataPathConfig())
+}
+
+func TestTryAcquireDataPath(t *testing.T) {
+	defer func() {
+		SetDataPathConfig(DataPathConfig{})
+		dataPaths.loadAverage = nodeLoadAverage
+	}()
+
+	var (
+		load    float64
+		loadErr error
+	)
+	dataPaths.loadAverage = func() (float64, error) { return load, loadErr }
+
+	SetDataPathConfig(DataPathConfig{Concurrency: 2, MaxLoadAverage: 4})
+
+	load = 1
+	ok, _ := tryAcquireDataPath(velerotest.NewLogger())
+	require.True(t, ok)
+
+	// no data paths start while the load average is too high.
+	load = 5
+	ok, reason := tryAcquireDataPath(velerotest.NewLogger())
+	assert.False(t, ok)
+	assert.Equal(t, "the node's load average 5.00 is above 4.00", reason)
+
+	// failing to read the load average doesn't hold data paths up.
+	loadErr = errors.New("no /proc")
+	ok, _ = tryAcquireDataPath(velerotest.NewLogger())
+	require.True(t, ok)
+
+	// no more data paths than the concurrency start.
+	loadErr = nil
+	load = 1
+	ok, reason = tryAcquireDataPath(velerotest.NewLogger())
+	assert.False(t, ok)
+	assert.Equal(t, "the node is already running 2 data paths", reason)
+
+	// raising the concurrency starts another data path right away.
+	SetDataPathConfig(DataPathConfig{Concurrency: 3})
+	ok, _ = tryAcquireDataPath(velerotest.NewLogger())
+	assert.True(t, ok)
+
+	dataPaths.running = 0
+}
+
+func TestTryAcquireDataPathRelease(t *testing.T) {
+	defer SetDataPathConfig(DataPathConfig{})
+	SetDataPathConfig(DataPathConfig{Concurrency: 1})
+
+	release, _ := TryAcquireDataPath(velerotest.NewLogger())
+	require.NotNil(t, release)
+
+	// the node is busy, so the data path doesn't start, and doesn't wait to.
+	waiting, reason := TryAcquireDataPath(velerotest.NewLogger())
+	assert.Nil(t, waiting)
+	assert.Equal(t, "the node is already running 1 data paths", reason)
+
+	// releasing twice only ends the data path once.
+	release()
+	release()
+	assert.Equal(t, 0, dataPaths.running)
+
+	release, _ = TryAcquireDataPath(velerotest.NewLogger())
+	require.NotNil(t, release)
+	release()
+}
+
+func TestDataPathEnv(t *testing.T) {
+	defer SetDataPathConfig(DataPathConfig{})
+
+	tests := []struct {
+		name   string
+		config DataPathConfig
+		env    []string
+		want   []string
+	}{
+		{
+			name:   "no budgets",
+			config: DataPathConfig{Concurrency: 2},
+			env:    []string{"AWS_REGION=us-east-1"},
+			want:   []string{"AWS_REGION=us-east-1"},
+		},
+		{
+			name:   "the CPU budget is shared by the concurrent data paths",
+			config: DataPathConfig{Concurrency: 2, CPUBudget: 4000},
+			env:    []string{"AWS_REGION=us-east-1"},
+			want:   []string{"AWS_REGION=us-east-1", "GOMAXPROCS=2"},
+		},
+		{
+			name:   "a CPU share isn't rounded down to zero",
+			config: DataPathConfig{Concurrency: 4, CPUBudget: 500},
+			env:    []string{"AWS_REGION=us-east-1"},
+			want:   []string{"AWS_REGION=us-east-1", "GOMAXPROCS=1"},
+		},
+		{
+			name:   "the memory budget is shared by the concurrent data paths",
+			config: DataPathConfig{Concurrency: 4, MemoryBudget: 4 << 30},
+			env:    []string{"AWS_REGION=us-east-1"},
+			want:   []string{"AWS_REGION=us-east-1", "GOMEMLIMIT=1073741824"},
+		},
+		{
+			name:   "both budgets are shared by the concurrent data paths",
+			config: DataPathConfig{Concurrency: 2, CPUBudget: 4000, MemoryBudget: 4 << 30},
+			env:    []string{"AWS_REGION=us-east-1"},
+			want:   []string{"AWS_REGION=us-east-1", "GOMAXPROCS=2", "GOMEMLIMIT=2147483648"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			SetDataPathConfig(test.config)
+			assert.Equal(t, test.want, DataPathEnv(test.env))
+		})
+	}
+}
+
+func TestParseLoadAverage(t *testing.T) {
+	load, err := parseLoadAverage("2.50 1.75 1.20 3/512 12345\n")
+	require.NoError(t, err)
+	assert.Equal(t, 2.5, load)
+
+	_, err = parseLoadAverage("")
+	assert.Error(t, err)
+
+	_, err = parseLoadAverage("high 1.75 1.20 3/512 12345\n")
+	assert.Error(t, err)
+}
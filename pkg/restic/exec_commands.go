@@ -20,6 +20,8 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -54,6 +56,12 @@ func GetSnapshotID(repoIdentifier, passwordFile string, tags map[string]string,
 	}
 	cmd.CACertFile = caCertFile
 
+	return getSnapshotID(cmd)
+}
+
+// getSnapshotID runs a 'restic snapshots' command that matches a single snapshot,
+// and returns the snapshot's ID.
+func getSnapshotID(cmd *Command) (string, error) {
 	stdout, stderr, err := exec.RunCommand(cmd.Cmd())
 	if err != nil {
 		return "", errors.Wrapf(err, "error running command, stderr=%s", stderr)
@@ -191,7 +199,7 @@ func getSummaryLine(b []byte) ([]byte, error) {
 // RunRestore runs a `restic restore` command and monitors the volume size to
 // provide progress updates to the caller.
 func RunRestore(restoreCmd *Command, log logrus.FieldLogger, updateFunc func(velerov1api.PodVolumeOperationProgress)) (string, string, error) {
-	snapshotSize, err := getSnapshotSize(restoreCmd.RepoIdentifier, restoreCmd.PasswordFile, restoreCmd.CACertFile, restoreCmd.Args[0], restoreCmd.Env)
+	snapshotSize, err := getSnapshotSize(restoreCmd, restoreCmd.Args[0])
 	if err != nil {
 		return "", "", errors.Wrap(err, "error getting snapshot size")
 	}
@@ -237,10 +245,87 @@ func RunRestore(restoreCmd *Command, log logrus.FieldLogger, updateFunc func(vel
 	return stdout, stderr, err
 }
 
-func getSnapshotSize(repoIdentifier, passwordFile, caCertFile, snapshotID string, env []string) (int64, error) {
-	cmd := StatsCommand(repoIdentifier, passwordFile, snapshotID)
-	cmd.Env = env
-	cmd.CACertFile = caCertFile
+// RunBlockRestore runs a `restic dump` command that writes a block volume's data to
+// its device, and counts the bytes written to provide progress updates to the caller.
+// It returns the command's stderr.
+func RunBlockRestore(dumpCmd *Command, device io.Writer, log logrus.FieldLogger, updateFunc func(velerov1api.PodVolumeOperationProgress)) (string, error) {
+	snapshotSize, err := getSnapshotSize(dumpCmd, dumpCmd.Args[0])
+	if err != nil {
+		return "", errors.Wrap(err, "error getting snapshot size")
+	}
+
+	updateFunc(velerov1api.PodVolumeOperationProgress{
+		TotalBytes: snapshotSize,
+	})
+
+	stdout := &countingWriter{writer: device}
+	stderrBuf := new(bytes.Buffer)
+
+	cmd := dumpCmd.Cmd()
+	cmd.Stdout = stdout
+	cmd.Stderr = stderrBuf
+
+	// create a channel to signal when to end the goroutine scanning for progress
+	// updates
+	quit := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(restoreProgressCheckInterval)
+		for {
+			select {
+			case <-ticker.C:
+				updateFunc(velerov1api.PodVolumeOperationProgress{
+					TotalBytes: snapshotSize,
+					BytesDone:  stdout.written(),
+				})
+			case <-quit:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+
+	err = cmd.Run()
+	quit <- struct{}{}
+	if err != nil {
+		return stderrBuf.String(), err
+	}
+
+	// update progress to 100%
+	updateFunc(velerov1api.PodVolumeOperationProgress{
+		TotalBytes: snapshotSize,
+		BytesDone:  snapshotSize,
+	})
+
+	return stderrBuf.String(), nil
+}
+
+// countingWriter is an io.Writer that counts the bytes written to its underlying
+// writer, so that they can be read while it's being written to.
+type countingWriter struct {
+	writer io.Writer
+	count  int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	atomic.AddInt64(&w.count, int64(n))
+	return n, err
+}
+
+func (w *countingWriter) written() int64 {
+	return atomic.LoadInt64(&w.count)
+}
+
+// getSnapshotSize returns the size of a snapshot in the repository of repoCmd, which it
+// accesses the same way as repoCmd.
+func getSnapshotSize(repoCmd *Command, snapshotID string) (int64, error) {
+	cmd := StatsCommand(repoCmd.RepoIdentifier, repoCmd.PasswordFile, snapshotID)
+	cmd.Env = repoCmd.Env
+	cmd.CACertFile = repoCmd.CACertFile
+	cmd.CacheDir = repoCmd.CacheDir
+	cmd.UploadLimit = repoCmd.UploadLimit
+	cmd.DownloadLimit = repoCmd.DownloadLimit
 
 	stdout, stderr, err := exec.RunCommand(cmd.Cmd())
 	if err != nil {
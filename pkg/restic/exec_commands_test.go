@@ -17,6 +17,7 @@ limitations under the License.
 package restic
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -108,3 +109,16 @@ func Test_getVolumeSize(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expectedSize, actualSize)
 }
+
+func Test_countingWriter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w := &countingWriter{writer: buf}
+
+	_, err := w.Write([]byte("block"))
+	assert.NoError(t, err)
+	_, err = w.Write([]byte("device"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "blockdevice", buf.String())
+	assert.Equal(t, int64(11), w.written())
+}
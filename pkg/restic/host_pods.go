@@ -0,0 +1,41 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"path/filepath"
+	"runtime"
+)
+
+// HostPodsDir returns the directory that the restic daemonset's pods mount the kubelet's
+// pods directory at, which depends on the operating system of the node.
+func HostPodsDir() string {
+	return hostPodsDir(runtime.GOOS)
+}
+
+func hostPodsDir(goos string) string {
+	if goos == "windows" {
+		return `C:\host_pods`
+	}
+	return "/host_pods"
+}
+
+// HostPodPath returns the path, under HostPodsDir, of the given elements of the directory
+// of the pod with the given UID. The elements can be glob patterns.
+func HostPodPath(podUID string, elem ...string) string {
+	return filepath.Join(append([]string{HostPodsDir(), podUID}, elem...)...)
+}
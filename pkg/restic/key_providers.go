@@ -0,0 +1,217 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/pkg/errors"
+)
+
+// The names of the key providers that repositories' own encryption keys can be wrapped by.
+const (
+	KeyProviderSecret = "secret"
+	KeyProviderAWSKMS = "aws-kms"
+	KeyProviderExec   = "exec"
+)
+
+// KeyProvider wraps the encryption keys of repositories that have their own key, so that
+// the keys are only stored in the restic credentials secret in wrapped form.
+type KeyProvider interface {
+	// Name returns the name of the provider.
+	Name() string
+
+	// WrapKey wraps the key of the repository with the given key name.
+	WrapKey(repoName string, key []byte) ([]byte, error)
+
+	// UnwrapKey unwraps the key of the repository with the given key name.
+	UnwrapKey(repoName string, wrapped []byte) ([]byte, error)
+}
+
+// NewKeyProvider returns the named key provider with the given configuration, or nil if
+// name is empty, which means that new repositories share the common repository key.
+func NewKeyProvider(name string, config map[string]string) (KeyProvider, error) {
+	switch name {
+	case "":
+		return nil, nil
+	case KeyProviderSecret:
+		return secretKeyProvider{}, nil
+	case KeyProviderAWSKMS:
+		if config["keyId"] == "" {
+			return nil, errors.Errorf("key provider %s requires a keyId", name)
+		}
+		return &awsKMSKeyProvider{keyID: config["keyId"], region: config["region"]}, nil
+	case KeyProviderExec:
+		if config["command"] == "" {
+			return nil, errors.Errorf("key provider %s requires a command", name)
+		}
+		return &execKeyProvider{command: strings.Fields(config["command"])}, nil
+	}
+
+	return nil, errors.Errorf("unsupported key provider %q, must be one of %s, %s or %s", name, KeyProviderSecret, KeyProviderAWSKMS, KeyProviderExec)
+}
+
+// keyProvider wraps the keys of new repositories, and of repositories whose key is rotated.
+// If nil, new repositories share the common repository key.
+var keyProvider KeyProvider
+
+// SetKeyProvider sets the key provider that wraps the keys of new repositories, and of
+// repositories whose key is rotated. It's meant to be called once, when a server starts.
+func SetKeyProvider(provider KeyProvider) {
+	keyProvider = provider
+}
+
+// keyProviderFor returns the key provider that a repository's key was wrapped by, which is
+// either the configured one or the secret one, which needs no configuration.
+func keyProviderFor(name string) (KeyProvider, error) {
+	if keyProvider != nil && keyProvider.Name() == name {
+		return keyProvider, nil
+	}
+	if name == KeyProviderSecret {
+		return secretKeyProvider{}, nil
+	}
+
+	configured := "none"
+	if keyProvider != nil {
+		configured = keyProvider.Name()
+	}
+	return nil, errors.Errorf("repository key is wrapped by key provider %q, but the configured key provider is %s", name, configured)
+}
+
+// secretKeyProvider doesn't wrap keys, so that repositories' keys are only protected by
+// the restic credentials secret, but each repository has its own.
+type secretKeyProvider struct{}
+
+func (secretKeyProvider) Name() string {
+	return KeyProviderSecret
+}
+
+func (secretKeyProvider) WrapKey(repoName string, key []byte) ([]byte, error) {
+	return key, nil
+}
+
+func (secretKeyProvider) UnwrapKey(repoName string, wrapped []byte) ([]byte, error) {
+	return wrapped, nil
+}
+
+// awsKMSKeyProvider encrypts keys with an AWS KMS key. The repository's key name is the
+// encryption context, so that a wrapped key can't be used for another repository.
+type awsKMSKeyProvider struct {
+	keyID  string
+	region string
+}
+
+func (p *awsKMSKeyProvider) Name() string {
+	return KeyProviderAWSKMS
+}
+
+func (p *awsKMSKeyProvider) client() (*kms.KMS, error) {
+	sess, err := session.NewSessionWithOptions(session.Options{SharedConfigState: session.SharedConfigEnable})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	config := aws.NewConfig()
+	if p.region != "" {
+		config = config.WithRegion(p.region)
+	}
+	return kms.New(sess, config), nil
+}
+
+func (p *awsKMSKeyProvider) encryptionContext(repoName string) map[string]*string {
+	return map[string]*string{"velero.io/restic-repository": aws.String(repoName)}
+}
+
+func (p *awsKMSKeyProvider) WrapKey(repoName string, key []byte) ([]byte, error) {
+	client, err := p.client()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Encrypt(&kms.EncryptInput{
+		KeyId:             aws.String(p.keyID),
+		Plaintext:         key,
+		EncryptionContext: p.encryptionContext(repoName),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error encrypting key of repository %s with AWS KMS key %s", repoName, p.keyID)
+	}
+
+	return res.CiphertextBlob, nil
+}
+
+func (p *awsKMSKeyProvider) UnwrapKey(repoName string, wrapped []byte) ([]byte, error) {
+	client, err := p.client()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Decrypt(&kms.DecryptInput{
+		CiphertextBlob:    wrapped,
+		EncryptionContext: p.encryptionContext(repoName),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decrypting key of repository %s with AWS KMS", repoName)
+	}
+
+	return res.Plaintext, nil
+}
+
+// execKeyProvider runs a command to wrap and unwrap keys, so that keys can be wrapped by
+// any KMS or secret store that there's a command for. The command is run with the
+// arguments "wrap <repository>" or "unwrap <repository>", is given the key on its standard
+// input, and writes the result to its standard output.
+type execKeyProvider struct {
+	command []string
+}
+
+func (p *execKeyProvider) Name() string {
+	return KeyProviderExec
+}
+
+func (p *execKeyProvider) run(operation, repoName string, input []byte) ([]byte, error) {
+	args := append(append([]string{}, p.command[1:]...), operation, repoName)
+	cmd := exec.Command(p.command[0], args...)
+	cmd.Stdin = bytes.NewReader(input)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	// the output is never logged, since it can be a key.
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "error running key provider command to %s key of repository %s, stderr=%s", operation, repoName, stderr.String())
+	}
+	if stdout.Len() == 0 {
+		return nil, errors.Errorf("key provider command to %s key of repository %s returned nothing", operation, repoName)
+	}
+
+	return stdout.Bytes(), nil
+}
+
+func (p *execKeyProvider) WrapKey(repoName string, key []byte) ([]byte, error) {
+	return p.run("wrap", repoName, key)
+}
+
+func (p *execKeyProvider) UnwrapKey(repoName string, wrapped []byte) ([]byte, error) {
+	return p.run("unwrap", repoName, wrapped)
+}
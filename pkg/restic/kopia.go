@@ -0,0 +1,511 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	veleroexec "github.com/vmware-tanzu/velero/pkg/util/exec"
+)
+
+const (
+	// kopiaUsername and kopiaHostname identify the snapshots taken by Velero in kopia
+	// repositories, and the owner of their maintenance, no matter which pod connects
+	// to the repository.
+	kopiaUsername = "velero"
+	kopiaHostname = "velero"
+
+	// kopiaRepoNotInitialized is part of the error that kopia returns when connecting
+	// to a repository that hasn't been initialized.
+	kopiaRepoNotInitialized = "repository not initialized"
+)
+
+// kopiaCommand represents a kopia command.
+type kopiaCommand struct {
+	// Command is the kopia command, with its subcommands, like "snapshot create".
+	Command        string
+	RepoIdentifier string
+	Args           []string
+	Env            []string
+}
+
+// StringSlice returns the command as a slice of strings.
+func (c *kopiaCommand) StringSlice() []string {
+	res := append([]string{"kopia"}, strings.Fields(c.Command)...)
+	res = append(res, fmt.Sprintf("--config-file=%s", filepath.Join(kopiaDir(".config"), kopiaRepoDirName(c.RepoIdentifier)+".config")))
+	res = append(res, fmt.Sprintf("--log-dir=%s", filepath.Join(kopiaDir(".logs"), kopiaRepoDirName(c.RepoIdentifier))))
+	return append(res, c.Args...)
+}
+
+// String returns the command as a string.
+func (c *kopiaCommand) String() string {
+	return strings.Join(c.StringSlice(), " ")
+}
+
+// Cmd returns an exec.Cmd for the command.
+func (c *kopiaCommand) Cmd() *exec.Cmd {
+	parts := c.StringSlice()
+	cmd := exec.Command(parts[0], parts[1:]...)
+	cmd.Env = c.Env
+	return cmd
+}
+
+// kopiaDir returns the directory of the given kind of kopia's files. If
+// VELERO_SCRATCH_DIR is defined, it's within it.
+func kopiaDir(kind string) string {
+	scratch := os.Getenv("VELERO_SCRATCH_DIR")
+	if scratch == "" {
+		scratch = os.TempDir()
+	}
+	return filepath.Join(scratch, kind, "kopia")
+}
+
+var kopiaRepoDirNameReplacer = regexp.MustCompile(`[^a-zA-Z0-9.-]+`)
+
+// kopiaRepoDirName returns the name of the files and directories of the repository with
+// the given identifier, which kopia keeps its configuration, cache and logs in.
+func kopiaRepoDirName(repoIdentifier string) string {
+	return kopiaRepoDirNameReplacer.ReplaceAllString(repoIdentifier, "_")
+}
+
+// kopiaStorageFlags returns the arguments of kopia's repository create and connect commands
+// that choose the storage of the repository with the given identifier. Identifiers have the
+// same format as restic's --repo flag.
+func kopiaStorageFlags(repoIdentifier string) ([]string, error) {
+	switch {
+	case strings.HasPrefix(repoIdentifier, "s3:"):
+		url := strings.TrimPrefix(repoIdentifier, "s3:")
+
+		var extraFlags []string
+		if strings.HasPrefix(url, "http://") {
+			extraFlags = append(extraFlags, "--disable-tls")
+		}
+		url = strings.TrimPrefix(strings.TrimPrefix(url, "http://"), "https://")
+
+		parts := strings.SplitN(url, "/", 3)
+		if len(parts) < 3 {
+			return nil, errors.Errorf("invalid kopia repository identifier %q, expected s3:<endpoint>/<bucket>/<prefix>", repoIdentifier)
+		}
+
+		return append([]string{"s3", "--endpoint=" + parts[0], "--bucket=" + parts[1], "--prefix=" + parts[2] + "/"}, extraFlags...), nil
+	case strings.HasPrefix(repoIdentifier, "azure:"), strings.HasPrefix(repoIdentifier, "gs:"):
+		parts := strings.SplitN(repoIdentifier, ":", 3)
+		if len(parts) < 3 || !strings.HasPrefix(parts[2], "/") {
+			return nil, errors.Errorf("invalid kopia repository identifier %q, expected %s:<bucket>:/<prefix>", repoIdentifier, parts[0])
+		}
+
+		prefix := "--prefix=" + strings.TrimPrefix(parts[2], "/") + "/"
+		if parts[0] == "azure" {
+			return []string{"azure", "--container=" + parts[1], prefix}, nil
+		}
+		return []string{"gcs", "--bucket=" + parts[1], prefix}, nil
+	case strings.HasPrefix(repoIdentifier, "/"):
+		return []string{"filesystem", "--path=" + repoIdentifier}, nil
+	}
+
+	return nil, errors.Errorf("unsupported kopia repository identifier %q", repoIdentifier)
+}
+
+// kopiaUploader is an Uploader that runs kopia commands. Kopia uploads the files
+// of a volume in parallel, and keeps less of the repository's index in memory than
+// restic does.
+type kopiaUploader struct {
+	log logrus.FieldLogger
+}
+
+func (u *kopiaUploader) InitRepo(repo RepoAccess) error {
+	return u.createOrConnect(repo, "repository create")
+}
+
+func (u *kopiaUploader) ConnectToRepo(repo RepoAccess) error {
+	return u.createOrConnect(repo, "repository connect")
+}
+
+func (u *kopiaUploader) createOrConnect(repo RepoAccess, command string) error {
+	storageFlags, err := kopiaStorageFlags(repo.Identifier)
+	if err != nil {
+		return err
+	}
+
+	cacheFlags := kopiaCacheFlags(repo)
+	args := append([]string{
+		fmt.Sprintf("--override-username=%s", kopiaUsername),
+		fmt.Sprintf("--override-hostname=%s", kopiaHostname),
+		"--no-persist-credentials",
+	}, cacheFlags...)
+
+	if _, err := u.run(repo, command+" "+storageFlags[0], append(storageFlags[1:], args...)...); err != nil {
+		return err
+	}
+
+	// kopia only reads the cache flags when connecting, so they're recorded to be able
+	// to tell when the repository's cache settings have changed since.
+	return errors.WithStack(ioutil.WriteFile(kopiaCacheFlagsFile(repo.Identifier), []byte(strings.Join(cacheFlags, " ")), 0600))
+}
+
+// kopiaCacheFlags returns the flags that configure kopia's cache for a repository.
+func kopiaCacheFlags(repo RepoAccess) []string {
+	cacheDir := repo.CacheDir
+	if cacheDir == "" {
+		cacheDir = filepath.Join(kopiaDir(".cache"), kopiaRepoDirName(repo.Identifier))
+	}
+
+	flags := []string{fmt.Sprintf("--cache-directory=%s", cacheDir)}
+
+	// kopia limits the sizes of its content and metadata caches itself, so the
+	// repository's limit is split between them.
+	if repo.CacheSizeLimit > 0 {
+		cacheSizeMB := repo.CacheSizeLimit / 2 / (1 << 20)
+		if cacheSizeMB < 1 {
+			cacheSizeMB = 1
+		}
+		flags = append(flags, fmt.Sprintf("--content-cache-size-mb=%d", cacheSizeMB), fmt.Sprintf("--metadata-cache-size-mb=%d", cacheSizeMB))
+	}
+
+	return flags
+}
+
+// kopiaCacheFlagsFile returns the file the cache flags a repository was last connected
+// with are recorded in.
+func kopiaCacheFlagsFile(repoIdentifier string) string {
+	return filepath.Join(kopiaDir(".config"), kopiaRepoDirName(repoIdentifier)+".cache")
+}
+
+func (u *kopiaUploader) PruneRepo(repo RepoAccess) error {
+	if err := u.throttle(repo); err != nil {
+		return err
+	}
+
+	_, err := u.runConnected(repo, "maintenance run", "--full")
+	return err
+}
+
+func (u *kopiaUploader) CheckRepo(repo RepoAccess) error {
+	if err := u.throttle(repo); err != nil {
+		return err
+	}
+
+	_, err := u.runConnected(repo, "snapshot verify")
+	return err
+}
+
+// throttle sets the rate limits of the repository's connection, which kopia keeps in its
+// configuration file, to the repository's current limits.
+func (u *kopiaUploader) throttle(repo RepoAccess) error {
+	_, err := u.runConnected(repo, "repository throttle set",
+		kopiaThrottleFlag("--upload-bytes-per-second", repo.UploadLimit),
+		kopiaThrottleFlag("--download-bytes-per-second", repo.DownloadLimit),
+	)
+	return errors.Wrap(err, "error setting kopia rate limits")
+}
+
+func kopiaThrottleFlag(flag string, limit int64) string {
+	if limit <= 0 {
+		return flag + "=unlimited"
+	}
+	return fmt.Sprintf("%s=%d", flag, limit)
+}
+
+// UnlockRepo does nothing, since kopia doesn't lock repositories.
+func (u *kopiaUploader) UnlockRepo(repo RepoAccess) error {
+	return nil
+}
+
+func (u *kopiaUploader) RepoSize(repo RepoAccess) (int64, error) {
+	stdout, err := u.runConnected(repo, "blob stats", "--raw")
+	if err != nil {
+		return 0, err
+	}
+
+	return parseKopiaBlobStatsTotal(stdout)
+}
+
+// parseKopiaBlobStatsTotal returns the total size of the blobs of a repository, from the
+// output of kopia's blob stats command run with --raw, which reports it in bytes on the
+// line starting with "Total:".
+func parseKopiaBlobStatsTotal(stdout string) (int64, error) {
+	for _, line := range strings.Split(stdout, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "Total:") {
+			continue
+		}
+
+		total, err := strconv.ParseInt(strings.TrimSpace(strings.TrimPrefix(line, "Total:")), 10, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "error parsing kopia blob stats total %q", line)
+		}
+		return total, nil
+	}
+
+	return 0, errors.Errorf("kopia blob stats output has no total: %s", stdout)
+}
+
+func (u *kopiaUploader) ChangeRepoPassword(repo RepoAccess, newPasswordFile string) error {
+	newPassword, err := ioutil.ReadFile(newPasswordFile)
+	if err != nil {
+		return errors.Wrap(err, "error reading new repository password")
+	}
+
+	// pass the new password in the environment so that it isn't logged with the command.
+	env := repo.Env
+	if len(env) == 0 {
+		env = os.Environ()
+	}
+	repo.Env = append(append([]string{}, env...), "KOPIA_NEW_PASSWORD="+string(newPassword))
+
+	_, err = u.runConnected(repo, "repository change-password")
+	return err
+}
+
+func (u *kopiaUploader) Forget(repo RepoAccess, snapshotID string) error {
+	_, err := u.runConnected(repo, "snapshot delete", snapshotID, "--delete")
+	return err
+}
+
+// kopiaSnapshot is the part of a kopia snapshot manifest that Velero uses.
+type kopiaSnapshot struct {
+	ID    string `json:"id"`
+	Stats struct {
+		TotalSize int64 `json:"totalSize"`
+	} `json:"stats"`
+}
+
+// Backup backs up a pod volume with kopia. Kopia finds the previous snapshot of a
+// volume's directory by itself, so req's ParentSnapshotID isn't used.
+func (u *kopiaUploader) Backup(repo RepoAccess, req UploaderBackupRequest, updateFunc func(velerov1api.PodVolumeOperationProgress)) (string, error) {
+	if req.Device != nil {
+		return "", errors.New("the kopia uploader doesn't support block volumes")
+	}
+	if len(req.Includes) > 0 {
+		return "", errors.New("the kopia uploader doesn't support volume path include patterns")
+	}
+
+	if err := u.throttle(repo); err != nil {
+		return "", err
+	}
+
+	// the volume's ignore rules are its policy, which is replaced by the ones of each backup.
+	policyArgs := []string{req.Path, "--clear-ignore"}
+	for _, pattern := range req.Excludes {
+		policyArgs = append(policyArgs, "--add-ignore="+pattern)
+	}
+	if _, err := u.runConnected(repo, "policy set", policyArgs...); err != nil {
+		return "", errors.Wrap(err, "error setting kopia ignore rules of the volume")
+	}
+
+	args := []string{req.Path, "--json"}
+	if tags := kopiaTagsFlag(req.Tags); tags != "" {
+		args = append(args, tags)
+	}
+
+	stdout, err := u.runConnected(repo, "snapshot create", args...)
+	if err != nil {
+		return "", errors.Wrap(err, "error running kopia backup")
+	}
+
+	var snapshot kopiaSnapshot
+	if err := json.Unmarshal([]byte(stdout), &snapshot); err != nil {
+		return "", errors.Wrap(err, "error unmarshalling kopia snapshot")
+	}
+
+	updateFunc(velerov1api.PodVolumeOperationProgress{
+		TotalBytes: snapshot.Stats.TotalSize,
+		BytesDone:  snapshot.Stats.TotalSize,
+	})
+
+	return snapshot.ID, nil
+}
+
+func kopiaTagsFlag(tags map[string]string) string {
+	var res []string
+	for k, v := range tags {
+		res = append(res, fmt.Sprintf("%s:%s", k, v))
+	}
+	sort.Strings(res)
+
+	if len(res) == 0 {
+		return ""
+	}
+	return "--tags=" + strings.Join(res, ",")
+}
+
+func (u *kopiaUploader) Restore(repo RepoAccess, req UploaderRestoreRequest, updateFunc func(velerov1api.PodVolumeOperationProgress)) error {
+	if req.Device != nil {
+		return errors.New("the kopia uploader doesn't support block volumes")
+	}
+
+	if err := u.throttle(repo); err != nil {
+		return err
+	}
+
+	snapshotSize, err := u.snapshotSize(repo, req.SnapshotID)
+	if err != nil {
+		return errors.Wrap(err, "error getting snapshot size")
+	}
+
+	updateFunc(velerov1api.PodVolumeOperationProgress{
+		TotalBytes: snapshotSize,
+	})
+
+	// create a channel to signal when to end the goroutine scanning for progress
+	// updates
+	quit := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(restoreProgressCheckInterval)
+		for {
+			select {
+			case <-ticker.C:
+				volumeSize, err := getVolumeSize(req.Path)
+				if err != nil {
+					u.log.WithError(err).Errorf("error getting kopia restore progress")
+				}
+
+				updateFunc(velerov1api.PodVolumeOperationProgress{
+					TotalBytes: snapshotSize,
+					BytesDone:  volumeSize,
+				})
+			case <-quit:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+
+	_, err = u.runConnected(repo, "snapshot restore", req.SnapshotID, req.Path)
+	quit <- struct{}{}
+	if err != nil {
+		return errors.Wrap(err, "error running kopia restore")
+	}
+
+	// update progress to 100%
+	updateFunc(velerov1api.PodVolumeOperationProgress{
+		TotalBytes: snapshotSize,
+		BytesDone:  snapshotSize,
+	})
+
+	return nil
+}
+
+func (u *kopiaUploader) snapshotSize(repo RepoAccess, snapshotID string) (int64, error) {
+	stdout, err := u.runConnected(repo, "snapshot list", "--all", "--json")
+	if err != nil {
+		return 0, err
+	}
+
+	var snapshots []kopiaSnapshot
+	if err := json.Unmarshal([]byte(stdout), &snapshots); err != nil {
+		return 0, errors.Wrap(err, "error unmarshalling kopia snapshot list")
+	}
+
+	for _, snapshot := range snapshots {
+		if snapshot.ID == snapshotID {
+			return snapshot.Stats.TotalSize, nil
+		}
+	}
+
+	return 0, errors.Errorf("snapshot %s not found", snapshotID)
+}
+
+// runConnected runs a kopia command against a repository, connecting to it first if
+// this pod hasn't connected to it yet, or if its cache settings have changed since.
+func (u *kopiaUploader) runConnected(repo RepoAccess, command string, args ...string) (string, error) {
+	configFile := filepath.Join(kopiaDir(".config"), kopiaRepoDirName(repo.Identifier)+".config")
+	_, err := os.Stat(configFile)
+	connected := err == nil
+
+	if connected {
+		cacheFlags, err := ioutil.ReadFile(kopiaCacheFlagsFile(repo.Identifier))
+		connected = err == nil && string(cacheFlags) == strings.Join(kopiaCacheFlags(repo), " ")
+	}
+
+	if !connected {
+		if err := u.ConnectToRepo(repo); err != nil {
+			return "", err
+		}
+	}
+
+	return u.run(repo, command, args...)
+}
+
+func (u *kopiaUploader) run(repo RepoAccess, command string, args ...string) (string, error) {
+	env, err := kopiaEnv(repo)
+	if err != nil {
+		return "", err
+	}
+
+	cmd := &kopiaCommand{
+		Command:        command,
+		RepoIdentifier: repo.Identifier,
+		Args:           args,
+		Env:            env,
+	}
+
+	stdout, stderr, err := veleroexec.RunCommand(cmd.Cmd())
+	u.log.WithFields(logrus.Fields{
+		"repository": repo.Identifier,
+		"command":    cmd.String(),
+		"stdout":     stdout,
+		"stderr":     stderr,
+	}).Debugf("Ran kopia command")
+	if err != nil {
+		return "", errors.Wrapf(err, "error running command=%s, stdout=%s, stderr=%s", cmd.String(), stdout, stderr)
+	}
+
+	return stdout, nil
+}
+
+// kopiaEnv returns the environment of kopia commands run against a repository. Kopia
+// reads the repository's password from it, and the Azure storage account that restic
+// reads from AZURE_ACCOUNT_NAME and AZURE_ACCOUNT_KEY from differently named variables.
+func kopiaEnv(repo RepoAccess) ([]string, error) {
+	password, err := ioutil.ReadFile(repo.PasswordFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading repository password")
+	}
+
+	env := repo.Env
+	if len(env) == 0 {
+		env = os.Environ()
+	}
+
+	res := append([]string{}, env...)
+	for _, v := range env {
+		switch {
+		case strings.HasPrefix(v, "AZURE_ACCOUNT_NAME="):
+			res = append(res, "AZURE_STORAGE_ACCOUNT="+strings.TrimPrefix(v, "AZURE_ACCOUNT_NAME="))
+		case strings.HasPrefix(v, "AZURE_ACCOUNT_KEY="):
+			res = append(res, "AZURE_STORAGE_KEY="+strings.TrimPrefix(v, "AZURE_ACCOUNT_KEY="))
+		}
+	}
+
+	return append(res, "KOPIA_PASSWORD="+string(password)), nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKopiaStorageFlags(t *testing.T) {
+	tests := []struct {
+		name        string
+		identifier  string
+		want        []string
+		expectedErr string
+	}{
+		{
+			name:       "AWS",
+			identifier: "s3:s3-us-east-1.amazonaws.com/bucket/prefix/kopia/ns-1",
+			want:       []string{"s3", "--endpoint=s3-us-east-1.amazonaws.com", "--bucket=bucket", "--prefix=prefix/kopia/ns-1/"},
+		},
+		{
+			name:       "S3-compatible object store without TLS",
+			identifier: "s3:http://minio:9000/bucket/kopia/ns-1",
+			want:       []string{"s3", "--endpoint=minio:9000", "--bucket=bucket", "--prefix=kopia/ns-1/", "--disable-tls"},
+		},
+		{
+			name:       "Azure",
+			identifier: "azure:container:/prefix/kopia/ns-1",
+			want:       []string{"azure", "--container=container", "--prefix=prefix/kopia/ns-1/"},
+		},
+		{
+			name:       "GCP",
+			identifier: "gs:bucket:/kopia/ns-1",
+			want:       []string{"gcs", "--bucket=bucket", "--prefix=kopia/ns-1/"},
+		},
+		{
+			name:       "filesystem",
+			identifier: "/mnt/backups/kopia/ns-1",
+			want:       []string{"filesystem", "--path=/mnt/backups/kopia/ns-1"},
+		},
+		{
+			name:        "S3 without a prefix",
+			identifier:  "s3:minio:9000/bucket",
+			expectedErr: `invalid kopia repository identifier "s3:minio:9000/bucket", expected s3:<endpoint>/<bucket>/<prefix>`,
+		},
+		{
+			name:        "unsupported backend",
+			identifier:  "b2:bucket:/kopia/ns-1",
+			expectedErr: `unsupported kopia repository identifier "b2:bucket:/kopia/ns-1"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			flags, err := kopiaStorageFlags(tc.identifier)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, flags)
+		})
+	}
+}
+
+func TestKopiaCommandStringSlice(t *testing.T) {
+	require.NoError(t, os.Setenv("VELERO_SCRATCH_DIR", "/scratch"))
+	defer os.Unsetenv("VELERO_SCRATCH_DIR")
+
+	c := &kopiaCommand{
+		Command:        "snapshot create",
+		RepoIdentifier: "s3:http://minio:9000/bucket/kopia/ns-1",
+		Args:           []string{"/host_pods/pod-1/volumes/data", "--json"},
+	}
+
+	assert.Equal(t, []string{
+		"kopia",
+		"snapshot",
+		"create",
+		"--config-file=/scratch/.config/kopia/s3_http_minio_9000_bucket_kopia_ns-1.config",
+		"--log-dir=/scratch/.logs/kopia/s3_http_minio_9000_bucket_kopia_ns-1",
+		"/host_pods/pod-1/volumes/data",
+		"--json",
+	}, c.StringSlice())
+}
+
+func TestKopiaCacheFlags(t *testing.T) {
+	require.NoError(t, os.Setenv("VELERO_SCRATCH_DIR", "/scratch"))
+	defer os.Unsetenv("VELERO_SCRATCH_DIR")
+
+	assert.Equal(t, []string{
+		"--cache-directory=/scratch/.cache/kopia/s3_http_minio_9000_bucket_kopia_ns-1",
+	}, kopiaCacheFlags(RepoAccess{Identifier: "s3:http://minio:9000/bucket/kopia/ns-1"}))
+
+	assert.Equal(t, []string{
+		"--cache-directory=/cache/ns-1",
+		"--content-cache-size-mb=256",
+		"--metadata-cache-size-mb=256",
+	}, kopiaCacheFlags(RepoAccess{Identifier: "s3:http://minio:9000/bucket/kopia/ns-1", CacheDir: "/cache/ns-1", CacheSizeLimit: 512 << 20}))
+
+	assert.Equal(t, "/scratch/.config/kopia/s3_http_minio_9000_bucket_kopia_ns-1.cache", kopiaCacheFlagsFile("s3:http://minio:9000/bucket/kopia/ns-1"))
+}
+
+func TestKopiaTagsFlag(t *testing.T) {
+	assert.Equal(t, "", kopiaTagsFlag(nil))
+	assert.Equal(t, "--tags=backup:backup-1,volume:data", kopiaTagsFlag(map[string]string{"volume": "data", "backup": "backup-1"}))
+}
+
+func TestKopiaThrottleFlag(t *testing.T) {
+	assert.Equal(t, "--upload-bytes-per-second=unlimited", kopiaThrottleFlag("--upload-bytes-per-second", 0))
+	assert.Equal(t, "--download-bytes-per-second=1048576", kopiaThrottleFlag("--download-bytes-per-second", 1<<20))
+}
+
+func TestKopiaEnv(t *testing.T) {
+	passwordFile, err := ioutil.TempFile("", "kopia-password")
+	require.NoError(t, err)
+	defer os.Remove(passwordFile.Name())
+
+	_, err = passwordFile.WriteString("static-passw0rd")
+	require.NoError(t, err)
+	require.NoError(t, passwordFile.Close())
+
+	env, err := kopiaEnv(RepoAccess{
+		PasswordFile: passwordFile.Name(),
+		Env:          []string{"AZURE_ACCOUNT_NAME=account", "AZURE_ACCOUNT_KEY=key"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"AZURE_ACCOUNT_NAME=account",
+		"AZURE_ACCOUNT_KEY=key",
+		"AZURE_STORAGE_ACCOUNT=account",
+		"AZURE_STORAGE_KEY=key",
+		"KOPIA_PASSWORD=static-passw0rd",
+	}, env)
+}
+
+func TestParseKopiaBlobStatsTotal(t *testing.T) {
+	total, err := parseKopiaBlobStatsTotal("Count: 42\nTotal: 1073741824\nAverage: 25565281\nHistogram:\n")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1073741824), total)
+
+	_, err = parseKopiaBlobStatsTotal("Count: 0\n")
+	assert.Error(t, err)
+
+	_, err = parseKopiaBlobStatsTotal("Total: 1 GB\n")
+	assert.Error(t, err)
+}
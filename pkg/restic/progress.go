@@ -0,0 +1,70 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"fmt"
+	"sync"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// podVolumeProgress sums the progress of the pod volume backups or restores of
+// a backup or restore, and patches it into the backup's or restore's status.
+type podVolumeProgress struct {
+	lock     sync.Mutex
+	progress map[string]velerov1api.PodVolumeOperationProgress
+	patched  velerov1api.PodVolumeOperationProgress
+
+	// patchFunc applies a merge patch to the backup or restore.
+	patchFunc func(patch []byte) error
+}
+
+func newPodVolumeProgress(patchFunc func(patch []byte) error) *podVolumeProgress {
+	return &podVolumeProgress{
+		progress:  make(map[string]velerov1api.PodVolumeOperationProgress),
+		patchFunc: patchFunc,
+	}
+}
+
+// update records the progress of the named pod volume backup or restore, and
+// patches the sum of the progress of all of them if it changed since the last
+// successful patch.
+func (p *podVolumeProgress) update(name string, progress velerov1api.PodVolumeOperationProgress) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.progress[name] = progress
+
+	var total velerov1api.PodVolumeOperationProgress
+	for _, progress := range p.progress {
+		total.TotalBytes += progress.TotalBytes
+		total.BytesDone += progress.BytesDone
+	}
+
+	if total == p.patched {
+		return nil
+	}
+
+	patch := fmt.Sprintf(`{"status":{"progress":{"podVolumeTotalBytes":%d,"podVolumeBytesDone":%d}}}`, total.TotalBytes, total.BytesDone)
+	if err := p.patchFunc([]byte(patch)); err != nil {
+		return err
+	}
+	p.patched = total
+
+	return nil
+}
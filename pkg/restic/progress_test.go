@@ -0,0 +1,59 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestPodVolumeProgressUpdate(t *testing.T) {
+	var (
+		patches  []string
+		patchErr error
+	)
+	progress := newPodVolumeProgress(func(patch []byte) error {
+		if patchErr != nil {
+			return patchErr
+		}
+		patches = append(patches, string(patch))
+		return nil
+	})
+
+	require.NoError(t, progress.update("pvb-1", velerov1api.PodVolumeOperationProgress{TotalBytes: 100, BytesDone: 10}))
+	require.NoError(t, progress.update("pvb-2", velerov1api.PodVolumeOperationProgress{TotalBytes: 50}))
+	// unchanged progress isn't patched again
+	require.NoError(t, progress.update("pvb-2", velerov1api.PodVolumeOperationProgress{TotalBytes: 50}))
+
+	patchErr = errors.New("the server is currently unable to handle the request")
+	assert.EqualError(t, progress.update("pvb-1", velerov1api.PodVolumeOperationProgress{TotalBytes: 100, BytesDone: 100}), "the server is currently unable to handle the request")
+
+	// progress that failed to be patched is patched with the next update
+	patchErr = nil
+	require.NoError(t, progress.update("pvb-2", velerov1api.PodVolumeOperationProgress{TotalBytes: 50}))
+
+	assert.Equal(t, []string{
+		`{"status":{"progress":{"podVolumeTotalBytes":100,"podVolumeBytesDone":10}}}`,
+		`{"status":{"progress":{"podVolumeTotalBytes":150,"podVolumeBytesDone":10}}}`,
+		`{"status":{"progress":{"podVolumeTotalBytes":150,"podVolumeBytesDone":100}}}`,
+	}, patches)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+)
+
+// ConvertResticRepositories creates a BackupRepository for each ResticRepository in the
+// namespace that doesn't have one of the same name yet, so that repositories created
+// before BackupRepositories replaced ResticRepositories keep being used and maintained.
+// The ResticRepositories are left in place, so that downgrading the server still finds them.
+func ConvertResticRepositories(ctx context.Context, client velerov1client.VeleroV1Interface, namespace string, log logrus.FieldLogger) error {
+	resticRepos, err := client.ResticRepositories(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return errors.Wrap(err, "error listing restic repositories")
+	}
+
+	for i := range resticRepos.Items {
+		resticRepo := &resticRepos.Items[i]
+		log := log.WithField("repository", resticRepo.Name)
+
+		backupRepo, err := convertResticRepository(resticRepo)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.BackupRepositories(namespace).Create(ctx, backupRepo, metav1.CreateOptions{})
+		if apierrors.IsAlreadyExists(err) {
+			log.Debug("Backup repository already exists, not converting restic repository")
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "error creating backup repository %s", backupRepo.Name)
+		}
+
+		log.Info("Converted restic repository to backup repository")
+	}
+
+	return nil
+}
+
+// convertResticRepository returns the BackupRepository that replaces a ResticRepository.
+func convertResticRepository(resticRepo *velerov1api.ResticRepository) (*velerov1api.BackupRepository, error) {
+	// the spec and status of both kinds have the same fields, apart from the repository's
+	// identifier, so they're copied by their JSON.
+	spec, err := json.Marshal(resticRepo.Spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshalling restic repository spec")
+	}
+	status, err := json.Marshal(resticRepo.Status)
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshalling restic repository status")
+	}
+
+	backupRepo := &velerov1api.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   resticRepo.Namespace,
+			Name:        resticRepo.Name,
+			Labels:      resticRepo.Labels,
+			Annotations: resticRepo.Annotations,
+		},
+	}
+	if err := json.Unmarshal(spec, &backupRepo.Spec); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling backup repository spec")
+	}
+	if err := json.Unmarshal(status, &backupRepo.Status); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling backup repository status")
+	}
+	backupRepo.Spec.RepoIdentifier = resticRepo.Spec.ResticIdentifier
+
+	return backupRepo, nil
+}
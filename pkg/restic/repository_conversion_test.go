@@ -0,0 +1,102 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestConvertResticRepositories(t *testing.T) {
+	lastMaintenance := metav1.NewTime(time.Date(2020, 10, 15, 1, 30, 0, 0, time.UTC))
+
+	resticRepo := &velerov1api.ResticRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       velerov1api.DefaultNamespace,
+			Name:            "ns-1-default-abcde",
+			Labels:          repoLabels("ns-1", "default"),
+			ResourceVersion: "5",
+		},
+		Spec: velerov1api.ResticRepositorySpec{
+			VolumeNamespace:       "ns-1",
+			BackupStorageLocation: "default",
+			ResticIdentifier:      "gs:bucket:/restic/ns-1",
+			MaintenanceFrequency:  metav1.Duration{Duration: time.Hour},
+			UploaderType:          velerov1api.UploaderTypeRestic,
+		},
+		Status: velerov1api.ResticRepositoryStatus{
+			Phase:               velerov1api.ResticRepositoryPhaseReady,
+			LastMaintenanceTime: &lastMaintenance,
+		},
+	}
+
+	existing := &velerov1api.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      "ns-2-default-fghij",
+		},
+		Spec: velerov1api.BackupRepositorySpec{
+			VolumeNamespace: "ns-2",
+			RepoIdentifier:  "gs:bucket:/kopia/ns-2",
+		},
+	}
+	existingResticRepo := &velerov1api.ResticRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      existing.Name,
+		},
+		Spec: velerov1api.ResticRepositorySpec{
+			VolumeNamespace:  "ns-2",
+			ResticIdentifier: "gs:bucket:/restic/ns-2",
+		},
+	}
+
+	clientset := fake.NewSimpleClientset(resticRepo, existingResticRepo, existing)
+
+	require.NoError(t, ConvertResticRepositories(context.TODO(), clientset.VeleroV1(), velerov1api.DefaultNamespace, velerotest.NewLogger()))
+
+	converted, err := clientset.VeleroV1().BackupRepositories(velerov1api.DefaultNamespace).Get(context.TODO(), resticRepo.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, resticRepo.Labels, converted.Labels)
+	assert.Equal(t, velerov1api.BackupRepositorySpec{
+		VolumeNamespace:       "ns-1",
+		BackupStorageLocation: "default",
+		RepoIdentifier:        "gs:bucket:/restic/ns-1",
+		MaintenanceFrequency:  metav1.Duration{Duration: time.Hour},
+		UploaderType:          velerov1api.UploaderTypeRestic,
+	}, converted.Spec)
+	assert.Equal(t, velerov1api.BackupRepositoryPhaseReady, converted.Status.Phase)
+	assert.True(t, lastMaintenance.Equal(converted.Status.LastMaintenanceTime))
+
+	// backup repositories that already exist aren't replaced.
+	notConverted, err := clientset.VeleroV1().BackupRepositories(velerov1api.DefaultNamespace).Get(context.TODO(), existing.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, existing.Spec, notConverted.Spec)
+
+	// restic repositories are left in place.
+	_, err = clientset.VeleroV1().ResticRepositories(velerov1api.DefaultNamespace).Get(context.TODO(), resticRepo.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+}
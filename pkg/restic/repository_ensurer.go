@@ -35,14 +35,14 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/label"
 )
 
-// repositoryEnsurer ensures that Velero restic repositories are created and ready.
+// repositoryEnsurer ensures that Velero restic and kopia repositories are created and ready.
 type repositoryEnsurer struct {
 	log        logrus.FieldLogger
-	repoLister velerov1listers.ResticRepositoryLister
-	repoClient velerov1client.ResticRepositoriesGetter
+	repoLister velerov1listers.BackupRepositoryLister
+	repoClient velerov1client.BackupRepositoriesGetter
 
 	repoChansLock sync.Mutex
-	repoChans     map[string]chan *velerov1api.ResticRepository
+	repoChans     map[string]chan *velerov1api.BackupRepository
 
 	// repoLocksMu synchronizes reads/writes to the repoLocks map itself
 	// since maps are not threadsafe.
@@ -53,22 +53,23 @@ type repositoryEnsurer struct {
 type repoKey struct {
 	volumeNamespace string
 	backupLocation  string
+	uploaderType    velerov1api.UploaderType
 }
 
-func newRepositoryEnsurer(repoInformer velerov1informers.ResticRepositoryInformer, repoClient velerov1client.ResticRepositoriesGetter, log logrus.FieldLogger) *repositoryEnsurer {
+func newRepositoryEnsurer(repoInformer velerov1informers.BackupRepositoryInformer, repoClient velerov1client.BackupRepositoriesGetter, log logrus.FieldLogger) *repositoryEnsurer {
 	r := &repositoryEnsurer{
 		log:        log,
 		repoLister: repoInformer.Lister(),
 		repoClient: repoClient,
-		repoChans:  make(map[string]chan *velerov1api.ResticRepository),
+		repoChans:  make(map[string]chan *velerov1api.BackupRepository),
 		repoLocks:  make(map[repoKey]*sync.Mutex),
 	}
 
 	repoInformer.Informer().AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(old, upd interface{}) {
-				oldObj := old.(*velerov1api.ResticRepository)
-				newObj := upd.(*velerov1api.ResticRepository)
+				oldObj := old.(*velerov1api.BackupRepository)
+				newObj := upd.(*velerov1api.BackupRepository)
 
 				// we're only interested in phase-changing updates
 				if oldObj.Status.Phase == newObj.Status.Phase {
@@ -76,21 +77,27 @@ func newRepositoryEnsurer(repoInformer velerov1informers.ResticRepositoryInforme
 				}
 
 				// we're only interested in updates where the updated object is either Ready or NotReady
-				if newObj.Status.Phase != velerov1api.ResticRepositoryPhaseReady && newObj.Status.Phase != velerov1api.ResticRepositoryPhaseNotReady {
+				if newObj.Status.Phase != velerov1api.BackupRepositoryPhaseReady && newObj.Status.Phase != velerov1api.BackupRepositoryPhaseNotReady {
 					return
 				}
 
 				r.repoChansLock.Lock()
 				defer r.repoChansLock.Unlock()
 
-				key := repoLabels(newObj.Spec.VolumeNamespace, newObj.Spec.BackupStorageLocation).String()
-				repoChan, ok := r.repoChans[key]
-				if !ok {
+				// a repository that was migrated is also waited for as the repository of
+				// the locations that it was migrated from.
+				found := false
+				for _, location := range append([]string{newObj.Spec.BackupStorageLocation}, newObj.Status.FormerBackupStorageLocations...) {
+					repoChan, ok := r.repoChans[repoChanKey(newObj.Spec.VolumeNamespace, location, repoUploaderType(newObj))]
+					if !ok {
+						continue
+					}
+					found = true
+					repoChan <- newObj
+				}
+				if !found {
 					log.Debugf("No ready channel found for repository %s/%s", newObj.Namespace, newObj.Name)
-					return
 				}
-
-				repoChan <- newObj
 			},
 		},
 	)
@@ -105,13 +112,58 @@ func repoLabels(volumeNamespace, backupLocation string) labels.Set {
 	}
 }
 
-func (r *repositoryEnsurer) EnsureRepo(ctx context.Context, namespace, volumeNamespace, backupLocation string) (*velerov1api.ResticRepository, error) {
-	log := r.log.WithField("volumeNamespace", volumeNamespace).WithField("backupLocation", backupLocation)
+// repoUploaderType returns the uploader type of a repository, which is restic
+// if its spec doesn't have one.
+func repoUploaderType(repo *velerov1api.BackupRepository) velerov1api.UploaderType {
+	if repo.Spec.UploaderType == "" {
+		return velerov1api.UploaderTypeRestic
+	}
+	return repo.Spec.UploaderType
+}
+
+// migratedRepos returns the repositories for a namespace's pod volumes that were migrated
+// from the given backup storage location.
+func (r *repositoryEnsurer) migratedRepos(namespace, volumeNamespace, backupLocation string, uploaderType velerov1api.UploaderType) ([]*velerov1api.BackupRepository, error) {
+	selector := labels.SelectorFromSet(map[string]string{
+		velerov1api.ResticVolumeNamespaceLabel: label.GetValidName(volumeNamespace),
+	})
+
+	allRepos, err := r.repoLister.BackupRepositories(namespace).List(selector)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var repos []*velerov1api.BackupRepository
+	for _, repo := range allRepos {
+		if repoUploaderType(repo) != uploaderType {
+			continue
+		}
+		for _, location := range repo.Status.FormerBackupStorageLocations {
+			if location == backupLocation {
+				repos = append(repos, repo)
+				break
+			}
+		}
+	}
+
+	return repos, nil
+}
+
+func repoChanKey(volumeNamespace, backupLocation string, uploaderType velerov1api.UploaderType) string {
+	return fmt.Sprintf("%s,%s", repoLabels(volumeNamespace, backupLocation).String(), uploaderType)
+}
+
+func (r *repositoryEnsurer) EnsureRepo(ctx context.Context, namespace, volumeNamespace, backupLocation string, uploaderType velerov1api.UploaderType) (*velerov1api.BackupRepository, error) {
+	if uploaderType == "" {
+		uploaderType = velerov1api.UploaderTypeRestic
+	}
+
+	log := r.log.WithField("volumeNamespace", volumeNamespace).WithField("backupLocation", backupLocation).WithField("uploaderType", uploaderType)
 
 	// It's only safe to have one instance of this method executing concurrently for a
 	// given volumeNamespace + backupLocation, so synchronize based on that. It's fine
 	// to run concurrently for *different* namespaces/locations. If you had 2 goroutines
-	// running this for the same inputs, both might find no ResticRepository exists, then
+	// running this for the same inputs, both might find no BackupRepository exists, then
 	// both would create new ones for the same namespace/location.
 	//
 	// This issue could probably be avoided if we had a deterministic name for
@@ -121,7 +173,7 @@ func (r *repositoryEnsurer) EnsureRepo(ctx context.Context, namespace, volumeNam
 	// GenerateName) which poses a backwards compatibility problem.
 	log.Debug("Acquiring lock")
 
-	repoMu := r.repoLock(volumeNamespace, backupLocation)
+	repoMu := r.repoLock(volumeNamespace, backupLocation, uploaderType)
 	repoMu.Lock()
 	defer func() {
 		repoMu.Unlock()
@@ -132,79 +184,143 @@ func (r *repositoryEnsurer) EnsureRepo(ctx context.Context, namespace, volumeNam
 
 	selector := labels.SelectorFromSet(repoLabels(volumeNamespace, backupLocation))
 
-	repos, err := r.repoLister.ResticRepositories(namespace).List(selector)
+	allRepos, err := r.repoLister.BackupRepositories(namespace).List(selector)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+
+	// restic repositories from before there was a choice of uploader don't have an
+	// uploader type label, so repositories are filtered by their spec instead.
+	var repos []*velerov1api.BackupRepository
+	for _, repo := range allRepos {
+		if repoUploaderType(repo) == uploaderType {
+			repos = append(repos, repo)
+		}
+	}
+
+	// the snapshots of backups in a location that a repository was migrated from
+	// are in the migrated repository.
+	if len(repos) == 0 {
+		if repos, err = r.migratedRepos(namespace, volumeNamespace, backupLocation, uploaderType); err != nil {
+			return nil, err
+		}
+	}
+
 	if len(repos) > 1 {
-		return nil, errors.Errorf("more than one ResticRepository found for workload namespace %q, backup storage location %q", volumeNamespace, backupLocation)
+		return nil, errors.Errorf("more than one BackupRepository found for workload namespace %q, backup storage location %q, uploader type %q", volumeNamespace, backupLocation, uploaderType)
 	}
 	if len(repos) == 1 {
-		if repos[0].Status.Phase != velerov1api.ResticRepositoryPhaseReady {
-			return nil, errors.Errorf("restic repository is not ready: %s", repos[0].Status.Message)
+		repo := repos[0]
+		if repo.Status.Phase == velerov1api.BackupRepositoryPhaseMigrating {
+			log.Info("Repository is being migrated, waiting for the migration to finish")
+			if repo, err = r.waitForMigration(ctx, repo, backupLocation); err != nil {
+				return nil, err
+			}
+		}
+
+		if repo.Status.Phase != velerov1api.BackupRepositoryPhaseReady {
+			return nil, errors.Errorf("%s repository is not ready: %s", uploaderType, repo.Status.Message)
 		}
 
 		log.Debug("Ready repository found")
-		return repos[0], nil
+		return repo, nil
 	}
 
 	log.Debug("No repository found, creating one")
 
 	// no repo found: create one and wait for it to be ready
-	repo := &velerov1api.ResticRepository{
+	generateName := fmt.Sprintf("%s-%s-", volumeNamespace, backupLocation)
+	if uploaderType != velerov1api.UploaderTypeRestic {
+		generateName = fmt.Sprintf("%s-%s-%s-", volumeNamespace, backupLocation, uploaderType)
+	}
+
+	repo := &velerov1api.BackupRepository{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:    namespace,
-			GenerateName: fmt.Sprintf("%s-%s-", volumeNamespace, backupLocation),
+			GenerateName: generateName,
 			Labels:       repoLabels(volumeNamespace, backupLocation),
 		},
-		Spec: velerov1api.ResticRepositorySpec{
+		Spec: velerov1api.BackupRepositorySpec{
 			VolumeNamespace:       volumeNamespace,
 			BackupStorageLocation: backupLocation,
 			MaintenanceFrequency:  metav1.Duration{Duration: DefaultMaintenanceFrequency},
+			UploaderType:          uploaderType,
 		},
 	}
 
-	repoChan := r.getRepoChan(selector.String())
+	key := repoChanKey(volumeNamespace, backupLocation, uploaderType)
+	repoChan := r.getRepoChan(key)
 	defer func() {
-		delete(r.repoChans, selector.String())
+		delete(r.repoChans, key)
 		close(repoChan)
 	}()
 
-	if _, err := r.repoClient.ResticRepositories(namespace).Create(context.TODO(), repo, metav1.CreateOptions{}); err != nil {
-		return nil, errors.Wrapf(err, "unable to create restic repository resource")
+	if _, err := r.repoClient.BackupRepositories(namespace).Create(context.TODO(), repo, metav1.CreateOptions{}); err != nil {
+		return nil, errors.Wrapf(err, "unable to create %s repository resource", uploaderType)
 	}
 
 	select {
 	// repositories should become either ready or not ready quickly if they're
 	// newly created.
 	case <-time.After(time.Minute):
-		return nil, errors.New("timed out waiting for restic repository to become ready")
+		return nil, errors.Errorf("timed out waiting for %s repository to become ready", uploaderType)
 	case <-ctx.Done():
-		return nil, errors.New("timed out waiting for restic repository to become ready")
+		return nil, errors.Errorf("timed out waiting for %s repository to become ready", uploaderType)
 	case res := <-repoChan:
-		if res.Status.Phase == velerov1api.ResticRepositoryPhaseNotReady {
-			return nil, errors.Errorf("restic repository is not ready: %s", res.Status.Message)
+		if res.Status.Phase == velerov1api.BackupRepositoryPhaseNotReady {
+			return nil, errors.Errorf("%s repository is not ready: %s", uploaderType, res.Status.Message)
 		}
 
 		return res, nil
 	}
 }
 
-func (r *repositoryEnsurer) getRepoChan(name string) chan *velerov1api.ResticRepository {
+// waitForMigration waits for a repository that's being migrated to no longer be, and returns
+// it. Snapshots that are taken while a repository is copied could be left out of the copy, so
+// it isn't used until then.
+func (r *repositoryEnsurer) waitForMigration(ctx context.Context, repo *velerov1api.BackupRepository, backupLocation string) (*velerov1api.BackupRepository, error) {
+	uploaderType := repoUploaderType(repo)
+
+	key := repoChanKey(repo.Spec.VolumeNamespace, backupLocation, uploaderType)
+	repoChan := r.getRepoChan(key)
+	defer func() {
+		delete(r.repoChans, key)
+		close(repoChan)
+	}()
+
+	// the migration could have finished before the channel was added.
+	current, err := r.repoClient.BackupRepositories(repo.Namespace).Get(context.TODO(), repo.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting %s repository", uploaderType)
+	}
+	if current.Status.Phase != velerov1api.BackupRepositoryPhaseMigrating {
+		return current, nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, errors.Errorf("timed out waiting for the migration of %s repository %s to finish", uploaderType, repo.Name)
+	case res := <-repoChan:
+		return res, nil
+	}
+}
+
+func (r *repositoryEnsurer) getRepoChan(name string) chan *velerov1api.BackupRepository {
 	r.repoChansLock.Lock()
 	defer r.repoChansLock.Unlock()
 
-	r.repoChans[name] = make(chan *velerov1api.ResticRepository)
+	r.repoChans[name] = make(chan *velerov1api.BackupRepository)
 	return r.repoChans[name]
 }
 
-func (r *repositoryEnsurer) repoLock(volumeNamespace, backupLocation string) *sync.Mutex {
+func (r *repositoryEnsurer) repoLock(volumeNamespace, backupLocation string, uploaderType velerov1api.UploaderType) *sync.Mutex {
 	r.repoLocksMu.Lock()
 	defer r.repoLocksMu.Unlock()
 
 	key := repoKey{
 		volumeNamespace: volumeNamespace,
 		backupLocation:  backupLocation,
+		uploaderType:    uploaderType,
 	}
 
 	if r.repoLocks[key] == nil {
@@ -0,0 +1,107 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestEnsureRepoFindsMigratedRepo(t *testing.T) {
+	newRepo := func(name, location string, uploaderType velerov1api.UploaderType, formerLocations ...string) *velerov1api.BackupRepository {
+		return &velerov1api.BackupRepository{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: velerov1api.DefaultNamespace,
+				Name:      name,
+				Labels:    repoLabels("ns-1", location),
+			},
+			Spec: velerov1api.BackupRepositorySpec{
+				VolumeNamespace:       "ns-1",
+				BackupStorageLocation: location,
+				UploaderType:          uploaderType,
+			},
+			Status: velerov1api.BackupRepositoryStatus{
+				Phase:                        velerov1api.BackupRepositoryPhaseReady,
+				FormerBackupStorageLocations: formerLocations,
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		repos        []*velerov1api.BackupRepository
+		uploaderType velerov1api.UploaderType
+		expectedRepo string
+	}{
+		{
+			name: "repository in the backup storage location is found",
+			repos: []*velerov1api.BackupRepository{
+				newRepo("repo-1", "default", velerov1api.UploaderTypeRestic),
+				newRepo("repo-2", "new", velerov1api.UploaderTypeRestic, "old"),
+			},
+			uploaderType: velerov1api.UploaderTypeRestic,
+			expectedRepo: "repo-1",
+		},
+		{
+			name: "repository migrated from the backup storage location is found",
+			repos: []*velerov1api.BackupRepository{
+				newRepo("repo-1", "other", velerov1api.UploaderTypeRestic),
+				newRepo("repo-2", "new", velerov1api.UploaderTypeRestic, "default"),
+			},
+			uploaderType: velerov1api.UploaderTypeRestic,
+			expectedRepo: "repo-2",
+		},
+		{
+			name: "repository of another uploader migrated from the backup storage location isn't found",
+			repos: []*velerov1api.BackupRepository{
+				newRepo("repo-1", "new", velerov1api.UploaderTypeKopia, "default"),
+				newRepo("repo-2", "newer", velerov1api.UploaderTypeRestic, "old", "default"),
+			},
+			uploaderType: velerov1api.UploaderTypeRestic,
+			expectedRepo: "repo-2",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				clientset       = fake.NewSimpleClientset()
+				veleroInformers = informers.NewSharedInformerFactory(clientset, 0)
+				repoInformer    = veleroInformers.Velero().V1().BackupRepositories()
+			)
+
+			for _, repo := range tc.repos {
+				require.NoError(t, repoInformer.Informer().GetStore().Add(repo))
+			}
+
+			ensurer := newRepositoryEnsurer(repoInformer, clientset.VeleroV1(), velerotest.NewLogger())
+
+			repo, err := ensurer.EnsureRepo(context.Background(), velerov1api.DefaultNamespace, "ns-1", "default", tc.uploaderType)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRepo, repo.Name)
+		})
+	}
+}
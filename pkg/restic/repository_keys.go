@@ -18,6 +18,10 @@ package restic
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 
 	"github.com/pkg/errors"
 	corev1api "k8s.io/api/core/v1"
@@ -25,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/util/retry"
 )
 
 const (
@@ -32,6 +37,10 @@ const (
 	CredentialsKey        = "repository-password"
 
 	encryptionKey = "static-passw0rd"
+
+	// pendingRepoKeySuffix is the suffix of the secret data key that a repository's
+	// new key is stored under while its key is rotated.
+	pendingRepoKeySuffix = ".pending"
 )
 
 func EnsureCommonRepositoryKey(secretClient corev1client.SecretsGetter, namespace string) error {
@@ -112,3 +121,155 @@ func GetRepositoryKey(secretGetter SecretGetter, namespace string) ([]byte, erro
 
 	return key, nil
 }
+
+// repositoryKey is a repository's own encryption key, as it's stored in the restic
+// credentials secret under the repository's key name.
+type repositoryKey struct {
+	// Provider is the name of the key provider that wrapped the key.
+	Provider string `json:"provider"`
+
+	// Key is the wrapped key.
+	Key []byte `json:"key"`
+}
+
+// RepoKeyName returns the name that the own key of the repository with the given identifier
+// is stored under in the restic credentials secret, and that key providers wrap it for. It's
+// derived from the identifier, rather than being the name of the repository's BackupRepository,
+// which is generated, so that a BackupRepository that's re-created for the same repository
+// gets the repository's key.
+func RepoKeyName(repoIdentifier string) string {
+	if repoIdentifier == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(repoIdentifier))
+	return "repo-" + hex.EncodeToString(sum[:16])
+}
+
+// GetRepoKey returns the encryption key of the repository with the given key name: its own
+// key, if it has one, or else the common repository key.
+func GetRepoKey(secretGetter SecretGetter, namespace, repoName string) ([]byte, error) {
+	secret, err := secretGetter.GetSecret(namespace, CredentialsSecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	if key, found, err := repoKeyFromSecret(secret, repoName, repoName); found || err != nil {
+		return key, err
+	}
+
+	key, found := secret.Data[CredentialsKey]
+	if !found {
+		return nil, errors.Errorf("%q secret is missing data for key %q", CredentialsSecretName, CredentialsKey)
+	}
+
+	return key, nil
+}
+
+// repoKeyFromSecret returns the unwrapped key of the repository with the given key name that's
+// stored in the secret under dataKey, and whether there is one.
+func repoKeyFromSecret(secret *corev1api.Secret, repoName, dataKey string) ([]byte, bool, error) {
+	data, found := secret.Data[dataKey]
+	if repoName == "" || !found {
+		return nil, false, nil
+	}
+
+	stored := repositoryKey{}
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, true, errors.Wrapf(err, "error decoding key of repository %s", repoName)
+	}
+
+	provider, err := keyProviderFor(stored.Provider)
+	if err != nil {
+		return nil, true, errors.Wrapf(err, "error getting key of repository %s", repoName)
+	}
+
+	key, err := provider.UnwrapKey(repoName, stored.Key)
+	if err != nil {
+		return nil, true, err
+	}
+
+	return key, true, nil
+}
+
+// copyRepoKey stores the own key of the repository with key name from, if it has one, as
+// the key of the repository with key name to, and returns the key that the repository with
+// key name from is encrypted with.
+func copyRepoKey(secretClient corev1client.SecretsGetter, namespace, from, to string) ([]byte, error) {
+	// the secrets lister can lag behind the key that's stored here, so the
+	// secret is read from the API server.
+	secretGetter := NewClientSecretGetter(secretClient)
+
+	secret, err := secretGetter.GetSecret(namespace, CredentialsSecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	key, found, err := repoKeyFromSecret(secret, from, from)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return GetRepoKey(secretGetter, namespace, from)
+	}
+
+	if err := storeRepoKey(secretClient, namespace, to, to, key); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// newRepoKey returns a random repository key.
+func newRepoKey() ([]byte, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.Wrap(err, "error generating repository key")
+	}
+
+	// restic and kopia passwords are text, so the key is hex-encoded.
+	return []byte(hex.EncodeToString(key)), nil
+}
+
+// storeRepoKey wraps the key of the repository with the given key name with the configured
+// key provider, and stores it in the restic credentials secret under dataKey.
+func storeRepoKey(secretClient corev1client.SecretsGetter, namespace, repoName, dataKey string, key []byte) error {
+	if keyProvider == nil {
+		return errors.New("no key provider is configured")
+	}
+
+	wrapped, err := keyProvider.WrapKey(repoName, key)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(repositoryKey{Provider: keyProvider.Name(), Key: wrapped})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return updateCredentialsSecret(secretClient, namespace, func(secret *corev1api.Secret) {
+		secret.Data[dataKey] = data
+	})
+}
+
+// updateCredentialsSecret applies mutate to the restic credentials secret, retrying on
+// conflicts with other updates.
+func updateCredentialsSecret(secretClient corev1client.SecretsGetter, namespace string, mutate func(*corev1api.Secret)) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret, err := secretClient.Secrets(namespace).Get(context.TODO(), CredentialsSecretName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if secret.Data == nil {
+			secret.Data = make(map[string][]byte)
+		}
+		mutate(secret)
+
+		_, err = secretClient.Secrets(namespace).Update(context.TODO(), secret, metav1.UpdateOptions{})
+		return err
+	})
+
+	return errors.Wrapf(err, "error updating %s secret", CredentialsSecretName)
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestNewKeyProvider(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider string
+		config   map[string]string
+		wantErr  bool
+	}{
+		{name: "no provider", provider: ""},
+		{name: "secret", provider: KeyProviderSecret},
+		{name: "aws-kms with a key", provider: KeyProviderAWSKMS, config: map[string]string{"keyId": "alias/velero"}},
+		{name: "aws-kms without a key", provider: KeyProviderAWSKMS, wantErr: true},
+		{name: "exec with a command", provider: KeyProviderExec, config: map[string]string{"command": "/usr/local/bin/wrap-key"}},
+		{name: "exec without a command", provider: KeyProviderExec, wantErr: true},
+		{name: "unsupported provider", provider: "vault", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider, err := NewKeyProvider(test.provider, test.config)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			if test.provider == "" {
+				assert.Nil(t, provider)
+			} else {
+				assert.Equal(t, test.provider, provider.Name())
+			}
+		})
+	}
+}
+
+func TestExecKeyProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "key-provider")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// the script "wraps" keys by prefixing them with the repository's name.
+	script := filepath.Join(dir, "wrap-key")
+	require.NoError(t, ioutil.WriteFile(script, []byte(`#!/bin/sh
+if [ "$1" = "wrap" ]; then printf '%s:' "$2"; cat; else sed 's/^[^:]*://'; fi
+`), 0755))
+
+	provider, err := NewKeyProvider(KeyProviderExec, map[string]string{"command": script})
+	require.NoError(t, err)
+
+	wrapped, err := provider.WrapKey("repo-1", []byte("passw0rd"))
+	require.NoError(t, err)
+	assert.Equal(t, "repo-1:passw0rd", string(wrapped))
+
+	key, err := provider.UnwrapKey("repo-1", wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, "passw0rd", string(key))
+}
+
+func TestRepoKeys(t *testing.T) {
+	defer SetKeyProvider(nil)
+
+	secretClient := kubefake.NewSimpleClientset(&corev1api.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      CredentialsSecretName,
+		},
+		Data: map[string][]byte{
+			CredentialsKey: []byte("static-passw0rd"),
+		},
+	}).CoreV1()
+	secretGetter := NewClientSecretGetter(secretClient)
+
+	// without a key provider, repositories can't get their own key
+	assert.Error(t, storeRepoKey(secretClient, "velero", "repo-1", "repo-1", []byte("passw0rd")))
+
+	provider, err := NewKeyProvider(KeyProviderSecret, nil)
+	require.NoError(t, err)
+	SetKeyProvider(provider)
+
+	require.NoError(t, storeRepoKey(secretClient, "velero", "repo-1", "repo-1", []byte("passw0rd")))
+
+	// a repository with its own key gets it, and others get the common key
+	key, err := GetRepoKey(secretGetter, "velero", "repo-1")
+	require.NoError(t, err)
+	assert.Equal(t, "passw0rd", string(key))
+
+	key, err = GetRepoKey(secretGetter, "velero", "repo-2")
+	require.NoError(t, err)
+	assert.Equal(t, "static-passw0rd", string(key))
+
+	// a key wrapped by a provider other than the configured one can't be unwrapped
+	require.NoError(t, updateCredentialsSecret(secretClient, "velero", func(secret *corev1api.Secret) {
+		secret.Data["repo-3"] = []byte(`{"provider":"aws-kms","key":"d3JhcHBlZA=="}`)
+	}))
+
+	_, err = GetRepoKey(secretGetter, "velero", "repo-3")
+	assert.EqualError(t, err, `error getting key of repository repo-3: repository key is wrapped by key provider "aws-kms", but the configured key provider is secret`)
+}
+
+func TestRepoKeyName(t *testing.T) {
+	name := RepoKeyName("s3:s3.amazonaws.com/bucket/restic/ns-1")
+
+	assert.Equal(t, name, RepoKeyName("s3:s3.amazonaws.com/bucket/restic/ns-1"))
+	assert.NotEqual(t, name, RepoKeyName("s3:s3.amazonaws.com/bucket/restic/ns-2"))
+	assert.Regexp(t, "^repo-[0-9a-f]{32}$", name)
+	assert.Empty(t, RepoKeyName(""))
+}
+
+func TestEnsureRepoKeyForRecreatedRepository(t *testing.T) {
+	defer SetKeyProvider(nil)
+
+	provider, err := NewKeyProvider(KeyProviderSecret, nil)
+	require.NoError(t, err)
+	SetKeyProvider(provider)
+
+	secretClient := kubefake.NewSimpleClientset(&corev1api.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      CredentialsSecretName,
+		},
+		Data: map[string][]byte{
+			CredentialsKey: []byte("static-passw0rd"),
+		},
+	}).CoreV1()
+	rm := &repositoryManager{namespace: "velero", secretClient: secretClient}
+
+	newRepo := func(name, identifier string) *velerov1api.BackupRepository {
+		return &velerov1api.BackupRepository{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: name},
+			Spec:       velerov1api.BackupRepositorySpec{RepoIdentifier: identifier},
+		}
+	}
+
+	key, err := rm.ensureRepoKey(newRepo("ns-1-default-abcde", "s3:s3.amazonaws.com/bucket/restic/ns-1"))
+	require.NoError(t, err)
+
+	// a BackupRepository that's re-created for the same repository gets a different
+	// generated name, but the repository's key.
+	recreatedKey, err := rm.ensureRepoKey(newRepo("ns-1-default-fghij", "s3:s3.amazonaws.com/bucket/restic/ns-1"))
+	require.NoError(t, err)
+	assert.Equal(t, key, recreatedKey)
+
+	otherKey, err := rm.ensureRepoKey(newRepo("ns-2-default-abcde", "s3:s3.amazonaws.com/bucket/restic/ns-2"))
+	require.NoError(t, err)
+	assert.NotEqual(t, key, otherKey)
+}
+
+func TestCopyRepoKey(t *testing.T) {
+	defer SetKeyProvider(nil)
+
+	provider, err := NewKeyProvider(KeyProviderSecret, nil)
+	require.NoError(t, err)
+	SetKeyProvider(provider)
+
+	secretClient := kubefake.NewSimpleClientset(&corev1api.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      CredentialsSecretName,
+		},
+		Data: map[string][]byte{
+			CredentialsKey: []byte("static-passw0rd"),
+		},
+	}).CoreV1()
+	secretGetter := NewClientSecretGetter(secretClient)
+
+	require.NoError(t, storeRepoKey(secretClient, "velero", "repo-1", "repo-1", []byte("passw0rd")))
+
+	// a repository's own key is stored for its copy
+	key, err := copyRepoKey(secretClient, "velero", "repo-1", "repo-1-copy")
+	require.NoError(t, err)
+	assert.Equal(t, "passw0rd", string(key))
+
+	key, err = GetRepoKey(secretGetter, "velero", "repo-1-copy")
+	require.NoError(t, err)
+	assert.Equal(t, "passw0rd", string(key))
+
+	// a repository with the common key leaves its copy with the common key
+	key, err = copyRepoKey(secretClient, "velero", "repo-2", "repo-2-copy")
+	require.NoError(t, err)
+	assert.Equal(t, "static-passw0rd", string(key))
+
+	secret, err := secretGetter.GetSecret("velero", CredentialsSecretName)
+	require.NoError(t, err)
+	assert.NotContains(t, secret.Data, "repo-2-copy")
+}
+
+func TestNewRepoKey(t *testing.T) {
+	key1, err := newRepoKey()
+	require.NoError(t, err)
+	key2, err := newRepoKey()
+	require.NoError(t, err)
+
+	assert.Len(t, key1, 64)
+	assert.NotEqual(t, key1, key2)
+}
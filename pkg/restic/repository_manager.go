@@ -24,6 +24,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
@@ -36,26 +37,38 @@ import (
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
-	veleroexec "github.com/vmware-tanzu/velero/pkg/util/exec"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 )
 
-// RepositoryManager executes commands against restic repositories.
+// RepositoryManager executes commands against restic and kopia repositories.
 type RepositoryManager interface {
 	// InitRepo initializes a repo with the specified name and identifier.
-	InitRepo(repo *velerov1api.ResticRepository) error
+	InitRepo(repo *velerov1api.BackupRepository) error
 
-	// ConnectToRepo runs the 'restic snapshots' command against the
-	// specified repo, and returns an error if it fails. This is
-	// intended to be used to ensure that the repo exists/can be
-	// authenticated to.
-	ConnectToRepo(repo *velerov1api.ResticRepository) error
+	// ConnectToRepo connects to the specified repo, and returns an
+	// error if it fails. This is intended to be used to ensure that
+	// the repo exists/can be authenticated to.
+	ConnectToRepo(repo *velerov1api.BackupRepository) error
+
+	// ConnectToRepoCopy gives a copy of a repo in another location
+	// the repo's encryption key, and connects to the copy.
+	ConnectToRepoCopy(repo, copied *velerov1api.BackupRepository) error
 
 	// PruneRepo deletes unused data from a repo.
-	PruneRepo(repo *velerov1api.ResticRepository) error
+	PruneRepo(repo *velerov1api.BackupRepository) error
+
+	// CheckRepo checks a repo's data for errors.
+	CheckRepo(repo *velerov1api.BackupRepository) error
 
 	// UnlockRepo removes stale locks from a repo.
-	UnlockRepo(repo *velerov1api.ResticRepository) error
+	UnlockRepo(repo *velerov1api.BackupRepository) error
+
+	// RepoSize returns the number of bytes of data that a repo stores.
+	RepoSize(repo *velerov1api.BackupRepository) (int64, error)
+
+	// RotateRepoKey replaces a repo's encryption key with a new key
+	// of its own, which is wrapped by the configured key provider.
+	RotateRepoKey(repo *velerov1api.BackupRepository) error
 
 	// Forget removes a snapshot from the list of
 	// available snapshots in a repo.
@@ -84,7 +97,8 @@ type repositoryManager struct {
 	namespace          string
 	veleroClient       clientset.Interface
 	secretsLister      corev1listers.SecretLister
-	repoLister         velerov1listers.ResticRepositoryLister
+	secretClient       corev1client.SecretsGetter
+	repoLister         velerov1listers.BackupRepositoryLister
 	repoInformerSynced cache.InformerSynced
 	kbClient           kbclient.Client
 	log                logrus.FieldLogger
@@ -94,6 +108,8 @@ type repositoryManager struct {
 	ctx                context.Context
 	pvcClient          corev1client.PersistentVolumeClaimsGetter
 	pvClient           corev1client.PersistentVolumesGetter
+	podClient          corev1client.PodsGetter
+	uploaderType       velerov1api.UploaderType
 }
 
 // NewRepositoryManager constructs a RepositoryManager.
@@ -102,11 +118,14 @@ func NewRepositoryManager(
 	namespace string,
 	veleroClient clientset.Interface,
 	secretsInformer cache.SharedIndexInformer,
-	repoInformer velerov1informers.ResticRepositoryInformer,
-	repoClient velerov1client.ResticRepositoriesGetter,
+	repoInformer velerov1informers.BackupRepositoryInformer,
+	repoClient velerov1client.BackupRepositoriesGetter,
 	kbClient kbclient.Client,
 	pvcClient corev1client.PersistentVolumeClaimsGetter,
 	pvClient corev1client.PersistentVolumesGetter,
+	podClient corev1client.PodsGetter,
+	secretClient corev1client.SecretsGetter,
+	uploaderType velerov1api.UploaderType,
 	log logrus.FieldLogger,
 ) (RepositoryManager, error) {
 	rm := &repositoryManager{
@@ -118,6 +137,9 @@ func NewRepositoryManager(
 		kbClient:           kbClient,
 		pvcClient:          pvcClient,
 		pvClient:           pvClient,
+		podClient:          podClient,
+		secretClient:       secretClient,
+		uploaderType:       uploaderType,
 		log:                log,
 		ctx:                ctx,
 
@@ -144,7 +166,7 @@ func (rm *repositoryManager) NewBackupper(ctx context.Context, backup *velerov1a
 		},
 	)
 
-	b := newBackupper(ctx, rm, rm.repoEnsurer, informer, rm.pvcClient, rm.pvClient, rm.log)
+	b := newBackupper(ctx, backup, rm, rm.repoEnsurer, informer, rm.pvcClient, rm.pvClient, rm.log)
 
 	go informer.Run(ctx.Done())
 	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced, rm.repoInformerSynced) {
@@ -165,7 +187,7 @@ func (rm *repositoryManager) NewRestorer(ctx context.Context, restore *velerov1a
 		},
 	)
 
-	r := newRestorer(ctx, rm, rm.repoEnsurer, informer, rm.log)
+	r := newRestorer(ctx, restore, rm, rm.repoEnsurer, informer, rm.log)
 
 	go informer.Run(ctx.Done())
 	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced, rm.repoInformerSynced) {
@@ -175,42 +197,185 @@ func (rm *repositoryManager) NewRestorer(ctx context.Context, restore *velerov1a
 	return r, nil
 }
 
-func (rm *repositoryManager) InitRepo(repo *velerov1api.ResticRepository) error {
+func (rm *repositoryManager) InitRepo(repo *velerov1api.BackupRepository) error {
 	// restic init requires an exclusive lock
 	rm.repoLocker.LockExclusive(repo.Name)
 	defer rm.repoLocker.UnlockExclusive(repo.Name)
 
-	return rm.exec(InitCommand(repo.Spec.ResticIdentifier), repo.Spec.BackupStorageLocation)
+	key, err := rm.ensureRepoKey(repo)
+	if err != nil {
+		return err
+	}
+
+	return rm.execWithKey(repo, key, func(uploader Uploader, access RepoAccess) error {
+		return uploader.InitRepo(access)
+	})
+}
+
+// ensureRepoKey returns the key that a new repo is initialized with. If a key
+// provider is configured, the repo gets its own key, unless it has one already
+// from an earlier attempt to initialize it.
+func (rm *repositoryManager) ensureRepoKey(repo *velerov1api.BackupRepository) ([]byte, error) {
+	// the secrets lister can lag behind the key that's stored here, so the
+	// secret is read from the API server.
+	secretGetter := NewClientSecretGetter(rm.secretClient)
+
+	secret, err := secretGetter.GetSecret(rm.namespace, CredentialsSecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	keyName := RepoKeyName(repo.Spec.RepoIdentifier)
+	if _, found := secret.Data[keyName]; found || keyProvider == nil {
+		return GetRepoKey(secretGetter, rm.namespace, keyName)
+	}
+
+	key, err := newRepoKey()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := storeRepoKey(rm.secretClient, rm.namespace, keyName, keyName, key); err != nil {
+		return nil, err
+	}
+
+	return key, nil
 }
 
-func (rm *repositoryManager) ConnectToRepo(repo *velerov1api.ResticRepository) error {
+func (rm *repositoryManager) ConnectToRepo(repo *velerov1api.BackupRepository) error {
 	// restic snapshots requires a non-exclusive lock
 	rm.repoLocker.Lock(repo.Name)
 	defer rm.repoLocker.Unlock(repo.Name)
 
-	snapshotsCmd := SnapshotsCommand(repo.Spec.ResticIdentifier)
-	// use the '--last' flag to minimize the amount of data fetched since
-	// we're just validating that the repo exists and can be authenticated
-	// to.
-	snapshotsCmd.ExtraFlags = append(snapshotsCmd.ExtraFlags, "--last")
+	return rm.exec(repo, func(uploader Uploader, access RepoAccess) error {
+		return uploader.ConnectToRepo(access)
+	})
+}
+
+func (rm *repositoryManager) ConnectToRepoCopy(repo, copied *velerov1api.BackupRepository) error {
+	// copying the source repo's key requires a non-exclusive lock on the
+	// source repo, so that its key can't be rotated while it's copied
+	rm.repoLocker.Lock(repo.Name)
+	defer rm.repoLocker.Unlock(repo.Name)
+
+	key, err := copyRepoKey(rm.secretClient, rm.namespace, RepoKeyName(repo.Spec.RepoIdentifier), RepoKeyName(copied.Spec.RepoIdentifier))
+	if err != nil {
+		return err
+	}
 
-	return rm.exec(snapshotsCmd, repo.Spec.BackupStorageLocation)
+	return rm.execWithKey(copied, key, func(uploader Uploader, access RepoAccess) error {
+		return uploader.ConnectToRepo(access)
+	})
 }
 
-func (rm *repositoryManager) PruneRepo(repo *velerov1api.ResticRepository) error {
+func (rm *repositoryManager) PruneRepo(repo *velerov1api.BackupRepository) error {
 	// restic prune requires an exclusive lock
 	rm.repoLocker.LockExclusive(repo.Name)
 	defer rm.repoLocker.UnlockExclusive(repo.Name)
 
-	return rm.exec(PruneCommand(repo.Spec.ResticIdentifier), repo.Spec.BackupStorageLocation)
+	return rm.exec(repo, func(uploader Uploader, access RepoAccess) error {
+		return uploader.PruneRepo(access)
+	})
+}
+
+func (rm *repositoryManager) CheckRepo(repo *velerov1api.BackupRepository) error {
+	// restic check requires an exclusive lock
+	rm.repoLocker.LockExclusive(repo.Name)
+	defer rm.repoLocker.UnlockExclusive(repo.Name)
+
+	return rm.exec(repo, func(uploader Uploader, access RepoAccess) error {
+		return uploader.CheckRepo(access)
+	})
 }
 
-func (rm *repositoryManager) UnlockRepo(repo *velerov1api.ResticRepository) error {
+func (rm *repositoryManager) UnlockRepo(repo *velerov1api.BackupRepository) error {
 	// restic unlock requires a non-exclusive lock
 	rm.repoLocker.Lock(repo.Name)
 	defer rm.repoLocker.Unlock(repo.Name)
 
-	return rm.exec(UnlockCommand(repo.Spec.ResticIdentifier), repo.Spec.BackupStorageLocation)
+	return rm.exec(repo, func(uploader Uploader, access RepoAccess) error {
+		return uploader.UnlockRepo(access)
+	})
+}
+
+func (rm *repositoryManager) RepoSize(repo *velerov1api.BackupRepository) (int64, error) {
+	// restic stats requires a non-exclusive lock
+	rm.repoLocker.Lock(repo.Name)
+	defer rm.repoLocker.Unlock(repo.Name)
+
+	var size int64
+	err := rm.exec(repo, func(uploader Uploader, access RepoAccess) error {
+		var err error
+		size, err = uploader.RepoSize(access)
+		return err
+	})
+	return size, err
+}
+
+func (rm *repositoryManager) RotateRepoKey(repo *velerov1api.BackupRepository) error {
+	if keyProvider == nil {
+		return errors.New("repository key can't be rotated because no key provider is configured")
+	}
+
+	// changing a repo's key requires an exclusive lock
+	rm.repoLocker.LockExclusive(repo.Name)
+	defer rm.repoLocker.UnlockExclusive(repo.Name)
+
+	secretGetter := NewClientSecretGetter(rm.secretClient)
+	secret, err := secretGetter.GetSecret(rm.namespace, CredentialsSecretName)
+	if err != nil {
+		return err
+	}
+
+	keyName := RepoKeyName(repo.Spec.RepoIdentifier)
+	currentKey, err := GetRepoKey(secretGetter, rm.namespace, keyName)
+	if err != nil {
+		return err
+	}
+
+	// if an earlier rotation changed the repo's key but failed to record it,
+	// the repo can only be connected to with the pending key.
+	pendingKey, found, err := repoKeyFromSecret(secret, keyName, keyName+pendingRepoKeySuffix)
+	if err != nil {
+		return err
+	}
+	if found {
+		if err := rm.execWithKey(repo, pendingKey, func(uploader Uploader, access RepoAccess) error {
+			return uploader.ConnectToRepo(access)
+		}); err == nil {
+			rm.log.WithField("repository", repo.Name).Info("Repository's key was changed to the pending key of an earlier rotation")
+			currentKey = pendingKey
+		}
+	}
+
+	newKey, err := newRepoKey()
+	if err != nil {
+		return err
+	}
+
+	// the new key is stored before the repo's key is changed to it, so that
+	// it isn't lost if recording the change fails.
+	if err := storeRepoKey(rm.secretClient, rm.namespace, keyName, keyName+pendingRepoKeySuffix, newKey); err != nil {
+		return err
+	}
+
+	newKeyFile, err := tempKeyFile(newKey, keyName, rm.fileSystem)
+	if err != nil {
+		return err
+	}
+	// ignore error since there's nothing we can do and it's a temp file.
+	defer os.Remove(newKeyFile)
+
+	if err := rm.execWithKey(repo, currentKey, func(uploader Uploader, access RepoAccess) error {
+		return uploader.ChangeRepoPassword(access, newKeyFile)
+	}); err != nil {
+		return err
+	}
+
+	return updateCredentialsSecret(rm.secretClient, rm.namespace, func(secret *corev1api.Secret) {
+		secret.Data[keyName] = secret.Data[keyName+pendingRepoKeySuffix]
+		delete(secret.Data, keyName+pendingRepoKeySuffix)
+	})
 }
 
 func (rm *repositoryManager) Forget(ctx context.Context, snapshot SnapshotIdentifier) error {
@@ -222,7 +387,7 @@ func (rm *repositoryManager) Forget(ctx context.Context, snapshot SnapshotIdenti
 		return errors.New("timed out waiting for cache to sync")
 	}
 
-	repo, err := rm.repoEnsurer.EnsureRepo(ctx, rm.namespace, snapshot.VolumeNamespace, snapshot.BackupStorageLocation)
+	repo, err := rm.repoEnsurer.EnsureRepo(ctx, rm.namespace, snapshot.VolumeNamespace, snapshot.BackupStorageLocation, snapshot.UploaderType)
 	if err != nil {
 		return err
 	}
@@ -231,60 +396,92 @@ func (rm *repositoryManager) Forget(ctx context.Context, snapshot SnapshotIdenti
 	rm.repoLocker.LockExclusive(repo.Name)
 	defer rm.repoLocker.UnlockExclusive(repo.Name)
 
-	return rm.exec(ForgetCommand(repo.Spec.ResticIdentifier, snapshot.SnapshotID), repo.Spec.BackupStorageLocation)
+	return rm.exec(repo, func(uploader Uploader, access RepoAccess) error {
+		return uploader.Forget(access, snapshot.SnapshotID)
+	})
+}
+
+// uploaderTypeForLocation returns the uploader that backs up pod volumes to the
+// given backup storage location: the location's, if it has one, or else the
+// server's.
+func (rm *repositoryManager) uploaderTypeForLocation(backupLocation string) (velerov1api.UploaderType, error) {
+	location := &velerov1api.BackupStorageLocation{}
+	if err := rm.kbClient.Get(context.Background(), kbclient.ObjectKey{
+		Namespace: rm.namespace,
+		Name:      backupLocation,
+	}, location); err != nil {
+		return "", errors.Wrap(err, "error getting backup storage location")
+	}
+
+	if location.Spec.UploaderType != "" {
+		return location.Spec.UploaderType, nil
+	}
+	return rm.uploaderType, nil
+}
+
+// exec runs an uploader command against a repository, with the repository's
+// credentials, CA bundle and provider-specific environment.
+func (rm *repositoryManager) exec(repo *velerov1api.BackupRepository, run func(Uploader, RepoAccess) error) error {
+	key, err := GetRepoKey(NewListerSecretGetter(rm.secretsLister), rm.namespace, RepoKeyName(repo.Spec.RepoIdentifier))
+	if err != nil {
+		return err
+	}
+
+	return rm.execWithKey(repo, key, run)
 }
 
-func (rm *repositoryManager) exec(cmd *Command, backupLocation string) error {
-	file, err := TempCredentialsFile(rm.secretsLister, rm.namespace, cmd.RepoName(), rm.fileSystem)
+// execWithKey runs an uploader command against a repository like exec, but
+// with the given key.
+func (rm *repositoryManager) execWithKey(repo *velerov1api.BackupRepository, key []byte, run func(Uploader, RepoAccess) error) error {
+	uploader, err := NewUploader(repo.Spec.UploaderType, rm.log.WithField("repository", repo.Name))
+	if err != nil {
+		return err
+	}
+
+	access := RepoAccess{Identifier: repo.Spec.RepoIdentifier}
+	access.CacheDir, access.CacheSizeLimit = RepoCache(repo.Spec.UploaderType, repo.Spec.RepoIdentifier, repo.Spec.CacheSizeLimit)
+	access.UploadLimit, access.DownloadLimit = RepoBandwidth(repo.Spec.UploadRateLimit, repo.Spec.DownloadRateLimit)
+	backupLocation := repo.Spec.BackupStorageLocation
+
+	file, err := tempKeyFile(key, repo.Name, rm.fileSystem)
 	if err != nil {
 		return err
 	}
 	// ignore error since there's nothing we can do and it's a temp file.
 	defer os.Remove(file)
 
-	cmd.PasswordFile = file
+	access.PasswordFile = file
 
-	// if there's a caCert on the ObjectStorage, write it to disk so that it can be passed to restic
+	// if there's a caCert on the ObjectStorage, write it to disk so that it can be passed to the uploader
 	caCert, err := GetCACert(rm.kbClient, rm.namespace, backupLocation)
 	if err != nil {
 		return err
 	}
 
-	var caCertFile string
 	if caCert != nil {
-		caCertFile, err = TempCACertFile(caCert, backupLocation, rm.fileSystem)
+		caCertFile, err := TempCACertFile(caCert, backupLocation, rm.fileSystem)
 		if err != nil {
 			return err
 		}
 		// ignore error since there's nothing we can do and it's a temp file.
 		defer os.Remove(caCertFile)
+
+		access.CACertFile = caCertFile
 	}
-	cmd.CACertFile = caCertFile
 
-	if strings.HasPrefix(cmd.RepoIdentifier, "azure") {
+	if strings.HasPrefix(access.Identifier, "azure") {
 		env, err := AzureCmdEnv(rm.kbClient, rm.namespace, backupLocation)
 		if err != nil {
 			return err
 		}
-		cmd.Env = env
-	} else if strings.HasPrefix(cmd.RepoIdentifier, "s3") {
+		access.Env = env
+	} else if strings.HasPrefix(access.Identifier, "s3") {
 		env, err := S3CmdEnv(rm.kbClient, rm.namespace, backupLocation)
 		if err != nil {
 			return err
 		}
-		cmd.Env = env
-	}
-
-	stdout, stderr, err := veleroexec.RunCommand(cmd.Cmd())
-	rm.log.WithFields(logrus.Fields{
-		"repository": cmd.RepoName(),
-		"command":    cmd.String(),
-		"stdout":     stdout,
-		"stderr":     stderr,
-	}).Debugf("Ran restic command")
-	if err != nil {
-		return errors.Wrapf(err, "error running command=%s, stdout=%s, stderr=%s", cmd.String(), stdout, stderr)
+		access.Env = env
 	}
 
-	return nil
+	return run(uploader, access)
 }
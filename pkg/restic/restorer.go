@@ -24,6 +24,7 @@ import (
 	"github.com/sirupsen/logrus"
 	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -34,6 +35,7 @@ import (
 type RestoreData struct {
 	Restore                         *velerov1api.Restore
 	Pod                             *corev1api.Pod
+	PVC                             *corev1api.PersistentVolumeClaim
 	PodVolumeBackups                []*velerov1api.PodVolumeBackup
 	SourceNamespace, BackupLocation string
 }
@@ -42,6 +44,10 @@ type RestoreData struct {
 type Restorer interface {
 	// RestorePodVolumes restores all annotated volumes in a pod.
 	RestorePodVolumes(RestoreData) []error
+
+	// RestorePVC restores the data of a persistent volume claim that was backed up without
+	// a pod that mounted it, by mounting it in a helper pod for the duration of its restore.
+	RestorePVC(RestoreData) []error
 }
 
 type restorer struct {
@@ -51,10 +57,13 @@ type restorer struct {
 
 	resultsLock sync.Mutex
 	results     map[string]chan *velerov1api.PodVolumeRestore
+
+	progress *podVolumeProgress
 }
 
 func newRestorer(
 	ctx context.Context,
+	restore *velerov1api.Restore,
 	rm *repositoryManager,
 	repoEnsurer *repositoryEnsurer,
 	podVolumeRestoreInformer cache.SharedIndexInformer,
@@ -66,6 +75,11 @@ func newRestorer(
 		repoEnsurer: repoEnsurer,
 
 		results: make(map[string]chan *velerov1api.PodVolumeRestore),
+
+		progress: newPodVolumeProgress(func(patch []byte) error {
+			_, err := rm.veleroClient.VeleroV1().Restores(restore.Namespace).Patch(ctx, restore.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+			return err
+		}),
 	}
 
 	podVolumeRestoreInformer.AddEventHandler(
@@ -73,6 +87,10 @@ func newRestorer(
 			UpdateFunc: func(_, obj interface{}) {
 				pvr := obj.(*velerov1api.PodVolumeRestore)
 
+				if err := r.progress.update(pvr.Name, pvr.Status.Progress); err != nil {
+					log.WithError(errors.WithStack(err)).Warn("Got error trying to update restore's status.progress")
+				}
+
 				if pvr.Status.Phase == velerov1api.PodVolumeRestorePhaseCompleted || pvr.Status.Phase == velerov1api.PodVolumeRestorePhaseFailed {
 					r.resultsLock.Lock()
 					defer r.resultsLock.Unlock()
@@ -97,15 +115,27 @@ func (r *restorer) RestorePodVolumes(data RestoreData) []error {
 		return nil
 	}
 
-	repo, err := r.repoEnsurer.EnsureRepo(r.ctx, data.Restore.Namespace, data.SourceNamespace, data.BackupLocation)
-	if err != nil {
-		return []error{err}
-	}
+	// the volumes of a pod may have been backed up by different uploaders, whose
+	// snapshots are in different repositories.
+	uploaderTypes := getVolumeUploaderTypes(data.PodVolumeBackups, data.Pod)
+	repos := make(map[velerov1api.UploaderType]*velerov1api.BackupRepository)
+	for volume := range volumesToRestore {
+		uploaderType := uploaderTypes[volume]
+		if _, ok := repos[uploaderType]; ok {
+			continue
+		}
+
+		repo, err := r.repoEnsurer.EnsureRepo(r.ctx, data.Restore.Namespace, data.SourceNamespace, data.BackupLocation, uploaderType)
+		if err != nil {
+			return []error{err}
+		}
+		repos[uploaderType] = repo
 
-	// get a single non-exclusive lock since we'll wait for all individual
-	// restores to be complete before releasing it.
-	r.repoManager.repoLocker.Lock(repo.Name)
-	defer r.repoManager.repoLocker.Unlock(repo.Name)
+		// get a single non-exclusive lock since we'll wait for all individual
+		// restores to be complete before releasing it.
+		r.repoManager.repoLocker.Lock(repo.Name)
+		defer r.repoManager.repoLocker.Unlock(repo.Name)
+	}
 
 	resultsChan := make(chan *velerov1api.PodVolumeRestore)
 
@@ -119,7 +149,11 @@ func (r *restorer) RestorePodVolumes(data RestoreData) []error {
 	)
 
 	for volume, snapshot := range volumesToRestore {
-		volumeRestore := newPodVolumeRestore(data.Restore, data.Pod, data.BackupLocation, volume, snapshot, repo.Spec.ResticIdentifier)
+		uploaderType := uploaderTypes[volume]
+		// the repository may have been migrated from the backup's storage location, so
+		// its data is accessed through the location that it's stored in now.
+		repo := repos[uploaderType]
+		volumeRestore := newPodVolumeRestore(data.Restore, data.Pod, repo.Spec.BackupStorageLocation, volume, snapshot, repo.Spec.RepoIdentifier, uploaderType)
 
 		if err := errorOnly(r.repoManager.veleroClient.VeleroV1().PodVolumeRestores(volumeRestore.Namespace).Create(context.TODO(), volumeRestore, metav1.CreateOptions{})); err != nil {
 			errs = append(errs, errors.WithStack(err))
@@ -148,7 +182,35 @@ ForEachVolume:
 	return errs
 }
 
-func newPodVolumeRestore(restore *velerov1api.Restore, pod *corev1api.Pod, backupLocation, volume, snapshot, repoIdentifier string) *velerov1api.PodVolumeRestore {
+func (r *restorer) RestorePVC(data RestoreData) []error {
+	podVolumeBackups := GetUnmountedPVCBackups(data.PodVolumeBackups, data.SourceNamespace, data.PVC.Name)
+	if len(podVolumeBackups) == 0 {
+		return nil
+	}
+
+	// the helper pod has the name of the one that the claim was backed up with, and mounts
+	// it as the same volume, so that the claim's pod volume backups are for the pod.
+	pod := newPVCHelperPod(data.PVC, podVolumeBackups[0].Spec.Volume, string(data.Restore.UID))
+	pod.Name = podVolumeBackups[0].Spec.Pod.Name
+	pod.Labels[velerov1api.RestoreNameLabel] = label.GetValidName(data.Restore.Name)
+
+	pod, err := r.repoManager.podClient.Pods(pod.Namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+	if err != nil {
+		return []error{errors.Wrapf(err, "error creating helper pod for persistent volume claim %s/%s", data.PVC.Namespace, data.PVC.Name)}
+	}
+
+	data.Pod = pod
+	data.PodVolumeBackups = podVolumeBackups
+	errs := r.RestorePodVolumes(data)
+
+	if err := deletePVCHelperPod(r.repoManager.podClient, pod); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+func newPodVolumeRestore(restore *velerov1api.Restore, pod *corev1api.Pod, backupLocation, volume, snapshot, repoIdentifier string, uploaderType velerov1api.UploaderType) *velerov1api.PodVolumeRestore {
 	return &velerov1api.PodVolumeRestore{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:    restore.Namespace,
@@ -179,6 +241,7 @@ func newPodVolumeRestore(restore *velerov1api.Restore, pod *corev1api.Pod, backu
 			SnapshotID:            snapshot,
 			BackupStorageLocation: backupLocation,
 			RepoIdentifier:        repoIdentifier,
+			UploaderType:          uploaderType,
 		},
 	}
 }
@@ -0,0 +1,198 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/buildinfo"
+)
+
+const (
+	// UnmountedPVCAnnotation is the annotation added to pod volume backups of persistent
+	// volume claims that no pod mounted, which were taken by mounting the claim in a
+	// helper pod.
+	UnmountedPVCAnnotation = "velero.io/unmounted-pvc"
+
+	// PVCHelperPodLabel is the label of the helper pods that mount persistent volume
+	// claims that no pod mounts, for their backup or restore.
+	PVCHelperPodLabel = "velero.io/pvc-helper-pod"
+
+	// pvcHelperPodVolume is the name of the claim's volume in a helper pod.
+	pvcHelperPodVolume = "pvc"
+
+	// pvcHelperPodImageBase and pvcHelperPodCommand are the image and the command of
+	// helper pods, which are the restic restore helper's.
+	pvcHelperPodImageBase = "velero/velero-restic-restore-helper"
+	pvcHelperPodCommand   = "/velero-restic-restore-helper"
+
+	// pvcHelperPodWaitFile is the done file that a helper pod's container waits for,
+	// which is never written, so that the pod runs until it's deleted.
+	pvcHelperPodWaitFile = "pvc-helper-pod"
+)
+
+// GetUnmountedPVCBackups returns the PodVolumeBackups of the persistent volume claim with
+// the given namespace and name that were taken by mounting it in a helper pod.
+func GetUnmountedPVCBackups(podVolumeBackups []*velerov1api.PodVolumeBackup, namespace, name string) []*velerov1api.PodVolumeBackup {
+	var res []*velerov1api.PodVolumeBackup
+	for _, pvb := range podVolumeBackups {
+		if pvb.Spec.Pod.Namespace != namespace || pvb.Annotations[UnmountedPVCAnnotation] != "true" || pvb.Annotations[PVCNameAnnotation] != name {
+			continue
+		}
+
+		// skip PVBs without a snapshot ID since there's nothing
+		// to restore (they could be failed, or for empty volumes).
+		if pvb.Status.SnapshotID == "" {
+			continue
+		}
+
+		res = append(res, pvb)
+	}
+
+	return res
+}
+
+// isPVCMounted returns true if a running pod, other than a helper pod, mounts the
+// persistent volume claim, or false otherwise.
+func isPVCMounted(podClient corev1client.PodsGetter, pvc *corev1api.PersistentVolumeClaim) (bool, error) {
+	pods, err := podClient.Pods(pvc.Namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return false, errors.Wrapf(err, "error listing pods in namespace %s", pvc.Namespace)
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Labels[PVCHelperPodLabel] != "" || pod.Status.Phase != corev1api.PodRunning {
+			continue
+		}
+
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == pvc.Name {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// newPVCHelperPod returns a pod that mounts the persistent volume claim as the given volume,
+// and runs until it's deleted. If restoreUID is not empty, the pod has the restic-wait init
+// container, which waits for the restore of the volume.
+func newPVCHelperPod(pvc *corev1api.PersistentVolumeClaim, volume string, restoreUID string) *corev1api.Pod {
+	image := pvcHelperPodImage()
+	volumeMounts := []corev1api.VolumeMount{
+		{
+			Name:      volume,
+			MountPath: filepath.Join("/restores", volume),
+		},
+	}
+
+	pod := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: pvc.Namespace,
+			Labels: map[string]string{
+				PVCHelperPodLabel: "true",
+			},
+		},
+		Spec: corev1api.PodSpec{
+			Containers: []corev1api.Container{
+				{
+					Name:         "velero-pvc-helper",
+					Image:        image,
+					Command:      []string{pvcHelperPodCommand},
+					Args:         []string{pvcHelperPodWaitFile},
+					VolumeMounts: volumeMounts,
+				},
+			},
+			Volumes: []corev1api.Volume{
+				{
+					Name: volume,
+					VolumeSource: corev1api.VolumeSource{
+						PersistentVolumeClaim: &corev1api.PersistentVolumeClaimVolumeSource{
+							ClaimName: pvc.Name,
+						},
+					},
+				},
+			},
+			RestartPolicy: corev1api.RestartPolicyNever,
+		},
+	}
+
+	if restoreUID != "" {
+		pod.Spec.InitContainers = []corev1api.Container{
+			{
+				Name:         InitContainer,
+				Image:        image,
+				Command:      []string{pvcHelperPodCommand},
+				Args:         []string{restoreUID},
+				VolumeMounts: volumeMounts,
+			},
+		}
+	}
+
+	return pod
+}
+
+func pvcHelperPodImage() string {
+	tag := buildinfo.Version
+	if tag == "" {
+		tag = "latest"
+	}
+
+	return fmt.Sprintf("%s:%s", pvcHelperPodImageBase, tag)
+}
+
+// waitForPodRunning waits until the pod is running, and returns it.
+func waitForPodRunning(ctx context.Context, podClient corev1client.PodsGetter, namespace, name string) (*corev1api.Pod, error) {
+	var pod *corev1api.Pod
+	err := wait.PollImmediateUntil(time.Second, func() (bool, error) {
+		res, err := podClient.Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, errors.WithStack(err)
+		}
+
+		switch res.Status.Phase {
+		case corev1api.PodRunning:
+			pod = res
+			return true, nil
+		case corev1api.PodSucceeded, corev1api.PodFailed:
+			return false, errors.Errorf("pod %s/%s is %s", namespace, name, res.Status.Phase)
+		}
+		return false, nil
+	}, ctx.Done())
+	if err == wait.ErrWaitTimeout {
+		return nil, errors.Errorf("timed out waiting for pod %s/%s to be running", namespace, name)
+	}
+
+	return pod, err
+}
+
+// deletePVCHelperPod deletes a helper pod once it's no longer needed.
+func deletePVCHelperPod(podClient corev1client.PodsGetter, pod *corev1api.Pod) error {
+	err := podClient.Pods(pod.Namespace).Delete(context.TODO(), pod.Name, metav1.DeleteOptions{})
+	return errors.Wrapf(err, "error deleting helper pod %s/%s", pod.Namespace, pod.Name)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestGetUnmountedPVCBackups(t *testing.T) {
+	unmounted := builder.WithAnnotations(PVCNameAnnotation, "pvc-1", UnmountedPVCAnnotation, "true")
+
+	podVolumeBackups := []*velerov1api.PodVolumeBackup{
+		builder.ForPodVolumeBackup("velero", "pvb-1").ObjectMeta(unmounted).PodNamespace("ns-1").SnapshotID("snapshot-1").Result(),
+		// from a pod that mounted the claim
+		builder.ForPodVolumeBackup("velero", "pvb-2").ObjectMeta(builder.WithAnnotations(PVCNameAnnotation, "pvc-1")).PodNamespace("ns-1").SnapshotID("snapshot-2").Result(),
+		// of a claim in another namespace
+		builder.ForPodVolumeBackup("velero", "pvb-3").ObjectMeta(unmounted).PodNamespace("ns-2").SnapshotID("snapshot-3").Result(),
+		// without a snapshot
+		builder.ForPodVolumeBackup("velero", "pvb-4").ObjectMeta(unmounted).PodNamespace("ns-1").Result(),
+	}
+
+	assert.Equal(t, podVolumeBackups[:1], GetUnmountedPVCBackups(podVolumeBackups, "ns-1", "pvc-1"))
+	assert.Empty(t, GetUnmountedPVCBackups(podVolumeBackups, "ns-1", "pvc-2"))
+}
+
+func TestIsPVCMounted(t *testing.T) {
+	pod := func(name string, phase corev1api.PodPhase, labels map[string]string, claim string) *corev1api.Pod {
+		return &corev1api.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: name, Labels: labels},
+			Spec: corev1api.PodSpec{
+				Volumes: []corev1api.Volume{
+					{
+						Name: "vol",
+						VolumeSource: corev1api.VolumeSource{
+							PersistentVolumeClaim: &corev1api.PersistentVolumeClaimVolumeSource{ClaimName: claim},
+						},
+					},
+				},
+			},
+			Status: corev1api.PodStatus{Phase: phase},
+		}
+	}
+
+	podClient := kubefake.NewSimpleClientset(
+		pod("running", corev1api.PodRunning, nil, "pvc-1"),
+		pod("completed", corev1api.PodSucceeded, nil, "pvc-2"),
+		pod("helper", corev1api.PodRunning, map[string]string{PVCHelperPodLabel: "true"}, "pvc-3"),
+	).CoreV1()
+
+	tests := []struct {
+		claim string
+		want  bool
+	}{
+		{claim: "pvc-1", want: true},
+		{claim: "pvc-2", want: false},
+		{claim: "pvc-3", want: false},
+		{claim: "pvc-4", want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.claim, func(t *testing.T) {
+			pvc := &corev1api.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: test.claim}}
+
+			mounted, err := isPVCMounted(podClient, pvc)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, mounted)
+		})
+	}
+}
+
+func TestNewPVCHelperPod(t *testing.T) {
+	pvc := &corev1api.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "pvc-1"}}
+
+	// a pod for a backup only mounts the claim
+	pod := newPVCHelperPod(pvc, "pvc", "")
+	assert.Equal(t, "ns-1", pod.Namespace)
+	assert.Equal(t, "pvc-1", pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, "/restores/pvc", pod.Spec.Containers[0].VolumeMounts[0].MountPath)
+	assert.Empty(t, pod.Spec.InitContainers)
+
+	// a pod for a restore waits for it in the restic-wait init container
+	pod = newPVCHelperPod(pvc, "data", "restore-uid")
+	require.Len(t, pod.Spec.InitContainers, 1)
+	assert.Equal(t, InitContainer, pod.Spec.InitContainers[0].Name)
+	assert.Equal(t, []string{"restore-uid"}, pod.Spec.InitContainers[0].Args)
+	assert.Equal(t, "/restores/data", pod.Spec.InitContainers[0].VolumeMounts[0].MountPath)
+}
@@ -0,0 +1,334 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	veleroexec "github.com/vmware-tanzu/velero/pkg/util/exec"
+)
+
+// DefaultUploaderType is the uploader that backs up pod volumes when neither the
+// backup storage location nor the Velero server choose one.
+const DefaultUploaderType = velerov1api.UploaderTypeRestic
+
+// RepoAccess is what an Uploader needs to run commands against a repository.
+type RepoAccess struct {
+	// Identifier is the repository's identifier, like a BackupRepository's
+	// RepoIdentifier.
+	Identifier string
+
+	// PasswordFile is the path of a file containing the repository's password.
+	PasswordFile string
+
+	// CACertFile, if set, is the path of a file containing the CA bundle of the
+	// repository's object storage.
+	CACertFile string
+
+	// Env, if set, is the environment that the uploader's commands are run in.
+	Env []string
+
+	// CacheDir, if set, is the directory of the repository's local cache.
+	CacheDir string
+
+	// CacheSizeLimit, if positive, is the maximum number of bytes of the
+	// repository's local cache.
+	CacheSizeLimit int64
+
+	// UploadLimit and DownloadLimit, if positive, are the maximum numbers
+	// of bytes per second that the uploader's commands upload to and
+	// download from the repository.
+	UploadLimit   int64
+	DownloadLimit int64
+}
+
+// UploaderBackupRequest is a pod volume to back up.
+type UploaderBackupRequest struct {
+	// Path is the path of the volume's directory.
+	Path string
+
+	// Device, if set, is the device of a raw block volume, whose data is backed up
+	// instead of the contents of Path.
+	Device io.Reader
+
+	// Tags are applied to the volume's snapshot.
+	Tags map[string]string
+
+	// ParentSnapshotID, if set, is the ID of the snapshot of the volume's previous
+	// backup, which only the changes since are backed up.
+	ParentSnapshotID string
+
+	// Includes, if set, are glob patterns of the paths within Path that are backed up.
+	Includes []string
+
+	// Excludes are patterns of the paths within Path that are not backed up.
+	Excludes []string
+}
+
+// UploaderRestoreRequest is a pod volume to restore.
+type UploaderRestoreRequest struct {
+	// SnapshotID is the ID of the volume's snapshot.
+	SnapshotID string
+
+	// Path is the path of the directory that the volume is restored to.
+	Path string
+
+	// Device, if set, is the device of a raw block volume, which its data is written
+	// to instead of Path.
+	Device io.Writer
+}
+
+// Uploader backs up and restores the data of pod volumes, and manages the repositories
+// that it's stored in.
+type Uploader interface {
+	// InitRepo initializes a repository.
+	InitRepo(repo RepoAccess) error
+
+	// ConnectToRepo returns an error if the repository doesn't exist, or can't be
+	// authenticated to.
+	ConnectToRepo(repo RepoAccess) error
+
+	// PruneRepo deletes unused data from a repository.
+	PruneRepo(repo RepoAccess) error
+
+	// CheckRepo checks a repository's data for errors.
+	CheckRepo(repo RepoAccess) error
+
+	// UnlockRepo removes stale locks from a repository.
+	UnlockRepo(repo RepoAccess) error
+
+	// RepoSize returns the number of bytes of data that a repository stores.
+	RepoSize(repo RepoAccess) (int64, error)
+
+	// ChangeRepoPassword changes a repository's password to the contents of
+	// newPasswordFile.
+	ChangeRepoPassword(repo RepoAccess, newPasswordFile string) error
+
+	// Forget removes a snapshot from a repository.
+	Forget(repo RepoAccess, snapshotID string) error
+
+	// Backup backs up a pod volume to a repository, and returns the ID of its snapshot.
+	// If the volume is empty, no snapshot is taken and the ID is empty.
+	Backup(repo RepoAccess, req UploaderBackupRequest, updateFunc func(velerov1api.PodVolumeOperationProgress)) (string, error)
+
+	// Restore restores a pod volume from its snapshot in a repository.
+	Restore(repo RepoAccess, req UploaderRestoreRequest, updateFunc func(velerov1api.PodVolumeOperationProgress)) error
+}
+
+// NewUploader returns the Uploader of the given type, which logs to log. An empty
+// uploader type is restic, which was the only uploader before there was a choice.
+func NewUploader(uploaderType velerov1api.UploaderType, log logrus.FieldLogger) (Uploader, error) {
+	switch uploaderType {
+	case "", velerov1api.UploaderTypeRestic:
+		return &resticUploader{log: log}, nil
+	case velerov1api.UploaderTypeKopia:
+		return &kopiaUploader{log: log}, nil
+	}
+
+	return nil, errors.Errorf("unsupported uploader type %q, must be one of %s or %s", uploaderType, velerov1api.UploaderTypeRestic, velerov1api.UploaderTypeKopia)
+}
+
+// ValidateUploaderType returns an error if uploaderType isn't a supported uploader type.
+func ValidateUploaderType(uploaderType string) error {
+	_, err := NewUploader(velerov1api.UploaderType(uploaderType), logrus.New())
+	return err
+}
+
+// resticRepoNotInitialized is part of the error that restic returns when running a
+// command against a repository that hasn't been initialized.
+const resticRepoNotInitialized = "Is there a repository at the following location?"
+
+// IsRepoNotInitialized returns true if err is the error that an Uploader's ConnectToRepo
+// returns when the repository hasn't been initialized, which is the only case where it
+// should be.
+func IsRepoNotInitialized(err error) bool {
+	return strings.Contains(err.Error(), resticRepoNotInitialized) || strings.Contains(err.Error(), kopiaRepoNotInitialized)
+}
+
+// resticUploader is an Uploader that runs restic commands.
+type resticUploader struct {
+	log logrus.FieldLogger
+}
+
+func (u *resticUploader) InitRepo(repo RepoAccess) error {
+	return u.run(repo, InitCommand(repo.Identifier))
+}
+
+func (u *resticUploader) ConnectToRepo(repo RepoAccess) error {
+	snapshotsCmd := SnapshotsCommand(repo.Identifier)
+	// use the '--last' flag to minimize the amount of data fetched since
+	// we're just validating that the repo exists and can be authenticated
+	// to.
+	snapshotsCmd.ExtraFlags = append(snapshotsCmd.ExtraFlags, "--last")
+
+	return u.run(repo, snapshotsCmd)
+}
+
+func (u *resticUploader) PruneRepo(repo RepoAccess) error {
+	return u.run(repo, PruneCommand(repo.Identifier))
+}
+
+func (u *resticUploader) CheckRepo(repo RepoAccess) error {
+	return u.run(repo, CheckCommand(repo.Identifier))
+}
+
+func (u *resticUploader) UnlockRepo(repo RepoAccess) error {
+	return u.run(repo, UnlockCommand(repo.Identifier))
+}
+
+func (u *resticUploader) RepoSize(repo RepoAccess) (int64, error) {
+	stdout, err := u.output(repo, RepoStatsCommand(repo.Identifier))
+	if err != nil {
+		return 0, err
+	}
+
+	var repoStats struct {
+		TotalSize int64 `json:"total_size"`
+	}
+
+	if err := json.Unmarshal([]byte(stdout), &repoStats); err != nil {
+		return 0, errors.Wrap(err, "error unmarshalling restic stats result")
+	}
+
+	return repoStats.TotalSize, nil
+}
+
+func (u *resticUploader) ChangeRepoPassword(repo RepoAccess, newPasswordFile string) error {
+	return u.run(repo, KeyPasswdCommand(repo.Identifier, newPasswordFile))
+}
+
+func (u *resticUploader) Forget(repo RepoAccess, snapshotID string) error {
+	return u.run(repo, ForgetCommand(repo.Identifier, snapshotID))
+}
+
+func (u *resticUploader) Backup(repo RepoAccess, req UploaderBackupRequest, updateFunc func(velerov1api.PodVolumeOperationProgress)) (string, error) {
+	var backupCmd *Command
+	if req.Device != nil {
+		backupCmd = BackupBlockCommand(repo.Identifier, repo.PasswordFile, req.Tags)
+		backupCmd.Stdin = req.Device
+	} else {
+		backupCmd = BackupCommand(repo.Identifier, repo.PasswordFile, req.Path, req.Tags)
+		backupCmd.ExtraFlags = append(backupCmd.ExtraFlags, resticExcludeFlags(req.Path, req.Excludes)...)
+
+		if len(req.Includes) > 0 {
+			paths, err := matchVolumePaths(req.Path, req.Includes)
+			if err != nil {
+				return "", err
+			}
+			if len(paths) == 0 {
+				u.log.Info("No paths in the volume match its include patterns, not backing it up")
+				return "", nil
+			}
+			backupCmd.Args = paths
+		}
+	}
+	setRepoAccess(backupCmd, repo)
+	defer useCache(repo.CacheDir, repo.CacheSizeLimit, u.log)()
+
+	if req.ParentSnapshotID != "" {
+		backupCmd.ExtraFlags = append(backupCmd.ExtraFlags, fmt.Sprintf("--parent=%s", req.ParentSnapshotID))
+	}
+
+	stdout, stderr, err := RunBackup(backupCmd, u.log, updateFunc)
+	if err != nil {
+		if strings.Contains(stderr, "snapshot is empty") {
+			return "", nil
+		}
+		u.log.WithError(errors.WithStack(err)).Errorf("Error running command=%s, stdout=%s, stderr=%s", backupCmd.String(), stdout, stderr)
+		return "", errors.Wrapf(err, "error running restic backup, stderr=%s", stderr)
+	}
+	u.log.Debugf("Ran command=%s, stdout=%s, stderr=%s", backupCmd.String(), stdout, stderr)
+
+	snapshotCmd := GetSnapshotCommand(repo.Identifier, repo.PasswordFile, req.Tags)
+	setRepoAccess(snapshotCmd, repo)
+
+	snapshotID, err := getSnapshotID(snapshotCmd)
+	if err != nil {
+		return "", errors.Wrap(err, "error getting snapshot id")
+	}
+
+	return snapshotID, nil
+}
+
+func (u *resticUploader) Restore(repo RepoAccess, req UploaderRestoreRequest, updateFunc func(velerov1api.PodVolumeOperationProgress)) error {
+	defer useCache(repo.CacheDir, repo.CacheSizeLimit, u.log)()
+
+	if req.Device != nil {
+		dumpCmd := DumpCommand(repo.Identifier, repo.PasswordFile, req.SnapshotID)
+		setRepoAccess(dumpCmd, repo)
+
+		stderr, err := RunBlockRestore(dumpCmd, req.Device, u.log, updateFunc)
+		if err != nil {
+			return errors.Wrapf(err, "error running restic dump, cmd=%s, stderr=%s", dumpCmd.String(), stderr)
+		}
+		u.log.Debugf("Ran command=%s, stderr=%s", dumpCmd.String(), stderr)
+		return nil
+	}
+
+	restoreCmd := RestoreCommand(repo.Identifier, repo.PasswordFile, req.SnapshotID, req.Path)
+	setRepoAccess(restoreCmd, repo)
+
+	stdout, stderr, err := RunRestore(restoreCmd, u.log, updateFunc)
+	if err != nil {
+		return errors.Wrapf(err, "error running restic restore, cmd=%s, stdout=%s, stderr=%s", restoreCmd.String(), stdout, stderr)
+	}
+	u.log.Debugf("Ran command=%s, stdout=%s, stderr=%s", restoreCmd.String(), stdout, stderr)
+
+	return nil
+}
+
+func (u *resticUploader) run(repo RepoAccess, cmd *Command) error {
+	_, err := u.output(repo, cmd)
+	return err
+}
+
+// output runs a restic command like run, and returns its stdout.
+func (u *resticUploader) output(repo RepoAccess, cmd *Command) (string, error) {
+	setRepoAccess(cmd, repo)
+	defer useCache(repo.CacheDir, repo.CacheSizeLimit, u.log)()
+
+	stdout, stderr, err := veleroexec.RunCommand(cmd.Cmd())
+	u.log.WithFields(logrus.Fields{
+		"repository": cmd.RepoName(),
+		"command":    cmd.String(),
+		"stdout":     stdout,
+		"stderr":     stderr,
+	}).Debugf("Ran restic command")
+	if err != nil {
+		return "", errors.Wrapf(err, "error running command=%s, stdout=%s, stderr=%s", cmd.String(), stdout, stderr)
+	}
+
+	return stdout, nil
+}
+
+func setRepoAccess(cmd *Command, repo RepoAccess) {
+	cmd.PasswordFile = repo.PasswordFile
+	cmd.CACertFile = repo.CACertFile
+	cmd.CacheDir = repo.CacheDir
+	cmd.UploadLimit = repo.UploadLimit
+	cmd.DownloadLimit = repo.DownloadLimit
+	if len(repo.Env) > 0 {
+		cmd.Env = repo.Env
+	}
+}
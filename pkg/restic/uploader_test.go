@@ -0,0 +1,49 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestNewUploader(t *testing.T) {
+	for _, uploaderType := range []velerov1api.UploaderType{"", velerov1api.UploaderTypeRestic} {
+		uploader, err := NewUploader(uploaderType, velerotest.NewLogger())
+		require.NoError(t, err)
+		assert.IsType(t, &resticUploader{}, uploader)
+	}
+
+	uploader, err := NewUploader(velerov1api.UploaderTypeKopia, velerotest.NewLogger())
+	require.NoError(t, err)
+	assert.IsType(t, &kopiaUploader{}, uploader)
+
+	_, err = NewUploader("rsync", velerotest.NewLogger())
+	assert.EqualError(t, err, `unsupported uploader type "rsync", must be one of restic or kopia`)
+}
+
+func TestIsRepoNotInitialized(t *testing.T) {
+	assert.True(t, IsRepoNotInitialized(errors.New("Fatal: unable to open config file: Stat: The specified key does not exist.\nIs there a repository at the following location?")))
+	assert.True(t, IsRepoNotInitialized(errors.New("error connecting to repository: repository not initialized in the provided storage")))
+	assert.False(t, IsRepoNotInitialized(errors.New("Fatal: unable to create lock in backend: repository is already locked exclusively")))
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// VolumeChangeIndicator returns a digest of the names, sizes, modes, modification times,
+// change times and inode numbers of the files and directories in a volume's directory, which
+// changes when the volume's data does. Only the files' metadata is read, so it's much cheaper
+// than backing the volume up. A file's modification time can be set back after its data is
+// rewritten, but its change time can't, and a file that's replaced has a new inode.
+func VolumeChangeIndicator(volumePath string) (string, error) {
+	hash := sha256.New()
+
+	err := filepath.Walk(volumePath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(volumePath, path)
+		if err != nil {
+			return err
+		}
+
+		inode, changeTime := fileInodeAndChangeTime(info)
+		fmt.Fprintf(hash, "%s\x00%d\x00%s\x00%d\x00%d\x00%d\n", rel, info.Size(), info.Mode(), info.ModTime().UnixNano(), changeTime, inode)
+		return nil
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "error reading volume directory")
+	}
+
+	return "sha256:" + hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVolumeChangeIndicator(t *testing.T) {
+	dir, err := ioutil.TempDir("", "volume")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	modTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	file := filepath.Join(dir, "data", "file")
+	require.NoError(t, os.MkdirAll(filepath.Dir(file), 0755))
+	require.NoError(t, ioutil.WriteFile(file, []byte("data"), 0644))
+	require.NoError(t, os.Chtimes(file, modTime, modTime))
+
+	indicator, err := VolumeChangeIndicator(dir)
+	require.NoError(t, err)
+
+	again, err := VolumeChangeIndicator(dir)
+	require.NoError(t, err)
+	assert.Equal(t, indicator, again, "an unchanged volume has the same indicator")
+
+	// the same size and modification time, but a different mode.
+	require.NoError(t, os.Chmod(file, 0600))
+	changed, err := VolumeChangeIndicator(dir)
+	require.NoError(t, err)
+	assert.NotEqual(t, indicator, changed)
+
+	// a new modification time.
+	require.NoError(t, os.Chtimes(file, modTime, modTime.Add(time.Second)))
+	changedAgain, err := VolumeChangeIndicator(dir)
+	require.NoError(t, err)
+	assert.NotEqual(t, changed, changedAgain)
+
+	_, err = VolumeChangeIndicator(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
+
+func TestVolumeChangeIndicatorRewrittenFile(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("change times and inodes are only read on Linux")
+	}
+
+	dir, err := ioutil.TempDir("", "volume")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	modTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	file := filepath.Join(dir, "file")
+	require.NoError(t, ioutil.WriteFile(file, []byte("data"), 0644))
+	require.NoError(t, os.Chtimes(file, modTime, modTime))
+
+	indicator, err := VolumeChangeIndicator(dir)
+	require.NoError(t, err)
+
+	// the file's contents are rewritten in place with data of the same size, and its
+	// modification time is set back. Its change time is only updated as often as the
+	// kernel's clock ticks, so wait long enough for it to differ.
+	time.Sleep(50 * time.Millisecond)
+	require.NoError(t, ioutil.WriteFile(file, []byte("DATA"), 0644))
+	require.NoError(t, os.Chtimes(file, modTime, modTime))
+
+	rewritten, err := VolumeChangeIndicator(dir)
+	require.NoError(t, err)
+	assert.NotEqual(t, indicator, rewritten)
+
+	// the file is replaced with a new file with the same contents and times.
+	replacement := filepath.Join(dir, "replacement")
+	require.NoError(t, ioutil.WriteFile(replacement, []byte("DATA"), 0644))
+	require.NoError(t, os.Chtimes(replacement, modTime, modTime))
+	require.NoError(t, os.Rename(replacement, file))
+
+	replaced, err := VolumeChangeIndicator(dir)
+	require.NoError(t, err)
+	assert.NotEqual(t, rewritten, replaced)
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+const (
+	// VolumePathIncludesAnnotation is the annotation on a pod that chooses, per volume, glob
+	// patterns of the paths within the volume that are backed up, as a comma-separated list
+	// of <volume name>=<pattern>[;<pattern>...] pairs.
+	VolumePathIncludesAnnotation = "backup.velero.io/volume-path-includes"
+
+	// VolumePathExcludesAnnotation is the annotation on a pod that chooses, per volume, patterns
+	// of the paths within the volume that are not backed up, in the same format as
+	// VolumePathIncludesAnnotation.
+	VolumePathExcludesAnnotation = "backup.velero.io/volume-path-excludes"
+)
+
+// getVolumePathPatterns returns a map, of volume name -> patterns, of the patterns in the
+// given annotation of a pod.
+func getVolumePathPatterns(obj metav1.Object, annotation string) map[string][]string {
+	value := obj.GetAnnotations()[annotation]
+	if value == "" {
+		return nil
+	}
+
+	res := make(map[string][]string)
+	for _, entry := range strings.Split(value, ",") {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		volume := strings.TrimSpace(parts[0])
+		for _, pattern := range strings.Split(parts[1], ";") {
+			if pattern = strings.TrimSpace(pattern); pattern != "" {
+				res[volume] = append(res[volume], pattern)
+			}
+		}
+	}
+
+	return res
+}
+
+// getVolumePathFilters returns the include and exclude patterns of the paths within a pod's
+// volume that are backed up. The pod's include patterns for the volume take precedence over
+// the backup's, and its exclude patterns are in addition to the backup's.
+func getVolumePathFilters(backup *velerov1api.Backup, pod metav1.Object, volume string) ([]string, []string) {
+	includes := getVolumePathPatterns(pod, VolumePathIncludesAnnotation)[volume]
+	if len(includes) == 0 {
+		includes = backup.Spec.VolumePathIncludes
+	}
+
+	var excludes []string
+	excludes = append(excludes, backup.Spec.VolumePathExcludes...)
+	excludes = append(excludes, getVolumePathPatterns(pod, VolumePathExcludesAnnotation)[volume]...)
+
+	return includes, excludes
+}
+
+// ValidateVolumePathPatterns returns an error if one of the patterns isn't a valid glob
+// pattern, or refers to a path outside of a volume.
+func ValidateVolumePathPatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return errors.Wrapf(err, "invalid volume path pattern %q", pattern)
+		}
+		for _, elem := range strings.Split(pattern, "/") {
+			if elem == ".." {
+				return errors.Errorf("invalid volume path pattern %q, patterns can't refer to parent directories", pattern)
+			}
+		}
+	}
+
+	return nil
+}
+
+// matchVolumePaths returns the sorted paths, relative to the volume's directory, that match the
+// include patterns.
+func matchVolumePaths(volumePath string, includes []string) ([]string, error) {
+	if err := ValidateVolumePathPatterns(includes); err != nil {
+		return nil, err
+	}
+
+	matched := make(map[string]struct{})
+	for _, pattern := range includes {
+		matches, err := filepath.Glob(filepath.Join(volumePath, strings.TrimPrefix(pattern, "/")))
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, match := range matches {
+			rel, err := filepath.Rel(volumePath, match)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			matched[rel] = struct{}{}
+		}
+	}
+
+	var res []string
+	for path := range matched {
+		res = append(res, path)
+	}
+	sort.Strings(res)
+
+	return res, nil
+}
+
+// resticExcludeFlags returns the flags of a restic backup of the volume's directory that
+// exclude the paths that match the patterns. Patterns that start with "/" are anchored to the
+// root of the volume, and others match at any depth.
+func resticExcludeFlags(volumePath string, excludes []string) []string {
+	var flags []string
+	for _, pattern := range excludes {
+		if strings.HasPrefix(pattern, "/") {
+			pattern = filepath.Join(volumePath, pattern)
+		}
+		flags = append(flags, "--exclude="+pattern)
+	}
+	return flags
+}
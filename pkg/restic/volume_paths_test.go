@@ -0,0 +1,112 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestGetVolumePathFilters(t *testing.T) {
+	tests := []struct {
+		name         string
+		pod          *builder.PodBuilder
+		backup       *builder.BackupBuilder
+		volume       string
+		wantIncludes []string
+		wantExcludes []string
+	}{
+		{
+			name:   "no patterns",
+			pod:    builder.ForPod("ns-1", "pod-1"),
+			backup: builder.ForBackup("velero", "backup-1"),
+			volume: "vol-1",
+		},
+		{
+			name:         "the backup's patterns",
+			pod:          builder.ForPod("ns-1", "pod-1"),
+			backup:       builder.ForBackup("velero", "backup-1").VolumePathIncludes("data").VolumePathExcludes("node_modules"),
+			volume:       "vol-1",
+			wantIncludes: []string{"data"},
+			wantExcludes: []string{"node_modules"},
+		},
+		{
+			name: "the pod's patterns for the volume take precedence over the backup's includes, and add to its excludes",
+			pod: builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithAnnotations(
+				VolumePathIncludesAnnotation, "vol-1=src;/config, vol-2=other",
+				VolumePathExcludesAnnotation, "vol-1=.cache;*.log.*",
+			)),
+			backup:       builder.ForBackup("velero", "backup-1").VolumePathIncludes("data").VolumePathExcludes("node_modules"),
+			volume:       "vol-1",
+			wantIncludes: []string{"src", "/config"},
+			wantExcludes: []string{"node_modules", ".cache", "*.log.*"},
+		},
+		{
+			name:         "the pod's patterns for other volumes are ignored",
+			pod:          builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithAnnotations(VolumePathExcludesAnnotation, "vol-2=.cache")),
+			backup:       builder.ForBackup("velero", "backup-1"),
+			volume:       "vol-1",
+			wantIncludes: nil,
+			wantExcludes: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			includes, excludes := getVolumePathFilters(test.backup.Result(), test.pod.Result(), test.volume)
+			assert.Equal(t, test.wantIncludes, includes)
+			assert.Equal(t, test.wantExcludes, excludes)
+		})
+	}
+}
+
+func TestValidateVolumePathPatterns(t *testing.T) {
+	assert.NoError(t, ValidateVolumePathPatterns([]string{"node_modules", "/data/*.db", "**/cache"}))
+	assert.Error(t, ValidateVolumePathPatterns([]string{"[a-"}))
+	assert.Error(t, ValidateVolumePathPatterns([]string{"data/../../etc"}))
+}
+
+func TestMatchVolumePaths(t *testing.T) {
+	dir, err := ioutil.TempDir("", "volume")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for _, path := range []string{"src/app", "src/lib", "data/db", "logs/app.log"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, path), 0755))
+	}
+
+	paths, err := matchVolumePaths(dir, []string{"src/*", "/data", "missing"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"data", "src/app", "src/lib"}, paths)
+
+	_, err = matchVolumePaths(dir, []string{"../*"})
+	assert.Error(t, err)
+}
+
+func TestResticExcludeFlags(t *testing.T) {
+	assert.Equal(t,
+		[]string{"--exclude=node_modules", "--exclude=/host_pods/uid/volumes/vol-1/tmp"},
+		resticExcludeFlags("/host_pods/uid/volumes/vol-1", []string{"node_modules", "/tmp"}),
+	)
+}
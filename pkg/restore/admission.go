@@ -0,0 +1,131 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// AdmissionHandler is an HTTP handler for a validating admission webhook that
+// rejects Restores whose creator, or the user changing their spec, isn't allowed
+// to create the items the restore would create.
+type AdmissionHandler struct {
+	authorizer *Authorizer
+	log        logrus.FieldLogger
+}
+
+// NewAdmissionHandler is the constructor for AdmissionHandler.
+func NewAdmissionHandler(authorizer *Authorizer, log logrus.FieldLogger) *AdmissionHandler {
+	return &AdmissionHandler{
+		authorizer: authorizer,
+		log:        log,
+	}
+}
+
+// ServeHTTP reads an AdmissionReview from the request and responds with the
+// review's result.
+func (h *AdmissionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	review := new(admissionv1.AdmissionReview)
+	if err := json.NewDecoder(r.Body).Decode(review); err != nil {
+		h.log.WithError(err).Error("Error decoding admission review")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if review.Request == nil {
+		http.Error(w, "admission review has no request", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = h.review(review.Request)
+	review.Response.UID = review.Request.UID
+	review.Request = nil
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		h.log.WithError(err).Error("Error encoding admission review")
+	}
+}
+
+// review returns the response to an admission request. The creation of Restores
+// and updates that change their spec are validated; every other request is allowed.
+func (h *AdmissionHandler) review(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	if req.Resource.Group != velerov1api.SchemeGroupVersion.Group || req.Resource.Resource != "restores" {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	restore := new(velerov1api.Restore)
+	if err := json.Unmarshal(req.Object.Raw, restore); err != nil {
+		return deniedResponse(http.StatusBadRequest, errors.Wrap(err, "error decoding restore").Error())
+	}
+
+	// updates of a restore's status or metadata don't change what it restores, so
+	// only updates of its spec are authorized.
+	if req.Operation == admissionv1.Update {
+		original := new(velerov1api.Restore)
+		if err := json.Unmarshal(req.OldObject.Raw, original); err != nil {
+			return deniedResponse(http.StatusBadRequest, errors.Wrap(err, "error decoding original restore").Error())
+		}
+		if equality.Semantic.DeepEqual(original.Spec, restore.Spec) {
+			return &admissionv1.AdmissionResponse{Allowed: true}
+		}
+	}
+
+	log := h.log.WithFields(logrus.Fields{
+		"restore": req.Namespace + "/" + restore.Name,
+		"user":    req.UserInfo.Username,
+	})
+
+	denied, err := h.authorizer.Authorize(req.UserInfo, restore)
+	if err != nil {
+		log.WithError(err).Error("Error authorizing restore")
+		return deniedResponse(http.StatusInternalServerError, errors.Wrap(err, "error authorizing restore").Error())
+	}
+	if len(denied) > 0 {
+		msgs := make([]string, 0, len(denied))
+		for _, err := range denied {
+			msgs = append(msgs, err.Error())
+		}
+		log.Infof("Restore denied: %s", strings.Join(msgs, "; "))
+		return deniedResponse(http.StatusForbidden, strings.Join(msgs, "; "))
+	}
+
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
+
+func deniedResponse(code int32, message string) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{
+		Allowed: false,
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    code,
+			Message: message,
+		},
+	}
+}
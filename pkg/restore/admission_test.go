@@ -0,0 +1,148 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestAdmissionHandler(t *testing.T) {
+	restoreJSON := func(t *testing.T, resources ...string) []byte {
+		res, err := json.Marshal(defaultRestore().IncludedNamespaces("ns-1").IncludedResources(resources...).Result())
+		require.NoError(t, err)
+		return res
+	}
+
+	restoresResource := metav1.GroupVersionResource{Group: "velero.io", Version: "v1", Resource: "restores"}
+
+	tests := []struct {
+		name        string
+		operation   admissionv1.Operation
+		resource    metav1.GroupVersionResource
+		object      func(t *testing.T) []byte
+		oldObject   func(t *testing.T) []byte
+		wantAllowed bool
+		wantCode    int32
+	}{
+		{
+			name:        "restore the user is allowed to perform is admitted",
+			operation:   admissionv1.Create,
+			resource:    restoresResource,
+			object:      func(t *testing.T) []byte { return restoreJSON(t, "pods") },
+			wantAllowed: true,
+		},
+		{
+			name:        "restore the user isn't allowed to perform is denied",
+			operation:   admissionv1.Create,
+			resource:    restoresResource,
+			object:      func(t *testing.T) []byte { return restoreJSON(t, "pods", "secrets") },
+			wantAllowed: false,
+			wantCode:    http.StatusForbidden,
+		},
+		{
+			name:        "update of a restore's spec the user is allowed to perform is admitted",
+			operation:   admissionv1.Update,
+			resource:    restoresResource,
+			object:      func(t *testing.T) []byte { return restoreJSON(t, "pods") },
+			oldObject:   func(t *testing.T) []byte { return restoreJSON(t, "configmaps") },
+			wantAllowed: true,
+		},
+		{
+			name:        "update of a restore's spec the user isn't allowed to perform is denied",
+			operation:   admissionv1.Update,
+			resource:    restoresResource,
+			object:      func(t *testing.T) []byte { return restoreJSON(t, "secrets") },
+			oldObject:   func(t *testing.T) []byte { return restoreJSON(t, "pods") },
+			wantAllowed: false,
+			wantCode:    http.StatusForbidden,
+		},
+		{
+			name:      "update that doesn't change a restore's spec is admitted",
+			operation: admissionv1.Update,
+			resource:  restoresResource,
+			object: func(t *testing.T) []byte {
+				res, err := json.Marshal(defaultRestore().IncludedNamespaces("ns-1").IncludedResources("secrets").Phase(velerov1api.RestorePhaseCompleted).Result())
+				require.NoError(t, err)
+				return res
+			},
+			oldObject:   func(t *testing.T) []byte { return restoreJSON(t, "secrets") },
+			wantAllowed: true,
+		},
+		{
+			name:        "other resources are admitted",
+			operation:   admissionv1.Create,
+			resource:    metav1.GroupVersionResource{Group: "velero.io", Version: "v1", Resource: "backups"},
+			object:      func(t *testing.T) []byte { return []byte("{}") },
+			wantAllowed: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewAdmissionHandler(newFakeAuthorizer(t, []string{"create pods ns-1", "get pods ns-1"}, "ns-1"), velerotest.NewLogger())
+
+			review := &admissionv1.AdmissionReview{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: admissionv1.SchemeGroupVersion.String(),
+					Kind:       "AdmissionReview",
+				},
+				Request: &admissionv1.AdmissionRequest{
+					UID:       types.UID("uid-1"),
+					Operation: tc.operation,
+					Resource:  tc.resource,
+					Namespace: "velero",
+					UserInfo:  authenticationv1.UserInfo{Username: "jane"},
+					Object:    runtime.RawExtension{Raw: tc.object(t)},
+				},
+			}
+			if tc.oldObject != nil {
+				review.Request.OldObject = runtime.RawExtension{Raw: tc.oldObject(t)}
+			}
+			body, err := json.Marshal(review)
+			require.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/validate-restore", bytes.NewReader(body)))
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			res := new(admissionv1.AdmissionReview)
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(res))
+			require.NotNil(t, res.Response)
+
+			assert.Equal(t, types.UID("uid-1"), res.Response.UID)
+			assert.Equal(t, tc.wantAllowed, res.Response.Allowed)
+			if !tc.wantAllowed {
+				assert.Equal(t, tc.wantCode, res.Response.Result.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/util/flowcontrol"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+)
+
+// ValidateAPIRateLimit checks that a restore's API rate limit allows a positive
+// number of requests per second, and that its burst isn't negative.
+func ValidateAPIRateLimit(spec *velerov1api.APIRateLimitSpec) []error {
+	if spec == nil {
+		return nil
+	}
+
+	var errs []error
+	if spec.QPS <= 0 {
+		errs = append(errs, errors.Errorf("invalid API rate limit QPS %d: must be greater than 0", spec.QPS))
+	}
+	if spec.Burst < 0 {
+		errs = append(errs, errors.Errorf("invalid API rate limit burst %d: must not be negative", spec.Burst))
+	}
+
+	return errs
+}
+
+// newRateLimitedDynamicFactory returns a DynamicFactory whose clients wait for
+// the restore's API rate limit before each request. If the restore doesn't have
+// an API rate limit, the given factory is returned.
+func newRateLimitedDynamicFactory(factory client.DynamicFactory, spec *velerov1api.APIRateLimitSpec) client.DynamicFactory {
+	if spec == nil {
+		return factory
+	}
+
+	burst := spec.Burst
+	if burst == 0 {
+		burst = spec.QPS
+	}
+
+	return &rateLimitedDynamicFactory{
+		DynamicFactory: factory,
+		limiter:        flowcontrol.NewTokenBucketRateLimiter(float32(spec.QPS), burst),
+	}
+}
+
+// rateLimitedDynamicFactory is a DynamicFactory whose clients share a rate limiter.
+type rateLimitedDynamicFactory struct {
+	client.DynamicFactory
+	limiter flowcontrol.RateLimiter
+}
+
+func (f *rateLimitedDynamicFactory) ClientForGroupVersionResource(gv schema.GroupVersion, resource metav1.APIResource, namespace string) (client.Dynamic, error) {
+	dynamic, err := f.DynamicFactory.ClientForGroupVersionResource(gv, resource, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rateLimitedDynamic{
+		Dynamic: dynamic,
+		limiter: f.limiter,
+	}, nil
+}
+
+// rateLimitedDynamic is a Dynamic client that waits for its rate limiter before
+// each request.
+type rateLimitedDynamic struct {
+	client.Dynamic
+	limiter flowcontrol.RateLimiter
+}
+
+func (d *rateLimitedDynamic) Create(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	d.limiter.Accept()
+	return d.Dynamic.Create(obj)
+}
+
+func (d *rateLimitedDynamic) List(options metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	d.limiter.Accept()
+	return d.Dynamic.List(options)
+}
+
+func (d *rateLimitedDynamic) Watch(options metav1.ListOptions) (watch.Interface, error) {
+	d.limiter.Accept()
+	return d.Dynamic.Watch(options)
+}
+
+func (d *rateLimitedDynamic) Get(name string, opts metav1.GetOptions) (*unstructured.Unstructured, error) {
+	d.limiter.Accept()
+	return d.Dynamic.Get(name, opts)
+}
+
+func (d *rateLimitedDynamic) Patch(name string, data []byte) (*unstructured.Unstructured, error) {
+	d.limiter.Accept()
+	return d.Dynamic.Patch(name, data)
+}
+
+func (d *rateLimitedDynamic) UpdateStatus(obj *unstructured.Unstructured, opts metav1.UpdateOptions) (*unstructured.Unstructured, error) {
+	d.limiter.Accept()
+	return d.Dynamic.UpdateStatus(obj, opts)
+}
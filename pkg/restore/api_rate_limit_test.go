@@ -0,0 +1,110 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/flowcontrol"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestValidateAPIRateLimit(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       *velerov1api.APIRateLimitSpec
+		wantErrors int
+	}{
+		{
+			name: "nil spec is valid",
+		},
+		{
+			name: "positive QPS without a burst is valid",
+			spec: &velerov1api.APIRateLimitSpec{QPS: 10},
+		},
+		{
+			name: "positive QPS and burst are valid",
+			spec: &velerov1api.APIRateLimitSpec{QPS: 10, Burst: 20},
+		},
+		{
+			name:       "QPS that isn't positive is invalid",
+			spec:       &velerov1api.APIRateLimitSpec{QPS: 0},
+			wantErrors: 1,
+		},
+		{
+			name:       "negative burst is invalid",
+			spec:       &velerov1api.APIRateLimitSpec{QPS: 10, Burst: -1},
+			wantErrors: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Len(t, ValidateAPIRateLimit(tc.spec), tc.wantErrors)
+		})
+	}
+}
+
+// countingRateLimiter is a rate limiter that counts the requests it accepts.
+type countingRateLimiter struct {
+	flowcontrol.RateLimiter
+	accepted int
+}
+
+func (l *countingRateLimiter) Accept() {
+	l.accepted++
+}
+
+func TestRateLimitedDynamicFactory(t *testing.T) {
+	apiServer := test.NewAPIServer(t)
+	factory := client.NewDynamicFactory(apiServer.DynamicClient)
+
+	assert.Equal(t, factory, newRateLimitedDynamicFactory(factory, nil))
+
+	limiter := new(countingRateLimiter)
+	rateLimited := &rateLimitedDynamicFactory{DynamicFactory: factory, limiter: limiter}
+
+	pods := test.Pods()
+	resourceClient, err := rateLimited.ClientForGroupVersionResource(schema.GroupVersion{Version: "v1"}, metav1.APIResource{Name: pods.Name, Namespaced: true}, "ns-1")
+	require.NoError(t, err)
+
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"namespace": "ns-1",
+			"name":      "pod-1",
+		},
+	}}
+
+	_, err = resourceClient.Create(obj)
+	require.NoError(t, err)
+	_, err = resourceClient.Get("pod-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	_, err = resourceClient.List(metav1.ListOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, limiter.accepted)
+}
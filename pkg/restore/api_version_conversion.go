@@ -0,0 +1,207 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// apiVersionConversion identifies a change of the API version of a resource's items.
+type apiVersionConversion struct {
+	groupResource schema.GroupResource
+	from, to      string
+}
+
+// apiVersionConverter converts an item between API versions of its resource, in
+// place. Only the fields that differ between the versions are changed.
+type apiVersionConverter func(obj *unstructured.Unstructured) error
+
+var (
+	ingresses                = schema.GroupResource{Group: "networking.k8s.io", Resource: "ingresses"}
+	horizontalPodAutoscalers = schema.GroupResource{Group: "autoscaling", Resource: "horizontalpodautoscalers"}
+)
+
+// apiVersionConverters are the conversions of built-in resources whose schemas differ
+// between API versions. Items of the other built-in resources keep their fields when
+// their API version is changed, since the versions share the same schema.
+var apiVersionConverters = map[apiVersionConversion]apiVersionConverter{
+	{groupResource: ingresses, from: "v1beta1", to: "v1"}:                     convertIngressV1beta1ToV1,
+	{groupResource: horizontalPodAutoscalers, from: "v2beta1", to: "v2beta2"}: convertHorizontalPodAutoscalerV2beta1ToV2,
+	{groupResource: horizontalPodAutoscalers, from: "v2beta1", to: "v2"}:      convertHorizontalPodAutoscalerV2beta1ToV2,
+}
+
+// convertAPIVersion converts obj from API version from to API version to of the
+// group-resource, returning whether a conversion was needed.
+func convertAPIVersion(obj *unstructured.Unstructured, groupResource schema.GroupResource, from, to string) (bool, error) {
+	convert, ok := apiVersionConverters[apiVersionConversion{groupResource: groupResource, from: from, to: to}]
+	if !ok {
+		return false, nil
+	}
+
+	if err := convert(obj); err != nil {
+		return false, errors.Wrapf(err, "error converting %s from API version %s to %s", groupResource, from, to)
+	}
+	return true, nil
+}
+
+// convertIngressV1beta1ToV1 converts an ingress's backends from references to a
+// service name and port to references to a service, and moves its default backend
+// from spec.backend to spec.defaultBackend. Paths without a type get the
+// ImplementationSpecific type, which was the default in v1beta1 and is required
+// in v1.
+func convertIngressV1beta1ToV1(obj *unstructured.Unstructured) error {
+	spec, found, err := unstructured.NestedMap(obj.Object, "spec")
+	if err != nil || !found {
+		return err
+	}
+
+	if backend, ok := spec["backend"].(map[string]interface{}); ok {
+		spec["defaultBackend"] = convertIngressBackendV1beta1ToV1(backend)
+		delete(spec, "backend")
+	}
+
+	rules, _ := spec["rules"].([]interface{})
+	for _, rule := range rules {
+		rule, ok := rule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		paths, _, _ := unstructured.NestedSlice(rule, "http", "paths")
+		for i := range paths {
+			path, ok := paths[i].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if backend, ok := path["backend"].(map[string]interface{}); ok {
+				path["backend"] = convertIngressBackendV1beta1ToV1(backend)
+			}
+			if _, ok := path["pathType"]; !ok {
+				path["pathType"] = "ImplementationSpecific"
+			}
+		}
+		if paths != nil {
+			if err := unstructured.SetNestedSlice(rule, paths, "http", "paths"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return unstructured.SetNestedMap(obj.Object, spec, "spec")
+}
+
+func convertIngressBackendV1beta1ToV1(backend map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{})
+	if resource, ok := backend["resource"]; ok {
+		res["resource"] = resource
+	}
+
+	serviceName, ok := backend["serviceName"].(string)
+	if !ok {
+		return res
+	}
+
+	service := map[string]interface{}{"name": serviceName}
+	switch port := backend["servicePort"].(type) {
+	case string:
+		if parsed := intstr.Parse(port); parsed.Type == intstr.Int {
+			service["port"] = map[string]interface{}{"number": int64(parsed.IntVal)}
+		} else {
+			service["port"] = map[string]interface{}{"name": port}
+		}
+	case int64:
+		service["port"] = map[string]interface{}{"number": port}
+	case float64:
+		service["port"] = map[string]interface{}{"number": int64(port)}
+	}
+	res["service"] = service
+
+	return res
+}
+
+// convertHorizontalPodAutoscalerV2beta1ToV2 converts a horizontal pod autoscaler's
+// metrics from the v2beta1 format, where each metric has its own target fields, to
+// the format of v2beta2 and v2, where metrics share a metric identifier and a target
+// with a type.
+func convertHorizontalPodAutoscalerV2beta1ToV2(obj *unstructured.Unstructured) error {
+	metrics, found, err := unstructured.NestedSlice(obj.Object, "spec", "metrics")
+	if err != nil || !found {
+		return err
+	}
+
+	for i := range metrics {
+		metric, ok := metrics[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if source, ok := metric["resource"].(map[string]interface{}); ok {
+			converted := map[string]interface{}{"name": source["name"]}
+			switch {
+			case source["targetAverageUtilization"] != nil:
+				converted["target"] = map[string]interface{}{"type": "Utilization", "averageUtilization": source["targetAverageUtilization"]}
+			case source["targetAverageValue"] != nil:
+				converted["target"] = map[string]interface{}{"type": "AverageValue", "averageValue": source["targetAverageValue"]}
+			}
+			metric["resource"] = converted
+		}
+
+		if source, ok := metric["pods"].(map[string]interface{}); ok {
+			metric["pods"] = map[string]interface{}{
+				"metric": metricIdentifier(source["metricName"], source["selector"]),
+				"target": map[string]interface{}{"type": "AverageValue", "averageValue": source["targetAverageValue"]},
+			}
+		}
+
+		if source, ok := metric["object"].(map[string]interface{}); ok {
+			converted := map[string]interface{}{
+				"describedObject": source["target"],
+				"metric":          metricIdentifier(source["metricName"], source["selector"]),
+			}
+			if source["averageValue"] != nil {
+				converted["target"] = map[string]interface{}{"type": "AverageValue", "averageValue": source["averageValue"]}
+			} else {
+				converted["target"] = map[string]interface{}{"type": "Value", "value": source["targetValue"]}
+			}
+			metric["object"] = converted
+		}
+
+		if source, ok := metric["external"].(map[string]interface{}); ok {
+			converted := map[string]interface{}{
+				"metric": metricIdentifier(source["metricName"], source["metricSelector"]),
+			}
+			if source["targetAverageValue"] != nil {
+				converted["target"] = map[string]interface{}{"type": "AverageValue", "averageValue": source["targetAverageValue"]}
+			} else {
+				converted["target"] = map[string]interface{}{"type": "Value", "value": source["targetValue"]}
+			}
+			metric["external"] = converted
+		}
+	}
+
+	return unstructured.SetNestedSlice(obj.Object, metrics, "spec", "metrics")
+}
+
+func metricIdentifier(name, selector interface{}) map[string]interface{} {
+	res := map[string]interface{}{"name": name}
+	if selector != nil {
+		res["selector"] = selector
+	}
+	return res
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestConvertAPIVersion(t *testing.T) {
+	tests := []struct {
+		name          string
+		groupResource schema.GroupResource
+		from, to      string
+		obj           map[string]interface{}
+		want          map[string]interface{}
+		wantConverted bool
+	}{
+		{
+			name:          "resources without a converter are left unchanged",
+			groupResource: schema.GroupResource{Group: "apps", Resource: "deployments"},
+			from:          "v1beta2",
+			to:            "v1",
+			obj:           map[string]interface{}{"spec": map[string]interface{}{"replicas": int64(1)}},
+			want:          map[string]interface{}{"spec": map[string]interface{}{"replicas": int64(1)}},
+		},
+		{
+			name:          "ingress backends are converted to service references",
+			groupResource: ingresses,
+			from:          "v1beta1",
+			to:            "v1",
+			obj: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"backend": map[string]interface{}{"serviceName": "default", "servicePort": int64(80)},
+					"rules": []interface{}{
+						map[string]interface{}{
+							"host": "example.com",
+							"http": map[string]interface{}{
+								"paths": []interface{}{
+									map[string]interface{}{
+										"path":    "/",
+										"backend": map[string]interface{}{"serviceName": "web", "servicePort": "http"},
+									},
+									map[string]interface{}{
+										"path":     "/api",
+										"pathType": "Prefix",
+										"backend":  map[string]interface{}{"serviceName": "api", "servicePort": "8080"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"defaultBackend": map[string]interface{}{
+						"service": map[string]interface{}{"name": "default", "port": map[string]interface{}{"number": int64(80)}},
+					},
+					"rules": []interface{}{
+						map[string]interface{}{
+							"host": "example.com",
+							"http": map[string]interface{}{
+								"paths": []interface{}{
+									map[string]interface{}{
+										"path":     "/",
+										"pathType": "ImplementationSpecific",
+										"backend": map[string]interface{}{
+											"service": map[string]interface{}{"name": "web", "port": map[string]interface{}{"name": "http"}},
+										},
+									},
+									map[string]interface{}{
+										"path":     "/api",
+										"pathType": "Prefix",
+										"backend": map[string]interface{}{
+											"service": map[string]interface{}{"name": "api", "port": map[string]interface{}{"number": int64(8080)}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantConverted: true,
+		},
+		{
+			name:          "horizontal pod autoscaler metrics are converted to metric identifiers and targets",
+			groupResource: horizontalPodAutoscalers,
+			from:          "v2beta1",
+			to:            "v2",
+			obj: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"maxReplicas": int64(10),
+					"metrics": []interface{}{
+						map[string]interface{}{
+							"type":     "Resource",
+							"resource": map[string]interface{}{"name": "cpu", "targetAverageUtilization": int64(50)},
+						},
+						map[string]interface{}{
+							"type": "Pods",
+							"pods": map[string]interface{}{"metricName": "requests", "targetAverageValue": "1k"},
+						},
+						map[string]interface{}{
+							"type": "Object",
+							"object": map[string]interface{}{
+								"target":      map[string]interface{}{"kind": "Service", "name": "web"},
+								"metricName":  "hits",
+								"targetValue": "10",
+							},
+						},
+						map[string]interface{}{
+							"type": "External",
+							"external": map[string]interface{}{
+								"metricName":         "queue",
+								"metricSelector":     map[string]interface{}{"matchLabels": map[string]interface{}{"queue": "jobs"}},
+								"targetAverageValue": "30",
+							},
+						},
+					},
+				},
+			},
+			want: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"maxReplicas": int64(10),
+					"metrics": []interface{}{
+						map[string]interface{}{
+							"type": "Resource",
+							"resource": map[string]interface{}{
+								"name":   "cpu",
+								"target": map[string]interface{}{"type": "Utilization", "averageUtilization": int64(50)},
+							},
+						},
+						map[string]interface{}{
+							"type": "Pods",
+							"pods": map[string]interface{}{
+								"metric": map[string]interface{}{"name": "requests"},
+								"target": map[string]interface{}{"type": "AverageValue", "averageValue": "1k"},
+							},
+						},
+						map[string]interface{}{
+							"type": "Object",
+							"object": map[string]interface{}{
+								"describedObject": map[string]interface{}{"kind": "Service", "name": "web"},
+								"metric":          map[string]interface{}{"name": "hits"},
+								"target":          map[string]interface{}{"type": "Value", "value": "10"},
+							},
+						},
+						map[string]interface{}{
+							"type": "External",
+							"external": map[string]interface{}{
+								"metric": map[string]interface{}{
+									"name":     "queue",
+									"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"queue": "jobs"}},
+								},
+								"target": map[string]interface{}{"type": "AverageValue", "averageValue": "30"},
+							},
+						},
+					},
+				},
+			},
+			wantConverted: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{Object: tc.obj}
+
+			converted, err := convertAPIVersion(obj, tc.groupResource, tc.from, tc.to)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantConverted, converted)
+			assert.Equal(t, tc.want, obj.Object)
+		})
+	}
+}
@@ -0,0 +1,351 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	authorizationv1client "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// Authorizer checks whether a user is allowed to create the items that a restore
+// would create, using the user's own RBAC permissions rather than Velero's.
+type Authorizer struct {
+	sarClient       authorizationv1client.SubjectAccessReviewInterface
+	namespaceClient corev1client.NamespaceInterface
+	discoveryHelper discovery.Helper
+}
+
+// NewAuthorizer is the constructor for Authorizer.
+func NewAuthorizer(
+	sarClient authorizationv1client.SubjectAccessReviewInterface,
+	namespaceClient corev1client.NamespaceInterface,
+	discoveryHelper discovery.Helper,
+) *Authorizer {
+	return &Authorizer{
+		sarClient:       sarClient,
+		namespaceClient: namespaceClient,
+		discoveryHelper: discoveryHelper,
+	}
+}
+
+// Authorize returns an error for each namespace and resource that the restore
+// could write to or read from and that the user isn't allowed to. The user must be
+// allowed to create every included resource in every namespace the restore
+// restores into, to patch them if the restore's existing resource policy or one
+// of its conflict policies overwrites existing items, to create the target
+// namespaces that don't exist yet, and to get the included resources in the
+// namespaces the restore restores from, since it reveals their backed-up items.
+// Restores that include all namespaces or cluster-scoped resources require the
+// permissions cluster-wide, and restores that don't exclude cluster-scoped
+// resources require the permissions for the included cluster-scoped resources.
+// Excluded resources and namespaces are not taken into account, so the check errs
+// on the side of requiring more permissions.
+func (a *Authorizer) Authorize(user authenticationv1.UserInfo, restore *velerov1api.Restore) ([]error, error) {
+	verbs := []string{"create"}
+	if overwritesExistingItems(restore) {
+		verbs = append(verbs, "patch")
+	}
+
+	resources := a.restoreTargetResources(restore)
+
+	namespaces, clusterWide := restoreTargetNamespaces(restore)
+	if clusterWide {
+		namespaces = []string{""}
+	}
+
+	var denied []error
+	for _, namespace := range namespaces {
+		for _, groupResource := range resources {
+			for _, verb := range verbs {
+				allowed, err := a.allowed(user, verb, namespace, groupResource)
+				if err != nil {
+					return nil, err
+				}
+				if !allowed {
+					denied = append(denied, errors.Errorf("user %q is not allowed to %s %s in %s", user.Username, verb, describeGroupResource(groupResource), describeNamespace(namespace)))
+				}
+			}
+		}
+	}
+
+	// unless they're excluded, cluster-scoped items related to the restored namespaces,
+	// such as the persistent volumes of restored claims, are restored too.
+	if !clusterWide && !boolptr.IsSetToFalse(restore.Spec.IncludeClusterResources) {
+		for _, groupResource := range a.clusterScopedResources(resources) {
+			for _, verb := range verbs {
+				allowed, err := a.allowed(user, verb, "", groupResource)
+				if err != nil {
+					return nil, err
+				}
+				if !allowed {
+					denied = append(denied, errors.Errorf("user %q is not allowed to %s %s", user.Username, verb, describeGroupResource(groupResource)))
+				}
+			}
+		}
+	}
+
+	sourceNamespaces, allSourceNamespaces := restoreSourceNamespaces(restore)
+	if allSourceNamespaces {
+		sourceNamespaces = []string{""}
+	}
+
+	for _, namespace := range sourceNamespaces {
+		for _, groupResource := range resources {
+			allowed, err := a.allowed(user, "get", namespace, groupResource)
+			if err != nil {
+				return nil, err
+			}
+			if !allowed {
+				denied = append(denied, errors.Errorf("user %q is not allowed to get %s in %s of the backup", user.Username, describeGroupResource(groupResource), describeNamespace(namespace)))
+			}
+		}
+	}
+
+	if clusterWide {
+		allowed, err := a.allowed(user, "create", "", kuberesource.Namespaces)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			denied = append(denied, errors.Errorf("user %q is not allowed to create namespaces", user.Username))
+		}
+		return denied, nil
+	}
+
+	for _, namespace := range namespaces {
+		_, err := a.namespaceClient.Get(context.TODO(), namespace, metav1.GetOptions{})
+		if err == nil {
+			continue
+		}
+		if !apierrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "error getting namespace %s", namespace)
+		}
+
+		allowed, err := a.allowed(user, "create", "", kuberesource.Namespaces)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			denied = append(denied, errors.Errorf("user %q is not allowed to create namespace %s", user.Username, namespace))
+		}
+	}
+
+	return denied, nil
+}
+
+// allowed returns whether the user is allowed to perform the verb on the resource
+// in the namespace, or cluster-wide if the namespace is empty.
+func (a *Authorizer) allowed(user authenticationv1.UserInfo, verb, namespace string, groupResource schema.GroupResource) (bool, error) {
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+
+	review := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			Groups: user.Groups,
+			UID:    user.UID,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      verb,
+				Group:     groupResource.Group,
+				Resource:  groupResource.Resource,
+			},
+		},
+	}
+
+	res, err := a.sarClient.Create(context.TODO(), review, metav1.CreateOptions{})
+	if err != nil {
+		return false, errors.Wrap(err, "error creating subject access review")
+	}
+
+	return res.Status.Allowed, nil
+}
+
+// overwritesExistingItems returns whether the restore could patch items that
+// already exist in the cluster, either because of its existing resource policy
+// or because one of its conflict policies overwrites conflicting items.
+func overwritesExistingItems(restore *velerov1api.Restore) bool {
+	switch restore.Spec.ExistingResourcePolicy {
+	case velerov1api.PolicyTypeUpdate, velerov1api.PolicyTypePatch:
+		return true
+	}
+
+	for _, policy := range restore.Spec.ConflictPolicies {
+		if policy.Policy == velerov1api.ConflictPolicyOverwrite {
+			return true
+		}
+	}
+
+	return false
+}
+
+// restoreTargetResources returns the group-resources the restore could create items
+// of. A restore that doesn't limit its resources could create items of any resource,
+// which is represented by the "*" group and resource.
+func (a *Authorizer) restoreTargetResources(restore *velerov1api.Restore) []schema.GroupResource {
+	resources := restore.Spec.IncludedResources
+	if len(resources) == 0 {
+		for _, resourceName := range restore.Spec.IncludedResourceNames {
+			if resource, _, err := parseResourceName(resourceName); err == nil {
+				resources = append(resources, resource)
+			}
+		}
+	}
+
+	var res []schema.GroupResource
+	seen := make(map[schema.GroupResource]bool)
+	for _, resource := range resources {
+		if resource == "*" {
+			return []schema.GroupResource{{Group: "*", Resource: "*"}}
+		}
+
+		groupResource := schema.ParseGroupResource(resource)
+		if gvr, _, err := a.discoveryHelper.ResourceFor(groupResource.WithVersion("")); err == nil {
+			groupResource = gvr.GroupResource()
+		}
+
+		if !seen[groupResource] {
+			seen[groupResource] = true
+			res = append(res, groupResource)
+		}
+	}
+
+	if len(res) == 0 {
+		return []schema.GroupResource{{Group: "*", Resource: "*"}}
+	}
+	return res
+}
+
+// clusterScopedResources returns the cluster-scoped resources the cluster serves
+// that are among the given group-resources, or all of them if the group-resources
+// include every resource. Namespaces are left out, since whether the user can
+// create them is checked separately.
+func (a *Authorizer) clusterScopedResources(resources []schema.GroupResource) []schema.GroupResource {
+	all := false
+	included := make(map[schema.GroupResource]bool)
+	for _, groupResource := range resources {
+		if groupResource.Resource == "*" {
+			all = true
+		}
+		included[groupResource] = true
+	}
+
+	var res []schema.GroupResource
+	seen := make(map[schema.GroupResource]bool)
+	for _, resourceList := range a.discoveryHelper.Resources() {
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+
+		for _, resource := range resourceList.APIResources {
+			// skip namespaced resources and subresources
+			if resource.Namespaced || strings.Contains(resource.Name, "/") {
+				continue
+			}
+
+			groupResource := schema.GroupResource{Group: gv.Group, Resource: resource.Name}
+			if groupResource == kuberesource.Namespaces || seen[groupResource] || !(all || included[groupResource]) {
+				continue
+			}
+
+			seen[groupResource] = true
+			res = append(res, groupResource)
+		}
+	}
+
+	return res
+}
+
+// restoreSourceNamespaces returns the backup's namespaces the restore restores
+// from, before namespace mapping, and whether it could restore from any namespace.
+func restoreSourceNamespaces(restore *velerov1api.Restore) ([]string, bool) {
+	if len(restore.Spec.IncludedNamespaces) == 0 {
+		return nil, true
+	}
+
+	for _, namespace := range restore.Spec.IncludedNamespaces {
+		if strings.ContainsAny(namespace, "*?[") {
+			return nil, true
+		}
+	}
+
+	return restore.Spec.IncludedNamespaces, false
+}
+
+// restoreTargetNamespaces returns the namespaces the restore restores into, after
+// namespace mapping, and whether the restore could write to any namespace or to
+// cluster-scoped resources.
+func restoreTargetNamespaces(restore *velerov1api.Restore) ([]string, bool) {
+	if restore.Spec.IncludeClusterResources != nil && *restore.Spec.IncludeClusterResources {
+		return nil, true
+	}
+
+	if len(restore.Spec.IncludedNamespaces) == 0 {
+		return nil, true
+	}
+
+	var namespaces []string
+	seen := make(map[string]bool)
+	for _, namespace := range restore.Spec.IncludedNamespaces {
+		if strings.ContainsAny(namespace, "*?[") {
+			return nil, true
+		}
+
+		if target, ok := mapNamespace(restore.Spec.NamespaceMapping, namespace); ok {
+			namespace = target
+		}
+
+		if !seen[namespace] {
+			seen[namespace] = true
+			namespaces = append(namespaces, namespace)
+		}
+	}
+
+	return namespaces, false
+}
+
+func describeGroupResource(groupResource schema.GroupResource) string {
+	if groupResource.Resource == "*" {
+		return "all resources"
+	}
+	return groupResource.String()
+}
+
+func describeNamespace(namespace string) string {
+	if namespace == "" {
+		return "all namespaces"
+	}
+	return "namespace " + namespace
+}
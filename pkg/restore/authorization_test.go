@@ -0,0 +1,211 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// newFakeAuthorizer returns an Authorizer whose subject access reviews are
+// allowed if they match one of the given "<verb> <resource> <namespace>" rules.
+func newFakeAuthorizer(t *testing.T, allowedRules []string, namespaces ...string) *Authorizer {
+	allowed := make(map[string]bool)
+	for _, rule := range allowedRules {
+		allowed[rule] = true
+	}
+
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "subjectaccessreviews", func(action core.Action) (bool, runtime.Object, error) {
+		review := action.(core.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		attrs := review.Spec.ResourceAttributes
+		gr := schema.GroupResource{Group: attrs.Group, Resource: attrs.Resource}
+		review.Status.Allowed = allowed[fmt.Sprintf("%s %s %s", attrs.Verb, gr.String(), attrs.Namespace)]
+		return true, review, nil
+	})
+
+	for _, ns := range namespaces {
+		_, err := clientset.CoreV1().Namespaces().Create(context.TODO(), builder.ForNamespace(ns).Result(), metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	discoveryHelper := velerotest.NewFakeDiscoveryHelper(true, nil)
+	discoveryHelper.ResourceList = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Namespaced: true},
+				{Name: "secrets", Namespaced: true},
+				{Name: "configmaps", Namespaced: true},
+				{Name: "persistentvolumeclaims", Namespaced: true},
+				{Name: "persistentvolumes"},
+				{Name: "namespaces"},
+			},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Namespaced: true},
+			},
+		},
+	}
+
+	return NewAuthorizer(
+		clientset.AuthorizationV1().SubjectAccessReviews(),
+		clientset.CoreV1().Namespaces(),
+		discoveryHelper,
+	)
+}
+
+func TestAuthorizerAuthorize(t *testing.T) {
+	user := authenticationv1.UserInfo{Username: "jane"}
+
+	tests := []struct {
+		name         string
+		restore      *velerov1api.Restore
+		allowedRules []string
+		namespaces   []string
+		wantDenied   []string
+	}{
+		{
+			name:         "user allowed to create the included resources in the included namespace is authorized",
+			restore:      defaultRestore().IncludedNamespaces("ns-1").IncludedResources("pods", "deployments.apps").Result(),
+			allowedRules: []string{"create pods ns-1", "create deployments.apps ns-1", "get pods ns-1", "get deployments.apps ns-1"},
+			namespaces:   []string{"ns-1"},
+		},
+		{
+			name:         "user not allowed to create an included resource is denied",
+			restore:      defaultRestore().IncludedNamespaces("ns-1").IncludedResources("pods", "secrets").Result(),
+			allowedRules: []string{"create pods ns-1", "get pods ns-1", "get secrets ns-1"},
+			namespaces:   []string{"ns-1"},
+			wantDenied:   []string{`user "jane" is not allowed to create secrets in namespace ns-1`},
+		},
+		{
+			name:         "permissions are checked in the mapped namespace",
+			restore:      defaultRestore().IncludedNamespaces("ns-1").IncludedResources("pods").NamespaceMappings("ns-1", "ns-2").Result(),
+			allowedRules: []string{"create pods ns-1", "get pods ns-1"},
+			namespaces:   []string{"ns-1", "ns-2"},
+			wantDenied:   []string{`user "jane" is not allowed to create pods in namespace ns-2`},
+		},
+		{
+			name:         "user must be allowed to get the included resources in the namespaces restored from",
+			restore:      defaultRestore().IncludedNamespaces("ns-1").IncludedResources("pods").NamespaceMappings("ns-1", "ns-2").Result(),
+			allowedRules: []string{"create pods ns-2", "get pods ns-2"},
+			namespaces:   []string{"ns-2"},
+			wantDenied:   []string{`user "jane" is not allowed to get pods in namespace ns-1 of the backup`},
+		},
+		{
+			name:         "user must be allowed to create target namespaces that don't exist",
+			restore:      defaultRestore().IncludedNamespaces("ns-1").IncludedResources("pods").Result(),
+			allowedRules: []string{"create pods ns-1", "get pods ns-1"},
+			wantDenied:   []string{`user "jane" is not allowed to create namespace ns-1`},
+		},
+		{
+			name:         "resources of included resource names are checked when no resources are included",
+			restore:      defaultRestore().IncludedNamespaces("ns-1").IncludedResourceNames("configmaps/cm-1").Result(),
+			allowedRules: []string{"create configmaps ns-1", "get configmaps ns-1"},
+			namespaces:   []string{"ns-1"},
+		},
+		{
+			name:         "user must be allowed to patch items when the existing resource policy updates them",
+			restore:      defaultRestore().IncludedNamespaces("ns-1").IncludedResources("pods").ExistingResourcePolicy(velerov1api.PolicyTypeUpdate).Result(),
+			allowedRules: []string{"create pods ns-1", "get pods ns-1"},
+			namespaces:   []string{"ns-1"},
+			wantDenied:   []string{`user "jane" is not allowed to patch pods in namespace ns-1`},
+		},
+		{
+			name:         "user must be allowed to patch items when a conflict policy overwrites them",
+			restore:      defaultRestore().IncludedNamespaces("ns-1").IncludedResources("pods").ConflictPolicy(velerov1api.ConflictPolicyOverwrite, "pods").Result(),
+			allowedRules: []string{"create pods ns-1", "get pods ns-1"},
+			namespaces:   []string{"ns-1"},
+			wantDenied:   []string{`user "jane" is not allowed to patch pods in namespace ns-1`},
+		},
+		{
+			name:         "user doesn't need to be allowed to patch items when conflict policies don't overwrite them",
+			restore:      defaultRestore().IncludedNamespaces("ns-1").IncludedResources("pods").ConflictPolicy(velerov1api.ConflictPolicySkip, "pods").Result(),
+			allowedRules: []string{"create pods ns-1", "get pods ns-1"},
+			namespaces:   []string{"ns-1"},
+		},
+		{
+			name:         "restore of all namespaces and resources requires cluster-wide permissions",
+			restore:      defaultRestore().Result(),
+			allowedRules: []string{"create *.* ns-1", "get *.* ns-1"},
+			namespaces:   []string{"ns-1"},
+			wantDenied: []string{
+				`user "jane" is not allowed to create all resources in all namespaces`,
+				`user "jane" is not allowed to get all resources in all namespaces of the backup`,
+				`user "jane" is not allowed to create namespaces`,
+			},
+		},
+		{
+			name:         "restore of cluster-scoped resources requires cluster-wide permissions",
+			restore:      defaultRestore().IncludedNamespaces("ns-1").IncludedResources("pods").IncludeClusterResources(true).Result(),
+			allowedRules: []string{"create pods ", "create namespaces ", "get pods ns-1"},
+			namespaces:   []string{"ns-1"},
+		},
+		{
+			name:         "restore that doesn't exclude cluster-scoped resources requires permissions for the included ones",
+			restore:      defaultRestore().IncludedNamespaces("ns-1").IncludedResources("persistentvolumeclaims", "persistentvolumes").Result(),
+			allowedRules: []string{"create persistentvolumeclaims ns-1", "create persistentvolumes ns-1", "get persistentvolumeclaims ns-1", "get persistentvolumes ns-1"},
+			namespaces:   []string{"ns-1"},
+			wantDenied:   []string{`user "jane" is not allowed to create persistentvolumes`},
+		},
+		{
+			name:         "restore of all resources that doesn't exclude cluster-scoped resources requires permissions for all of them",
+			restore:      defaultRestore().IncludedNamespaces("ns-1").Result(),
+			allowedRules: []string{"create *.* ns-1", "get *.* ns-1"},
+			namespaces:   []string{"ns-1"},
+			wantDenied:   []string{`user "jane" is not allowed to create persistentvolumes`},
+		},
+		{
+			name:         "restore that excludes cluster-scoped resources doesn't require permissions for them",
+			restore:      defaultRestore().IncludedNamespaces("ns-1").IncludeClusterResources(false).Result(),
+			allowedRules: []string{"create *.* ns-1", "get *.* ns-1"},
+			namespaces:   []string{"ns-1"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			authorizer := newFakeAuthorizer(t, tc.allowedRules, tc.namespaces...)
+
+			denied, err := authorizer.Authorize(user, tc.restore)
+			require.NoError(t, err)
+
+			var got []string
+			for _, err := range denied {
+				got = append(got, err.Error())
+			}
+			assert.Equal(t, tc.wantDenied, got)
+		})
+	}
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// defaultImageRegistry is the registry container runtimes use for image
+// references that don't name one explicitly.
+const defaultImageRegistry = "docker.io"
+
+// ChangeImageNameAction updates the registry and repository of the container
+// images in an item's pod spec if a mapping is found in the plugin's config map.
+type ChangeImageNameAction struct {
+	logger          logrus.FieldLogger
+	configMapClient corev1client.ConfigMapInterface
+}
+
+// NewChangeImageNameAction is the constructor for ChangeImageNameAction.
+func NewChangeImageNameAction(
+	logger logrus.FieldLogger,
+	configMapClient corev1client.ConfigMapInterface,
+) *ChangeImageNameAction {
+	return &ChangeImageNameAction{
+		logger:          logger,
+		configMapClient: configMapClient,
+	}
+}
+
+// AppliesTo returns the resources that ChangeImageNameAction should
+// be run for.
+func (a *ChangeImageNameAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{
+			"pods",
+			"deployments",
+			"statefulsets",
+			"daemonsets",
+			"replicasets",
+			"jobs",
+			"cronjobs",
+		},
+	}, nil
+}
+
+// Execute rewrites the images of the item's containers, init containers and
+// ephemeral containers if a mapping is found in the config map for the plugin.
+func (a *ChangeImageNameAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	a.logger.Info("Executing ChangeImageNameAction")
+	defer a.logger.Info("Done executing ChangeImageNameAction")
+
+	a.logger.Debug("Getting plugin config")
+	config, err := getPluginConfig(framework.PluginKindRestoreItemAction, "velero.io/change-image-name", a.configMapClient)
+	if err != nil {
+		return nil, err
+	}
+
+	if config == nil || len(config.Data) == 0 {
+		a.logger.Debug("No image name mappings found")
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	obj, ok := input.Item.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("object was of unexpected type %T", input.Item)
+	}
+
+	log := a.logger.WithFields(map[string]interface{}{
+		"kind":      obj.GetKind(),
+		"namespace": obj.GetNamespace(),
+		"name":      obj.GetName(),
+	})
+
+	var podSpecPath []string
+	switch obj.GetKind() {
+	case "Pod":
+		podSpecPath = []string{"spec"}
+	case "CronJob":
+		podSpecPath = []string{"spec", "jobTemplate", "spec", "template", "spec"}
+	default:
+		podSpecPath = []string{"spec", "template", "spec"}
+	}
+
+	mappings, err := parseImageNameMappings(config.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, containersField := range []string{"containers", "initContainers", "ephemeralContainers"} {
+		if err := changeContainerImages(log, obj.UnstructuredContent(), append(podSpecPath, containersField), mappings); err != nil {
+			return nil, err
+		}
+	}
+
+	return velero.NewRestoreItemActionExecuteOutput(obj), nil
+}
+
+// parseImageNameMappings returns the image name mappings in the config map's data,
+// keyed by the old prefix. Config map keys can't contain the "/" and ":" characters
+// of image references, so each value holds a mapping formatted as "<old>=<new>",
+// and the keys are only used to tell the mappings apart.
+func parseImageNameMappings(data map[string]string) (map[string]string, error) {
+	mappings := make(map[string]string, len(data))
+	for key, value := range data {
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+			return nil, errors.Errorf("invalid image name mapping %q in config map entry %s, must be formatted as <old>=<new>", value, key)
+		}
+
+		from, to := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		if _, ok := mappings[from]; ok {
+			return nil, errors.Errorf("image name prefix %s is mapped more than once", from)
+		}
+		mappings[from] = to
+	}
+
+	return mappings, nil
+}
+
+// changeContainerImages updates the image of each container in the list at the
+// given path that has a mapping.
+func changeContainerImages(log logrus.FieldLogger, content map[string]interface{}, path []string, mappings map[string]string) error {
+	fieldPath := strings.Join(path, ".")
+
+	containers, found, err := unstructured.NestedSlice(content, path...)
+	if err != nil {
+		return errors.Wrapf(err, "error getting item's %s", fieldPath)
+	}
+	if !found || len(containers) == 0 {
+		return nil
+	}
+
+	for i := range containers {
+		container, ok := containers[i].(map[string]interface{})
+		if !ok {
+			return errors.Errorf("%s[%d] was of unexpected type %T", fieldPath, i, containers[i])
+		}
+
+		image, _, err := unstructured.NestedString(container, "image")
+		if err != nil {
+			return errors.Wrapf(err, "error getting item's %s[%d].image", fieldPath, i)
+		}
+		if image == "" {
+			continue
+		}
+
+		newImage, ok := mapImageName(image, mappings)
+		if !ok {
+			log.Debugf("No mapping found for image %s", image)
+			continue
+		}
+
+		log.Infof("Updating %s[%d] image from %s to %s", fieldPath, i, image, newImage)
+		container["image"] = newImage
+	}
+
+	if err := unstructured.SetNestedSlice(content, containers, path...); err != nil {
+		return errors.Wrapf(err, "unable to set item's %s", fieldPath)
+	}
+
+	return nil
+}
+
+// mapImageName returns the image with its registry/repository prefix replaced
+// according to the given mappings. The longest matching prefix wins, and a
+// prefix only matches at a path, tag or digest boundary so that a mapping for
+// "registry.example.com/app" does not apply to "registry.example.com/application".
+// If the image as written doesn't match any prefix, its fully-qualified Docker Hub
+// form (e.g. "docker.io/library/nginx" for "nginx") is tried as well.
+func mapImageName(image string, mappings map[string]string) (string, bool) {
+	for _, candidate := range []string{image, qualifyImageName(image)} {
+		var longest string
+		for prefix := range mappings {
+			if len(prefix) > len(longest) && hasImagePrefix(candidate, prefix) {
+				longest = prefix
+			}
+		}
+
+		if longest != "" {
+			return strings.TrimSuffix(mappings[longest], "/") + candidate[len(strings.TrimSuffix(longest, "/")):], true
+		}
+	}
+
+	return "", false
+}
+
+// hasImagePrefix returns true if prefix is a leading registry/repository portion
+// of image.
+func hasImagePrefix(image, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" || !strings.HasPrefix(image, prefix) {
+		return false
+	}
+
+	if len(image) == len(prefix) {
+		return true
+	}
+
+	switch image[len(prefix)] {
+	case '/', ':', '@':
+		return true
+	default:
+		return false
+	}
+}
+
+// qualifyImageName returns the image reference with the implicit Docker Hub
+// registry and "library" namespace made explicit.
+func qualifyImageName(image string) string {
+	parts := strings.SplitN(image, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		// the first component is a registry host
+		return image
+	}
+
+	if len(parts) == 1 {
+		return defaultImageRegistry + "/library/" + image
+	}
+
+	return defaultImageRegistry + "/" + image
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1api "k8s.io/api/apps/v1"
+	batchv1api "k8s.io/api/batch/v1"
+	batchv1beta1api "k8s.io/api/batch/v1beta1"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// TestChangeImageNameActionExecute runs the ChangeImageNameAction's Execute
+// method and validates that the item's container images are modified (or not)
+// as expected.
+func TestChangeImageNameActionExecute(t *testing.T) {
+	podSpec := func(images ...string) corev1api.PodSpec {
+		spec := corev1api.PodSpec{}
+		for _, image := range images {
+			spec.Containers = append(spec.Containers, *builder.ForContainer("", image).Result())
+		}
+		return spec
+	}
+
+	deployment := func(images ...string) *appsv1api.Deployment {
+		deploy := builder.ForDeployment("velero", "deploy-1").Result()
+		deploy.Spec.Template.Spec = podSpec(images...)
+		return deploy
+	}
+
+	cronJob := func(images ...string) *batchv1beta1api.CronJob {
+		return &batchv1beta1api.CronJob{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: batchv1beta1api.SchemeGroupVersion.String(),
+				Kind:       "CronJob",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "velero",
+				Name:      "cronjob-1",
+			},
+			Spec: batchv1beta1api.CronJobSpec{
+				JobTemplate: batchv1beta1api.JobTemplateSpec{
+					Spec: batchv1api.JobSpec{
+						Template: corev1api.PodTemplateSpec{
+							Spec: podSpec(images...),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	configMap := func(data ...string) *corev1api.ConfigMap {
+		return builder.ForConfigMap("velero", "change-image-name").
+			ObjectMeta(builder.WithLabels("velero.io/plugin-config", "true", "velero.io/change-image-name", "RestoreItemAction")).
+			Data(data...).
+			Result()
+	}
+
+	tests := []struct {
+		name      string
+		item      interface{}
+		configMap *corev1api.ConfigMap
+		want      interface{}
+	}{
+		{
+			name: "a registry mapping is applied to a pod's containers and init containers",
+			item: builder.ForPod("velero", "pod-1").
+				Containers(builder.ForContainer("app", "gcr.io/project/app:v1").Result()).
+				InitContainers(builder.ForContainer("init", "gcr.io/project/init@sha256:abc").Result()).
+				Result(),
+			configMap: configMap("case1", "gcr.io=mirror.internal/gcr"),
+			want: builder.ForPod("velero", "pod-1").
+				Containers(builder.ForContainer("app", "mirror.internal/gcr/project/app:v1").Result()).
+				InitContainers(builder.ForContainer("init", "mirror.internal/gcr/project/init@sha256:abc").Result()).
+				Result(),
+		},
+		{
+			name:      "a registry mapping is applied to a deployment's pod template",
+			item:      deployment("quay.io/org/app:v1", "gcr.io/project/sidecar"),
+			configMap: configMap("case1", "quay.io=mirror.internal/quay"),
+			want:      deployment("mirror.internal/quay/org/app:v1", "gcr.io/project/sidecar"),
+		},
+		{
+			name:      "a registry mapping is applied to a cron job's job template",
+			item:      cronJob("quay.io/org/job:v1"),
+			configMap: configMap("case1", "quay.io=mirror.internal/quay"),
+			want:      cronJob("mirror.internal/quay/org/job:v1"),
+		},
+		{
+			name:      "the longest matching prefix is used",
+			item:      deployment("gcr.io/project/app:v1", "gcr.io/other/app:v1"),
+			configMap: configMap("case1", "gcr.io=mirror.internal/gcr", "case2", "gcr.io/project=mirror.internal/project"),
+			want:      deployment("mirror.internal/project/app:v1", "mirror.internal/gcr/other/app:v1"),
+		},
+		{
+			name:      "a prefix only matches at a path boundary",
+			item:      deployment("registry.example.com/application:v1"),
+			configMap: configMap("case1", "registry.example.com/app=mirror.internal/app"),
+			want:      deployment("registry.example.com/application:v1"),
+		},
+		{
+			name:      "images without an explicit registry are matched against docker hub",
+			item:      deployment("nginx:1.19", "bitnami/redis:6"),
+			configMap: configMap("case1", "docker.io=mirror.internal/dockerhub"),
+			want:      deployment("mirror.internal/dockerhub/library/nginx:1.19", "mirror.internal/dockerhub/bitnami/redis:6"),
+		},
+		{
+			name:      "when no image has a mapping in the config map, the item is returned as-is",
+			item:      deployment("quay.io/org/app:v1"),
+			configMap: configMap("case1", "gcr.io=mirror.internal/gcr"),
+			want:      deployment("quay.io/org/app:v1"),
+		},
+		{
+			name: "when no config map exists for the plugin, the item is returned as-is",
+			item: deployment("gcr.io/project/app:v1"),
+			configMap: builder.ForConfigMap("velero", "change-image-name").
+				ObjectMeta(builder.WithLabels("velero.io/plugin-config", "true", "velero.io/some-other-plugin", "RestoreItemAction")).
+				Data("case1", "gcr.io=mirror.internal/gcr").
+				Result(),
+			want: deployment("gcr.io/project/app:v1"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clientset := fake.NewSimpleClientset()
+			a := NewChangeImageNameAction(
+				logrus.StandardLogger(),
+				clientset.CoreV1().ConfigMaps("velero"),
+			)
+
+			// set up test data
+			if tc.configMap != nil {
+				_, err := clientset.CoreV1().ConfigMaps(tc.configMap.Namespace).Create(context.TODO(), tc.configMap, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+
+			unstructuredMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.item)
+			require.NoError(t, err)
+
+			input := &velero.RestoreItemActionExecuteInput{
+				Item: &unstructured.Unstructured{
+					Object: unstructuredMap,
+				},
+			}
+
+			// execute method under test
+			res, err := a.Execute(input)
+			require.NoError(t, err)
+
+			wantUnstructured, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.want)
+			require.NoError(t, err)
+
+			assert.Equal(t, &unstructured.Unstructured{Object: wantUnstructured}, res.UpdatedItem)
+		})
+	}
+}
+
+func TestParseImageNameMappings(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name: "mappings are keyed by their old prefix",
+			data: map[string]string{"case1": "gcr.io=mirror.internal/gcr", "case2": " quay.io/org = mirror.internal/org "},
+			want: map[string]string{"gcr.io": "mirror.internal/gcr", "quay.io/org": "mirror.internal/org"},
+		},
+		{
+			name:    "a value without a separator is invalid",
+			data:    map[string]string{"case1": "gcr.io"},
+			wantErr: `invalid image name mapping "gcr.io" in config map entry case1, must be formatted as <old>=<new>`,
+		},
+		{
+			name:    "a value with an empty prefix is invalid",
+			data:    map[string]string{"case1": "=mirror.internal/gcr"},
+			wantErr: `invalid image name mapping "=mirror.internal/gcr" in config map entry case1, must be formatted as <old>=<new>`,
+		},
+		{
+			name:    "a prefix can only be mapped once",
+			data:    map[string]string{"case1": "gcr.io=mirror.internal/gcr", "case2": "gcr.io=other.internal/gcr"},
+			wantErr: "image name prefix gcr.io is mapped more than once",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseImageNameMappings(tc.data)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
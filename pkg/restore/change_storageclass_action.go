@@ -21,6 +21,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
@@ -30,8 +31,9 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
-// ChangeStorageClassAction updates a PV or PVC's storage class name
-// if a mapping is found in the plugin's config map.
+// ChangeStorageClassAction updates a PV or PVC's storage class name, or the
+// storage class names of a StatefulSet's volume claim templates, if a mapping
+// is found in the plugin's config map.
 type ChangeStorageClassAction struct {
 	logger             logrus.FieldLogger
 	configMapClient    corev1client.ConfigMapInterface
@@ -55,7 +57,7 @@ func NewChangeStorageClassAction(
 // be run for.
 func (a *ChangeStorageClassAction) AppliesTo() (velero.ResourceSelector, error) {
 	return velero.ResourceSelector{
-		IncludedResources: []string{"persistentvolumeclaims", "persistentvolumes"},
+		IncludedResources: []string{"persistentvolumeclaims", "persistentvolumes", "statefulsets"},
 	}, nil
 }
 
@@ -87,33 +89,80 @@ func (a *ChangeStorageClassAction) Execute(input *velero.RestoreItemActionExecut
 		"name":      obj.GetName(),
 	})
 
+	if obj.GetKind() == "StatefulSet" {
+		if err := a.changeVolumeClaimTemplatesStorageClass(log, obj, config); err != nil {
+			return nil, err
+		}
+		return velero.NewRestoreItemActionExecuteOutput(obj), nil
+	}
+
 	// use the unstructured helpers here since this code is for both PVs and PVCs, and the
 	// field names are the same for both types.
-	storageClass, _, err := unstructured.NestedString(obj.UnstructuredContent(), "spec", "storageClassName")
+	if err := a.changeStorageClass(log, obj.UnstructuredContent(), config); err != nil {
+		return nil, err
+	}
+
+	return velero.NewRestoreItemActionExecuteOutput(obj), nil
+}
+
+// changeVolumeClaimTemplatesStorageClass updates the storage class name of each of a
+// StatefulSet's volume claim templates that has a mapping in the config map.
+func (a *ChangeStorageClassAction) changeVolumeClaimTemplatesStorageClass(log logrus.FieldLogger, obj *unstructured.Unstructured, config *corev1api.ConfigMap) error {
+	templates, found, err := unstructured.NestedSlice(obj.UnstructuredContent(), "spec", "volumeClaimTemplates")
+	if err != nil {
+		return errors.Wrap(err, "error getting item's spec.volumeClaimTemplates")
+	}
+	if !found || len(templates) == 0 {
+		log.Debug("Item has no volume claim templates")
+		return nil
+	}
+
+	for i := range templates {
+		template, ok := templates[i].(map[string]interface{})
+		if !ok {
+			return errors.Errorf("volume claim template %d was of unexpected type %T", i, templates[i])
+		}
+
+		if err := a.changeStorageClass(log, template, config); err != nil {
+			return errors.Wrapf(err, "error updating volume claim template %d", i)
+		}
+	}
+
+	if err := unstructured.SetNestedSlice(obj.UnstructuredContent(), templates, "spec", "volumeClaimTemplates"); err != nil {
+		return errors.Wrap(err, "unable to set item's spec.volumeClaimTemplates")
+	}
+
+	return nil
+}
+
+// changeStorageClass updates the spec.storageClassName field of the given PV, PVC or
+// volume claim template content if a mapping is found in the config map.
+func (a *ChangeStorageClassAction) changeStorageClass(log logrus.FieldLogger, content map[string]interface{}, config *corev1api.ConfigMap) error {
+	storageClass, _, err := unstructured.NestedString(content, "spec", "storageClassName")
 	if err != nil {
-		return nil, errors.Wrap(err, "error getting item's spec.storageClassName")
+		return errors.Wrap(err, "error getting item's spec.storageClassName")
 	}
 	if storageClass == "" {
 		log.Debug("Item has no storage class specified")
-		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+		return nil
 	}
 
 	newStorageClass, ok := config.Data[storageClass]
 	if !ok {
 		log.Debugf("No mapping found for storage class %s", storageClass)
-		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+		return nil
 	}
 
 	// validate that new storage class exists
 	if _, err := a.storageClassClient.Get(context.TODO(), newStorageClass, metav1.GetOptions{}); err != nil {
-		return nil, errors.Wrapf(err, "error getting storage class %s from API", newStorageClass)
+		return errors.Wrapf(err, "error getting storage class %s from API", newStorageClass)
 	}
 
 	log.Infof("Updating item's storage class name to %s", newStorageClass)
 
-	if err := unstructured.SetNestedField(obj.UnstructuredContent(), newStorageClass, "spec", "storageClassName"); err != nil {
-		return nil, errors.Wrap(err, "unable to set item's spec.storageClassName")
+	if err := unstructured.SetNestedField(content, newStorageClass, "spec", "storageClassName"); err != nil {
+		return errors.Wrap(err, "unable to set item's spec.storageClassName")
 	}
 
-	return velero.NewRestoreItemActionExecuteOutput(obj), nil
+	return nil
 }
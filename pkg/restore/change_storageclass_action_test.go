@@ -24,6 +24,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	appsv1api "k8s.io/api/apps/v1"
 	corev1api "k8s.io/api/core/v1"
 	storagev1api "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -40,6 +41,23 @@ import (
 // Validation is done by comparing the result of the Execute method to the test case's
 // desired result.
 func TestChangeStorageClassActionExecute(t *testing.T) {
+	statefulSet := func(storageClasses ...string) *appsv1api.StatefulSet {
+		sts := &appsv1api.StatefulSet{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: appsv1api.SchemeGroupVersion.String(),
+				Kind:       "StatefulSet",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "velero",
+				Name:      "sts-1",
+			},
+		}
+		for _, storageClass := range storageClasses {
+			sts.Spec.VolumeClaimTemplates = append(sts.Spec.VolumeClaimTemplates, *builder.ForPersistentVolumeClaim("", "data").StorageClass(storageClass).Result())
+		}
+		return sts
+	}
+
 	tests := []struct {
 		name         string
 		pvOrPVC      interface{}
@@ -68,6 +86,25 @@ func TestChangeStorageClassActionExecute(t *testing.T) {
 			storageClass: builder.ForStorageClass("storageclass-2").Result(),
 			want:         builder.ForPersistentVolumeClaim("velero", "pvc-1").StorageClass("storageclass-2").Result(),
 		},
+		{
+			name:    "valid mappings for a stateful set's volume claim templates are applied correctly",
+			pvOrPVC: statefulSet("storageclass-1", "storageclass-3"),
+			configMap: builder.ForConfigMap("velero", "change-storage-classs").
+				ObjectMeta(builder.WithLabels("velero.io/plugin-config", "true", "velero.io/change-storage-class", "RestoreItemAction")).
+				Data("storageclass-1", "storageclass-2").
+				Result(),
+			storageClass: builder.ForStorageClass("storageclass-2").Result(),
+			want:         statefulSet("storageclass-2", "storageclass-3"),
+		},
+		{
+			name:    "when a stateful set's volume claim template's storage class is mapped to a nonexistent storage class, an error is returned",
+			pvOrPVC: statefulSet("storageclass-1"),
+			configMap: builder.ForConfigMap("velero", "change-storage-classs").
+				ObjectMeta(builder.WithLabels("velero.io/plugin-config", "true", "velero.io/change-storage-class", "RestoreItemAction")).
+				Data("storageclass-1", "nonexistent-storage-class").
+				Result(),
+			wantErr: errors.New("error updating volume claim template 0: error getting storage class nonexistent-storage-class from API: storageclasses.storage.k8s.io \"nonexistent-storage-class\" not found"),
+		},
 		{
 			name:    "when no config map exists for the plugin, the item is returned as-is",
 			pvOrPVC: builder.ForPersistentVolume("pv-1").StorageClass("storageclass-1").Result(),
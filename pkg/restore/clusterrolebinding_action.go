@@ -53,7 +53,7 @@ func (a *ClusterRoleBindingAction) Execute(input *velero.RestoreItemActionExecut
 	}
 
 	for i, subject := range clusterRoleBinding.Subjects {
-		if newNamespace, ok := namespaceMapping[subject.Namespace]; ok {
+		if newNamespace, ok := mapNamespace(namespaceMapping, subject.Namespace); ok {
 			clusterRoleBinding.Subjects[i].Namespace = newNamespace
 		}
 	}
@@ -0,0 +1,106 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+)
+
+// ValidateConflictPolicies checks that each of a restore's conflict policies is one
+// that Velero knows how to apply and lists at least one resource, and that no resource
+// is listed in more than one policy.
+func ValidateConflictPolicies(policies []velerov1api.ConflictPolicy) []error {
+	var errs []error
+
+	resources := sets.NewString()
+	for _, policy := range policies {
+		switch policy.Policy {
+		case velerov1api.ConflictPolicySkip, velerov1api.ConflictPolicyOverwrite, velerov1api.ConflictPolicyFailFast:
+		default:
+			errs = append(errs, errors.Errorf("invalid conflict policy %q, must be one of %q, %q or %q", policy.Policy, velerov1api.ConflictPolicySkip, velerov1api.ConflictPolicyOverwrite, velerov1api.ConflictPolicyFailFast))
+		}
+
+		if len(policy.Resources) == 0 {
+			errs = append(errs, errors.Errorf("invalid conflict policy %q: at least one resource is required", policy.Policy))
+		}
+
+		for _, resource := range policy.Resources {
+			if resources.Has(resource) {
+				errs = append(errs, errors.Errorf("invalid conflict policy %q: resource %s is listed in more than one conflict policy", policy.Policy, resource))
+			}
+			resources.Insert(resource)
+		}
+	}
+
+	return errs
+}
+
+// conflictPolicies looks up the conflict policy for a resource.
+type conflictPolicies struct {
+	byResource map[string]velerov1api.ConflictPolicyType
+	all        velerov1api.ConflictPolicyType
+}
+
+// newConflictPolicies returns the restore's conflict policies, with the listed
+// resources resolved via discovery. Resources that can't be resolved, such as
+// those whose custom resource definitions haven't been restored yet, are kept
+// as they're listed.
+func newConflictPolicies(helper discovery.Helper, policies []velerov1api.ConflictPolicy) *conflictPolicies {
+	res := &conflictPolicies{
+		byResource: make(map[string]velerov1api.ConflictPolicyType),
+	}
+
+	for _, policy := range policies {
+		for _, resource := range policy.Resources {
+			if resource == "*" {
+				res.all = policy.Policy
+				continue
+			}
+
+			if gvr, _, err := helper.ResourceFor(schema.ParseGroupResource(resource).WithVersion("")); err == nil {
+				resource = gvr.GroupResource().String()
+			}
+			res.byResource[resource] = policy.Policy
+		}
+	}
+
+	return res
+}
+
+// policyFor returns the conflict policy for items of the given resource, or an
+// empty policy if the restore doesn't have one for the resource.
+func (p *conflictPolicies) policyFor(groupResource schema.GroupResource) velerov1api.ConflictPolicyType {
+	if p == nil {
+		return ""
+	}
+
+	if policy, ok := p.byResource[groupResource.String()]; ok {
+		return policy
+	}
+	return p.all
+}
+
+// recordConflict adds an entry to the restore's conflict report saying how an item
+// that conflicted with the in-cluster version was handled.
+func (ctx *restoreContext) recordConflict(namespace, resourceID, resolution string) {
+	ctx.conflictReport.Add(namespace, errors.Errorf("%s: %s", resourceID, resolution))
+}
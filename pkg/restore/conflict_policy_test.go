@@ -0,0 +1,91 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestValidateConflictPolicies(t *testing.T) {
+	tests := []struct {
+		name     string
+		policies []velerov1api.ConflictPolicy
+		wantErrs int
+	}{
+		{
+			name: "no policies are valid",
+		},
+		{
+			name: "known policies for distinct resources are valid",
+			policies: []velerov1api.ConflictPolicy{
+				{Resources: []string{"configmaps", "secrets"}, Policy: velerov1api.ConflictPolicyOverwrite},
+				{Resources: []string{"deployments.apps"}, Policy: velerov1api.ConflictPolicyFailFast},
+				{Resources: []string{"*"}, Policy: velerov1api.ConflictPolicySkip},
+			},
+		},
+		{
+			name: "unknown policy is invalid",
+			policies: []velerov1api.ConflictPolicy{
+				{Resources: []string{"configmaps"}, Policy: "update"},
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "policy without resources is invalid",
+			policies: []velerov1api.ConflictPolicy{
+				{Policy: velerov1api.ConflictPolicySkip},
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "resource listed in more than one policy is invalid",
+			policies: []velerov1api.ConflictPolicy{
+				{Resources: []string{"configmaps"}, Policy: velerov1api.ConflictPolicySkip},
+				{Resources: []string{"configmaps"}, Policy: velerov1api.ConflictPolicyOverwrite},
+			},
+			wantErrs: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Len(t, ValidateConflictPolicies(tc.policies), tc.wantErrs)
+		})
+	}
+}
+
+func TestConflictPoliciesPolicyFor(t *testing.T) {
+	policies := &conflictPolicies{
+		byResource: map[string]velerov1api.ConflictPolicyType{
+			"configmaps":       velerov1api.ConflictPolicyOverwrite,
+			"deployments.apps": velerov1api.ConflictPolicyFailFast,
+		},
+		all: velerov1api.ConflictPolicySkip,
+	}
+
+	assert.Equal(t, velerov1api.ConflictPolicyOverwrite, policies.policyFor(schema.GroupResource{Resource: "configmaps"}))
+	assert.Equal(t, velerov1api.ConflictPolicyFailFast, policies.policyFor(schema.GroupResource{Group: "apps", Resource: "deployments"}))
+	assert.Equal(t, velerov1api.ConflictPolicySkip, policies.policyFor(schema.GroupResource{Resource: "secrets"}))
+
+	var empty *conflictPolicies
+	assert.Equal(t, velerov1api.ConflictPolicyType(""), empty.policyFor(schema.GroupResource{Resource: "secrets"}))
+}
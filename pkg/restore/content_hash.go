@@ -0,0 +1,82 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ItemCounts are counts of the items a restore handled in particular ways.
+type ItemCounts struct {
+	// Unchanged is the number of items that already existed in the cluster and
+	// were the same as the backed-up version.
+	Unchanged int
+}
+
+// contentHash returns a hash of an item's content, ignoring the fields that are
+// populated by the API server or aren't restored: its status, its metadata other
+// than its name, namespace, labels and annotations, and the labels Velero adds
+// to restored items.
+func contentHash(obj *unstructured.Unstructured) (string, error) {
+	normalized, err := resetMetadataAndStatus(obj.DeepCopy())
+	if err != nil {
+		return "", err
+	}
+
+	labels := normalized.GetLabels()
+	delete(labels, velerov1api.BackupNameLabel)
+	delete(labels, velerov1api.RestoreNameLabel)
+	if len(labels) == 0 {
+		unstructured.RemoveNestedField(normalized.Object, "metadata", "labels")
+	} else {
+		normalized.SetLabels(labels)
+	}
+	if len(normalized.GetAnnotations()) == 0 {
+		unstructured.RemoveNestedField(normalized.Object, "metadata", "annotations")
+	}
+
+	// maps are marshaled with sorted keys, so equal content has the same hash.
+	content, err := json.Marshal(normalized.Object)
+	if err != nil {
+		return "", errors.Wrap(err, "error marshaling item content")
+	}
+
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// sameContent returns whether two versions of an item have the same content
+// hash.
+func sameContent(a, b *unstructured.Unstructured) (bool, error) {
+	hashA, err := contentHash(a)
+	if err != nil {
+		return false, err
+	}
+	hashB, err := contentHash(b)
+	if err != nil {
+		return false, err
+	}
+
+	return hashA == hashB, nil
+}
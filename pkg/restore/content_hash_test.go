@@ -0,0 +1,81 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestSameContent(t *testing.T) {
+	configMap := func(metadata map[string]interface{}, data map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   metadata,
+			"data":       data,
+		}}
+	}
+
+	tests := []struct {
+		name string
+		a, b *unstructured.Unstructured
+		want bool
+	}{
+		{
+			name: "fields populated by the API server are ignored",
+			a:    configMap(map[string]interface{}{"name": "cm-1"}, map[string]interface{}{"a": "b"}),
+			b: configMap(map[string]interface{}{
+				"name":              "cm-1",
+				"uid":               "uid-1",
+				"resourceVersion":   "100",
+				"creationTimestamp": "2020-01-01T00:00:00Z",
+			}, map[string]interface{}{"a": "b"}),
+			want: true,
+		},
+		{
+			name: "labels added to restored items are ignored",
+			a:    configMap(map[string]interface{}{"name": "cm-1"}, map[string]interface{}{"a": "b"}),
+			b: configMap(map[string]interface{}{
+				"name":   "cm-1",
+				"labels": map[string]interface{}{"velero.io/backup-name": "backup-1", "velero.io/restore-name": "restore-1"},
+			}, map[string]interface{}{"a": "b"}),
+			want: true,
+		},
+		{
+			name: "different labels are different content",
+			a:    configMap(map[string]interface{}{"name": "cm-1", "labels": map[string]interface{}{"app": "a"}}, map[string]interface{}{"a": "b"}),
+			b:    configMap(map[string]interface{}{"name": "cm-1", "labels": map[string]interface{}{"app": "b"}}, map[string]interface{}{"a": "b"}),
+		},
+		{
+			name: "different data is different content",
+			a:    configMap(map[string]interface{}{"name": "cm-1"}, map[string]interface{}{"a": "b"}),
+			b:    configMap(map[string]interface{}{"name": "cm-1"}, map[string]interface{}{"a": "c"}),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := sameContent(tc.a, tc.b)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
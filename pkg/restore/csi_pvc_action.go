@@ -0,0 +1,100 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/v2/pkg/apis/volumesnapshot/v1beta1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// nativeCSIEnabled returns whether Velero's built-in actions restore CSI volumes.
+func nativeCSIEnabled() bool {
+	return features.IsEnabled(velerov1api.CSIFeatureFlag) && features.IsEnabled(velerov1api.NativeCSIFeatureFlag)
+}
+
+// annotations that bind a PersistentVolumeClaim to the volume it had when it was backed up.
+var pvcBindingAnnotations = []string{
+	"pv.kubernetes.io/bind-completed",
+	"pv.kubernetes.io/bound-by-controller",
+	"volume.kubernetes.io/selected-node",
+}
+
+// CSIPVCAction restores PersistentVolumeClaims that were backed up with a CSI VolumeSnapshot
+// from the snapshot.
+type CSIPVCAction struct {
+	logger logrus.FieldLogger
+}
+
+// NewCSIPVCAction creates a new RestoreItemAction for CSI PersistentVolumeClaims.
+func NewCSIPVCAction(logger logrus.FieldLogger) *CSIPVCAction {
+	return &CSIPVCAction{logger: logger}
+}
+
+// AppliesTo returns a ResourceSelector that applies only to persistent volume claims.
+func (a *CSIPVCAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"persistentvolumeclaims"},
+	}, nil
+}
+
+// Execute sets the data source of a PersistentVolumeClaim that's annotated with a
+// VolumeSnapshot to the snapshot, and unbinds it from its volume so that a new volume is
+// provisioned from the snapshot.
+func (a *CSIPVCAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	if !nativeCSIEnabled() || boolptr.IsSetToFalse(input.Restore.Spec.RestorePVs) {
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	var pvc corev1api.PersistentVolumeClaim
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(input.Item.UnstructuredContent(), &pvc); err != nil {
+		return nil, errors.Wrap(err, "unable to convert unstructured item to persistent volume claim")
+	}
+
+	snapshotName := pvc.Annotations[velerov1api.VolumeSnapshotAnnotation]
+	if snapshotName == "" {
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	a.logger.Infof("Restoring persistent volume claim %s/%s from volume snapshot %s", pvc.Namespace, pvc.Name, snapshotName)
+
+	apiGroup := snapshotv1beta1api.SchemeGroupVersion.Group
+	pvc.Spec.DataSource = &corev1api.TypedLocalObjectReference{
+		APIGroup: &apiGroup,
+		Kind:     "VolumeSnapshot",
+		Name:     snapshotName,
+	}
+	pvc.Spec.VolumeName = ""
+	for _, annotation := range pvcBindingAnnotations {
+		delete(pvc.Annotations, annotation)
+	}
+
+	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&pvc)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to convert persistent volume claim to unstructured item")
+	}
+
+	return velero.NewRestoreItemActionExecuteOutput(&unstructured.Unstructured{Object: res}), nil
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestCSIPVCActionExecute(t *testing.T) {
+	apiGroup := "snapshot.storage.k8s.io"
+
+	tests := []struct {
+		name         string
+		featureFlags []string
+		restore      *velerov1api.Restore
+		pvc          *corev1api.PersistentVolumeClaim
+		want         *corev1api.PersistentVolumeClaim
+	}{
+		{
+			name:    "claim is left alone when native CSI snapshots aren't enabled",
+			restore: builder.ForRestore("velero", "restore-1").Result(),
+			pvc: builder.ForPersistentVolumeClaim("ns-1", "pvc-1").
+				ObjectMeta(builder.WithAnnotations(velerov1api.VolumeSnapshotAnnotation, "snapshot-1")).
+				VolumeName("pv-1").
+				Result(),
+			want: builder.ForPersistentVolumeClaim("ns-1", "pvc-1").
+				ObjectMeta(builder.WithAnnotations(velerov1api.VolumeSnapshotAnnotation, "snapshot-1")).
+				VolumeName("pv-1").
+				Result(),
+		},
+		{
+			name:         "claim without a volume snapshot is left alone",
+			featureFlags: []string{velerov1api.CSIFeatureFlag, velerov1api.NativeCSIFeatureFlag},
+			restore:      builder.ForRestore("velero", "restore-1").Result(),
+			pvc:          builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+			want:         builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+		},
+		{
+			name:         "claim is left alone when volumes aren't restored",
+			featureFlags: []string{velerov1api.CSIFeatureFlag, velerov1api.NativeCSIFeatureFlag},
+			restore:      builder.ForRestore("velero", "restore-1").RestorePVs(false).Result(),
+			pvc: builder.ForPersistentVolumeClaim("ns-1", "pvc-1").
+				ObjectMeta(builder.WithAnnotations(velerov1api.VolumeSnapshotAnnotation, "snapshot-1")).
+				VolumeName("pv-1").
+				Result(),
+			want: builder.ForPersistentVolumeClaim("ns-1", "pvc-1").
+				ObjectMeta(builder.WithAnnotations(velerov1api.VolumeSnapshotAnnotation, "snapshot-1")).
+				VolumeName("pv-1").
+				Result(),
+		},
+		{
+			name:         "claim with a volume snapshot is unbound and restored from the snapshot",
+			featureFlags: []string{velerov1api.CSIFeatureFlag, velerov1api.NativeCSIFeatureFlag},
+			restore:      builder.ForRestore("velero", "restore-1").Result(),
+			pvc: builder.ForPersistentVolumeClaim("ns-1", "pvc-1").
+				ObjectMeta(builder.WithAnnotations(
+					velerov1api.VolumeSnapshotAnnotation, "snapshot-1",
+					"pv.kubernetes.io/bind-completed", "yes",
+					"pv.kubernetes.io/bound-by-controller", "yes",
+				)).
+				VolumeName("pv-1").
+				Result(),
+			want: func() *corev1api.PersistentVolumeClaim {
+				pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").
+					ObjectMeta(builder.WithAnnotations(velerov1api.VolumeSnapshotAnnotation, "snapshot-1")).
+					Result()
+				pvc.Spec.DataSource = &corev1api.TypedLocalObjectReference{
+					APIGroup: &apiGroup,
+					Kind:     "VolumeSnapshot",
+					Name:     "snapshot-1",
+				}
+				return pvc
+			}(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			features.NewFeatureFlagSet(tc.featureFlags...)
+			defer features.NewFeatureFlagSet()
+
+			pvcMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.pvc)
+			require.NoError(t, err)
+
+			res, err := NewCSIPVCAction(velerotest.NewLogger()).Execute(&velero.RestoreItemActionExecuteInput{
+				Item:           &unstructured.Unstructured{Object: pvcMap},
+				ItemFromBackup: &unstructured.Unstructured{Object: pvcMap},
+				Restore:        tc.restore,
+			})
+			require.NoError(t, err)
+
+			var got corev1api.PersistentVolumeClaim
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(res.UpdatedItem.UnstructuredContent(), &got))
+			assert.Equal(t, *tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+
+	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/v2/pkg/apis/volumesnapshot/v1beta1"
+	snapshotv1beta1client "github.com/kubernetes-csi/external-snapshotter/v2/pkg/client/clientset/versioned/typed/volumesnapshot/v1beta1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// CSIVolumeSnapshotAction re-binds the CSI VolumeSnapshots that Velero backed up to their
+// snapshots in the storage provider.
+type CSIVolumeSnapshotAction struct {
+	logger         logrus.FieldLogger
+	snapshotClient snapshotv1beta1client.SnapshotV1beta1Interface
+}
+
+// NewCSIVolumeSnapshotAction creates a new RestoreItemAction for CSI VolumeSnapshots.
+func NewCSIVolumeSnapshotAction(logger logrus.FieldLogger, snapshotClient snapshotv1beta1client.SnapshotV1beta1Interface) *CSIVolumeSnapshotAction {
+	return &CSIVolumeSnapshotAction{
+		logger:         logger,
+		snapshotClient: snapshotClient,
+	}
+}
+
+// AppliesTo returns a ResourceSelector that applies only to volume snapshots.
+func (a *CSIVolumeSnapshotAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"volumesnapshots.snapshot.storage.k8s.io"},
+	}, nil
+}
+
+// Execute creates a pre-provisioned VolumeSnapshotContent for the snapshot handle that a
+// VolumeSnapshot is annotated with, and sets the VolumeSnapshot's source to it. The
+// VolumeSnapshotContent's deletion policy is Retain, since the snapshot still belongs to
+// the backup.
+func (a *CSIVolumeSnapshotAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	if !nativeCSIEnabled() {
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	var snapshot snapshotv1beta1api.VolumeSnapshot
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(input.Item.UnstructuredContent(), &snapshot); err != nil {
+		return nil, errors.Wrap(err, "unable to convert unstructured item to volume snapshot")
+	}
+
+	handle := snapshot.Annotations[velerov1api.CSISnapshotHandleAnnotation]
+	if handle == "" {
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	// the item's namespace is remapped after the action is executed.
+	namespace, _ := mapNamespace(input.Restore.Spec.NamespaceMapping, snapshot.Namespace)
+
+	// a volume snapshot that already exists isn't restored, so it mustn't get a new
+	// volume snapshot content either.
+	_, err := a.snapshotClient.VolumeSnapshots(namespace).Get(context.TODO(), snapshot.Name, metav1.GetOptions{})
+	if err == nil {
+		a.logger.Infof("Volume snapshot %s/%s already exists", namespace, snapshot.Name)
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "error getting volume snapshot %s/%s", namespace, snapshot.Name)
+	}
+
+	if input.Restore.Spec.DryRun {
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	content := &snapshotv1beta1api.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "velero-" + snapshot.Name + "-",
+			Labels: map[string]string{
+				velerov1api.RestoreNameLabel: label.GetValidName(input.Restore.Name),
+			},
+		},
+		Spec: snapshotv1beta1api.VolumeSnapshotContentSpec{
+			DeletionPolicy: snapshotv1beta1api.VolumeSnapshotContentRetain,
+			Driver:         snapshot.Annotations[velerov1api.CSIDriverNameAnnotation],
+			VolumeSnapshotRef: corev1api.ObjectReference{
+				APIVersion: snapshotv1beta1api.SchemeGroupVersion.String(),
+				Kind:       "VolumeSnapshot",
+				Namespace:  namespace,
+				Name:       snapshot.Name,
+			},
+			VolumeSnapshotClassName: snapshot.Spec.VolumeSnapshotClassName,
+			Source: snapshotv1beta1api.VolumeSnapshotContentSource{
+				SnapshotHandle: &handle,
+			},
+		},
+	}
+
+	content, err = a.snapshotClient.VolumeSnapshotContents().Create(context.TODO(), content, metav1.CreateOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating volume snapshot content")
+	}
+	a.logger.Infof("Created volume snapshot content %s for volume snapshot %s/%s", content.Name, namespace, snapshot.Name)
+
+	snapshot.Spec.Source = snapshotv1beta1api.VolumeSnapshotSource{
+		VolumeSnapshotContentName: &content.Name,
+	}
+	snapshot.Status = nil
+
+	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&snapshot)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to convert volume snapshot to unstructured item")
+	}
+
+	return velero.NewRestoreItemActionExecuteOutput(&unstructured.Unstructured{Object: res}), nil
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/v2/pkg/apis/volumesnapshot/v1beta1"
+	snapshotfake "github.com/kubernetes-csi/external-snapshotter/v2/pkg/client/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestCSIVolumeSnapshotActionExecute(t *testing.T) {
+	newSnapshot := func(namespace string, annotations ...string) *snapshotv1beta1api.VolumeSnapshot {
+		className := "class-1"
+		contentName := "content-1"
+		snapshot := &snapshotv1beta1api.VolumeSnapshot{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: snapshotv1beta1api.SchemeGroupVersion.String(),
+				Kind:       "VolumeSnapshot",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "snapshot-1",
+			},
+			Spec: snapshotv1beta1api.VolumeSnapshotSpec{
+				VolumeSnapshotClassName: &className,
+			},
+			Status: &snapshotv1beta1api.VolumeSnapshotStatus{
+				BoundVolumeSnapshotContentName: &contentName,
+			},
+		}
+		builder.WithAnnotations(annotations...)(snapshot)
+		return snapshot
+	}
+
+	backedUp := func(namespace string) *snapshotv1beta1api.VolumeSnapshot {
+		return newSnapshot(namespace,
+			velerov1api.CSISnapshotHandleAnnotation, "snap-1",
+			velerov1api.CSIDriverNameAnnotation, "csi.example.com",
+		)
+	}
+
+	tests := []struct {
+		name             string
+		restore          *velerov1api.Restore
+		snapshot         *snapshotv1beta1api.VolumeSnapshot
+		existing         *snapshotv1beta1api.VolumeSnapshot
+		wantContentRefNS string
+	}{
+		{
+			name:     "volume snapshot without a snapshot handle is left alone",
+			restore:  builder.ForRestore("velero", "restore-1").Result(),
+			snapshot: newSnapshot("ns-1"),
+		},
+		{
+			name:     "volume snapshot that already exists is left alone",
+			restore:  builder.ForRestore("velero", "restore-1").Result(),
+			snapshot: backedUp("ns-1"),
+			existing: backedUp("ns-1"),
+		},
+		{
+			name:     "volume snapshot isn't re-bound in a dry run",
+			restore:  builder.ForRestore("velero", "restore-1").DryRun(true).Result(),
+			snapshot: backedUp("ns-1"),
+		},
+		{
+			name:             "volume snapshot is re-bound to a new volume snapshot content",
+			restore:          builder.ForRestore("velero", "restore-1").Result(),
+			snapshot:         backedUp("ns-1"),
+			wantContentRefNS: "ns-1",
+		},
+		{
+			name:             "volume snapshot content references the volume snapshot's remapped namespace",
+			restore:          builder.ForRestore("velero", "restore-1").NamespaceMappings("ns-1", "ns-2").Result(),
+			snapshot:         backedUp("ns-1"),
+			wantContentRefNS: "ns-2",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			features.NewFeatureFlagSet(velerov1api.CSIFeatureFlag, velerov1api.NativeCSIFeatureFlag)
+			defer features.NewFeatureFlagSet()
+
+			snapshotClient := snapshotfake.NewSimpleClientset()
+			snapshotClient.PrependReactor("create", "volumesnapshotcontents", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				obj := action.(clienttesting.CreateAction).GetObject().(metav1.Object)
+				obj.SetName(obj.GetGenerateName() + "abcde")
+				return false, nil, nil
+			})
+			if tc.existing != nil {
+				require.NoError(t, snapshotClient.Tracker().Add(tc.existing))
+			}
+
+			snapshotMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.snapshot)
+			require.NoError(t, err)
+
+			res, err := NewCSIVolumeSnapshotAction(velerotest.NewLogger(), snapshotClient.SnapshotV1beta1()).Execute(&velero.RestoreItemActionExecuteInput{
+				Item:           &unstructured.Unstructured{Object: snapshotMap},
+				ItemFromBackup: &unstructured.Unstructured{Object: snapshotMap},
+				Restore:        tc.restore,
+			})
+			require.NoError(t, err)
+
+			var got snapshotv1beta1api.VolumeSnapshot
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(res.UpdatedItem.UnstructuredContent(), &got))
+
+			contents, err := snapshotClient.SnapshotV1beta1().VolumeSnapshotContents().List(context.TODO(), metav1.ListOptions{})
+			require.NoError(t, err)
+
+			if tc.wantContentRefNS == "" {
+				assert.Equal(t, *tc.snapshot, got)
+				assert.Empty(t, contents.Items)
+				return
+			}
+
+			require.Len(t, contents.Items, 1)
+			content := contents.Items[0]
+			assert.Equal(t, "velero-snapshot-1-abcde", content.Name)
+			assert.Equal(t, "restore-1", content.Labels[velerov1api.RestoreNameLabel])
+			assert.Equal(t, snapshotv1beta1api.VolumeSnapshotContentRetain, content.Spec.DeletionPolicy)
+			assert.Equal(t, "csi.example.com", content.Spec.Driver)
+			assert.Equal(t, "snap-1", *content.Spec.Source.SnapshotHandle)
+			assert.Equal(t, "class-1", *content.Spec.VolumeSnapshotClassName)
+			assert.Equal(t, tc.wantContentRefNS, content.Spec.VolumeSnapshotRef.Namespace)
+			assert.Equal(t, "snapshot-1", content.Spec.VolumeSnapshotRef.Name)
+
+			assert.Nil(t, got.Status)
+			assert.Nil(t, got.Spec.Source.PersistentVolumeClaimName)
+			assert.Equal(t, content.Name, *got.Spec.Source.VolumeSnapshotContentName)
+		})
+	}
+}
+
+func TestCSIVolumeSnapshotContentActionExecute(t *testing.T) {
+	features.NewFeatureFlagSet(velerov1api.CSIFeatureFlag, velerov1api.NativeCSIFeatureFlag)
+	defer features.NewFeatureFlagSet()
+
+	a := NewCSIVolumeSnapshotContentAction(velerotest.NewLogger())
+
+	content := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "content-1"},
+	}}
+	res, err := a.Execute(&velero.RestoreItemActionExecuteInput{Item: content, ItemFromBackup: content})
+	require.NoError(t, err)
+	assert.False(t, res.SkipRestore)
+
+	content.SetLabels(map[string]string{velerov1api.BackupNameLabel: "backup-1"})
+	res, err = a.Execute(&velero.RestoreItemActionExecuteInput{Item: content, ItemFromBackup: content})
+	require.NoError(t, err)
+	assert.True(t, res.SkipRestore)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// CSIVolumeSnapshotContentAction skips the CSI VolumeSnapshotContents that Velero backed
+// up, since CSIVolumeSnapshotAction creates new ones for their VolumeSnapshots.
+type CSIVolumeSnapshotContentAction struct {
+	logger logrus.FieldLogger
+}
+
+// NewCSIVolumeSnapshotContentAction creates a new RestoreItemAction for CSI
+// VolumeSnapshotContents.
+func NewCSIVolumeSnapshotContentAction(logger logrus.FieldLogger) *CSIVolumeSnapshotContentAction {
+	return &CSIVolumeSnapshotContentAction{logger: logger}
+}
+
+// AppliesTo returns a ResourceSelector that applies only to volume snapshot contents.
+func (a *CSIVolumeSnapshotContentAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"volumesnapshotcontents.snapshot.storage.k8s.io"},
+	}, nil
+}
+
+// Execute skips the restore of volume snapshot contents that are labeled with a backup's
+// name.
+func (a *CSIVolumeSnapshotContentAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	if !nativeCSIEnabled() {
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	metadata, err := meta.Accessor(input.Item)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if _, ok := metadata.GetLabels()[velerov1api.BackupNameLabel]; !ok {
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	a.logger.Infof("Skipping restore of volume snapshot content %s because it's re-created for its volume snapshot", metadata.GetName())
+	return velero.NewRestoreItemActionExecuteOutput(input.Item).WithoutRestore(), nil
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// nonDiffableResources are the resources whose items are never compared with the
+// cluster, because they're never restored.
+var nonDiffableResources = sets.NewString(
+	"nodes",
+	"events",
+	"events.events.k8s.io",
+	"backups.velero.io",
+	"restores.velero.io",
+	"resticrepositories.velero.io",
+	"backuprepositories.velero.io",
+)
+
+// DiffResult lists the differences between the items in a backup and the items
+// in the cluster. Items are identified as <resource>/<namespace>/<name>, or
+// <resource>/<name> for cluster-scoped items.
+type DiffResult struct {
+	// Missing are the items in the backup that don't exist in the cluster.
+	Missing []string
+
+	// Changed are the items in the backup whose in-cluster version is different
+	// than the backed-up version.
+	Changed []string
+
+	// Extra are the items in the cluster that aren't in the backup, but are in a
+	// namespace in the backup and of a namespaced resource in the backup.
+	Extra []string
+}
+
+// Differ compares the contents of a backup with the current state of the cluster.
+type Differ struct {
+	discoveryHelper discovery.Helper
+	dynamicFactory  client.DynamicFactory
+	fileSystem      filesystem.Interface
+	log             logrus.FieldLogger
+}
+
+// NewDiffer constructs a Differ.
+func NewDiffer(discoveryHelper discovery.Helper, dynamicFactory client.DynamicFactory, fileSystem filesystem.Interface, log logrus.FieldLogger) *Differ {
+	return &Differ{
+		discoveryHelper: discoveryHelper,
+		dynamicFactory:  dynamicFactory,
+		fileSystem:      fileSystem,
+		log:             log,
+	}
+}
+
+// Diff compares the items in a backup tarball with the items in the cluster.
+// Items are compared as they'd be restored, without their status and metadata
+// other than their name, namespace, labels and annotations. Nothing in the
+// cluster is modified.
+func (d *Differ) Diff(backupReader io.Reader) (*DiffResult, error) {
+	dir, err := archive.NewExtractor(d.log, d.fileSystem).UnzipAndExtractBackup(backupReader)
+	if err != nil {
+		return nil, errors.Wrap(err, "error extracting backup contents")
+	}
+	defer d.fileSystem.RemoveAll(dir)
+
+	backupResources, err := archive.NewParser(d.log, d.fileSystem).Parse(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing backup contents")
+	}
+
+	resources := make([]string, 0, len(backupResources))
+	for resource := range backupResources {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	res := new(DiffResult)
+	for _, resource := range resources {
+		if nonDiffableResources.Has(resource) {
+			continue
+		}
+
+		resourceItems := backupResources[resource]
+		namespaces := make([]string, 0, len(resourceItems.ItemsByNamespace))
+		for namespace := range resourceItems.ItemsByNamespace {
+			namespaces = append(namespaces, namespace)
+		}
+		sort.Strings(namespaces)
+
+		gvr, apiResource, err := d.discoveryHelper.ResourceFor(schema.ParseGroupResource(resource).WithVersion(""))
+		if err != nil {
+			// the cluster doesn't serve the resource, so none of its items exist.
+			d.log.WithField("resource", resource).Infof("Reporting all items of resource as missing because it cannot be resolved via discovery")
+			for _, namespace := range namespaces {
+				for _, name := range resourceItems.ItemsByNamespace[namespace] {
+					res.Missing = append(res.Missing, getResourceID(schema.ParseGroupResource(resource), namespace, name))
+				}
+			}
+			continue
+		}
+
+		for _, namespace := range namespaces {
+			if err := d.diffNamespace(dir, resource, gvr, apiResource, namespace, resourceItems.ItemsByNamespace[namespace], res); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// diffNamespace compares the backed-up items of a resource in a namespace, or the
+// resource's cluster-scoped items if the namespace is empty, with the cluster.
+func (d *Differ) diffNamespace(dir, resource string, gvr schema.GroupVersionResource, apiResource metav1.APIResource, namespace string, items []string, res *DiffResult) error {
+	groupResource := gvr.GroupResource()
+
+	resourceClient, err := d.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), apiResource, namespace)
+	if err != nil {
+		return errors.Wrapf(err, "error getting client for %s", groupResource)
+	}
+
+	list, err := resourceClient.List(metav1.ListOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "error listing %s", groupResource)
+	}
+
+	inCluster := make(map[string]*unstructured.Unstructured, len(list.Items))
+	for i := range list.Items {
+		inCluster[list.Items[i].GetName()] = &list.Items[i]
+	}
+
+	inBackup := sets.NewString()
+	for _, name := range items {
+		inBackup.Insert(name)
+		resourceID := getResourceID(groupResource, namespace, name)
+
+		fromCluster, ok := inCluster[name]
+		if !ok {
+			res.Missing = append(res.Missing, resourceID)
+			continue
+		}
+
+		obj, err := archive.Unmarshal(d.fileSystem, archive.GetItemFilePath(dir, resource, namespace, name))
+		if err != nil {
+			return errors.Wrapf(err, "error reading %s from backup", resourceID)
+		}
+
+		// the cluster's preferred version of the resource may not be the backed-up
+		// version, in which case the in-cluster item is fetched in the backed-up
+		// version so that its fields can be compared.
+		if obj.GetAPIVersion() != fromCluster.GetAPIVersion() {
+			if fromCluster, err = d.getVersion(obj, apiResource, namespace); err != nil {
+				d.log.WithError(err).WithField("item", resourceID).Info("Reporting item as changed because it can't be fetched in its backed-up version")
+				res.Changed = append(res.Changed, resourceID)
+				continue
+			}
+		}
+
+		equal, err := sameContent(obj, fromCluster)
+		if err != nil {
+			return errors.Wrapf(err, "error comparing %s", resourceID)
+		}
+		if !equal {
+			res.Changed = append(res.Changed, resourceID)
+		}
+	}
+
+	// there may be many more cluster-scoped items than the ones that were backed up,
+	// e.g. persistent volumes that only are backed up for the claims in the backup,
+	// so extra items are only reported for namespaced resources.
+	if namespace == "" {
+		return nil
+	}
+
+	var extra []string
+	for name := range inCluster {
+		if !inBackup.Has(name) {
+			extra = append(extra, getResourceID(groupResource, namespace, name))
+		}
+	}
+	sort.Strings(extra)
+	res.Extra = append(res.Extra, extra...)
+
+	return nil
+}
+
+// getVersion fetches the in-cluster version of an item in the item's API version.
+func (d *Differ) getVersion(obj *unstructured.Unstructured, apiResource metav1.APIResource, namespace string) (*unstructured.Unstructured, error) {
+	gv, err := schema.ParseGroupVersion(obj.GetAPIVersion())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	resourceClient, err := d.dynamicFactory.ClientForGroupVersionResource(gv, apiResource, namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting client for %s", gv.WithResource(apiResource.Name))
+	}
+
+	return resourceClient.Get(obj.GetName(), metav1.GetOptions{})
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestDiff(t *testing.T) {
+	h := newHarness(t)
+	h.AddItems(t, test.Pods(
+		builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Result(),
+		builder.ForPod("ns-1", "pod-2").ObjectMeta(builder.WithLabels("app", "changed")).Result(),
+		builder.ForPod("ns-1", "pod-4").Result(),
+		builder.ForPod("ns-2", "pod-5").Result(),
+	))
+	h.AddItems(t, test.PVs(
+		builder.ForPersistentVolume("pv-1").Result(),
+	))
+
+	tarball := test.NewTarWriter(t).
+		AddItems("pods",
+			builder.ForPod("ns-1", "pod-1").Result(),
+			builder.ForPod("ns-1", "pod-2").ObjectMeta(builder.WithLabels("app", "original")).Result(),
+			builder.ForPod("ns-1", "pod-3").Result(),
+		).
+		AddItems("persistentvolumes", builder.ForPersistentVolume("pv-2").Result()).
+		AddItems("widgets.example.com", builder.ForConfigMap("ns-1", "widget-1").Result()).
+		AddItems("nodes", builder.ForNode("node-1").Result()).
+		Done()
+
+	res, err := NewDiffer(h.restorer.discoveryHelper, h.restorer.dynamicFactory, h.restorer.fileSystem, h.log).Diff(tarball)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"persistentvolumes/pv-2", "pods/ns-1/pod-3", "widgets.example.com/ns-1/widget-1"}, res.Missing)
+	assert.Equal(t, []string{"pods/ns-1/pod-2"}, res.Changed)
+	assert.Equal(t, []string{"pods/ns-1/pod-4"}, res.Extra)
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// DryRunAction is what a restore would do with an item if it were not a dry run.
+type DryRunAction string
+
+const (
+	// DryRunActionCreate means the item does not exist in the cluster and would be created.
+	DryRunActionCreate DryRunAction = "Create"
+
+	// DryRunActionUnchanged means the item already exists in the cluster and is the
+	// same as the backed-up version, so it wouldn't be modified.
+	DryRunActionUnchanged DryRunAction = "Unchanged"
+
+	// DryRunActionSkip means the item would not be restored, for example because
+	// it's excluded from the restore or would be dynamically re-provisioned.
+	DryRunActionSkip DryRunAction = "Skip"
+
+	// DryRunActionConflict means the item already exists in the cluster and differs
+	// from the backed-up version.
+	DryRunActionConflict DryRunAction = "Conflict"
+)
+
+// DryRunItem records what a restore would do with a single item.
+type DryRunItem struct {
+	GroupResource string       `json:"groupResource"`
+	Namespace     string       `json:"namespace,omitempty"`
+	Name          string       `json:"name"`
+	Action        DryRunAction `json:"action"`
+	Reason        string       `json:"reason,omitempty"`
+}
+
+// DryRunReport is the plan produced by a dry-run restore.
+type DryRunReport struct {
+	// Items is the list of items the restore would process, in the order
+	// they would be processed.
+	Items []DryRunItem `json:"items"`
+}
+
+// Counts returns the number of items in the report for each action.
+func (r *DryRunReport) Counts() map[DryRunAction]int {
+	counts := make(map[DryRunAction]int)
+	for _, item := range r.Items {
+		counts[item.Action]++
+	}
+	return counts
+}
+
+func (r *DryRunReport) add(groupResource schema.GroupResource, namespace, name string, action DryRunAction, reason string) {
+	r.Items = append(r.Items, DryRunItem{
+		GroupResource: groupResource.String(),
+		Namespace:     namespace,
+		Name:          name,
+		Action:        action,
+		Reason:        reason,
+	})
+}
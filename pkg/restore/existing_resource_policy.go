@@ -0,0 +1,75 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ValidateExistingResourcePolicy returns an error if the given existing resource policy
+// is not one that Velero knows how to apply.
+func ValidateExistingResourcePolicy(policy velerov1api.PolicyType) error {
+	switch policy {
+	case "", velerov1api.PolicyTypeNone, velerov1api.PolicyTypeUpdate, velerov1api.PolicyTypePatch:
+		return nil
+	default:
+		return errors.Errorf("invalid existing resource policy %q, must be one of %q, %q or %q", policy, velerov1api.PolicyTypeNone, velerov1api.PolicyTypeUpdate, velerov1api.PolicyTypePatch)
+	}
+}
+
+// generateExistingResourcePatch calculates the JSON merge patch to apply to an item that
+// already exists in the cluster, according to the given existing resource policy. With
+// the "update" policy, the patch makes the in-cluster version the same as the backed-up
+// version. With the "patch" policy, the patch sets every field of the backed-up version
+// on the in-cluster version, but doesn't remove fields that are only set in the cluster.
+// If no patch is needed, nil is returned.
+func generateExistingResourcePatch(policy velerov1api.PolicyType, fromCluster, fromBackup *unstructured.Unstructured) ([]byte, error) {
+	switch policy {
+	case velerov1api.PolicyTypeUpdate:
+		return generatePatch(fromCluster, fromBackup)
+	case velerov1api.PolicyTypePatch:
+		fromBackupBytes, err := json.Marshal(fromBackup.Object)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to marshal backed-up object")
+		}
+
+		fromClusterBytes, err := json.Marshal(fromCluster.Object)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to marshal in-cluster object")
+		}
+
+		// Using the backed-up version as both the original and the modified version
+		// means that the patch never deletes fields from the in-cluster version.
+		patchBytes, err := jsonmergepatch.CreateThreeWayJSONMergePatch(fromBackupBytes, fromBackupBytes, fromClusterBytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to create three-way merge patch")
+		}
+
+		if string(patchBytes) == "{}" {
+			return nil, nil
+		}
+		return patchBytes, nil
+	default:
+		return nil, errors.Errorf("existing resource policy %q does not modify existing items", policy)
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestValidateExistingResourcePolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  velerov1api.PolicyType
+		wantErr bool
+	}{
+		{name: "empty policy is valid", policy: ""},
+		{name: "none policy is valid", policy: velerov1api.PolicyTypeNone},
+		{name: "update policy is valid", policy: velerov1api.PolicyTypeUpdate},
+		{name: "patch policy is valid", policy: velerov1api.PolicyTypePatch},
+		{name: "unknown policy is invalid", policy: "replace", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateExistingResourcePolicy(tc.policy)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGenerateExistingResourcePatch(t *testing.T) {
+	fromCluster := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":   "cm-1",
+			"labels": map[string]interface{}{"a": "cluster", "b": "cluster"},
+		},
+	}}
+
+	tests := []struct {
+		name       string
+		policy     velerov1api.PolicyType
+		fromBackup *unstructured.Unstructured
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:   "update policy removes fields that are only in the cluster",
+			policy: velerov1api.PolicyTypeUpdate,
+			fromBackup: &unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":   "cm-1",
+					"labels": map[string]interface{}{"a": "backup"},
+				},
+			}},
+			want: `{"metadata":{"labels":{"a":"backup","b":null}}}`,
+		},
+		{
+			name:   "patch policy keeps fields that are only in the cluster",
+			policy: velerov1api.PolicyTypePatch,
+			fromBackup: &unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":   "cm-1",
+					"labels": map[string]interface{}{"a": "backup"},
+				},
+			}},
+			want: `{"metadata":{"labels":{"a":"backup"}}}`,
+		},
+		{
+			name:   "patch policy returns no patch when the cluster already contains the backed-up version",
+			policy: velerov1api.PolicyTypePatch,
+			fromBackup: &unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":   "cm-1",
+					"labels": map[string]interface{}{"a": "cluster"},
+				},
+			}},
+			want: "",
+		},
+		{
+			name:       "none policy returns an error",
+			policy:     velerov1api.PolicyTypeNone,
+			fromBackup: fromCluster,
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			patch, err := generateExistingResourcePatch(tc.policy, fromCluster, tc.fromBackup)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			if tc.want == "" {
+				assert.Nil(t, patch)
+			} else {
+				assert.JSONEq(t, tc.want, string(patch))
+			}
+		})
+	}
+}
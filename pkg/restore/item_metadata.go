@@ -0,0 +1,81 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ValidateItemMetadata checks that a restore's item labels and annotations have
+// valid keys and values, and that they don't set the labels that Velero adds to
+// every restored item.
+func ValidateItemMetadata(labels, annotations map[string]string) []error {
+	var errs []error
+
+	for _, key := range sortedKeys(labels) {
+		if key == velerov1api.BackupNameLabel || key == velerov1api.RestoreNameLabel {
+			errs = append(errs, errors.Errorf("invalid item label %s: label is reserved for use by Velero", key))
+			continue
+		}
+		for _, msg := range validation.IsQualifiedName(key) {
+			errs = append(errs, errors.Errorf("invalid item label key %s: %s", key, msg))
+		}
+		for _, msg := range validation.IsValidLabelValue(labels[key]) {
+			errs = append(errs, errors.Errorf("invalid item label value %s=%s: %s", key, labels[key], msg))
+		}
+	}
+
+	for _, key := range sortedKeys(annotations) {
+		for _, msg := range validation.IsQualifiedName(strings.ToLower(key)) {
+			errs = append(errs, errors.Errorf("invalid item annotation key %s: %s", key, msg))
+		}
+	}
+
+	return errs
+}
+
+// addItemMetadata adds the provided labels and annotations to the provided
+// object, overwriting any existing values for the same keys.
+func addItemMetadata(obj metav1.Object, labels, annotations map[string]string) {
+	if len(labels) > 0 {
+		objLabels := obj.GetLabels()
+		if objLabels == nil {
+			objLabels = make(map[string]string)
+		}
+		for k, v := range labels {
+			objLabels[k] = v
+		}
+		obj.SetLabels(objLabels)
+	}
+
+	if len(annotations) > 0 {
+		objAnnotations := obj.GetAnnotations()
+		if objAnnotations == nil {
+			objAnnotations = make(map[string]string)
+		}
+		for k, v := range annotations {
+			objAnnotations[k] = v
+		}
+		obj.SetAnnotations(objAnnotations)
+	}
+}
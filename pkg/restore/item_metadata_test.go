@@ -0,0 +1,79 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestValidateItemMetadata(t *testing.T) {
+	tests := []struct {
+		name        string
+		labels      map[string]string
+		annotations map[string]string
+		wantErrors  int
+	}{
+		{
+			name:        "valid labels and annotations",
+			labels:      map[string]string{"restored-by": "team-a", "example.com/ticket": "INC-1234"},
+			annotations: map[string]string{"example.com/description": "restored after accidental deletion"},
+		},
+		{
+			name:       "invalid label keys and values",
+			labels:     map[string]string{"not a key": "foo", "key": "not a value"},
+			wantErrors: 2,
+		},
+		{
+			name:       "velero's restore labels are reserved",
+			labels:     map[string]string{"velero.io/backup-name": "foo", "velero.io/restore-name": "bar"},
+			wantErrors: 2,
+		},
+		{
+			name:        "invalid annotation keys",
+			annotations: map[string]string{"not/a/key": "foo"},
+			wantErrors:  1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Len(t, ValidateItemMetadata(tc.labels, tc.annotations), tc.wantErrors)
+		})
+	}
+}
+
+func TestAddItemMetadata(t *testing.T) {
+	obj := builder.ForPod("ns-1", "pod-1").
+		ObjectMeta(
+			builder.WithLabels("a", "1", "b", "2"),
+		).
+		Result()
+
+	addItemMetadata(obj, map[string]string{"b": "3", "c": "4"}, map[string]string{"d": "5"})
+
+	assert.Equal(t, map[string]string{"a": "1", "b": "3", "c": "4"}, obj.Labels)
+	assert.Equal(t, map[string]string{"d": "5"}, obj.Annotations)
+
+	addItemMetadata(obj, nil, nil)
+
+	assert.Equal(t, map[string]string{"a": "1", "b": "3", "c": "4"}, obj.Labels)
+	assert.Equal(t, map[string]string{"d": "5"}, obj.Annotations)
+}
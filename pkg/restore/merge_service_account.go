@@ -17,6 +17,7 @@ package restore
 
 import (
 	"encoding/json"
+	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
@@ -24,8 +25,27 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
+// ValidateServiceAccountPolicy returns an error if the given service account policy
+// is not one that Velero knows how to apply.
+func ValidateServiceAccountPolicy(policy velerov1api.ServiceAccountPolicyType) error {
+	switch policy {
+	case "", velerov1api.ServiceAccountPolicyMerge, velerov1api.ServiceAccountPolicyReplace, velerov1api.ServiceAccountPolicySkip:
+		return nil
+	default:
+		return errors.Errorf("invalid service account policy %q, must be one of %q, %q or %q", policy, velerov1api.ServiceAccountPolicyMerge, velerov1api.ServiceAccountPolicyReplace, velerov1api.ServiceAccountPolicySkip)
+	}
+}
+
+// isServiceAccountTokenSecret returns true if the named secret is one of the token
+// secrets that the cluster generates for the named service account.
+func isServiceAccountTokenSecret(serviceAccountName, secretName string) bool {
+	return strings.HasPrefix(secretName, serviceAccountName+"-token-")
+}
+
 // mergeServiceAccount takes a backed up serviceaccount and merges attributes into the current in-cluster service account.
 // Labels and Annotations on the backed up version but not on the in-cluster version will be merged. If a key is specified in both, the in-cluster version is retained.
 func mergeServiceAccounts(fromCluster, fromBackup *unstructured.Unstructured) (*unstructured.Unstructured, error) {
@@ -59,6 +79,43 @@ func mergeServiceAccounts(fromCluster, fromBackup *unstructured.Unstructured) (*
 	return &unstructured.Unstructured{Object: desiredUnstructured}, nil
 }
 
+// replaceServiceAccount returns the backed up service account with the token secrets of the
+// current in-cluster service account. Token secrets are generated by the cluster for each service
+// account, so the backed up service account's token secrets are dropped and the in-cluster ones kept.
+func replaceServiceAccount(fromCluster, fromBackup *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	clusterSA := new(corev1api.ServiceAccount)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(fromCluster.UnstructuredContent(), clusterSA); err != nil {
+		return nil, errors.Wrap(err, "unable to convert from-cluster service account from unstructured to serviceaccount")
+	}
+
+	desired := new(corev1api.ServiceAccount)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(fromBackup.UnstructuredContent(), desired); err != nil {
+		return nil, errors.Wrap(err, "unable to convert from backed up service account unstructured to serviceaccount")
+	}
+
+	var secrets []corev1api.ObjectReference
+	for _, secret := range desired.Secrets {
+		if !isServiceAccountTokenSecret(desired.Name, secret.Name) {
+			secrets = append(secrets, secret)
+		}
+	}
+	for _, secret := range clusterSA.Secrets {
+		if isServiceAccountTokenSecret(clusterSA.Name, secret.Name) {
+			secrets = append(secrets, secret)
+		}
+	}
+	desired.Secrets = secrets
+
+	desiredUnstructured, err := runtime.DefaultUnstructuredConverter.ToUnstructured(desired)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to convert desired service account to unstructured")
+	}
+	// As in mergeServiceAccounts, don't include the nil creation timestamp added by the converter.
+	delete(desiredUnstructured["metadata"].(map[string]interface{}), "creationTimestamp")
+
+	return &unstructured.Unstructured{Object: desiredUnstructured}, nil
+}
+
 func mergeObjectReferenceSlices(first, second []corev1api.ObjectReference) []corev1api.ObjectReference {
 	for _, s := range second {
 		var exists bool
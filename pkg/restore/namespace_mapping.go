@@ -0,0 +1,117 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const namespaceMappingWildcard = "*"
+
+// ValidateNamespaceMapping checks that every entry of a restore's namespace mapping
+// is either an exact mapping or a well-formed wildcard mapping. A wildcard mapping
+// has exactly one '*' in its source pattern, and zero or one '*' in its target; if
+// the target contains a '*', it's replaced by the portion of the namespace name
+// matched by the source's '*'.
+func ValidateNamespaceMapping(mapping map[string]string) []error {
+	var errs []error
+
+	for _, source := range sortedKeys(mapping) {
+		target := mapping[source]
+
+		sourceWildcards := strings.Count(source, namespaceMappingWildcard)
+		targetWildcards := strings.Count(target, namespaceMappingWildcard)
+
+		switch {
+		case sourceWildcards > 1:
+			errs = append(errs, errors.Errorf("invalid namespace mapping %s:%s: source may contain at most one '*'", source, target))
+		case targetWildcards > 1:
+			errs = append(errs, errors.Errorf("invalid namespace mapping %s:%s: target may contain at most one '*'", source, target))
+		case targetWildcards == 1 && sourceWildcards == 0:
+			errs = append(errs, errors.Errorf("invalid namespace mapping %s:%s: target may only contain '*' if source does", source, target))
+		}
+	}
+
+	return errs
+}
+
+// mapNamespace returns the namespace that items from the given source namespace
+// should be restored into, and whether the namespace is being remapped. Exact
+// mappings take precedence over wildcard mappings. If more than one wildcard
+// mapping matches, the one with the longest non-wildcard portion is used, with
+// ties broken alphabetically.
+func mapNamespace(mapping map[string]string, namespace string) (string, bool) {
+	if namespace == "" || len(mapping) == 0 {
+		return namespace, false
+	}
+
+	if target, ok := mapping[namespace]; ok {
+		return target, true
+	}
+
+	var (
+		matched   bool
+		bestLen   int
+		bestValue string
+	)
+	for _, source := range sortedKeys(mapping) {
+		prefix, suffix, ok := splitWildcard(source)
+		if !ok {
+			continue
+		}
+		if len(namespace) < len(prefix)+len(suffix) || !strings.HasPrefix(namespace, prefix) || !strings.HasSuffix(namespace, suffix) {
+			continue
+		}
+		if matched && len(prefix)+len(suffix) <= bestLen {
+			continue
+		}
+
+		captured := namespace[len(prefix) : len(namespace)-len(suffix)]
+
+		matched = true
+		bestLen = len(prefix) + len(suffix)
+		bestValue = strings.Replace(mapping[source], namespaceMappingWildcard, captured, 1)
+	}
+
+	if !matched {
+		return namespace, false
+	}
+	return bestValue, true
+}
+
+// splitWildcard splits a wildcard namespace pattern into the portions before
+// and after its '*', or returns false if the pattern isn't a wildcard pattern.
+func splitWildcard(pattern string) (string, string, bool) {
+	if strings.Count(pattern, namespaceMappingWildcard) != 1 {
+		return "", "", false
+	}
+
+	i := strings.Index(pattern, namespaceMappingWildcard)
+	return pattern[:i], pattern[i+1:], true
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapNamespace(t *testing.T) {
+	tests := []struct {
+		name       string
+		mapping    map[string]string
+		namespace  string
+		want       string
+		wantMapped bool
+	}{
+		{
+			name:       "nil mapping returns the namespace unchanged",
+			namespace:  "ns-1",
+			want:       "ns-1",
+			wantMapped: false,
+		},
+		{
+			name:       "cluster-scoped items are never mapped",
+			mapping:    map[string]string{"*-1": "foo"},
+			namespace:  "",
+			want:       "",
+			wantMapped: false,
+		},
+		{
+			name:       "exact mapping is applied",
+			mapping:    map[string]string{"ns-1": "new-ns-1"},
+			namespace:  "ns-1",
+			want:       "new-ns-1",
+			wantMapped: true,
+		},
+		{
+			name:       "non-matching namespace is not mapped",
+			mapping:    map[string]string{"ns-1": "new-ns-1", "team-*": "staging-team-*"},
+			namespace:  "ns-2",
+			want:       "ns-2",
+			wantMapped: false,
+		},
+		{
+			name:       "prefix wildcard mapping substitutes the matched portion",
+			mapping:    map[string]string{"team-*": "staging-team-*"},
+			namespace:  "team-a",
+			want:       "staging-team-a",
+			wantMapped: true,
+		},
+		{
+			name:       "suffix wildcard mapping substitutes the matched portion",
+			mapping:    map[string]string{"*-prod": "*-staging"},
+			namespace:  "payments-prod",
+			want:       "payments-staging",
+			wantMapped: true,
+		},
+		{
+			name:       "wildcard mapping without a wildcard target maps to a single namespace",
+			mapping:    map[string]string{"team-*": "all-teams"},
+			namespace:  "team-a",
+			want:       "all-teams",
+			wantMapped: true,
+		},
+		{
+			name:       "wildcard may match an empty string",
+			mapping:    map[string]string{"team-*": "staging-team-*"},
+			namespace:  "team-",
+			want:       "staging-team-",
+			wantMapped: true,
+		},
+		{
+			name:       "prefix and suffix may not overlap",
+			mapping:    map[string]string{"ab*ba": "x"},
+			namespace:  "aba",
+			want:       "aba",
+			wantMapped: false,
+		},
+		{
+			name:       "bare wildcard mapping matches every namespace",
+			mapping:    map[string]string{"*": "restored-*"},
+			namespace:  "ns-1",
+			want:       "restored-ns-1",
+			wantMapped: true,
+		},
+		{
+			name:       "exact mapping takes precedence over wildcard mapping",
+			mapping:    map[string]string{"team-*": "staging-team-*", "team-a": "special"},
+			namespace:  "team-a",
+			want:       "special",
+			wantMapped: true,
+		},
+		{
+			name:       "most specific wildcard mapping is used",
+			mapping:    map[string]string{"team-*": "staging-team-*", "team-a-*": "a-*"},
+			namespace:  "team-a-1",
+			want:       "a-1",
+			wantMapped: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, mapped := mapNamespace(tc.mapping, tc.namespace)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantMapped, mapped)
+		})
+	}
+}
+
+func TestValidateNamespaceMapping(t *testing.T) {
+	tests := []struct {
+		name       string
+		mapping    map[string]string
+		wantErrors int
+	}{
+		{
+			name:    "exact and wildcard mappings are valid",
+			mapping: map[string]string{"ns-1": "ns-2", "team-*": "staging-team-*", "*-prod": "prod", "*": "restored-*"},
+		},
+		{
+			name:       "source with multiple wildcards is invalid",
+			mapping:    map[string]string{"*-team-*": "foo"},
+			wantErrors: 1,
+		},
+		{
+			name:       "target with multiple wildcards is invalid",
+			mapping:    map[string]string{"team-*": "*-*"},
+			wantErrors: 1,
+		},
+		{
+			name:       "target with a wildcard requires a wildcard source",
+			mapping:    map[string]string{"team-a": "team-*", "team-b": "team-*"},
+			wantErrors: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Len(t, ValidateNamespaceMapping(tc.mapping), tc.wantErrors)
+		})
+	}
+}
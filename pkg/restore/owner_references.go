@@ -0,0 +1,124 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// restoreOwners restores the owners of an item that are in the backup before the
+// item itself, so that the item's owner references can be updated with the UIDs
+// of the restored owners. Otherwise, the garbage collector would delete the item
+// as soon as it's created because its owners don't exist.
+func (ctx *restoreContext) restoreOwners(obj *unstructured.Unstructured) (Result, Result) {
+	warnings, errs := Result{}, Result{}
+
+	for _, ref := range obj.GetOwnerReferences() {
+		log := ctx.log.WithFields(logrus.Fields{
+			"item":  obj.GetKind() + " " + kube.NamespaceAndName(obj),
+			"owner": ref.Kind + "/" + ref.Name,
+		})
+
+		gvr, apiResource, err := ctx.discoveryHelper.KindFor(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind))
+		if err != nil {
+			log.Debug("Not restoring owner first because its kind can't be resolved via discovery")
+			continue
+		}
+		groupResource := gvr.GroupResource()
+
+		// owners of namespaced items are in the same namespace, or are cluster-scoped.
+		ownerNamespace := ""
+		if apiResource.Namespaced {
+			ownerNamespace = obj.GetNamespace()
+		}
+
+		itemPath := archive.GetItemFilePath(ctx.restoreDir, groupResource.String(), ownerNamespace, ref.Name)
+		if _, err := ctx.fileSystem.Stat(itemPath); err != nil {
+			log.Debug("Not restoring owner first because it isn't in the backup")
+			continue
+		}
+
+		owner, err := archive.Unmarshal(ctx.fileSystem, itemPath)
+		if err != nil {
+			errs.Add(obj.GetNamespace(), errors.Wrapf(err, "error restoring owner %s", getResourceID(groupResource, ownerNamespace, ref.Name)))
+			continue
+		}
+
+		// the owner may have been backed up as a different object with the same
+		// name, in which case it doesn't own the item.
+		if owner.GetUID() != ref.UID {
+			continue
+		}
+
+		if !ctx.selector.Matches(labels.Set(owner.GetLabels())) {
+			log.Debug("Not restoring owner first because it doesn't match the restore's label selector")
+			continue
+		}
+
+		targetNamespace := ownerNamespace
+		if targetNamespace != "" {
+			if remapped, ok := mapNamespace(ctx.restore.Spec.NamespaceMapping, targetNamespace); ok {
+				targetNamespace = remapped
+			}
+		}
+
+		log.Info("Restoring owner before the item it owns")
+		w, e := ctx.restoreItem(owner, groupResource, targetNamespace)
+		warnings.Merge(&w)
+		errs.Merge(&e)
+	}
+
+	return warnings, errs
+}
+
+// remapOwnerReferences updates the UIDs in an item's owner references to the UIDs
+// of the owners in the cluster. Owners that haven't been restored keep their
+// backed-up UIDs.
+func (ctx *restoreContext) remapOwnerReferences(obj *unstructured.Unstructured) {
+	refs := obj.GetOwnerReferences()
+	if len(refs) == 0 {
+		return
+	}
+
+	for i := range refs {
+		uid, ok := ctx.restoredUIDs[refs[i].UID]
+		if !ok {
+			ctx.log.Infof("Owner %s %s of %s %s wasn't restored, so its owner reference isn't updated", refs[i].Kind, refs[i].Name, obj.GetKind(), kube.NamespaceAndName(obj))
+			continue
+		}
+		refs[i].UID = uid
+	}
+
+	obj.SetOwnerReferences(refs)
+}
+
+// recordRestoredUID records the UID in the cluster of a backed-up item, so that
+// the owner references of the items it owns can be updated.
+func (ctx *restoreContext) recordRestoredUID(backupUID, clusterUID types.UID) {
+	if backupUID == "" || clusterUID == "" {
+		return
+	}
+	ctx.restoredUIDs[backupUID] = clusterUID
+}
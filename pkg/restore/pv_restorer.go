@@ -21,8 +21,11 @@ import (
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -36,9 +39,13 @@ type pvRestorer struct {
 	backup                  *api.Backup
 	snapshotVolumes         *bool
 	restorePVs              *bool
+	zoneMapping             map[string]string
+	volumeOverrides         map[string]api.SnapshotVolumeOverride
 	volumeSnapshots         []*volume.Snapshot
 	volumeSnapshotterGetter VolumeSnapshotterGetter
 	snapshotLocationLister  listers.VolumeSnapshotLocationLister
+	credentialFileStore     credentials.FileStore
+	snapshotLimiter         *clientmgmt.SnapshotLimiter
 }
 
 func (r *pvRestorer) executePVAction(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
@@ -61,6 +68,10 @@ func (r *pvRestorer) executePVAction(obj *unstructured.Unstructured) (*unstructu
 
 	delete(spec, "claimRef")
 
+	if err := remapPVZones(obj, r.zoneMapping); err != nil {
+		return nil, err
+	}
+
 	if boolptr.IsSetToFalse(r.snapshotVolumes) {
 		// The backup had snapshots disabled, so we can return early
 		return obj, nil
@@ -82,21 +93,56 @@ func (r *pvRestorer) executePVAction(obj *unstructured.Unstructured) (*unstructu
 		return obj, nil
 	}
 
-	volumeSnapshotter, err := r.volumeSnapshotterGetter.GetVolumeSnapshotter(snapshotInfo.location.Spec.Provider)
+	volumeSnapshotter, err := clientmgmt.GetVolumeSnapshotterWithEgressProxy(r.volumeSnapshotterGetter, snapshotInfo.location.Spec.Provider, snapshotInfo.location.Spec.EgressProxy)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	if err := volumeSnapshotter.Init(snapshotInfo.location.Spec.Config); err != nil {
+	config, err := credentials.VolumeSnapshotterConfig(snapshotInfo.location, r.credentialFileStore)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := volumeSnapshotter.Init(config); err != nil {
 		return nil, errors.WithStack(err)
 	}
+	volumeSnapshotter = r.snapshotLimiter.VolumeSnapshotter(snapshotInfo.location, volumeSnapshotter)
+
+	volumeAZ := mapZone(snapshotInfo.volumeAZ, r.zoneMapping)
+	if volumeAZ != snapshotInfo.volumeAZ {
+		log.Infof("Creating volume in availability zone %s instead of %s according to the restore's zone mapping", volumeAZ, snapshotInfo.volumeAZ)
+	}
+
+	volumeType, volumeIOPS := snapshotInfo.volumeType, snapshotInfo.volumeIOPS
+	override, hasOverride := r.volumeOverrides[pvStorageClass(obj)]
+	if override.VolumeType != "" {
+		volumeType = override.VolumeType
+	}
+	if override.IOPS != nil {
+		volumeIOPS = override.IOPS
+	}
+	if hasOverride {
+		log.Infof("Applying the restore's snapshot volume overrides for storage class %s", pvStorageClass(obj))
+	}
 
-	volumeID, err := volumeSnapshotter.CreateVolumeFromSnapshot(snapshotInfo.providerSnapshotID, snapshotInfo.volumeType, snapshotInfo.volumeAZ, snapshotInfo.volumeIOPS)
+	size, err := snapshotVolumeSize(obj, override.Size)
+	if err != nil {
+		return nil, err
+	}
+	var sizeBytes int64
+	if size != nil {
+		sizeBytes = size.Value()
+	}
+
+	volumeID, resized, err := velero.CreateResizedVolumeFromSnapshot(volumeSnapshotter, snapshotInfo.providerSnapshotID, volumeType, volumeAZ, volumeIOPS, sizeBytes)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
 	log.WithField("providerSnapshotID", snapshotInfo.providerSnapshotID).Info("successfully restored persistent volume from snapshot")
+	if size != nil && !resized {
+		log.Warnf("Volume wasn't enlarged to %s because its volume snapshotter doesn't support resizing volumes", size.String())
+	}
 
 	updated1, err := volumeSnapshotter.SetVolumeID(obj, volumeID)
 	if err != nil {
@@ -106,6 +152,18 @@ func (r *pvRestorer) executePVAction(obj *unstructured.Unstructured) (*unstructu
 	if !ok {
 		return nil, errors.Errorf("unexpected type %T", updated1)
 	}
+
+	if resized {
+		if err := unstructured.SetNestedField(updated2.Object, size.String(), "spec", "capacity", "storage"); err != nil {
+			return nil, errors.Wrap(err, "error setting persistent volume's capacity")
+		}
+	}
+	if override.StorageClass != "" {
+		if err := unstructured.SetNestedField(updated2.Object, override.StorageClass, "spec", "storageClassName"); err != nil {
+			return nil, errors.Wrap(err, "error setting persistent volume's storage class")
+		}
+	}
+
 	return updated2, nil
 }
 
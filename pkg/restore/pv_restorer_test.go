@@ -194,6 +194,23 @@ func TestExecutePVAction_SnapshotRestores(t *testing.T) {
 			expectedVolumeAZ:   "az-1",
 			expectedVolumeIOPS: int64Ptr(1),
 		},
+		{
+			name:    "volume is created in the zone that the restore's zone mapping maps the snapshot's zone to",
+			obj:     NewTestUnstructured().WithName("pv-1").WithSpec().Unstructured,
+			restore: builder.ForRestore(api.DefaultNamespace, "").RestorePVs(true).ZoneMappings("az-1", "az-3").Result(),
+			backup:  defaultBackup().Result(),
+			locations: []*api.VolumeSnapshotLocation{
+				builder.ForVolumeSnapshotLocation(api.DefaultNamespace, "loc-1").Provider("provider-1").Result(),
+			},
+			volumeSnapshots: []*volume.Snapshot{
+				newSnapshot("pv-1", "loc-1", "type-1", "az-1", "snap-1", 1),
+			},
+			expectedProvider:   "provider-1",
+			expectedSnapshotID: "snap-1",
+			expectedVolumeType: "type-1",
+			expectedVolumeAZ:   "az-3",
+			expectedVolumeIOPS: int64Ptr(1),
+		},
 	}
 
 	for _, tc := range tests {
@@ -213,6 +230,7 @@ func TestExecutePVAction_SnapshotRestores(t *testing.T) {
 			r := &pvRestorer{
 				logger:                  velerotest.NewLogger(),
 				backup:                  tc.backup,
+				zoneMapping:             tc.restore.Spec.ZoneMapping,
 				volumeSnapshots:         tc.volumeSnapshots,
 				snapshotLocationLister:  locationsInformer.Lister(),
 				volumeSnapshotterGetter: volumeSnapshotterGetter,
@@ -230,6 +248,106 @@ func TestExecutePVAction_SnapshotRestores(t *testing.T) {
 	}
 }
 
+func TestExecutePVAction_SnapshotVolumeOverrides(t *testing.T) {
+	overrides := map[string]api.SnapshotVolumeOverride{
+		"gp2": {StorageClass: "gp3", VolumeType: "type-3", IOPS: int64Ptr(3000), Size: "100Gi"},
+		"io1": {Size: "5Gi"},
+	}
+
+	tests := []struct {
+		name                 string
+		storageClass         string
+		resized              bool
+		expectedVolumeType   string
+		expectedVolumeIOPS   *int64
+		expectedSizeBytes    int64
+		expectedStorageClass string
+		expectedCapacity     string
+	}{
+		{
+			name:                 "volume without an override is created like the snapshotted volume",
+			storageClass:         "standard",
+			expectedVolumeType:   "type-1",
+			expectedVolumeIOPS:   int64Ptr(1),
+			expectedStorageClass: "standard",
+			expectedCapacity:     "10Gi",
+		},
+		{
+			name:                 "volume is created with the override's type, IOPS, size and storage class",
+			storageClass:         "gp2",
+			resized:              true,
+			expectedVolumeType:   "type-3",
+			expectedVolumeIOPS:   int64Ptr(3000),
+			expectedSizeBytes:    100 * 1024 * 1024 * 1024,
+			expectedStorageClass: "gp3",
+			expectedCapacity:     "100Gi",
+		},
+		{
+			name:                 "capacity isn't changed if the volume snapshotter didn't resize the volume",
+			storageClass:         "gp2",
+			expectedVolumeType:   "type-3",
+			expectedVolumeIOPS:   int64Ptr(3000),
+			expectedSizeBytes:    100 * 1024 * 1024 * 1024,
+			expectedStorageClass: "gp3",
+			expectedCapacity:     "10Gi",
+		},
+		{
+			name:                 "volume isn't shrunk",
+			storageClass:         "io1",
+			expectedVolumeType:   "type-1",
+			expectedVolumeIOPS:   int64Ptr(1),
+			expectedStorageClass: "io1",
+			expectedCapacity:     "10Gi",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				volumeSnapshotter       = new(providermocks.VolumeSnapshotter)
+				volumeSnapshotterGetter = providerToVolumeSnapshotterMap(map[string]velero.VolumeSnapshotter{
+					"provider-1": volumeSnapshotter,
+				})
+				locationsInformer = informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0).Velero().V1().VolumeSnapshotLocations()
+			)
+
+			obj := NewTestUnstructured().WithName("pv-1").
+				WithSpecField("storageClassName", tc.storageClass).
+				WithSpecField("capacity", map[string]interface{}{"storage": "10Gi"}).
+				Unstructured
+
+			require.NoError(t, locationsInformer.Informer().GetStore().Add(builder.ForVolumeSnapshotLocation(api.DefaultNamespace, "loc-1").Provider("provider-1").Result()))
+
+			r := &pvRestorer{
+				logger:                  velerotest.NewLogger(),
+				backup:                  defaultBackup().Result(),
+				volumeOverrides:         overrides,
+				volumeSnapshots:         []*volume.Snapshot{newSnapshot("pv-1", "loc-1", "type-1", "az-1", "snap-1", 1)},
+				snapshotLocationLister:  locationsInformer.Lister(),
+				volumeSnapshotterGetter: volumeSnapshotterGetter,
+			}
+
+			volumeSnapshotter.On("Init", mock.Anything).Return(nil)
+			if tc.expectedSizeBytes > 0 {
+				volumeSnapshotter.On("CreateResizedVolumeFromSnapshot", "snap-1", tc.expectedVolumeType, "az-1", tc.expectedVolumeIOPS, tc.expectedSizeBytes).Return("volume-1", tc.resized, nil)
+			} else {
+				volumeSnapshotter.On("CreateVolumeFromSnapshot", "snap-1", tc.expectedVolumeType, "az-1", tc.expectedVolumeIOPS).Return("volume-1", nil)
+			}
+			volumeSnapshotter.On("SetVolumeID", obj, "volume-1").Return(obj, nil)
+
+			res, err := r.executePVAction(obj)
+			require.NoError(t, err)
+
+			volumeSnapshotter.AssertExpectations(t)
+
+			storageClass, _, _ := unstructured.NestedString(res.Object, "spec", "storageClassName")
+			assert.Equal(t, tc.expectedStorageClass, storageClass)
+			capacity, _, _ := unstructured.NestedString(res.Object, "spec", "capacity", "storage")
+			assert.Equal(t, tc.expectedCapacity, capacity)
+		})
+	}
+}
+
 type providerToVolumeSnapshotterMap map[string]velero.VolumeSnapshotter
 
 func (g providerToVolumeSnapshotterMap) GetVolumeSnapshotter(provider string) (velero.VolumeSnapshotter, error) {
@@ -0,0 +1,179 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"github.com/pkg/errors"
+	storagev1api "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// defaultStorageClassAnnotations are the annotations that mark a storage class as
+// the one used for claims that don't specify a storage class.
+var defaultStorageClassAnnotations = []string{
+	"storageclass.kubernetes.io/is-default-class",
+	"storageclass.beta.kubernetes.io/is-default-class",
+}
+
+// ValidatePVCResize checks that the factor of a restore's PVC resize spec is a number
+// that's at least 1, and that its minimum sizes are positive quantities.
+func ValidatePVCResize(spec *velerov1api.PVCResizeSpec) []error {
+	if spec == nil {
+		return nil
+	}
+
+	var errs []error
+
+	if spec.Factor != "" {
+		if factor, err := strconv.ParseFloat(spec.Factor, 64); err != nil || factor < 1 || math.IsInf(factor, 0) {
+			errs = append(errs, errors.Errorf("invalid PVC resize factor %q: must be a number that's at least 1", spec.Factor))
+		}
+	}
+
+	for storageClass, size := range spec.MinimumSizes {
+		if quantity, err := resource.ParseQuantity(size); err != nil || quantity.Sign() <= 0 {
+			errs = append(errs, errors.Errorf("invalid PVC minimum size %q for storage class %s: must be a positive quantity", size, storageClass))
+		}
+	}
+
+	return errs
+}
+
+// resizePVC increases the storage requested by a persistent volume claim according
+// to the restore's PVC resize spec, if the claim's volume is going to be dynamically
+// provisioned. The claim's storage class must exist in the cluster.
+func (ctx *restoreContext) resizePVC(obj *unstructured.Unstructured) error {
+	spec := ctx.restore.Spec.PVCResize
+	if spec == nil {
+		return nil
+	}
+
+	log := ctx.log.WithField("persistentVolumeClaim", obj.GetNamespace()+"/"+obj.GetName())
+
+	// a claim can't request more storage than the volume it's bound to has, so
+	// only claims whose volumes will be provisioned are resized.
+	if volumeName, _, _ := unstructured.NestedString(obj.Object, "spec", "volumeName"); volumeName != "" {
+		log.Infof("Not resizing persistent volume claim because it's bound to persistent volume %s", volumeName)
+		return nil
+	}
+
+	storageClass, err := ctx.pvcStorageClass(obj)
+	if err != nil {
+		return err
+	}
+	if storageClass == nil {
+		log.Info("Not resizing persistent volume claim because it doesn't have a storage class and there's no default storage class")
+		return nil
+	}
+
+	requested, found, err := unstructured.NestedString(obj.Object, "spec", "resources", "requests", "storage")
+	if err != nil {
+		return errors.Wrap(err, "error getting persistent volume claim's requested storage")
+	}
+	if !found {
+		return nil
+	}
+	current, err := resource.ParseQuantity(requested)
+	if err != nil {
+		return errors.Wrapf(err, "error parsing persistent volume claim's requested storage %q", requested)
+	}
+
+	size := current.DeepCopy()
+	if spec.Factor != "" {
+		factor, err := strconv.ParseFloat(spec.Factor, 64)
+		if err != nil {
+			return errors.Wrapf(err, "error parsing PVC resize factor %q", spec.Factor)
+		}
+		size = *resource.NewQuantity(int64(math.Ceil(float64(current.Value())*factor)), current.Format)
+	}
+
+	if minimum, ok := spec.MinimumSizes[storageClass.Name]; ok {
+		minimumSize, err := resource.ParseQuantity(minimum)
+		if err != nil {
+			return errors.Wrapf(err, "error parsing PVC minimum size %q for storage class %s", minimum, storageClass.Name)
+		}
+		if minimumSize.Cmp(size) > 0 {
+			size = minimumSize
+		}
+	}
+
+	if size.Cmp(current) <= 0 {
+		return nil
+	}
+
+	log.Infof("Resizing persistent volume claim's requested storage from %s to %s", current.String(), size.String())
+	if err := unstructured.SetNestedField(obj.Object, size.String(), "spec", "resources", "requests", "storage"); err != nil {
+		return errors.Wrap(err, "error setting persistent volume claim's requested storage")
+	}
+
+	return nil
+}
+
+// pvcStorageClass returns the storage class that will provision the claim's volume,
+// or nil if the claim explicitly has no storage class, or doesn't specify one and
+// the cluster has no default storage class.
+func (ctx *restoreContext) pvcStorageClass(obj *unstructured.Unstructured) (*storagev1api.StorageClass, error) {
+	name, found, err := unstructured.NestedString(obj.Object, "spec", "storageClassName")
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting persistent volume claim's storage class")
+	}
+
+	if !found {
+		return ctx.defaultStorageClass()
+	}
+	if name == "" {
+		return nil, nil
+	}
+
+	storageClass, err := ctx.storageClassClient.Get(context.TODO(), name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, errors.Errorf("error resizing persistent volume claim: storage class %s does not exist in the cluster", name)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting storage class %s", name)
+	}
+
+	return storageClass, nil
+}
+
+// defaultStorageClass returns the cluster's default storage class, or nil if there
+// isn't one.
+func (ctx *restoreContext) defaultStorageClass() (*storagev1api.StorageClass, error) {
+	storageClasses, err := ctx.storageClassClient.List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing storage classes")
+	}
+
+	for i := range storageClasses.Items {
+		for _, annotation := range defaultStorageClassAnnotations {
+			if storageClasses.Items[i].Annotations[annotation] == "true" {
+				return &storageClasses.Items[i], nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestValidatePVCResize(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       *velerov1api.PVCResizeSpec
+		wantErrors int
+	}{
+		{
+			name: "nil spec is valid",
+		},
+		{
+			name: "factor of at least 1 and positive minimum sizes are valid",
+			spec: &velerov1api.PVCResizeSpec{Factor: "1.5", MinimumSizes: map[string]string{"standard": "10Gi", "premium": "100G"}},
+		},
+		{
+			name:       "factor that isn't a number is invalid",
+			spec:       &velerov1api.PVCResizeSpec{Factor: "double"},
+			wantErrors: 1,
+		},
+		{
+			name:       "factor less than 1 is invalid",
+			spec:       &velerov1api.PVCResizeSpec{Factor: "0.5"},
+			wantErrors: 1,
+		},
+		{
+			name:       "minimum sizes that aren't positive quantities are invalid",
+			spec:       &velerov1api.PVCResizeSpec{MinimumSizes: map[string]string{"standard": "ten", "premium": "0"}},
+			wantErrors: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Len(t, ValidatePVCResize(tc.spec), tc.wantErrors)
+		})
+	}
+}
@@ -0,0 +1,358 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/jsonpath"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const defaultReadinessTimeout = 10 * time.Minute
+
+// readinessPollInterval is how often restored items are checked while waiting
+// for them to become ready.
+var readinessPollInterval = time.Second
+
+// defaultReadinessConditions are the status conditions used to determine
+// whether items of well-known resources are ready, keyed by group-resource.
+var defaultReadinessConditions = map[string]string{
+	"customresourcedefinitions.apiextensions.k8s.io": "Established",
+	"deployments.apps":                   "Available",
+	"apiservices.apiregistration.k8s.io": "Available",
+	"pods":                               "Ready",
+}
+
+// ValidateReadinessGates checks that every readiness gate specifies a resource,
+// and either a JSONPath expression that parses or a condition type, which may only
+// be omitted if the resource has a well-known readiness condition.
+func ValidateReadinessGates(gates []velerov1api.ReadinessGate) []error {
+	var errs []error
+
+	for i, gate := range gates {
+		switch {
+		case gate.Resource == "":
+			errs = append(errs, errors.Errorf("invalid readiness gate %d: resource must be specified", i))
+		case gate.JSONPath != "" && gate.ConditionType != "":
+			errs = append(errs, errors.Errorf("invalid readiness gate for %s: only one of conditionType and jsonPath may be specified", gate.Resource))
+		case gate.JSONPath == "" && gate.Value != "":
+			errs = append(errs, errors.Errorf("invalid readiness gate for %s: value may only be specified with jsonPath", gate.Resource))
+		case gate.JSONPath == "" && gate.ConditionType == "" && defaultReadinessConditions[schema.ParseGroupResource(gate.Resource).String()] == "":
+			errs = append(errs, errors.Errorf("invalid readiness gate for %s: conditionType or jsonPath must be specified for resources without a well-known readiness condition", gate.Resource))
+		case gate.Timeout.Duration < 0:
+			errs = append(errs, errors.Errorf("invalid readiness gate for %s: timeout must not be negative", gate.Resource))
+		case gate.JSONPath != "":
+			if _, err := parseReadinessJSONPath(gate.JSONPath); err != nil {
+				errs = append(errs, errors.Wrapf(err, "invalid readiness gate for %s", gate.Resource))
+			}
+		}
+	}
+
+	return errs
+}
+
+// parseReadinessJSONPath parses a readiness gate's JSONPath expression. Keys that
+// items don't have yet aren't errors, since they're commonly status fields that
+// are only set once the item is ready.
+func parseReadinessJSONPath(expression string) (*jsonpath.JSONPath, error) {
+	parsed := jsonpath.New("readiness")
+	parsed.AllowMissingKeys(true)
+	if err := parsed.Parse(expression); err != nil {
+		return nil, errors.Wrapf(err, "error parsing jsonPath %q", expression)
+	}
+	return parsed, nil
+}
+
+// readinessGate is a resolved velerov1api.ReadinessGate. Items are ready when
+// they match its JSONPath expression if it has one, or else when they have its
+// condition; if it has neither, items are ready once they exist.
+type readinessGate struct {
+	conditionType string
+	jsonPath      *jsonpath.JSONPath
+	expression    string
+	value         string
+	timeout       time.Duration
+}
+
+// ready returns whether the item is ready.
+func (g readinessGate) ready(obj *unstructured.Unstructured) bool {
+	switch {
+	case g.jsonPath != nil:
+		return matchesJSONPath(obj, g.jsonPath, g.value)
+	case g.conditionType != "":
+		return isConditionTrue(obj, g.conditionType)
+	default:
+		return true
+	}
+}
+
+// requirement describes what ready items have, e.g. "have condition Available".
+func (g readinessGate) requirement() string {
+	switch {
+	case g.jsonPath != nil && g.value == "":
+		return fmt.Sprintf("have a value for jsonPath %s", g.expression)
+	case g.jsonPath != nil:
+		return fmt.Sprintf("have jsonPath %s=%s", g.expression, g.value)
+	default:
+		return fmt.Sprintf("have condition %s", g.conditionType)
+	}
+}
+
+// newReadinessGates resolves a restore's readiness gates, keyed by group-resource
+// string. Resources are resolved via discovery where possible; resources that
+// can't be resolved, such as custom resources whose definitions are being
+// restored, are used as given.
+// Gates whose JSONPath expression doesn't parse, which validation rejects, are ignored.
+func newReadinessGates(helper discovery.Helper, gates []velerov1api.ReadinessGate) map[string]readinessGate {
+	if len(gates) == 0 {
+		return nil
+	}
+
+	res := make(map[string]readinessGate)
+	for _, gate := range gates {
+		groupResource := schema.ParseGroupResource(gate.Resource)
+		if gvr, _, err := helper.ResourceFor(groupResource.WithVersion("")); err == nil {
+			groupResource = gvr.GroupResource()
+		}
+
+		resolved := readinessGate{
+			conditionType: gate.ConditionType,
+			expression:    gate.JSONPath,
+			value:         gate.Value,
+			timeout:       gate.Timeout.Duration,
+		}
+
+		if gate.JSONPath != "" {
+			var err error
+			if resolved.jsonPath, err = parseReadinessJSONPath(gate.JSONPath); err != nil {
+				continue
+			}
+		} else if resolved.conditionType == "" {
+			resolved.conditionType = defaultReadinessConditions[groupResource.String()]
+		}
+
+		if resolved.timeout == 0 {
+			resolved.timeout = defaultReadinessTimeout
+		}
+
+		res[groupResource.String()] = resolved
+	}
+
+	return res
+}
+
+// pendingReadyItem is a restored item that must become ready before the
+// restore proceeds.
+type pendingReadyItem struct {
+	obj            *unstructured.Unstructured
+	groupResource  string
+	resourceClient client.Dynamic
+}
+
+// trackReadiness records a newly-created item to be waited for, if its
+// resource has a readiness gate.
+func (ctx *restoreContext) trackReadiness(groupResource schema.GroupResource, obj *unstructured.Unstructured, resourceClient client.Dynamic) {
+	if _, ok := ctx.readinessGates[groupResource.String()]; !ok {
+		return
+	}
+
+	ctx.pendingReadyItems = append(ctx.pendingReadyItems, pendingReadyItem{
+		obj:            obj,
+		groupResource:  groupResource.String(),
+		resourceClient: resourceClient,
+	})
+}
+
+// waitForReadiness waits for every tracked item to become ready, or for its
+// readiness gate's timeout to expire. A warning is returned for each item
+// that doesn't become ready.
+func (ctx *restoreContext) waitForReadiness() Result {
+	warnings := Result{}
+
+	if len(ctx.pendingReadyItems) == 0 {
+		return warnings
+	}
+
+	byResource := make(map[string][]pendingReadyItem)
+	for _, item := range ctx.pendingReadyItems {
+		byResource[item.groupResource] = append(byResource[item.groupResource], item)
+	}
+	ctx.pendingReadyItems = nil
+
+	var resources []string
+	for resource := range byResource {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	for _, resource := range resources {
+		gate := ctx.readinessGates[resource]
+		pending := byResource[resource]
+
+		ctx.log.Infof("Waiting up to %s for %d %s to %s", gate.timeout, len(pending), resource, gate.requirement())
+
+		err := wait.PollImmediate(readinessPollInterval, gate.timeout, func() (bool, error) {
+			var notReady []pendingReadyItem
+			for _, item := range pending {
+				obj, err := item.resourceClient.Get(item.obj.GetName(), metav1.GetOptions{})
+				if err != nil || !gate.ready(obj) {
+					notReady = append(notReady, item)
+				}
+			}
+			pending = notReady
+			return len(pending) == 0, nil
+		})
+		if err != nil && err != wait.ErrWaitTimeout {
+			warnings.AddVeleroError(errors.Wrapf(err, "error waiting for %s to become ready", resource))
+			continue
+		}
+
+		for _, item := range pending {
+			warnings.Add(item.obj.GetNamespace(), errors.Errorf("%s %s did not %s within %s", resource, kube.NamespaceAndName(item.obj), gate.requirement(), gate.timeout))
+		}
+	}
+
+	return warnings
+}
+
+// additionalReadyItem is an additional item returned by a restore item action
+// that must become ready before the item the action was invoked for is restored.
+type additionalReadyItem struct {
+	obj           *unstructured.Unstructured
+	groupResource schema.GroupResource
+	namespace     string
+}
+
+// waitForAdditionalItems waits for the additional items to become ready, or for
+// the timeout to expire. An item is ready when it's ready by its resource's
+// readiness gate, or has its resource's well-known readiness condition; items of
+// other resources are ready once they exist. A warning is returned for each item
+// that doesn't become ready.
+func (ctx *restoreContext) waitForAdditionalItems(items []additionalReadyItem, timeout time.Duration) Result {
+	warnings := Result{}
+
+	if timeout == 0 {
+		timeout = defaultReadinessTimeout
+	}
+
+	var pending []pendingReadyItem
+	gates := make(map[string]readinessGate)
+	for _, item := range items {
+		resourceClient, err := ctx.getResourceClient(item.groupResource, item.obj, item.namespace)
+		if err != nil {
+			warnings.Add(item.namespace, errors.Wrapf(err, "error getting resource client for additional item %s", getResourceID(item.groupResource, item.namespace, item.obj.GetName())))
+			continue
+		}
+
+		gate, ok := ctx.readinessGates[item.groupResource.String()]
+		if !ok {
+			gate = readinessGate{conditionType: defaultReadinessConditions[item.groupResource.String()]}
+		}
+		gates[item.groupResource.String()] = gate
+
+		pending = append(pending, pendingReadyItem{
+			obj:            item.obj,
+			groupResource:  item.groupResource.String(),
+			resourceClient: resourceClient,
+		})
+	}
+
+	if len(pending) == 0 {
+		return warnings
+	}
+
+	ctx.log.Infof("Waiting up to %s for %d additional items to become ready", timeout, len(pending))
+
+	err := wait.PollImmediate(readinessPollInterval, timeout, func() (bool, error) {
+		var notReady []pendingReadyItem
+		for _, item := range pending {
+			obj, err := item.resourceClient.Get(item.obj.GetName(), metav1.GetOptions{})
+			if err != nil {
+				notReady = append(notReady, item)
+				continue
+			}
+			if !gates[item.groupResource].ready(obj) {
+				notReady = append(notReady, item)
+			}
+		}
+		pending = notReady
+		return len(pending) == 0, nil
+	})
+	if err != nil && err != wait.ErrWaitTimeout {
+		warnings.AddVeleroError(errors.Wrap(err, "error waiting for additional items to become ready"))
+		return warnings
+	}
+
+	for _, item := range pending {
+		warnings.Add(item.obj.GetNamespace(), errors.Errorf("additional item %s %s did not become ready within %s", item.groupResource, kube.NamespaceAndName(item.obj), timeout))
+	}
+
+	return warnings
+}
+
+// matchesJSONPath returns whether any result of the JSONPath expression for the item
+// is value, compared as text, or if value is empty, whether the expression has any
+// result.
+func matchesJSONPath(obj *unstructured.Unstructured, expression *jsonpath.JSONPath, value string) bool {
+	results, err := expression.FindResults(obj.UnstructuredContent())
+	if err != nil {
+		return false
+	}
+
+	for _, result := range results {
+		for _, v := range result {
+			if value == "" || fmt.Sprintf("%v", v.Interface()) == value {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isConditionTrue returns whether the item has a status condition of the given
+// type with a status of "True".
+func isConditionTrue(obj *unstructured.Unstructured, conditionType string) bool {
+	conditions, _, err := unstructured.NestedSlice(obj.UnstructuredContent(), "status", "conditions")
+	if err != nil {
+		return false
+	}
+
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if condition["type"] == conditionType && condition["status"] == "True" {
+			return true
+		}
+	}
+
+	return false
+}
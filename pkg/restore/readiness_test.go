@@ -0,0 +1,141 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestValidateReadinessGates(t *testing.T) {
+	tests := []struct {
+		name       string
+		gates      []velerov1api.ReadinessGate
+		wantErrors int
+	}{
+		{
+			name: "gates for well-known resources don't need a condition type",
+			gates: []velerov1api.ReadinessGate{
+				{Resource: "customresourcedefinitions.apiextensions.k8s.io"},
+				{Resource: "deployments.apps", Timeout: metav1.Duration{Duration: time.Minute}},
+				{Resource: "widgets.example.com", ConditionType: "Ready"},
+			},
+		},
+		{
+			name: "gates with a jsonPath don't need a condition type",
+			gates: []velerov1api.ReadinessGate{
+				{Resource: "widgets.example.com", JSONPath: "{.status.phase}", Value: "Running"},
+				{Resource: "gadgets.example.com", JSONPath: `{.status.conditions[?(@.type=="Synced")].status}`},
+			},
+		},
+		{
+			name: "gates need a jsonPath that parses, and can't also have a condition type",
+			gates: []velerov1api.ReadinessGate{
+				{Resource: "widgets.example.com", JSONPath: "{.status.phase"},
+				{Resource: "widgets.example.com", JSONPath: "{.status.phase}", ConditionType: "Ready"},
+				{Resource: "pods", ConditionType: "Ready", Value: "True"},
+			},
+			wantErrors: 3,
+		},
+		{
+			name: "gates for other resources need a condition type",
+			gates: []velerov1api.ReadinessGate{
+				{Resource: "widgets.example.com"},
+				{Resource: "deploy"},
+			},
+			wantErrors: 2,
+		},
+		{
+			name: "gates need a resource and a non-negative timeout",
+			gates: []velerov1api.ReadinessGate{
+				{ConditionType: "Ready"},
+				{Resource: "pods", Timeout: metav1.Duration{Duration: -time.Minute}},
+			},
+			wantErrors: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Len(t, ValidateReadinessGates(tc.gates), tc.wantErrors)
+		})
+	}
+}
+
+func TestIsConditionTrue(t *testing.T) {
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Available", "status": "True"},
+					map[string]interface{}{"type": "Progressing", "status": "False"},
+				},
+			},
+		},
+	}
+
+	assert.True(t, isConditionTrue(obj, "Available"))
+	assert.False(t, isConditionTrue(obj, "Progressing"))
+	assert.False(t, isConditionTrue(obj, "Ready"))
+	assert.False(t, isConditionTrue(&unstructured.Unstructured{Object: map[string]interface{}{}}, "Ready"))
+}
+
+func TestMatchesJSONPath(t *testing.T) {
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"status": map[string]interface{}{
+				"phase":         "Running",
+				"readyReplicas": int64(3),
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Synced", "status": "True"},
+					map[string]interface{}{"type": "Healthy", "status": "False"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		value      string
+		want       bool
+	}{
+		{name: "string field with the value", expression: "{.status.phase}", value: "Running", want: true},
+		{name: "string field with another value", expression: "{.status.phase}", value: "Pending"},
+		{name: "number field compared as text", expression: "{.status.readyReplicas}", value: "3", want: true},
+		{name: "filter of a condition", expression: `{.status.conditions[?(@.type=="Synced")].status}`, value: "True", want: true},
+		{name: "filter of a condition that isn't true", expression: `{.status.conditions[?(@.type=="Healthy")].status}`, value: "True"},
+		{name: "missing field", expression: "{.status.observedGeneration}", value: "1"},
+		{name: "any value of an existing field", expression: "{.status.phase}", want: true},
+		{name: "any value of a missing field", expression: "{.status.observedGeneration}"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			expression, err := parseReadinessJSONPath(tc.expression)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, matchesJSONPath(obj, expression, tc.value))
+		})
+	}
+}
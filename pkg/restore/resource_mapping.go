@@ -0,0 +1,152 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ValidateResourceMappings checks that each of a restore's resource mappings has
+// a source and a target, that no source is mapped more than once, and that the
+// fields to prune are JSON pointers.
+func ValidateResourceMappings(mappings []velerov1api.ResourceMapping) []error {
+	var errs []error
+
+	sources := sets.NewString()
+	for _, mapping := range mappings {
+		if mapping.Source == "" || mapping.Target == "" {
+			errs = append(errs, errors.Errorf("invalid resource mapping %q to %q: source and target are required", mapping.Source, mapping.Target))
+			continue
+		}
+		if sources.Has(mapping.Source) {
+			errs = append(errs, errors.Errorf("invalid resource mapping for %s: source is mapped more than once", mapping.Source))
+		}
+		sources.Insert(mapping.Source)
+
+		for _, path := range mapping.PruneFields {
+			if !strings.HasPrefix(path, "/") {
+				errs = append(errs, errors.Errorf("invalid resource mapping for %s: prune field %q must be a JSON pointer starting with '/'", mapping.Source, path))
+			}
+		}
+	}
+
+	return errs
+}
+
+// resourceMapping is a restore resource mapping resolved against the cluster's
+// discovery information.
+type resourceMapping struct {
+	source      string
+	target      schema.GroupVersionResource
+	kind        string
+	pruneFields []string
+}
+
+// newResourceMappings returns the restore's resource mappings keyed by source
+// resource, so they can be looked up by the resource names in the backup.
+func newResourceMappings(mappings []velerov1api.ResourceMapping) map[string]velerov1api.ResourceMapping {
+	if len(mappings) == 0 {
+		return nil
+	}
+
+	res := make(map[string]velerov1api.ResourceMapping, len(mappings))
+	for _, mapping := range mappings {
+		res[mapping.Source] = mapping
+	}
+	return res
+}
+
+// resolveResourceMapping returns the resource mapping for the given backup resource,
+// or nil if there isn't one, with its target resolved via discovery. Targets are resolved when
+// they're restored, rather than up front, so that a resource whose definition is
+// restored earlier in the restore can be a target.
+func (ctx *restoreContext) resolveResourceMapping(resource string) (*resourceMapping, error) {
+	mapping, ok := ctx.resourceMappings[resource]
+	if !ok {
+		return nil, nil
+	}
+
+	target := schema.ParseGroupResource(mapping.Target).WithVersion(mapping.Version)
+	gvr, apiResource, err := ctx.discoveryHelper.ResourceFor(target)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error resolving target resource %s of resource mapping for %s", mapping.Target, resource)
+	}
+
+	return &resourceMapping{
+		source:      resource,
+		target:      gvr,
+		kind:        apiResource.Kind,
+		pruneFields: mapping.PruneFields,
+	}, nil
+}
+
+// apply converts an item of the mapping's source resource into an item of its
+// target resource, by changing its API version and kind and removing the fields
+// to prune.
+func (m *resourceMapping) apply(obj *unstructured.Unstructured) {
+	obj.SetAPIVersion(m.target.GroupVersion().String())
+	obj.SetKind(m.kind)
+
+	for _, path := range m.pruneFields {
+		removeJSONPointer(obj.Object, path)
+	}
+}
+
+// removeJSONPointer removes the field at the RFC 6901 JSON pointer from obj, if
+// it exists.
+func removeJSONPointer(obj map[string]interface{}, pointer string) {
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(tokens[i])
+	}
+
+	var cur interface{} = obj
+	for i, token := range tokens {
+		last := i == len(tokens)-1
+
+		switch v := cur.(type) {
+		case map[string]interface{}:
+			if last {
+				delete(v, token)
+				return
+			}
+			cur = v[token]
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(v) {
+				return
+			}
+			if last {
+				// removing a list entry would require replacing the list in
+				// its parent, so clear the entry instead.
+				v[index] = nil
+				return
+			}
+			cur = v[index]
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestValidateResourceMappings(t *testing.T) {
+	tests := []struct {
+		name       string
+		mappings   []velerov1api.ResourceMapping
+		wantErrors int
+	}{
+		{
+			name: "mappings with a source, a target and JSON pointer prune fields are valid",
+			mappings: []velerov1api.ResourceMapping{
+				{Source: "deploymentconfigs.apps.openshift.io", Target: "deployments.apps", PruneFields: []string{"/spec/triggers", "/spec/test"}},
+				{Source: "widgets.old.example.com", Target: "widgets.example.com", Version: "v1"},
+			},
+		},
+		{
+			name: "mappings without a source or target are invalid",
+			mappings: []velerov1api.ResourceMapping{
+				{Source: "widgets.old.example.com"},
+				{Target: "widgets.example.com"},
+			},
+			wantErrors: 2,
+		},
+		{
+			name: "a source mapped more than once is invalid",
+			mappings: []velerov1api.ResourceMapping{
+				{Source: "widgets.old.example.com", Target: "widgets.example.com"},
+				{Source: "widgets.old.example.com", Target: "gadgets.example.com"},
+			},
+			wantErrors: 1,
+		},
+		{
+			name: "prune fields that aren't JSON pointers are invalid",
+			mappings: []velerov1api.ResourceMapping{
+				{Source: "widgets.old.example.com", Target: "widgets.example.com", PruneFields: []string{"spec.triggers"}},
+			},
+			wantErrors: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Len(t, ValidateResourceMappings(tc.mappings), tc.wantErrors)
+		})
+	}
+}
+
+func TestRemoveJSONPointer(t *testing.T) {
+	newObj := func() map[string]interface{} {
+		return map[string]interface{}{
+			"spec": map[string]interface{}{
+				"triggers": []interface{}{"a", "b"},
+				"a/b":      "slash",
+				"replicas": int64(1),
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		pointer string
+		want    map[string]interface{}
+	}{
+		{
+			name:    "a nested field is removed",
+			pointer: "/spec/triggers",
+			want: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"a/b":      "slash",
+					"replicas": int64(1),
+				},
+			},
+		},
+		{
+			name:    "escaped keys are matched",
+			pointer: "/spec/a~1b",
+			want: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"triggers": []interface{}{"a", "b"},
+					"replicas": int64(1),
+				},
+			},
+		},
+		{
+			name:    "a list entry is cleared",
+			pointer: "/spec/triggers/1",
+			want: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"triggers": []interface{}{"a", nil},
+					"a/b":      "slash",
+					"replicas": int64(1),
+				},
+			},
+		},
+		{
+			name:    "a missing field is ignored",
+			pointer: "/status/conditions",
+			want:    newObj(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := newObj()
+			removeJSONPointer(obj, tc.pointer)
+			assert.Equal(t, tc.want, obj)
+		})
+	}
+}
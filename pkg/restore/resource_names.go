@@ -0,0 +1,113 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+)
+
+// ValidateIncludedResourceNames checks that every entry of a restore's included
+// resource names is formatted as <resource>/<name>.
+func ValidateIncludedResourceNames(resourceNames []string) []error {
+	var errs []error
+
+	for _, resourceName := range resourceNames {
+		if _, _, err := parseResourceName(resourceName); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+func parseResourceName(resourceName string) (string, string, error) {
+	parts := strings.SplitN(resourceName, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" || strings.Contains(parts[1], "/") {
+		return "", "", errors.Errorf("invalid resource name %q: must be formatted as <resource>/<name>", resourceName)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// resourceNameFilter is a map of group-resource strings to the names of the
+// items of that resource to restore. A nil resourceNameFilter includes every item.
+type resourceNameFilter map[string]sets.String
+
+// newResourceNameFilter builds a resourceNameFilter from a restore's included
+// resource names. Resources are resolved via discovery where possible, so that
+// short names and unqualified resource names can be used; resources that can't
+// be resolved, such as custom resources whose definitions are being restored,
+// are used as given. Invalid entries are ignored.
+func newResourceNameFilter(helper discovery.Helper, resourceNames []string) resourceNameFilter {
+	if len(resourceNames) == 0 {
+		return nil
+	}
+
+	filter := make(resourceNameFilter)
+	for _, resourceName := range resourceNames {
+		resource, name, err := parseResourceName(resourceName)
+		if err != nil {
+			continue
+		}
+
+		groupResource := schema.ParseGroupResource(resource)
+		if gvr, _, err := helper.ResourceFor(groupResource.WithVersion("")); err == nil {
+			groupResource = gvr.GroupResource()
+		}
+
+		if filter[groupResource.String()] == nil {
+			filter[groupResource.String()] = sets.NewString()
+		}
+		filter[groupResource.String()].Insert(name)
+	}
+
+	return filter
+}
+
+// includesResource returns whether any items of the given group-resource
+// should be restored.
+func (f resourceNameFilter) includesResource(groupResource string) bool {
+	if f == nil {
+		return true
+	}
+
+	_, ok := f[groupResource]
+	return ok
+}
+
+// filterItems returns the names of the items of the given group-resource
+// that should be restored.
+func (f resourceNameFilter) filterItems(groupResource string, items []string) []string {
+	if f == nil {
+		return items
+	}
+
+	var res []string
+	for _, item := range items {
+		if f[groupResource].Has(item) {
+			res = append(res, item)
+		}
+	}
+
+	return res
+}
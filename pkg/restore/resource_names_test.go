@@ -0,0 +1,57 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateIncludedResourceNames(t *testing.T) {
+	tests := []struct {
+		name          string
+		resourceNames []string
+		wantErrors    int
+	}{
+		{
+			name:          "resource/name entries are valid",
+			resourceNames: []string{"pods/pod-1", "deployments.apps/web", "widgets.example.com/widget-1"},
+		},
+		{
+			name:          "entries without a name are invalid",
+			resourceNames: []string{"pods", "pods/"},
+			wantErrors:    2,
+		},
+		{
+			name:          "entries without a resource are invalid",
+			resourceNames: []string{"/pod-1"},
+			wantErrors:    1,
+		},
+		{
+			name:          "entries with more than one slash are invalid",
+			resourceNames: []string{"pods/ns-1/pod-1"},
+			wantErrors:    1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Len(t, ValidateIncludedResourceNames(tc.resourceNames), tc.wantErrors)
+		})
+	}
+}
@@ -105,6 +105,11 @@ func (a *ResticRestoreAction) Execute(input *velero.RestoreItemActionExecuteInpu
 	}
 
 	image := getImage(log, config)
+	if isWindowsPod(&pod) {
+		if windowsImage := getWindowsImage(log, config); windowsImage != "" {
+			image = windowsImage
+		}
+	}
 	log.Infof("Using image %q", image)
 
 	cpuRequest, memRequest := getResourceRequests(log, config)
@@ -131,24 +136,51 @@ func (a *ResticRestoreAction) Execute(input *velero.RestoreItemActionExecuteInpu
 		)
 	}
 
-	runAsRoot, runAsGroup, allowPrivilegeEscalation := getSecurityContext(log, config)
+	runAsRoot, runAsGroup, allowPrivilegeEscalation, secCtx := getSecurityContext(log, config)
 
-	securityContext, err := kube.ParseSecurityContext(runAsRoot, runAsGroup, allowPrivilegeEscalation)
+	securityContext, err := kube.ParseSecurityContext(runAsRoot, runAsGroup, allowPrivilegeEscalation, secCtx)
 	if err != nil {
-		log.Errorf("Using default resource values, couldn't parse resource requirements: %s.", err)
+		log.Errorf("Using default security context, couldn't parse security context: %s.", err)
+		securityContext = corev1.SecurityContext{}
 	}
 
 	initContainerBuilder := newResticInitContainerBuilder(image, string(input.Restore.UID))
 	initContainerBuilder.Resources(&resourceReqs)
 	initContainerBuilder.SecurityContext(&securityContext)
+	if pullPolicy := getImagePullPolicy(log, config); pullPolicy != "" {
+		initContainerBuilder.PullPolicy(pullPolicy)
+	}
+	for _, secret := range getImagePullSecrets(log, config) {
+		if !hasImagePullSecret(&pod, secret) {
+			pod.Spec.ImagePullSecrets = append(pod.Spec.ImagePullSecrets, corev1.LocalObjectReference{Name: secret})
+		}
+	}
 
+	var hasBlockVolumes bool
 	for volumeName := range volumeSnapshots {
 		mount := &corev1.VolumeMount{
 			Name:      volumeName,
 			MountPath: "/restores/" + volumeName,
 		}
+
+		// raw block volumes can't be mounted, so the init container waits for their done files
+		// in a directory of the block restores emptyDir volume instead.
+		if kube.IsBlockVolume(&pod, volumeName) {
+			mount.Name = restic.BlockRestoresVolume
+			mount.SubPath = volumeName
+			hasBlockVolumes = true
+		}
+
 		initContainerBuilder.VolumeMounts(mount)
 	}
+	if hasBlockVolumes && !hasVolume(&pod, restic.BlockRestoresVolume) {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: restic.BlockRestoresVolume,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: new(corev1.EmptyDirVolumeSource),
+			},
+		})
+	}
 	initContainerBuilder.Command(getCommand(log, config))
 
 	initContainer := *initContainerBuilder.Result()
@@ -166,6 +198,16 @@ func (a *ResticRestoreAction) Execute(input *velero.RestoreItemActionExecuteInpu
 	return velero.NewRestoreItemActionExecuteOutput(&unstructured.Unstructured{Object: res}), nil
 }
 
+// hasVolume returns whether a pod has a volume with the given name.
+func hasVolume(pod *corev1.Pod, name string) bool {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func getCommand(log logrus.FieldLogger, config *corev1.ConfigMap) []string {
 	if config == nil {
 		log.Debug("No config found for plugin")
@@ -214,6 +256,34 @@ func getImage(log logrus.FieldLogger, config *corev1.ConfigMap) string {
 	}
 }
 
+// getWindowsImage extracts the restore helper image to use for pods scheduled
+// onto Windows nodes from a ConfigMap. It's empty if no Windows image is configured.
+func getWindowsImage(log logrus.FieldLogger, config *corev1.ConfigMap) string {
+	if config == nil || config.Data["windowsImage"] == "" {
+		return ""
+	}
+
+	image := config.Data["windowsImage"]
+	parts := strings.Split(image, "/")
+	if strings.Contains(parts[len(parts)-1], ":") {
+		log.WithField("image", image).Debugf("Plugin config contains Windows image name with tag")
+		return image
+	}
+
+	log.WithField("image", image).Debugf("Plugin config contains Windows image name without tag. Adding tag.")
+	return initContainerImage(image)
+}
+
+// isWindowsPod returns whether the pod is restricted to Windows nodes by its node selector.
+func isWindowsPod(pod *corev1.Pod) bool {
+	for _, key := range []string{"kubernetes.io/os", "beta.kubernetes.io/os"} {
+		if pod.Spec.NodeSelector[key] == "windows" {
+			return true
+		}
+	}
+	return false
+}
+
 // getResourceRequests extracts the CPU and memory requests from a ConfigMap.
 // The 0 values are valid if the keys are not present
 func getResourceRequests(log logrus.FieldLogger, config *corev1.ConfigMap) (string, string) {
@@ -236,14 +306,60 @@ func getResourceLimits(log logrus.FieldLogger, config *corev1.ConfigMap) (string
 	return config.Data["cpuLimit"], config.Data["memLimit"]
 }
 
-// getSecurityContext extracts securityContext runAsUser, runAsGroup, and allowPrivilegeEscalation from a ConfigMap.
-func getSecurityContext(log logrus.FieldLogger, config *corev1.ConfigMap) (string, string, string) {
+// getSecurityContext extracts securityContext runAsUser, runAsGroup, and allowPrivilegeEscalation, and
+// a whole securityContext in YAML, from a ConfigMap.
+func getSecurityContext(log logrus.FieldLogger, config *corev1.ConfigMap) (string, string, string, string) {
 	if config == nil {
 		log.Debug("No config found for plugin")
-		return "", "", ""
+		return "", "", "", ""
+	}
+
+	return config.Data["secCtxRunAsUser"], config.Data["secCtxRunAsGroup"], config.Data["secCtxAllowPrivilegeEscalation"], config.Data["secCtx"]
+}
+
+// getImagePullPolicy extracts the image pull policy from a ConfigMap. It's empty if the
+// key is not present or its value is invalid.
+func getImagePullPolicy(log logrus.FieldLogger, config *corev1.ConfigMap) corev1.PullPolicy {
+	if config == nil || config.Data["imagePullPolicy"] == "" {
+		return ""
+	}
+
+	pullPolicy := corev1.PullPolicy(config.Data["imagePullPolicy"])
+	switch pullPolicy {
+	case corev1.PullAlways, corev1.PullIfNotPresent, corev1.PullNever:
+		return pullPolicy
 	}
 
-	return config.Data["secCtxRunAsUser"], config.Data["secCtxRunAsGroup"], config.Data["secCtxAllowPrivilegeEscalation"]
+	log.Errorf("Using default image pull policy, invalid image pull policy %q.", pullPolicy)
+	return ""
+}
+
+// getImagePullSecrets extracts the names of the secrets to pull the image with from a
+// ConfigMap, as a comma-separated list.
+func getImagePullSecrets(log logrus.FieldLogger, config *corev1.ConfigMap) []string {
+	if config == nil || config.Data["imagePullSecrets"] == "" {
+		return nil
+	}
+
+	var secrets []string
+	for _, secret := range strings.Split(config.Data["imagePullSecrets"], ",") {
+		if secret = strings.TrimSpace(secret); secret != "" {
+			secrets = append(secrets, secret)
+		}
+	}
+
+	log.Debugf("Using image pull secrets %v", secrets)
+	return secrets
+}
+
+// hasImagePullSecret returns whether a pod pulls its images with the named secret.
+func hasImagePullSecret(pod *corev1.Pod, name string) bool {
+	for _, secret := range pod.Spec.ImagePullSecrets {
+		if secret.Name == name {
+			return true
+		}
+	}
+	return false
 }
 
 // TODO eventually this can move to pkg/plugin/framework since it'll be used across multiple
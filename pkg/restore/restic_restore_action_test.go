@@ -37,6 +37,7 @@ import (
 	velerofake "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -111,7 +112,7 @@ func TestResticRestoreActionExecute(t *testing.T) {
 		defaultCPURequestLimit, defaultMemRequestLimit, // limits
 	)
 
-	securityContext, _ := kube.ParseSecurityContext("", "", "")
+	securityContext, _ := kube.ParseSecurityContext("", "", "", "")
 
 	var (
 		restoreName = "my-restore"
@@ -123,6 +124,7 @@ func TestResticRestoreActionExecute(t *testing.T) {
 		name             string
 		pod              *corev1api.Pod
 		podVolumeBackups []*velerov1api.PodVolumeBackup
+		configMap        *corev1api.ConfigMap
 		want             *corev1api.Pod
 	}{
 		{
@@ -200,6 +202,73 @@ func TestResticRestoreActionExecute(t *testing.T) {
 					builder.ForContainer("first-container", "").Result()).
 				Result(),
 		},
+		{
+			name: "Restoring pod with a block volume mounts a directory of the block restores volume in the restic initContainer instead of the volume",
+			pod: builder.ForPod("ns-1", "my-pod").
+				Volumes(
+					builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+					builder.ForVolume("vol-2").PersistentVolumeClaimSource("pvc-2").Result(),
+				).
+				Containers(builder.ForContainer("my-container", "").VolumeDevices(&corev1api.VolumeDevice{Name: "vol-2", DevicePath: "/dev/xvda"}).Result()).
+				Result(),
+			podVolumeBackups: []*velerov1api.PodVolumeBackup{
+				builder.ForPodVolumeBackup(veleroNs, "pvb-1").
+					PodName("my-pod").
+					Volume("vol-1").
+					ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, backupName)).
+					SnapshotID("foo").
+					Result(),
+				builder.ForPodVolumeBackup(veleroNs, "pvb-2").
+					PodName("my-pod").
+					Volume("vol-2").
+					ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, backupName)).
+					SnapshotID("bar").
+					Result(),
+			},
+			want: builder.ForPod("ns-1", "my-pod").
+				Volumes(
+					builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+					builder.ForVolume("vol-2").PersistentVolumeClaimSource("pvc-2").Result(),
+					builder.ForVolume("velero-block-restores").EmptyDirSource().Result(),
+				).
+				Containers(builder.ForContainer("my-container", "").VolumeDevices(&corev1api.VolumeDevice{Name: "vol-2", DevicePath: "/dev/xvda"}).Result()).
+				InitContainers(
+					newResticInitContainerBuilder(initContainerImage(defaultImageBase), "").
+						Resources(&resourceReqs).
+						SecurityContext(&securityContext).
+						VolumeMounts(
+							builder.ForVolumeMount("velero-block-restores", "/restores/vol-2").SubPath("vol-2").Result(),
+							builder.ForVolumeMount("vol-1", "/restores/vol-1").Result(),
+						).
+						Command([]string{"/velero-restic-restore-helper"}).Result()).
+				Result(),
+		},
+		{
+			name: "Restoring pod with a plugin config uses its image pull policy, image pull secrets and security context",
+			pod: builder.ForPod("ns-1", "my-pod").ObjectMeta(
+				builder.WithAnnotations("snapshot.velero.io/myvol", "")).
+				Result(),
+			configMap: builder.ForConfigMap(veleroNs, "restic-config").
+				ObjectMeta(builder.WithLabels("velero.io/plugin-config", "", "velero.io/restic", "RestoreItemAction")).
+				Data(
+					"image", "myregistry.io:5000/helper:v1",
+					"imagePullPolicy", "Always",
+					"imagePullSecrets", "registry-1, registry-2",
+					"secCtx", "runAsNonRoot: true\nreadOnlyRootFilesystem: true",
+				).
+				Result(),
+			want: builder.ForPod("ns-1", "my-pod").
+				ObjectMeta(
+					builder.WithAnnotations("snapshot.velero.io/myvol", "")).
+				ImagePullSecrets("registry-1", "registry-2").
+				InitContainers(
+					newResticInitContainerBuilder("myregistry.io:5000/helper:v1", "").
+						Resources(&resourceReqs).
+						SecurityContext(&corev1api.SecurityContext{RunAsNonRoot: boolptr.True(), ReadOnlyRootFilesystem: boolptr.True()}).
+						PullPolicy(corev1api.PullAlways).
+						VolumeMounts(builder.ForVolumeMount("myvol", "/restores/myvol").Result()).
+						Command([]string{"/velero-restic-restore-helper"}).Result()).Result(),
+		},
 	}
 
 	for _, tc := range tests {
@@ -207,6 +276,11 @@ func TestResticRestoreActionExecute(t *testing.T) {
 			clientset := fake.NewSimpleClientset()
 			clientsetVelero := velerofake.NewSimpleClientset()
 
+			if tc.configMap != nil {
+				_, err := clientset.CoreV1().ConfigMaps(veleroNs).Create(context.TODO(), tc.configMap, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+
 			for _, podVolumeBackup := range tc.podVolumeBackups {
 				_, err := clientsetVelero.VeleroV1().PodVolumeBackups(veleroNs).Create(context.TODO(), podVolumeBackup, metav1.CreateOptions{})
 				require.NoError(t, err)
@@ -296,3 +370,58 @@ func TestGetCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestGetImagePullPolicy(t *testing.T) {
+	testCases := []struct {
+		name      string
+		configMap *corev1api.ConfigMap
+		expected  corev1api.PullPolicy
+	}{
+		{
+			name:      "should get no pull policy when config is nil",
+			configMap: nil,
+			expected:  "",
+		},
+		{
+			name:      "should get pull policy from config",
+			configMap: builder.ForConfigMap("velero", "config").Data("imagePullPolicy", "IfNotPresent").Result(),
+			expected:  corev1api.PullIfNotPresent,
+		},
+		{
+			name:      "should get no pull policy when config's is invalid",
+			configMap: builder.ForConfigMap("velero", "config").Data("imagePullPolicy", "Sometimes").Result(),
+			expected:  "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, getImagePullPolicy(velerotest.NewLogger(), tc.configMap))
+		})
+	}
+}
+
+func TestGetImagePullSecrets(t *testing.T) {
+	testCases := []struct {
+		name      string
+		configMap *corev1api.ConfigMap
+		expected  []string
+	}{
+		{
+			name:      "should get no secrets when config is nil",
+			configMap: nil,
+			expected:  nil,
+		},
+		{
+			name:      "should get secrets from config, ignoring whitespace and empty names",
+			configMap: builder.ForConfigMap("velero", "config").Data("imagePullSecrets", "registry-1, registry-2,,").Result(),
+			expected:  []string{"registry-1", "registry-2"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, getImagePullSecrets(velerotest.NewLogger(), tc.configMap))
+		})
+	}
+}
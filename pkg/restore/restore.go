@@ -31,20 +31,24 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/version"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	storagev1client "k8s.io/client-go/kubernetes/typed/storage/v1"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/hook"
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/client"
@@ -52,6 +56,7 @@ import (
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
@@ -69,11 +74,33 @@ type VolumeSnapshotterGetter interface {
 type Request struct {
 	*velerov1api.Restore
 
-	Log              logrus.FieldLogger
-	Backup           *velerov1api.Backup
-	PodVolumeBackups []*velerov1api.PodVolumeBackup
-	VolumeSnapshots  []*volume.Snapshot
-	BackupReader     io.Reader
+	Log               logrus.FieldLogger
+	Backup            *velerov1api.Backup
+	PodVolumeBackups  []*velerov1api.PodVolumeBackup
+	VolumeSnapshots   []*volume.Snapshot
+	BackupReader      io.Reader
+	ResourceModifiers *resourcemodifiers.ResourceModifiers
+
+	// DryRunReport, if non-nil, is populated with the restore's plan when
+	// the restore is a dry run.
+	DryRunReport *DryRunReport
+
+	// ConflictReport, if non-nil, is populated with an entry for each item that
+	// already existed in the cluster and was different than the backed-up version,
+	// saying how the conflict was handled.
+	ConflictReport *Result
+
+	// ItemCounts, if non-nil, is populated with counts of the items the restore
+	// handled in particular ways.
+	ItemCounts *ItemCounts
+
+	// RenamedPVs, if non-nil, is populated with a map of the original names of
+	// persistent volumes that are renamed during the restore to their new names.
+	RenamedPVs map[string]string
+
+	// HookTracker, if non-nil, records the outcome of the restore's
+	// post-restore exec hooks.
+	HookTracker *hook.HookTracker
 }
 
 // Restorer knows how to restore a backup.
@@ -91,34 +118,41 @@ type kubernetesRestorer struct {
 	discoveryHelper            discovery.Helper
 	dynamicFactory             client.DynamicFactory
 	namespaceClient            corev1.NamespaceInterface
+	storageClassClient         storagev1client.StorageClassInterface
 	resticRestorerFactory      restic.RestorerFactory
 	resticTimeout              time.Duration
 	resourceTerminatingTimeout time.Duration
-	resourcePriorities         []string
+	resourcePriorities         Priorities
 	fileSystem                 filesystem.Interface
 	pvRenamer                  func(string) (string, error)
 	logger                     logrus.FieldLogger
 	podCommandExecutor         podexec.PodCommandExecutor
 	podGetter                  cache.Getter
+	credentialFileStore        credentials.FileStore
+	snapshotLimiter            *clientmgmt.SnapshotLimiter
 }
 
 // NewKubernetesRestorer creates a new kubernetesRestorer.
 func NewKubernetesRestorer(
 	discoveryHelper discovery.Helper,
 	dynamicFactory client.DynamicFactory,
-	resourcePriorities []string,
+	resourcePriorities Priorities,
 	namespaceClient corev1.NamespaceInterface,
+	storageClassClient storagev1client.StorageClassInterface,
 	resticRestorerFactory restic.RestorerFactory,
 	resticTimeout time.Duration,
 	resourceTerminatingTimeout time.Duration,
 	logger logrus.FieldLogger,
 	podCommandExecutor podexec.PodCommandExecutor,
 	podGetter cache.Getter,
+	credentialFileStore credentials.FileStore,
+	snapshotLimiter *clientmgmt.SnapshotLimiter,
 ) (Restorer, error) {
 	return &kubernetesRestorer{
 		discoveryHelper:            discoveryHelper,
 		dynamicFactory:             dynamicFactory,
 		namespaceClient:            namespaceClient,
+		storageClassClient:         storageClassClient,
 		resticRestorerFactory:      resticRestorerFactory,
 		resticTimeout:              resticTimeout,
 		resourceTerminatingTimeout: resourceTerminatingTimeout,
@@ -132,9 +166,11 @@ func NewKubernetesRestorer(
 			veleroCloneName := "velero-clone-" + veleroCloneUuid.String()
 			return veleroCloneName, nil
 		},
-		fileSystem:         filesystem.NewFileSystem(),
-		podCommandExecutor: podCommandExecutor,
-		podGetter:          podGetter,
+		fileSystem:          filesystem.NewFileSystem(),
+		podCommandExecutor:  podCommandExecutor,
+		podGetter:           podGetter,
+		credentialFileStore: credentialFileStore,
+		snapshotLimiter:     snapshotLimiter,
 	}, nil
 }
 
@@ -169,6 +205,14 @@ func (kr *kubernetesRestorer) Restore(
 		Includes(req.Restore.Spec.IncludedNamespaces...).
 		Excludes(req.Restore.Spec.ExcludedNamespaces...)
 
+	// get the includes-excludes for resources whose status should be restored.
+	// Unlike the other includes-excludes, an empty includes list means that
+	// the status of no resources is restored.
+	var statusIncludesExcludes *collections.IncludesExcludes
+	if req.Restore.Spec.RestoreStatus != nil && len(req.Restore.Spec.RestoreStatus.IncludedResources) > 0 {
+		statusIncludesExcludes = collections.GetResourceIncludesExcludes(kr.discoveryHelper, req.Restore.Spec.RestoreStatus.IncludedResources, req.Restore.Spec.RestoreStatus.ExcludedResources)
+	}
+
 	resolvedActions, err := resolveActions(actions, kr.discoveryHelper)
 	if err != nil {
 		return Result{}, Result{Velero: []string{err.Error()}}
@@ -205,6 +249,7 @@ func (kr *kubernetesRestorer) Restore(
 		ListWatchFactory: &hook.DefaultListWatchFactory{
 			PodsGetter: kr.podGetter,
 		},
+		HookTracker: req.HookTracker,
 	}
 
 	pvRestorer := &pvRestorer{
@@ -212,9 +257,45 @@ func (kr *kubernetesRestorer) Restore(
 		backup:                  req.Backup,
 		snapshotVolumes:         req.Backup.Spec.SnapshotVolumes,
 		restorePVs:              req.Restore.Spec.RestorePVs,
+		zoneMapping:             req.Restore.Spec.ZoneMapping,
+		volumeOverrides:         req.Restore.Spec.SnapshotVolumeOverrides,
 		volumeSnapshots:         req.VolumeSnapshots,
 		volumeSnapshotterGetter: volumeSnapshotterGetter,
 		snapshotLocationLister:  snapshotLocationLister,
+		credentialFileStore:     kr.credentialFileStore,
+		snapshotLimiter:         kr.snapshotLimiter,
+	}
+
+	dryRunReport := req.DryRunReport
+	if dryRunReport == nil {
+		dryRunReport = new(DryRunReport)
+	}
+
+	conflictReport := req.ConflictReport
+	if conflictReport == nil {
+		conflictReport = new(Result)
+	}
+
+	itemCounts := req.ItemCounts
+	if itemCounts == nil {
+		itemCounts = new(ItemCounts)
+	}
+
+	renamedPVs := req.RenamedPVs
+	if renamedPVs == nil {
+		renamedPVs = make(map[string]string)
+	}
+
+	// The restore's priorities override the server's, list by list, so a restore that only
+	// sets low priorities keeps the server's high priorities, and vice versa.
+	resourcePriorities := kr.resourcePriorities
+	if p := req.Restore.Spec.ResourcePriorities; p != nil {
+		if p.HighPriorities != nil {
+			resourcePriorities.HighPriorities = p.HighPriorities
+		}
+		if p.LowPriorities != nil {
+			resourcePriorities.LowPriorities = p.LowPriorities
+		}
 	}
 
 	restoreCtx := &restoreContext{
@@ -223,11 +304,21 @@ func (kr *kubernetesRestorer) Restore(
 		restore:                    req.Restore,
 		resourceIncludesExcludes:   resourceIncludesExcludes,
 		namespaceIncludesExcludes:  namespaceIncludesExcludes,
+		resourceNames:              newResourceNameFilter(kr.discoveryHelper, req.Restore.Spec.IncludedResourceNames),
+		statusIncludesExcludes:     statusIncludesExcludes,
+		readinessGates:             newReadinessGates(kr.discoveryHelper, req.Restore.Spec.ReadinessGates),
+		stripFinalizers:            newStripIncludesExcludes(kr.discoveryHelper, req.Restore.Spec.StripFinalizers),
+		stripOwnerReferences:       newStripIncludesExcludes(kr.discoveryHelper, req.Restore.Spec.StripOwnerReferences),
+		resourceMappings:           newResourceMappings(req.Restore.Spec.ResourceMappings),
+		conflictPolicies:           newConflictPolicies(kr.discoveryHelper, req.Restore.Spec.ConflictPolicies),
+		conflictReport:             conflictReport,
+		itemCounts:                 itemCounts,
 		selector:                   selector,
 		log:                        req.Log,
-		dynamicFactory:             kr.dynamicFactory,
+		dynamicFactory:             newRateLimitedDynamicFactory(kr.dynamicFactory, req.Restore.Spec.APIRateLimit),
 		fileSystem:                 kr.fileSystem,
 		namespaceClient:            kr.namespaceClient,
+		storageClassClient:         kr.storageClassClient,
 		actions:                    resolvedActions,
 		volumeSnapshotterGetter:    volumeSnapshotterGetter,
 		resticRestorer:             resticRestorer,
@@ -239,15 +330,21 @@ func (kr *kubernetesRestorer) Restore(
 		resourceTerminatingTimeout: kr.resourceTerminatingTimeout,
 		resourceClients:            make(map[resourceClientKey]client.Dynamic),
 		restoredItems:              make(map[velero.ResourceIdentifier]struct{}),
-		renamedPVs:                 make(map[string]string),
+		restoredUIDs:               make(map[types.UID]types.UID),
+		renamedPVs:                 renamedPVs,
+		pvStorageClasses:           make(map[string]string),
 		pvRenamer:                  kr.pvRenamer,
 		discoveryHelper:            kr.discoveryHelper,
-		resourcePriorities:         kr.resourcePriorities,
+		resourcePriorities:         resourcePriorities,
 		resourceRestoreHooks:       resourceRestoreHooks,
 		hooksErrs:                  make(chan error),
 		waitExecHookHandler:        waitExecHookHandler,
 		hooksContext:               hooksCtx,
 		hooksCancelFunc:            hooksCancelFunc,
+		resourceModifiers:          req.ResourceModifiers,
+		dryRun:                     req.Restore.Spec.DryRun,
+		dryRunReport:               dryRunReport,
+		dryRunNamespaces:           sets.NewString(),
 	}
 
 	return restoreCtx.execute()
@@ -300,11 +397,23 @@ type restoreContext struct {
 	restoreDir                 string
 	resourceIncludesExcludes   *collections.IncludesExcludes
 	namespaceIncludesExcludes  *collections.IncludesExcludes
+	resourceNames              resourceNameFilter
+	statusIncludesExcludes     *collections.IncludesExcludes
+	readinessGates             map[string]readinessGate
+	pendingReadyItems          []pendingReadyItem
+	stripFinalizers            *collections.IncludesExcludes
+	stripOwnerReferences       *collections.IncludesExcludes
+	resourceMappings           map[string]velerov1api.ResourceMapping
+	conflictPolicies           *conflictPolicies
+	conflictReport             *Result
+	itemCounts                 *ItemCounts
+	abortedOnConflict          bool
 	selector                   labels.Selector
 	log                        logrus.FieldLogger
 	dynamicFactory             client.DynamicFactory
 	fileSystem                 filesystem.Interface
 	namespaceClient            corev1.NamespaceInterface
+	storageClassClient         storagev1client.StorageClassInterface
 	actions                    []resolvedAction
 	volumeSnapshotterGetter    VolumeSnapshotterGetter
 	resticRestorer             restic.Restorer
@@ -317,16 +426,22 @@ type restoreContext struct {
 	resourceTerminatingTimeout time.Duration
 	resourceClients            map[resourceClientKey]client.Dynamic
 	restoredItems              map[velero.ResourceIdentifier]struct{}
+	restoredUIDs               map[types.UID]types.UID
 	renamedPVs                 map[string]string
+	pvStorageClasses           map[string]string
 	pvRenamer                  func(string) (string, error)
 	discoveryHelper            discovery.Helper
-	resourcePriorities         []string
+	resourcePriorities         Priorities
 	hooksWaitGroup             sync.WaitGroup
 	hooksErrs                  chan error
 	resourceRestoreHooks       []hook.ResourceRestoreHook
 	waitExecHookHandler        hook.WaitExecHookHandler
 	hooksContext               go_context.Context
 	hooksCancelFunc            go_context.CancelFunc
+	resourceModifiers          *resourcemodifiers.ResourceModifiers
+	dryRun                     bool
+	dryRunReport               *DryRunReport
+	dryRunNamespaces           sets.String
 }
 
 type resourceClientKey struct {
@@ -334,19 +449,37 @@ type resourceClientKey struct {
 	namespace string
 }
 
+// Priorities defines the order in which resources are restored. Resources in HighPriorities
+// are restored first, in order, and resources in LowPriorities are restored last, in order.
+// All other resources in the backup are restored alphabetically in between.
+type Priorities struct {
+	HighPriorities []string
+	LowPriorities  []string
+}
+
 // getOrderedResources returns an ordered list of resource identifiers to restore, based on the provided resource
-// priorities and backup contents. The returned list begins with all of the prioritized resources (in order), and
-// appends to that an alphabetized list of all resources in the backup.
-func getOrderedResources(resourcePriorities []string, backupResources map[string]*archive.ResourceItems) []string {
-	// alphabetize resources in the backup
+// priorities and backup contents. The returned list begins with all of the high-priority resources (in order),
+// appends to that an alphabetized list of all resources in the backup that aren't low-priority resources, and
+// ends with all of the low-priority resources (in order).
+func getOrderedResources(resourcePriorities Priorities, backupResources map[string]*archive.ResourceItems) []string {
+	lowPriorities := sets.NewString(resourcePriorities.LowPriorities...)
+
+	// alphabetize resources in the backup, leaving out the low-priority ones
 	orderedBackupResources := make([]string, 0, len(backupResources))
 	for resource := range backupResources {
+		if lowPriorities.Has(resource) {
+			continue
+		}
 		orderedBackupResources = append(orderedBackupResources, resource)
 	}
 	sort.Strings(orderedBackupResources)
 
-	// main list: everything in resource priorities, followed by what's in the backup (alphabetized)
-	return append(resourcePriorities, orderedBackupResources...)
+	// main list: everything in high priorities, followed by what's in the backup (alphabetized),
+	// followed by everything in low priorities
+	list := make([]string, 0, len(resourcePriorities.HighPriorities)+len(orderedBackupResources)+len(resourcePriorities.LowPriorities))
+	list = append(list, resourcePriorities.HighPriorities...)
+	list = append(list, orderedBackupResources...)
+	return append(list, resourcePriorities.LowPriorities...)
 }
 
 func (ctx *restoreContext) execute() (Result, Result) {
@@ -387,19 +520,47 @@ func (ctx *restoreContext) execute() (Result, Result) {
 	// Since we keep track of the fully-resolved group-resources that we *have* restored, we won't try to restore a
 	// resource twice even if it's in the ordered list twice.
 	for _, resource := range getOrderedResources(ctx.resourcePriorities, backupResources) {
-		// try to resolve the resource via discovery to a complete group/version/resource
-		gvr, _, err := ctx.discoveryHelper.ResourceFor(schema.ParseGroupResource(resource).WithVersion(""))
+		// stop restoring items if an item conflicted with the in-cluster version
+		// and its conflict policy is fail-fast.
+		if ctx.abortedOnConflict {
+			ctx.log.Info("Not restoring any more resources because an item conflicted with the in-cluster version and its conflict policy is fail-fast")
+			break
+		}
+
+		// if the restore maps the resource to a different one, restore its items
+		// as items of the target resource.
+		mapping, err := ctx.resolveResourceMapping(resource)
 		if err != nil {
-			ctx.log.WithField("resource", resource).Infof("Skipping restore of resource because it cannot be resolved via discovery")
+			errs.AddVeleroError(err)
 			continue
 		}
-		groupResource := gvr.GroupResource()
+
+		var groupResource schema.GroupResource
+		if mapping != nil {
+			groupResource = mapping.target.GroupResource()
+			ctx.log.WithField("resource", resource).Infof("Restoring resource as %s according to the restore's resource mappings", groupResource)
+		} else {
+			// try to resolve the resource via discovery to a complete group/version/resource
+			gvr, _, err := ctx.discoveryHelper.ResourceFor(schema.ParseGroupResource(resource).WithVersion(""))
+			if err != nil {
+				ctx.log.WithField("resource", resource).Infof("Skipping restore of resource because it cannot be resolved via discovery")
+				continue
+			}
+			groupResource = gvr.GroupResource()
+		}
+
+		// the name of the resource in the backup, which is the target resource
+		// unless the resource is mapped.
+		backupResource := groupResource.String()
+		if mapping != nil {
+			backupResource = resource
+		}
 
 		// check if we've already restored this resource (this would happen if the resource
 		// we're currently looking at was already restored because it was a prioritized
 		// resource, and now we're looking at it as part of the backup contents).
-		if processedResources.Has(groupResource.String()) {
-			ctx.log.WithField("resource", groupResource.String()).Debugf("Skipping restore of resource because it's already been processed")
+		if processedResources.Has(backupResource) {
+			ctx.log.WithField("resource", backupResource).Debugf("Skipping restore of resource because it's already been processed")
 			continue
 		}
 
@@ -409,6 +570,12 @@ func (ctx *restoreContext) execute() (Result, Result) {
 			continue
 		}
 
+		// check if any items of the resource are named in the restore's included resource names
+		if !ctx.resourceNames.includesResource(groupResource.String()) {
+			ctx.log.WithField("resource", groupResource.String()).Infof("Skipping restore of resource because the restore spec doesn't include any of its items by name")
+			continue
+		}
+
 		// we don't want to explicitly restore namespace API objs because we'll handle
 		// them as a special case prior to restoring anything into them
 		if groupResource == kuberesource.Namespaces {
@@ -416,9 +583,9 @@ func (ctx *restoreContext) execute() (Result, Result) {
 		}
 
 		// check if the resource is present in the backup
-		resourceList := backupResources[groupResource.String()]
+		resourceList := backupResources[backupResource]
 		if resourceList == nil {
-			ctx.log.WithField("resource", groupResource.String()).Debugf("Skipping restore of resource because it's not present in the backup tarball")
+			ctx.log.WithField("resource", backupResource).Debugf("Skipping restore of resource because it's not present in the backup tarball")
 			continue
 		}
 
@@ -430,10 +597,15 @@ func (ctx *restoreContext) execute() (Result, Result) {
 				continue
 			}
 
+			items = ctx.resourceNames.filterItems(groupResource.String(), items)
+			if len(items) == 0 {
+				continue
+			}
+
 			// get target namespace to restore into, if different
 			// from source namespace
 			targetNamespace := namespace
-			if target, ok := ctx.restore.Spec.NamespaceMapping[namespace]; ok {
+			if target, ok := mapNamespace(ctx.restore.Spec.NamespaceMapping, namespace); ok {
 				targetNamespace = target
 			}
 
@@ -444,7 +616,8 @@ func (ctx *restoreContext) execute() (Result, Result) {
 			if namespace != "" && !existingNamespaces.Has(targetNamespace) {
 				logger := ctx.log.WithField("namespace", namespace)
 				ns := getNamespace(logger, archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", namespace), targetNamespace)
-				if _, err := kube.EnsureNamespaceExistsAndIsReady(ns, ctx.namespaceClient, ctx.resourceTerminatingTimeout); err != nil {
+				addItemMetadata(ns, ctx.restore.Spec.ItemLabels, ctx.restore.Spec.ItemAnnotations)
+				if err := ctx.ensureNamespace(ns); err != nil {
 					errs.AddVeleroError(err)
 					continue
 				}
@@ -454,13 +627,18 @@ func (ctx *restoreContext) execute() (Result, Result) {
 				existingNamespaces.Insert(targetNamespace)
 			}
 
-			w, e := ctx.restoreResource(groupResource.String(), targetNamespace, namespace, items)
+			w, e := ctx.restoreResource(backupResource, mapping, targetNamespace, namespace, items)
 			warnings.Merge(&w)
 			errs.Merge(&e)
 		}
 
 		// record that we've restored the resource
-		processedResources.Insert(groupResource.String())
+		processedResources.Insert(backupResource)
+
+		// wait for restored items with readiness gates to become ready before
+		// restoring any resources that may depend on them.
+		w := ctx.waitForReadiness()
+		warnings.Merge(&w)
 
 		// if we just restored custom resource definitions (CRDs), refresh discovery
 		// because the restored CRDs may have created new APIs that didn't previously
@@ -699,7 +877,8 @@ func (ctx *restoreContext) crdAvailable(name string, crdClient client.Dynamic) (
 
 // restoreResource restores the specified cluster or namespace scoped resource. If namespace is
 // empty we are restoring a cluster level resource, otherwise into the specified namespace.
-func (ctx *restoreContext) restoreResource(resource, targetNamespace, originalNamespace string, items []string) (Result, Result) {
+// If mapping is non-nil, the items are restored as items of the mapping's target resource.
+func (ctx *restoreContext) restoreResource(resource string, mapping *resourceMapping, targetNamespace, originalNamespace string, items []string) (Result, Result) {
 	warnings, errs := Result{}, Result{}
 
 	if targetNamespace == "" && boolptr.IsSetToFalse(ctx.restore.Spec.IncludeClusterResources) {
@@ -723,6 +902,9 @@ func (ctx *restoreContext) restoreResource(resource, targetNamespace, originalNa
 	}
 
 	groupResource := schema.ParseGroupResource(resource)
+	if mapping != nil {
+		groupResource = mapping.target.GroupResource()
+	}
 
 	for _, item := range items {
 		itemPath := archive.GetItemFilePath(ctx.restoreDir, resource, originalNamespace, item)
@@ -737,6 +919,10 @@ func (ctx *restoreContext) restoreResource(resource, targetNamespace, originalNa
 			continue
 		}
 
+		if mapping != nil {
+			mapping.apply(obj)
+		}
+
 		w, e := ctx.restoreItem(obj, groupResource, targetNamespace)
 		warnings.Merge(&w)
 		errs.Merge(&e)
@@ -773,6 +959,62 @@ func (ctx *restoreContext) getResourceClient(groupResource schema.GroupResource,
 	return client, nil
 }
 
+// chooseAPIVersion returns the API version that an item of the given group-resource,
+// backed up with backupVersion, should be restored with. If the cluster serves the
+// resource with backupVersion, it's returned unchanged. Otherwise, the API group's
+// preferred version is used if it provides the resource, falling back to the
+// highest-priority served version that does, as ordered by the Kubernetes
+// version-priority rules.
+func (ctx *restoreContext) chooseAPIVersion(groupResource schema.GroupResource, backupVersion string) (string, error) {
+	if ctx.servesResource(groupResource, backupVersion) {
+		return backupVersion, nil
+	}
+
+	var group *metav1.APIGroup
+	groups := ctx.discoveryHelper.APIGroups()
+	for i := range groups {
+		if groups[i].Name == groupResource.Group {
+			group = &groups[i]
+			break
+		}
+	}
+
+	// if the group isn't known, there's nothing to choose from, so let
+	// the restore proceed with the backed-up version.
+	if group == nil {
+		return backupVersion, nil
+	}
+
+	var candidates []string
+	for _, v := range group.Versions {
+		if v.Version != group.PreferredVersion.Version && v.Version != backupVersion {
+			candidates = append(candidates, v.Version)
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return version.CompareKubeAwareVersionStrings(candidates[i], candidates[j]) > 0
+	})
+	if group.PreferredVersion.Version != "" && group.PreferredVersion.Version != backupVersion {
+		candidates = append([]string{group.PreferredVersion.Version}, candidates...)
+	}
+
+	for _, candidate := range candidates {
+		if ctx.servesResource(groupResource, candidate) {
+			return candidate, nil
+		}
+	}
+
+	return "", errors.Errorf("the cluster does not serve API version %s of %s, or any other version that provides it", backupVersion, groupResource)
+}
+
+// servesResource returns whether the cluster serves the group-resource with the
+// given API version.
+func (ctx *restoreContext) servesResource(groupResource schema.GroupResource, version string) bool {
+	gvr, _, err := ctx.discoveryHelper.ResourceFor(groupResource.WithVersion(version))
+	return err == nil && gvr.Version == version
+}
+
 func getResourceID(groupResource schema.GroupResource, namespace, name string) string {
 	if namespace == "" {
 		return fmt.Sprintf("%s/%s", groupResource.String(), name)
@@ -785,6 +1027,10 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	warnings, errs := Result{}, Result{}
 	resourceID := getResourceID(groupResource, namespace, obj.GetName())
 
+	if ctx.abortedOnConflict {
+		return warnings, errs
+	}
+
 	// Check if group/resource should be restored. We need to do this here since
 	// this method may be getting called for an additional item which is a group/resource
 	// that's excluded.
@@ -817,7 +1063,8 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		// which the resource is being restored into exists.
 		// This is the *remapped* namespace that we are ensuring exists.
 		nsToEnsure := getNamespace(ctx.log, archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", obj.GetNamespace()), namespace)
-		if _, err := kube.EnsureNamespaceExistsAndIsReady(nsToEnsure, ctx.namespaceClient, ctx.resourceTerminatingTimeout); err != nil {
+		addItemMetadata(nsToEnsure, ctx.restore.Spec.ItemLabels, ctx.restore.Spec.ItemAnnotations)
+		if err := ctx.ensureNamespace(nsToEnsure); err != nil {
 			errs.AddVeleroError(err)
 			return warnings, errs
 		}
@@ -843,6 +1090,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	}
 	if complete {
 		ctx.log.Infof("%s is complete - skipping", kube.NamespaceAndName(obj))
+		ctx.recordDryRun(groupResource, namespace, obj.GetName(), DryRunActionSkip, "item is complete")
 		return warnings, errs
 	}
 
@@ -863,9 +1111,41 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	// TODO: move to restore item action if/when we add a ShouldRestore() method to the interface
 	if groupResource == kuberesource.Pods && obj.GetAnnotations()[v1.MirrorPodAnnotationKey] != "" {
 		ctx.log.Infof("Not restoring pod because it's a mirror pod")
+		ctx.recordDryRun(groupResource, namespace, name, DryRunActionSkip, "pod is a mirror pod")
 		return warnings, errs
 	}
 
+	// restore the item's owners before the item, unless its owner references are
+	// removed, so that its owner references can be updated to refer to them.
+	if !shouldStrip(ctx.stripOwnerReferences, groupResource) {
+		w, e := ctx.restoreOwners(obj)
+		warnings.Merge(&w)
+		errs.Merge(&e)
+	}
+
+	// if the cluster doesn't serve the version of the API that the item was backed up
+	// with, restore it using a version that is served.
+	restoreVersion, err := ctx.chooseAPIVersion(groupResource, obj.GroupVersionKind().Version)
+	if err != nil {
+		errs.Add(namespace, errors.Wrapf(err, "error restoring %s", resourceID))
+		return warnings, errs
+	}
+	if backupVersion := obj.GroupVersionKind().Version; restoreVersion != backupVersion {
+		converted, err := convertAPIVersion(obj, groupResource, backupVersion, restoreVersion)
+		if err != nil {
+			errs.Add(namespace, errors.Wrapf(err, "error restoring %s", resourceID))
+			return warnings, errs
+		}
+
+		ctx.log.Infof("Restoring %s with API version %s because the cluster does not serve backed-up version %s", resourceID, restoreVersion, backupVersion)
+		if converted {
+			warnings.Add(namespace, errors.Errorf("%s was backed up with API version %s, which the cluster does not serve; restoring it converted to API version %s", resourceID, backupVersion, restoreVersion))
+		} else {
+			warnings.Add(namespace, errors.Errorf("%s was backed up with API version %s, which the cluster does not serve; restoring it with API version %s", resourceID, backupVersion, restoreVersion))
+		}
+		obj.SetAPIVersion(schema.GroupVersion{Group: groupResource.Group, Version: restoreVersion}.String())
+	}
+
 	resourceClient, err := ctx.getResourceClient(groupResource, obj, namespace)
 	if err != nil {
 		errs.AddVeleroError(fmt.Errorf("error getting resource client for namespace %q, resource %q: %v", namespace, &groupResource, err))
@@ -898,10 +1178,13 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				shouldRestoreSnapshot = true
 			}
 
-			if shouldRestoreSnapshot {
+			if shouldRestoreSnapshot && ctx.dryRun {
+				ctx.log.Infof("Not restoring persistent volume from snapshot because this is a dry run.")
+			} else if shouldRestoreSnapshot {
 				// even if we're renaming the PV, obj still has the old name here, because the pvRestorer
 				// uses the original name to look up metadata about the snapshot.
 				ctx.log.Infof("Restoring persistent volume from snapshot.")
+				oldStorageClass := pvStorageClass(obj)
 				updatedObj, err := ctx.pvRestorer.executePVAction(obj)
 				if err != nil {
 					errs.Add(namespace, fmt.Errorf("error executing PVAction for %s: %v", resourceID, err))
@@ -909,6 +1192,12 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				}
 				obj = updatedObj
 
+				// the restore's snapshot volume overrides may have changed the PV's storage class,
+				// in which case its PVC needs to be changed to match for them to be bound.
+				if storageClass := pvStorageClass(obj); storageClass != oldStorageClass {
+					ctx.pvStorageClasses[oldName] = storageClass
+				}
+
 				// VolumeSnapshotter has modified the PV name, we should rename the PV
 				if oldName != obj.GetName() {
 					shouldRenamePV = true
@@ -944,6 +1233,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		case hasResticBackup(obj, ctx):
 			ctx.log.Infof("Dynamically re-provisioning persistent volume because it has a restic backup to be restored.")
 			ctx.pvsToProvision.Insert(name)
+			ctx.recordDryRun(groupResource, namespace, name, DryRunActionSkip, "persistent volume would be dynamically re-provisioned")
 
 			// return early because we don't want to restore the PV itself, we want to dynamically re-provision it.
 			return warnings, errs
@@ -951,6 +1241,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		case hasDeleteReclaimPolicy(obj.Object):
 			ctx.log.Infof("Dynamically re-provisioning persistent volume because it doesn't have a snapshot and its reclaim policy is Delete.")
 			ctx.pvsToProvision.Insert(name)
+			ctx.recordDryRun(groupResource, namespace, name, DryRunActionSkip, "persistent volume would be dynamically re-provisioned")
 
 			// return early because we don't want to restore the PV itself, we want to dynamically re-provision it.
 			return warnings, errs
@@ -969,13 +1260,26 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		}
 	}
 
+	// keep a copy of the item's status if it should be restored, since it's
+	// cleared out below and can only be set after the item's created.
+	itemStatus, restoreStatus := ctx.itemStatusToRestore(obj, groupResource)
+
 	// clear out non-core metadata fields & status
-	if obj, err = resetMetadataAndStatus(obj); err != nil {
+	if obj, err = ctx.resetItemMetadataAndStatus(obj, groupResource); err != nil {
 		errs.Add(namespace, err)
 		return warnings, errs
 	}
 
-	for _, action := range ctx.getApplicableActions(groupResource, namespace) {
+	// restore item actions can have side effects outside of the item itself, such as
+	// creating volumes from snapshots or starting asynchronous operations, so they
+	// aren't executed in a dry run.
+	actions := ctx.getApplicableActions(groupResource, namespace)
+	if ctx.dryRun && len(actions) > 0 {
+		ctx.log.Infof("Not executing restore item actions for %s because this is a dry run.", resourceID)
+		actions = nil
+	}
+
+	for _, action := range actions {
 		if !action.selector.Matches(labels.Set(obj.GetLabels())) {
 			return warnings, errs
 		}
@@ -992,8 +1296,30 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			return warnings, errs
 		}
 
+		// version 2 actions can start asynchronous operations for the item, which have to
+		// complete before the item is restored.
+		if actionV2, ok := action.RestoreItemAction.(velero.RestoreItemActionV2); ok {
+			item := velero.ResourceIdentifier{GroupResource: groupResource, Namespace: namespace, Name: name}
+			err := clientmgmt.WaitForItemOperation(
+				func() (velero.OperationProgress, error) { return actionV2.Progress(item, ctx.restore) },
+				func() error { return actionV2.Cancel(item, ctx.restore) },
+				clientmgmt.ItemOperationPollInterval,
+				clientmgmt.ItemOperationTimeout,
+				ctx.log,
+			)
+			if err != nil {
+				errs.Add(namespace, fmt.Errorf("error preparing %s: %v", resourceID, err))
+				return warnings, errs
+			}
+		}
+
 		if executeOutput.SkipRestore {
-			ctx.log.Infof("Skipping restore of %s: %v because a registered plugin discarded it", obj.GroupVersionKind().Kind, name)
+			reason := "a restore item action discarded it"
+			if executeOutput.SkipRestoreReason != "" {
+				reason = fmt.Sprintf("%s: %s", reason, executeOutput.SkipRestoreReason)
+			}
+			ctx.log.Infof("Skipping restore of %s: %v because %s", obj.GroupVersionKind().Kind, name, reason)
+			ctx.recordDryRun(groupResource, namespace, name, DryRunActionSkip, reason)
 			return warnings, errs
 		}
 		unstructuredObj, ok := executeOutput.UpdatedItem.(*unstructured.Unstructured)
@@ -1004,6 +1330,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 		obj = unstructuredObj
 
+		var readyItems []additionalReadyItem
 		for _, additionalItem := range executeOutput.AdditionalItems {
 			itemPath := archive.GetItemFilePath(ctx.restoreDir, additionalItem.GroupResource.String(), additionalItem.Namespace, additionalItem.Name)
 
@@ -1026,7 +1353,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 			additionalItemNamespace := additionalItem.Namespace
 			if additionalItemNamespace != "" {
-				if remapped, ok := ctx.restore.Spec.NamespaceMapping[additionalItemNamespace]; ok {
+				if remapped, ok := mapNamespace(ctx.restore.Spec.NamespaceMapping, additionalItemNamespace); ok {
 					additionalItemNamespace = remapped
 				}
 			}
@@ -1034,6 +1361,21 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			w, e := ctx.restoreItem(additionalObj, additionalItem.GroupResource, additionalItemNamespace)
 			warnings.Merge(&w)
 			errs.Merge(&e)
+
+			if executeOutput.WaitForAdditionalItems {
+				readyItems = append(readyItems, additionalReadyItem{
+					obj:           additionalObj,
+					groupResource: additionalItem.GroupResource,
+					namespace:     additionalItemNamespace,
+				})
+			}
+		}
+
+		// wait for the additional items to become ready before restoring the item,
+		// if the action asked to.
+		if len(readyItems) > 0 {
+			w := ctx.waitForAdditionalItems(readyItems, executeOutput.AdditionalItemsReadyTimeout)
+			warnings.Merge(&w)
 		}
 	}
 
@@ -1065,6 +1407,14 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			obj.SetAnnotations(annotations)
 		}
 
+		if storageClass, ok := ctx.pvStorageClasses[pvc.Spec.VolumeName]; ok {
+			ctx.log.Infof("Changing persistent volume claim %s/%s's storage class to %s to match its persistent volume", namespace, name, storageClass)
+			if err := unstructured.SetNestedField(obj.Object, storageClass, "spec", "storageClassName"); err != nil {
+				errs.Add(namespace, err)
+				return warnings, errs
+			}
+		}
+
 		if newName, ok := ctx.renamedPVs[pvc.Spec.VolumeName]; ok {
 			ctx.log.Infof("Updating persistent volume claim %s/%s to reference renamed persistent volume (%s -> %s)", namespace, name, pvc.Spec.VolumeName, newName)
 			if err := unstructured.SetNestedField(obj.Object, newName, "spec", "volumeName"); err != nil {
@@ -1072,6 +1422,11 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				return warnings, errs
 			}
 		}
+
+		if err := ctx.resizePVC(obj); err != nil {
+			errs.Add(namespace, err)
+			return warnings, errs
+		}
 	}
 
 	// necessary because we may have remapped the namespace
@@ -1081,11 +1436,30 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		obj.SetNamespace(namespace)
 	}
 
+	if ctx.resourceModifiers != nil {
+		if modifierErrs := ctx.resourceModifiers.ApplyResourceModifierRules(obj, groupResource.String(), ctx.log); len(modifierErrs) > 0 {
+			for _, err := range modifierErrs {
+				errs.Add(namespace, err)
+			}
+			return warnings, errs
+		}
+	}
+
 	// label the resource with the restore's name and the restored backup's name
 	// for easy identification of all cluster resources created by this restore
 	// and which backup they came from
+	addItemMetadata(obj, ctx.restore.Spec.ItemLabels, ctx.restore.Spec.ItemAnnotations)
 	addRestoreLabels(obj, ctx.restore.Name, ctx.restore.Spec.BackupName)
 
+	if ctx.dryRun {
+		if err := ctx.planItem(obj, groupResource, namespace, resourceClient); err != nil {
+			errs.Add(namespace, err)
+		}
+		return warnings, errs
+	}
+
+	ctx.remapOwnerReferences(obj)
+
 	ctx.log.Infof("Attempting to restore %s: %v", obj.GroupVersionKind().Kind, name)
 	createdObj, restoreErr := resourceClient.Create(obj)
 	if apierrors.IsAlreadyExists(restoreErr) {
@@ -1095,8 +1469,11 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			warnings.Add(namespace, err)
 			return warnings, errs
 		}
+		// items owned by the backed-up item are owned by the in-cluster version instead
+		ctx.recordRestoredUID(itemFromBackup.GetUID(), fromCluster.GetUID())
+
 		// Remove insubstantial metadata
-		fromCluster, err = resetMetadataAndStatus(fromCluster)
+		fromCluster, err = ctx.resetItemMetadataAndStatus(fromCluster, groupResource)
 		if err != nil {
 			ctx.log.Infof("Error trying to reset metadata for %s: %v", kube.NamespaceAndName(obj), err)
 			warnings.Add(namespace, err)
@@ -1106,14 +1483,32 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		// We know the object from the cluster won't have the backup/restore name labels, so
 		// copy them from the object we attempted to restore.
 		labels := obj.GetLabels()
+		addItemMetadata(fromCluster, ctx.restore.Spec.ItemLabels, ctx.restore.Spec.ItemAnnotations)
 		addRestoreLabels(fromCluster, labels[velerov1api.RestoreNameLabel], labels[velerov1api.BackupNameLabel])
 
-		if !equality.Semantic.DeepEqual(fromCluster, obj) {
+		unchanged, err := sameContent(fromCluster, obj)
+		if err != nil {
+			ctx.log.Infof("Error comparing %s with its cluster version: %v", kube.NamespaceAndName(obj), err)
+			warnings.Add(namespace, err)
+			return warnings, errs
+		}
+
+		if !unchanged {
 			switch groupResource {
 			case kuberesource.ServiceAccounts:
-				desired, err := mergeServiceAccounts(fromCluster, obj)
+				var desired *unstructured.Unstructured
+				switch ctx.restore.Spec.ServiceAccountPolicy {
+				case velerov1api.ServiceAccountPolicySkip:
+					ctx.log.Infof("Restore of ServiceAccount %s skipped: it already exists in the cluster and the service account policy is %q", kube.NamespaceAndName(obj), velerov1api.ServiceAccountPolicySkip)
+					ctx.recordConflict(namespace, resourceID, "skipped according to the service account policy")
+					return warnings, errs
+				case velerov1api.ServiceAccountPolicyReplace:
+					desired, err = replaceServiceAccount(fromCluster, obj)
+				default:
+					desired, err = mergeServiceAccounts(fromCluster, obj)
+				}
 				if err != nil {
-					ctx.log.Infof("error merging secrets for ServiceAccount %s: %v", kube.NamespaceAndName(obj), err)
+					ctx.log.Infof("error applying service account policy to ServiceAccount %s: %v", kube.NamespaceAndName(obj), err)
 					warnings.Add(namespace, err)
 					return warnings, errs
 				}
@@ -1135,15 +1530,71 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 					warnings.Add(namespace, err)
 				} else {
 					ctx.log.Infof("ServiceAccount %s successfully updated", kube.NamespaceAndName(obj))
+					ctx.recordConflict(namespace, resourceID, "updated according to the service account policy")
 				}
 			default:
-				e := errors.Errorf("could not restore, %s. Warning: the in-cluster version is different than the backed-up version.", restoreErr)
-				warnings.Add(namespace, e)
+				switch ctx.conflictPolicies.policyFor(groupResource) {
+				case velerov1api.ConflictPolicySkip:
+					ctx.log.Infof("Restore of %s skipped: it already exists in the cluster and its conflict policy is %q", resourceID, velerov1api.ConflictPolicySkip)
+					ctx.recordConflict(namespace, resourceID, "skipped according to the conflict policy")
+					return warnings, errs
+				case velerov1api.ConflictPolicyOverwrite:
+					patchBytes, err := generateExistingResourcePatch(velerov1api.PolicyTypeUpdate, fromCluster, obj)
+					if err != nil {
+						ctx.log.Infof("error generating patch for %s: %v", kube.NamespaceAndName(obj), err)
+						warnings.Add(namespace, err)
+						return warnings, errs
+					}
+
+					if _, err := resourceClient.Patch(name, patchBytes); err != nil {
+						warnings.Add(namespace, errors.Wrapf(err, "could not overwrite %s according to its conflict policy", resourceID))
+						ctx.recordConflict(namespace, resourceID, "could not be overwritten with the backed-up version")
+					} else {
+						ctx.log.Infof("%s already existed in the cluster and was overwritten according to its conflict policy", resourceID)
+						ctx.recordConflict(namespace, resourceID, "overwritten with the backed-up version according to the conflict policy")
+					}
+					return warnings, errs
+				case velerov1api.ConflictPolicyFailFast:
+					ctx.log.Infof("Stopping restore: %s already exists in the cluster and its conflict policy is %q", resourceID, velerov1api.ConflictPolicyFailFast)
+					errs.Add(namespace, errors.Errorf("%s already exists in the cluster and is different than the backed-up version; no further items were restored because its conflict policy is %q", resourceID, velerov1api.ConflictPolicyFailFast))
+					ctx.recordConflict(namespace, resourceID, "stopped the restore according to the conflict policy")
+					ctx.abortedOnConflict = true
+					return warnings, errs
+				}
+
+				switch ctx.restore.Spec.ExistingResourcePolicy {
+				case velerov1api.PolicyTypeUpdate, velerov1api.PolicyTypePatch:
+					patchBytes, err := generateExistingResourcePatch(ctx.restore.Spec.ExistingResourcePolicy, fromCluster, obj)
+					if err != nil {
+						ctx.log.Infof("error generating patch for %s: %v", kube.NamespaceAndName(obj), err)
+						warnings.Add(namespace, err)
+						return warnings, errs
+					}
+
+					if patchBytes == nil {
+						ctx.log.Infof("Restore of %s, %v skipped: the in-cluster version already contains the backed-up version", obj.GroupVersionKind().Kind, name)
+						return warnings, errs
+					}
+
+					if _, err := resourceClient.Patch(name, patchBytes); err != nil {
+						e := errors.Wrapf(err, "could not apply existing resource policy %q to %s", ctx.restore.Spec.ExistingResourcePolicy, resourceID)
+						warnings.Add(namespace, e)
+						ctx.recordConflict(namespace, resourceID, fmt.Sprintf("could not be updated according to the existing resource policy %q", ctx.restore.Spec.ExistingResourcePolicy))
+					} else {
+						ctx.log.Infof("%s %s already existed in the cluster and was updated using the existing resource policy %q", obj.GroupVersionKind().Kind, kube.NamespaceAndName(obj), ctx.restore.Spec.ExistingResourcePolicy)
+						ctx.recordConflict(namespace, resourceID, fmt.Sprintf("updated according to the existing resource policy %q", ctx.restore.Spec.ExistingResourcePolicy))
+					}
+				default:
+					e := errors.Errorf("could not restore, %s. Warning: the in-cluster version is different than the backed-up version.", restoreErr)
+					warnings.Add(namespace, e)
+					ctx.recordConflict(namespace, resourceID, "not restored; the in-cluster version was kept")
+				}
 			}
 			return warnings, errs
 		}
 
-		ctx.log.Infof("Restore of %s, %v skipped: it already exists in the cluster and is the same as the backed up version", obj.GroupVersionKind().Kind, name)
+		ctx.log.Infof("%s is unchanged: it already exists in the cluster and is the same as the backed up version", resourceID)
+		ctx.itemCounts.Unchanged++
 		return warnings, errs
 	}
 
@@ -1154,10 +1605,26 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		return warnings, errs
 	}
 
+	ctx.recordRestoredUID(itemFromBackup.GetUID(), createdObj.GetUID())
+
+	if restoreStatus {
+		if updated, err := ctx.restoreItemStatus(createdObj, itemStatus, resourceClient); err != nil {
+			warnings.Add(namespace, err)
+		} else {
+			createdObj = updated
+		}
+	}
+
+	ctx.trackReadiness(groupResource, createdObj, resourceClient)
+
 	if groupResource == kuberesource.Pods && len(restic.GetVolumeBackupsForPod(ctx.podVolumeBackups, obj)) > 0 {
 		restorePodVolumeBackups(ctx, createdObj, originalNamespace)
 	}
 
+	if groupResource == kuberesource.PersistentVolumeClaims && len(restic.GetUnmountedPVCBackups(ctx.podVolumeBackups, originalNamespace, name)) > 0 {
+		restoreUnmountedPVCBackups(ctx, createdObj, originalNamespace)
+	}
+
 	if groupResource == kuberesource.Pods {
 		ctx.waitExec(createdObj)
 	}
@@ -1176,33 +1643,167 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	return warnings, errs
 }
 
-// shouldRenamePV returns a boolean indicating whether a persistent volume should be given a new name
-// before being restored, or an error if this cannot be determined. A persistent volume will be
-// given a new name if and only if (a) a PV with the original name already exists in-cluster, and
-// (b) in the backup, the PV is claimed by a PVC in a namespace that's being remapped during the
-// restore.
-func shouldRenamePV(ctx *restoreContext, obj *unstructured.Unstructured, client client.Dynamic) (bool, error) {
-	if len(ctx.restore.Spec.NamespaceMapping) == 0 {
-		ctx.log.Debugf("Persistent volume does not need to be renamed because restore is not remapping any namespaces")
-		return false, nil
+// itemStatusToRestore returns a copy of the item's status, and whether it should
+// be restored according to the restore's RestoreStatus spec.
+func (ctx *restoreContext) itemStatusToRestore(obj *unstructured.Unstructured, groupResource schema.GroupResource) (map[string]interface{}, bool) {
+	if ctx.statusIncludesExcludes == nil || !ctx.statusIncludesExcludes.ShouldInclude(groupResource.String()) {
+		return nil, false
 	}
 
-	pv := new(v1.PersistentVolume)
-	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, pv); err != nil {
-		return false, errors.Wrapf(err, "error converting persistent volume to structured")
+	status, found, err := unstructured.NestedMap(obj.UnstructuredContent(), "status")
+	if err != nil {
+		ctx.log.WithError(err).Warnf("Unable to get status of %s, it won't be restored", kube.NamespaceAndName(obj))
+		return nil, false
 	}
 
-	if pv.Spec.ClaimRef == nil {
-		ctx.log.Debugf("Persistent volume does not need to be renamed because it's not claimed")
-		return false, nil
+	return status, found && len(status) > 0
+}
+
+// restoreItemStatus sets the status of a newly-created item through its status
+// subresource, returning the updated item.
+func (ctx *restoreContext) restoreItemStatus(createdObj *unstructured.Unstructured, status map[string]interface{}, resourceClient client.Dynamic) (*unstructured.Unstructured, error) {
+	withStatus := createdObj.DeepCopy()
+	if err := unstructured.SetNestedMap(withStatus.UnstructuredContent(), status, "status"); err != nil {
+		return nil, errors.Wrapf(err, "error setting status of %s", kube.NamespaceAndName(createdObj))
+	}
+
+	ctx.log.Infof("Restoring status of %s", kube.NamespaceAndName(createdObj))
+	updated, err := resourceClient.UpdateStatus(withStatus, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error restoring status of %s", kube.NamespaceAndName(createdObj))
+	}
+
+	return updated, nil
+}
+
+// ensureNamespace ensures that the namespace exists in the cluster and is ready, creating
+// it if necessary. In a dry run, the namespace is never created; what would be done with
+// it is recorded in the dry-run report instead.
+func (ctx *restoreContext) ensureNamespace(ns *v1.Namespace) error {
+	if !ctx.dryRun {
+		_, err := kube.EnsureNamespaceExistsAndIsReady(ns, ctx.namespaceClient, ctx.resourceTerminatingTimeout)
+		return err
+	}
+
+	if ctx.dryRunNamespaces.Has(ns.Name) {
+		return nil
 	}
+	ctx.dryRunNamespaces.Insert(ns.Name)
 
-	if _, ok := ctx.restore.Spec.NamespaceMapping[pv.Spec.ClaimRef.Namespace]; !ok {
-		ctx.log.Debugf("Persistent volume does not need to be renamed because it's not claimed by a PVC in a namespace that's being remapped")
+	_, err := ctx.namespaceClient.Get(go_context.TODO(), ns.Name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		ctx.dryRunReport.add(kuberesource.Namespaces, "", ns.Name, DryRunActionCreate, "")
+	case err != nil:
+		return errors.Wrapf(err, "error getting namespace %s", ns.Name)
+	default:
+		ctx.dryRunReport.add(kuberesource.Namespaces, "", ns.Name, DryRunActionSkip, "namespace already exists")
+	}
+
+	return nil
+}
+
+// recordDryRun records what would be done with an item in the dry-run report,
+// if the restore is a dry run.
+func (ctx *restoreContext) recordDryRun(groupResource schema.GroupResource, namespace, name string, action DryRunAction, reason string) {
+	if ctx.dryRun {
+		ctx.dryRunReport.add(groupResource, namespace, name, action, reason)
+	}
+}
+
+// planItem records in the dry-run report what restoring obj would do, by comparing it
+// with the in-cluster version of the item, if there is one.
+func (ctx *restoreContext) planItem(obj *unstructured.Unstructured, groupResource schema.GroupResource, namespace string, resourceClient client.Dynamic) error {
+	fromCluster, err := resourceClient.Get(obj.GetName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		ctx.dryRunReport.add(groupResource, namespace, obj.GetName(), DryRunActionCreate, "")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "error retrieving cluster version of %s", kube.NamespaceAndName(obj))
+	}
+
+	if fromCluster, err = ctx.resetItemMetadataAndStatus(fromCluster, groupResource); err != nil {
+		return errors.Wrapf(err, "error resetting metadata for cluster version of %s", kube.NamespaceAndName(obj))
+	}
+
+	// the object from the cluster won't have the backup/restore name labels, so
+	// copy them from the object being restored.
+	labels := obj.GetLabels()
+	addItemMetadata(fromCluster, ctx.restore.Spec.ItemLabels, ctx.restore.Spec.ItemAnnotations)
+	addRestoreLabels(fromCluster, labels[velerov1api.RestoreNameLabel], labels[velerov1api.BackupNameLabel])
+
+	unchanged, err := sameContent(fromCluster, obj)
+	if err != nil {
+		return errors.Wrapf(err, "error comparing %s with its cluster version", kube.NamespaceAndName(obj))
+	}
+
+	switch {
+	case unchanged:
+		ctx.dryRunReport.add(groupResource, namespace, obj.GetName(), DryRunActionUnchanged, "")
+	case groupResource == kuberesource.ServiceAccounts && ctx.restore.Spec.ServiceAccountPolicy == velerov1api.ServiceAccountPolicySkip:
+		ctx.dryRunReport.add(groupResource, namespace, obj.GetName(), DryRunActionSkip, "already exists in the cluster and the service account policy is skip")
+	case groupResource == kuberesource.ServiceAccounts && ctx.restore.Spec.ServiceAccountPolicy == velerov1api.ServiceAccountPolicyReplace:
+		ctx.dryRunReport.add(groupResource, namespace, obj.GetName(), DryRunActionConflict, "already exists in the cluster; it would be replaced with the backed-up version, keeping its token secrets")
+	case groupResource == kuberesource.ServiceAccounts:
+		ctx.dryRunReport.add(groupResource, namespace, obj.GetName(), DryRunActionConflict, "already exists in the cluster; its secrets would be merged with the backed-up version")
+	case ctx.conflictPolicies.policyFor(groupResource) == velerov1api.ConflictPolicySkip:
+		ctx.dryRunReport.add(groupResource, namespace, obj.GetName(), DryRunActionSkip, "already exists in the cluster and its conflict policy is skip")
+	case ctx.conflictPolicies.policyFor(groupResource) == velerov1api.ConflictPolicyOverwrite:
+		ctx.dryRunReport.add(groupResource, namespace, obj.GetName(), DryRunActionConflict, "already exists in the cluster; it would be overwritten with the backed-up version")
+	case ctx.conflictPolicies.policyFor(groupResource) == velerov1api.ConflictPolicyFailFast:
+		ctx.dryRunReport.add(groupResource, namespace, obj.GetName(), DryRunActionConflict, "already exists in the cluster; the restore would stop here because its conflict policy is fail-fast")
+	case ctx.restore.Spec.ExistingResourcePolicy == velerov1api.PolicyTypeUpdate:
+		ctx.dryRunReport.add(groupResource, namespace, obj.GetName(), DryRunActionConflict, "already exists in the cluster; it would be replaced with the backed-up version")
+	case ctx.restore.Spec.ExistingResourcePolicy == velerov1api.PolicyTypePatch:
+		ctx.dryRunReport.add(groupResource, namespace, obj.GetName(), DryRunActionConflict, "already exists in the cluster; the backed-up version would be merged into it")
+	default:
+		ctx.dryRunReport.add(groupResource, namespace, obj.GetName(), DryRunActionConflict, "already exists in the cluster and is different than the backed-up version")
+	}
+
+	return nil
+}
+
+// shouldRenamePV returns a boolean indicating whether a persistent volume should be given a new name
+// before being restored, or an error if this cannot be determined. If the restore has a PV rename
+// policy of Always or Never, the persistent volume is always or never given a new name, respectively.
+// With a policy of OnConflict, it will be given a new name if a PV with the original name already
+// exists in-cluster. Otherwise, it will be given a new name if and only if (a) a PV with the original
+// name already exists in-cluster, and (b) in the backup, the PV is claimed by a PVC in a namespace
+// that's being remapped during the restore.
+func shouldRenamePV(ctx *restoreContext, obj *unstructured.Unstructured, client client.Dynamic) (bool, error) {
+	switch ctx.restore.Spec.PVRenamePolicy {
+	case velerov1api.PVRenamePolicyAlways:
+		ctx.log.Debugf("Persistent volume will be renamed because the restore's PV rename policy is %s", velerov1api.PVRenamePolicyAlways)
+		return true, nil
+	case velerov1api.PVRenamePolicyNever:
+		ctx.log.Debugf("Persistent volume will not be renamed because the restore's PV rename policy is %s", velerov1api.PVRenamePolicyNever)
 		return false, nil
+	case velerov1api.PVRenamePolicyOnConflict:
+		// only whether the PV already exists in-cluster matters
+	default:
+		if len(ctx.restore.Spec.NamespaceMapping) == 0 {
+			ctx.log.Debugf("Persistent volume does not need to be renamed because restore is not remapping any namespaces")
+			return false, nil
+		}
+
+		pv := new(v1.PersistentVolume)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, pv); err != nil {
+			return false, errors.Wrapf(err, "error converting persistent volume to structured")
+		}
+
+		if pv.Spec.ClaimRef == nil {
+			ctx.log.Debugf("Persistent volume does not need to be renamed because it's not claimed")
+			return false, nil
+		}
+
+		if _, ok := mapNamespace(ctx.restore.Spec.NamespaceMapping, pv.Spec.ClaimRef.Namespace); !ok {
+			ctx.log.Debugf("Persistent volume does not need to be renamed because it's not claimed by a PVC in a namespace that's being remapped")
+			return false, nil
+		}
 	}
 
-	_, err := client.Get(pv.Name, metav1.GetOptions{})
+	_, err := client.Get(obj.GetName(), metav1.GetOptions{})
 	switch {
 	case apierrors.IsNotFound(err):
 		ctx.log.Debugf("Persistent volume does not need to be renamed because it does not exist in the cluster")
@@ -1215,6 +1816,17 @@ func shouldRenamePV(ctx *restoreContext, obj *unstructured.Unstructured, client
 	return true, nil
 }
 
+// ValidatePVRenamePolicy returns an error if the given PV rename policy is not
+// one that Velero knows how to apply.
+func ValidatePVRenamePolicy(policy velerov1api.PVRenamePolicy) error {
+	switch policy {
+	case "", velerov1api.PVRenamePolicyAlways, velerov1api.PVRenamePolicyOnConflict, velerov1api.PVRenamePolicyNever:
+		return nil
+	default:
+		return errors.Errorf("invalid PV rename policy %q, must be one of %q, %q or %q", policy, velerov1api.PVRenamePolicyAlways, velerov1api.PVRenamePolicyOnConflict, velerov1api.PVRenamePolicyNever)
+	}
+}
+
 // restorePodVolumeBackups restores the PodVolumeBackups for the given restored pod
 func restorePodVolumeBackups(ctx *restoreContext, createdObj *unstructured.Unstructured, originalNamespace string) {
 	if ctx.resticRestorer == nil {
@@ -1251,6 +1863,43 @@ func restorePodVolumeBackups(ctx *restoreContext, createdObj *unstructured.Unstr
 	}
 }
 
+// restoreUnmountedPVCBackups restores the PodVolumeBackups for the given restored persistent
+// volume claim that were taken without a pod that mounted it
+func restoreUnmountedPVCBackups(ctx *restoreContext, createdObj *unstructured.Unstructured, originalNamespace string) {
+	if ctx.resticRestorer == nil {
+		ctx.log.Warn("No restic restorer, not restoring persistent volume claim's data")
+	} else {
+		ctx.resticWaitGroup.Add(1)
+		go func() {
+			// Done() will only be called after all errors have been successfully sent
+			// on the ctx.resticErrs channel
+			defer ctx.resticWaitGroup.Done()
+
+			pvc := new(v1.PersistentVolumeClaim)
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(createdObj.UnstructuredContent(), &pvc); err != nil {
+				ctx.log.WithError(err).Error("error converting unstructured persistent volume claim")
+				ctx.resticErrs <- err
+				return
+			}
+
+			data := restic.RestoreData{
+				Restore:          ctx.restore,
+				PVC:              pvc,
+				PodVolumeBackups: ctx.podVolumeBackups,
+				SourceNamespace:  originalNamespace,
+				BackupLocation:   ctx.backup.Spec.StorageLocation,
+			}
+			if errs := ctx.resticRestorer.RestorePVC(data); errs != nil {
+				ctx.log.WithError(kubeerrs.NewAggregate(errs)).Error("unable to successfully complete restic restores of persistent volume claim's data")
+
+				for _, err := range errs {
+					ctx.resticErrs <- err
+				}
+			}
+		}()
+	}
+}
+
 // waitExec executes hooks in a restored pod's containers when they become ready
 func (ctx *restoreContext) waitExec(createdObj *unstructured.Unstructured) {
 	ctx.hooksWaitGroup.Add(1)
@@ -29,8 +29,11 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -39,6 +42,7 @@ import (
 	"k8s.io/client-go/dynamic"
 	kubetesting "k8s.io/client-go/testing"
 
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -507,6 +511,53 @@ func TestRestoreResourceFiltering(t *testing.T) {
 				test.PVs():  {"/pv-1", "/pv-2"},
 			},
 		},
+		{
+			name:    "included resource names filter only restores the named items",
+			restore: defaultRestore().IncludedResourceNames("pods/pod-1", "po/pod-3", "deployments/deploy-1").Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-1", "pod-2").Result(),
+					builder.ForPod("ns-2", "pod-1").Result(),
+					builder.ForPod("ns-2", "pod-3").Result(),
+				).
+				AddItems("deployments.apps",
+					builder.ForDeployment("ns-1", "deploy-1").Result(),
+					builder.ForDeployment("ns-2", "deploy-2").Result(),
+				).
+				AddItems("persistentvolumes",
+					builder.ForPersistentVolume("pv-1").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+				test.Deployments(),
+				test.PVs(),
+			},
+			want: map[*test.APIResource][]string{
+				test.Pods():        {"ns-1/pod-1", "ns-2/pod-1", "ns-2/pod-3"},
+				test.Deployments(): {"ns-1/deploy-1"},
+			},
+		},
+		{
+			name:    "included resource names filter is combined with the included namespaces filter",
+			restore: defaultRestore().IncludedResourceNames("pods/pod-1").IncludedNamespaces("ns-2").Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-2", "pod-1").Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+			},
+			want: map[*test.APIResource][]string{
+				test.Pods(): {"ns-2/pod-1"},
+			},
+		},
 		{
 			name:         "mirror pods are not restored",
 			restore:      defaultRestore().Result(),
@@ -604,6 +655,24 @@ func TestRestoreNamespaceMapping(t *testing.T) {
 				test.Pods(): {"mapped-ns-1/pod-1", "mapped-ns-2/pod-2"},
 			},
 		},
+		{
+			name:    "wildcard namespace mappings are applied",
+			restore: defaultRestore().NamespaceMappings("team-*", "staging-team-*", "team-b", "special").Result(),
+			backup:  defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+			},
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("team-a", "pod-1").Result(),
+					builder.ForPod("team-b", "pod-2").Result(),
+					builder.ForPod("ns-3", "pod-3").Result(),
+				).
+				Done(),
+			want: map[*test.APIResource][]string{
+				test.Pods(): {"staging-team-a/pod-1", "special/pod-2", "ns-3/pod-3"},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -647,7 +716,9 @@ func TestRestoreResourcePriorities(t *testing.T) {
 		backup             *velerov1api.Backup
 		apiResources       []*test.APIResource
 		tarball            io.Reader
-		resourcePriorities []string
+		resourcePriorities Priorities
+		// want is the priorities the restore is expected to use, if they aren't resourcePriorities.
+		want *Priorities
 	}{
 		{
 			name:    "resources are restored according to the specified resource priorities",
@@ -681,7 +752,98 @@ func TestRestoreResourcePriorities(t *testing.T) {
 				test.Deployments(),
 				test.ServiceAccounts(),
 			},
-			resourcePriorities: []string{"persistentvolumes", "serviceaccounts", "pods", "deployments.apps"},
+			resourcePriorities: Priorities{HighPriorities: []string{"persistentvolumes", "serviceaccounts", "pods", "deployments.apps"}},
+		},
+		{
+			name:    "low-priority resources are restored after all other resources",
+			restore: defaultRestore().Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
+				).
+				AddItems("persistentvolumes",
+					builder.ForPersistentVolume("pv-1").Result(),
+					builder.ForPersistentVolume("pv-2").Result(),
+				).
+				AddItems("deployments.apps",
+					builder.ForDeployment("ns-1", "deploy-1").Result(),
+					builder.ForDeployment("ns-2", "deploy-2").Result(),
+				).
+				AddItems("serviceaccounts",
+					builder.ForServiceAccount("ns-1", "sa-1").Result(),
+					builder.ForServiceAccount("ns-2", "sa-2").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+				test.PVs(),
+				test.Deployments(),
+				test.ServiceAccounts(),
+			},
+			resourcePriorities: Priorities{
+				HighPriorities: []string{"persistentvolumes"},
+				LowPriorities:  []string{"deployments.apps", "serviceaccounts"},
+			},
+		},
+		{
+			name: "restore's resource priorities override the server's",
+			restore: defaultRestore().
+				ResourcePriorities([]string{"serviceaccounts", "pods"}, []string{"persistentvolumes"}).
+				Result(),
+			backup: defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
+				).
+				AddItems("persistentvolumes",
+					builder.ForPersistentVolume("pv-1").Result(),
+					builder.ForPersistentVolume("pv-2").Result(),
+				).
+				AddItems("deployments.apps",
+					builder.ForDeployment("ns-1", "deploy-1").Result(),
+					builder.ForDeployment("ns-2", "deploy-2").Result(),
+				).
+				AddItems("serviceaccounts",
+					builder.ForServiceAccount("ns-1", "sa-1").Result(),
+					builder.ForServiceAccount("ns-2", "sa-2").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+				test.PVs(),
+				test.Deployments(),
+				test.ServiceAccounts(),
+			},
+			resourcePriorities: Priorities{HighPriorities: []string{"persistentvolumes", "deployments.apps"}},
+			want:               &Priorities{HighPriorities: []string{"serviceaccounts", "pods"}, LowPriorities: []string{"persistentvolumes"}},
+		},
+		{
+			name: "restore's low priorities keep the server's high priorities",
+			restore: defaultRestore().
+				ResourcePriorities(nil, []string{"serviceaccounts"}).
+				Result(),
+			backup: defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+				).
+				AddItems("persistentvolumes",
+					builder.ForPersistentVolume("pv-1").Result(),
+				).
+				AddItems("serviceaccounts",
+					builder.ForServiceAccount("ns-1", "sa-1").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+				test.PVs(),
+				test.ServiceAccounts(),
+			},
+			resourcePriorities: Priorities{HighPriorities: []string{"persistentvolumes"}, LowPriorities: []string{"pods"}},
+			want:               &Priorities{HighPriorities: []string{"persistentvolumes"}, LowPriorities: []string{"serviceaccounts"}},
 		},
 	}
 
@@ -689,6 +851,11 @@ func TestRestoreResourcePriorities(t *testing.T) {
 		h := newHarness(t)
 		h.restorer.resourcePriorities = tc.resourcePriorities
 
+		want := tc.resourcePriorities
+		if tc.want != nil {
+			want = *tc.want
+		}
+
 		recorder := &createRecorder{t: t}
 		h.DynamicClient.PrependReactor("create", "*", recorder.reactor())
 
@@ -713,7 +880,7 @@ func TestRestoreResourcePriorities(t *testing.T) {
 		)
 
 		assertEmptyResults(t, warnings, errs)
-		assertResourceCreationOrder(t, tc.resourcePriorities, recorder.resources)
+		assertResourceCreationOrder(t, want, recorder.resources)
 	}
 }
 
@@ -796,206 +963,1322 @@ func TestInvalidTarballContents(t *testing.T) {
 	}
 }
 
-// TestRestoreItems runs restores of specific items and validates that they are created
-// with the expected metadata/spec/status in the API.
-func TestRestoreItems(t *testing.T) {
+// TestRestoreAPIVersionFallback runs restores of items that were backed up with an API
+// version the cluster doesn't serve, and verifies that they're restored using a version
+// that is served.
+func TestRestoreAPIVersionFallback(t *testing.T) {
+	deploymentWithVersion := func(ns, name, apiVersion string) *appsv1.Deployment {
+		deploy := builder.ForDeployment(ns, name).Result()
+		deploy.APIVersion = apiVersion
+		return deploy
+	}
+
 	tests := []struct {
 		name         string
 		restore      *velerov1api.Restore
 		backup       *velerov1api.Backup
 		apiResources []*test.APIResource
 		tarball      io.Reader
-		want         []*test.APIResource
+		want         map[*test.APIResource][]string
+		wantWarnings Result
 	}{
 		{
-			name:    "metadata other than namespace/name/labels/annotations gets removed",
+			name:    "item backed up with an unserved version is restored with the preferred version",
 			restore: defaultRestore().Result(),
 			backup:  defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Deployments(),
+			},
 			tarball: test.NewTarWriter(t).
-				AddItems("pods",
-					builder.ForPod("ns-1", "pod-1").
-						ObjectMeta(
-							builder.WithLabels("key-1", "val-1"),
-							builder.WithAnnotations("key-1", "val-1"),
-							builder.WithClusterName("cluster-1"),
-							builder.WithFinalizers("finalizer-1"),
-						).
-						Result(),
-				).
+				AddItems("deployments.apps", deploymentWithVersion("ns-1", "deploy-1", "apps/v1beta2")).
 				Done(),
-			apiResources: []*test.APIResource{
-				test.Pods(),
+			want: map[*test.APIResource][]string{
+				test.Deployments(): {"ns-1/deploy-1"},
 			},
-			want: []*test.APIResource{
-				test.Pods(
-					builder.ForPod("ns-1", "pod-1").
-						ObjectMeta(
-							builder.WithLabels("key-1", "val-1", "velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1"),
-							builder.WithAnnotations("key-1", "val-1"),
-						).
-						Result(),
-				),
+			wantWarnings: Result{
+				Namespaces: map[string][]string{
+					"ns-1": {"deployments.apps/ns-1/deploy-1 was backed up with API version v1beta2, which the cluster does not serve; restoring it with API version v1"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+
+			for _, r := range tc.apiResources {
+				h.DiscoveryClient.WithAPIResource(r)
+			}
+			require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+			data := Request{
+				Log:              h.log,
+				Restore:          tc.restore,
+				Backup:           tc.backup,
+				PodVolumeBackups: nil,
+				VolumeSnapshots:  nil,
+				BackupReader:     tc.tarball,
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // actions
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assert.Equal(t, tc.wantWarnings, warnings)
+			assertEmptyResults(t, errs)
+			assertAPIContents(t, h, tc.want)
+		})
+	}
+}
+
+func TestChooseAPIVersion(t *testing.T) {
+	deployments := func(version string) schema.GroupVersionResource {
+		return schema.GroupVersionResource{Group: "apps", Version: version, Resource: "deployments"}
+	}
+
+	tests := []struct {
+		name          string
+		served        []schema.GroupVersionResource
+		groupVersions []string
+		preferred     string
+		backupVersion string
+		want          string
+		wantErr       string
+	}{
+		{
+			name:          "backed-up version that provides the resource is kept",
+			served:        []schema.GroupVersionResource{deployments("v1"), deployments("v1beta2")},
+			groupVersions: []string{"v1", "v1beta2"},
+			preferred:     "v1",
+			backupVersion: "v1beta2",
+			want:          "v1beta2",
+		},
+		{
+			name:          "preferred version is used when the backed-up version isn't served",
+			served:        []schema.GroupVersionResource{deployments("v1"), deployments("v2")},
+			groupVersions: []string{"v2", "v1"},
+			preferred:     "v1",
+			backupVersion: "v1beta2",
+			want:          "v1",
+		},
+		{
+			name:          "highest-priority version is used when the preferred version doesn't provide the resource",
+			served:        []schema.GroupVersionResource{deployments("v1beta1"), deployments("v1beta3")},
+			groupVersions: []string{"v2", "v1beta1", "v1beta3"},
+			preferred:     "v2",
+			backupVersion: "v1alpha1",
+			want:          "v1beta3",
+		},
+		{
+			name:          "backed-up version is kept when the group isn't served",
+			backupVersion: "v1beta2",
+			want:          "v1beta2",
+		},
+		{
+			name:          "error is returned when no served version provides the resource",
+			served:        []schema.GroupVersionResource{{Group: "apps", Version: "v1", Resource: "daemonsets"}},
+			groupVersions: []string{"v1"},
+			preferred:     "v1",
+			backupVersion: "v1beta2",
+			wantErr:       "the cluster does not serve API version v1beta2 of deployments.apps, or any other version that provides it",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resources := make(map[schema.GroupVersionResource]schema.GroupVersionResource)
+			for _, gvr := range tc.served {
+				resources[gvr] = gvr
+			}
+			discoveryHelper := test.NewFakeDiscoveryHelper(false, resources)
+
+			discoveryHelper.APIGroupsList = nil
+			if len(tc.groupVersions) > 0 {
+				group := metav1.APIGroup{
+					Name:             "apps",
+					PreferredVersion: metav1.GroupVersionForDiscovery{GroupVersion: "apps/" + tc.preferred, Version: tc.preferred},
+				}
+				for _, version := range tc.groupVersions {
+					group.Versions = append(group.Versions, metav1.GroupVersionForDiscovery{GroupVersion: "apps/" + version, Version: version})
+				}
+				discoveryHelper.APIGroupsList = []metav1.APIGroup{group}
+			}
+
+			ctx := &restoreContext{discoveryHelper: discoveryHelper}
+
+			got, err := ctx.chooseAPIVersion(schema.GroupResource{Group: "apps", Resource: "deployments"}, tc.backupVersion)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+// TestRestoreResourceMappings runs restores with resource mappings, and verifies
+// that items of the source resources are restored as items of the target resources.
+func TestRestoreResourceMappings(t *testing.T) {
+	pausedDeployment := func(ns, name, apiVersion string) *appsv1.Deployment {
+		deploy := builder.ForDeployment(ns, name).Result()
+		deploy.APIVersion = apiVersion
+		deploy.Spec.Paused = true
+		return deploy
+	}
+
+	tests := []struct {
+		name       string
+		restore    *velerov1api.Restore
+		tarball    io.Reader
+		want       map[*test.APIResource][]string
+		wantPaused bool
+		wantErrs   bool
+	}{
+		{
+			name:    "items of a mapped resource are restored as items of the target resource",
+			restore: defaultRestore().ResourceMapping("deployments.extensions", "deployments.apps").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("deployments.extensions", pausedDeployment("ns-1", "deploy-1", "extensions/v1beta1")).
+				Done(),
+			want: map[*test.APIResource][]string{
+				test.Deployments(): {"ns-1/deploy-1"},
+			},
+			wantPaused: true,
+		},
+		{
+			name:    "pruned fields are removed from the items of a mapped resource",
+			restore: defaultRestore().ResourceMapping("deployments.extensions", "deployments.apps", "/spec/paused").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("deployments.extensions", pausedDeployment("ns-1", "deploy-1", "extensions/v1beta1")).
+				Done(),
+			want: map[*test.APIResource][]string{
+				test.Deployments(): {"ns-1/deploy-1"},
+			},
+		},
+		{
+			name:    "an error is recorded when the target resource can't be resolved",
+			restore: defaultRestore().ResourceMapping("deployments.extensions", "widgets.example.com").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("deployments.extensions", pausedDeployment("ns-1", "deploy-1", "extensions/v1beta1")).
+				Done(),
+			want: map[*test.APIResource][]string{
+				test.Deployments(): {},
+			},
+			wantErrs: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.AddItems(t, test.Deployments())
+
+			data := Request{
+				Log:          h.log,
+				Restore:      tc.restore,
+				Backup:       defaultBackup().Result(),
+				BackupReader: tc.tarball,
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // actions
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, warnings)
+			assertAPIContents(t, h, tc.want)
+			if tc.wantErrs {
+				assert.Len(t, errs.Velero, 1)
+				return
+			}
+			assertEmptyResults(t, errs)
+
+			res, err := h.DynamicClient.Resource(test.Deployments().GVR()).Namespace("ns-1").Get(context.TODO(), "deploy-1", metav1.GetOptions{})
+			require.NoError(t, err)
+
+			assert.Equal(t, "apps/v1", res.GetAPIVersion())
+			paused, _, err := unstructured.NestedBool(res.Object, "spec", "paused")
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantPaused, paused)
+		})
+	}
+}
+
+// TestRestorePVCResize runs restores that resize persistent volume claims, and
+// verifies the storage requested by the restored claims.
+func TestRestorePVCResize(t *testing.T) {
+	pvcWithRequest := func(storageClass *string, volumeName, request string) *corev1api.PersistentVolumeClaim {
+		pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName(volumeName).Result()
+		pvc.Spec.StorageClassName = storageClass
+		pvc.Spec.Resources.Requests = corev1api.ResourceList{
+			corev1api.ResourceStorage: resource.MustParse(request),
+		}
+		return pvc
+	}
+	standard := "standard"
+	missing := "missing"
+
+	tests := []struct {
+		name        string
+		restore     *velerov1api.Restore
+		pvc         *corev1api.PersistentVolumeClaim
+		want        string
+		wantErrs    Result
+		skipDefault bool
+	}{
+		{
+			name:    "requested storage is multiplied by the factor",
+			restore: defaultRestore().PVCResize("1.5", nil).Result(),
+			pvc:     pvcWithRequest(&standard, "", "10Gi"),
+			want:    "15Gi",
+		},
+		{
+			name:    "requested storage is increased to the storage class's minimum size",
+			restore: defaultRestore().PVCResize("", map[string]string{"standard": "10Gi"}).Result(),
+			pvc:     pvcWithRequest(&standard, "", "1Gi"),
+			want:    "10Gi",
+		},
+		{
+			name:    "requested storage is never decreased",
+			restore: defaultRestore().PVCResize("1", map[string]string{"standard": "10Gi"}).Result(),
+			pvc:     pvcWithRequest(&standard, "", "20Gi"),
+			want:    "20Gi",
+		},
+		{
+			name:    "the default storage class's minimum size applies to claims without a storage class",
+			restore: defaultRestore().PVCResize("", map[string]string{"standard": "10Gi"}).Result(),
+			pvc:     pvcWithRequest(nil, "", "1Gi"),
+			want:    "10Gi",
+		},
+		{
+			name:        "claims without a storage class aren't resized when there's no default storage class",
+			restore:     defaultRestore().PVCResize("2", nil).Result(),
+			pvc:         pvcWithRequest(nil, "", "1Gi"),
+			want:        "1Gi",
+			skipDefault: true,
+		},
+		{
+			name:    "claims bound to a restored volume aren't resized",
+			restore: defaultRestore().PVCResize("2", nil).Result(),
+			pvc:     pvcWithRequest(&standard, "pv-1", "1Gi"),
+			want:    "1Gi",
+		},
+		{
+			name:    "an error is recorded when the claim's storage class doesn't exist",
+			restore: defaultRestore().PVCResize("2", nil).Result(),
+			pvc:     pvcWithRequest(&missing, "", "1Gi"),
+			wantErrs: Result{
+				Namespaces: map[string][]string{
+					"ns-1": {"error resizing persistent volume claim: storage class missing does not exist in the cluster"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.AddItems(t, test.PVCs())
+
+			storageClass := builder.ForStorageClass("standard").Result()
+			if !tc.skipDefault {
+				storageClass.Annotations = map[string]string{"storageclass.kubernetes.io/is-default-class": "true"}
+			}
+			_, err := h.KubeClient.StorageV1().StorageClasses().Create(context.TODO(), storageClass, metav1.CreateOptions{})
+			require.NoError(t, err)
+
+			data := Request{
+				Log:          h.log,
+				Restore:      tc.restore,
+				Backup:       defaultBackup().Result(),
+				BackupReader: test.NewTarWriter(t).AddItems("persistentvolumeclaims", tc.pvc).Done(),
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // actions
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, warnings)
+			assert.Equal(t, tc.wantErrs, errs)
+			if tc.want == "" {
+				return
+			}
+
+			res, err := h.DynamicClient.Resource(test.PVCs().GVR()).Namespace("ns-1").Get(context.TODO(), "pvc-1", metav1.GetOptions{})
+			require.NoError(t, err)
+
+			request, _, err := unstructured.NestedString(res.Object, "spec", "resources", "requests", "storage")
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, request)
+		})
+	}
+}
+
+// TestRestoreDryRun runs dry-run restores and verifies that the expected plan is
+// reported, and that nothing is created in the API.
+func TestRestoreDryRun(t *testing.T) {
+	tests := []struct {
+		name         string
+		restore      *velerov1api.Restore
+		backup       *velerov1api.Backup
+		apiResources []*test.APIResource
+		tarball      io.Reader
+		actions      []*recordResourcesAction
+		want         map[*test.APIResource][]string
+		wantReport   []DryRunItem
+	}{
+		{
+			name:    "new items would be created and items that differ from the cluster would conflict",
+			restore: defaultRestore().DryRun(true).Result(),
+			backup:  defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-2").ObjectMeta(builder.WithLabels("foo", "bar")).Result(),
+				),
+			},
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-1", "pod-2").Result(),
+				).
+				Done(),
+			want: map[*test.APIResource][]string{
+				test.Pods(): {"ns-1/pod-2"},
+			},
+			wantReport: []DryRunItem{
+				{GroupResource: "namespaces", Name: "ns-1", Action: DryRunActionCreate},
+				{GroupResource: "pods", Namespace: "ns-1", Name: "pod-1", Action: DryRunActionCreate},
+				{GroupResource: "pods", Namespace: "ns-1", Name: "pod-2", Action: DryRunActionConflict, Reason: "already exists in the cluster and is different than the backed-up version"},
+			},
+		},
+		{
+			name:    "items that are the same as the cluster version would be unchanged",
+			restore: defaultRestore().DryRun(true).Result(),
+			backup:  defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("foo", "bar", "velero.io/restore-name", "previous-restore")).Result(),
+				),
+			},
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("foo", "bar")).Result()).
+				Done(),
+			want: map[*test.APIResource][]string{
+				test.Pods(): {"ns-1/pod-1"},
+			},
+			wantReport: []DryRunItem{
+				{GroupResource: "namespaces", Name: "ns-1", Action: DryRunActionCreate},
+				{GroupResource: "pods", Namespace: "ns-1", Name: "pod-1", Action: DryRunActionUnchanged},
+			},
+		},
+		{
+			name:    "restore item actions are not executed",
+			restore: defaultRestore().DryRun(true).Result(),
+			backup:  defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+			},
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+				Done(),
+			actions: []*recordResourcesAction{
+				new(recordResourcesAction).ForResource("pods"),
+			},
+			want: map[*test.APIResource][]string{
+				test.Pods(): {},
+			},
+			wantReport: []DryRunItem{
+				{GroupResource: "namespaces", Name: "ns-1", Action: DryRunActionCreate},
+				{GroupResource: "pods", Namespace: "ns-1", Name: "pod-1", Action: DryRunActionCreate},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+
+			for _, r := range tc.apiResources {
+				h.AddItems(t, r)
+			}
+
+			report := new(DryRunReport)
+			data := Request{
+				Log:              h.log,
+				Restore:          tc.restore,
+				Backup:           tc.backup,
+				PodVolumeBackups: nil,
+				VolumeSnapshots:  nil,
+				BackupReader:     tc.tarball,
+				DryRunReport:     report,
+			}
+			var actions []velero.RestoreItemAction
+			for _, action := range tc.actions {
+				actions = append(actions, action)
+			}
+
+			warnings, errs := h.restorer.Restore(
+				data,
+				actions,
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, warnings, errs)
+			assertAPIContents(t, h, tc.want)
+			assert.ElementsMatch(t, tc.wantReport, report.Items)
+
+			for _, action := range tc.actions {
+				assert.Empty(t, action.ids)
+			}
+
+			namespaces, err := h.KubeClient.CoreV1().Namespaces().List(context.TODO(), metav1.ListOptions{})
+			require.NoError(t, err)
+			assert.Empty(t, namespaces.Items)
+		})
+	}
+}
+
+// TestRestoreUnchangedItems runs restores of items that already exist in the
+// cluster, and verifies that the items that are the same as the backed-up
+// version are counted as unchanged.
+func TestRestoreUnchangedItems(t *testing.T) {
+	h := newHarness(t)
+	h.AddItems(t, test.Pods(
+		// restored by a previous restore of the same backup
+		builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "web", "velero.io/backup-name", "backup-1", "velero.io/restore-name", "previous-restore"), builder.WithUID("uid-1")).Result(),
+		builder.ForPod("ns-1", "pod-2").ObjectMeta(builder.WithLabels("app", "changed")).Result(),
+	))
+
+	counts := new(ItemCounts)
+	data := Request{
+		Log:     h.log,
+		Restore: defaultRestore().Result(),
+		Backup:  defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).
+			AddItems("pods",
+				builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "web")).Result(),
+				builder.ForPod("ns-1", "pod-2").ObjectMeta(builder.WithLabels("app", "web")).Result(),
+			).
+			Done(),
+		ItemCounts: counts,
+	}
+	warnings, errs := h.restorer.Restore(
+		data,
+		nil, // actions
+		nil, // snapshot location lister
+		nil, // volume snapshotter getter
+	)
+
+	assert.Empty(t, errs.Namespaces)
+	assert.Len(t, warnings.Namespaces["ns-1"], 1)
+	assert.Equal(t, 1, counts.Unchanged)
+}
+
+// TestRestoreItems runs restores of specific items and validates that they are created
+// with the expected metadata/spec/status in the API.
+func TestRestoreItems(t *testing.T) {
+	tests := []struct {
+		name         string
+		restore      *velerov1api.Restore
+		backup       *velerov1api.Backup
+		apiResources []*test.APIResource
+		tarball      io.Reader
+		want         []*test.APIResource
+	}{
+		{
+			name:    "metadata other than namespace/name/labels/annotations gets removed",
+			restore: defaultRestore().Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").
+						ObjectMeta(
+							builder.WithLabels("key-1", "val-1"),
+							builder.WithAnnotations("key-1", "val-1"),
+							builder.WithClusterName("cluster-1"),
+							builder.WithFinalizers("finalizer-1"),
+						).
+						Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+			},
+			want: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").
+						ObjectMeta(
+							builder.WithLabels("key-1", "val-1", "velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1"),
+							builder.WithAnnotations("key-1", "val-1"),
+						).
+						Result(),
+				),
+			},
+		},
+		{
+			name:    "item labels and annotations are added to restored items",
+			restore: defaultRestore().ItemLabels("restored-by", "team-a").ItemAnnotations("ticket", "INC-1234").Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").
+						ObjectMeta(
+							builder.WithLabels("key-1", "val-1", "restored-by", "team-b"),
+						).
+						Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+			},
+			want: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").
+						ObjectMeta(
+							builder.WithLabels("key-1", "val-1", "restored-by", "team-a", "velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1"),
+							builder.WithAnnotations("ticket", "INC-1234"),
+						).
+						Result(),
+				),
+			},
+		},
+		{
+			name:    "status gets removed",
+			restore: defaultRestore().Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					&corev1api.Pod{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: "v1",
+							Kind:       "Pod",
+						},
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "ns-1",
+							Name:      "pod-1",
+						},
+						Status: corev1api.PodStatus{
+							Message: "a non-empty status",
+						},
+					},
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+			},
+			want: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Result(),
+				),
+			},
+		},
+		{
+			name:    "object gets labeled with full backup and restore names when they're both shorter than 63 characters",
+			restore: defaultRestore().Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+			},
+			want: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Result()),
+			},
+		},
+		{
+			name: "object gets labeled with full backup and restore names when they're both equal to 63 characters",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "the-really-long-kube-service-name-that-is-exactly-63-characters").
+				Backup("the-really-long-kube-service-name-that-is-exactly-63-characters").
+				Result(),
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "the-really-long-kube-service-name-that-is-exactly-63-characters").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+			},
+			want: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").
+						ObjectMeta(
+							builder.WithLabels(
+								"velero.io/backup-name", "the-really-long-kube-service-name-that-is-exactly-63-characters",
+								"velero.io/restore-name", "the-really-long-kube-service-name-that-is-exactly-63-characters",
+							),
+						).Result(),
+				),
+			},
+		},
+		{
+			name: "object gets labeled with shortened backup and restore names when they're both longer than 63 characters",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "the-really-long-kube-service-name-that-is-much-greater-than-63-characters").
+				Backup("the-really-long-kube-service-name-that-is-much-greater-than-63-characters").
+				Result(),
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "the-really-long-kube-service-name-that-is-much-greater-than-63-characters").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+			},
+			want: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-1").
+					ObjectMeta(
+						builder.WithLabels(
+							"velero.io/backup-name", "the-really-long-kube-service-name-that-is-much-greater-th8a11b3",
+							"velero.io/restore-name", "the-really-long-kube-service-name-that-is-much-greater-th8a11b3",
+						),
+					).
+					Result(),
+				),
+			},
+		},
+		{
+			name:    "no error when service account already exists in cluster and is identical to the backed up one",
+			restore: defaultRestore().Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("serviceaccounts", builder.ForServiceAccount("ns-1", "sa-1").Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.ServiceAccounts(builder.ForServiceAccount("ns-1", "sa-1").Result()),
+			},
+			want: []*test.APIResource{
+				test.ServiceAccounts(builder.ForServiceAccount("ns-1", "sa-1").Result()),
+			},
+		},
+		{
+			name:    "service account secrets and image pull secrets are restored when service account already exists in cluster",
+			restore: defaultRestore().Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("serviceaccounts", &corev1api.ServiceAccount{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "v1",
+						Kind:       "ServiceAccount",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "sa-1",
+					},
+					Secrets:          []corev1api.ObjectReference{{Name: "secret-1"}},
+					ImagePullSecrets: []corev1api.LocalObjectReference{{Name: "pull-secret-1"}},
+				}).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.ServiceAccounts(builder.ForServiceAccount("ns-1", "sa-1").Result()),
+			},
+			want: []*test.APIResource{
+				test.ServiceAccounts(&corev1api.ServiceAccount{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "v1",
+						Kind:       "ServiceAccount",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "sa-1",
+					},
+					Secrets:          []corev1api.ObjectReference{{Name: "secret-1"}},
+					ImagePullSecrets: []corev1api.LocalObjectReference{{Name: "pull-secret-1"}},
+				}),
+			},
+		},
+		{
+			name:    "existing service account is replaced with the backed-up version, keeping its token secrets, when service account policy is replace",
+			restore: defaultRestore().ServiceAccountPolicy(velerov1api.ServiceAccountPolicyReplace).Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("serviceaccounts", &corev1api.ServiceAccount{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "v1",
+						Kind:       "ServiceAccount",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "sa-1",
+					},
+					Secrets:          []corev1api.ObjectReference{{Name: "secret-1"}},
+					ImagePullSecrets: []corev1api.LocalObjectReference{{Name: "pull-secret-1"}},
+				}).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.ServiceAccounts(&corev1api.ServiceAccount{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "v1",
+						Kind:       "ServiceAccount",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "sa-1",
+					},
+					Secrets:          []corev1api.ObjectReference{{Name: "secret-2"}, {Name: "sa-1-token-abcde"}},
+					ImagePullSecrets: []corev1api.LocalObjectReference{{Name: "pull-secret-2"}},
+				}),
+			},
+			want: []*test.APIResource{
+				test.ServiceAccounts(&corev1api.ServiceAccount{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "v1",
+						Kind:       "ServiceAccount",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "sa-1",
+					},
+					Secrets:          []corev1api.ObjectReference{{Name: "secret-1"}, {Name: "sa-1-token-abcde"}},
+					ImagePullSecrets: []corev1api.LocalObjectReference{{Name: "pull-secret-1"}},
+				}),
+			},
+		},
+		{
+			name:    "existing service account is left as it is when service account policy is skip",
+			restore: defaultRestore().ServiceAccountPolicy(velerov1api.ServiceAccountPolicySkip).Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("serviceaccounts", &corev1api.ServiceAccount{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "v1",
+						Kind:       "ServiceAccount",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "sa-1",
+					},
+					Secrets: []corev1api.ObjectReference{{Name: "secret-1"}},
+				}).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.ServiceAccounts(builder.ForServiceAccount("ns-1", "sa-1").Result()),
+			},
+			want: []*test.APIResource{
+				test.ServiceAccounts(builder.ForServiceAccount("ns-1", "sa-1").Result()),
+			},
+		},
+		{
+			name:    "existing item is replaced with the backed-up version when existing resource policy is update",
+			restore: defaultRestore().ExistingResourcePolicy(velerov1api.PolicyTypeUpdate).Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "from-backup")).Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("foo", "bar")).Result()),
+			},
+			want: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "from-backup", "velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Result()),
+			},
+		},
+		{
+			name:    "backed-up version is merged into existing item when existing resource policy is patch",
+			restore: defaultRestore().ExistingResourcePolicy(velerov1api.PolicyTypePatch).Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "from-backup")).Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("foo", "bar")).Result()),
+			},
+			want: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "from-backup", "foo", "bar", "velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Result()),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+
+			for _, r := range tc.apiResources {
+				h.AddItems(t, r)
+			}
+
+			data := Request{
+				Log:              h.log,
+				Restore:          tc.restore,
+				Backup:           tc.backup,
+				PodVolumeBackups: nil,
+				VolumeSnapshots:  nil,
+				BackupReader:     tc.tarball,
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // actions
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, warnings, errs)
+			assertRestoredItems(t, h, tc.want)
+		})
+	}
+}
+
+// TestRestoreConflictPolicies runs restores of items that already exist in the
+// cluster with different conflict policies, and verifies the items in the API
+// and the restore's conflict report.
+func TestRestoreConflictPolicies(t *testing.T) {
+	existingPod := builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("foo", "bar")).Result()
+	tarball := func() io.Reader {
+		return test.NewTarWriter(t).
+			AddItems("pods", builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "from-backup")).Result()).
+			AddItems("secrets", builder.ForSecret("ns-1", "secret-1").Result()).
+			Done()
+	}
+
+	tests := []struct {
+		name          string
+		restore       *velerov1api.Restore
+		want          []*test.APIResource
+		wantContents  map[*test.APIResource][]string
+		wantErrs      Result
+		wantWarnings  int
+		wantConflicts Result
+	}{
+		{
+			name:    "conflicting items are left as they are when their conflict policy is skip",
+			restore: defaultRestore().ConflictPolicy(velerov1api.ConflictPolicySkip, "pods").Result(),
+			want: []*test.APIResource{
+				test.Pods(existingPod),
+			},
+			wantContents: map[*test.APIResource][]string{
+				test.Secrets(): {"ns-1/secret-1"},
+			},
+			wantConflicts: Result{
+				Namespaces: map[string][]string{
+					"ns-1": {"pods/ns-1/pod-1: skipped according to the conflict policy"},
+				},
+			},
+		},
+		{
+			name:    "conflicting items are replaced with the backed-up version when their conflict policy is overwrite",
+			restore: defaultRestore().ConflictPolicy(velerov1api.ConflictPolicyOverwrite, "pods").Result(),
+			want: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "from-backup", "velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Result()),
+			},
+			wantContents: map[*test.APIResource][]string{
+				test.Secrets(): {"ns-1/secret-1"},
+			},
+			wantConflicts: Result{
+				Namespaces: map[string][]string{
+					"ns-1": {"pods/ns-1/pod-1: overwritten with the backed-up version according to the conflict policy"},
+				},
+			},
+		},
+		{
+			name:    "the wildcard conflict policy applies to resources without their own conflict policy",
+			restore: defaultRestore().ConflictPolicy(velerov1api.ConflictPolicyOverwrite, "secrets").ConflictPolicy(velerov1api.ConflictPolicySkip, "*").Result(),
+			want: []*test.APIResource{
+				test.Pods(existingPod),
+			},
+			wantContents: map[*test.APIResource][]string{
+				test.Secrets(): {"ns-1/secret-1"},
+			},
+			wantConflicts: Result{
+				Namespaces: map[string][]string{
+					"ns-1": {"pods/ns-1/pod-1: skipped according to the conflict policy"},
+				},
+			},
+		},
+		{
+			name:    "no further items are restored after a conflicting item whose conflict policy is fail-fast",
+			restore: defaultRestore().ConflictPolicy(velerov1api.ConflictPolicyFailFast, "pods").Result(),
+			want: []*test.APIResource{
+				test.Pods(existingPod),
+			},
+			wantContents: map[*test.APIResource][]string{
+				test.Secrets(): {},
+			},
+			wantErrs: Result{
+				Namespaces: map[string][]string{
+					"ns-1": {`pods/ns-1/pod-1 already exists in the cluster and is different than the backed-up version; no further items were restored because its conflict policy is "fail-fast"`},
+				},
+			},
+			wantConflicts: Result{
+				Namespaces: map[string][]string{
+					"ns-1": {"pods/ns-1/pod-1: stopped the restore according to the conflict policy"},
+				},
+			},
+		},
+		{
+			name:    "conflicting items without a conflict policy are reported",
+			restore: defaultRestore().Result(),
+			want: []*test.APIResource{
+				test.Pods(existingPod),
+			},
+			wantContents: map[*test.APIResource][]string{
+				test.Secrets(): {"ns-1/secret-1"},
+			},
+			wantWarnings: 1,
+			wantConflicts: Result{
+				Namespaces: map[string][]string{
+					"ns-1": {"pods/ns-1/pod-1: not restored; the in-cluster version was kept"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.AddItems(t, test.Pods(existingPod))
+			h.AddItems(t, test.Secrets())
+
+			conflicts := new(Result)
+			data := Request{
+				Log:            h.log,
+				Restore:        tc.restore,
+				Backup:         defaultBackup().Result(),
+				BackupReader:   tarball(),
+				ConflictReport: conflicts,
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // actions
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assert.Equal(t, tc.wantErrs, errs)
+			assert.Len(t, warnings.Namespaces["ns-1"], tc.wantWarnings)
+			assert.Equal(t, tc.wantConflicts, *conflicts)
+			assertRestoredItems(t, h, tc.want)
+			assertAPIContents(t, h, tc.wantContents)
+		})
+	}
+}
+
+// TestRestoreItemStatus runs restores with and without the status of resources
+// being restored, and verifies the status of the restored items in the API.
+func TestRestoreItemStatus(t *testing.T) {
+	podWithStatus := func(ns, name string) *corev1api.Pod {
+		pod := builder.ForPod(ns, name).Result()
+		pod.Status.Message = "a non-empty status"
+		return pod
+	}
+
+	tests := []struct {
+		name       string
+		restore    *velerov1api.Restore
+		tarball    io.Reader
+		wantStatus map[string]string
+	}{
+		{
+			name:    "status isn't restored by default",
+			restore: defaultRestore().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", podWithStatus("ns-1", "pod-1")).
+				Done(),
+			wantStatus: map[string]string{"pod-1": ""},
+		},
+		{
+			name:    "status is restored for included resources",
+			restore: defaultRestore().RestoreStatus([]string{"pods"}, nil).Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", podWithStatus("ns-1", "pod-1")).
+				Done(),
+			wantStatus: map[string]string{"pod-1": "a non-empty status"},
+		},
+		{
+			name:    "status isn't restored for excluded resources",
+			restore: defaultRestore().RestoreStatus([]string{"*"}, []string{"pods"}).Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", podWithStatus("ns-1", "pod-1")).
+				Done(),
+			wantStatus: map[string]string{"pod-1": ""},
+		},
+		{
+			name:    "status isn't restored when no resources are included",
+			restore: defaultRestore().RestoreStatus(nil, nil).Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", podWithStatus("ns-1", "pod-1")).
+				Done(),
+			wantStatus: map[string]string{"pod-1": ""},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.AddItems(t, test.Pods())
+
+			data := Request{
+				Log:          h.log,
+				Restore:      tc.restore,
+				Backup:       defaultBackup().Result(),
+				BackupReader: tc.tarball,
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // actions
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, warnings, errs)
+
+			for name, want := range tc.wantStatus {
+				res, err := h.DynamicClient.Resource(test.Pods().GVR()).Namespace("ns-1").Get(context.TODO(), name, metav1.GetOptions{})
+				require.NoError(t, err)
+
+				got, _, err := unstructured.NestedString(res.Object, "status", "message")
+				require.NoError(t, err)
+				assert.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+// TestRestoreStripMetadata runs restores with finalizer and owner reference
+// stripping options, and verifies that the restored items keep or lose that
+// metadata as expected.
+func TestRestoreStripMetadata(t *testing.T) {
+	podWithMetadata := func(ns, name string) *corev1api.Pod {
+		pod := builder.ForPod(ns, name).ObjectMeta(builder.WithFinalizers("finalizer-1")).Result()
+		pod.OwnerReferences = []metav1.OwnerReference{
+			{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs-1", UID: "uid-1"},
+		}
+		return pod
+	}
+
+	tests := []struct {
+		name                string
+		restore             *velerov1api.Restore
+		wantFinalizers      bool
+		wantOwnerReferences bool
+	}{
+		{
+			name:    "finalizers and owner references are stripped by default",
+			restore: defaultRestore().Result(),
+		},
+		{
+			name:                "finalizers are stripped and owner references are kept for excluded resources",
+			restore:             defaultRestore().StripOwnerReferences(nil, []string{"pods"}).Result(),
+			wantOwnerReferences: true,
+		},
+		{
+			name:           "owner references are stripped and finalizers are kept for resources that aren't included",
+			restore:        defaultRestore().StripFinalizers([]string{"deployments.apps"}, nil).Result(),
+			wantFinalizers: true,
+		},
+		{
+			name:                "finalizers and owner references are kept when all resources are excluded",
+			restore:             defaultRestore().StripFinalizers(nil, []string{"*"}).StripOwnerReferences(nil, []string{"*"}).Result(),
+			wantFinalizers:      true,
+			wantOwnerReferences: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.AddItems(t, test.Pods())
+
+			data := Request{
+				Log:          h.log,
+				Restore:      tc.restore,
+				Backup:       defaultBackup().Result(),
+				BackupReader: test.NewTarWriter(t).AddItems("pods", podWithMetadata("ns-1", "pod-1")).Done(),
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // actions
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, warnings, errs)
+
+			res, err := h.DynamicClient.Resource(test.Pods().GVR()).Namespace("ns-1").Get(context.TODO(), "pod-1", metav1.GetOptions{})
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.wantFinalizers, len(res.GetFinalizers()) > 0)
+			assert.Equal(t, tc.wantOwnerReferences, len(res.GetOwnerReferences()) > 0)
+		})
+	}
+}
+
+// TestRestoreOwnerReferences runs restores of items whose owner references are
+// kept, and verifies that the owner references are updated with the UIDs of the
+// owners in the cluster.
+func TestRestoreOwnerReferences(t *testing.T) {
+	// persistent volume claims are restored before pods, so the pod that owns
+	// the claim must be restored first.
+	pvcOwnedBy := func(ownerName string) *corev1api.PersistentVolumeClaim {
+		pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").Result()
+		pvc.OwnerReferences = []metav1.OwnerReference{
+			{APIVersion: "v1", Kind: "Pod", Name: ownerName, UID: "backup-uid"},
+		}
+		return pvc
+	}
+
+	tests := []struct {
+		name    string
+		restore *velerov1api.Restore
+		tarball io.Reader
+		want    []metav1.OwnerReference
+	}{
+		{
+			name:    "owner references are updated with the UIDs of owners in the backup",
+			restore: defaultRestore().StripOwnerReferences(nil, []string{"*"}).Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("persistentvolumeclaims", pvcOwnedBy("pod-1")).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithUID("backup-uid")).Result()).
+				Done(),
+			want: []metav1.OwnerReference{
+				{APIVersion: "v1", Kind: "Pod", Name: "pod-1", UID: "cluster-uid"},
+			},
+		},
+		{
+			name:    "owner references to owners that aren't in the backup keep their UIDs",
+			restore: defaultRestore().StripOwnerReferences(nil, []string{"*"}).Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("persistentvolumeclaims", pvcOwnedBy("pod-2")).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithUID("backup-uid")).Result()).
+				Done(),
+			want: []metav1.OwnerReference{
+				{APIVersion: "v1", Kind: "Pod", Name: "pod-2", UID: "backup-uid"},
 			},
 		},
 		{
-			name:    "status gets removed",
+			name:    "owner references are removed when they're stripped",
 			restore: defaultRestore().Result(),
-			backup:  defaultBackup().Result(),
 			tarball: test.NewTarWriter(t).
-				AddItems("pods",
-					&corev1api.Pod{
-						TypeMeta: metav1.TypeMeta{
-							APIVersion: "v1",
-							Kind:       "Pod",
-						},
-						ObjectMeta: metav1.ObjectMeta{
-							Namespace: "ns-1",
-							Name:      "pod-1",
-						},
-						Status: corev1api.PodStatus{
-							Message: "a non-empty status",
-						},
-					},
-				).
+				AddItems("persistentvolumeclaims", pvcOwnedBy("pod-1")).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithUID("backup-uid")).Result()).
 				Done(),
-			apiResources: []*test.APIResource{
-				test.Pods(),
-			},
-			want: []*test.APIResource{
-				test.Pods(
-					builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Result(),
-				),
-			},
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.AddItems(t, test.PVCs())
+			h.AddItems(t, test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithUID("cluster-uid")).Result()))
+
+			data := Request{
+				Log:          h.log,
+				Restore:      tc.restore,
+				Backup:       defaultBackup().Result(),
+				BackupReader: tc.tarball,
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // actions
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, warnings, errs)
+
+			res, err := h.DynamicClient.Resource(test.PVCs().GVR()).Namespace("ns-1").Get(context.TODO(), "pvc-1", metav1.GetOptions{})
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.want, res.GetOwnerReferences())
+		})
+	}
+}
+
+// TestRestoreReadinessGates runs restores with readiness gates, and verifies
+// that a warning is recorded for each restored item that doesn't become ready.
+func TestRestoreReadinessGates(t *testing.T) {
+	availableDeployment := func(ns, name string) *appsv1.Deployment {
+		deploy := builder.ForDeployment(ns, name).Result()
+		deploy.Status.Conditions = []appsv1.DeploymentCondition{
+			{Type: appsv1.DeploymentAvailable, Status: corev1api.ConditionTrue},
+		}
+		return deploy
+	}
+
+	tests := []struct {
+		name         string
+		restore      *velerov1api.Restore
+		tarball      io.Reader
+		wantWarnings Result
+	}{
 		{
-			name:    "object gets labeled with full backup and restore names when they're both shorter than 63 characters",
-			restore: defaultRestore().Result(),
-			backup:  defaultBackup().Result(),
+			name: "no warnings when items become ready",
+			restore: defaultRestore().
+				RestoreStatus([]string{"deployments.apps"}, nil).
+				ReadinessGate("deployments.apps", "", time.Minute).
+				Result(),
 			tarball: test.NewTarWriter(t).
-				AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+				AddItems("deployments.apps", availableDeployment("ns-1", "deploy-1")).
 				Done(),
-			apiResources: []*test.APIResource{
-				test.Pods(),
-			},
-			want: []*test.APIResource{
-				test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Result()),
-			},
 		},
 		{
-			name: "object gets labeled with full backup and restore names when they're both equal to 63 characters",
-			restore: builder.ForRestore(velerov1api.DefaultNamespace, "the-really-long-kube-service-name-that-is-exactly-63-characters").
-				Backup("the-really-long-kube-service-name-that-is-exactly-63-characters").
+			name: "a warning is recorded for each item that doesn't become ready",
+			restore: defaultRestore().
+				ReadinessGate("deployments.apps", "", time.Millisecond).
 				Result(),
-			backup: builder.ForBackup(velerov1api.DefaultNamespace, "the-really-long-kube-service-name-that-is-exactly-63-characters").Result(),
 			tarball: test.NewTarWriter(t).
-				AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+				AddItems("deployments.apps",
+					availableDeployment("ns-1", "deploy-1"),
+					availableDeployment("ns-2", "deploy-2"),
+				).
 				Done(),
-			apiResources: []*test.APIResource{
-				test.Pods(),
-			},
-			want: []*test.APIResource{
-				test.Pods(
-					builder.ForPod("ns-1", "pod-1").
-						ObjectMeta(
-							builder.WithLabels(
-								"velero.io/backup-name", "the-really-long-kube-service-name-that-is-exactly-63-characters",
-								"velero.io/restore-name", "the-really-long-kube-service-name-that-is-exactly-63-characters",
-							),
-						).Result(),
-				),
+			wantWarnings: Result{
+				Namespaces: map[string][]string{
+					"ns-1": {"deployments.apps ns-1/deploy-1 did not have condition Available within 1ms"},
+					"ns-2": {"deployments.apps ns-2/deploy-2 did not have condition Available within 1ms"},
+				},
 			},
 		},
 		{
-			name: "object gets labeled with shortened backup and restore names when they're both longer than 63 characters",
-			restore: builder.ForRestore(velerov1api.DefaultNamespace, "the-really-long-kube-service-name-that-is-much-greater-than-63-characters").
-				Backup("the-really-long-kube-service-name-that-is-much-greater-than-63-characters").
+			name: "no warnings when items match a jsonPath gate",
+			restore: defaultRestore().
+				RestoreStatus([]string{"deployments.apps"}, nil).
+				ReadinessGateJSONPath("deployments.apps", `{.status.conditions[?(@.type=="Available")].status}`, "True", time.Minute).
 				Result(),
-			backup: builder.ForBackup(velerov1api.DefaultNamespace, "the-really-long-kube-service-name-that-is-much-greater-than-63-characters").Result(),
 			tarball: test.NewTarWriter(t).
-				AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+				AddItems("deployments.apps", availableDeployment("ns-1", "deploy-1")).
 				Done(),
-			apiResources: []*test.APIResource{
-				test.Pods(),
-			},
-			want: []*test.APIResource{
-				test.Pods(builder.ForPod("ns-1", "pod-1").
-					ObjectMeta(
-						builder.WithLabels(
-							"velero.io/backup-name", "the-really-long-kube-service-name-that-is-much-greater-th8a11b3",
-							"velero.io/restore-name", "the-really-long-kube-service-name-that-is-much-greater-th8a11b3",
-						),
-					).
-					Result(),
-				),
-			},
 		},
 		{
-			name:    "no error when service account already exists in cluster and is identical to the backed up one",
-			restore: defaultRestore().Result(),
-			backup:  defaultBackup().Result(),
+			name: "a warning is recorded for each item that doesn't match a jsonPath gate",
+			restore: defaultRestore().
+				RestoreStatus([]string{"deployments.apps"}, nil).
+				ReadinessGateJSONPath("deployments.apps", "{.status.readyReplicas}", "3", time.Millisecond).
+				Result(),
 			tarball: test.NewTarWriter(t).
-				AddItems("serviceaccounts", builder.ForServiceAccount("ns-1", "sa-1").Result()).
+				AddItems("deployments.apps", availableDeployment("ns-1", "deploy-1")).
 				Done(),
-			apiResources: []*test.APIResource{
-				test.ServiceAccounts(builder.ForServiceAccount("ns-1", "sa-1").Result()),
-			},
-			want: []*test.APIResource{
-				test.ServiceAccounts(builder.ForServiceAccount("ns-1", "sa-1").Result()),
+			wantWarnings: Result{
+				Namespaces: map[string][]string{
+					"ns-1": {"deployments.apps ns-1/deploy-1 did not have jsonPath {.status.readyReplicas}=3 within 1ms"},
+				},
 			},
 		},
 		{
-			name:    "service account secrets and image pull secrets are restored when service account already exists in cluster",
-			restore: defaultRestore().Result(),
-			backup:  defaultBackup().Result(),
+			name: "items of resources without readiness gates aren't waited for",
+			restore: defaultRestore().
+				ReadinessGate("pods", "", time.Millisecond).
+				Result(),
 			tarball: test.NewTarWriter(t).
-				AddItems("serviceaccounts", &corev1api.ServiceAccount{
-					TypeMeta: metav1.TypeMeta{
-						APIVersion: "v1",
-						Kind:       "ServiceAccount",
-					},
-					ObjectMeta: metav1.ObjectMeta{
-						Namespace: "ns-1",
-						Name:      "sa-1",
-					},
-					Secrets:          []corev1api.ObjectReference{{Name: "secret-1"}},
-					ImagePullSecrets: []corev1api.LocalObjectReference{{Name: "pull-secret-1"}},
-				}).
+				AddItems("deployments.apps", availableDeployment("ns-1", "deploy-1")).
 				Done(),
-			apiResources: []*test.APIResource{
-				test.ServiceAccounts(builder.ForServiceAccount("ns-1", "sa-1").Result()),
-			},
-			want: []*test.APIResource{
-				test.ServiceAccounts(&corev1api.ServiceAccount{
-					TypeMeta: metav1.TypeMeta{
-						APIVersion: "v1",
-						Kind:       "ServiceAccount",
-					},
-					ObjectMeta: metav1.ObjectMeta{
-						Namespace: "ns-1",
-						Name:      "sa-1",
-					},
-					Secrets:          []corev1api.ObjectReference{{Name: "secret-1"}},
-					ImagePullSecrets: []corev1api.LocalObjectReference{{Name: "pull-secret-1"}},
-				}),
-			},
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			h := newHarness(t)
-
-			for _, r := range tc.apiResources {
-				h.AddItems(t, r)
-			}
+			h.AddItems(t, test.Pods())
+			h.AddItems(t, test.Deployments())
 
 			data := Request{
-				Log:              h.log,
-				Restore:          tc.restore,
-				Backup:           tc.backup,
-				PodVolumeBackups: nil,
-				VolumeSnapshots:  nil,
-				BackupReader:     tc.tarball,
+				Log:          h.log,
+				Restore:      tc.restore,
+				Backup:       defaultBackup().Result(),
+				BackupReader: tc.tarball,
 			}
 			warnings, errs := h.restorer.Restore(
 				data,
@@ -1004,8 +2287,8 @@ func TestRestoreItems(t *testing.T) {
 				nil, // volume snapshotter getter
 			)
 
-			assertEmptyResults(t, warnings, errs)
-			assertRestoredItems(t, h, tc.want)
+			assertEmptyResults(t, errs)
+			assert.Equal(t, tc.wantWarnings, warnings)
 		})
 	}
 }
@@ -1269,33 +2552,123 @@ func TestRestoreActionModifications(t *testing.T) {
 			backup:       defaultBackup().Result(),
 			tarball:      test.NewTarWriter(t).AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).Done(),
 			apiResources: []*test.APIResource{test.Pods()},
-			actions: []velero.RestoreItemAction{
-				modifyingActionGetter(func(item *unstructured.Unstructured) {
-					item.SetLabels(map[string]string{"updated": "true"})
-				}),
-			},
+			actions: []velero.RestoreItemAction{
+				modifyingActionGetter(func(item *unstructured.Unstructured) {
+					item.SetLabels(map[string]string{"updated": "true"})
+				}),
+			},
+			want: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("updated", "true")).Result(),
+				),
+			},
+		},
+		{
+			name:         "action that removes a label to item gets restored",
+			restore:      defaultRestore().Result(),
+			backup:       defaultBackup().Result(),
+			tarball:      test.NewTarWriter(t).AddItems("pods", builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("should-be-removed", "true")).Result()).Done(),
+			apiResources: []*test.APIResource{test.Pods()},
+			actions: []velero.RestoreItemAction{
+				modifyingActionGetter(func(item *unstructured.Unstructured) {
+					item.SetLabels(nil)
+				}),
+			},
+			want: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-1").Result()),
+			},
+		},
+		// TODO action that modifies namespace/name - what's the expected behavior?
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+
+			for _, r := range tc.apiResources {
+				h.AddItems(t, r)
+			}
+
+			// every restored item should have the restore and backup name labels, set
+			// them here so we don't have to do it in every test case definition above.
+			for _, resource := range tc.want {
+				for _, item := range resource.Items {
+					labels := item.GetLabels()
+					if labels == nil {
+						labels = make(map[string]string)
+					}
+
+					labels["velero.io/restore-name"] = tc.restore.Name
+					labels["velero.io/backup-name"] = tc.restore.Spec.BackupName
+
+					item.SetLabels(labels)
+				}
+			}
+
+			data := Request{
+				Log:              h.log,
+				Restore:          tc.restore,
+				Backup:           tc.backup,
+				PodVolumeBackups: nil,
+				VolumeSnapshots:  nil,
+				BackupReader:     tc.tarball,
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				tc.actions,
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, warnings, errs)
+			assertRestoredItems(t, h, tc.want)
+		})
+	}
+}
+
+// TestRestoreResourceModifiers runs restores with resource modifier rules, and
+// verifies that the items created in the API have been patched accordingly.
+func TestRestoreResourceModifiers(t *testing.T) {
+	tests := []struct {
+		name         string
+		restore      *velerov1api.Restore
+		backup       *velerov1api.Backup
+		apiResources []*test.APIResource
+		tarball      io.Reader
+		rules        string
+		want         []*test.APIResource
+	}{
+		{
+			name:    "items matching a rule are patched before being restored",
+			restore: defaultRestore().Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{test.Pods()},
+			rules: `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: pods
+    namespaces:
+    - ns-1
+  patches:
+  - operation: add
+    path: /metadata/annotations
+    value:
+      modified: "true"
+`,
 			want: []*test.APIResource{
 				test.Pods(
-					builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("updated", "true")).Result(),
+					builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithAnnotations("modified", "true")).Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
 				),
 			},
 		},
-		{
-			name:         "action that removes a label to item gets restored",
-			restore:      defaultRestore().Result(),
-			backup:       defaultBackup().Result(),
-			tarball:      test.NewTarWriter(t).AddItems("pods", builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("should-be-removed", "true")).Result()).Done(),
-			apiResources: []*test.APIResource{test.Pods()},
-			actions: []velero.RestoreItemAction{
-				modifyingActionGetter(func(item *unstructured.Unstructured) {
-					item.SetLabels(nil)
-				}),
-			},
-			want: []*test.APIResource{
-				test.Pods(builder.ForPod("ns-1", "pod-1").Result()),
-			},
-		},
-		// TODO action that modifies namespace/name - what's the expected behavior?
 	}
 
 	for _, tc := range tests {
@@ -1322,17 +2695,23 @@ func TestRestoreActionModifications(t *testing.T) {
 				}
 			}
 
+			modifiers, err := resourcemodifiers.GetResourceModifiersFromConfig(
+				builder.ForConfigMap(velerov1api.DefaultNamespace, "modifiers").Data("rules.yaml", tc.rules).Result(),
+			)
+			require.NoError(t, err)
+
 			data := Request{
-				Log:              h.log,
-				Restore:          tc.restore,
-				Backup:           tc.backup,
-				PodVolumeBackups: nil,
-				VolumeSnapshots:  nil,
-				BackupReader:     tc.tarball,
+				Log:               h.log,
+				Restore:           tc.restore,
+				Backup:            tc.backup,
+				PodVolumeBackups:  nil,
+				VolumeSnapshots:   nil,
+				BackupReader:      tc.tarball,
+				ResourceModifiers: modifiers,
 			}
 			warnings, errs := h.restorer.Restore(
 				data,
-				tc.actions,
+				nil, // actions
 				nil, // snapshot location lister
 				nil, // volume snapshotter getter
 			)
@@ -1510,6 +2889,88 @@ func TestRestoreActionAdditionalItems(t *testing.T) {
 	}
 }
 
+// TestRestoreActionWaitForAdditionalItems runs restores with restore item actions that ask to wait
+// for their additional items to become ready, and verifies that a warning is returned for each
+// additional item that doesn't become ready.
+func TestRestoreActionWaitForAdditionalItems(t *testing.T) {
+	waitingActionGetter := func(additionalItem velero.ResourceIdentifier) *pluggableAction {
+		return &pluggableAction{
+			selector: velero.ResourceSelector{IncludedNamespaces: []string{"ns-1"}},
+			executeFunc: func(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+				res := velero.NewRestoreItemActionExecuteOutput(input.Item).WithWaitForAdditionalItems(time.Millisecond)
+				res.AdditionalItems = []velero.ResourceIdentifier{additionalItem}
+				return res, nil
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		tarball      io.Reader
+		apiResources []*test.APIResource
+		actions      []velero.RestoreItemAction
+		want         map[*test.APIResource][]string
+		wantWarnings int
+	}{
+		{
+			name: "additional items without a readiness condition are ready once restored",
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+				AddItems("persistentvolumes", builder.ForPersistentVolume("pv-1").Result()).
+				Done(),
+			apiResources: []*test.APIResource{test.Pods(), test.PVs()},
+			actions: []velero.RestoreItemAction{
+				waitingActionGetter(velero.ResourceIdentifier{GroupResource: kuberesource.PersistentVolumes, Name: "pv-1"}),
+			},
+			want: map[*test.APIResource][]string{
+				test.Pods(): {"ns-1/pod-1"},
+				test.PVs():  {"/pv-1"},
+			},
+		},
+		{
+			name:         "additional items that don't have their readiness condition result in a warning",
+			tarball:      test.NewTarWriter(t).AddItems("pods", builder.ForPod("ns-1", "pod-1").Result(), builder.ForPod("ns-2", "pod-2").Result()).Done(),
+			apiResources: []*test.APIResource{test.Pods()},
+			actions: []velero.RestoreItemAction{
+				waitingActionGetter(velero.ResourceIdentifier{GroupResource: kuberesource.Pods, Namespace: "ns-2", Name: "pod-2"}),
+			},
+			want: map[*test.APIResource][]string{
+				test.Pods(): {"ns-1/pod-1", "ns-2/pod-2"},
+			},
+			wantWarnings: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+
+			for _, r := range tc.apiResources {
+				h.AddItems(t, r)
+			}
+
+			data := Request{
+				Log:              h.log,
+				Restore:          defaultRestore().Result(),
+				Backup:           defaultBackup().Result(),
+				PodVolumeBackups: nil,
+				VolumeSnapshots:  nil,
+				BackupReader:     tc.tarball,
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				tc.actions,
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, errs)
+			assert.Len(t, warnings.Namespaces["ns-2"], tc.wantWarnings)
+			assertAPIContents(t, h, tc.want)
+		})
+	}
+}
+
 // TestShouldRestore runs the ShouldRestore function for various permutations of
 // existing/nonexisting/being-deleted PVs, PVCs, and namespaces, and verifies the
 // result/error matches expectations.
@@ -1795,6 +3256,7 @@ func TestRestorePersistentVolumes(t *testing.T) {
 		volumeSnapshotLocations []*velerov1api.VolumeSnapshotLocation
 		volumeSnapshotterGetter volumeSnapshotterGetter
 		want                    []*test.APIResource
+		wantRenamedPVs          map[string]string
 	}{
 		{
 			name:    "when a PV with a reclaim policy of delete has no snapshot and does not exist in-cluster, it does not get restored, and its PVC gets reset for dynamic provisioning",
@@ -2360,12 +3822,135 @@ func TestRestorePersistentVolumes(t *testing.T) {
 				),
 			},
 		},
+		{
+			name:    "when the PV rename policy is Always, a PV with a snapshot is renamed even if it does not exist in-cluster",
+			restore: defaultRestore().PVRenamePolicy(velerov1api.PVRenamePolicyAlways).Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems(
+					"persistentvolumes",
+					builder.ForPersistentVolume("source-pv").AWSEBSVolumeID("source-volume").ClaimRef("source-ns", "pvc-1").Result(),
+				).
+				AddItems(
+					"persistentvolumeclaims",
+					builder.ForPersistentVolumeClaim("source-ns", "pvc-1").VolumeName("source-pv").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.PVs(),
+				test.PVCs(),
+			},
+			volumeSnapshots: []*volume.Snapshot{
+				{
+					Spec: volume.SnapshotSpec{
+						BackupName:           "backup-1",
+						Location:             "default",
+						PersistentVolumeName: "source-pv",
+					},
+					Status: volume.SnapshotStatus{
+						Phase:              volume.SnapshotPhaseCompleted,
+						ProviderSnapshotID: "snapshot-1",
+					},
+				},
+			},
+			volumeSnapshotLocations: []*velerov1api.VolumeSnapshotLocation{
+				builder.ForVolumeSnapshotLocation(velerov1api.DefaultNamespace, "default").Provider("provider-1").Result(),
+			},
+			volumeSnapshotterGetter: map[string]velero.VolumeSnapshotter{
+				"provider-1": &volumeSnapshotter{
+					snapshotVolumes: map[string]string{"snapshot-1": "new-volume"},
+				},
+			},
+			want: []*test.APIResource{
+				test.PVs(
+					builder.ForPersistentVolume("renamed-source-pv").
+						ObjectMeta(
+							builder.WithAnnotations("velero.io/original-pv-name", "source-pv"),
+							builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1"),
+						).
+						AWSEBSVolumeID("new-volume").
+						Result(),
+				),
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("source-ns", "pvc-1").
+						ObjectMeta(
+							builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1"),
+						).
+						VolumeName("renamed-source-pv").
+						Result(),
+				),
+			},
+			wantRenamedPVs: map[string]string{"source-pv": "renamed-source-pv"},
+		},
+		{
+			name:    "when the PV rename policy is OnConflict, a PV with a snapshot that exists in-cluster is renamed even if its namespace is not being remapped",
+			restore: defaultRestore().PVRenamePolicy(velerov1api.PVRenamePolicyOnConflict).Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems(
+					"persistentvolumes",
+					builder.ForPersistentVolume("source-pv").AWSEBSVolumeID("source-volume").ClaimRef("source-ns", "pvc-1").Result(),
+				).
+				AddItems(
+					"persistentvolumeclaims",
+					builder.ForPersistentVolumeClaim("source-ns", "pvc-1").VolumeName("source-pv").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.PVs(
+					builder.ForPersistentVolume("source-pv").AWSEBSVolumeID("source-volume").ClaimRef("source-ns", "pvc-1").Result(),
+				),
+				test.PVCs(),
+			},
+			volumeSnapshots: []*volume.Snapshot{
+				{
+					Spec: volume.SnapshotSpec{
+						BackupName:           "backup-1",
+						Location:             "default",
+						PersistentVolumeName: "source-pv",
+					},
+					Status: volume.SnapshotStatus{
+						Phase:              volume.SnapshotPhaseCompleted,
+						ProviderSnapshotID: "snapshot-1",
+					},
+				},
+			},
+			volumeSnapshotLocations: []*velerov1api.VolumeSnapshotLocation{
+				builder.ForVolumeSnapshotLocation(velerov1api.DefaultNamespace, "default").Provider("provider-1").Result(),
+			},
+			volumeSnapshotterGetter: map[string]velero.VolumeSnapshotter{
+				"provider-1": &volumeSnapshotter{
+					snapshotVolumes: map[string]string{"snapshot-1": "new-volume"},
+				},
+			},
+			want: []*test.APIResource{
+				test.PVs(
+					builder.ForPersistentVolume("source-pv").AWSEBSVolumeID("source-volume").ClaimRef("source-ns", "pvc-1").Result(),
+					builder.ForPersistentVolume("renamed-source-pv").
+						ObjectMeta(
+							builder.WithAnnotations("velero.io/original-pv-name", "source-pv"),
+							builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1"),
+						).
+						AWSEBSVolumeID("new-volume").
+						Result(),
+				),
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("source-ns", "pvc-1").
+						ObjectMeta(
+							builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1"),
+						).
+						VolumeName("renamed-source-pv").
+						Result(),
+				),
+			},
+			wantRenamedPVs: map[string]string{"source-pv": "renamed-source-pv"},
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			h := newHarness(t)
-			h.restorer.resourcePriorities = []string{"persistentvolumes", "persistentvolumeclaims"}
+			h.restorer.resourcePriorities = Priorities{HighPriorities: []string{"persistentvolumes", "persistentvolumeclaims"}}
 			h.restorer.pvRenamer = func(oldName string) (string, error) {
 				renamed := "renamed-" + oldName
 				return renamed, nil
@@ -2392,12 +3977,14 @@ func TestRestorePersistentVolumes(t *testing.T) {
 				}
 			}
 
+			renamedPVs := make(map[string]string)
 			data := Request{
 				Log:             h.log,
 				Restore:         tc.restore,
 				Backup:          tc.backup,
 				VolumeSnapshots: tc.volumeSnapshots,
 				BackupReader:    tc.tarball,
+				RenamedPVs:      renamedPVs,
 			}
 			warnings, errs := h.restorer.Restore(
 				data,
@@ -2409,6 +3996,86 @@ func TestRestorePersistentVolumes(t *testing.T) {
 			assertEmptyResults(t, warnings, errs)
 			assertAPIContents(t, h, wantIDs)
 			assertRestoredItems(t, h, tc.want)
+			if tc.wantRenamedPVs != nil {
+				assert.Equal(t, tc.wantRenamedPVs, renamedPVs)
+			}
+		})
+	}
+}
+
+func TestShouldRenamePV(t *testing.T) {
+	pv := builder.ForPersistentVolume("pv-1").ClaimRef("source-ns", "pvc-1").Result()
+
+	tests := []struct {
+		name            string
+		restore         *velerov1api.Restore
+		existsInCluster bool
+		want            bool
+	}{
+		{
+			name:            "no policy, namespace not remapped, PV exists in-cluster: not renamed",
+			restore:         defaultRestore().Result(),
+			existsInCluster: true,
+			want:            false,
+		},
+		{
+			name:            "no policy, namespace remapped, PV exists in-cluster: renamed",
+			restore:         defaultRestore().NamespaceMappings("source-ns", "target-ns").Result(),
+			existsInCluster: true,
+			want:            true,
+		},
+		{
+			name:            "no policy, namespace remapped, PV does not exist in-cluster: not renamed",
+			restore:         defaultRestore().NamespaceMappings("source-ns", "target-ns").Result(),
+			existsInCluster: false,
+			want:            false,
+		},
+		{
+			name:            "Always policy, PV does not exist in-cluster: renamed",
+			restore:         defaultRestore().PVRenamePolicy(velerov1api.PVRenamePolicyAlways).Result(),
+			existsInCluster: false,
+			want:            true,
+		},
+		{
+			name:            "OnConflict policy, namespace not remapped, PV exists in-cluster: renamed",
+			restore:         defaultRestore().PVRenamePolicy(velerov1api.PVRenamePolicyOnConflict).Result(),
+			existsInCluster: true,
+			want:            true,
+		},
+		{
+			name:            "OnConflict policy, PV does not exist in-cluster: not renamed",
+			restore:         defaultRestore().PVRenamePolicy(velerov1api.PVRenamePolicyOnConflict).Result(),
+			existsInCluster: false,
+			want:            false,
+		},
+		{
+			name:            "Never policy, namespace remapped, PV exists in-cluster: not renamed",
+			restore:         defaultRestore().PVRenamePolicy(velerov1api.PVRenamePolicyNever).NamespaceMappings("source-ns", "target-ns").Result(),
+			existsInCluster: true,
+			want:            false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := &restoreContext{
+				restore: tc.restore,
+				log:     logrus.StandardLogger(),
+			}
+
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pv)
+			require.NoError(t, err)
+
+			pvClient := &test.FakeDynamicClient{}
+			if tc.existsInCluster {
+				pvClient.On("Get", "pv-1", metav1.GetOptions{}).Return(&unstructured.Unstructured{Object: obj}, nil).Maybe()
+			} else {
+				pvClient.On("Get", "pv-1", metav1.GetOptions{}).Return((*unstructured.Unstructured)(nil), apierrors.NewNotFound(schema.GroupResource{Resource: "persistentvolumes"}, "pv-1")).Maybe()
+			}
+
+			got, err := shouldRenamePV(ctx, &unstructured.Unstructured{Object: obj}, pvClient)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
 		})
 	}
 }
@@ -2648,19 +4315,19 @@ func TestIsCompleted(t *testing.T) {
 func Test_getOrderedResources(t *testing.T) {
 	tests := []struct {
 		name               string
-		resourcePriorities []string
+		resourcePriorities Priorities
 		backupResources    map[string]*archive.ResourceItems
 		want               []string
 	}{
 		{
 			name:               "when only priorities are specified, they're returned in order",
-			resourcePriorities: []string{"prio-3", "prio-2", "prio-1"},
+			resourcePriorities: Priorities{HighPriorities: []string{"prio-3", "prio-2", "prio-1"}},
 			backupResources:    nil,
 			want:               []string{"prio-3", "prio-2", "prio-1"},
 		},
 		{
 			name:               "when only backup resources are specified, they're returned in alphabetical order",
-			resourcePriorities: nil,
+			resourcePriorities: Priorities{},
 			backupResources: map[string]*archive.ResourceItems{
 				"backup-resource-3": nil,
 				"backup-resource-2": nil,
@@ -2670,7 +4337,7 @@ func Test_getOrderedResources(t *testing.T) {
 		},
 		{
 			name:               "when priorities and backup resources are specified, they're returned in the correct order",
-			resourcePriorities: []string{"prio-3", "prio-2", "prio-1"},
+			resourcePriorities: Priorities{HighPriorities: []string{"prio-3", "prio-2", "prio-1"}},
 			backupResources: map[string]*archive.ResourceItems{
 				"prio-3":            nil,
 				"backup-resource-3": nil,
@@ -2679,6 +4346,19 @@ func Test_getOrderedResources(t *testing.T) {
 			},
 			want: []string{"prio-3", "prio-2", "prio-1", "backup-resource-1", "backup-resource-2", "backup-resource-3", "prio-3"},
 		},
+		{
+			name: "when low priorities are specified, they're returned in order after backup resources",
+			resourcePriorities: Priorities{
+				HighPriorities: []string{"prio-1"},
+				LowPriorities:  []string{"low-prio-2", "low-prio-1"},
+			},
+			backupResources: map[string]*archive.ResourceItems{
+				"low-prio-1":        nil,
+				"backup-resource-2": nil,
+				"backup-resource-1": nil,
+			},
+			want: []string{"prio-1", "backup-resource-1", "backup-resource-2", "low-prio-2", "low-prio-1"},
+		},
 	}
 
 	for _, tc := range tests {
@@ -2690,8 +4370,15 @@ func Test_getOrderedResources(t *testing.T) {
 
 // assertResourceCreationOrder ensures that resources were created in the expected
 // order. Any resources *not* in resourcePriorities are required to come *after* all
-// resources in any order.
-func assertResourceCreationOrder(t *testing.T, resourcePriorities []string, createdResources []resourceID) {
+// high-priority resources and *before* all low-priority resources, in any order.
+func assertResourceCreationOrder(t *testing.T, priorities Priorities, createdResources []resourceID) {
+	// build a single ordered list of resources, with an empty entry standing in for
+	// all of the resources that aren't prioritized.
+	resourcePriorities := make([]string, 0, len(priorities.HighPriorities)+1+len(priorities.LowPriorities))
+	resourcePriorities = append(resourcePriorities, priorities.HighPriorities...)
+	resourcePriorities = append(resourcePriorities, "")
+	resourcePriorities = append(resourcePriorities, priorities.LowPriorities...)
+
 	// lastSeen tracks the index in 'resourcePriorities' of the last resource type
 	// we saw created. Once we've seen a resource in 'resourcePriorities', we should
 	// never see another instance of a prior resource.
@@ -2701,11 +4388,11 @@ func assertResourceCreationOrder(t *testing.T, resourcePriorities []string, crea
 	// the current item, if it exists. This index ('current') *must*
 	// be greater than or equal to 'lastSeen', which was the last resource
 	// we saw, since otherwise the current resource would be out of order. By
-	// initializing current to len(ordered), we're saying that if the resource
-	// is not explicitly in orderedResources, then it must come *after*
-	// all orderedResources.
+	// initializing current to the index of the empty entry, we're saying that
+	// if the resource is not explicitly prioritized, then it must come *after*
+	// all high-priority resources and *before* all low-priority resources.
 	for _, r := range createdResources {
-		current := len(resourcePriorities)
+		current := len(priorities.HighPriorities)
 		for i, item := range resourcePriorities {
 			if item == r.groupResource {
 				current = i
@@ -2809,6 +4496,7 @@ func newHarness(t *testing.T) *harness {
 			discoveryHelper:            discoveryHelper,
 			dynamicFactory:             client.NewDynamicFactory(apiServer.DynamicClient),
 			namespaceClient:            apiServer.KubeClient.CoreV1().Namespaces(),
+			storageClassClient:         apiServer.KubeClient.StorageV1().StorageClasses(),
 			resourceTerminatingTimeout: time.Minute,
 			logger:                     log,
 			fileSystem:                 testutil.NewFakeFileSystem(),
@@ -53,7 +53,7 @@ func (a *RoleBindingAction) Execute(input *velero.RestoreItemActionExecuteInput)
 	}
 
 	for i, subject := range roleBinding.Subjects {
-		if newNamespace, ok := namespaceMapping[subject.Namespace]; ok {
+		if newNamespace, ok := mapNamespace(namespaceMapping, subject.Namespace); ok {
 			roleBinding.Subjects[i].Namespace = newNamespace
 		}
 	}
@@ -17,8 +17,6 @@ limitations under the License.
 package restore
 
 import (
-	"strings"
-
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
@@ -55,20 +53,17 @@ func (a *ServiceAccountAction) Execute(input *velero.RestoreItemActionExecuteInp
 	log := a.logger.WithField("serviceaccount", kube.NamespaceAndName(&serviceAccount))
 
 	log.Debug("Checking secrets")
-	check := serviceAccount.Name + "-token-"
-	for i := len(serviceAccount.Secrets) - 1; i >= 0; i-- {
-		secret := &serviceAccount.Secrets[i]
-		log.Debugf("Checking if secret %s matches %s", secret.Name, check)
-
-		if strings.HasPrefix(secret.Name, check) {
-			// Copy all secrets *except* -token-
-			log.Debug("Match found - excluding this secret")
-			serviceAccount.Secrets = append(serviceAccount.Secrets[:i], serviceAccount.Secrets[i+1:]...)
-			break
-		} else {
-			log.Debug("No match found - including this secret")
+	// Copy all secrets *except* the token secrets, which the cluster generates for
+	// each service account. There may be more than one if the token was rotated.
+	var secrets []corev1.ObjectReference
+	for _, secret := range serviceAccount.Secrets {
+		if isServiceAccountTokenSecret(serviceAccount.Name, secret.Name) {
+			log.Debugf("Excluding token secret %s", secret.Name)
+			continue
 		}
+		secrets = append(secrets, secret)
 	}
+	serviceAccount.Secrets = secrets
 
 	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&serviceAccount)
 	if err != nil {
@@ -69,6 +69,11 @@ func TestServiceAccountActionExecute(t *testing.T) {
 			secrets:  []string{"a", "baz", "bar-token-a1b2c"},
 			expected: []string{"a", "baz"},
 		},
+		{
+			name:     "match - multiple",
+			secrets:  []string{"bar-token-a1b2c", "a", "bar-token-d3e4f"},
+			expected: []string{"a"},
+		},
 	}
 
 	for _, tc := range tests {
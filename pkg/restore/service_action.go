@@ -26,7 +26,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 )
 
 const annotationLastAppliedConfig = "kubectl.kubernetes.io/last-applied-configuration"
@@ -55,7 +57,7 @@ func (a *ServiceAction) Execute(input *velero.RestoreItemActionExecuteInput) (*v
 		service.Spec.ClusterIP = ""
 	}
 
-	if err := deleteNodePorts(service); err != nil {
+	if err := deleteNodePorts(service, input.Restore); err != nil {
 		return nil, err
 	}
 
@@ -67,11 +69,31 @@ func (a *ServiceAction) Execute(input *velero.RestoreItemActionExecuteInput) (*v
 	return velero.NewRestoreItemActionExecuteOutput(&unstructured.Unstructured{Object: res}), nil
 }
 
-func deleteNodePorts(service *corev1api.Service) error {
+// deleteNodePorts clears the NodePort values of the service's ports according
+// to the restore's PreserveNodePorts setting: all NodePorts are kept if it's
+// true, all are cleared if it's false, and if it's unset, only NodePorts that
+// were explicitly specified are kept.
+func deleteNodePorts(service *corev1api.Service, restore *velerov1api.Restore) error {
 	if service.Spec.Type == corev1api.ServiceTypeExternalName {
 		return nil
 	}
 
+	var preserveNodePorts *bool
+	if restore != nil {
+		preserveNodePorts = restore.Spec.PreserveNodePorts
+	}
+
+	if boolptr.IsSetToTrue(preserveNodePorts) {
+		return nil
+	}
+
+	if boolptr.IsSetToFalse(preserveNodePorts) {
+		for i := range service.Spec.Ports {
+			service.Spec.Ports[i].NodePort = 0
+		}
+		return nil
+	}
+
 	// find any NodePorts whose values were explicitly specified according
 	// to the last-applied-config annotation. We'll retain these values, and
 	// clear out any other (presumably auto-assigned) NodePort values.
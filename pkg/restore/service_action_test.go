@@ -27,6 +27,8 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
@@ -51,6 +53,7 @@ func TestServiceActionExecute(t *testing.T) {
 	tests := []struct {
 		name        string
 		obj         corev1api.Service
+		restore     *velerov1api.Restore
 		expectedErr bool
 		expectedRes corev1api.Service
 	}{
@@ -266,6 +269,92 @@ func TestServiceActionExecute(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "all nodePorts should be preserved when restore has preserveNodePorts=true",
+			restore: builder.ForRestore("velero", "restore-1").PreserveNodePorts(true).Result(),
+			obj: corev1api.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "svc-1",
+					Annotations: map[string]string{
+						annotationLastAppliedConfig: svcJSON(corev1api.ServicePort{Name: "http", NodePort: 8080}),
+					},
+				},
+				Spec: corev1api.ServiceSpec{
+					Ports: []corev1api.ServicePort{
+						{
+							Name:     "http",
+							NodePort: 8080,
+						},
+						{
+							Name:     "admin",
+							NodePort: 9090,
+						},
+					},
+				},
+			},
+			expectedRes: corev1api.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "svc-1",
+					Annotations: map[string]string{
+						annotationLastAppliedConfig: svcJSON(corev1api.ServicePort{Name: "http", NodePort: 8080}),
+					},
+				},
+				Spec: corev1api.ServiceSpec{
+					Ports: []corev1api.ServicePort{
+						{
+							Name:     "http",
+							NodePort: 8080,
+						},
+						{
+							Name:     "admin",
+							NodePort: 9090,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "all nodePorts should be deleted when restore has preserveNodePorts=false",
+			restore: builder.ForRestore("velero", "restore-1").PreserveNodePorts(false).Result(),
+			obj: corev1api.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "svc-1",
+					Annotations: map[string]string{
+						annotationLastAppliedConfig: svcJSON(corev1api.ServicePort{Name: "http", NodePort: 8080}),
+					},
+				},
+				Spec: corev1api.ServiceSpec{
+					Ports: []corev1api.ServicePort{
+						{
+							Name:     "http",
+							NodePort: 8080,
+						},
+						{
+							Name:     "admin",
+							NodePort: 9090,
+						},
+					},
+				},
+			},
+			expectedRes: corev1api.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "svc-1",
+					Annotations: map[string]string{
+						annotationLastAppliedConfig: svcJSON(corev1api.ServicePort{Name: "http", NodePort: 8080}),
+					},
+				},
+				Spec: corev1api.ServiceSpec{
+					Ports: []corev1api.ServicePort{
+						{
+							Name: "http",
+						},
+						{
+							Name: "admin",
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -278,7 +367,7 @@ func TestServiceActionExecute(t *testing.T) {
 			res, err := action.Execute(&velero.RestoreItemActionExecuteInput{
 				Item:           &unstructured.Unstructured{Object: unstructuredSvc},
 				ItemFromBackup: &unstructured.Unstructured{Object: unstructuredSvc},
-				Restore:        nil,
+				Restore:        test.restore,
 			})
 
 			if assert.Equal(t, test.expectedErr, err != nil) && !test.expectedErr {
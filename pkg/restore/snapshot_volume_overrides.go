@@ -0,0 +1,81 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ValidateSnapshotVolumeOverrides checks that the sizes of a restore's snapshot volume
+// overrides are positive quantities, and that their IOPS are positive.
+func ValidateSnapshotVolumeOverrides(overrides map[string]velerov1api.SnapshotVolumeOverride) []error {
+	var errs []error
+
+	for storageClass, override := range overrides {
+		if override.Size != "" {
+			if quantity, err := resource.ParseQuantity(override.Size); err != nil || quantity.Sign() <= 0 {
+				errs = append(errs, errors.Errorf("invalid snapshot volume size %q for storage class %s: must be a positive quantity", override.Size, storageClass))
+			}
+		}
+
+		if override.IOPS != nil && *override.IOPS <= 0 {
+			errs = append(errs, errors.Errorf("invalid snapshot volume IOPS %d for storage class %s: must be positive", *override.IOPS, storageClass))
+		}
+	}
+
+	return errs
+}
+
+// pvStorageClass returns the name of a persistent volume's storage class.
+func pvStorageClass(obj *unstructured.Unstructured) string {
+	storageClass, _, _ := unstructured.NestedString(obj.Object, "spec", "storageClassName")
+	return storageClass
+}
+
+// snapshotVolumeSize returns the size that a volume restored from a snapshot of the
+// persistent volume is enlarged to, or nil if the persistent volume's capacity is
+// already at least size.
+func snapshotVolumeSize(obj *unstructured.Unstructured, size string) (*resource.Quantity, error) {
+	if size == "" {
+		return nil, nil
+	}
+
+	quantity, err := resource.ParseQuantity(size)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing snapshot volume size %q", size)
+	}
+
+	capacity, found, err := unstructured.NestedString(obj.Object, "spec", "capacity", "storage")
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting persistent volume's capacity")
+	}
+	if found {
+		current, err := resource.ParseQuantity(capacity)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing persistent volume's capacity %q", capacity)
+		}
+		if quantity.Cmp(current) <= 0 {
+			return nil, nil
+		}
+	}
+
+	return &quantity, nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestValidateSnapshotVolumeOverrides(t *testing.T) {
+	tests := []struct {
+		name       string
+		overrides  map[string]velerov1api.SnapshotVolumeOverride
+		wantErrors int
+	}{
+		{
+			name: "no overrides are valid",
+		},
+		{
+			name: "positive sizes and IOPS are valid",
+			overrides: map[string]velerov1api.SnapshotVolumeOverride{
+				"gp2":      {StorageClass: "gp3", VolumeType: "gp3", IOPS: int64Ptr(3000), Size: "100Gi"},
+				"standard": {StorageClass: "premium"},
+			},
+		},
+		{
+			name: "sizes that aren't positive quantities and IOPS that aren't positive are invalid",
+			overrides: map[string]velerov1api.SnapshotVolumeOverride{
+				"gp2":      {Size: "big", IOPS: int64Ptr(0)},
+				"standard": {Size: "-1Gi"},
+			},
+			wantErrors: 3,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Len(t, ValidateSnapshotVolumeOverrides(tc.overrides), tc.wantErrors)
+		})
+	}
+}
+
+func TestSnapshotVolumeSize(t *testing.T) {
+	pv := NewTestUnstructured().WithName("pv-1").WithSpecField("capacity", map[string]interface{}{"storage": "10Gi"}).Unstructured
+
+	size, err := snapshotVolumeSize(pv, "")
+	require.NoError(t, err)
+	assert.Nil(t, size, "volume isn't enlarged without a size")
+
+	size, err = snapshotVolumeSize(pv, "5Gi")
+	require.NoError(t, err)
+	assert.Nil(t, size, "volume isn't shrunk")
+
+	size, err = snapshotVolumeSize(pv, "20Gi")
+	require.NoError(t, err)
+	require.NotNil(t, size)
+	assert.Equal(t, "20Gi", size.String())
+
+	_, err = snapshotVolumeSize(pv, "big")
+	assert.Error(t, err)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// newStripIncludesExcludes returns the includes-excludes for the resources whose
+// items have a piece of metadata removed when they're restored. If spec is nil,
+// all resources are included.
+func newStripIncludesExcludes(helper discovery.Helper, spec *velerov1api.StripMetadataSpec) *collections.IncludesExcludes {
+	if spec == nil {
+		return collections.NewIncludesExcludes()
+	}
+
+	return collections.GetResourceIncludesExcludes(helper, spec.IncludedResources, spec.ExcludedResources)
+}
+
+// shouldStrip returns true if the given includes-excludes selects the resource
+// for stripping. A nil includes-excludes selects every resource.
+func shouldStrip(ie *collections.IncludesExcludes, groupResource schema.GroupResource) bool {
+	return ie == nil || ie.ShouldInclude(groupResource.String())
+}
+
+// resetItemMetadataAndStatus removes the metadata and status that aren't restored
+// from obj, keeping its finalizers and owner references if the restore doesn't
+// strip them for the item's resource.
+func (ctx *restoreContext) resetItemMetadataAndStatus(obj *unstructured.Unstructured, groupResource schema.GroupResource) (*unstructured.Unstructured, error) {
+	finalizers := obj.GetFinalizers()
+	ownerReferences := obj.GetOwnerReferences()
+
+	obj, err := resetMetadataAndStatus(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	if !shouldStrip(ctx.stripFinalizers, groupResource) {
+		obj.SetFinalizers(finalizers)
+	}
+	if !shouldStrip(ctx.stripOwnerReferences, groupResource) {
+		obj.SetOwnerReferences(ownerReferences)
+	}
+
+	return obj, nil
+}
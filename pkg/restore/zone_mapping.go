@@ -0,0 +1,123 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// topologyLabels are the well-known labels of the availability zone and region of
+// nodes and persistent volumes.
+var topologyLabels = sets.NewString(
+	"topology.kubernetes.io/zone",
+	"topology.kubernetes.io/region",
+	"failure-domain.beta.kubernetes.io/zone",
+	"failure-domain.beta.kubernetes.io/region",
+)
+
+// multiZoneDelimiter separates the zones of a label whose volume is replicated in
+// several zones, like a GCE regional persistent disk.
+const multiZoneDelimiter = "__"
+
+// isTopologyKey returns whether a label key is an availability zone or region: one of
+// the well-known labels, or a CSI driver's topology key like topology.ebs.csi.aws.com/zone.
+func isTopologyKey(key string) bool {
+	if topologyLabels.Has(key) {
+		return true
+	}
+
+	parts := strings.Split(key, "/")
+	name := parts[len(parts)-1]
+	return len(parts) == 2 && (name == "zone" || name == "region")
+}
+
+// mapZone returns the target of an availability zone or region in mapping, or the zone
+// itself if it isn't mapped. Each of the zones of a multi-zone value is mapped.
+func mapZone(zone string, mapping map[string]string) string {
+	if target, ok := mapping[zone]; ok {
+		return target
+	}
+
+	if !strings.Contains(zone, multiZoneDelimiter) {
+		return zone
+	}
+
+	zones := strings.Split(zone, multiZoneDelimiter)
+	for i := range zones {
+		zones[i] = mapZone(zones[i], mapping)
+	}
+	return strings.Join(zones, multiZoneDelimiter)
+}
+
+// remapPVZones rewrites the availability zones and regions in a persistent volume's
+// topology labels and required node affinity according to mapping, so that the volume
+// can be scheduled in the cluster it's restored into.
+func remapPVZones(obj *unstructured.Unstructured, mapping map[string]string) error {
+	if len(mapping) == 0 {
+		return nil
+	}
+
+	if labels := obj.GetLabels(); len(labels) > 0 {
+		for key, val := range labels {
+			if isTopologyKey(key) {
+				labels[key] = mapZone(val, mapping)
+			}
+		}
+		obj.SetLabels(labels)
+	}
+
+	nodeAffinityMap, found, err := unstructured.NestedMap(obj.Object, "spec", "nodeAffinity")
+	if err != nil {
+		return errors.Wrap(err, "error getting persistent volume's node affinity")
+	}
+	if !found {
+		return nil
+	}
+
+	nodeAffinity := new(corev1api.VolumeNodeAffinity)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(nodeAffinityMap, nodeAffinity); err != nil {
+		return errors.Wrap(err, "error converting persistent volume's node affinity")
+	}
+	if nodeAffinity.Required == nil {
+		return nil
+	}
+
+	for _, term := range nodeAffinity.Required.NodeSelectorTerms {
+		for i := range term.MatchExpressions {
+			expr := &term.MatchExpressions[i]
+			if !isTopologyKey(expr.Key) {
+				continue
+			}
+			for j := range expr.Values {
+				expr.Values[j] = mapZone(expr.Values[j], mapping)
+			}
+		}
+	}
+
+	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(nodeAffinity)
+	if err != nil {
+		return errors.Wrap(err, "error converting persistent volume's node affinity")
+	}
+
+	return errors.Wrap(unstructured.SetNestedMap(obj.Object, res, "spec", "nodeAffinity"), "error setting persistent volume's node affinity")
+}
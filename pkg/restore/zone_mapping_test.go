@@ -0,0 +1,120 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestMapZone(t *testing.T) {
+	mapping := map[string]string{
+		"us-east-1":     "us-west-2",
+		"us-east-1a":    "us-west-2a",
+		"us-central1-a": "europe-west1-b",
+	}
+
+	assert.Equal(t, "us-west-2", mapZone("us-east-1", mapping))
+	assert.Equal(t, "us-west-2a", mapZone("us-east-1a", mapping))
+	assert.Equal(t, "us-east-1b", mapZone("us-east-1b", mapping))
+	assert.Equal(t, "europe-west1-b__us-central1-b", mapZone("us-central1-a__us-central1-b", mapping))
+	assert.Equal(t, "", mapZone("", mapping))
+}
+
+func TestIsTopologyKey(t *testing.T) {
+	assert.True(t, isTopologyKey("topology.kubernetes.io/zone"))
+	assert.True(t, isTopologyKey("failure-domain.beta.kubernetes.io/region"))
+	assert.True(t, isTopologyKey("topology.ebs.csi.aws.com/zone"))
+	assert.False(t, isTopologyKey("zone"))
+	assert.False(t, isTopologyKey("kubernetes.io/hostname"))
+}
+
+func TestRemapPVZones(t *testing.T) {
+	mapping := map[string]string{
+		"us-east-1":  "us-west-2",
+		"us-east-1a": "us-west-2a",
+	}
+
+	newPV := func(region, zone string) *corev1api.PersistentVolume {
+		pv := builder.ForPersistentVolume("pv-1").
+			ObjectMeta(builder.WithLabels(
+				"topology.kubernetes.io/region", region,
+				"failure-domain.beta.kubernetes.io/zone", zone,
+				"app", "us-east-1a",
+			)).
+			Result()
+		pv.Spec.NodeAffinity = &corev1api.VolumeNodeAffinity{
+			Required: &corev1api.NodeSelector{
+				NodeSelectorTerms: []corev1api.NodeSelectorTerm{
+					{
+						MatchExpressions: []corev1api.NodeSelectorRequirement{
+							{Key: "topology.ebs.csi.aws.com/zone", Operator: corev1api.NodeSelectorOpIn, Values: []string{zone, "us-east-1b"}},
+							{Key: "kubernetes.io/hostname", Operator: corev1api.NodeSelectorOpIn, Values: []string{"us-east-1a"}},
+						},
+					},
+				},
+			},
+		}
+		return pv
+	}
+
+	tests := []struct {
+		name    string
+		pv      *corev1api.PersistentVolume
+		mapping map[string]string
+		want    *corev1api.PersistentVolume
+	}{
+		{
+			name: "volume is unchanged without a mapping",
+			pv:   newPV("us-east-1", "us-east-1a"),
+			want: newPV("us-east-1", "us-east-1a"),
+		},
+		{
+			name:    "topology labels and node affinity are remapped",
+			pv:      newPV("us-east-1", "us-east-1a"),
+			mapping: mapping,
+			want:    newPV("us-west-2", "us-west-2a"),
+		},
+		{
+			name:    "volume without node affinity has its labels remapped",
+			pv:      builder.ForPersistentVolume("pv-1").ObjectMeta(builder.WithLabels("topology.kubernetes.io/zone", "us-east-1a")).Result(),
+			mapping: mapping,
+			want:    builder.ForPersistentVolume("pv-1").ObjectMeta(builder.WithLabels("topology.kubernetes.io/zone", "us-west-2a")).Result(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pvMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.pv)
+			require.NoError(t, err)
+			obj := &unstructured.Unstructured{Object: pvMap}
+
+			require.NoError(t, remapPVZones(obj, tc.mapping))
+
+			res := new(corev1api.PersistentVolume)
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, res))
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
@@ -17,15 +17,26 @@ limitations under the License.
 package kube
 
 import (
+	"bytes"
 	"strconv"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
 )
 
-func ParseSecurityContext(runAsUser string, runAsGroup string, allowPrivilegeEscalation string) (corev1.SecurityContext, error) {
+// ParseSecurityContext returns the security context that secCtx, a YAML or JSON
+// SecurityContext, describes, with the given runAsUser, runAsGroup and
+// allowPrivilegeEscalation values, if set, taking precedence over secCtx's.
+func ParseSecurityContext(runAsUser string, runAsGroup string, allowPrivilegeEscalation string, secCtx string) (corev1.SecurityContext, error) {
 	securityContext := corev1.SecurityContext{}
 
+	if secCtx != "" {
+		if err := yaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(secCtx), len(secCtx)).Decode(&securityContext); err != nil {
+			return securityContext, errors.Wrap(err, "error parsing security context")
+		}
+	}
+
 	if runAsUser != "" {
 		parsedRunAsUser, err := strconv.ParseInt(runAsUser, 10, 64)
 		if err != nil {
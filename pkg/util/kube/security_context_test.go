@@ -30,6 +30,7 @@ func TestParseSecurityContext(t *testing.T) {
 		runAsUser                string
 		runAsGroup               string
 		allowPrivilegeEscalation string
+		secCtx                   string
 	}
 	tests := []struct {
 		name     string
@@ -37,33 +38,51 @@ func TestParseSecurityContext(t *testing.T) {
 		wantErr  bool
 		expected *corev1.SecurityContext
 	}{
-		{"valid security context", args{"1001", "999", "true"}, false, &corev1.SecurityContext{
+		{"valid security context", args{"1001", "999", "true", ""}, false, &corev1.SecurityContext{
 			RunAsUser:                pointInt64(1001),
 			RunAsGroup:               pointInt64(999),
 			AllowPrivilegeEscalation: boolptr.True(),
 		}},
 		{
 			"another valid security context",
-			args{"1001", "999", "false"}, false, &corev1.SecurityContext{
+			args{"1001", "999", "false", ""}, false, &corev1.SecurityContext{
 				RunAsUser:                pointInt64(1001),
 				RunAsGroup:               pointInt64(999),
 				AllowPrivilegeEscalation: boolptr.False(),
 			},
 		},
-		{"security context without runAsGroup", args{"1001", "", ""}, false, &corev1.SecurityContext{
+		{"security context without runAsGroup", args{"1001", "", "", ""}, false, &corev1.SecurityContext{
 			RunAsUser: pointInt64(1001),
 		}},
-		{"security context without runAsUser", args{"", "999", ""}, false, &corev1.SecurityContext{
+		{"security context without runAsUser", args{"", "999", "", ""}, false, &corev1.SecurityContext{
 			RunAsGroup: pointInt64(999),
 		}},
-		{"empty context without runAsUser", args{"", "", ""}, false, &corev1.SecurityContext{}},
-		{"invalid security context runAsUser", args{"not a number", "", ""}, true, nil},
-		{"invalid security context runAsGroup", args{"", "not a number", ""}, true, nil},
-		{"invalid security context allowPrivilegeEscalation", args{"", "", "not a bool"}, true, nil},
+		{"empty context without runAsUser", args{"", "", "", ""}, false, &corev1.SecurityContext{}},
+		{"invalid security context runAsUser", args{"not a number", "", "", ""}, true, nil},
+		{"invalid security context runAsGroup", args{"", "not a number", "", ""}, true, nil},
+		{"invalid security context allowPrivilegeEscalation", args{"", "", "not a bool", ""}, true, nil},
+		{
+			"security context from YAML",
+			args{"", "", "", "runAsNonRoot: true\nrunAsUser: 1001\nreadOnlyRootFilesystem: true\ncapabilities:\n  drop:\n  - ALL\n"}, false, &corev1.SecurityContext{
+				RunAsUser:              pointInt64(1001),
+				RunAsNonRoot:           boolptr.True(),
+				ReadOnlyRootFilesystem: boolptr.True(),
+				Capabilities:           &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+			},
+		},
+		{
+			"security context from YAML with runAsUser and runAsGroup",
+			args{"1002", "999", "", "runAsUser: 1001\nrunAsNonRoot: true"}, false, &corev1.SecurityContext{
+				RunAsUser:    pointInt64(1002),
+				RunAsGroup:   pointInt64(999),
+				RunAsNonRoot: boolptr.True(),
+			},
+		},
+		{"invalid security context YAML", args{"", "", "", "runAsUser: [1001]"}, true, nil},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := ParseSecurityContext(tt.args.runAsUser, tt.args.runAsGroup, tt.args.allowPrivilegeEscalation)
+			got, err := ParseSecurityContext(tt.args.runAsUser, tt.args.runAsGroup, tt.args.allowPrivilegeEscalation, tt.args.secCtx)
 			if tt.wantErr {
 				assert.Error(t, err)
 				return
@@ -76,6 +95,9 @@ func TestParseSecurityContext(t *testing.T) {
 
 			assert.Equal(t, tt.expected.RunAsUser, got.RunAsUser)
 			assert.Equal(t, tt.expected.RunAsGroup, got.RunAsGroup)
+			assert.Equal(t, tt.expected.RunAsNonRoot, got.RunAsNonRoot)
+			assert.Equal(t, tt.expected.ReadOnlyRootFilesystem, got.ReadOnlyRootFilesystem)
+			assert.Equal(t, tt.expected.Capabilities, got.Capabilities)
 		})
 	}
 }
@@ -139,6 +139,48 @@ func GetVolumeDirectory(pod *corev1api.Pod, volumeName string, pvcLister corev1l
 	return pvc.Spec.VolumeName, nil
 }
 
+// IsBlockVolume returns whether a pod's volume is a raw block volume, i.e. whether any of the
+// pod's containers use it as a device rather than mounting it.
+func IsBlockVolume(pod *corev1api.Pod, volumeName string) bool {
+	containers := append(append([]corev1api.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+	for _, container := range containers {
+		for _, device := range container.VolumeDevices {
+			if device.Name == volumeName {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// GetVolumeDeviceName gets the name of the device file on the host, under
+// /var/lib/kubelet/pods/<podUID>/volumeDevices/<volume-plugin-name>/, of the specified raw
+// block volume, which is the name of the persistent volume that the volume's PVC is bound to.
+func GetVolumeDeviceName(pod *corev1api.Pod, volumeName string, pvcLister corev1listers.PersistentVolumeClaimLister) (string, error) {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Name != volumeName {
+			continue
+		}
+
+		if volume.PersistentVolumeClaim == nil {
+			return "", errors.New("block volume doesn't have a persistent volume claim")
+		}
+
+		pvc, err := pvcLister.PersistentVolumeClaims(pod.Namespace).Get(volume.PersistentVolumeClaim.ClaimName)
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+		if pvc.Spec.VolumeName == "" {
+			return "", errors.New("persistent volume claim isn't bound")
+		}
+
+		return pvc.Spec.VolumeName, nil
+	}
+
+	return "", errors.New("volume not found in pod")
+}
+
 // IsCRDReady checks a CRD to see if it's ready, with both the Established and NamesAccepted conditions.
 func IsCRDReady(crd *apiextv1beta1.CustomResourceDefinition) bool {
 	var isEstablished, namesAccepted bool
@@ -202,6 +202,53 @@ func TestGetVolumeDirectorySuccess(t *testing.T) {
 	}
 }
 
+func TestIsBlockVolume(t *testing.T) {
+	pod := builder.ForPod("ns-1", "my-pod").
+		Volumes(
+			builder.ForVolume("block-vol").PersistentVolumeClaimSource("block-pvc").Result(),
+			builder.ForVolume("fs-vol").PersistentVolumeClaimSource("fs-pvc").Result(),
+		).
+		Containers(
+			builder.ForContainer("my-container", "my-image").
+				VolumeDevices(&corev1.VolumeDevice{Name: "block-vol", DevicePath: "/dev/xvda"}).
+				VolumeMounts(builder.ForVolumeMount("fs-vol", "/data").Result()).
+				Result(),
+		).
+		Result()
+
+	assert.True(t, IsBlockVolume(pod, "block-vol"))
+	assert.False(t, IsBlockVolume(pod, "fs-vol"))
+}
+
+func TestGetVolumeDeviceName(t *testing.T) {
+	h := newHarness(t)
+
+	pvcInformer := kubeinformers.NewSharedInformerFactoryWithOptions(h.KubeClient, 0, kubeinformers.WithNamespace("ns-1")).Core().V1().PersistentVolumeClaims()
+	require.NoError(t, pvcInformer.Informer().GetStore().Add(builder.ForPersistentVolumeClaim("ns-1", "bound-pvc").VolumeName("a-pv").Result()))
+	require.NoError(t, pvcInformer.Informer().GetStore().Add(builder.ForPersistentVolumeClaim("ns-1", "unbound-pvc").Result()))
+
+	pod := builder.ForPod("ns-1", "my-pod").
+		Volumes(
+			builder.ForVolume("bound-vol").PersistentVolumeClaimSource("bound-pvc").Result(),
+			builder.ForVolume("unbound-vol").PersistentVolumeClaimSource("unbound-pvc").Result(),
+			builder.ForVolume("csi-vol").CSISource("csi.test.com").Result(),
+		).
+		Result()
+
+	name, err := GetVolumeDeviceName(pod, "bound-vol", pvcInformer.Lister())
+	require.NoError(t, err)
+	assert.Equal(t, "a-pv", name)
+
+	_, err = GetVolumeDeviceName(pod, "unbound-vol", pvcInformer.Lister())
+	assert.Error(t, err)
+
+	_, err = GetVolumeDeviceName(pod, "csi-vol", pvcInformer.Lister())
+	assert.Error(t, err)
+
+	_, err = GetVolumeDeviceName(pod, "missing-vol", pvcInformer.Lister())
+	assert.Error(t, err)
+}
+
 func TestIsCRDReady(t *testing.T) {
 	tests := []struct {
 		name string
@@ -53,6 +53,10 @@ type SnapshotSpec struct {
 	// VolumeIOPS is the optional value of provisioned IOPS for the
 	// disk/volume in the cloud provider API.
 	VolumeIOPS *int64 `json:"volumeIOPS,omitempty"`
+
+	// SnapshotGroup is the name of the group of volumes that this volume
+	// was snapshotted together with, if any.
+	SnapshotGroup string `json:"snapshotGroup,omitempty"`
 }
 
 type SnapshotStatus struct {
@@ -62,6 +66,28 @@ type SnapshotStatus struct {
 
 	// Phase is the current state of the VolumeSnapshot.
 	Phase SnapshotPhase `json:"phase,omitempty"`
+
+	// ProviderOperationID is the ID of the volume snapshotter's operation that
+	// takes the snapshot, if the snapshot was still in progress when the
+	// backup's contents were uploaded.
+	ProviderOperationID string `json:"providerOperationID,omitempty"`
+
+	// Verification is the result of verifying the snapshot, if the backup
+	// requested it.
+	Verification SnapshotVerificationPhase `json:"verification,omitempty"`
+
+	// VerificationError is the error that the snapshot's verification failed with.
+	VerificationError string `json:"verificationError,omitempty"`
+
+	// Grouped is true if the snapshot was taken as part of a consistency group
+	// snapshot of its snapshot group. It's false if the group's volumes were
+	// snapshotted one after another because the provider doesn't support
+	// consistency groups.
+	Grouped bool `json:"grouped,omitempty"`
+
+	// SizeBytes is the number of bytes of storage that the snapshot uses in
+	// its provider, if the volume snapshotter reports it.
+	SizeBytes int64 `json:"sizeBytes,omitempty"`
 }
 
 // SnapshotPhase is the lifecyle phase of a Velero volume snapshot.
@@ -72,9 +98,32 @@ const (
 	// yet processed by the VolumeSnapshotController.
 	SnapshotPhaseNew SnapshotPhase = "New"
 
+	// SnapshotPhaseInProgress means the volume snapshotter has started taking
+	// the volume snapshot, but hasn't finished yet.
+	SnapshotPhaseInProgress SnapshotPhase = "InProgress"
+
 	// SnapshotPhaseCompleted means the volume snapshot was successfully created and can be restored from..
 	SnapshotPhaseCompleted SnapshotPhase = "Completed"
 
 	// SnapshotPhaseFailed means the volume snapshot was unable to execute.
 	SnapshotPhaseFailed SnapshotPhase = "Failed"
+
+	// SnapshotPhaseDeleted means the volume snapshot was deleted because the
+	// backup's snapshot TTL elapsed, and can no longer be restored from.
+	SnapshotPhaseDeleted SnapshotPhase = "Deleted"
+)
+
+// SnapshotVerificationPhase is the result of verifying a Velero volume snapshot.
+type SnapshotVerificationPhase string
+
+const (
+	// SnapshotVerificationVerified means the volume snapshotter verified the snapshot.
+	SnapshotVerificationVerified SnapshotVerificationPhase = "Verified"
+
+	// SnapshotVerificationFailed means the snapshot couldn't be verified.
+	SnapshotVerificationFailed SnapshotVerificationPhase = "Failed"
+
+	// SnapshotVerificationUnsupported means the volume snapshotter doesn't support
+	// verifying snapshots.
+	SnapshotVerificationUnsupported SnapshotVerificationPhase = "Unsupported"
 )